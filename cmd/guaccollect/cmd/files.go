@@ -45,8 +45,14 @@ type filesOptions struct {
 	blobAddr string
 	// poll location
 	poll bool
+	// watch the location for file changes instead of re-walking it when polling
+	watch bool
 	// use blob URL for origin instead of source URL (useful if the blob store is persistent and we want to store the blob source location)
 	useBlobURL bool
+	// gzip compress the collected files
+	compress bool
+	// number of files read at once
+	concurrency int
 }
 
 var filesCmd = &cobra.Command{
@@ -74,6 +80,9 @@ you have access to read and write to the respective blob store.`,
 			viper.GetString("blob-addr"),
 			viper.GetBool("service-poll"),
 			viper.GetBool("use-blob-url"),
+			viper.GetBool("watch"),
+			viper.GetBool("compress"),
+			viper.GetInt("file-concurrency"),
 			args)
 		if err != nil {
 			fmt.Printf("unable to validate flags: %v\n", err)
@@ -85,7 +94,7 @@ you have access to read and write to the respective blob store.`,
 		logger := logging.FromContext(ctx)
 
 		// Register collector
-		fileCollector := file.NewFileCollector(ctx, opts.path, opts.poll, 30*time.Second, opts.useBlobURL)
+		fileCollector := file.NewFileCollector(ctx, opts.path, opts.poll, 30*time.Second, opts.useBlobURL, opts.watch, opts.compress, opts.concurrency)
 		err = collector.RegisterDocumentCollector(fileCollector, file.FileCollector)
 		if err != nil {
 			logger.Fatalf("unable to register file collector: %v", err)
@@ -95,13 +104,20 @@ you have access to read and write to the respective blob store.`,
 	},
 }
 
-func validateFilesFlags(pubsubAddr, blobAddr string, poll, useBlobURL bool, args []string) (filesOptions, error) {
+func validateFilesFlags(pubsubAddr, blobAddr string, poll, useBlobURL, watch, compress bool, concurrency int, args []string) (filesOptions, error) {
 	var opts filesOptions
 
 	opts.pubsubAddr = pubsubAddr
 	opts.blobAddr = blobAddr
 	opts.poll = poll
 	opts.useBlobURL = useBlobURL
+	opts.watch = watch
+	opts.compress = compress
+	opts.concurrency = concurrency
+
+	if concurrency < 1 {
+		return opts, fmt.Errorf("file concurrency must be at least 1, got %d", concurrency)
+	}
 
 	if len(args) != 1 {
 		return opts, fmt.Errorf("expected positional argument for file_path")
@@ -193,7 +209,7 @@ func initializeNATsandCollector(ctx context.Context, pubsubAddr string, blobAddr
 }
 
 func init() {
-	set, err := cli.BuildFlags([]string{"use-blob-url"})
+	set, err := cli.BuildFlags([]string{"use-blob-url", "watch", "compress", "file-concurrency"})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
 		os.Exit(1)
@@ -1,9 +1,11 @@
 package cmd
 
 import (
-	"cloud.google.com/go/storage"
 	"context"
 	"fmt"
+	"os"
+	"time"
+
 	"github.com/guacsec/guac/pkg/cli"
 	"github.com/guacsec/guac/pkg/collectsub/client"
 	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
@@ -14,7 +16,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"google.golang.org/api/option"
-	"os"
 )
 
 type gcsOptions struct {
@@ -23,14 +24,22 @@ type gcsOptions struct {
 	graphqlEndpoint   string
 	csubClientOptions client.CsubClientOptions
 	bucket            string
+	prefix            string
+	projectID         string
+	pollInterval      time.Duration
 }
 
-const gcsCredentialsPathFlag = "gcp-credentials-path"
+const (
+	gcsCredentialsPathFlag = "gcp-credentials-path"
+	gcsPrefixFlag          = "gcs-prefix"
+	gcpProjectIDFlag       = "gcp-project-id"
+	gcsPollIntervalFlag    = "gcs-poll-interval"
+)
 
 var gcsCmd = &cobra.Command{
 	Use:     "gcs [flags] bucket_name",
 	Short:   "takes SBOMs and attestations from a Google Cloud Storage bucket and injects them to GUAC graph. This command talks directly to the graphQL endpoint",
-	Example: "guacone collect gcs my-bucket --gcs-credentials-path /secret/sa.json",
+	Example: "guaccollect gcs my-bucket --gcp-credentials-path /secret/sa.json --gcs-prefix sboms/ --gcs-poll-interval 5m",
 	Args:    cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		ctx := logging.WithLogger(context.Background())
@@ -43,7 +52,9 @@ var gcsCmd = &cobra.Command{
 			viper.GetString("csub-addr"),
 			viper.GetBool("csub-tls"),
 			viper.GetBool("csub-tls-skip-verify"),
-			viper.GetString(gcsCredentialsPathFlag),
+			viper.GetString(gcsPrefixFlag),
+			viper.GetString(gcpProjectIDFlag),
+			viper.GetString(gcsPollIntervalFlag),
 			args)
 		if err != nil {
 			fmt.Printf("unable to validate flags: %v\n", err)
@@ -51,23 +62,25 @@ var gcsCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		gcsOpts := []option.ClientOption{
-			option.WithUserAgent(version.UserAgent),
-		}
-
-		// Credential flag is not mandatory since they can also be loaded from
-		// the environment variable GOOGLE_APPLICATION_CREDENTIALS by the client, by default
-		if credsPath := viper.GetString(gcsCredentialsPathFlag); credsPath != "" {
-			gcsOpts = append(gcsOpts, option.WithCredentialsFile(credsPath))
-		}
-
-		client, err := storage.NewClient(ctx, gcsOpts...)
+		// Without the credentials flag, the application default credentials
+		// are used: GOOGLE_APPLICATION_CREDENTIALS, Workload Identity
+		// Federation or the metadata server
+		client, err := gcs.NewClient(ctx, viper.GetString(gcsCredentialsPathFlag), option.WithUserAgent(version.UserAgent))
 		if err != nil {
 			logger.Fatalf("creating client: %v", err)
 		}
 
 		// Register collector by providing a new GCS Client and bucket name
-		gcsCollector, err := gcs.NewGCSCollector(gcs.WithBucket(opts.bucket), gcs.WithClient(client))
+		collectorOpts := []gcs.Opt{
+			gcs.WithBucket(opts.bucket),
+			gcs.WithClient(client),
+			gcs.WithPrefix(opts.prefix),
+			gcs.WithProjectID(opts.projectID),
+		}
+		if opts.pollInterval > 0 {
+			collectorOpts = append(collectorOpts, gcs.WithPolling(opts.pollInterval))
+		}
+		gcsCollector, err := gcs.NewGCSCollector(collectorOpts...)
 		if err != nil {
 			logger.Fatalf("unable to create gcs client: %v", err)
 		}
@@ -90,7 +103,7 @@ var gcsCmd = &cobra.Command{
 	},
 }
 
-func validateGCSFlags(pubSubAddr, blobAddr, gqlEndpoint string, csubAddr string, csubTls bool, csubTlsSkipVerify bool, credentialsPath string, args []string) (gcsOptions, error) {
+func validateGCSFlags(pubSubAddr, blobAddr, gqlEndpoint string, csubAddr string, csubTls bool, csubTlsSkipVerify bool, prefix, projectID, pollInterval string, args []string) (gcsOptions, error) {
 	opts := gcsOptions{
 		pubSubAddr:      pubSubAddr,
 		blobAddr:        blobAddr,
@@ -108,15 +121,22 @@ func validateGCSFlags(pubSubAddr, blobAddr, gqlEndpoint string, csubAddr string,
 	}
 	opts.bucket = args[0]
 
-	if credentialsPath == "" && os.Getenv("GOOGLE_APPLICATION_CREDENTIALS") == "" {
-		return opts, fmt.Errorf("expected either --%s flag or GOOGLE_APPLICATION_CREDENTIALS environment variable", gcsCredentialsPathFlag)
+	opts.prefix = prefix
+	opts.projectID = projectID
+
+	if pollInterval != "" {
+		interval, err := time.ParseDuration(pollInterval)
+		if err != nil {
+			return opts, fmt.Errorf("failed to parse poll interval: %w", err)
+		}
+		opts.pollInterval = interval
 	}
 
 	return opts, nil
 }
 
 func init() {
-	set, err := cli.BuildFlags([]string{gcsCredentialsPathFlag})
+	set, err := cli.BuildFlags([]string{gcsCredentialsPathFlag, gcsPrefixFlag, gcpProjectIDFlag, gcsPollIntervalFlag})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
 		os.Exit(1)
@@ -0,0 +1,124 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/diff"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type diffOptions struct {
+	beforeEndpoint string
+	afterEndpoint  string
+	headerFile     string
+	filter         diff.NodeFilter
+}
+
+var rootCmd = &cobra.Command{
+	Use:   "guacdiff [flags] <before-gql-addr> <after-gql-addr>",
+	Short: "compares two GUAC graph snapshots and prints the added, removed and updated nodes",
+	Long: `guacdiff enumerates the packages, vulnerability certifications, SBOMs and
+dependencies served by two GUAC GraphQL servers and prints the difference as
+JSON. For example:
+
+  guacdiff -t package,certifyVuln http://before:8080/query http://after:8080/query
+
+Nodes are matched by ID, so both servers should use a backend with
+content-derived IDs such as ent.`,
+	Version: version.Version,
+	Args:    cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateDiffFlags(
+			viper.GetString("header-file"),
+			viper.GetStringSlice("diff-node-types"),
+			args,
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		transport, err := cli.NewHTTPHeaderTransport(opts.headerFile, http.DefaultTransport)
+		if err != nil {
+			logger.Fatalf("unable to create HTTP transport: %v", err)
+		}
+		httpClient := http.Client{Transport: transport}
+		before := diff.NewGraphQLBackend(graphql.NewClient(opts.beforeEndpoint, &httpClient))
+		after := diff.NewGraphQLBackend(graphql.NewClient(opts.afterEndpoint, &httpClient))
+
+		result, err := diff.Diff(ctx, before, after, opts.filter)
+		if err != nil {
+			logger.Fatalf("unable to compare snapshots: %v", err)
+		}
+		out, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			logger.Fatalf("unable to format results: %v", err)
+		}
+		fmt.Println(string(out))
+	},
+}
+
+func validateDiffFlags(headerFile string, types []string, args []string) (diffOptions, error) {
+	var opts diffOptions
+	opts.headerFile = headerFile
+	opts.beforeEndpoint = args[0]
+	opts.afterEndpoint = args[1]
+	for _, t := range types {
+		nodeType, err := diff.ParseNodeType(t)
+		if err != nil {
+			return opts, err
+		}
+		opts.filter.Types = append(opts.filter.Types, nodeType)
+	}
+	return opts, nil
+}
+
+func init() {
+	cobra.OnInitialize(cli.InitConfig)
+
+	set, err := cli.BuildFlags([]string{"header-file", "diff-node-types"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	rootCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(rootCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+}
+
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/guacsec/guac/pkg/assembler/backends"
 	entbackend "github.com/guacsec/guac/pkg/assembler/backends/ent/backend"
+	"github.com/guacsec/guac/pkg/assembler/graphql/explain"
 )
 
 func init() {
@@ -33,9 +34,11 @@ func init() {
 
 func getEnt(_ context.Context) backends.BackendArgs {
 	return &entbackend.BackendOptions{
-		DriverName:  flags.dbDriver,
-		Address:     flags.dbAddress,
-		Debug:       flags.dbDebug,
-		AutoMigrate: flags.dbMigrate,
+		DriverName:   flags.dbDriver,
+		Address:      flags.dbAddress,
+		Debug:        flags.dbDebug,
+		AutoMigrate:  flags.dbMigrate,
+		SkipIfExists: flags.dbSkipExisting,
+		Explain:      explain.DevMode(),
 	}
 }
@@ -35,6 +35,28 @@ var flags = struct {
 	debug       bool
 	tracegql    bool
 
+	traceBackend bool
+
+	staleAfterDays int
+
+	apqCacheSize int
+
+	hotCacheSize int
+	hotCacheTTL  string
+
+	rateLimitRPS   float64
+	rateLimitBurst int
+
+	authJWKSURL string
+	authScope   string
+
+	gzipLevel   int
+	gzipMinSize int
+
+	complexityLimit int
+
+	healthPort int
+
 	// Needed only if using neo4j backend
 	nAddr  string
 	nUser  string
@@ -42,10 +64,11 @@ var flags = struct {
 	nRealm string
 
 	// Needed only if using ent backend
-	dbAddress string
-	dbDriver  string
-	dbDebug   bool
-	dbMigrate bool
+	dbAddress      string
+	dbDriver       string
+	dbDebug        bool
+	dbMigrate      bool
+	dbSkipExisting bool
 
 	// Needed only if using arangodb backend
 	arangoAddr string
@@ -75,6 +98,19 @@ var rootCmd = &cobra.Command{
 		flags.tlsKeyFile = viper.GetString("gql-tls-key-file")
 		flags.debug = viper.GetBool("gql-debug")
 		flags.tracegql = viper.GetBool("gql-trace")
+		flags.traceBackend = viper.GetBool("gql-backend-trace")
+		flags.staleAfterDays = viper.GetInt("gql-stale-after-days")
+		flags.apqCacheSize = viper.GetInt("gql-apq-cache-size")
+		flags.hotCacheSize = viper.GetInt("gql-hotcache-size")
+		flags.hotCacheTTL = viper.GetString("gql-hotcache-ttl")
+		flags.rateLimitRPS = viper.GetFloat64("gql-rate-limit-rps")
+		flags.rateLimitBurst = viper.GetInt("gql-rate-limit-burst")
+		flags.authJWKSURL = viper.GetString("gql-auth-jwks-url")
+		flags.authScope = viper.GetString("gql-auth-scope")
+		flags.gzipLevel = viper.GetInt("gql-gzip-level")
+		flags.gzipMinSize = viper.GetInt("gql-gzip-min-size")
+		flags.complexityLimit = viper.GetInt("gql-complexity-limit")
+		flags.healthPort = viper.GetInt("gql-health-port")
 
 		flags.nUser = viper.GetString("neo4j-user")
 		flags.nPass = viper.GetString("neo4j-pass")
@@ -86,6 +122,7 @@ var rootCmd = &cobra.Command{
 		flags.dbDriver = viper.GetString("db-driver")
 		flags.dbDebug = viper.GetBool("db-debug")
 		flags.dbMigrate = viper.GetBool("db-migrate")
+		flags.dbSkipExisting = viper.GetBool("db-skip-existing-certify-vulns")
 
 		flags.arangoUser = viper.GetString("arango-user")
 		flags.arangoPass = viper.GetString("arango-pass")
@@ -111,8 +148,11 @@ func init() {
 		"arango-addr", "arango-user", "arango-pass",
 		"neo4j-addr", "neo4j-user", "neo4j-pass", "neo4j-realm",
 		"neptune-endpoint", "neptune-port", "neptune-region", "neptune-user", "neptune-realm",
-		"gql-listen-port", "gql-tls-cert-file", "gql-tls-key-file", "gql-debug", "gql-backend", "gql-trace",
-		"db-address", "db-driver", "db-debug", "db-migrate",
+		"gql-listen-port", "gql-tls-cert-file", "gql-tls-key-file", "gql-debug", "gql-backend", "gql-trace", "gql-backend-trace", "gql-stale-after-days",
+		"gql-apq-cache-size", "gql-hotcache-size", "gql-hotcache-ttl", "gql-rate-limit-rps", "gql-rate-limit-burst",
+		"gql-auth-jwks-url", "gql-auth-scope", "gql-gzip-level", "gql-gzip-min-size", "gql-complexity-limit",
+		"gql-health-port",
+		"db-address", "db-driver", "db-debug", "db-migrate", "db-skip-existing-certify-vulns",
 		"kv-store", "kv-redis", "kv-tikv", "enable-prometheus",
 	})
 	if err != nil {
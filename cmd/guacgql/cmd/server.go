@@ -16,6 +16,7 @@
 package cmd
 
 import (
+	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
@@ -26,22 +27,40 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/debug"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/guacsec/guac/internal/client/githubclient"
 	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/arangodb"
+	"github.com/guacsec/guac/pkg/assembler/backends/hotcache"
 	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
 	"github.com/guacsec/guac/pkg/assembler/backends/neo4j"
 	"github.com/guacsec/guac/pkg/assembler/backends/neptune"
+	"github.com/guacsec/guac/pkg/assembler/backends/ratelimit"
+	"github.com/guacsec/guac/pkg/assembler/backends/tracing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
+	"github.com/guacsec/guac/pkg/assembler/graphql/explain"
 	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/middleware"
 	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 	"github.com/guacsec/guac/pkg/assembler/kv/redis"
+	"github.com/guacsec/guac/pkg/exporter/ndjson"
+	"github.com/guacsec/guac/pkg/health"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/guacsec/guac/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 	"golang.org/x/exp/maps"
 )
 
@@ -53,6 +72,10 @@ const (
 	keyvalue = "keyvalue"
 )
 
+// staleVulnCheckInterval is how often vulnerability certifications older than
+// the configured threshold are marked as STALE.
+const staleVulnCheckInterval = time.Hour
+
 type optsFunc func(context.Context) backends.BackendArgs
 
 var getOpts map[string]optsFunc
@@ -78,18 +101,41 @@ func startServer(cmd *cobra.Command) {
 		os.Exit(1)
 	}
 
-	srv, err := getGraphqlServer(ctx)
+	backend, err := backends.Get(flags.backend, ctx, getOpts[flags.backend](ctx))
+	if err != nil {
+		logger.Errorf("unable to initialize graphql server: Error creating %v backend: %v", flags.backend, err)
+		os.Exit(1)
+	}
+	// the database is checked on the backend itself, as the wrappers added by
+	// getGraphqlServer hide it
+	db, _ := backend.(health.Database)
+	// the operations explained are run on the backend itself too, so that the
+	// queries are not answered by the hot cache
+	explainBackend := backend
+
+	srv, backend, err := getGraphqlServer(ctx, backend)
 	if err != nil {
 		logger.Errorf("unable to initialize graphql server: %v", err)
 		os.Exit(1)
 	}
 
+	if staleAfter := staleAfter(); staleAfter > 0 {
+		staleCtx, cancelStale := context.WithCancel(ctx)
+		defer cancelStale()
+		go markStaleVulns(staleCtx, backend, staleAfter)
+	}
+
 	metric, err := setupPrometheus(ctx, "guacgql")
 	if err != nil {
 		logger.Fatalf("Error setting up Prometheus: %v", err)
 	}
 
 	if metric != nil {
+		resolverMetrics, err := middleware.NewMetrics(prometheus.DefaultRegisterer)
+		if err != nil {
+			logger.Fatalf("Error registering resolver metrics: %v", err)
+		}
+		srv.Use(resolverMetrics)
 		srvHandler = metric.MeasureGraphQLResponseDuration(srv)
 	} else {
 		srvHandler = srv
@@ -100,9 +146,54 @@ func startServer(cmd *cobra.Command) {
 		srv.Use(tracer)
 	}
 
+	// SourceName.recentCommits calls the GitHub API with the token of the
+	// request context, which is unauthenticated without one
+	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+		srvHandler = withGithubToken(token, srvHandler)
+	}
+
+	// the export streams its response, so it is not compressed
+	exportHandler := ndjson.NewCertifyVulnHandler(backend)
+
+	// the explain endpoint runs operations outside of the limits of the
+	// graphql server, so it is only served in development mode
+	var explainHandler http.Handler
+	if explain.DevMode() {
+		h := explain.NewHandler(newExecutableSchema(explainBackend))
+		h.SetErrorPresenter(errext.ErrorPresenter)
+		h.AroundOperations(resolvers.LoaderMiddleware)
+		explainHandler = h
+	}
+
+	if flags.authJWKSURL != "" {
+		auth, err := middleware.NewAuth(ctx, flags.authJWKSURL)
+		if err != nil {
+			logger.Fatalf("Error setting up authentication: %v", err)
+		}
+		srv.Use(middleware.RequireScope(flags.authScope))
+		srvHandler = auth.Handler(srvHandler)
+		exportHandler = auth.Handler(middleware.RequireScopeHandler(flags.authScope, exportHandler))
+		if explainHandler != nil {
+			explainHandler = auth.Handler(middleware.RequireScopeHandler(flags.authScope, explainHandler))
+		}
+	}
+
+	if flags.gzipLevel != gzip.NoCompression {
+		compress, err := middleware.NewCompress(flags.gzipLevel, flags.gzipMinSize)
+		if err != nil {
+			logger.Fatalf("Error setting up response compression: %v", err)
+		}
+		srvHandler = compress.Handler(srvHandler)
+	}
+
 	http.HandleFunc("/healthz", healthHandler)
 
 	http.Handle("/query", srvHandler)
+	http.Handle(ndjson.CertifyVulnExportPath, exportHandler)
+	if explainHandler != nil {
+		http.Handle(explain.Path, explainHandler)
+		logger.Infof("%s is set, serving query plans on %s", explain.DevModeEnv, explain.Path)
+	}
 	proto := "http"
 	if flags.tlsCertFile != "" && flags.tlsKeyFile != "" {
 		proto = "https"
@@ -121,6 +212,19 @@ func startServer(cmd *cobra.Command) {
 			logger.Infof("server finished: %s", server.ListenAndServe())
 		}
 	}()
+
+	// the health check is on its own port so that probes are not subject to
+	// the authentication and limits of the graphql api
+	var healthServer *http.Server
+	if flags.healthPort != 0 {
+		mux := http.NewServeMux()
+		mux.Handle(health.Path, health.NewHealthChecker(db))
+		healthServer = &http.Server{Addr: fmt.Sprintf(":%d", flags.healthPort), Handler: mux}
+		logger.Infof("serving health check on :%d%s", flags.healthPort, health.Path)
+		go func() {
+			logger.Infof("health server finished: %s", healthServer.ListenAndServe())
+		}()
+	}
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 	s := <-sigs
@@ -128,6 +232,9 @@ func startServer(cmd *cobra.Command) {
 	done := make(chan bool, 1)
 	ctx, cf := context.WithCancel(ctx)
 	go func() {
+		if healthServer != nil {
+			_ = healthServer.Shutdown(ctx)
+		}
 		_ = server.Shutdown(ctx)
 		done <- true
 	}()
@@ -137,10 +244,20 @@ func startServer(cmd *cobra.Command) {
 		logger.Warnf("forcibly shutting down gql http server")
 		cf()
 		server.Close()
+		if healthServer != nil {
+			healthServer.Close()
+		}
 	}
 	cf()
 }
 
+// withGithubToken adds the GitHub token to the context of the requests
+func withGithubToken(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(githubclient.WithToken(r.Context(), token)))
+	})
+}
+
 // setupPrometheus sets up the Prometheus server, registering its handler on http.DefaultServeMux
 func setupPrometheus(ctx context.Context, name string) (metrics.MetricCollector, error) {
 	enablePrometheus := viper.GetBool("enable-prometheus")
@@ -167,25 +284,165 @@ func validateFlags() error {
 	if !slices.Contains([]string{"memmap", "redis", "tikv"}, flags.kvStore) {
 		return fmt.Errorf("invalid kv store specified: %v", flags.kvStore)
 	}
+	if flags.staleAfterDays < 0 {
+		return fmt.Errorf("invalid stale after days specified: %v", flags.staleAfterDays)
+	}
+	if flags.apqCacheSize < 0 {
+		return fmt.Errorf("invalid persisted query cache size specified: %v", flags.apqCacheSize)
+	}
+	if flags.hotCacheSize < 0 {
+		return fmt.Errorf("invalid hot cache size specified: %v", flags.hotCacheSize)
+	}
+	if ttl, err := time.ParseDuration(flags.hotCacheTTL); err != nil || ttl < 0 {
+		return fmt.Errorf("invalid hot cache ttl specified: %v", flags.hotCacheTTL)
+	}
+	if flags.rateLimitRPS < 0 {
+		return fmt.Errorf("invalid rate limit specified: %v", flags.rateLimitRPS)
+	}
+	if flags.rateLimitRPS > 0 && flags.rateLimitBurst <= 0 {
+		return fmt.Errorf("invalid rate limit burst specified: %v", flags.rateLimitBurst)
+	}
+	if flags.complexityLimit < 0 {
+		return fmt.Errorf("invalid complexity limit specified: %v", flags.complexityLimit)
+	}
+	if flags.gzipLevel < gzip.HuffmanOnly || flags.gzipLevel > gzip.BestCompression {
+		return fmt.Errorf("invalid gzip level specified: %v", flags.gzipLevel)
+	}
+	if flags.gzipMinSize < 0 {
+		return fmt.Errorf("invalid gzip minimum size specified: %v", flags.gzipMinSize)
+	}
+	if flags.healthPort < 0 || (flags.healthPort != 0 && flags.healthPort == flags.port) {
+		return fmt.Errorf("invalid health port specified: %v", flags.healthPort)
+	}
 	return nil
 }
 
-func getGraphqlServer(ctx context.Context) (*handler.Server, error) {
-	var topResolver resolvers.Resolver
+func staleAfter() time.Duration {
+	return time.Duration(flags.staleAfterDays) * 24 * time.Hour
+}
 
-	backend, err := backends.Get(flags.backend, ctx, getOpts[flags.backend](ctx))
+func getGraphqlServer(ctx context.Context, backend backends.Backend) (*handler.Server, backends.Backend, error) {
+	var err error
+
+	// the backend is traced before it is wrapped, so that the spans measure the
+	// calls that reach it and not the waits on the rate limit or cache hits
+	if flags.traceBackend {
+		backend = tracing.NewTracedBackend(backend, newBackendTracer(ctx))
+	}
+	if flags.rateLimitRPS > 0 {
+		backend = ratelimit.NewRateLimitedBackend(backend, flags.rateLimitRPS, flags.rateLimitBurst)
+	}
+	// the hot cache is in front of the rate limit so that cache hits do not
+	// use up calls
+	if flags.hotCacheSize > 0 {
+		// validateFlags has checked the TTL
+		ttl, _ := time.ParseDuration(flags.hotCacheTTL)
+		backend, err = hotcache.New(backend, flags.hotCacheSize, ttl)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error creating hot cache: %w", err)
+		}
+	}
+	srv, err := newServer(newExecutableSchema(backend))
 	if err != nil {
-		return nil, fmt.Errorf("Error creating %v backend: %w", flags.backend, err)
+		return nil, nil, err
 	}
-	topResolver = resolvers.Resolver{Backend: backend}
+	srv.SetErrorPresenter(errext.ErrorPresenter)
+	srv.AroundOperations(resolvers.LoaderMiddleware)
+
+	return srv, backend, nil
+}
+
+// newBackendTracer returns a tracer logging the spans of the backend calls on
+// the console as they end.
+func newBackendTracer(ctx context.Context) trace.Tracer {
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(logSpanExporter{logger: logging.FromContext(ctx)}))
+	return provider.Tracer("github.com/guacsec/guac/pkg/assembler/backends/tracing")
+}
+
+// logSpanExporter logs the spans with their duration and attributes
+type logSpanExporter struct {
+	logger *zap.SugaredLogger
+}
 
+func (e logSpanExporter) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	for _, span := range spans {
+		fields := []any{"duration", span.EndTime().Sub(span.StartTime())}
+		for _, attr := range span.Attributes() {
+			fields = append(fields, string(attr.Key), attr.Value.Emit())
+		}
+		if span.Status().Code == codes.Error {
+			fields = append(fields, "error", span.Status().Description)
+		}
+		e.logger.Infow(span.Name(), fields...)
+	}
+	return nil
+}
+
+func (logSpanExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func newExecutableSchema(backend backends.Backend) graphql.ExecutableSchema {
+	topResolver := resolvers.Resolver{Backend: backend, StaleAfter: staleAfter()}
 	config := generated.Config{Resolvers: &topResolver}
 	config.Directives.Filter = resolvers.Filter
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(config))
+	return generated.NewExecutableSchema(config)
+}
 
+// newServer is handler.NewDefaultServer with persisted queries kept in a cache
+// of the configured size and the configured complexity limit.
+func newServer(es graphql.ExecutableSchema) (*handler.Server, error) {
+	srv := handler.New(es)
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+	srv.SetQueryCache(lru.New(1000))
+	srv.Use(extension.Introspection{})
+	if flags.apqCacheSize > 0 {
+		srv.Use(extension.AutomaticPersistedQuery{Cache: lru.New(flags.apqCacheSize)})
+	}
+	if flags.complexityLimit > 0 {
+		complexityLimit, err := middleware.NewComplexityLimit(flags.complexityLimit)
+		if err != nil {
+			return nil, err
+		}
+		srv.Use(complexityLimit)
+	}
 	return srv, nil
 }
 
+// markStaleVulns marks aged vulnerability certifications as STALE once and then
+// every staleVulnCheckInterval, until the context is canceled.
+func markStaleVulns(ctx context.Context, backend backends.Backend, staleAfter time.Duration) {
+	logger := logging.FromContext(ctx)
+	markStale := func() {
+		updated, err := backend.MarkStaleVulns(ctx, staleAfter)
+		if err != nil {
+			logger.Errorf("failed to mark stale vulnerability certifications: %v", err)
+			return
+		}
+		if updated > 0 {
+			logger.Infof("marked %d vulnerability certifications as stale", updated)
+		}
+	}
+
+	markStale()
+	ticker := time.NewTicker(staleVulnCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			markStale()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 func healthHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	_, _ = fmt.Fprint(w, "Server is healthy")
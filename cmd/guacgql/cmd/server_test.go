@@ -0,0 +1,146 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+)
+
+const certifyVulnQuery = "{ CertifyVuln(certifyVulnSpec: {}) { id } }"
+
+type gqlResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+}
+
+func TestServerPersistedQuery(t *testing.T) {
+	sum := sha256.Sum256([]byte(certifyVulnQuery))
+	hash := hex.EncodeToString(sum[:])
+	otherSum := sha256.Sum256([]byte("{ __typename }"))
+	otherHash := hex.EncodeToString(otherSum[:])
+
+	// the steps share the cache and run in order
+	tests := []struct {
+		name      string
+		query     string
+		hash      string
+		wantErr   string
+		wantCode  string
+		wantCalls bool
+	}{{
+		name:     "cache miss",
+		hash:     hash,
+		wantErr:  "PersistedQueryNotFound",
+		wantCode: "PERSISTED_QUERY_NOT_FOUND",
+	}, {
+		name:    "hash mismatch",
+		query:   certifyVulnQuery,
+		hash:    otherHash,
+		wantErr: "provided APQ hash does not match query",
+	}, {
+		name:     "mismatched query is not stored",
+		hash:     otherHash,
+		wantErr:  "PersistedQueryNotFound",
+		wantCode: "PERSISTED_QUERY_NOT_FOUND",
+	}, {
+		name:      "query stored",
+		query:     certifyVulnQuery,
+		hash:      hash,
+		wantCalls: true,
+	}, {
+		name:      "cache hit",
+		hash:      hash,
+		wantCalls: true,
+	}}
+
+	oldSize := flags.apqCacheSize
+	flags.apqCacheSize = 10
+	defer func() { flags.apqCacheSize = oldSize }()
+
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	srv, err := newServer(generated.NewExecutableSchema(generated.Config{Resolvers: &resolvers.Resolver{Backend: b}}))
+	if err != nil {
+		t.Fatalf("newServer() error = %v", err)
+	}
+	server := httptest.NewServer(srv)
+	defer server.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			times := 0
+			if tt.wantCalls {
+				times = 1
+			}
+			b.EXPECT().CertifyVuln(gomock.Any(), gomock.Any()).Return([]*model.CertifyVuln{}, nil).Times(times)
+
+			body, err := json.Marshal(map[string]interface{}{
+				"query": tt.query,
+				"extensions": map[string]interface{}{
+					"persistedQuery": map[string]interface{}{"version": 1, "sha256Hash": tt.hash},
+				},
+			})
+			if err != nil {
+				t.Fatalf("failed to encode request: %v", err)
+			}
+			resp, err := http.Post(server.URL, "application/json", bytes.NewReader(body))
+			if err != nil {
+				t.Fatalf("query failed: %v", err)
+			}
+			defer resp.Body.Close()
+			var got gqlResponse
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if tt.wantErr == "" {
+				if len(got.Errors) != 0 {
+					t.Fatalf("unexpected errors: %+v", got.Errors)
+				}
+				if _, ok := got.Data["CertifyVuln"]; !ok {
+					t.Errorf("response is missing CertifyVuln: %+v", got.Data)
+				}
+				return
+			}
+			if len(got.Errors) != 1 {
+				t.Fatalf("got errors %+v, want %q", got.Errors, tt.wantErr)
+			}
+			if got.Errors[0].Message != tt.wantErr {
+				t.Errorf("got error %q, want %q", got.Errors[0].Message, tt.wantErr)
+			}
+			if tt.wantCode != "" {
+				if code := got.Errors[0].Extensions["code"]; code != tt.wantCode {
+					t.Errorf("got code %v, want %q", code, tt.wantCode)
+				}
+			}
+		})
+	}
+}
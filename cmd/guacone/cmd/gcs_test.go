@@ -21,48 +21,32 @@ import (
 
 func TestValidateGCSFlags(t *testing.T) {
 	testCases := []struct {
-		name            string
-		args            []string
-		credentialsPath string
-		credsEnvVarSet  bool
-		errorMsg        string
+		name      string
+		args      []string
+		prefix    string
+		projectID string
+		errorMsg  string
 	}{
 		{
 			name:     "no args",
 			errorMsg: "expected positional argument: bucket",
 		},
 		{
-			name:     "no credentials",
-			args:     []string{"bucket"},
-			errorMsg: "expected either --gcp-credentials-path flag or GOOGLE_APPLICATION_CREDENTIALS environment variable",
+			// the application default credentials are used
+			name: "no credentials",
+			args: []string{"bucket"},
 		},
 		{
-			name:            "credentials path and env var set",
-			args:            []string{"bucket"},
-			credentialsPath: "/path/to/creds.json",
-			credsEnvVarSet:  true,
-			errorMsg:        "",
-		},
-		{
-			name:            "credentials path and env var not set",
-			args:            []string{"bucket"},
-			credentialsPath: "/path/to/creds.json",
-		},
-		{
-			name:           "credentials path not set and env var set",
-			args:           []string{"bucket"},
-			credsEnvVarSet: true,
-			errorMsg:       "",
+			name:      "prefix and project",
+			args:      []string{"bucket"},
+			prefix:    "sboms/",
+			projectID: "some-project",
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			if tc.credsEnvVarSet {
-				t.Setenv("GOOGLE_APPLICATION_CREDENTIALS", "/path/to/creds.json")
-			}
-
-			o, err := validateGCSFlags("", "", false, false, tc.credentialsPath, tc.args)
+			o, err := validateGCSFlags("", "", false, false, tc.prefix, tc.projectID, tc.args)
 			if err != nil {
 				if tc.errorMsg != err.Error() {
 					t.Errorf("expected error message: %s, got: %s", tc.errorMsg, err.Error())
@@ -75,6 +59,12 @@ func TestValidateGCSFlags(t *testing.T) {
 				if o.bucket != tc.args[0] {
 					t.Errorf("expected bucket: %s, got: %s", tc.args[0], o.bucket)
 				}
+				if o.prefix != tc.prefix {
+					t.Errorf("expected prefix: %s, got: %s", tc.prefix, o.prefix)
+				}
+				if o.projectID != tc.projectID {
+					t.Errorf("expected project ID: %s, got: %s", tc.projectID, o.projectID)
+				}
 			}
 		})
 	}
@@ -22,6 +22,7 @@ import (
 	"os/signal"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/guacsec/guac/pkg/cli"
 	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
@@ -39,11 +40,13 @@ type s3Options struct {
 	s3url             string                        // base url of the s3 to collect from
 	s3bucket          string                        // name of bucket to collect from
 	s3item            string                        // s3 item (only for non-polling behaviour)
+	s3prefix          string                        // s3 key prefix (only for polling by listing the bucket)
 	region            string                        // AWS region, for s3/sqs configuration (defaults to us-east-1)
 	queues            string                        // comma-separated list of queues/topics (only for polling behaviour)
 	mp                string                        // message provider name (sqs or kafka, will default to kafka)
 	mpEndpoint        string                        // endpoint for the message provider (only for polling behaviour)
 	poll              bool                          // polling or non-polling behaviour? (defaults to non-polling)
+	pollInterval      time.Duration                 // if set, poll by listing the bucket on this interval instead of using queues
 	graphqlEndpoint   string                        // endpoint for the graphql server
 	csubClientOptions csub_client.CsubClientOptions // options for the collectsub client
 }
@@ -71,6 +74,10 @@ $ guacone collect s3 --s3-url play.min.io --s3-bucket guac-test --s3-item alpine
 For the polling option, you need to define event bus endpoint for bucket notifications:
 
 $ guacone collect s3 --s3-url http://localhost:9000 --s3-bucket guac-test --poll --s3-mp-endpoint localhost:9092 --s3-queues sboms
+
+Alternatively, poll by listing the bucket (optionally under a key prefix) on an interval:
+
+$ guacone collect s3 --s3-url http://localhost:9000 --s3-bucket guac-test --poll --s3-poll-interval 5m --s3-prefix sboms/
 	`,
 	Args: cobra.MinimumNArgs(0),
 	Run: func(cmd *cobra.Command, args []string) {
@@ -86,10 +93,12 @@ $ guacone collect s3 --s3-url http://localhost:9000 --s3-bucket guac-test --poll
 			viper.GetString("s3-bucket"),
 			viper.GetString("s3-region"),
 			viper.GetString("s3-item"),
+			viper.GetString("s3-prefix"),
 			viper.GetString("s3-mp"),
 			viper.GetString("s3-mp-endpoint"),
 			viper.GetString("s3-queues"),
 			viper.GetBool("poll"),
+			viper.GetString("s3-poll-interval"),
 		)
 		if err != nil {
 			fmt.Printf("failed to validate flags: %v\n", err)
@@ -105,10 +114,12 @@ $ guacone collect s3 --s3-url http://localhost:9000 --s3-bucket guac-test --poll
 			S3Bucket:                s3Opts.s3bucket,
 			S3Region:                s3Opts.region,
 			S3Item:                  s3Opts.s3item,
+			S3Prefix:                s3Opts.s3prefix,
 			MessageProvider:         s3Opts.mp,
 			MessageProviderEndpoint: s3Opts.mpEndpoint,
 			Queues:                  s3Opts.queues,
 			Poll:                    s3Opts.poll,
+			PollInterval:            s3Opts.pollInterval,
 		})
 
 		if err := collector.RegisterDocumentCollector(s3Collector, s3.S3CollectorType); err != nil {
@@ -172,10 +183,19 @@ $ guacone collect s3 --s3-url http://localhost:9000 --s3-bucket guac-test --poll
 	},
 }
 
-func validateS3Opts(graphqlEndpoint string, csubAddr string, csubTls bool, csubTlsSkipVerify bool, s3url string, s3bucket string, region string, s3item string, mp string, mpEndpoint string, queues string, poll bool) (s3Options, error) {
+func validateS3Opts(graphqlEndpoint string, csubAddr string, csubTls bool, csubTlsSkipVerify bool, s3url string, s3bucket string, region string, s3item string, s3prefix string, mp string, mpEndpoint string, queues string, poll bool, pollInterval string) (s3Options, error) {
 	var opts s3Options
 
-	if poll {
+	var interval time.Duration
+	if pollInterval != "" {
+		var err error
+		interval, err = time.ParseDuration(pollInterval)
+		if err != nil {
+			return opts, fmt.Errorf("failed to parse poll interval: %w", err)
+		}
+	}
+
+	if poll && interval == 0 {
 		if mp == "kafka" {
 			if len(mpEndpoint) == 0 {
 				return opts, fmt.Errorf("expected endpoint for message provider")
@@ -195,13 +215,13 @@ func validateS3Opts(graphqlEndpoint string, csubAddr string, csubTls bool, csubT
 		return opts, fmt.Errorf("unable to validate csub client flags: %w", err)
 	}
 
-	opts = s3Options{s3url, s3bucket, s3item, region, queues, mp, mpEndpoint, poll, graphqlEndpoint, csubClientOptions}
+	opts = s3Options{s3url, s3bucket, s3item, s3prefix, region, queues, mp, mpEndpoint, poll, interval, graphqlEndpoint, csubClientOptions}
 
 	return opts, nil
 }
 
 func init() {
-	set, err := cli.BuildFlags([]string{"s3-url", "s3-bucket", "s3-region", "s3-item", "s3-mp", "s3-mp-endpoint", "s3-queues", "poll"})
+	set, err := cli.BuildFlags([]string{"s3-url", "s3-bucket", "s3-region", "s3-item", "s3-mp", "s3-mp-endpoint", "s3-queues", "poll", "s3-prefix", "s3-poll-interval"})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %s", err)
 		os.Exit(1)
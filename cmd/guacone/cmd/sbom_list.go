@@ -0,0 +1,137 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/exporter/sbomlist"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type sbomListOptions struct {
+	graphqlEndpoint string
+	headerFile      string
+	// json or csv
+	format string
+	filter model.HasSBOMSpec
+}
+
+var sbomListCmd = &cobra.Command{
+	Use:   "sbom-list [flags] [purl]",
+	Short: "lists the packages with an SBOM, along with the SBOM URI and digest",
+	Long: `lists the packages that have an SBOM attested by a HasSBOM node, with the
+URI, digest and time the SBOM was first known. If a purl is given, only the
+SBOMs of that package are listed. For example:
+
+  guacone sbom-list --format csv pkg:npm/lodash@4.17.21`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateSBOMListFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("format"),
+			args,
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		transport, err := cli.NewHTTPHeaderTransport(opts.headerFile, http.DefaultTransport)
+		if err != nil {
+			logger.Fatalf("unable to create HTTP transport: %v", err)
+		}
+		httpClient := http.Client{Transport: transport}
+		backend := sbomlist.NewGraphQLBackend(graphql.NewClient(opts.graphqlEndpoint, &httpClient))
+
+		entries, err := sbomlist.ExportSBOMList(ctx, backend, opts.filter)
+		if err != nil {
+			logger.Fatalf("unable to list SBOMs: %v", err)
+		}
+		if opts.format == "csv" {
+			err = sbomlist.WriteCSV(os.Stdout, entries)
+		} else {
+			err = sbomlist.WriteJSON(os.Stdout, entries)
+		}
+		if err != nil {
+			logger.Fatalf("unable to write results: %v", err)
+		}
+	},
+}
+
+func validateSBOMListFlags(graphqlEndpoint, headerFile, format string, args []string) (sbomListOptions, error) {
+	var opts sbomListOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+
+	if format != "json" && format != "csv" {
+		return opts, fmt.Errorf("expected format to be either json or csv, got %q", format)
+	}
+	opts.format = format
+
+	if len(args) == 1 {
+		pkg, err := helpers.PurlToPkg(args[0])
+		if err != nil {
+			return opts, fmt.Errorf("failed to parse PURL: %w", err)
+		}
+		pkgSpec := &model.PkgSpec{
+			Type:                     &pkg.Type,
+			Namespace:                pkg.Namespace,
+			Name:                     &pkg.Name,
+			Version:                  pkg.Version,
+			Subpath:                  pkg.Subpath,
+			MatchOnlyEmptyQualifiers: ptrfrom.Bool(len(pkg.Qualifiers) == 0),
+		}
+		for _, qualifier := range pkg.Qualifiers {
+			pkgSpec.Qualifiers = append(pkgSpec.Qualifiers, &model.PackageQualifierSpec{
+				Key:   qualifier.Key,
+				Value: ptrfrom.String(qualifier.Value),
+			})
+		}
+		opts.filter.Subject = &model.PackageOrArtifactSpec{Package: pkgSpec}
+	}
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"header-file", "format"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	sbomListCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(sbomListCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	rootCmd.AddCommand(sbomListCmd)
+}
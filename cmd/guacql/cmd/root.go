@@ -0,0 +1,133 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/cli/gql"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type qlOptions struct {
+	graphqlEndpoint string
+	headerFile      string
+	// queryFile is the file holding the query, empty to read it from stdin
+	queryFile string
+}
+
+var rootCmd = &cobra.Command{
+	Use:   "guacql [flags] [query-file]",
+	Short: "runs a GUAC Query Language query against the GUAC GraphQL server",
+	Long: `guacql runs a GUAC Query Language query read from query-file, or from stdin
+if no file is given, and prints the nodes found as JSON. For example:
+
+  echo 'FIND CertifyVuln WHERE package.type = "pypi" AND metadata.scannerUri = "grype" LIMIT 10' | guacql
+
+The CertifyVuln, Package and Source node types are supported.`,
+	Version: version.Version,
+	Args:    cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateQLFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			args,
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		input, err := readQuery(opts.queryFile)
+		if err != nil {
+			logger.Fatalf("unable to read query: %v", err)
+		}
+		query, err := gql.Parse(string(input))
+		if err != nil {
+			logger.Fatalf("unable to parse query: %v", err)
+		}
+
+		transport, err := cli.NewHTTPHeaderTransport(opts.headerFile, http.DefaultTransport)
+		if err != nil {
+			logger.Fatalf("unable to create HTTP transport: %v", err)
+		}
+		httpClient := http.Client{Transport: transport}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+
+		nodes, err := gql.Run(ctx, gqlclient, query)
+		if err != nil {
+			logger.Fatalf("unable to run query: %v", err)
+		}
+		out, err := json.MarshalIndent(nodes, "", "  ")
+		if err != nil {
+			logger.Fatalf("unable to format results: %v", err)
+		}
+		fmt.Println(string(out))
+	},
+}
+
+func validateQLFlags(graphqlEndpoint, headerFile string, args []string) (qlOptions, error) {
+	var opts qlOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+	if len(args) > 0 {
+		opts.queryFile = args[0]
+	}
+	return opts, nil
+}
+
+func readQuery(queryFile string) ([]byte, error) {
+	if queryFile == "" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(queryFile)
+}
+
+func init() {
+	cobra.OnInitialize(cli.InitConfig)
+
+	set, err := cli.BuildFlags([]string{"gql-addr", "header-file"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	rootCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(rootCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+}
+
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
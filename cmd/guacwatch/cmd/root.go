@@ -0,0 +1,90 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/daemon/watch"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "guacwatch",
+	Short: "posts the vulnerabilities found by a GUAC server to a webhook",
+	Long: `guacwatch subscribes to the vulnerability certifications added to a GUAC
+GraphQL server and posts the package purl and vulnerability ID of each of them
+to a webhook, for example to open tickets. Requests are signed with
+HMAC-SHA256 in the X-Guac-Signature-256 header when a secret is set.
+
+The options can also be set with GUAC_ environment variables or in guac.yaml,
+e.g. GUAC_WATCH_WEBHOOK_SECRET.`,
+	Version: version.Version,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		cfg := watch.Config{
+			GraphQLEndpoint: viper.GetString("gql-addr"),
+			WebhookURL:      viper.GetString("watch-webhook-url"),
+			WebhookSecret:   viper.GetString("watch-webhook-secret"),
+			RetryDelay:      viper.GetDuration("watch-retry-delay"),
+		}
+		if cfg.WebhookURL == "" {
+			fmt.Println("unable to validate flags: the webhook URL must be specified")
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		logger.Infof("watching %s for new vulnerabilities", cfg.GraphQLEndpoint)
+		if err := watch.Watch(ctx, cfg); err != nil && !errors.Is(err, context.Canceled) {
+			logger.Fatalf("watch failed: %v", err)
+		}
+	},
+}
+
+func init() {
+	cobra.OnInitialize(cli.InitConfig)
+
+	set, err := cli.BuildFlags([]string{"gql-addr", "watch-webhook-url", "watch-webhook-secret", "watch-retry-delay"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	rootCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(rootCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+}
+
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"golang.org/x/oauth2"
 	"io"
 	"net/http"
+	"time"
 )
 
 // TODO (mlieberman85): This interface will probably be pulled out into an interface that can support other
@@ -56,6 +57,29 @@ type GithubClient interface {
 
 	// GetWorkflowRunArtifacts fetches all the workflow run artifacts for a given workflow run id
 	GetWorkflowRunArtifacts(ctx context.Context, owner, repo, githubSBOMName string, runID int64) ([]*client.WorkflowArtifactContent, error)
+
+	// ListCommits fetches the most recent commits of the default branch of a repo, newest first
+	ListCommits(ctx context.Context, owner, repo string, limit int) ([]*client.Commit, error)
+
+	// ListCommitsUntil fetches the most recent commits of the default branch of a repo authored
+	// no later than until, newest first. A zero until lists from the head of the branch.
+	ListCommitsUntil(ctx context.Context, owner, repo string, until time.Time, limit int) ([]*client.Commit, error)
+}
+
+// maxCommitsPerPage is the most commits the Github API returns in a single page
+const maxCommitsPerPage = 100
+
+type tokenKey struct{}
+
+// WithToken returns a copy of ctx carrying the Github token to use for requests made on its behalf.
+func WithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey{}, token)
+}
+
+// TokenFromContext returns the Github token carried by ctx, or the empty string if there is none.
+func TokenFromContext(ctx context.Context) string {
+	token, _ := ctx.Value(tokenKey{}).(string)
+	return token
 }
 
 type githubClient struct {
@@ -66,14 +90,7 @@ type githubClient struct {
 var _ GithubClient = &githubClient{}
 
 func NewGithubClient(ctx context.Context, token string) (*githubClient, error) {
-	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
-	tc := &http.Client{
-		Transport: &oauth2.Transport{
-			Source: ts,
-			Base:   version.UATransport,
-		},
-	}
-	gc := github.NewClient(tc)
+	gc := newGithubAPIClient(token)
 
 	// Run a simple API call to verify authentication to Github API.
 	// If it fails we can error out quickly
@@ -91,6 +108,30 @@ func NewGithubClient(ctx context.Context, token string) (*githubClient, error) {
 	}, nil
 }
 
+// NewUnverifiedGithubClient returns a client like NewGithubClient, without
+// calling the Github API to verify the token. An empty token makes
+// unauthenticated requests, which are subject to a lower rate limit.
+func NewUnverifiedGithubClient(token string) *githubClient {
+	return &githubClient{
+		ghClient:   newGithubAPIClient(token),
+		httpClient: http.DefaultClient,
+	}
+}
+
+func newGithubAPIClient(token string) *github.Client {
+	if token == "" {
+		return github.NewClient(&http.Client{Transport: version.UATransport})
+	}
+	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
+	tc := &http.Client{
+		Transport: &oauth2.Transport{
+			Source: ts,
+			Base:   version.UATransport,
+		},
+	}
+	return github.NewClient(tc)
+}
+
 func (gc *githubClient) GetLatestRelease(ctx context.Context, owner string, repo string) (*client.Release, error) {
 	githubRelease, _, err := gc.ghClient.Repositories.GetLatestRelease(ctx, owner, repo)
 	if err != nil {
@@ -253,6 +294,41 @@ func DownloadAndExtractZip(url string, runID int64) ([]*client.WorkflowArtifactC
 	return files, nil
 }
 
+// ListCommits retrieves the limit most recent commits of the default branch of a given GitHub repository.
+// At most 100 commits are returned.
+func (gc *githubClient) ListCommits(ctx context.Context, owner, repo string, limit int) ([]*client.Commit, error) {
+	return gc.ListCommitsUntil(ctx, owner, repo, time.Time{}, limit)
+}
+
+// ListCommitsUntil retrieves the limit most recent commits of the default branch of a given GitHub repository
+// authored no later than until. At most 100 commits are returned.
+func (gc *githubClient) ListCommitsUntil(ctx context.Context, owner, repo string, until time.Time, limit int) ([]*client.Commit, error) {
+	if limit > maxCommitsPerPage {
+		limit = maxCommitsPerPage
+	}
+	commits, _, err := gc.ghClient.Repositories.ListCommits(ctx, owner, repo, &github.CommitsListOptions{
+		Until:       until,
+		ListOptions: github.ListOptions{PerPage: limit},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list commits: %w", err)
+	}
+
+	var res []*client.Commit
+	for _, commit := range commits {
+		if len(res) == limit {
+			break
+		}
+		res = append(res, &client.Commit{
+			SHA:        commit.GetSHA(),
+			Message:    commit.GetCommit().GetMessage(),
+			AuthorDate: commit.GetCommit().GetAuthor().GetDate().Time,
+		})
+	}
+
+	return res, nil
+}
+
 func (gc *githubClient) GetCommitSHA1(ctx context.Context, owner string, repo string, ref string) (string, error) {
 	commit, _, err := gc.ghClient.Repositories.GetCommitSHA1(ctx, owner, repo, ref, "")
 
@@ -25,6 +25,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/guacsec/guac/internal/client"
 	"github.com/guacsec/guac/internal/testing/testdata"
@@ -447,3 +448,60 @@ func Test_githubClient_GetWorkflow(t *testing.T) {
 		})
 	}
 }
+
+func Test_githubClient_ListCommits(t *testing.T) {
+	gc := testGithubClient()
+
+	tests := []struct {
+		name    string
+		owner   string
+		repo    string
+		limit   int
+		wantLen int
+		wantErr bool
+	}{
+		{
+			name:    "list with limit",
+			owner:   "guacsec",
+			repo:    "guac-test",
+			limit:   2,
+			wantLen: 2,
+		},
+		{
+			name:    "list with invalid repo",
+			owner:   "guacsec",
+			repo:    "doesnotexist",
+			limit:   2,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gc.ListCommits(context.Background(), tt.owner, tt.repo, tt.limit)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("githubClient.ListCommits() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != tt.wantLen {
+				t.Fatalf("githubClient.ListCommits() returned %d commits, want %d", len(got), tt.wantLen)
+			}
+			for _, commit := range got {
+				if commit.SHA == "" || commit.AuthorDate.IsZero() {
+					t.Errorf("githubClient.ListCommits() returned incomplete commit %+v", commit)
+				}
+			}
+		})
+	}
+}
+
+func Test_githubClient_ListCommitsUntil(t *testing.T) {
+	gc := testGithubClient()
+
+	// the repository did not exist yet
+	got, err := gc.ListCommitsUntil(context.Background(), "guacsec", "guac-test", time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), 2)
+	if err != nil {
+		t.Fatalf("githubClient.ListCommitsUntil() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("githubClient.ListCommitsUntil() returned %d commits, want 0", len(got))
+	}
+}
@@ -15,6 +15,8 @@
 
 package client
 
+import "time"
+
 // Most of this is inspired by the work in: https://github.com/ossf/scorecard/tree/main/clients
 
 // TODO: Once we support Gitlab and other VCS this should include an interface
@@ -60,3 +62,10 @@ type WorkflowRun struct {
 	RunId      int64
 	WorkflowId int64
 }
+
+// Commit represents a commit in a VCS repository
+type Commit struct {
+	SHA        string
+	Message    string
+	AuthorDate time.Time
+}
@@ -97,6 +97,62 @@ func TestArtifacts(t *testing.T) {
 			Digest:    "374ab8f711235830769aa5f0b31ce9b72c5670074b34cb302cdafe3b606233ee92ee01e298e5701f15cc7087714cd9abd7ddb838a6e1206b3642de16d9fc9dd7",
 		}},
 		wantErr: false,
+	}, {
+		name: "digest prefix",
+		artifactInput: &model.ArtifactInputSpec{
+			Algorithm: "sha256",
+			Digest:    "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf",
+		},
+		artifactSpec: &model.ArtifactSpec{
+			DigestPrefix: ptrfrom.String("6bbb0da1"),
+		},
+		want: []*model.Artifact{{
+			Algorithm: "sha256",
+			Digest:    "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf",
+		}},
+		wantErr: false,
+	}, {
+		name: "uppercase digest prefix matches all algorithms",
+		artifactInput: &model.ArtifactInputSpec{
+			Algorithm: "sha1",
+			Digest:    "7A8F47318E4676DACB0142AFA0B83029CD7BEFD9",
+		},
+		artifactSpec: &model.ArtifactSpec{
+			DigestPrefix: ptrfrom.String("7A8F4731"),
+		},
+		want: []*model.Artifact{{
+			Algorithm: "sha1",
+			Digest:    "7a8f47318e4676dacb0142afa0b83029cd7befd9",
+		}, {
+			Algorithm: "sha-1",
+			Digest:    "7a8f47318e4676dacb0142afa0b83029cd7befd9",
+		}},
+		wantErr: false,
+	}, {
+		name: "digest prefix with algorithm",
+		artifactInput: &model.ArtifactInputSpec{
+			Algorithm: "sha1",
+			Digest:    "7A8F47318E4676DACB0142AFA0B83029CD7BEFD9",
+		},
+		artifactSpec: &model.ArtifactSpec{
+			Algorithm:    ptrfrom.String("sha-1"),
+			DigestPrefix: ptrfrom.String("7a8f"),
+		},
+		want: []*model.Artifact{{
+			Algorithm: "sha-1",
+			Digest:    "7a8f47318e4676dacb0142afa0b83029cd7befd9",
+		}},
+		wantErr: false,
+	}, {
+		name: "digest prefix no match",
+		artifactInput: &model.ArtifactInputSpec{
+			Algorithm: "sha256",
+			Digest:    "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf",
+		},
+		artifactSpec: &model.ArtifactSpec{
+			DigestPrefix: ptrfrom.String("6bbb0da2"),
+		},
+		wantErr: false,
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
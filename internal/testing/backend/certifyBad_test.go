@@ -22,6 +22,7 @@ import (
 	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
@@ -853,6 +854,71 @@ func TestCertifyBad(t *testing.T) {
 	}
 }
 
+func TestIngestCertifyBadsIDs(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: testdata.S1}); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	if _, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A1}); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+
+	// each call has a single subject type, 50 records each for package
+	// versions, package names, sources and artifacts
+	const perSubject = 50
+	type call struct {
+		sub   model.PackageSourceOrArtifactInputs
+		match *model.MatchFlags
+	}
+	calls := []call{{match: &mSpecific}, {match: &mAll}, {match: &mSpecific}, {match: &mSpecific}}
+	var certifyBads []*model.CertifyBadInputSpec
+	for i := 0; i < perSubject; i++ {
+		calls[0].sub.Packages = append(calls[0].sub.Packages, &model.IDorPkgInput{PackageInput: testdata.P1})
+		calls[1].sub.Packages = append(calls[1].sub.Packages, &model.IDorPkgInput{PackageInput: testdata.P1})
+		calls[2].sub.Sources = append(calls[2].sub.Sources, &model.IDorSourceInput{SourceInput: testdata.S1})
+		calls[3].sub.Artifacts = append(calls[3].sub.Artifacts, &model.IDorArtifactInput{ArtifactInput: testdata.A1})
+		certifyBads = append(certifyBads, &model.CertifyBadInputSpec{
+			Justification: "bulk",
+			KnownSince:    testdata.T1.Add(time.Duration(i) * time.Hour),
+		})
+	}
+
+	seen := map[string]bool{}
+	for _, c := range calls {
+		ids, err := b.IngestCertifyBads(ctx, c.sub, c.match, certifyBads)
+		if err != nil {
+			t.Fatalf("IngestCertifyBads() error = %v", err)
+		}
+		if len(ids) != perSubject {
+			t.Fatalf("IngestCertifyBads() returned %d IDs, want %d", len(ids), perSubject)
+		}
+		again, err := b.IngestCertifyBads(ctx, c.sub, c.match, certifyBads)
+		if err != nil {
+			t.Fatalf("IngestCertifyBads() error on re-ingestion = %v", err)
+		}
+		if diff := cmp.Diff(ids, again); diff != "" {
+			t.Errorf("Re-ingestion returned different IDs (-first +second):\n%s", diff)
+		}
+		for _, id := range ids {
+			seen[id] = true
+			n, err := b.Node(ctx, id)
+			if err != nil {
+				t.Fatalf("Node(%s) error = %v", id, err)
+			}
+			if _, ok := n.(*model.CertifyBad); !ok {
+				t.Errorf("Node(%s) = %T, want *model.CertifyBad", id, n)
+			}
+		}
+	}
+	if len(seen) != len(calls)*perSubject {
+		t.Errorf("got %d distinct IDs, want %d", len(seen), len(calls)*perSubject)
+	}
+}
+
 func TestIngestCertifyBads(t *testing.T) {
 	ctx := context.Background()
 	b := setupTest(t)
@@ -1174,3 +1240,159 @@ func TestIngestCertifyBads(t *testing.T) {
 		})
 	}
 }
+
+func TestCertifyBadJustificationPattern(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A1}); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	sub := model.PackageSourceOrArtifactInput{Artifact: &model.IDorArtifactInput{ArtifactInput: testdata.A1}}
+	match := &model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	justifications := []string{
+		"approved:sec-review-2024-Q1",
+		"approved:sec-review-2024-Q2",
+		"pre-approved:legacy",
+		"rejected:sec-review-2023",
+		"approved_50%",
+	}
+	for _, j := range justifications {
+		if _, err := b.IngestCertifyBad(ctx, sub, match, model.CertifyBadInputSpec{Justification: j, KnownSince: testdata.T1}); err != nil {
+			t.Fatalf("Could not ingest CertifyBad: %v", err)
+		}
+	}
+	tests := []struct {
+		name string
+		spec model.CertifyBadSpec
+		want []string
+	}{{
+		name: "prefix",
+		spec: model.CertifyBadSpec{JustificationPrefix: ptrfrom.String("approved:")},
+		want: []string{"approved:sec-review-2024-Q1", "approved:sec-review-2024-Q2"},
+	}, {
+		name: "prefix with LIKE wildcard character",
+		spec: model.CertifyBadSpec{JustificationPrefix: ptrfrom.String("approved_")},
+		want: []string{"approved_50%"},
+	}, {
+		name: "contains",
+		spec: model.CertifyBadSpec{JustificationContains: ptrfrom.String("sec-review-2024")},
+		want: []string{"approved:sec-review-2024-Q1", "approved:sec-review-2024-Q2"},
+	}, {
+		name: "contains matches anywhere",
+		spec: model.CertifyBadSpec{JustificationContains: ptrfrom.String("approved")},
+		want: []string{"approved:sec-review-2024-Q1", "approved:sec-review-2024-Q2", "approved_50%", "pre-approved:legacy"},
+	}, {
+		name: "contains with LIKE wildcard character",
+		spec: model.CertifyBadSpec{JustificationContains: ptrfrom.String("50%")},
+		want: []string{"approved_50%"},
+	}, {
+		name: "prefix and contains",
+		spec: model.CertifyBadSpec{JustificationPrefix: ptrfrom.String("approved"), JustificationContains: ptrfrom.String("Q2")},
+		want: []string{"approved:sec-review-2024-Q2"},
+	}, {
+		name: "prefix and exact",
+		spec: model.CertifyBadSpec{JustificationPrefix: ptrfrom.String("rejected"), Justification: ptrfrom.String("approved:sec-review-2024-Q1")},
+	}, {
+		name: "no match",
+		spec: model.CertifyBadSpec{JustificationPrefix: ptrfrom.String("%")},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.CertifyBad(ctx, &tt.spec)
+			if err != nil {
+				t.Fatalf("CertifyBad() error = %v", err)
+			}
+			var js []string
+			for _, c := range got {
+				js = append(js, c.Justification)
+			}
+			slices.Sort(js)
+			if diff := cmp.Diff(tt.want, js); diff != "" {
+				t.Errorf("Unexpected justifications (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCertifyBadSubjectType(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: testdata.S1}); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	if _, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A1}); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	ingests := []struct {
+		sub   model.PackageSourceOrArtifactInput
+		match model.PkgMatchType
+		just  string
+	}{{
+		sub:   model.PackageSourceOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: testdata.P1}},
+		match: model.PkgMatchTypeSpecificVersion,
+		just:  "package version",
+	}, {
+		sub:   model.PackageSourceOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: testdata.P1}},
+		match: model.PkgMatchTypeAllVersions,
+		just:  "package name",
+	}, {
+		sub:   model.PackageSourceOrArtifactInput{Source: &model.IDorSourceInput{SourceInput: testdata.S1}},
+		match: model.PkgMatchTypeSpecificVersion,
+		just:  "source",
+	}, {
+		sub:   model.PackageSourceOrArtifactInput{Artifact: &model.IDorArtifactInput{ArtifactInput: testdata.A1}},
+		match: model.PkgMatchTypeSpecificVersion,
+		just:  "artifact",
+	}}
+	for _, i := range ingests {
+		if _, err := b.IngestCertifyBad(ctx, i.sub, &model.MatchFlags{Pkg: i.match}, model.CertifyBadInputSpec{Justification: i.just, KnownSince: testdata.T1}); err != nil {
+			t.Fatalf("Could not ingest CertifyBad: %v", err)
+		}
+	}
+	tests := []struct {
+		name string
+		spec model.CertifyBadSpec
+		want []string
+	}{{
+		name: "package",
+		spec: model.CertifyBadSpec{SubjectType: ptrfrom.Any(model.SubjectTypePackage)},
+		want: []string{"package name", "package version"},
+	}, {
+		name: "source",
+		spec: model.CertifyBadSpec{SubjectType: ptrfrom.Any(model.SubjectTypeSource)},
+		want: []string{"source"},
+	}, {
+		name: "artifact",
+		spec: model.CertifyBadSpec{SubjectType: ptrfrom.Any(model.SubjectTypeArtifact)},
+		want: []string{"artifact"},
+	}, {
+		name: "unset",
+		spec: model.CertifyBadSpec{},
+		want: []string{"artifact", "package name", "package version", "source"},
+	}, {
+		name: "conflicting subject",
+		spec: model.CertifyBadSpec{
+			Subject:     &model.PackageSourceOrArtifactSpec{Artifact: &model.ArtifactSpec{Digest: ptrfrom.String(testdata.A1.Digest)}},
+			SubjectType: ptrfrom.Any(model.SubjectTypeSource),
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.CertifyBad(ctx, &tt.spec)
+			if err != nil {
+				t.Fatalf("CertifyBad() error = %v", err)
+			}
+			var js []string
+			for _, c := range got {
+				js = append(js, c.Justification)
+			}
+			slices.Sort(js)
+			if diff := cmp.Diff(tt.want, js); diff != "" {
+				t.Errorf("Unexpected justifications (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package backend_test
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -1019,3 +1020,159 @@ func TestIngestCertifyGoods(t *testing.T) {
 		})
 	}
 }
+
+func TestCertifyGoodJustificationPattern(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A1}); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	sub := model.PackageSourceOrArtifactInput{Artifact: &model.IDorArtifactInput{ArtifactInput: testdata.A1}}
+	match := &model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	justifications := []string{
+		"approved:sec-review-2024-Q1",
+		"approved:sec-review-2024-Q2",
+		"pre-approved:legacy",
+		"rejected:sec-review-2023",
+		"approved_50%",
+	}
+	for _, j := range justifications {
+		if _, err := b.IngestCertifyGood(ctx, sub, match, model.CertifyGoodInputSpec{Justification: j, KnownSince: testdata.T1}); err != nil {
+			t.Fatalf("Could not ingest CertifyGood: %v", err)
+		}
+	}
+	tests := []struct {
+		name string
+		spec model.CertifyGoodSpec
+		want []string
+	}{{
+		name: "prefix",
+		spec: model.CertifyGoodSpec{JustificationPrefix: ptrfrom.String("approved:")},
+		want: []string{"approved:sec-review-2024-Q1", "approved:sec-review-2024-Q2"},
+	}, {
+		name: "prefix with LIKE wildcard character",
+		spec: model.CertifyGoodSpec{JustificationPrefix: ptrfrom.String("approved_")},
+		want: []string{"approved_50%"},
+	}, {
+		name: "contains",
+		spec: model.CertifyGoodSpec{JustificationContains: ptrfrom.String("sec-review-2024")},
+		want: []string{"approved:sec-review-2024-Q1", "approved:sec-review-2024-Q2"},
+	}, {
+		name: "contains matches anywhere",
+		spec: model.CertifyGoodSpec{JustificationContains: ptrfrom.String("approved")},
+		want: []string{"approved:sec-review-2024-Q1", "approved:sec-review-2024-Q2", "approved_50%", "pre-approved:legacy"},
+	}, {
+		name: "contains with LIKE wildcard character",
+		spec: model.CertifyGoodSpec{JustificationContains: ptrfrom.String("50%")},
+		want: []string{"approved_50%"},
+	}, {
+		name: "prefix and contains",
+		spec: model.CertifyGoodSpec{JustificationPrefix: ptrfrom.String("approved"), JustificationContains: ptrfrom.String("Q2")},
+		want: []string{"approved:sec-review-2024-Q2"},
+	}, {
+		name: "prefix and exact",
+		spec: model.CertifyGoodSpec{JustificationPrefix: ptrfrom.String("rejected"), Justification: ptrfrom.String("approved:sec-review-2024-Q1")},
+	}, {
+		name: "no match",
+		spec: model.CertifyGoodSpec{JustificationPrefix: ptrfrom.String("%")},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.CertifyGood(ctx, &tt.spec)
+			if err != nil {
+				t.Fatalf("CertifyGood() error = %v", err)
+			}
+			var js []string
+			for _, c := range got {
+				js = append(js, c.Justification)
+			}
+			slices.Sort(js)
+			if diff := cmp.Diff(tt.want, js); diff != "" {
+				t.Errorf("Unexpected justifications (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCertifyGoodSubjectType(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: testdata.S1}); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	if _, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A1}); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	ingests := []struct {
+		sub   model.PackageSourceOrArtifactInput
+		match model.PkgMatchType
+		just  string
+	}{{
+		sub:   model.PackageSourceOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: testdata.P1}},
+		match: model.PkgMatchTypeSpecificVersion,
+		just:  "package version",
+	}, {
+		sub:   model.PackageSourceOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: testdata.P1}},
+		match: model.PkgMatchTypeAllVersions,
+		just:  "package name",
+	}, {
+		sub:   model.PackageSourceOrArtifactInput{Source: &model.IDorSourceInput{SourceInput: testdata.S1}},
+		match: model.PkgMatchTypeSpecificVersion,
+		just:  "source",
+	}, {
+		sub:   model.PackageSourceOrArtifactInput{Artifact: &model.IDorArtifactInput{ArtifactInput: testdata.A1}},
+		match: model.PkgMatchTypeSpecificVersion,
+		just:  "artifact",
+	}}
+	for _, i := range ingests {
+		if _, err := b.IngestCertifyGood(ctx, i.sub, &model.MatchFlags{Pkg: i.match}, model.CertifyGoodInputSpec{Justification: i.just, KnownSince: testdata.T1}); err != nil {
+			t.Fatalf("Could not ingest CertifyGood: %v", err)
+		}
+	}
+	tests := []struct {
+		name string
+		spec model.CertifyGoodSpec
+		want []string
+	}{{
+		name: "package",
+		spec: model.CertifyGoodSpec{SubjectType: ptrfrom.Any(model.SubjectTypePackage)},
+		want: []string{"package name", "package version"},
+	}, {
+		name: "source",
+		spec: model.CertifyGoodSpec{SubjectType: ptrfrom.Any(model.SubjectTypeSource)},
+		want: []string{"source"},
+	}, {
+		name: "artifact",
+		spec: model.CertifyGoodSpec{SubjectType: ptrfrom.Any(model.SubjectTypeArtifact)},
+		want: []string{"artifact"},
+	}, {
+		name: "unset",
+		spec: model.CertifyGoodSpec{},
+		want: []string{"artifact", "package name", "package version", "source"},
+	}, {
+		name: "conflicting subject",
+		spec: model.CertifyGoodSpec{
+			Subject:     &model.PackageSourceOrArtifactSpec{Artifact: &model.ArtifactSpec{Digest: ptrfrom.String(testdata.A1.Digest)}},
+			SubjectType: ptrfrom.Any(model.SubjectTypeSource),
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.CertifyGood(ctx, &tt.spec)
+			if err != nil {
+				t.Fatalf("CertifyGood() error = %v", err)
+			}
+			var js []string
+			for _, c := range got {
+				js = append(js, c.Justification)
+			}
+			slices.Sort(js)
+			if diff := cmp.Diff(tt.want, js); diff != "" {
+				t.Errorf("Unexpected justifications (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
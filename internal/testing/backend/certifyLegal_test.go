@@ -717,3 +717,69 @@ func TestLegals(t *testing.T) {
 		})
 	}
 }
+
+func TestLegalsBulkMatchesSingle(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestPackages(ctx, []*model.IDorPkgInput{{PackageInput: testdata.P1}, {PackageInput: testdata.P2}}); err != nil {
+		t.Fatalf("Could not ingest packages: %v", err)
+	}
+	if _, err := b.IngestSources(ctx, []*model.IDorSourceInput{{SourceInput: testdata.S1}, {SourceInput: testdata.S2}}); err != nil {
+		t.Fatalf("Could not ingest sources: %v", err)
+	}
+	if _, err := b.IngestLicenses(ctx, []*model.IDorLicenseInput{{LicenseInput: testdata.L1}, {LicenseInput: testdata.L2}}); err != nil {
+		t.Fatalf("Could not ingest licenses: %v", err)
+	}
+
+	dec := [][]*model.IDorLicenseInput{{{LicenseInput: testdata.L1}}, {{LicenseInput: testdata.L1}, {LicenseInput: testdata.L2}}}
+	dis := [][]*model.IDorLicenseInput{{}, {{LicenseInput: testdata.L2}}}
+	legals := []*model.CertifyLegalInputSpec{
+		{Justification: "test justification", DeclaredLicense: "MIT"},
+		{Justification: "test justification", DeclaredLicense: "MIT AND GPL-2.0", DiscoveredLicense: "GPL-2.0"},
+	}
+	tests := []struct {
+		Name     string
+		Subjects model.PackageOrSourceInputs
+		Single   []model.PackageOrSourceInput
+	}{
+		{
+			Name: "packages",
+			Subjects: model.PackageOrSourceInputs{
+				Packages: []*model.IDorPkgInput{{PackageInput: testdata.P1}, {PackageInput: testdata.P2}},
+			},
+			Single: []model.PackageOrSourceInput{
+				{Package: &model.IDorPkgInput{PackageInput: testdata.P1}},
+				{Package: &model.IDorPkgInput{PackageInput: testdata.P2}},
+			},
+		},
+		{
+			Name: "sources",
+			Subjects: model.PackageOrSourceInputs{
+				Sources: []*model.IDorSourceInput{{SourceInput: testdata.S1}, {SourceInput: testdata.S2}},
+			},
+			Single: []model.PackageOrSourceInput{
+				{Source: &model.IDorSourceInput{SourceInput: testdata.S1}},
+				{Source: &model.IDorSourceInput{SourceInput: testdata.S2}},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var single []string
+			for i, s := range test.Single {
+				id, err := b.IngestCertifyLegal(ctx, s, dec[i], dis[i], legals[i])
+				if err != nil {
+					t.Fatalf("IngestCertifyLegal() error = %v", err)
+				}
+				single = append(single, id)
+			}
+			bulk, err := b.IngestCertifyLegals(ctx, test.Subjects, dec, dis, legals)
+			if err != nil {
+				t.Fatalf("IngestCertifyLegals() error = %v", err)
+			}
+			if diff := cmp.Diff(single, bulk); diff != "" {
+				t.Errorf("Bulk ingestion returned different IDs (-single +bulk):\n%s", diff)
+			}
+		})
+	}
+}
@@ -547,6 +547,55 @@ func TestCertifyScorecard(t *testing.T) {
 	}
 }
 
+func TestIngestScorecardsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: testdata.S1}); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+
+	const count = 500
+	var srcs []*model.IDorSourceInput
+	var scs []*model.ScorecardInputSpec
+	for i := 0; i < count; i++ {
+		srcs = append(srcs, &model.IDorSourceInput{SourceInput: testdata.S1})
+		scs = append(scs, &model.ScorecardInputSpec{
+			Checks:         []*model.ScorecardCheckInputSpec{{Check: "Binary_Artifacts", Score: i % 10}},
+			AggregateScore: float64(i%100) / 10,
+			TimeScanned:    testdata.T1.Add(time.Duration(i) * time.Minute),
+			Origin:         "test",
+		})
+	}
+
+	ids, err := b.IngestScorecards(ctx, srcs, scs)
+	if err != nil {
+		t.Fatalf("IngestScorecards() error = %v", err)
+	}
+	if len(ids) != count {
+		t.Fatalf("IngestScorecards() returned %d IDs, want %d", len(ids), count)
+	}
+	again, err := b.IngestScorecards(ctx, srcs, scs)
+	if err != nil {
+		t.Fatalf("IngestScorecards() error on re-ingestion = %v", err)
+	}
+	if diff := cmp.Diff(ids, again); diff != "" {
+		t.Errorf("Re-ingestion returned different IDs (-first +second):\n%s", diff)
+	}
+
+	got, err := b.Scorecards(ctx, &model.CertifyScorecardSpec{Origin: ptrfrom.String("test")})
+	if err != nil {
+		t.Fatalf("Scorecards() error = %v", err)
+	}
+	if len(got) != count {
+		t.Errorf("Scorecards() returned %d scorecards, want %d", len(got), count)
+	}
+	for i, id := range []string{ids[0], ids[count-1]} {
+		if _, err := b.Node(ctx, id); err != nil {
+			t.Errorf("Node(%d) error = %v", i, err)
+		}
+	}
+}
+
 func TestIngestScorecards(t *testing.T) {
 	ctx := context.Background()
 	b := setupTest(t)
@@ -770,3 +819,87 @@ func TestIngestScorecards(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateCertifyScorecard(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: testdata.S1}); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	stale := model.ScorecardInputSpec{
+		Checks:         []*model.ScorecardCheckInputSpec{{Check: "Binary_Artifacts", Score: 4}},
+		AggregateScore: 4.5,
+		TimeScanned:    testdata.T1,
+		Origin:         "test",
+	}
+	other := model.ScorecardInputSpec{
+		Checks:         []*model.ScorecardCheckInputSpec{{Check: "Binary_Artifacts", Score: 2}},
+		AggregateScore: 2.5,
+		TimeScanned:    testdata.T1,
+		Origin:         "test",
+	}
+	staleID, err := b.IngestScorecard(ctx, model.IDorSourceInput{SourceInput: testdata.S1}, stale)
+	if err != nil {
+		t.Fatalf("Could not ingest scorecard: %v", err)
+	}
+	if _, err := b.IngestScorecard(ctx, model.IDorSourceInput{SourceInput: testdata.S1}, other); err != nil {
+		t.Fatalf("Could not ingest scorecard: %v", err)
+	}
+
+	refreshed := model.ScorecardInputSpec{
+		Checks:         []*model.ScorecardCheckInputSpec{{Check: "Binary_Artifacts", Score: 8}},
+		AggregateScore: 8.5,
+		TimeScanned:    testdata.T2,
+		Origin:         "test",
+	}
+	expSC := &model.CertifyScorecard{
+		Source: testdata.S1out,
+		Scorecard: &model.Scorecard{
+			Checks:         []*model.ScorecardCheck{{Check: "Binary_Artifacts", Score: 8}},
+			AggregateScore: 8.5,
+			TimeScanned:    testdata.T2,
+			Origin:         "test",
+		},
+	}
+
+	got, err := b.UpdateCertifyScorecard(ctx, staleID, refreshed)
+	if err != nil {
+		t.Fatalf("UpdateCertifyScorecard() error = %v", err)
+	}
+	if diff := cmp.Diff(expSC, got, commonOpts); diff != "" {
+		t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+	}
+
+	// the node keeps its ID and is no longer found by the old score
+	gotByID, err := b.Scorecards(ctx, &model.CertifyScorecardSpec{ID: &staleID})
+	if err != nil {
+		t.Fatalf("Scorecards() error = %v", err)
+	}
+	if diff := cmp.Diff([]*model.CertifyScorecard{expSC}, gotByID, commonOpts); diff != "" {
+		t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+	}
+	gotOld, err := b.Scorecards(ctx, &model.CertifyScorecardSpec{AggregateScore: ptrfrom.Float64(4.5)})
+	if err != nil {
+		t.Fatalf("Scorecards() error = %v", err)
+	}
+	if len(gotOld) != 0 {
+		t.Errorf("expected no scorecard with the old score, got %d", len(gotOld))
+	}
+	gotAll, err := b.Scorecards(ctx, &model.CertifyScorecardSpec{Origin: ptrfrom.String("test")})
+	if err != nil {
+		t.Fatalf("Scorecards() error = %v", err)
+	}
+	if len(gotAll) != 2 {
+		t.Errorf("Scorecards() returned %d scorecards, want 2", len(gotAll))
+	}
+
+	// updating into the fields of another scorecard for the same source fails
+	if _, err := b.UpdateCertifyScorecard(ctx, staleID, other); err == nil {
+		t.Errorf("expected error when the update conflicts with an existing certifyScorecard")
+	}
+
+	// unknown ID
+	if _, err := b.UpdateCertifyScorecard(ctx, "999999", refreshed); err == nil {
+		t.Errorf("expected error when updating an unknown certifyScorecard")
+	}
+}
@@ -19,12 +19,18 @@ package backend_test
 
 import (
 	"context"
+	"sort"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	entbackend "github.com/guacsec/guac/pkg/assembler/backends/ent/backend"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -89,7 +95,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "cve",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.C1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -124,7 +131,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "novuln",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.NoVulnOut},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -159,7 +167,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "osv",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.O1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -194,7 +203,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "ghsa",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -229,7 +239,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "ghsa",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -260,7 +271,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "ghsa",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -291,7 +303,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "ghsa",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -333,6 +346,7 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						DbURI:          "test db uri 1",
 						TimeScanned:    testdata.T1,
 					},
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -374,6 +388,7 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						DbURI:          "test db uri",
 						TimeScanned:    testdata.T1,
 					},
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -415,6 +430,7 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						DbURI:          "test db uri",
 						TimeScanned:    testTime,
 					},
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -456,6 +472,7 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						DbURI:          "test db uri",
 						TimeScanned:    testTime,
 					},
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -497,6 +514,7 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						DbURI:          "test db uri",
 						TimeScanned:    testTime,
 					},
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -532,7 +550,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "ghsa",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -563,7 +582,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "ghsa",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -624,7 +644,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "novuln",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.NoVulnOut},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -672,7 +693,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "novuln",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.NoVulnOut},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -721,7 +743,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "cve",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.C1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 				{
 					Package: testdata.P1out,
@@ -729,7 +752,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "cve",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.C1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -788,7 +812,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "cve",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.C1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 				{
 					Package: testdata.P2out,
@@ -796,7 +821,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "novuln",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.NoVulnOut},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 				{
 					Package: testdata.P2out,
@@ -804,7 +830,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "osv",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.O1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 				{
 					Package: testdata.P2out,
@@ -812,7 +839,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "ghsa",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 				{
 					Package: testdata.P2out,
@@ -829,6 +857,7 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						DbURI:          "test db uri 1",
 						TimeScanned:    testdata.T1,
 					},
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 				{
 					Package: testdata.P2out,
@@ -845,6 +874,7 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						DbURI:          "test db uri",
 						TimeScanned:    testdata.T1,
 					},
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 				{
 					Package: testdata.P2out,
@@ -861,6 +891,7 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						DbURI:          "test db uri",
 						TimeScanned:    testTime,
 					},
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 				{
 					Package: testdata.P2out,
@@ -877,6 +908,7 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						DbURI:          "test db uri",
 						TimeScanned:    testTime,
 					},
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 				{
 					Package: testdata.P2out,
@@ -893,6 +925,7 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						DbURI:          "test db uri",
 						TimeScanned:    testTime,
 					},
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 				{
 					Package: testdata.P3out,
@@ -900,7 +933,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "ghsa",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 				{
 					Package: testdata.P1out,
@@ -908,7 +942,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "cve",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.C1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 				{
 					Package: testdata.P1out,
@@ -916,7 +951,8 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						Type:             "ghsa",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		}, {
@@ -960,6 +996,7 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 						TimeScanned:    testdata.T1,
 						DocumentRef:    "test",
 					},
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -1087,7 +1124,8 @@ func TestIngestCertifyVulns(t *testing.T) {
 						Type:             "cve",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.C1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 				{
 					ID:      "10",
@@ -1096,7 +1134,8 @@ func TestIngestCertifyVulns(t *testing.T) {
 						Type:             "cve",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.C2out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -1142,7 +1181,8 @@ func TestIngestCertifyVulns(t *testing.T) {
 						Type:             "novuln",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.NoVulnOut},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 				{
 					Package: testdata.P1out,
@@ -1150,7 +1190,8 @@ func TestIngestCertifyVulns(t *testing.T) {
 						Type:             "novuln",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.NoVulnOut},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -1187,7 +1228,8 @@ func TestIngestCertifyVulns(t *testing.T) {
 						Type:             "osv",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.O1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -1224,7 +1266,8 @@ func TestIngestCertifyVulns(t *testing.T) {
 						Type:             "ghsa",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -1270,7 +1313,8 @@ func TestIngestCertifyVulns(t *testing.T) {
 						Type:             "ghsa",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -1317,7 +1361,8 @@ func TestIngestCertifyVulns(t *testing.T) {
 						Type:             "ghsa",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -1400,7 +1445,8 @@ func TestIngestCertifyVulns(t *testing.T) {
 						Type:             "novuln",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.NoVulnOut},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 				{
 					Package: testdata.P1out,
@@ -1408,7 +1454,8 @@ func TestIngestCertifyVulns(t *testing.T) {
 						Type:             "novuln",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.NoVulnOut},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		}, {
@@ -1463,6 +1510,7 @@ func TestIngestCertifyVulns(t *testing.T) {
 						TimeScanned:    testdata.T1,
 						DocumentRef:    "test",
 					},
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 			},
 		},
@@ -1502,3 +1550,1360 @@ func TestIngestCertifyVulns(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckScannerFreshness(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	recent := time.Now().UTC().Truncate(time.Second).Add(-time.Hour)
+	scan := func(scannerURI, dbVersion string, timeScanned time.Time) *model.ScanMetadataInput {
+		return &model.ScanMetadataInput{
+			Collector:      "test collector",
+			Origin:         "test origin",
+			ScannerVersion: "v1.0.0",
+			ScannerURI:     scannerURI,
+			DbVersion:      dbVersion,
+			DbURI:          "test db uri",
+			TimeScanned:    timeScanned,
+		}
+	}
+	tests := []struct {
+		Name         string
+		Calls        []*model.ScanMetadataInput
+		ScannerURI   string
+		MaxAge       time.Duration
+		ExpFreshness *model.ScannerFreshnessResult
+		ExpQueryErr  bool
+	}{
+		{
+			Name: "Recent scan is fresh",
+			Calls: []*model.ScanMetadataInput{
+				scan("fresh scanner", "2023.01.01", testdata.T1),
+				scan("fresh scanner", "2024.06.01", recent),
+			},
+			ScannerURI: "fresh scanner",
+			MaxAge:     24 * time.Hour,
+			ExpFreshness: &model.ScannerFreshnessResult{
+				LatestScanTime: recent,
+				DbVersion:      "2024.06.01",
+				IsFresh:        true,
+				StaleScanCount: 1,
+			},
+		},
+		{
+			Name: "Old scans are stale",
+			Calls: []*model.ScanMetadataInput{
+				scan("stale scanner", "2001.09.09", testdata.T2),
+				scan("stale scanner", "2023.01.01", testdata.T1),
+			},
+			ScannerURI: "stale scanner",
+			MaxAge:     24 * time.Hour,
+			ExpFreshness: &model.ScannerFreshnessResult{
+				LatestScanTime: testdata.T1,
+				DbVersion:      "2023.01.01",
+				IsFresh:        false,
+				StaleScanCount: 2,
+			},
+		},
+		{
+			Name:       "Old scans within max age",
+			ScannerURI: "stale scanner",
+			MaxAge:     100 * 365 * 24 * time.Hour,
+			ExpFreshness: &model.ScannerFreshnessResult{
+				LatestScanTime: testdata.T1,
+				DbVersion:      "2023.01.01",
+				IsFresh:        true,
+				StaleScanCount: 0,
+			},
+		},
+		{
+			Name:        "Unknown scanner",
+			ScannerURI:  "unknown scanner",
+			MaxAge:      24 * time.Hour,
+			ExpQueryErr: true,
+		},
+	}
+	if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}); err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			for _, o := range test.Calls {
+				if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, *o); err != nil {
+					t.Fatalf("Could not ingest certify vuln: %v", err)
+				}
+			}
+			got, err := b.CheckScannerFreshness(ctx, test.ScannerURI, test.MaxAge)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(test.ExpFreshness, got, commonOpts); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMarkStaleVulns(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	recent := time.Now().UTC().Truncate(time.Second).Add(-time.Hour)
+	if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}); err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	for _, timeScanned := range []time.Time{testdata.T1, recent} {
+		scan := model.ScanMetadataInput{
+			Collector:      "test collector",
+			Origin:         "test origin",
+			ScannerVersion: "v1.0.0",
+			ScannerURI:     "test scanner uri",
+			DbVersion:      "2023.01.01",
+			DbURI:          "test db uri",
+			TimeScanned:    timeScanned,
+		}
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, scan); err != nil {
+			t.Fatalf("Could not ingest certify vuln: %v", err)
+		}
+	}
+
+	updated, err := b.MarkStaleVulns(ctx, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("MarkStaleVulns() error = %v", err)
+	}
+	if updated != 1 {
+		t.Errorf("MarkStaleVulns() updated %v certifications, want 1", updated)
+	}
+
+	got, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{ScannerURI: ptrfrom.String("test scanner uri")})
+	if err != nil {
+		t.Fatalf("CertifyVuln() error = %v", err)
+	}
+	gotStatus := map[time.Time]model.RemediationStatus{}
+	for _, cv := range got {
+		gotStatus[cv.Metadata.TimeScanned.UTC()] = cv.RemediationStatus
+	}
+	wantStatus := map[time.Time]model.RemediationStatus{
+		testdata.T1: model.RemediationStatusStale,
+		recent:      model.RemediationStatusOpen,
+	}
+	if diff := cmp.Diff(wantStatus, gotStatus); diff != "" {
+		t.Errorf("Unexpected remediation status (-want +got):\n%s", diff)
+	}
+
+	// already stale certifications are not counted again
+	updated, err = b.MarkStaleVulns(ctx, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("MarkStaleVulns() error = %v", err)
+	}
+	if updated != 0 {
+		t.Errorf("MarkStaleVulns() updated %v certifications on second run, want 0", updated)
+	}
+}
+
+func TestArchiveCertifyVulns(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	recent := time.Now().UTC().Truncate(time.Second).Add(-time.Hour)
+	if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}); err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	for _, timeScanned := range []time.Time{testdata.T1, recent} {
+		scan := model.ScanMetadataInput{
+			Collector:      "test collector",
+			Origin:         "test origin",
+			ScannerVersion: "v1.0.0",
+			ScannerURI:     "test scanner uri",
+			DbVersion:      "2023.01.01",
+			DbURI:          "test db uri",
+			TimeScanned:    timeScanned,
+		}
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, scan); err != nil {
+			t.Fatalf("Could not ingest certify vuln: %v", err)
+		}
+	}
+
+	archived, err := b.ArchiveCertifyVulns(ctx, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("ArchiveCertifyVulns() error = %v", err)
+	}
+	if archived != 1 {
+		t.Errorf("ArchiveCertifyVulns() archived %v certifications, want 1", archived)
+	}
+
+	filter := &model.CertifyVulnSpec{
+		Package: &model.PkgSpec{Name: ptrfrom.String(testdata.P1.Name)},
+		Vulnerability: &model.VulnerabilitySpec{
+			VulnerabilityID: ptrfrom.String(testdata.C1.VulnerabilityID),
+		},
+	}
+	got, err := b.CertifyVuln(ctx, filter)
+	if err != nil {
+		t.Fatalf("CertifyVuln() error = %v", err)
+	}
+	if len(got) != 1 || !got[0].Metadata.TimeScanned.Equal(recent) {
+		t.Errorf("CertifyVuln() after archiving = %v, want only the certification scanned at %v", got, recent)
+	}
+
+	got, err = b.ArchivedCertifyVuln(ctx, filter)
+	if err != nil {
+		t.Fatalf("ArchivedCertifyVuln() error = %v", err)
+	}
+	if len(got) != 1 || !got[0].Metadata.TimeScanned.Equal(testdata.T1) {
+		t.Fatalf("ArchivedCertifyVuln() = %v, want only the certification scanned at %v", got, testdata.T1)
+	}
+	if got[0].Package == nil || got[0].Vulnerability == nil {
+		t.Errorf("ArchivedCertifyVuln() did not return the package and vulnerability of the archived certification")
+	}
+	// the ID of an archived certification filters the archive, but is not a
+	// node of the graph
+	byID, err := b.ArchivedCertifyVuln(ctx, &model.CertifyVulnSpec{ID: &got[0].ID})
+	if err != nil {
+		t.Fatalf("ArchivedCertifyVuln() error = %v", err)
+	}
+	if len(byID) != 1 || byID[0].ID != got[0].ID {
+		t.Errorf("ArchivedCertifyVuln() by ID = %v, want the archived certification %q", byID, got[0].ID)
+	}
+	if n, err := b.Node(ctx, got[0].ID); err == nil && n != nil {
+		t.Errorf("Node(%q) = %v, want the archived certification not to be found", got[0].ID, n)
+	}
+
+	// archived certifications are not moved again
+	archived, err = b.ArchiveCertifyVulns(ctx, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("ArchiveCertifyVulns() error = %v", err)
+	}
+	if archived != 0 {
+		t.Errorf("ArchiveCertifyVulns() archived %v certifications on second run, want 0", archived)
+	}
+}
+
+func TestUpdateCertifyVulnResolution(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}); err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	pkgIDs, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1})
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	srcIDs, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: testdata.S1})
+	if err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	hsaID, err := b.IngestHasSourceAt(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, model.IDorSourceInput{SourceInput: testdata.S1}, model.HasSourceAtInputSpec{
+		KnownSince:    testdata.T1,
+		Justification: "fixed upstream",
+	})
+	if err != nil {
+		t.Fatalf("Could not ingest hasSourceAt: %v", err)
+	}
+	cvID, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, model.ScanMetadataInput{
+		Collector:   "test collector",
+		Origin:      "test origin",
+		ScannerURI:  "test scanner uri",
+		DbURI:       "test db uri",
+		TimeScanned: testdata.T1,
+	})
+	if err != nil {
+		t.Fatalf("Could not ingest certify vuln: %v", err)
+	}
+
+	got, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: &cvID})
+	if err != nil {
+		t.Fatalf("CertifyVuln() error = %v", err)
+	}
+	if len(got) != 1 || got[0].ResolvedByID != nil || got[0].ResolvedByType != nil {
+		t.Fatalf("expected one unresolved certification, got %+v", got)
+	}
+
+	updated, err := b.UpdateCertifyVulnResolution(ctx, cvID, hsaID)
+	if err != nil {
+		t.Fatalf("UpdateCertifyVulnResolution() error = %v", err)
+	}
+	if diff := cmp.Diff(ptrfrom.String(hsaID), updated.ResolvedByID); diff != "" {
+		t.Errorf("Unexpected resolvedByID (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(ptrfrom.String("HasSourceAt"), updated.ResolvedByType); diff != "" {
+		t.Errorf("Unexpected resolvedByType (-want +got):\n%s", diff)
+	}
+
+	// the resolution is stored with the certification
+	got, err = b.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: &cvID})
+	if err != nil {
+		t.Fatalf("CertifyVuln() error = %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("CertifyVuln() returned %d certifications, want 1", len(got))
+	}
+	if diff := cmp.Diff(updated, got[0], commonOpts); diff != "" {
+		t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+	}
+
+	// only a hasSBOM or hasSourceAt can resolve a vulnerability
+	if _, err := b.UpdateCertifyVulnResolution(ctx, cvID, pkgIDs.PackageVersionID); err == nil {
+		t.Errorf("UpdateCertifyVulnResolution() with a package version did not error")
+	}
+	if _, err := b.UpdateCertifyVulnResolution(ctx, hsaID, hsaID); err == nil {
+		t.Errorf("UpdateCertifyVulnResolution() with a hasSourceAt as certification did not error")
+	}
+
+	// deleting the source deletes the hasSourceAt, which the resolution
+	// then reports as not found
+	if err := b.DeleteSource(ctx, srcIDs.SourceNameID); err != nil {
+		t.Fatalf("DeleteSource() error = %v", err)
+	}
+	if _, err := b.Node(ctx, hsaID); err == nil {
+		t.Errorf("Node() of the deleted hasSourceAt did not error")
+	} else if code := errext.Classify(err); code != errext.ErrNotFound {
+		t.Errorf("Node() of the deleted hasSourceAt returned code %q, want %q", code, errext.ErrNotFound)
+	}
+}
+
+func TestCertifyVulnCVSSRange(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}); err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	scores := map[string]*float64{
+		"low":      ptrfrom.Float64(5.0),
+		"high":     ptrfrom.Float64(7.5),
+		"critical": ptrfrom.Float64(9.8),
+		"unscored": nil,
+	}
+	for docRef, score := range scores {
+		scan := model.ScanMetadataInput{
+			Collector:      "test collector",
+			Origin:         "test origin",
+			ScannerVersion: "v1.0.0",
+			ScannerURI:     "test scanner uri",
+			DbVersion:      "2023.01.01",
+			DbURI:          "test db uri",
+			TimeScanned:    testdata.T1,
+			DocumentRef:    docRef,
+			CVSSScore:      score,
+		}
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, scan); err != nil {
+			t.Fatalf("Could not ingest certify vuln: %v", err)
+		}
+	}
+
+	tests := []struct {
+		Name    string
+		Query   *model.CertifyVulnSpec
+		ExpRefs []string
+	}{
+		{
+			Name:    "No range",
+			Query:   &model.CertifyVulnSpec{},
+			ExpRefs: []string{"critical", "high", "low", "unscored"},
+		},
+		{
+			Name:    "Min only",
+			Query:   &model.CertifyVulnSpec{MinCVSS: ptrfrom.Float64(7.0)},
+			ExpRefs: []string{"critical", "high"},
+		},
+		{
+			Name:    "Max only, inclusive",
+			Query:   &model.CertifyVulnSpec{MaxCVSS: ptrfrom.Float64(7.5)},
+			ExpRefs: []string{"high", "low"},
+		},
+		{
+			Name:    "Min and max",
+			Query:   &model.CertifyVulnSpec{MinCVSS: ptrfrom.Float64(6.0), MaxCVSS: ptrfrom.Float64(8.0)},
+			ExpRefs: []string{"high"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.CertifyVuln(ctx, test.Query)
+			if err != nil {
+				t.Fatalf("CertifyVuln() error = %v", err)
+			}
+			var gotRefs []string
+			for _, cv := range got {
+				gotRefs = append(gotRefs, cv.Metadata.DocumentRef)
+				if want := scores[cv.Metadata.DocumentRef]; !cmp.Equal(want, cv.Metadata.CVSSScore) {
+					t.Errorf("CVSS score for %q = %v, want %v", cv.Metadata.DocumentRef, cv.Metadata.CVSSScore, want)
+				}
+			}
+			sort.Strings(gotRefs)
+			if diff := cmp.Diff(test.ExpRefs, gotRefs); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCertifyVulnTimeScannedRange(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}); err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	scanned := map[string]time.Time{
+		"day1": testdata.T1,
+		"day2": testdata.T1.Add(24 * time.Hour),
+		"day3": testdata.T1.Add(48 * time.Hour),
+	}
+	for docRef, timeScanned := range scanned {
+		scan := model.ScanMetadataInput{
+			Collector:      "test collector",
+			Origin:         "test origin",
+			ScannerVersion: "v1.0.0",
+			ScannerURI:     "test scanner uri",
+			DbVersion:      "2023.01.01",
+			DbURI:          "test db uri",
+			TimeScanned:    timeScanned,
+			DocumentRef:    docRef,
+		}
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, scan); err != nil {
+			t.Fatalf("Could not ingest certify vuln: %v", err)
+		}
+	}
+
+	tests := []struct {
+		Name    string
+		Query   model.CertifyVulnSpec
+		ExpRefs []string
+	}{
+		{
+			Name:    "No range",
+			Query:   model.CertifyVulnSpec{},
+			ExpRefs: []string{"day1", "day2", "day3"},
+		},
+		{
+			Name:    "After only, inclusive",
+			Query:   model.CertifyVulnSpec{TimeScannedAfter: ptrfrom.Time(testdata.T1.Add(24 * time.Hour))},
+			ExpRefs: []string{"day2", "day3"},
+		},
+		{
+			Name:    "Before only, inclusive",
+			Query:   model.CertifyVulnSpec{TimeScannedBefore: ptrfrom.Time(testdata.T1.Add(24 * time.Hour))},
+			ExpRefs: []string{"day1", "day2"},
+		},
+		{
+			Name: "After and before",
+			Query: model.CertifyVulnSpec{
+				TimeScannedAfter:  ptrfrom.Time(testdata.T1.Add(12 * time.Hour)),
+				TimeScannedBefore: ptrfrom.Time(testdata.T1.Add(36 * time.Hour)),
+			},
+			ExpRefs: []string{"day2"},
+		},
+		{
+			Name:  "Empty range",
+			Query: model.CertifyVulnSpec{TimeScannedAfter: ptrfrom.Time(testdata.T1.Add(72 * time.Hour))},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.CertifyVuln(ctx, &test.Query)
+			if err != nil {
+				t.Fatalf("CertifyVuln() error = %v", err)
+			}
+			var gotRefs []string
+			for _, cv := range got {
+				gotRefs = append(gotRefs, cv.Metadata.DocumentRef)
+			}
+			sort.Strings(gotRefs)
+			if diff := cmp.Diff(test.ExpRefs, gotRefs); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+
+			gotList, err := b.CertifyVulnList(ctx, test.Query, nil)
+			if err != nil {
+				t.Fatalf("CertifyVulnList() error = %v", err)
+			}
+			var gotListRefs []string
+			if gotList != nil {
+				for _, edge := range gotList.Edges {
+					gotListRefs = append(gotListRefs, edge.Node.Metadata.DocumentRef)
+				}
+			}
+			sort.Strings(gotListRefs)
+			if diff := cmp.Diff(test.ExpRefs, gotListRefs); diff != "" {
+				t.Errorf("Unexpected list results. (-want +got):\n%s", diff)
+			}
+
+			count, err := b.CertifyVulnCount(ctx, test.Query)
+			if err != nil {
+				t.Fatalf("CertifyVulnCount() error = %v", err)
+			}
+			if count != len(test.ExpRefs) {
+				t.Errorf("CertifyVulnCount() = %v, want %v", count, len(test.ExpRefs))
+			}
+		})
+	}
+}
+
+func TestCertifyVulnScannerURIIn(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}); err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	scanners := map[string]string{
+		"grype-report": "pkg:guac/grype",
+		"trivy-report": "pkg:guac/trivy",
+		"snyk-report":  "pkg:guac/snyk",
+	}
+	for docRef, scannerURI := range scanners {
+		scan := model.ScanMetadataInput{
+			Collector:      "test collector",
+			Origin:         "test origin",
+			ScannerVersion: "v1.0.0",
+			ScannerURI:     scannerURI,
+			DbVersion:      "2023.01.01",
+			DbURI:          "test db uri",
+			TimeScanned:    testdata.T1,
+			DocumentRef:    docRef,
+		}
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, scan); err != nil {
+			t.Fatalf("Could not ingest certify vuln: %v", err)
+		}
+	}
+
+	tests := []struct {
+		Name    string
+		Query   model.CertifyVulnSpec
+		ExpRefs []string
+	}{
+		{
+			Name:    "Empty list",
+			Query:   model.CertifyVulnSpec{ScannerURIIn: []string{}},
+			ExpRefs: []string{"grype-report", "snyk-report", "trivy-report"},
+		},
+		{
+			Name:    "Two scanners",
+			Query:   model.CertifyVulnSpec{ScannerURIIn: []string{"pkg:guac/grype", "pkg:guac/trivy"}},
+			ExpRefs: []string{"grype-report", "trivy-report"},
+		},
+		{
+			Name: "Combined with scannerUri",
+			Query: model.CertifyVulnSpec{
+				ScannerURI:   ptrfrom.String("pkg:guac/trivy"),
+				ScannerURIIn: []string{"pkg:guac/grype", "pkg:guac/trivy"},
+			},
+			ExpRefs: []string{"trivy-report"},
+		},
+		{
+			Name:  "Unknown scanner",
+			Query: model.CertifyVulnSpec{ScannerURIIn: []string{"pkg:guac/unknown"}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.CertifyVuln(ctx, &test.Query)
+			if err != nil {
+				t.Fatalf("CertifyVuln() error = %v", err)
+			}
+			var gotRefs []string
+			for _, cv := range got {
+				gotRefs = append(gotRefs, cv.Metadata.DocumentRef)
+			}
+			sort.Strings(gotRefs)
+			if diff := cmp.Diff(test.ExpRefs, gotRefs); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+
+			count, err := b.CertifyVulnCount(ctx, test.Query)
+			if err != nil {
+				t.Fatalf("CertifyVulnCount() error = %v", err)
+			}
+			if count != len(test.ExpRefs) {
+				t.Errorf("CertifyVulnCount() = %v, want %v", count, len(test.ExpRefs))
+			}
+		})
+	}
+}
+
+func TestCertifyVulnOrder(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}); err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	scans := []struct {
+		docRef         string
+		timeScanned    time.Time
+		scannerVersion string
+		dbVersion      string
+	}{
+		{"b", testdata.T1.Add(24 * time.Hour), "v1.0.0", "2023.03.01"},
+		{"c", testdata.T1.Add(48 * time.Hour), "v0.9.0", "2023.01.01"},
+		{"a", testdata.T1, "v1.1.0", "2023.02.01"},
+	}
+	for _, scan := range scans {
+		metadata := model.ScanMetadataInput{
+			Collector:      "test collector",
+			Origin:         "test origin",
+			ScannerVersion: scan.scannerVersion,
+			ScannerURI:     "test scanner uri",
+			DbVersion:      scan.dbVersion,
+			DbURI:          "test db uri",
+			TimeScanned:    scan.timeScanned,
+			DocumentRef:    scan.docRef,
+		}
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, metadata); err != nil {
+			t.Fatalf("Could not ingest certify vuln: %v", err)
+		}
+	}
+
+	tests := []struct {
+		Name    string
+		Order   *model.CertifyVulnOrder
+		ExpRefs []string
+	}{
+		{
+			Name:    "Time scanned ascending",
+			Order:   &model.CertifyVulnOrder{SortBy: model.CertifyVulnOrderFieldTimeScanned, SortOrder: model.OrderDirectionAsc},
+			ExpRefs: []string{"a", "b", "c"},
+		},
+		{
+			Name:    "Time scanned descending",
+			Order:   &model.CertifyVulnOrder{SortBy: model.CertifyVulnOrderFieldTimeScanned, SortOrder: model.OrderDirectionDesc},
+			ExpRefs: []string{"c", "b", "a"},
+		},
+		{
+			Name:    "Scanner version ascending",
+			Order:   &model.CertifyVulnOrder{SortBy: model.CertifyVulnOrderFieldScannerVersion, SortOrder: model.OrderDirectionAsc},
+			ExpRefs: []string{"c", "b", "a"},
+		},
+		{
+			Name:    "DB version descending",
+			Order:   &model.CertifyVulnOrder{SortBy: model.CertifyVulnOrderFieldDbVersion, SortOrder: model.OrderDirectionDesc},
+			ExpRefs: []string{"b", "a", "c"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{Order: test.Order})
+			if err != nil {
+				t.Fatalf("CertifyVuln() error = %v", err)
+			}
+			var gotRefs []string
+			for _, cv := range got {
+				gotRefs = append(gotRefs, cv.Metadata.DocumentRef)
+			}
+			if diff := cmp.Diff(test.ExpRefs, gotRefs); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteCertifyVuln(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	vulnIDs, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1})
+	if err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	pkgIDs, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1})
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	var ids []string
+	for _, timeScanned := range []time.Time{testdata.T1, testdata.T1.Add(time.Hour)} {
+		scan := model.ScanMetadataInput{
+			Collector:      "test collector",
+			Origin:         "test origin",
+			ScannerVersion: "v1.0.0",
+			ScannerURI:     "test scanner uri",
+			DbVersion:      "2023.01.01",
+			DbURI:          "test db uri",
+			TimeScanned:    timeScanned,
+		}
+		id, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, scan)
+		if err != nil {
+			t.Fatalf("Could not ingest certify vuln: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	deleted, kept := ids[0], ids[1]
+
+	if err := b.DeleteCertifyVuln(ctx, deleted); err != nil {
+		t.Fatalf("DeleteCertifyVuln() error = %v", err)
+	}
+
+	got, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{ScannerURI: ptrfrom.String("test scanner uri")})
+	if err != nil {
+		t.Fatalf("CertifyVuln() error = %v", err)
+	}
+	if len(got) != 1 || got[0].ID != kept {
+		t.Errorf("CertifyVuln() after delete = %v, want only %q", got, kept)
+	}
+
+	if n, err := b.Node(ctx, deleted); err == nil && n != nil {
+		t.Errorf("Node(%q) = %v after delete, want not found", deleted, n)
+	}
+
+	for _, neighborOf := range []string{pkgIDs.PackageVersionID, vulnIDs.VulnerabilityNodeID} {
+		neighbors, err := b.Neighbors(ctx, neighborOf, []model.Edge{})
+		if err != nil {
+			t.Fatalf("Neighbors(%q) error = %v", neighborOf, err)
+		}
+		foundKept := false
+		for _, n := range neighbors {
+			if cv, ok := n.(*model.CertifyVuln); ok {
+				if cv.ID == deleted {
+					t.Errorf("Neighbors(%q) still returns deleted certifyVuln %q", neighborOf, deleted)
+				}
+				foundKept = foundKept || cv.ID == kept
+			}
+		}
+		if !foundKept {
+			t.Errorf("Neighbors(%q) did not return remaining certifyVuln %q", neighborOf, kept)
+		}
+	}
+
+	if err := b.DeleteCertifyVuln(ctx, deleted); err == nil {
+		t.Errorf("DeleteCertifyVuln() on deleted id did not return an error")
+	} else if code := errext.Classify(err); code != errext.ErrNotFound {
+		t.Errorf("DeleteCertifyVuln() on deleted id returned code %q, want %q", code, errext.ErrNotFound)
+	}
+}
+
+func TestCertifyVulnAdded(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	for _, v := range []*model.VulnerabilityInputSpec{testdata.C1, testdata.C2} {
+		if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: v}); err != nil {
+			t.Fatalf("Could not ingest vulnerability: %v", err)
+		}
+	}
+
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	added, err := b.CertifyVulnAdded(subCtx, &model.CertifyVulnSpec{
+		Vulnerability: &model.VulnerabilitySpec{VulnerabilityID: ptrfrom.String(testdata.C1.VulnerabilityID)},
+	})
+	if err != nil {
+		t.Fatalf("CertifyVulnAdded() error = %v", err)
+	}
+
+	scan := func(docRef string) *model.ScanMetadataInput {
+		return &model.ScanMetadataInput{
+			ScannerURI:  "test scanner uri",
+			TimeScanned: testdata.T1,
+			DocumentRef: docRef,
+		}
+	}
+	ingests := []struct {
+		vuln   *model.VulnerabilityInputSpec
+		docRef string
+	}{
+		{testdata.C1, "first"},
+		// filtered out
+		{testdata.C2, "other"},
+		// not new
+		{testdata.C1, "first"},
+	}
+	for _, i := range ingests {
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: testdata.P1},
+			model.IDorVulnerabilityInput{VulnerabilityInput: i.vuln}, *scan(i.docRef)); err != nil {
+			t.Fatalf("Could not ingest certify vuln: %v", err)
+		}
+	}
+	if _, err := b.IngestCertifyVulns(ctx,
+		[]*model.IDorPkgInput{{PackageInput: testdata.P1}, {PackageInput: testdata.P1}},
+		[]*model.IDorVulnerabilityInput{{VulnerabilityInput: testdata.C1}, {VulnerabilityInput: testdata.C1}},
+		[]*model.ScanMetadataInput{scan("first"), scan("bulk")}); err != nil {
+		t.Fatalf("Could not bulk ingest certify vulns: %v", err)
+	}
+	if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: testdata.P1},
+		model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, *scan("last")); err != nil {
+		t.Fatalf("Could not ingest certify vuln: %v", err)
+	}
+
+	var got []string
+	for len(got) == 0 || got[len(got)-1] != "last" {
+		select {
+		case cv := <-added:
+			got = append(got, cv.Metadata.DocumentRef)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for certifyVulnAdded events, got %v", got)
+		}
+	}
+	if diff := cmp.Diff([]string{"first", "bulk", "last"}, got); diff != "" {
+		t.Errorf("Unexpected certifyVulnAdded events (-want +got):\n%s", diff)
+	}
+
+	cancel()
+	select {
+	case _, ok := <-added:
+		if ok {
+			t.Errorf("unexpected certifyVulnAdded event after cancel")
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("certifyVulnAdded channel not closed after cancel")
+	}
+}
+
+func TestCertifyVulnAddedForPackage(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	for _, p := range []*model.PkgInputSpec{testdata.P1, testdata.P4} {
+		if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p}); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}); err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	subscribe := func(pkg *model.PkgSpec) <-chan *model.CertifyVuln {
+		added, err := b.CertifyVulnAdded(subCtx, &model.CertifyVulnSpec{Package: pkg})
+		if err != nil {
+			t.Fatalf("CertifyVulnAdded() error = %v", err)
+		}
+		return added
+	}
+	// the namespace of the first is a wildcard
+	tensorflow := subscribe(&model.PkgSpec{Type: ptrfrom.String("pypi"), Name: ptrfrom.String("tensorflow")})
+	openssl := subscribe(&model.PkgSpec{Type: ptrfrom.String("conan"), Namespace: ptrfrom.String("openssl.org"), Name: ptrfrom.String("openssl")})
+	other := subscribe(&model.PkgSpec{Type: ptrfrom.String("pypi"), Name: ptrfrom.String("django")})
+
+	for _, p := range []*model.PkgInputSpec{testdata.P1, testdata.P4} {
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: p},
+			model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1},
+			model.ScanMetadataInput{ScannerURI: "test scanner uri", TimeScanned: testdata.T1, DocumentRef: p.Name}); err != nil {
+			t.Fatalf("Could not ingest certify vuln: %v", err)
+		}
+	}
+
+	for name, added := range map[string]<-chan *model.CertifyVuln{"tensorflow": tensorflow, "openssl": openssl} {
+		select {
+		case cv := <-added:
+			if cv.Metadata.DocumentRef != name {
+				t.Errorf("got certifyVuln of %s, want %s", cv.Metadata.DocumentRef, name)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for the certifyVuln of %s", name)
+		}
+	}
+	cancel()
+	for name, added := range map[string]<-chan *model.CertifyVuln{"tensorflow": tensorflow, "openssl": openssl, "django": other} {
+		for cv := range added {
+			t.Errorf("unexpected certifyVuln of %s for %s", cv.Metadata.DocumentRef, name)
+		}
+	}
+}
+
+func TestCertifyVulnByVulnerabilityIDs(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	vulnIDs := map[*model.VulnerabilityInputSpec]string{}
+	for _, vuln := range []*model.VulnerabilityInputSpec{testdata.C1, testdata.C2, testdata.C3} {
+		ids, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: vuln})
+		if err != nil {
+			t.Fatalf("Could not ingest vulnerability: %v", err)
+		}
+		vulnIDs[vuln] = ids.VulnerabilityNodeID
+	}
+	pkgIDs := map[*model.PkgInputSpec]string{}
+	for _, pkg := range []*model.PkgInputSpec{testdata.P1, testdata.P2, testdata.P4} {
+		ids, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: pkg})
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		pkgIDs[pkg] = ids.PackageVersionID
+	}
+	certs := []struct {
+		pkg    *model.PkgInputSpec
+		vuln   *model.VulnerabilityInputSpec
+		origin string
+	}{
+		{testdata.P1, testdata.C1, "origin one"},
+		{testdata.P2, testdata.C1, "origin two"},
+		{testdata.P4, testdata.C2, "origin one"},
+	}
+	for _, c := range certs {
+		scan := model.ScanMetadataInput{
+			Collector:      "test collector",
+			Origin:         c.origin,
+			ScannerVersion: "v1.0.0",
+			ScannerURI:     "test scanner uri",
+			DbVersion:      "2023.01.01",
+			DbURI:          "test db uri",
+			TimeScanned:    testdata.T1,
+		}
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: c.pkg}, model.IDorVulnerabilityInput{VulnerabilityInput: c.vuln}, scan); err != nil {
+			t.Fatalf("Could not ingest certify vuln: %v", err)
+		}
+	}
+
+	tests := []struct {
+		Name    string
+		Filter  *model.CertifyVulnSpec
+		ExpPkgs map[string][]string
+	}{
+		{
+			Name:   "No filter",
+			Filter: nil,
+			ExpPkgs: map[string][]string{
+				vulnIDs[testdata.C1]: {pkgIDs[testdata.P1], pkgIDs[testdata.P2]},
+				vulnIDs[testdata.C2]: {pkgIDs[testdata.P4]},
+			},
+		},
+		{
+			Name:   "Filter by origin",
+			Filter: &model.CertifyVulnSpec{Origin: ptrfrom.String("origin one")},
+			ExpPkgs: map[string][]string{
+				vulnIDs[testdata.C1]: {pkgIDs[testdata.P1]},
+				vulnIDs[testdata.C2]: {pkgIDs[testdata.P4]},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.CertifyVulnByVulnerabilityIDs(ctx, []string{vulnIDs[testdata.C1], vulnIDs[testdata.C2], vulnIDs[testdata.C3]}, test.Filter)
+			if err != nil {
+				t.Fatalf("CertifyVulnByVulnerabilityIDs() error = %v", err)
+			}
+			gotPkgs := map[string][]string{}
+			for vulnID, certs := range got {
+				for _, cv := range certs {
+					gotPkgs[vulnID] = append(gotPkgs[vulnID], cv.Package.Namespaces[0].Names[0].Versions[0].ID)
+				}
+				sort.Strings(gotPkgs[vulnID])
+			}
+			for _, pkgs := range test.ExpPkgs {
+				sort.Strings(pkgs)
+			}
+			if diff := cmp.Diff(test.ExpPkgs, gotPkgs); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCertifyVulnByPackageIDs(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	vulnIDs := map[*model.VulnerabilityInputSpec]string{}
+	for _, vuln := range []*model.VulnerabilityInputSpec{testdata.C1, testdata.C2, testdata.C3} {
+		ids, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: vuln})
+		if err != nil {
+			t.Fatalf("Could not ingest vulnerability: %v", err)
+		}
+		vulnIDs[vuln] = ids.VulnerabilityNodeID
+	}
+	pkgIDs := map[*model.PkgInputSpec]string{}
+	for _, pkg := range []*model.PkgInputSpec{testdata.P1, testdata.P2, testdata.P4} {
+		ids, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: pkg})
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		pkgIDs[pkg] = ids.PackageVersionID
+	}
+	certs := []struct {
+		pkg    *model.PkgInputSpec
+		vuln   *model.VulnerabilityInputSpec
+		origin string
+	}{
+		{testdata.P1, testdata.C1, "origin one"},
+		{testdata.P2, testdata.C1, "origin two"},
+		{testdata.P4, testdata.C2, "origin one"},
+	}
+	for _, c := range certs {
+		scan := model.ScanMetadataInput{
+			Collector:      "test collector",
+			Origin:         c.origin,
+			ScannerVersion: "v1.0.0",
+			ScannerURI:     "test scanner uri",
+			DbVersion:      "2023.01.01",
+			DbURI:          "test db uri",
+			TimeScanned:    testdata.T1,
+		}
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: c.pkg}, model.IDorVulnerabilityInput{VulnerabilityInput: c.vuln}, scan); err != nil {
+			t.Fatalf("Could not ingest certify vuln: %v", err)
+		}
+	}
+
+	tests := []struct {
+		Name     string
+		Filter   *model.CertifyVulnSpec
+		ExpVulns map[string][]string
+	}{
+		{
+			Name:   "No filter",
+			Filter: nil,
+			ExpVulns: map[string][]string{
+				pkgIDs[testdata.P1]: {vulnIDs[testdata.C1]},
+				pkgIDs[testdata.P2]: {vulnIDs[testdata.C1]},
+				pkgIDs[testdata.P4]: {vulnIDs[testdata.C2]},
+			},
+		},
+		{
+			Name:   "Filter by origin",
+			Filter: &model.CertifyVulnSpec{Origin: ptrfrom.String("origin two")},
+			ExpVulns: map[string][]string{
+				pkgIDs[testdata.P2]: {vulnIDs[testdata.C1]},
+			},
+		},
+		{
+			Name: "Package in filter is ignored",
+			Filter: &model.CertifyVulnSpec{
+				Origin:  ptrfrom.String("origin one"),
+				Package: &model.PkgSpec{Type: ptrfrom.String("conan")},
+			},
+			ExpVulns: map[string][]string{
+				pkgIDs[testdata.P1]: {vulnIDs[testdata.C1]},
+				pkgIDs[testdata.P4]: {vulnIDs[testdata.C2]},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.CertifyVulnByPackageIDs(ctx, []string{pkgIDs[testdata.P1], pkgIDs[testdata.P2], pkgIDs[testdata.P4]}, test.Filter)
+			if err != nil {
+				t.Fatalf("CertifyVulnByPackageIDs() error = %v", err)
+			}
+			gotVulns := map[string][]string{}
+			for pkgID, certs := range got {
+				for _, cv := range certs {
+					gotVulns[pkgID] = append(gotVulns[pkgID], cv.Vulnerability.VulnerabilityIDs[0].ID)
+				}
+			}
+			if diff := cmp.Diff(test.ExpVulns, gotVulns); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTopVulnerablePackages(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	for _, vuln := range []*model.VulnerabilityInputSpec{testdata.C1, testdata.C2, testdata.C3, testdata.NoVulnInput} {
+		if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: vuln}); err != nil {
+			t.Fatalf("Could not ingest vulnerability: %v", err)
+		}
+	}
+	for _, pkg := range []*model.PkgInputSpec{testdata.P1, testdata.P2, testdata.P4} {
+		if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: pkg}); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	certs := []struct {
+		pkg    *model.PkgInputSpec
+		vuln   *model.VulnerabilityInputSpec
+		origin string
+	}{
+		{testdata.P1, testdata.C1, "origin one"},
+		{testdata.P1, testdata.C2, "origin one"},
+		{testdata.P1, testdata.C3, "origin two"},
+		{testdata.P2, testdata.C1, "origin two"},
+		{testdata.P2, testdata.C2, "origin two"},
+		{testdata.P4, testdata.NoVulnInput, "origin one"},
+	}
+	for _, c := range certs {
+		scan := model.ScanMetadataInput{
+			Collector:      "test collector",
+			Origin:         c.origin,
+			ScannerVersion: "v1.0.0",
+			ScannerURI:     "test scanner uri",
+			DbVersion:      "2023.01.01",
+			DbURI:          "test db uri",
+			TimeScanned:    testdata.T1,
+		}
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: c.pkg}, model.IDorVulnerabilityInput{VulnerabilityInput: c.vuln}, scan); err != nil {
+			t.Fatalf("Could not ingest certify vuln: %v", err)
+		}
+	}
+
+	tests := []struct {
+		Name   string
+		Limit  int
+		Filter *model.CertifyVulnSpec
+		Exp    []*model.PackageVulnCount
+	}{
+		{
+			Name:  "All packages",
+			Limit: 10,
+			Exp: []*model.PackageVulnCount{
+				{Package: testdata.P1out, Count: 3},
+				{Package: testdata.P2out, Count: 2},
+			},
+		},
+		{
+			Name:  "Limited",
+			Limit: 1,
+			Exp: []*model.PackageVulnCount{
+				{Package: testdata.P1out, Count: 3},
+			},
+		},
+		{
+			Name:   "Filter by origin",
+			Limit:  10,
+			Filter: &model.CertifyVulnSpec{Origin: ptrfrom.String("origin two")},
+			Exp: []*model.PackageVulnCount{
+				{Package: testdata.P2out, Count: 2},
+				{Package: testdata.P1out, Count: 1},
+			},
+		},
+		{
+			Name:   "Only novuln",
+			Limit:  10,
+			Filter: &model.CertifyVulnSpec{Package: &model.PkgSpec{Type: ptrfrom.String("conan")}},
+			Exp:    []*model.PackageVulnCount{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.TopVulnerablePackages(ctx, test.Limit, test.Filter)
+			if err != nil {
+				t.Fatalf("TopVulnerablePackages() error = %v", err)
+			}
+			if diff := cmp.Diff(test.Exp, got, commonOpts); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+	for _, limit := range []int{0, -1} {
+		if _, err := b.TopVulnerablePackages(ctx, limit, nil); errext.Classify(err) != errext.ErrInvalidInput {
+			t.Errorf("TopVulnerablePackages(%d) error = %v, want an invalid input error", limit, err)
+		}
+	}
+}
+
+func TestGetCertifyVulnsByDocumentRef(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	for _, vuln := range []*model.VulnerabilityInputSpec{testdata.C1, testdata.C2} {
+		if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: vuln}); err != nil {
+			t.Fatalf("Could not ingest vulnerability: %v", err)
+		}
+	}
+	for _, pkg := range []*model.PkgInputSpec{testdata.P1, testdata.P2} {
+		if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: pkg}); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	certs := []struct {
+		pkg    *model.PkgInputSpec
+		vuln   *model.VulnerabilityInputSpec
+		docRef string
+	}{
+		{testdata.P1, testdata.C1, "doc one"},
+		{testdata.P2, testdata.C2, "doc one"},
+		{testdata.P2, testdata.C1, "doc two"},
+	}
+	for _, c := range certs {
+		scan := model.ScanMetadataInput{
+			Collector:      "test collector",
+			Origin:         "test origin",
+			ScannerVersion: "v1.0.0",
+			ScannerURI:     "test scanner uri",
+			DbVersion:      "2023.01.01",
+			DbURI:          "test db uri",
+			TimeScanned:    testdata.T1,
+			DocumentRef:    c.docRef,
+		}
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: c.pkg}, model.IDorVulnerabilityInput{VulnerabilityInput: c.vuln}, scan); err != nil {
+			t.Fatalf("Could not ingest certify vuln: %v", err)
+		}
+	}
+
+	tests := []struct {
+		Name   string
+		DocRef string
+		Exp    []string
+	}{
+		{
+			Name:   "Several certifications",
+			DocRef: "doc one",
+			Exp:    []string{"tensorflow@ cve-2019-13110", "tensorflow@2.11.1 cve-2014-8139"},
+		},
+		{
+			Name:   "One certification",
+			DocRef: "doc two",
+			Exp:    []string{"tensorflow@2.11.1 cve-2019-13110"},
+		},
+		{
+			Name:   "Unknown document",
+			DocRef: "doc three",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.GetCertifyVulnsByDocumentRef(ctx, test.DocRef)
+			if err != nil {
+				t.Fatalf("GetCertifyVulnsByDocumentRef() error = %v", err)
+			}
+			var gotCerts []string
+			for _, cv := range got {
+				if cv.Metadata.DocumentRef != test.DocRef {
+					t.Errorf("got certification of document %q, want %q", cv.Metadata.DocumentRef, test.DocRef)
+				}
+				name := cv.Package.Namespaces[0].Names[0]
+				gotCerts = append(gotCerts, name.Name+"@"+name.Versions[0].Version+" "+cv.Vulnerability.VulnerabilityIDs[0].VulnerabilityID)
+			}
+			if diff := cmp.Diff(test.Exp, gotCerts, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// certifyVulnChecker is implemented by the backends that can check for an
+// existing CertifyVuln without writing it
+type certifyVulnChecker interface {
+	CheckCertifyVulnExists(ctx context.Context, pkgID, vulnID string, meta model.ScanMetadataInput) (bool, string, error)
+}
+
+// newSkipIfExistsBackend returns an ent backend that checks for existing
+// CertifyVuln nodes on ingestion, the other backends do not support it
+func newSkipIfExistsBackend(tb testing.TB, skipIfExists bool) backends.Backend {
+	tb.Helper()
+	if currentBackend != ent {
+		tb.Skipf("checking for existing certify vulns is not supported by %s", currentBackend)
+	}
+	be, err := testBackends[ent].(*entBE).newDB(func(opts *entbackend.BackendOptions) {
+		opts.SkipIfExists = skipIfExists
+	})
+	if err != nil {
+		tb.Fatalf("Could not setup ent backend: %v", err)
+	}
+	return be
+}
+
+// ingestCertifyVulnSubjects ingests testdata.P1 and testdata.C1 and returns
+// their IDs, as the ingestion pipeline passes them to IngestCertifyVuln
+func ingestCertifyVulnSubjects(tb testing.TB, b backends.Backend) (model.IDorPkgInput, model.IDorVulnerabilityInput) {
+	tb.Helper()
+	ctx := context.Background()
+	pkgIDs, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1})
+	if err != nil {
+		tb.Fatalf("Could not ingest package: %v", err)
+	}
+	vulnIDs, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1})
+	if err != nil {
+		tb.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	return model.IDorPkgInput{PackageVersionID: &pkgIDs.PackageVersionID},
+		model.IDorVulnerabilityInput{VulnerabilityNodeID: &vulnIDs.VulnerabilityNodeID}
+}
+
+func TestCheckCertifyVulnExists(t *testing.T) {
+	ctx := context.Background()
+	b := newSkipIfExistsBackend(t, true)
+	checker, ok := b.(certifyVulnChecker)
+	if !ok {
+		t.Fatalf("%s backend does not implement CheckCertifyVulnExists", currentBackend)
+	}
+	pkg, vuln := ingestCertifyVulnSubjects(t, b)
+	scan := model.ScanMetadataInput{
+		Collector:      "test collector",
+		Origin:         "test origin",
+		ScannerVersion: "v1.0.0",
+		ScannerURI:     "test scanner uri",
+		DbVersion:      "2023.01.01",
+		DbURI:          "test db uri",
+		TimeScanned:    testdata.T1,
+	}
+
+	exists, _, err := checker.CheckCertifyVulnExists(ctx, *pkg.PackageVersionID, *vuln.VulnerabilityNodeID, scan)
+	if err != nil {
+		t.Fatalf("CheckCertifyVulnExists() error = %v", err)
+	}
+	if exists {
+		t.Fatal("CheckCertifyVulnExists() found a certify vuln before ingestion")
+	}
+
+	id, err := b.IngestCertifyVuln(ctx, pkg, vuln, scan)
+	if err != nil {
+		t.Fatalf("IngestCertifyVuln() error = %v", err)
+	}
+	exists, existingID, err := checker.CheckCertifyVulnExists(ctx, *pkg.PackageVersionID, *vuln.VulnerabilityNodeID, scan)
+	if err != nil {
+		t.Fatalf("CheckCertifyVulnExists() error = %v", err)
+	}
+	if !exists || existingID != id {
+		t.Errorf("CheckCertifyVulnExists() = %v, %q, want true, %q", exists, existingID, id)
+	}
+
+	// the inputs of a re-run ingestion resolve to the existing certify vuln
+	againID, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, scan)
+	if err != nil {
+		t.Fatalf("IngestCertifyVuln() error = %v", err)
+	}
+	if againID != id {
+		t.Errorf("IngestCertifyVuln() of a duplicate = %q, want %q", againID, id)
+	}
+
+	// every field of the scan metadata must match
+	rescan := scan
+	rescan.TimeScanned = testdata.T1.Add(time.Hour)
+	exists, _, err = checker.CheckCertifyVulnExists(ctx, *pkg.PackageVersionID, *vuln.VulnerabilityNodeID, rescan)
+	if err != nil {
+		t.Fatalf("CheckCertifyVulnExists() error = %v", err)
+	}
+	if exists {
+		t.Error("CheckCertifyVulnExists() matched a scan at another time")
+	}
+}
+
+// BenchmarkIngestDuplicateCertifyVulns compares re-ingesting 10,000 existing
+// certify vulns through the upsert with checking for them first
+func BenchmarkIngestDuplicateCertifyVulns(b *testing.B) {
+	const duplicates = 10000
+	ctx := context.Background()
+	scan := model.ScanMetadataInput{
+		Collector:      "test collector",
+		Origin:         "test origin",
+		ScannerVersion: "v1.0.0",
+		ScannerURI:     "test scanner uri",
+		DbVersion:      "2023.01.01",
+		DbURI:          "test db uri",
+		TimeScanned:    testdata.T1,
+	}
+	for _, skipIfExists := range []bool{false, true} {
+		b.Run("SkipIfExists="+strconv.FormatBool(skipIfExists), func(b *testing.B) {
+			be := newSkipIfExistsBackend(b, skipIfExists)
+			pkg, vuln := ingestCertifyVulnSubjects(b, be)
+			if _, err := be.IngestCertifyVuln(ctx, pkg, vuln, scan); err != nil {
+				b.Fatalf("IngestCertifyVuln() error = %v", err)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < duplicates; j++ {
+					if _, err := be.IngestCertifyVuln(ctx, pkg, vuln, scan); err != nil {
+						b.Fatalf("IngestCertifyVuln() error = %v", err)
+					}
+				}
+			}
+			b.ReportMetric(float64(b.N*duplicates)/b.Elapsed().Seconds(), "records/s")
+		})
+	}
+}
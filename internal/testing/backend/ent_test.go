@@ -22,9 +22,14 @@ import (
 	"database/sql"
 	"fmt"
 	"net/url"
+	"testing"
 
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/testdata"
 	"github.com/guacsec/guac/pkg/assembler/backends"
 	entbackend "github.com/guacsec/guac/pkg/assembler/backends/ent/backend"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/health"
 	"github.com/segmentio/ksuid"
 )
 
@@ -69,11 +74,19 @@ func (m *entBE) Cleanup() {
 }
 
 func (m *entBE) setupNewDB() error {
+	be, err := m.newDB(nil)
+	m.be = be
+	return err
+}
+
+// newDB returns a backend on a new database, with the options changed by
+// configure when it is not nil
+func (m *entBE) newDB(configure func(*entbackend.BackendOptions)) (backends.Backend, error) {
 	ctx := context.Background()
 	ident := ksuid.New().String()
 	_, err := m.topSQL.ExecContext(ctx, fmt.Sprintf("CREATE DATABASE \"%v\"", ident))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	testURL := *m.topURL
 	testURL.Path = ident
@@ -83,7 +96,78 @@ func (m *entBE) setupNewDB() error {
 		Debug:       false,
 		AutoMigrate: true,
 	}
-	be, err := backends.Get("ent", ctx, opts)
-	m.be = be
-	return err
+	if configure != nil {
+		configure(opts)
+	}
+	return backends.Get("ent", ctx, opts)
+}
+
+func TestEntExplainPing(t *testing.T) {
+	if currentBackend != ent {
+		t.Skipf("explaining queries is not supported by %s", currentBackend)
+	}
+	ctx := context.Background()
+	// setting up the backend pings the database through the explain driver
+	be, err := testBackends[ent].(*entBE).newDB(func(opts *entbackend.BackendOptions) {
+		opts.Explain = true
+	})
+	if err != nil {
+		t.Fatalf("Could not setup ent backend with explain: %v", err)
+	}
+	db, ok := be.(health.Database)
+	if !ok {
+		t.Fatalf("ent backend does not implement health.Database")
+	}
+	if err := db.Ping(ctx); err != nil {
+		t.Errorf("Ping() error = %v", err)
+	}
+	if _, err := db.SchemaVersion(ctx); err != nil {
+		t.Errorf("SchemaVersion() error = %v", err)
+	}
+}
+
+func TestEntCanonicalPackageFilters(t *testing.T) {
+	if currentBackend != ent {
+		t.Skipf("package canonicalization is specific to %s", ent)
+	}
+	ctx := context.Background()
+	b := setupTest(t)
+	pkg := &model.PkgInputSpec{
+		Type:      "Golang",
+		Namespace: ptrfrom.String("github.com/Sirupsen"),
+		Name:      "logrus",
+		Version:   ptrfrom.String(" v1.9.3 "),
+		Subpath:   ptrfrom.String("/a/b/"),
+	}
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: pkg}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}); err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: pkg}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, model.ScanMetadataInput{TimeScanned: testdata.T1}); err != nil {
+		t.Fatalf("Could not ingest certifyVuln: %v", err)
+	}
+
+	filter := &model.PkgSpec{
+		Type:      ptrfrom.String("Golang"),
+		Namespace: ptrfrom.String("github.com/Sirupsen"),
+		Name:      ptrfrom.String("logrus"),
+		Version:   ptrfrom.String(" v1.9.3 "),
+		Subpath:   ptrfrom.String("/a/b/"),
+	}
+	pkgs, err := b.Packages(ctx, filter)
+	if err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	if len(pkgs) != 1 {
+		t.Errorf("Packages() returned %d packages, want 1", len(pkgs))
+	}
+	vulns, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{Package: filter})
+	if err != nil {
+		t.Fatalf("CertifyVuln() error = %v", err)
+	}
+	if len(vulns) != 1 {
+		t.Errorf("CertifyVuln() returned %d certifications, want 1", len(vulns))
+	}
 }
@@ -0,0 +1,211 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestExploitReferences(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	type call struct {
+		Vuln    *model.VulnerabilityInputSpec
+		Exploit *model.ExploitReferenceInputSpec
+	}
+
+	edb := &model.ExploitReferenceInputSpec{
+		Source:    "EXPLOIT_DB",
+		URL:       "https://www.exploit-db.com/exploits/50592",
+		Origin:    "test origin",
+		Collector: "test collector",
+	}
+	edbVerified := &model.ExploitReferenceInputSpec{
+		Source:    "EXPLOIT_DB",
+		URL:       "https://www.exploit-db.com/exploits/50592",
+		Verified:  true,
+		Origin:    "test origin",
+		Collector: "test collector",
+	}
+	poc := &model.ExploitReferenceInputSpec{
+		Source:    "GITHUB_POC",
+		URL:       "https://github.com/example/poc",
+		Origin:    "test origin",
+		Collector: "test collector",
+	}
+	c1Vuln := &model.Vulnerability{
+		Type:             "cve",
+		VulnerabilityIDs: []*model.VulnerabilityID{testdata.C1out},
+	}
+	o1Vuln := &model.Vulnerability{
+		Type:             "osv",
+		VulnerabilityIDs: []*model.VulnerabilityID{testdata.O1out},
+	}
+
+	tests := []struct {
+		Name         string
+		InVuln       []*model.VulnerabilityInputSpec
+		Calls        []call
+		Query        *model.ExploitReferenceSpec
+		QueryID      bool
+		ExpExploit   []*model.ExploitReference
+		ExpIngestErr bool
+		ExpQueryErr  bool
+	}{
+		{
+			Name:   "HappyPath",
+			InVuln: []*model.VulnerabilityInputSpec{testdata.C1},
+			Calls:  []call{{Vuln: testdata.C1, Exploit: edb}},
+			Query: &model.ExploitReferenceSpec{
+				Source: ptrfrom.String("EXPLOIT_DB"),
+			},
+			ExpExploit: []*model.ExploitReference{
+				{
+					Vulnerability: c1Vuln,
+					Source:        "EXPLOIT_DB",
+					URL:           "https://www.exploit-db.com/exploits/50592",
+					Origin:        "test origin",
+					Collector:     "test collector",
+				},
+			},
+		},
+		{
+			Name:   "Reingest updates verified",
+			InVuln: []*model.VulnerabilityInputSpec{testdata.C1},
+			Calls: []call{
+				{Vuln: testdata.C1, Exploit: edb},
+				{Vuln: testdata.C1, Exploit: edbVerified},
+			},
+			Query: &model.ExploitReferenceSpec{
+				URL: ptrfrom.String("https://www.exploit-db.com/exploits/50592"),
+			},
+			ExpExploit: []*model.ExploitReference{
+				{
+					Vulnerability: c1Vuln,
+					Source:        "EXPLOIT_DB",
+					URL:           "https://www.exploit-db.com/exploits/50592",
+					Verified:      true,
+					Origin:        "test origin",
+					Collector:     "test collector",
+				},
+			},
+		},
+		{
+			Name:   "Query on verified",
+			InVuln: []*model.VulnerabilityInputSpec{testdata.C1, testdata.O1},
+			Calls: []call{
+				{Vuln: testdata.C1, Exploit: edbVerified},
+				{Vuln: testdata.O1, Exploit: poc},
+			},
+			Query: &model.ExploitReferenceSpec{
+				Verified: ptrfrom.Bool(false),
+			},
+			ExpExploit: []*model.ExploitReference{
+				{
+					Vulnerability: o1Vuln,
+					Source:        "GITHUB_POC",
+					URL:           "https://github.com/example/poc",
+					Origin:        "test origin",
+					Collector:     "test collector",
+				},
+			},
+		},
+		{
+			Name:   "Query on vulnerability",
+			InVuln: []*model.VulnerabilityInputSpec{testdata.C1, testdata.O1},
+			Calls: []call{
+				{Vuln: testdata.C1, Exploit: edb},
+				{Vuln: testdata.O1, Exploit: poc},
+			},
+			Query: &model.ExploitReferenceSpec{
+				Vulnerability: &model.VulnerabilitySpec{
+					Type:            ptrfrom.String("cve"),
+					VulnerabilityID: ptrfrom.String("cve-2019-13110"),
+				},
+			},
+			ExpExploit: []*model.ExploitReference{
+				{
+					Vulnerability: c1Vuln,
+					Source:        "EXPLOIT_DB",
+					URL:           "https://www.exploit-db.com/exploits/50592",
+					Origin:        "test origin",
+					Collector:     "test collector",
+				},
+			},
+		},
+		{
+			Name:    "Query on ID",
+			InVuln:  []*model.VulnerabilityInputSpec{testdata.O1},
+			Calls:   []call{{Vuln: testdata.O1, Exploit: poc}},
+			QueryID: true,
+			ExpExploit: []*model.ExploitReference{
+				{
+					Vulnerability: o1Vuln,
+					Source:        "GITHUB_POC",
+					URL:           "https://github.com/example/poc",
+					Origin:        "test origin",
+					Collector:     "test collector",
+				},
+			},
+		},
+		{
+			Name:         "Ingest without vulnerability",
+			Calls:        []call{{Vuln: testdata.G1, Exploit: edb}},
+			ExpIngestErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			for _, v := range test.InVuln {
+				if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: v}); err != nil {
+					t.Fatalf("Could not ingest vulnerability: %v", err)
+				}
+			}
+			for _, o := range test.Calls {
+				id, err := b.IngestExploitReference(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: o.Vuln}, *o.Exploit)
+				if (err != nil) != test.ExpIngestErr {
+					t.Fatalf("did not get expected ingest error, want: %v, got: %v", test.ExpIngestErr, err)
+				}
+				if err != nil {
+					return
+				}
+				if test.QueryID {
+					test.Query = &model.ExploitReferenceSpec{
+						ID: ptrfrom.String(id),
+					}
+				}
+			}
+			got, err := b.ExploitReferences(ctx, test.Query)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(test.ExpExploit, got, commonOpts); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package backend_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -838,6 +839,56 @@ func TestHasMetadata(t *testing.T) {
 	}
 }
 
+func TestIngestBulkHasMetadataIdempotent(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+
+	const count = 500
+	var pkgs []*model.IDorPkgInput
+	var hms []*model.HasMetadataInputSpec
+	for i := 0; i < count; i++ {
+		pkgs = append(pkgs, &model.IDorPkgInput{PackageInput: testdata.P1})
+		hms = append(hms, &model.HasMetadataInputSpec{
+			Key:       fmt.Sprintf("key-%d", i),
+			Value:     fmt.Sprintf("value-%d", i),
+			Timestamp: testdata.T1,
+			Origin:    "enrichment",
+		})
+	}
+	match := &model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+
+	ids, err := b.IngestBulkHasMetadata(ctx, model.PackageSourceOrArtifactInputs{Packages: pkgs}, match, hms)
+	if err != nil {
+		t.Fatalf("IngestBulkHasMetadata() error = %v", err)
+	}
+	if len(ids) != count {
+		t.Fatalf("IngestBulkHasMetadata() returned %d IDs, want %d", len(ids), count)
+	}
+	again, err := b.IngestBulkHasMetadata(ctx, model.PackageSourceOrArtifactInputs{Packages: pkgs}, match, hms)
+	if err != nil {
+		t.Fatalf("IngestBulkHasMetadata() error on re-ingestion = %v", err)
+	}
+	if diff := cmp.Diff(ids, again); diff != "" {
+		t.Errorf("Re-ingestion returned different IDs (-first +second):\n%s", diff)
+	}
+
+	got, err := b.HasMetadata(ctx, &model.HasMetadataSpec{Origin: ptrfrom.String("enrichment")})
+	if err != nil {
+		t.Fatalf("HasMetadata() error = %v", err)
+	}
+	if len(got) != count {
+		t.Errorf("HasMetadata() returned %d nodes, want %d", len(got), count)
+	}
+	for i, id := range []string{ids[0], ids[count-1]} {
+		if _, err := b.Node(ctx, id); err != nil {
+			t.Errorf("Node(%d) error = %v", i, err)
+		}
+	}
+}
+
 func TestIngestBulkHasMetadata(t *testing.T) {
 	ctx := context.Background()
 	b := setupTest(t)
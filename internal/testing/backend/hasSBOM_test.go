@@ -19,6 +19,8 @@ package backend_test
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -1003,6 +1005,57 @@ func TestHasSBOM(t *testing.T) {
 			Query: &model.HasSBOMSpec{IncludedSoftware: []*model.PackageOrArtifactSpec{{Package: &model.PkgSpec{Namespace: ptrfrom.String("invalid_namespace")}}}},
 			ExpHS: nil,
 		},
+		{
+			Name:   "IncludedPackages - Included Package Name",
+			InPkg:  includedPackages,
+			InArt:  includedArtifacts,
+			InSrc:  includedSources,
+			PkgArt: includedPackageArtifacts,
+			IsDeps: includedTestDependencies,
+			IsOccs: includedTestOccurrences,
+			Calls: []call{{
+				Sub: model.PackageOrArtifactInput{
+					Package: &model.IDorPkgInput{PackageInput: includedPackage1},
+				},
+				HS: includedHasSBOM,
+			}},
+			Query: &model.HasSBOMSpec{IncludedPackages: []*model.PkgSpec{{Name: &includedPackage2.Name}}},
+			ExpHS: []*model.HasSbom{includedTestExpectedSBOM},
+		},
+		{
+			Name:   "IncludedPackages - All Packages Included",
+			InPkg:  includedPackages,
+			InArt:  includedArtifacts,
+			InSrc:  includedSources,
+			PkgArt: includedPackageArtifacts,
+			IsDeps: includedTestDependencies,
+			IsOccs: includedTestOccurrences,
+			Calls: []call{{
+				Sub: model.PackageOrArtifactInput{
+					Package: &model.IDorPkgInput{PackageInput: includedPackage1},
+				},
+				HS: includedHasSBOM,
+			}},
+			Query: &model.HasSBOMSpec{IncludedPackages: []*model.PkgSpec{{Name: &includedPackage2.Name}, {Type: &includedPackage3.Type, Version: includedPackage3.Version}}},
+			ExpHS: []*model.HasSbom{includedTestExpectedSBOM},
+		},
+		{
+			Name:   "IncludedPackages - One Package Not Included",
+			InPkg:  includedPackages,
+			InArt:  includedArtifacts,
+			InSrc:  includedSources,
+			PkgArt: includedPackageArtifacts,
+			IsDeps: includedTestDependencies,
+			IsOccs: includedTestOccurrences,
+			Calls: []call{{
+				Sub: model.PackageOrArtifactInput{
+					Package: &model.IDorPkgInput{PackageInput: includedPackage1},
+				},
+				HS: includedHasSBOM,
+			}},
+			Query: &model.HasSBOMSpec{IncludedPackages: []*model.PkgSpec{{Name: &includedPackage2.Name}, {Name: ptrfrom.String("invalid_name")}}},
+			ExpHS: nil,
+		},
 		{
 			Name:   "IncludedSoftware - Valid Included Package Name",
 			InPkg:  includedPackages,
@@ -3157,3 +3210,243 @@ func TestIngestHasSBOMs(t *testing.T) {
 		})
 	}
 }
+
+func TestSBOMComponentBreakdown(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	webApp := &model.PkgInputSpec{
+		Type:          "npm",
+		Name:          "web-app",
+		Version:       ptrfrom.String("1.0.0"),
+		ComponentType: ptrfrom.String("application"),
+	}
+	bootstrap := &model.PkgInputSpec{
+		Type:          "npm",
+		Name:          "bootstrap",
+		Version:       ptrfrom.String("4.0.0-beta.2"),
+		ComponentType: ptrfrom.String("library"),
+	}
+	jquery := &model.PkgInputSpec{
+		Type:          "npm",
+		Name:          "jquery",
+		Version:       ptrfrom.String("3.3.1"),
+		ComponentType: ptrfrom.String("Library"),
+	}
+	static := &model.PkgInputSpec{
+		Type:          "oci",
+		Name:          "static",
+		Version:       ptrfrom.String("sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388"),
+		ComponentType: ptrfrom.String("container"),
+	}
+	leftPad := &model.PkgInputSpec{
+		Type:    "npm",
+		Name:    "left-pad",
+		Version: ptrfrom.String("1.3.0"),
+	}
+	bootstrapAsFramework := &model.PkgInputSpec{
+		Type:          "npm",
+		Name:          "bootstrap",
+		Version:       ptrfrom.String("4.0.0-beta.2"),
+		ComponentType: ptrfrom.String("framework"),
+	}
+	tests := []struct {
+		Name        string
+		Subject     *model.PkgInputSpec
+		InPkg       []*model.PkgInputSpec
+		InArt       []*model.ArtifactInputSpec
+		URI         string
+		QueryID     *string
+		ExpCounts   []*model.ComponentTypeCount
+		ExpQueryErr bool
+	}{
+		{
+			Name:    "Multiple component types",
+			Subject: webApp,
+			InPkg:   []*model.PkgInputSpec{webApp, bootstrap, jquery, static, leftPad},
+			InArt:   []*model.ArtifactInputSpec{testdata.A1},
+			URI:     "test uri one",
+			ExpCounts: []*model.ComponentTypeCount{
+				{ComponentType: "application", Count: 1},
+				{ComponentType: "container", Count: 1},
+				{ComponentType: "library", Count: 2},
+			},
+		},
+		{
+			Name:    "Component type is kept from first ingestion",
+			Subject: webApp,
+			InPkg:   []*model.PkgInputSpec{bootstrapAsFramework, jquery},
+			URI:     "test uri two",
+			ExpCounts: []*model.ComponentTypeCount{
+				{ComponentType: "library", Count: 2},
+			},
+		},
+		{
+			Name:      "No typed packages",
+			Subject:   leftPad,
+			InPkg:     []*model.PkgInputSpec{leftPad},
+			URI:       "test uri three",
+			ExpCounts: []*model.ComponentTypeCount{},
+		},
+		{
+			Name:        "Unknown SBOM",
+			Subject:     webApp,
+			URI:         "test uri four",
+			QueryID:     ptrfrom.String("123456"),
+			ExpQueryErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			includes := model.HasSBOMIncludesInputSpec{}
+			for _, p := range test.InPkg {
+				pkgIDs, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p})
+				if err != nil {
+					t.Fatalf("Could not ingest package: %v", err)
+				}
+				includes.Packages = append(includes.Packages, pkgIDs.PackageVersionID)
+			}
+			for _, a := range test.InArt {
+				artID, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: a})
+				if err != nil {
+					t.Fatalf("Could not ingest artifact: %v", err)
+				}
+				includes.Artifacts = append(includes.Artifacts, artID)
+			}
+			subject := model.PackageOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: test.Subject}}
+			sbomID, err := b.IngestHasSbom(ctx, subject, model.HasSBOMInputSpec{URI: test.URI}, includes)
+			if err != nil {
+				t.Fatalf("Could not ingest HasSBOM: %v", err)
+			}
+			if test.QueryID != nil {
+				sbomID = *test.QueryID
+			}
+			got, err := b.SBOMComponentBreakdown(ctx, sbomID)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(test.ExpCounts, got, commonOpts); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIngestHasSBOMsBulk(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+
+	const numSBOMs = 1000
+	var subjects model.PackageOrArtifactInputs
+	var hasSBOMs []*model.HasSBOMInputSpec
+	var includes []*model.HasSBOMIncludesInputSpec
+	for i := 0; i < numSBOMs; i++ {
+		subjects.Packages = append(subjects.Packages, &model.IDorPkgInput{PackageInput: testdata.P1})
+		hasSBOMs = append(hasSBOMs, &model.HasSBOMInputSpec{
+			URI:       fmt.Sprintf("https://example.com/sbom/%d", i),
+			Algorithm: "sha256",
+			Digest:    fmt.Sprintf("%064d", i),
+		})
+		includes = append(includes, &model.HasSBOMIncludesInputSpec{})
+	}
+
+	ids, err := b.IngestHasSBOMs(ctx, subjects, hasSBOMs, includes)
+	if err != nil {
+		t.Fatalf("IngestHasSBOMs() error = %v", err)
+	}
+	if len(ids) != numSBOMs {
+		t.Fatalf("IngestHasSBOMs() returned %d ids, want %d", len(ids), numSBOMs)
+	}
+
+	got, err := b.HasSBOM(ctx, &model.HasSBOMSpec{})
+	if err != nil {
+		t.Fatalf("HasSBOM() error = %v", err)
+	}
+	if len(got) != numSBOMs {
+		t.Errorf("HasSBOM() returned %d nodes, want %d", len(got), numSBOMs)
+	}
+
+	// ingesting the same nodes again returns the same IDs and creates no new nodes
+	again, err := b.IngestHasSBOMs(ctx, subjects, hasSBOMs, includes)
+	if err != nil {
+		t.Fatalf("IngestHasSBOMs() second ingestion error = %v", err)
+	}
+	if diff := cmp.Diff(ids, again); diff != "" {
+		t.Errorf("Unexpected ids on second ingestion (-want +got):\n%s", diff)
+	}
+	got, err = b.HasSBOM(ctx, &model.HasSBOMSpec{})
+	if err != nil {
+		t.Fatalf("HasSBOM() error = %v", err)
+	}
+	if len(got) != numSBOMs {
+		t.Errorf("HasSBOM() after second ingestion returned %d nodes, want %d", len(got), numSBOMs)
+	}
+}
+
+func TestHasSBOMIncludedDependenciesNeighbors(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P2}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	includes := model.HasSBOMIncludesInputSpec{}
+	for _, pkg := range includedIDorPackages {
+		pkgIDs, err := b.IngestPackage(ctx, *pkg)
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		includes.Packages = append(includes.Packages, pkgIDs.PackageVersionID)
+	}
+	var wantDeps []string
+	for _, dep := range includedTestDependencies {
+		depID, err := b.IngestDependency(ctx, model.IDorPkgInput{PackageInput: dep.pkg}, model.IDorPkgInput{PackageInput: dep.depPkg}, dep.matchType, *dep.isDep)
+		if err != nil {
+			t.Fatalf("Could not ingest dependency: %v", err)
+		}
+		includes.Dependencies = append(includes.Dependencies, depID)
+		wantDeps = append(wantDeps, depID)
+	}
+	hsID, err := b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: testdata.P2}}, model.HasSBOMInputSpec{
+		DownloadLocation: "location two",
+	}, includes)
+	if err != nil {
+		t.Fatalf("Could not ingest hasSBOM: %v", err)
+	}
+
+	// only the included dependencies are reached through the edge, not the
+	// included packages or the subject
+	neighbors, err := b.Neighbors(ctx, hsID, []model.Edge{model.EdgeHasSbomIncludedDependencies})
+	if err != nil {
+		t.Fatalf("Neighbors() error = %v", err)
+	}
+	var gotDeps []string
+	for _, n := range neighbors {
+		dep, ok := n.(*model.IsDependency)
+		if !ok {
+			t.Errorf("Neighbors() returned %T, want only IsDependency nodes", n)
+			continue
+		}
+		gotDeps = append(gotDeps, dep.ID)
+	}
+	sort.Strings(wantDeps)
+	sort.Strings(gotDeps)
+	if diff := cmp.Diff(wantDeps, gotDeps); diff != "" {
+		t.Errorf("Unexpected dependencies. (-want +got):\n%s", diff)
+	}
+
+	// without the edge, the dependencies are not neighbors
+	neighbors, err = b.Neighbors(ctx, hsID, []model.Edge{model.EdgeHasSbomPackage})
+	if err != nil {
+		t.Fatalf("Neighbors() error = %v", err)
+	}
+	for _, n := range neighbors {
+		if _, ok := n.(*model.IsDependency); ok {
+			t.Errorf("Neighbors() returned dependency %v without the includedDependencies edge", n)
+		}
+	}
+}
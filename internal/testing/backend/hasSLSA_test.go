@@ -19,6 +19,7 @@ package backend_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -913,3 +914,78 @@ func TestIngestHasSLSAs(t *testing.T) {
 		})
 	}
 }
+
+func TestIngestHasSLSAsBulk(t *testing.T) {
+	const numSLSAs = 200
+	ctx := context.Background()
+	b := setupTest(t)
+
+	var subjects []*model.IDorArtifactInput
+	var builtFromList [][]*model.IDorArtifactInput
+	var builtByList []*model.IDorBuilderInput
+	var slsaList []*model.SLSAInputSpec
+	for i := 0; i < numSLSAs; i++ {
+		subjects = append(subjects, &model.IDorArtifactInput{ArtifactInput: &model.ArtifactInputSpec{
+			Algorithm: "sha256",
+			Digest:    fmt.Sprintf("%064x", i),
+		}})
+		builtFromList = append(builtFromList, []*model.IDorArtifactInput{{ArtifactInput: testdata.A2}})
+		builtByList = append(builtByList, &model.IDorBuilderInput{BuilderInput: testdata.B1})
+		slsaList = append(slsaList, &model.SLSAInputSpec{
+			BuildType:   "test type",
+			SlsaVersion: "v1",
+			DocumentRef: fmt.Sprintf("provenance-%d", i),
+		})
+	}
+	if _, err := b.IngestArtifacts(ctx, append([]*model.IDorArtifactInput{{ArtifactInput: testdata.A2}}, subjects...)); err != nil {
+		t.Fatalf("Could not ingest artifacts: %v", err)
+	}
+	if _, err := b.IngestBuilder(ctx, &model.IDorBuilderInput{BuilderInput: testdata.B1}); err != nil {
+		t.Fatalf("Could not ingest builder: %v", err)
+	}
+
+	ids, err := b.IngestSLSAs(ctx, subjects, builtFromList, builtByList, slsaList)
+	if err != nil {
+		t.Fatalf("IngestSLSAs() error = %v", err)
+	}
+	if len(ids) != numSLSAs {
+		t.Fatalf("IngestSLSAs() returned %d IDs, want %d", len(ids), numSLSAs)
+	}
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			t.Fatalf("IngestSLSAs() returned empty or duplicate ID %q", id)
+		}
+		seen[id] = true
+	}
+
+	// ingesting again returns the existing nodes
+	again, err := b.IngestSLSAs(ctx, subjects, builtFromList, builtByList, slsaList)
+	if err != nil {
+		t.Fatalf("IngestSLSAs() error = %v", err)
+	}
+	if diff := cmp.Diff(ids, again); diff != "" {
+		t.Errorf("Unexpected IDs on re-ingestion. (-want +got):\n%s", diff)
+	}
+
+	got, err := b.HasSlsa(ctx, &model.HasSLSASpec{BuildType: ptrfrom.String("test type")})
+	if err != nil {
+		t.Fatalf("HasSlsa() error = %v", err)
+	}
+	if len(got) != numSLSAs {
+		t.Fatalf("HasSlsa() returned %d nodes, want %d", len(got), numSLSAs)
+	}
+	// the IDs are in the order of the input
+	for _, hs := range got {
+		var i int
+		if _, err := fmt.Sscanf(hs.Slsa.DocumentRef, "provenance-%d", &i); err != nil {
+			t.Fatalf("unexpected document ref %q", hs.Slsa.DocumentRef)
+		}
+		if hs.ID != ids[i] {
+			t.Errorf("HasSLSA %s got ID %s, want %s", hs.Slsa.DocumentRef, hs.ID, ids[i])
+		}
+		if hs.Subject.Digest != subjects[i].ArtifactInput.Digest {
+			t.Errorf("HasSLSA %s got subject %s, want %s", hs.Slsa.DocumentRef, hs.Subject.Digest, subjects[i].ArtifactInput.Digest)
+		}
+	}
+}
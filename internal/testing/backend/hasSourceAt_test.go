@@ -918,3 +918,136 @@ func TestIngestHasSourceAts(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateHasSourceAt(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: testdata.S1}); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	match := model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	wrong := model.HasSourceAtInputSpec{
+		KnownSince:    testdata.T1,
+		Justification: "test justification",
+	}
+	other := model.HasSourceAtInputSpec{
+		KnownSince:    testdata.T1,
+		Justification: "other justification",
+	}
+	wrongID, err := b.IngestHasSourceAt(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, match, model.IDorSourceInput{SourceInput: testdata.S1}, wrong)
+	if err != nil {
+		t.Fatalf("Could not ingest hasSourceAt: %v", err)
+	}
+	if _, err := b.IngestHasSourceAt(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, match, model.IDorSourceInput{SourceInput: testdata.S1}, other); err != nil {
+		t.Fatalf("Could not ingest hasSourceAt: %v", err)
+	}
+
+	corrected := model.HasSourceAtInputSpec{
+		KnownSince:    testdata.T2,
+		Justification: "test justification",
+	}
+	expHSA := &model.HasSourceAt{
+		Package:       testdata.P1out,
+		Source:        testdata.S1out,
+		KnownSince:    testdata.T2,
+		Justification: "test justification",
+	}
+
+	got, err := b.UpdateHasSourceAt(ctx, wrongID, corrected)
+	if err != nil {
+		t.Fatalf("UpdateHasSourceAt() error = %v", err)
+	}
+	if diff := cmp.Diff(expHSA, got, commonOpts); diff != "" {
+		t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+	}
+
+	// the node keeps its ID and is no longer found by the old timestamp
+	gotByID, err := b.HasSourceAt(ctx, &model.HasSourceAtSpec{ID: &wrongID})
+	if err != nil {
+		t.Fatalf("HasSourceAt() error = %v", err)
+	}
+	if diff := cmp.Diff([]*model.HasSourceAt{expHSA}, gotByID, commonOpts); diff != "" {
+		t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+	}
+	gotOld, err := b.HasSourceAt(ctx, &model.HasSourceAtSpec{
+		KnownSince:    &testdata.T1,
+		Justification: ptrfrom.String("test justification"),
+	})
+	if err != nil {
+		t.Fatalf("HasSourceAt() error = %v", err)
+	}
+	if len(gotOld) != 0 {
+		t.Errorf("expected no hasSourceAt with the old timestamp, got %d", len(gotOld))
+	}
+	if count, err := b.HasSourceAtCount(ctx, model.HasSourceAtSpec{}); err != nil || count != 2 {
+		t.Errorf("HasSourceAtCount() = %v, %v, want 2", count, err)
+	}
+	if count, err := b.HasSourceAtCount(ctx, model.HasSourceAtSpec{KnownSince: &testdata.T2}); err != nil || count != 1 {
+		t.Errorf("HasSourceAtCount() for updated timestamp = %v, %v, want 1", count, err)
+	}
+
+	// updating into the fields of another hasSourceAt for the same package and source fails
+	if _, err := b.UpdateHasSourceAt(ctx, wrongID, other); err == nil {
+		t.Errorf("expected error when the update conflicts with an existing hasSourceAt")
+	}
+
+	// unknown ID
+	if _, err := b.UpdateHasSourceAt(ctx, "999999", corrected); err == nil {
+		t.Errorf("expected error when updating an unknown hasSourceAt")
+	}
+}
+
+func TestHasSourceAtOrder(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: testdata.S1}); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	match := model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	for _, hsa := range []model.HasSourceAtInputSpec{
+		{KnownSince: testdata.T1, Justification: "b"},
+		{KnownSince: testdata.T2, Justification: "a"},
+	} {
+		if _, err := b.IngestHasSourceAt(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, match, model.IDorSourceInput{SourceInput: testdata.S1}, hsa); err != nil {
+			t.Fatalf("Could not ingest hasSourceAt: %v", err)
+		}
+	}
+	tests := []struct {
+		name  string
+		order *model.HasSourceAtOrder
+		want  []string
+	}{{
+		name:  "known since descending",
+		order: &model.HasSourceAtOrder{SortBy: model.HasSourceAtOrderFieldKnownSince, SortOrder: model.OrderDirectionDesc},
+		want:  []string{"b", "a"},
+	}, {
+		name:  "justification ascending",
+		order: &model.HasSourceAtOrder{SortBy: model.HasSourceAtOrderFieldJustification, SortOrder: model.OrderDirectionAsc},
+		want:  []string{"a", "b"},
+	}, {
+		name:  "known since ascending",
+		order: &model.HasSourceAtOrder{SortBy: model.HasSourceAtOrderFieldKnownSince, SortOrder: model.OrderDirectionAsc},
+		want:  []string{"a", "b"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.HasSourceAt(ctx, &model.HasSourceAtSpec{Order: tt.order})
+			if err != nil {
+				t.Fatalf("HasSourceAt() error = %v", err)
+			}
+			var justifications []string
+			for _, hsa := range got {
+				justifications = append(justifications, hsa.Justification)
+			}
+			if diff := cmp.Diff(tt.want, justifications); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
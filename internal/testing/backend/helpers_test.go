@@ -451,6 +451,9 @@ func lessIsDep(a, b *model.IsDependency) bool {
 	if d := strings.Compare(a.Collector, b.Collector); d != 0 {
 		return d < 0
 	}
+	if d := strings.Compare(a.DocumentRef, b.DocumentRef); d != 0 {
+		return d < 0
+	}
 	return depTypeCmp(a.DependencyType, b.DependencyType)
 }
 
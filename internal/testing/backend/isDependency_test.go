@@ -838,6 +838,46 @@ func TestIsDependency(t *testing.T) {
 					DocumentRef:       "test",
 				},
 			},
+		}, {
+			Name:  "different docrefs are separate nodes",
+			InPkg: []*model.PkgInputSpec{testdata.P1, testdata.P2},
+			Calls: []call{
+				{
+					P1: testdata.P1,
+					P2: testdata.P2,
+					MF: mAll,
+					ID: &model.IsDependencyInputSpec{
+						Justification: "from two SBOMs",
+						DocumentRef:   "sbom-1",
+					},
+				},
+				{
+					P1: testdata.P1,
+					P2: testdata.P2,
+					MF: mAll,
+					ID: &model.IsDependencyInputSpec{
+						Justification: "from two SBOMs",
+						DocumentRef:   "sbom-2",
+					},
+				},
+			},
+			Query: &model.IsDependencySpec{
+				Justification: ptrfrom.String("from two SBOMs"),
+			},
+			ExpID: []*model.IsDependency{
+				{
+					Package:           testdata.P1out,
+					DependencyPackage: testdata.P2outName,
+					Justification:     "from two SBOMs",
+					DocumentRef:       "sbom-1",
+				},
+				{
+					Package:           testdata.P1out,
+					DependencyPackage: testdata.P2outName,
+					Justification:     "from two SBOMs",
+					DocumentRef:       "sbom-2",
+				},
+			},
 		},
 	}
 	for _, test := range tests {
@@ -43,9 +43,7 @@ var skipMatrix = map[string]map[string]bool{
 	"TestIsDependency": {memmap: true, redis: true, tikv: true},
 	// arango errors when ID is not found
 	"TestOccurrence": {arango: true},
-	// keyvalue: path: input: No path found up to specified length
 	// neighbors: sorting not done, testdata is only in order for arango
-	"TestPath":      {memmap: true, redis: true, tikv: true},
 	"TestNeighbors": {memmap: true, redis: true, tikv: true},
 	// keyvalue: query on both packages fail
 	"TestPkgEqual": {memmap: true, redis: true, tikv: true},
@@ -59,6 +57,28 @@ var skipMatrix = map[string]map[string]bool{
 	// redis order issues
 	"TestVEXBulkIngest": {redis: true},
 	"TestFindSoftware":  {redis: true, arango: true},
+	// arango: the operations in pkg/assembler/backends/arangodb/unimplemented.go
+	// are not implemented
+	"TestArchiveCertifyVulns":           {arango: true},
+	"TestBatchNodes":                    {arango: true},
+	"TestCertifyVulnAdded":              {arango: true},
+	"TestCertifyVulnByPackageIDs":       {arango: true},
+	"TestCertifyVulnByVulnerabilityIDs": {arango: true},
+	"TestCertifyVulnCVSSRange":          {arango: true},
+	"TestDeleteCertifyVuln":             {arango: true},
+	"TestDeleteSource":                  {arango: true},
+	"TestExploitReferences":             {arango: true},
+	"TestGetProvenance":                 {arango: true},
+	"TestMarkStaleVulns":                {arango: true},
+	"TestMergePackageNames":             {arango: true},
+	"TestMergeArchivedCertifyVuln":      {arango: true},
+	"TestMergePackages":                 {arango: true},
+	"TestSBOMComponentBreakdown":        {arango: true},
+	"TestTopVulnerablePackages":         {arango: true},
+	"TestUpdateCertifyScorecard":        {arango: true},
+	"TestUpdateCertifyVulnResolution":   {arango: true},
+	"TestUpdateHasSourceAt":             {arango: true},
+	"TestUpdatePointOfContact":          {arango: true},
 }
 
 type backend interface {
@@ -19,11 +19,14 @@ package backend_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -80,7 +83,8 @@ func TestPath(t *testing.T) {
 						Type:             "ghsa",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 				&model.Vulnerability{
 					Type:             "ghsa",
@@ -114,7 +118,8 @@ func TestPath(t *testing.T) {
 						Type:             "ghsa",
 						VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
 					},
-					Metadata: vmd1,
+					Metadata:          vmd1,
+					RemediationStatus: model.RemediationStatusOpen,
 				},
 				&model.Vulnerability{
 					Type:             "ghsa",
@@ -240,6 +245,42 @@ func TestPath(t *testing.T) {
 	}
 }
 
+func TestPathMaxLength(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	pkgIDs, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P2})
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	vulnIDs, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.G1})
+	if err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: testdata.P2}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.G1},
+		model.ScanMetadataInput{TimeScanned: testdata.T1}); err != nil {
+		t.Fatalf("Could not ingest certifyVuln: %v", err)
+	}
+
+	// the package is two hops away from the vulnerability, through the certifyVuln
+	got, err := b.Path(ctx, pkgIDs.PackageVersionID, vulnIDs.VulnerabilityNodeID, 1, nil)
+	if err != nil {
+		t.Fatalf("Path() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Path() with maxPathLength 1 = %v, want no path", got)
+	}
+	got, err = b.Path(ctx, pkgIDs.PackageVersionID, vulnIDs.VulnerabilityNodeID, 2, nil)
+	if err != nil {
+		t.Fatalf("Path() error = %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("Path() with maxPathLength 2 returned %d nodes, want 3", len(got))
+	}
+	if _, ok := got[1].(*model.CertifyVuln); !ok {
+		t.Errorf("Path() middle node is %T, want *model.CertifyVuln", got[1])
+	}
+}
+
 func TestNodes(t *testing.T) {
 	ctx := context.Background()
 	b := setupTest(t)
@@ -519,7 +560,8 @@ func TestNodes(t *testing.T) {
 				Type:             "ghsa",
 				VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
 			},
-			Metadata: vmd1,
+			Metadata:          vmd1,
+			RemediationStatus: model.RemediationStatusOpen,
 		}},
 	}, {
 		name:  "hashEqual",
@@ -1154,6 +1196,25 @@ func TestNeighbors(t *testing.T) {
 				Namespaces: []*model.PackageNamespace{},
 			}},
 		wantErr: false,
+	}, {
+		name:                "package - pkgNamespace with namespace",
+		pkgInput:            testdata.P4,
+		queryPkgNamespaceID: true,
+		want: []model.Node{
+			&model.Package{
+				Type: "conan",
+				Namespaces: []*model.PackageNamespace{{
+					Namespace: "openssl.org",
+					Names: []*model.PackageName{{
+						Name:     "openssl",
+						Versions: []*model.PackageVersion{},
+					}},
+				}}},
+			&model.Package{
+				Type:       "conan",
+				Namespaces: []*model.PackageNamespace{},
+			}},
+		wantErr: false,
 	}, {
 		name:           "package - pkgName",
 		pkgInput:       testdata.P1,
@@ -1935,7 +1996,8 @@ func TestNeighbors(t *testing.T) {
 					Type:             "ghsa",
 					VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
 				},
-				Metadata: vmd1,
+				Metadata:          vmd1,
+				RemediationStatus: model.RemediationStatusOpen,
 			}},
 	}, {
 		name:   "certifyVuln - vulnID",
@@ -1966,7 +2028,8 @@ func TestNeighbors(t *testing.T) {
 					Type:             "ghsa",
 					VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
 				},
-				Metadata: vmd1,
+				Metadata:          vmd1,
+				RemediationStatus: model.RemediationStatusOpen,
 			}},
 	}, {
 		name:   "certifyVuln - certifyVulnID -  pkgVersion",
@@ -3690,3 +3753,227 @@ func TestNeighbors(t *testing.T) {
 		})
 	}
 }
+
+func TestNeighborsRecursive(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	pkgIDs := map[*model.PkgInputSpec]string{}
+	for _, p := range []*model.PkgInputSpec{testdata.P2, testdata.P4} {
+		ids, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p})
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		pkgIDs[p] = ids.PackageVersionID
+	}
+	artID, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A1})
+	if err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	depID, err := b.IngestDependency(ctx, model.IDorPkgInput{PackageInput: testdata.P2}, model.IDorPkgInput{PackageInput: testdata.P4},
+		model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, model.IsDependencyInputSpec{Justification: "test"})
+	if err != nil {
+		t.Fatalf("Could not ingest dependency: %v", err)
+	}
+	occID, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: &model.IDorPkgInput{PackageInput: testdata.P4}},
+		model.IDorArtifactInput{ArtifactInput: testdata.A1}, model.IsOccurrenceInputSpec{Justification: "test"})
+	if err != nil {
+		t.Fatalf("Could not ingest occurrence: %v", err)
+	}
+
+	edges := []model.Edge{
+		model.EdgePackageIsDependency,
+		model.EdgeIsDependencyPackage,
+		model.EdgePackageIsOccurrence,
+		model.EdgeIsOccurrenceArtifact,
+	}
+	tests := []struct {
+		name     string
+		node     string
+		edges    []model.Edge
+		maxDepth int
+		want     []string
+	}{{
+		name:     "one hop",
+		node:     pkgIDs[testdata.P2],
+		edges:    edges,
+		maxDepth: 1,
+		want:     []string{depID},
+	}, {
+		name:     "two hops",
+		node:     pkgIDs[testdata.P2],
+		edges:    edges,
+		maxDepth: 2,
+		want:     []string{depID, pkgIDs[testdata.P4]},
+	}, {
+		name:     "whole chain",
+		node:     pkgIDs[testdata.P2],
+		edges:    edges,
+		maxDepth: 10,
+		want:     []string{depID, pkgIDs[testdata.P4], occID, artID},
+	}, {
+		name:     "restricted edges",
+		node:     pkgIDs[testdata.P2],
+		edges:    []model.Edge{model.EdgePackageIsDependency, model.EdgeIsDependencyPackage},
+		maxDepth: 10,
+		want:     []string{depID, pkgIDs[testdata.P4]},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes, err := b.NeighborsRecursive(ctx, tt.node, tt.edges, tt.maxDepth)
+			if err != nil {
+				t.Fatalf("NeighborsRecursive() error = %v", err)
+			}
+			var got []string
+			for _, n := range nodes {
+				id, err := helper.NodeID(n)
+				if err != nil {
+					t.Fatalf("NodeID() error = %v", err)
+				}
+				got = append(got, id)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBatchNodes(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+
+	pkgIDs, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1})
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	artID, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A1})
+	if err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	srcIDs, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: testdata.S1})
+	if err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	builderID, err := b.IngestBuilder(ctx, &model.IDorBuilderInput{BuilderInput: testdata.B1})
+	if err != nil {
+		t.Fatalf("Could not ingest builder: %v", err)
+	}
+	// an ID of a known type that does not match any node
+	missingID := "999999"
+	if i := strings.LastIndex(artID, ":"); i >= 0 {
+		missingID = artID[:i+1] + uuid.NewString()
+	}
+
+	tests := []struct {
+		name string
+		ids  []string
+		want []model.Node
+	}{{
+		name: "empty",
+		ids:  []string{},
+		want: []model.Node{},
+	}, {
+		name: "mixed types keep input order",
+		ids:  []string{srcIDs.SourceNameID, pkgIDs.PackageVersionID, builderID, artID},
+		want: []model.Node{testdata.S1out, testdata.P1out, testdata.B1out, testdata.A1out},
+	}, {
+		name: "missing ID returns null in place",
+		ids:  []string{artID, missingID, pkgIDs.PackageVersionID},
+		want: []model.Node{testdata.A1out, nil, testdata.P1out},
+	}, {
+		name: "duplicate IDs",
+		ids:  []string{builderID, builderID},
+		want: []model.Node{testdata.B1out, testdata.B1out},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.BatchNodes(ctx, tt.ids)
+			if err != nil {
+				t.Fatalf("BatchNodes() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, commonOpts); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNodeType(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+
+	pkgIDs, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1})
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	srcIDs, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: testdata.S1})
+	if err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	vulnIDs, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1})
+	if err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	artID, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A1})
+	if err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	cvID, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, model.ScanMetadataInput{TimeScanned: testdata.T1})
+	if err != nil {
+		t.Fatalf("Could not ingest certifyVuln: %v", err)
+	}
+	occID, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: &model.IDorPkgInput{PackageInput: testdata.P1}}, model.IDorArtifactInput{ArtifactInput: testdata.A1}, model.IsOccurrenceInputSpec{Justification: "test justification"})
+	if err != nil {
+		t.Fatalf("Could not ingest occurrence: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		want    string
+		wantErr bool
+	}{{
+		name: "package version",
+		id:   pkgIDs.PackageVersionID,
+		want: "Package",
+	}, {
+		name: "package name",
+		id:   pkgIDs.PackageNameID,
+		want: "Package",
+	}, {
+		name: "source",
+		id:   srcIDs.SourceNameID,
+		want: "Source",
+	}, {
+		name: "vulnerability",
+		id:   vulnIDs.VulnerabilityNodeID,
+		want: "Vulnerability",
+	}, {
+		name: "artifact",
+		id:   artID,
+		want: "Artifact",
+	}, {
+		name: "certifyVuln",
+		id:   cvID,
+		want: "CertifyVuln",
+	}, {
+		name: "isOccurrence",
+		id:   occID,
+		want: "IsOccurrence",
+	}, {
+		name:    "malformed ID",
+		id:      "not-an-id",
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.NodeType(ctx, tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NodeType() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NodeType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -19,11 +19,15 @@ package backend_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -163,3 +167,407 @@ func TestIngestPackages(t *testing.T) {
 		})
 	}
 }
+
+func TestPackagesList(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	for _, p := range []*model.PkgInputSpec{testdata.P2, testdata.P4, testdata.P5} {
+		if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p}); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+
+	// walk all package versions two at a time
+	var after *string
+	seen := map[string]bool{}
+	for page := 0; ; page++ {
+		got, err := b.PackagesList(ctx, model.PkgSpec{}, &model.PaginationSpec{First: ptrfrom.Int(2), After: after})
+		if err != nil {
+			t.Fatalf("PackagesList() error = %v", err)
+		}
+		if got.TotalCount != 3 {
+			t.Errorf("PackagesList() totalCount = %v, want 3", got.TotalCount)
+		}
+		if got.PageInfo.HasPreviousPage != (page > 0) {
+			t.Errorf("PackagesList() page %v hasPreviousPage = %v", page, got.PageInfo.HasPreviousPage)
+		}
+		for _, edge := range got.Edges {
+			if seen[edge.Cursor] {
+				t.Errorf("PackagesList() returned cursor %v twice", edge.Cursor)
+			}
+			seen[edge.Cursor] = true
+			if versions := edge.Node.Namespaces[0].Names[0].Versions; len(versions) != 1 {
+				t.Errorf("PackagesList() node has %v versions, want 1", len(versions))
+			}
+		}
+		if !got.PageInfo.HasNextPage {
+			if len(got.Edges) != 1 {
+				t.Errorf("PackagesList() last page has %v edges, want 1", len(got.Edges))
+			}
+			break
+		}
+		if len(got.Edges) != 2 {
+			t.Fatalf("PackagesList() page %v has %v edges, want 2", page, len(got.Edges))
+		}
+		if diff := cmp.Diff(&got.Edges[1].Cursor, got.PageInfo.EndCursor); diff != "" {
+			t.Errorf("Unexpected end cursor (-want +got):\n%s", diff)
+		}
+		after = got.PageInfo.EndCursor
+	}
+	if len(seen) != 3 {
+		t.Errorf("PackagesList() returned %v package versions, want 3", len(seen))
+	}
+
+	got, err := b.PackagesList(ctx, model.PkgSpec{Name: ptrfrom.String("openssl")}, &model.PaginationSpec{Last: ptrfrom.Int(1)})
+	if err != nil {
+		t.Fatalf("PackagesList() error = %v", err)
+	}
+	if got.TotalCount != 2 || len(got.Edges) != 1 || !got.PageInfo.HasPreviousPage || got.PageInfo.HasNextPage {
+		t.Errorf("PackagesList() with last = 1 got totalCount %v, %v edges, pageInfo %+v",
+			got.TotalCount, len(got.Edges), got.PageInfo)
+	}
+
+	if _, err := b.PackagesList(ctx, model.PkgSpec{}, &model.PaginationSpec{First: ptrfrom.Int(1), Last: ptrfrom.Int(1)}); err == nil {
+		t.Errorf("PackagesList() with first and last did not return an error")
+	}
+
+	// the count queries agree with totalCount
+	if count, err := b.PackagesCount(ctx, model.PkgSpec{}); err != nil || count != 3 {
+		t.Errorf("PackagesCount() = %v, %v, want 3", count, err)
+	}
+	if count, err := b.PackagesCount(ctx, model.PkgSpec{Name: ptrfrom.String("openssl")}); err != nil || count != 2 {
+		t.Errorf("PackagesCount() for openssl = %v, %v, want 2", count, err)
+	}
+}
+
+func TestPackagesOrder(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	for _, p := range []*model.PkgInputSpec{testdata.P4, testdata.P2, testdata.P1} {
+		if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p}); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	tests := []struct {
+		name  string
+		order *model.PackageOrder
+		want  []string
+	}{{
+		name:  "name ascending",
+		order: &model.PackageOrder{SortBy: model.PackageOrderFieldName, SortOrder: model.OrderDirectionAsc},
+		want:  []string{"openssl", "tensorflow", "tensorflow"},
+	}, {
+		name:  "type descending",
+		order: &model.PackageOrder{SortBy: model.PackageOrderFieldType, SortOrder: model.OrderDirectionDesc},
+		want:  []string{"tensorflow", "tensorflow", "openssl"},
+	}, {
+		name:  "version ascending",
+		order: &model.PackageOrder{SortBy: model.PackageOrderFieldVersion, SortOrder: model.OrderDirectionAsc},
+		want:  []string{"tensorflow", "tensorflow", "openssl"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.Packages(ctx, &model.PkgSpec{Order: tt.order})
+			if err != nil {
+				t.Fatalf("Packages() error = %v", err)
+			}
+			var names []string
+			for _, p := range got {
+				for _, ns := range p.Namespaces {
+					for _, n := range ns.Names {
+						for range n.Versions {
+							names = append(names, n.Name)
+						}
+					}
+				}
+			}
+			if diff := cmp.Diff(tt.want, names); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// evidenceCounts counts the neighbors of id by their type
+func evidenceCounts(ctx context.Context, t *testing.T, b backends.Backend, id string, edges []model.Edge) map[string]int {
+	t.Helper()
+	neighbors, err := b.Neighbors(ctx, id, edges)
+	if err != nil {
+		t.Fatalf("Neighbors(%q) error = %v", id, err)
+	}
+	counts := map[string]int{}
+	for _, n := range neighbors {
+		counts[fmt.Sprintf("%T", n)]++
+	}
+	return counts
+}
+
+func TestMergePackages(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	primary := &model.PkgInputSpec{Type: "npm", Namespace: ptrfrom.String("acme"), Name: "widget", Version: ptrfrom.String("1.0.0")}
+	duplicate := &model.PkgInputSpec{Type: "npm", Namespace: ptrfrom.String("acme"), Name: "Widget", Version: ptrfrom.String("1.0.0")}
+	var ids []*model.PackageIDs
+	for _, p := range []*model.PkgInputSpec{primary, duplicate, testdata.P1} {
+		id, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p})
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	primaryID, duplicateID := ids[0].PackageVersionID, ids[1].PackageVersionID
+	for _, v := range []*model.VulnerabilityInputSpec{testdata.C1, testdata.C2} {
+		if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: v}); err != nil {
+			t.Fatalf("Could not ingest vulnerability: %v", err)
+		}
+	}
+	if _, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A1}); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+
+	scan := model.ScanMetadataInput{TimeScanned: testdata.T1}
+	if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: primary}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, scan); err != nil {
+		t.Fatalf("Could not ingest certifyVuln: %v", err)
+	}
+	if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: duplicate}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C2}, scan); err != nil {
+		t.Fatalf("Could not ingest certifyVuln: %v", err)
+	}
+	if _, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: &model.IDorPkgInput{PackageInput: duplicate}}, model.IDorArtifactInput{ArtifactInput: testdata.A1}, model.IsOccurrenceInputSpec{Justification: "test justification"}); err != nil {
+		t.Fatalf("Could not ingest occurrence: %v", err)
+	}
+	if _, err := b.IngestPkgEqual(ctx, model.IDorPkgInput{PackageInput: primary}, model.IDorPkgInput{PackageInput: duplicate}, model.PkgEqualInputSpec{Justification: "test justification"}); err != nil {
+		t.Fatalf("Could not ingest pkgEqual: %v", err)
+	}
+	if _, err := b.IngestDependency(ctx, model.IDorPkgInput{PackageInput: duplicate}, model.IDorPkgInput{PackageInput: testdata.P1}, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, model.IsDependencyInputSpec{Justification: "test justification"}); err != nil {
+		t.Fatalf("Could not ingest dependency: %v", err)
+	}
+	if _, err := b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Artifact: &model.IDorArtifactInput{ArtifactInput: testdata.A1}}, model.HasSBOMInputSpec{URI: "test uri"}, model.HasSBOMIncludesInputSpec{Packages: []string{duplicateID}}); err != nil {
+		t.Fatalf("Could not ingest hasSBOM: %v", err)
+	}
+
+	edges := []model.Edge{model.EdgePackageCertifyVuln, model.EdgePackageIsOccurrence, model.EdgePackagePkgEqual, model.EdgePackageIsDependency}
+	if diff := cmp.Diff(map[string]int{"*model.CertifyVuln": 1, "*model.PkgEqual": 1}, evidenceCounts(ctx, t, b, primaryID, edges)); diff != "" {
+		t.Errorf("primary evidence before merge (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]int{"*model.CertifyVuln": 1, "*model.IsOccurrence": 1, "*model.PkgEqual": 1, "*model.IsDependency": 1}, evidenceCounts(ctx, t, b, duplicateID, edges)); diff != "" {
+		t.Errorf("duplicate evidence before merge (-want +got):\n%s", diff)
+	}
+
+	if _, err := b.MergePackages(ctx, primaryID, []string{primaryID}); err == nil {
+		t.Errorf("MergePackages() of a package with itself did not return an error")
+	}
+	if _, err := b.MergePackages(ctx, primaryID, []string{ids[1].PackageNameID}); err == nil {
+		t.Errorf("MergePackages() of a version with a name did not return an error")
+	}
+	merged, err := b.MergePackages(ctx, primaryID, []string{duplicateID})
+	if err != nil {
+		t.Fatalf("MergePackages() error = %v", err)
+	}
+	if got := merged.Namespaces[0].Names[0].Versions[0].ID; got != primaryID {
+		t.Errorf("MergePackages() returned version %q, want %q", got, primaryID)
+	}
+
+	// the pkgEqual between the two packages is gone
+	if diff := cmp.Diff(map[string]int{"*model.CertifyVuln": 2, "*model.IsOccurrence": 1, "*model.IsDependency": 1}, evidenceCounts(ctx, t, b, primaryID, edges)); diff != "" {
+		t.Errorf("primary evidence after merge (-want +got):\n%s", diff)
+	}
+	if n, err := b.Node(ctx, duplicateID); err == nil && n != nil {
+		t.Errorf("Node(%q) = %v after merge, want not found", duplicateID, n)
+	}
+	sboms, err := b.HasSBOM(ctx, &model.HasSBOMSpec{})
+	if err != nil {
+		t.Fatalf("HasSBOM() error = %v", err)
+	}
+	if len(sboms) != 1 || len(sboms[0].IncludedSoftware) != 1 {
+		t.Fatalf("HasSBOM() after merge = %v, want one SBOM with one package", sboms)
+	}
+	if p, ok := sboms[0].IncludedSoftware[0].(*model.Package); !ok || p.Namespaces[0].Names[0].Versions[0].ID != primaryID {
+		t.Errorf("HasSBOM() after merge includes %v, want the primary package", sboms[0].IncludedSoftware[0])
+	}
+}
+
+func TestMergePackagesSharedEvidence(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	primary := &model.PkgInputSpec{Type: "npm", Namespace: ptrfrom.String("acme"), Name: "widget", Version: ptrfrom.String("1.0.0")}
+	duplicates := []*model.PkgInputSpec{
+		{Type: "npm", Namespace: ptrfrom.String("acme"), Name: "Widget", Version: ptrfrom.String("1.0.0")},
+		{Type: "npm", Namespace: ptrfrom.String("Acme"), Name: "widget", Version: ptrfrom.String("1.0.0")},
+	}
+	var ids []string
+	for _, p := range append([]*model.PkgInputSpec{primary}, duplicates...) {
+		id, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p})
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		ids = append(ids, id.PackageVersionID)
+	}
+	for _, v := range []*model.VulnerabilityInputSpec{testdata.C1, testdata.C2} {
+		if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: v}); err != nil {
+			t.Fatalf("Could not ingest vulnerability: %v", err)
+		}
+	}
+	if _, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A1}); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	if _, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: testdata.S1}); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+
+	// the primary and the first duplicate share a certifyVuln, an occurrence
+	// and a hasSourceAt, the two duplicates share another certifyVuln
+	scan := model.ScanMetadataInput{TimeScanned: testdata.T1}
+	var occurrences []string
+	for _, p := range []*model.PkgInputSpec{primary, duplicates[0]} {
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: p}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, scan); err != nil {
+			t.Fatalf("Could not ingest certifyVuln: %v", err)
+		}
+		id, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: &model.IDorPkgInput{PackageInput: p}}, model.IDorArtifactInput{ArtifactInput: testdata.A1}, model.IsOccurrenceInputSpec{Justification: "test justification"})
+		if err != nil {
+			t.Fatalf("Could not ingest occurrence: %v", err)
+		}
+		occurrences = append(occurrences, id)
+		if _, err := b.IngestHasSourceAt(ctx, model.IDorPkgInput{PackageInput: p}, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, model.IDorSourceInput{SourceInput: testdata.S1}, model.HasSourceAtInputSpec{Justification: "test justification"}); err != nil {
+			t.Fatalf("Could not ingest hasSourceAt: %v", err)
+		}
+	}
+	for _, p := range duplicates {
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: p}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C2}, scan); err != nil {
+			t.Fatalf("Could not ingest certifyVuln: %v", err)
+		}
+	}
+	// the SBOM includes only the occurrence of the duplicate
+	if _, err := b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Artifact: &model.IDorArtifactInput{ArtifactInput: testdata.A1}}, model.HasSBOMInputSpec{URI: "test uri"}, model.HasSBOMIncludesInputSpec{Occurrences: occurrences[1:]}); err != nil {
+		t.Fatalf("Could not ingest hasSBOM: %v", err)
+	}
+
+	if _, err := b.MergePackages(ctx, ids[0], ids[1:]); err != nil {
+		t.Fatalf("MergePackages() error = %v", err)
+	}
+
+	edges := []model.Edge{model.EdgePackageCertifyVuln, model.EdgePackageIsOccurrence, model.EdgePackageHasSourceAt}
+	if diff := cmp.Diff(map[string]int{"*model.CertifyVuln": 2, "*model.IsOccurrence": 1, "*model.HasSourceAt": 1}, evidenceCounts(ctx, t, b, ids[0], edges)); diff != "" {
+		t.Errorf("primary evidence after merge (-want +got):\n%s", diff)
+	}
+	sboms, err := b.HasSBOM(ctx, &model.HasSBOMSpec{})
+	if err != nil {
+		t.Fatalf("HasSBOM() error = %v", err)
+	}
+	if len(sboms) != 1 || len(sboms[0].IncludedOccurrences) != 1 {
+		t.Fatalf("HasSBOM() after merge = %v, want one SBOM with one occurrence", sboms)
+	}
+	if got := sboms[0].IncludedOccurrences[0].ID; got != occurrences[0] {
+		t.Errorf("HasSBOM() after merge includes occurrence %q, want the primary's %q", got, occurrences[0])
+	}
+}
+
+func TestMergePackageNames(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	primary := &model.PkgInputSpec{Type: "npm", Namespace: ptrfrom.String("acme"), Name: "widget", Version: ptrfrom.String("1.0.0")}
+	duplicates := []*model.PkgInputSpec{
+		{Type: "npm", Namespace: ptrfrom.String("acme"), Name: "Widget", Version: ptrfrom.String("1.0.0")},
+		{Type: "npm", Namespace: ptrfrom.String("acme"), Name: "Widget", Version: ptrfrom.String("2.0.0")},
+	}
+	var ids []*model.PackageIDs
+	for _, p := range append([]*model.PkgInputSpec{primary}, duplicates...) {
+		id, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p})
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	for _, v := range []*model.VulnerabilityInputSpec{testdata.C1, testdata.C2} {
+		if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: v}); err != nil {
+			t.Fatalf("Could not ingest vulnerability: %v", err)
+		}
+	}
+	if _, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: testdata.S1}); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	scan := model.ScanMetadataInput{TimeScanned: testdata.T1}
+	for i, v := range []*model.VulnerabilityInputSpec{testdata.C1, testdata.C2} {
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: duplicates[i]}, model.IDorVulnerabilityInput{VulnerabilityInput: v}, scan); err != nil {
+			t.Fatalf("Could not ingest certifyVuln: %v", err)
+		}
+	}
+	if _, err := b.IngestHasSourceAt(ctx, model.IDorPkgInput{PackageInput: duplicates[0]}, model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions}, model.IDorSourceInput{SourceInput: testdata.S1}, model.HasSourceAtInputSpec{Justification: "test justification"}); err != nil {
+		t.Fatalf("Could not ingest hasSourceAt: %v", err)
+	}
+
+	merged, err := b.MergePackages(ctx, ids[0].PackageNameID, []string{ids[1].PackageNameID})
+	if err != nil {
+		t.Fatalf("MergePackages() error = %v", err)
+	}
+	names := merged.Namespaces[0].Names
+	if len(names) != 1 || names[0].ID != ids[0].PackageNameID {
+		t.Fatalf("MergePackages() returned names %v, want %q", names, ids[0].PackageNameID)
+	}
+	var versions []string
+	for _, v := range names[0].Versions {
+		versions = append(versions, v.Version)
+	}
+	if diff := cmp.Diff([]string{"1.0.0", "2.0.0"}, versions, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("versions after merge (-want +got):\n%s", diff)
+	}
+
+	remaining, err := b.Packages(ctx, &model.PkgSpec{Name: ptrfrom.String("Widget")})
+	if err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	if len(remaining) != 0 {
+		t.Errorf("Packages() after merge = %v, want none", remaining)
+	}
+	// the version in both names was merged into the primary's, the other
+	// one moved
+	if diff := cmp.Diff(map[string]int{"*model.CertifyVuln": 1}, evidenceCounts(ctx, t, b, ids[0].PackageVersionID, []model.Edge{model.EdgePackageCertifyVuln})); diff != "" {
+		t.Errorf("primary version evidence after merge (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]int{"*model.CertifyVuln": 1}, evidenceCounts(ctx, t, b, ids[2].PackageVersionID, []model.Edge{model.EdgePackageCertifyVuln})); diff != "" {
+		t.Errorf("moved version evidence after merge (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]int{"*model.HasSourceAt": 1}, evidenceCounts(ctx, t, b, ids[0].PackageNameID, []model.Edge{model.EdgePackageHasSourceAt})); diff != "" {
+		t.Errorf("primary name evidence after merge (-want +got):\n%s", diff)
+	}
+}
+
+func TestMergeArchivedCertifyVuln(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	primary := &model.PkgInputSpec{Type: "npm", Namespace: ptrfrom.String("acme"), Name: "widget", Version: ptrfrom.String("1.0.0")}
+	duplicate := &model.PkgInputSpec{Type: "npm", Namespace: ptrfrom.String("acme"), Name: "Widget", Version: ptrfrom.String("1.0.0")}
+	var ids []*model.PackageIDs
+	for _, p := range []*model.PkgInputSpec{primary, duplicate} {
+		id, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p})
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}); err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	scan := model.ScanMetadataInput{TimeScanned: testdata.T1}
+	if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: duplicate}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, scan); err != nil {
+		t.Fatalf("Could not ingest certifyVuln: %v", err)
+	}
+	if archived, err := b.ArchiveCertifyVulns(ctx, 24*time.Hour); err != nil || archived != 1 {
+		t.Fatalf("ArchiveCertifyVulns() = %v, %v, want 1 archived certification", archived, err)
+	}
+
+	if _, err := b.MergePackages(ctx, ids[0].PackageVersionID, []string{ids[1].PackageVersionID}); err != nil {
+		t.Fatalf("MergePackages() error = %v", err)
+	}
+
+	// the archived certification follows the merged version
+	got, err := b.ArchivedCertifyVuln(ctx, &model.CertifyVulnSpec{})
+	if err != nil {
+		t.Fatalf("ArchivedCertifyVuln() error = %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("ArchivedCertifyVuln() after merge = %v, want one certification", got)
+	}
+	if id := got[0].Package.Namespaces[0].Names[0].Versions[0].ID; id != ids[0].PackageVersionID {
+		t.Errorf("ArchivedCertifyVuln() after merge is on package %q, want %q", id, ids[0].PackageVersionID)
+	}
+}
@@ -1172,3 +1172,80 @@ func TestIngestPointOfContacts(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdatePointOfContact(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	sub := model.PackageSourceOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: testdata.P1}}
+	match := &model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	old := model.PointOfContactInputSpec{
+		Email:         "old@example.com",
+		Info:          "security team",
+		Since:         testdata.T1,
+		Justification: "test justification",
+	}
+	other := model.PointOfContactInputSpec{
+		Email:         "other@example.com",
+		Info:          "security team",
+		Since:         testdata.T1,
+		Justification: "test justification",
+	}
+	oldID, err := b.IngestPointOfContact(ctx, sub, match, old)
+	if err != nil {
+		t.Fatalf("Could not ingest pointOfContact: %v", err)
+	}
+	if _, err := b.IngestPointOfContact(ctx, sub, match, other); err != nil {
+		t.Fatalf("Could not ingest pointOfContact: %v", err)
+	}
+
+	updated := model.PointOfContactInputSpec{
+		Email:         "new@example.com",
+		Info:          "security team",
+		Since:         testdata.T1,
+		Justification: "test justification",
+	}
+	expPOC := &model.PointOfContact{
+		Subject:       testdata.P1out,
+		Email:         "new@example.com",
+		Info:          "security team",
+		Since:         testdata.T1,
+		Justification: "test justification",
+	}
+
+	got, err := b.UpdatePointOfContact(ctx, oldID, updated)
+	if err != nil {
+		t.Fatalf("UpdatePointOfContact() error = %v", err)
+	}
+	if diff := cmp.Diff(expPOC, got, commonOpts); diff != "" {
+		t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+	}
+
+	// the node keeps its ID and is no longer found by the old email
+	gotByID, err := b.PointOfContact(ctx, &model.PointOfContactSpec{ID: &oldID})
+	if err != nil {
+		t.Fatalf("PointOfContact() error = %v", err)
+	}
+	if diff := cmp.Diff([]*model.PointOfContact{expPOC}, gotByID, commonOpts); diff != "" {
+		t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+	}
+	gotOld, err := b.PointOfContact(ctx, &model.PointOfContactSpec{Email: ptrfrom.String("old@example.com")})
+	if err != nil {
+		t.Fatalf("PointOfContact() error = %v", err)
+	}
+	if len(gotOld) != 0 {
+		t.Errorf("expected no pointOfContact with the old email, got %d", len(gotOld))
+	}
+
+	// updating into the fields of another pointOfContact for the same subject fails
+	if _, err := b.UpdatePointOfContact(ctx, oldID, other); err == nil {
+		t.Errorf("expected error when the update conflicts with an existing pointOfContact")
+	}
+
+	// unknown ID
+	if _, err := b.UpdatePointOfContact(ctx, "999999", updated); err == nil {
+		t.Errorf("expected error when updating an unknown pointOfContact")
+	}
+}
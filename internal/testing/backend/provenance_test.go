@@ -0,0 +1,134 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestGetProvenance(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+
+	p1, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1})
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	p2, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P2})
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	for _, a := range []*model.ArtifactInputSpec{testdata.A1, testdata.A2, testdata.A3} {
+		if _, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: a}); err != nil {
+			t.Fatalf("Could not ingest artifact: %v", err)
+		}
+	}
+	if _, err := b.IngestBuilder(ctx, &model.IDorBuilderInput{BuilderInput: testdata.B1}); err != nil {
+		t.Fatalf("Could not ingest builder: %v", err)
+	}
+	if _, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: testdata.S1}); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}); err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	if _, err := b.IngestLicense(ctx, &model.IDorLicenseInput{LicenseInput: testdata.L1}); err != nil {
+		t.Fatalf("Could not ingest license: %v", err)
+	}
+
+	p1Subject := model.PackageOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: testdata.P1}}
+	if _, err := b.IngestHasSbom(ctx, p1Subject, model.HasSBOMInputSpec{URI: "test uri"}, model.HasSBOMIncludesInputSpec{}); err != nil {
+		t.Fatalf("Could not ingest hasSBOM: %v", err)
+	}
+	match := model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	if _, err := b.IngestHasSourceAt(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, match, model.IDorSourceInput{SourceInput: testdata.S1}, model.HasSourceAtInputSpec{Justification: "test justification"}); err != nil {
+		t.Fatalf("Could not ingest hasSourceAt: %v", err)
+	}
+	scan := model.ScanMetadataInput{
+		Collector:   "test collector",
+		Origin:      "test origin",
+		ScannerURI:  "test scanner uri",
+		TimeScanned: testdata.T1,
+	}
+	if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, scan); err != nil {
+		t.Fatalf("Could not ingest certifyVuln: %v", err)
+	}
+	if _, err := b.IngestCertifyLegal(ctx, model.PackageOrSourceInput{Package: &model.IDorPkgInput{PackageInput: testdata.P1}},
+		[]*model.IDorLicenseInput{{LicenseInput: testdata.L1}}, nil, &model.CertifyLegalInputSpec{Justification: "test justification"}); err != nil {
+		t.Fatalf("Could not ingest certifyLegal: %v", err)
+	}
+	occurrences := []struct {
+		pkg *model.PkgInputSpec
+		art *model.ArtifactInputSpec
+	}{
+		{testdata.P1, testdata.A1},
+		{testdata.P2, testdata.A2},
+	}
+	for _, o := range occurrences {
+		if _, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: &model.IDorPkgInput{PackageInput: o.pkg}},
+			model.IDorArtifactInput{ArtifactInput: o.art}, model.IsOccurrenceInputSpec{Justification: "test justification"}); err != nil {
+			t.Fatalf("Could not ingest isOccurrence: %v", err)
+		}
+	}
+	for _, a := range []*model.ArtifactInputSpec{testdata.A1, testdata.A2} {
+		if _, err := b.IngestSLSA(ctx, model.IDorArtifactInput{ArtifactInput: a}, []*model.IDorArtifactInput{{ArtifactInput: testdata.A3}},
+			model.IDorBuilderInput{BuilderInput: testdata.B1}, model.SLSAInputSpec{BuildType: "test type", SlsaVersion: "test version"}); err != nil {
+			t.Fatalf("Could not ingest hasSLSA: %v", err)
+		}
+	}
+
+	tests := []struct {
+		Name  string
+		PkgID string
+		// Exp is the number of nodes expected in each list of the summary:
+		// hasSBOM, hasSLSA, hasSourceAt, certifyVulns, certifyLegal and isOccurrence
+		Exp [6]int
+	}{
+		{
+			Name:  "Every attestation",
+			PkgID: p1.PackageVersionID,
+			Exp:   [6]int{1, 1, 1, 1, 1, 1},
+		},
+		{
+			Name:  "Occurrence only",
+			PkgID: p2.PackageVersionID,
+			Exp:   [6]int{0, 1, 0, 0, 0, 1},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.GetProvenance(ctx, test.PkgID)
+			if err != nil {
+				t.Fatalf("GetProvenance() error = %v", err)
+			}
+			counts := [6]int{len(got.HasSbom), len(got.HasSlsa), len(got.HasSourceAt), len(got.CertifyVulns), len(got.CertifyLegal), len(got.IsOccurrence)}
+			if counts != test.Exp {
+				t.Errorf("GetProvenance() counts = %v, want %v", counts, test.Exp)
+			}
+			for _, slsa := range got.HasSlsa {
+				if len(got.IsOccurrence) == 0 || slsa.Subject.ID != got.IsOccurrence[0].Artifact.ID {
+					t.Errorf("GetProvenance() returned the SLSA attestation of artifact %s, not an occurrence of the package", slsa.Subject.ID)
+				}
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package backend_test
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -124,3 +125,335 @@ func TestSources(t *testing.T) {
 		})
 	}
 }
+
+func TestSourcesCount(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	for _, s := range []*model.SourceInputSpec{testdata.S1, testdata.S2, testdata.S3, testdata.S4} {
+		if _, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: s}); err != nil {
+			t.Fatalf("Could not ingest source: %v", err)
+		}
+	}
+	tests := []struct {
+		name string
+		spec model.SourceSpec
+		want int
+	}{{
+		name: "all",
+		spec: model.SourceSpec{},
+		want: 4,
+	}, {
+		name: "by type",
+		spec: model.SourceSpec{Type: ptrfrom.String("git")},
+		want: 3,
+	}, {
+		name: "by namespace and name",
+		spec: model.SourceSpec{Namespace: ptrfrom.String("github.com/jeff"), Name: ptrfrom.String("myrepo")},
+		want: 2,
+	}, {
+		name: "no match",
+		spec: model.SourceSpec{Name: ptrfrom.String("nothere")},
+		want: 0,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.SourcesCount(ctx, tt.spec)
+			if err != nil {
+				t.Fatalf("SourcesCount() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("SourcesCount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSourcesOrder(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	for _, s := range []*model.SourceInputSpec{testdata.S1, testdata.S4, testdata.S2} {
+		if _, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: s}); err != nil {
+			t.Fatalf("Could not ingest source: %v", err)
+		}
+	}
+	got, err := b.Sources(ctx, &model.SourceSpec{Order: &model.SourceOrder{SortBy: model.SourceOrderFieldType, SortOrder: model.OrderDirectionDesc}})
+	if err != nil {
+		t.Fatalf("Sources() error = %v", err)
+	}
+	var types []string
+	for _, s := range got {
+		for _, ns := range s.Namespaces {
+			for range ns.Names {
+				types = append(types, s.Type)
+			}
+		}
+	}
+	if diff := cmp.Diff([]string{"svn", "git", "git"}, types); diff != "" {
+		t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+	}
+}
+
+func TestSourcesNamePattern(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	sources := []*model.SourceInputSpec{
+		testdata.S1,
+		testdata.S2,
+		{Type: "git", Namespace: "github.com/guacsec", Name: "guac-sdk"},
+		{Type: "git", Namespace: "github.com/guacsec", Name: "guac_collector"},
+		{Type: "git", Namespace: "github.com/other", Name: "other-sdk"},
+	}
+	for _, s := range sources {
+		if _, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: s}); err != nil {
+			t.Fatalf("Could not ingest source: %v", err)
+		}
+	}
+	tests := []struct {
+		name string
+		spec model.SourceSpec
+		want []string
+	}{{
+		name: "prefix",
+		spec: model.SourceSpec{NamePrefix: ptrfrom.String("guac")},
+		want: []string{"guac-sdk", "guac_collector"},
+	}, {
+		name: "prefix with LIKE wildcard character",
+		spec: model.SourceSpec{NamePrefix: ptrfrom.String("guac_")},
+		want: []string{"guac_collector"},
+	}, {
+		name: "suffix",
+		spec: model.SourceSpec{NameGlob: ptrfrom.String("*-sdk")},
+		want: []string{"guac-sdk", "other-sdk"},
+	}, {
+		name: "wildcards",
+		spec: model.SourceSpec{NameGlob: ptrfrom.String("*s?epo")},
+		want: []string{"bobsrepo"},
+	}, {
+		name: "glob without wildcards is exact",
+		spec: model.SourceSpec{NameGlob: ptrfrom.String("repo")},
+	}, {
+		name: "prefix and glob with namespace",
+		spec: model.SourceSpec{Namespace: ptrfrom.String("github.com/guacsec"), NamePrefix: ptrfrom.String("guac"), NameGlob: ptrfrom.String("*sdk")},
+		want: []string{"guac-sdk"},
+	}, {
+		name: "no match",
+		spec: model.SourceSpec{NamePrefix: ptrfrom.String("%")},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.Sources(ctx, &tt.spec)
+			if err != nil {
+				t.Fatalf("Sources() error = %v", err)
+			}
+			var names []string
+			for _, s := range got {
+				for _, ns := range s.Namespaces {
+					for _, n := range ns.Names {
+						names = append(names, n.Name)
+					}
+				}
+			}
+			slices.Sort(names)
+			if diff := cmp.Diff(tt.want, names); diff != "" {
+				t.Errorf("Unexpected source names (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSourcesNameContainsSuffix(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	sources := []*model.SourceInputSpec{
+		{Type: "git", Namespace: "github.com/guacsec", Name: "guac"},
+		{Type: "git", Namespace: "github.com/guacsec", Name: "guac-sdk"},
+		{Type: "git", Namespace: "github.com/guacsec", Name: "guac-visualizer"},
+		{Type: "git", Namespace: "github.com/guacsec", Name: "guac-data"},
+		{Type: "git", Namespace: "github.com/kubernetes", Name: "kubernetes"},
+		{Type: "git", Namespace: "github.com/kubernetes", Name: "client-go"},
+		{Type: "git", Namespace: "github.com/aws", Name: "aws-sdk-go"},
+		{Type: "svn", Namespace: "svn.apache.org", Name: "subversion-data"},
+		{Type: "svn", Namespace: "svn.apache.org", Name: "apache_sdk"},
+		{Type: "hg", Namespace: "hg.mozilla.org", Name: "mozilla-central"},
+	}
+	for _, s := range sources {
+		if _, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: s}); err != nil {
+			t.Fatalf("Could not ingest source: %v", err)
+		}
+	}
+	tests := []struct {
+		name string
+		spec model.SourceSpec
+		want []string
+	}{{
+		name: "contains",
+		spec: model.SourceSpec{NameContains: ptrfrom.String("sdk")},
+		want: []string{"apache_sdk", "aws-sdk-go", "guac-sdk"},
+	}, {
+		name: "contains is case sensitive",
+		spec: model.SourceSpec{NameContains: ptrfrom.String("SDK")},
+	}, {
+		name: "contains with LIKE wildcard character",
+		spec: model.SourceSpec{NameContains: ptrfrom.String("_sdk")},
+		want: []string{"apache_sdk"},
+	}, {
+		name: "suffix",
+		spec: model.SourceSpec{NameSuffix: ptrfrom.String("-data")},
+		want: []string{"guac-data", "subversion-data"},
+	}, {
+		name: "suffix matching whole name",
+		spec: model.SourceSpec{NameSuffix: ptrfrom.String("kubernetes")},
+		want: []string{"kubernetes"},
+	}, {
+		name: "contains with type",
+		spec: model.SourceSpec{Type: ptrfrom.String("git"), NameContains: ptrfrom.String("sdk")},
+		want: []string{"aws-sdk-go", "guac-sdk"},
+	}, {
+		name: "suffix with type",
+		spec: model.SourceSpec{Type: ptrfrom.String("svn"), NameSuffix: ptrfrom.String("data")},
+		want: []string{"subversion-data"},
+	}, {
+		name: "contains and suffix",
+		spec: model.SourceSpec{NameContains: ptrfrom.String("guac"), NameSuffix: ptrfrom.String("er")},
+		want: []string{"guac-visualizer"},
+	}, {
+		name: "no match",
+		spec: model.SourceSpec{Type: ptrfrom.String("hg"), NameContains: ptrfrom.String("guac")},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.Sources(ctx, &tt.spec)
+			if err != nil {
+				t.Fatalf("Sources() error = %v", err)
+			}
+			var names []string
+			for _, s := range got {
+				for _, ns := range s.Namespaces {
+					for _, n := range ns.Names {
+						names = append(names, n.Name)
+					}
+				}
+			}
+			slices.Sort(names)
+			if diff := cmp.Diff(tt.want, names); diff != "" {
+				t.Errorf("Unexpected source names (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteSource(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	var srcIDs []*model.SourceIDs
+	for _, s := range []*model.SourceInputSpec{testdata.S1, testdata.S2, testdata.S3} {
+		ids, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: s})
+		if err != nil {
+			t.Fatalf("Could not ingest source: %v", err)
+		}
+		srcIDs = append(srcIDs, ids)
+	}
+	deleted := srcIDs[0].SourceNameID
+	pkgIDs, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1})
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	artID, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A1})
+	if err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+
+	var hsaIDs []string
+	for _, s := range []*model.SourceInputSpec{testdata.S1, testdata.S2} {
+		id, err := b.IngestHasSourceAt(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, model.IDorSourceInput{SourceInput: s}, model.HasSourceAtInputSpec{Justification: "test justification"})
+		if err != nil {
+			t.Fatalf("Could not ingest hasSourceAt: %v", err)
+		}
+		hsaIDs = append(hsaIDs, id)
+	}
+	if _, err := b.IngestScorecard(ctx, model.IDorSourceInput{SourceInput: testdata.S1}, model.ScorecardInputSpec{AggregateScore: 4.5, TimeScanned: testdata.T1, Origin: "test origin"}); err != nil {
+		t.Fatalf("Could not ingest scorecard: %v", err)
+	}
+	occID, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Source: &model.IDorSourceInput{SourceInput: testdata.S1}}, model.IDorArtifactInput{ArtifactInput: testdata.A1}, model.IsOccurrenceInputSpec{Justification: "test justification"})
+	if err != nil {
+		t.Fatalf("Could not ingest occurrence: %v", err)
+	}
+	if _, err := b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: testdata.P1}}, model.HasSBOMInputSpec{URI: "test uri"}, model.HasSBOMIncludesInputSpec{Occurrences: []string{occID}}); err != nil {
+		t.Fatalf("Could not ingest hasSBOM: %v", err)
+	}
+
+	if err := b.DeleteSource(ctx, deleted); err != nil {
+		t.Fatalf("DeleteSource() error = %v", err)
+	}
+
+	if n, err := b.Node(ctx, deleted); err == nil && n != nil {
+		t.Errorf("Node(%q) = %v after delete, want not found", deleted, n)
+	}
+	sources, err := b.Sources(ctx, &model.SourceSpec{Name: ptrfrom.String(testdata.S1.Name)})
+	if err != nil {
+		t.Fatalf("Sources() error = %v", err)
+	}
+	if diff := cmp.Diff([]*model.Source{testdata.S3out}, sources, commonOpts); diff != "" {
+		t.Errorf("Sources() after delete (-want +got):\n%s", diff)
+	}
+
+	hsas, err := b.HasSourceAt(ctx, &model.HasSourceAtSpec{})
+	if err != nil {
+		t.Fatalf("HasSourceAt() error = %v", err)
+	}
+	if len(hsas) != 1 || hsas[0].ID != hsaIDs[1] {
+		t.Errorf("HasSourceAt() after delete = %v, want only %q", hsas, hsaIDs[1])
+	}
+	scorecards, err := b.Scorecards(ctx, &model.CertifyScorecardSpec{})
+	if err != nil {
+		t.Fatalf("Scorecards() error = %v", err)
+	}
+	if len(scorecards) != 0 {
+		t.Errorf("Scorecards() after delete = %v, want none", scorecards)
+	}
+	occurrences, err := b.IsOccurrence(ctx, &model.IsOccurrenceSpec{})
+	if err != nil {
+		t.Fatalf("IsOccurrence() error = %v", err)
+	}
+	if len(occurrences) != 0 {
+		t.Errorf("IsOccurrence() after delete = %v, want none", occurrences)
+	}
+	sboms, err := b.HasSBOM(ctx, &model.HasSBOMSpec{})
+	if err != nil {
+		t.Fatalf("HasSBOM() error = %v", err)
+	}
+	if len(sboms) != 1 || len(sboms[0].IncludedOccurrences) != 0 {
+		t.Errorf("HasSBOM() after delete = %v, want one SBOM without occurrences", sboms)
+	}
+
+	for _, neighborOf := range []string{pkgIDs.PackageVersionID, artID, srcIDs[2].SourceNamespaceID} {
+		neighbors, err := b.Neighbors(ctx, neighborOf, []model.Edge{})
+		if err != nil {
+			t.Fatalf("Neighbors(%q) error = %v", neighborOf, err)
+		}
+		for _, n := range neighbors {
+			switch n := n.(type) {
+			case *model.HasSourceAt:
+				if n.ID == hsaIDs[0] {
+					t.Errorf("Neighbors(%q) still returns deleted hasSourceAt %q", neighborOf, n.ID)
+				}
+			case *model.IsOccurrence:
+				if n.ID == occID {
+					t.Errorf("Neighbors(%q) still returns deleted occurrence %q", neighborOf, n.ID)
+				}
+			case *model.Source:
+				for _, ns := range n.Namespaces {
+					for _, name := range ns.Names {
+						if name.ID == deleted {
+							t.Errorf("Neighbors(%q) still returns deleted source %q", neighborOf, deleted)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if err := b.DeleteSource(ctx, deleted); err == nil {
+		t.Errorf("DeleteSource() on deleted id did not return an error")
+	}
+}
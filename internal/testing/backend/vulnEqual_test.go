@@ -19,6 +19,7 @@ package backend_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -865,3 +866,57 @@ func TestIngestVulnEquals(t *testing.T) {
 		})
 	}
 }
+
+func TestIngestVulnEqualsDedup(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+
+	const count = 1000
+	var vulnInputs []*model.IDorVulnerabilityInput
+	var osvs []*model.IDorVulnerabilityInput
+	var cves []*model.IDorVulnerabilityInput
+	var ves []*model.VulnEqualInputSpec
+	for i := 0; i < count; i++ {
+		osv := &model.IDorVulnerabilityInput{VulnerabilityInput: &model.VulnerabilityInputSpec{
+			Type:            "osv",
+			VulnerabilityID: fmt.Sprintf("ghsa-%04d", i),
+		}}
+		cve := &model.IDorVulnerabilityInput{VulnerabilityInput: &model.VulnerabilityInputSpec{
+			Type:            "cve",
+			VulnerabilityID: fmt.Sprintf("cve-2023-%04d", i),
+		}}
+		vulnInputs = append(vulnInputs, osv, cve)
+		osvs = append(osvs, osv)
+		cves = append(cves, cve)
+		ves = append(ves, &model.VulnEqualInputSpec{
+			Justification: "nvd load",
+			Origin:        "nvd",
+		})
+	}
+	if _, err := b.IngestVulnerabilities(ctx, vulnInputs); err != nil {
+		t.Fatalf("Could not ingest vulnerabilities: %v", err)
+	}
+
+	ids, err := b.IngestVulnEquals(ctx, osvs, cves, ves)
+	if err != nil {
+		t.Fatalf("IngestVulnEquals() error = %v", err)
+	}
+	if len(ids) != count {
+		t.Fatalf("IngestVulnEquals() returned %d IDs, want %d", len(ids), count)
+	}
+	again, err := b.IngestVulnEquals(ctx, osvs, cves, ves)
+	if err != nil {
+		t.Fatalf("IngestVulnEquals() error on re-ingestion = %v", err)
+	}
+	if diff := cmp.Diff(ids, again); diff != "" {
+		t.Errorf("Re-ingestion returned different IDs (-first +second):\n%s", diff)
+	}
+
+	got, err := b.VulnEqual(ctx, &model.VulnEqualSpec{Origin: ptrfrom.String("nvd")})
+	if err != nil {
+		t.Fatalf("VulnEqual() error = %v", err)
+	}
+	if len(got) != count {
+		t.Errorf("VulnEqual() returned %d nodes, want %d", len(got), count)
+	}
+}
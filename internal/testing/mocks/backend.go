@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/guacsec/guac/pkg/assembler/graphql/model"
@@ -35,6 +36,36 @@ func (m *MockBackend) EXPECT() *MockBackendMockRecorder {
 	return m.recorder
 }
 
+// ArchiveCertifyVulns mocks base method.
+func (m *MockBackend) ArchiveCertifyVulns(ctx context.Context, olderThan time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveCertifyVulns", ctx, olderThan)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveCertifyVulns indicates an expected call of ArchiveCertifyVulns.
+func (mr *MockBackendMockRecorder) ArchiveCertifyVulns(ctx, olderThan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveCertifyVulns", reflect.TypeOf((*MockBackend)(nil).ArchiveCertifyVulns), ctx, olderThan)
+}
+
+// ArchivedCertifyVuln mocks base method.
+func (m *MockBackend) ArchivedCertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchivedCertifyVuln", ctx, certifyVulnSpec)
+	ret0, _ := ret[0].([]*model.CertifyVuln)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchivedCertifyVuln indicates an expected call of ArchivedCertifyVuln.
+func (mr *MockBackendMockRecorder) ArchivedCertifyVuln(ctx, certifyVulnSpec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchivedCertifyVuln", reflect.TypeOf((*MockBackend)(nil).ArchivedCertifyVuln), ctx, certifyVulnSpec)
+}
+
 // Artifacts mocks base method.
 func (m *MockBackend) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +81,36 @@ func (mr *MockBackendMockRecorder) Artifacts(ctx, artifactSpec interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Artifacts", reflect.TypeOf((*MockBackend)(nil).Artifacts), ctx, artifactSpec)
 }
 
+// ArtifactsList mocks base method.
+func (m *MockBackend) ArtifactsList(ctx context.Context, artifactSpec model.ArtifactSpec, pagination *model.PaginationSpec) (*model.ArtifactConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtifactsList", ctx, artifactSpec, pagination)
+	ret0, _ := ret[0].(*model.ArtifactConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArtifactsList indicates an expected call of ArtifactsList.
+func (mr *MockBackendMockRecorder) ArtifactsList(ctx, artifactSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtifactsList", reflect.TypeOf((*MockBackend)(nil).ArtifactsList), ctx, artifactSpec, pagination)
+}
+
+// BatchNodes mocks base method.
+func (m *MockBackend) BatchNodes(ctx context.Context, nodes []string) ([]model.Node, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchNodes", ctx, nodes)
+	ret0, _ := ret[0].([]model.Node)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchNodes indicates an expected call of BatchNodes.
+func (mr *MockBackendMockRecorder) BatchNodes(ctx, nodes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchNodes", reflect.TypeOf((*MockBackend)(nil).BatchNodes), ctx, nodes)
+}
+
 // Builders mocks base method.
 func (m *MockBackend) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +126,21 @@ func (mr *MockBackendMockRecorder) Builders(ctx, builderSpec interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Builders", reflect.TypeOf((*MockBackend)(nil).Builders), ctx, builderSpec)
 }
 
+// BuildersList mocks base method.
+func (m *MockBackend) BuildersList(ctx context.Context, builderSpec model.BuilderSpec, pagination *model.PaginationSpec) (*model.BuilderConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildersList", ctx, builderSpec, pagination)
+	ret0, _ := ret[0].(*model.BuilderConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildersList indicates an expected call of BuildersList.
+func (mr *MockBackendMockRecorder) BuildersList(ctx, builderSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildersList", reflect.TypeOf((*MockBackend)(nil).BuildersList), ctx, builderSpec, pagination)
+}
+
 // CertifyBad mocks base method.
 func (m *MockBackend) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +156,21 @@ func (mr *MockBackendMockRecorder) CertifyBad(ctx, certifyBadSpec interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyBad", reflect.TypeOf((*MockBackend)(nil).CertifyBad), ctx, certifyBadSpec)
 }
 
+// CertifyBadList mocks base method.
+func (m *MockBackend) CertifyBadList(ctx context.Context, certifyBadSpec model.CertifyBadSpec, pagination *model.PaginationSpec) (*model.CertifyBadConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertifyBadList", ctx, certifyBadSpec, pagination)
+	ret0, _ := ret[0].(*model.CertifyBadConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertifyBadList indicates an expected call of CertifyBadList.
+func (mr *MockBackendMockRecorder) CertifyBadList(ctx, certifyBadSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyBadList", reflect.TypeOf((*MockBackend)(nil).CertifyBadList), ctx, certifyBadSpec, pagination)
+}
+
 // CertifyGood mocks base method.
 func (m *MockBackend) CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +186,21 @@ func (mr *MockBackendMockRecorder) CertifyGood(ctx, certifyGoodSpec interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyGood", reflect.TypeOf((*MockBackend)(nil).CertifyGood), ctx, certifyGoodSpec)
 }
 
+// CertifyGoodList mocks base method.
+func (m *MockBackend) CertifyGoodList(ctx context.Context, certifyGoodSpec model.CertifyGoodSpec, pagination *model.PaginationSpec) (*model.CertifyGoodConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertifyGoodList", ctx, certifyGoodSpec, pagination)
+	ret0, _ := ret[0].(*model.CertifyGoodConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertifyGoodList indicates an expected call of CertifyGoodList.
+func (mr *MockBackendMockRecorder) CertifyGoodList(ctx, certifyGoodSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyGoodList", reflect.TypeOf((*MockBackend)(nil).CertifyGoodList), ctx, certifyGoodSpec, pagination)
+}
+
 // CertifyLegal mocks base method.
 func (m *MockBackend) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +216,21 @@ func (mr *MockBackendMockRecorder) CertifyLegal(ctx, certifyLegalSpec interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyLegal", reflect.TypeOf((*MockBackend)(nil).CertifyLegal), ctx, certifyLegalSpec)
 }
 
+// CertifyLegalList mocks base method.
+func (m *MockBackend) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, pagination *model.PaginationSpec) (*model.CertifyLegalConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertifyLegalList", ctx, certifyLegalSpec, pagination)
+	ret0, _ := ret[0].(*model.CertifyLegalConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertifyLegalList indicates an expected call of CertifyLegalList.
+func (mr *MockBackendMockRecorder) CertifyLegalList(ctx, certifyLegalSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyLegalList", reflect.TypeOf((*MockBackend)(nil).CertifyLegalList), ctx, certifyLegalSpec, pagination)
+}
+
 // CertifyVEXStatement mocks base method.
 func (m *MockBackend) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +246,21 @@ func (mr *MockBackendMockRecorder) CertifyVEXStatement(ctx, certifyVEXStatementS
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVEXStatement", reflect.TypeOf((*MockBackend)(nil).CertifyVEXStatement), ctx, certifyVEXStatementSpec)
 }
 
+// CertifyVEXStatementList mocks base method.
+func (m *MockBackend) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, pagination *model.PaginationSpec) (*model.CertifyVEXStatementConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertifyVEXStatementList", ctx, certifyVEXStatementSpec, pagination)
+	ret0, _ := ret[0].(*model.CertifyVEXStatementConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertifyVEXStatementList indicates an expected call of CertifyVEXStatementList.
+func (mr *MockBackendMockRecorder) CertifyVEXStatementList(ctx, certifyVEXStatementSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVEXStatementList", reflect.TypeOf((*MockBackend)(nil).CertifyVEXStatementList), ctx, certifyVEXStatementSpec, pagination)
+}
+
 // CertifyVuln mocks base method.
 func (m *MockBackend) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +276,139 @@ func (mr *MockBackendMockRecorder) CertifyVuln(ctx, certifyVulnSpec interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVuln", reflect.TypeOf((*MockBackend)(nil).CertifyVuln), ctx, certifyVulnSpec)
 }
 
+// CertifyVulnAdded mocks base method.
+func (m *MockBackend) CertifyVulnAdded(ctx context.Context, filter *model.CertifyVulnSpec) (<-chan *model.CertifyVuln, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertifyVulnAdded", ctx, filter)
+	ret0, _ := ret[0].(<-chan *model.CertifyVuln)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertifyVulnAdded indicates an expected call of CertifyVulnAdded.
+func (mr *MockBackendMockRecorder) CertifyVulnAdded(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVulnAdded", reflect.TypeOf((*MockBackend)(nil).CertifyVulnAdded), ctx, filter)
+}
+
+// CertifyVulnByPackageIDs mocks base method.
+func (m *MockBackend) CertifyVulnByPackageIDs(ctx context.Context, packageIDs []string, certifyVulnSpec *model.CertifyVulnSpec) (map[string][]*model.CertifyVuln, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertifyVulnByPackageIDs", ctx, packageIDs, certifyVulnSpec)
+	ret0, _ := ret[0].(map[string][]*model.CertifyVuln)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertifyVulnByPackageIDs indicates an expected call of CertifyVulnByPackageIDs.
+func (mr *MockBackendMockRecorder) CertifyVulnByPackageIDs(ctx, packageIDs, certifyVulnSpec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVulnByPackageIDs", reflect.TypeOf((*MockBackend)(nil).CertifyVulnByPackageIDs), ctx, packageIDs, certifyVulnSpec)
+}
+
+// CertifyVulnByVulnerabilityIDs mocks base method.
+func (m *MockBackend) CertifyVulnByVulnerabilityIDs(ctx context.Context, vulnerabilityIDs []string, certifyVulnSpec *model.CertifyVulnSpec) (map[string][]*model.CertifyVuln, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertifyVulnByVulnerabilityIDs", ctx, vulnerabilityIDs, certifyVulnSpec)
+	ret0, _ := ret[0].(map[string][]*model.CertifyVuln)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertifyVulnByVulnerabilityIDs indicates an expected call of CertifyVulnByVulnerabilityIDs.
+func (mr *MockBackendMockRecorder) CertifyVulnByVulnerabilityIDs(ctx, vulnerabilityIDs, certifyVulnSpec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVulnByVulnerabilityIDs", reflect.TypeOf((*MockBackend)(nil).CertifyVulnByVulnerabilityIDs), ctx, vulnerabilityIDs, certifyVulnSpec)
+}
+
+// CertifyVulnCount mocks base method.
+func (m *MockBackend) CertifyVulnCount(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertifyVulnCount", ctx, certifyVulnSpec)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertifyVulnCount indicates an expected call of CertifyVulnCount.
+func (mr *MockBackendMockRecorder) CertifyVulnCount(ctx, certifyVulnSpec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVulnCount", reflect.TypeOf((*MockBackend)(nil).CertifyVulnCount), ctx, certifyVulnSpec)
+}
+
+// CertifyVulnList mocks base method.
+func (m *MockBackend) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, pagination *model.PaginationSpec) (*model.CertifyVulnConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertifyVulnList", ctx, certifyVulnSpec, pagination)
+	ret0, _ := ret[0].(*model.CertifyVulnConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertifyVulnList indicates an expected call of CertifyVulnList.
+func (mr *MockBackendMockRecorder) CertifyVulnList(ctx, certifyVulnSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVulnList", reflect.TypeOf((*MockBackend)(nil).CertifyVulnList), ctx, certifyVulnSpec, pagination)
+}
+
+// CheckScannerFreshness mocks base method.
+func (m *MockBackend) CheckScannerFreshness(ctx context.Context, scannerURI string, maxAge time.Duration) (*model.ScannerFreshnessResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckScannerFreshness", ctx, scannerURI, maxAge)
+	ret0, _ := ret[0].(*model.ScannerFreshnessResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckScannerFreshness indicates an expected call of CheckScannerFreshness.
+func (mr *MockBackendMockRecorder) CheckScannerFreshness(ctx, scannerURI, maxAge interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckScannerFreshness", reflect.TypeOf((*MockBackend)(nil).CheckScannerFreshness), ctx, scannerURI, maxAge)
+}
+
+// DeleteCertifyVuln mocks base method.
+func (m *MockBackend) DeleteCertifyVuln(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCertifyVuln", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCertifyVuln indicates an expected call of DeleteCertifyVuln.
+func (mr *MockBackendMockRecorder) DeleteCertifyVuln(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertifyVuln", reflect.TypeOf((*MockBackend)(nil).DeleteCertifyVuln), ctx, id)
+}
+
+// DeleteSource mocks base method.
+func (m *MockBackend) DeleteSource(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSource", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSource indicates an expected call of DeleteSource.
+func (mr *MockBackendMockRecorder) DeleteSource(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSource", reflect.TypeOf((*MockBackend)(nil).DeleteSource), ctx, id)
+}
+
+// ExploitReferences mocks base method.
+func (m *MockBackend) ExploitReferences(ctx context.Context, exploitReferenceSpec *model.ExploitReferenceSpec) ([]*model.ExploitReference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExploitReferences", ctx, exploitReferenceSpec)
+	ret0, _ := ret[0].([]*model.ExploitReference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExploitReferences indicates an expected call of ExploitReferences.
+func (mr *MockBackendMockRecorder) ExploitReferences(ctx, exploitReferenceSpec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExploitReferences", reflect.TypeOf((*MockBackend)(nil).ExploitReferences), ctx, exploitReferenceSpec)
+}
+
 // FindSoftware mocks base method.
 func (m *MockBackend) FindSoftware(ctx context.Context, searchText string) ([]model.PackageSourceOrArtifact, error) {
 	m.ctrl.T.Helper()
@@ -155,6 +424,36 @@ func (mr *MockBackendMockRecorder) FindSoftware(ctx, searchText interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSoftware", reflect.TypeOf((*MockBackend)(nil).FindSoftware), ctx, searchText)
 }
 
+// GetCertifyVulnsByDocumentRef mocks base method.
+func (m *MockBackend) GetCertifyVulnsByDocumentRef(ctx context.Context, documentRef string) ([]*model.CertifyVuln, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertifyVulnsByDocumentRef", ctx, documentRef)
+	ret0, _ := ret[0].([]*model.CertifyVuln)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertifyVulnsByDocumentRef indicates an expected call of GetCertifyVulnsByDocumentRef.
+func (mr *MockBackendMockRecorder) GetCertifyVulnsByDocumentRef(ctx, documentRef interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertifyVulnsByDocumentRef", reflect.TypeOf((*MockBackend)(nil).GetCertifyVulnsByDocumentRef), ctx, documentRef)
+}
+
+// GetProvenance mocks base method.
+func (m *MockBackend) GetProvenance(ctx context.Context, pkgID string) (*model.ProvenanceSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProvenance", ctx, pkgID)
+	ret0, _ := ret[0].(*model.ProvenanceSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProvenance indicates an expected call of GetProvenance.
+func (mr *MockBackendMockRecorder) GetProvenance(ctx, pkgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProvenance", reflect.TypeOf((*MockBackend)(nil).GetProvenance), ctx, pkgID)
+}
+
 // HasMetadata mocks base method.
 func (m *MockBackend) HasMetadata(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec) ([]*model.HasMetadata, error) {
 	m.ctrl.T.Helper()
@@ -170,6 +469,21 @@ func (mr *MockBackendMockRecorder) HasMetadata(ctx, hasMetadataSpec interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasMetadata", reflect.TypeOf((*MockBackend)(nil).HasMetadata), ctx, hasMetadataSpec)
 }
 
+// HasMetadataList mocks base method.
+func (m *MockBackend) HasMetadataList(ctx context.Context, hasMetadataSpec model.HasMetadataSpec, pagination *model.PaginationSpec) (*model.HasMetadataConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasMetadataList", ctx, hasMetadataSpec, pagination)
+	ret0, _ := ret[0].(*model.HasMetadataConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasMetadataList indicates an expected call of HasMetadataList.
+func (mr *MockBackendMockRecorder) HasMetadataList(ctx, hasMetadataSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasMetadataList", reflect.TypeOf((*MockBackend)(nil).HasMetadataList), ctx, hasMetadataSpec, pagination)
+}
+
 // HasSBOM mocks base method.
 func (m *MockBackend) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +499,36 @@ func (mr *MockBackendMockRecorder) HasSBOM(ctx, hasSBOMSpec interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSBOM", reflect.TypeOf((*MockBackend)(nil).HasSBOM), ctx, hasSBOMSpec)
 }
 
+// HasSBOMList mocks base method.
+func (m *MockBackend) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, pagination *model.PaginationSpec) (*model.HasSBOMConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasSBOMList", ctx, hasSBOMSpec, pagination)
+	ret0, _ := ret[0].(*model.HasSBOMConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasSBOMList indicates an expected call of HasSBOMList.
+func (mr *MockBackendMockRecorder) HasSBOMList(ctx, hasSBOMSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSBOMList", reflect.TypeOf((*MockBackend)(nil).HasSBOMList), ctx, hasSBOMSpec, pagination)
+}
+
+// HasSLSAList mocks base method.
+func (m *MockBackend) HasSLSAList(ctx context.Context, hasSLSASpec model.HasSLSASpec, pagination *model.PaginationSpec) (*model.HasSLSAConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasSLSAList", ctx, hasSLSASpec, pagination)
+	ret0, _ := ret[0].(*model.HasSLSAConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasSLSAList indicates an expected call of HasSLSAList.
+func (mr *MockBackendMockRecorder) HasSLSAList(ctx, hasSLSASpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSLSAList", reflect.TypeOf((*MockBackend)(nil).HasSLSAList), ctx, hasSLSASpec, pagination)
+}
+
 // HasSlsa mocks base method.
 func (m *MockBackend) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
 	m.ctrl.T.Helper()
@@ -215,6 +559,36 @@ func (mr *MockBackendMockRecorder) HasSourceAt(ctx, hasSourceAtSpec interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSourceAt", reflect.TypeOf((*MockBackend)(nil).HasSourceAt), ctx, hasSourceAtSpec)
 }
 
+// HasSourceAtCount mocks base method.
+func (m *MockBackend) HasSourceAtCount(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasSourceAtCount", ctx, hasSourceAtSpec)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasSourceAtCount indicates an expected call of HasSourceAtCount.
+func (mr *MockBackendMockRecorder) HasSourceAtCount(ctx, hasSourceAtSpec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSourceAtCount", reflect.TypeOf((*MockBackend)(nil).HasSourceAtCount), ctx, hasSourceAtSpec)
+}
+
+// HasSourceAtList mocks base method.
+func (m *MockBackend) HasSourceAtList(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec, pagination *model.PaginationSpec) (*model.HasSourceAtConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasSourceAtList", ctx, hasSourceAtSpec, pagination)
+	ret0, _ := ret[0].(*model.HasSourceAtConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasSourceAtList indicates an expected call of HasSourceAtList.
+func (mr *MockBackendMockRecorder) HasSourceAtList(ctx, hasSourceAtSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSourceAtList", reflect.TypeOf((*MockBackend)(nil).HasSourceAtList), ctx, hasSourceAtSpec, pagination)
+}
+
 // HashEqual mocks base method.
 func (m *MockBackend) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
 	m.ctrl.T.Helper()
@@ -230,6 +604,21 @@ func (mr *MockBackendMockRecorder) HashEqual(ctx, hashEqualSpec interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashEqual", reflect.TypeOf((*MockBackend)(nil).HashEqual), ctx, hashEqualSpec)
 }
 
+// HashEqualList mocks base method.
+func (m *MockBackend) HashEqualList(ctx context.Context, hashEqualSpec model.HashEqualSpec, pagination *model.PaginationSpec) (*model.HashEqualConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashEqualList", ctx, hashEqualSpec, pagination)
+	ret0, _ := ret[0].(*model.HashEqualConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HashEqualList indicates an expected call of HashEqualList.
+func (mr *MockBackendMockRecorder) HashEqualList(ctx, hashEqualSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashEqualList", reflect.TypeOf((*MockBackend)(nil).HashEqualList), ctx, hashEqualSpec, pagination)
+}
+
 // IngestArtifact mocks base method.
 func (m *MockBackend) IngestArtifact(ctx context.Context, artifact *model.IDorArtifactInput) (string, error) {
 	m.ctrl.T.Helper()
@@ -470,6 +859,21 @@ func (mr *MockBackendMockRecorder) IngestDependency(ctx, pkg, depPkg, depPkgMatc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IngestDependency", reflect.TypeOf((*MockBackend)(nil).IngestDependency), ctx, pkg, depPkg, depPkgMatchType, dependency)
 }
 
+// IngestExploitReference mocks base method.
+func (m *MockBackend) IngestExploitReference(ctx context.Context, vulnerability model.IDorVulnerabilityInput, exploitReference model.ExploitReferenceInputSpec) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IngestExploitReference", ctx, vulnerability, exploitReference)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IngestExploitReference indicates an expected call of IngestExploitReference.
+func (mr *MockBackendMockRecorder) IngestExploitReference(ctx, vulnerability, exploitReference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IngestExploitReference", reflect.TypeOf((*MockBackend)(nil).IngestExploitReference), ctx, vulnerability, exploitReference)
+}
+
 // IngestHasMetadata mocks base method.
 func (m *MockBackend) IngestHasMetadata(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, hasMetadata model.HasMetadataInputSpec) (string, error) {
 	m.ctrl.T.Helper()
@@ -935,6 +1339,21 @@ func (mr *MockBackendMockRecorder) IsDependency(ctx, isDependencySpec interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDependency", reflect.TypeOf((*MockBackend)(nil).IsDependency), ctx, isDependencySpec)
 }
 
+// IsDependencyList mocks base method.
+func (m *MockBackend) IsDependencyList(ctx context.Context, isDependencySpec model.IsDependencySpec, pagination *model.PaginationSpec) (*model.IsDependencyConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDependencyList", ctx, isDependencySpec, pagination)
+	ret0, _ := ret[0].(*model.IsDependencyConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsDependencyList indicates an expected call of IsDependencyList.
+func (mr *MockBackendMockRecorder) IsDependencyList(ctx, isDependencySpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDependencyList", reflect.TypeOf((*MockBackend)(nil).IsDependencyList), ctx, isDependencySpec, pagination)
+}
+
 // IsOccurrence mocks base method.
 func (m *MockBackend) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
 	m.ctrl.T.Helper()
@@ -950,6 +1369,21 @@ func (mr *MockBackendMockRecorder) IsOccurrence(ctx, isOccurrenceSpec interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOccurrence", reflect.TypeOf((*MockBackend)(nil).IsOccurrence), ctx, isOccurrenceSpec)
 }
 
+// IsOccurrenceList mocks base method.
+func (m *MockBackend) IsOccurrenceList(ctx context.Context, isOccurrenceSpec model.IsOccurrenceSpec, pagination *model.PaginationSpec) (*model.IsOccurrenceConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsOccurrenceList", ctx, isOccurrenceSpec, pagination)
+	ret0, _ := ret[0].(*model.IsOccurrenceConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsOccurrenceList indicates an expected call of IsOccurrenceList.
+func (mr *MockBackendMockRecorder) IsOccurrenceList(ctx, isOccurrenceSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOccurrenceList", reflect.TypeOf((*MockBackend)(nil).IsOccurrenceList), ctx, isOccurrenceSpec, pagination)
+}
+
 // Licenses mocks base method.
 func (m *MockBackend) Licenses(ctx context.Context, licenseSpec *model.LicenseSpec) ([]*model.License, error) {
 	m.ctrl.T.Helper()
@@ -965,6 +1399,51 @@ func (mr *MockBackendMockRecorder) Licenses(ctx, licenseSpec interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Licenses", reflect.TypeOf((*MockBackend)(nil).Licenses), ctx, licenseSpec)
 }
 
+// LicensesList mocks base method.
+func (m *MockBackend) LicensesList(ctx context.Context, licenseSpec model.LicenseSpec, pagination *model.PaginationSpec) (*model.LicenseConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LicensesList", ctx, licenseSpec, pagination)
+	ret0, _ := ret[0].(*model.LicenseConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LicensesList indicates an expected call of LicensesList.
+func (mr *MockBackendMockRecorder) LicensesList(ctx, licenseSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LicensesList", reflect.TypeOf((*MockBackend)(nil).LicensesList), ctx, licenseSpec, pagination)
+}
+
+// MarkStaleVulns mocks base method.
+func (m *MockBackend) MarkStaleVulns(ctx context.Context, olderThan time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkStaleVulns", ctx, olderThan)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkStaleVulns indicates an expected call of MarkStaleVulns.
+func (mr *MockBackendMockRecorder) MarkStaleVulns(ctx, olderThan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkStaleVulns", reflect.TypeOf((*MockBackend)(nil).MarkStaleVulns), ctx, olderThan)
+}
+
+// MergePackages mocks base method.
+func (m *MockBackend) MergePackages(ctx context.Context, primary string, duplicates []string) (*model.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergePackages", ctx, primary, duplicates)
+	ret0, _ := ret[0].(*model.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergePackages indicates an expected call of MergePackages.
+func (mr *MockBackendMockRecorder) MergePackages(ctx, primary, duplicates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePackages", reflect.TypeOf((*MockBackend)(nil).MergePackages), ctx, primary, duplicates)
+}
+
 // Neighbors mocks base method.
 func (m *MockBackend) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
 	m.ctrl.T.Helper()
@@ -980,6 +1459,21 @@ func (mr *MockBackendMockRecorder) Neighbors(ctx, node, usingOnly interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Neighbors", reflect.TypeOf((*MockBackend)(nil).Neighbors), ctx, node, usingOnly)
 }
 
+// NeighborsRecursive mocks base method.
+func (m *MockBackend) NeighborsRecursive(ctx context.Context, node string, usingOnly []model.Edge, maxDepth int) ([]model.Node, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeighborsRecursive", ctx, node, usingOnly, maxDepth)
+	ret0, _ := ret[0].([]model.Node)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NeighborsRecursive indicates an expected call of NeighborsRecursive.
+func (mr *MockBackendMockRecorder) NeighborsRecursive(ctx, node, usingOnly, maxDepth interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeighborsRecursive", reflect.TypeOf((*MockBackend)(nil).NeighborsRecursive), ctx, node, usingOnly, maxDepth)
+}
+
 // Node mocks base method.
 func (m *MockBackend) Node(ctx context.Context, node string) (model.Node, error) {
 	m.ctrl.T.Helper()
@@ -995,6 +1489,21 @@ func (mr *MockBackendMockRecorder) Node(ctx, node interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Node", reflect.TypeOf((*MockBackend)(nil).Node), ctx, node)
 }
 
+// NodeType mocks base method.
+func (m *MockBackend) NodeType(ctx context.Context, node string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NodeType", ctx, node)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NodeType indicates an expected call of NodeType.
+func (mr *MockBackendMockRecorder) NodeType(ctx, node interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeType", reflect.TypeOf((*MockBackend)(nil).NodeType), ctx, node)
+}
+
 // Nodes mocks base method.
 func (m *MockBackend) Nodes(ctx context.Context, nodes []string) ([]model.Node, error) {
 	m.ctrl.T.Helper()
@@ -1025,6 +1534,36 @@ func (mr *MockBackendMockRecorder) Packages(ctx, pkgSpec interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Packages", reflect.TypeOf((*MockBackend)(nil).Packages), ctx, pkgSpec)
 }
 
+// PackagesCount mocks base method.
+func (m *MockBackend) PackagesCount(ctx context.Context, pkgSpec model.PkgSpec) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PackagesCount", ctx, pkgSpec)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PackagesCount indicates an expected call of PackagesCount.
+func (mr *MockBackendMockRecorder) PackagesCount(ctx, pkgSpec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PackagesCount", reflect.TypeOf((*MockBackend)(nil).PackagesCount), ctx, pkgSpec)
+}
+
+// PackagesList mocks base method.
+func (m *MockBackend) PackagesList(ctx context.Context, pkgSpec model.PkgSpec, pagination *model.PaginationSpec) (*model.PackageConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PackagesList", ctx, pkgSpec, pagination)
+	ret0, _ := ret[0].(*model.PackageConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PackagesList indicates an expected call of PackagesList.
+func (mr *MockBackendMockRecorder) PackagesList(ctx, pkgSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PackagesList", reflect.TypeOf((*MockBackend)(nil).PackagesList), ctx, pkgSpec, pagination)
+}
+
 // Path mocks base method.
 func (m *MockBackend) Path(ctx context.Context, subject, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
 	m.ctrl.T.Helper()
@@ -1055,6 +1594,21 @@ func (mr *MockBackendMockRecorder) PkgEqual(ctx, pkgEqualSpec interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PkgEqual", reflect.TypeOf((*MockBackend)(nil).PkgEqual), ctx, pkgEqualSpec)
 }
 
+// PkgEqualList mocks base method.
+func (m *MockBackend) PkgEqualList(ctx context.Context, pkgEqualSpec model.PkgEqualSpec, pagination *model.PaginationSpec) (*model.PkgEqualConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PkgEqualList", ctx, pkgEqualSpec, pagination)
+	ret0, _ := ret[0].(*model.PkgEqualConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PkgEqualList indicates an expected call of PkgEqualList.
+func (mr *MockBackendMockRecorder) PkgEqualList(ctx, pkgEqualSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PkgEqualList", reflect.TypeOf((*MockBackend)(nil).PkgEqualList), ctx, pkgEqualSpec, pagination)
+}
+
 // PointOfContact mocks base method.
 func (m *MockBackend) PointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
 	m.ctrl.T.Helper()
@@ -1070,6 +1624,36 @@ func (mr *MockBackendMockRecorder) PointOfContact(ctx, pointOfContactSpec interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PointOfContact", reflect.TypeOf((*MockBackend)(nil).PointOfContact), ctx, pointOfContactSpec)
 }
 
+// PointOfContactList mocks base method.
+func (m *MockBackend) PointOfContactList(ctx context.Context, pointOfContactSpec model.PointOfContactSpec, pagination *model.PaginationSpec) (*model.PointOfContactConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PointOfContactList", ctx, pointOfContactSpec, pagination)
+	ret0, _ := ret[0].(*model.PointOfContactConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PointOfContactList indicates an expected call of PointOfContactList.
+func (mr *MockBackendMockRecorder) PointOfContactList(ctx, pointOfContactSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PointOfContactList", reflect.TypeOf((*MockBackend)(nil).PointOfContactList), ctx, pointOfContactSpec, pagination)
+}
+
+// SBOMComponentBreakdown mocks base method.
+func (m *MockBackend) SBOMComponentBreakdown(ctx context.Context, hasSBOMID string) ([]*model.ComponentTypeCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SBOMComponentBreakdown", ctx, hasSBOMID)
+	ret0, _ := ret[0].([]*model.ComponentTypeCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SBOMComponentBreakdown indicates an expected call of SBOMComponentBreakdown.
+func (mr *MockBackendMockRecorder) SBOMComponentBreakdown(ctx, hasSBOMID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SBOMComponentBreakdown", reflect.TypeOf((*MockBackend)(nil).SBOMComponentBreakdown), ctx, hasSBOMID)
+}
+
 // Scorecards mocks base method.
 func (m *MockBackend) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
 	m.ctrl.T.Helper()
@@ -1085,6 +1669,21 @@ func (mr *MockBackendMockRecorder) Scorecards(ctx, certifyScorecardSpec interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scorecards", reflect.TypeOf((*MockBackend)(nil).Scorecards), ctx, certifyScorecardSpec)
 }
 
+// ScorecardsList mocks base method.
+func (m *MockBackend) ScorecardsList(ctx context.Context, certifyScorecardSpec model.CertifyScorecardSpec, pagination *model.PaginationSpec) (*model.CertifyScorecardConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScorecardsList", ctx, certifyScorecardSpec, pagination)
+	ret0, _ := ret[0].(*model.CertifyScorecardConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScorecardsList indicates an expected call of ScorecardsList.
+func (mr *MockBackendMockRecorder) ScorecardsList(ctx, certifyScorecardSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScorecardsList", reflect.TypeOf((*MockBackend)(nil).ScorecardsList), ctx, certifyScorecardSpec, pagination)
+}
+
 // Sources mocks base method.
 func (m *MockBackend) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
 	m.ctrl.T.Helper()
@@ -1100,6 +1699,111 @@ func (mr *MockBackendMockRecorder) Sources(ctx, sourceSpec interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sources", reflect.TypeOf((*MockBackend)(nil).Sources), ctx, sourceSpec)
 }
 
+// SourcesCount mocks base method.
+func (m *MockBackend) SourcesCount(ctx context.Context, sourceSpec model.SourceSpec) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SourcesCount", ctx, sourceSpec)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SourcesCount indicates an expected call of SourcesCount.
+func (mr *MockBackendMockRecorder) SourcesCount(ctx, sourceSpec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SourcesCount", reflect.TypeOf((*MockBackend)(nil).SourcesCount), ctx, sourceSpec)
+}
+
+// SourcesList mocks base method.
+func (m *MockBackend) SourcesList(ctx context.Context, sourceSpec model.SourceSpec, pagination *model.PaginationSpec) (*model.SourceConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SourcesList", ctx, sourceSpec, pagination)
+	ret0, _ := ret[0].(*model.SourceConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SourcesList indicates an expected call of SourcesList.
+func (mr *MockBackendMockRecorder) SourcesList(ctx, sourceSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SourcesList", reflect.TypeOf((*MockBackend)(nil).SourcesList), ctx, sourceSpec, pagination)
+}
+
+// TopVulnerablePackages mocks base method.
+func (m *MockBackend) TopVulnerablePackages(ctx context.Context, limit int, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.PackageVulnCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopVulnerablePackages", ctx, limit, certifyVulnSpec)
+	ret0, _ := ret[0].([]*model.PackageVulnCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopVulnerablePackages indicates an expected call of TopVulnerablePackages.
+func (mr *MockBackendMockRecorder) TopVulnerablePackages(ctx, limit, certifyVulnSpec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopVulnerablePackages", reflect.TypeOf((*MockBackend)(nil).TopVulnerablePackages), ctx, limit, certifyVulnSpec)
+}
+
+// UpdateCertifyScorecard mocks base method.
+func (m *MockBackend) UpdateCertifyScorecard(ctx context.Context, id string, scorecard model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCertifyScorecard", ctx, id, scorecard)
+	ret0, _ := ret[0].(*model.CertifyScorecard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCertifyScorecard indicates an expected call of UpdateCertifyScorecard.
+func (mr *MockBackendMockRecorder) UpdateCertifyScorecard(ctx, id, scorecard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCertifyScorecard", reflect.TypeOf((*MockBackend)(nil).UpdateCertifyScorecard), ctx, id, scorecard)
+}
+
+// UpdateCertifyVulnResolution mocks base method.
+func (m *MockBackend) UpdateCertifyVulnResolution(ctx context.Context, id, resolvedByID string) (*model.CertifyVuln, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCertifyVulnResolution", ctx, id, resolvedByID)
+	ret0, _ := ret[0].(*model.CertifyVuln)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCertifyVulnResolution indicates an expected call of UpdateCertifyVulnResolution.
+func (mr *MockBackendMockRecorder) UpdateCertifyVulnResolution(ctx, id, resolvedByID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCertifyVulnResolution", reflect.TypeOf((*MockBackend)(nil).UpdateCertifyVulnResolution), ctx, id, resolvedByID)
+}
+
+// UpdateHasSourceAt mocks base method.
+func (m *MockBackend) UpdateHasSourceAt(ctx context.Context, id string, hasSourceAt model.HasSourceAtInputSpec) (*model.HasSourceAt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHasSourceAt", ctx, id, hasSourceAt)
+	ret0, _ := ret[0].(*model.HasSourceAt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHasSourceAt indicates an expected call of UpdateHasSourceAt.
+func (mr *MockBackendMockRecorder) UpdateHasSourceAt(ctx, id, hasSourceAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHasSourceAt", reflect.TypeOf((*MockBackend)(nil).UpdateHasSourceAt), ctx, id, hasSourceAt)
+}
+
+// UpdatePointOfContact mocks base method.
+func (m *MockBackend) UpdatePointOfContact(ctx context.Context, id string, pointOfContact model.PointOfContactInputSpec) (*model.PointOfContact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePointOfContact", ctx, id, pointOfContact)
+	ret0, _ := ret[0].(*model.PointOfContact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePointOfContact indicates an expected call of UpdatePointOfContact.
+func (mr *MockBackendMockRecorder) UpdatePointOfContact(ctx, id, pointOfContact interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePointOfContact", reflect.TypeOf((*MockBackend)(nil).UpdatePointOfContact), ctx, id, pointOfContact)
+}
+
 // VulnEqual mocks base method.
 func (m *MockBackend) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error) {
 	m.ctrl.T.Helper()
@@ -1115,6 +1819,21 @@ func (mr *MockBackendMockRecorder) VulnEqual(ctx, vulnEqualSpec interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VulnEqual", reflect.TypeOf((*MockBackend)(nil).VulnEqual), ctx, vulnEqualSpec)
 }
 
+// VulnEqualList mocks base method.
+func (m *MockBackend) VulnEqualList(ctx context.Context, vulnEqualSpec model.VulnEqualSpec, pagination *model.PaginationSpec) (*model.VulnEqualConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VulnEqualList", ctx, vulnEqualSpec, pagination)
+	ret0, _ := ret[0].(*model.VulnEqualConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VulnEqualList indicates an expected call of VulnEqualList.
+func (mr *MockBackendMockRecorder) VulnEqualList(ctx, vulnEqualSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VulnEqualList", reflect.TypeOf((*MockBackend)(nil).VulnEqualList), ctx, vulnEqualSpec, pagination)
+}
+
 // Vulnerabilities mocks base method.
 func (m *MockBackend) Vulnerabilities(ctx context.Context, vulnSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
 	m.ctrl.T.Helper()
@@ -1130,6 +1849,21 @@ func (mr *MockBackendMockRecorder) Vulnerabilities(ctx, vulnSpec interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vulnerabilities", reflect.TypeOf((*MockBackend)(nil).Vulnerabilities), ctx, vulnSpec)
 }
 
+// VulnerabilitiesList mocks base method.
+func (m *MockBackend) VulnerabilitiesList(ctx context.Context, vulnSpec model.VulnerabilitySpec, pagination *model.PaginationSpec) (*model.VulnerabilityConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VulnerabilitiesList", ctx, vulnSpec, pagination)
+	ret0, _ := ret[0].(*model.VulnerabilityConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VulnerabilitiesList indicates an expected call of VulnerabilitiesList.
+func (mr *MockBackendMockRecorder) VulnerabilitiesList(ctx, vulnSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VulnerabilitiesList", reflect.TypeOf((*MockBackend)(nil).VulnerabilitiesList), ctx, vulnSpec, pagination)
+}
+
 // VulnerabilityMetadata mocks base method.
 func (m *MockBackend) VulnerabilityMetadata(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec) ([]*model.VulnerabilityMetadata, error) {
 	m.ctrl.T.Helper()
@@ -1145,6 +1879,21 @@ func (mr *MockBackendMockRecorder) VulnerabilityMetadata(ctx, vulnerabilityMetad
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VulnerabilityMetadata", reflect.TypeOf((*MockBackend)(nil).VulnerabilityMetadata), ctx, vulnerabilityMetadataSpec)
 }
 
+// VulnerabilityMetadataList mocks base method.
+func (m *MockBackend) VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, pagination *model.PaginationSpec) (*model.VulnerabilityMetadataConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VulnerabilityMetadataList", ctx, vulnerabilityMetadataSpec, pagination)
+	ret0, _ := ret[0].(*model.VulnerabilityMetadataConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VulnerabilityMetadataList indicates an expected call of VulnerabilityMetadataList.
+func (mr *MockBackendMockRecorder) VulnerabilityMetadataList(ctx, vulnerabilityMetadataSpec, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VulnerabilityMetadataList", reflect.TypeOf((*MockBackend)(nil).VulnerabilityMetadataList), ctx, vulnerabilityMetadataSpec, pagination)
+}
+
 // MockBackendArgs is a mock of BackendArgs interface.
 type MockBackendArgs struct {
 	ctrl     *gomock.Controller
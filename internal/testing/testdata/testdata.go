@@ -933,11 +933,11 @@ var (
 	// CycloneDX Testdata
 	cdxTopLevelPack, _ = asmhelpers.PurlToPkg("pkg:guac/cdx/gcr.io/distroless/static@sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388?tag=nonroot")
 
-	cdxTzdataPack, _ = asmhelpers.PurlToPkg("pkg:deb/debian/tzdata@2021a-1+deb11u6?arch=all&distro=debian-11")
+	cdxTzdataPack = pkgWithComponentType("library", "pkg:deb/debian/tzdata@2021a-1+deb11u6?arch=all&distro=debian-11")
 
-	cdxNetbasePack, _ = asmhelpers.PurlToPkg("pkg:deb/debian/netbase@6.3?arch=all&distro=debian-11")
+	cdxNetbasePack = pkgWithComponentType("library", "pkg:deb/debian/netbase@6.3?arch=all&distro=debian-11")
 
-	cdxBasefilesPack, _ = asmhelpers.PurlToPkg("pkg:deb/debian/base-files@11.1+deb11u5?arch=amd64&distro=debian-11")
+	cdxBasefilesPack = pkgWithComponentType("library", "pkg:deb/debian/base-files@11.1+deb11u5?arch=amd64&distro=debian-11")
 
 	CdxDeps = []assembler.IsDependencyIngest{
 		{
@@ -994,9 +994,9 @@ var (
 
 	cdxTopQuarkusPack, _ = asmhelpers.PurlToPkg("pkg:maven/org.acme/getting-started@1.0.0-SNAPSHOT?type=jar")
 
-	cdxResteasyPack, _ = asmhelpers.PurlToPkg("pkg:maven/io.quarkus/quarkus-resteasy-reactive@2.13.4.Final?type=jar")
+	cdxResteasyPack = pkgWithComponentType("library", "pkg:maven/io.quarkus/quarkus-resteasy-reactive@2.13.4.Final?type=jar")
 
-	cdxReactiveCommonPack, _ = asmhelpers.PurlToPkg("pkg:maven/io.quarkus/quarkus-resteasy-reactive-common@2.13.4.Final?type=jar")
+	cdxReactiveCommonPack = pkgWithComponentType("library", "pkg:maven/io.quarkus/quarkus-resteasy-reactive-common@2.13.4.Final?type=jar")
 
 	CdxQuarkusDeps = []assembler.IsDependencyIngest{
 		{
@@ -1089,7 +1089,7 @@ var (
 
 	cdxWebAppPackage, _ = asmhelpers.PurlToPkg("pkg:npm/web-app@1.0.0")
 
-	cdxBootstrapPackage, _ = asmhelpers.PurlToPkg("pkg:npm/bootstrap@4.0.0-beta.2")
+	cdxBootstrapPackage = pkgWithComponentType("library", "pkg:npm/bootstrap@4.0.0-beta.2")
 
 	CdxNpmDeps = []assembler.IsDependencyIngest{
 		{
@@ -2251,6 +2251,54 @@ var (
 		},
 	}
 
+	// NVD
+	//go:embed exampledata/nvd-cve-feed.json
+	NVDFeedExample []byte
+
+	NVDVulnMetadataIngest = []assembler.VulnMetadataIngest{
+		{
+			Vulnerability: &generated.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2021-44228"},
+			VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
+				ScoreType:  generated.VulnerabilityScoreTypeCvssv2,
+				ScoreValue: 9.3,
+				Timestamp:  parseRfc3339("2023-11-07T03:39:36.747Z"),
+			},
+		},
+		{
+			Vulnerability: &generated.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2021-44228"},
+			VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
+				ScoreType:  generated.VulnerabilityScoreTypeCvssv31,
+				ScoreValue: 10,
+				Timestamp:  parseRfc3339("2023-11-07T03:39:36.747Z"),
+			},
+		},
+		{
+			Vulnerability: &generated.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2023-44487"},
+			VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
+				ScoreType:  generated.VulnerabilityScoreTypeCvssv31,
+				ScoreValue: 7.5,
+				Timestamp:  parseRfc3339("2024-02-02T16:15:45.377Z"),
+			},
+		},
+	}
+
+	// OSV
+	//go:embed exampledata/osv-ghsa.json
+	OSVExample []byte
+
+	OSVVulnEqualIngest = []assembler.VulnEqualIngest{
+		{
+			Vulnerability:      &generated.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "ghsa-jfh8-c2jp-5v3q"},
+			EqualVulnerability: &generated.VulnerabilityInputSpec{Type: "ghsa", VulnerabilityID: "ghsa-jfh8-c2jp-5v3q"},
+			VulnEqual:          &generated.VulnEqualInputSpec{Justification: "Decoded OSV data"},
+		},
+		{
+			Vulnerability:      &generated.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "ghsa-jfh8-c2jp-5v3q"},
+			EqualVulnerability: &generated.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2021-44228"},
+			VulnEqual:          &generated.VulnEqualInputSpec{Justification: "OSV alias"},
+		},
+	}
+
 	// CSAF
 	//go:embed exampledata/rhsa-csaf.json
 	CsafExampleRedHat []byte
@@ -2929,6 +2977,12 @@ func strP(s string) *string {
 	return &s
 }
 
+func pkgWithComponentType(componentType string, purl string) *model.PkgInputSpec {
+	pkg, _ := asmhelpers.PurlToPkg(purl)
+	pkg.ComponentType = &componentType
+	return pkg
+}
+
 func parseRfc3339(s string) time.Time {
 	time, err := time.Parse(time.RFC3339, s)
 	if err != nil {
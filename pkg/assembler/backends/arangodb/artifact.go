@@ -22,6 +22,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -60,6 +61,10 @@ func setArtifactMatchValues(artifactSpec *model.ArtifactSpec, queryValues map[st
 			arangoQueryBuilder.filter("art", "digest", "==", "@digest")
 			queryValues["digest"] = strings.ToLower(*artifactSpec.Digest)
 		}
+		if artifactSpec.DigestPrefix != nil {
+			arangoQueryBuilder.filter("art", "digest", "LIKE", "@digestPrefix")
+			queryValues["digestPrefix"] = helper.PrefixToLike(strings.ToLower(*artifactSpec.DigestPrefix))
+		}
 	}
 	return arangoQueryBuilder
 }
@@ -490,3 +495,23 @@ func (c *arangoClient) getNeighborIDFromCursor(ctx context.Context, arangoQueryB
 	}
 	return foundIDs, nil
 }
+
+func (c *arangoClient) ArtifactsList(ctx context.Context, artifactSpec model.ArtifactSpec, pagination *model.PaginationSpec) (*model.ArtifactConnection, error) {
+	results, err := c.Artifacts(ctx, &artifactSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.Artifact) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("ArtifactsList :: %v", err)
+	}
+	edges := make([]*model.ArtifactEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.ArtifactEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.ArtifactConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -208,3 +209,23 @@ func (c *arangoClient) builderNeighbors(ctx context.Context, nodeID string, allo
 	}
 	return out, nil
 }
+
+func (c *arangoClient) BuildersList(ctx context.Context, builderSpec model.BuilderSpec, pagination *model.PaginationSpec) (*model.BuilderConnection, error) {
+	results, err := c.Builders(ctx, &builderSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.Builder) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("BuildersList :: %v", err)
+	}
+	edges := make([]*model.BuilderEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.BuilderEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.BuilderConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
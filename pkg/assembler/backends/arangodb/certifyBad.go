@@ -18,11 +18,13 @@ package arangodb
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 )
@@ -95,7 +97,9 @@ func (c *arangoClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cer
 
 			combinedCertifyBad = append(combinedCertifyBad, artCertifyBads...)
 		}
-		return combinedCertifyBad, nil
+		return slices.DeleteFunc(combinedCertifyBad, func(cb *model.CertifyBad) bool {
+			return !matchesSubjectType(certifyBadSpec.SubjectType, cb.Subject)
+		}), nil
 	} else {
 		values := map[string]any{}
 		var combinedCertifyBad []*model.CertifyBad
@@ -154,10 +158,29 @@ func (c *arangoClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cer
 		}
 		combinedCertifyBad = append(combinedCertifyBad, artCertifyBads...)
 
-		return combinedCertifyBad, nil
+		return slices.DeleteFunc(combinedCertifyBad, func(cb *model.CertifyBad) bool {
+			return !matchesSubjectType(certifyBadSpec.SubjectType, cb.Subject)
+		}), nil
 	}
 }
 
+// matchesSubjectType reports whether subject is of the kind requested by
+// subjectType. A nil subjectType matches every subject.
+func matchesSubjectType(subjectType *model.SubjectType, subject model.PackageSourceOrArtifact) bool {
+	if subjectType == nil {
+		return true
+	}
+	switch subject.(type) {
+	case *model.Package:
+		return *subjectType == model.SubjectTypePackage
+	case *model.Source:
+		return *subjectType == model.SubjectTypeSource
+	case *model.Artifact:
+		return *subjectType == model.SubjectTypeArtifact
+	}
+	return false
+}
+
 func getSrcCertifyBadForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.CertifyBad, error) {
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
@@ -274,6 +297,14 @@ func setCertifyBadMatchValues(arangoQueryBuilder *arangoQueryBuilder, certifyBad
 		arangoQueryBuilder.filter("certifyBad", justification, "==", "@"+justification)
 		queryValues[justification] = *certifyBadSpec.Justification
 	}
+	if certifyBadSpec.JustificationPrefix != nil {
+		arangoQueryBuilder.filter("certifyBad", justification, "LIKE", "@justificationPrefix")
+		queryValues["justificationPrefix"] = helper.PrefixToLike(*certifyBadSpec.JustificationPrefix)
+	}
+	if certifyBadSpec.JustificationContains != nil {
+		arangoQueryBuilder.filter("certifyBad", justification, "LIKE", "@justificationContains")
+		queryValues["justificationContains"] = helper.ContainsToLike(*certifyBadSpec.JustificationContains)
+	}
 	if certifyBadSpec.Origin != nil {
 		arangoQueryBuilder.filter("certifyBad", origin, "==", "@"+origin)
 		queryValues[origin] = *certifyBadSpec.Origin
@@ -926,3 +957,23 @@ func (c *arangoClient) certifyBadNeighbors(ctx context.Context, nodeID string, a
 	}
 	return out, nil
 }
+
+func (c *arangoClient) CertifyBadList(ctx context.Context, certifyBadSpec model.CertifyBadSpec, pagination *model.PaginationSpec) (*model.CertifyBadConnection, error) {
+	results, err := c.CertifyBad(ctx, &certifyBadSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.CertifyBad) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("CertifyBadList :: %v", err)
+	}
+	edges := make([]*model.CertifyBadEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.CertifyBadEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.CertifyBadConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -18,11 +18,13 @@ package arangodb
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 )
@@ -95,7 +97,9 @@ func (c *arangoClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.C
 
 			combinedCertifyGood = append(combinedCertifyGood, artCertifyGoods...)
 		}
-		return combinedCertifyGood, nil
+		return slices.DeleteFunc(combinedCertifyGood, func(cg *model.CertifyGood) bool {
+			return !matchesSubjectType(certifyGoodSpec.SubjectType, cg.Subject)
+		}), nil
 	} else {
 		values := map[string]any{}
 		var combinedCertifyGood []*model.CertifyGood
@@ -154,7 +158,9 @@ func (c *arangoClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.C
 		}
 		combinedCertifyGood = append(combinedCertifyGood, artCertifyGoods...)
 
-		return combinedCertifyGood, nil
+		return slices.DeleteFunc(combinedCertifyGood, func(cg *model.CertifyGood) bool {
+			return !matchesSubjectType(certifyGoodSpec.SubjectType, cg.Subject)
+		}), nil
 	}
 }
 
@@ -275,6 +281,14 @@ func setCertifyGoodMatchValues(arangoQueryBuilder *arangoQueryBuilder, certifyGo
 		arangoQueryBuilder.filter("certifyGood", justification, "==", "@"+justification)
 		queryValues[justification] = *certifyGoodSpec.Justification
 	}
+	if certifyGoodSpec.JustificationPrefix != nil {
+		arangoQueryBuilder.filter("certifyGood", justification, "LIKE", "@justificationPrefix")
+		queryValues["justificationPrefix"] = helper.PrefixToLike(*certifyGoodSpec.JustificationPrefix)
+	}
+	if certifyGoodSpec.JustificationContains != nil {
+		arangoQueryBuilder.filter("certifyGood", justification, "LIKE", "@justificationContains")
+		queryValues["justificationContains"] = helper.ContainsToLike(*certifyGoodSpec.JustificationContains)
+	}
 	if certifyGoodSpec.Origin != nil {
 		arangoQueryBuilder.filter("certifyGood", origin, "==", "@"+origin)
 		queryValues[origin] = *certifyGoodSpec.Origin
@@ -939,3 +953,23 @@ func (c *arangoClient) certifyGoodNeighbors(ctx context.Context, nodeID string,
 	}
 	return out, nil
 }
+
+func (c *arangoClient) CertifyGoodList(ctx context.Context, certifyGoodSpec model.CertifyGoodSpec, pagination *model.PaginationSpec) (*model.CertifyGoodConnection, error) {
+	results, err := c.CertifyGood(ctx, &certifyGoodSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.CertifyGood) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("CertifyGoodList :: %v", err)
+	}
+	edges := make([]*model.CertifyGoodEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.CertifyGoodEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.CertifyGoodConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
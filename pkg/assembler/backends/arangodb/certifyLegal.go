@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 )
@@ -997,3 +998,23 @@ func (c *arangoClient) certifyLegalNeighbors(ctx context.Context, nodeID string,
 
 	return out, nil
 }
+
+func (c *arangoClient) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, pagination *model.PaginationSpec) (*model.CertifyLegalConnection, error) {
+	results, err := c.CertifyLegal(ctx, &certifyLegalSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.CertifyLegal) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("CertifyLegalList :: %v", err)
+	}
+	edges := make([]*model.CertifyLegalEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.CertifyLegalEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.CertifyLegalConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
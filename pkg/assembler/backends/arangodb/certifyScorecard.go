@@ -25,6 +25,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 )
@@ -505,3 +506,23 @@ func (c *arangoClient) certifyScorecardNeighbors(ctx context.Context, nodeID str
 
 	return out, nil
 }
+
+func (c *arangoClient) ScorecardsList(ctx context.Context, certifyScorecardSpec model.CertifyScorecardSpec, pagination *model.PaginationSpec) (*model.CertifyScorecardConnection, error) {
+	results, err := c.Scorecards(ctx, &certifyScorecardSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.CertifyScorecard) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("ScorecardsList :: %v", err)
+	}
+	edges := make([]*model.CertifyScorecardEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.CertifyScorecardEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.CertifyScorecardConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 )
@@ -779,3 +780,23 @@ func (c *arangoClient) certifyVexNeighbors(ctx context.Context, nodeID string, a
 
 	return out, nil
 }
+
+func (c *arangoClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, pagination *model.PaginationSpec) (*model.CertifyVEXStatementConnection, error) {
+	results, err := c.CertifyVEXStatement(ctx, &certifyVEXStatementSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.CertifyVEXStatement) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("CertifyVEXStatementList :: %v", err)
+	}
+	edges := make([]*model.CertifyVEXStatementEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.CertifyVEXStatementEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.CertifyVEXStatementConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
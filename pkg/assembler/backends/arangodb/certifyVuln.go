@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 )
@@ -34,7 +35,15 @@ const (
 	scannerVersionStr string = "scannerVersion"
 )
 
+func (c *arangoClient) GetCertifyVulnsByDocumentRef(ctx context.Context, documentRef string) ([]*model.CertifyVuln, error) {
+	return c.CertifyVuln(ctx, &model.CertifyVulnSpec{DocumentRef: &documentRef})
+}
+
 func (c *arangoClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	// CVSS scores are not stored by this backend
+	if certifyVulnSpec != nil && (certifyVulnSpec.MinCVSS != nil || certifyVulnSpec.MaxCVSS != nil) {
+		return nil, fmt.Errorf("not implemented: CertifyVuln CVSS range filter")
+	}
 
 	if certifyVulnSpec != nil && certifyVulnSpec.ID != nil {
 		cv, err := c.buildCertifyVulnByID(ctx, *certifyVulnSpec.ID, certifyVulnSpec)
@@ -46,13 +55,15 @@ func (c *arangoClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.C
 
 	// TODO (pxp928): Optimization of the query can be done by starting from the vulnerability node (if specified)
 	var arangoQueryBuilder *arangoQueryBuilder
+	var certifyVulns []*model.CertifyVuln
+	var err error
 	if certifyVulnSpec.Package != nil {
 		values := map[string]any{}
 		arangoQueryBuilder = setPkgVersionMatchValues(certifyVulnSpec.Package, values)
 		arangoQueryBuilder.forOutBound(certifyVulnPkgEdgesStr, "certifyVuln", "pVersion")
 		setCertifyVulnMatchValues(arangoQueryBuilder, certifyVulnSpec, values)
 
-		return getPkgCertifyVulnForQuery(ctx, c, arangoQueryBuilder, values)
+		certifyVulns, err = getPkgCertifyVulnForQuery(ctx, c, arangoQueryBuilder, values)
 
 	} else {
 		values := map[string]any{}
@@ -63,8 +74,68 @@ func (c *arangoClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.C
 		arangoQueryBuilder.forInBound(pkgHasNameStr, "pNs", "pName")
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "pType", "pNs")
 
-		return getPkgCertifyVulnForQuery(ctx, c, arangoQueryBuilder, values)
+		certifyVulns, err = getPkgCertifyVulnForQuery(ctx, c, arangoQueryBuilder, values)
+	}
+	if err != nil {
+		return nil, err
 	}
+	helper.SortCertifyVulns(certifyVulns, certifyVulnSpec.Order)
+	return certifyVulns, nil
+}
+
+func (c *arangoClient) CheckScannerFreshness(ctx context.Context, scannerURI string, maxAge time.Duration) (*model.ScannerFreshnessResult, error) {
+	staleBefore := time.Now().UTC().Add(-maxAge)
+	values := map[string]any{
+		scannerUriStr: scannerURI,
+		"staleBefore": staleBefore,
+	}
+
+	query := `LET scans = (
+		FOR certifyVuln IN certifyVulns
+		  FILTER certifyVuln.scannerUri == @scannerUri
+		  RETURN certifyVuln
+	  )
+	  LET latest = FIRST(
+		FOR scan IN scans
+		  SORT DATE_TIMESTAMP(scan.timeScanned) DESC
+		  LIMIT 1
+		  RETURN scan
+	  )
+	  FILTER latest != null
+	  RETURN {
+		'timeScanned': latest.timeScanned,
+		'dbVersion': latest.dbVersion,
+		'staleScanCount': LENGTH(
+		  FOR scan IN scans
+			FILTER DATE_TIMESTAMP(scan.timeScanned) < DATE_TIMESTAMP(@staleBefore)
+			RETURN 1
+		)
+	  }`
+
+	cursor, err := executeQueryWithRetry(ctx, c.db, query, values, "CheckScannerFreshness")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scanner freshness: %w", err)
+	}
+	defer cursor.Close()
+
+	var doc struct {
+		TimeScanned    time.Time `json:"timeScanned"`
+		DbVersion      string    `json:"dbVersion"`
+		StaleScanCount int       `json:"staleScanCount"`
+	}
+	if _, err := cursor.ReadDocument(ctx, &doc); err != nil {
+		if driver.IsNoMoreDocuments(err) {
+			return nil, fmt.Errorf("no vulnerability certifications found for scanner %s", scannerURI)
+		}
+		return nil, fmt.Errorf("failed to read scanner freshness from cursor: %w", err)
+	}
+
+	return &model.ScannerFreshnessResult{
+		LatestScanTime: doc.TimeScanned,
+		DbVersion:      doc.DbVersion,
+		IsFresh:        !doc.TimeScanned.Before(staleBefore),
+		StaleScanCount: doc.StaleScanCount,
+	}, nil
 }
 
 func getPkgCertifyVulnForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.CertifyVuln, error) {
@@ -117,6 +188,14 @@ func setCertifyVulnMatchValues(arangoQueryBuilder *arangoQueryBuilder, certifyVu
 		arangoQueryBuilder.filter("certifyVuln", timeScannedStr, "==", "@"+timeScannedStr)
 		queryValues[timeScannedStr] = certifyVulnSpec.TimeScanned.UTC()
 	}
+	if certifyVulnSpec.TimeScannedAfter != nil {
+		arangoQueryBuilder.filter("certifyVuln", timeScannedStr, ">=", "@timeScannedAfter")
+		queryValues["timeScannedAfter"] = certifyVulnSpec.TimeScannedAfter.UTC()
+	}
+	if certifyVulnSpec.TimeScannedBefore != nil {
+		arangoQueryBuilder.filter("certifyVuln", timeScannedStr, "<=", "@timeScannedBefore")
+		queryValues["timeScannedBefore"] = certifyVulnSpec.TimeScannedBefore.UTC()
+	}
 	if certifyVulnSpec.DbURI != nil {
 		arangoQueryBuilder.filter("certifyVuln", dbUriStr, "==", "@"+dbUriStr)
 		queryValues[dbUriStr] = *certifyVulnSpec.DbURI
@@ -129,6 +208,10 @@ func setCertifyVulnMatchValues(arangoQueryBuilder *arangoQueryBuilder, certifyVu
 		arangoQueryBuilder.filter("certifyVuln", scannerUriStr, "==", "@"+scannerUriStr)
 		queryValues[scannerUriStr] = *certifyVulnSpec.ScannerURI
 	}
+	if len(certifyVulnSpec.ScannerURIIn) > 0 {
+		arangoQueryBuilder.filter("certifyVuln", scannerUriStr, "IN", "@scannerUriIn")
+		queryValues["scannerUriIn"] = certifyVulnSpec.ScannerURIIn
+	}
 	if certifyVulnSpec.ScannerVersion != nil {
 		arangoQueryBuilder.filter("certifyVuln", scannerVersionStr, "==", "@"+scannerVersionStr)
 		queryValues[scannerVersionStr] = *certifyVulnSpec.ScannerVersion
@@ -377,6 +460,8 @@ func geCertifyVulnFromCursor(ctx context.Context, cursor driver.Cursor, ingestio
 				Collector:      createdValue.Collector,
 				DocumentRef:    createdValue.DocumentRef,
 			},
+			// remediation status is not tracked by this backend
+			RemediationStatus: model.RemediationStatusOpen,
 		}
 		if createdValue.PkgVersion != nil {
 			pkg := generateModelPackage(createdValue.PkgVersion.TypeID, createdValue.PkgVersion.PkgType, createdValue.PkgVersion.NamespaceID, createdValue.PkgVersion.Namespace, createdValue.PkgVersion.NameID,
@@ -493,6 +578,8 @@ func (c *arangoClient) queryCertifyVulnNodeByID(ctx context.Context, filter *mod
 			Collector:      collectedValues[0].Collector,
 			DocumentRef:    collectedValues[0].DocumentRef,
 		},
+		// remediation status is not tracked by this backend
+		RemediationStatus: model.RemediationStatusOpen,
 	}
 
 	builtVuln, err := c.buildVulnResponseByID(ctx, collectedValues[0].VulnerabilityID, filter.Vulnerability)
@@ -540,3 +627,31 @@ func (c *arangoClient) certifyVulnNeighbors(ctx context.Context, nodeID string,
 
 	return out, nil
 }
+
+func (c *arangoClient) CertifyVulnCount(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec) (int, error) {
+	results, err := c.CertifyVuln(ctx, &certifyVulnSpec)
+	if err != nil {
+		return 0, err
+	}
+	return len(results), nil
+}
+
+func (c *arangoClient) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, pagination *model.PaginationSpec) (*model.CertifyVulnConnection, error) {
+	results, err := c.CertifyVuln(ctx, &certifyVulnSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.CertifyVuln) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("CertifyVulnList :: %v", err)
+	}
+	edges := make([]*model.CertifyVulnEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.CertifyVulnEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.CertifyVulnConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
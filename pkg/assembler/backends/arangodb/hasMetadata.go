@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 )
@@ -960,3 +961,23 @@ func (c *arangoClient) hasMetadataNeighbors(ctx context.Context, nodeID string,
 
 	return out, nil
 }
+
+func (c *arangoClient) HasMetadataList(ctx context.Context, hasMetadataSpec model.HasMetadataSpec, pagination *model.PaginationSpec) (*model.HasMetadataConnection, error) {
+	results, err := c.HasMetadata(ctx, &hasMetadataSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.HasMetadata) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("HasMetadataList :: %v", err)
+	}
+	edges := make([]*model.HasMetadataEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.HasMetadataEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.HasMetadataConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -1103,3 +1103,23 @@ func (c *arangoClient) hasSbomNeighbors(ctx context.Context, nodeID string, allo
 
 	return out, nil
 }
+
+func (c *arangoClient) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, pagination *model.PaginationSpec) (*model.HasSBOMConnection, error) {
+	results, err := c.HasSBOM(ctx, &hasSBOMSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.HasSbom) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("HasSBOMList :: %v", err)
+	}
+	edges := make([]*model.HasSBOMEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.HasSBOMEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.HasSBOMConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
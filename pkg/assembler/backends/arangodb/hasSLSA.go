@@ -679,3 +679,23 @@ func (c *arangoClient) hasSlsaNeighbors(ctx context.Context, nodeID string, allo
 
 	return out, nil
 }
+
+func (c *arangoClient) HasSLSAList(ctx context.Context, hasSLSASpec model.HasSLSASpec, pagination *model.PaginationSpec) (*model.HasSLSAConnection, error) {
+	results, err := c.HasSlsa(ctx, &hasSLSASpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.HasSlsa) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("HasSLSAList :: %v", err)
+	}
+	edges := make([]*model.HasSLSAEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.HasSLSAEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.HasSLSAConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 )
@@ -69,6 +70,7 @@ func (c *arangoClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.H
 			combinedHasSourceAt = append(combinedHasSourceAt, pkgNameHasSourceAt...)
 		}
 
+		helper.SortHasSourceAts(combinedHasSourceAt, hasSourceAtSpec.Order)
 		return combinedHasSourceAt, nil
 	} else {
 		values := map[string]any{}
@@ -102,6 +104,7 @@ func (c *arangoClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.H
 		}
 		combinedHasSourceAt = append(combinedHasSourceAt, pkgNameHasSourceAt...)
 
+		helper.SortHasSourceAts(combinedHasSourceAt, hasSourceAtSpec.Order)
 		return combinedHasSourceAt, nil
 	}
 }
@@ -663,3 +666,31 @@ func (c *arangoClient) hasSourceAtNeighbors(ctx context.Context, nodeID string,
 
 	return out, nil
 }
+
+func (c *arangoClient) HasSourceAtCount(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec) (int, error) {
+	results, err := c.HasSourceAt(ctx, &hasSourceAtSpec)
+	if err != nil {
+		return 0, err
+	}
+	return len(results), nil
+}
+
+func (c *arangoClient) HasSourceAtList(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec, pagination *model.PaginationSpec) (*model.HasSourceAtConnection, error) {
+	results, err := c.HasSourceAt(ctx, &hasSourceAtSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.HasSourceAt) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("HasSourceAtList :: %v", err)
+	}
+	edges := make([]*model.HasSourceAtEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.HasSourceAtEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.HasSourceAtConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
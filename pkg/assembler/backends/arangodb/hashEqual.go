@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -463,3 +464,23 @@ func (c *arangoClient) hashEqualNeighbors(ctx context.Context, nodeID string, al
 	}
 	return out, nil
 }
+
+func (c *arangoClient) HashEqualList(ctx context.Context, hashEqualSpec model.HashEqualSpec, pagination *model.PaginationSpec) (*model.HashEqualConnection, error) {
+	results, err := c.HashEqual(ctx, &hashEqualSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.HashEqual) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("HashEqualList :: %v", err)
+	}
+	edges := make([]*model.HashEqualEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.HashEqualEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.HashEqualConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -824,3 +824,23 @@ func matchDependencies(ctx context.Context, filters []*model.IsDependencySpec, d
 	}
 	return true
 }
+
+func (c *arangoClient) IsDependencyList(ctx context.Context, isDependencySpec model.IsDependencySpec, pagination *model.PaginationSpec) (*model.IsDependencyConnection, error) {
+	results, err := c.IsDependency(ctx, &isDependencySpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.IsDependency) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("IsDependencyList :: %v", err)
+	}
+	edges := make([]*model.IsDependencyEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.IsDependencyEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.IsDependencyConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
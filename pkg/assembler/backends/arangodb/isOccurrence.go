@@ -754,3 +754,23 @@ func matchOccurrences(ctx context.Context, filters []*model.IsOccurrenceSpec, oc
 	}
 	return true
 }
+
+func (c *arangoClient) IsOccurrenceList(ctx context.Context, isOccurrenceSpec model.IsOccurrenceSpec, pagination *model.PaginationSpec) (*model.IsOccurrenceConnection, error) {
+	results, err := c.IsOccurrence(ctx, &isOccurrenceSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.IsOccurrence) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("IsOccurrenceList :: %v", err)
+	}
+	edges := make([]*model.IsOccurrenceEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.IsOccurrenceEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.IsOccurrenceConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
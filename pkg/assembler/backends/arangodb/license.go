@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"github.com/arangodb/go-driver"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -368,3 +369,23 @@ func (c *arangoClient) licenseNeighbors(ctx context.Context, nodeID string, allo
 
 	return out, nil
 }
+
+func (c *arangoClient) LicensesList(ctx context.Context, licenseSpec model.LicenseSpec, pagination *model.PaginationSpec) (*model.LicenseConnection, error) {
+	results, err := c.Licenses(ctx, &licenseSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.License) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("LicensesList :: %v", err)
+	}
+	edges := make([]*model.LicenseEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.LicenseEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.LicenseConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"github.com/arangodb/go-driver"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -124,6 +125,10 @@ IN 1..@maxLength ANY K_PATHS
 	return foundNodes, nil
 }
 
+func (c *arangoClient) NeighborsRecursive(ctx context.Context, nodeID string, usingOnly []model.Edge, maxDepth int) ([]model.Node, error) {
+	return helper.NeighborsRecursive(ctx, nodeID, usingOnly, maxDepth, c.Neighbors)
+}
+
 // TODO (pxp928): investigate if the individual neighbor queries (within nouns and verbs) can be done co-currently
 func (c *arangoClient) Neighbors(ctx context.Context, nodeID string, usingOnly []model.Edge) ([]model.Node, error) {
 	var neighborsID []string
@@ -353,3 +358,60 @@ func (c *arangoClient) Nodes(ctx context.Context, nodeIDs []string) ([]model.Nod
 	}
 	return rv, nil
 }
+
+func (c *arangoClient) NodeType(ctx context.Context, nodeID string) (string, error) {
+	idSplit := strings.Split(nodeID, "/")
+	if len(idSplit) != 2 {
+		return "", fmt.Errorf("invalid ID: %s", nodeID)
+	}
+	switch idSplit[0] {
+	case pkgVersionsStr, pkgNamesStr, pkgNamespacesStr, pkgTypesStr:
+		return "Package", nil
+	case srcNamesStr, srcNamespacesStr, srcTypesStr:
+		return "Source", nil
+	case vulnerabilitiesStr, vulnTypesStr:
+		return "Vulnerability", nil
+	case buildersStr:
+		return "Builder", nil
+	case artifactsStr:
+		return "Artifact", nil
+	case licensesStr:
+		return "License", nil
+	case certifyBadsStr:
+		return "CertifyBad", nil
+	case certifyGoodsStr:
+		return "CertifyGood", nil
+	case certifyLegalsStr:
+		return "CertifyLegal", nil
+	case scorecardStr:
+		return "CertifyScorecard", nil
+	case certifyVEXsStr:
+		return "CertifyVEXStatement", nil
+	case certifyVulnsStr:
+		return "CertifyVuln", nil
+	case hashEqualsStr:
+		return "HashEqual", nil
+	case hasMetadataStr:
+		return "HasMetadata", nil
+	case hasSBOMsStr:
+		return "HasSBOM", nil
+	case hasSLSAsStr:
+		return "HasSLSA", nil
+	case hasSourceAtsStr:
+		return "HasSourceAt", nil
+	case isDependenciesStr:
+		return "IsDependency", nil
+	case isOccurrencesStr:
+		return "IsOccurrence", nil
+	case pkgEqualsStr:
+		return "PkgEqual", nil
+	case pointOfContactStr:
+		return "PointOfContact", nil
+	case vulnEqualsStr:
+		return "VulnEqual", nil
+	case vulnMetadataStr:
+		return "VulnerabilityMetadata", nil
+	default:
+		return "", fmt.Errorf("unknown ID for node type query: %s", nodeID)
+	}
+}
@@ -430,7 +430,14 @@ func (c *arangoClient) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]
 	}
 	defer cursor.Close()
 
-	return getPackages(ctx, cursor)
+	pkgs, err := getPackages(ctx, cursor)
+	if err != nil {
+		return nil, err
+	}
+	if pkgSpec != nil {
+		return helper.SortPackages(pkgs, pkgSpec.Order), nil
+	}
+	return pkgs, nil
 }
 
 func (c *arangoClient) packagesType(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
@@ -1587,3 +1594,34 @@ func noMatchQualifiers(filter *model.PkgSpec, v []*model.PackageQualifier) bool
 	}
 	return false
 }
+
+func (c *arangoClient) PackagesCount(ctx context.Context, pkgSpec model.PkgSpec) (int, error) {
+	results, err := c.Packages(ctx, &pkgSpec)
+	if err != nil {
+		return 0, err
+	}
+	// count package versions, as PackagesList pages over them
+	return len(helper.SplitPackageVersions(results)), nil
+}
+
+func (c *arangoClient) PackagesList(ctx context.Context, pkgSpec model.PkgSpec, pagination *model.PaginationSpec) (*model.PackageConnection, error) {
+	results, err := c.Packages(ctx, &pkgSpec)
+	if err != nil {
+		return nil, err
+	}
+	// page over package versions, each returned as its own package tree
+	versions := helper.SplitPackageVersions(results)
+	page, pageInfo, err := helper.PaginateSlice(versions, helper.PackageVersionID, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("PackagesList :: %v", err)
+	}
+	edges := make([]*model.PackageEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.PackageEdge{Cursor: helper.PackageVersionID(n), Node: n})
+	}
+	return &model.PackageConnection{
+		TotalCount: len(versions),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 	purl "github.com/package-url/packageurl-go"
@@ -665,3 +666,23 @@ func (c *arangoClient) pkgEqualNeighbors(ctx context.Context, nodeID string, all
 	}
 	return out, nil
 }
+
+func (c *arangoClient) PkgEqualList(ctx context.Context, pkgEqualSpec model.PkgEqualSpec, pagination *model.PaginationSpec) (*model.PkgEqualConnection, error) {
+	results, err := c.PkgEqual(ctx, &pkgEqualSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.PkgEqual) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("PkgEqualList :: %v", err)
+	}
+	edges := make([]*model.PkgEqualEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.PkgEqualEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.PkgEqualConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 )
@@ -959,3 +960,23 @@ func (c *arangoClient) pointOfContactNeighbors(ctx context.Context, nodeID strin
 	}
 	return out, nil
 }
+
+func (c *arangoClient) PointOfContactList(ctx context.Context, pointOfContactSpec model.PointOfContactSpec, pagination *model.PaginationSpec) (*model.PointOfContactConnection, error) {
+	results, err := c.PointOfContact(ctx, &pointOfContactSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.PointOfContact) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("PointOfContactList :: %v", err)
+	}
+	edges := make([]*model.PointOfContactEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.PointOfContactEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.PointOfContactConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -254,6 +254,7 @@ func setSrcMatchValues(srcSpec *model.SourceSpec, queryValues map[string]any) *a
 			arangoQueryBuilder.filter("sName", "name", "==", "@name")
 			queryValues["name"] = *srcSpec.Name
 		}
+		setSrcNamePatternValues(arangoQueryBuilder, srcSpec, queryValues)
 		if srcSpec.Commit != nil {
 			arangoQueryBuilder.filter("sName", "commit", "==", "@commit")
 			queryValues["commit"] = *srcSpec.Commit
@@ -270,6 +271,26 @@ func setSrcMatchValues(srcSpec *model.SourceSpec, queryValues map[string]any) *a
 	return arangoQueryBuilder
 }
 
+// setSrcNamePatternValues filters source names on the prefix, substring, suffix and glob pattern of the spec with LIKE
+func setSrcNamePatternValues(arangoQueryBuilder *arangoQueryBuilder, srcSpec *model.SourceSpec, queryValues map[string]any) {
+	if srcSpec.NamePrefix != nil {
+		arangoQueryBuilder.filter("sName", "name", "LIKE", "@namePrefix")
+		queryValues["namePrefix"] = helper.PrefixToLike(*srcSpec.NamePrefix)
+	}
+	if srcSpec.NameGlob != nil {
+		arangoQueryBuilder.filter("sName", "name", "LIKE", "@nameGlob")
+		queryValues["nameGlob"] = helper.GlobToLike(*srcSpec.NameGlob)
+	}
+	if srcSpec.NameContains != nil {
+		arangoQueryBuilder.filter("sName", "name", "LIKE", "@nameContains")
+		queryValues["nameContains"] = helper.ContainsToLike(*srcSpec.NameContains)
+	}
+	if srcSpec.NameSuffix != nil {
+		arangoQueryBuilder.filter("sName", "name", "LIKE", "@nameSuffix")
+		queryValues["nameSuffix"] = helper.SuffixToLike(*srcSpec.NameSuffix)
+	}
+}
+
 func (c *arangoClient) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
 	if sourceSpec != nil && sourceSpec.ID != nil {
 		p, err := c.buildSourceResponseFromID(ctx, *sourceSpec.ID, sourceSpec)
@@ -323,7 +344,14 @@ func (c *arangoClient) Sources(ctx context.Context, sourceSpec *model.SourceSpec
 	}
 	defer cursor.Close()
 
-	return getSources(ctx, cursor)
+	srcs, err := getSources(ctx, cursor)
+	if err != nil {
+		return nil, err
+	}
+	if sourceSpec != nil {
+		return helper.SortSources(srcs, sourceSpec.Order), nil
+	}
+	return srcs, nil
 }
 
 func (c *arangoClient) sourcesType(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
@@ -588,6 +616,7 @@ func (c *arangoClient) querySrcNameNodeByID(ctx context.Context, id string, filt
 			arangoQueryBuilder.filter("sName", "name", "==", "@name")
 			values["name"] = *filter.Name
 		}
+		setSrcNamePatternValues(arangoQueryBuilder, filter, values)
 		if filter.Commit != nil {
 			arangoQueryBuilder.filter("sName", "commit", "==", "@commit")
 			values["commit"] = *filter.Commit
@@ -996,3 +1025,34 @@ func matchSources(ctx context.Context, filter []*model.SourceSpec, sources []*mo
 	}
 	return true
 }
+
+func (c *arangoClient) SourcesCount(ctx context.Context, sourceSpec model.SourceSpec) (int, error) {
+	results, err := c.Sources(ctx, &sourceSpec)
+	if err != nil {
+		return 0, err
+	}
+	// count source names, as SourcesList pages over them
+	return len(helper.SplitSourceNames(results)), nil
+}
+
+func (c *arangoClient) SourcesList(ctx context.Context, sourceSpec model.SourceSpec, pagination *model.PaginationSpec) (*model.SourceConnection, error) {
+	results, err := c.Sources(ctx, &sourceSpec)
+	if err != nil {
+		return nil, err
+	}
+	// page over source names, each returned as its own tree
+	leaves := helper.SplitSourceNames(results)
+	page, pageInfo, err := helper.PaginateSlice(leaves, helper.SourceNameID, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("SourcesList :: %v", err)
+	}
+	edges := make([]*model.SourceEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.SourceEdge{Cursor: helper.SourceNameID(n), Node: n})
+	}
+	return &model.SourceConnection{
+		TotalCount: len(leaves),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 )
@@ -547,3 +548,23 @@ func (c *arangoClient) vulnEqualNeighbors(ctx context.Context, nodeID string, al
 	}
 	return out, nil
 }
+
+func (c *arangoClient) VulnEqualList(ctx context.Context, vulnEqualSpec model.VulnEqualSpec, pagination *model.PaginationSpec) (*model.VulnEqualConnection, error) {
+	results, err := c.VulnEqual(ctx, &vulnEqualSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.VulnEqual) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("VulnEqualList :: %v", err)
+	}
+	edges := make([]*model.VulnEqualEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.VulnEqualEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.VulnEqualConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
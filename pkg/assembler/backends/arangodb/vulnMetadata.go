@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 )
@@ -452,3 +453,23 @@ func (c *arangoClient) vulnMetadataNeighbors(ctx context.Context, nodeID string,
 
 	return out, nil
 }
+
+func (c *arangoClient) VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, pagination *model.PaginationSpec) (*model.VulnerabilityMetadataConnection, error) {
+	results, err := c.VulnerabilityMetadata(ctx, &vulnerabilityMetadataSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.VulnerabilityMetadata) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("VulnerabilityMetadataList :: %v", err)
+	}
+	edges := make([]*model.VulnerabilityMetadataEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.VulnerabilityMetadataEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.VulnerabilityMetadataConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 )
@@ -635,3 +636,25 @@ func (c *arangoClient) vulnIdNeighbors(ctx context.Context, nodeID string, allow
 
 	return out, nil
 }
+
+func (c *arangoClient) VulnerabilitiesList(ctx context.Context, vulnSpec model.VulnerabilitySpec, pagination *model.PaginationSpec) (*model.VulnerabilityConnection, error) {
+	results, err := c.Vulnerabilities(ctx, &vulnSpec)
+	if err != nil {
+		return nil, err
+	}
+	// page over vulnerability IDs, each returned as its own tree
+	leaves := helper.SplitVulnerabilityIDs(results)
+	page, pageInfo, err := helper.PaginateSlice(leaves, helper.VulnerabilityIDID, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("VulnerabilitiesList :: %v", err)
+	}
+	edges := make([]*model.VulnerabilityEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.VulnerabilityEdge{Cursor: helper.VulnerabilityIDID(n), Node: n})
+	}
+	return &model.VulnerabilityConnection{
+		TotalCount: len(leaves),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
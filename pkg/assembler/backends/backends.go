@@ -17,6 +17,7 @@ package backends
 
 import (
 	"context"
+	"time"
 
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
@@ -38,7 +39,14 @@ type Backend interface {
 	CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error)
 	CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error)
 	CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error)
+	CertifyVulnByVulnerabilityIDs(ctx context.Context, vulnerabilityIDs []string, certifyVulnSpec *model.CertifyVulnSpec) (map[string][]*model.CertifyVuln, error)
+	CertifyVulnByPackageIDs(ctx context.Context, packageIDs []string, certifyVulnSpec *model.CertifyVulnSpec) (map[string][]*model.CertifyVuln, error)
+	TopVulnerablePackages(ctx context.Context, limit int, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.PackageVulnCount, error)
+	GetCertifyVulnsByDocumentRef(ctx context.Context, documentRef string) ([]*model.CertifyVuln, error)
+	// ArchivedCertifyVuln queries the CertifyVuln nodes moved out by ArchiveCertifyVulns
+	ArchivedCertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error)
 	CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error)
+	ExploitReferences(ctx context.Context, exploitReferenceSpec *model.ExploitReferenceSpec) ([]*model.ExploitReference, error)
 	HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error)
 	HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error)
 	HasSourceAt(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec) ([]*model.HasSourceAt, error)
@@ -52,6 +60,37 @@ type Backend interface {
 	VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error)
 	VulnerabilityMetadata(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec) ([]*model.VulnerabilityMetadata, error)
 
+	// Paginated retrieval read-only queries for software and evidence trees
+	ArtifactsList(ctx context.Context, artifactSpec model.ArtifactSpec, pagination *model.PaginationSpec) (*model.ArtifactConnection, error)
+	BuildersList(ctx context.Context, builderSpec model.BuilderSpec, pagination *model.PaginationSpec) (*model.BuilderConnection, error)
+	LicensesList(ctx context.Context, licenseSpec model.LicenseSpec, pagination *model.PaginationSpec) (*model.LicenseConnection, error)
+	PackagesList(ctx context.Context, pkgSpec model.PkgSpec, pagination *model.PaginationSpec) (*model.PackageConnection, error)
+	SourcesList(ctx context.Context, sourceSpec model.SourceSpec, pagination *model.PaginationSpec) (*model.SourceConnection, error)
+	VulnerabilitiesList(ctx context.Context, vulnSpec model.VulnerabilitySpec, pagination *model.PaginationSpec) (*model.VulnerabilityConnection, error)
+	CertifyBadList(ctx context.Context, certifyBadSpec model.CertifyBadSpec, pagination *model.PaginationSpec) (*model.CertifyBadConnection, error)
+	CertifyGoodList(ctx context.Context, certifyGoodSpec model.CertifyGoodSpec, pagination *model.PaginationSpec) (*model.CertifyGoodConnection, error)
+	CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, pagination *model.PaginationSpec) (*model.CertifyVEXStatementConnection, error)
+	CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, pagination *model.PaginationSpec) (*model.CertifyVulnConnection, error)
+	CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, pagination *model.PaginationSpec) (*model.CertifyLegalConnection, error)
+	HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, pagination *model.PaginationSpec) (*model.HasSBOMConnection, error)
+	HasSLSAList(ctx context.Context, hasSLSASpec model.HasSLSASpec, pagination *model.PaginationSpec) (*model.HasSLSAConnection, error)
+	HasSourceAtList(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec, pagination *model.PaginationSpec) (*model.HasSourceAtConnection, error)
+	HasMetadataList(ctx context.Context, hasMetadataSpec model.HasMetadataSpec, pagination *model.PaginationSpec) (*model.HasMetadataConnection, error)
+	HashEqualList(ctx context.Context, hashEqualSpec model.HashEqualSpec, pagination *model.PaginationSpec) (*model.HashEqualConnection, error)
+	IsDependencyList(ctx context.Context, isDependencySpec model.IsDependencySpec, pagination *model.PaginationSpec) (*model.IsDependencyConnection, error)
+	IsOccurrenceList(ctx context.Context, isOccurrenceSpec model.IsOccurrenceSpec, pagination *model.PaginationSpec) (*model.IsOccurrenceConnection, error)
+	PkgEqualList(ctx context.Context, pkgEqualSpec model.PkgEqualSpec, pagination *model.PaginationSpec) (*model.PkgEqualConnection, error)
+	PointOfContactList(ctx context.Context, pointOfContactSpec model.PointOfContactSpec, pagination *model.PaginationSpec) (*model.PointOfContactConnection, error)
+	ScorecardsList(ctx context.Context, certifyScorecardSpec model.CertifyScorecardSpec, pagination *model.PaginationSpec) (*model.CertifyScorecardConnection, error)
+	VulnEqualList(ctx context.Context, vulnEqualSpec model.VulnEqualSpec, pagination *model.PaginationSpec) (*model.VulnEqualConnection, error)
+	VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, pagination *model.PaginationSpec) (*model.VulnerabilityMetadataConnection, error)
+
+	// Count read-only queries: number of nodes matching a filter, consistent with the totalCount of the paginated queries
+	PackagesCount(ctx context.Context, pkgSpec model.PkgSpec) (int, error)
+	SourcesCount(ctx context.Context, sourceSpec model.SourceSpec) (int, error)
+	CertifyVulnCount(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec) (int, error)
+	HasSourceAtCount(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec) (int, error)
+
 	// Mutations for software trees (read-write queries)
 	IngestArtifact(ctx context.Context, artifact *model.IDorArtifactInput) (string, error)
 	IngestArtifacts(ctx context.Context, artifacts []*model.IDorArtifactInput) ([]string, error)
@@ -77,6 +116,7 @@ type Backend interface {
 	IngestCertifyLegals(ctx context.Context, subjects model.PackageOrSourceInputs, declaredLicensesList [][]*model.IDorLicenseInput, discoveredLicensesList [][]*model.IDorLicenseInput, certifyLegals []*model.CertifyLegalInputSpec) ([]string, error)
 	IngestDependency(ctx context.Context, pkg model.IDorPkgInput, depPkg model.IDorPkgInput, depPkgMatchType model.MatchFlags, dependency model.IsDependencyInputSpec) (string, error)
 	IngestDependencies(ctx context.Context, pkgs []*model.IDorPkgInput, depPkgs []*model.IDorPkgInput, depPkgMatchType model.MatchFlags, dependencies []*model.IsDependencyInputSpec) ([]string, error)
+	IngestExploitReference(ctx context.Context, vulnerability model.IDorVulnerabilityInput, exploitReference model.ExploitReferenceInputSpec) (string, error)
 	IngestHasSbom(ctx context.Context, subject model.PackageOrArtifactInput, hasSbom model.HasSBOMInputSpec, includes model.HasSBOMIncludesInputSpec) (string, error)
 	IngestHasSBOMs(ctx context.Context, subjects model.PackageOrArtifactInputs, hasSBOMs []*model.HasSBOMInputSpec, includes []*model.HasSBOMIncludesInputSpec) ([]string, error)
 	IngestHasSourceAt(ctx context.Context, pkg model.IDorPkgInput, pkgMatchType model.MatchFlags, source model.IDorSourceInput, hasSourceAt model.HasSourceAtInputSpec) (string, error)
@@ -102,10 +142,47 @@ type Backend interface {
 	IngestVulnerabilityMetadata(ctx context.Context, vulnerability model.IDorVulnerabilityInput, vulnerabilityMetadata model.VulnerabilityMetadataInputSpec) (string, error)
 	IngestBulkVulnerabilityMetadata(ctx context.Context, vulnerabilities []*model.IDorVulnerabilityInput, vulnerabilityMetadataList []*model.VulnerabilityMetadataInputSpec) ([]string, error)
 
+	// Maintenance mutations: bulk updates over existing evidence trees
+	MarkStaleVulns(ctx context.Context, olderThan time.Duration) (int, error)
+	// ArchiveCertifyVulns moves the CertifyVuln nodes scanned more than olderThan ago
+	// out of regular queries, returning how many were moved
+	ArchiveCertifyVulns(ctx context.Context, olderThan time.Duration) (int, error)
+
+	// Update mutations: correct the fields of a single evidence node by ID
+	UpdateHasSourceAt(ctx context.Context, id string, hasSourceAt model.HasSourceAtInputSpec) (*model.HasSourceAt, error)
+	UpdatePointOfContact(ctx context.Context, id string, pointOfContact model.PointOfContactInputSpec) (*model.PointOfContact, error)
+	UpdateCertifyScorecard(ctx context.Context, id string, scorecard model.ScorecardInputSpec) (*model.CertifyScorecard, error)
+	// UpdateCertifyVulnResolution records the HasSBOM or HasSourceAt that
+	// resolved the vulnerability of a CertifyVuln
+	UpdateCertifyVulnResolution(ctx context.Context, id string, resolvedByID string) (*model.CertifyVuln, error)
+
+	// Delete mutations: remove a single evidence node by ID
+	DeleteCertifyVuln(ctx context.Context, id string) error
+	// DeleteSource removes a source name node with all the evidence nodes
+	// attached to it
+	DeleteSource(ctx context.Context, id string) error
+
+	// Merge mutations: unify duplicate noun nodes
+	// MergePackages moves the evidence of the duplicate package versions or
+	// names to the primary one and deletes the duplicates
+	MergePackages(ctx context.Context, primary string, duplicates []string) (*model.Package, error)
+
+	// Analysis queries: aggregates computed over evidence trees
+	CheckScannerFreshness(ctx context.Context, scannerURI string, maxAge time.Duration) (*model.ScannerFreshnessResult, error)
+	SBOMComponentBreakdown(ctx context.Context, hasSBOMID string) ([]*model.ComponentTypeCount, error)
+	// GetProvenance gathers the supply chain attestations recorded for a package
+	GetProvenance(ctx context.Context, pkgID string) (*model.ProvenanceSummary, error)
+
+	// Subscriptions: streams of evidence nodes as they are created, closed when ctx is done
+	CertifyVulnAdded(ctx context.Context, filter *model.CertifyVulnSpec) (<-chan *model.CertifyVuln, error)
+
 	// Topological queries: queries where node connectivity matters more than node type
 	Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error)
+	NeighborsRecursive(ctx context.Context, node string, usingOnly []model.Edge, maxDepth int) ([]model.Node, error)
 	Node(ctx context.Context, node string) (model.Node, error)
 	Nodes(ctx context.Context, nodes []string) ([]model.Node, error)
+	BatchNodes(ctx context.Context, nodes []string) ([]model.Node, error)
+	NodeType(ctx context.Context, node string) (string, error)
 	Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error)
 
 	// Search queries: queries to help find data in GUAC based on text search
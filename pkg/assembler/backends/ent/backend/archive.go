@@ -0,0 +1,152 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvulnarchive"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// archiveBatchSize is the number of CertifyVuln rows moved to the archive per
+// statement, which bounds how long the rows of certify_vulns stay locked.
+const archiveBatchSize = 1000
+
+func (b *EntBackend) ArchiveCertifyVulns(ctx context.Context, olderThan time.Duration) (int, error) {
+	funcName := "ArchiveCertifyVulns"
+
+	before := time.Now().UTC().Add(-olderThan)
+	archived := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return archived, Errorf("%v :: stopped after archiving %d certifyVulns: %s", funcName, archived, err)
+		}
+		moved, err := b.client.ArchiveCertifyVulnBatch(ctx, before, archiveBatchSize)
+		if err != nil {
+			return archived, Errorf("%v :: %s", funcName, err)
+		}
+		archived += moved
+		if moved < archiveBatchSize {
+			return archived, nil
+		}
+	}
+}
+
+func (b *EntBackend) ArchivedCertifyVuln(ctx context.Context, spec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	if spec == nil {
+		spec = &model.CertifyVulnSpec{}
+	}
+	query := b.client.CertifyVulnArchive.Query().
+		Where(certifyVulnArchivePredicate(*spec)).
+		Order(certifyVulnArchiveOrder(spec.Order)...).
+		WithPackage(func(q *ent.PackageVersionQuery) {
+			q.WithName(func(q *ent.PackageNameQuery) {})
+		}).
+		WithVulnerability(func(query *ent.VulnerabilityIDQuery) {})
+
+	records, err := query.
+		Limit(MaxPageSize).
+		All(ctx)
+	if err != nil {
+		return nil, Errorf("ArchivedCertifyVuln :: %s", err)
+	}
+	return collect(records, toModelArchivedCertifyVuln), nil
+}
+
+// certifyVulnArchivePredicate matches archived records the way
+// certifyVulnPredicate matches the ones still in certify_vulns.
+func certifyVulnArchivePredicate(spec model.CertifyVulnSpec) predicate.CertifyVulnArchive {
+	predicates := []predicate.CertifyVulnArchive{
+		optionalPredicate(spec.ID, IDEQ),
+		optionalPredicate(spec.Collector, certifyvulnarchive.CollectorEQ),
+		optionalPredicate(spec.Origin, certifyvulnarchive.OriginEQ),
+		optionalPredicate(spec.DbURI, certifyvulnarchive.DbURIEQ),
+		optionalPredicate(spec.DbVersion, certifyvulnarchive.DbVersionEQ),
+		optionalPredicate(spec.ScannerURI, certifyvulnarchive.ScannerURIEQ),
+		optionalPredicate(spec.ScannerVersion, certifyvulnarchive.ScannerVersionEQ),
+		optionalPredicate(spec.TimeScanned, certifyvulnarchive.TimeScannedEQ),
+		optionalPredicate(spec.DocumentRef, certifyvulnarchive.DocumentRefEQ),
+		optionalPredicate(spec.MinCVSS, certifyvulnarchive.CvssScoreGTE),
+		optionalPredicate(spec.MaxCVSS, certifyvulnarchive.CvssScoreLTE),
+		optionalPredicate(spec.TimeScannedAfter, certifyvulnarchive.TimeScannedGTE),
+		optionalPredicate(spec.TimeScannedBefore, certifyvulnarchive.TimeScannedLTE),
+		optionalPredicate(spec.Package, func(pkg model.PkgSpec) predicate.CertifyVulnArchive {
+			return certifyvulnarchive.HasPackageWith(
+				packageVersionQuery(spec.Package),
+			)
+		}),
+		optionalPredicate(spec.Vulnerability, func(vuln model.VulnerabilitySpec) predicate.CertifyVulnArchive {
+			return certifyvulnarchive.HasVulnerabilityWith(
+				vulnerabilityQueryPredicates(*spec.Vulnerability)...,
+			)
+		}),
+	}
+	if len(spec.ScannerURIIn) > 0 {
+		predicates = append(predicates, certifyvulnarchive.ScannerURIIn(spec.ScannerURIIn...))
+	}
+	return certifyvulnarchive.And(predicates...)
+}
+
+func certifyVulnArchiveOrder(order *model.CertifyVulnOrder) []certifyvulnarchive.OrderOption {
+	if order == nil {
+		return nil
+	}
+	term := orderTerm(order.SortOrder)
+	switch order.SortBy {
+	case model.CertifyVulnOrderFieldTimeScanned:
+		return []certifyvulnarchive.OrderOption{certifyvulnarchive.ByTimeScanned(term)}
+	case model.CertifyVulnOrderFieldScannerVersion:
+		return []certifyvulnarchive.OrderOption{certifyvulnarchive.ByScannerVersion(term)}
+	case model.CertifyVulnOrderFieldDbVersion:
+		return []certifyvulnarchive.OrderOption{certifyvulnarchive.ByDbVersion(term)}
+	}
+	return nil
+}
+
+// toModelArchivedCertifyVuln converts an archived record through
+// toModelCertifyVuln, as it has the same fields and edges. The record keeps the
+// UUID it had before it was archived, under the archive's node type, so that
+// its ID is not taken for the one of a certifyVuln that Node can look up.
+func toModelArchivedCertifyVuln(record *ent.CertifyVulnArchive) *model.CertifyVuln {
+	cv := toModelCertifyVuln(&ent.CertifyVuln{
+		ID:                record.ID,
+		VulnerabilityID:   record.VulnerabilityID,
+		PackageID:         record.PackageID,
+		TimeScanned:       record.TimeScanned,
+		DbURI:             record.DbURI,
+		DbVersion:         record.DbVersion,
+		ScannerURI:        record.ScannerURI,
+		ScannerVersion:    record.ScannerVersion,
+		Origin:            record.Origin,
+		Collector:         record.Collector,
+		DocumentRef:       record.DocumentRef,
+		CvssScore:         record.CvssScore,
+		RemediationStatus: certifyvuln.RemediationStatus(record.RemediationStatus),
+		ResolvedByID:      record.ResolvedByID,
+		ResolvedByType:    record.ResolvedByType,
+		Edges: ent.CertifyVulnEdges{
+			Vulnerability: record.Edges.Vulnerability,
+			Package:       record.Edges.Package,
+		},
+	})
+	cv.ID = toGlobalID(certifyvulnarchive.Table, record.ID.String())
+	return cv
+}
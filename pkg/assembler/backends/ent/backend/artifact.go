@@ -31,7 +31,6 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func (b *EntBackend) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
@@ -61,6 +60,7 @@ func artifactQueryPredicates(spec *model.ArtifactSpec) predicate.Artifact {
 		optionalPredicate(spec.ID, IDEQ),
 		optionalPredicate(spec.Algorithm, artifact.AlgorithmEqualFold),
 		optionalPredicate(spec.Digest, artifact.DigestEqualFold),
+		optionalPredicate(toLowerPtr(spec.DigestPrefix), artifact.DigestHasPrefix),
 	)
 }
 
@@ -75,7 +75,7 @@ func (b *EntBackend) IngestArtifacts(ctx context.Context, artifacts []*model.IDo
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(artifact.Table, *ids), nil
@@ -266,3 +266,31 @@ func (b *EntBackend) artifactNeighbors(ctx context.Context, nodeID string, allow
 
 	return out, nil
 }
+
+func (b *EntBackend) ArtifactsList(ctx context.Context, artifactSpec model.ArtifactSpec, pagination *model.PaginationSpec) (*model.ArtifactConnection, error) {
+	funcName := "ArtifactsList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := b.client.Artifact.Query().
+		Where(artifactQueryPredicates(&artifactSpec)).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.ArtifactEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.ArtifactEdge{
+			Cursor: toGlobalID(artifact.Table, edge.Node.ID.String()),
+			Node:   toModelArtifact(edge.Node),
+		})
+	}
+	return &model.ArtifactConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, artifact.Table),
+		Edges:      edges,
+	}, nil
+}
@@ -21,7 +21,8 @@ import (
 
 	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
-	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 
 	// Import regular postgres driver
 	_ "github.com/lib/pq"
@@ -36,10 +37,15 @@ const (
 	MaxBatchSize = 5000
 )
 
-var Errorf = gqlerror.Errorf
+var Errorf = errext.Errorf
 
 type EntBackend struct {
 	client *ent.Client
+	// certifyVulnAdded publishes the global IDs of newly created CertifyVuln nodes,
+	// keyed by the package they certify
+	certifyVulnAdded helper.PubSub[string]
+	// skipIfExists makes IngestCertifyVuln look for the CertifyVuln before writing it
+	skipIfExists bool
 }
 
 func getBackend(ctx context.Context, args backends.BackendArgs) (backends.Backend, error) {
@@ -51,7 +57,12 @@ func getBackend(ctx context.Context, args backends.BackendArgs) (backends.Backen
 	if err != nil {
 		return nil, err
 	}
-	return GetBackend(client)
+	be, err := GetBackend(client)
+	if err != nil {
+		return nil, err
+	}
+	be.(*EntBackend).skipIfExists = config.SkipIfExists
+	return be, nil
 }
 
 func GetBackend(client *ent.Client) (backends.Backend, error) {
@@ -69,3 +80,14 @@ func GetBackend(client *ent.Client) (backends.Backend, error) {
 
 	return be, nil
 }
+
+// Ping checks that the database can be queried.
+func (b *EntBackend) Ping(ctx context.Context) error {
+	return b.client.Ping(ctx)
+}
+
+// SchemaVersion returns the version of the last migration applied to the
+// database.
+func (b *EntBackend) SchemaVersion(ctx context.Context) (string, error) {
+	return b.client.SchemaVersion(ctx)
+}
@@ -28,7 +28,6 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func (b *EntBackend) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
@@ -86,7 +85,7 @@ func (b *EntBackend) IngestBuilders(ctx context.Context, builders []*model.IDorB
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(builder.Table, *ids), nil
@@ -174,3 +173,31 @@ func (b *EntBackend) builderNeighbors(ctx context.Context, nodeID string, allowe
 
 	return out, nil
 }
+
+func (b *EntBackend) BuildersList(ctx context.Context, builderSpec model.BuilderSpec, pagination *model.PaginationSpec) (*model.BuilderConnection, error) {
+	funcName := "BuildersList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := b.client.Builder.Query().
+		Where(builderQueryPredicate(&builderSpec)).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.BuilderEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.BuilderEdge{
+			Cursor: toGlobalID(builder.Table, edge.Node.ID.String()),
+			Node:   toModelBuilder(edge.Node),
+		})
+	}
+	return &model.BuilderConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, builder.Table),
+		Edges:      edges,
+	}, nil
+}
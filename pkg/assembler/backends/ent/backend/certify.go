@@ -18,6 +18,7 @@ package backend
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
@@ -27,7 +28,6 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 const (
@@ -91,6 +91,8 @@ func queryCertifications(typ certification.Type, filter *model.CertifyBadSpec) p
 		optionalPredicate(filter.Collector, certification.CollectorEQ),
 		optionalPredicate(filter.Origin, certification.OriginEQ),
 		optionalPredicate(filter.Justification, certification.JustificationEQ),
+		optionalPredicate(filter.JustificationPrefix, certification.JustificationHasPrefix),
+		optionalPredicate(filter.JustificationContains, certification.JustificationContains),
 		optionalPredicate(filter.KnownSince, certification.KnownSinceEQ),
 		optionalPredicate(filter.DocumentRef, certification.DocumentRef),
 	}
@@ -109,6 +111,20 @@ func queryCertifications(typ certification.Type, filter *model.CertifyBadSpec) p
 		}
 	}
 
+	if filter.SubjectType != nil {
+		switch *filter.SubjectType {
+		case model.SubjectTypePackage:
+			predicates = append(predicates, certification.Or(
+				certification.HasAllVersions(),
+				certification.HasPackageVersion(),
+			))
+		case model.SubjectTypeSource:
+			predicates = append(predicates, certification.HasSource())
+		case model.SubjectTypeArtifact:
+			predicates = append(predicates, certification.HasArtifact())
+		}
+	}
+
 	return certification.And(predicates...)
 }
 
@@ -134,7 +150,7 @@ func (b *EntBackend) IngestCertifyBads(ctx context.Context, subjects model.Packa
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(certifyBadString, *ids), nil
@@ -162,7 +178,7 @@ func (b *EntBackend) IngestCertifyGoods(ctx context.Context, subjects model.Pack
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(certifyGoodString, *ids), nil
@@ -227,14 +243,14 @@ func upsertCertification[T certificationInputSpec](ctx context.Context, tx *ent.
 	var err error
 	switch v := any(spec).(type) {
 	case model.CertifyBadInputSpec:
-		insert, err = generateCertifyCreate(ctx, tx, subject.Package, subject.Source, subject.Artifact, pkgMatchType, &v, nil)
+		insert, _, err = generateCertifyCreate(ctx, tx, subject.Package, subject.Source, subject.Artifact, pkgMatchType, &v, nil)
 		if err != nil {
-			return nil, gqlerror.Errorf("generateCertifyCreate :: %s", err)
+			return nil, Errorf("generateCertifyCreate :: %s", err)
 		}
 	case model.CertifyGoodInputSpec:
-		insert, err = generateCertifyCreate(ctx, tx, subject.Package, subject.Source, subject.Artifact, pkgMatchType, nil, &v)
+		insert, _, err = generateCertifyCreate(ctx, tx, subject.Package, subject.Source, subject.Artifact, pkgMatchType, nil, &v)
 		if err != nil {
-			return nil, gqlerror.Errorf("generateCertifyCreate :: %s", err)
+			return nil, Errorf("generateCertifyCreate :: %s", err)
 		}
 	default:
 		return nil, fmt.Errorf("unknown spec: %+T", v)
@@ -254,10 +270,11 @@ func upsertCertification[T certificationInputSpec](ctx context.Context, tx *ent.
 }
 
 func generateCertifyCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgInput, src *model.IDorSourceInput, art *model.IDorArtifactInput, pkgMatchType *model.MatchFlags,
-	cb *model.CertifyBadInputSpec, cg *model.CertifyGoodInputSpec) (*ent.CertificationCreate, error) {
+	cb *model.CertifyBadInputSpec, cg *model.CertifyGoodInputSpec) (*ent.CertificationCreate, *uuid.UUID, error) {
 
 	certifyCreate := tx.Certification.Create()
 
+	var canonicalCertify string
 	if cb != nil {
 		certifyCreate.
 			SetType(certification.TypeBAD).
@@ -266,6 +283,7 @@ func generateCertifyCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgIn
 			SetOrigin(cb.Origin).
 			SetCollector(cb.Collector).
 			SetDocumentRef(cb.DocumentRef)
+		canonicalCertify = canonicalCertifyString(certification.TypeBAD, cb.Justification, cb.KnownSince, cb.Origin, cb.Collector, cb.DocumentRef)
 	} else if cg != nil {
 		certifyCreate.
 			SetType(certification.TypeGOOD).
@@ -274,10 +292,13 @@ func generateCertifyCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgIn
 			SetOrigin(cg.Origin).
 			SetCollector(cg.Collector).
 			SetDocumentRef(cg.DocumentRef)
+		canonicalCertify = canonicalCertifyString(certification.TypeGOOD, cg.Justification, cg.KnownSince, cg.Origin, cg.Collector, cg.DocumentRef)
 	} else {
-		return nil, fmt.Errorf("must specify either certifyGood or certifyBad")
+		return nil, nil, fmt.Errorf("must specify either certifyGood or certifyBad")
 	}
 
+	var subjectID string
+
 	switch {
 	case art != nil:
 		var artID uuid.UUID
@@ -286,16 +307,17 @@ func generateCertifyCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgIn
 			artGlobalID := fromGlobalID(*art.ArtifactID)
 			artID, err = uuid.Parse(artGlobalID.id)
 			if err != nil {
-				return nil, fmt.Errorf("uuid conversion from ArtifactID failed with error: %w", err)
+				return nil, nil, fmt.Errorf("uuid conversion from ArtifactID failed with error: %w", err)
 			}
 		} else {
 			foundArt, err := tx.Artifact.Query().Where(artifactQueryInputPredicates(*art.ArtifactInput)).Only(ctx)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			artID = foundArt.ID
 		}
 		certifyCreate.SetArtifactID(artID)
+		subjectID = "artifact::" + artID.String()
 	case pkg != nil:
 		if pkgMatchType.Pkg == model.PkgMatchTypeSpecificVersion {
 			var pkgVersionID uuid.UUID
@@ -304,16 +326,17 @@ func generateCertifyCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgIn
 				pkgVersionGlobalID := fromGlobalID(*pkg.PackageVersionID)
 				pkgVersionID, err = uuid.Parse(pkgVersionGlobalID.id)
 				if err != nil {
-					return nil, fmt.Errorf("uuid conversion from packageVersionID failed with error: %w", err)
+					return nil, nil, fmt.Errorf("uuid conversion from packageVersionID failed with error: %w", err)
 				}
 			} else {
 				pv, err := getPkgVersion(ctx, tx.Client(), *pkg.PackageInput)
 				if err != nil {
-					return nil, fmt.Errorf("getPkgVersion :: %w", err)
+					return nil, nil, fmt.Errorf("getPkgVersion :: %w", err)
 				}
 				pkgVersionID = pv.ID
 			}
 			certifyCreate.SetPackageVersionID(pkgVersionID)
+			subjectID = "packageVersion::" + pkgVersionID.String()
 		} else {
 			var pkgNameID uuid.UUID
 			if pkg.PackageNameID != nil {
@@ -321,16 +344,17 @@ func generateCertifyCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgIn
 				pkgNameGlobalID := fromGlobalID(*pkg.PackageNameID)
 				pkgNameID, err = uuid.Parse(pkgNameGlobalID.id)
 				if err != nil {
-					return nil, fmt.Errorf("uuid conversion from PackageNameID failed with error: %w", err)
+					return nil, nil, fmt.Errorf("uuid conversion from PackageNameID failed with error: %w", err)
 				}
 			} else {
 				pn, err := getPkgName(ctx, tx.Client(), *pkg.PackageInput)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				pkgNameID = pn.ID
 			}
 			certifyCreate.SetAllVersionsID(pkgNameID)
+			subjectID = "packageName::" + pkgNameID.String()
 		}
 	case src != nil:
 		var sourceID uuid.UUID
@@ -339,18 +363,31 @@ func generateCertifyCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgIn
 			srcNameGlobalID := fromGlobalID(*src.SourceNameID)
 			sourceID, err = uuid.Parse(srcNameGlobalID.id)
 			if err != nil {
-				return nil, fmt.Errorf("uuid conversion from SourceNameID failed with error: %w", err)
+				return nil, nil, fmt.Errorf("uuid conversion from SourceNameID failed with error: %w", err)
 			}
 		} else {
 			srcID, err := getSourceNameID(ctx, tx.Client(), *src.SourceInput)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			sourceID = srcID
 		}
 		certifyCreate.SetSourceID(sourceID)
+		subjectID = "source::" + sourceID.String()
 	}
-	return certifyCreate, nil
+	certifyID := guacCertifyKey(subjectID, canonicalCertify)
+	certifyCreate.SetID(certifyID)
+	return certifyCreate, &certifyID, nil
+}
+
+func canonicalCertifyString(certType certification.Type, justification string, knownSince time.Time, origin, collector, documentRef string) string {
+	return fmt.Sprintf("%s::%s::%s::%s::%s:%s", certType, justification, knownSince.UTC(), origin, collector, documentRef)
+}
+
+// guacCertifyKey generates an uuid based on the hash of the subject and the fields of the unique index, so that the
+// IDs of certifications ingested in bulk can be returned, including the ones that were already ingested.
+func guacCertifyKey(subjectID string, canonicalCertify string) uuid.UUID {
+	return generateUUIDKey([]byte(fmt.Sprintf("%s::%s?", subjectID, canonicalCertify)))
 }
 
 func upsertBulkCertification[T certificationInputSpec](ctx context.Context, tx *ent.Tx, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, spec []*T) (*[]string, error) {
@@ -409,23 +446,25 @@ func upsertBulkCertification[T certificationInputSpec](ctx context.Context, tx *
 			for i, cb := range certifyBads {
 				cb := cb
 				var err error
+				var certifyID *uuid.UUID
 				switch {
 				case len(subjects.Artifacts) > 0:
-					creates[i], err = generateCertifyCreate(ctx, tx, nil, nil, subjects.Artifacts[index], pkgMatchType, cb, nil)
+					creates[i], certifyID, err = generateCertifyCreate(ctx, tx, nil, nil, subjects.Artifacts[index], pkgMatchType, cb, nil)
 					if err != nil {
-						return nil, gqlerror.Errorf("generateCertifyCreate :: %s", err)
+						return nil, Errorf("generateCertifyCreate :: %s", err)
 					}
 				case len(subjects.Packages) > 0:
-					creates[i], err = generateCertifyCreate(ctx, tx, subjects.Packages[index], nil, nil, pkgMatchType, cb, nil)
+					creates[i], certifyID, err = generateCertifyCreate(ctx, tx, subjects.Packages[index], nil, nil, pkgMatchType, cb, nil)
 					if err != nil {
-						return nil, gqlerror.Errorf("generateCertifyCreate :: %s", err)
+						return nil, Errorf("generateCertifyCreate :: %s", err)
 					}
 				case len(subjects.Sources) > 0:
-					creates[i], err = generateCertifyCreate(ctx, tx, nil, subjects.Sources[index], nil, pkgMatchType, cb, nil)
+					creates[i], certifyID, err = generateCertifyCreate(ctx, tx, nil, subjects.Sources[index], nil, pkgMatchType, cb, nil)
 					if err != nil {
-						return nil, gqlerror.Errorf("generateCertifyCreate :: %s", err)
+						return nil, Errorf("generateCertifyCreate :: %s", err)
 					}
 				}
+				ids = append(ids, certifyID.String())
 				index++
 			}
 
@@ -448,23 +487,25 @@ func upsertBulkCertification[T certificationInputSpec](ctx context.Context, tx *
 			creates := make([]*ent.CertificationCreate, len(certifyGoods))
 			for i, cg := range certifyGoods {
 				var err error
+				var certifyID *uuid.UUID
 				switch {
 				case len(subjects.Artifacts) > 0:
-					creates[i], err = generateCertifyCreate(ctx, tx, nil, nil, subjects.Artifacts[index], pkgMatchType, nil, cg)
+					creates[i], certifyID, err = generateCertifyCreate(ctx, tx, nil, nil, subjects.Artifacts[index], pkgMatchType, nil, cg)
 					if err != nil {
-						return nil, gqlerror.Errorf("generateCertifyCreate :: %s", err)
+						return nil, Errorf("generateCertifyCreate :: %s", err)
 					}
 				case len(subjects.Packages) > 0:
-					creates[i], err = generateCertifyCreate(ctx, tx, subjects.Packages[index], nil, nil, pkgMatchType, nil, cg)
+					creates[i], certifyID, err = generateCertifyCreate(ctx, tx, subjects.Packages[index], nil, nil, pkgMatchType, nil, cg)
 					if err != nil {
-						return nil, gqlerror.Errorf("generateCertifyCreate :: %s", err)
+						return nil, Errorf("generateCertifyCreate :: %s", err)
 					}
 				case len(subjects.Sources) > 0:
-					creates[i], err = generateCertifyCreate(ctx, tx, nil, subjects.Sources[index], nil, pkgMatchType, nil, cg)
+					creates[i], certifyID, err = generateCertifyCreate(ctx, tx, nil, subjects.Sources[index], nil, pkgMatchType, nil, cg)
 					if err != nil {
-						return nil, gqlerror.Errorf("generateCertifyCreate :: %s", err)
+						return nil, Errorf("generateCertifyCreate :: %s", err)
 					}
 				}
+				ids = append(ids, certifyID.String())
 				index++
 			}
 
@@ -632,3 +673,59 @@ func (b *EntBackend) certifyGoodNeighbors(ctx context.Context, nodeID string, al
 	}
 	return out, nil
 }
+
+func (b *EntBackend) CertifyBadList(ctx context.Context, certifyBadSpec model.CertifyBadSpec, pagination *model.PaginationSpec) (*model.CertifyBadConnection, error) {
+	funcName := "CertifyBadList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := getCertificationObject(b.client.Certification.Query().
+		Where(queryCertifications(certification.TypeBAD, &certifyBadSpec))).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.CertifyBadEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.CertifyBadEdge{
+			Cursor: toGlobalID(certifyBadString, edge.Node.ID.String()),
+			Node:   toModelCertifyBad(edge.Node),
+		})
+	}
+	return &model.CertifyBadConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, certifyBadString),
+		Edges:      edges,
+	}, nil
+}
+
+func (b *EntBackend) CertifyGoodList(ctx context.Context, certifyGoodSpec model.CertifyGoodSpec, pagination *model.PaginationSpec) (*model.CertifyGoodConnection, error) {
+	funcName := "CertifyGoodList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := getCertificationObject(b.client.Certification.Query().
+		Where(queryCertifications(certification.TypeGOOD, (*model.CertifyBadSpec)(&certifyGoodSpec)))).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.CertifyGoodEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.CertifyGoodEdge{
+			Cursor: toGlobalID(certifyGoodString, edge.Node.ID.String()),
+			Node:   toModelCertifyGood(edge.Node),
+		})
+	}
+	return &model.CertifyGoodConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, certifyGoodString),
+		Edges:      edges,
+	}, nil
+}
@@ -28,7 +28,6 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func (b *EntBackend) CertifyLegal(ctx context.Context, spec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
@@ -70,7 +69,7 @@ func (b *EntBackend) IngestCertifyLegals(ctx context.Context, subjects model.Pac
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(certifylegal.Table, *ids), nil
@@ -112,12 +111,12 @@ func (b *EntBackend) IngestCertifyLegal(ctx context.Context, subject model.Packa
 				sql.NotNull(certifylegal.FieldSourceID),
 			)
 		} else {
-			return nil, gqlerror.Errorf("%v :: %s", "IngestCertifyLegal", "subject must be either a package or source")
+			return nil, Errorf("%v :: %s", "IngestCertifyLegal", "subject must be either a package or source")
 		}
 
-		certifyLegalCreate, err := generateCertifyLegalCreate(ctx, tx, spec, subject.Package, subject.Source, declaredLicenses, discoveredLicenses)
+		certifyLegalCreate, _, err := generateCertifyLegalCreate(ctx, tx, spec, subject.Package, subject.Source, declaredLicenses, discoveredLicenses)
 		if err != nil {
-			return nil, gqlerror.Errorf("generateCertifyLegalCreate :: %s", err)
+			return nil, Errorf("generateCertifyLegalCreate :: %s", err)
 		}
 
 		if id, err := certifyLegalCreate.
@@ -134,13 +133,13 @@ func (b *EntBackend) IngestCertifyLegal(ctx context.Context, subject model.Packa
 		}
 	})
 	if txErr != nil {
-		return "", gqlerror.Errorf("IngestCertifyLegal :: %s", txErr)
+		return "", Errorf("IngestCertifyLegal :: %s", txErr)
 	}
 
 	return toGlobalID(certifylegal.Table, *recordID), nil
 }
 
-func generateCertifyLegalCreate(ctx context.Context, tx *ent.Tx, cl *model.CertifyLegalInputSpec, pkg *model.IDorPkgInput, src *model.IDorSourceInput, declaredLicenses []*model.IDorLicenseInput, discoveredLicenses []*model.IDorLicenseInput) (*ent.CertifyLegalCreate, error) {
+func generateCertifyLegalCreate(ctx context.Context, tx *ent.Tx, cl *model.CertifyLegalInputSpec, pkg *model.IDorPkgInput, src *model.IDorSourceInput, declaredLicenses []*model.IDorLicenseInput, discoveredLicenses []*model.IDorLicenseInput) (*ent.CertifyLegalCreate, *uuid.UUID, error) {
 	certifyLegalCreate := tx.CertifyLegal.Create().
 		SetDeclaredLicense(cl.DeclaredLicense).
 		SetDiscoveredLicense(cl.DiscoveredLicense).
@@ -163,7 +162,7 @@ func generateCertifyLegalCreate(ctx context.Context, tx *ent.Tx, cl *model.Certi
 			} else {
 				licenseID, err := getLicenseID(ctx, tx.Client(), *decLic.LicenseInput)
 				if err != nil {
-					return nil, errors.Wrap(err, "failed to get license ID")
+					return nil, nil, errors.Wrap(err, "failed to get license ID")
 				}
 				declaredLicenseIDs = append(declaredLicenseIDs, licenseID.String())
 			}
@@ -173,7 +172,7 @@ func generateCertifyLegalCreate(ctx context.Context, tx *ent.Tx, cl *model.Certi
 		for _, declaredLicID := range sortedDeclaredLicenseIDs {
 			declaredLicUUID, err := uuid.Parse(declaredLicID)
 			if err != nil {
-				return nil, fmt.Errorf("uuid conversion from licenseID failed with error: %w", err)
+				return nil, nil, fmt.Errorf("uuid conversion from licenseID failed with error: %w", err)
 			}
 			certifyLegalCreate.AddDeclaredLicenseIDs(declaredLicUUID)
 		}
@@ -193,7 +192,7 @@ func generateCertifyLegalCreate(ctx context.Context, tx *ent.Tx, cl *model.Certi
 			} else {
 				licenseID, err := getLicenseID(ctx, tx.Client(), *disLic.LicenseInput)
 				if err != nil {
-					return nil, errors.Wrap(err, "failed to get license ID")
+					return nil, nil, errors.Wrap(err, "failed to get license ID")
 				}
 				discoveredLicenseIDs = append(discoveredLicenseIDs, licenseID.String())
 			}
@@ -203,7 +202,7 @@ func generateCertifyLegalCreate(ctx context.Context, tx *ent.Tx, cl *model.Certi
 		for _, discoveredLicID := range sortedDiscoveredLicenseIDs {
 			discoveredLicUUID, err := uuid.Parse(discoveredLicID)
 			if err != nil {
-				return nil, fmt.Errorf("uuid conversion from licenseID failed with error: %w", err)
+				return nil, nil, fmt.Errorf("uuid conversion from licenseID failed with error: %w", err)
 			}
 			certifyLegalCreate.AddDiscoveredLicenseIDs(discoveredLicUUID)
 		}
@@ -214,6 +213,7 @@ func generateCertifyLegalCreate(ctx context.Context, tx *ent.Tx, cl *model.Certi
 		certifyLegalCreate.SetDiscoveredLicensesHash(sortedDiscoveredLicenseHash)
 	}
 
+	var certifyLegalID *uuid.UUID
 	if pkg != nil {
 		var pkgVersionID uuid.UUID
 		if pkg.PackageVersionID != nil {
@@ -221,19 +221,20 @@ func generateCertifyLegalCreate(ctx context.Context, tx *ent.Tx, cl *model.Certi
 			pkgVersionGlobalID := fromGlobalID(*pkg.PackageVersionID)
 			pkgVersionID, err = uuid.Parse(pkgVersionGlobalID.id)
 			if err != nil {
-				return nil, fmt.Errorf("uuid conversion from packageVersionID failed with error: %w", err)
+				return nil, nil, fmt.Errorf("uuid conversion from packageVersionID failed with error: %w", err)
 			}
 		} else {
 			pv, err := getPkgVersion(ctx, tx.Client(), *pkg.PackageInput)
 			if err != nil {
-				return nil, fmt.Errorf("getPkgVersion :: %w", err)
+				return nil, nil, fmt.Errorf("getPkgVersion :: %w", err)
 			}
 			pkgVersionID = pv.ID
 		}
 		certifyLegalCreate.SetPackageID(pkgVersionID)
-		certifyLegalID, err := guacCertifyLegalKey(ptrfrom.String(pkgVersionID.String()), nil, sortedDeclaredLicenseHash, sortedDiscoveredLicenseHash, cl)
+		var err error
+		certifyLegalID, err = guacCertifyLegalKey(ptrfrom.String(pkgVersionID.String()), nil, sortedDeclaredLicenseHash, sortedDiscoveredLicenseHash, cl)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create certifyLegal uuid with error: %w", err)
+			return nil, nil, fmt.Errorf("failed to create certifyLegal uuid with error: %w", err)
 		}
 		certifyLegalCreate.SetID(*certifyLegalID)
 	} else if src != nil {
@@ -243,24 +244,25 @@ func generateCertifyLegalCreate(ctx context.Context, tx *ent.Tx, cl *model.Certi
 			srcNameGlobalID := fromGlobalID(*src.SourceNameID)
 			sourceID, err = uuid.Parse(srcNameGlobalID.id)
 			if err != nil {
-				return nil, fmt.Errorf("uuid conversion from SourceNameID failed with error: %w", err)
+				return nil, nil, fmt.Errorf("uuid conversion from SourceNameID failed with error: %w", err)
 			}
 		} else {
 			srcID, err := getSourceNameID(ctx, tx.Client(), *src.SourceInput)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			sourceID = srcID
 		}
 		certifyLegalCreate.SetSourceID(sourceID)
-		certifyLegalID, err := guacCertifyLegalKey(nil, ptrfrom.String(sourceID.String()), sortedDeclaredLicenseHash, sortedDiscoveredLicenseHash, cl)
+		var err error
+		certifyLegalID, err = guacCertifyLegalKey(nil, ptrfrom.String(sourceID.String()), sortedDeclaredLicenseHash, sortedDiscoveredLicenseHash, cl)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create certifyLegal uuid with error: %w", err)
+			return nil, nil, fmt.Errorf("failed to create certifyLegal uuid with error: %w", err)
 		}
 		certifyLegalCreate.SetID(*certifyLegalID)
 	}
 
-	return certifyLegalCreate, nil
+	return certifyLegalCreate, certifyLegalID, nil
 }
 
 func upsertBulkCertifyLegal(ctx context.Context, tx *ent.Tx, subjects model.PackageOrSourceInputs, declaredLicensesList [][]*model.IDorLicenseInput, discoveredLicensesList [][]*model.IDorLicenseInput, certifyLegals []*model.CertifyLegalInputSpec) (*[]string, error) {
@@ -283,7 +285,7 @@ func upsertBulkCertifyLegal(ctx context.Context, tx *ent.Tx, subjects model.Pack
 			sql.NotNull(certifylegal.FieldSourceID),
 		)
 	} else {
-		return nil, gqlerror.Errorf("%v :: %s", "upsertBulkCertifyLegal", "subject must be either a package or source")
+		return nil, Errorf("%v :: %s", "upsertBulkCertifyLegal", "subject must be either a package or source")
 	}
 
 	batches := chunk(certifyLegals, MaxBatchSize)
@@ -293,21 +295,23 @@ func upsertBulkCertifyLegal(ctx context.Context, tx *ent.Tx, subjects model.Pack
 		creates := make([]*ent.CertifyLegalCreate, len(cls))
 		for i, cl := range cls {
 			cl := cl
+			var certifyLegalID *uuid.UUID
 			var err error
 			if len(subjects.Packages) > 0 {
-				creates[i], err = generateCertifyLegalCreate(ctx, tx, cl, subjects.Packages[index], nil, declaredLicensesList[index], discoveredLicensesList[index])
+				creates[i], certifyLegalID, err = generateCertifyLegalCreate(ctx, tx, cl, subjects.Packages[index], nil, declaredLicensesList[index], discoveredLicensesList[index])
 				if err != nil {
-					return nil, gqlerror.Errorf("generateCertifyLegalCreate :: %s", err)
+					return nil, Errorf("generateCertifyLegalCreate :: %s", err)
 				}
 
 			} else if len(subjects.Sources) > 0 {
-				creates[i], err = generateCertifyLegalCreate(ctx, tx, cl, nil, subjects.Sources[index], declaredLicensesList[index], discoveredLicensesList[index])
+				creates[i], certifyLegalID, err = generateCertifyLegalCreate(ctx, tx, cl, nil, subjects.Sources[index], declaredLicensesList[index], discoveredLicensesList[index])
 				if err != nil {
-					return nil, gqlerror.Errorf("generateCertifyLegalCreate :: %s", err)
+					return nil, Errorf("generateCertifyLegalCreate :: %s", err)
 				}
 			} else {
-				return nil, gqlerror.Errorf("%v :: %s", "upsertBulkCertifyLegal", "subject must be either a package or source")
+				return nil, Errorf("%v :: %s", "upsertBulkCertifyLegal", "subject must be either a package or source")
 			}
+			ids = append(ids, certifyLegalID.String())
 			index++
 		}
 
@@ -388,7 +392,7 @@ func guacCertifyLegalKey(pkgVersionID *string, srcNameID *string, declaredLicens
 	} else if srcNameID != nil {
 		subjectID = *srcNameID
 	} else {
-		return nil, gqlerror.Errorf("%v :: %s", "guacCertifyLegalKey", "subject must be either a package or source")
+		return nil, Errorf("%v :: %s", "guacCertifyLegalKey", "subject must be either a package or source")
 	}
 
 	clIDString := fmt.Sprintf("%s::%s::%s::%s?", subjectID, declaredLicenseHash, discoveredLicenseHash, canonicalCertifyLegalString(clInput))
@@ -442,3 +446,31 @@ func (b *EntBackend) certifyLegalNeighbors(ctx context.Context, nodeID string, a
 
 	return out, nil
 }
+
+func (b *EntBackend) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, pagination *model.PaginationSpec) (*model.CertifyLegalConnection, error) {
+	funcName := "CertifyLegalList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := getCertifyLegalObject(b.client.CertifyLegal.Query().
+		Where(certifyLegalQuery(certifyLegalSpec))).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.CertifyLegalEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.CertifyLegalEdge{
+			Cursor: toGlobalID(certifylegal.Table, edge.Node.ID.String()),
+			Node:   toModelCertifyLegal(edge.Node),
+		})
+	}
+	return &model.CertifyLegalConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, certifylegal.Table),
+		Edges:      edges,
+	}, nil
+}
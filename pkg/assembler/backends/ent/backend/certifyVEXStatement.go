@@ -28,7 +28,6 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func certifyVexConflictColumns() []string {
@@ -72,7 +71,7 @@ func (b *EntBackend) IngestVEXStatement(ctx context.Context, subject model.Packa
 
 		insert, err := generateVexCreate(ctx, tx, subject.Package, subject.Artifact, &vulnerability, &vexStatement)
 		if err != nil {
-			return nil, gqlerror.Errorf("generateVexCreate :: %s", err)
+			return nil, Errorf("generateVexCreate :: %s", err)
 		}
 
 		if id, err := insert.
@@ -108,7 +107,7 @@ func (b *EntBackend) IngestVEXStatements(ctx context.Context, subjects model.Pac
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(certifyvex.Table, *ids), nil
@@ -230,12 +229,12 @@ func upsertBulkVEX(ctx context.Context, tx *ent.Tx, subjects model.PackageOrArti
 			if len(subjects.Packages) > 0 {
 				creates[i], err = generateVexCreate(ctx, tx, subjects.Packages[index], nil, vulnerabilities[index], vex)
 				if err != nil {
-					return nil, gqlerror.Errorf("generateVexCreate :: %s", err)
+					return nil, Errorf("generateVexCreate :: %s", err)
 				}
 			} else if len(subjects.Artifacts) > 0 {
 				creates[i], err = generateVexCreate(ctx, tx, nil, subjects.Artifacts[index], vulnerabilities[index], vex)
 				if err != nil {
-					return nil, gqlerror.Errorf("generateVexCreate :: %s", err)
+					return nil, Errorf("generateVexCreate :: %s", err)
 				}
 			}
 			index++
@@ -269,7 +268,7 @@ func (b *EntBackend) CertifyVEXStatement(ctx context.Context, spec *model.Certif
 		Limit(MaxPageSize).
 		All(ctx)
 	if err != nil {
-		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		return nil, Errorf("%v :: %v", funcName, err)
 	}
 
 	return collect(records, toModelCertifyVEXStatement), nil
@@ -385,3 +384,31 @@ func (b *EntBackend) certifyVexNeighbors(ctx context.Context, nodeID string, all
 
 	return out, nil
 }
+
+func (b *EntBackend) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, pagination *model.PaginationSpec) (*model.CertifyVEXStatementConnection, error) {
+	funcName := "CertifyVEXStatementList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := getVEXObject(b.client.CertifyVex.Query().
+		Where(certifyVexPredicate(certifyVEXStatementSpec))).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.CertifyVEXStatementEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.CertifyVEXStatementEdge{
+			Cursor: toGlobalID(certifyvex.Table, edge.Node.ID.String()),
+			Node:   toModelCertifyVEXStatement(edge.Node),
+		})
+	}
+	return &model.CertifyVEXStatementConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, certifyvex.Table),
+		Edges:      edges,
+	}, nil
+}
@@ -18,17 +18,23 @@ package backend
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packageversion"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/logging"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func certifyVulnConflictColumns() []string {
@@ -47,7 +53,22 @@ func certifyVulnConflictColumns() []string {
 }
 
 func (b *EntBackend) IngestCertifyVuln(ctx context.Context, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, certifyVuln model.ScanMetadataInput) (string, error) {
+	if b.skipIfExists {
+		// re-ingesting a known certification then only costs reads, not a write transaction
+		pkgVersionID, vulnID, err := certifyVulnSubjectIDs(ctx, b.client, &pkg, &vulnerability)
+		if err != nil {
+			return "", Errorf("IngestCertifyVuln :: %s", err)
+		}
+		exists, id, err := b.certifyVulnExists(ctx, pkgVersionID, vulnID, certifyVuln)
+		if err != nil {
+			return "", Errorf("IngestCertifyVuln :: %s", err)
+		}
+		if exists {
+			return id, nil
+		}
+	}
 
+	var created bool
 	record, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*string, error) {
 		tx := ent.TxFromContext(ctx)
 
@@ -55,8 +76,14 @@ func (b *EntBackend) IngestCertifyVuln(ctx context.Context, pkg model.IDorPkgInp
 
 		insert, err := generateCertifyVulnCreate(ctx, tx, &pkg, &vulnerability, &certifyVuln)
 		if err != nil {
-			return nil, gqlerror.Errorf("generateCertifyVulnCreate :: %s", err)
+			return nil, Errorf("generateCertifyVulnCreate :: %s", err)
 		}
+		// on conflict the ID of the existing node is returned instead of the new one
+		newID, err := uuid.NewV7()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate certifyVuln ID: %w", err)
+		}
+		insert.SetID(newID)
 
 		if id, err := insert.
 			OnConflict(
@@ -66,6 +93,7 @@ func (b *EntBackend) IngestCertifyVuln(ctx context.Context, pkg model.IDorPkgInp
 			ID(ctx); err != nil {
 			return nil, errors.Wrap(err, "upsert certify Vuln statement node")
 		} else {
+			created = id == newID
 			return ptrfrom.String(id.String()), nil
 		}
 	})
@@ -73,33 +101,133 @@ func (b *EntBackend) IngestCertifyVuln(ctx context.Context, pkg model.IDorPkgInp
 		return "", txErr
 	}
 
-	return toGlobalID(certifyvuln.Table, *record), nil
+	globalID := toGlobalID(certifyvuln.Table, *record)
+	if created && b.certifyVulnAdded.HasSubscribers() {
+		events, err := certifyVulnEvents(ctx, b.client.CertifyVuln, []uuid.UUID{uuid.MustParse(*record)})
+		if err != nil {
+			logging.FromContext(ctx).Warnf("failed to publish new certifyVuln %s: %v", globalID, err)
+		}
+		for _, event := range events {
+			helper.PublishCertifyVuln(ctx, &b.certifyVulnAdded, event)
+		}
+	}
+	return globalID, nil
 }
 
 func (b *EntBackend) IngestCertifyVulns(ctx context.Context, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, certifyVulns []*model.ScanMetadataInput) ([]string, error) {
 	funcName := "IngestCertifyVulns"
+	var created []helper.CertifyVulnEvent
 	ids, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*[]string, error) {
 		client := ent.TxFromContext(ctx)
-		slc, err := upsertBulkCertifyVuln(ctx, client, pkgs, vulnerabilities, certifyVulns)
+		slc, candidates, err := upsertBulkCertifyVuln(ctx, client, pkgs, vulnerabilities, certifyVulns)
 		if err != nil {
 			return nil, err
 		}
+		if b.certifyVulnAdded.HasSubscribers() {
+			// conflicting rows keep their existing IDs, so only the candidates found were inserted
+			for _, batch := range chunk(candidates, MaxBatchSize) {
+				events, err := certifyVulnEvents(ctx, client.CertifyVuln, batch)
+				if err != nil {
+					return nil, errors.Wrap(err, "query created certifyVuln nodes")
+				}
+				created = append(created, events...)
+			}
+		}
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
+	for _, event := range created {
+		helper.PublishCertifyVuln(ctx, &b.certifyVulnAdded, event)
+	}
 	return toGlobalIDs(certifyvuln.Table, *ids), nil
 }
 
-func generateCertifyVulnCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgInput, vuln *model.IDorVulnerabilityInput, certifyVuln *model.ScanMetadataInput) (*ent.CertifyVulnCreate, error) {
+// certifyVulnEvents returns the events of the CertifyVuln nodes with the given
+// IDs that exist, along with the packages they certify
+func certifyVulnEvents(ctx context.Context, client *ent.CertifyVulnClient, ids []uuid.UUID) ([]helper.CertifyVulnEvent, error) {
+	cvs, err := client.Query().
+		Where(certifyvuln.IDIn(ids...)).
+		WithPackage(func(q *ent.PackageVersionQuery) {
+			q.WithName()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]helper.CertifyVulnEvent, 0, len(cvs))
+	for _, cv := range cvs {
+		name := cv.Edges.Package.Edges.Name
+		events = append(events, helper.CertifyVulnEvent{
+			ID:        toGlobalID(certifyvuln.Table, cv.ID.String()),
+			Type:      name.Type,
+			Namespace: name.Namespace,
+			Name:      name.Name,
+		})
+	}
+	return events, nil
+}
 
-	certifyVulnCreate := tx.CertifyVuln.Create()
+// CertifyVulnAdded streams the CertifyVuln nodes created after the call that match the filter
+func (b *EntBackend) CertifyVulnAdded(ctx context.Context, filter *model.CertifyVulnSpec) (<-chan *model.CertifyVuln, error) {
+	return helper.SubscribeCertifyVulns(ctx, &b.certifyVulnAdded, filter, b.CertifyVuln), nil
+}
 
+// CheckCertifyVulnExists looks up the CertifyVuln of the package version and
+// vulnerability, given by their global IDs, with exactly the scan metadata
+// that identifies it on ingestion. It only reads, without starting a
+// transaction, and returns the global ID of the CertifyVuln when it exists.
+func (b *EntBackend) CheckCertifyVulnExists(ctx context.Context, pkgID, vulnID string, meta model.ScanMetadataInput) (bool, string, error) {
+	funcName := "CheckCertifyVulnExists"
+	pkgVersionID, err := uuid.Parse(fromGlobalID(pkgID).id)
+	if err != nil {
+		return false, "", Errorf("%v :: uuid conversion from package ID %s failed with error: %s", funcName, pkgID, err)
+	}
+	vulnerabilityID, err := uuid.Parse(fromGlobalID(vulnID).id)
+	if err != nil {
+		return false, "", Errorf("%v :: uuid conversion from vulnerability ID %s failed with error: %s", funcName, vulnID, err)
+	}
+	exists, id, err := b.certifyVulnExists(ctx, pkgVersionID, vulnerabilityID, meta)
+	if err != nil {
+		return false, "", Errorf("%v :: %s", funcName, err)
+	}
+	return exists, id, nil
+}
+
+// certifyVulnExists matches on the conflict columns of certifyVulnConflictColumns
+func (b *EntBackend) certifyVulnExists(ctx context.Context, pkgVersionID, vulnID uuid.UUID, meta model.ScanMetadataInput) (bool, string, error) {
+	ids, err := b.client.CertifyVuln.Query().
+		Where(
+			certifyvuln.PackageIDEQ(pkgVersionID),
+			certifyvuln.VulnerabilityIDEQ(vulnID),
+			certifyvuln.CollectorEQ(meta.Collector),
+			certifyvuln.ScannerURIEQ(meta.ScannerURI),
+			certifyvuln.ScannerVersionEQ(meta.ScannerVersion),
+			certifyvuln.OriginEQ(meta.Origin),
+			certifyvuln.DbURIEQ(meta.DbURI),
+			certifyvuln.DbVersionEQ(meta.DbVersion),
+			certifyvuln.TimeScannedEQ(meta.TimeScanned),
+			certifyvuln.DocumentRefEQ(meta.DocumentRef),
+		).
+		Limit(1).
+		IDs(ctx)
+	if err != nil {
+		return false, "", errors.Wrap(err, "query existing certifyVuln")
+	}
+	if len(ids) == 0 {
+		return false, "", nil
+	}
+	return true, toGlobalID(certifyvuln.Table, ids[0].String()), nil
+}
+
+// certifyVulnSubjectIDs returns the IDs of the package version and the
+// vulnerability, looking them up when they are given as inputs
+func certifyVulnSubjectIDs(ctx context.Context, client *ent.Client, pkg *model.IDorPkgInput, vuln *model.IDorVulnerabilityInput) (uuid.UUID, uuid.UUID, error) {
 	// manage vulnerability
 	if vuln == nil {
-		return nil, fmt.Errorf("vulnerability must be specified for vex ingestion")
+		return uuid.Nil, uuid.Nil, fmt.Errorf("vulnerability must be specified for vex ingestion")
 	}
 	var vulnID uuid.UUID
 	if vuln.VulnerabilityNodeID != nil {
@@ -107,25 +235,24 @@ func generateCertifyVulnCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorP
 		vulnGlobalID := fromGlobalID(*vuln.VulnerabilityNodeID)
 		vulnID, err = uuid.Parse(vulnGlobalID.id)
 		if err != nil {
-			return nil, fmt.Errorf("uuid conversion from VulnerabilityNodeID failed with error: %w", err)
+			return uuid.Nil, uuid.Nil, fmt.Errorf("uuid conversion from VulnerabilityNodeID failed with error: %w", err)
 		}
 	} else {
-		foundVulnID, err := tx.VulnerabilityID.Query().
+		foundVulnID, err := client.VulnerabilityID.Query().
 			Where(
 				vulnerabilityid.VulnerabilityIDEqualFold(vuln.VulnerabilityInput.VulnerabilityID),
 				vulnerabilityid.TypeEqualFold(vuln.VulnerabilityInput.Type),
 			).
 			OnlyID(ctx)
 		if err != nil {
-			return nil, Errorf("%v ::  %s", "generateVexCreate", err)
+			return uuid.Nil, uuid.Nil, Errorf("%v ::  %s", "generateVexCreate", err)
 		}
 		vulnID = foundVulnID
 	}
-	certifyVulnCreate.SetVulnerabilityID(vulnID)
 
 	// manage package or artifact
 	if pkg == nil {
-		return nil, Errorf("%v :: %s", "generateCertifyVulnCreate", "subject must be package")
+		return uuid.Nil, uuid.Nil, Errorf("%v :: %s", "generateCertifyVulnCreate", "subject must be package")
 	}
 	var pkgVersionID uuid.UUID
 	if pkg.PackageVersionID != nil {
@@ -133,15 +260,27 @@ func generateCertifyVulnCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorP
 		pkgVersionGlobalID := fromGlobalID(*pkg.PackageVersionID)
 		pkgVersionID, err = uuid.Parse(pkgVersionGlobalID.id)
 		if err != nil {
-			return nil, fmt.Errorf("uuid conversion from packageVersionID failed with error: %w", err)
+			return uuid.Nil, uuid.Nil, fmt.Errorf("uuid conversion from packageVersionID failed with error: %w", err)
 		}
 	} else {
-		pv, err := getPkgVersion(ctx, tx.Client(), *pkg.PackageInput)
+		pv, err := getPkgVersion(ctx, client, *pkg.PackageInput)
 		if err != nil {
-			return nil, fmt.Errorf("getPkgVersion :: %w", err)
+			return uuid.Nil, uuid.Nil, fmt.Errorf("getPkgVersion :: %w", err)
 		}
 		pkgVersionID = pv.ID
 	}
+	return pkgVersionID, vulnID, nil
+}
+
+func generateCertifyVulnCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgInput, vuln *model.IDorVulnerabilityInput, certifyVuln *model.ScanMetadataInput) (*ent.CertifyVulnCreate, error) {
+
+	certifyVulnCreate := tx.CertifyVuln.Create()
+
+	pkgVersionID, vulnID, err := certifyVulnSubjectIDs(ctx, tx.Client(), pkg, vuln)
+	if err != nil {
+		return nil, err
+	}
+	certifyVulnCreate.SetVulnerabilityID(vulnID)
 	certifyVulnCreate.SetPackageID(pkgVersionID)
 
 	certifyVulnCreate.
@@ -152,13 +291,16 @@ func generateCertifyVulnCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorP
 		SetScannerURI(certifyVuln.ScannerURI).
 		SetScannerVersion(certifyVuln.ScannerVersion).
 		SetTimeScanned(certifyVuln.TimeScanned).
-		SetDocumentRef(certifyVuln.DocumentRef)
+		SetDocumentRef(certifyVuln.DocumentRef).
+		SetNillableCvssScore(certifyVuln.CVSSScore)
 
 	return certifyVulnCreate, nil
 }
 
-func upsertBulkCertifyVuln(ctx context.Context, tx *ent.Tx, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, certifyVulns []*model.ScanMetadataInput) (*[]string, error) {
+// upsertBulkCertifyVuln returns the IDs given to the nodes it tried to create alongside the ingested IDs
+func upsertBulkCertifyVuln(ctx context.Context, tx *ent.Tx, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, certifyVulns []*model.ScanMetadataInput) (*[]string, []uuid.UUID, error) {
 	ids := make([]string, 0)
+	var candidates []uuid.UUID
 
 	conflictColumns := certifyVulnConflictColumns()
 
@@ -172,8 +314,14 @@ func upsertBulkCertifyVuln(ctx context.Context, tx *ent.Tx, pkgs []*model.IDorPk
 			var err error
 			creates[i], err = generateCertifyVulnCreate(ctx, tx, pkgs[index], vulnerabilities[index], vuln)
 			if err != nil {
-				return nil, gqlerror.Errorf("generateCertifyVulnCreate :: %s", err)
+				return nil, nil, Errorf("generateCertifyVulnCreate :: %s", err)
 			}
+			id, err := uuid.NewV7()
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to generate certifyVuln ID: %w", err)
+			}
+			creates[i].SetID(id)
+			candidates = append(candidates, id)
 			index++
 		}
 
@@ -184,11 +332,11 @@ func upsertBulkCertifyVuln(ctx context.Context, tx *ent.Tx, pkgs []*model.IDorPk
 			DoNothing().
 			Exec(ctx)
 		if err != nil {
-			return nil, errors.Wrap(err, "bulk upsert certifyVuln node")
+			return nil, nil, errors.Wrap(err, "bulk upsert certifyVuln node")
 		}
 	}
 
-	return &ids, nil
+	return &ids, candidates, nil
 }
 
 func (b *EntBackend) CertifyVuln(ctx context.Context, spec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
@@ -196,7 +344,8 @@ func (b *EntBackend) CertifyVuln(ctx context.Context, spec *model.CertifyVulnSpe
 		spec = &model.CertifyVulnSpec{}
 	}
 	certVulnQuery := b.client.CertifyVuln.Query().
-		Where(certifyVulnPredicate(*spec))
+		Where(certifyVulnPredicate(*spec)).
+		Order(certifyVulnOrder(spec.Order)...)
 
 	records, err := getCertVulnObject(certVulnQuery).
 		Limit(MaxPageSize).
@@ -205,7 +354,222 @@ func (b *EntBackend) CertifyVuln(ctx context.Context, spec *model.CertifyVulnSpe
 		return nil, err
 	}
 
-	return collect(records, toModelCertifyVulnerability), nil
+	return collect(records, toModelCertifyVuln), nil
+}
+
+// CertifyVulnByVulnerabilityIDs returns the CertifyVuln nodes matching spec for
+// each of the vulnerability ID nodes, keyed by node ID. The certifications of
+// all nodes are eager loaded in a single query through the certify_vuln edge.
+func (b *EntBackend) CertifyVulnByVulnerabilityIDs(ctx context.Context, vulnerabilityIDs []string, spec *model.CertifyVulnSpec) (map[string][]*model.CertifyVuln, error) {
+	if spec == nil {
+		spec = &model.CertifyVulnSpec{}
+	}
+	nodeIDs := make(map[uuid.UUID]string, len(vulnerabilityIDs))
+	ids := make([]uuid.UUID, 0, len(vulnerabilityIDs))
+	for _, vulnerabilityID := range vulnerabilityIDs {
+		id, err := uuid.Parse(fromGlobalID(vulnerabilityID).id)
+		if err != nil {
+			return nil, fmt.Errorf("uuid conversion from vulnerability ID %s failed with error: %w", vulnerabilityID, err)
+		}
+		nodeIDs[id] = vulnerabilityID
+		ids = append(ids, id)
+	}
+
+	records, err := b.client.VulnerabilityID.Query().
+		Where(vulnerabilityid.IDIn(ids...)).
+		WithCertifyVuln(func(q *ent.CertifyVulnQuery) {
+			getCertVulnObject(q.Where(certifyVulnPredicate(*spec)).Order(certifyVulnOrder(spec.Order)...))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := make(map[string][]*model.CertifyVuln, len(records))
+	for _, record := range records {
+		rv[nodeIDs[record.ID]] = collect(record.Edges.CertifyVuln, toModelCertifyVuln)
+	}
+	return rv, nil
+}
+
+// CertifyVulnByPackageIDs returns the CertifyVuln nodes matching filter for
+// each of the package version nodes, keyed by node ID. The package field of the
+// filter is ignored. The certifications of all nodes are eager loaded in a
+// single query through the vuln edge.
+func (b *EntBackend) CertifyVulnByPackageIDs(ctx context.Context, packageIDs []string, filter *model.CertifyVulnSpec) (map[string][]*model.CertifyVuln, error) {
+	spec := model.CertifyVulnSpec{}
+	if filter != nil {
+		spec = *filter
+		spec.Package = nil
+	}
+	nodeIDs := make(map[uuid.UUID]string, len(packageIDs))
+	ids := make([]uuid.UUID, 0, len(packageIDs))
+	for _, packageID := range packageIDs {
+		id, err := uuid.Parse(fromGlobalID(packageID).id)
+		if err != nil {
+			return nil, fmt.Errorf("uuid conversion from package ID %s failed with error: %w", packageID, err)
+		}
+		nodeIDs[id] = packageID
+		ids = append(ids, id)
+	}
+
+	records, err := b.client.PackageVersion.Query().
+		Where(packageversion.IDIn(ids...)).
+		WithVuln(func(q *ent.CertifyVulnQuery) {
+			getCertVulnObject(q.Where(certifyVulnPredicate(spec)).Order(certifyVulnOrder(spec.Order)...))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := make(map[string][]*model.CertifyVuln, len(records))
+	for _, record := range records {
+		rv[nodeIDs[record.ID]] = collect(record.Edges.Vuln, toModelCertifyVuln)
+	}
+	return rv, nil
+}
+
+func certifyVulnOrder(order *model.CertifyVulnOrder) []certifyvuln.OrderOption {
+	if order == nil {
+		return nil
+	}
+	term := orderTerm(order.SortOrder)
+	switch order.SortBy {
+	case model.CertifyVulnOrderFieldTimeScanned:
+		return []certifyvuln.OrderOption{certifyvuln.ByTimeScanned(term)}
+	case model.CertifyVulnOrderFieldScannerVersion:
+		return []certifyvuln.OrderOption{certifyvuln.ByScannerVersion(term)}
+	case model.CertifyVulnOrderFieldDbVersion:
+		return []certifyvuln.OrderOption{certifyvuln.ByDbVersion(term)}
+	}
+	return nil
+}
+
+func (b *EntBackend) CheckScannerFreshness(ctx context.Context, scannerURI string, maxAge time.Duration) (*model.ScannerFreshnessResult, error) {
+	funcName := "CheckScannerFreshness"
+
+	latest, err := b.client.CertifyVuln.Query().
+		Where(certifyvuln.ScannerURIEQ(scannerURI)).
+		Order(ent.Desc(certifyvuln.FieldTimeScanned)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, Errorf("%v :: no vulnerability certifications found for scanner %s", funcName, scannerURI)
+		}
+		return nil, Errorf("%v :: failed to query latest scan: %s", funcName, err)
+	}
+
+	staleBefore := time.Now().UTC().Add(-maxAge)
+	staleCount, err := b.client.CertifyVuln.Query().
+		Where(
+			certifyvuln.ScannerURIEQ(scannerURI),
+			certifyvuln.TimeScannedLT(staleBefore),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, Errorf("%v :: failed to count stale scans: %s", funcName, err)
+	}
+
+	return &model.ScannerFreshnessResult{
+		LatestScanTime: latest.TimeScanned,
+		DbVersion:      latest.DbVersion,
+		IsFresh:        !latest.TimeScanned.Before(staleBefore),
+		StaleScanCount: staleCount,
+	}, nil
+}
+
+func (b *EntBackend) MarkStaleVulns(ctx context.Context, olderThan time.Duration) (int, error) {
+	funcName := "MarkStaleVulns"
+
+	staleBefore := time.Now().UTC().Add(-olderThan)
+	updated, err := b.client.CertifyVuln.Update().
+		Where(
+			certifyvuln.TimeScannedLT(staleBefore),
+			certifyvuln.RemediationStatusNEQ(certifyvuln.RemediationStatusSTALE),
+		).
+		SetRemediationStatus(certifyvuln.RemediationStatusSTALE).
+		Save(ctx)
+	if err != nil {
+		return 0, Errorf("%v :: failed to mark stale vulnerability certifications: %s", funcName, err)
+	}
+	return updated, nil
+}
+
+func (b *EntBackend) DeleteCertifyVuln(ctx context.Context, id string) error {
+	funcName := "DeleteCertifyVuln"
+	foundGlobalID := fromGlobalID(id)
+	if foundGlobalID.nodeType != "" && foundGlobalID.nodeType != certifyvuln.Table {
+		return Errorf("%v :: id %s is not a certifyVuln", funcName, id)
+	}
+	certifyVulnID, err := uuid.Parse(foundGlobalID.id)
+	if err != nil {
+		return Errorf("%v :: uuid conversion from id %s failed with error: %v", funcName, id, err)
+	}
+
+	if err := b.client.CertifyVuln.DeleteOneID(certifyVulnID).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return errext.WithCode(Errorf("%v :: certifyVuln with id %s not found", funcName, id), errext.ErrNotFound)
+		}
+		return Errorf("%v :: %s", funcName, err)
+	}
+	return nil
+}
+
+// resolvedByTables maps the GraphQL types that can resolve a vulnerability to
+// the tables holding their nodes.
+var resolvedByTables = map[string]string{
+	"HasSBOM":     billofmaterials.Table,
+	"HasSourceAt": hassourceat.Table,
+}
+
+func (b *EntBackend) UpdateCertifyVulnResolution(ctx context.Context, id string, resolvedByID string) (*model.CertifyVuln, error) {
+	funcName := "UpdateCertifyVulnResolution"
+	foundGlobalID := fromGlobalID(id)
+	if foundGlobalID.nodeType != "" && foundGlobalID.nodeType != certifyvuln.Table {
+		return nil, Errorf("%v :: id %s is not a certifyVuln", funcName, id)
+	}
+	certifyVulnID, err := uuid.Parse(foundGlobalID.id)
+	if err != nil {
+		return nil, Errorf("%v :: uuid conversion from id %s failed with error: %v", funcName, id, err)
+	}
+
+	resolvedByGlobalID := fromGlobalID(resolvedByID)
+	resolvedByUUID, err := uuid.Parse(resolvedByGlobalID.id)
+	if err != nil {
+		return nil, Errorf("%v :: uuid conversion from id %s failed with error: %v", funcName, resolvedByID, err)
+	}
+	var resolvedByType string
+	var exists bool
+	switch resolvedByGlobalID.nodeType {
+	case billofmaterials.Table:
+		resolvedByType = "HasSBOM"
+		exists, err = b.client.BillOfMaterials.Query().Where(billofmaterials.ID(resolvedByUUID)).Exist(ctx)
+	case hassourceat.Table:
+		resolvedByType = "HasSourceAt"
+		exists, err = b.client.HasSourceAt.Query().Where(hassourceat.ID(resolvedByUUID)).Exist(ctx)
+	default:
+		return nil, Errorf("%v :: id %s is not a hasSBOM or hasSourceAt", funcName, resolvedByID)
+	}
+	if err != nil {
+		return nil, Errorf("%v :: %s", funcName, err)
+	}
+	if !exists {
+		return nil, Errorf("%v :: %s with id %s not found", funcName, resolvedByType, resolvedByID)
+	}
+
+	if err := b.client.CertifyVuln.UpdateOneID(certifyVulnID).SetResolvedBy(resolvedByUUID, resolvedByType).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, Errorf("%v :: certifyVuln with id %s not found", funcName, id)
+		}
+		return nil, Errorf("%v :: %s", funcName, err)
+	}
+
+	record, err := getCertVulnObject(b.client.CertifyVuln.Query().Where(certifyvuln.ID(certifyVulnID))).Only(ctx)
+	if err != nil {
+		return nil, Errorf("%v :: %s", funcName, err)
+	}
+	return toModelCertifyVuln(record), nil
 }
 
 func certifyVulnPredicate(spec model.CertifyVulnSpec) predicate.CertifyVuln {
@@ -219,6 +583,10 @@ func certifyVulnPredicate(spec model.CertifyVulnSpec) predicate.CertifyVuln {
 		optionalPredicate(spec.ScannerVersion, certifyvuln.ScannerVersionEQ),
 		optionalPredicate(spec.TimeScanned, certifyvuln.TimeScannedEQ),
 		optionalPredicate(spec.DocumentRef, certifyvuln.DocumentRefEQ),
+		optionalPredicate(spec.MinCVSS, certifyvuln.CvssScoreGTE),
+		optionalPredicate(spec.MaxCVSS, certifyvuln.CvssScoreLTE),
+		optionalPredicate(spec.TimeScannedAfter, certifyvuln.TimeScannedGTE),
+		optionalPredicate(spec.TimeScannedBefore, certifyvuln.TimeScannedLTE),
 		optionalPredicate(spec.Package, func(pkg model.PkgSpec) predicate.CertifyVuln {
 			return certifyvuln.HasPackageWith(
 				packageVersionQuery(spec.Package),
@@ -230,10 +598,14 @@ func certifyVulnPredicate(spec model.CertifyVulnSpec) predicate.CertifyVuln {
 			)
 		}),
 	}
+	if len(spec.ScannerURIIn) > 0 {
+		predicates = append(predicates, certifyvuln.ScannerURIIn(spec.ScannerURIIn...))
+	}
 	return certifyvuln.And(predicates...)
 }
 
-// getCertVulnObject is used recreate the CertifyVuln object be eager loading the edges
+// getCertVulnObject is used recreate the CertifyVuln object be eager loading the edges.
+// Every query whose results are passed to toModelCertifyVuln must go through it.
 func getCertVulnObject(q *ent.CertifyVulnQuery) *ent.CertifyVulnQuery {
 	return q.
 		WithPackage(func(q *ent.PackageVersionQuery) {
@@ -242,11 +614,18 @@ func getCertVulnObject(q *ent.CertifyVulnQuery) *ent.CertifyVulnQuery {
 		WithVulnerability(func(query *ent.VulnerabilityIDQuery) {})
 }
 
-func toModelCertifyVulnerability(record *ent.CertifyVuln) *model.CertifyVuln {
-	return &model.CertifyVuln{
+// toModelCertifyVuln converts a CertifyVuln record, loaded with its package
+// and vulnerability edges by getCertVulnObject, to its GraphQL model. Edges
+// that were not loaded are left nil.
+func toModelCertifyVuln(record *ent.CertifyVuln) *model.CertifyVuln {
+	var vuln *model.Vulnerability
+	if record.Edges.Vulnerability != nil {
+		vuln = toModelVulnerabilityFromVulnerabilityID(record.Edges.Vulnerability)
+	}
+	cv := &model.CertifyVuln{
 		ID:            toGlobalID(certifyvuln.Table, record.ID.String()),
 		Package:       toModelPackage(backReferencePackageVersion(record.Edges.Package)),
-		Vulnerability: toModelVulnerabilityFromVulnerabilityID(record.Edges.Vulnerability),
+		Vulnerability: vuln,
 		Metadata: &model.ScanMetadata{
 			TimeScanned:    record.TimeScanned,
 			DbURI:          record.DbURI,
@@ -256,9 +635,15 @@ func toModelCertifyVulnerability(record *ent.CertifyVuln) *model.CertifyVuln {
 			Origin:         record.Origin,
 			Collector:      record.Collector,
 			DocumentRef:    record.DocumentRef,
+			CVSSScore:      record.CvssScore,
 		},
+		RemediationStatus: model.RemediationStatus(record.RemediationStatus),
 	}
-
+	if record.ResolvedByID != nil && record.ResolvedByType != nil {
+		cv.ResolvedByID = ptrfrom.String(toGlobalID(resolvedByTables[*record.ResolvedByType], record.ResolvedByID.String()))
+		cv.ResolvedByType = record.ResolvedByType
+	}
+	return cv
 }
 
 func (b *EntBackend) certifyVulnNeighbors(ctx context.Context, nodeID string, allowedEdges edgeMap) ([]model.Node, error) {
@@ -295,3 +680,108 @@ func (b *EntBackend) certifyVulnNeighbors(ctx context.Context, nodeID string, al
 
 	return out, nil
 }
+
+func (b *EntBackend) CertifyVulnCount(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec) (int, error) {
+	count, err := b.client.CertifyVuln.Query().
+		Where(certifyVulnPredicate(certifyVulnSpec)).
+		Count(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "CertifyVulnCount")
+	}
+	return count, nil
+}
+
+func (b *EntBackend) GetCertifyVulnsByDocumentRef(ctx context.Context, documentRef string) ([]*model.CertifyVuln, error) {
+	records, err := getCertVulnObject(b.client.CertifyVuln.Query().
+		Where(certifyvuln.DocumentRef(documentRef))).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetCertifyVulnsByDocumentRef")
+	}
+	return collect(records, toModelCertifyVuln), nil
+}
+
+func (b *EntBackend) TopVulnerablePackages(ctx context.Context, limit int, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.PackageVulnCount, error) {
+	funcName := "TopVulnerablePackages"
+	if limit <= 0 {
+		return nil, errext.WithCode(Errorf("%v :: limit must be positive, got %d", funcName, limit), errext.ErrInvalidInput)
+	}
+	spec := model.CertifyVulnSpec{}
+	if certifyVulnSpec != nil {
+		spec = *certifyVulnSpec
+	}
+
+	var counts []struct {
+		PackageID uuid.UUID `json:"package_id"`
+		Count     int       `json:"count"`
+	}
+	err := b.client.CertifyVuln.Query().
+		Where(
+			certifyVulnPredicate(spec),
+			certifyvuln.HasVulnerabilityWith(vulnerabilityid.TypeNEQ(NoVuln)),
+		).
+		// the most certified packages first, ties broken by ID
+		Order(func(s *sql.Selector) {
+			s.OrderBy(sql.Desc(sql.Count("*")), s.C(certifyvuln.FieldPackageID))
+		}).
+		Limit(limit).
+		GroupBy(certifyvuln.FieldPackageID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	ids := make([]uuid.UUID, 0, len(counts))
+	for _, c := range counts {
+		ids = append(ids, c.PackageID)
+	}
+	versions, err := b.client.PackageVersion.Query().
+		Where(packageversion.IDIn(ids...)).
+		WithName(withPackageNameTree()).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	pkgs := make(map[uuid.UUID]*model.Package, len(versions))
+	for _, pv := range versions {
+		pkgs[pv.ID] = toModelPackage(backReferencePackageVersion(pv))
+	}
+
+	result := make([]*model.PackageVulnCount, 0, len(counts))
+	for _, c := range counts {
+		result = append(result, &model.PackageVulnCount{
+			Package: pkgs[c.PackageID],
+			Count:   c.Count,
+		})
+	}
+	return result, nil
+}
+
+func (b *EntBackend) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, pagination *model.PaginationSpec) (*model.CertifyVulnConnection, error) {
+	funcName := "CertifyVulnList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := getCertVulnObject(b.client.CertifyVuln.Query().
+		Where(certifyVulnPredicate(certifyVulnSpec))).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.CertifyVulnEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.CertifyVulnEdge{
+			Cursor: toGlobalID(certifyvuln.Table, edge.Node.ID.String()),
+			Node:   toModelCertifyVuln(edge.Node),
+		})
+	}
+	return &model.CertifyVulnConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, certifyvuln.Table),
+		Edges:      edges,
+	}, nil
+}
@@ -0,0 +1,138 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestToModelCertifyVuln(t *testing.T) {
+	timeScanned := time.Unix(1e9, 0).UTC()
+	pnID := uuid.New()
+	pvID := uuid.New()
+	vulnID := uuid.New()
+	cvID := uuid.New()
+
+	record := &ent.CertifyVuln{
+		ID:                cvID,
+		TimeScanned:       timeScanned,
+		DbURI:             "db.example.com",
+		DbVersion:         "v1",
+		ScannerURI:        "scanner.example.com",
+		ScannerVersion:    "v2",
+		Origin:            "origin",
+		Collector:         "collector",
+		DocumentRef:       "docref",
+		CvssScore:         ptrfrom.Float64(7.5),
+		RemediationStatus: certifyvuln.RemediationStatusSTALE,
+		Edges: ent.CertifyVulnEdges{
+			Package: &ent.PackageVersion{
+				ID:      pvID,
+				Version: "1.0.0",
+				Subpath: "sub",
+				Edges: ent.PackageVersionEdges{
+					Name: &ent.PackageName{
+						ID:        pnID,
+						Type:      "golang",
+						Namespace: "github.com/guacsec",
+						Name:      "guac",
+					},
+				},
+			},
+			Vulnerability: &ent.VulnerabilityID{
+				ID:              vulnID,
+				Type:            "osv",
+				VulnerabilityID: "ghsa-1234",
+			},
+		},
+	}
+
+	want := &model.CertifyVuln{
+		ID: toGlobalID(certifyvuln.Table, cvID.String()),
+		Package: &model.Package{
+			ID:   toGlobalID(pkgTypeString, pnID.String()),
+			Type: "golang",
+			Namespaces: []*model.PackageNamespace{{
+				ID:        toGlobalID(pkgNamespaceString, pnID.String()),
+				Namespace: "github.com/guacsec",
+				Names: []*model.PackageName{{
+					ID:   toGlobalID(packagename.Table, pnID.String()),
+					Name: "guac",
+					Versions: []*model.PackageVersion{{
+						ID:         toGlobalID(packageversion.Table, pvID.String()),
+						Version:    "1.0.0",
+						Subpath:    "sub",
+						Qualifiers: []*model.PackageQualifier{},
+					}},
+				}},
+			}},
+		},
+		Vulnerability: &model.Vulnerability{
+			ID:   toGlobalID(vulnTypeString, vulnID.String()),
+			Type: "osv",
+			VulnerabilityIDs: []*model.VulnerabilityID{{
+				ID:              toGlobalID(vulnerabilityid.Table, vulnID.String()),
+				VulnerabilityID: "ghsa-1234",
+			}},
+		},
+		Metadata: &model.ScanMetadata{
+			TimeScanned:    timeScanned,
+			DbURI:          "db.example.com",
+			DbVersion:      "v1",
+			ScannerURI:     "scanner.example.com",
+			ScannerVersion: "v2",
+			Origin:         "origin",
+			Collector:      "collector",
+			DocumentRef:    "docref",
+			CVSSScore:      ptrfrom.Float64(7.5),
+		},
+		RemediationStatus: model.RemediationStatusStale,
+	}
+
+	if diff := cmp.Diff(want, toModelCertifyVuln(record)); diff != "" {
+		t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+	}
+}
+
+func TestToModelCertifyVulnWithoutEdges(t *testing.T) {
+	cvID := uuid.New()
+	record := &ent.CertifyVuln{ID: cvID, Origin: "origin"}
+
+	got := toModelCertifyVuln(record)
+	if got.ID != toGlobalID(certifyvuln.Table, cvID.String()) {
+		t.Errorf("Unexpected ID %q", got.ID)
+	}
+	if got.Package != nil {
+		t.Errorf("Expected nil package, got %v", got.Package)
+	}
+	if got.Vulnerability != nil {
+		t.Errorf("Expected nil vulnerability, got %v", got.Vulnerability)
+	}
+	if got.Metadata.Origin != "origin" {
+		t.Errorf("Unexpected origin %q", got.Metadata.Origin)
+	}
+}
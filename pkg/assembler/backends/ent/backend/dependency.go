@@ -27,7 +27,6 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func (b *EntBackend) IsDependency(ctx context.Context, spec *model.IsDependencySpec) ([]*model.IsDependency, error) {
@@ -69,7 +68,7 @@ func (b *EntBackend) IngestDependencies(ctx context.Context, pkgs []*model.IDorP
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(dependency.Table, *ids), nil
@@ -119,7 +118,7 @@ func upsertBulkDependencies(ctx context.Context, tx *ent.Tx, pkgs []*model.IDorP
 			var isDependencyID *uuid.UUID
 			creates[i], isDependencyID, err = generateDependencyCreate(ctx, tx, pkgs[index], depPkgs[index], depPkgMatchType, dep)
 			if err != nil {
-				return nil, gqlerror.Errorf("generateDependencyCreate :: %s", err)
+				return nil, Errorf("generateDependencyCreate :: %s", err)
 			}
 			ids = append(ids, isDependencyID.String())
 
@@ -257,7 +256,7 @@ func (b *EntBackend) IngestDependency(ctx context.Context, pkg model.IDorPkgInpu
 
 		insert, _, err := generateDependencyCreate(ctx, tx, &pkg, &depPkg, depPkgMatchType, &dep)
 		if err != nil {
-			return nil, gqlerror.Errorf("generateDependencyCreate :: %s", err)
+			return nil, Errorf("generateDependencyCreate :: %s", err)
 		}
 
 		if id, err := insert.
@@ -390,3 +389,31 @@ func (b *EntBackend) isDependencyNeighbors(ctx context.Context, nodeID string, a
 
 	return out, nil
 }
+
+func (b *EntBackend) IsDependencyList(ctx context.Context, isDependencySpec model.IsDependencySpec, pagination *model.PaginationSpec) (*model.IsDependencyConnection, error) {
+	funcName := "IsDependencyList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := getIsDepObject(b.client.Dependency.Query().
+		Where(isDependencyQuery(&isDependencySpec))).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.IsDependencyEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.IsDependencyEdge{
+			Cursor: toGlobalID(dependency.Table, edge.Node.ID.String()),
+			Node:   toModelIsDependencyWithBackrefs(edge.Node),
+		})
+	}
+	return &model.IsDependencyConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, dependency.Table),
+		Edges:      edges,
+	}, nil
+}
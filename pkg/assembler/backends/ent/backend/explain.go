@@ -0,0 +1,95 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"database/sql"
+
+	entgo "entgo.io/ent"
+	"entgo.io/ent/dialect"
+	dialectsql "entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/graphql/explain"
+)
+
+// explainDriver runs EXPLAIN for the queries run with a context recording
+// them, before running the queries. The queries run in a transaction are not
+// explained.
+type explainDriver struct {
+	dialect.Driver
+}
+
+func (d explainDriver) Query(ctx context.Context, query string, args, v any) error {
+	if recorder := explain.FromContext(ctx); recorder != nil {
+		recorder.AddStatement(ctx, d.explain(ctx, query, args))
+	}
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+// Unwrap returns the driver running the queries, for the client methods that
+// need the database itself
+func (d explainDriver) Unwrap() dialect.Driver {
+	return d.Driver
+}
+
+// BeginTx is called by ent.Client.BeginTx on its driver
+func (d explainDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	return d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}).BeginTx(ctx, opts)
+}
+
+func (d explainDriver) explain(ctx context.Context, query string, args any) *explain.Statement {
+	stmt := &explain.Statement{SQL: query}
+	stmt.Args, _ = args.([]any)
+
+	var rows dialectsql.Rows
+	if err := d.Driver.Query(ctx, "EXPLAIN "+query, args, &rows); err != nil {
+		stmt.Error = err.Error()
+		return stmt
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var line string
+		if err := rows.Scan(&line); err != nil {
+			stmt.Error = err.Error()
+			return stmt
+		}
+		stmt.Plan = append(stmt.Plan, line)
+	}
+	if err := rows.Err(); err != nil {
+		stmt.Error = err.Error()
+	}
+	return stmt
+}
+
+// explainInterceptor attributes the statements run for an ent query, including
+// the ones loading its edges, to the query in the explain recorder
+func explainInterceptor() ent.Interceptor {
+	return ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			recorder := explain.FromContext(ctx)
+			qc := entgo.QueryFromContext(ctx)
+			if recorder == nil || qc == nil {
+				return next.Query(ctx, q)
+			}
+			ctx, done := recorder.StartQuery(ctx, qc.Type, qc.Op)
+			defer done()
+			return next.Query(ctx, q)
+		})
+	})
+}
@@ -0,0 +1,179 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploitreference"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/pkg/errors"
+)
+
+func (b *EntBackend) ExploitReferences(ctx context.Context, filter *model.ExploitReferenceSpec) ([]*model.ExploitReference, error) {
+	if filter == nil {
+		filter = &model.ExploitReferenceSpec{}
+	}
+
+	records, err := getExploitReferenceObject(b.client.ExploitReference.Query().
+		Where(exploitReferencePredicate(filter))).
+		Limit(MaxPageSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve ExploitReference :: %w", err)
+	}
+
+	return collect(records, toModelExploitReference), nil
+}
+
+// getExploitReferenceObject is used to recreate the exploitReference object by eager loading the edges
+func getExploitReferenceObject(q *ent.ExploitReferenceQuery) *ent.ExploitReferenceQuery {
+	return q.
+		WithVulnerabilityID(func(q *ent.VulnerabilityIDQuery) {})
+}
+
+func (b *EntBackend) IngestExploitReference(ctx context.Context, vulnerability model.IDorVulnerabilityInput, exploitReference model.ExploitReferenceInputSpec) (string, error) {
+	recordID, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*string, error) {
+		return upsertExploitReference(ctx, ent.TxFromContext(ctx), vulnerability, exploitReference)
+	})
+	if txErr != nil {
+		return "", fmt.Errorf("failed to execute IngestExploitReference :: %s", txErr)
+	}
+
+	return toGlobalID(exploitreference.Table, *recordID), nil
+}
+
+func exploitReferencePredicate(filter *model.ExploitReferenceSpec) predicate.ExploitReference {
+	predicates := []predicate.ExploitReference{
+		optionalPredicate(filter.ID, IDEQ),
+		optionalPredicate(filter.Source, exploitreference.SourceEQ),
+		optionalPredicate(filter.URL, exploitreference.URLEQ),
+		optionalPredicate(filter.Verified, exploitreference.VerifiedEQ),
+		optionalPredicate(filter.Origin, exploitreference.OriginEQ),
+		optionalPredicate(filter.Collector, exploitreference.CollectorEQ),
+		optionalPredicate(filter.DocumentRef, exploitreference.DocumentRefEQ),
+	}
+
+	if filter.Vulnerability != nil {
+		predicates = append(predicates,
+			exploitreference.HasVulnerabilityIDWith(
+				vulnerabilityQueryPredicates(*filter.Vulnerability)...,
+			),
+		)
+	}
+	return exploitreference.And(predicates...)
+}
+
+func upsertExploitReference(ctx context.Context, tx *ent.Tx, vulnerability model.IDorVulnerabilityInput, spec model.ExploitReferenceInputSpec) (*string, error) {
+	var vulnID uuid.UUID
+	if vulnerability.VulnerabilityNodeID != nil {
+		var err error
+		vulnGlobalID := fromGlobalID(*vulnerability.VulnerabilityNodeID)
+		vulnID, err = uuid.Parse(vulnGlobalID.id)
+		if err != nil {
+			return nil, fmt.Errorf("uuid conversion from VulnerabilityNodeID failed with error: %w", err)
+		}
+	} else {
+		if vulnerability.VulnerabilityInput == nil {
+			return nil, fmt.Errorf("vulnerability must be specified for exploitReference")
+		}
+		foundVulnID, err := tx.VulnerabilityID.Query().
+			Where(
+				vulnerabilityid.VulnerabilityIDEqualFold(vulnerability.VulnerabilityInput.VulnerabilityID),
+				vulnerabilityid.TypeEqualFold(vulnerability.VulnerabilityInput.Type),
+			).
+			OnlyID(ctx)
+		if err != nil {
+			return nil, Errorf("%v ::  %s", "upsertExploitReference", err)
+		}
+		vulnID = foundVulnID
+	}
+
+	// the reference is identified by its vulnerability, source and url, the
+	// remaining fields are updated when the same exploit is ingested again
+	id, err := tx.ExploitReference.Create().
+		SetVulnerabilityIDID(vulnID).
+		SetSource(spec.Source).
+		SetURL(spec.URL).
+		SetVerified(spec.Verified).
+		SetOrigin(spec.Origin).
+		SetCollector(spec.Collector).
+		SetDocumentRef(spec.DocumentRef).
+		OnConflict(
+			sql.ConflictColumns(
+				exploitreference.FieldVulnerabilityIDID,
+				exploitreference.FieldSource,
+				exploitreference.FieldURL,
+			),
+		).
+		UpdateVerified().
+		UpdateOrigin().
+		UpdateCollector().
+		UpdateDocumentRef().
+		ID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "upsert ExploitReference node")
+	}
+	return ptrfrom.String(id.String()), nil
+}
+
+func toModelExploitReference(e *ent.ExploitReference) *model.ExploitReference {
+	return &model.ExploitReference{
+		ID:            toGlobalID(exploitreference.Table, e.ID.String()),
+		Vulnerability: toModelVulnerabilityFromVulnerabilityID(e.Edges.VulnerabilityID),
+		Source:        e.Source,
+		URL:           e.URL,
+		Verified:      e.Verified,
+		Origin:        e.Origin,
+		Collector:     e.Collector,
+		DocumentRef:   e.DocumentRef,
+	}
+}
+
+func (b *EntBackend) exploitReferenceNeighbors(ctx context.Context, nodeID string, allowedEdges edgeMap) ([]model.Node, error) {
+	var out []model.Node
+
+	query := b.client.ExploitReference.Query().
+		Where(exploitReferencePredicate(&model.ExploitReferenceSpec{ID: &nodeID}))
+
+	if allowedEdges[model.EdgeExploitReferenceVulnerability] {
+		query.
+			WithVulnerabilityID()
+	}
+
+	query.
+		Limit(MaxPageSize)
+
+	exploitRefs, err := query.All(ctx)
+	if err != nil {
+		return []model.Node{}, fmt.Errorf("failed to query for exploit reference with node ID: %s with error: %w", nodeID, err)
+	}
+
+	for _, er := range exploitRefs {
+		if er.Edges.VulnerabilityID != nil {
+			out = append(out, toModelVulnerabilityFromVulnerabilityID(er.Edges.VulnerabilityID))
+		}
+	}
+
+	return out, nil
+}
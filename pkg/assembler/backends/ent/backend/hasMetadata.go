@@ -27,7 +27,6 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func (b *EntBackend) HasMetadata(ctx context.Context, filter *model.HasMetadataSpec) ([]*model.HasMetadata, error) {
@@ -78,7 +77,7 @@ func (b *EntBackend) IngestBulkHasMetadata(ctx context.Context, subjects model.P
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(hasmetadata.Table, *ids), nil
@@ -171,9 +170,9 @@ func upsertHasMetadata(ctx context.Context, tx *ent.Tx, subject model.PackageSou
 		)
 	}
 
-	insert, err := generateHasMetadataCreate(ctx, tx, subject.Package, subject.Source, subject.Artifact, pkgMatchType, &spec)
+	insert, _, err := generateHasMetadataCreate(ctx, tx, subject.Package, subject.Source, subject.Artifact, pkgMatchType, &spec)
 	if err != nil {
-		return nil, gqlerror.Errorf("generateDependencyCreate :: %s", err)
+		return nil, Errorf("generateDependencyCreate :: %s", err)
 	}
 
 	if id, err := insert.OnConflict(
@@ -190,7 +189,7 @@ func upsertHasMetadata(ctx context.Context, tx *ent.Tx, subject model.PackageSou
 }
 
 func generateHasMetadataCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgInput, src *model.IDorSourceInput, art *model.IDorArtifactInput, pkgMatchType *model.MatchFlags,
-	hm *model.HasMetadataInputSpec) (*ent.HasMetadataCreate, error) {
+	hm *model.HasMetadataInputSpec) (*ent.HasMetadataCreate, *uuid.UUID, error) {
 
 	hasMetadataCreate := tx.HasMetadata.Create()
 
@@ -203,6 +202,8 @@ func generateHasMetadataCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorP
 		SetCollector(hm.Collector).
 		SetDocumentRef(hm.DocumentRef)
 
+	var subjectID string
+
 	switch {
 	case art != nil:
 		var artID uuid.UUID
@@ -211,16 +212,17 @@ func generateHasMetadataCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorP
 			artGlobalID := fromGlobalID(*art.ArtifactID)
 			artID, err = uuid.Parse(artGlobalID.id)
 			if err != nil {
-				return nil, fmt.Errorf("uuid conversion from ArtifactID failed with error: %w", err)
+				return nil, nil, fmt.Errorf("uuid conversion from ArtifactID failed with error: %w", err)
 			}
 		} else {
 			foundArt, err := tx.Artifact.Query().Where(artifactQueryInputPredicates(*art.ArtifactInput)).Only(ctx)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			artID = foundArt.ID
 		}
 		hasMetadataCreate.SetArtifactID(artID)
+		subjectID = "artifact::" + artID.String()
 	case pkg != nil:
 		if pkgMatchType.Pkg == model.PkgMatchTypeSpecificVersion {
 			var pkgVersionID uuid.UUID
@@ -229,16 +231,17 @@ func generateHasMetadataCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorP
 				pkgVersionGlobalID := fromGlobalID(*pkg.PackageVersionID)
 				pkgVersionID, err = uuid.Parse(pkgVersionGlobalID.id)
 				if err != nil {
-					return nil, fmt.Errorf("uuid conversion from packageVersionID failed with error: %w", err)
+					return nil, nil, fmt.Errorf("uuid conversion from packageVersionID failed with error: %w", err)
 				}
 			} else {
 				pv, err := getPkgVersion(ctx, tx.Client(), *pkg.PackageInput)
 				if err != nil {
-					return nil, fmt.Errorf("getPkgVersion :: %w", err)
+					return nil, nil, fmt.Errorf("getPkgVersion :: %w", err)
 				}
 				pkgVersionID = pv.ID
 			}
 			hasMetadataCreate.SetPackageVersionID(pkgVersionID)
+			subjectID = "packageVersion::" + pkgVersionID.String()
 		} else {
 			var pkgNameID uuid.UUID
 			if pkg.PackageNameID != nil {
@@ -246,16 +249,17 @@ func generateHasMetadataCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorP
 				pkgNameGlobalID := fromGlobalID(*pkg.PackageNameID)
 				pkgNameID, err = uuid.Parse(pkgNameGlobalID.id)
 				if err != nil {
-					return nil, fmt.Errorf("uuid conversion from PackageNameID failed with error: %w", err)
+					return nil, nil, fmt.Errorf("uuid conversion from PackageNameID failed with error: %w", err)
 				}
 			} else {
 				pn, err := getPkgName(ctx, tx.Client(), *pkg.PackageInput)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				pkgNameID = pn.ID
 			}
 			hasMetadataCreate.SetAllVersionsID(pkgNameID)
+			subjectID = "packageName::" + pkgNameID.String()
 		}
 	case src != nil:
 		var sourceID uuid.UUID
@@ -264,18 +268,31 @@ func generateHasMetadataCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorP
 			srcNameGlobalID := fromGlobalID(*src.SourceNameID)
 			sourceID, err = uuid.Parse(srcNameGlobalID.id)
 			if err != nil {
-				return nil, fmt.Errorf("uuid conversion from SourceNameID failed with error: %w", err)
+				return nil, nil, fmt.Errorf("uuid conversion from SourceNameID failed with error: %w", err)
 			}
 		} else {
 			srcID, err := getSourceNameID(ctx, tx.Client(), *src.SourceInput)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			sourceID = srcID
 		}
 		hasMetadataCreate.SetSourceID(sourceID)
+		subjectID = "source::" + sourceID.String()
 	}
-	return hasMetadataCreate, nil
+	hasMetadataID := guacHasMetadataKey(subjectID, hm)
+	hasMetadataCreate.SetID(hasMetadataID)
+	return hasMetadataCreate, &hasMetadataID, nil
+}
+
+func canonicalHasMetadataString(hm *model.HasMetadataInputSpec) string {
+	return fmt.Sprintf("%s::%s::%s::%s::%s::%s:%s", hm.Key, hm.Value, hm.Justification, hm.Timestamp.UTC(), hm.Origin, hm.Collector, hm.DocumentRef)
+}
+
+// guacHasMetadataKey generates an uuid based on the hash of the subject and the fields of the unique index, so that the
+// IDs of hasMetadata nodes ingested in bulk can be returned, including the ones that were already ingested.
+func guacHasMetadataKey(subjectID string, hm *model.HasMetadataInputSpec) uuid.UUID {
+	return generateUUIDKey([]byte(fmt.Sprintf("%s::%s?", subjectID, canonicalHasMetadataString(hm))))
 }
 
 func upsertBulkHasMetadata(ctx context.Context, tx *ent.Tx, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, hasMetadataList []*model.HasMetadataInputSpec) (*[]string, error) {
@@ -332,23 +349,25 @@ func upsertBulkHasMetadata(ctx context.Context, tx *ent.Tx, subjects model.Packa
 		for i, hm := range hms {
 			hm := hm
 			var err error
+			var hasMetadataID *uuid.UUID
 			switch {
 			case len(subjects.Artifacts) > 0:
-				creates[i], err = generateHasMetadataCreate(ctx, tx, nil, nil, subjects.Artifacts[index], pkgMatchType, hm)
+				creates[i], hasMetadataID, err = generateHasMetadataCreate(ctx, tx, nil, nil, subjects.Artifacts[index], pkgMatchType, hm)
 				if err != nil {
-					return nil, gqlerror.Errorf("generateCertifyCreate :: %s", err)
+					return nil, Errorf("generateHasMetadataCreate :: %s", err)
 				}
 			case len(subjects.Packages) > 0:
-				creates[i], err = generateHasMetadataCreate(ctx, tx, subjects.Packages[index], nil, nil, pkgMatchType, hm)
+				creates[i], hasMetadataID, err = generateHasMetadataCreate(ctx, tx, subjects.Packages[index], nil, nil, pkgMatchType, hm)
 				if err != nil {
-					return nil, gqlerror.Errorf("generateCertifyCreate :: %s", err)
+					return nil, Errorf("generateHasMetadataCreate :: %s", err)
 				}
 			case len(subjects.Sources) > 0:
-				creates[i], err = generateHasMetadataCreate(ctx, tx, nil, subjects.Sources[index], nil, pkgMatchType, hm)
+				creates[i], hasMetadataID, err = generateHasMetadataCreate(ctx, tx, nil, subjects.Sources[index], nil, pkgMatchType, hm)
 				if err != nil {
-					return nil, gqlerror.Errorf("generateCertifyCreate :: %s", err)
+					return nil, Errorf("generateHasMetadataCreate :: %s", err)
 				}
 			}
+			ids = append(ids, hasMetadataID.String())
 			index++
 		}
 
@@ -442,3 +461,31 @@ func (b *EntBackend) hasMetadataNeighbors(ctx context.Context, nodeID string, al
 
 	return out, nil
 }
+
+func (b *EntBackend) HasMetadataList(ctx context.Context, hasMetadataSpec model.HasMetadataSpec, pagination *model.PaginationSpec) (*model.HasMetadataConnection, error) {
+	funcName := "HasMetadataList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := getHasMetadataObject(b.client.HasMetadata.Query().
+		Where(hasMetadataPredicate(&hasMetadataSpec))).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.HasMetadataEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.HasMetadataEdge{
+			Cursor: toGlobalID(hasmetadata.Table, edge.Node.ID.String()),
+			Node:   toModelHasMetadata(edge.Node),
+		})
+	}
+	return &model.HasMetadataConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, hasmetadata.Table),
+		Edges:      edges,
+	}, nil
+}
@@ -31,7 +31,6 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func (b *EntBackend) HashEqual(ctx context.Context, spec *model.HashEqualSpec) ([]*model.HashEqual, error) {
@@ -107,7 +106,7 @@ func (b *EntBackend) IngestHashEquals(ctx context.Context, artifacts []*model.ID
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(hashequal.Table, *ids), nil
@@ -138,7 +137,7 @@ func upsertBulkHashEqual(ctx context.Context, tx *ent.Tx, artifacts []*model.IDo
 			var err error
 			creates[i], err = generateHashEqualCreate(ctx, tx, artifacts[index], otherArtifacts[index], he)
 			if err != nil {
-				return nil, gqlerror.Errorf("generateHashEqualCreate :: %s", err)
+				return nil, Errorf("generateHashEqualCreate :: %s", err)
 			}
 			index++
 		}
@@ -223,7 +222,7 @@ func upsertHashEqual(ctx context.Context, tx *ent.Tx, artifactA model.IDorArtifa
 
 	hashEqualCreate, err := generateHashEqualCreate(ctx, tx, &artifactA, &artifactB, &spec)
 	if err != nil {
-		return nil, gqlerror.Errorf("generateHashEqualCreate :: %s", err)
+		return nil, Errorf("generateHashEqualCreate :: %s", err)
 	}
 
 	if id, err := hashEqualCreate.
@@ -315,3 +314,34 @@ func (b *EntBackend) hashEqualNeighbors(ctx context.Context, nodeID string, allo
 
 	return out, nil
 }
+
+func (b *EntBackend) HashEqualList(ctx context.Context, hashEqualSpec model.HashEqualSpec, pagination *model.PaginationSpec) (*model.HashEqualConnection, error) {
+	funcName := "HashEqualList"
+	if len(hashEqualSpec.Artifacts) > 2 {
+		return nil, fmt.Errorf("too many artifacts specified in hash equal filter")
+	}
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := getHashEqualObject(b.client.HashEqual.Query().
+		Where(hashEqualQueryPredicates(&hashEqualSpec))).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.HashEqualEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.HashEqualEdge{
+			Cursor: toGlobalID(hashequal.Table, edge.Node.ID.String()),
+			Node:   toModelHashEqual(edge.Node),
+		})
+	}
+	return &model.HashEqualConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, hashequal.Table),
+		Edges:      edges,
+	}, nil
+}
@@ -17,7 +17,6 @@ package backend
 
 import (
 	"crypto/sha256"
-	"fmt"
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
@@ -78,6 +77,14 @@ func optionalPredicate[P Predicate, T any](value *T, fn func(s T) P) P {
 	return fn(*value)
 }
 
+// orderTerm returns the ent order term option for a GraphQL order direction.
+func orderTerm(direction model.OrderDirection) sql.OrderTermOption {
+	if direction == model.OrderDirectionDesc {
+		return sql.OrderDesc()
+	}
+	return sql.OrderAsc()
+}
+
 func ptrWithDefault[T any](value *T, defaultValue T) T {
 	if value == nil {
 		return defaultValue
@@ -143,74 +150,3 @@ func chunk[T any](collection []T, size int) [][]T {
 func generateUUIDKey(data []byte) uuid.UUID {
 	return uuid.NewHash(sha256.New(), uuid.NameSpaceDNS, data, 5)
 }
-
-func getIDfromNode(node model.Node) (string, error) {
-	switch v := node.(type) {
-	case *model.Package:
-		if v != nil && len(v.Namespaces) > 0 && len(v.Namespaces[0].Names) > 0 && len(v.Namespaces[0].Names[0].Versions) > 0 {
-			return v.Namespaces[0].Names[0].Versions[0].ID, nil
-		} else if v != nil && len(v.Namespaces) > 0 && len(v.Namespaces[0].Names) > 0 {
-			return v.Namespaces[0].Names[0].ID, nil
-		} else if v != nil && len(v.Namespaces) > 0 {
-			return v.Namespaces[0].ID, nil
-		} else {
-			return v.ID, nil
-		}
-	case *model.Artifact:
-		return v.ID, nil
-	case *model.Builder:
-		return v.ID, nil
-	case *model.Source:
-		if v != nil && len(v.Namespaces) > 0 && len(v.Namespaces[0].Names) > 0 {
-			return v.Namespaces[0].Names[0].ID, nil
-		} else if v != nil && len(v.Namespaces) > 0 {
-			return v.Namespaces[0].ID, nil
-		} else {
-			return v.ID, nil
-		}
-	case *model.Vulnerability:
-		if len(v.VulnerabilityIDs) > 0 {
-			return v.VulnerabilityIDs[0].ID, nil
-		} else {
-			return v.ID, nil
-		}
-	case *model.License:
-		return v.ID, nil
-	case *model.CertifyBad:
-		return v.ID, nil
-	case *model.CertifyGood:
-		return v.ID, nil
-	case *model.CertifyLegal:
-		return v.ID, nil
-	case *model.CertifyScorecard:
-		return v.ID, nil
-	case *model.CertifyVEXStatement:
-		return v.ID, nil
-	case *model.CertifyVuln:
-		return v.ID, nil
-	case *model.HashEqual:
-		return v.ID, nil
-	case *model.HasMetadata:
-		return v.ID, nil
-	case *model.HasSbom:
-		return v.ID, nil
-	case *model.HasSlsa:
-		return v.ID, nil
-	case *model.HasSourceAt:
-		return v.ID, nil
-	case *model.IsDependency:
-		return v.ID, nil
-	case *model.IsOccurrence:
-		return v.ID, nil
-	case *model.PkgEqual:
-		return v.ID, nil
-	case *model.PointOfContact:
-		return v.ID, nil
-	case *model.VulnEqual:
-		return v.ID, nil
-	case *model.VulnerabilityMetadata:
-		return v.ID, nil
-	default:
-		return "", fmt.Errorf("unknown type: %v", v)
-	}
-}
@@ -29,7 +29,6 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func (b *EntBackend) IngestLicenses(ctx context.Context, licenses []*model.IDorLicenseInput) ([]string, error) {
@@ -43,7 +42,7 @@ func (b *EntBackend) IngestLicenses(ctx context.Context, licenses []*model.IDorL
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(license.Table, *ids), nil
@@ -212,3 +211,31 @@ func (b *EntBackend) licenseNeighbors(ctx context.Context, nodeID string, allowe
 
 	return out, nil
 }
+
+func (b *EntBackend) LicensesList(ctx context.Context, licenseSpec model.LicenseSpec, pagination *model.PaginationSpec) (*model.LicenseConnection, error) {
+	funcName := "LicensesList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := b.client.License.Query().
+		Where(licenseQuery(licenseSpec)).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.LicenseEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.LicenseEdge{
+			Cursor: toGlobalID(license.Table, edge.Node.ID.String()),
+			Node:   toModelLicense(edge.Node),
+		})
+	}
+	return &model.LicenseConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, license.Table),
+		Edges:      edges,
+	}, nil
+}
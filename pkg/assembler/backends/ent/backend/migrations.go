@@ -33,6 +33,13 @@ type BackendOptions struct {
 	Address     string
 	Debug       bool
 	AutoMigrate bool
+	// SkipIfExists checks whether a CertifyVuln already exists before
+	// ingesting it, so that re-running an ingestion does not write to the
+	// database
+	SkipIfExists bool
+	// Explain records the plans of the SQL queries run for the contexts
+	// recording them, see the explain package
+	Explain bool
 }
 
 // SetupBackend sets up the ent backend, preparing the database and returning a client
@@ -54,7 +61,14 @@ func SetupBackend(ctx context.Context, options *BackendOptions) (*ent.Client, er
 		return nil, fmt.Errorf("error opening db: %w", err)
 	}
 
-	client := ent.NewClient(ent.Driver(dialectsql.OpenDB(driver, db)))
+	var drv dialect.Driver = dialectsql.OpenDB(driver, db)
+	if options.Explain {
+		drv = explainDriver{Driver: drv}
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	if options.Explain {
+		client.Intercept(explainInterceptor())
+	}
 
 	if options.AutoMigrate {
 		// Run db migrations
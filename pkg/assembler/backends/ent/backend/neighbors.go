@@ -25,11 +25,13 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/builder"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploitreference"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -46,6 +48,8 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
 
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -76,8 +80,10 @@ func (b *EntBackend) bfs(ctx context.Context, from, to string, maxLength int, us
 			break
 		}
 
+		// nodes at the maximum length are not expanded, but the target may
+		// still be queued after them at the same depth
 		if nowNode.depth >= maxLength {
-			break
+			continue
 		}
 
 		neighbors, err := b.Neighbors(ctx, now, usingOnly)
@@ -86,7 +92,7 @@ func (b *EntBackend) bfs(ctx context.Context, from, to string, maxLength int, us
 		}
 
 		for _, next := range neighbors {
-			nextID, err := getIDfromNode(next)
+			nextID, err := helper.NodeID(next)
 			if err != nil {
 				return nil, fmt.Errorf("failed to convert model.Node to a specific type with error: %w", err)
 			}
@@ -128,6 +134,10 @@ func (b *EntBackend) bfs(ctx context.Context, from, to string, maxLength int, us
 	return b.Nodes(ctx, path)
 }
 
+func (b *EntBackend) NeighborsRecursive(ctx context.Context, nodeID string, usingOnly []model.Edge, maxDepth int) ([]model.Node, error) {
+	return helper.NeighborsRecursive(ctx, nodeID, usingOnly, maxDepth, b.Neighbors)
+}
+
 func (b *EntBackend) Neighbors(ctx context.Context, nodeID string, usingOnly []model.Edge) ([]model.Node, error) {
 	var neighbors []model.Node
 	var err error
@@ -282,6 +292,11 @@ func (b *EntBackend) Neighbors(ctx context.Context, nodeID string, usingOnly []m
 		if err != nil {
 			return []model.Node{}, fmt.Errorf("failed to get neighbors with id: %s with error: %w", nodeID, err)
 		}
+	case exploitreference.Table:
+		neighbors, err = b.exploitReferenceNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
+		if err != nil {
+			return []model.Node{}, fmt.Errorf("failed to get neighbors with id: %s with error: %w", nodeID, err)
+		}
 	default:
 		return nil, fmt.Errorf("unknown ID for neighbors query: %s", nodeID)
 	}
@@ -440,6 +455,9 @@ func (b *EntBackend) Node(ctx context.Context, node string) (model.Node, error)
 		if err != nil {
 			return nil, fmt.Errorf("failed to query for HasSBOM via ID: %s, with error: %w", nodeID.String(), err)
 		}
+		if len(hbs) == 0 {
+			return nil, errext.WithCode(Errorf("HasSBOM with id %s not found", nodeID.String()), errext.ErrNotFound)
+		}
 		if len(hbs) != 1 {
 			return nil, fmt.Errorf("ID returned multiple HasSBOM nodes %s", nodeID.String())
 		}
@@ -458,6 +476,9 @@ func (b *EntBackend) Node(ctx context.Context, node string) (model.Node, error)
 		if err != nil {
 			return nil, fmt.Errorf("failed to query for HasSourceAt via ID: %s, with error: %w", nodeID.String(), err)
 		}
+		if len(hsas) == 0 {
+			return nil, errext.WithCode(Errorf("HasSourceAt with id %s not found", nodeID.String()), errext.ErrNotFound)
+		}
 		if len(hsas) != 1 {
 			return nil, fmt.Errorf("ID returned multiple HasSourceAt nodes %s", nodeID.String())
 		}
@@ -516,6 +537,15 @@ func (b *EntBackend) Node(ctx context.Context, node string) (model.Node, error)
 			return nil, fmt.Errorf("ID returned multiple VulnerabilityMetadata nodes %s", nodeID.String())
 		}
 		return vms[0], nil
+	case exploitreference.Table:
+		ers, err := b.ExploitReferences(ctx, &model.ExploitReferenceSpec{ID: ptrfrom.String(nodeID.String())})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query for ExploitReference via ID: %s, with error: %w", nodeID.String(), err)
+		}
+		if len(ers) != 1 {
+			return nil, fmt.Errorf("ID returned multiple ExploitReference nodes %s", nodeID.String())
+		}
+		return ers[0], nil
 	default:
 		log.Printf("Unknown node type: %s", foundGlobalID.nodeType)
 	}
@@ -534,6 +564,353 @@ func (b *EntBackend) Nodes(ctx context.Context, nodes []string) ([]model.Node, e
 	return rv, nil
 }
 
+// BatchNodes returns the nodes for the given global IDs in the same order,
+// with nil for IDs that do not match a node. IDs are grouped by node type so
+// that each table is queried once.
+func (b *EntBackend) BatchNodes(ctx context.Context, nodes []string) ([]model.Node, error) {
+	idsByType := map[string][]uuid.UUID{}
+	for _, node := range nodes {
+		foundGlobalID := fromGlobalID(node)
+		if foundGlobalID.nodeType == "" {
+			return nil, fmt.Errorf("failed to parse globalID %s. Missing Node Type", node)
+		}
+		nodeID, err := uuid.Parse(foundGlobalID.id)
+		if err != nil {
+			return nil, fmt.Errorf("uuid conversion from string failed with error: %w", err)
+		}
+		idsByType[foundGlobalID.nodeType] = append(idsByType[foundGlobalID.nodeType], nodeID)
+	}
+
+	found := make(map[string]model.Node, len(nodes))
+	for nodeType, ids := range idsByType {
+		if err := b.queryNodesByType(ctx, nodeType, ids, found); err != nil {
+			return nil, err
+		}
+	}
+
+	rv := make([]model.Node, len(nodes))
+	for i, node := range nodes {
+		foundGlobalID := fromGlobalID(node)
+		nodeID, _ := uuid.Parse(foundGlobalID.id)
+		rv[i] = found[toGlobalID(foundGlobalID.nodeType, nodeID.String())]
+	}
+	return rv, nil
+}
+
+// nodeTypeNames maps the node type prefix of the global IDs to the GraphQL
+// type of the node.
+var nodeTypeNames = map[string]string{
+	artifact.Table:              "Artifact",
+	pkgTypeString:               "Package",
+	pkgNamespaceString:          "Package",
+	packagename.Table:           "Package",
+	packageversion.Table:        "Package",
+	srcTypeString:               "Source",
+	srcNamespaceString:          "Source",
+	sourcename.Table:            "Source",
+	vulnTypeString:              "Vulnerability",
+	vulnerabilityid.Table:       "Vulnerability",
+	builder.Table:               "Builder",
+	license.Table:               "License",
+	certifyBadString:            "CertifyBad",
+	certifyGoodString:           "CertifyGood",
+	certifylegal.Table:          "CertifyLegal",
+	certifyscorecard.Table:      "CertifyScorecard",
+	certifyvex.Table:            "CertifyVEXStatement",
+	certifyvuln.Table:           "CertifyVuln",
+	hashequal.Table:             "HashEqual",
+	hasmetadata.Table:           "HasMetadata",
+	billofmaterials.Table:       "HasSBOM",
+	slsaattestation.Table:       "HasSLSA",
+	hassourceat.Table:           "HasSourceAt",
+	dependency.Table:            "IsDependency",
+	occurrence.Table:            "IsOccurrence",
+	pkgequal.Table:              "PkgEqual",
+	pointofcontact.Table:        "PointOfContact",
+	vulnequal.Table:             "VulnEqual",
+	vulnerabilitymetadata.Table: "VulnerabilityMetadata",
+	exploitreference.Table:      "ExploitReference",
+}
+
+// NodeType decodes the type of the node from the prefix of its global ID, the
+// database is not queried so the node is not checked to exist.
+func (b *EntBackend) NodeType(ctx context.Context, node string) (string, error) {
+	foundGlobalID := fromGlobalID(node)
+	if foundGlobalID.nodeType == "" {
+		return "", fmt.Errorf("failed to parse globalID %s. Missing Node Type", node)
+	}
+	if _, err := uuid.Parse(foundGlobalID.id); err != nil {
+		return "", fmt.Errorf("uuid conversion from string failed with error: %w", err)
+	}
+	nodeType, ok := nodeTypeNames[foundGlobalID.nodeType]
+	if !ok {
+		return "", fmt.Errorf("unknown node type %q in globalID %s", foundGlobalID.nodeType, node)
+	}
+	return nodeType, nil
+}
+
+// queryNodesByType fetches all nodes of nodeType with the given IDs in a single
+// query and adds them to found, keyed by their global ID.
+func (b *EntBackend) queryNodesByType(ctx context.Context, nodeType string, ids []uuid.UUID, found map[string]model.Node) error {
+	switch nodeType {
+	case artifact.Table:
+		records, err := b.client.Artifact.Query().
+			Where(artifact.IDIn(ids...)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for Artifact nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelArtifact(record)
+		}
+	case packageversion.Table:
+		records, err := b.client.PackageVersion.Query().
+			Where(packageversion.IDIn(ids...)).
+			WithName(func(q *ent.PackageNameQuery) {}).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for PackageVersion nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelPackage(backReferencePackageVersion(record))
+		}
+	case packagename.Table:
+		records, err := b.client.PackageName.Query().
+			Where(packagename.IDIn(ids...)).
+			WithVersions().
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for PackageName nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelPackage(backReferencePackageName(record))
+		}
+	case sourcename.Table:
+		records, err := b.client.SourceName.Query().
+			Where(sourcename.IDIn(ids...)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for SourceName nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelSourceName(record)
+		}
+	case builder.Table:
+		records, err := b.client.Builder.Query().
+			Where(builder.IDIn(ids...)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for Builder nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelBuilder(record)
+		}
+	case license.Table:
+		records, err := b.client.License.Query().
+			Where(license.IDIn(ids...)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for License nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelLicense(record)
+		}
+	case vulnerabilityid.Table:
+		records, err := b.client.VulnerabilityID.Query().
+			Where(vulnerabilityid.IDIn(ids...)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for VulnerabilityID nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelVulnerabilityFromVulnerabilityID(record)
+		}
+	case certifyBadString:
+		records, err := getCertificationObject(b.client.Certification.Query().
+			Where(certification.IDIn(ids...), certification.TypeEQ(certification.TypeBAD))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for Certification nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelCertifyBad(record)
+		}
+	case certifyGoodString:
+		records, err := getCertificationObject(b.client.Certification.Query().
+			Where(certification.IDIn(ids...), certification.TypeEQ(certification.TypeGOOD))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for Certification nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelCertifyGood(record)
+		}
+	case certifylegal.Table:
+		records, err := getCertifyLegalObject(b.client.CertifyLegal.Query().
+			Where(certifylegal.IDIn(ids...))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for CertifyLegal nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelCertifyLegal(record)
+		}
+	case certifyscorecard.Table:
+		records, err := getScorecardObject(b.client.CertifyScorecard.Query().
+			Where(certifyscorecard.IDIn(ids...))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for CertifyScorecard nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelCertifyScorecard(record)
+		}
+	case certifyvex.Table:
+		records, err := getVEXObject(b.client.CertifyVex.Query().
+			Where(certifyvex.IDIn(ids...))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for CertifyVex nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelCertifyVEXStatement(record)
+		}
+	case certifyvuln.Table:
+		records, err := getCertVulnObject(b.client.CertifyVuln.Query().
+			Where(certifyvuln.IDIn(ids...))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for CertifyVuln nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelCertifyVuln(record)
+		}
+	case hashequal.Table:
+		records, err := getHashEqualObject(b.client.HashEqual.Query().
+			Where(hashequal.IDIn(ids...))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for HashEqual nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelHashEqual(record)
+		}
+	case hasmetadata.Table:
+		records, err := getHasMetadataObject(b.client.HasMetadata.Query().
+			Where(hasmetadata.IDIn(ids...))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for HasMetadata nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelHasMetadata(record)
+		}
+	case billofmaterials.Table:
+		records, err := getSBOMObject(b.client.BillOfMaterials.Query().
+			Where(billofmaterials.IDIn(ids...))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for BillOfMaterials nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelHasSBOM(record)
+		}
+	case slsaattestation.Table:
+		records, err := getSLSAObject(b.client.SLSAAttestation.Query().
+			Where(slsaattestation.IDIn(ids...))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for SLSAAttestation nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelHasSLSA(record)
+		}
+	case hassourceat.Table:
+		records, err := getHasSourceAtObject(b.client.HasSourceAt.Query().
+			Where(hassourceat.IDIn(ids...))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for HasSourceAt nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelHasSourceAt(record)
+		}
+	case dependency.Table:
+		records, err := getIsDepObject(b.client.Dependency.Query().
+			Where(dependency.IDIn(ids...))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for Dependency nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelIsDependencyWithBackrefs(record)
+		}
+	case occurrence.Table:
+		records, err := getOccurrenceObject(b.client.Occurrence.Query().
+			Where(occurrence.IDIn(ids...))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for Occurrence nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelIsOccurrenceWithSubject(record)
+		}
+	case pkgequal.Table:
+		records, err := getPkgEqualObject(b.client.PkgEqual.Query().
+			Where(pkgequal.IDIn(ids...))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for PkgEqual nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelPkgEqual(record)
+		}
+	case pointofcontact.Table:
+		records, err := getPointOfContactObject(b.client.PointOfContact.Query().
+			Where(pointofcontact.IDIn(ids...))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for PointOfContact nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelPointOfContact(record)
+		}
+	case vulnequal.Table:
+		records, err := getVulnEqualObject(b.client.VulnEqual.Query().
+			Where(vulnequal.IDIn(ids...))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for VulnEqual nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelVulnEqual(record)
+		}
+	case vulnerabilitymetadata.Table:
+		records, err := getVulnMetadataObject(b.client.VulnerabilityMetadata.Query().
+			Where(vulnerabilitymetadata.IDIn(ids...))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for VulnerabilityMetadata nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelVulnerabilityMetadata(record)
+		}
+	case exploitreference.Table:
+		records, err := getExploitReferenceObject(b.client.ExploitReference.Query().
+			Where(exploitreference.IDIn(ids...))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query for ExploitReference nodes: %w", err)
+		}
+		for _, record := range records {
+			found[toGlobalID(nodeType, record.ID.String())] = toModelExploitReference(record)
+		}
+	default:
+		log.Printf("Unknown node type: %s", nodeType)
+	}
+	return nil
+}
+
 type edgeMap map[model.Edge]bool
 
 func processUsingOnly(usingOnly []model.Edge) edgeMap {
@@ -27,7 +27,6 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func (b *EntBackend) IsOccurrence(ctx context.Context, query *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
@@ -67,7 +66,7 @@ func (b *EntBackend) IngestOccurrences(ctx context.Context, subjects model.Packa
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(occurrence.Table, *ids), nil
@@ -104,7 +103,7 @@ func upsertBulkOccurrences(ctx context.Context, tx *ent.Tx, subjects model.Packa
 			sql.NotNull(occurrence.FieldSourceID),
 		)
 	default:
-		return nil, gqlerror.Errorf("%v :: %s", "upsertBulkOccurrences", "subject must be either a package or source")
+		return nil, Errorf("%v :: %s", "upsertBulkOccurrences", "subject must be either a package or source")
 	}
 
 	batches := chunk(occurrences, MaxBatchSize)
@@ -120,7 +119,7 @@ func upsertBulkOccurrences(ctx context.Context, tx *ent.Tx, subjects model.Packa
 				var isOccurrenceID *uuid.UUID
 				creates[i], isOccurrenceID, err = generateOccurrenceCreate(ctx, tx, subjects.Packages[index], nil, artifacts[index], occur)
 				if err != nil {
-					return nil, gqlerror.Errorf("generateDependencyCreate :: %s", err)
+					return nil, Errorf("generateDependencyCreate :: %s", err)
 				}
 				ids = append(ids, isOccurrenceID.String())
 
@@ -129,11 +128,11 @@ func upsertBulkOccurrences(ctx context.Context, tx *ent.Tx, subjects model.Packa
 				var isOccurrenceID *uuid.UUID
 				creates[i], isOccurrenceID, err = generateOccurrenceCreate(ctx, tx, nil, subjects.Sources[index], artifacts[index], occur)
 				if err != nil {
-					return nil, gqlerror.Errorf("generateDependencyCreate :: %s", err)
+					return nil, Errorf("generateDependencyCreate :: %s", err)
 				}
 				ids = append(ids, isOccurrenceID.String())
 			default:
-				return nil, gqlerror.Errorf("%v :: %s", "upsertBulkOccurrences", "subject must be either a package or source")
+				return nil, Errorf("%v :: %s", "upsertBulkOccurrences", "subject must be either a package or source")
 			}
 			index++
 		}
@@ -233,7 +232,7 @@ func generateOccurrenceCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPk
 		}
 		occurrenceCreate.SetID(*isOccurrenceID)
 	} else {
-		return nil, nil, gqlerror.Errorf("%v :: %s", "generateOccurrenceCreate", "subject must be either a package or source")
+		return nil, nil, Errorf("%v :: %s", "generateOccurrenceCreate", "subject must be either a package or source")
 	}
 
 	return occurrenceCreate, isOccurrenceID, nil
@@ -266,12 +265,12 @@ func (b *EntBackend) IngestOccurrence(ctx context.Context,
 				sql.NotNull(occurrence.FieldSourceID),
 			)
 		} else {
-			return nil, gqlerror.Errorf("%v :: %s", funcName, "subject must be either a package or source")
+			return nil, Errorf("%v :: %s", funcName, "subject must be either a package or source")
 		}
 
 		insert, _, err := generateOccurrenceCreate(ctx, tx, subject.Package, subject.Source, &art, &spec)
 		if err != nil {
-			return nil, gqlerror.Errorf("generateDependencyCreate :: %s", err)
+			return nil, Errorf("generateDependencyCreate :: %s", err)
 		}
 
 		if id, err := insert.
@@ -287,7 +286,7 @@ func (b *EntBackend) IngestOccurrence(ctx context.Context,
 		}
 	})
 	if txErr != nil {
-		return "", gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return "", Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalID(occurrence.Table, *recordID), nil
@@ -336,11 +335,11 @@ func guacOccurrenceKey(pkgVersionID *string, srcNameID *string, artID *string, o
 	} else if srcNameID != nil {
 		subjectID = *srcNameID
 	} else {
-		return nil, gqlerror.Errorf("%v :: %s", "guacOccurrenceKey", "subject must be either a package or source")
+		return nil, Errorf("%v :: %s", "guacOccurrenceKey", "subject must be either a package or source")
 	}
 
 	if artID == nil {
-		return nil, gqlerror.Errorf("%v :: %s", "guacOccurrenceKey", "artifact must be specified")
+		return nil, Errorf("%v :: %s", "guacOccurrenceKey", "artifact must be specified")
 	}
 
 	occurIDString := fmt.Sprintf("%s::%s::%s?", subjectID, *artID, canonicalOccurrenceString(occur))
@@ -390,3 +389,31 @@ func (b *EntBackend) isOccurrenceNeighbors(ctx context.Context, nodeID string, a
 
 	return out, nil
 }
+
+func (b *EntBackend) IsOccurrenceList(ctx context.Context, isOccurrenceSpec model.IsOccurrenceSpec, pagination *model.PaginationSpec) (*model.IsOccurrenceConnection, error) {
+	funcName := "IsOccurrenceList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := getOccurrenceObject(b.client.Occurrence.Query().
+		Where(isOccurrenceQuery(&isOccurrenceSpec))).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.IsOccurrenceEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.IsOccurrenceEdge{
+			Cursor: toGlobalID(occurrence.Table, edge.Node.ID.String()),
+			Node:   toModelIsOccurrenceWithSubject(edge.Node),
+		})
+	}
+	return &model.IsOccurrenceConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, occurrence.Table),
+		Edges:      edges,
+	}, nil
+}
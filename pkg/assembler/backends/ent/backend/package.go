@@ -21,20 +21,34 @@ import (
 	"crypto/sha1"
 	stdsql "database/sql"
 	"fmt"
+	"slices"
 	"sort"
+	"strings"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvulnarchive"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/migrate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/occurrence"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/packagename"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/pkgequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/pointofcontact"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 const (
@@ -49,6 +63,7 @@ func (b *EntBackend) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*m
 
 	pkgs, err := b.client.PackageVersion.Query().
 		Where(packageQueryPredicates(pkgSpec)).
+		Order(packageVersionOrder(pkgSpec.Order)...).
 		WithName(func(q *ent.PackageNameQuery) {}).
 		Limit(MaxPageSize).
 		All(ctx)
@@ -64,7 +79,26 @@ func (b *EntBackend) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*m
 	return collect(pkgNames, toModelPackage), nil
 }
 
+func packageVersionOrder(order *model.PackageOrder) []packageversion.OrderOption {
+	if order == nil {
+		return nil
+	}
+	term := orderTerm(order.SortOrder)
+	switch order.SortBy {
+	case model.PackageOrderFieldType:
+		return []packageversion.OrderOption{packageversion.ByNameField(packagename.FieldType, term)}
+	case model.PackageOrderFieldNamespace:
+		return []packageversion.OrderOption{packageversion.ByNameField(packagename.FieldNamespace, term)}
+	case model.PackageOrderFieldName:
+		return []packageversion.OrderOption{packageversion.ByNameField(packagename.FieldName, term)}
+	case model.PackageOrderFieldVersion:
+		return []packageversion.OrderOption{packageversion.ByVersion(term)}
+	}
+	return nil
+}
+
 func packageQueryPredicates(pkgSpec *model.PkgSpec) predicate.PackageVersion {
+	pkgSpec = helpers.CanonicalizePkgSpec(pkgSpec)
 	return packageversion.And(
 		optionalPredicate(pkgSpec.ID, IDEQ),
 		optionalPredicate(pkgSpec.Version, packageversion.VersionEqualFold),
@@ -90,7 +124,7 @@ func (b *EntBackend) IngestPackages(ctx context.Context, pkgs []*model.IDorPkgIn
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	for _, pkgIDs := range *ids {
@@ -116,6 +150,414 @@ func (b *EntBackend) IngestPackage(ctx context.Context, pkg model.IDorPkgInput)
 	return pkgVersionID, nil
 }
 
+func (b *EntBackend) MergePackages(ctx context.Context, primary string, duplicates []string) (*model.Package, error) {
+	funcName := "MergePackages"
+	primaryGlobalID := fromGlobalID(primary)
+	if primaryGlobalID.nodeType != packageversion.Table && primaryGlobalID.nodeType != packagename.Table {
+		return nil, Errorf("%v :: id %s is not a package version or name", funcName, primary)
+	}
+	primaryID, err := uuid.Parse(primaryGlobalID.id)
+	if err != nil {
+		return nil, Errorf("%v :: uuid conversion from id %s failed with error: %v", funcName, primary, err)
+	}
+	dupIDs := make([]uuid.UUID, 0, len(duplicates))
+	for _, id := range duplicates {
+		dupGlobalID := fromGlobalID(id)
+		if dupGlobalID.nodeType != primaryGlobalID.nodeType {
+			return nil, Errorf("%v :: duplicate %s is not a package node of the same kind as %s", funcName, id, primary)
+		}
+		dupID, err := uuid.Parse(dupGlobalID.id)
+		if err != nil {
+			return nil, Errorf("%v :: uuid conversion from id %s failed with error: %v", funcName, id, err)
+		}
+		if dupID == primaryID {
+			return nil, Errorf("%v :: the primary package %s is also listed as a duplicate", funcName, primary)
+		}
+		dupIDs = append(dupIDs, dupID)
+	}
+
+	_, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*string, error) {
+		tx := ent.TxFromContext(ctx)
+		if primaryGlobalID.nodeType == packageversion.Table {
+			count, err := tx.PackageVersion.Query().Where(packageversion.IDIn(append(dupIDs, primaryID)...)).Count(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if count != len(dupIDs)+1 {
+				return nil, fmt.Errorf("not all of the package versions %s, %v exist", primary, duplicates)
+			}
+			return &primary, mergePackageVersions(ctx, tx, primaryID, dupIDs)
+		}
+		count, err := tx.PackageName.Query().Where(packagename.IDIn(append(dupIDs, primaryID)...)).Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if count != len(dupIDs)+1 {
+			return nil, fmt.Errorf("not all of the package names %s, %v exist", primary, duplicates)
+		}
+		return &primary, mergePackageNames(ctx, tx, primaryID, dupIDs)
+	})
+	if txErr != nil {
+		return nil, Errorf("%v :: %s", funcName, txErr)
+	}
+
+	if primaryGlobalID.nodeType == packageversion.Table {
+		pv, err := b.client.PackageVersion.Query().
+			Where(packageversion.ID(primaryID)).
+			WithName(func(q *ent.PackageNameQuery) {}).
+			Only(ctx)
+		if err != nil {
+			return nil, Errorf("%v :: %s", funcName, err)
+		}
+		return toModelPackage(backReferencePackageVersion(pv)), nil
+	}
+	pn, err := b.client.PackageName.Query().
+		Where(packagename.ID(primaryID)).
+		WithVersions().
+		Only(ctx)
+	if err != nil {
+		return nil, Errorf("%v :: %s", funcName, err)
+	}
+	return toModelPackage(pn), nil
+}
+
+// mergePackageVersions points the evidence of the versions dupIDs to the
+// version primaryID and deletes the duplicates. Evidence that would then be a
+// copy of evidence the primary already has, or of evidence of another
+// duplicate, is deleted instead of moved.
+func mergePackageVersions(ctx context.Context, tx *ent.Tx, primaryID uuid.UUID, dupIDs []uuid.UUID) error {
+	// equalities between the merged versions would point from the primary
+	// to itself
+	merged := append([]uuid.UUID{primaryID}, dupIDs...)
+	if _, err := tx.PkgEqual.Delete().Where(pkgequal.PkgIDIn(merged...), pkgequal.EqualPkgIDIn(merged...)).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete pkgEquals between the merged packages")
+	}
+
+	if _, err := tx.PkgEqual.Delete().Where(predicate.PkgEqual(duplicateEvidence(migrate.PkgEqualsTable, pkgequal.FieldPkgID, primaryID, dupIDs))).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete duplicate pkgEquals")
+	}
+	if _, err := tx.PkgEqual.Update().Where(pkgequal.PkgIDIn(dupIDs...)).SetPkgID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update pkgEquals")
+	}
+	if _, err := tx.PkgEqual.Delete().Where(predicate.PkgEqual(duplicateEvidence(migrate.PkgEqualsTable, pkgequal.FieldEqualPkgID, primaryID, dupIDs))).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete duplicate pkgEquals")
+	}
+	if _, err := tx.PkgEqual.Update().Where(pkgequal.EqualPkgIDIn(dupIDs...)).SetEqualPkgID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update pkgEquals")
+	}
+	if err := dropDuplicateOccurrences(ctx, tx, primaryID, dupIDs); err != nil {
+		return err
+	}
+	if _, err := tx.Occurrence.Update().Where(occurrence.PackageIDIn(dupIDs...)).SetPackageID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update occurrences")
+	}
+	if _, err := tx.BillOfMaterials.Delete().Where(predicate.BillOfMaterials(duplicateEvidence(migrate.BillOfMaterialsTable, billofmaterials.FieldPackageID, primaryID, dupIDs))).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete duplicate hasSBOMs")
+	}
+	if _, err := tx.BillOfMaterials.Update().Where(billofmaterials.PackageIDIn(dupIDs...)).SetPackageID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update hasSBOMs")
+	}
+	including, err := tx.BillOfMaterials.Query().Where(billofmaterials.HasIncludedSoftwarePackagesWith(packageversion.IDIn(dupIDs...))).IDs(ctx)
+	if err != nil {
+		return errors.Wrap(err, "query hasSBOMs including the duplicates")
+	}
+	if _, err := tx.BillOfMaterials.Update().
+		Where(billofmaterials.IDIn(including...)).
+		RemoveIncludedSoftwarePackageIDs(dupIDs...).
+		Save(ctx); err != nil {
+		return errors.Wrap(err, "update hasSBOM included software")
+	}
+	if _, err := tx.BillOfMaterials.Update().
+		Where(billofmaterials.IDIn(including...), billofmaterials.Not(billofmaterials.HasIncludedSoftwarePackagesWith(packageversion.ID(primaryID)))).
+		AddIncludedSoftwarePackageIDs(primaryID).
+		Save(ctx); err != nil {
+		return errors.Wrap(err, "update hasSBOM included software")
+	}
+	if _, err := tx.CertifyVuln.Delete().Where(predicate.CertifyVuln(duplicateEvidence(migrate.CertifyVulnsTable, certifyvuln.FieldPackageID, primaryID, dupIDs))).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete duplicate certifyVulns")
+	}
+	if _, err := tx.CertifyVuln.Update().Where(certifyvuln.PackageIDIn(dupIDs...)).SetPackageID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update certifyVulns")
+	}
+	// archived certifyVulns are history and are never deduplicated
+	if _, err := tx.CertifyVulnArchive.Update().Where(certifyvulnarchive.PackageIDIn(dupIDs...)).SetPackageID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update archived certifyVulns")
+	}
+	if _, err := tx.CertifyVex.Delete().Where(predicate.CertifyVex(duplicateEvidence(migrate.CertifyVexesTable, certifyvex.FieldPackageID, primaryID, dupIDs))).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete duplicate certifyVEXStatements")
+	}
+	if _, err := tx.CertifyVex.Update().Where(certifyvex.PackageIDIn(dupIDs...)).SetPackageID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update certifyVEXStatements")
+	}
+	if _, err := tx.HasSourceAt.Delete().Where(predicate.HasSourceAt(duplicateEvidence(migrate.HasSourceAtsTable, hassourceat.FieldPackageVersionID, primaryID, dupIDs))).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete duplicate hasSourceAts")
+	}
+	if _, err := tx.HasSourceAt.Update().Where(hassourceat.PackageVersionIDIn(dupIDs...)).SetPackageVersionID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update hasSourceAts")
+	}
+	if _, err := tx.Certification.Delete().Where(predicate.Certification(duplicateEvidence(migrate.CertificationsTable, certification.FieldPackageVersionID, primaryID, dupIDs))).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete duplicate certifications")
+	}
+	if _, err := tx.Certification.Update().Where(certification.PackageVersionIDIn(dupIDs...)).SetPackageVersionID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update certifications")
+	}
+	if _, err := tx.HasMetadata.Delete().Where(predicate.HasMetadata(duplicateEvidence(migrate.HasMetadataTable, hasmetadata.FieldPackageVersionID, primaryID, dupIDs))).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete duplicate hasMetadata")
+	}
+	if _, err := tx.HasMetadata.Update().Where(hasmetadata.PackageVersionIDIn(dupIDs...)).SetPackageVersionID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update hasMetadata")
+	}
+	if err := dropDuplicateDependencies(ctx, tx, dependency.FieldPackageID, primaryID, dupIDs); err != nil {
+		return err
+	}
+	if _, err := tx.Dependency.Update().Where(dependency.PackageIDIn(dupIDs...)).SetPackageID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update dependencies")
+	}
+	if err := dropDuplicateDependencies(ctx, tx, dependency.FieldDependentPackageVersionID, primaryID, dupIDs); err != nil {
+		return err
+	}
+	if _, err := tx.Dependency.Update().Where(dependency.DependentPackageVersionIDIn(dupIDs...)).SetDependentPackageVersionID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update dependents")
+	}
+	if _, err := tx.PointOfContact.Delete().Where(predicate.PointOfContact(duplicateEvidence(migrate.PointOfContactsTable, pointofcontact.FieldPackageVersionID, primaryID, dupIDs))).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete duplicate pointOfContacts")
+	}
+	if _, err := tx.PointOfContact.Update().Where(pointofcontact.PackageVersionIDIn(dupIDs...)).SetPackageVersionID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update pointOfContacts")
+	}
+	if _, err := tx.CertifyLegal.Delete().Where(predicate.CertifyLegal(duplicateEvidence(migrate.CertifyLegalsTable, certifylegal.FieldPackageID, primaryID, dupIDs))).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete duplicate certifyLegals")
+	}
+	if _, err := tx.CertifyLegal.Update().Where(certifylegal.PackageIDIn(dupIDs...)).SetPackageID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update certifyLegals")
+	}
+
+	if _, err := tx.PackageVersion.Delete().Where(packageversion.IDIn(dupIDs...)).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete package versions")
+	}
+	return nil
+}
+
+// mergePackageNames moves the versions and evidence of the names dupIDs to the
+// name primaryID and deletes the duplicates. A version that the primary
+// already has, with the same version, subpath and qualifiers, is merged into
+// the primary's.
+func mergePackageNames(ctx context.Context, tx *ent.Tx, primaryID uuid.UUID, dupIDs []uuid.UUID) error {
+	versions, err := tx.PackageVersion.Query().Where(packageversion.NameIDIn(dupIDs...)).All(ctx)
+	if err != nil {
+		return errors.Wrap(err, "query versions of the duplicate names")
+	}
+	primaryVersions, err := tx.PackageVersion.Query().Where(packageversion.NameID(primaryID)).All(ctx)
+	if err != nil {
+		return errors.Wrap(err, "query versions of the primary name")
+	}
+	byHash := map[string]uuid.UUID{}
+	for _, v := range primaryVersions {
+		byHash[v.Hash] = v.ID
+	}
+	sameVersions := map[uuid.UUID][]uuid.UUID{}
+	var moved []uuid.UUID
+	for _, v := range versions {
+		if id, ok := byHash[v.Hash]; ok {
+			sameVersions[id] = append(sameVersions[id], v.ID)
+		} else {
+			// the first duplicate of a version not in the primary moves,
+			// the others are merged into it
+			byHash[v.Hash] = v.ID
+			moved = append(moved, v.ID)
+		}
+	}
+	for id, dups := range sameVersions {
+		if err := mergePackageVersions(ctx, tx, id, dups); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.PackageVersion.Update().Where(packageversion.IDIn(moved...)).SetNameID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "move package versions")
+	}
+
+	if _, err := tx.HasSourceAt.Delete().Where(predicate.HasSourceAt(duplicateEvidence(migrate.HasSourceAtsTable, hassourceat.FieldPackageNameID, primaryID, dupIDs))).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete duplicate hasSourceAts")
+	}
+	if _, err := tx.HasSourceAt.Update().Where(hassourceat.PackageNameIDIn(dupIDs...)).SetPackageNameID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update hasSourceAts")
+	}
+	if _, err := tx.Certification.Delete().Where(predicate.Certification(duplicateEvidence(migrate.CertificationsTable, certification.FieldPackageNameID, primaryID, dupIDs))).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete duplicate certifications")
+	}
+	if _, err := tx.Certification.Update().Where(certification.PackageNameIDIn(dupIDs...)).SetPackageNameID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update certifications")
+	}
+	if _, err := tx.HasMetadata.Delete().Where(predicate.HasMetadata(duplicateEvidence(migrate.HasMetadataTable, hasmetadata.FieldPackageNameID, primaryID, dupIDs))).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete duplicate hasMetadata")
+	}
+	if _, err := tx.HasMetadata.Update().Where(hasmetadata.PackageNameIDIn(dupIDs...)).SetPackageNameID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update hasMetadata")
+	}
+	if err := dropDuplicateDependencies(ctx, tx, dependency.FieldDependentPackageNameID, primaryID, dupIDs); err != nil {
+		return err
+	}
+	if _, err := tx.Dependency.Update().Where(dependency.DependentPackageNameIDIn(dupIDs...)).SetDependentPackageNameID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update dependents")
+	}
+	if _, err := tx.PointOfContact.Delete().Where(predicate.PointOfContact(duplicateEvidence(migrate.PointOfContactsTable, pointofcontact.FieldPackageNameID, primaryID, dupIDs))).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete duplicate pointOfContacts")
+	}
+	if _, err := tx.PointOfContact.Update().Where(pointofcontact.PackageNameIDIn(dupIDs...)).SetPackageNameID(primaryID).Save(ctx); err != nil {
+		return errors.Wrap(err, "update pointOfContacts")
+	}
+
+	if _, err := tx.PackageName.Delete().Where(packagename.IDIn(dupIDs...)).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete package names")
+	}
+	return nil
+}
+
+// dropDuplicateOccurrences deletes the occurrences of the versions dupIDs
+// that the merge would turn into copies of another occurrence. The SBOMs
+// that included them include the kept occurrence instead.
+func dropDuplicateOccurrences(ctx context.Context, tx *ent.Tx, primaryID uuid.UUID, dupIDs []uuid.UUID) error {
+	duplicate := predicate.Occurrence(duplicateEvidence(migrate.OccurrencesTable, occurrence.FieldPackageID, primaryID, dupIDs))
+	included, err := tx.Occurrence.Query().
+		Where(duplicate, occurrence.HasIncludedInSboms()).
+		WithIncludedInSboms().
+		All(ctx)
+	if err != nil {
+		return errors.Wrap(err, "query duplicate occurrences")
+	}
+	for _, o := range included {
+		kept, err := tx.Occurrence.Query().
+			Where(
+				occurrence.PackageIDIn(append(dupIDs, primaryID)...),
+				occurrence.Not(duplicate),
+				predicate.Occurrence(sameEvidence(migrate.OccurrencesTable, occurrence.FieldPackageID, o.ID)),
+			).
+			OnlyID(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "query the occurrence kept for %s", o.ID)
+		}
+		bomIDs := make([]uuid.UUID, 0, len(o.Edges.IncludedInSboms))
+		for _, bom := range o.Edges.IncludedInSboms {
+			bomIDs = append(bomIDs, bom.ID)
+		}
+		if _, err := tx.BillOfMaterials.Update().
+			Where(billofmaterials.IDIn(bomIDs...), billofmaterials.Not(billofmaterials.HasIncludedOccurrencesWith(occurrence.ID(kept)))).
+			AddIncludedOccurrenceIDs(kept).
+			Save(ctx); err != nil {
+			return errors.Wrap(err, "update hasSBOM included occurrences")
+		}
+	}
+	if _, err := tx.Occurrence.Delete().Where(duplicate).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete duplicate occurrences")
+	}
+	return nil
+}
+
+// dropDuplicateDependencies deletes the dependencies that point with column to
+// one of dupIDs and that the merge would turn into copies of another
+// dependency. The SBOMs that included them include the kept dependency
+// instead.
+func dropDuplicateDependencies(ctx context.Context, tx *ent.Tx, column string, primaryID uuid.UUID, dupIDs []uuid.UUID) error {
+	duplicate := predicate.Dependency(duplicateEvidence(migrate.DependenciesTable, column, primaryID, dupIDs))
+	included, err := tx.Dependency.Query().
+		Where(duplicate, dependency.HasIncludedInSboms()).
+		WithIncludedInSboms().
+		All(ctx)
+	if err != nil {
+		return errors.Wrap(err, "query duplicate dependencies")
+	}
+	for _, d := range included {
+		kept, err := tx.Dependency.Query().
+			Where(
+				sql.FieldIn(column, append(dupIDs, primaryID)...),
+				dependency.Not(duplicate),
+				predicate.Dependency(sameEvidence(migrate.DependenciesTable, column, d.ID)),
+			).
+			OnlyID(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "query the dependency kept for %s", d.ID)
+		}
+		bomIDs := make([]uuid.UUID, 0, len(d.Edges.IncludedInSboms))
+		for _, bom := range d.Edges.IncludedInSboms {
+			bomIDs = append(bomIDs, bom.ID)
+		}
+		if _, err := tx.BillOfMaterials.Update().
+			Where(billofmaterials.IDIn(bomIDs...), billofmaterials.Not(billofmaterials.HasIncludedDependenciesWith(dependency.ID(kept)))).
+			AddIncludedDependencyIDs(kept).
+			Save(ctx); err != nil {
+			return errors.Wrap(err, "update hasSBOM included dependencies")
+		}
+	}
+	if _, err := tx.Dependency.Delete().Where(duplicate).Exec(ctx); err != nil {
+		return errors.Wrap(err, "delete duplicate dependencies")
+	}
+	return nil
+}
+
+// duplicateEvidence matches the rows of table that point with column to one
+// of dupIDs and that, once pointed to primaryID, would repeat under one of the
+// unique indexes of table a row of the primary, or a row of a duplicate with a
+// lower ID. Deleting them keeps one row of each repeated evidence.
+func duplicateEvidence(table *schema.Table, column string, primaryID uuid.UUID, dupIDs []uuid.UUID) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		other := sql.Dialect(s.Dialect()).Table(table.Name).As("other")
+		s.Where(sql.And(
+			sql.In(s.C(column), uuidArgs(dupIDs)...),
+			sql.Exists(sql.Dialect(s.Dialect()).Select(other.C("id")).From(other).Where(sql.And(
+				sql.Or(
+					sql.EQ(other.C(column), primaryID),
+					sql.And(sql.In(other.C(column), uuidArgs(dupIDs)...), sql.ColumnsLT(other.C("id"), s.C("id"))),
+				),
+				evidenceKeyEQ(table, column, other, s),
+			))),
+		))
+	}
+}
+
+// sameEvidence matches the rows of table that agree with the row id on the
+// columns other than column of one of the unique indexes of table.
+func sameEvidence(table *schema.Table, column string, id uuid.UUID) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		other := sql.Dialect(s.Dialect()).Table(table.Name).As("other")
+		s.Where(sql.Exists(sql.Dialect(s.Dialect()).Select(other.C("id")).From(other).Where(sql.And(
+			sql.EQ(other.C("id"), id),
+			sql.ColumnsNEQ(other.C("id"), s.C("id")),
+			evidenceKeyEQ(table, column, other, s),
+		))))
+	}
+}
+
+// evidenceKeyEQ is true when other and s agree on the columns, besides column,
+// of a unique index of table that contains column. As in the indexes, NULL
+// columns never agree.
+func evidenceKeyEQ(table *schema.Table, column string, other *sql.SelectTable, s *sql.Selector) *sql.Predicate {
+	var keys []*sql.Predicate
+	for _, idx := range table.Indexes {
+		if !idx.Unique || !slices.ContainsFunc(idx.Columns, func(c *schema.Column) bool { return c.Name == column }) {
+			continue
+		}
+		var eqs []*sql.Predicate
+		for _, c := range idx.Columns {
+			if c.Name != column {
+				eqs = append(eqs, sql.ColumnsEQ(other.C(c.Name), s.C(c.Name)))
+			}
+		}
+		keys = append(keys, sql.And(eqs...))
+	}
+	if len(keys) == 0 {
+		return sql.False()
+	}
+	return sql.Or(keys...)
+}
+
+func uuidArgs(ids []uuid.UUID) []any {
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	return args
+}
+
 func generatePackageNameCreate(tx *ent.Tx, pkgNameID *uuid.UUID, pkgInput *model.IDorPkgInput) *ent.PackageNameCreate {
 	return tx.PackageName.Create().
 		SetID(*pkgNameID).
@@ -131,7 +573,8 @@ func generatePackageVersionCreate(tx *ent.Tx, pkgVersionID *uuid.UUID, pkgNameID
 		SetNillableVersion(pkgInput.PackageInput.Version).
 		SetSubpath(ptrWithDefault(pkgInput.PackageInput.Subpath, "")).
 		SetQualifiers(normalizeInputQualifiers(pkgInput.PackageInput.Qualifiers)).
-		SetHash(versionHashFromInputSpec(*pkgInput.PackageInput))
+		SetHash(versionHashFromInputSpec(*pkgInput.PackageInput)).
+		SetComponentType(strings.ToLower(ptrWithDefault(pkgInput.PackageInput.ComponentType, "")))
 }
 
 func upsertBulkPackage(ctx context.Context, tx *ent.Tx, pkgInputs []*model.IDorPkgInput) (*[]model.PackageIDs, error) {
@@ -144,7 +587,10 @@ func upsertBulkPackage(ctx context.Context, tx *ent.Tx, pkgInputs []*model.IDorP
 		pkgVersionCreates := make([]*ent.PackageVersionCreate, len(pkgs))
 
 		for i, pkg := range pkgs {
-			pkgInput := pkg
+			// packages are stored in canonical form, see getPkgVersion
+			canonical := *pkg
+			canonical.PackageInput = helpers.CanonicalizePkgInputSpec(pkg.PackageInput)
+			pkgInput := &canonical
 			pkgIDs := helpers.GetKey[*model.PkgInputSpec, helpers.PkgIds](pkgInput.PackageInput, helpers.PkgServerKey)
 			pkgNameID := generateUUIDKey([]byte(pkgIDs.NameId))
 			pkgVersionID := generateUUIDKey([]byte(pkgIDs.VersionId))
@@ -194,6 +640,7 @@ func upsertBulkPackage(ctx context.Context, tx *ent.Tx, pkgInputs []*model.IDorP
 // upsertPackage is a helper function to create or update a package node and its associated edges.
 // It is used in multiple places, so we extract it to a function.
 func upsertPackage(ctx context.Context, tx *ent.Tx, pkg model.IDorPkgInput) (*model.PackageIDs, error) {
+	pkg.PackageInput = helpers.CanonicalizePkgInputSpec(pkg.PackageInput)
 	pkgIDs := helpers.GetKey[*model.PkgInputSpec, helpers.PkgIds](pkg.PackageInput, helpers.PkgServerKey)
 	pkgNameID := generateUUIDKey([]byte(pkgIDs.NameId))
 	pkgVersionID := generateUUIDKey([]byte(pkgIDs.VersionId))
@@ -284,14 +731,11 @@ func normalizeInputQualifiers(inputs []*model.PackageQualifierInputSpec) []model
 	return qualifiers
 }
 
-func packageVersionInputQuery(spec model.PkgInputSpec) predicate.PackageVersion {
-	return packageVersionQuery(helper.ConvertPkgInputSpecToPkgSpec(&spec))
-}
-
 func packageVersionQuery(filter *model.PkgSpec) predicate.PackageVersion {
 	if filter == nil {
 		return NoOpSelector()
 	}
+	filter = helpers.CanonicalizePkgSpec(filter)
 
 	rv := []predicate.PackageVersion{
 		optionalPredicate(filter.ID, IDEQ),
@@ -322,6 +766,7 @@ func packageNameQuery(spec *model.PkgSpec) predicate.PackageName {
 	if spec == nil {
 		return NoOpSelector()
 	}
+	spec = helpers.CanonicalizePkgSpec(spec)
 	query := []predicate.PackageName{
 		optionalPredicate(spec.ID, IDEQ),
 		optionalPredicate(spec.Name, packagename.NameEQ),
@@ -389,11 +834,11 @@ func backReferencePackageVersion(pv *ent.PackageVersion) *ent.PackageName {
 // and should allow using the db index.
 
 func getPkgName(ctx context.Context, client *ent.Client, pkgin model.PkgInputSpec) (*ent.PackageName, error) {
-	return client.PackageName.Query().Where(packageNameInputQuery(pkgin)).Only(ctx)
+	return client.PackageName.Query().Where(packageNameInputQuery(*helpers.CanonicalizePkgInputSpec(&pkgin))).Only(ctx)
 }
 
 func getPkgVersion(ctx context.Context, client *ent.Client, pkgin model.PkgInputSpec) (*ent.PackageVersion, error) {
-	return client.PackageVersion.Query().Where(packageVersionInputQuery(pkgin)).Only(ctx)
+	return client.PackageVersion.Query().Where(packageVersionQuery(helper.ConvertPkgInputSpecToPkgSpec(&pkgin))).Only(ctx)
 }
 
 func (b *EntBackend) packageTypeNeighbors(ctx context.Context, nodeID string, allowedEdges edgeMap) ([]model.Node, error) {
@@ -721,7 +1166,7 @@ func (b *EntBackend) packageVersionNeighbors(ctx context.Context, nodeID string,
 			out = append(out, toModelIsOccurrenceWithSubject(foundOccur))
 		}
 		for _, foundVuln := range foundPkgVersion.Edges.Vuln {
-			out = append(out, toModelCertifyVulnerability(foundVuln))
+			out = append(out, toModelCertifyVuln(foundVuln))
 		}
 		for _, foundSBOM := range foundPkgVersion.Edges.Sbom {
 			out = append(out, toModelHasSBOM(foundSBOM))
@@ -756,3 +1201,42 @@ func (b *EntBackend) packageVersionNeighbors(ctx context.Context, nodeID string,
 
 	return out, nil
 }
+
+func (b *EntBackend) PackagesCount(ctx context.Context, pkgSpec model.PkgSpec) (int, error) {
+	count, err := b.client.PackageVersion.Query().
+		Where(packageQueryPredicates(&pkgSpec)).
+		Count(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "PackagesCount")
+	}
+	return count, nil
+}
+
+func (b *EntBackend) PackagesList(ctx context.Context, pkgSpec model.PkgSpec, pagination *model.PaginationSpec) (*model.PackageConnection, error) {
+	funcName := "PackagesList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := b.client.PackageVersion.Query().
+		Where(packageQueryPredicates(&pkgSpec)).
+		WithName(func(q *ent.PackageNameQuery) {}).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.PackageEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.PackageEdge{
+			Cursor: toGlobalID(packageversion.Table, edge.Node.ID.String()),
+			Node:   toModelPackage(backReferencePackageVersion(edge.Node)),
+		})
+	}
+	return &model.PackageConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, packageversion.Table),
+		Edges:      edges,
+	}, nil
+}
@@ -0,0 +1,74 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// paginationArgs converts a PaginationSpec into the cursor arguments used by
+// the generated ent Paginate functions. If neither first nor last is set, the
+// page is capped at MaxPageSize.
+func paginationArgs(pagination *model.PaginationSpec) (after *ent.Cursor, first *int, before *ent.Cursor, last *int, err error) {
+	if err := helper.ValidatePaginationSpec(pagination); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if pagination == nil {
+		pagination = &model.PaginationSpec{}
+	}
+	if after, err = toEntCursor(pagination.After); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if before, err = toEntCursor(pagination.Before); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	first, last = pagination.First, pagination.Last
+	if first == nil && last == nil {
+		first = ptrfrom.Int(MaxPageSize)
+	}
+	return after, first, before, last, nil
+}
+
+func toEntCursor(cursor *string) (*ent.Cursor, error) {
+	if cursor == nil {
+		return nil, nil
+	}
+	id, err := uuid.Parse(fromGlobalID(*cursor).id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor %q: %w", *cursor, err)
+	}
+	return &ent.Cursor{ID: id}, nil
+}
+
+func toModelPageInfo(pageInfo ent.PageInfo, nodeType string) *model.PageInfo {
+	out := &model.PageInfo{
+		HasNextPage:     pageInfo.HasNextPage,
+		HasPreviousPage: pageInfo.HasPreviousPage,
+	}
+	if pageInfo.StartCursor != nil {
+		out.StartCursor = ptrfrom.String(toGlobalID(nodeType, pageInfo.StartCursor.ID.String()))
+	}
+	if pageInfo.EndCursor != nil {
+		out.EndCursor = ptrfrom.String(toGlobalID(nodeType, pageInfo.EndCursor.ID.String()))
+	}
+	return out
+}
@@ -31,7 +31,6 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func (b *EntBackend) PkgEqual(ctx context.Context, spec *model.PkgEqualSpec) ([]*model.PkgEqual, error) {
@@ -84,7 +83,7 @@ func (b *EntBackend) IngestPkgEquals(ctx context.Context, pkgs []*model.IDorPkgI
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(pkgequal.Table, *ids), nil
@@ -116,7 +115,7 @@ func upsertBulkPkgEquals(ctx context.Context, tx *ent.Tx, pkgs []*model.IDorPkgI
 
 			creates[i], err = generatePkgEqualCreate(ctx, tx, pkgs[index], otherPackages[index], pe)
 			if err != nil {
-				return nil, gqlerror.Errorf("generatePkgEqualCreate :: %s", err)
+				return nil, Errorf("generatePkgEqualCreate :: %s", err)
 			}
 			index++
 		}
@@ -202,7 +201,7 @@ func upsertPackageEqual(ctx context.Context, tx *ent.Tx, pkgA model.IDorPkgInput
 
 	pkgEqualCreate, err := generatePkgEqualCreate(ctx, tx, &pkgA, &pkgB, &spec)
 	if err != nil {
-		return nil, gqlerror.Errorf("generatePkgEqualCreate :: %s", err)
+		return nil, Errorf("generatePkgEqualCreate :: %s", err)
 	}
 	if id, err := pkgEqualCreate.
 		OnConflict(
@@ -312,3 +311,34 @@ func (b *EntBackend) pkgEqualNeighbors(ctx context.Context, nodeID string, allow
 
 	return out, nil
 }
+
+func (b *EntBackend) PkgEqualList(ctx context.Context, pkgEqualSpec model.PkgEqualSpec, pagination *model.PaginationSpec) (*model.PkgEqualConnection, error) {
+	funcName := "PkgEqualList"
+	if len(pkgEqualSpec.Packages) > 2 {
+		return nil, fmt.Errorf("too many packages specified in pkg equal filter")
+	}
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := getPkgEqualObject(b.client.PkgEqual.Query().
+		Where(pkgEqualQueryPredicates(&pkgEqualSpec))).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.PkgEqualEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.PkgEqualEdge{
+			Cursor: toGlobalID(pkgequal.Table, edge.Node.ID.String()),
+			Node:   toModelPkgEqual(edge.Node),
+		})
+	}
+	return &model.PkgEqualConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, pkgequal.Table),
+		Edges:      edges,
+	}, nil
+}
@@ -27,7 +27,6 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func (b *EntBackend) PointOfContact(ctx context.Context, filter *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
@@ -78,12 +77,73 @@ func (b *EntBackend) IngestPointOfContacts(ctx context.Context, subjects model.P
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(pointofcontact.Table, *ids), nil
 }
 
+func (b *EntBackend) UpdatePointOfContact(ctx context.Context, id string, pointOfContact model.PointOfContactInputSpec) (*model.PointOfContact, error) {
+	funcName := "UpdatePointOfContact"
+	foundGlobalID := fromGlobalID(id)
+	if foundGlobalID.nodeType != "" && foundGlobalID.nodeType != pointofcontact.Table {
+		return nil, Errorf("%v :: id %s is not a pointOfContact", funcName, id)
+	}
+	pocID, err := uuid.Parse(foundGlobalID.id)
+	if err != nil {
+		return nil, Errorf("%v :: uuid conversion from id %s failed with error: %v", funcName, id, err)
+	}
+
+	_, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*uuid.UUID, error) {
+		tx := ent.TxFromContext(ctx)
+		record, err := tx.PointOfContact.Query().
+			Where(pointofcontact.ID(pocID)).
+			WithSource().
+			WithArtifact().
+			WithPackageVersion().
+			WithAllVersions().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("pointOfContact with id %s not found", id)
+			}
+			return nil, err
+		}
+		if record.Edges.Source == nil && record.Edges.Artifact == nil &&
+			record.Edges.PackageVersion == nil && record.Edges.AllVersions == nil {
+			return nil, fmt.Errorf("subject of pointOfContact with id %s no longer exists", id)
+		}
+
+		err = tx.PointOfContact.UpdateOneID(pocID).
+			SetEmail(pointOfContact.Email).
+			SetInfo(pointOfContact.Info).
+			SetSince(pointOfContact.Since.UTC()).
+			SetJustification(pointOfContact.Justification).
+			SetOrigin(pointOfContact.Origin).
+			SetCollector(pointOfContact.Collector).
+			SetDocumentRef(pointOfContact.DocumentRef).
+			Exec(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return nil, fmt.Errorf("update conflicts with an existing pointOfContact: %w", err)
+			}
+			return nil, errors.Wrap(err, "update pointOfContact node")
+		}
+		return &pocID, nil
+	})
+	if txErr != nil {
+		return nil, Errorf("%v :: %s", funcName, txErr)
+	}
+
+	record, err := getPointOfContactObject(b.client.PointOfContact.Query().
+		Where(pointofcontact.ID(pocID))).
+		Only(ctx)
+	if err != nil {
+		return nil, Errorf("%v :: %s", funcName, err)
+	}
+	return toModelPointOfContact(record), nil
+}
+
 func pointOfContactPredicate(filter *model.PointOfContactSpec) predicate.PointOfContact {
 	predicates := []predicate.PointOfContact{
 		optionalPredicate(filter.ID, IDEQ),
@@ -180,17 +240,17 @@ func upsertBulkPointOfContact(ctx context.Context, tx *ent.Tx, subjects model.Pa
 			case len(subjects.Artifacts) > 0:
 				creates[i], err = generatePointOfContactCreate(ctx, tx, nil, nil, subjects.Artifacts[index], pkgMatchType, poc)
 				if err != nil {
-					return nil, gqlerror.Errorf("generatePointOfContactCreate :: %s", err)
+					return nil, Errorf("generatePointOfContactCreate :: %s", err)
 				}
 			case len(subjects.Packages) > 0:
 				creates[i], err = generatePointOfContactCreate(ctx, tx, subjects.Packages[index], nil, nil, pkgMatchType, poc)
 				if err != nil {
-					return nil, gqlerror.Errorf("generatePointOfContactCreate :: %s", err)
+					return nil, Errorf("generatePointOfContactCreate :: %s", err)
 				}
 			case len(subjects.Sources) > 0:
 				creates[i], err = generatePointOfContactCreate(ctx, tx, nil, subjects.Sources[index], nil, pkgMatchType, poc)
 				if err != nil {
-					return nil, gqlerror.Errorf("generatePointOfContactCreate :: %s", err)
+					return nil, Errorf("generatePointOfContactCreate :: %s", err)
 				}
 			}
 			index++
@@ -346,7 +406,7 @@ func upsertPointOfContact(ctx context.Context, tx *ent.Tx, subject model.Package
 
 	insert, err := generatePointOfContactCreate(ctx, tx, subject.Package, subject.Source, subject.Artifact, pkgMatchType, &spec)
 	if err != nil {
-		return nil, gqlerror.Errorf("generatePointOfContactCreate :: %s", err)
+		return nil, Errorf("generatePointOfContactCreate :: %s", err)
 	}
 	if id, err := insert.OnConflict(
 		sql.ConflictColumns(conflictColumns...),
@@ -437,3 +497,31 @@ func (b *EntBackend) pointOfContactNeighbors(ctx context.Context, nodeID string,
 
 	return out, nil
 }
+
+func (b *EntBackend) PointOfContactList(ctx context.Context, pointOfContactSpec model.PointOfContactSpec, pagination *model.PaginationSpec) (*model.PointOfContactConnection, error) {
+	funcName := "PointOfContactList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := getPointOfContactObject(b.client.PointOfContact.Query().
+		Where(pointOfContactPredicate(&pointOfContactSpec))).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.PointOfContactEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.PointOfContactEdge{
+			Cursor: toGlobalID(pointofcontact.Table, edge.Node.ID.String()),
+			Node:   toModelPointOfContact(edge.Node),
+		})
+	}
+	return &model.PointOfContactConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, pointofcontact.Table),
+		Edges:      edges,
+	}, nil
+}
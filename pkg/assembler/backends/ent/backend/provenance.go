@@ -0,0 +1,88 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+)
+
+// GetProvenance runs the queries for each kind of attestation of the package
+// concurrently. The SLSA attestations are those of the artifacts the package
+// is an occurrence of, so they are queried once the occurrences are known.
+func (b *EntBackend) GetProvenance(ctx context.Context, pkgID string) (*model.ProvenanceSummary, error) {
+	funcName := "GetProvenance"
+	pkgSpec := &model.PkgSpec{ID: &pkgID}
+	summary := &model.ProvenanceSummary{}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		summary.HasSbom, err = b.HasSBOM(gctx, &model.HasSBOMSpec{Subject: &model.PackageOrArtifactSpec{Package: pkgSpec}})
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		summary.HasSourceAt, err = b.HasSourceAt(gctx, &model.HasSourceAtSpec{Package: pkgSpec})
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		summary.CertifyVulns, err = b.CertifyVuln(gctx, &model.CertifyVulnSpec{Package: pkgSpec})
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		summary.CertifyLegal, err = b.CertifyLegal(gctx, &model.CertifyLegalSpec{Subject: &model.PackageOrSourceSpec{Package: pkgSpec}})
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		summary.IsOccurrence, err = b.IsOccurrence(gctx, &model.IsOccurrenceSpec{Subject: &model.PackageOrSourceSpec{Package: pkgSpec}})
+		if err != nil {
+			return err
+		}
+		summary.HasSlsa, err = b.occurrencesHasSLSA(gctx, summary.IsOccurrence)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	return summary, nil
+}
+
+// occurrencesHasSLSA returns the SLSA attestations of the artifacts of the
+// occurrences, once per artifact
+func (b *EntBackend) occurrencesHasSLSA(ctx context.Context, occurrences []*model.IsOccurrence) ([]*model.HasSlsa, error) {
+	var slsas []*model.HasSlsa
+	seen := map[string]bool{}
+	for _, occurrence := range occurrences {
+		artifactID := occurrence.Artifact.ID
+		if seen[artifactID] {
+			continue
+		}
+		seen[artifactID] = true
+		found, err := b.HasSlsa(ctx, &model.HasSLSASpec{Subject: &model.ArtifactSpec{ID: &artifactID}})
+		if err != nil {
+			return nil, err
+		}
+		slsas = append(slsas, found...)
+	}
+	return slsas, nil
+}
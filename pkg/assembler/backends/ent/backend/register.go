@@ -17,8 +17,26 @@
 
 package backend
 
-import "github.com/guacsec/guac/pkg/assembler/backends"
+import (
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
+)
 
 func init() {
 	backends.Register("ent", getBackend)
+	errext.RegisterClassifier(classifyError)
+}
+
+// classifyError maps ent errors to the error codes returned to GraphQL clients.
+func classifyError(err error) errext.Code {
+	switch {
+	case ent.IsNotFound(err):
+		return errext.ErrNotFound
+	case ent.IsConstraintError(err):
+		return errext.ErrConstraintViolation
+	case ent.IsValidationError(err):
+		return errext.ErrInvalidInput
+	}
+	return ""
 }
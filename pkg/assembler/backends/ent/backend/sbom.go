@@ -19,6 +19,7 @@ import (
 	"context"
 	stdsql "database/sql"
 	"fmt"
+	"sort"
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
@@ -26,11 +27,11 @@ import (
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packageversion"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func (b *EntBackend) HasSBOM(ctx context.Context, spec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
@@ -52,6 +53,52 @@ func (b *EntBackend) HasSBOM(ctx context.Context, spec *model.HasSBOMSpec) ([]*m
 	return collect(records, toModelHasSBOM), nil
 }
 
+func (b *EntBackend) SBOMComponentBreakdown(ctx context.Context, hasSBOMID string) ([]*model.ComponentTypeCount, error) {
+	funcName := "SBOMComponentBreakdown"
+
+	sbomID, err := uuid.Parse(fromGlobalID(hasSBOMID).id)
+	if err != nil {
+		return nil, Errorf("%v :: invalid hasSBOM ID %s: %v", funcName, hasSBOMID, err)
+	}
+
+	exists, err := b.client.BillOfMaterials.Query().
+		Where(billofmaterials.ID(sbomID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	if !exists {
+		return nil, Errorf("%v :: hasSBOM with ID %s not found", funcName, hasSBOMID)
+	}
+
+	var counts []struct {
+		ComponentType string `json:"component_type"`
+		Count         int    `json:"count"`
+	}
+	err = b.client.PackageVersion.Query().
+		Where(
+			packageversion.HasIncludedInSbomsWith(billofmaterials.ID(sbomID)),
+			packageversion.ComponentTypeNEQ(""),
+		).
+		GroupBy(packageversion.FieldComponentType).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	sort.Slice(counts, func(i, j int) bool { return counts[i].ComponentType < counts[j].ComponentType })
+
+	result := make([]*model.ComponentTypeCount, 0, len(counts))
+	for _, c := range counts {
+		result = append(result, &model.ComponentTypeCount{
+			ComponentType: c.ComponentType,
+			Count:         c.Count,
+		})
+	}
+	return result, nil
+}
+
 func hasSBOMQuery(spec model.HasSBOMSpec) predicate.BillOfMaterials {
 	predicates := []predicate.BillOfMaterials{
 		optionalPredicate(spec.ID, IDEQ),
@@ -80,6 +127,9 @@ func hasSBOMQuery(spec model.HasSBOMSpec) predicate.BillOfMaterials {
 			predicates = append(predicates, billofmaterials.HasIncludedSoftwareArtifactsWith(artifactQueryPredicates(spec.IncludedSoftware[i].Artifact)))
 		}
 	}
+	for _, pkg := range spec.IncludedPackages {
+		predicates = append(predicates, billofmaterials.HasIncludedSoftwarePackagesWith(packageVersionQuery(pkg)))
+	}
 	for i := range spec.IncludedDependencies {
 		predicates = append(predicates, billofmaterials.HasIncludedDependenciesWith(isDependencyQuery(spec.IncludedDependencies[i])))
 	}
@@ -118,7 +168,7 @@ func (b *EntBackend) IngestHasSbom(ctx context.Context, subject model.PackageOrA
 
 		id, err := upsertHasSBOM(ctx, tx, subject.Package, subject.Artifact, &includes, &spec)
 		if err != nil {
-			return nil, gqlerror.Errorf("generateSBOMCreate :: %s", err)
+			return nil, Errorf("generateSBOMCreate :: %s", err)
 		}
 		return id, nil
 	})
@@ -130,21 +180,20 @@ func (b *EntBackend) IngestHasSbom(ctx context.Context, subject model.PackageOrA
 }
 
 func (b *EntBackend) IngestHasSBOMs(ctx context.Context, subjects model.PackageOrArtifactInputs, hasSBOMs []*model.HasSBOMInputSpec, includes []*model.HasSBOMIncludesInputSpec) ([]string, error) {
-	var sbomIDs []string
-	for i, hasSbom := range hasSBOMs {
-		var subject model.PackageOrArtifactInput
-		if len(subjects.Artifacts) > 0 {
-			subject = model.PackageOrArtifactInput{Artifact: subjects.Artifacts[i]}
-		} else {
-			subject = model.PackageOrArtifactInput{Package: subjects.Packages[i]}
-		}
-		id, err := b.IngestHasSbom(ctx, subject, *hasSbom, *includes[i])
+	funcName := "IngestHasSBOMs"
+	ids, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*[]string, error) {
+		client := ent.TxFromContext(ctx)
+		slc, err := upsertBulkHasSBOM(ctx, client, subjects, hasSBOMs, includes)
 		if err != nil {
-			return nil, gqlerror.Errorf("IngestHasSBOMs failed with err: %v", err)
+			return nil, err
 		}
-		sbomIDs = append(sbomIDs, id)
+		return slc, nil
+	})
+	if txErr != nil {
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
-	return toGlobalIDs(billofmaterials.Table, sbomIDs), nil
+
+	return toGlobalIDs(billofmaterials.Table, *ids), nil
 }
 
 func sbomConflictColumns() []string {
@@ -164,29 +213,115 @@ func sbomConflictColumns() []string {
 	}
 }
 
-func upsertHasSBOM(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgInput, art *model.IDorArtifactInput, includes *model.HasSBOMIncludesInputSpec, hasSBOM *model.HasSBOMInputSpec) (*string, error) {
-
-	// If a new column is included in the conflict columns, it must be added to the Indexes() function in the schema
+// sbomSubjectConflict returns the conflict columns and predicate for hasSBOM nodes on a package or an artifact.
+// If a new column is included in the conflict columns, it must be added to the Indexes() function in the schema
+func sbomSubjectConflict(isPackage bool) ([]string, *sql.Predicate) {
 	conflictColumns := sbomConflictColumns()
-
-	var conflictWhere *sql.Predicate
-
-	if pkg != nil {
-		conflictColumns = append(conflictColumns, billofmaterials.FieldPackageID)
-		conflictWhere = sql.And(
+	if isPackage {
+		return append(conflictColumns, billofmaterials.FieldPackageID), sql.And(
 			sql.NotNull(billofmaterials.FieldPackageID),
 			sql.IsNull(billofmaterials.FieldArtifactID),
 		)
-	} else if art != nil {
-		conflictColumns = append(conflictColumns, billofmaterials.FieldArtifactID)
-		conflictWhere = sql.And(
-			sql.IsNull(billofmaterials.FieldPackageID),
-			sql.NotNull(billofmaterials.FieldArtifactID),
-		)
-	} else {
+	}
+	return append(conflictColumns, billofmaterials.FieldArtifactID), sql.And(
+		sql.IsNull(billofmaterials.FieldPackageID),
+		sql.NotNull(billofmaterials.FieldArtifactID),
+	)
+}
+
+// hasSBOMIncludes holds the ID of a hasSBOM node and the IDs of the nodes it includes, which are
+// added as edges once the node exists
+type hasSBOMIncludes struct {
+	hasSBOMID    uuid.UUID
+	packages     []uuid.UUID
+	artifacts    []uuid.UUID
+	dependencies []uuid.UUID
+	occurrences  []uuid.UUID
+}
+
+func upsertBulkHasSBOM(ctx context.Context, tx *ent.Tx, subjects model.PackageOrArtifactInputs, hasSBOMs []*model.HasSBOMInputSpec, includes []*model.HasSBOMIncludesInputSpec) (*[]string, error) {
+	ids := make([]string, 0)
+
+	isPackage := len(subjects.Packages) > 0
+	conflictColumns, conflictWhere := sbomSubjectConflict(isPackage)
+
+	batches := chunk(hasSBOMs, MaxBatchSize)
+
+	index := 0
+	for _, sboms := range batches {
+		creates := make([]*ent.BillOfMaterialsCreate, len(sboms))
+		included := make([]*hasSBOMIncludes, len(sboms))
+		for i, sbom := range sboms {
+			var pkg *model.IDorPkgInput
+			var art *model.IDorArtifactInput
+			if isPackage {
+				pkg = subjects.Packages[index]
+			} else {
+				art = subjects.Artifacts[index]
+			}
+			var err error
+			creates[i], included[i], err = generateHasSBOMCreate(ctx, tx, pkg, art, includes[index], sbom)
+			if err != nil {
+				return nil, Errorf("generateHasSBOMCreate :: %s", err)
+			}
+			ids = append(ids, included[i].hasSBOMID.String())
+			index++
+		}
+
+		err := tx.BillOfMaterials.CreateBulk(creates...).
+			OnConflict(
+				sql.ConflictColumns(conflictColumns...),
+				sql.ConflictWhere(conflictWhere),
+			).
+			DoNothing().
+			Exec(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "bulk upsert hasSBOM node")
+		}
+
+		for _, inc := range included {
+			if err := updateHasSBOMIncludes(ctx, tx.Client(), inc); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &ids, nil
+}
+
+func upsertHasSBOM(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgInput, art *model.IDorArtifactInput, includes *model.HasSBOMIncludesInputSpec, hasSBOM *model.HasSBOMInputSpec) (*string, error) {
+	if pkg == nil && art == nil {
 		return nil, Errorf("%v :: %s", "upsertHasSBOM", "subject must be either a package or artifact")
 	}
+	conflictColumns, conflictWhere := sbomSubjectConflict(pkg != nil)
+
+	sbomCreate, included, err := generateHasSBOMCreate(ctx, tx, pkg, art, includes, hasSBOM)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = sbomCreate.
+		OnConflict(
+			sql.ConflictColumns(conflictColumns...),
+			sql.ConflictWhere(conflictWhere),
+		).
+		DoNothing().
+		ID(ctx)
+	if err != nil {
+		// err "no rows in select set" appear when ingesting and the node already exists. This is non-error produced by "DoNothing"
+		if err != stdsql.ErrNoRows {
+			return nil, errors.Wrap(err, "upsert hasSBOM node")
+		}
+	}
 
+	if err := updateHasSBOMIncludes(ctx, tx.Client(), included); err != nil {
+		return nil, err
+	}
+
+	return ptrfrom.String(included.hasSBOMID.String()), nil
+}
+
+func generateHasSBOMCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgInput, art *model.IDorArtifactInput, includes *model.HasSBOMIncludesInputSpec, hasSBOM *model.HasSBOMInputSpec) (*ent.BillOfMaterialsCreate, *hasSBOMIncludes, error) {
 	sbomCreate := tx.BillOfMaterials.Create().
 		SetURI(hasSBOM.URI).
 		SetAlgorithm(strings.ToLower(hasSBOM.Algorithm)).
@@ -206,19 +341,16 @@ func upsertHasSBOM(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgInput, art
 	var sortedDepHash string
 	var sortedOccurHash string
 
-	var sortedPkgUUIDs []uuid.UUID
-	var sortedArtUUIDs []uuid.UUID
-	var sortedIsDepUUIDs []uuid.UUID
-	var sortedIsOccurrenceUUIDs []uuid.UUID
+	included := &hasSBOMIncludes{}
 
 	if len(sortedPkgIDs) > 0 {
 		for _, pkgID := range sortedPkgIDs {
 			pkgGlobalID := fromGlobalID(pkgID)
 			pkgIncludesID, err := uuid.Parse(pkgGlobalID.id)
 			if err != nil {
-				return nil, fmt.Errorf("uuid conversion from packageVersionID failed with error: %w", err)
+				return nil, nil, fmt.Errorf("uuid conversion from packageVersionID failed with error: %w", err)
 			}
-			sortedPkgUUIDs = append(sortedPkgUUIDs, pkgIncludesID)
+			included.packages = append(included.packages, pkgIncludesID)
 		}
 		sortedPkgHash = hashListOfSortedKeys(sortedPkgIDs)
 		sbomCreate.SetIncludedPackagesHash(sortedPkgHash)
@@ -232,9 +364,9 @@ func upsertHasSBOM(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgInput, art
 			artGlobalID := fromGlobalID(artID)
 			artIncludesID, err := uuid.Parse(artGlobalID.id)
 			if err != nil {
-				return nil, fmt.Errorf("uuid conversion from ArtifactID failed with error: %w", err)
+				return nil, nil, fmt.Errorf("uuid conversion from ArtifactID failed with error: %w", err)
 			}
-			sortedArtUUIDs = append(sortedArtUUIDs, artIncludesID)
+			included.artifacts = append(included.artifacts, artIncludesID)
 		}
 
 		sortedArtHash = hashListOfSortedKeys(sortedArtIDs)
@@ -249,9 +381,9 @@ func upsertHasSBOM(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgInput, art
 			depGlobalID := fromGlobalID(isDependencyID)
 			isDepIncludesID, err := uuid.Parse(depGlobalID.id)
 			if err != nil {
-				return nil, fmt.Errorf("uuid conversion from isDependencyID failed with error: %w", err)
+				return nil, nil, fmt.Errorf("uuid conversion from isDependencyID failed with error: %w", err)
 			}
-			sortedIsDepUUIDs = append(sortedIsDepUUIDs, isDepIncludesID)
+			included.dependencies = append(included.dependencies, isDepIncludesID)
 		}
 
 		sortedDepHash = hashListOfSortedKeys(sortedDependencyIDs)
@@ -266,9 +398,9 @@ func upsertHasSBOM(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgInput, art
 			occurGlobalID := fromGlobalID(isOccurrenceID)
 			isOccurIncludesID, err := uuid.Parse(occurGlobalID.id)
 			if err != nil {
-				return nil, fmt.Errorf("uuid conversion from isOccurrenceID failed with error: %w", err)
+				return nil, nil, fmt.Errorf("uuid conversion from isOccurrenceID failed with error: %w", err)
 			}
-			sortedIsOccurrenceUUIDs = append(sortedIsOccurrenceUUIDs, isOccurIncludesID)
+			included.occurrences = append(included.occurrences, isOccurIncludesID)
 		}
 
 		sortedOccurHash = hashListOfSortedKeys(sortedOccurrenceIDs)
@@ -278,8 +410,6 @@ func upsertHasSBOM(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgInput, art
 		sbomCreate.SetIncludedOccurrencesHash(sortedOccurHash)
 	}
 
-	var createdHasSBOMID uuid.UUID
-
 	if pkg != nil {
 		var pkgVersionID uuid.UUID
 		if pkg.PackageVersionID != nil {
@@ -287,20 +417,20 @@ func upsertHasSBOM(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgInput, art
 			pkgVersionGlobalID := fromGlobalID(*pkg.PackageVersionID)
 			pkgVersionID, err = uuid.Parse(pkgVersionGlobalID.id)
 			if err != nil {
-				return nil, fmt.Errorf("uuid conversion from packageVersionID failed with error: %w", err)
+				return nil, nil, fmt.Errorf("uuid conversion from packageVersionID failed with error: %w", err)
 			}
 		} else {
 			pv, err := getPkgVersion(ctx, tx.Client(), *pkg.PackageInput)
 			if err != nil {
-				return nil, fmt.Errorf("getPkgVersion :: %w", err)
+				return nil, nil, fmt.Errorf("getPkgVersion :: %w", err)
 			}
 			pkgVersionID = pv.ID
 		}
 		hasSBOMID, err := guacHasSBOMKey(ptrfrom.String(pkgVersionID.String()), nil, sortedPkgHash, sortedArtHash, sortedDepHash, sortedOccurHash, hasSBOM)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create hasSBOM uuid with error: %w", err)
+			return nil, nil, fmt.Errorf("failed to create hasSBOM uuid with error: %w", err)
 		}
-		createdHasSBOMID = *hasSBOMID
+		included.hasSBOMID = *hasSBOMID
 		sbomCreate.SetID(*hasSBOMID)
 		sbomCreate.SetPackageID(pkgVersionID)
 	} else if art != nil {
@@ -310,57 +440,46 @@ func upsertHasSBOM(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgInput, art
 			artGlobalID := fromGlobalID(*art.ArtifactID)
 			artID, err = uuid.Parse(artGlobalID.id)
 			if err != nil {
-				return nil, fmt.Errorf("uuid conversion from ArtifactID failed with error: %w", err)
+				return nil, nil, fmt.Errorf("uuid conversion from ArtifactID failed with error: %w", err)
 			}
 		} else {
 			foundArt, err := tx.Artifact.Query().Where(artifactQueryInputPredicates(*art.ArtifactInput)).Only(ctx)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			artID = foundArt.ID
 		}
 		hasSBOMID, err := guacHasSBOMKey(nil, ptrfrom.String(artID.String()), sortedPkgHash, sortedArtHash, sortedDepHash, sortedOccurHash, hasSBOM)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create hasSBOM uuid with error: %w", err)
+			return nil, nil, fmt.Errorf("failed to create hasSBOM uuid with error: %w", err)
 		}
-		createdHasSBOMID = *hasSBOMID
+		included.hasSBOMID = *hasSBOMID
 		sbomCreate.SetID(*hasSBOMID)
 		sbomCreate.SetArtifactID(artID)
 	} else {
-		return nil, Errorf("%v :: %s", "generateSBOMCreate", "subject must be either a package or artifact")
+		return nil, nil, Errorf("%v :: %s", "generateHasSBOMCreate", "subject must be either a package or artifact")
 	}
 
-	_, err := sbomCreate.
-		OnConflict(
-			sql.ConflictColumns(conflictColumns...),
-			sql.ConflictWhere(conflictWhere),
-		).
-		DoNothing().
-		ID(ctx)
-	if err != nil {
-		// err "no rows in select set" appear when ingesting and the node already exists. This is non-error produced by "DoNothing"
-		if err != stdsql.ErrNoRows {
-			return nil, errors.Wrap(err, "upsert hasSBOM node")
-		}
-	}
+	return sbomCreate, included, nil
+}
 
-	if err := updateHasSBOMWithIncludePackageIDs(ctx, tx.Client(), createdHasSBOMID, sortedPkgUUIDs); err != nil {
-		return nil, errors.Wrap(err, "updateHasSBOMWithIncludePackageIDs")
+func updateHasSBOMIncludes(ctx context.Context, client *ent.Client, included *hasSBOMIncludes) error {
+	if err := updateHasSBOMWithIncludePackageIDs(ctx, client, included.hasSBOMID, included.packages); err != nil {
+		return errors.Wrap(err, "updateHasSBOMWithIncludePackageIDs")
 	}
 
-	if err := updateHasSBOMWithIncludeArtifacts(ctx, tx.Client(), createdHasSBOMID, sortedArtUUIDs); err != nil {
-		return nil, errors.Wrap(err, "updateHasSBOMWithIncludeArtifacts")
+	if err := updateHasSBOMWithIncludeArtifacts(ctx, client, included.hasSBOMID, included.artifacts); err != nil {
+		return errors.Wrap(err, "updateHasSBOMWithIncludeArtifacts")
 	}
 
-	if err := updateHasSBOMWithIncludeDependencies(ctx, tx.Client(), createdHasSBOMID, sortedIsDepUUIDs); err != nil {
-		return nil, errors.Wrap(err, "updateHasSBOMWithIncludeDependencies")
+	if err := updateHasSBOMWithIncludeDependencies(ctx, client, included.hasSBOMID, included.dependencies); err != nil {
+		return errors.Wrap(err, "updateHasSBOMWithIncludeDependencies")
 	}
 
-	if err := updateHasSBOMWithIncludeOccurrences(ctx, tx.Client(), createdHasSBOMID, sortedIsOccurrenceUUIDs); err != nil {
-		return nil, errors.Wrap(err, "updateHasSBOMWithIncludeOccurrences")
+	if err := updateHasSBOMWithIncludeOccurrences(ctx, client, included.hasSBOMID, included.occurrences); err != nil {
+		return errors.Wrap(err, "updateHasSBOMWithIncludeOccurrences")
 	}
-
-	return ptrfrom.String(createdHasSBOMID.String()), nil
+	return nil
 }
 
 func updateHasSBOMWithIncludePackageIDs(ctx context.Context, client *ent.Client, hasSBOMID uuid.UUID, sortedPkgUUIDs []uuid.UUID) error {
@@ -440,7 +559,7 @@ func guacHasSBOMKey(pkgVersionID *string, artID *string, includedPkgHash, includ
 	} else if artID != nil {
 		subjectID = *artID
 	} else {
-		return nil, gqlerror.Errorf("%v :: %s", "guacHasSBOMKey", "subject must be either a package or artifact")
+		return nil, Errorf("%v :: %s", "guacHasSBOMKey", "subject must be either a package or artifact")
 	}
 	hsIDString := fmt.Sprintf("%s::%s::%s::%s::%s::%s?", subjectID, includedPkgHash, includedArtHash, includedDepHash, includedOccurHash, canonicalHasSBOMString(hasSBOM))
 
@@ -520,3 +639,31 @@ func (b *EntBackend) hasSbomNeighbors(ctx context.Context, nodeID string, allowe
 
 	return out, nil
 }
+
+func (b *EntBackend) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, pagination *model.PaginationSpec) (*model.HasSBOMConnection, error) {
+	funcName := "HasSBOMList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := getSBOMObject(b.client.BillOfMaterials.Query().
+		Where(hasSBOMQuery(hasSBOMSpec))).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.HasSBOMEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.HasSBOMEdge{
+			Cursor: toGlobalID(billofmaterials.Table, edge.Node.ID.String()),
+			Node:   toModelHasSBOM(edge.Node),
+		})
+	}
+	return &model.HasSBOMConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, billofmaterials.Table),
+		Edges:      edges,
+	}, nil
+}
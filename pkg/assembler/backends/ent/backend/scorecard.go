@@ -31,7 +31,6 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func (b *EntBackend) Scorecards(ctx context.Context, filter *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
@@ -120,13 +119,13 @@ func (b *EntBackend) IngestScorecards(ctx context.Context, sources []*model.IDor
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(certifyscorecard.Table, *ids), nil
 }
 
-func generateScorecardCreate(ctx context.Context, tx *ent.Tx, src *model.IDorSourceInput, scorecard *model.ScorecardInputSpec) (*ent.CertifyScorecardCreate, error) {
+func generateScorecardCreate(ctx context.Context, tx *ent.Tx, src *model.IDorSourceInput, scorecard *model.ScorecardInputSpec) (*ent.CertifyScorecardCreate, *uuid.UUID, error) {
 
 	checks := make([]*model.ScorecardCheck, len(scorecard.Checks))
 	for i, check := range scorecard.Checks {
@@ -144,22 +143,26 @@ func generateScorecardCreate(ctx context.Context, tx *ent.Tx, src *model.IDorSou
 		srcNameGlobalID := fromGlobalID(*src.SourceNameID)
 		sourceID, err = uuid.Parse(srcNameGlobalID.id)
 		if err != nil {
-			return nil, fmt.Errorf("uuid conversion from SourceNameID failed with error: %w", err)
+			return nil, nil, fmt.Errorf("uuid conversion from SourceNameID failed with error: %w", err)
 		}
 	} else {
 		srcID, err := getSourceNameID(ctx, tx.Client(), *src.SourceInput)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		sourceID = srcID
 	}
 
+	checksHash := hashSortedScorecardChecks(checks)
+	scorecardID := guacScorecardKey(sourceID.String(), checksHash, scorecard)
+
 	scorecardCreate := tx.CertifyScorecard.Create()
 
 	scorecardCreate.
+		SetID(*scorecardID).
 		SetSourceID(sourceID).
 		SetChecks(checks).
-		SetChecksHash(hashSortedScorecardChecks(checks)).
+		SetChecksHash(checksHash).
 		SetAggregateScore(scorecard.AggregateScore).
 		SetTimeScanned(scorecard.TimeScanned.UTC()).
 		SetScorecardVersion(scorecard.ScorecardVersion).
@@ -168,7 +171,21 @@ func generateScorecardCreate(ctx context.Context, tx *ent.Tx, src *model.IDorSou
 		SetCollector(scorecard.Collector).
 		SetDocumentRef(scorecard.DocumentRef)
 
-	return scorecardCreate, nil
+	return scorecardCreate, scorecardID, nil
+}
+
+func canonicalScorecardString(sc *model.ScorecardInputSpec) string {
+	return fmt.Sprintf("%s::%s::%s::%s::%s::%s::%s", strconv.FormatFloat(sc.AggregateScore, 'g', -1, 64), sc.TimeScanned.UTC(), sc.ScorecardVersion, sc.ScorecardCommit, sc.Origin, sc.Collector, sc.DocumentRef)
+}
+
+// guacScorecardKey generates an uuid based on the hash of the inputspec and inputs, covering the same fields as the
+// unique index. The scorecard ID has to be set for bulk ingestion so that the IDs of the scorecards can be returned
+// without querying them back, including the ones that were already ingested.
+func guacScorecardKey(srcNameID string, checksHash string, sc *model.ScorecardInputSpec) *uuid.UUID {
+	scIDString := fmt.Sprintf("%s::%s::%s?", srcNameID, checksHash, canonicalScorecardString(sc))
+
+	scID := generateUUIDKey([]byte(scIDString))
+	return &scID
 }
 
 func scorecardConflictColumns() []string {
@@ -196,10 +213,12 @@ func upsertBulkScorecard(ctx context.Context, tx *ent.Tx, sources []*model.IDorS
 		for i, cs := range css {
 			cs := cs
 			var err error
-			creates[i], err = generateScorecardCreate(ctx, tx, sources[index], cs)
+			var scorecardID *uuid.UUID
+			creates[i], scorecardID, err = generateScorecardCreate(ctx, tx, sources[index], cs)
 			if err != nil {
-				return nil, gqlerror.Errorf("generateScorecardCreate :: %s", err)
+				return nil, Errorf("generateScorecardCreate :: %s", err)
 			}
+			ids = append(ids, scorecardID.String())
 			index++
 		}
 
@@ -219,9 +238,9 @@ func upsertBulkScorecard(ctx context.Context, tx *ent.Tx, sources []*model.IDorS
 
 func upsertScorecard(ctx context.Context, tx *ent.Tx, source model.IDorSourceInput, scorecardInput model.ScorecardInputSpec) (*string, error) {
 
-	scorecardCreate, err := generateScorecardCreate(ctx, tx, &source, &scorecardInput)
+	scorecardCreate, _, err := generateScorecardCreate(ctx, tx, &source, &scorecardInput)
 	if err != nil {
-		return nil, gqlerror.Errorf("generateScorecardCreate :: %s", err)
+		return nil, Errorf("generateScorecardCreate :: %s", err)
 	}
 	if id, err := scorecardCreate.
 		OnConflict(
@@ -236,6 +255,77 @@ func upsertScorecard(ctx context.Context, tx *ent.Tx, source model.IDorSourceInp
 	}
 }
 
+// UpdateCertifyScorecard replaces the results of an existing scorecard with the
+// ones of a new scan. The ID of the node is kept, so it no longer matches the
+// key computed for the same results by a bulk ingestion.
+func (b *EntBackend) UpdateCertifyScorecard(ctx context.Context, id string, scorecard model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
+	funcName := "UpdateCertifyScorecard"
+	foundGlobalID := fromGlobalID(id)
+	if foundGlobalID.nodeType != "" && foundGlobalID.nodeType != certifyscorecard.Table {
+		return nil, Errorf("%v :: id %s is not a certifyScorecard", funcName, id)
+	}
+	scorecardID, err := uuid.Parse(foundGlobalID.id)
+	if err != nil {
+		return nil, Errorf("%v :: uuid conversion from id %s failed with error: %v", funcName, id, err)
+	}
+
+	checks := make([]*model.ScorecardCheck, len(scorecard.Checks))
+	for i, check := range scorecard.Checks {
+		checks[i] = &model.ScorecardCheck{
+			Check: check.Check,
+			Score: check.Score,
+		}
+	}
+	sort.Slice(checks, func(i, j int) bool { return checks[i].Check < checks[j].Check })
+
+	_, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*uuid.UUID, error) {
+		tx := ent.TxFromContext(ctx)
+		record, err := tx.CertifyScorecard.Query().
+			Where(certifyscorecard.ID(scorecardID)).
+			WithSource().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("certifyScorecard with id %s not found", id)
+			}
+			return nil, err
+		}
+		if record.Edges.Source == nil {
+			return nil, fmt.Errorf("source of certifyScorecard with id %s no longer exists", id)
+		}
+
+		err = tx.CertifyScorecard.UpdateOneID(scorecardID).
+			SetChecks(checks).
+			SetChecksHash(hashSortedScorecardChecks(checks)).
+			SetAggregateScore(scorecard.AggregateScore).
+			SetTimeScanned(scorecard.TimeScanned.UTC()).
+			SetScorecardVersion(scorecard.ScorecardVersion).
+			SetScorecardCommit(scorecard.ScorecardCommit).
+			SetOrigin(scorecard.Origin).
+			SetCollector(scorecard.Collector).
+			SetDocumentRef(scorecard.DocumentRef).
+			Exec(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return nil, fmt.Errorf("update conflicts with an existing certifyScorecard: %w", err)
+			}
+			return nil, errors.Wrap(err, "update certifyScorecard node")
+		}
+		return &scorecardID, nil
+	})
+	if txErr != nil {
+		return nil, Errorf("%v :: %s", funcName, txErr)
+	}
+
+	record, err := getScorecardObject(b.client.CertifyScorecard.Query().
+		Where(certifyscorecard.ID(scorecardID))).
+		Only(ctx)
+	if err != nil {
+		return nil, Errorf("%v :: %s", funcName, err)
+	}
+	return toModelCertifyScorecard(record), nil
+}
+
 func hashSortedScorecardChecks(checks []*model.ScorecardCheck) string {
 	hash := sha1.New()
 
@@ -298,3 +388,31 @@ func (b *EntBackend) certifyScorecardNeighbors(ctx context.Context, nodeID strin
 
 	return out, nil
 }
+
+func (b *EntBackend) ScorecardsList(ctx context.Context, certifyScorecardSpec model.CertifyScorecardSpec, pagination *model.PaginationSpec) (*model.CertifyScorecardConnection, error) {
+	funcName := "ScorecardsList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := getScorecardObject(b.client.CertifyScorecard.Query().
+		Where(certifyScorecardQuery(&certifyScorecardSpec))).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.CertifyScorecardEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.CertifyScorecardEdge{
+			Cursor: toGlobalID(certifyscorecard.Table, edge.Node.ID.String()),
+			Node:   toModelCertifyScorecard(edge.Node),
+		})
+	}
+	return &model.CertifyScorecardConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, certifyscorecard.Table),
+		Edges:      edges,
+	}, nil
+}
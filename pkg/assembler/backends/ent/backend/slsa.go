@@ -32,7 +32,6 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func (b *EntBackend) HasSlsa(ctx context.Context, spec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
@@ -109,7 +108,7 @@ func (b *EntBackend) IngestSLSAs(ctx context.Context, subjects []*model.IDorArti
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(slsaattestation.Table, *ids), nil
@@ -141,10 +140,12 @@ func upsertBulkSLSA(ctx context.Context, tx *ent.Tx, subjects []*model.IDorArtif
 		for i, slsa := range css {
 			slsa := slsa
 			var err error
-			creates[i], err = generateSLSACreate(ctx, tx, subjects[index], builtFromList[index], builtByList[index], slsa)
+			var slsaID *uuid.UUID
+			creates[i], slsaID, err = generateSLSACreate(ctx, tx, subjects[index], builtFromList[index], builtByList[index], slsa)
 			if err != nil {
-				return nil, gqlerror.Errorf("generateSLSACreate :: %s", err)
+				return nil, Errorf("generateSLSACreate :: %s", err)
 			}
+			ids = append(ids, slsaID.String())
 			index++
 		}
 
@@ -170,7 +171,7 @@ func setDefaultTime(inputTime *time.Time) time.Time {
 	}
 }
 
-func generateSLSACreate(ctx context.Context, tx *ent.Tx, subject *model.IDorArtifactInput, builtFrom []*model.IDorArtifactInput, builtBy *model.IDorBuilderInput, slsa *model.SLSAInputSpec) (*ent.SLSAAttestationCreate, error) {
+func generateSLSACreate(ctx context.Context, tx *ent.Tx, subject *model.IDorArtifactInput, builtFrom []*model.IDorArtifactInput, builtBy *model.IDorBuilderInput, slsa *model.SLSAInputSpec) (*ent.SLSAAttestationCreate, *uuid.UUID, error) {
 	slsaCreate := tx.SLSAAttestation.Create()
 
 	slsaCreate.
@@ -184,7 +185,7 @@ func generateSLSACreate(ctx context.Context, tx *ent.Tx, subject *model.IDorArti
 		SetFinishedOn(setDefaultTime(slsa.FinishedOn))
 
 	if builtBy == nil {
-		return nil, fmt.Errorf("builtBy not specified for SLSA")
+		return nil, nil, fmt.Errorf("builtBy not specified for SLSA")
 	}
 	var buildID uuid.UUID
 	if builtBy.BuilderID != nil {
@@ -192,12 +193,12 @@ func generateSLSACreate(ctx context.Context, tx *ent.Tx, subject *model.IDorArti
 		builtGlobalID := fromGlobalID(*builtBy.BuilderID)
 		buildID, err = uuid.Parse(builtGlobalID.id)
 		if err != nil {
-			return nil, fmt.Errorf("uuid conversion from BuilderID failed with error: %w", err)
+			return nil, nil, fmt.Errorf("uuid conversion from BuilderID failed with error: %w", err)
 		}
 	} else {
 		builder, err := tx.Builder.Query().Where(builderInputQueryPredicate(*builtBy.BuilderInput)).Only(ctx)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		buildID = builder.ID
 	}
@@ -209,12 +210,12 @@ func generateSLSACreate(ctx context.Context, tx *ent.Tx, subject *model.IDorArti
 		artGlobalID := fromGlobalID(*subject.ArtifactID)
 		subjectArtifactID, err = uuid.Parse(artGlobalID.id)
 		if err != nil {
-			return nil, fmt.Errorf("uuid conversion from ArtifactID failed with error: %w", err)
+			return nil, nil, fmt.Errorf("uuid conversion from ArtifactID failed with error: %w", err)
 		}
 	} else {
 		foundArt, err := tx.Artifact.Query().Where(artifactQueryInputPredicates(*subject.ArtifactInput)).Only(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to query for artifact")
+			return nil, nil, fmt.Errorf("failed to query for artifact")
 		}
 		subjectArtifactID = foundArt.ID
 	}
@@ -231,7 +232,7 @@ func generateSLSACreate(ctx context.Context, tx *ent.Tx, subject *model.IDorArti
 			} else {
 				foundArt, err := tx.Artifact.Query().Where(artifactQueryInputPredicates(*bf.ArtifactInput)).Only(ctx)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				builtFromIDs = append(builtFromIDs, foundArt.ID.String())
 			}
@@ -242,7 +243,7 @@ func generateSLSACreate(ctx context.Context, tx *ent.Tx, subject *model.IDorArti
 		for _, sbfID := range sortedBuildFromIDs {
 			sbfUUID, err := uuid.Parse(sbfID)
 			if err != nil {
-				return nil, fmt.Errorf("uuid conversion from ArtifactID failed with error: %w", err)
+				return nil, nil, fmt.Errorf("uuid conversion from ArtifactID failed with error: %w", err)
 			}
 			slsaCreate.AddBuiltFromIDs(sbfUUID)
 		}
@@ -256,19 +257,19 @@ func generateSLSACreate(ctx context.Context, tx *ent.Tx, subject *model.IDorArti
 
 	slsaID, err := guacSLSAKey(ptrfrom.String(subjectArtifactID.String()), builtFromHash, ptrfrom.String(buildID.String()), slsa)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create slsa uuid with error: %w", err)
+		return nil, nil, fmt.Errorf("failed to create slsa uuid with error: %w", err)
 	}
 
 	slsaCreate.SetID(*slsaID)
 
-	return slsaCreate, nil
+	return slsaCreate, slsaID, nil
 }
 
 func upsertSLSA(ctx context.Context, tx *ent.Tx, subject model.IDorArtifactInput, builtFrom []*model.IDorArtifactInput, builtBy model.IDorBuilderInput, slsa model.SLSAInputSpec) (*string, error) {
 
-	slsaCreate, err := generateSLSACreate(ctx, tx, &subject, builtFrom, &builtBy, &slsa)
+	slsaCreate, _, err := generateSLSACreate(ctx, tx, &subject, builtFrom, &builtBy, &slsa)
 	if err != nil {
-		return nil, gqlerror.Errorf("generateSLSACreate :: %s", err)
+		return nil, Errorf("generateSLSACreate :: %s", err)
 	}
 
 	if id, err := slsaCreate.
@@ -436,3 +437,31 @@ func (b *EntBackend) hasSlsaNeighbors(ctx context.Context, nodeID string, allowe
 	}
 	return out, nil
 }
+
+func (b *EntBackend) HasSLSAList(ctx context.Context, hasSLSASpec model.HasSLSASpec, pagination *model.PaginationSpec) (*model.HasSLSAConnection, error) {
+	funcName := "HasSLSAList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := getSLSAObject(b.client.SLSAAttestation.Query().
+		Where(hasSLSAQuery(hasSLSASpec))).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.HasSLSAEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.HasSLSAEdge{
+			Cursor: toGlobalID(slsaattestation.Table, edge.Node.ID.String()),
+			Node:   toModelHasSLSA(edge.Node),
+		})
+	}
+	return &model.HasSLSAConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, slsaattestation.Table),
+		Edges:      edges,
+	}, nil
+}
@@ -25,13 +25,18 @@ import (
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/occurrence"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/pointofcontact"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/sourcename"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 const (
@@ -45,7 +50,8 @@ func (b *EntBackend) HasSourceAt(ctx context.Context, filter *model.HasSourceAtS
 	}
 
 	hasSourceAtQuery := b.client.HasSourceAt.Query().
-		Where(hasSourceAtQuery(*filter))
+		Where(hasSourceAtQuery(*filter)).
+		Order(hasSourceAtOrder(filter.Order)...)
 
 	records, err := getHasSourceAtObject(hasSourceAtQuery).
 		Limit(MaxPageSize).
@@ -57,6 +63,20 @@ func (b *EntBackend) HasSourceAt(ctx context.Context, filter *model.HasSourceAtS
 	return collect(records, toModelHasSourceAt), nil
 }
 
+func hasSourceAtOrder(order *model.HasSourceAtOrder) []hassourceat.OrderOption {
+	if order == nil {
+		return nil
+	}
+	term := orderTerm(order.SortOrder)
+	switch order.SortBy {
+	case model.HasSourceAtOrderFieldKnownSince:
+		return []hassourceat.OrderOption{hassourceat.ByKnownSince(term)}
+	case model.HasSourceAtOrderFieldJustification:
+		return []hassourceat.OrderOption{hassourceat.ByJustification(term)}
+	}
+	return nil
+}
+
 func hasSourceAtQuery(filter model.HasSourceAtSpec) predicate.HasSourceAt {
 	predicates := []predicate.HasSourceAt{
 		optionalPredicate(filter.ID, IDEQ),
@@ -112,7 +132,7 @@ func (b *EntBackend) IngestHasSourceAts(ctx context.Context, pkgs []*model.IDorP
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(hassourceat.Table, *ids), nil
@@ -154,7 +174,7 @@ func upsertBulkHasSourceAts(ctx context.Context, tx *ent.Tx, pkgs []*model.IDorP
 
 			creates[i], err = generateHasSourceAtCreate(ctx, tx, pkgs[index], sources[index], *pkgMatchType, hsa)
 			if err != nil {
-				return nil, gqlerror.Errorf("generateHasSourceAtCreate :: %s", err)
+				return nil, Errorf("generateHasSourceAtCreate :: %s", err)
 			}
 			index++
 		}
@@ -263,7 +283,7 @@ func upsertHasSourceAt(ctx context.Context, tx *ent.Tx, pkg model.IDorPkgInput,
 
 	insert, err := generateHasSourceAtCreate(ctx, tx, &pkg, &source, pkgMatchType, &spec)
 	if err != nil {
-		return nil, gqlerror.Errorf("generateHasSourceAtCreate :: %s", err)
+		return nil, Errorf("generateHasSourceAtCreate :: %s", err)
 	}
 	id, err := insert.OnConflict(
 		sql.ConflictColumns(conflictColumns...),
@@ -278,6 +298,66 @@ func upsertHasSourceAt(ctx context.Context, tx *ent.Tx, pkg model.IDorPkgInput,
 	return ptrfrom.String(id.String()), nil
 }
 
+func (b *EntBackend) UpdateHasSourceAt(ctx context.Context, id string, hasSourceAt model.HasSourceAtInputSpec) (*model.HasSourceAt, error) {
+	funcName := "UpdateHasSourceAt"
+	foundGlobalID := fromGlobalID(id)
+	if foundGlobalID.nodeType != "" && foundGlobalID.nodeType != hassourceat.Table {
+		return nil, Errorf("%v :: id %s is not a hasSourceAt", funcName, id)
+	}
+	hasSourceAtID, err := uuid.Parse(foundGlobalID.id)
+	if err != nil {
+		return nil, Errorf("%v :: uuid conversion from id %s failed with error: %v", funcName, id, err)
+	}
+
+	_, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*uuid.UUID, error) {
+		tx := ent.TxFromContext(ctx)
+		record, err := tx.HasSourceAt.Query().
+			Where(hassourceat.ID(hasSourceAtID)).
+			WithPackageVersion().
+			WithAllVersions().
+			WithSource().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("hasSourceAt with id %s not found", id)
+			}
+			return nil, err
+		}
+		if record.Edges.Source == nil {
+			return nil, fmt.Errorf("source of hasSourceAt with id %s no longer exists", id)
+		}
+		if record.Edges.PackageVersion == nil && record.Edges.AllVersions == nil {
+			return nil, fmt.Errorf("package of hasSourceAt with id %s no longer exists", id)
+		}
+
+		err = tx.HasSourceAt.UpdateOneID(hasSourceAtID).
+			SetCollector(hasSourceAt.Collector).
+			SetOrigin(hasSourceAt.Origin).
+			SetDocumentRef(hasSourceAt.DocumentRef).
+			SetJustification(hasSourceAt.Justification).
+			SetKnownSince(hasSourceAt.KnownSince.UTC()).
+			Exec(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return nil, fmt.Errorf("update conflicts with an existing hasSourceAt: %w", err)
+			}
+			return nil, errors.Wrap(err, "update hasSourceAt node")
+		}
+		return &hasSourceAtID, nil
+	})
+	if txErr != nil {
+		return nil, Errorf("%v :: %s", funcName, txErr)
+	}
+
+	record, err := getHasSourceAtObject(b.client.HasSourceAt.Query().
+		Where(hassourceat.ID(hasSourceAtID))).
+		Only(ctx)
+	if err != nil {
+		return nil, Errorf("%v :: %s", funcName, err)
+	}
+	return toModelHasSourceAt(record), nil
+}
+
 func (b *EntBackend) hasSourceAtNeighbors(ctx context.Context, nodeID string, allowedEdges edgeMap) ([]model.Node, error) {
 	var out []model.Node
 
@@ -323,6 +403,7 @@ func (b *EntBackend) Sources(ctx context.Context, filter *model.SourceSpec) ([]*
 	}
 	records, err := b.client.SourceName.Query().
 		Where(sourceQuery(filter)).
+		Order(sourceNameOrder(filter.Order)...).
 		Limit(MaxPageSize).
 		All(ctx)
 	if err != nil {
@@ -332,6 +413,22 @@ func (b *EntBackend) Sources(ctx context.Context, filter *model.SourceSpec) ([]*
 	return collect(records, toModelSourceName), nil
 }
 
+func sourceNameOrder(order *model.SourceOrder) []sourcename.OrderOption {
+	if order == nil {
+		return nil
+	}
+	term := orderTerm(order.SortOrder)
+	switch order.SortBy {
+	case model.SourceOrderFieldType:
+		return []sourcename.OrderOption{sourcename.ByType(term)}
+	case model.SourceOrderFieldNamespace:
+		return []sourcename.OrderOption{sourcename.ByNamespace(term)}
+	case model.SourceOrderFieldName:
+		return []sourcename.OrderOption{sourcename.ByName(term)}
+	}
+	return nil
+}
+
 func (b *EntBackend) IngestSources(ctx context.Context, sources []*model.IDorSourceInput) ([]*model.SourceIDs, error) {
 	funcName := "IngestSources"
 	var collectedSrcIDs []*model.SourceIDs
@@ -344,7 +441,7 @@ func (b *EntBackend) IngestSources(ctx context.Context, sources []*model.IDorSou
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	for _, srcIDs := range *ids {
@@ -366,6 +463,61 @@ func (b *EntBackend) IngestSource(ctx context.Context, source model.IDorSourceIn
 	return sourceNameID, nil
 }
 
+func (b *EntBackend) DeleteSource(ctx context.Context, id string) error {
+	funcName := "DeleteSource"
+	foundGlobalID := fromGlobalID(id)
+	if foundGlobalID.nodeType != "" && foundGlobalID.nodeType != sourcename.Table {
+		return Errorf("%v :: id %s is not a source name", funcName, id)
+	}
+	srcNameID, err := uuid.Parse(foundGlobalID.id)
+	if err != nil {
+		return Errorf("%v :: uuid conversion from id %s failed with error: %v", funcName, id, err)
+	}
+
+	_, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*string, error) {
+		tx := ent.TxFromContext(ctx)
+		exists, err := tx.SourceName.Query().Where(sourcename.ID(srcNameID)).Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, fmt.Errorf("source name with id %s not found", id)
+		}
+
+		// the evidence nodes require their source, so they go first
+		hasSource := sourcename.ID(srcNameID)
+		if _, err := tx.Occurrence.Delete().Where(occurrence.HasSourceWith(hasSource)).Exec(ctx); err != nil {
+			return nil, errors.Wrap(err, "delete occurrences")
+		}
+		if _, err := tx.HasSourceAt.Delete().Where(hassourceat.HasSourceWith(hasSource)).Exec(ctx); err != nil {
+			return nil, errors.Wrap(err, "delete hasSourceAts")
+		}
+		if _, err := tx.CertifyScorecard.Delete().Where(certifyscorecard.HasSourceWith(hasSource)).Exec(ctx); err != nil {
+			return nil, errors.Wrap(err, "delete scorecards")
+		}
+		if _, err := tx.Certification.Delete().Where(certification.HasSourceWith(hasSource)).Exec(ctx); err != nil {
+			return nil, errors.Wrap(err, "delete certifications")
+		}
+		if _, err := tx.HasMetadata.Delete().Where(hasmetadata.HasSourceWith(hasSource)).Exec(ctx); err != nil {
+			return nil, errors.Wrap(err, "delete hasMetadata")
+		}
+		if _, err := tx.PointOfContact.Delete().Where(pointofcontact.HasSourceWith(hasSource)).Exec(ctx); err != nil {
+			return nil, errors.Wrap(err, "delete pointOfContacts")
+		}
+		if _, err := tx.CertifyLegal.Delete().Where(certifylegal.HasSourceWith(hasSource)).Exec(ctx); err != nil {
+			return nil, errors.Wrap(err, "delete certifyLegals")
+		}
+		if err := tx.SourceName.DeleteOneID(srcNameID).Exec(ctx); err != nil {
+			return nil, errors.Wrap(err, "delete source name")
+		}
+		return &id, nil
+	})
+	if txErr != nil {
+		return Errorf("%v :: %s", funcName, txErr)
+	}
+	return nil
+}
+
 func upsertBulkSource(ctx context.Context, tx *ent.Tx, srcInputs []*model.IDorSourceInput) (*[]model.SourceIDs, error) {
 	batches := chunk(srcInputs, MaxBatchSize)
 	srcNameIDs := make([]string, 0)
@@ -468,6 +620,10 @@ func sourceQuery(filter *model.SourceSpec) predicate.SourceName {
 		optionalPredicate(filter.Type, sourcename.TypeEQ),
 		optionalPredicate(filter.Namespace, sourcename.NamespaceEQ),
 		optionalPredicate(filter.Name, sourcename.NameEQ),
+		optionalPredicate(filter.NamePrefix, sourcename.NameHasPrefix),
+		optionalPredicate(filter.NameGlob, sourceNameGlob),
+		optionalPredicate(filter.NameContains, sourcename.NameContains),
+		optionalPredicate(filter.NameSuffix, sourcename.NameHasSuffix),
 		optionalPredicate(filter.Commit, sourcename.CommitEqualFold),
 		optionalPredicate(filter.Tag, sourcename.TagEQ),
 	}
@@ -475,6 +631,13 @@ func sourceQuery(filter *model.SourceSpec) predicate.SourceName {
 	return sourcename.And(query...)
 }
 
+// sourceNameGlob matches source names against a glob pattern with SQL LIKE
+func sourceNameGlob(glob string) predicate.SourceName {
+	return predicate.SourceName(func(s *sql.Selector) {
+		s.Where(sql.Like(s.C(sourcename.FieldName), helper.GlobToLike(glob)))
+	})
+}
+
 func toModelHasSourceAt(record *ent.HasSourceAt) *model.HasSourceAt {
 	var pkg *model.Package
 	if record.Edges.PackageVersion != nil {
@@ -715,3 +878,79 @@ func (b *EntBackend) srcNameNeighbors(ctx context.Context, nodeID string, allowe
 
 	return out, nil
 }
+
+func (b *EntBackend) SourcesCount(ctx context.Context, sourceSpec model.SourceSpec) (int, error) {
+	count, err := b.client.SourceName.Query().
+		Where(sourceQuery(&sourceSpec)).
+		Count(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "SourcesCount")
+	}
+	return count, nil
+}
+
+func (b *EntBackend) SourcesList(ctx context.Context, sourceSpec model.SourceSpec, pagination *model.PaginationSpec) (*model.SourceConnection, error) {
+	funcName := "SourcesList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := b.client.SourceName.Query().
+		Where(sourceQuery(&sourceSpec)).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.SourceEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.SourceEdge{
+			Cursor: toGlobalID(sourcename.Table, edge.Node.ID.String()),
+			Node:   toModelSourceName(edge.Node),
+		})
+	}
+	return &model.SourceConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, sourcename.Table),
+		Edges:      edges,
+	}, nil
+}
+
+func (b *EntBackend) HasSourceAtCount(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec) (int, error) {
+	count, err := b.client.HasSourceAt.Query().
+		Where(hasSourceAtQuery(hasSourceAtSpec)).
+		Count(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "HasSourceAtCount")
+	}
+	return count, nil
+}
+
+func (b *EntBackend) HasSourceAtList(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec, pagination *model.PaginationSpec) (*model.HasSourceAtConnection, error) {
+	funcName := "HasSourceAtList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := getHasSourceAtObject(b.client.HasSourceAt.Query().
+		Where(hasSourceAtQuery(hasSourceAtSpec))).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.HasSourceAtEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.HasSourceAtEdge{
+			Cursor: toGlobalID(hassourceat.Table, edge.Node.ID.String()),
+			Node:   toModelHasSourceAt(edge.Node),
+		})
+	}
+	return &model.HasSourceAtConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, hassourceat.Table),
+		Edges:      edges,
+	}, nil
+}
@@ -31,7 +31,6 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func (b *EntBackend) VulnEqual(ctx context.Context, filter *model.VulnEqualSpec) ([]*model.VulnEqual, error) {
@@ -108,7 +107,7 @@ func (b *EntBackend) IngestVulnEquals(ctx context.Context, vulnerabilities []*mo
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(vulnequal.Table, *ids), nil
@@ -153,7 +152,7 @@ func upsertBulkVulnEquals(ctx context.Context, tx *ent.Tx, vulnerabilities []*mo
 
 			creates[i], err = generateVulnEqualCreate(ctx, tx, vulnerabilities[index], otherVulnerabilities[index], ve)
 			if err != nil {
-				return nil, gqlerror.Errorf("generateVulnEqualCreate :: %s", err)
+				return nil, Errorf("generateVulnEqualCreate :: %s", err)
 			}
 			index++
 		}
@@ -249,7 +248,7 @@ func upsertVulnEquals(ctx context.Context, tx *ent.Tx, vulnerability model.IDorV
 
 	vulnEqualCreate, err := generateVulnEqualCreate(ctx, tx, &vulnerability, &otherVulnerability, &vulnEqualInput)
 	if err != nil {
-		return nil, gqlerror.Errorf("generatePkgEqualCreate :: %s", err)
+		return nil, Errorf("generatePkgEqualCreate :: %s", err)
 	}
 
 	if id, err := vulnEqualCreate.
@@ -347,3 +346,34 @@ func (b *EntBackend) vulnEqualNeighbors(ctx context.Context, nodeID string, allo
 
 	return out, nil
 }
+
+func (b *EntBackend) VulnEqualList(ctx context.Context, vulnEqualSpec model.VulnEqualSpec, pagination *model.PaginationSpec) (*model.VulnEqualConnection, error) {
+	funcName := "VulnEqualList"
+	if len(vulnEqualSpec.Vulnerabilities) > 2 {
+		return nil, fmt.Errorf("too many vulnerability specified in vuln equal filter")
+	}
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := getVulnEqualObject(b.client.VulnEqual.Query().
+		Where(vulnEqualQuery(&vulnEqualSpec))).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.VulnEqualEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.VulnEqualEdge{
+			Cursor: toGlobalID(vulnequal.Table, edge.Node.ID.String()),
+			Node:   toModelVulnEqual(edge.Node),
+		})
+	}
+	return &model.VulnEqualConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, vulnequal.Table),
+		Edges:      edges,
+	}, nil
+}
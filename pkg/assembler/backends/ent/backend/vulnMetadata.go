@@ -28,7 +28,6 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func (b *EntBackend) VulnerabilityMetadata(ctx context.Context, filter *model.VulnerabilityMetadataSpec) ([]*model.VulnerabilityMetadata, error) {
@@ -81,7 +80,7 @@ func (b *EntBackend) IngestBulkVulnerabilityMetadata(ctx context.Context, vulner
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	return toGlobalIDs(vulnerabilitymetadata.Table, *ids), nil
@@ -160,7 +159,7 @@ func upsertBulkVulnerabilityMetadata(ctx context.Context, tx *ent.Tx, vulnerabil
 
 			creates[i], err = generateVulnMetadataCreate(ctx, tx, vulnerabilities[index], vm)
 			if err != nil {
-				return nil, gqlerror.Errorf("generateVulnEqualCreate :: %s", err)
+				return nil, Errorf("generateVulnEqualCreate :: %s", err)
 			}
 			index++
 		}
@@ -223,7 +222,7 @@ func upsertVulnerabilityMetadata(ctx context.Context, tx *ent.Tx, vulnerability
 
 	insert, err := generateVulnMetadataCreate(ctx, tx, &vulnerability, &spec)
 	if err != nil {
-		return nil, gqlerror.Errorf("generateVulnMetadataCreate :: %s", err)
+		return nil, Errorf("generateVulnMetadataCreate :: %s", err)
 
 	}
 	if id, err := insert.OnConflict(
@@ -282,3 +281,35 @@ func (b *EntBackend) vulnMetadataNeighbors(ctx context.Context, nodeID string, a
 
 	return out, nil
 }
+
+func (b *EntBackend) VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, pagination *model.PaginationSpec) (*model.VulnerabilityMetadataConnection, error) {
+	funcName := "VulnerabilityMetadataList"
+	vulnMetadataPred, err := vulnerabilityMetadataPredicate(&vulnerabilityMetadataSpec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate vulnerabilityMetadataPredicate :: %w", err)
+	}
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := getVulnMetadataObject(b.client.VulnerabilityMetadata.Query().
+		Where(vulnMetadataPred)).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.VulnerabilityMetadataEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.VulnerabilityMetadataEdge{
+			Cursor: toGlobalID(vulnerabilitymetadata.Table, edge.Node.ID.String()),
+			Node:   toModelVulnerabilityMetadata(edge.Node),
+		})
+	}
+	return &model.VulnerabilityMetadataConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, vulnerabilitymetadata.Table),
+		Edges:      edges,
+	}, nil
+}
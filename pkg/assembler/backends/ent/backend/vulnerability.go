@@ -30,7 +30,6 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/pkg/errors"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 const (
@@ -62,7 +61,7 @@ func (b *EntBackend) IngestVulnerabilities(ctx context.Context, vulns []*model.I
 		return slc, nil
 	})
 	if txErr != nil {
-		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+		return nil, Errorf("%v :: %s", funcName, txErr)
 	}
 
 	for _, vulnIDs := range *ids {
@@ -278,6 +277,12 @@ func (b *EntBackend) vulnIdNeighbors(ctx context.Context, nodeID string, allowed
 				getVulnMetadataObject(q)
 			})
 	}
+	if allowedEdges[model.EdgeVulnerabilityExploitReference] {
+		query.
+			WithExploitReferences(func(q *ent.ExploitReferenceQuery) {
+				getExploitReferenceObject(q)
+			})
+	}
 	query.
 		Limit(MaxPageSize)
 
@@ -295,7 +300,7 @@ func (b *EntBackend) vulnIdNeighbors(ctx context.Context, nodeID string, allowed
 			})
 		}
 		for _, certVuln := range foundVulnID.Edges.CertifyVuln {
-			out = append(out, toModelCertifyVulnerability(certVuln))
+			out = append(out, toModelCertifyVuln(certVuln))
 		}
 		for _, vulnEqualA := range foundVulnID.Edges.VulnEqualVulnA {
 			out = append(out, toModelVulnEqual(vulnEqualA))
@@ -309,7 +314,38 @@ func (b *EntBackend) vulnIdNeighbors(ctx context.Context, nodeID string, allowed
 		for _, meta := range foundVulnID.Edges.Metadata {
 			out = append(out, toModelVulnerabilityMetadata(meta))
 		}
+		for _, exploitRef := range foundVulnID.Edges.ExploitReferences {
+			out = append(out, toModelExploitReference(exploitRef))
+		}
 	}
 
 	return out, nil
 }
+
+func (b *EntBackend) VulnerabilitiesList(ctx context.Context, vulnSpec model.VulnerabilitySpec, pagination *model.PaginationSpec) (*model.VulnerabilityConnection, error) {
+	funcName := "VulnerabilitiesList"
+	after, first, before, last, err := paginationArgs(pagination)
+	if err != nil {
+		return nil, Errorf("%v :: %v", funcName, err)
+	}
+
+	conn, err := b.client.VulnerabilityID.Query().
+		Where(vulnerabilityQueryPredicates(vulnSpec)...).
+		Paginate(ctx, after, first, before, last)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+
+	edges := make([]*model.VulnerabilityEdge, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		edges = append(edges, &model.VulnerabilityEdge{
+			Cursor: toGlobalID(vulnerabilityid.Table, edge.Node.ID.String()),
+			Node:   toModelVulnerability([]*ent.VulnerabilityID{edge.Node})[0],
+		})
+	}
+	return &model.VulnerabilityConnection{
+		TotalCount: conn.TotalCount,
+		PageInfo:   toModelPageInfo(conn.PageInfo, vulnerabilityid.Table),
+		Edges:      edges,
+	}, nil
+}
@@ -40,6 +40,14 @@ type CertifyVuln struct {
 	Collector string `json:"collector,omitempty"`
 	// DocumentRef holds the value of the "document_ref" field.
 	DocumentRef string `json:"document_ref,omitempty"`
+	// CvssScore holds the value of the "cvss_score" field.
+	CvssScore *float64 `json:"cvss_score,omitempty"`
+	// RemediationStatus holds the value of the "remediation_status" field.
+	RemediationStatus certifyvuln.RemediationStatus `json:"remediation_status,omitempty"`
+	// ResolvedByID holds the value of the "resolved_by_id" field.
+	ResolvedByID *uuid.UUID `json:"resolved_by_id,omitempty"`
+	// ResolvedByType holds the value of the "resolved_by_type" field.
+	ResolvedByType *string `json:"resolved_by_type,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CertifyVulnQuery when eager-loading is set.
 	Edges        CertifyVulnEdges `json:"edges"`
@@ -90,7 +98,11 @@ func (*CertifyVuln) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case certifyvuln.FieldDbURI, certifyvuln.FieldDbVersion, certifyvuln.FieldScannerURI, certifyvuln.FieldScannerVersion, certifyvuln.FieldOrigin, certifyvuln.FieldCollector, certifyvuln.FieldDocumentRef:
+		case certifyvuln.FieldResolvedByID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case certifyvuln.FieldCvssScore:
+			values[i] = new(sql.NullFloat64)
+		case certifyvuln.FieldDbURI, certifyvuln.FieldDbVersion, certifyvuln.FieldScannerURI, certifyvuln.FieldScannerVersion, certifyvuln.FieldOrigin, certifyvuln.FieldCollector, certifyvuln.FieldDocumentRef, certifyvuln.FieldRemediationStatus, certifyvuln.FieldResolvedByType:
 			values[i] = new(sql.NullString)
 		case certifyvuln.FieldTimeScanned:
 			values[i] = new(sql.NullTime)
@@ -177,6 +189,33 @@ func (cv *CertifyVuln) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				cv.DocumentRef = value.String
 			}
+		case certifyvuln.FieldCvssScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cvss_score", values[i])
+			} else if value.Valid {
+				cv.CvssScore = new(float64)
+				*cv.CvssScore = value.Float64
+			}
+		case certifyvuln.FieldRemediationStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remediation_status", values[i])
+			} else if value.Valid {
+				cv.RemediationStatus = certifyvuln.RemediationStatus(value.String)
+			}
+		case certifyvuln.FieldResolvedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_by_id", values[i])
+			} else if value.Valid {
+				cv.ResolvedByID = new(uuid.UUID)
+				*cv.ResolvedByID = *value.S.(*uuid.UUID)
+			}
+		case certifyvuln.FieldResolvedByType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_by_type", values[i])
+			} else if value.Valid {
+				cv.ResolvedByType = new(string)
+				*cv.ResolvedByType = value.String
+			}
 		default:
 			cv.selectValues.Set(columns[i], values[i])
 		}
@@ -252,6 +291,24 @@ func (cv *CertifyVuln) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("document_ref=")
 	builder.WriteString(cv.DocumentRef)
+	builder.WriteString(", ")
+	if v := cv.CvssScore; v != nil {
+		builder.WriteString("cvss_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("remediation_status=")
+	builder.WriteString(fmt.Sprintf("%v", cv.RemediationStatus))
+	builder.WriteString(", ")
+	if v := cv.ResolvedByID; v != nil {
+		builder.WriteString("resolved_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := cv.ResolvedByType; v != nil {
+		builder.WriteString("resolved_by_type=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,10 @@
 package certifyvuln
 
 import (
+	"fmt"
+	"io"
+	"strconv"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -33,6 +37,14 @@ const (
 	FieldCollector = "collector"
 	// FieldDocumentRef holds the string denoting the document_ref field in the database.
 	FieldDocumentRef = "document_ref"
+	// FieldCvssScore holds the string denoting the cvss_score field in the database.
+	FieldCvssScore = "cvss_score"
+	// FieldRemediationStatus holds the string denoting the remediation_status field in the database.
+	FieldRemediationStatus = "remediation_status"
+	// FieldResolvedByID holds the string denoting the resolved_by_id field in the database.
+	FieldResolvedByID = "resolved_by_id"
+	// FieldResolvedByType holds the string denoting the resolved_by_type field in the database.
+	FieldResolvedByType = "resolved_by_type"
 	// EdgeVulnerability holds the string denoting the vulnerability edge name in mutations.
 	EdgeVulnerability = "vulnerability"
 	// EdgePackage holds the string denoting the package edge name in mutations.
@@ -68,6 +80,10 @@ var Columns = []string{
 	FieldOrigin,
 	FieldCollector,
 	FieldDocumentRef,
+	FieldCvssScore,
+	FieldRemediationStatus,
+	FieldResolvedByID,
+	FieldResolvedByType,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -85,6 +101,32 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// RemediationStatus defines the type for the "remediation_status" enum field.
+type RemediationStatus string
+
+// RemediationStatusOPEN is the default value of the RemediationStatus enum.
+const DefaultRemediationStatus = RemediationStatusOPEN
+
+// RemediationStatus values.
+const (
+	RemediationStatusOPEN  RemediationStatus = "OPEN"
+	RemediationStatusSTALE RemediationStatus = "STALE"
+)
+
+func (rs RemediationStatus) String() string {
+	return string(rs)
+}
+
+// RemediationStatusValidator is a validator for the "remediation_status" field enum values. It is called by the builders before save.
+func RemediationStatusValidator(rs RemediationStatus) error {
+	switch rs {
+	case RemediationStatusOPEN, RemediationStatusSTALE:
+		return nil
+	default:
+		return fmt.Errorf("certifyvuln: invalid enum value for remediation_status field: %q", rs)
+	}
+}
+
 // OrderOption defines the ordering options for the CertifyVuln queries.
 type OrderOption func(*sql.Selector)
 
@@ -143,6 +185,26 @@ func ByDocumentRef(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDocumentRef, opts...).ToFunc()
 }
 
+// ByCvssScore orders the results by the cvss_score field.
+func ByCvssScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCvssScore, opts...).ToFunc()
+}
+
+// ByRemediationStatus orders the results by the remediation_status field.
+func ByRemediationStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemediationStatus, opts...).ToFunc()
+}
+
+// ByResolvedByID orders the results by the resolved_by_id field.
+func ByResolvedByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedByID, opts...).ToFunc()
+}
+
+// ByResolvedByType orders the results by the resolved_by_type field.
+func ByResolvedByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedByType, opts...).ToFunc()
+}
+
 // ByVulnerabilityField orders the results by vulnerability field.
 func ByVulnerabilityField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -170,3 +232,21 @@ func newPackageStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, PackageTable, PackageColumn),
 	)
 }
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e RemediationStatus) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *RemediationStatus) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = RemediationStatus(str)
+	if err := RemediationStatusValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid RemediationStatus", str)
+	}
+	return nil
+}
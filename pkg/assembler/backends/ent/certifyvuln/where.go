@@ -106,6 +106,21 @@ func DocumentRef(v string) predicate.CertifyVuln {
 	return predicate.CertifyVuln(sql.FieldEQ(FieldDocumentRef, v))
 }
 
+// CvssScore applies equality check predicate on the "cvss_score" field. It's identical to CvssScoreEQ.
+func CvssScore(v float64) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldEQ(FieldCvssScore, v))
+}
+
+// ResolvedByID applies equality check predicate on the "resolved_by_id" field. It's identical to ResolvedByIDEQ.
+func ResolvedByID(v uuid.UUID) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldEQ(FieldResolvedByID, v))
+}
+
+// ResolvedByType applies equality check predicate on the "resolved_by_type" field. It's identical to ResolvedByTypeEQ.
+func ResolvedByType(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldEQ(FieldResolvedByType, v))
+}
+
 // VulnerabilityIDEQ applies the EQ predicate on the "vulnerability_id" field.
 func VulnerabilityIDEQ(v uuid.UUID) predicate.CertifyVuln {
 	return predicate.CertifyVuln(sql.FieldEQ(FieldVulnerabilityID, v))
@@ -641,6 +656,201 @@ func DocumentRefContainsFold(v string) predicate.CertifyVuln {
 	return predicate.CertifyVuln(sql.FieldContainsFold(FieldDocumentRef, v))
 }
 
+// CvssScoreEQ applies the EQ predicate on the "cvss_score" field.
+func CvssScoreEQ(v float64) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldEQ(FieldCvssScore, v))
+}
+
+// CvssScoreNEQ applies the NEQ predicate on the "cvss_score" field.
+func CvssScoreNEQ(v float64) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNEQ(FieldCvssScore, v))
+}
+
+// CvssScoreIn applies the In predicate on the "cvss_score" field.
+func CvssScoreIn(vs ...float64) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldIn(FieldCvssScore, vs...))
+}
+
+// CvssScoreNotIn applies the NotIn predicate on the "cvss_score" field.
+func CvssScoreNotIn(vs ...float64) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNotIn(FieldCvssScore, vs...))
+}
+
+// CvssScoreGT applies the GT predicate on the "cvss_score" field.
+func CvssScoreGT(v float64) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldGT(FieldCvssScore, v))
+}
+
+// CvssScoreGTE applies the GTE predicate on the "cvss_score" field.
+func CvssScoreGTE(v float64) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldGTE(FieldCvssScore, v))
+}
+
+// CvssScoreLT applies the LT predicate on the "cvss_score" field.
+func CvssScoreLT(v float64) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldLT(FieldCvssScore, v))
+}
+
+// CvssScoreLTE applies the LTE predicate on the "cvss_score" field.
+func CvssScoreLTE(v float64) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldLTE(FieldCvssScore, v))
+}
+
+// CvssScoreIsNil applies the IsNil predicate on the "cvss_score" field.
+func CvssScoreIsNil() predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldIsNull(FieldCvssScore))
+}
+
+// CvssScoreNotNil applies the NotNil predicate on the "cvss_score" field.
+func CvssScoreNotNil() predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNotNull(FieldCvssScore))
+}
+
+// RemediationStatusEQ applies the EQ predicate on the "remediation_status" field.
+func RemediationStatusEQ(v RemediationStatus) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldEQ(FieldRemediationStatus, v))
+}
+
+// RemediationStatusNEQ applies the NEQ predicate on the "remediation_status" field.
+func RemediationStatusNEQ(v RemediationStatus) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNEQ(FieldRemediationStatus, v))
+}
+
+// RemediationStatusIn applies the In predicate on the "remediation_status" field.
+func RemediationStatusIn(vs ...RemediationStatus) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldIn(FieldRemediationStatus, vs...))
+}
+
+// RemediationStatusNotIn applies the NotIn predicate on the "remediation_status" field.
+func RemediationStatusNotIn(vs ...RemediationStatus) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNotIn(FieldRemediationStatus, vs...))
+}
+
+// ResolvedByIDEQ applies the EQ predicate on the "resolved_by_id" field.
+func ResolvedByIDEQ(v uuid.UUID) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldEQ(FieldResolvedByID, v))
+}
+
+// ResolvedByIDNEQ applies the NEQ predicate on the "resolved_by_id" field.
+func ResolvedByIDNEQ(v uuid.UUID) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNEQ(FieldResolvedByID, v))
+}
+
+// ResolvedByIDIn applies the In predicate on the "resolved_by_id" field.
+func ResolvedByIDIn(vs ...uuid.UUID) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldIn(FieldResolvedByID, vs...))
+}
+
+// ResolvedByIDNotIn applies the NotIn predicate on the "resolved_by_id" field.
+func ResolvedByIDNotIn(vs ...uuid.UUID) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNotIn(FieldResolvedByID, vs...))
+}
+
+// ResolvedByIDGT applies the GT predicate on the "resolved_by_id" field.
+func ResolvedByIDGT(v uuid.UUID) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldGT(FieldResolvedByID, v))
+}
+
+// ResolvedByIDGTE applies the GTE predicate on the "resolved_by_id" field.
+func ResolvedByIDGTE(v uuid.UUID) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldGTE(FieldResolvedByID, v))
+}
+
+// ResolvedByIDLT applies the LT predicate on the "resolved_by_id" field.
+func ResolvedByIDLT(v uuid.UUID) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldLT(FieldResolvedByID, v))
+}
+
+// ResolvedByIDLTE applies the LTE predicate on the "resolved_by_id" field.
+func ResolvedByIDLTE(v uuid.UUID) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldLTE(FieldResolvedByID, v))
+}
+
+// ResolvedByIDIsNil applies the IsNil predicate on the "resolved_by_id" field.
+func ResolvedByIDIsNil() predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldIsNull(FieldResolvedByID))
+}
+
+// ResolvedByIDNotNil applies the NotNil predicate on the "resolved_by_id" field.
+func ResolvedByIDNotNil() predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNotNull(FieldResolvedByID))
+}
+
+// ResolvedByTypeEQ applies the EQ predicate on the "resolved_by_type" field.
+func ResolvedByTypeEQ(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldEQ(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeNEQ applies the NEQ predicate on the "resolved_by_type" field.
+func ResolvedByTypeNEQ(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNEQ(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeIn applies the In predicate on the "resolved_by_type" field.
+func ResolvedByTypeIn(vs ...string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldIn(FieldResolvedByType, vs...))
+}
+
+// ResolvedByTypeNotIn applies the NotIn predicate on the "resolved_by_type" field.
+func ResolvedByTypeNotIn(vs ...string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNotIn(FieldResolvedByType, vs...))
+}
+
+// ResolvedByTypeGT applies the GT predicate on the "resolved_by_type" field.
+func ResolvedByTypeGT(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldGT(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeGTE applies the GTE predicate on the "resolved_by_type" field.
+func ResolvedByTypeGTE(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldGTE(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeLT applies the LT predicate on the "resolved_by_type" field.
+func ResolvedByTypeLT(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldLT(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeLTE applies the LTE predicate on the "resolved_by_type" field.
+func ResolvedByTypeLTE(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldLTE(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeContains applies the Contains predicate on the "resolved_by_type" field.
+func ResolvedByTypeContains(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldContains(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeHasPrefix applies the HasPrefix predicate on the "resolved_by_type" field.
+func ResolvedByTypeHasPrefix(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldHasPrefix(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeHasSuffix applies the HasSuffix predicate on the "resolved_by_type" field.
+func ResolvedByTypeHasSuffix(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldHasSuffix(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeIsNil applies the IsNil predicate on the "resolved_by_type" field.
+func ResolvedByTypeIsNil() predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldIsNull(FieldResolvedByType))
+}
+
+// ResolvedByTypeNotNil applies the NotNil predicate on the "resolved_by_type" field.
+func ResolvedByTypeNotNil() predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNotNull(FieldResolvedByType))
+}
+
+// ResolvedByTypeEqualFold applies the EqualFold predicate on the "resolved_by_type" field.
+func ResolvedByTypeEqualFold(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldEqualFold(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeContainsFold applies the ContainsFold predicate on the "resolved_by_type" field.
+func ResolvedByTypeContainsFold(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldContainsFold(FieldResolvedByType, v))
+}
+
 // HasVulnerability applies the HasEdge predicate on the "vulnerability" edge.
 func HasVulnerability() predicate.CertifyVuln {
 	return predicate.CertifyVuln(func(s *sql.Selector) {
@@ -86,6 +86,62 @@ func (cvc *CertifyVulnCreate) SetDocumentRef(s string) *CertifyVulnCreate {
 	return cvc
 }
 
+// SetCvssScore sets the "cvss_score" field.
+func (cvc *CertifyVulnCreate) SetCvssScore(f float64) *CertifyVulnCreate {
+	cvc.mutation.SetCvssScore(f)
+	return cvc
+}
+
+// SetNillableCvssScore sets the "cvss_score" field if the given value is not nil.
+func (cvc *CertifyVulnCreate) SetNillableCvssScore(f *float64) *CertifyVulnCreate {
+	if f != nil {
+		cvc.SetCvssScore(*f)
+	}
+	return cvc
+}
+
+// SetRemediationStatus sets the "remediation_status" field.
+func (cvc *CertifyVulnCreate) SetRemediationStatus(cs certifyvuln.RemediationStatus) *CertifyVulnCreate {
+	cvc.mutation.SetRemediationStatus(cs)
+	return cvc
+}
+
+// SetNillableRemediationStatus sets the "remediation_status" field if the given value is not nil.
+func (cvc *CertifyVulnCreate) SetNillableRemediationStatus(cs *certifyvuln.RemediationStatus) *CertifyVulnCreate {
+	if cs != nil {
+		cvc.SetRemediationStatus(*cs)
+	}
+	return cvc
+}
+
+// SetResolvedByID sets the "resolved_by_id" field.
+func (cvc *CertifyVulnCreate) SetResolvedByID(u uuid.UUID) *CertifyVulnCreate {
+	cvc.mutation.SetResolvedByID(u)
+	return cvc
+}
+
+// SetNillableResolvedByID sets the "resolved_by_id" field if the given value is not nil.
+func (cvc *CertifyVulnCreate) SetNillableResolvedByID(u *uuid.UUID) *CertifyVulnCreate {
+	if u != nil {
+		cvc.SetResolvedByID(*u)
+	}
+	return cvc
+}
+
+// SetResolvedByType sets the "resolved_by_type" field.
+func (cvc *CertifyVulnCreate) SetResolvedByType(s string) *CertifyVulnCreate {
+	cvc.mutation.SetResolvedByType(s)
+	return cvc
+}
+
+// SetNillableResolvedByType sets the "resolved_by_type" field if the given value is not nil.
+func (cvc *CertifyVulnCreate) SetNillableResolvedByType(s *string) *CertifyVulnCreate {
+	if s != nil {
+		cvc.SetResolvedByType(*s)
+	}
+	return cvc
+}
+
 // SetID sets the "id" field.
 func (cvc *CertifyVulnCreate) SetID(u uuid.UUID) *CertifyVulnCreate {
 	cvc.mutation.SetID(u)
@@ -145,6 +201,10 @@ func (cvc *CertifyVulnCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (cvc *CertifyVulnCreate) defaults() {
+	if _, ok := cvc.mutation.RemediationStatus(); !ok {
+		v := certifyvuln.DefaultRemediationStatus
+		cvc.mutation.SetRemediationStatus(v)
+	}
 	if _, ok := cvc.mutation.ID(); !ok {
 		v := certifyvuln.DefaultID()
 		cvc.mutation.SetID(v)
@@ -183,6 +243,14 @@ func (cvc *CertifyVulnCreate) check() error {
 	if _, ok := cvc.mutation.DocumentRef(); !ok {
 		return &ValidationError{Name: "document_ref", err: errors.New(`ent: missing required field "CertifyVuln.document_ref"`)}
 	}
+	if _, ok := cvc.mutation.RemediationStatus(); !ok {
+		return &ValidationError{Name: "remediation_status", err: errors.New(`ent: missing required field "CertifyVuln.remediation_status"`)}
+	}
+	if v, ok := cvc.mutation.RemediationStatus(); ok {
+		if err := certifyvuln.RemediationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "remediation_status", err: fmt.Errorf(`ent: validator failed for field "CertifyVuln.remediation_status": %w`, err)}
+		}
+	}
 	if _, ok := cvc.mutation.VulnerabilityID(); !ok {
 		return &ValidationError{Name: "vulnerability", err: errors.New(`ent: missing required edge "CertifyVuln.vulnerability"`)}
 	}
@@ -257,6 +325,22 @@ func (cvc *CertifyVulnCreate) createSpec() (*CertifyVuln, *sqlgraph.CreateSpec)
 		_spec.SetField(certifyvuln.FieldDocumentRef, field.TypeString, value)
 		_node.DocumentRef = value
 	}
+	if value, ok := cvc.mutation.CvssScore(); ok {
+		_spec.SetField(certifyvuln.FieldCvssScore, field.TypeFloat64, value)
+		_node.CvssScore = &value
+	}
+	if value, ok := cvc.mutation.RemediationStatus(); ok {
+		_spec.SetField(certifyvuln.FieldRemediationStatus, field.TypeEnum, value)
+		_node.RemediationStatus = value
+	}
+	if value, ok := cvc.mutation.ResolvedByID(); ok {
+		_spec.SetField(certifyvuln.FieldResolvedByID, field.TypeUUID, value)
+		_node.ResolvedByID = &value
+	}
+	if value, ok := cvc.mutation.ResolvedByType(); ok {
+		_spec.SetField(certifyvuln.FieldResolvedByType, field.TypeString, value)
+		_node.ResolvedByType = &value
+	}
 	if nodes := cvc.mutation.VulnerabilityIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -463,6 +547,78 @@ func (u *CertifyVulnUpsert) UpdateDocumentRef() *CertifyVulnUpsert {
 	return u
 }
 
+// SetCvssScore sets the "cvss_score" field.
+func (u *CertifyVulnUpsert) SetCvssScore(v float64) *CertifyVulnUpsert {
+	u.Set(certifyvuln.FieldCvssScore, v)
+	return u
+}
+
+// UpdateCvssScore sets the "cvss_score" field to the value that was provided on create.
+func (u *CertifyVulnUpsert) UpdateCvssScore() *CertifyVulnUpsert {
+	u.SetExcluded(certifyvuln.FieldCvssScore)
+	return u
+}
+
+// AddCvssScore adds v to the "cvss_score" field.
+func (u *CertifyVulnUpsert) AddCvssScore(v float64) *CertifyVulnUpsert {
+	u.Add(certifyvuln.FieldCvssScore, v)
+	return u
+}
+
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (u *CertifyVulnUpsert) ClearCvssScore() *CertifyVulnUpsert {
+	u.SetNull(certifyvuln.FieldCvssScore)
+	return u
+}
+
+// SetRemediationStatus sets the "remediation_status" field.
+func (u *CertifyVulnUpsert) SetRemediationStatus(v certifyvuln.RemediationStatus) *CertifyVulnUpsert {
+	u.Set(certifyvuln.FieldRemediationStatus, v)
+	return u
+}
+
+// UpdateRemediationStatus sets the "remediation_status" field to the value that was provided on create.
+func (u *CertifyVulnUpsert) UpdateRemediationStatus() *CertifyVulnUpsert {
+	u.SetExcluded(certifyvuln.FieldRemediationStatus)
+	return u
+}
+
+// SetResolvedByID sets the "resolved_by_id" field.
+func (u *CertifyVulnUpsert) SetResolvedByID(v uuid.UUID) *CertifyVulnUpsert {
+	u.Set(certifyvuln.FieldResolvedByID, v)
+	return u
+}
+
+// UpdateResolvedByID sets the "resolved_by_id" field to the value that was provided on create.
+func (u *CertifyVulnUpsert) UpdateResolvedByID() *CertifyVulnUpsert {
+	u.SetExcluded(certifyvuln.FieldResolvedByID)
+	return u
+}
+
+// ClearResolvedByID clears the value of the "resolved_by_id" field.
+func (u *CertifyVulnUpsert) ClearResolvedByID() *CertifyVulnUpsert {
+	u.SetNull(certifyvuln.FieldResolvedByID)
+	return u
+}
+
+// SetResolvedByType sets the "resolved_by_type" field.
+func (u *CertifyVulnUpsert) SetResolvedByType(v string) *CertifyVulnUpsert {
+	u.Set(certifyvuln.FieldResolvedByType, v)
+	return u
+}
+
+// UpdateResolvedByType sets the "resolved_by_type" field to the value that was provided on create.
+func (u *CertifyVulnUpsert) UpdateResolvedByType() *CertifyVulnUpsert {
+	u.SetExcluded(certifyvuln.FieldResolvedByType)
+	return u
+}
+
+// ClearResolvedByType clears the value of the "resolved_by_type" field.
+func (u *CertifyVulnUpsert) ClearResolvedByType() *CertifyVulnUpsert {
+	u.SetNull(certifyvuln.FieldResolvedByType)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -651,6 +807,90 @@ func (u *CertifyVulnUpsertOne) UpdateDocumentRef() *CertifyVulnUpsertOne {
 	})
 }
 
+// SetCvssScore sets the "cvss_score" field.
+func (u *CertifyVulnUpsertOne) SetCvssScore(v float64) *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.SetCvssScore(v)
+	})
+}
+
+// AddCvssScore adds v to the "cvss_score" field.
+func (u *CertifyVulnUpsertOne) AddCvssScore(v float64) *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.AddCvssScore(v)
+	})
+}
+
+// UpdateCvssScore sets the "cvss_score" field to the value that was provided on create.
+func (u *CertifyVulnUpsertOne) UpdateCvssScore() *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.UpdateCvssScore()
+	})
+}
+
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (u *CertifyVulnUpsertOne) ClearCvssScore() *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.ClearCvssScore()
+	})
+}
+
+// SetRemediationStatus sets the "remediation_status" field.
+func (u *CertifyVulnUpsertOne) SetRemediationStatus(v certifyvuln.RemediationStatus) *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.SetRemediationStatus(v)
+	})
+}
+
+// UpdateRemediationStatus sets the "remediation_status" field to the value that was provided on create.
+func (u *CertifyVulnUpsertOne) UpdateRemediationStatus() *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.UpdateRemediationStatus()
+	})
+}
+
+// SetResolvedByID sets the "resolved_by_id" field.
+func (u *CertifyVulnUpsertOne) SetResolvedByID(v uuid.UUID) *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.SetResolvedByID(v)
+	})
+}
+
+// UpdateResolvedByID sets the "resolved_by_id" field to the value that was provided on create.
+func (u *CertifyVulnUpsertOne) UpdateResolvedByID() *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.UpdateResolvedByID()
+	})
+}
+
+// ClearResolvedByID clears the value of the "resolved_by_id" field.
+func (u *CertifyVulnUpsertOne) ClearResolvedByID() *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.ClearResolvedByID()
+	})
+}
+
+// SetResolvedByType sets the "resolved_by_type" field.
+func (u *CertifyVulnUpsertOne) SetResolvedByType(v string) *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.SetResolvedByType(v)
+	})
+}
+
+// UpdateResolvedByType sets the "resolved_by_type" field to the value that was provided on create.
+func (u *CertifyVulnUpsertOne) UpdateResolvedByType() *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.UpdateResolvedByType()
+	})
+}
+
+// ClearResolvedByType clears the value of the "resolved_by_type" field.
+func (u *CertifyVulnUpsertOne) ClearResolvedByType() *CertifyVulnUpsertOne {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.ClearResolvedByType()
+	})
+}
+
 // Exec executes the query.
 func (u *CertifyVulnUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1006,6 +1246,90 @@ func (u *CertifyVulnUpsertBulk) UpdateDocumentRef() *CertifyVulnUpsertBulk {
 	})
 }
 
+// SetCvssScore sets the "cvss_score" field.
+func (u *CertifyVulnUpsertBulk) SetCvssScore(v float64) *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.SetCvssScore(v)
+	})
+}
+
+// AddCvssScore adds v to the "cvss_score" field.
+func (u *CertifyVulnUpsertBulk) AddCvssScore(v float64) *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.AddCvssScore(v)
+	})
+}
+
+// UpdateCvssScore sets the "cvss_score" field to the value that was provided on create.
+func (u *CertifyVulnUpsertBulk) UpdateCvssScore() *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.UpdateCvssScore()
+	})
+}
+
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (u *CertifyVulnUpsertBulk) ClearCvssScore() *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.ClearCvssScore()
+	})
+}
+
+// SetRemediationStatus sets the "remediation_status" field.
+func (u *CertifyVulnUpsertBulk) SetRemediationStatus(v certifyvuln.RemediationStatus) *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.SetRemediationStatus(v)
+	})
+}
+
+// UpdateRemediationStatus sets the "remediation_status" field to the value that was provided on create.
+func (u *CertifyVulnUpsertBulk) UpdateRemediationStatus() *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.UpdateRemediationStatus()
+	})
+}
+
+// SetResolvedByID sets the "resolved_by_id" field.
+func (u *CertifyVulnUpsertBulk) SetResolvedByID(v uuid.UUID) *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.SetResolvedByID(v)
+	})
+}
+
+// UpdateResolvedByID sets the "resolved_by_id" field to the value that was provided on create.
+func (u *CertifyVulnUpsertBulk) UpdateResolvedByID() *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.UpdateResolvedByID()
+	})
+}
+
+// ClearResolvedByID clears the value of the "resolved_by_id" field.
+func (u *CertifyVulnUpsertBulk) ClearResolvedByID() *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.ClearResolvedByID()
+	})
+}
+
+// SetResolvedByType sets the "resolved_by_type" field.
+func (u *CertifyVulnUpsertBulk) SetResolvedByType(v string) *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.SetResolvedByType(v)
+	})
+}
+
+// UpdateResolvedByType sets the "resolved_by_type" field to the value that was provided on create.
+func (u *CertifyVulnUpsertBulk) UpdateResolvedByType() *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.UpdateResolvedByType()
+	})
+}
+
+// ClearResolvedByType clears the value of the "resolved_by_type" field.
+func (u *CertifyVulnUpsertBulk) ClearResolvedByType() *CertifyVulnUpsertBulk {
+	return u.Update(func(s *CertifyVulnUpsert) {
+		s.ClearResolvedByType()
+	})
+}
+
 // Exec executes the query.
 func (u *CertifyVulnUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -0,0 +1,30 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import "github.com/google/uuid"
+
+// SetResolvedBy records the node, of GraphQL type nodeType, that resolved the
+// vulnerability.
+func (cvc *CertifyVulnCreate) SetResolvedBy(id uuid.UUID, nodeType string) *CertifyVulnCreate {
+	return cvc.SetResolvedByID(id).SetResolvedByType(nodeType)
+}
+
+// SetResolvedBy records the node, of GraphQL type nodeType, that resolved the
+// vulnerability.
+func (cvuo *CertifyVulnUpdateOne) SetResolvedBy(id uuid.UUID, nodeType string) *CertifyVulnUpdateOne {
+	return cvuo.SetResolvedByID(id).SetResolvedByType(nodeType)
+}
@@ -171,6 +171,87 @@ func (cvu *CertifyVulnUpdate) SetNillableDocumentRef(s *string) *CertifyVulnUpda
 	return cvu
 }
 
+// SetCvssScore sets the "cvss_score" field.
+func (cvu *CertifyVulnUpdate) SetCvssScore(f float64) *CertifyVulnUpdate {
+	cvu.mutation.ResetCvssScore()
+	cvu.mutation.SetCvssScore(f)
+	return cvu
+}
+
+// SetNillableCvssScore sets the "cvss_score" field if the given value is not nil.
+func (cvu *CertifyVulnUpdate) SetNillableCvssScore(f *float64) *CertifyVulnUpdate {
+	if f != nil {
+		cvu.SetCvssScore(*f)
+	}
+	return cvu
+}
+
+// AddCvssScore adds f to the "cvss_score" field.
+func (cvu *CertifyVulnUpdate) AddCvssScore(f float64) *CertifyVulnUpdate {
+	cvu.mutation.AddCvssScore(f)
+	return cvu
+}
+
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (cvu *CertifyVulnUpdate) ClearCvssScore() *CertifyVulnUpdate {
+	cvu.mutation.ClearCvssScore()
+	return cvu
+}
+
+// SetRemediationStatus sets the "remediation_status" field.
+func (cvu *CertifyVulnUpdate) SetRemediationStatus(cs certifyvuln.RemediationStatus) *CertifyVulnUpdate {
+	cvu.mutation.SetRemediationStatus(cs)
+	return cvu
+}
+
+// SetNillableRemediationStatus sets the "remediation_status" field if the given value is not nil.
+func (cvu *CertifyVulnUpdate) SetNillableRemediationStatus(cs *certifyvuln.RemediationStatus) *CertifyVulnUpdate {
+	if cs != nil {
+		cvu.SetRemediationStatus(*cs)
+	}
+	return cvu
+}
+
+// SetResolvedByID sets the "resolved_by_id" field.
+func (cvu *CertifyVulnUpdate) SetResolvedByID(u uuid.UUID) *CertifyVulnUpdate {
+	cvu.mutation.SetResolvedByID(u)
+	return cvu
+}
+
+// SetNillableResolvedByID sets the "resolved_by_id" field if the given value is not nil.
+func (cvu *CertifyVulnUpdate) SetNillableResolvedByID(u *uuid.UUID) *CertifyVulnUpdate {
+	if u != nil {
+		cvu.SetResolvedByID(*u)
+	}
+	return cvu
+}
+
+// ClearResolvedByID clears the value of the "resolved_by_id" field.
+func (cvu *CertifyVulnUpdate) ClearResolvedByID() *CertifyVulnUpdate {
+	cvu.mutation.ClearResolvedByID()
+	return cvu
+}
+
+// SetResolvedByType sets the "resolved_by_type" field.
+func (cvu *CertifyVulnUpdate) SetResolvedByType(s string) *CertifyVulnUpdate {
+	cvu.mutation.SetResolvedByType(s)
+	return cvu
+}
+
+// SetNillableResolvedByType sets the "resolved_by_type" field if the given value is not nil.
+func (cvu *CertifyVulnUpdate) SetNillableResolvedByType(s *string) *CertifyVulnUpdate {
+	if s != nil {
+		cvu.SetResolvedByType(*s)
+	}
+	return cvu
+}
+
+// ClearResolvedByType clears the value of the "resolved_by_type" field.
+func (cvu *CertifyVulnUpdate) ClearResolvedByType() *CertifyVulnUpdate {
+	cvu.mutation.ClearResolvedByType()
+	return cvu
+}
+
 // SetVulnerability sets the "vulnerability" edge to the VulnerabilityID entity.
 func (cvu *CertifyVulnUpdate) SetVulnerability(v *VulnerabilityID) *CertifyVulnUpdate {
 	return cvu.SetVulnerabilityID(v.ID)
@@ -227,6 +308,11 @@ func (cvu *CertifyVulnUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (cvu *CertifyVulnUpdate) check() error {
+	if v, ok := cvu.mutation.RemediationStatus(); ok {
+		if err := certifyvuln.RemediationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "remediation_status", err: fmt.Errorf(`ent: validator failed for field "CertifyVuln.remediation_status": %w`, err)}
+		}
+	}
 	if _, ok := cvu.mutation.VulnerabilityID(); cvu.mutation.VulnerabilityCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "CertifyVuln.vulnerability"`)
 	}
@@ -272,6 +358,30 @@ func (cvu *CertifyVulnUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cvu.mutation.DocumentRef(); ok {
 		_spec.SetField(certifyvuln.FieldDocumentRef, field.TypeString, value)
 	}
+	if value, ok := cvu.mutation.CvssScore(); ok {
+		_spec.SetField(certifyvuln.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if value, ok := cvu.mutation.AddedCvssScore(); ok {
+		_spec.AddField(certifyvuln.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if cvu.mutation.CvssScoreCleared() {
+		_spec.ClearField(certifyvuln.FieldCvssScore, field.TypeFloat64)
+	}
+	if value, ok := cvu.mutation.RemediationStatus(); ok {
+		_spec.SetField(certifyvuln.FieldRemediationStatus, field.TypeEnum, value)
+	}
+	if value, ok := cvu.mutation.ResolvedByID(); ok {
+		_spec.SetField(certifyvuln.FieldResolvedByID, field.TypeUUID, value)
+	}
+	if cvu.mutation.ResolvedByIDCleared() {
+		_spec.ClearField(certifyvuln.FieldResolvedByID, field.TypeUUID)
+	}
+	if value, ok := cvu.mutation.ResolvedByType(); ok {
+		_spec.SetField(certifyvuln.FieldResolvedByType, field.TypeString, value)
+	}
+	if cvu.mutation.ResolvedByTypeCleared() {
+		_spec.ClearField(certifyvuln.FieldResolvedByType, field.TypeString)
+	}
 	if cvu.mutation.VulnerabilityCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -490,6 +600,87 @@ func (cvuo *CertifyVulnUpdateOne) SetNillableDocumentRef(s *string) *CertifyVuln
 	return cvuo
 }
 
+// SetCvssScore sets the "cvss_score" field.
+func (cvuo *CertifyVulnUpdateOne) SetCvssScore(f float64) *CertifyVulnUpdateOne {
+	cvuo.mutation.ResetCvssScore()
+	cvuo.mutation.SetCvssScore(f)
+	return cvuo
+}
+
+// SetNillableCvssScore sets the "cvss_score" field if the given value is not nil.
+func (cvuo *CertifyVulnUpdateOne) SetNillableCvssScore(f *float64) *CertifyVulnUpdateOne {
+	if f != nil {
+		cvuo.SetCvssScore(*f)
+	}
+	return cvuo
+}
+
+// AddCvssScore adds f to the "cvss_score" field.
+func (cvuo *CertifyVulnUpdateOne) AddCvssScore(f float64) *CertifyVulnUpdateOne {
+	cvuo.mutation.AddCvssScore(f)
+	return cvuo
+}
+
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (cvuo *CertifyVulnUpdateOne) ClearCvssScore() *CertifyVulnUpdateOne {
+	cvuo.mutation.ClearCvssScore()
+	return cvuo
+}
+
+// SetRemediationStatus sets the "remediation_status" field.
+func (cvuo *CertifyVulnUpdateOne) SetRemediationStatus(cs certifyvuln.RemediationStatus) *CertifyVulnUpdateOne {
+	cvuo.mutation.SetRemediationStatus(cs)
+	return cvuo
+}
+
+// SetNillableRemediationStatus sets the "remediation_status" field if the given value is not nil.
+func (cvuo *CertifyVulnUpdateOne) SetNillableRemediationStatus(cs *certifyvuln.RemediationStatus) *CertifyVulnUpdateOne {
+	if cs != nil {
+		cvuo.SetRemediationStatus(*cs)
+	}
+	return cvuo
+}
+
+// SetResolvedByID sets the "resolved_by_id" field.
+func (cvuo *CertifyVulnUpdateOne) SetResolvedByID(u uuid.UUID) *CertifyVulnUpdateOne {
+	cvuo.mutation.SetResolvedByID(u)
+	return cvuo
+}
+
+// SetNillableResolvedByID sets the "resolved_by_id" field if the given value is not nil.
+func (cvuo *CertifyVulnUpdateOne) SetNillableResolvedByID(u *uuid.UUID) *CertifyVulnUpdateOne {
+	if u != nil {
+		cvuo.SetResolvedByID(*u)
+	}
+	return cvuo
+}
+
+// ClearResolvedByID clears the value of the "resolved_by_id" field.
+func (cvuo *CertifyVulnUpdateOne) ClearResolvedByID() *CertifyVulnUpdateOne {
+	cvuo.mutation.ClearResolvedByID()
+	return cvuo
+}
+
+// SetResolvedByType sets the "resolved_by_type" field.
+func (cvuo *CertifyVulnUpdateOne) SetResolvedByType(s string) *CertifyVulnUpdateOne {
+	cvuo.mutation.SetResolvedByType(s)
+	return cvuo
+}
+
+// SetNillableResolvedByType sets the "resolved_by_type" field if the given value is not nil.
+func (cvuo *CertifyVulnUpdateOne) SetNillableResolvedByType(s *string) *CertifyVulnUpdateOne {
+	if s != nil {
+		cvuo.SetResolvedByType(*s)
+	}
+	return cvuo
+}
+
+// ClearResolvedByType clears the value of the "resolved_by_type" field.
+func (cvuo *CertifyVulnUpdateOne) ClearResolvedByType() *CertifyVulnUpdateOne {
+	cvuo.mutation.ClearResolvedByType()
+	return cvuo
+}
+
 // SetVulnerability sets the "vulnerability" edge to the VulnerabilityID entity.
 func (cvuo *CertifyVulnUpdateOne) SetVulnerability(v *VulnerabilityID) *CertifyVulnUpdateOne {
 	return cvuo.SetVulnerabilityID(v.ID)
@@ -559,6 +750,11 @@ func (cvuo *CertifyVulnUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (cvuo *CertifyVulnUpdateOne) check() error {
+	if v, ok := cvuo.mutation.RemediationStatus(); ok {
+		if err := certifyvuln.RemediationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "remediation_status", err: fmt.Errorf(`ent: validator failed for field "CertifyVuln.remediation_status": %w`, err)}
+		}
+	}
 	if _, ok := cvuo.mutation.VulnerabilityID(); cvuo.mutation.VulnerabilityCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "CertifyVuln.vulnerability"`)
 	}
@@ -621,6 +817,30 @@ func (cvuo *CertifyVulnUpdateOne) sqlSave(ctx context.Context) (_node *CertifyVu
 	if value, ok := cvuo.mutation.DocumentRef(); ok {
 		_spec.SetField(certifyvuln.FieldDocumentRef, field.TypeString, value)
 	}
+	if value, ok := cvuo.mutation.CvssScore(); ok {
+		_spec.SetField(certifyvuln.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if value, ok := cvuo.mutation.AddedCvssScore(); ok {
+		_spec.AddField(certifyvuln.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if cvuo.mutation.CvssScoreCleared() {
+		_spec.ClearField(certifyvuln.FieldCvssScore, field.TypeFloat64)
+	}
+	if value, ok := cvuo.mutation.RemediationStatus(); ok {
+		_spec.SetField(certifyvuln.FieldRemediationStatus, field.TypeEnum, value)
+	}
+	if value, ok := cvuo.mutation.ResolvedByID(); ok {
+		_spec.SetField(certifyvuln.FieldResolvedByID, field.TypeUUID, value)
+	}
+	if cvuo.mutation.ResolvedByIDCleared() {
+		_spec.ClearField(certifyvuln.FieldResolvedByID, field.TypeUUID)
+	}
+	if value, ok := cvuo.mutation.ResolvedByType(); ok {
+		_spec.SetField(certifyvuln.FieldResolvedByType, field.TypeString, value)
+	}
+	if cvuo.mutation.ResolvedByTypeCleared() {
+		_spec.ClearField(certifyvuln.FieldResolvedByType, field.TypeString)
+	}
 	if cvuo.mutation.VulnerabilityCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,913 @@
+// Code generated by ent, DO NOT EDIT.
+
+package certifyvulnarchive
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLTE(FieldID, id))
+}
+
+// VulnerabilityID applies equality check predicate on the "vulnerability_id" field. It's identical to VulnerabilityIDEQ.
+func VulnerabilityID(v uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldVulnerabilityID, v))
+}
+
+// PackageID applies equality check predicate on the "package_id" field. It's identical to PackageIDEQ.
+func PackageID(v uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldPackageID, v))
+}
+
+// TimeScanned applies equality check predicate on the "time_scanned" field. It's identical to TimeScannedEQ.
+func TimeScanned(v time.Time) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldTimeScanned, v))
+}
+
+// DbURI applies equality check predicate on the "db_uri" field. It's identical to DbURIEQ.
+func DbURI(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldDbURI, v))
+}
+
+// DbVersion applies equality check predicate on the "db_version" field. It's identical to DbVersionEQ.
+func DbVersion(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldDbVersion, v))
+}
+
+// ScannerURI applies equality check predicate on the "scanner_uri" field. It's identical to ScannerURIEQ.
+func ScannerURI(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldScannerURI, v))
+}
+
+// ScannerVersion applies equality check predicate on the "scanner_version" field. It's identical to ScannerVersionEQ.
+func ScannerVersion(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldScannerVersion, v))
+}
+
+// Origin applies equality check predicate on the "origin" field. It's identical to OriginEQ.
+func Origin(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldOrigin, v))
+}
+
+// Collector applies equality check predicate on the "collector" field. It's identical to CollectorEQ.
+func Collector(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldCollector, v))
+}
+
+// DocumentRef applies equality check predicate on the "document_ref" field. It's identical to DocumentRefEQ.
+func DocumentRef(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldDocumentRef, v))
+}
+
+// CvssScore applies equality check predicate on the "cvss_score" field. It's identical to CvssScoreEQ.
+func CvssScore(v float64) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldCvssScore, v))
+}
+
+// ResolvedByID applies equality check predicate on the "resolved_by_id" field. It's identical to ResolvedByIDEQ.
+func ResolvedByID(v uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldResolvedByID, v))
+}
+
+// ResolvedByType applies equality check predicate on the "resolved_by_type" field. It's identical to ResolvedByTypeEQ.
+func ResolvedByType(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldResolvedByType, v))
+}
+
+// VulnerabilityIDEQ applies the EQ predicate on the "vulnerability_id" field.
+func VulnerabilityIDEQ(v uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldVulnerabilityID, v))
+}
+
+// VulnerabilityIDNEQ applies the NEQ predicate on the "vulnerability_id" field.
+func VulnerabilityIDNEQ(v uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNEQ(FieldVulnerabilityID, v))
+}
+
+// VulnerabilityIDIn applies the In predicate on the "vulnerability_id" field.
+func VulnerabilityIDIn(vs ...uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIn(FieldVulnerabilityID, vs...))
+}
+
+// VulnerabilityIDNotIn applies the NotIn predicate on the "vulnerability_id" field.
+func VulnerabilityIDNotIn(vs ...uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotIn(FieldVulnerabilityID, vs...))
+}
+
+// PackageIDEQ applies the EQ predicate on the "package_id" field.
+func PackageIDEQ(v uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldPackageID, v))
+}
+
+// PackageIDNEQ applies the NEQ predicate on the "package_id" field.
+func PackageIDNEQ(v uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNEQ(FieldPackageID, v))
+}
+
+// PackageIDIn applies the In predicate on the "package_id" field.
+func PackageIDIn(vs ...uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIn(FieldPackageID, vs...))
+}
+
+// PackageIDNotIn applies the NotIn predicate on the "package_id" field.
+func PackageIDNotIn(vs ...uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotIn(FieldPackageID, vs...))
+}
+
+// TimeScannedEQ applies the EQ predicate on the "time_scanned" field.
+func TimeScannedEQ(v time.Time) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldTimeScanned, v))
+}
+
+// TimeScannedNEQ applies the NEQ predicate on the "time_scanned" field.
+func TimeScannedNEQ(v time.Time) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNEQ(FieldTimeScanned, v))
+}
+
+// TimeScannedIn applies the In predicate on the "time_scanned" field.
+func TimeScannedIn(vs ...time.Time) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIn(FieldTimeScanned, vs...))
+}
+
+// TimeScannedNotIn applies the NotIn predicate on the "time_scanned" field.
+func TimeScannedNotIn(vs ...time.Time) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotIn(FieldTimeScanned, vs...))
+}
+
+// TimeScannedGT applies the GT predicate on the "time_scanned" field.
+func TimeScannedGT(v time.Time) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGT(FieldTimeScanned, v))
+}
+
+// TimeScannedGTE applies the GTE predicate on the "time_scanned" field.
+func TimeScannedGTE(v time.Time) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGTE(FieldTimeScanned, v))
+}
+
+// TimeScannedLT applies the LT predicate on the "time_scanned" field.
+func TimeScannedLT(v time.Time) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLT(FieldTimeScanned, v))
+}
+
+// TimeScannedLTE applies the LTE predicate on the "time_scanned" field.
+func TimeScannedLTE(v time.Time) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLTE(FieldTimeScanned, v))
+}
+
+// DbURIEQ applies the EQ predicate on the "db_uri" field.
+func DbURIEQ(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldDbURI, v))
+}
+
+// DbURINEQ applies the NEQ predicate on the "db_uri" field.
+func DbURINEQ(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNEQ(FieldDbURI, v))
+}
+
+// DbURIIn applies the In predicate on the "db_uri" field.
+func DbURIIn(vs ...string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIn(FieldDbURI, vs...))
+}
+
+// DbURINotIn applies the NotIn predicate on the "db_uri" field.
+func DbURINotIn(vs ...string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotIn(FieldDbURI, vs...))
+}
+
+// DbURIGT applies the GT predicate on the "db_uri" field.
+func DbURIGT(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGT(FieldDbURI, v))
+}
+
+// DbURIGTE applies the GTE predicate on the "db_uri" field.
+func DbURIGTE(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGTE(FieldDbURI, v))
+}
+
+// DbURILT applies the LT predicate on the "db_uri" field.
+func DbURILT(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLT(FieldDbURI, v))
+}
+
+// DbURILTE applies the LTE predicate on the "db_uri" field.
+func DbURILTE(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLTE(FieldDbURI, v))
+}
+
+// DbURIContains applies the Contains predicate on the "db_uri" field.
+func DbURIContains(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldContains(FieldDbURI, v))
+}
+
+// DbURIHasPrefix applies the HasPrefix predicate on the "db_uri" field.
+func DbURIHasPrefix(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldHasPrefix(FieldDbURI, v))
+}
+
+// DbURIHasSuffix applies the HasSuffix predicate on the "db_uri" field.
+func DbURIHasSuffix(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldHasSuffix(FieldDbURI, v))
+}
+
+// DbURIEqualFold applies the EqualFold predicate on the "db_uri" field.
+func DbURIEqualFold(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEqualFold(FieldDbURI, v))
+}
+
+// DbURIContainsFold applies the ContainsFold predicate on the "db_uri" field.
+func DbURIContainsFold(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldContainsFold(FieldDbURI, v))
+}
+
+// DbVersionEQ applies the EQ predicate on the "db_version" field.
+func DbVersionEQ(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldDbVersion, v))
+}
+
+// DbVersionNEQ applies the NEQ predicate on the "db_version" field.
+func DbVersionNEQ(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNEQ(FieldDbVersion, v))
+}
+
+// DbVersionIn applies the In predicate on the "db_version" field.
+func DbVersionIn(vs ...string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIn(FieldDbVersion, vs...))
+}
+
+// DbVersionNotIn applies the NotIn predicate on the "db_version" field.
+func DbVersionNotIn(vs ...string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotIn(FieldDbVersion, vs...))
+}
+
+// DbVersionGT applies the GT predicate on the "db_version" field.
+func DbVersionGT(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGT(FieldDbVersion, v))
+}
+
+// DbVersionGTE applies the GTE predicate on the "db_version" field.
+func DbVersionGTE(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGTE(FieldDbVersion, v))
+}
+
+// DbVersionLT applies the LT predicate on the "db_version" field.
+func DbVersionLT(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLT(FieldDbVersion, v))
+}
+
+// DbVersionLTE applies the LTE predicate on the "db_version" field.
+func DbVersionLTE(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLTE(FieldDbVersion, v))
+}
+
+// DbVersionContains applies the Contains predicate on the "db_version" field.
+func DbVersionContains(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldContains(FieldDbVersion, v))
+}
+
+// DbVersionHasPrefix applies the HasPrefix predicate on the "db_version" field.
+func DbVersionHasPrefix(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldHasPrefix(FieldDbVersion, v))
+}
+
+// DbVersionHasSuffix applies the HasSuffix predicate on the "db_version" field.
+func DbVersionHasSuffix(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldHasSuffix(FieldDbVersion, v))
+}
+
+// DbVersionEqualFold applies the EqualFold predicate on the "db_version" field.
+func DbVersionEqualFold(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEqualFold(FieldDbVersion, v))
+}
+
+// DbVersionContainsFold applies the ContainsFold predicate on the "db_version" field.
+func DbVersionContainsFold(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldContainsFold(FieldDbVersion, v))
+}
+
+// ScannerURIEQ applies the EQ predicate on the "scanner_uri" field.
+func ScannerURIEQ(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldScannerURI, v))
+}
+
+// ScannerURINEQ applies the NEQ predicate on the "scanner_uri" field.
+func ScannerURINEQ(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNEQ(FieldScannerURI, v))
+}
+
+// ScannerURIIn applies the In predicate on the "scanner_uri" field.
+func ScannerURIIn(vs ...string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIn(FieldScannerURI, vs...))
+}
+
+// ScannerURINotIn applies the NotIn predicate on the "scanner_uri" field.
+func ScannerURINotIn(vs ...string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotIn(FieldScannerURI, vs...))
+}
+
+// ScannerURIGT applies the GT predicate on the "scanner_uri" field.
+func ScannerURIGT(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGT(FieldScannerURI, v))
+}
+
+// ScannerURIGTE applies the GTE predicate on the "scanner_uri" field.
+func ScannerURIGTE(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGTE(FieldScannerURI, v))
+}
+
+// ScannerURILT applies the LT predicate on the "scanner_uri" field.
+func ScannerURILT(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLT(FieldScannerURI, v))
+}
+
+// ScannerURILTE applies the LTE predicate on the "scanner_uri" field.
+func ScannerURILTE(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLTE(FieldScannerURI, v))
+}
+
+// ScannerURIContains applies the Contains predicate on the "scanner_uri" field.
+func ScannerURIContains(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldContains(FieldScannerURI, v))
+}
+
+// ScannerURIHasPrefix applies the HasPrefix predicate on the "scanner_uri" field.
+func ScannerURIHasPrefix(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldHasPrefix(FieldScannerURI, v))
+}
+
+// ScannerURIHasSuffix applies the HasSuffix predicate on the "scanner_uri" field.
+func ScannerURIHasSuffix(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldHasSuffix(FieldScannerURI, v))
+}
+
+// ScannerURIEqualFold applies the EqualFold predicate on the "scanner_uri" field.
+func ScannerURIEqualFold(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEqualFold(FieldScannerURI, v))
+}
+
+// ScannerURIContainsFold applies the ContainsFold predicate on the "scanner_uri" field.
+func ScannerURIContainsFold(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldContainsFold(FieldScannerURI, v))
+}
+
+// ScannerVersionEQ applies the EQ predicate on the "scanner_version" field.
+func ScannerVersionEQ(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldScannerVersion, v))
+}
+
+// ScannerVersionNEQ applies the NEQ predicate on the "scanner_version" field.
+func ScannerVersionNEQ(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNEQ(FieldScannerVersion, v))
+}
+
+// ScannerVersionIn applies the In predicate on the "scanner_version" field.
+func ScannerVersionIn(vs ...string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIn(FieldScannerVersion, vs...))
+}
+
+// ScannerVersionNotIn applies the NotIn predicate on the "scanner_version" field.
+func ScannerVersionNotIn(vs ...string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotIn(FieldScannerVersion, vs...))
+}
+
+// ScannerVersionGT applies the GT predicate on the "scanner_version" field.
+func ScannerVersionGT(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGT(FieldScannerVersion, v))
+}
+
+// ScannerVersionGTE applies the GTE predicate on the "scanner_version" field.
+func ScannerVersionGTE(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGTE(FieldScannerVersion, v))
+}
+
+// ScannerVersionLT applies the LT predicate on the "scanner_version" field.
+func ScannerVersionLT(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLT(FieldScannerVersion, v))
+}
+
+// ScannerVersionLTE applies the LTE predicate on the "scanner_version" field.
+func ScannerVersionLTE(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLTE(FieldScannerVersion, v))
+}
+
+// ScannerVersionContains applies the Contains predicate on the "scanner_version" field.
+func ScannerVersionContains(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldContains(FieldScannerVersion, v))
+}
+
+// ScannerVersionHasPrefix applies the HasPrefix predicate on the "scanner_version" field.
+func ScannerVersionHasPrefix(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldHasPrefix(FieldScannerVersion, v))
+}
+
+// ScannerVersionHasSuffix applies the HasSuffix predicate on the "scanner_version" field.
+func ScannerVersionHasSuffix(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldHasSuffix(FieldScannerVersion, v))
+}
+
+// ScannerVersionEqualFold applies the EqualFold predicate on the "scanner_version" field.
+func ScannerVersionEqualFold(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEqualFold(FieldScannerVersion, v))
+}
+
+// ScannerVersionContainsFold applies the ContainsFold predicate on the "scanner_version" field.
+func ScannerVersionContainsFold(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldContainsFold(FieldScannerVersion, v))
+}
+
+// OriginEQ applies the EQ predicate on the "origin" field.
+func OriginEQ(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldOrigin, v))
+}
+
+// OriginNEQ applies the NEQ predicate on the "origin" field.
+func OriginNEQ(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNEQ(FieldOrigin, v))
+}
+
+// OriginIn applies the In predicate on the "origin" field.
+func OriginIn(vs ...string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIn(FieldOrigin, vs...))
+}
+
+// OriginNotIn applies the NotIn predicate on the "origin" field.
+func OriginNotIn(vs ...string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotIn(FieldOrigin, vs...))
+}
+
+// OriginGT applies the GT predicate on the "origin" field.
+func OriginGT(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGT(FieldOrigin, v))
+}
+
+// OriginGTE applies the GTE predicate on the "origin" field.
+func OriginGTE(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGTE(FieldOrigin, v))
+}
+
+// OriginLT applies the LT predicate on the "origin" field.
+func OriginLT(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLT(FieldOrigin, v))
+}
+
+// OriginLTE applies the LTE predicate on the "origin" field.
+func OriginLTE(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLTE(FieldOrigin, v))
+}
+
+// OriginContains applies the Contains predicate on the "origin" field.
+func OriginContains(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldContains(FieldOrigin, v))
+}
+
+// OriginHasPrefix applies the HasPrefix predicate on the "origin" field.
+func OriginHasPrefix(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldHasPrefix(FieldOrigin, v))
+}
+
+// OriginHasSuffix applies the HasSuffix predicate on the "origin" field.
+func OriginHasSuffix(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldHasSuffix(FieldOrigin, v))
+}
+
+// OriginEqualFold applies the EqualFold predicate on the "origin" field.
+func OriginEqualFold(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEqualFold(FieldOrigin, v))
+}
+
+// OriginContainsFold applies the ContainsFold predicate on the "origin" field.
+func OriginContainsFold(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldContainsFold(FieldOrigin, v))
+}
+
+// CollectorEQ applies the EQ predicate on the "collector" field.
+func CollectorEQ(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldCollector, v))
+}
+
+// CollectorNEQ applies the NEQ predicate on the "collector" field.
+func CollectorNEQ(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNEQ(FieldCollector, v))
+}
+
+// CollectorIn applies the In predicate on the "collector" field.
+func CollectorIn(vs ...string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIn(FieldCollector, vs...))
+}
+
+// CollectorNotIn applies the NotIn predicate on the "collector" field.
+func CollectorNotIn(vs ...string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotIn(FieldCollector, vs...))
+}
+
+// CollectorGT applies the GT predicate on the "collector" field.
+func CollectorGT(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGT(FieldCollector, v))
+}
+
+// CollectorGTE applies the GTE predicate on the "collector" field.
+func CollectorGTE(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGTE(FieldCollector, v))
+}
+
+// CollectorLT applies the LT predicate on the "collector" field.
+func CollectorLT(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLT(FieldCollector, v))
+}
+
+// CollectorLTE applies the LTE predicate on the "collector" field.
+func CollectorLTE(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLTE(FieldCollector, v))
+}
+
+// CollectorContains applies the Contains predicate on the "collector" field.
+func CollectorContains(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldContains(FieldCollector, v))
+}
+
+// CollectorHasPrefix applies the HasPrefix predicate on the "collector" field.
+func CollectorHasPrefix(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldHasPrefix(FieldCollector, v))
+}
+
+// CollectorHasSuffix applies the HasSuffix predicate on the "collector" field.
+func CollectorHasSuffix(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldHasSuffix(FieldCollector, v))
+}
+
+// CollectorEqualFold applies the EqualFold predicate on the "collector" field.
+func CollectorEqualFold(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEqualFold(FieldCollector, v))
+}
+
+// CollectorContainsFold applies the ContainsFold predicate on the "collector" field.
+func CollectorContainsFold(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldContainsFold(FieldCollector, v))
+}
+
+// DocumentRefEQ applies the EQ predicate on the "document_ref" field.
+func DocumentRefEQ(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldDocumentRef, v))
+}
+
+// DocumentRefNEQ applies the NEQ predicate on the "document_ref" field.
+func DocumentRefNEQ(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNEQ(FieldDocumentRef, v))
+}
+
+// DocumentRefIn applies the In predicate on the "document_ref" field.
+func DocumentRefIn(vs ...string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIn(FieldDocumentRef, vs...))
+}
+
+// DocumentRefNotIn applies the NotIn predicate on the "document_ref" field.
+func DocumentRefNotIn(vs ...string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotIn(FieldDocumentRef, vs...))
+}
+
+// DocumentRefGT applies the GT predicate on the "document_ref" field.
+func DocumentRefGT(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGT(FieldDocumentRef, v))
+}
+
+// DocumentRefGTE applies the GTE predicate on the "document_ref" field.
+func DocumentRefGTE(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGTE(FieldDocumentRef, v))
+}
+
+// DocumentRefLT applies the LT predicate on the "document_ref" field.
+func DocumentRefLT(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLT(FieldDocumentRef, v))
+}
+
+// DocumentRefLTE applies the LTE predicate on the "document_ref" field.
+func DocumentRefLTE(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLTE(FieldDocumentRef, v))
+}
+
+// DocumentRefContains applies the Contains predicate on the "document_ref" field.
+func DocumentRefContains(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldContains(FieldDocumentRef, v))
+}
+
+// DocumentRefHasPrefix applies the HasPrefix predicate on the "document_ref" field.
+func DocumentRefHasPrefix(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldHasPrefix(FieldDocumentRef, v))
+}
+
+// DocumentRefHasSuffix applies the HasSuffix predicate on the "document_ref" field.
+func DocumentRefHasSuffix(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldHasSuffix(FieldDocumentRef, v))
+}
+
+// DocumentRefEqualFold applies the EqualFold predicate on the "document_ref" field.
+func DocumentRefEqualFold(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEqualFold(FieldDocumentRef, v))
+}
+
+// DocumentRefContainsFold applies the ContainsFold predicate on the "document_ref" field.
+func DocumentRefContainsFold(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldContainsFold(FieldDocumentRef, v))
+}
+
+// CvssScoreEQ applies the EQ predicate on the "cvss_score" field.
+func CvssScoreEQ(v float64) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldCvssScore, v))
+}
+
+// CvssScoreNEQ applies the NEQ predicate on the "cvss_score" field.
+func CvssScoreNEQ(v float64) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNEQ(FieldCvssScore, v))
+}
+
+// CvssScoreIn applies the In predicate on the "cvss_score" field.
+func CvssScoreIn(vs ...float64) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIn(FieldCvssScore, vs...))
+}
+
+// CvssScoreNotIn applies the NotIn predicate on the "cvss_score" field.
+func CvssScoreNotIn(vs ...float64) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotIn(FieldCvssScore, vs...))
+}
+
+// CvssScoreGT applies the GT predicate on the "cvss_score" field.
+func CvssScoreGT(v float64) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGT(FieldCvssScore, v))
+}
+
+// CvssScoreGTE applies the GTE predicate on the "cvss_score" field.
+func CvssScoreGTE(v float64) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGTE(FieldCvssScore, v))
+}
+
+// CvssScoreLT applies the LT predicate on the "cvss_score" field.
+func CvssScoreLT(v float64) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLT(FieldCvssScore, v))
+}
+
+// CvssScoreLTE applies the LTE predicate on the "cvss_score" field.
+func CvssScoreLTE(v float64) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLTE(FieldCvssScore, v))
+}
+
+// CvssScoreIsNil applies the IsNil predicate on the "cvss_score" field.
+func CvssScoreIsNil() predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIsNull(FieldCvssScore))
+}
+
+// CvssScoreNotNil applies the NotNil predicate on the "cvss_score" field.
+func CvssScoreNotNil() predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotNull(FieldCvssScore))
+}
+
+// RemediationStatusEQ applies the EQ predicate on the "remediation_status" field.
+func RemediationStatusEQ(v RemediationStatus) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldRemediationStatus, v))
+}
+
+// RemediationStatusNEQ applies the NEQ predicate on the "remediation_status" field.
+func RemediationStatusNEQ(v RemediationStatus) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNEQ(FieldRemediationStatus, v))
+}
+
+// RemediationStatusIn applies the In predicate on the "remediation_status" field.
+func RemediationStatusIn(vs ...RemediationStatus) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIn(FieldRemediationStatus, vs...))
+}
+
+// RemediationStatusNotIn applies the NotIn predicate on the "remediation_status" field.
+func RemediationStatusNotIn(vs ...RemediationStatus) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotIn(FieldRemediationStatus, vs...))
+}
+
+// ResolvedByIDEQ applies the EQ predicate on the "resolved_by_id" field.
+func ResolvedByIDEQ(v uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldResolvedByID, v))
+}
+
+// ResolvedByIDNEQ applies the NEQ predicate on the "resolved_by_id" field.
+func ResolvedByIDNEQ(v uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNEQ(FieldResolvedByID, v))
+}
+
+// ResolvedByIDIn applies the In predicate on the "resolved_by_id" field.
+func ResolvedByIDIn(vs ...uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIn(FieldResolvedByID, vs...))
+}
+
+// ResolvedByIDNotIn applies the NotIn predicate on the "resolved_by_id" field.
+func ResolvedByIDNotIn(vs ...uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotIn(FieldResolvedByID, vs...))
+}
+
+// ResolvedByIDGT applies the GT predicate on the "resolved_by_id" field.
+func ResolvedByIDGT(v uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGT(FieldResolvedByID, v))
+}
+
+// ResolvedByIDGTE applies the GTE predicate on the "resolved_by_id" field.
+func ResolvedByIDGTE(v uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGTE(FieldResolvedByID, v))
+}
+
+// ResolvedByIDLT applies the LT predicate on the "resolved_by_id" field.
+func ResolvedByIDLT(v uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLT(FieldResolvedByID, v))
+}
+
+// ResolvedByIDLTE applies the LTE predicate on the "resolved_by_id" field.
+func ResolvedByIDLTE(v uuid.UUID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLTE(FieldResolvedByID, v))
+}
+
+// ResolvedByIDIsNil applies the IsNil predicate on the "resolved_by_id" field.
+func ResolvedByIDIsNil() predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIsNull(FieldResolvedByID))
+}
+
+// ResolvedByIDNotNil applies the NotNil predicate on the "resolved_by_id" field.
+func ResolvedByIDNotNil() predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotNull(FieldResolvedByID))
+}
+
+// ResolvedByTypeEQ applies the EQ predicate on the "resolved_by_type" field.
+func ResolvedByTypeEQ(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEQ(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeNEQ applies the NEQ predicate on the "resolved_by_type" field.
+func ResolvedByTypeNEQ(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNEQ(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeIn applies the In predicate on the "resolved_by_type" field.
+func ResolvedByTypeIn(vs ...string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIn(FieldResolvedByType, vs...))
+}
+
+// ResolvedByTypeNotIn applies the NotIn predicate on the "resolved_by_type" field.
+func ResolvedByTypeNotIn(vs ...string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotIn(FieldResolvedByType, vs...))
+}
+
+// ResolvedByTypeGT applies the GT predicate on the "resolved_by_type" field.
+func ResolvedByTypeGT(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGT(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeGTE applies the GTE predicate on the "resolved_by_type" field.
+func ResolvedByTypeGTE(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldGTE(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeLT applies the LT predicate on the "resolved_by_type" field.
+func ResolvedByTypeLT(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLT(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeLTE applies the LTE predicate on the "resolved_by_type" field.
+func ResolvedByTypeLTE(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldLTE(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeContains applies the Contains predicate on the "resolved_by_type" field.
+func ResolvedByTypeContains(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldContains(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeHasPrefix applies the HasPrefix predicate on the "resolved_by_type" field.
+func ResolvedByTypeHasPrefix(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldHasPrefix(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeHasSuffix applies the HasSuffix predicate on the "resolved_by_type" field.
+func ResolvedByTypeHasSuffix(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldHasSuffix(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeIsNil applies the IsNil predicate on the "resolved_by_type" field.
+func ResolvedByTypeIsNil() predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldIsNull(FieldResolvedByType))
+}
+
+// ResolvedByTypeNotNil applies the NotNil predicate on the "resolved_by_type" field.
+func ResolvedByTypeNotNil() predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldNotNull(FieldResolvedByType))
+}
+
+// ResolvedByTypeEqualFold applies the EqualFold predicate on the "resolved_by_type" field.
+func ResolvedByTypeEqualFold(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldEqualFold(FieldResolvedByType, v))
+}
+
+// ResolvedByTypeContainsFold applies the ContainsFold predicate on the "resolved_by_type" field.
+func ResolvedByTypeContainsFold(v string) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.FieldContainsFold(FieldResolvedByType, v))
+}
+
+// HasVulnerability applies the HasEdge predicate on the "vulnerability" edge.
+func HasVulnerability() predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, VulnerabilityTable, VulnerabilityColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVulnerabilityWith applies the HasEdge predicate on the "vulnerability" edge with a given conditions (other predicates).
+func HasVulnerabilityWith(preds ...predicate.VulnerabilityID) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(func(s *sql.Selector) {
+		step := newVulnerabilityStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPackage applies the HasEdge predicate on the "package" edge.
+func HasPackage() predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PackageTable, PackageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPackageWith applies the HasEdge predicate on the "package" edge with a given conditions (other predicates).
+func HasPackageWith(preds ...predicate.PackageVersion) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(func(s *sql.Selector) {
+		step := newPackageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CertifyVulnArchive) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CertifyVulnArchive) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CertifyVulnArchive) predicate.CertifyVulnArchive {
+	return predicate.CertifyVulnArchive(sql.NotPredicates(p))
+}
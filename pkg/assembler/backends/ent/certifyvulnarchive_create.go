@@ -0,0 +1,1354 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvulnarchive"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+)
+
+// CertifyVulnArchiveCreate is the builder for creating a CertifyVulnArchive entity.
+type CertifyVulnArchiveCreate struct {
+	config
+	mutation *CertifyVulnArchiveMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (cvac *CertifyVulnArchiveCreate) SetVulnerabilityID(u uuid.UUID) *CertifyVulnArchiveCreate {
+	cvac.mutation.SetVulnerabilityID(u)
+	return cvac
+}
+
+// SetPackageID sets the "package_id" field.
+func (cvac *CertifyVulnArchiveCreate) SetPackageID(u uuid.UUID) *CertifyVulnArchiveCreate {
+	cvac.mutation.SetPackageID(u)
+	return cvac
+}
+
+// SetTimeScanned sets the "time_scanned" field.
+func (cvac *CertifyVulnArchiveCreate) SetTimeScanned(t time.Time) *CertifyVulnArchiveCreate {
+	cvac.mutation.SetTimeScanned(t)
+	return cvac
+}
+
+// SetDbURI sets the "db_uri" field.
+func (cvac *CertifyVulnArchiveCreate) SetDbURI(s string) *CertifyVulnArchiveCreate {
+	cvac.mutation.SetDbURI(s)
+	return cvac
+}
+
+// SetDbVersion sets the "db_version" field.
+func (cvac *CertifyVulnArchiveCreate) SetDbVersion(s string) *CertifyVulnArchiveCreate {
+	cvac.mutation.SetDbVersion(s)
+	return cvac
+}
+
+// SetScannerURI sets the "scanner_uri" field.
+func (cvac *CertifyVulnArchiveCreate) SetScannerURI(s string) *CertifyVulnArchiveCreate {
+	cvac.mutation.SetScannerURI(s)
+	return cvac
+}
+
+// SetScannerVersion sets the "scanner_version" field.
+func (cvac *CertifyVulnArchiveCreate) SetScannerVersion(s string) *CertifyVulnArchiveCreate {
+	cvac.mutation.SetScannerVersion(s)
+	return cvac
+}
+
+// SetOrigin sets the "origin" field.
+func (cvac *CertifyVulnArchiveCreate) SetOrigin(s string) *CertifyVulnArchiveCreate {
+	cvac.mutation.SetOrigin(s)
+	return cvac
+}
+
+// SetCollector sets the "collector" field.
+func (cvac *CertifyVulnArchiveCreate) SetCollector(s string) *CertifyVulnArchiveCreate {
+	cvac.mutation.SetCollector(s)
+	return cvac
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (cvac *CertifyVulnArchiveCreate) SetDocumentRef(s string) *CertifyVulnArchiveCreate {
+	cvac.mutation.SetDocumentRef(s)
+	return cvac
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (cvac *CertifyVulnArchiveCreate) SetCvssScore(f float64) *CertifyVulnArchiveCreate {
+	cvac.mutation.SetCvssScore(f)
+	return cvac
+}
+
+// SetNillableCvssScore sets the "cvss_score" field if the given value is not nil.
+func (cvac *CertifyVulnArchiveCreate) SetNillableCvssScore(f *float64) *CertifyVulnArchiveCreate {
+	if f != nil {
+		cvac.SetCvssScore(*f)
+	}
+	return cvac
+}
+
+// SetRemediationStatus sets the "remediation_status" field.
+func (cvac *CertifyVulnArchiveCreate) SetRemediationStatus(cs certifyvulnarchive.RemediationStatus) *CertifyVulnArchiveCreate {
+	cvac.mutation.SetRemediationStatus(cs)
+	return cvac
+}
+
+// SetNillableRemediationStatus sets the "remediation_status" field if the given value is not nil.
+func (cvac *CertifyVulnArchiveCreate) SetNillableRemediationStatus(cs *certifyvulnarchive.RemediationStatus) *CertifyVulnArchiveCreate {
+	if cs != nil {
+		cvac.SetRemediationStatus(*cs)
+	}
+	return cvac
+}
+
+// SetResolvedByID sets the "resolved_by_id" field.
+func (cvac *CertifyVulnArchiveCreate) SetResolvedByID(u uuid.UUID) *CertifyVulnArchiveCreate {
+	cvac.mutation.SetResolvedByID(u)
+	return cvac
+}
+
+// SetNillableResolvedByID sets the "resolved_by_id" field if the given value is not nil.
+func (cvac *CertifyVulnArchiveCreate) SetNillableResolvedByID(u *uuid.UUID) *CertifyVulnArchiveCreate {
+	if u != nil {
+		cvac.SetResolvedByID(*u)
+	}
+	return cvac
+}
+
+// SetResolvedByType sets the "resolved_by_type" field.
+func (cvac *CertifyVulnArchiveCreate) SetResolvedByType(s string) *CertifyVulnArchiveCreate {
+	cvac.mutation.SetResolvedByType(s)
+	return cvac
+}
+
+// SetNillableResolvedByType sets the "resolved_by_type" field if the given value is not nil.
+func (cvac *CertifyVulnArchiveCreate) SetNillableResolvedByType(s *string) *CertifyVulnArchiveCreate {
+	if s != nil {
+		cvac.SetResolvedByType(*s)
+	}
+	return cvac
+}
+
+// SetID sets the "id" field.
+func (cvac *CertifyVulnArchiveCreate) SetID(u uuid.UUID) *CertifyVulnArchiveCreate {
+	cvac.mutation.SetID(u)
+	return cvac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cvac *CertifyVulnArchiveCreate) SetNillableID(u *uuid.UUID) *CertifyVulnArchiveCreate {
+	if u != nil {
+		cvac.SetID(*u)
+	}
+	return cvac
+}
+
+// SetVulnerability sets the "vulnerability" edge to the VulnerabilityID entity.
+func (cvac *CertifyVulnArchiveCreate) SetVulnerability(v *VulnerabilityID) *CertifyVulnArchiveCreate {
+	return cvac.SetVulnerabilityID(v.ID)
+}
+
+// SetPackage sets the "package" edge to the PackageVersion entity.
+func (cvac *CertifyVulnArchiveCreate) SetPackage(p *PackageVersion) *CertifyVulnArchiveCreate {
+	return cvac.SetPackageID(p.ID)
+}
+
+// Mutation returns the CertifyVulnArchiveMutation object of the builder.
+func (cvac *CertifyVulnArchiveCreate) Mutation() *CertifyVulnArchiveMutation {
+	return cvac.mutation
+}
+
+// Save creates the CertifyVulnArchive in the database.
+func (cvac *CertifyVulnArchiveCreate) Save(ctx context.Context) (*CertifyVulnArchive, error) {
+	cvac.defaults()
+	return withHooks(ctx, cvac.sqlSave, cvac.mutation, cvac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cvac *CertifyVulnArchiveCreate) SaveX(ctx context.Context) *CertifyVulnArchive {
+	v, err := cvac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cvac *CertifyVulnArchiveCreate) Exec(ctx context.Context) error {
+	_, err := cvac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvac *CertifyVulnArchiveCreate) ExecX(ctx context.Context) {
+	if err := cvac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cvac *CertifyVulnArchiveCreate) defaults() {
+	if _, ok := cvac.mutation.RemediationStatus(); !ok {
+		v := certifyvulnarchive.DefaultRemediationStatus
+		cvac.mutation.SetRemediationStatus(v)
+	}
+	if _, ok := cvac.mutation.ID(); !ok {
+		v := certifyvulnarchive.DefaultID()
+		cvac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cvac *CertifyVulnArchiveCreate) check() error {
+	if _, ok := cvac.mutation.VulnerabilityID(); !ok {
+		return &ValidationError{Name: "vulnerability_id", err: errors.New(`ent: missing required field "CertifyVulnArchive.vulnerability_id"`)}
+	}
+	if _, ok := cvac.mutation.PackageID(); !ok {
+		return &ValidationError{Name: "package_id", err: errors.New(`ent: missing required field "CertifyVulnArchive.package_id"`)}
+	}
+	if _, ok := cvac.mutation.TimeScanned(); !ok {
+		return &ValidationError{Name: "time_scanned", err: errors.New(`ent: missing required field "CertifyVulnArchive.time_scanned"`)}
+	}
+	if _, ok := cvac.mutation.DbURI(); !ok {
+		return &ValidationError{Name: "db_uri", err: errors.New(`ent: missing required field "CertifyVulnArchive.db_uri"`)}
+	}
+	if _, ok := cvac.mutation.DbVersion(); !ok {
+		return &ValidationError{Name: "db_version", err: errors.New(`ent: missing required field "CertifyVulnArchive.db_version"`)}
+	}
+	if _, ok := cvac.mutation.ScannerURI(); !ok {
+		return &ValidationError{Name: "scanner_uri", err: errors.New(`ent: missing required field "CertifyVulnArchive.scanner_uri"`)}
+	}
+	if _, ok := cvac.mutation.ScannerVersion(); !ok {
+		return &ValidationError{Name: "scanner_version", err: errors.New(`ent: missing required field "CertifyVulnArchive.scanner_version"`)}
+	}
+	if _, ok := cvac.mutation.Origin(); !ok {
+		return &ValidationError{Name: "origin", err: errors.New(`ent: missing required field "CertifyVulnArchive.origin"`)}
+	}
+	if _, ok := cvac.mutation.Collector(); !ok {
+		return &ValidationError{Name: "collector", err: errors.New(`ent: missing required field "CertifyVulnArchive.collector"`)}
+	}
+	if _, ok := cvac.mutation.DocumentRef(); !ok {
+		return &ValidationError{Name: "document_ref", err: errors.New(`ent: missing required field "CertifyVulnArchive.document_ref"`)}
+	}
+	if _, ok := cvac.mutation.RemediationStatus(); !ok {
+		return &ValidationError{Name: "remediation_status", err: errors.New(`ent: missing required field "CertifyVulnArchive.remediation_status"`)}
+	}
+	if v, ok := cvac.mutation.RemediationStatus(); ok {
+		if err := certifyvulnarchive.RemediationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "remediation_status", err: fmt.Errorf(`ent: validator failed for field "CertifyVulnArchive.remediation_status": %w`, err)}
+		}
+	}
+	if _, ok := cvac.mutation.VulnerabilityID(); !ok {
+		return &ValidationError{Name: "vulnerability", err: errors.New(`ent: missing required edge "CertifyVulnArchive.vulnerability"`)}
+	}
+	if _, ok := cvac.mutation.PackageID(); !ok {
+		return &ValidationError{Name: "package", err: errors.New(`ent: missing required edge "CertifyVulnArchive.package"`)}
+	}
+	return nil
+}
+
+func (cvac *CertifyVulnArchiveCreate) sqlSave(ctx context.Context) (*CertifyVulnArchive, error) {
+	if err := cvac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cvac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cvac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cvac.mutation.id = &_node.ID
+	cvac.mutation.done = true
+	return _node, nil
+}
+
+func (cvac *CertifyVulnArchiveCreate) createSpec() (*CertifyVulnArchive, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CertifyVulnArchive{config: cvac.config}
+		_spec = sqlgraph.NewCreateSpec(certifyvulnarchive.Table, sqlgraph.NewFieldSpec(certifyvulnarchive.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = cvac.conflict
+	if id, ok := cvac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cvac.mutation.TimeScanned(); ok {
+		_spec.SetField(certifyvulnarchive.FieldTimeScanned, field.TypeTime, value)
+		_node.TimeScanned = value
+	}
+	if value, ok := cvac.mutation.DbURI(); ok {
+		_spec.SetField(certifyvulnarchive.FieldDbURI, field.TypeString, value)
+		_node.DbURI = value
+	}
+	if value, ok := cvac.mutation.DbVersion(); ok {
+		_spec.SetField(certifyvulnarchive.FieldDbVersion, field.TypeString, value)
+		_node.DbVersion = value
+	}
+	if value, ok := cvac.mutation.ScannerURI(); ok {
+		_spec.SetField(certifyvulnarchive.FieldScannerURI, field.TypeString, value)
+		_node.ScannerURI = value
+	}
+	if value, ok := cvac.mutation.ScannerVersion(); ok {
+		_spec.SetField(certifyvulnarchive.FieldScannerVersion, field.TypeString, value)
+		_node.ScannerVersion = value
+	}
+	if value, ok := cvac.mutation.Origin(); ok {
+		_spec.SetField(certifyvulnarchive.FieldOrigin, field.TypeString, value)
+		_node.Origin = value
+	}
+	if value, ok := cvac.mutation.Collector(); ok {
+		_spec.SetField(certifyvulnarchive.FieldCollector, field.TypeString, value)
+		_node.Collector = value
+	}
+	if value, ok := cvac.mutation.DocumentRef(); ok {
+		_spec.SetField(certifyvulnarchive.FieldDocumentRef, field.TypeString, value)
+		_node.DocumentRef = value
+	}
+	if value, ok := cvac.mutation.CvssScore(); ok {
+		_spec.SetField(certifyvulnarchive.FieldCvssScore, field.TypeFloat64, value)
+		_node.CvssScore = &value
+	}
+	if value, ok := cvac.mutation.RemediationStatus(); ok {
+		_spec.SetField(certifyvulnarchive.FieldRemediationStatus, field.TypeEnum, value)
+		_node.RemediationStatus = value
+	}
+	if value, ok := cvac.mutation.ResolvedByID(); ok {
+		_spec.SetField(certifyvulnarchive.FieldResolvedByID, field.TypeUUID, value)
+		_node.ResolvedByID = &value
+	}
+	if value, ok := cvac.mutation.ResolvedByType(); ok {
+		_spec.SetField(certifyvulnarchive.FieldResolvedByType, field.TypeString, value)
+		_node.ResolvedByType = &value
+	}
+	if nodes := cvac.mutation.VulnerabilityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvulnarchive.VulnerabilityTable,
+			Columns: []string{certifyvulnarchive.VulnerabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VulnerabilityID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cvac.mutation.PackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvulnarchive.PackageTable,
+			Columns: []string{certifyvulnarchive.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PackageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CertifyVulnArchive.Create().
+//		SetVulnerabilityID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CertifyVulnArchiveUpsert) {
+//			SetVulnerabilityID(v+v).
+//		}).
+//		Exec(ctx)
+func (cvac *CertifyVulnArchiveCreate) OnConflict(opts ...sql.ConflictOption) *CertifyVulnArchiveUpsertOne {
+	cvac.conflict = opts
+	return &CertifyVulnArchiveUpsertOne{
+		create: cvac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CertifyVulnArchive.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cvac *CertifyVulnArchiveCreate) OnConflictColumns(columns ...string) *CertifyVulnArchiveUpsertOne {
+	cvac.conflict = append(cvac.conflict, sql.ConflictColumns(columns...))
+	return &CertifyVulnArchiveUpsertOne{
+		create: cvac,
+	}
+}
+
+type (
+	// CertifyVulnArchiveUpsertOne is the builder for "upsert"-ing
+	//  one CertifyVulnArchive node.
+	CertifyVulnArchiveUpsertOne struct {
+		create *CertifyVulnArchiveCreate
+	}
+
+	// CertifyVulnArchiveUpsert is the "OnConflict" setter.
+	CertifyVulnArchiveUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (u *CertifyVulnArchiveUpsert) SetVulnerabilityID(v uuid.UUID) *CertifyVulnArchiveUpsert {
+	u.Set(certifyvulnarchive.FieldVulnerabilityID, v)
+	return u
+}
+
+// UpdateVulnerabilityID sets the "vulnerability_id" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsert) UpdateVulnerabilityID() *CertifyVulnArchiveUpsert {
+	u.SetExcluded(certifyvulnarchive.FieldVulnerabilityID)
+	return u
+}
+
+// SetPackageID sets the "package_id" field.
+func (u *CertifyVulnArchiveUpsert) SetPackageID(v uuid.UUID) *CertifyVulnArchiveUpsert {
+	u.Set(certifyvulnarchive.FieldPackageID, v)
+	return u
+}
+
+// UpdatePackageID sets the "package_id" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsert) UpdatePackageID() *CertifyVulnArchiveUpsert {
+	u.SetExcluded(certifyvulnarchive.FieldPackageID)
+	return u
+}
+
+// SetTimeScanned sets the "time_scanned" field.
+func (u *CertifyVulnArchiveUpsert) SetTimeScanned(v time.Time) *CertifyVulnArchiveUpsert {
+	u.Set(certifyvulnarchive.FieldTimeScanned, v)
+	return u
+}
+
+// UpdateTimeScanned sets the "time_scanned" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsert) UpdateTimeScanned() *CertifyVulnArchiveUpsert {
+	u.SetExcluded(certifyvulnarchive.FieldTimeScanned)
+	return u
+}
+
+// SetDbURI sets the "db_uri" field.
+func (u *CertifyVulnArchiveUpsert) SetDbURI(v string) *CertifyVulnArchiveUpsert {
+	u.Set(certifyvulnarchive.FieldDbURI, v)
+	return u
+}
+
+// UpdateDbURI sets the "db_uri" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsert) UpdateDbURI() *CertifyVulnArchiveUpsert {
+	u.SetExcluded(certifyvulnarchive.FieldDbURI)
+	return u
+}
+
+// SetDbVersion sets the "db_version" field.
+func (u *CertifyVulnArchiveUpsert) SetDbVersion(v string) *CertifyVulnArchiveUpsert {
+	u.Set(certifyvulnarchive.FieldDbVersion, v)
+	return u
+}
+
+// UpdateDbVersion sets the "db_version" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsert) UpdateDbVersion() *CertifyVulnArchiveUpsert {
+	u.SetExcluded(certifyvulnarchive.FieldDbVersion)
+	return u
+}
+
+// SetScannerURI sets the "scanner_uri" field.
+func (u *CertifyVulnArchiveUpsert) SetScannerURI(v string) *CertifyVulnArchiveUpsert {
+	u.Set(certifyvulnarchive.FieldScannerURI, v)
+	return u
+}
+
+// UpdateScannerURI sets the "scanner_uri" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsert) UpdateScannerURI() *CertifyVulnArchiveUpsert {
+	u.SetExcluded(certifyvulnarchive.FieldScannerURI)
+	return u
+}
+
+// SetScannerVersion sets the "scanner_version" field.
+func (u *CertifyVulnArchiveUpsert) SetScannerVersion(v string) *CertifyVulnArchiveUpsert {
+	u.Set(certifyvulnarchive.FieldScannerVersion, v)
+	return u
+}
+
+// UpdateScannerVersion sets the "scanner_version" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsert) UpdateScannerVersion() *CertifyVulnArchiveUpsert {
+	u.SetExcluded(certifyvulnarchive.FieldScannerVersion)
+	return u
+}
+
+// SetOrigin sets the "origin" field.
+func (u *CertifyVulnArchiveUpsert) SetOrigin(v string) *CertifyVulnArchiveUpsert {
+	u.Set(certifyvulnarchive.FieldOrigin, v)
+	return u
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsert) UpdateOrigin() *CertifyVulnArchiveUpsert {
+	u.SetExcluded(certifyvulnarchive.FieldOrigin)
+	return u
+}
+
+// SetCollector sets the "collector" field.
+func (u *CertifyVulnArchiveUpsert) SetCollector(v string) *CertifyVulnArchiveUpsert {
+	u.Set(certifyvulnarchive.FieldCollector, v)
+	return u
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsert) UpdateCollector() *CertifyVulnArchiveUpsert {
+	u.SetExcluded(certifyvulnarchive.FieldCollector)
+	return u
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (u *CertifyVulnArchiveUpsert) SetDocumentRef(v string) *CertifyVulnArchiveUpsert {
+	u.Set(certifyvulnarchive.FieldDocumentRef, v)
+	return u
+}
+
+// UpdateDocumentRef sets the "document_ref" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsert) UpdateDocumentRef() *CertifyVulnArchiveUpsert {
+	u.SetExcluded(certifyvulnarchive.FieldDocumentRef)
+	return u
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (u *CertifyVulnArchiveUpsert) SetCvssScore(v float64) *CertifyVulnArchiveUpsert {
+	u.Set(certifyvulnarchive.FieldCvssScore, v)
+	return u
+}
+
+// UpdateCvssScore sets the "cvss_score" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsert) UpdateCvssScore() *CertifyVulnArchiveUpsert {
+	u.SetExcluded(certifyvulnarchive.FieldCvssScore)
+	return u
+}
+
+// AddCvssScore adds v to the "cvss_score" field.
+func (u *CertifyVulnArchiveUpsert) AddCvssScore(v float64) *CertifyVulnArchiveUpsert {
+	u.Add(certifyvulnarchive.FieldCvssScore, v)
+	return u
+}
+
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (u *CertifyVulnArchiveUpsert) ClearCvssScore() *CertifyVulnArchiveUpsert {
+	u.SetNull(certifyvulnarchive.FieldCvssScore)
+	return u
+}
+
+// SetRemediationStatus sets the "remediation_status" field.
+func (u *CertifyVulnArchiveUpsert) SetRemediationStatus(v certifyvulnarchive.RemediationStatus) *CertifyVulnArchiveUpsert {
+	u.Set(certifyvulnarchive.FieldRemediationStatus, v)
+	return u
+}
+
+// UpdateRemediationStatus sets the "remediation_status" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsert) UpdateRemediationStatus() *CertifyVulnArchiveUpsert {
+	u.SetExcluded(certifyvulnarchive.FieldRemediationStatus)
+	return u
+}
+
+// SetResolvedByID sets the "resolved_by_id" field.
+func (u *CertifyVulnArchiveUpsert) SetResolvedByID(v uuid.UUID) *CertifyVulnArchiveUpsert {
+	u.Set(certifyvulnarchive.FieldResolvedByID, v)
+	return u
+}
+
+// UpdateResolvedByID sets the "resolved_by_id" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsert) UpdateResolvedByID() *CertifyVulnArchiveUpsert {
+	u.SetExcluded(certifyvulnarchive.FieldResolvedByID)
+	return u
+}
+
+// ClearResolvedByID clears the value of the "resolved_by_id" field.
+func (u *CertifyVulnArchiveUpsert) ClearResolvedByID() *CertifyVulnArchiveUpsert {
+	u.SetNull(certifyvulnarchive.FieldResolvedByID)
+	return u
+}
+
+// SetResolvedByType sets the "resolved_by_type" field.
+func (u *CertifyVulnArchiveUpsert) SetResolvedByType(v string) *CertifyVulnArchiveUpsert {
+	u.Set(certifyvulnarchive.FieldResolvedByType, v)
+	return u
+}
+
+// UpdateResolvedByType sets the "resolved_by_type" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsert) UpdateResolvedByType() *CertifyVulnArchiveUpsert {
+	u.SetExcluded(certifyvulnarchive.FieldResolvedByType)
+	return u
+}
+
+// ClearResolvedByType clears the value of the "resolved_by_type" field.
+func (u *CertifyVulnArchiveUpsert) ClearResolvedByType() *CertifyVulnArchiveUpsert {
+	u.SetNull(certifyvulnarchive.FieldResolvedByType)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CertifyVulnArchive.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(certifyvulnarchive.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CertifyVulnArchiveUpsertOne) UpdateNewValues() *CertifyVulnArchiveUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(certifyvulnarchive.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CertifyVulnArchive.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CertifyVulnArchiveUpsertOne) Ignore() *CertifyVulnArchiveUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CertifyVulnArchiveUpsertOne) DoNothing() *CertifyVulnArchiveUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CertifyVulnArchiveCreate.OnConflict
+// documentation for more info.
+func (u *CertifyVulnArchiveUpsertOne) Update(set func(*CertifyVulnArchiveUpsert)) *CertifyVulnArchiveUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CertifyVulnArchiveUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (u *CertifyVulnArchiveUpsertOne) SetVulnerabilityID(v uuid.UUID) *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetVulnerabilityID(v)
+	})
+}
+
+// UpdateVulnerabilityID sets the "vulnerability_id" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertOne) UpdateVulnerabilityID() *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateVulnerabilityID()
+	})
+}
+
+// SetPackageID sets the "package_id" field.
+func (u *CertifyVulnArchiveUpsertOne) SetPackageID(v uuid.UUID) *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetPackageID(v)
+	})
+}
+
+// UpdatePackageID sets the "package_id" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertOne) UpdatePackageID() *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdatePackageID()
+	})
+}
+
+// SetTimeScanned sets the "time_scanned" field.
+func (u *CertifyVulnArchiveUpsertOne) SetTimeScanned(v time.Time) *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetTimeScanned(v)
+	})
+}
+
+// UpdateTimeScanned sets the "time_scanned" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertOne) UpdateTimeScanned() *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateTimeScanned()
+	})
+}
+
+// SetDbURI sets the "db_uri" field.
+func (u *CertifyVulnArchiveUpsertOne) SetDbURI(v string) *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetDbURI(v)
+	})
+}
+
+// UpdateDbURI sets the "db_uri" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertOne) UpdateDbURI() *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateDbURI()
+	})
+}
+
+// SetDbVersion sets the "db_version" field.
+func (u *CertifyVulnArchiveUpsertOne) SetDbVersion(v string) *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetDbVersion(v)
+	})
+}
+
+// UpdateDbVersion sets the "db_version" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertOne) UpdateDbVersion() *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateDbVersion()
+	})
+}
+
+// SetScannerURI sets the "scanner_uri" field.
+func (u *CertifyVulnArchiveUpsertOne) SetScannerURI(v string) *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetScannerURI(v)
+	})
+}
+
+// UpdateScannerURI sets the "scanner_uri" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertOne) UpdateScannerURI() *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateScannerURI()
+	})
+}
+
+// SetScannerVersion sets the "scanner_version" field.
+func (u *CertifyVulnArchiveUpsertOne) SetScannerVersion(v string) *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetScannerVersion(v)
+	})
+}
+
+// UpdateScannerVersion sets the "scanner_version" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertOne) UpdateScannerVersion() *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateScannerVersion()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *CertifyVulnArchiveUpsertOne) SetOrigin(v string) *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertOne) UpdateOrigin() *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *CertifyVulnArchiveUpsertOne) SetCollector(v string) *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertOne) UpdateCollector() *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (u *CertifyVulnArchiveUpsertOne) SetDocumentRef(v string) *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetDocumentRef(v)
+	})
+}
+
+// UpdateDocumentRef sets the "document_ref" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertOne) UpdateDocumentRef() *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateDocumentRef()
+	})
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (u *CertifyVulnArchiveUpsertOne) SetCvssScore(v float64) *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetCvssScore(v)
+	})
+}
+
+// AddCvssScore adds v to the "cvss_score" field.
+func (u *CertifyVulnArchiveUpsertOne) AddCvssScore(v float64) *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.AddCvssScore(v)
+	})
+}
+
+// UpdateCvssScore sets the "cvss_score" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertOne) UpdateCvssScore() *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateCvssScore()
+	})
+}
+
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (u *CertifyVulnArchiveUpsertOne) ClearCvssScore() *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.ClearCvssScore()
+	})
+}
+
+// SetRemediationStatus sets the "remediation_status" field.
+func (u *CertifyVulnArchiveUpsertOne) SetRemediationStatus(v certifyvulnarchive.RemediationStatus) *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetRemediationStatus(v)
+	})
+}
+
+// UpdateRemediationStatus sets the "remediation_status" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertOne) UpdateRemediationStatus() *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateRemediationStatus()
+	})
+}
+
+// SetResolvedByID sets the "resolved_by_id" field.
+func (u *CertifyVulnArchiveUpsertOne) SetResolvedByID(v uuid.UUID) *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetResolvedByID(v)
+	})
+}
+
+// UpdateResolvedByID sets the "resolved_by_id" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertOne) UpdateResolvedByID() *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateResolvedByID()
+	})
+}
+
+// ClearResolvedByID clears the value of the "resolved_by_id" field.
+func (u *CertifyVulnArchiveUpsertOne) ClearResolvedByID() *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.ClearResolvedByID()
+	})
+}
+
+// SetResolvedByType sets the "resolved_by_type" field.
+func (u *CertifyVulnArchiveUpsertOne) SetResolvedByType(v string) *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetResolvedByType(v)
+	})
+}
+
+// UpdateResolvedByType sets the "resolved_by_type" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertOne) UpdateResolvedByType() *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateResolvedByType()
+	})
+}
+
+// ClearResolvedByType clears the value of the "resolved_by_type" field.
+func (u *CertifyVulnArchiveUpsertOne) ClearResolvedByType() *CertifyVulnArchiveUpsertOne {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.ClearResolvedByType()
+	})
+}
+
+// Exec executes the query.
+func (u *CertifyVulnArchiveUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CertifyVulnArchiveCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CertifyVulnArchiveUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CertifyVulnArchiveUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CertifyVulnArchiveUpsertOne.ID is not supported by MySQL driver. Use CertifyVulnArchiveUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CertifyVulnArchiveUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CertifyVulnArchiveCreateBulk is the builder for creating many CertifyVulnArchive entities in bulk.
+type CertifyVulnArchiveCreateBulk struct {
+	config
+	err      error
+	builders []*CertifyVulnArchiveCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CertifyVulnArchive entities in the database.
+func (cvacb *CertifyVulnArchiveCreateBulk) Save(ctx context.Context) ([]*CertifyVulnArchive, error) {
+	if cvacb.err != nil {
+		return nil, cvacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cvacb.builders))
+	nodes := make([]*CertifyVulnArchive, len(cvacb.builders))
+	mutators := make([]Mutator, len(cvacb.builders))
+	for i := range cvacb.builders {
+		func(i int, root context.Context) {
+			builder := cvacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CertifyVulnArchiveMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cvacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cvacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cvacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cvacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cvacb *CertifyVulnArchiveCreateBulk) SaveX(ctx context.Context) []*CertifyVulnArchive {
+	v, err := cvacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cvacb *CertifyVulnArchiveCreateBulk) Exec(ctx context.Context) error {
+	_, err := cvacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvacb *CertifyVulnArchiveCreateBulk) ExecX(ctx context.Context) {
+	if err := cvacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CertifyVulnArchive.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CertifyVulnArchiveUpsert) {
+//			SetVulnerabilityID(v+v).
+//		}).
+//		Exec(ctx)
+func (cvacb *CertifyVulnArchiveCreateBulk) OnConflict(opts ...sql.ConflictOption) *CertifyVulnArchiveUpsertBulk {
+	cvacb.conflict = opts
+	return &CertifyVulnArchiveUpsertBulk{
+		create: cvacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CertifyVulnArchive.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cvacb *CertifyVulnArchiveCreateBulk) OnConflictColumns(columns ...string) *CertifyVulnArchiveUpsertBulk {
+	cvacb.conflict = append(cvacb.conflict, sql.ConflictColumns(columns...))
+	return &CertifyVulnArchiveUpsertBulk{
+		create: cvacb,
+	}
+}
+
+// CertifyVulnArchiveUpsertBulk is the builder for "upsert"-ing
+// a bulk of CertifyVulnArchive nodes.
+type CertifyVulnArchiveUpsertBulk struct {
+	create *CertifyVulnArchiveCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CertifyVulnArchive.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(certifyvulnarchive.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CertifyVulnArchiveUpsertBulk) UpdateNewValues() *CertifyVulnArchiveUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(certifyvulnarchive.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CertifyVulnArchive.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CertifyVulnArchiveUpsertBulk) Ignore() *CertifyVulnArchiveUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CertifyVulnArchiveUpsertBulk) DoNothing() *CertifyVulnArchiveUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CertifyVulnArchiveCreateBulk.OnConflict
+// documentation for more info.
+func (u *CertifyVulnArchiveUpsertBulk) Update(set func(*CertifyVulnArchiveUpsert)) *CertifyVulnArchiveUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CertifyVulnArchiveUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (u *CertifyVulnArchiveUpsertBulk) SetVulnerabilityID(v uuid.UUID) *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetVulnerabilityID(v)
+	})
+}
+
+// UpdateVulnerabilityID sets the "vulnerability_id" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertBulk) UpdateVulnerabilityID() *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateVulnerabilityID()
+	})
+}
+
+// SetPackageID sets the "package_id" field.
+func (u *CertifyVulnArchiveUpsertBulk) SetPackageID(v uuid.UUID) *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetPackageID(v)
+	})
+}
+
+// UpdatePackageID sets the "package_id" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertBulk) UpdatePackageID() *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdatePackageID()
+	})
+}
+
+// SetTimeScanned sets the "time_scanned" field.
+func (u *CertifyVulnArchiveUpsertBulk) SetTimeScanned(v time.Time) *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetTimeScanned(v)
+	})
+}
+
+// UpdateTimeScanned sets the "time_scanned" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertBulk) UpdateTimeScanned() *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateTimeScanned()
+	})
+}
+
+// SetDbURI sets the "db_uri" field.
+func (u *CertifyVulnArchiveUpsertBulk) SetDbURI(v string) *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetDbURI(v)
+	})
+}
+
+// UpdateDbURI sets the "db_uri" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertBulk) UpdateDbURI() *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateDbURI()
+	})
+}
+
+// SetDbVersion sets the "db_version" field.
+func (u *CertifyVulnArchiveUpsertBulk) SetDbVersion(v string) *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetDbVersion(v)
+	})
+}
+
+// UpdateDbVersion sets the "db_version" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertBulk) UpdateDbVersion() *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateDbVersion()
+	})
+}
+
+// SetScannerURI sets the "scanner_uri" field.
+func (u *CertifyVulnArchiveUpsertBulk) SetScannerURI(v string) *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetScannerURI(v)
+	})
+}
+
+// UpdateScannerURI sets the "scanner_uri" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertBulk) UpdateScannerURI() *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateScannerURI()
+	})
+}
+
+// SetScannerVersion sets the "scanner_version" field.
+func (u *CertifyVulnArchiveUpsertBulk) SetScannerVersion(v string) *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetScannerVersion(v)
+	})
+}
+
+// UpdateScannerVersion sets the "scanner_version" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertBulk) UpdateScannerVersion() *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateScannerVersion()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *CertifyVulnArchiveUpsertBulk) SetOrigin(v string) *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertBulk) UpdateOrigin() *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *CertifyVulnArchiveUpsertBulk) SetCollector(v string) *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertBulk) UpdateCollector() *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (u *CertifyVulnArchiveUpsertBulk) SetDocumentRef(v string) *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetDocumentRef(v)
+	})
+}
+
+// UpdateDocumentRef sets the "document_ref" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertBulk) UpdateDocumentRef() *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateDocumentRef()
+	})
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (u *CertifyVulnArchiveUpsertBulk) SetCvssScore(v float64) *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetCvssScore(v)
+	})
+}
+
+// AddCvssScore adds v to the "cvss_score" field.
+func (u *CertifyVulnArchiveUpsertBulk) AddCvssScore(v float64) *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.AddCvssScore(v)
+	})
+}
+
+// UpdateCvssScore sets the "cvss_score" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertBulk) UpdateCvssScore() *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateCvssScore()
+	})
+}
+
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (u *CertifyVulnArchiveUpsertBulk) ClearCvssScore() *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.ClearCvssScore()
+	})
+}
+
+// SetRemediationStatus sets the "remediation_status" field.
+func (u *CertifyVulnArchiveUpsertBulk) SetRemediationStatus(v certifyvulnarchive.RemediationStatus) *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetRemediationStatus(v)
+	})
+}
+
+// UpdateRemediationStatus sets the "remediation_status" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertBulk) UpdateRemediationStatus() *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateRemediationStatus()
+	})
+}
+
+// SetResolvedByID sets the "resolved_by_id" field.
+func (u *CertifyVulnArchiveUpsertBulk) SetResolvedByID(v uuid.UUID) *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetResolvedByID(v)
+	})
+}
+
+// UpdateResolvedByID sets the "resolved_by_id" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertBulk) UpdateResolvedByID() *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateResolvedByID()
+	})
+}
+
+// ClearResolvedByID clears the value of the "resolved_by_id" field.
+func (u *CertifyVulnArchiveUpsertBulk) ClearResolvedByID() *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.ClearResolvedByID()
+	})
+}
+
+// SetResolvedByType sets the "resolved_by_type" field.
+func (u *CertifyVulnArchiveUpsertBulk) SetResolvedByType(v string) *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.SetResolvedByType(v)
+	})
+}
+
+// UpdateResolvedByType sets the "resolved_by_type" field to the value that was provided on create.
+func (u *CertifyVulnArchiveUpsertBulk) UpdateResolvedByType() *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.UpdateResolvedByType()
+	})
+}
+
+// ClearResolvedByType clears the value of the "resolved_by_type" field.
+func (u *CertifyVulnArchiveUpsertBulk) ClearResolvedByType() *CertifyVulnArchiveUpsertBulk {
+	return u.Update(func(s *CertifyVulnArchiveUpsert) {
+		s.ClearResolvedByType()
+	})
+}
+
+// Exec executes the query.
+func (u *CertifyVulnArchiveUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CertifyVulnArchiveCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CertifyVulnArchiveCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CertifyVulnArchiveUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,64 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvulnarchive"
+)
+
+// ArchiveCertifyVulnBatch moves at most limit CertifyVuln rows scanned before
+// the given time to the archive table, oldest first, and returns how many were
+// moved. The rows are deleted and inserted by a single statement, so a batch
+// is moved atomically and only holds its row locks while it runs. Rows locked
+// by concurrent transactions are skipped until the next batch.
+func (c *Client) ArchiveCertifyVulnBatch(ctx context.Context, before time.Time, limit int) (int, error) {
+	driver, ok := c.sqlDriver()
+	if !ok {
+		return 0, fmt.Errorf("connection does not support ArchiveCertifyVulnBatch")
+	}
+
+	// the archive has the same columns, so they are copied one to one
+	columns := strings.Join(certifyvuln.Columns, ", ")
+	query := fmt.Sprintf(`WITH moved AS (
+	DELETE FROM %[1]s
+	WHERE %[2]s IN (
+		SELECT %[2]s FROM %[1]s
+		WHERE %[3]s < $1
+		ORDER BY %[3]s
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING %[4]s
+)
+INSERT INTO %[5]s (%[4]s) SELECT %[4]s FROM moved`,
+		certifyvuln.Table, certifyvuln.FieldID, certifyvuln.FieldTimeScanned, columns, certifyvulnarchive.Table)
+
+	res, err := driver.DB().ExecContext(ctx, query, before, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to archive certifyVulns: %w", err)
+	}
+	moved, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count the archived certifyVulns: %w", err)
+	}
+	return int(moved), nil
+}
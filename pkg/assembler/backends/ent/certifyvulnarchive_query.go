@@ -0,0 +1,694 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvulnarchive"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+)
+
+// CertifyVulnArchiveQuery is the builder for querying CertifyVulnArchive entities.
+type CertifyVulnArchiveQuery struct {
+	config
+	ctx               *QueryContext
+	order             []certifyvulnarchive.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.CertifyVulnArchive
+	withVulnerability *VulnerabilityIDQuery
+	withPackage       *PackageVersionQuery
+	modifiers         []func(*sql.Selector)
+	loadTotal         []func(context.Context, []*CertifyVulnArchive) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CertifyVulnArchiveQuery builder.
+func (cvaq *CertifyVulnArchiveQuery) Where(ps ...predicate.CertifyVulnArchive) *CertifyVulnArchiveQuery {
+	cvaq.predicates = append(cvaq.predicates, ps...)
+	return cvaq
+}
+
+// Limit the number of records to be returned by this query.
+func (cvaq *CertifyVulnArchiveQuery) Limit(limit int) *CertifyVulnArchiveQuery {
+	cvaq.ctx.Limit = &limit
+	return cvaq
+}
+
+// Offset to start from.
+func (cvaq *CertifyVulnArchiveQuery) Offset(offset int) *CertifyVulnArchiveQuery {
+	cvaq.ctx.Offset = &offset
+	return cvaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cvaq *CertifyVulnArchiveQuery) Unique(unique bool) *CertifyVulnArchiveQuery {
+	cvaq.ctx.Unique = &unique
+	return cvaq
+}
+
+// Order specifies how the records should be ordered.
+func (cvaq *CertifyVulnArchiveQuery) Order(o ...certifyvulnarchive.OrderOption) *CertifyVulnArchiveQuery {
+	cvaq.order = append(cvaq.order, o...)
+	return cvaq
+}
+
+// QueryVulnerability chains the current query on the "vulnerability" edge.
+func (cvaq *CertifyVulnArchiveQuery) QueryVulnerability() *VulnerabilityIDQuery {
+	query := (&VulnerabilityIDClient{config: cvaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cvaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cvaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifyvulnarchive.Table, certifyvulnarchive.FieldID, selector),
+			sqlgraph.To(vulnerabilityid.Table, vulnerabilityid.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifyvulnarchive.VulnerabilityTable, certifyvulnarchive.VulnerabilityColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cvaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPackage chains the current query on the "package" edge.
+func (cvaq *CertifyVulnArchiveQuery) QueryPackage() *PackageVersionQuery {
+	query := (&PackageVersionClient{config: cvaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cvaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cvaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifyvulnarchive.Table, certifyvulnarchive.FieldID, selector),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifyvulnarchive.PackageTable, certifyvulnarchive.PackageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cvaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CertifyVulnArchive entity from the query.
+// Returns a *NotFoundError when no CertifyVulnArchive was found.
+func (cvaq *CertifyVulnArchiveQuery) First(ctx context.Context) (*CertifyVulnArchive, error) {
+	nodes, err := cvaq.Limit(1).All(setContextOp(ctx, cvaq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{certifyvulnarchive.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cvaq *CertifyVulnArchiveQuery) FirstX(ctx context.Context) *CertifyVulnArchive {
+	node, err := cvaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CertifyVulnArchive ID from the query.
+// Returns a *NotFoundError when no CertifyVulnArchive ID was found.
+func (cvaq *CertifyVulnArchiveQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cvaq.Limit(1).IDs(setContextOp(ctx, cvaq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{certifyvulnarchive.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cvaq *CertifyVulnArchiveQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := cvaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CertifyVulnArchive entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CertifyVulnArchive entity is found.
+// Returns a *NotFoundError when no CertifyVulnArchive entities are found.
+func (cvaq *CertifyVulnArchiveQuery) Only(ctx context.Context) (*CertifyVulnArchive, error) {
+	nodes, err := cvaq.Limit(2).All(setContextOp(ctx, cvaq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{certifyvulnarchive.Label}
+	default:
+		return nil, &NotSingularError{certifyvulnarchive.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cvaq *CertifyVulnArchiveQuery) OnlyX(ctx context.Context) *CertifyVulnArchive {
+	node, err := cvaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CertifyVulnArchive ID in the query.
+// Returns a *NotSingularError when more than one CertifyVulnArchive ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cvaq *CertifyVulnArchiveQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cvaq.Limit(2).IDs(setContextOp(ctx, cvaq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{certifyvulnarchive.Label}
+	default:
+		err = &NotSingularError{certifyvulnarchive.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cvaq *CertifyVulnArchiveQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := cvaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CertifyVulnArchives.
+func (cvaq *CertifyVulnArchiveQuery) All(ctx context.Context) ([]*CertifyVulnArchive, error) {
+	ctx = setContextOp(ctx, cvaq.ctx, "All")
+	if err := cvaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CertifyVulnArchive, *CertifyVulnArchiveQuery]()
+	return withInterceptors[[]*CertifyVulnArchive](ctx, cvaq, qr, cvaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cvaq *CertifyVulnArchiveQuery) AllX(ctx context.Context) []*CertifyVulnArchive {
+	nodes, err := cvaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CertifyVulnArchive IDs.
+func (cvaq *CertifyVulnArchiveQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if cvaq.ctx.Unique == nil && cvaq.path != nil {
+		cvaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cvaq.ctx, "IDs")
+	if err = cvaq.Select(certifyvulnarchive.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cvaq *CertifyVulnArchiveQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := cvaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cvaq *CertifyVulnArchiveQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cvaq.ctx, "Count")
+	if err := cvaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cvaq, querierCount[*CertifyVulnArchiveQuery](), cvaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cvaq *CertifyVulnArchiveQuery) CountX(ctx context.Context) int {
+	count, err := cvaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cvaq *CertifyVulnArchiveQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cvaq.ctx, "Exist")
+	switch _, err := cvaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cvaq *CertifyVulnArchiveQuery) ExistX(ctx context.Context) bool {
+	exist, err := cvaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CertifyVulnArchiveQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cvaq *CertifyVulnArchiveQuery) Clone() *CertifyVulnArchiveQuery {
+	if cvaq == nil {
+		return nil
+	}
+	return &CertifyVulnArchiveQuery{
+		config:            cvaq.config,
+		ctx:               cvaq.ctx.Clone(),
+		order:             append([]certifyvulnarchive.OrderOption{}, cvaq.order...),
+		inters:            append([]Interceptor{}, cvaq.inters...),
+		predicates:        append([]predicate.CertifyVulnArchive{}, cvaq.predicates...),
+		withVulnerability: cvaq.withVulnerability.Clone(),
+		withPackage:       cvaq.withPackage.Clone(),
+		// clone intermediate query.
+		sql:  cvaq.sql.Clone(),
+		path: cvaq.path,
+	}
+}
+
+// WithVulnerability tells the query-builder to eager-load the nodes that are connected to
+// the "vulnerability" edge. The optional arguments are used to configure the query builder of the edge.
+func (cvaq *CertifyVulnArchiveQuery) WithVulnerability(opts ...func(*VulnerabilityIDQuery)) *CertifyVulnArchiveQuery {
+	query := (&VulnerabilityIDClient{config: cvaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cvaq.withVulnerability = query
+	return cvaq
+}
+
+// WithPackage tells the query-builder to eager-load the nodes that are connected to
+// the "package" edge. The optional arguments are used to configure the query builder of the edge.
+func (cvaq *CertifyVulnArchiveQuery) WithPackage(opts ...func(*PackageVersionQuery)) *CertifyVulnArchiveQuery {
+	query := (&PackageVersionClient{config: cvaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cvaq.withPackage = query
+	return cvaq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		VulnerabilityID uuid.UUID `json:"vulnerability_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CertifyVulnArchive.Query().
+//		GroupBy(certifyvulnarchive.FieldVulnerabilityID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cvaq *CertifyVulnArchiveQuery) GroupBy(field string, fields ...string) *CertifyVulnArchiveGroupBy {
+	cvaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CertifyVulnArchiveGroupBy{build: cvaq}
+	grbuild.flds = &cvaq.ctx.Fields
+	grbuild.label = certifyvulnarchive.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		VulnerabilityID uuid.UUID `json:"vulnerability_id,omitempty"`
+//	}
+//
+//	client.CertifyVulnArchive.Query().
+//		Select(certifyvulnarchive.FieldVulnerabilityID).
+//		Scan(ctx, &v)
+func (cvaq *CertifyVulnArchiveQuery) Select(fields ...string) *CertifyVulnArchiveSelect {
+	cvaq.ctx.Fields = append(cvaq.ctx.Fields, fields...)
+	sbuild := &CertifyVulnArchiveSelect{CertifyVulnArchiveQuery: cvaq}
+	sbuild.label = certifyvulnarchive.Label
+	sbuild.flds, sbuild.scan = &cvaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CertifyVulnArchiveSelect configured with the given aggregations.
+func (cvaq *CertifyVulnArchiveQuery) Aggregate(fns ...AggregateFunc) *CertifyVulnArchiveSelect {
+	return cvaq.Select().Aggregate(fns...)
+}
+
+func (cvaq *CertifyVulnArchiveQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cvaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cvaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cvaq.ctx.Fields {
+		if !certifyvulnarchive.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cvaq.path != nil {
+		prev, err := cvaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cvaq.sql = prev
+	}
+	return nil
+}
+
+func (cvaq *CertifyVulnArchiveQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CertifyVulnArchive, error) {
+	var (
+		nodes       = []*CertifyVulnArchive{}
+		_spec       = cvaq.querySpec()
+		loadedTypes = [2]bool{
+			cvaq.withVulnerability != nil,
+			cvaq.withPackage != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CertifyVulnArchive).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CertifyVulnArchive{config: cvaq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(cvaq.modifiers) > 0 {
+		_spec.Modifiers = cvaq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cvaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cvaq.withVulnerability; query != nil {
+		if err := cvaq.loadVulnerability(ctx, query, nodes, nil,
+			func(n *CertifyVulnArchive, e *VulnerabilityID) { n.Edges.Vulnerability = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cvaq.withPackage; query != nil {
+		if err := cvaq.loadPackage(ctx, query, nodes, nil,
+			func(n *CertifyVulnArchive, e *PackageVersion) { n.Edges.Package = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range cvaq.loadTotal {
+		if err := cvaq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cvaq *CertifyVulnArchiveQuery) loadVulnerability(ctx context.Context, query *VulnerabilityIDQuery, nodes []*CertifyVulnArchive, init func(*CertifyVulnArchive), assign func(*CertifyVulnArchive, *VulnerabilityID)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*CertifyVulnArchive)
+	for i := range nodes {
+		fk := nodes[i].VulnerabilityID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(vulnerabilityid.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "vulnerability_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cvaq *CertifyVulnArchiveQuery) loadPackage(ctx context.Context, query *PackageVersionQuery, nodes []*CertifyVulnArchive, init func(*CertifyVulnArchive), assign func(*CertifyVulnArchive, *PackageVersion)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*CertifyVulnArchive)
+	for i := range nodes {
+		fk := nodes[i].PackageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(packageversion.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "package_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cvaq *CertifyVulnArchiveQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cvaq.querySpec()
+	if len(cvaq.modifiers) > 0 {
+		_spec.Modifiers = cvaq.modifiers
+	}
+	_spec.Node.Columns = cvaq.ctx.Fields
+	if len(cvaq.ctx.Fields) > 0 {
+		_spec.Unique = cvaq.ctx.Unique != nil && *cvaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cvaq.driver, _spec)
+}
+
+func (cvaq *CertifyVulnArchiveQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(certifyvulnarchive.Table, certifyvulnarchive.Columns, sqlgraph.NewFieldSpec(certifyvulnarchive.FieldID, field.TypeUUID))
+	_spec.From = cvaq.sql
+	if unique := cvaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cvaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cvaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, certifyvulnarchive.FieldID)
+		for i := range fields {
+			if fields[i] != certifyvulnarchive.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if cvaq.withVulnerability != nil {
+			_spec.Node.AddColumnOnce(certifyvulnarchive.FieldVulnerabilityID)
+		}
+		if cvaq.withPackage != nil {
+			_spec.Node.AddColumnOnce(certifyvulnarchive.FieldPackageID)
+		}
+	}
+	if ps := cvaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cvaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cvaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cvaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cvaq *CertifyVulnArchiveQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cvaq.driver.Dialect())
+	t1 := builder.Table(certifyvulnarchive.Table)
+	columns := cvaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = certifyvulnarchive.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cvaq.sql != nil {
+		selector = cvaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cvaq.ctx.Unique != nil && *cvaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cvaq.predicates {
+		p(selector)
+	}
+	for _, p := range cvaq.order {
+		p(selector)
+	}
+	if offset := cvaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cvaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CertifyVulnArchiveGroupBy is the group-by builder for CertifyVulnArchive entities.
+type CertifyVulnArchiveGroupBy struct {
+	selector
+	build *CertifyVulnArchiveQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cvagb *CertifyVulnArchiveGroupBy) Aggregate(fns ...AggregateFunc) *CertifyVulnArchiveGroupBy {
+	cvagb.fns = append(cvagb.fns, fns...)
+	return cvagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cvagb *CertifyVulnArchiveGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cvagb.build.ctx, "GroupBy")
+	if err := cvagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CertifyVulnArchiveQuery, *CertifyVulnArchiveGroupBy](ctx, cvagb.build, cvagb, cvagb.build.inters, v)
+}
+
+func (cvagb *CertifyVulnArchiveGroupBy) sqlScan(ctx context.Context, root *CertifyVulnArchiveQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cvagb.fns))
+	for _, fn := range cvagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cvagb.flds)+len(cvagb.fns))
+		for _, f := range *cvagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cvagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cvagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CertifyVulnArchiveSelect is the builder for selecting fields of CertifyVulnArchive entities.
+type CertifyVulnArchiveSelect struct {
+	*CertifyVulnArchiveQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cvas *CertifyVulnArchiveSelect) Aggregate(fns ...AggregateFunc) *CertifyVulnArchiveSelect {
+	cvas.fns = append(cvas.fns, fns...)
+	return cvas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cvas *CertifyVulnArchiveSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cvas.ctx, "Select")
+	if err := cvas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CertifyVulnArchiveQuery, *CertifyVulnArchiveSelect](ctx, cvas.CertifyVulnArchiveQuery, cvas, cvas.inters, v)
+}
+
+func (cvas *CertifyVulnArchiveSelect) sqlScan(ctx context.Context, root *CertifyVulnArchiveQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cvas.fns))
+	for _, fn := range cvas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cvas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cvas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,915 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvulnarchive"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+)
+
+// CertifyVulnArchiveUpdate is the builder for updating CertifyVulnArchive entities.
+type CertifyVulnArchiveUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CertifyVulnArchiveMutation
+}
+
+// Where appends a list predicates to the CertifyVulnArchiveUpdate builder.
+func (cvau *CertifyVulnArchiveUpdate) Where(ps ...predicate.CertifyVulnArchive) *CertifyVulnArchiveUpdate {
+	cvau.mutation.Where(ps...)
+	return cvau
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (cvau *CertifyVulnArchiveUpdate) SetVulnerabilityID(u uuid.UUID) *CertifyVulnArchiveUpdate {
+	cvau.mutation.SetVulnerabilityID(u)
+	return cvau
+}
+
+// SetNillableVulnerabilityID sets the "vulnerability_id" field if the given value is not nil.
+func (cvau *CertifyVulnArchiveUpdate) SetNillableVulnerabilityID(u *uuid.UUID) *CertifyVulnArchiveUpdate {
+	if u != nil {
+		cvau.SetVulnerabilityID(*u)
+	}
+	return cvau
+}
+
+// SetPackageID sets the "package_id" field.
+func (cvau *CertifyVulnArchiveUpdate) SetPackageID(u uuid.UUID) *CertifyVulnArchiveUpdate {
+	cvau.mutation.SetPackageID(u)
+	return cvau
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (cvau *CertifyVulnArchiveUpdate) SetNillablePackageID(u *uuid.UUID) *CertifyVulnArchiveUpdate {
+	if u != nil {
+		cvau.SetPackageID(*u)
+	}
+	return cvau
+}
+
+// SetTimeScanned sets the "time_scanned" field.
+func (cvau *CertifyVulnArchiveUpdate) SetTimeScanned(t time.Time) *CertifyVulnArchiveUpdate {
+	cvau.mutation.SetTimeScanned(t)
+	return cvau
+}
+
+// SetNillableTimeScanned sets the "time_scanned" field if the given value is not nil.
+func (cvau *CertifyVulnArchiveUpdate) SetNillableTimeScanned(t *time.Time) *CertifyVulnArchiveUpdate {
+	if t != nil {
+		cvau.SetTimeScanned(*t)
+	}
+	return cvau
+}
+
+// SetDbURI sets the "db_uri" field.
+func (cvau *CertifyVulnArchiveUpdate) SetDbURI(s string) *CertifyVulnArchiveUpdate {
+	cvau.mutation.SetDbURI(s)
+	return cvau
+}
+
+// SetNillableDbURI sets the "db_uri" field if the given value is not nil.
+func (cvau *CertifyVulnArchiveUpdate) SetNillableDbURI(s *string) *CertifyVulnArchiveUpdate {
+	if s != nil {
+		cvau.SetDbURI(*s)
+	}
+	return cvau
+}
+
+// SetDbVersion sets the "db_version" field.
+func (cvau *CertifyVulnArchiveUpdate) SetDbVersion(s string) *CertifyVulnArchiveUpdate {
+	cvau.mutation.SetDbVersion(s)
+	return cvau
+}
+
+// SetNillableDbVersion sets the "db_version" field if the given value is not nil.
+func (cvau *CertifyVulnArchiveUpdate) SetNillableDbVersion(s *string) *CertifyVulnArchiveUpdate {
+	if s != nil {
+		cvau.SetDbVersion(*s)
+	}
+	return cvau
+}
+
+// SetScannerURI sets the "scanner_uri" field.
+func (cvau *CertifyVulnArchiveUpdate) SetScannerURI(s string) *CertifyVulnArchiveUpdate {
+	cvau.mutation.SetScannerURI(s)
+	return cvau
+}
+
+// SetNillableScannerURI sets the "scanner_uri" field if the given value is not nil.
+func (cvau *CertifyVulnArchiveUpdate) SetNillableScannerURI(s *string) *CertifyVulnArchiveUpdate {
+	if s != nil {
+		cvau.SetScannerURI(*s)
+	}
+	return cvau
+}
+
+// SetScannerVersion sets the "scanner_version" field.
+func (cvau *CertifyVulnArchiveUpdate) SetScannerVersion(s string) *CertifyVulnArchiveUpdate {
+	cvau.mutation.SetScannerVersion(s)
+	return cvau
+}
+
+// SetNillableScannerVersion sets the "scanner_version" field if the given value is not nil.
+func (cvau *CertifyVulnArchiveUpdate) SetNillableScannerVersion(s *string) *CertifyVulnArchiveUpdate {
+	if s != nil {
+		cvau.SetScannerVersion(*s)
+	}
+	return cvau
+}
+
+// SetOrigin sets the "origin" field.
+func (cvau *CertifyVulnArchiveUpdate) SetOrigin(s string) *CertifyVulnArchiveUpdate {
+	cvau.mutation.SetOrigin(s)
+	return cvau
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (cvau *CertifyVulnArchiveUpdate) SetNillableOrigin(s *string) *CertifyVulnArchiveUpdate {
+	if s != nil {
+		cvau.SetOrigin(*s)
+	}
+	return cvau
+}
+
+// SetCollector sets the "collector" field.
+func (cvau *CertifyVulnArchiveUpdate) SetCollector(s string) *CertifyVulnArchiveUpdate {
+	cvau.mutation.SetCollector(s)
+	return cvau
+}
+
+// SetNillableCollector sets the "collector" field if the given value is not nil.
+func (cvau *CertifyVulnArchiveUpdate) SetNillableCollector(s *string) *CertifyVulnArchiveUpdate {
+	if s != nil {
+		cvau.SetCollector(*s)
+	}
+	return cvau
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (cvau *CertifyVulnArchiveUpdate) SetDocumentRef(s string) *CertifyVulnArchiveUpdate {
+	cvau.mutation.SetDocumentRef(s)
+	return cvau
+}
+
+// SetNillableDocumentRef sets the "document_ref" field if the given value is not nil.
+func (cvau *CertifyVulnArchiveUpdate) SetNillableDocumentRef(s *string) *CertifyVulnArchiveUpdate {
+	if s != nil {
+		cvau.SetDocumentRef(*s)
+	}
+	return cvau
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (cvau *CertifyVulnArchiveUpdate) SetCvssScore(f float64) *CertifyVulnArchiveUpdate {
+	cvau.mutation.ResetCvssScore()
+	cvau.mutation.SetCvssScore(f)
+	return cvau
+}
+
+// SetNillableCvssScore sets the "cvss_score" field if the given value is not nil.
+func (cvau *CertifyVulnArchiveUpdate) SetNillableCvssScore(f *float64) *CertifyVulnArchiveUpdate {
+	if f != nil {
+		cvau.SetCvssScore(*f)
+	}
+	return cvau
+}
+
+// AddCvssScore adds f to the "cvss_score" field.
+func (cvau *CertifyVulnArchiveUpdate) AddCvssScore(f float64) *CertifyVulnArchiveUpdate {
+	cvau.mutation.AddCvssScore(f)
+	return cvau
+}
+
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (cvau *CertifyVulnArchiveUpdate) ClearCvssScore() *CertifyVulnArchiveUpdate {
+	cvau.mutation.ClearCvssScore()
+	return cvau
+}
+
+// SetRemediationStatus sets the "remediation_status" field.
+func (cvau *CertifyVulnArchiveUpdate) SetRemediationStatus(cs certifyvulnarchive.RemediationStatus) *CertifyVulnArchiveUpdate {
+	cvau.mutation.SetRemediationStatus(cs)
+	return cvau
+}
+
+// SetNillableRemediationStatus sets the "remediation_status" field if the given value is not nil.
+func (cvau *CertifyVulnArchiveUpdate) SetNillableRemediationStatus(cs *certifyvulnarchive.RemediationStatus) *CertifyVulnArchiveUpdate {
+	if cs != nil {
+		cvau.SetRemediationStatus(*cs)
+	}
+	return cvau
+}
+
+// SetResolvedByID sets the "resolved_by_id" field.
+func (cvau *CertifyVulnArchiveUpdate) SetResolvedByID(u uuid.UUID) *CertifyVulnArchiveUpdate {
+	cvau.mutation.SetResolvedByID(u)
+	return cvau
+}
+
+// SetNillableResolvedByID sets the "resolved_by_id" field if the given value is not nil.
+func (cvau *CertifyVulnArchiveUpdate) SetNillableResolvedByID(u *uuid.UUID) *CertifyVulnArchiveUpdate {
+	if u != nil {
+		cvau.SetResolvedByID(*u)
+	}
+	return cvau
+}
+
+// ClearResolvedByID clears the value of the "resolved_by_id" field.
+func (cvau *CertifyVulnArchiveUpdate) ClearResolvedByID() *CertifyVulnArchiveUpdate {
+	cvau.mutation.ClearResolvedByID()
+	return cvau
+}
+
+// SetResolvedByType sets the "resolved_by_type" field.
+func (cvau *CertifyVulnArchiveUpdate) SetResolvedByType(s string) *CertifyVulnArchiveUpdate {
+	cvau.mutation.SetResolvedByType(s)
+	return cvau
+}
+
+// SetNillableResolvedByType sets the "resolved_by_type" field if the given value is not nil.
+func (cvau *CertifyVulnArchiveUpdate) SetNillableResolvedByType(s *string) *CertifyVulnArchiveUpdate {
+	if s != nil {
+		cvau.SetResolvedByType(*s)
+	}
+	return cvau
+}
+
+// ClearResolvedByType clears the value of the "resolved_by_type" field.
+func (cvau *CertifyVulnArchiveUpdate) ClearResolvedByType() *CertifyVulnArchiveUpdate {
+	cvau.mutation.ClearResolvedByType()
+	return cvau
+}
+
+// SetVulnerability sets the "vulnerability" edge to the VulnerabilityID entity.
+func (cvau *CertifyVulnArchiveUpdate) SetVulnerability(v *VulnerabilityID) *CertifyVulnArchiveUpdate {
+	return cvau.SetVulnerabilityID(v.ID)
+}
+
+// SetPackage sets the "package" edge to the PackageVersion entity.
+func (cvau *CertifyVulnArchiveUpdate) SetPackage(p *PackageVersion) *CertifyVulnArchiveUpdate {
+	return cvau.SetPackageID(p.ID)
+}
+
+// Mutation returns the CertifyVulnArchiveMutation object of the builder.
+func (cvau *CertifyVulnArchiveUpdate) Mutation() *CertifyVulnArchiveMutation {
+	return cvau.mutation
+}
+
+// ClearVulnerability clears the "vulnerability" edge to the VulnerabilityID entity.
+func (cvau *CertifyVulnArchiveUpdate) ClearVulnerability() *CertifyVulnArchiveUpdate {
+	cvau.mutation.ClearVulnerability()
+	return cvau
+}
+
+// ClearPackage clears the "package" edge to the PackageVersion entity.
+func (cvau *CertifyVulnArchiveUpdate) ClearPackage() *CertifyVulnArchiveUpdate {
+	cvau.mutation.ClearPackage()
+	return cvau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cvau *CertifyVulnArchiveUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cvau.sqlSave, cvau.mutation, cvau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cvau *CertifyVulnArchiveUpdate) SaveX(ctx context.Context) int {
+	affected, err := cvau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cvau *CertifyVulnArchiveUpdate) Exec(ctx context.Context) error {
+	_, err := cvau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvau *CertifyVulnArchiveUpdate) ExecX(ctx context.Context) {
+	if err := cvau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cvau *CertifyVulnArchiveUpdate) check() error {
+	if v, ok := cvau.mutation.RemediationStatus(); ok {
+		if err := certifyvulnarchive.RemediationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "remediation_status", err: fmt.Errorf(`ent: validator failed for field "CertifyVulnArchive.remediation_status": %w`, err)}
+		}
+	}
+	if _, ok := cvau.mutation.VulnerabilityID(); cvau.mutation.VulnerabilityCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CertifyVulnArchive.vulnerability"`)
+	}
+	if _, ok := cvau.mutation.PackageID(); cvau.mutation.PackageCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CertifyVulnArchive.package"`)
+	}
+	return nil
+}
+
+func (cvau *CertifyVulnArchiveUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cvau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(certifyvulnarchive.Table, certifyvulnarchive.Columns, sqlgraph.NewFieldSpec(certifyvulnarchive.FieldID, field.TypeUUID))
+	if ps := cvau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cvau.mutation.TimeScanned(); ok {
+		_spec.SetField(certifyvulnarchive.FieldTimeScanned, field.TypeTime, value)
+	}
+	if value, ok := cvau.mutation.DbURI(); ok {
+		_spec.SetField(certifyvulnarchive.FieldDbURI, field.TypeString, value)
+	}
+	if value, ok := cvau.mutation.DbVersion(); ok {
+		_spec.SetField(certifyvulnarchive.FieldDbVersion, field.TypeString, value)
+	}
+	if value, ok := cvau.mutation.ScannerURI(); ok {
+		_spec.SetField(certifyvulnarchive.FieldScannerURI, field.TypeString, value)
+	}
+	if value, ok := cvau.mutation.ScannerVersion(); ok {
+		_spec.SetField(certifyvulnarchive.FieldScannerVersion, field.TypeString, value)
+	}
+	if value, ok := cvau.mutation.Origin(); ok {
+		_spec.SetField(certifyvulnarchive.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := cvau.mutation.Collector(); ok {
+		_spec.SetField(certifyvulnarchive.FieldCollector, field.TypeString, value)
+	}
+	if value, ok := cvau.mutation.DocumentRef(); ok {
+		_spec.SetField(certifyvulnarchive.FieldDocumentRef, field.TypeString, value)
+	}
+	if value, ok := cvau.mutation.CvssScore(); ok {
+		_spec.SetField(certifyvulnarchive.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if value, ok := cvau.mutation.AddedCvssScore(); ok {
+		_spec.AddField(certifyvulnarchive.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if cvau.mutation.CvssScoreCleared() {
+		_spec.ClearField(certifyvulnarchive.FieldCvssScore, field.TypeFloat64)
+	}
+	if value, ok := cvau.mutation.RemediationStatus(); ok {
+		_spec.SetField(certifyvulnarchive.FieldRemediationStatus, field.TypeEnum, value)
+	}
+	if value, ok := cvau.mutation.ResolvedByID(); ok {
+		_spec.SetField(certifyvulnarchive.FieldResolvedByID, field.TypeUUID, value)
+	}
+	if cvau.mutation.ResolvedByIDCleared() {
+		_spec.ClearField(certifyvulnarchive.FieldResolvedByID, field.TypeUUID)
+	}
+	if value, ok := cvau.mutation.ResolvedByType(); ok {
+		_spec.SetField(certifyvulnarchive.FieldResolvedByType, field.TypeString, value)
+	}
+	if cvau.mutation.ResolvedByTypeCleared() {
+		_spec.ClearField(certifyvulnarchive.FieldResolvedByType, field.TypeString)
+	}
+	if cvau.mutation.VulnerabilityCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvulnarchive.VulnerabilityTable,
+			Columns: []string{certifyvulnarchive.VulnerabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cvau.mutation.VulnerabilityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvulnarchive.VulnerabilityTable,
+			Columns: []string{certifyvulnarchive.VulnerabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cvau.mutation.PackageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvulnarchive.PackageTable,
+			Columns: []string{certifyvulnarchive.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cvau.mutation.PackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvulnarchive.PackageTable,
+			Columns: []string{certifyvulnarchive.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cvau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{certifyvulnarchive.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cvau.mutation.done = true
+	return n, nil
+}
+
+// CertifyVulnArchiveUpdateOne is the builder for updating a single CertifyVulnArchive entity.
+type CertifyVulnArchiveUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CertifyVulnArchiveMutation
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetVulnerabilityID(u uuid.UUID) *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.SetVulnerabilityID(u)
+	return cvauo
+}
+
+// SetNillableVulnerabilityID sets the "vulnerability_id" field if the given value is not nil.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetNillableVulnerabilityID(u *uuid.UUID) *CertifyVulnArchiveUpdateOne {
+	if u != nil {
+		cvauo.SetVulnerabilityID(*u)
+	}
+	return cvauo
+}
+
+// SetPackageID sets the "package_id" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetPackageID(u uuid.UUID) *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.SetPackageID(u)
+	return cvauo
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetNillablePackageID(u *uuid.UUID) *CertifyVulnArchiveUpdateOne {
+	if u != nil {
+		cvauo.SetPackageID(*u)
+	}
+	return cvauo
+}
+
+// SetTimeScanned sets the "time_scanned" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetTimeScanned(t time.Time) *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.SetTimeScanned(t)
+	return cvauo
+}
+
+// SetNillableTimeScanned sets the "time_scanned" field if the given value is not nil.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetNillableTimeScanned(t *time.Time) *CertifyVulnArchiveUpdateOne {
+	if t != nil {
+		cvauo.SetTimeScanned(*t)
+	}
+	return cvauo
+}
+
+// SetDbURI sets the "db_uri" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetDbURI(s string) *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.SetDbURI(s)
+	return cvauo
+}
+
+// SetNillableDbURI sets the "db_uri" field if the given value is not nil.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetNillableDbURI(s *string) *CertifyVulnArchiveUpdateOne {
+	if s != nil {
+		cvauo.SetDbURI(*s)
+	}
+	return cvauo
+}
+
+// SetDbVersion sets the "db_version" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetDbVersion(s string) *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.SetDbVersion(s)
+	return cvauo
+}
+
+// SetNillableDbVersion sets the "db_version" field if the given value is not nil.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetNillableDbVersion(s *string) *CertifyVulnArchiveUpdateOne {
+	if s != nil {
+		cvauo.SetDbVersion(*s)
+	}
+	return cvauo
+}
+
+// SetScannerURI sets the "scanner_uri" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetScannerURI(s string) *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.SetScannerURI(s)
+	return cvauo
+}
+
+// SetNillableScannerURI sets the "scanner_uri" field if the given value is not nil.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetNillableScannerURI(s *string) *CertifyVulnArchiveUpdateOne {
+	if s != nil {
+		cvauo.SetScannerURI(*s)
+	}
+	return cvauo
+}
+
+// SetScannerVersion sets the "scanner_version" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetScannerVersion(s string) *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.SetScannerVersion(s)
+	return cvauo
+}
+
+// SetNillableScannerVersion sets the "scanner_version" field if the given value is not nil.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetNillableScannerVersion(s *string) *CertifyVulnArchiveUpdateOne {
+	if s != nil {
+		cvauo.SetScannerVersion(*s)
+	}
+	return cvauo
+}
+
+// SetOrigin sets the "origin" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetOrigin(s string) *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.SetOrigin(s)
+	return cvauo
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetNillableOrigin(s *string) *CertifyVulnArchiveUpdateOne {
+	if s != nil {
+		cvauo.SetOrigin(*s)
+	}
+	return cvauo
+}
+
+// SetCollector sets the "collector" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetCollector(s string) *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.SetCollector(s)
+	return cvauo
+}
+
+// SetNillableCollector sets the "collector" field if the given value is not nil.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetNillableCollector(s *string) *CertifyVulnArchiveUpdateOne {
+	if s != nil {
+		cvauo.SetCollector(*s)
+	}
+	return cvauo
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetDocumentRef(s string) *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.SetDocumentRef(s)
+	return cvauo
+}
+
+// SetNillableDocumentRef sets the "document_ref" field if the given value is not nil.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetNillableDocumentRef(s *string) *CertifyVulnArchiveUpdateOne {
+	if s != nil {
+		cvauo.SetDocumentRef(*s)
+	}
+	return cvauo
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetCvssScore(f float64) *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.ResetCvssScore()
+	cvauo.mutation.SetCvssScore(f)
+	return cvauo
+}
+
+// SetNillableCvssScore sets the "cvss_score" field if the given value is not nil.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetNillableCvssScore(f *float64) *CertifyVulnArchiveUpdateOne {
+	if f != nil {
+		cvauo.SetCvssScore(*f)
+	}
+	return cvauo
+}
+
+// AddCvssScore adds f to the "cvss_score" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) AddCvssScore(f float64) *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.AddCvssScore(f)
+	return cvauo
+}
+
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) ClearCvssScore() *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.ClearCvssScore()
+	return cvauo
+}
+
+// SetRemediationStatus sets the "remediation_status" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetRemediationStatus(cs certifyvulnarchive.RemediationStatus) *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.SetRemediationStatus(cs)
+	return cvauo
+}
+
+// SetNillableRemediationStatus sets the "remediation_status" field if the given value is not nil.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetNillableRemediationStatus(cs *certifyvulnarchive.RemediationStatus) *CertifyVulnArchiveUpdateOne {
+	if cs != nil {
+		cvauo.SetRemediationStatus(*cs)
+	}
+	return cvauo
+}
+
+// SetResolvedByID sets the "resolved_by_id" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetResolvedByID(u uuid.UUID) *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.SetResolvedByID(u)
+	return cvauo
+}
+
+// SetNillableResolvedByID sets the "resolved_by_id" field if the given value is not nil.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetNillableResolvedByID(u *uuid.UUID) *CertifyVulnArchiveUpdateOne {
+	if u != nil {
+		cvauo.SetResolvedByID(*u)
+	}
+	return cvauo
+}
+
+// ClearResolvedByID clears the value of the "resolved_by_id" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) ClearResolvedByID() *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.ClearResolvedByID()
+	return cvauo
+}
+
+// SetResolvedByType sets the "resolved_by_type" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetResolvedByType(s string) *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.SetResolvedByType(s)
+	return cvauo
+}
+
+// SetNillableResolvedByType sets the "resolved_by_type" field if the given value is not nil.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetNillableResolvedByType(s *string) *CertifyVulnArchiveUpdateOne {
+	if s != nil {
+		cvauo.SetResolvedByType(*s)
+	}
+	return cvauo
+}
+
+// ClearResolvedByType clears the value of the "resolved_by_type" field.
+func (cvauo *CertifyVulnArchiveUpdateOne) ClearResolvedByType() *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.ClearResolvedByType()
+	return cvauo
+}
+
+// SetVulnerability sets the "vulnerability" edge to the VulnerabilityID entity.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetVulnerability(v *VulnerabilityID) *CertifyVulnArchiveUpdateOne {
+	return cvauo.SetVulnerabilityID(v.ID)
+}
+
+// SetPackage sets the "package" edge to the PackageVersion entity.
+func (cvauo *CertifyVulnArchiveUpdateOne) SetPackage(p *PackageVersion) *CertifyVulnArchiveUpdateOne {
+	return cvauo.SetPackageID(p.ID)
+}
+
+// Mutation returns the CertifyVulnArchiveMutation object of the builder.
+func (cvauo *CertifyVulnArchiveUpdateOne) Mutation() *CertifyVulnArchiveMutation {
+	return cvauo.mutation
+}
+
+// ClearVulnerability clears the "vulnerability" edge to the VulnerabilityID entity.
+func (cvauo *CertifyVulnArchiveUpdateOne) ClearVulnerability() *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.ClearVulnerability()
+	return cvauo
+}
+
+// ClearPackage clears the "package" edge to the PackageVersion entity.
+func (cvauo *CertifyVulnArchiveUpdateOne) ClearPackage() *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.ClearPackage()
+	return cvauo
+}
+
+// Where appends a list predicates to the CertifyVulnArchiveUpdate builder.
+func (cvauo *CertifyVulnArchiveUpdateOne) Where(ps ...predicate.CertifyVulnArchive) *CertifyVulnArchiveUpdateOne {
+	cvauo.mutation.Where(ps...)
+	return cvauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cvauo *CertifyVulnArchiveUpdateOne) Select(field string, fields ...string) *CertifyVulnArchiveUpdateOne {
+	cvauo.fields = append([]string{field}, fields...)
+	return cvauo
+}
+
+// Save executes the query and returns the updated CertifyVulnArchive entity.
+func (cvauo *CertifyVulnArchiveUpdateOne) Save(ctx context.Context) (*CertifyVulnArchive, error) {
+	return withHooks(ctx, cvauo.sqlSave, cvauo.mutation, cvauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cvauo *CertifyVulnArchiveUpdateOne) SaveX(ctx context.Context) *CertifyVulnArchive {
+	node, err := cvauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cvauo *CertifyVulnArchiveUpdateOne) Exec(ctx context.Context) error {
+	_, err := cvauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvauo *CertifyVulnArchiveUpdateOne) ExecX(ctx context.Context) {
+	if err := cvauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cvauo *CertifyVulnArchiveUpdateOne) check() error {
+	if v, ok := cvauo.mutation.RemediationStatus(); ok {
+		if err := certifyvulnarchive.RemediationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "remediation_status", err: fmt.Errorf(`ent: validator failed for field "CertifyVulnArchive.remediation_status": %w`, err)}
+		}
+	}
+	if _, ok := cvauo.mutation.VulnerabilityID(); cvauo.mutation.VulnerabilityCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CertifyVulnArchive.vulnerability"`)
+	}
+	if _, ok := cvauo.mutation.PackageID(); cvauo.mutation.PackageCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CertifyVulnArchive.package"`)
+	}
+	return nil
+}
+
+func (cvauo *CertifyVulnArchiveUpdateOne) sqlSave(ctx context.Context) (_node *CertifyVulnArchive, err error) {
+	if err := cvauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(certifyvulnarchive.Table, certifyvulnarchive.Columns, sqlgraph.NewFieldSpec(certifyvulnarchive.FieldID, field.TypeUUID))
+	id, ok := cvauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CertifyVulnArchive.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cvauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, certifyvulnarchive.FieldID)
+		for _, f := range fields {
+			if !certifyvulnarchive.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != certifyvulnarchive.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cvauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cvauo.mutation.TimeScanned(); ok {
+		_spec.SetField(certifyvulnarchive.FieldTimeScanned, field.TypeTime, value)
+	}
+	if value, ok := cvauo.mutation.DbURI(); ok {
+		_spec.SetField(certifyvulnarchive.FieldDbURI, field.TypeString, value)
+	}
+	if value, ok := cvauo.mutation.DbVersion(); ok {
+		_spec.SetField(certifyvulnarchive.FieldDbVersion, field.TypeString, value)
+	}
+	if value, ok := cvauo.mutation.ScannerURI(); ok {
+		_spec.SetField(certifyvulnarchive.FieldScannerURI, field.TypeString, value)
+	}
+	if value, ok := cvauo.mutation.ScannerVersion(); ok {
+		_spec.SetField(certifyvulnarchive.FieldScannerVersion, field.TypeString, value)
+	}
+	if value, ok := cvauo.mutation.Origin(); ok {
+		_spec.SetField(certifyvulnarchive.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := cvauo.mutation.Collector(); ok {
+		_spec.SetField(certifyvulnarchive.FieldCollector, field.TypeString, value)
+	}
+	if value, ok := cvauo.mutation.DocumentRef(); ok {
+		_spec.SetField(certifyvulnarchive.FieldDocumentRef, field.TypeString, value)
+	}
+	if value, ok := cvauo.mutation.CvssScore(); ok {
+		_spec.SetField(certifyvulnarchive.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if value, ok := cvauo.mutation.AddedCvssScore(); ok {
+		_spec.AddField(certifyvulnarchive.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if cvauo.mutation.CvssScoreCleared() {
+		_spec.ClearField(certifyvulnarchive.FieldCvssScore, field.TypeFloat64)
+	}
+	if value, ok := cvauo.mutation.RemediationStatus(); ok {
+		_spec.SetField(certifyvulnarchive.FieldRemediationStatus, field.TypeEnum, value)
+	}
+	if value, ok := cvauo.mutation.ResolvedByID(); ok {
+		_spec.SetField(certifyvulnarchive.FieldResolvedByID, field.TypeUUID, value)
+	}
+	if cvauo.mutation.ResolvedByIDCleared() {
+		_spec.ClearField(certifyvulnarchive.FieldResolvedByID, field.TypeUUID)
+	}
+	if value, ok := cvauo.mutation.ResolvedByType(); ok {
+		_spec.SetField(certifyvulnarchive.FieldResolvedByType, field.TypeString, value)
+	}
+	if cvauo.mutation.ResolvedByTypeCleared() {
+		_spec.ClearField(certifyvulnarchive.FieldResolvedByType, field.TypeString)
+	}
+	if cvauo.mutation.VulnerabilityCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvulnarchive.VulnerabilityTable,
+			Columns: []string{certifyvulnarchive.VulnerabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cvauo.mutation.VulnerabilityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvulnarchive.VulnerabilityTable,
+			Columns: []string{certifyvulnarchive.VulnerabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cvauo.mutation.PackageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvulnarchive.PackageTable,
+			Columns: []string{certifyvulnarchive.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cvauo.mutation.PackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifyvulnarchive.PackageTable,
+			Columns: []string{certifyvulnarchive.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packageversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CertifyVulnArchive{config: cvauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cvauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{certifyvulnarchive.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cvauo.mutation.done = true
+	return _node, nil
+}
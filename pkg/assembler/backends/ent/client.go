@@ -24,7 +24,9 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvulnarchive"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploitreference"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -62,8 +64,12 @@ type Client struct {
 	CertifyVex *CertifyVexClient
 	// CertifyVuln is the client for interacting with the CertifyVuln builders.
 	CertifyVuln *CertifyVulnClient
+	// CertifyVulnArchive is the client for interacting with the CertifyVulnArchive builders.
+	CertifyVulnArchive *CertifyVulnArchiveClient
 	// Dependency is the client for interacting with the Dependency builders.
 	Dependency *DependencyClient
+	// ExploitReference is the client for interacting with the ExploitReference builders.
+	ExploitReference *ExploitReferenceClient
 	// HasMetadata is the client for interacting with the HasMetadata builders.
 	HasMetadata *HasMetadataClient
 	// HasSourceAt is the client for interacting with the HasSourceAt builders.
@@ -111,7 +117,9 @@ func (c *Client) init() {
 	c.CertifyScorecard = NewCertifyScorecardClient(c.config)
 	c.CertifyVex = NewCertifyVexClient(c.config)
 	c.CertifyVuln = NewCertifyVulnClient(c.config)
+	c.CertifyVulnArchive = NewCertifyVulnArchiveClient(c.config)
 	c.Dependency = NewDependencyClient(c.config)
+	c.ExploitReference = NewExploitReferenceClient(c.config)
 	c.HasMetadata = NewHasMetadataClient(c.config)
 	c.HasSourceAt = NewHasSourceAtClient(c.config)
 	c.HashEqual = NewHashEqualClient(c.config)
@@ -226,7 +234,9 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		CertifyScorecard:      NewCertifyScorecardClient(cfg),
 		CertifyVex:            NewCertifyVexClient(cfg),
 		CertifyVuln:           NewCertifyVulnClient(cfg),
+		CertifyVulnArchive:    NewCertifyVulnArchiveClient(cfg),
 		Dependency:            NewDependencyClient(cfg),
+		ExploitReference:      NewExploitReferenceClient(cfg),
 		HasMetadata:           NewHasMetadataClient(cfg),
 		HasSourceAt:           NewHasSourceAtClient(cfg),
 		HashEqual:             NewHashEqualClient(cfg),
@@ -268,7 +278,9 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		CertifyScorecard:      NewCertifyScorecardClient(cfg),
 		CertifyVex:            NewCertifyVexClient(cfg),
 		CertifyVuln:           NewCertifyVulnClient(cfg),
+		CertifyVulnArchive:    NewCertifyVulnArchiveClient(cfg),
 		Dependency:            NewDependencyClient(cfg),
+		ExploitReference:      NewExploitReferenceClient(cfg),
 		HasMetadata:           NewHasMetadataClient(cfg),
 		HasSourceAt:           NewHasSourceAtClient(cfg),
 		HashEqual:             NewHashEqualClient(cfg),
@@ -313,10 +325,11 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.Artifact, c.BillOfMaterials, c.Builder, c.Certification, c.CertifyLegal,
-		c.CertifyScorecard, c.CertifyVex, c.CertifyVuln, c.Dependency, c.HasMetadata,
-		c.HasSourceAt, c.HashEqual, c.License, c.Occurrence, c.PackageName,
-		c.PackageVersion, c.PkgEqual, c.PointOfContact, c.SLSAAttestation,
-		c.SourceName, c.VulnEqual, c.VulnerabilityID, c.VulnerabilityMetadata,
+		c.CertifyScorecard, c.CertifyVex, c.CertifyVuln, c.CertifyVulnArchive,
+		c.Dependency, c.ExploitReference, c.HasMetadata, c.HasSourceAt, c.HashEqual,
+		c.License, c.Occurrence, c.PackageName, c.PackageVersion, c.PkgEqual,
+		c.PointOfContact, c.SLSAAttestation, c.SourceName, c.VulnEqual,
+		c.VulnerabilityID, c.VulnerabilityMetadata,
 	} {
 		n.Use(hooks...)
 	}
@@ -327,10 +340,11 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.Artifact, c.BillOfMaterials, c.Builder, c.Certification, c.CertifyLegal,
-		c.CertifyScorecard, c.CertifyVex, c.CertifyVuln, c.Dependency, c.HasMetadata,
-		c.HasSourceAt, c.HashEqual, c.License, c.Occurrence, c.PackageName,
-		c.PackageVersion, c.PkgEqual, c.PointOfContact, c.SLSAAttestation,
-		c.SourceName, c.VulnEqual, c.VulnerabilityID, c.VulnerabilityMetadata,
+		c.CertifyScorecard, c.CertifyVex, c.CertifyVuln, c.CertifyVulnArchive,
+		c.Dependency, c.ExploitReference, c.HasMetadata, c.HasSourceAt, c.HashEqual,
+		c.License, c.Occurrence, c.PackageName, c.PackageVersion, c.PkgEqual,
+		c.PointOfContact, c.SLSAAttestation, c.SourceName, c.VulnEqual,
+		c.VulnerabilityID, c.VulnerabilityMetadata,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -355,8 +369,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.CertifyVex.mutate(ctx, m)
 	case *CertifyVulnMutation:
 		return c.CertifyVuln.mutate(ctx, m)
+	case *CertifyVulnArchiveMutation:
+		return c.CertifyVulnArchive.mutate(ctx, m)
 	case *DependencyMutation:
 		return c.Dependency.mutate(ctx, m)
+	case *ExploitReferenceMutation:
+		return c.ExploitReference.mutate(ctx, m)
 	case *HasMetadataMutation:
 		return c.HasMetadata.mutate(ctx, m)
 	case *HasSourceAtMutation:
@@ -1966,6 +1984,171 @@ func (c *CertifyVulnClient) mutate(ctx context.Context, m *CertifyVulnMutation)
 	}
 }
 
+// CertifyVulnArchiveClient is a client for the CertifyVulnArchive schema.
+type CertifyVulnArchiveClient struct {
+	config
+}
+
+// NewCertifyVulnArchiveClient returns a client for the CertifyVulnArchive from the given config.
+func NewCertifyVulnArchiveClient(c config) *CertifyVulnArchiveClient {
+	return &CertifyVulnArchiveClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `certifyvulnarchive.Hooks(f(g(h())))`.
+func (c *CertifyVulnArchiveClient) Use(hooks ...Hook) {
+	c.hooks.CertifyVulnArchive = append(c.hooks.CertifyVulnArchive, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `certifyvulnarchive.Intercept(f(g(h())))`.
+func (c *CertifyVulnArchiveClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CertifyVulnArchive = append(c.inters.CertifyVulnArchive, interceptors...)
+}
+
+// Create returns a builder for creating a CertifyVulnArchive entity.
+func (c *CertifyVulnArchiveClient) Create() *CertifyVulnArchiveCreate {
+	mutation := newCertifyVulnArchiveMutation(c.config, OpCreate)
+	return &CertifyVulnArchiveCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CertifyVulnArchive entities.
+func (c *CertifyVulnArchiveClient) CreateBulk(builders ...*CertifyVulnArchiveCreate) *CertifyVulnArchiveCreateBulk {
+	return &CertifyVulnArchiveCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CertifyVulnArchiveClient) MapCreateBulk(slice any, setFunc func(*CertifyVulnArchiveCreate, int)) *CertifyVulnArchiveCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CertifyVulnArchiveCreateBulk{err: fmt.Errorf("calling to CertifyVulnArchiveClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CertifyVulnArchiveCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CertifyVulnArchiveCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CertifyVulnArchive.
+func (c *CertifyVulnArchiveClient) Update() *CertifyVulnArchiveUpdate {
+	mutation := newCertifyVulnArchiveMutation(c.config, OpUpdate)
+	return &CertifyVulnArchiveUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CertifyVulnArchiveClient) UpdateOne(cva *CertifyVulnArchive) *CertifyVulnArchiveUpdateOne {
+	mutation := newCertifyVulnArchiveMutation(c.config, OpUpdateOne, withCertifyVulnArchive(cva))
+	return &CertifyVulnArchiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CertifyVulnArchiveClient) UpdateOneID(id uuid.UUID) *CertifyVulnArchiveUpdateOne {
+	mutation := newCertifyVulnArchiveMutation(c.config, OpUpdateOne, withCertifyVulnArchiveID(id))
+	return &CertifyVulnArchiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CertifyVulnArchive.
+func (c *CertifyVulnArchiveClient) Delete() *CertifyVulnArchiveDelete {
+	mutation := newCertifyVulnArchiveMutation(c.config, OpDelete)
+	return &CertifyVulnArchiveDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CertifyVulnArchiveClient) DeleteOne(cva *CertifyVulnArchive) *CertifyVulnArchiveDeleteOne {
+	return c.DeleteOneID(cva.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CertifyVulnArchiveClient) DeleteOneID(id uuid.UUID) *CertifyVulnArchiveDeleteOne {
+	builder := c.Delete().Where(certifyvulnarchive.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CertifyVulnArchiveDeleteOne{builder}
+}
+
+// Query returns a query builder for CertifyVulnArchive.
+func (c *CertifyVulnArchiveClient) Query() *CertifyVulnArchiveQuery {
+	return &CertifyVulnArchiveQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCertifyVulnArchive},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CertifyVulnArchive entity by its id.
+func (c *CertifyVulnArchiveClient) Get(ctx context.Context, id uuid.UUID) (*CertifyVulnArchive, error) {
+	return c.Query().Where(certifyvulnarchive.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CertifyVulnArchiveClient) GetX(ctx context.Context, id uuid.UUID) *CertifyVulnArchive {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVulnerability queries the vulnerability edge of a CertifyVulnArchive.
+func (c *CertifyVulnArchiveClient) QueryVulnerability(cva *CertifyVulnArchive) *VulnerabilityIDQuery {
+	query := (&VulnerabilityIDClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cva.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifyvulnarchive.Table, certifyvulnarchive.FieldID, id),
+			sqlgraph.To(vulnerabilityid.Table, vulnerabilityid.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifyvulnarchive.VulnerabilityTable, certifyvulnarchive.VulnerabilityColumn),
+		)
+		fromV = sqlgraph.Neighbors(cva.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPackage queries the package edge of a CertifyVulnArchive.
+func (c *CertifyVulnArchiveClient) QueryPackage(cva *CertifyVulnArchive) *PackageVersionQuery {
+	query := (&PackageVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cva.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifyvulnarchive.Table, certifyvulnarchive.FieldID, id),
+			sqlgraph.To(packageversion.Table, packageversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifyvulnarchive.PackageTable, certifyvulnarchive.PackageColumn),
+		)
+		fromV = sqlgraph.Neighbors(cva.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CertifyVulnArchiveClient) Hooks() []Hook {
+	return c.hooks.CertifyVulnArchive
+}
+
+// Interceptors returns the client interceptors.
+func (c *CertifyVulnArchiveClient) Interceptors() []Interceptor {
+	return c.inters.CertifyVulnArchive
+}
+
+func (c *CertifyVulnArchiveClient) mutate(ctx context.Context, m *CertifyVulnArchiveMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CertifyVulnArchiveCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CertifyVulnArchiveUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CertifyVulnArchiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CertifyVulnArchiveDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CertifyVulnArchive mutation op: %q", m.Op())
+	}
+}
+
 // DependencyClient is a client for the Dependency schema.
 type DependencyClient struct {
 	config
@@ -2163,6 +2346,155 @@ func (c *DependencyClient) mutate(ctx context.Context, m *DependencyMutation) (V
 	}
 }
 
+// ExploitReferenceClient is a client for the ExploitReference schema.
+type ExploitReferenceClient struct {
+	config
+}
+
+// NewExploitReferenceClient returns a client for the ExploitReference from the given config.
+func NewExploitReferenceClient(c config) *ExploitReferenceClient {
+	return &ExploitReferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `exploitreference.Hooks(f(g(h())))`.
+func (c *ExploitReferenceClient) Use(hooks ...Hook) {
+	c.hooks.ExploitReference = append(c.hooks.ExploitReference, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `exploitreference.Intercept(f(g(h())))`.
+func (c *ExploitReferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExploitReference = append(c.inters.ExploitReference, interceptors...)
+}
+
+// Create returns a builder for creating a ExploitReference entity.
+func (c *ExploitReferenceClient) Create() *ExploitReferenceCreate {
+	mutation := newExploitReferenceMutation(c.config, OpCreate)
+	return &ExploitReferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExploitReference entities.
+func (c *ExploitReferenceClient) CreateBulk(builders ...*ExploitReferenceCreate) *ExploitReferenceCreateBulk {
+	return &ExploitReferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExploitReferenceClient) MapCreateBulk(slice any, setFunc func(*ExploitReferenceCreate, int)) *ExploitReferenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExploitReferenceCreateBulk{err: fmt.Errorf("calling to ExploitReferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExploitReferenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExploitReferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExploitReference.
+func (c *ExploitReferenceClient) Update() *ExploitReferenceUpdate {
+	mutation := newExploitReferenceMutation(c.config, OpUpdate)
+	return &ExploitReferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExploitReferenceClient) UpdateOne(er *ExploitReference) *ExploitReferenceUpdateOne {
+	mutation := newExploitReferenceMutation(c.config, OpUpdateOne, withExploitReference(er))
+	return &ExploitReferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExploitReferenceClient) UpdateOneID(id uuid.UUID) *ExploitReferenceUpdateOne {
+	mutation := newExploitReferenceMutation(c.config, OpUpdateOne, withExploitReferenceID(id))
+	return &ExploitReferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExploitReference.
+func (c *ExploitReferenceClient) Delete() *ExploitReferenceDelete {
+	mutation := newExploitReferenceMutation(c.config, OpDelete)
+	return &ExploitReferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExploitReferenceClient) DeleteOne(er *ExploitReference) *ExploitReferenceDeleteOne {
+	return c.DeleteOneID(er.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExploitReferenceClient) DeleteOneID(id uuid.UUID) *ExploitReferenceDeleteOne {
+	builder := c.Delete().Where(exploitreference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExploitReferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for ExploitReference.
+func (c *ExploitReferenceClient) Query() *ExploitReferenceQuery {
+	return &ExploitReferenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExploitReference},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExploitReference entity by its id.
+func (c *ExploitReferenceClient) Get(ctx context.Context, id uuid.UUID) (*ExploitReference, error) {
+	return c.Query().Where(exploitreference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExploitReferenceClient) GetX(ctx context.Context, id uuid.UUID) *ExploitReference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVulnerabilityID queries the vulnerability_id edge of a ExploitReference.
+func (c *ExploitReferenceClient) QueryVulnerabilityID(er *ExploitReference) *VulnerabilityIDQuery {
+	query := (&VulnerabilityIDClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := er.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(exploitreference.Table, exploitreference.FieldID, id),
+			sqlgraph.To(vulnerabilityid.Table, vulnerabilityid.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, exploitreference.VulnerabilityIDTable, exploitreference.VulnerabilityIDColumn),
+		)
+		fromV = sqlgraph.Neighbors(er.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ExploitReferenceClient) Hooks() []Hook {
+	return c.hooks.ExploitReference
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExploitReferenceClient) Interceptors() []Interceptor {
+	return c.inters.ExploitReference
+}
+
+func (c *ExploitReferenceClient) mutate(ctx context.Context, m *ExploitReferenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExploitReferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExploitReferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExploitReferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExploitReferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExploitReference mutation op: %q", m.Op())
+	}
+}
+
 // HasMetadataClient is a client for the HasMetadata schema.
 type HasMetadataClient struct {
 	config
@@ -4811,6 +5143,22 @@ func (c *VulnerabilityIDClient) QueryVex(vi *VulnerabilityID) *CertifyVexQuery {
 	return query
 }
 
+// QueryExploitReferences queries the exploit_references edge of a VulnerabilityID.
+func (c *VulnerabilityIDClient) QueryExploitReferences(vi *VulnerabilityID) *ExploitReferenceQuery {
+	query := (&ExploitReferenceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := vi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vulnerabilityid.Table, vulnerabilityid.FieldID, id),
+			sqlgraph.To(exploitreference.Table, exploitreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, vulnerabilityid.ExploitReferencesTable, vulnerabilityid.ExploitReferencesColumn),
+		)
+		fromV = sqlgraph.Neighbors(vi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *VulnerabilityIDClient) Hooks() []Hook {
 	return c.hooks.VulnerabilityID
@@ -4989,16 +5337,16 @@ func (c *VulnerabilityMetadataClient) mutate(ctx context.Context, m *Vulnerabili
 type (
 	hooks struct {
 		Artifact, BillOfMaterials, Builder, Certification, CertifyLegal,
-		CertifyScorecard, CertifyVex, CertifyVuln, Dependency, HasMetadata,
-		HasSourceAt, HashEqual, License, Occurrence, PackageName, PackageVersion,
-		PkgEqual, PointOfContact, SLSAAttestation, SourceName, VulnEqual,
-		VulnerabilityID, VulnerabilityMetadata []ent.Hook
+		CertifyScorecard, CertifyVex, CertifyVuln, CertifyVulnArchive, Dependency,
+		ExploitReference, HasMetadata, HasSourceAt, HashEqual, License, Occurrence,
+		PackageName, PackageVersion, PkgEqual, PointOfContact, SLSAAttestation,
+		SourceName, VulnEqual, VulnerabilityID, VulnerabilityMetadata []ent.Hook
 	}
 	inters struct {
 		Artifact, BillOfMaterials, Builder, Certification, CertifyLegal,
-		CertifyScorecard, CertifyVex, CertifyVuln, Dependency, HasMetadata,
-		HasSourceAt, HashEqual, License, Occurrence, PackageName, PackageVersion,
-		PkgEqual, PointOfContact, SLSAAttestation, SourceName, VulnEqual,
-		VulnerabilityID, VulnerabilityMetadata []ent.Interceptor
+		CertifyScorecard, CertifyVex, CertifyVuln, CertifyVulnArchive, Dependency,
+		ExploitReference, HasMetadata, HasSourceAt, HashEqual, License, Occurrence,
+		PackageName, PackageVersion, PkgEqual, PointOfContact, SLSAAttestation,
+		SourceName, VulnEqual, VulnerabilityID, VulnerabilityMetadata []ent.Interceptor
 	}
 )
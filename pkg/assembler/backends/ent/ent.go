@@ -20,7 +20,9 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvulnarchive"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploitreference"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -103,7 +105,9 @@ func checkColumn(table, column string) error {
 			certifyscorecard.Table:      certifyscorecard.ValidColumn,
 			certifyvex.Table:            certifyvex.ValidColumn,
 			certifyvuln.Table:           certifyvuln.ValidColumn,
+			certifyvulnarchive.Table:    certifyvulnarchive.ValidColumn,
 			dependency.Table:            dependency.ValidColumn,
+			exploitreference.Table:      exploitreference.ValidColumn,
 			hasmetadata.Table:           hasmetadata.ValidColumn,
 			hassourceat.Table:           hassourceat.ValidColumn,
 			hashequal.Table:             hashequal.ValidColumn,
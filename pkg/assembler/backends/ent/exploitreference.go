@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploitreference"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+)
+
+// ExploitReference is the model entity for the ExploitReference schema.
+type ExploitReference struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// VulnerabilityIDID holds the value of the "vulnerability_id_id" field.
+	VulnerabilityIDID uuid.UUID `json:"vulnerability_id_id,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Verified holds the value of the "verified" field.
+	Verified bool `json:"verified,omitempty"`
+	// Origin holds the value of the "origin" field.
+	Origin string `json:"origin,omitempty"`
+	// Collector holds the value of the "collector" field.
+	Collector string `json:"collector,omitempty"`
+	// DocumentRef holds the value of the "document_ref" field.
+	DocumentRef string `json:"document_ref,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ExploitReferenceQuery when eager-loading is set.
+	Edges        ExploitReferenceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ExploitReferenceEdges holds the relations/edges for other nodes in the graph.
+type ExploitReferenceEdges struct {
+	// VulnerabilityID holds the value of the vulnerability_id edge.
+	VulnerabilityID *VulnerabilityID `json:"vulnerability_id,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// VulnerabilityIDOrErr returns the VulnerabilityID value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExploitReferenceEdges) VulnerabilityIDOrErr() (*VulnerabilityID, error) {
+	if e.loadedTypes[0] {
+		if e.VulnerabilityID == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: vulnerabilityid.Label}
+		}
+		return e.VulnerabilityID, nil
+	}
+	return nil, &NotLoadedError{edge: "vulnerability_id"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExploitReference) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case exploitreference.FieldVerified:
+			values[i] = new(sql.NullBool)
+		case exploitreference.FieldSource, exploitreference.FieldURL, exploitreference.FieldOrigin, exploitreference.FieldCollector, exploitreference.FieldDocumentRef:
+			values[i] = new(sql.NullString)
+		case exploitreference.FieldID, exploitreference.FieldVulnerabilityIDID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExploitReference fields.
+func (er *ExploitReference) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case exploitreference.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				er.ID = *value
+			}
+		case exploitreference.FieldVulnerabilityIDID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field vulnerability_id_id", values[i])
+			} else if value != nil {
+				er.VulnerabilityIDID = *value
+			}
+		case exploitreference.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				er.Source = value.String
+			}
+		case exploitreference.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				er.URL = value.String
+			}
+		case exploitreference.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				er.Verified = value.Bool
+			}
+		case exploitreference.FieldOrigin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value.Valid {
+				er.Origin = value.String
+			}
+		case exploitreference.FieldCollector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collector", values[i])
+			} else if value.Valid {
+				er.Collector = value.String
+			}
+		case exploitreference.FieldDocumentRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_ref", values[i])
+			} else if value.Valid {
+				er.DocumentRef = value.String
+			}
+		default:
+			er.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExploitReference.
+// This includes values selected through modifiers, order, etc.
+func (er *ExploitReference) Value(name string) (ent.Value, error) {
+	return er.selectValues.Get(name)
+}
+
+// QueryVulnerabilityID queries the "vulnerability_id" edge of the ExploitReference entity.
+func (er *ExploitReference) QueryVulnerabilityID() *VulnerabilityIDQuery {
+	return NewExploitReferenceClient(er.config).QueryVulnerabilityID(er)
+}
+
+// Update returns a builder for updating this ExploitReference.
+// Note that you need to call ExploitReference.Unwrap() before calling this method if this ExploitReference
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (er *ExploitReference) Update() *ExploitReferenceUpdateOne {
+	return NewExploitReferenceClient(er.config).UpdateOne(er)
+}
+
+// Unwrap unwraps the ExploitReference entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (er *ExploitReference) Unwrap() *ExploitReference {
+	_tx, ok := er.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExploitReference is not a transactional entity")
+	}
+	er.config.driver = _tx.drv
+	return er
+}
+
+// String implements the fmt.Stringer.
+func (er *ExploitReference) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExploitReference(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", er.ID))
+	builder.WriteString("vulnerability_id_id=")
+	builder.WriteString(fmt.Sprintf("%v", er.VulnerabilityIDID))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(er.Source)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(er.URL)
+	builder.WriteString(", ")
+	builder.WriteString("verified=")
+	builder.WriteString(fmt.Sprintf("%v", er.Verified))
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(er.Origin)
+	builder.WriteString(", ")
+	builder.WriteString("collector=")
+	builder.WriteString(er.Collector)
+	builder.WriteString(", ")
+	builder.WriteString("document_ref=")
+	builder.WriteString(er.DocumentRef)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExploitReferences is a parsable slice of ExploitReference.
+type ExploitReferences []*ExploitReference
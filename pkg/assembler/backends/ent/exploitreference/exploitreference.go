@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exploitreference
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the exploitreference type in the database.
+	Label = "exploit_reference"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVulnerabilityIDID holds the string denoting the vulnerability_id_id field in the database.
+	FieldVulnerabilityIDID = "vulnerability_id_id"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldVerified holds the string denoting the verified field in the database.
+	FieldVerified = "verified"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldCollector holds the string denoting the collector field in the database.
+	FieldCollector = "collector"
+	// FieldDocumentRef holds the string denoting the document_ref field in the database.
+	FieldDocumentRef = "document_ref"
+	// EdgeVulnerabilityID holds the string denoting the vulnerability_id edge name in mutations.
+	EdgeVulnerabilityID = "vulnerability_id"
+	// Table holds the table name of the exploitreference in the database.
+	Table = "exploit_references"
+	// VulnerabilityIDTable is the table that holds the vulnerability_id relation/edge.
+	VulnerabilityIDTable = "exploit_references"
+	// VulnerabilityIDInverseTable is the table name for the VulnerabilityID entity.
+	// It exists in this package in order to avoid circular dependency with the "vulnerabilityid" package.
+	VulnerabilityIDInverseTable = "vulnerability_ids"
+	// VulnerabilityIDColumn is the table column denoting the vulnerability_id relation/edge.
+	VulnerabilityIDColumn = "vulnerability_id_id"
+)
+
+// Columns holds all SQL columns for exploitreference fields.
+var Columns = []string{
+	FieldID,
+	FieldVulnerabilityIDID,
+	FieldSource,
+	FieldURL,
+	FieldVerified,
+	FieldOrigin,
+	FieldCollector,
+	FieldDocumentRef,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ExploitReference queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVulnerabilityIDID orders the results by the vulnerability_id_id field.
+func ByVulnerabilityIDID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVulnerabilityIDID, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByVerified orders the results by the verified field.
+func ByVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerified, opts...).ToFunc()
+}
+
+// ByOrigin orders the results by the origin field.
+func ByOrigin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrigin, opts...).ToFunc()
+}
+
+// ByCollector orders the results by the collector field.
+func ByCollector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollector, opts...).ToFunc()
+}
+
+// ByDocumentRef orders the results by the document_ref field.
+func ByDocumentRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentRef, opts...).ToFunc()
+}
+
+// ByVulnerabilityIDField orders the results by vulnerability_id field.
+func ByVulnerabilityIDField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVulnerabilityIDStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newVulnerabilityIDStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VulnerabilityIDInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, VulnerabilityIDTable, VulnerabilityIDColumn),
+	)
+}
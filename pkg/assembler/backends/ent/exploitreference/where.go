@@ -0,0 +1,483 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exploitreference
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldLTE(FieldID, id))
+}
+
+// VulnerabilityIDID applies equality check predicate on the "vulnerability_id_id" field. It's identical to VulnerabilityIDIDEQ.
+func VulnerabilityIDID(v uuid.UUID) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEQ(FieldVulnerabilityIDID, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEQ(FieldSource, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEQ(FieldURL, v))
+}
+
+// Verified applies equality check predicate on the "verified" field. It's identical to VerifiedEQ.
+func Verified(v bool) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEQ(FieldVerified, v))
+}
+
+// Origin applies equality check predicate on the "origin" field. It's identical to OriginEQ.
+func Origin(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEQ(FieldOrigin, v))
+}
+
+// Collector applies equality check predicate on the "collector" field. It's identical to CollectorEQ.
+func Collector(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEQ(FieldCollector, v))
+}
+
+// DocumentRef applies equality check predicate on the "document_ref" field. It's identical to DocumentRefEQ.
+func DocumentRef(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEQ(FieldDocumentRef, v))
+}
+
+// VulnerabilityIDIDEQ applies the EQ predicate on the "vulnerability_id_id" field.
+func VulnerabilityIDIDEQ(v uuid.UUID) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEQ(FieldVulnerabilityIDID, v))
+}
+
+// VulnerabilityIDIDNEQ applies the NEQ predicate on the "vulnerability_id_id" field.
+func VulnerabilityIDIDNEQ(v uuid.UUID) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldNEQ(FieldVulnerabilityIDID, v))
+}
+
+// VulnerabilityIDIDIn applies the In predicate on the "vulnerability_id_id" field.
+func VulnerabilityIDIDIn(vs ...uuid.UUID) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldIn(FieldVulnerabilityIDID, vs...))
+}
+
+// VulnerabilityIDIDNotIn applies the NotIn predicate on the "vulnerability_id_id" field.
+func VulnerabilityIDIDNotIn(vs ...uuid.UUID) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldNotIn(FieldVulnerabilityIDID, vs...))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldContainsFold(FieldSource, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldContainsFold(FieldURL, v))
+}
+
+// VerifiedEQ applies the EQ predicate on the "verified" field.
+func VerifiedEQ(v bool) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEQ(FieldVerified, v))
+}
+
+// VerifiedNEQ applies the NEQ predicate on the "verified" field.
+func VerifiedNEQ(v bool) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldNEQ(FieldVerified, v))
+}
+
+// OriginEQ applies the EQ predicate on the "origin" field.
+func OriginEQ(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEQ(FieldOrigin, v))
+}
+
+// OriginNEQ applies the NEQ predicate on the "origin" field.
+func OriginNEQ(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldNEQ(FieldOrigin, v))
+}
+
+// OriginIn applies the In predicate on the "origin" field.
+func OriginIn(vs ...string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldIn(FieldOrigin, vs...))
+}
+
+// OriginNotIn applies the NotIn predicate on the "origin" field.
+func OriginNotIn(vs ...string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldNotIn(FieldOrigin, vs...))
+}
+
+// OriginGT applies the GT predicate on the "origin" field.
+func OriginGT(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldGT(FieldOrigin, v))
+}
+
+// OriginGTE applies the GTE predicate on the "origin" field.
+func OriginGTE(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldGTE(FieldOrigin, v))
+}
+
+// OriginLT applies the LT predicate on the "origin" field.
+func OriginLT(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldLT(FieldOrigin, v))
+}
+
+// OriginLTE applies the LTE predicate on the "origin" field.
+func OriginLTE(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldLTE(FieldOrigin, v))
+}
+
+// OriginContains applies the Contains predicate on the "origin" field.
+func OriginContains(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldContains(FieldOrigin, v))
+}
+
+// OriginHasPrefix applies the HasPrefix predicate on the "origin" field.
+func OriginHasPrefix(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldHasPrefix(FieldOrigin, v))
+}
+
+// OriginHasSuffix applies the HasSuffix predicate on the "origin" field.
+func OriginHasSuffix(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldHasSuffix(FieldOrigin, v))
+}
+
+// OriginEqualFold applies the EqualFold predicate on the "origin" field.
+func OriginEqualFold(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEqualFold(FieldOrigin, v))
+}
+
+// OriginContainsFold applies the ContainsFold predicate on the "origin" field.
+func OriginContainsFold(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldContainsFold(FieldOrigin, v))
+}
+
+// CollectorEQ applies the EQ predicate on the "collector" field.
+func CollectorEQ(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEQ(FieldCollector, v))
+}
+
+// CollectorNEQ applies the NEQ predicate on the "collector" field.
+func CollectorNEQ(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldNEQ(FieldCollector, v))
+}
+
+// CollectorIn applies the In predicate on the "collector" field.
+func CollectorIn(vs ...string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldIn(FieldCollector, vs...))
+}
+
+// CollectorNotIn applies the NotIn predicate on the "collector" field.
+func CollectorNotIn(vs ...string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldNotIn(FieldCollector, vs...))
+}
+
+// CollectorGT applies the GT predicate on the "collector" field.
+func CollectorGT(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldGT(FieldCollector, v))
+}
+
+// CollectorGTE applies the GTE predicate on the "collector" field.
+func CollectorGTE(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldGTE(FieldCollector, v))
+}
+
+// CollectorLT applies the LT predicate on the "collector" field.
+func CollectorLT(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldLT(FieldCollector, v))
+}
+
+// CollectorLTE applies the LTE predicate on the "collector" field.
+func CollectorLTE(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldLTE(FieldCollector, v))
+}
+
+// CollectorContains applies the Contains predicate on the "collector" field.
+func CollectorContains(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldContains(FieldCollector, v))
+}
+
+// CollectorHasPrefix applies the HasPrefix predicate on the "collector" field.
+func CollectorHasPrefix(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldHasPrefix(FieldCollector, v))
+}
+
+// CollectorHasSuffix applies the HasSuffix predicate on the "collector" field.
+func CollectorHasSuffix(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldHasSuffix(FieldCollector, v))
+}
+
+// CollectorEqualFold applies the EqualFold predicate on the "collector" field.
+func CollectorEqualFold(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEqualFold(FieldCollector, v))
+}
+
+// CollectorContainsFold applies the ContainsFold predicate on the "collector" field.
+func CollectorContainsFold(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldContainsFold(FieldCollector, v))
+}
+
+// DocumentRefEQ applies the EQ predicate on the "document_ref" field.
+func DocumentRefEQ(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEQ(FieldDocumentRef, v))
+}
+
+// DocumentRefNEQ applies the NEQ predicate on the "document_ref" field.
+func DocumentRefNEQ(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldNEQ(FieldDocumentRef, v))
+}
+
+// DocumentRefIn applies the In predicate on the "document_ref" field.
+func DocumentRefIn(vs ...string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldIn(FieldDocumentRef, vs...))
+}
+
+// DocumentRefNotIn applies the NotIn predicate on the "document_ref" field.
+func DocumentRefNotIn(vs ...string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldNotIn(FieldDocumentRef, vs...))
+}
+
+// DocumentRefGT applies the GT predicate on the "document_ref" field.
+func DocumentRefGT(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldGT(FieldDocumentRef, v))
+}
+
+// DocumentRefGTE applies the GTE predicate on the "document_ref" field.
+func DocumentRefGTE(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldGTE(FieldDocumentRef, v))
+}
+
+// DocumentRefLT applies the LT predicate on the "document_ref" field.
+func DocumentRefLT(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldLT(FieldDocumentRef, v))
+}
+
+// DocumentRefLTE applies the LTE predicate on the "document_ref" field.
+func DocumentRefLTE(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldLTE(FieldDocumentRef, v))
+}
+
+// DocumentRefContains applies the Contains predicate on the "document_ref" field.
+func DocumentRefContains(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldContains(FieldDocumentRef, v))
+}
+
+// DocumentRefHasPrefix applies the HasPrefix predicate on the "document_ref" field.
+func DocumentRefHasPrefix(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldHasPrefix(FieldDocumentRef, v))
+}
+
+// DocumentRefHasSuffix applies the HasSuffix predicate on the "document_ref" field.
+func DocumentRefHasSuffix(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldHasSuffix(FieldDocumentRef, v))
+}
+
+// DocumentRefEqualFold applies the EqualFold predicate on the "document_ref" field.
+func DocumentRefEqualFold(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldEqualFold(FieldDocumentRef, v))
+}
+
+// DocumentRefContainsFold applies the ContainsFold predicate on the "document_ref" field.
+func DocumentRefContainsFold(v string) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.FieldContainsFold(FieldDocumentRef, v))
+}
+
+// HasVulnerabilityID applies the HasEdge predicate on the "vulnerability_id" edge.
+func HasVulnerabilityID() predicate.ExploitReference {
+	return predicate.ExploitReference(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, VulnerabilityIDTable, VulnerabilityIDColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVulnerabilityIDWith applies the HasEdge predicate on the "vulnerability_id" edge with a given conditions (other predicates).
+func HasVulnerabilityIDWith(preds ...predicate.VulnerabilityID) predicate.ExploitReference {
+	return predicate.ExploitReference(func(s *sql.Selector) {
+		step := newVulnerabilityIDStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExploitReference) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExploitReference) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExploitReference) predicate.ExploitReference {
+	return predicate.ExploitReference(sql.NotPredicates(p))
+}
@@ -0,0 +1,848 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploitreference"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+)
+
+// ExploitReferenceCreate is the builder for creating a ExploitReference entity.
+type ExploitReferenceCreate struct {
+	config
+	mutation *ExploitReferenceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetVulnerabilityIDID sets the "vulnerability_id_id" field.
+func (erc *ExploitReferenceCreate) SetVulnerabilityIDID(u uuid.UUID) *ExploitReferenceCreate {
+	erc.mutation.SetVulnerabilityIDID(u)
+	return erc
+}
+
+// SetSource sets the "source" field.
+func (erc *ExploitReferenceCreate) SetSource(s string) *ExploitReferenceCreate {
+	erc.mutation.SetSource(s)
+	return erc
+}
+
+// SetURL sets the "url" field.
+func (erc *ExploitReferenceCreate) SetURL(s string) *ExploitReferenceCreate {
+	erc.mutation.SetURL(s)
+	return erc
+}
+
+// SetVerified sets the "verified" field.
+func (erc *ExploitReferenceCreate) SetVerified(b bool) *ExploitReferenceCreate {
+	erc.mutation.SetVerified(b)
+	return erc
+}
+
+// SetOrigin sets the "origin" field.
+func (erc *ExploitReferenceCreate) SetOrigin(s string) *ExploitReferenceCreate {
+	erc.mutation.SetOrigin(s)
+	return erc
+}
+
+// SetCollector sets the "collector" field.
+func (erc *ExploitReferenceCreate) SetCollector(s string) *ExploitReferenceCreate {
+	erc.mutation.SetCollector(s)
+	return erc
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (erc *ExploitReferenceCreate) SetDocumentRef(s string) *ExploitReferenceCreate {
+	erc.mutation.SetDocumentRef(s)
+	return erc
+}
+
+// SetID sets the "id" field.
+func (erc *ExploitReferenceCreate) SetID(u uuid.UUID) *ExploitReferenceCreate {
+	erc.mutation.SetID(u)
+	return erc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (erc *ExploitReferenceCreate) SetNillableID(u *uuid.UUID) *ExploitReferenceCreate {
+	if u != nil {
+		erc.SetID(*u)
+	}
+	return erc
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" edge to the VulnerabilityID entity.
+func (erc *ExploitReferenceCreate) SetVulnerabilityID(v *VulnerabilityID) *ExploitReferenceCreate {
+	return erc.SetVulnerabilityIDID(v.ID)
+}
+
+// Mutation returns the ExploitReferenceMutation object of the builder.
+func (erc *ExploitReferenceCreate) Mutation() *ExploitReferenceMutation {
+	return erc.mutation
+}
+
+// Save creates the ExploitReference in the database.
+func (erc *ExploitReferenceCreate) Save(ctx context.Context) (*ExploitReference, error) {
+	erc.defaults()
+	return withHooks(ctx, erc.sqlSave, erc.mutation, erc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (erc *ExploitReferenceCreate) SaveX(ctx context.Context) *ExploitReference {
+	v, err := erc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (erc *ExploitReferenceCreate) Exec(ctx context.Context) error {
+	_, err := erc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (erc *ExploitReferenceCreate) ExecX(ctx context.Context) {
+	if err := erc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (erc *ExploitReferenceCreate) defaults() {
+	if _, ok := erc.mutation.ID(); !ok {
+		v := exploitreference.DefaultID()
+		erc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (erc *ExploitReferenceCreate) check() error {
+	if _, ok := erc.mutation.VulnerabilityIDID(); !ok {
+		return &ValidationError{Name: "vulnerability_id_id", err: errors.New(`ent: missing required field "ExploitReference.vulnerability_id_id"`)}
+	}
+	if _, ok := erc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "ExploitReference.source"`)}
+	}
+	if _, ok := erc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "ExploitReference.url"`)}
+	}
+	if _, ok := erc.mutation.Verified(); !ok {
+		return &ValidationError{Name: "verified", err: errors.New(`ent: missing required field "ExploitReference.verified"`)}
+	}
+	if _, ok := erc.mutation.Origin(); !ok {
+		return &ValidationError{Name: "origin", err: errors.New(`ent: missing required field "ExploitReference.origin"`)}
+	}
+	if _, ok := erc.mutation.Collector(); !ok {
+		return &ValidationError{Name: "collector", err: errors.New(`ent: missing required field "ExploitReference.collector"`)}
+	}
+	if _, ok := erc.mutation.DocumentRef(); !ok {
+		return &ValidationError{Name: "document_ref", err: errors.New(`ent: missing required field "ExploitReference.document_ref"`)}
+	}
+	if _, ok := erc.mutation.VulnerabilityIDID(); !ok {
+		return &ValidationError{Name: "vulnerability_id", err: errors.New(`ent: missing required edge "ExploitReference.vulnerability_id"`)}
+	}
+	return nil
+}
+
+func (erc *ExploitReferenceCreate) sqlSave(ctx context.Context) (*ExploitReference, error) {
+	if err := erc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := erc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, erc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	erc.mutation.id = &_node.ID
+	erc.mutation.done = true
+	return _node, nil
+}
+
+func (erc *ExploitReferenceCreate) createSpec() (*ExploitReference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExploitReference{config: erc.config}
+		_spec = sqlgraph.NewCreateSpec(exploitreference.Table, sqlgraph.NewFieldSpec(exploitreference.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = erc.conflict
+	if id, ok := erc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := erc.mutation.Source(); ok {
+		_spec.SetField(exploitreference.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := erc.mutation.URL(); ok {
+		_spec.SetField(exploitreference.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := erc.mutation.Verified(); ok {
+		_spec.SetField(exploitreference.FieldVerified, field.TypeBool, value)
+		_node.Verified = value
+	}
+	if value, ok := erc.mutation.Origin(); ok {
+		_spec.SetField(exploitreference.FieldOrigin, field.TypeString, value)
+		_node.Origin = value
+	}
+	if value, ok := erc.mutation.Collector(); ok {
+		_spec.SetField(exploitreference.FieldCollector, field.TypeString, value)
+		_node.Collector = value
+	}
+	if value, ok := erc.mutation.DocumentRef(); ok {
+		_spec.SetField(exploitreference.FieldDocumentRef, field.TypeString, value)
+		_node.DocumentRef = value
+	}
+	if nodes := erc.mutation.VulnerabilityIDIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   exploitreference.VulnerabilityIDTable,
+			Columns: []string{exploitreference.VulnerabilityIDColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VulnerabilityIDID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExploitReference.Create().
+//		SetVulnerabilityIDID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExploitReferenceUpsert) {
+//			SetVulnerabilityIDID(v+v).
+//		}).
+//		Exec(ctx)
+func (erc *ExploitReferenceCreate) OnConflict(opts ...sql.ConflictOption) *ExploitReferenceUpsertOne {
+	erc.conflict = opts
+	return &ExploitReferenceUpsertOne{
+		create: erc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExploitReference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (erc *ExploitReferenceCreate) OnConflictColumns(columns ...string) *ExploitReferenceUpsertOne {
+	erc.conflict = append(erc.conflict, sql.ConflictColumns(columns...))
+	return &ExploitReferenceUpsertOne{
+		create: erc,
+	}
+}
+
+type (
+	// ExploitReferenceUpsertOne is the builder for "upsert"-ing
+	//  one ExploitReference node.
+	ExploitReferenceUpsertOne struct {
+		create *ExploitReferenceCreate
+	}
+
+	// ExploitReferenceUpsert is the "OnConflict" setter.
+	ExploitReferenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetVulnerabilityIDID sets the "vulnerability_id_id" field.
+func (u *ExploitReferenceUpsert) SetVulnerabilityIDID(v uuid.UUID) *ExploitReferenceUpsert {
+	u.Set(exploitreference.FieldVulnerabilityIDID, v)
+	return u
+}
+
+// UpdateVulnerabilityIDID sets the "vulnerability_id_id" field to the value that was provided on create.
+func (u *ExploitReferenceUpsert) UpdateVulnerabilityIDID() *ExploitReferenceUpsert {
+	u.SetExcluded(exploitreference.FieldVulnerabilityIDID)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *ExploitReferenceUpsert) SetSource(v string) *ExploitReferenceUpsert {
+	u.Set(exploitreference.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ExploitReferenceUpsert) UpdateSource() *ExploitReferenceUpsert {
+	u.SetExcluded(exploitreference.FieldSource)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *ExploitReferenceUpsert) SetURL(v string) *ExploitReferenceUpsert {
+	u.Set(exploitreference.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *ExploitReferenceUpsert) UpdateURL() *ExploitReferenceUpsert {
+	u.SetExcluded(exploitreference.FieldURL)
+	return u
+}
+
+// SetVerified sets the "verified" field.
+func (u *ExploitReferenceUpsert) SetVerified(v bool) *ExploitReferenceUpsert {
+	u.Set(exploitreference.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *ExploitReferenceUpsert) UpdateVerified() *ExploitReferenceUpsert {
+	u.SetExcluded(exploitreference.FieldVerified)
+	return u
+}
+
+// SetOrigin sets the "origin" field.
+func (u *ExploitReferenceUpsert) SetOrigin(v string) *ExploitReferenceUpsert {
+	u.Set(exploitreference.FieldOrigin, v)
+	return u
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *ExploitReferenceUpsert) UpdateOrigin() *ExploitReferenceUpsert {
+	u.SetExcluded(exploitreference.FieldOrigin)
+	return u
+}
+
+// SetCollector sets the "collector" field.
+func (u *ExploitReferenceUpsert) SetCollector(v string) *ExploitReferenceUpsert {
+	u.Set(exploitreference.FieldCollector, v)
+	return u
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *ExploitReferenceUpsert) UpdateCollector() *ExploitReferenceUpsert {
+	u.SetExcluded(exploitreference.FieldCollector)
+	return u
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (u *ExploitReferenceUpsert) SetDocumentRef(v string) *ExploitReferenceUpsert {
+	u.Set(exploitreference.FieldDocumentRef, v)
+	return u
+}
+
+// UpdateDocumentRef sets the "document_ref" field to the value that was provided on create.
+func (u *ExploitReferenceUpsert) UpdateDocumentRef() *ExploitReferenceUpsert {
+	u.SetExcluded(exploitreference.FieldDocumentRef)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ExploitReference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(exploitreference.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExploitReferenceUpsertOne) UpdateNewValues() *ExploitReferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(exploitreference.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExploitReference.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExploitReferenceUpsertOne) Ignore() *ExploitReferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExploitReferenceUpsertOne) DoNothing() *ExploitReferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExploitReferenceCreate.OnConflict
+// documentation for more info.
+func (u *ExploitReferenceUpsertOne) Update(set func(*ExploitReferenceUpsert)) *ExploitReferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExploitReferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVulnerabilityIDID sets the "vulnerability_id_id" field.
+func (u *ExploitReferenceUpsertOne) SetVulnerabilityIDID(v uuid.UUID) *ExploitReferenceUpsertOne {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.SetVulnerabilityIDID(v)
+	})
+}
+
+// UpdateVulnerabilityIDID sets the "vulnerability_id_id" field to the value that was provided on create.
+func (u *ExploitReferenceUpsertOne) UpdateVulnerabilityIDID() *ExploitReferenceUpsertOne {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.UpdateVulnerabilityIDID()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *ExploitReferenceUpsertOne) SetSource(v string) *ExploitReferenceUpsertOne {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ExploitReferenceUpsertOne) UpdateSource() *ExploitReferenceUpsertOne {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *ExploitReferenceUpsertOne) SetURL(v string) *ExploitReferenceUpsertOne {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *ExploitReferenceUpsertOne) UpdateURL() *ExploitReferenceUpsertOne {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetVerified sets the "verified" field.
+func (u *ExploitReferenceUpsertOne) SetVerified(v bool) *ExploitReferenceUpsertOne {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *ExploitReferenceUpsertOne) UpdateVerified() *ExploitReferenceUpsertOne {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *ExploitReferenceUpsertOne) SetOrigin(v string) *ExploitReferenceUpsertOne {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *ExploitReferenceUpsertOne) UpdateOrigin() *ExploitReferenceUpsertOne {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *ExploitReferenceUpsertOne) SetCollector(v string) *ExploitReferenceUpsertOne {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *ExploitReferenceUpsertOne) UpdateCollector() *ExploitReferenceUpsertOne {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (u *ExploitReferenceUpsertOne) SetDocumentRef(v string) *ExploitReferenceUpsertOne {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.SetDocumentRef(v)
+	})
+}
+
+// UpdateDocumentRef sets the "document_ref" field to the value that was provided on create.
+func (u *ExploitReferenceUpsertOne) UpdateDocumentRef() *ExploitReferenceUpsertOne {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.UpdateDocumentRef()
+	})
+}
+
+// Exec executes the query.
+func (u *ExploitReferenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExploitReferenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExploitReferenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExploitReferenceUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ExploitReferenceUpsertOne.ID is not supported by MySQL driver. Use ExploitReferenceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExploitReferenceUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExploitReferenceCreateBulk is the builder for creating many ExploitReference entities in bulk.
+type ExploitReferenceCreateBulk struct {
+	config
+	err      error
+	builders []*ExploitReferenceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExploitReference entities in the database.
+func (ercb *ExploitReferenceCreateBulk) Save(ctx context.Context) ([]*ExploitReference, error) {
+	if ercb.err != nil {
+		return nil, ercb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ercb.builders))
+	nodes := make([]*ExploitReference, len(ercb.builders))
+	mutators := make([]Mutator, len(ercb.builders))
+	for i := range ercb.builders {
+		func(i int, root context.Context) {
+			builder := ercb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExploitReferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ercb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ercb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ercb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ercb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ercb *ExploitReferenceCreateBulk) SaveX(ctx context.Context) []*ExploitReference {
+	v, err := ercb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ercb *ExploitReferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := ercb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ercb *ExploitReferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := ercb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExploitReference.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExploitReferenceUpsert) {
+//			SetVulnerabilityIDID(v+v).
+//		}).
+//		Exec(ctx)
+func (ercb *ExploitReferenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExploitReferenceUpsertBulk {
+	ercb.conflict = opts
+	return &ExploitReferenceUpsertBulk{
+		create: ercb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExploitReference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ercb *ExploitReferenceCreateBulk) OnConflictColumns(columns ...string) *ExploitReferenceUpsertBulk {
+	ercb.conflict = append(ercb.conflict, sql.ConflictColumns(columns...))
+	return &ExploitReferenceUpsertBulk{
+		create: ercb,
+	}
+}
+
+// ExploitReferenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExploitReference nodes.
+type ExploitReferenceUpsertBulk struct {
+	create *ExploitReferenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExploitReference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(exploitreference.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExploitReferenceUpsertBulk) UpdateNewValues() *ExploitReferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(exploitreference.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExploitReference.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExploitReferenceUpsertBulk) Ignore() *ExploitReferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExploitReferenceUpsertBulk) DoNothing() *ExploitReferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExploitReferenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExploitReferenceUpsertBulk) Update(set func(*ExploitReferenceUpsert)) *ExploitReferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExploitReferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVulnerabilityIDID sets the "vulnerability_id_id" field.
+func (u *ExploitReferenceUpsertBulk) SetVulnerabilityIDID(v uuid.UUID) *ExploitReferenceUpsertBulk {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.SetVulnerabilityIDID(v)
+	})
+}
+
+// UpdateVulnerabilityIDID sets the "vulnerability_id_id" field to the value that was provided on create.
+func (u *ExploitReferenceUpsertBulk) UpdateVulnerabilityIDID() *ExploitReferenceUpsertBulk {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.UpdateVulnerabilityIDID()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *ExploitReferenceUpsertBulk) SetSource(v string) *ExploitReferenceUpsertBulk {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ExploitReferenceUpsertBulk) UpdateSource() *ExploitReferenceUpsertBulk {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *ExploitReferenceUpsertBulk) SetURL(v string) *ExploitReferenceUpsertBulk {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *ExploitReferenceUpsertBulk) UpdateURL() *ExploitReferenceUpsertBulk {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetVerified sets the "verified" field.
+func (u *ExploitReferenceUpsertBulk) SetVerified(v bool) *ExploitReferenceUpsertBulk {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *ExploitReferenceUpsertBulk) UpdateVerified() *ExploitReferenceUpsertBulk {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *ExploitReferenceUpsertBulk) SetOrigin(v string) *ExploitReferenceUpsertBulk {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *ExploitReferenceUpsertBulk) UpdateOrigin() *ExploitReferenceUpsertBulk {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *ExploitReferenceUpsertBulk) SetCollector(v string) *ExploitReferenceUpsertBulk {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *ExploitReferenceUpsertBulk) UpdateCollector() *ExploitReferenceUpsertBulk {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (u *ExploitReferenceUpsertBulk) SetDocumentRef(v string) *ExploitReferenceUpsertBulk {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.SetDocumentRef(v)
+	})
+}
+
+// UpdateDocumentRef sets the "document_ref" field to the value that was provided on create.
+func (u *ExploitReferenceUpsertBulk) UpdateDocumentRef() *ExploitReferenceUpsertBulk {
+	return u.Update(func(s *ExploitReferenceUpsert) {
+		s.UpdateDocumentRef()
+	})
+}
+
+// Exec executes the query.
+func (u *ExploitReferenceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ExploitReferenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExploitReferenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExploitReferenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploitreference"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+)
+
+// ExploitReferenceDelete is the builder for deleting a ExploitReference entity.
+type ExploitReferenceDelete struct {
+	config
+	hooks    []Hook
+	mutation *ExploitReferenceMutation
+}
+
+// Where appends a list predicates to the ExploitReferenceDelete builder.
+func (erd *ExploitReferenceDelete) Where(ps ...predicate.ExploitReference) *ExploitReferenceDelete {
+	erd.mutation.Where(ps...)
+	return erd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (erd *ExploitReferenceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, erd.sqlExec, erd.mutation, erd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (erd *ExploitReferenceDelete) ExecX(ctx context.Context) int {
+	n, err := erd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (erd *ExploitReferenceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(exploitreference.Table, sqlgraph.NewFieldSpec(exploitreference.FieldID, field.TypeUUID))
+	if ps := erd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, erd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	erd.mutation.done = true
+	return affected, err
+}
+
+// ExploitReferenceDeleteOne is the builder for deleting a single ExploitReference entity.
+type ExploitReferenceDeleteOne struct {
+	erd *ExploitReferenceDelete
+}
+
+// Where appends a list predicates to the ExploitReferenceDelete builder.
+func (erdo *ExploitReferenceDeleteOne) Where(ps ...predicate.ExploitReference) *ExploitReferenceDeleteOne {
+	erdo.erd.mutation.Where(ps...)
+	return erdo
+}
+
+// Exec executes the deletion query.
+func (erdo *ExploitReferenceDeleteOne) Exec(ctx context.Context) error {
+	n, err := erdo.erd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{exploitreference.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (erdo *ExploitReferenceDeleteOne) ExecX(ctx context.Context) {
+	if err := erdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,619 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploitreference"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+)
+
+// ExploitReferenceQuery is the builder for querying ExploitReference entities.
+type ExploitReferenceQuery struct {
+	config
+	ctx                 *QueryContext
+	order               []exploitreference.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.ExploitReference
+	withVulnerabilityID *VulnerabilityIDQuery
+	modifiers           []func(*sql.Selector)
+	loadTotal           []func(context.Context, []*ExploitReference) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ExploitReferenceQuery builder.
+func (erq *ExploitReferenceQuery) Where(ps ...predicate.ExploitReference) *ExploitReferenceQuery {
+	erq.predicates = append(erq.predicates, ps...)
+	return erq
+}
+
+// Limit the number of records to be returned by this query.
+func (erq *ExploitReferenceQuery) Limit(limit int) *ExploitReferenceQuery {
+	erq.ctx.Limit = &limit
+	return erq
+}
+
+// Offset to start from.
+func (erq *ExploitReferenceQuery) Offset(offset int) *ExploitReferenceQuery {
+	erq.ctx.Offset = &offset
+	return erq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (erq *ExploitReferenceQuery) Unique(unique bool) *ExploitReferenceQuery {
+	erq.ctx.Unique = &unique
+	return erq
+}
+
+// Order specifies how the records should be ordered.
+func (erq *ExploitReferenceQuery) Order(o ...exploitreference.OrderOption) *ExploitReferenceQuery {
+	erq.order = append(erq.order, o...)
+	return erq
+}
+
+// QueryVulnerabilityID chains the current query on the "vulnerability_id" edge.
+func (erq *ExploitReferenceQuery) QueryVulnerabilityID() *VulnerabilityIDQuery {
+	query := (&VulnerabilityIDClient{config: erq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := erq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := erq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(exploitreference.Table, exploitreference.FieldID, selector),
+			sqlgraph.To(vulnerabilityid.Table, vulnerabilityid.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, exploitreference.VulnerabilityIDTable, exploitreference.VulnerabilityIDColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(erq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ExploitReference entity from the query.
+// Returns a *NotFoundError when no ExploitReference was found.
+func (erq *ExploitReferenceQuery) First(ctx context.Context) (*ExploitReference, error) {
+	nodes, err := erq.Limit(1).All(setContextOp(ctx, erq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{exploitreference.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (erq *ExploitReferenceQuery) FirstX(ctx context.Context) *ExploitReference {
+	node, err := erq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ExploitReference ID from the query.
+// Returns a *NotFoundError when no ExploitReference ID was found.
+func (erq *ExploitReferenceQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = erq.Limit(1).IDs(setContextOp(ctx, erq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{exploitreference.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (erq *ExploitReferenceQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := erq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ExploitReference entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ExploitReference entity is found.
+// Returns a *NotFoundError when no ExploitReference entities are found.
+func (erq *ExploitReferenceQuery) Only(ctx context.Context) (*ExploitReference, error) {
+	nodes, err := erq.Limit(2).All(setContextOp(ctx, erq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{exploitreference.Label}
+	default:
+		return nil, &NotSingularError{exploitreference.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (erq *ExploitReferenceQuery) OnlyX(ctx context.Context) *ExploitReference {
+	node, err := erq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ExploitReference ID in the query.
+// Returns a *NotSingularError when more than one ExploitReference ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (erq *ExploitReferenceQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = erq.Limit(2).IDs(setContextOp(ctx, erq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{exploitreference.Label}
+	default:
+		err = &NotSingularError{exploitreference.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (erq *ExploitReferenceQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := erq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ExploitReferences.
+func (erq *ExploitReferenceQuery) All(ctx context.Context) ([]*ExploitReference, error) {
+	ctx = setContextOp(ctx, erq.ctx, "All")
+	if err := erq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ExploitReference, *ExploitReferenceQuery]()
+	return withInterceptors[[]*ExploitReference](ctx, erq, qr, erq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (erq *ExploitReferenceQuery) AllX(ctx context.Context) []*ExploitReference {
+	nodes, err := erq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ExploitReference IDs.
+func (erq *ExploitReferenceQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if erq.ctx.Unique == nil && erq.path != nil {
+		erq.Unique(true)
+	}
+	ctx = setContextOp(ctx, erq.ctx, "IDs")
+	if err = erq.Select(exploitreference.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (erq *ExploitReferenceQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := erq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (erq *ExploitReferenceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, erq.ctx, "Count")
+	if err := erq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, erq, querierCount[*ExploitReferenceQuery](), erq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (erq *ExploitReferenceQuery) CountX(ctx context.Context) int {
+	count, err := erq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (erq *ExploitReferenceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, erq.ctx, "Exist")
+	switch _, err := erq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (erq *ExploitReferenceQuery) ExistX(ctx context.Context) bool {
+	exist, err := erq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ExploitReferenceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (erq *ExploitReferenceQuery) Clone() *ExploitReferenceQuery {
+	if erq == nil {
+		return nil
+	}
+	return &ExploitReferenceQuery{
+		config:              erq.config,
+		ctx:                 erq.ctx.Clone(),
+		order:               append([]exploitreference.OrderOption{}, erq.order...),
+		inters:              append([]Interceptor{}, erq.inters...),
+		predicates:          append([]predicate.ExploitReference{}, erq.predicates...),
+		withVulnerabilityID: erq.withVulnerabilityID.Clone(),
+		// clone intermediate query.
+		sql:  erq.sql.Clone(),
+		path: erq.path,
+	}
+}
+
+// WithVulnerabilityID tells the query-builder to eager-load the nodes that are connected to
+// the "vulnerability_id" edge. The optional arguments are used to configure the query builder of the edge.
+func (erq *ExploitReferenceQuery) WithVulnerabilityID(opts ...func(*VulnerabilityIDQuery)) *ExploitReferenceQuery {
+	query := (&VulnerabilityIDClient{config: erq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	erq.withVulnerabilityID = query
+	return erq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		VulnerabilityIDID uuid.UUID `json:"vulnerability_id_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ExploitReference.Query().
+//		GroupBy(exploitreference.FieldVulnerabilityIDID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (erq *ExploitReferenceQuery) GroupBy(field string, fields ...string) *ExploitReferenceGroupBy {
+	erq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ExploitReferenceGroupBy{build: erq}
+	grbuild.flds = &erq.ctx.Fields
+	grbuild.label = exploitreference.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		VulnerabilityIDID uuid.UUID `json:"vulnerability_id_id,omitempty"`
+//	}
+//
+//	client.ExploitReference.Query().
+//		Select(exploitreference.FieldVulnerabilityIDID).
+//		Scan(ctx, &v)
+func (erq *ExploitReferenceQuery) Select(fields ...string) *ExploitReferenceSelect {
+	erq.ctx.Fields = append(erq.ctx.Fields, fields...)
+	sbuild := &ExploitReferenceSelect{ExploitReferenceQuery: erq}
+	sbuild.label = exploitreference.Label
+	sbuild.flds, sbuild.scan = &erq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ExploitReferenceSelect configured with the given aggregations.
+func (erq *ExploitReferenceQuery) Aggregate(fns ...AggregateFunc) *ExploitReferenceSelect {
+	return erq.Select().Aggregate(fns...)
+}
+
+func (erq *ExploitReferenceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range erq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, erq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range erq.ctx.Fields {
+		if !exploitreference.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if erq.path != nil {
+		prev, err := erq.path(ctx)
+		if err != nil {
+			return err
+		}
+		erq.sql = prev
+	}
+	return nil
+}
+
+func (erq *ExploitReferenceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ExploitReference, error) {
+	var (
+		nodes       = []*ExploitReference{}
+		_spec       = erq.querySpec()
+		loadedTypes = [1]bool{
+			erq.withVulnerabilityID != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ExploitReference).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ExploitReference{config: erq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(erq.modifiers) > 0 {
+		_spec.Modifiers = erq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, erq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := erq.withVulnerabilityID; query != nil {
+		if err := erq.loadVulnerabilityID(ctx, query, nodes, nil,
+			func(n *ExploitReference, e *VulnerabilityID) { n.Edges.VulnerabilityID = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range erq.loadTotal {
+		if err := erq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (erq *ExploitReferenceQuery) loadVulnerabilityID(ctx context.Context, query *VulnerabilityIDQuery, nodes []*ExploitReference, init func(*ExploitReference), assign func(*ExploitReference, *VulnerabilityID)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ExploitReference)
+	for i := range nodes {
+		fk := nodes[i].VulnerabilityIDID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(vulnerabilityid.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "vulnerability_id_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (erq *ExploitReferenceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := erq.querySpec()
+	if len(erq.modifiers) > 0 {
+		_spec.Modifiers = erq.modifiers
+	}
+	_spec.Node.Columns = erq.ctx.Fields
+	if len(erq.ctx.Fields) > 0 {
+		_spec.Unique = erq.ctx.Unique != nil && *erq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, erq.driver, _spec)
+}
+
+func (erq *ExploitReferenceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(exploitreference.Table, exploitreference.Columns, sqlgraph.NewFieldSpec(exploitreference.FieldID, field.TypeUUID))
+	_spec.From = erq.sql
+	if unique := erq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if erq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := erq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exploitreference.FieldID)
+		for i := range fields {
+			if fields[i] != exploitreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if erq.withVulnerabilityID != nil {
+			_spec.Node.AddColumnOnce(exploitreference.FieldVulnerabilityIDID)
+		}
+	}
+	if ps := erq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := erq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := erq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := erq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (erq *ExploitReferenceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(erq.driver.Dialect())
+	t1 := builder.Table(exploitreference.Table)
+	columns := erq.ctx.Fields
+	if len(columns) == 0 {
+		columns = exploitreference.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if erq.sql != nil {
+		selector = erq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if erq.ctx.Unique != nil && *erq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range erq.predicates {
+		p(selector)
+	}
+	for _, p := range erq.order {
+		p(selector)
+	}
+	if offset := erq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := erq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ExploitReferenceGroupBy is the group-by builder for ExploitReference entities.
+type ExploitReferenceGroupBy struct {
+	selector
+	build *ExploitReferenceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ergb *ExploitReferenceGroupBy) Aggregate(fns ...AggregateFunc) *ExploitReferenceGroupBy {
+	ergb.fns = append(ergb.fns, fns...)
+	return ergb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ergb *ExploitReferenceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ergb.build.ctx, "GroupBy")
+	if err := ergb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExploitReferenceQuery, *ExploitReferenceGroupBy](ctx, ergb.build, ergb, ergb.build.inters, v)
+}
+
+func (ergb *ExploitReferenceGroupBy) sqlScan(ctx context.Context, root *ExploitReferenceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ergb.fns))
+	for _, fn := range ergb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ergb.flds)+len(ergb.fns))
+		for _, f := range *ergb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ergb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ergb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ExploitReferenceSelect is the builder for selecting fields of ExploitReference entities.
+type ExploitReferenceSelect struct {
+	*ExploitReferenceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ers *ExploitReferenceSelect) Aggregate(fns ...AggregateFunc) *ExploitReferenceSelect {
+	ers.fns = append(ers.fns, fns...)
+	return ers
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ers *ExploitReferenceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ers.ctx, "Select")
+	if err := ers.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExploitReferenceQuery, *ExploitReferenceSelect](ctx, ers.ExploitReferenceQuery, ers, ers.inters, v)
+}
+
+func (ers *ExploitReferenceSelect) sqlScan(ctx context.Context, root *ExploitReferenceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ers.fns))
+	for _, fn := range ers.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ers.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ers.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,511 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploitreference"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+)
+
+// ExploitReferenceUpdate is the builder for updating ExploitReference entities.
+type ExploitReferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExploitReferenceMutation
+}
+
+// Where appends a list predicates to the ExploitReferenceUpdate builder.
+func (eru *ExploitReferenceUpdate) Where(ps ...predicate.ExploitReference) *ExploitReferenceUpdate {
+	eru.mutation.Where(ps...)
+	return eru
+}
+
+// SetVulnerabilityIDID sets the "vulnerability_id_id" field.
+func (eru *ExploitReferenceUpdate) SetVulnerabilityIDID(u uuid.UUID) *ExploitReferenceUpdate {
+	eru.mutation.SetVulnerabilityIDID(u)
+	return eru
+}
+
+// SetNillableVulnerabilityIDID sets the "vulnerability_id_id" field if the given value is not nil.
+func (eru *ExploitReferenceUpdate) SetNillableVulnerabilityIDID(u *uuid.UUID) *ExploitReferenceUpdate {
+	if u != nil {
+		eru.SetVulnerabilityIDID(*u)
+	}
+	return eru
+}
+
+// SetSource sets the "source" field.
+func (eru *ExploitReferenceUpdate) SetSource(s string) *ExploitReferenceUpdate {
+	eru.mutation.SetSource(s)
+	return eru
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (eru *ExploitReferenceUpdate) SetNillableSource(s *string) *ExploitReferenceUpdate {
+	if s != nil {
+		eru.SetSource(*s)
+	}
+	return eru
+}
+
+// SetURL sets the "url" field.
+func (eru *ExploitReferenceUpdate) SetURL(s string) *ExploitReferenceUpdate {
+	eru.mutation.SetURL(s)
+	return eru
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (eru *ExploitReferenceUpdate) SetNillableURL(s *string) *ExploitReferenceUpdate {
+	if s != nil {
+		eru.SetURL(*s)
+	}
+	return eru
+}
+
+// SetVerified sets the "verified" field.
+func (eru *ExploitReferenceUpdate) SetVerified(b bool) *ExploitReferenceUpdate {
+	eru.mutation.SetVerified(b)
+	return eru
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (eru *ExploitReferenceUpdate) SetNillableVerified(b *bool) *ExploitReferenceUpdate {
+	if b != nil {
+		eru.SetVerified(*b)
+	}
+	return eru
+}
+
+// SetOrigin sets the "origin" field.
+func (eru *ExploitReferenceUpdate) SetOrigin(s string) *ExploitReferenceUpdate {
+	eru.mutation.SetOrigin(s)
+	return eru
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (eru *ExploitReferenceUpdate) SetNillableOrigin(s *string) *ExploitReferenceUpdate {
+	if s != nil {
+		eru.SetOrigin(*s)
+	}
+	return eru
+}
+
+// SetCollector sets the "collector" field.
+func (eru *ExploitReferenceUpdate) SetCollector(s string) *ExploitReferenceUpdate {
+	eru.mutation.SetCollector(s)
+	return eru
+}
+
+// SetNillableCollector sets the "collector" field if the given value is not nil.
+func (eru *ExploitReferenceUpdate) SetNillableCollector(s *string) *ExploitReferenceUpdate {
+	if s != nil {
+		eru.SetCollector(*s)
+	}
+	return eru
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (eru *ExploitReferenceUpdate) SetDocumentRef(s string) *ExploitReferenceUpdate {
+	eru.mutation.SetDocumentRef(s)
+	return eru
+}
+
+// SetNillableDocumentRef sets the "document_ref" field if the given value is not nil.
+func (eru *ExploitReferenceUpdate) SetNillableDocumentRef(s *string) *ExploitReferenceUpdate {
+	if s != nil {
+		eru.SetDocumentRef(*s)
+	}
+	return eru
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" edge to the VulnerabilityID entity.
+func (eru *ExploitReferenceUpdate) SetVulnerabilityID(v *VulnerabilityID) *ExploitReferenceUpdate {
+	return eru.SetVulnerabilityIDID(v.ID)
+}
+
+// Mutation returns the ExploitReferenceMutation object of the builder.
+func (eru *ExploitReferenceUpdate) Mutation() *ExploitReferenceMutation {
+	return eru.mutation
+}
+
+// ClearVulnerabilityID clears the "vulnerability_id" edge to the VulnerabilityID entity.
+func (eru *ExploitReferenceUpdate) ClearVulnerabilityID() *ExploitReferenceUpdate {
+	eru.mutation.ClearVulnerabilityID()
+	return eru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eru *ExploitReferenceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, eru.sqlSave, eru.mutation, eru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eru *ExploitReferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := eru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eru *ExploitReferenceUpdate) Exec(ctx context.Context) error {
+	_, err := eru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eru *ExploitReferenceUpdate) ExecX(ctx context.Context) {
+	if err := eru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eru *ExploitReferenceUpdate) check() error {
+	if _, ok := eru.mutation.VulnerabilityIDID(); eru.mutation.VulnerabilityIDCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ExploitReference.vulnerability_id"`)
+	}
+	return nil
+}
+
+func (eru *ExploitReferenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := eru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exploitreference.Table, exploitreference.Columns, sqlgraph.NewFieldSpec(exploitreference.FieldID, field.TypeUUID))
+	if ps := eru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eru.mutation.Source(); ok {
+		_spec.SetField(exploitreference.FieldSource, field.TypeString, value)
+	}
+	if value, ok := eru.mutation.URL(); ok {
+		_spec.SetField(exploitreference.FieldURL, field.TypeString, value)
+	}
+	if value, ok := eru.mutation.Verified(); ok {
+		_spec.SetField(exploitreference.FieldVerified, field.TypeBool, value)
+	}
+	if value, ok := eru.mutation.Origin(); ok {
+		_spec.SetField(exploitreference.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := eru.mutation.Collector(); ok {
+		_spec.SetField(exploitreference.FieldCollector, field.TypeString, value)
+	}
+	if value, ok := eru.mutation.DocumentRef(); ok {
+		_spec.SetField(exploitreference.FieldDocumentRef, field.TypeString, value)
+	}
+	if eru.mutation.VulnerabilityIDCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   exploitreference.VulnerabilityIDTable,
+			Columns: []string{exploitreference.VulnerabilityIDColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eru.mutation.VulnerabilityIDIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   exploitreference.VulnerabilityIDTable,
+			Columns: []string{exploitreference.VulnerabilityIDColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exploitreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eru.mutation.done = true
+	return n, nil
+}
+
+// ExploitReferenceUpdateOne is the builder for updating a single ExploitReference entity.
+type ExploitReferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExploitReferenceMutation
+}
+
+// SetVulnerabilityIDID sets the "vulnerability_id_id" field.
+func (eruo *ExploitReferenceUpdateOne) SetVulnerabilityIDID(u uuid.UUID) *ExploitReferenceUpdateOne {
+	eruo.mutation.SetVulnerabilityIDID(u)
+	return eruo
+}
+
+// SetNillableVulnerabilityIDID sets the "vulnerability_id_id" field if the given value is not nil.
+func (eruo *ExploitReferenceUpdateOne) SetNillableVulnerabilityIDID(u *uuid.UUID) *ExploitReferenceUpdateOne {
+	if u != nil {
+		eruo.SetVulnerabilityIDID(*u)
+	}
+	return eruo
+}
+
+// SetSource sets the "source" field.
+func (eruo *ExploitReferenceUpdateOne) SetSource(s string) *ExploitReferenceUpdateOne {
+	eruo.mutation.SetSource(s)
+	return eruo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (eruo *ExploitReferenceUpdateOne) SetNillableSource(s *string) *ExploitReferenceUpdateOne {
+	if s != nil {
+		eruo.SetSource(*s)
+	}
+	return eruo
+}
+
+// SetURL sets the "url" field.
+func (eruo *ExploitReferenceUpdateOne) SetURL(s string) *ExploitReferenceUpdateOne {
+	eruo.mutation.SetURL(s)
+	return eruo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (eruo *ExploitReferenceUpdateOne) SetNillableURL(s *string) *ExploitReferenceUpdateOne {
+	if s != nil {
+		eruo.SetURL(*s)
+	}
+	return eruo
+}
+
+// SetVerified sets the "verified" field.
+func (eruo *ExploitReferenceUpdateOne) SetVerified(b bool) *ExploitReferenceUpdateOne {
+	eruo.mutation.SetVerified(b)
+	return eruo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (eruo *ExploitReferenceUpdateOne) SetNillableVerified(b *bool) *ExploitReferenceUpdateOne {
+	if b != nil {
+		eruo.SetVerified(*b)
+	}
+	return eruo
+}
+
+// SetOrigin sets the "origin" field.
+func (eruo *ExploitReferenceUpdateOne) SetOrigin(s string) *ExploitReferenceUpdateOne {
+	eruo.mutation.SetOrigin(s)
+	return eruo
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (eruo *ExploitReferenceUpdateOne) SetNillableOrigin(s *string) *ExploitReferenceUpdateOne {
+	if s != nil {
+		eruo.SetOrigin(*s)
+	}
+	return eruo
+}
+
+// SetCollector sets the "collector" field.
+func (eruo *ExploitReferenceUpdateOne) SetCollector(s string) *ExploitReferenceUpdateOne {
+	eruo.mutation.SetCollector(s)
+	return eruo
+}
+
+// SetNillableCollector sets the "collector" field if the given value is not nil.
+func (eruo *ExploitReferenceUpdateOne) SetNillableCollector(s *string) *ExploitReferenceUpdateOne {
+	if s != nil {
+		eruo.SetCollector(*s)
+	}
+	return eruo
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (eruo *ExploitReferenceUpdateOne) SetDocumentRef(s string) *ExploitReferenceUpdateOne {
+	eruo.mutation.SetDocumentRef(s)
+	return eruo
+}
+
+// SetNillableDocumentRef sets the "document_ref" field if the given value is not nil.
+func (eruo *ExploitReferenceUpdateOne) SetNillableDocumentRef(s *string) *ExploitReferenceUpdateOne {
+	if s != nil {
+		eruo.SetDocumentRef(*s)
+	}
+	return eruo
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" edge to the VulnerabilityID entity.
+func (eruo *ExploitReferenceUpdateOne) SetVulnerabilityID(v *VulnerabilityID) *ExploitReferenceUpdateOne {
+	return eruo.SetVulnerabilityIDID(v.ID)
+}
+
+// Mutation returns the ExploitReferenceMutation object of the builder.
+func (eruo *ExploitReferenceUpdateOne) Mutation() *ExploitReferenceMutation {
+	return eruo.mutation
+}
+
+// ClearVulnerabilityID clears the "vulnerability_id" edge to the VulnerabilityID entity.
+func (eruo *ExploitReferenceUpdateOne) ClearVulnerabilityID() *ExploitReferenceUpdateOne {
+	eruo.mutation.ClearVulnerabilityID()
+	return eruo
+}
+
+// Where appends a list predicates to the ExploitReferenceUpdate builder.
+func (eruo *ExploitReferenceUpdateOne) Where(ps ...predicate.ExploitReference) *ExploitReferenceUpdateOne {
+	eruo.mutation.Where(ps...)
+	return eruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (eruo *ExploitReferenceUpdateOne) Select(field string, fields ...string) *ExploitReferenceUpdateOne {
+	eruo.fields = append([]string{field}, fields...)
+	return eruo
+}
+
+// Save executes the query and returns the updated ExploitReference entity.
+func (eruo *ExploitReferenceUpdateOne) Save(ctx context.Context) (*ExploitReference, error) {
+	return withHooks(ctx, eruo.sqlSave, eruo.mutation, eruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eruo *ExploitReferenceUpdateOne) SaveX(ctx context.Context) *ExploitReference {
+	node, err := eruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (eruo *ExploitReferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := eruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eruo *ExploitReferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := eruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eruo *ExploitReferenceUpdateOne) check() error {
+	if _, ok := eruo.mutation.VulnerabilityIDID(); eruo.mutation.VulnerabilityIDCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ExploitReference.vulnerability_id"`)
+	}
+	return nil
+}
+
+func (eruo *ExploitReferenceUpdateOne) sqlSave(ctx context.Context) (_node *ExploitReference, err error) {
+	if err := eruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exploitreference.Table, exploitreference.Columns, sqlgraph.NewFieldSpec(exploitreference.FieldID, field.TypeUUID))
+	id, ok := eruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExploitReference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := eruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exploitreference.FieldID)
+		for _, f := range fields {
+			if !exploitreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != exploitreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := eruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eruo.mutation.Source(); ok {
+		_spec.SetField(exploitreference.FieldSource, field.TypeString, value)
+	}
+	if value, ok := eruo.mutation.URL(); ok {
+		_spec.SetField(exploitreference.FieldURL, field.TypeString, value)
+	}
+	if value, ok := eruo.mutation.Verified(); ok {
+		_spec.SetField(exploitreference.FieldVerified, field.TypeBool, value)
+	}
+	if value, ok := eruo.mutation.Origin(); ok {
+		_spec.SetField(exploitreference.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := eruo.mutation.Collector(); ok {
+		_spec.SetField(exploitreference.FieldCollector, field.TypeString, value)
+	}
+	if value, ok := eruo.mutation.DocumentRef(); ok {
+		_spec.SetField(exploitreference.FieldDocumentRef, field.TypeString, value)
+	}
+	if eruo.mutation.VulnerabilityIDCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   exploitreference.VulnerabilityIDTable,
+			Columns: []string{exploitreference.VulnerabilityIDColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eruo.mutation.VulnerabilityIDIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   exploitreference.VulnerabilityIDTable,
+			Columns: []string{exploitreference.VulnerabilityIDColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ExploitReference{config: eruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, eruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exploitreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	eruo.mutation.done = true
+	return _node, nil
+}
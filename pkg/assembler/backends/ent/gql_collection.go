@@ -15,7 +15,9 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvulnarchive"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploitreference"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -1228,6 +1230,26 @@ func (cv *CertifyVulnQuery) collectField(ctx context.Context, opCtx *graphql.Ope
 				selectedFields = append(selectedFields, certifyvuln.FieldDocumentRef)
 				fieldSeen[certifyvuln.FieldDocumentRef] = struct{}{}
 			}
+		case "cvssScore":
+			if _, ok := fieldSeen[certifyvuln.FieldCvssScore]; !ok {
+				selectedFields = append(selectedFields, certifyvuln.FieldCvssScore)
+				fieldSeen[certifyvuln.FieldCvssScore] = struct{}{}
+			}
+		case "remediationStatus":
+			if _, ok := fieldSeen[certifyvuln.FieldRemediationStatus]; !ok {
+				selectedFields = append(selectedFields, certifyvuln.FieldRemediationStatus)
+				fieldSeen[certifyvuln.FieldRemediationStatus] = struct{}{}
+			}
+		case "resolvedByID":
+			if _, ok := fieldSeen[certifyvuln.FieldResolvedByID]; !ok {
+				selectedFields = append(selectedFields, certifyvuln.FieldResolvedByID)
+				fieldSeen[certifyvuln.FieldResolvedByID] = struct{}{}
+			}
+		case "resolvedByType":
+			if _, ok := fieldSeen[certifyvuln.FieldResolvedByType]; !ok {
+				selectedFields = append(selectedFields, certifyvuln.FieldResolvedByType)
+				fieldSeen[certifyvuln.FieldResolvedByType] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -1266,6 +1288,163 @@ func newCertifyVulnPaginateArgs(rv map[string]any) *certifyvulnPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (cva *CertifyVulnArchiveQuery) CollectFields(ctx context.Context, satisfies ...string) (*CertifyVulnArchiveQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return cva, nil
+	}
+	if err := cva.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return cva, nil
+}
+
+func (cva *CertifyVulnArchiveQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(certifyvulnarchive.Columns))
+		selectedFields = []string{certifyvulnarchive.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "vulnerability":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&VulnerabilityIDClient{config: cva.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			cva.withVulnerability = query
+			if _, ok := fieldSeen[certifyvulnarchive.FieldVulnerabilityID]; !ok {
+				selectedFields = append(selectedFields, certifyvulnarchive.FieldVulnerabilityID)
+				fieldSeen[certifyvulnarchive.FieldVulnerabilityID] = struct{}{}
+			}
+		case "package":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&PackageVersionClient{config: cva.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			cva.withPackage = query
+			if _, ok := fieldSeen[certifyvulnarchive.FieldPackageID]; !ok {
+				selectedFields = append(selectedFields, certifyvulnarchive.FieldPackageID)
+				fieldSeen[certifyvulnarchive.FieldPackageID] = struct{}{}
+			}
+		case "vulnerabilityID":
+			if _, ok := fieldSeen[certifyvulnarchive.FieldVulnerabilityID]; !ok {
+				selectedFields = append(selectedFields, certifyvulnarchive.FieldVulnerabilityID)
+				fieldSeen[certifyvulnarchive.FieldVulnerabilityID] = struct{}{}
+			}
+		case "packageID":
+			if _, ok := fieldSeen[certifyvulnarchive.FieldPackageID]; !ok {
+				selectedFields = append(selectedFields, certifyvulnarchive.FieldPackageID)
+				fieldSeen[certifyvulnarchive.FieldPackageID] = struct{}{}
+			}
+		case "timeScanned":
+			if _, ok := fieldSeen[certifyvulnarchive.FieldTimeScanned]; !ok {
+				selectedFields = append(selectedFields, certifyvulnarchive.FieldTimeScanned)
+				fieldSeen[certifyvulnarchive.FieldTimeScanned] = struct{}{}
+			}
+		case "dbURI":
+			if _, ok := fieldSeen[certifyvulnarchive.FieldDbURI]; !ok {
+				selectedFields = append(selectedFields, certifyvulnarchive.FieldDbURI)
+				fieldSeen[certifyvulnarchive.FieldDbURI] = struct{}{}
+			}
+		case "dbVersion":
+			if _, ok := fieldSeen[certifyvulnarchive.FieldDbVersion]; !ok {
+				selectedFields = append(selectedFields, certifyvulnarchive.FieldDbVersion)
+				fieldSeen[certifyvulnarchive.FieldDbVersion] = struct{}{}
+			}
+		case "scannerURI":
+			if _, ok := fieldSeen[certifyvulnarchive.FieldScannerURI]; !ok {
+				selectedFields = append(selectedFields, certifyvulnarchive.FieldScannerURI)
+				fieldSeen[certifyvulnarchive.FieldScannerURI] = struct{}{}
+			}
+		case "scannerVersion":
+			if _, ok := fieldSeen[certifyvulnarchive.FieldScannerVersion]; !ok {
+				selectedFields = append(selectedFields, certifyvulnarchive.FieldScannerVersion)
+				fieldSeen[certifyvulnarchive.FieldScannerVersion] = struct{}{}
+			}
+		case "origin":
+			if _, ok := fieldSeen[certifyvulnarchive.FieldOrigin]; !ok {
+				selectedFields = append(selectedFields, certifyvulnarchive.FieldOrigin)
+				fieldSeen[certifyvulnarchive.FieldOrigin] = struct{}{}
+			}
+		case "collector":
+			if _, ok := fieldSeen[certifyvulnarchive.FieldCollector]; !ok {
+				selectedFields = append(selectedFields, certifyvulnarchive.FieldCollector)
+				fieldSeen[certifyvulnarchive.FieldCollector] = struct{}{}
+			}
+		case "documentRef":
+			if _, ok := fieldSeen[certifyvulnarchive.FieldDocumentRef]; !ok {
+				selectedFields = append(selectedFields, certifyvulnarchive.FieldDocumentRef)
+				fieldSeen[certifyvulnarchive.FieldDocumentRef] = struct{}{}
+			}
+		case "cvssScore":
+			if _, ok := fieldSeen[certifyvulnarchive.FieldCvssScore]; !ok {
+				selectedFields = append(selectedFields, certifyvulnarchive.FieldCvssScore)
+				fieldSeen[certifyvulnarchive.FieldCvssScore] = struct{}{}
+			}
+		case "remediationStatus":
+			if _, ok := fieldSeen[certifyvulnarchive.FieldRemediationStatus]; !ok {
+				selectedFields = append(selectedFields, certifyvulnarchive.FieldRemediationStatus)
+				fieldSeen[certifyvulnarchive.FieldRemediationStatus] = struct{}{}
+			}
+		case "resolvedByID":
+			if _, ok := fieldSeen[certifyvulnarchive.FieldResolvedByID]; !ok {
+				selectedFields = append(selectedFields, certifyvulnarchive.FieldResolvedByID)
+				fieldSeen[certifyvulnarchive.FieldResolvedByID] = struct{}{}
+			}
+		case "resolvedByType":
+			if _, ok := fieldSeen[certifyvulnarchive.FieldResolvedByType]; !ok {
+				selectedFields = append(selectedFields, certifyvulnarchive.FieldResolvedByType)
+				fieldSeen[certifyvulnarchive.FieldResolvedByType] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		cva.Select(selectedFields...)
+	}
+	return nil
+}
+
+type certifyvulnarchivePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []CertifyVulnArchivePaginateOption
+}
+
+func newCertifyVulnArchivePaginateArgs(rv map[string]any) *certifyvulnarchivePaginateArgs {
+	args := &certifyvulnarchivePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (d *DependencyQuery) CollectFields(ctx context.Context, satisfies ...string) (*DependencyQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -1424,6 +1603,114 @@ func newDependencyPaginateArgs(rv map[string]any) *dependencyPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (er *ExploitReferenceQuery) CollectFields(ctx context.Context, satisfies ...string) (*ExploitReferenceQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return er, nil
+	}
+	if err := er.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return er, nil
+}
+
+func (er *ExploitReferenceQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(exploitreference.Columns))
+		selectedFields = []string{exploitreference.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "vulnerabilityID":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&VulnerabilityIDClient{config: er.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			er.withVulnerabilityID = query
+			if _, ok := fieldSeen[exploitreference.FieldVulnerabilityIDID]; !ok {
+				selectedFields = append(selectedFields, exploitreference.FieldVulnerabilityIDID)
+				fieldSeen[exploitreference.FieldVulnerabilityIDID] = struct{}{}
+			}
+		case "vulnerabilityIDID":
+			if _, ok := fieldSeen[exploitreference.FieldVulnerabilityIDID]; !ok {
+				selectedFields = append(selectedFields, exploitreference.FieldVulnerabilityIDID)
+				fieldSeen[exploitreference.FieldVulnerabilityIDID] = struct{}{}
+			}
+		case "source":
+			if _, ok := fieldSeen[exploitreference.FieldSource]; !ok {
+				selectedFields = append(selectedFields, exploitreference.FieldSource)
+				fieldSeen[exploitreference.FieldSource] = struct{}{}
+			}
+		case "url":
+			if _, ok := fieldSeen[exploitreference.FieldURL]; !ok {
+				selectedFields = append(selectedFields, exploitreference.FieldURL)
+				fieldSeen[exploitreference.FieldURL] = struct{}{}
+			}
+		case "verified":
+			if _, ok := fieldSeen[exploitreference.FieldVerified]; !ok {
+				selectedFields = append(selectedFields, exploitreference.FieldVerified)
+				fieldSeen[exploitreference.FieldVerified] = struct{}{}
+			}
+		case "origin":
+			if _, ok := fieldSeen[exploitreference.FieldOrigin]; !ok {
+				selectedFields = append(selectedFields, exploitreference.FieldOrigin)
+				fieldSeen[exploitreference.FieldOrigin] = struct{}{}
+			}
+		case "collector":
+			if _, ok := fieldSeen[exploitreference.FieldCollector]; !ok {
+				selectedFields = append(selectedFields, exploitreference.FieldCollector)
+				fieldSeen[exploitreference.FieldCollector] = struct{}{}
+			}
+		case "documentRef":
+			if _, ok := fieldSeen[exploitreference.FieldDocumentRef]; !ok {
+				selectedFields = append(selectedFields, exploitreference.FieldDocumentRef)
+				fieldSeen[exploitreference.FieldDocumentRef] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		er.Select(selectedFields...)
+	}
+	return nil
+}
+
+type exploitreferencePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []ExploitReferencePaginateOption
+}
+
+func newExploitReferencePaginateArgs(rv map[string]any) *exploitreferencePaginateArgs {
+	args := &exploitreferencePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (hm *HasMetadataQuery) CollectFields(ctx context.Context, satisfies ...string) (*HasMetadataQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -2477,6 +2764,11 @@ func (pv *PackageVersionQuery) collectField(ctx context.Context, opCtx *graphql.
 				selectedFields = append(selectedFields, packageversion.FieldHash)
 				fieldSeen[packageversion.FieldHash] = struct{}{}
 			}
+		case "componentType":
+			if _, ok := fieldSeen[packageversion.FieldComponentType]; !ok {
+				selectedFields = append(selectedFields, packageversion.FieldComponentType)
+				fieldSeen[packageversion.FieldComponentType] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -3332,6 +3624,18 @@ func (vi *VulnerabilityIDQuery) collectField(ctx context.Context, opCtx *graphql
 			vi.WithNamedVex(alias, func(wq *CertifyVexQuery) {
 				*wq = *query
 			})
+		case "exploitReferences":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ExploitReferenceClient{config: vi.config}).Query()
+			)
+			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+				return err
+			}
+			vi.WithNamedExploitReferences(alias, func(wq *ExploitReferenceQuery) {
+				*wq = *query
+			})
 		case "vulnerabilityID":
 			if _, ok := fieldSeen[vulnerabilityid.FieldVulnerabilityID]; !ok {
 				selectedFields = append(selectedFields, vulnerabilityid.FieldVulnerabilityID)
@@ -336,6 +336,22 @@ func (cv *CertifyVuln) Package(ctx context.Context) (*PackageVersion, error) {
 	return result, err
 }
 
+func (cva *CertifyVulnArchive) Vulnerability(ctx context.Context) (*VulnerabilityID, error) {
+	result, err := cva.Edges.VulnerabilityOrErr()
+	if IsNotLoaded(err) {
+		result, err = cva.QueryVulnerability().Only(ctx)
+	}
+	return result, err
+}
+
+func (cva *CertifyVulnArchive) Package(ctx context.Context) (*PackageVersion, error) {
+	result, err := cva.Edges.PackageOrErr()
+	if IsNotLoaded(err) {
+		result, err = cva.QueryPackage().Only(ctx)
+	}
+	return result, err
+}
+
 func (d *Dependency) Package(ctx context.Context) (*PackageVersion, error) {
 	result, err := d.Edges.PackageOrErr()
 	if IsNotLoaded(err) {
@@ -372,6 +388,14 @@ func (d *Dependency) IncludedInSboms(ctx context.Context) (result []*BillOfMater
 	return result, err
 }
 
+func (er *ExploitReference) VulnerabilityID(ctx context.Context) (*VulnerabilityID, error) {
+	result, err := er.Edges.VulnerabilityIDOrErr()
+	if IsNotLoaded(err) {
+		result, err = er.QueryVulnerabilityID().Only(ctx)
+	}
+	return result, err
+}
+
 func (hm *HasMetadata) Source(ctx context.Context) (*SourceName, error) {
 	result, err := hm.Edges.SourceOrErr()
 	if IsNotLoaded(err) {
@@ -988,6 +1012,18 @@ func (vi *VulnerabilityID) Vex(ctx context.Context) (result []*CertifyVex, err e
 	return result, err
 }
 
+func (vi *VulnerabilityID) ExploitReferences(ctx context.Context) (result []*ExploitReference, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = vi.NamedExploitReferences(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = vi.Edges.ExploitReferencesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = vi.QueryExploitReferences().All(ctx)
+	}
+	return result, err
+}
+
 func (vm *VulnerabilityMetadata) VulnerabilityID(ctx context.Context) (*VulnerabilityID, error) {
 	result, err := vm.Edges.VulnerabilityIDOrErr()
 	if IsNotLoaded(err) {
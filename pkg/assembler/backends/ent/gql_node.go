@@ -17,7 +17,9 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvulnarchive"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploitreference"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -64,9 +66,15 @@ func (n *CertifyVex) IsNode() {}
 // IsNode implements the Node interface check for GQLGen.
 func (n *CertifyVuln) IsNode() {}
 
+// IsNode implements the Node interface check for GQLGen.
+func (n *CertifyVulnArchive) IsNode() {}
+
 // IsNode implements the Node interface check for GQLGen.
 func (n *Dependency) IsNode() {}
 
+// IsNode implements the Node interface check for GQLGen.
+func (n *ExploitReference) IsNode() {}
+
 // IsNode implements the Node interface check for GQLGen.
 func (n *HasMetadata) IsNode() {}
 
@@ -263,6 +271,18 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			return nil, err
 		}
 		return n, nil
+	case certifyvulnarchive.Table:
+		query := c.CertifyVulnArchive.Query().
+			Where(certifyvulnarchive.ID(id))
+		query, err := query.CollectFields(ctx, "CertifyVulnArchive")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case dependency.Table:
 		query := c.Dependency.Query().
 			Where(dependency.ID(id))
@@ -275,6 +295,18 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			return nil, err
 		}
 		return n, nil
+	case exploitreference.Table:
+		query := c.ExploitReference.Query().
+			Where(exploitreference.ID(id))
+		query, err := query.CollectFields(ctx, "ExploitReference")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case hasmetadata.Table:
 		query := c.HasMetadata.Query().
 			Where(hasmetadata.ID(id))
@@ -644,6 +676,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case certifyvulnarchive.Table:
+		query := c.CertifyVulnArchive.Query().
+			Where(certifyvulnarchive.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "CertifyVulnArchive")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case dependency.Table:
 		query := c.Dependency.Query().
 			Where(dependency.IDIn(ids...))
@@ -660,6 +708,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case exploitreference.Table:
+		query := c.ExploitReference.Query().
+			Where(exploitreference.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "ExploitReference")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case hasmetadata.Table:
 		query := c.HasMetadata.Query().
 			Where(hasmetadata.IDIn(ids...))
@@ -20,7 +20,9 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvulnarchive"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploitreference"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -2086,6 +2088,252 @@ func (cv *CertifyVuln) ToEdge(order *CertifyVulnOrder) *CertifyVulnEdge {
 	}
 }
 
+// CertifyVulnArchiveEdge is the edge representation of CertifyVulnArchive.
+type CertifyVulnArchiveEdge struct {
+	Node   *CertifyVulnArchive `json:"node"`
+	Cursor Cursor              `json:"cursor"`
+}
+
+// CertifyVulnArchiveConnection is the connection containing edges to CertifyVulnArchive.
+type CertifyVulnArchiveConnection struct {
+	Edges      []*CertifyVulnArchiveEdge `json:"edges"`
+	PageInfo   PageInfo                  `json:"pageInfo"`
+	TotalCount int                       `json:"totalCount"`
+}
+
+func (c *CertifyVulnArchiveConnection) build(nodes []*CertifyVulnArchive, pager *certifyvulnarchivePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *CertifyVulnArchive
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *CertifyVulnArchive {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *CertifyVulnArchive {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*CertifyVulnArchiveEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &CertifyVulnArchiveEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// CertifyVulnArchivePaginateOption enables pagination customization.
+type CertifyVulnArchivePaginateOption func(*certifyvulnarchivePager) error
+
+// WithCertifyVulnArchiveOrder configures pagination ordering.
+func WithCertifyVulnArchiveOrder(order *CertifyVulnArchiveOrder) CertifyVulnArchivePaginateOption {
+	if order == nil {
+		order = DefaultCertifyVulnArchiveOrder
+	}
+	o := *order
+	return func(pager *certifyvulnarchivePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultCertifyVulnArchiveOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithCertifyVulnArchiveFilter configures pagination filter.
+func WithCertifyVulnArchiveFilter(filter func(*CertifyVulnArchiveQuery) (*CertifyVulnArchiveQuery, error)) CertifyVulnArchivePaginateOption {
+	return func(pager *certifyvulnarchivePager) error {
+		if filter == nil {
+			return errors.New("CertifyVulnArchiveQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type certifyvulnarchivePager struct {
+	reverse bool
+	order   *CertifyVulnArchiveOrder
+	filter  func(*CertifyVulnArchiveQuery) (*CertifyVulnArchiveQuery, error)
+}
+
+func newCertifyVulnArchivePager(opts []CertifyVulnArchivePaginateOption, reverse bool) (*certifyvulnarchivePager, error) {
+	pager := &certifyvulnarchivePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultCertifyVulnArchiveOrder
+	}
+	return pager, nil
+}
+
+func (p *certifyvulnarchivePager) applyFilter(query *CertifyVulnArchiveQuery) (*CertifyVulnArchiveQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *certifyvulnarchivePager) toCursor(cva *CertifyVulnArchive) Cursor {
+	return p.order.Field.toCursor(cva)
+}
+
+func (p *certifyvulnarchivePager) applyCursors(query *CertifyVulnArchiveQuery, after, before *Cursor) (*CertifyVulnArchiveQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultCertifyVulnArchiveOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *certifyvulnarchivePager) applyOrder(query *CertifyVulnArchiveQuery) *CertifyVulnArchiveQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultCertifyVulnArchiveOrder.Field {
+		query = query.Order(DefaultCertifyVulnArchiveOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *certifyvulnarchivePager) orderExpr(query *CertifyVulnArchiveQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultCertifyVulnArchiveOrder.Field {
+			b.Comma().Ident(DefaultCertifyVulnArchiveOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to CertifyVulnArchive.
+func (cva *CertifyVulnArchiveQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...CertifyVulnArchivePaginateOption,
+) (*CertifyVulnArchiveConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newCertifyVulnArchivePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if cva, err = pager.applyFilter(cva); err != nil {
+		return nil, err
+	}
+	conn := &CertifyVulnArchiveConnection{Edges: []*CertifyVulnArchiveEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			if conn.TotalCount, err = cva.Clone().Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if cva, err = pager.applyCursors(cva, after, before); err != nil {
+		return nil, err
+	}
+	if limit := paginateLimit(first, last); limit != 0 {
+		cva.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := cva.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	cva = pager.applyOrder(cva)
+	nodes, err := cva.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// CertifyVulnArchiveOrderField defines the ordering field of CertifyVulnArchive.
+type CertifyVulnArchiveOrderField struct {
+	// Value extracts the ordering value from the given CertifyVulnArchive.
+	Value    func(*CertifyVulnArchive) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) certifyvulnarchive.OrderOption
+	toCursor func(*CertifyVulnArchive) Cursor
+}
+
+// CertifyVulnArchiveOrder defines the ordering of CertifyVulnArchive.
+type CertifyVulnArchiveOrder struct {
+	Direction OrderDirection                `json:"direction"`
+	Field     *CertifyVulnArchiveOrderField `json:"field"`
+}
+
+// DefaultCertifyVulnArchiveOrder is the default ordering of CertifyVulnArchive.
+var DefaultCertifyVulnArchiveOrder = &CertifyVulnArchiveOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &CertifyVulnArchiveOrderField{
+		Value: func(cva *CertifyVulnArchive) (ent.Value, error) {
+			return cva.ID, nil
+		},
+		column: certifyvulnarchive.FieldID,
+		toTerm: certifyvulnarchive.ByID,
+		toCursor: func(cva *CertifyVulnArchive) Cursor {
+			return Cursor{ID: cva.ID}
+		},
+	},
+}
+
+// ToEdge converts CertifyVulnArchive into CertifyVulnArchiveEdge.
+func (cva *CertifyVulnArchive) ToEdge(order *CertifyVulnArchiveOrder) *CertifyVulnArchiveEdge {
+	if order == nil {
+		order = DefaultCertifyVulnArchiveOrder
+	}
+	return &CertifyVulnArchiveEdge{
+		Node:   cva,
+		Cursor: order.Field.toCursor(cva),
+	}
+}
+
 // DependencyEdge is the edge representation of Dependency.
 type DependencyEdge struct {
 	Node   *Dependency `json:"node"`
@@ -2332,6 +2580,252 @@ func (d *Dependency) ToEdge(order *DependencyOrder) *DependencyEdge {
 	}
 }
 
+// ExploitReferenceEdge is the edge representation of ExploitReference.
+type ExploitReferenceEdge struct {
+	Node   *ExploitReference `json:"node"`
+	Cursor Cursor            `json:"cursor"`
+}
+
+// ExploitReferenceConnection is the connection containing edges to ExploitReference.
+type ExploitReferenceConnection struct {
+	Edges      []*ExploitReferenceEdge `json:"edges"`
+	PageInfo   PageInfo                `json:"pageInfo"`
+	TotalCount int                     `json:"totalCount"`
+}
+
+func (c *ExploitReferenceConnection) build(nodes []*ExploitReference, pager *exploitreferencePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *ExploitReference
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *ExploitReference {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *ExploitReference {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*ExploitReferenceEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &ExploitReferenceEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// ExploitReferencePaginateOption enables pagination customization.
+type ExploitReferencePaginateOption func(*exploitreferencePager) error
+
+// WithExploitReferenceOrder configures pagination ordering.
+func WithExploitReferenceOrder(order *ExploitReferenceOrder) ExploitReferencePaginateOption {
+	if order == nil {
+		order = DefaultExploitReferenceOrder
+	}
+	o := *order
+	return func(pager *exploitreferencePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultExploitReferenceOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithExploitReferenceFilter configures pagination filter.
+func WithExploitReferenceFilter(filter func(*ExploitReferenceQuery) (*ExploitReferenceQuery, error)) ExploitReferencePaginateOption {
+	return func(pager *exploitreferencePager) error {
+		if filter == nil {
+			return errors.New("ExploitReferenceQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type exploitreferencePager struct {
+	reverse bool
+	order   *ExploitReferenceOrder
+	filter  func(*ExploitReferenceQuery) (*ExploitReferenceQuery, error)
+}
+
+func newExploitReferencePager(opts []ExploitReferencePaginateOption, reverse bool) (*exploitreferencePager, error) {
+	pager := &exploitreferencePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultExploitReferenceOrder
+	}
+	return pager, nil
+}
+
+func (p *exploitreferencePager) applyFilter(query *ExploitReferenceQuery) (*ExploitReferenceQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *exploitreferencePager) toCursor(er *ExploitReference) Cursor {
+	return p.order.Field.toCursor(er)
+}
+
+func (p *exploitreferencePager) applyCursors(query *ExploitReferenceQuery, after, before *Cursor) (*ExploitReferenceQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultExploitReferenceOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *exploitreferencePager) applyOrder(query *ExploitReferenceQuery) *ExploitReferenceQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultExploitReferenceOrder.Field {
+		query = query.Order(DefaultExploitReferenceOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *exploitreferencePager) orderExpr(query *ExploitReferenceQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultExploitReferenceOrder.Field {
+			b.Comma().Ident(DefaultExploitReferenceOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to ExploitReference.
+func (er *ExploitReferenceQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ExploitReferencePaginateOption,
+) (*ExploitReferenceConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newExploitReferencePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if er, err = pager.applyFilter(er); err != nil {
+		return nil, err
+	}
+	conn := &ExploitReferenceConnection{Edges: []*ExploitReferenceEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			if conn.TotalCount, err = er.Clone().Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if er, err = pager.applyCursors(er, after, before); err != nil {
+		return nil, err
+	}
+	if limit := paginateLimit(first, last); limit != 0 {
+		er.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := er.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	er = pager.applyOrder(er)
+	nodes, err := er.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// ExploitReferenceOrderField defines the ordering field of ExploitReference.
+type ExploitReferenceOrderField struct {
+	// Value extracts the ordering value from the given ExploitReference.
+	Value    func(*ExploitReference) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) exploitreference.OrderOption
+	toCursor func(*ExploitReference) Cursor
+}
+
+// ExploitReferenceOrder defines the ordering of ExploitReference.
+type ExploitReferenceOrder struct {
+	Direction OrderDirection              `json:"direction"`
+	Field     *ExploitReferenceOrderField `json:"field"`
+}
+
+// DefaultExploitReferenceOrder is the default ordering of ExploitReference.
+var DefaultExploitReferenceOrder = &ExploitReferenceOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &ExploitReferenceOrderField{
+		Value: func(er *ExploitReference) (ent.Value, error) {
+			return er.ID, nil
+		},
+		column: exploitreference.FieldID,
+		toTerm: exploitreference.ByID,
+		toCursor: func(er *ExploitReference) Cursor {
+			return Cursor{ID: er.ID}
+		},
+	},
+}
+
+// ToEdge converts ExploitReference into ExploitReferenceEdge.
+func (er *ExploitReference) ToEdge(order *ExploitReferenceOrder) *ExploitReferenceEdge {
+	if order == nil {
+		order = DefaultExploitReferenceOrder
+	}
+	return &ExploitReferenceEdge{
+		Node:   er,
+		Cursor: order.Field.toCursor(er),
+	}
+}
+
 // HasMetadataEdge is the edge representation of HasMetadata.
 type HasMetadataEdge struct {
 	Node   *HasMetadata `json:"node"`
@@ -105,6 +105,18 @@ func (f CertifyVulnFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CertifyVulnMutation", m)
 }
 
+// The CertifyVulnArchiveFunc type is an adapter to allow the use of ordinary
+// function as CertifyVulnArchive mutator.
+type CertifyVulnArchiveFunc func(context.Context, *ent.CertifyVulnArchiveMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CertifyVulnArchiveFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CertifyVulnArchiveMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CertifyVulnArchiveMutation", m)
+}
+
 // The DependencyFunc type is an adapter to allow the use of ordinary
 // function as Dependency mutator.
 type DependencyFunc func(context.Context, *ent.DependencyMutation) (ent.Value, error)
@@ -117,6 +129,18 @@ func (f DependencyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DependencyMutation", m)
 }
 
+// The ExploitReferenceFunc type is an adapter to allow the use of ordinary
+// function as ExploitReference mutator.
+type ExploitReferenceFunc func(context.Context, *ent.ExploitReferenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExploitReferenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExploitReferenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExploitReferenceMutation", m)
+}
+
 // The HasMetadataFunc type is an adapter to allow the use of ordinary
 // function as HasMetadata mutator.
 type HasMetadataFunc func(context.Context, *ent.HasMetadataMutation) (ent.Value, error)
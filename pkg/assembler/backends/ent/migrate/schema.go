@@ -341,6 +341,10 @@ var (
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
 		{Name: "document_ref", Type: field.TypeString},
+		{Name: "cvss_score", Type: field.TypeFloat64, Nullable: true},
+		{Name: "remediation_status", Type: field.TypeEnum, Enums: []string{"OPEN", "STALE"}, Default: "OPEN"},
+		{Name: "resolved_by_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "resolved_by_type", Type: field.TypeString, Nullable: true},
 		{Name: "vulnerability_id", Type: field.TypeUUID},
 		{Name: "package_id", Type: field.TypeUUID},
 	}
@@ -352,13 +356,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "certify_vulns_vulnerability_ids_vulnerability",
-				Columns:    []*schema.Column{CertifyVulnsColumns[9]},
+				Columns:    []*schema.Column{CertifyVulnsColumns[13]},
 				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "certify_vulns_package_versions_package",
-				Columns:    []*schema.Column{CertifyVulnsColumns[10]},
+				Columns:    []*schema.Column{CertifyVulnsColumns[14]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -367,7 +371,62 @@ var (
 			{
 				Name:    "certifyvuln_db_uri_db_version_scanner_uri_scanner_version_origin_collector_time_scanned_document_ref_vulnerability_id_package_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyVulnsColumns[2], CertifyVulnsColumns[3], CertifyVulnsColumns[4], CertifyVulnsColumns[5], CertifyVulnsColumns[6], CertifyVulnsColumns[7], CertifyVulnsColumns[1], CertifyVulnsColumns[8], CertifyVulnsColumns[9], CertifyVulnsColumns[10]},
+				Columns: []*schema.Column{CertifyVulnsColumns[2], CertifyVulnsColumns[3], CertifyVulnsColumns[4], CertifyVulnsColumns[5], CertifyVulnsColumns[6], CertifyVulnsColumns[7], CertifyVulnsColumns[1], CertifyVulnsColumns[8], CertifyVulnsColumns[13], CertifyVulnsColumns[14]},
+			},
+			{
+				Name:    "certifyvuln_document_ref",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyVulnsColumns[8]},
+			},
+		},
+	}
+	// CertifyVulnArchiveColumns holds the columns for the "certify_vuln_archive" table.
+	CertifyVulnArchiveColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "time_scanned", Type: field.TypeTime},
+		{Name: "db_uri", Type: field.TypeString},
+		{Name: "db_version", Type: field.TypeString},
+		{Name: "scanner_uri", Type: field.TypeString},
+		{Name: "scanner_version", Type: field.TypeString},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "document_ref", Type: field.TypeString},
+		{Name: "cvss_score", Type: field.TypeFloat64, Nullable: true},
+		{Name: "remediation_status", Type: field.TypeEnum, Enums: []string{"OPEN", "STALE"}, Default: "OPEN"},
+		{Name: "resolved_by_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "resolved_by_type", Type: field.TypeString, Nullable: true},
+		{Name: "vulnerability_id", Type: field.TypeUUID},
+		{Name: "package_id", Type: field.TypeUUID},
+	}
+	// CertifyVulnArchiveTable holds the schema information for the "certify_vuln_archive" table.
+	CertifyVulnArchiveTable = &schema.Table{
+		Name:       "certify_vuln_archive",
+		Columns:    CertifyVulnArchiveColumns,
+		PrimaryKey: []*schema.Column{CertifyVulnArchiveColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "certify_vuln_archive_vulnerability_ids_vulnerability",
+				Columns:    []*schema.Column{CertifyVulnArchiveColumns[13]},
+				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "certify_vuln_archive_package_versions_package",
+				Columns:    []*schema.Column{CertifyVulnArchiveColumns[14]},
+				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "certifyvulnarchive_time_scanned",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyVulnArchiveColumns[1]},
+			},
+			{
+				Name:    "certifyvulnarchive_document_ref",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyVulnArchiveColumns[8]},
 			},
 		},
 	}
@@ -428,6 +487,38 @@ var (
 			},
 		},
 	}
+	// ExploitReferencesColumns holds the columns for the "exploit_references" table.
+	ExploitReferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "source", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString},
+		{Name: "verified", Type: field.TypeBool},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "document_ref", Type: field.TypeString},
+		{Name: "vulnerability_id_id", Type: field.TypeUUID},
+	}
+	// ExploitReferencesTable holds the schema information for the "exploit_references" table.
+	ExploitReferencesTable = &schema.Table{
+		Name:       "exploit_references",
+		Columns:    ExploitReferencesColumns,
+		PrimaryKey: []*schema.Column{ExploitReferencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "exploit_references_vulnerability_ids_vulnerability_id",
+				Columns:    []*schema.Column{ExploitReferencesColumns[7]},
+				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "exploitreference_vulnerability_id_id_source_url",
+				Unique:  true,
+				Columns: []*schema.Column{ExploitReferencesColumns[7], ExploitReferencesColumns[1], ExploitReferencesColumns[2]},
+			},
+		},
+	}
 	// HasMetadataColumns holds the columns for the "has_metadata" table.
 	HasMetadataColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -705,6 +796,7 @@ var (
 		{Name: "subpath", Type: field.TypeString, Default: ""},
 		{Name: "qualifiers", Type: field.TypeJSON, Nullable: true},
 		{Name: "hash", Type: field.TypeString},
+		{Name: "component_type", Type: field.TypeString, Default: ""},
 		{Name: "name_id", Type: field.TypeUUID},
 	}
 	// PackageVersionsTable holds the schema information for the "package_versions" table.
@@ -715,7 +807,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "package_versions_package_names_versions",
-				Columns:    []*schema.Column{PackageVersionsColumns[5]},
+				Columns:    []*schema.Column{PackageVersionsColumns[6]},
 				RefColumns: []*schema.Column{PackageNamesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -724,7 +816,7 @@ var (
 			{
 				Name:    "packageversion_hash_name_id",
 				Unique:  true,
-				Columns: []*schema.Column{PackageVersionsColumns[4], PackageVersionsColumns[5]},
+				Columns: []*schema.Column{PackageVersionsColumns[4], PackageVersionsColumns[6]},
 			},
 			{
 				Name:    "packageversion_qualifiers",
@@ -739,7 +831,7 @@ var (
 			{
 				Name:    "packageversion_version_subpath_qualifiers_name_id",
 				Unique:  true,
-				Columns: []*schema.Column{PackageVersionsColumns[1], PackageVersionsColumns[2], PackageVersionsColumns[3], PackageVersionsColumns[5]},
+				Columns: []*schema.Column{PackageVersionsColumns[1], PackageVersionsColumns[2], PackageVersionsColumns[3], PackageVersionsColumns[6]},
 			},
 		},
 	}
@@ -1200,7 +1292,9 @@ var (
 		CertifyScorecardsTable,
 		CertifyVexesTable,
 		CertifyVulnsTable,
+		CertifyVulnArchiveTable,
 		DependenciesTable,
+		ExploitReferencesTable,
 		HasMetadataTable,
 		HasSourceAtsTable,
 		HashEqualsTable,
@@ -1240,9 +1334,15 @@ func init() {
 	CertifyVexesTable.ForeignKeys[2].RefTable = VulnerabilityIdsTable
 	CertifyVulnsTable.ForeignKeys[0].RefTable = VulnerabilityIdsTable
 	CertifyVulnsTable.ForeignKeys[1].RefTable = PackageVersionsTable
+	CertifyVulnArchiveTable.ForeignKeys[0].RefTable = VulnerabilityIdsTable
+	CertifyVulnArchiveTable.ForeignKeys[1].RefTable = PackageVersionsTable
+	CertifyVulnArchiveTable.Annotation = &entsql.Annotation{
+		Table: "certify_vuln_archive",
+	}
 	DependenciesTable.ForeignKeys[0].RefTable = PackageVersionsTable
 	DependenciesTable.ForeignKeys[1].RefTable = PackageNamesTable
 	DependenciesTable.ForeignKeys[2].RefTable = PackageVersionsTable
+	ExploitReferencesTable.ForeignKeys[0].RefTable = VulnerabilityIdsTable
 	HasMetadataTable.ForeignKeys[0].RefTable = SourceNamesTable
 	HasMetadataTable.ForeignKeys[1].RefTable = PackageVersionsTable
 	HasMetadataTable.ForeignKeys[2].RefTable = PackageNamesTable
@@ -20,7 +20,9 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvulnarchive"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploitreference"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -56,7 +58,9 @@ const (
 	TypeCertifyScorecard      = "CertifyScorecard"
 	TypeCertifyVex            = "CertifyVex"
 	TypeCertifyVuln           = "CertifyVuln"
+	TypeCertifyVulnArchive    = "CertifyVulnArchive"
 	TypeDependency            = "Dependency"
+	TypeExploitReference      = "ExploitReference"
 	TypeHasMetadata           = "HasMetadata"
 	TypeHasSourceAt           = "HasSourceAt"
 	TypeHashEqual             = "HashEqual"
@@ -7650,6 +7654,11 @@ type CertifyVulnMutation struct {
 	origin               *string
 	collector            *string
 	document_ref         *string
+	cvss_score           *float64
+	addcvss_score        *float64
+	remediation_status   *certifyvuln.RemediationStatus
+	resolved_by_id       *uuid.UUID
+	resolved_by_type     *string
 	clearedFields        map[string]struct{}
 	vulnerability        *uuid.UUID
 	clearedvulnerability bool
@@ -8124,6 +8133,210 @@ func (m *CertifyVulnMutation) ResetDocumentRef() {
 	m.document_ref = nil
 }
 
+// SetCvssScore sets the "cvss_score" field.
+func (m *CertifyVulnMutation) SetCvssScore(f float64) {
+	m.cvss_score = &f
+	m.addcvss_score = nil
+}
+
+// CvssScore returns the value of the "cvss_score" field in the mutation.
+func (m *CertifyVulnMutation) CvssScore() (r float64, exists bool) {
+	v := m.cvss_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCvssScore returns the old "cvss_score" field's value of the CertifyVuln entity.
+// If the CertifyVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnMutation) OldCvssScore(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCvssScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCvssScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCvssScore: %w", err)
+	}
+	return oldValue.CvssScore, nil
+}
+
+// AddCvssScore adds f to the "cvss_score" field.
+func (m *CertifyVulnMutation) AddCvssScore(f float64) {
+	if m.addcvss_score != nil {
+		*m.addcvss_score += f
+	} else {
+		m.addcvss_score = &f
+	}
+}
+
+// AddedCvssScore returns the value that was added to the "cvss_score" field in this mutation.
+func (m *CertifyVulnMutation) AddedCvssScore() (r float64, exists bool) {
+	v := m.addcvss_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (m *CertifyVulnMutation) ClearCvssScore() {
+	m.cvss_score = nil
+	m.addcvss_score = nil
+	m.clearedFields[certifyvuln.FieldCvssScore] = struct{}{}
+}
+
+// CvssScoreCleared returns if the "cvss_score" field was cleared in this mutation.
+func (m *CertifyVulnMutation) CvssScoreCleared() bool {
+	_, ok := m.clearedFields[certifyvuln.FieldCvssScore]
+	return ok
+}
+
+// ResetCvssScore resets all changes to the "cvss_score" field.
+func (m *CertifyVulnMutation) ResetCvssScore() {
+	m.cvss_score = nil
+	m.addcvss_score = nil
+	delete(m.clearedFields, certifyvuln.FieldCvssScore)
+}
+
+// SetRemediationStatus sets the "remediation_status" field.
+func (m *CertifyVulnMutation) SetRemediationStatus(cs certifyvuln.RemediationStatus) {
+	m.remediation_status = &cs
+}
+
+// RemediationStatus returns the value of the "remediation_status" field in the mutation.
+func (m *CertifyVulnMutation) RemediationStatus() (r certifyvuln.RemediationStatus, exists bool) {
+	v := m.remediation_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemediationStatus returns the old "remediation_status" field's value of the CertifyVuln entity.
+// If the CertifyVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnMutation) OldRemediationStatus(ctx context.Context) (v certifyvuln.RemediationStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemediationStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemediationStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemediationStatus: %w", err)
+	}
+	return oldValue.RemediationStatus, nil
+}
+
+// ResetRemediationStatus resets all changes to the "remediation_status" field.
+func (m *CertifyVulnMutation) ResetRemediationStatus() {
+	m.remediation_status = nil
+}
+
+// SetResolvedByID sets the "resolved_by_id" field.
+func (m *CertifyVulnMutation) SetResolvedByID(u uuid.UUID) {
+	m.resolved_by_id = &u
+}
+
+// ResolvedByID returns the value of the "resolved_by_id" field in the mutation.
+func (m *CertifyVulnMutation) ResolvedByID() (r uuid.UUID, exists bool) {
+	v := m.resolved_by_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedByID returns the old "resolved_by_id" field's value of the CertifyVuln entity.
+// If the CertifyVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnMutation) OldResolvedByID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedByID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedByID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedByID: %w", err)
+	}
+	return oldValue.ResolvedByID, nil
+}
+
+// ClearResolvedByID clears the value of the "resolved_by_id" field.
+func (m *CertifyVulnMutation) ClearResolvedByID() {
+	m.resolved_by_id = nil
+	m.clearedFields[certifyvuln.FieldResolvedByID] = struct{}{}
+}
+
+// ResolvedByIDCleared returns if the "resolved_by_id" field was cleared in this mutation.
+func (m *CertifyVulnMutation) ResolvedByIDCleared() bool {
+	_, ok := m.clearedFields[certifyvuln.FieldResolvedByID]
+	return ok
+}
+
+// ResetResolvedByID resets all changes to the "resolved_by_id" field.
+func (m *CertifyVulnMutation) ResetResolvedByID() {
+	m.resolved_by_id = nil
+	delete(m.clearedFields, certifyvuln.FieldResolvedByID)
+}
+
+// SetResolvedByType sets the "resolved_by_type" field.
+func (m *CertifyVulnMutation) SetResolvedByType(s string) {
+	m.resolved_by_type = &s
+}
+
+// ResolvedByType returns the value of the "resolved_by_type" field in the mutation.
+func (m *CertifyVulnMutation) ResolvedByType() (r string, exists bool) {
+	v := m.resolved_by_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedByType returns the old "resolved_by_type" field's value of the CertifyVuln entity.
+// If the CertifyVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnMutation) OldResolvedByType(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedByType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedByType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedByType: %w", err)
+	}
+	return oldValue.ResolvedByType, nil
+}
+
+// ClearResolvedByType clears the value of the "resolved_by_type" field.
+func (m *CertifyVulnMutation) ClearResolvedByType() {
+	m.resolved_by_type = nil
+	m.clearedFields[certifyvuln.FieldResolvedByType] = struct{}{}
+}
+
+// ResolvedByTypeCleared returns if the "resolved_by_type" field was cleared in this mutation.
+func (m *CertifyVulnMutation) ResolvedByTypeCleared() bool {
+	_, ok := m.clearedFields[certifyvuln.FieldResolvedByType]
+	return ok
+}
+
+// ResetResolvedByType resets all changes to the "resolved_by_type" field.
+func (m *CertifyVulnMutation) ResetResolvedByType() {
+	m.resolved_by_type = nil
+	delete(m.clearedFields, certifyvuln.FieldResolvedByType)
+}
+
 // ClearVulnerability clears the "vulnerability" edge to the VulnerabilityID entity.
 func (m *CertifyVulnMutation) ClearVulnerability() {
 	m.clearedvulnerability = true
@@ -8212,7 +8425,7 @@ func (m *CertifyVulnMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CertifyVulnMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+	fields := make([]string, 0, 14)
 	if m.vulnerability != nil {
 		fields = append(fields, certifyvuln.FieldVulnerabilityID)
 	}
@@ -8243,6 +8456,18 @@ func (m *CertifyVulnMutation) Fields() []string {
 	if m.document_ref != nil {
 		fields = append(fields, certifyvuln.FieldDocumentRef)
 	}
+	if m.cvss_score != nil {
+		fields = append(fields, certifyvuln.FieldCvssScore)
+	}
+	if m.remediation_status != nil {
+		fields = append(fields, certifyvuln.FieldRemediationStatus)
+	}
+	if m.resolved_by_id != nil {
+		fields = append(fields, certifyvuln.FieldResolvedByID)
+	}
+	if m.resolved_by_type != nil {
+		fields = append(fields, certifyvuln.FieldResolvedByType)
+	}
 	return fields
 }
 
@@ -8271,6 +8496,14 @@ func (m *CertifyVulnMutation) Field(name string) (ent.Value, bool) {
 		return m.Collector()
 	case certifyvuln.FieldDocumentRef:
 		return m.DocumentRef()
+	case certifyvuln.FieldCvssScore:
+		return m.CvssScore()
+	case certifyvuln.FieldRemediationStatus:
+		return m.RemediationStatus()
+	case certifyvuln.FieldResolvedByID:
+		return m.ResolvedByID()
+	case certifyvuln.FieldResolvedByType:
+		return m.ResolvedByType()
 	}
 	return nil, false
 }
@@ -8300,6 +8533,14 @@ func (m *CertifyVulnMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldCollector(ctx)
 	case certifyvuln.FieldDocumentRef:
 		return m.OldDocumentRef(ctx)
+	case certifyvuln.FieldCvssScore:
+		return m.OldCvssScore(ctx)
+	case certifyvuln.FieldRemediationStatus:
+		return m.OldRemediationStatus(ctx)
+	case certifyvuln.FieldResolvedByID:
+		return m.OldResolvedByID(ctx)
+	case certifyvuln.FieldResolvedByType:
+		return m.OldResolvedByType(ctx)
 	}
 	return nil, fmt.Errorf("unknown CertifyVuln field %s", name)
 }
@@ -8379,6 +8620,34 @@ func (m *CertifyVulnMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetDocumentRef(v)
 		return nil
+	case certifyvuln.FieldCvssScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCvssScore(v)
+		return nil
+	case certifyvuln.FieldRemediationStatus:
+		v, ok := value.(certifyvuln.RemediationStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemediationStatus(v)
+		return nil
+	case certifyvuln.FieldResolvedByID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedByID(v)
+		return nil
+	case certifyvuln.FieldResolvedByType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedByType(v)
+		return nil
 	}
 	return fmt.Errorf("unknown CertifyVuln field %s", name)
 }
@@ -8386,13 +8655,21 @@ func (m *CertifyVulnMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *CertifyVulnMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addcvss_score != nil {
+		fields = append(fields, certifyvuln.FieldCvssScore)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *CertifyVulnMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case certifyvuln.FieldCvssScore:
+		return m.AddedCvssScore()
+	}
 	return nil, false
 }
 
@@ -8401,6 +8678,13 @@ func (m *CertifyVulnMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *CertifyVulnMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case certifyvuln.FieldCvssScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCvssScore(v)
+		return nil
 	}
 	return fmt.Errorf("unknown CertifyVuln numeric field %s", name)
 }
@@ -8408,7 +8692,17 @@ func (m *CertifyVulnMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *CertifyVulnMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(certifyvuln.FieldCvssScore) {
+		fields = append(fields, certifyvuln.FieldCvssScore)
+	}
+	if m.FieldCleared(certifyvuln.FieldResolvedByID) {
+		fields = append(fields, certifyvuln.FieldResolvedByID)
+	}
+	if m.FieldCleared(certifyvuln.FieldResolvedByType) {
+		fields = append(fields, certifyvuln.FieldResolvedByType)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -8421,6 +8715,17 @@ func (m *CertifyVulnMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *CertifyVulnMutation) ClearField(name string) error {
+	switch name {
+	case certifyvuln.FieldCvssScore:
+		m.ClearCvssScore()
+		return nil
+	case certifyvuln.FieldResolvedByID:
+		m.ClearResolvedByID()
+		return nil
+	case certifyvuln.FieldResolvedByType:
+		m.ClearResolvedByType()
+		return nil
+	}
 	return fmt.Errorf("unknown CertifyVuln nullable field %s", name)
 }
 
@@ -8458,6 +8763,18 @@ func (m *CertifyVulnMutation) ResetField(name string) error {
 	case certifyvuln.FieldDocumentRef:
 		m.ResetDocumentRef()
 		return nil
+	case certifyvuln.FieldCvssScore:
+		m.ResetCvssScore()
+		return nil
+	case certifyvuln.FieldRemediationStatus:
+		m.ResetRemediationStatus()
+		return nil
+	case certifyvuln.FieldResolvedByID:
+		m.ResetResolvedByID()
+		return nil
+	case certifyvuln.FieldResolvedByType:
+		m.ResetResolvedByType()
+		return nil
 	}
 	return fmt.Errorf("unknown CertifyVuln field %s", name)
 }
@@ -8554,44 +8871,46 @@ func (m *CertifyVulnMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown CertifyVuln edge %s", name)
 }
 
-// DependencyMutation represents an operation that mutates the Dependency nodes in the graph.
-type DependencyMutation struct {
+// CertifyVulnArchiveMutation represents an operation that mutates the CertifyVulnArchive nodes in the graph.
+type CertifyVulnArchiveMutation struct {
 	config
-	op                               Op
-	typ                              string
-	id                               *uuid.UUID
-	version_range                    *string
-	dependency_type                  *dependency.DependencyType
-	justification                    *string
-	origin                           *string
-	collector                        *string
-	document_ref                     *string
-	clearedFields                    map[string]struct{}
-	_package                         *uuid.UUID
-	cleared_package                  bool
-	dependent_package_name           *uuid.UUID
-	cleareddependent_package_name    bool
-	dependent_package_version        *uuid.UUID
-	cleareddependent_package_version bool
-	included_in_sboms                map[uuid.UUID]struct{}
-	removedincluded_in_sboms         map[uuid.UUID]struct{}
-	clearedincluded_in_sboms         bool
-	done                             bool
-	oldValue                         func(context.Context) (*Dependency, error)
-	predicates                       []predicate.Dependency
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	time_scanned         *time.Time
+	db_uri               *string
+	db_version           *string
+	scanner_uri          *string
+	scanner_version      *string
+	origin               *string
+	collector            *string
+	document_ref         *string
+	cvss_score           *float64
+	addcvss_score        *float64
+	remediation_status   *certifyvulnarchive.RemediationStatus
+	resolved_by_id       *uuid.UUID
+	resolved_by_type     *string
+	clearedFields        map[string]struct{}
+	vulnerability        *uuid.UUID
+	clearedvulnerability bool
+	_package             *uuid.UUID
+	cleared_package      bool
+	done                 bool
+	oldValue             func(context.Context) (*CertifyVulnArchive, error)
+	predicates           []predicate.CertifyVulnArchive
 }
 
-var _ ent.Mutation = (*DependencyMutation)(nil)
+var _ ent.Mutation = (*CertifyVulnArchiveMutation)(nil)
 
-// dependencyOption allows management of the mutation configuration using functional options.
-type dependencyOption func(*DependencyMutation)
+// certifyvulnarchiveOption allows management of the mutation configuration using functional options.
+type certifyvulnarchiveOption func(*CertifyVulnArchiveMutation)
 
-// newDependencyMutation creates new mutation for the Dependency entity.
-func newDependencyMutation(c config, op Op, opts ...dependencyOption) *DependencyMutation {
-	m := &DependencyMutation{
+// newCertifyVulnArchiveMutation creates new mutation for the CertifyVulnArchive entity.
+func newCertifyVulnArchiveMutation(c config, op Op, opts ...certifyvulnarchiveOption) *CertifyVulnArchiveMutation {
+	m := &CertifyVulnArchiveMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDependency,
+		typ:           TypeCertifyVulnArchive,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8600,20 +8919,20 @@ func newDependencyMutation(c config, op Op, opts ...dependencyOption) *Dependenc
 	return m
 }
 
-// withDependencyID sets the ID field of the mutation.
-func withDependencyID(id uuid.UUID) dependencyOption {
-	return func(m *DependencyMutation) {
+// withCertifyVulnArchiveID sets the ID field of the mutation.
+func withCertifyVulnArchiveID(id uuid.UUID) certifyvulnarchiveOption {
+	return func(m *CertifyVulnArchiveMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Dependency
+			value *CertifyVulnArchive
 		)
-		m.oldValue = func(ctx context.Context) (*Dependency, error) {
+		m.oldValue = func(ctx context.Context) (*CertifyVulnArchive, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Dependency.Get(ctx, id)
+					value, err = m.Client().CertifyVulnArchive.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8622,10 +8941,10 @@ func withDependencyID(id uuid.UUID) dependencyOption {
 	}
 }
 
-// withDependency sets the old Dependency of the mutation.
-func withDependency(node *Dependency) dependencyOption {
-	return func(m *DependencyMutation) {
-		m.oldValue = func(context.Context) (*Dependency, error) {
+// withCertifyVulnArchive sets the old CertifyVulnArchive of the mutation.
+func withCertifyVulnArchive(node *CertifyVulnArchive) certifyvulnarchiveOption {
+	return func(m *CertifyVulnArchiveMutation) {
+		m.oldValue = func(context.Context) (*CertifyVulnArchive, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8634,7 +8953,7 @@ func withDependency(node *Dependency) dependencyOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DependencyMutation) Client() *Client {
+func (m CertifyVulnArchiveMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8642,7 +8961,7 @@ func (m DependencyMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DependencyMutation) Tx() (*Tx, error) {
+func (m CertifyVulnArchiveMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8652,14 +8971,14 @@ func (m DependencyMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Dependency entities.
-func (m *DependencyMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of CertifyVulnArchive entities.
+func (m *CertifyVulnArchiveMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DependencyMutation) ID() (id uuid.UUID, exists bool) {
+func (m *CertifyVulnArchiveMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8670,7 +8989,7 @@ func (m *DependencyMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DependencyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *CertifyVulnArchiveMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8679,261 +8998,271 @@ func (m *DependencyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Dependency.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CertifyVulnArchive.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetPackageID sets the "package_id" field.
-func (m *DependencyMutation) SetPackageID(u uuid.UUID) {
-	m._package = &u
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (m *CertifyVulnArchiveMutation) SetVulnerabilityID(u uuid.UUID) {
+	m.vulnerability = &u
 }
 
-// PackageID returns the value of the "package_id" field in the mutation.
-func (m *DependencyMutation) PackageID() (r uuid.UUID, exists bool) {
-	v := m._package
+// VulnerabilityID returns the value of the "vulnerability_id" field in the mutation.
+func (m *CertifyVulnArchiveMutation) VulnerabilityID() (r uuid.UUID, exists bool) {
+	v := m.vulnerability
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPackageID returns the old "package_id" field's value of the Dependency entity.
-// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// OldVulnerabilityID returns the old "vulnerability_id" field's value of the CertifyVulnArchive entity.
+// If the CertifyVulnArchive object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DependencyMutation) OldPackageID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *CertifyVulnArchiveMutation) OldVulnerabilityID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPackageID is only allowed on UpdateOne operations")
+		return v, errors.New("OldVulnerabilityID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPackageID requires an ID field in the mutation")
+		return v, errors.New("OldVulnerabilityID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPackageID: %w", err)
+		return v, fmt.Errorf("querying old value for OldVulnerabilityID: %w", err)
 	}
-	return oldValue.PackageID, nil
+	return oldValue.VulnerabilityID, nil
 }
 
-// ResetPackageID resets all changes to the "package_id" field.
-func (m *DependencyMutation) ResetPackageID() {
-	m._package = nil
+// ResetVulnerabilityID resets all changes to the "vulnerability_id" field.
+func (m *CertifyVulnArchiveMutation) ResetVulnerabilityID() {
+	m.vulnerability = nil
 }
 
-// SetDependentPackageNameID sets the "dependent_package_name_id" field.
-func (m *DependencyMutation) SetDependentPackageNameID(u uuid.UUID) {
-	m.dependent_package_name = &u
+// SetPackageID sets the "package_id" field.
+func (m *CertifyVulnArchiveMutation) SetPackageID(u uuid.UUID) {
+	m._package = &u
 }
 
-// DependentPackageNameID returns the value of the "dependent_package_name_id" field in the mutation.
-func (m *DependencyMutation) DependentPackageNameID() (r uuid.UUID, exists bool) {
-	v := m.dependent_package_name
+// PackageID returns the value of the "package_id" field in the mutation.
+func (m *CertifyVulnArchiveMutation) PackageID() (r uuid.UUID, exists bool) {
+	v := m._package
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDependentPackageNameID returns the old "dependent_package_name_id" field's value of the Dependency entity.
-// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// OldPackageID returns the old "package_id" field's value of the CertifyVulnArchive entity.
+// If the CertifyVulnArchive object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DependencyMutation) OldDependentPackageNameID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *CertifyVulnArchiveMutation) OldPackageID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDependentPackageNameID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPackageID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDependentPackageNameID requires an ID field in the mutation")
+		return v, errors.New("OldPackageID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDependentPackageNameID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPackageID: %w", err)
 	}
-	return oldValue.DependentPackageNameID, nil
-}
-
-// ClearDependentPackageNameID clears the value of the "dependent_package_name_id" field.
-func (m *DependencyMutation) ClearDependentPackageNameID() {
-	m.dependent_package_name = nil
-	m.clearedFields[dependency.FieldDependentPackageNameID] = struct{}{}
-}
-
-// DependentPackageNameIDCleared returns if the "dependent_package_name_id" field was cleared in this mutation.
-func (m *DependencyMutation) DependentPackageNameIDCleared() bool {
-	_, ok := m.clearedFields[dependency.FieldDependentPackageNameID]
-	return ok
+	return oldValue.PackageID, nil
 }
 
-// ResetDependentPackageNameID resets all changes to the "dependent_package_name_id" field.
-func (m *DependencyMutation) ResetDependentPackageNameID() {
-	m.dependent_package_name = nil
-	delete(m.clearedFields, dependency.FieldDependentPackageNameID)
+// ResetPackageID resets all changes to the "package_id" field.
+func (m *CertifyVulnArchiveMutation) ResetPackageID() {
+	m._package = nil
 }
 
-// SetDependentPackageVersionID sets the "dependent_package_version_id" field.
-func (m *DependencyMutation) SetDependentPackageVersionID(u uuid.UUID) {
-	m.dependent_package_version = &u
+// SetTimeScanned sets the "time_scanned" field.
+func (m *CertifyVulnArchiveMutation) SetTimeScanned(t time.Time) {
+	m.time_scanned = &t
 }
 
-// DependentPackageVersionID returns the value of the "dependent_package_version_id" field in the mutation.
-func (m *DependencyMutation) DependentPackageVersionID() (r uuid.UUID, exists bool) {
-	v := m.dependent_package_version
+// TimeScanned returns the value of the "time_scanned" field in the mutation.
+func (m *CertifyVulnArchiveMutation) TimeScanned() (r time.Time, exists bool) {
+	v := m.time_scanned
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDependentPackageVersionID returns the old "dependent_package_version_id" field's value of the Dependency entity.
-// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// OldTimeScanned returns the old "time_scanned" field's value of the CertifyVulnArchive entity.
+// If the CertifyVulnArchive object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DependencyMutation) OldDependentPackageVersionID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *CertifyVulnArchiveMutation) OldTimeScanned(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDependentPackageVersionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimeScanned is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDependentPackageVersionID requires an ID field in the mutation")
+		return v, errors.New("OldTimeScanned requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDependentPackageVersionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimeScanned: %w", err)
 	}
-	return oldValue.DependentPackageVersionID, nil
+	return oldValue.TimeScanned, nil
 }
 
-// ClearDependentPackageVersionID clears the value of the "dependent_package_version_id" field.
-func (m *DependencyMutation) ClearDependentPackageVersionID() {
-	m.dependent_package_version = nil
-	m.clearedFields[dependency.FieldDependentPackageVersionID] = struct{}{}
+// ResetTimeScanned resets all changes to the "time_scanned" field.
+func (m *CertifyVulnArchiveMutation) ResetTimeScanned() {
+	m.time_scanned = nil
 }
 
-// DependentPackageVersionIDCleared returns if the "dependent_package_version_id" field was cleared in this mutation.
-func (m *DependencyMutation) DependentPackageVersionIDCleared() bool {
-	_, ok := m.clearedFields[dependency.FieldDependentPackageVersionID]
-	return ok
+// SetDbURI sets the "db_uri" field.
+func (m *CertifyVulnArchiveMutation) SetDbURI(s string) {
+	m.db_uri = &s
 }
 
-// ResetDependentPackageVersionID resets all changes to the "dependent_package_version_id" field.
-func (m *DependencyMutation) ResetDependentPackageVersionID() {
-	m.dependent_package_version = nil
-	delete(m.clearedFields, dependency.FieldDependentPackageVersionID)
+// DbURI returns the value of the "db_uri" field in the mutation.
+func (m *CertifyVulnArchiveMutation) DbURI() (r string, exists bool) {
+	v := m.db_uri
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetVersionRange sets the "version_range" field.
-func (m *DependencyMutation) SetVersionRange(s string) {
-	m.version_range = &s
+// OldDbURI returns the old "db_uri" field's value of the CertifyVulnArchive entity.
+// If the CertifyVulnArchive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnArchiveMutation) OldDbURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDbURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDbURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDbURI: %w", err)
+	}
+	return oldValue.DbURI, nil
 }
 
-// VersionRange returns the value of the "version_range" field in the mutation.
-func (m *DependencyMutation) VersionRange() (r string, exists bool) {
-	v := m.version_range
+// ResetDbURI resets all changes to the "db_uri" field.
+func (m *CertifyVulnArchiveMutation) ResetDbURI() {
+	m.db_uri = nil
+}
+
+// SetDbVersion sets the "db_version" field.
+func (m *CertifyVulnArchiveMutation) SetDbVersion(s string) {
+	m.db_version = &s
+}
+
+// DbVersion returns the value of the "db_version" field in the mutation.
+func (m *CertifyVulnArchiveMutation) DbVersion() (r string, exists bool) {
+	v := m.db_version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVersionRange returns the old "version_range" field's value of the Dependency entity.
-// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// OldDbVersion returns the old "db_version" field's value of the CertifyVulnArchive entity.
+// If the CertifyVulnArchive object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DependencyMutation) OldVersionRange(ctx context.Context) (v string, err error) {
+func (m *CertifyVulnArchiveMutation) OldDbVersion(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersionRange is only allowed on UpdateOne operations")
+		return v, errors.New("OldDbVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersionRange requires an ID field in the mutation")
+		return v, errors.New("OldDbVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersionRange: %w", err)
+		return v, fmt.Errorf("querying old value for OldDbVersion: %w", err)
 	}
-	return oldValue.VersionRange, nil
+	return oldValue.DbVersion, nil
 }
 
-// ResetVersionRange resets all changes to the "version_range" field.
-func (m *DependencyMutation) ResetVersionRange() {
-	m.version_range = nil
+// ResetDbVersion resets all changes to the "db_version" field.
+func (m *CertifyVulnArchiveMutation) ResetDbVersion() {
+	m.db_version = nil
 }
 
-// SetDependencyType sets the "dependency_type" field.
-func (m *DependencyMutation) SetDependencyType(dt dependency.DependencyType) {
-	m.dependency_type = &dt
+// SetScannerURI sets the "scanner_uri" field.
+func (m *CertifyVulnArchiveMutation) SetScannerURI(s string) {
+	m.scanner_uri = &s
 }
 
-// DependencyType returns the value of the "dependency_type" field in the mutation.
-func (m *DependencyMutation) DependencyType() (r dependency.DependencyType, exists bool) {
-	v := m.dependency_type
+// ScannerURI returns the value of the "scanner_uri" field in the mutation.
+func (m *CertifyVulnArchiveMutation) ScannerURI() (r string, exists bool) {
+	v := m.scanner_uri
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDependencyType returns the old "dependency_type" field's value of the Dependency entity.
-// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// OldScannerURI returns the old "scanner_uri" field's value of the CertifyVulnArchive entity.
+// If the CertifyVulnArchive object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DependencyMutation) OldDependencyType(ctx context.Context) (v dependency.DependencyType, err error) {
+func (m *CertifyVulnArchiveMutation) OldScannerURI(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDependencyType is only allowed on UpdateOne operations")
+		return v, errors.New("OldScannerURI is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDependencyType requires an ID field in the mutation")
+		return v, errors.New("OldScannerURI requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDependencyType: %w", err)
+		return v, fmt.Errorf("querying old value for OldScannerURI: %w", err)
 	}
-	return oldValue.DependencyType, nil
+	return oldValue.ScannerURI, nil
 }
 
-// ResetDependencyType resets all changes to the "dependency_type" field.
-func (m *DependencyMutation) ResetDependencyType() {
-	m.dependency_type = nil
+// ResetScannerURI resets all changes to the "scanner_uri" field.
+func (m *CertifyVulnArchiveMutation) ResetScannerURI() {
+	m.scanner_uri = nil
 }
 
-// SetJustification sets the "justification" field.
-func (m *DependencyMutation) SetJustification(s string) {
-	m.justification = &s
+// SetScannerVersion sets the "scanner_version" field.
+func (m *CertifyVulnArchiveMutation) SetScannerVersion(s string) {
+	m.scanner_version = &s
 }
 
-// Justification returns the value of the "justification" field in the mutation.
-func (m *DependencyMutation) Justification() (r string, exists bool) {
-	v := m.justification
+// ScannerVersion returns the value of the "scanner_version" field in the mutation.
+func (m *CertifyVulnArchiveMutation) ScannerVersion() (r string, exists bool) {
+	v := m.scanner_version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJustification returns the old "justification" field's value of the Dependency entity.
-// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// OldScannerVersion returns the old "scanner_version" field's value of the CertifyVulnArchive entity.
+// If the CertifyVulnArchive object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DependencyMutation) OldJustification(ctx context.Context) (v string, err error) {
+func (m *CertifyVulnArchiveMutation) OldScannerVersion(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJustification is only allowed on UpdateOne operations")
+		return v, errors.New("OldScannerVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJustification requires an ID field in the mutation")
+		return v, errors.New("OldScannerVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJustification: %w", err)
+		return v, fmt.Errorf("querying old value for OldScannerVersion: %w", err)
 	}
-	return oldValue.Justification, nil
+	return oldValue.ScannerVersion, nil
 }
 
-// ResetJustification resets all changes to the "justification" field.
-func (m *DependencyMutation) ResetJustification() {
-	m.justification = nil
+// ResetScannerVersion resets all changes to the "scanner_version" field.
+func (m *CertifyVulnArchiveMutation) ResetScannerVersion() {
+	m.scanner_version = nil
 }
 
 // SetOrigin sets the "origin" field.
-func (m *DependencyMutation) SetOrigin(s string) {
+func (m *CertifyVulnArchiveMutation) SetOrigin(s string) {
 	m.origin = &s
 }
 
 // Origin returns the value of the "origin" field in the mutation.
-func (m *DependencyMutation) Origin() (r string, exists bool) {
+func (m *CertifyVulnArchiveMutation) Origin() (r string, exists bool) {
 	v := m.origin
 	if v == nil {
 		return
@@ -8941,10 +9270,10 @@ func (m *DependencyMutation) Origin() (r string, exists bool) {
 	return *v, true
 }
 
-// OldOrigin returns the old "origin" field's value of the Dependency entity.
-// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// OldOrigin returns the old "origin" field's value of the CertifyVulnArchive entity.
+// If the CertifyVulnArchive object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DependencyMutation) OldOrigin(ctx context.Context) (v string, err error) {
+func (m *CertifyVulnArchiveMutation) OldOrigin(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
 	}
@@ -8959,17 +9288,17 @@ func (m *DependencyMutation) OldOrigin(ctx context.Context) (v string, err error
 }
 
 // ResetOrigin resets all changes to the "origin" field.
-func (m *DependencyMutation) ResetOrigin() {
+func (m *CertifyVulnArchiveMutation) ResetOrigin() {
 	m.origin = nil
 }
 
 // SetCollector sets the "collector" field.
-func (m *DependencyMutation) SetCollector(s string) {
+func (m *CertifyVulnArchiveMutation) SetCollector(s string) {
 	m.collector = &s
 }
 
 // Collector returns the value of the "collector" field in the mutation.
-func (m *DependencyMutation) Collector() (r string, exists bool) {
+func (m *CertifyVulnArchiveMutation) Collector() (r string, exists bool) {
 	v := m.collector
 	if v == nil {
 		return
@@ -8977,10 +9306,10 @@ func (m *DependencyMutation) Collector() (r string, exists bool) {
 	return *v, true
 }
 
-// OldCollector returns the old "collector" field's value of the Dependency entity.
-// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// OldCollector returns the old "collector" field's value of the CertifyVulnArchive entity.
+// If the CertifyVulnArchive object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DependencyMutation) OldCollector(ctx context.Context) (v string, err error) {
+func (m *CertifyVulnArchiveMutation) OldCollector(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
 	}
@@ -8995,17 +9324,17 @@ func (m *DependencyMutation) OldCollector(ctx context.Context) (v string, err er
 }
 
 // ResetCollector resets all changes to the "collector" field.
-func (m *DependencyMutation) ResetCollector() {
+func (m *CertifyVulnArchiveMutation) ResetCollector() {
 	m.collector = nil
 }
 
 // SetDocumentRef sets the "document_ref" field.
-func (m *DependencyMutation) SetDocumentRef(s string) {
+func (m *CertifyVulnArchiveMutation) SetDocumentRef(s string) {
 	m.document_ref = &s
 }
 
 // DocumentRef returns the value of the "document_ref" field in the mutation.
-func (m *DependencyMutation) DocumentRef() (r string, exists bool) {
+func (m *CertifyVulnArchiveMutation) DocumentRef() (r string, exists bool) {
 	v := m.document_ref
 	if v == nil {
 		return
@@ -9013,10 +9342,10 @@ func (m *DependencyMutation) DocumentRef() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDocumentRef returns the old "document_ref" field's value of the Dependency entity.
-// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// OldDocumentRef returns the old "document_ref" field's value of the CertifyVulnArchive entity.
+// If the CertifyVulnArchive object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DependencyMutation) OldDocumentRef(ctx context.Context) (v string, err error) {
+func (m *CertifyVulnArchiveMutation) OldDocumentRef(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDocumentRef is only allowed on UpdateOne operations")
 	}
@@ -9031,154 +9360,277 @@ func (m *DependencyMutation) OldDocumentRef(ctx context.Context) (v string, err
 }
 
 // ResetDocumentRef resets all changes to the "document_ref" field.
-func (m *DependencyMutation) ResetDocumentRef() {
+func (m *CertifyVulnArchiveMutation) ResetDocumentRef() {
 	m.document_ref = nil
 }
 
-// ClearPackage clears the "package" edge to the PackageVersion entity.
-func (m *DependencyMutation) ClearPackage() {
-	m.cleared_package = true
-	m.clearedFields[dependency.FieldPackageID] = struct{}{}
-}
-
-// PackageCleared reports if the "package" edge to the PackageVersion entity was cleared.
-func (m *DependencyMutation) PackageCleared() bool {
-	return m.cleared_package
+// SetCvssScore sets the "cvss_score" field.
+func (m *CertifyVulnArchiveMutation) SetCvssScore(f float64) {
+	m.cvss_score = &f
+	m.addcvss_score = nil
 }
 
-// PackageIDs returns the "package" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PackageID instead. It exists only for internal usage by the builders.
-func (m *DependencyMutation) PackageIDs() (ids []uuid.UUID) {
-	if id := m._package; id != nil {
-		ids = append(ids, *id)
+// CvssScore returns the value of the "cvss_score" field in the mutation.
+func (m *CertifyVulnArchiveMutation) CvssScore() (r float64, exists bool) {
+	v := m.cvss_score
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetPackage resets all changes to the "package" edge.
-func (m *DependencyMutation) ResetPackage() {
-	m._package = nil
-	m.cleared_package = false
+// OldCvssScore returns the old "cvss_score" field's value of the CertifyVulnArchive entity.
+// If the CertifyVulnArchive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnArchiveMutation) OldCvssScore(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCvssScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCvssScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCvssScore: %w", err)
+	}
+	return oldValue.CvssScore, nil
 }
 
-// ClearDependentPackageName clears the "dependent_package_name" edge to the PackageName entity.
-func (m *DependencyMutation) ClearDependentPackageName() {
-	m.cleareddependent_package_name = true
-	m.clearedFields[dependency.FieldDependentPackageNameID] = struct{}{}
+// AddCvssScore adds f to the "cvss_score" field.
+func (m *CertifyVulnArchiveMutation) AddCvssScore(f float64) {
+	if m.addcvss_score != nil {
+		*m.addcvss_score += f
+	} else {
+		m.addcvss_score = &f
+	}
 }
 
-// DependentPackageNameCleared reports if the "dependent_package_name" edge to the PackageName entity was cleared.
-func (m *DependencyMutation) DependentPackageNameCleared() bool {
-	return m.DependentPackageNameIDCleared() || m.cleareddependent_package_name
+// AddedCvssScore returns the value that was added to the "cvss_score" field in this mutation.
+func (m *CertifyVulnArchiveMutation) AddedCvssScore() (r float64, exists bool) {
+	v := m.addcvss_score
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// DependentPackageNameIDs returns the "dependent_package_name" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DependentPackageNameID instead. It exists only for internal usage by the builders.
-func (m *DependencyMutation) DependentPackageNameIDs() (ids []uuid.UUID) {
-	if id := m.dependent_package_name; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (m *CertifyVulnArchiveMutation) ClearCvssScore() {
+	m.cvss_score = nil
+	m.addcvss_score = nil
+	m.clearedFields[certifyvulnarchive.FieldCvssScore] = struct{}{}
 }
 
-// ResetDependentPackageName resets all changes to the "dependent_package_name" edge.
-func (m *DependencyMutation) ResetDependentPackageName() {
-	m.dependent_package_name = nil
-	m.cleareddependent_package_name = false
+// CvssScoreCleared returns if the "cvss_score" field was cleared in this mutation.
+func (m *CertifyVulnArchiveMutation) CvssScoreCleared() bool {
+	_, ok := m.clearedFields[certifyvulnarchive.FieldCvssScore]
+	return ok
 }
 
-// ClearDependentPackageVersion clears the "dependent_package_version" edge to the PackageVersion entity.
-func (m *DependencyMutation) ClearDependentPackageVersion() {
-	m.cleareddependent_package_version = true
-	m.clearedFields[dependency.FieldDependentPackageVersionID] = struct{}{}
+// ResetCvssScore resets all changes to the "cvss_score" field.
+func (m *CertifyVulnArchiveMutation) ResetCvssScore() {
+	m.cvss_score = nil
+	m.addcvss_score = nil
+	delete(m.clearedFields, certifyvulnarchive.FieldCvssScore)
 }
 
-// DependentPackageVersionCleared reports if the "dependent_package_version" edge to the PackageVersion entity was cleared.
-func (m *DependencyMutation) DependentPackageVersionCleared() bool {
-	return m.DependentPackageVersionIDCleared() || m.cleareddependent_package_version
+// SetRemediationStatus sets the "remediation_status" field.
+func (m *CertifyVulnArchiveMutation) SetRemediationStatus(cs certifyvulnarchive.RemediationStatus) {
+	m.remediation_status = &cs
 }
 
-// DependentPackageVersionIDs returns the "dependent_package_version" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DependentPackageVersionID instead. It exists only for internal usage by the builders.
-func (m *DependencyMutation) DependentPackageVersionIDs() (ids []uuid.UUID) {
-	if id := m.dependent_package_version; id != nil {
-		ids = append(ids, *id)
+// RemediationStatus returns the value of the "remediation_status" field in the mutation.
+func (m *CertifyVulnArchiveMutation) RemediationStatus() (r certifyvulnarchive.RemediationStatus, exists bool) {
+	v := m.remediation_status
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetDependentPackageVersion resets all changes to the "dependent_package_version" edge.
-func (m *DependencyMutation) ResetDependentPackageVersion() {
-	m.dependent_package_version = nil
-	m.cleareddependent_package_version = false
+// OldRemediationStatus returns the old "remediation_status" field's value of the CertifyVulnArchive entity.
+// If the CertifyVulnArchive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnArchiveMutation) OldRemediationStatus(ctx context.Context) (v certifyvulnarchive.RemediationStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemediationStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemediationStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemediationStatus: %w", err)
+	}
+	return oldValue.RemediationStatus, nil
 }
 
-// AddIncludedInSbomIDs adds the "included_in_sboms" edge to the BillOfMaterials entity by ids.
-func (m *DependencyMutation) AddIncludedInSbomIDs(ids ...uuid.UUID) {
-	if m.included_in_sboms == nil {
-		m.included_in_sboms = make(map[uuid.UUID]struct{})
+// ResetRemediationStatus resets all changes to the "remediation_status" field.
+func (m *CertifyVulnArchiveMutation) ResetRemediationStatus() {
+	m.remediation_status = nil
+}
+
+// SetResolvedByID sets the "resolved_by_id" field.
+func (m *CertifyVulnArchiveMutation) SetResolvedByID(u uuid.UUID) {
+	m.resolved_by_id = &u
+}
+
+// ResolvedByID returns the value of the "resolved_by_id" field in the mutation.
+func (m *CertifyVulnArchiveMutation) ResolvedByID() (r uuid.UUID, exists bool) {
+	v := m.resolved_by_id
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.included_in_sboms[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldResolvedByID returns the old "resolved_by_id" field's value of the CertifyVulnArchive entity.
+// If the CertifyVulnArchive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnArchiveMutation) OldResolvedByID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedByID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedByID requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedByID: %w", err)
+	}
+	return oldValue.ResolvedByID, nil
 }
 
-// ClearIncludedInSboms clears the "included_in_sboms" edge to the BillOfMaterials entity.
-func (m *DependencyMutation) ClearIncludedInSboms() {
-	m.clearedincluded_in_sboms = true
+// ClearResolvedByID clears the value of the "resolved_by_id" field.
+func (m *CertifyVulnArchiveMutation) ClearResolvedByID() {
+	m.resolved_by_id = nil
+	m.clearedFields[certifyvulnarchive.FieldResolvedByID] = struct{}{}
 }
 
-// IncludedInSbomsCleared reports if the "included_in_sboms" edge to the BillOfMaterials entity was cleared.
-func (m *DependencyMutation) IncludedInSbomsCleared() bool {
-	return m.clearedincluded_in_sboms
+// ResolvedByIDCleared returns if the "resolved_by_id" field was cleared in this mutation.
+func (m *CertifyVulnArchiveMutation) ResolvedByIDCleared() bool {
+	_, ok := m.clearedFields[certifyvulnarchive.FieldResolvedByID]
+	return ok
 }
 
-// RemoveIncludedInSbomIDs removes the "included_in_sboms" edge to the BillOfMaterials entity by IDs.
-func (m *DependencyMutation) RemoveIncludedInSbomIDs(ids ...uuid.UUID) {
-	if m.removedincluded_in_sboms == nil {
-		m.removedincluded_in_sboms = make(map[uuid.UUID]struct{})
+// ResetResolvedByID resets all changes to the "resolved_by_id" field.
+func (m *CertifyVulnArchiveMutation) ResetResolvedByID() {
+	m.resolved_by_id = nil
+	delete(m.clearedFields, certifyvulnarchive.FieldResolvedByID)
+}
+
+// SetResolvedByType sets the "resolved_by_type" field.
+func (m *CertifyVulnArchiveMutation) SetResolvedByType(s string) {
+	m.resolved_by_type = &s
+}
+
+// ResolvedByType returns the value of the "resolved_by_type" field in the mutation.
+func (m *CertifyVulnArchiveMutation) ResolvedByType() (r string, exists bool) {
+	v := m.resolved_by_type
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.included_in_sboms, ids[i])
-		m.removedincluded_in_sboms[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldResolvedByType returns the old "resolved_by_type" field's value of the CertifyVulnArchive entity.
+// If the CertifyVulnArchive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnArchiveMutation) OldResolvedByType(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedByType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedByType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedByType: %w", err)
 	}
+	return oldValue.ResolvedByType, nil
 }
 
-// RemovedIncludedInSboms returns the removed IDs of the "included_in_sboms" edge to the BillOfMaterials entity.
-func (m *DependencyMutation) RemovedIncludedInSbomsIDs() (ids []uuid.UUID) {
-	for id := range m.removedincluded_in_sboms {
-		ids = append(ids, id)
+// ClearResolvedByType clears the value of the "resolved_by_type" field.
+func (m *CertifyVulnArchiveMutation) ClearResolvedByType() {
+	m.resolved_by_type = nil
+	m.clearedFields[certifyvulnarchive.FieldResolvedByType] = struct{}{}
+}
+
+// ResolvedByTypeCleared returns if the "resolved_by_type" field was cleared in this mutation.
+func (m *CertifyVulnArchiveMutation) ResolvedByTypeCleared() bool {
+	_, ok := m.clearedFields[certifyvulnarchive.FieldResolvedByType]
+	return ok
+}
+
+// ResetResolvedByType resets all changes to the "resolved_by_type" field.
+func (m *CertifyVulnArchiveMutation) ResetResolvedByType() {
+	m.resolved_by_type = nil
+	delete(m.clearedFields, certifyvulnarchive.FieldResolvedByType)
+}
+
+// ClearVulnerability clears the "vulnerability" edge to the VulnerabilityID entity.
+func (m *CertifyVulnArchiveMutation) ClearVulnerability() {
+	m.clearedvulnerability = true
+	m.clearedFields[certifyvulnarchive.FieldVulnerabilityID] = struct{}{}
+}
+
+// VulnerabilityCleared reports if the "vulnerability" edge to the VulnerabilityID entity was cleared.
+func (m *CertifyVulnArchiveMutation) VulnerabilityCleared() bool {
+	return m.clearedvulnerability
+}
+
+// VulnerabilityIDs returns the "vulnerability" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VulnerabilityID instead. It exists only for internal usage by the builders.
+func (m *CertifyVulnArchiveMutation) VulnerabilityIDs() (ids []uuid.UUID) {
+	if id := m.vulnerability; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// IncludedInSbomsIDs returns the "included_in_sboms" edge IDs in the mutation.
-func (m *DependencyMutation) IncludedInSbomsIDs() (ids []uuid.UUID) {
-	for id := range m.included_in_sboms {
-		ids = append(ids, id)
+// ResetVulnerability resets all changes to the "vulnerability" edge.
+func (m *CertifyVulnArchiveMutation) ResetVulnerability() {
+	m.vulnerability = nil
+	m.clearedvulnerability = false
+}
+
+// ClearPackage clears the "package" edge to the PackageVersion entity.
+func (m *CertifyVulnArchiveMutation) ClearPackage() {
+	m.cleared_package = true
+	m.clearedFields[certifyvulnarchive.FieldPackageID] = struct{}{}
+}
+
+// PackageCleared reports if the "package" edge to the PackageVersion entity was cleared.
+func (m *CertifyVulnArchiveMutation) PackageCleared() bool {
+	return m.cleared_package
+}
+
+// PackageIDs returns the "package" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageID instead. It exists only for internal usage by the builders.
+func (m *CertifyVulnArchiveMutation) PackageIDs() (ids []uuid.UUID) {
+	if id := m._package; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetIncludedInSboms resets all changes to the "included_in_sboms" edge.
-func (m *DependencyMutation) ResetIncludedInSboms() {
-	m.included_in_sboms = nil
-	m.clearedincluded_in_sboms = false
-	m.removedincluded_in_sboms = nil
+// ResetPackage resets all changes to the "package" edge.
+func (m *CertifyVulnArchiveMutation) ResetPackage() {
+	m._package = nil
+	m.cleared_package = false
 }
 
-// Where appends a list predicates to the DependencyMutation builder.
-func (m *DependencyMutation) Where(ps ...predicate.Dependency) {
+// Where appends a list predicates to the CertifyVulnArchiveMutation builder.
+func (m *CertifyVulnArchiveMutation) Where(ps ...predicate.CertifyVulnArchive) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DependencyMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CertifyVulnArchiveMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DependencyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Dependency, len(ps))
+func (m *CertifyVulnArchiveMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CertifyVulnArchive, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9186,51 +9638,66 @@ func (m *DependencyMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DependencyMutation) Op() Op {
+func (m *CertifyVulnArchiveMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DependencyMutation) SetOp(op Op) {
+func (m *CertifyVulnArchiveMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Dependency).
-func (m *DependencyMutation) Type() string {
+// Type returns the node type of this mutation (CertifyVulnArchive).
+func (m *CertifyVulnArchiveMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DependencyMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *CertifyVulnArchiveMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.vulnerability != nil {
+		fields = append(fields, certifyvulnarchive.FieldVulnerabilityID)
+	}
 	if m._package != nil {
-		fields = append(fields, dependency.FieldPackageID)
+		fields = append(fields, certifyvulnarchive.FieldPackageID)
 	}
-	if m.dependent_package_name != nil {
-		fields = append(fields, dependency.FieldDependentPackageNameID)
+	if m.time_scanned != nil {
+		fields = append(fields, certifyvulnarchive.FieldTimeScanned)
 	}
-	if m.dependent_package_version != nil {
-		fields = append(fields, dependency.FieldDependentPackageVersionID)
+	if m.db_uri != nil {
+		fields = append(fields, certifyvulnarchive.FieldDbURI)
 	}
-	if m.version_range != nil {
-		fields = append(fields, dependency.FieldVersionRange)
+	if m.db_version != nil {
+		fields = append(fields, certifyvulnarchive.FieldDbVersion)
 	}
-	if m.dependency_type != nil {
-		fields = append(fields, dependency.FieldDependencyType)
+	if m.scanner_uri != nil {
+		fields = append(fields, certifyvulnarchive.FieldScannerURI)
 	}
-	if m.justification != nil {
-		fields = append(fields, dependency.FieldJustification)
+	if m.scanner_version != nil {
+		fields = append(fields, certifyvulnarchive.FieldScannerVersion)
 	}
 	if m.origin != nil {
-		fields = append(fields, dependency.FieldOrigin)
+		fields = append(fields, certifyvulnarchive.FieldOrigin)
 	}
 	if m.collector != nil {
-		fields = append(fields, dependency.FieldCollector)
+		fields = append(fields, certifyvulnarchive.FieldCollector)
 	}
 	if m.document_ref != nil {
-		fields = append(fields, dependency.FieldDocumentRef)
+		fields = append(fields, certifyvulnarchive.FieldDocumentRef)
+	}
+	if m.cvss_score != nil {
+		fields = append(fields, certifyvulnarchive.FieldCvssScore)
+	}
+	if m.remediation_status != nil {
+		fields = append(fields, certifyvulnarchive.FieldRemediationStatus)
+	}
+	if m.resolved_by_id != nil {
+		fields = append(fields, certifyvulnarchive.FieldResolvedByID)
+	}
+	if m.resolved_by_type != nil {
+		fields = append(fields, certifyvulnarchive.FieldResolvedByType)
 	}
 	return fields
 }
@@ -9238,26 +9705,36 @@ func (m *DependencyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DependencyMutation) Field(name string) (ent.Value, bool) {
+func (m *CertifyVulnArchiveMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case dependency.FieldPackageID:
+	case certifyvulnarchive.FieldVulnerabilityID:
+		return m.VulnerabilityID()
+	case certifyvulnarchive.FieldPackageID:
 		return m.PackageID()
-	case dependency.FieldDependentPackageNameID:
-		return m.DependentPackageNameID()
-	case dependency.FieldDependentPackageVersionID:
-		return m.DependentPackageVersionID()
-	case dependency.FieldVersionRange:
-		return m.VersionRange()
-	case dependency.FieldDependencyType:
-		return m.DependencyType()
-	case dependency.FieldJustification:
-		return m.Justification()
-	case dependency.FieldOrigin:
+	case certifyvulnarchive.FieldTimeScanned:
+		return m.TimeScanned()
+	case certifyvulnarchive.FieldDbURI:
+		return m.DbURI()
+	case certifyvulnarchive.FieldDbVersion:
+		return m.DbVersion()
+	case certifyvulnarchive.FieldScannerURI:
+		return m.ScannerURI()
+	case certifyvulnarchive.FieldScannerVersion:
+		return m.ScannerVersion()
+	case certifyvulnarchive.FieldOrigin:
 		return m.Origin()
-	case dependency.FieldCollector:
+	case certifyvulnarchive.FieldCollector:
 		return m.Collector()
-	case dependency.FieldDocumentRef:
+	case certifyvulnarchive.FieldDocumentRef:
 		return m.DocumentRef()
+	case certifyvulnarchive.FieldCvssScore:
+		return m.CvssScore()
+	case certifyvulnarchive.FieldRemediationStatus:
+		return m.RemediationStatus()
+	case certifyvulnarchive.FieldResolvedByID:
+		return m.ResolvedByID()
+	case certifyvulnarchive.FieldResolvedByType:
+		return m.ResolvedByType()
 	}
 	return nil, false
 }
@@ -9265,92 +9742,1958 @@ func (m *DependencyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DependencyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CertifyVulnArchiveMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case dependency.FieldPackageID:
+	case certifyvulnarchive.FieldVulnerabilityID:
+		return m.OldVulnerabilityID(ctx)
+	case certifyvulnarchive.FieldPackageID:
 		return m.OldPackageID(ctx)
-	case dependency.FieldDependentPackageNameID:
-		return m.OldDependentPackageNameID(ctx)
-	case dependency.FieldDependentPackageVersionID:
-		return m.OldDependentPackageVersionID(ctx)
-	case dependency.FieldVersionRange:
-		return m.OldVersionRange(ctx)
-	case dependency.FieldDependencyType:
-		return m.OldDependencyType(ctx)
-	case dependency.FieldJustification:
-		return m.OldJustification(ctx)
-	case dependency.FieldOrigin:
+	case certifyvulnarchive.FieldTimeScanned:
+		return m.OldTimeScanned(ctx)
+	case certifyvulnarchive.FieldDbURI:
+		return m.OldDbURI(ctx)
+	case certifyvulnarchive.FieldDbVersion:
+		return m.OldDbVersion(ctx)
+	case certifyvulnarchive.FieldScannerURI:
+		return m.OldScannerURI(ctx)
+	case certifyvulnarchive.FieldScannerVersion:
+		return m.OldScannerVersion(ctx)
+	case certifyvulnarchive.FieldOrigin:
 		return m.OldOrigin(ctx)
-	case dependency.FieldCollector:
+	case certifyvulnarchive.FieldCollector:
 		return m.OldCollector(ctx)
-	case dependency.FieldDocumentRef:
+	case certifyvulnarchive.FieldDocumentRef:
 		return m.OldDocumentRef(ctx)
+	case certifyvulnarchive.FieldCvssScore:
+		return m.OldCvssScore(ctx)
+	case certifyvulnarchive.FieldRemediationStatus:
+		return m.OldRemediationStatus(ctx)
+	case certifyvulnarchive.FieldResolvedByID:
+		return m.OldResolvedByID(ctx)
+	case certifyvulnarchive.FieldResolvedByType:
+		return m.OldResolvedByType(ctx)
 	}
-	return nil, fmt.Errorf("unknown Dependency field %s", name)
+	return nil, fmt.Errorf("unknown CertifyVulnArchive field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DependencyMutation) SetField(name string, value ent.Value) error {
+func (m *CertifyVulnArchiveMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case dependency.FieldPackageID:
+	case certifyvulnarchive.FieldVulnerabilityID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPackageID(v)
+		m.SetVulnerabilityID(v)
 		return nil
-	case dependency.FieldDependentPackageNameID:
+	case certifyvulnarchive.FieldPackageID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDependentPackageNameID(v)
+		m.SetPackageID(v)
 		return nil
-	case dependency.FieldDependentPackageVersionID:
+	case certifyvulnarchive.FieldTimeScanned:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeScanned(v)
+		return nil
+	case certifyvulnarchive.FieldDbURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDbURI(v)
+		return nil
+	case certifyvulnarchive.FieldDbVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDbVersion(v)
+		return nil
+	case certifyvulnarchive.FieldScannerURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScannerURI(v)
+		return nil
+	case certifyvulnarchive.FieldScannerVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScannerVersion(v)
+		return nil
+	case certifyvulnarchive.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case certifyvulnarchive.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	case certifyvulnarchive.FieldDocumentRef:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocumentRef(v)
+		return nil
+	case certifyvulnarchive.FieldCvssScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCvssScore(v)
+		return nil
+	case certifyvulnarchive.FieldRemediationStatus:
+		v, ok := value.(certifyvulnarchive.RemediationStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemediationStatus(v)
+		return nil
+	case certifyvulnarchive.FieldResolvedByID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedByID(v)
+		return nil
+	case certifyvulnarchive.FieldResolvedByType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedByType(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyVulnArchive field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CertifyVulnArchiveMutation) AddedFields() []string {
+	var fields []string
+	if m.addcvss_score != nil {
+		fields = append(fields, certifyvulnarchive.FieldCvssScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CertifyVulnArchiveMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case certifyvulnarchive.FieldCvssScore:
+		return m.AddedCvssScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertifyVulnArchiveMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case certifyvulnarchive.FieldCvssScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCvssScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyVulnArchive numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CertifyVulnArchiveMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(certifyvulnarchive.FieldCvssScore) {
+		fields = append(fields, certifyvulnarchive.FieldCvssScore)
+	}
+	if m.FieldCleared(certifyvulnarchive.FieldResolvedByID) {
+		fields = append(fields, certifyvulnarchive.FieldResolvedByID)
+	}
+	if m.FieldCleared(certifyvulnarchive.FieldResolvedByType) {
+		fields = append(fields, certifyvulnarchive.FieldResolvedByType)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CertifyVulnArchiveMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CertifyVulnArchiveMutation) ClearField(name string) error {
+	switch name {
+	case certifyvulnarchive.FieldCvssScore:
+		m.ClearCvssScore()
+		return nil
+	case certifyvulnarchive.FieldResolvedByID:
+		m.ClearResolvedByID()
+		return nil
+	case certifyvulnarchive.FieldResolvedByType:
+		m.ClearResolvedByType()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyVulnArchive nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CertifyVulnArchiveMutation) ResetField(name string) error {
+	switch name {
+	case certifyvulnarchive.FieldVulnerabilityID:
+		m.ResetVulnerabilityID()
+		return nil
+	case certifyvulnarchive.FieldPackageID:
+		m.ResetPackageID()
+		return nil
+	case certifyvulnarchive.FieldTimeScanned:
+		m.ResetTimeScanned()
+		return nil
+	case certifyvulnarchive.FieldDbURI:
+		m.ResetDbURI()
+		return nil
+	case certifyvulnarchive.FieldDbVersion:
+		m.ResetDbVersion()
+		return nil
+	case certifyvulnarchive.FieldScannerURI:
+		m.ResetScannerURI()
+		return nil
+	case certifyvulnarchive.FieldScannerVersion:
+		m.ResetScannerVersion()
+		return nil
+	case certifyvulnarchive.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case certifyvulnarchive.FieldCollector:
+		m.ResetCollector()
+		return nil
+	case certifyvulnarchive.FieldDocumentRef:
+		m.ResetDocumentRef()
+		return nil
+	case certifyvulnarchive.FieldCvssScore:
+		m.ResetCvssScore()
+		return nil
+	case certifyvulnarchive.FieldRemediationStatus:
+		m.ResetRemediationStatus()
+		return nil
+	case certifyvulnarchive.FieldResolvedByID:
+		m.ResetResolvedByID()
+		return nil
+	case certifyvulnarchive.FieldResolvedByType:
+		m.ResetResolvedByType()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyVulnArchive field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CertifyVulnArchiveMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.vulnerability != nil {
+		edges = append(edges, certifyvulnarchive.EdgeVulnerability)
+	}
+	if m._package != nil {
+		edges = append(edges, certifyvulnarchive.EdgePackage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CertifyVulnArchiveMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case certifyvulnarchive.EdgeVulnerability:
+		if id := m.vulnerability; id != nil {
+			return []ent.Value{*id}
+		}
+	case certifyvulnarchive.EdgePackage:
+		if id := m._package; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CertifyVulnArchiveMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CertifyVulnArchiveMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CertifyVulnArchiveMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedvulnerability {
+		edges = append(edges, certifyvulnarchive.EdgeVulnerability)
+	}
+	if m.cleared_package {
+		edges = append(edges, certifyvulnarchive.EdgePackage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CertifyVulnArchiveMutation) EdgeCleared(name string) bool {
+	switch name {
+	case certifyvulnarchive.EdgeVulnerability:
+		return m.clearedvulnerability
+	case certifyvulnarchive.EdgePackage:
+		return m.cleared_package
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CertifyVulnArchiveMutation) ClearEdge(name string) error {
+	switch name {
+	case certifyvulnarchive.EdgeVulnerability:
+		m.ClearVulnerability()
+		return nil
+	case certifyvulnarchive.EdgePackage:
+		m.ClearPackage()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyVulnArchive unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CertifyVulnArchiveMutation) ResetEdge(name string) error {
+	switch name {
+	case certifyvulnarchive.EdgeVulnerability:
+		m.ResetVulnerability()
+		return nil
+	case certifyvulnarchive.EdgePackage:
+		m.ResetPackage()
+		return nil
+	}
+	return fmt.Errorf("unknown CertifyVulnArchive edge %s", name)
+}
+
+// DependencyMutation represents an operation that mutates the Dependency nodes in the graph.
+type DependencyMutation struct {
+	config
+	op                               Op
+	typ                              string
+	id                               *uuid.UUID
+	version_range                    *string
+	dependency_type                  *dependency.DependencyType
+	justification                    *string
+	origin                           *string
+	collector                        *string
+	document_ref                     *string
+	clearedFields                    map[string]struct{}
+	_package                         *uuid.UUID
+	cleared_package                  bool
+	dependent_package_name           *uuid.UUID
+	cleareddependent_package_name    bool
+	dependent_package_version        *uuid.UUID
+	cleareddependent_package_version bool
+	included_in_sboms                map[uuid.UUID]struct{}
+	removedincluded_in_sboms         map[uuid.UUID]struct{}
+	clearedincluded_in_sboms         bool
+	done                             bool
+	oldValue                         func(context.Context) (*Dependency, error)
+	predicates                       []predicate.Dependency
+}
+
+var _ ent.Mutation = (*DependencyMutation)(nil)
+
+// dependencyOption allows management of the mutation configuration using functional options.
+type dependencyOption func(*DependencyMutation)
+
+// newDependencyMutation creates new mutation for the Dependency entity.
+func newDependencyMutation(c config, op Op, opts ...dependencyOption) *DependencyMutation {
+	m := &DependencyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDependency,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDependencyID sets the ID field of the mutation.
+func withDependencyID(id uuid.UUID) dependencyOption {
+	return func(m *DependencyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Dependency
+		)
+		m.oldValue = func(ctx context.Context) (*Dependency, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Dependency.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDependency sets the old Dependency of the mutation.
+func withDependency(node *Dependency) dependencyOption {
+	return func(m *DependencyMutation) {
+		m.oldValue = func(context.Context) (*Dependency, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DependencyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DependencyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Dependency entities.
+func (m *DependencyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DependencyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DependencyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Dependency.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPackageID sets the "package_id" field.
+func (m *DependencyMutation) SetPackageID(u uuid.UUID) {
+	m._package = &u
+}
+
+// PackageID returns the value of the "package_id" field in the mutation.
+func (m *DependencyMutation) PackageID() (r uuid.UUID, exists bool) {
+	v := m._package
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageID returns the old "package_id" field's value of the Dependency entity.
+// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DependencyMutation) OldPackageID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageID: %w", err)
+	}
+	return oldValue.PackageID, nil
+}
+
+// ResetPackageID resets all changes to the "package_id" field.
+func (m *DependencyMutation) ResetPackageID() {
+	m._package = nil
+}
+
+// SetDependentPackageNameID sets the "dependent_package_name_id" field.
+func (m *DependencyMutation) SetDependentPackageNameID(u uuid.UUID) {
+	m.dependent_package_name = &u
+}
+
+// DependentPackageNameID returns the value of the "dependent_package_name_id" field in the mutation.
+func (m *DependencyMutation) DependentPackageNameID() (r uuid.UUID, exists bool) {
+	v := m.dependent_package_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDependentPackageNameID returns the old "dependent_package_name_id" field's value of the Dependency entity.
+// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DependencyMutation) OldDependentPackageNameID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDependentPackageNameID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDependentPackageNameID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDependentPackageNameID: %w", err)
+	}
+	return oldValue.DependentPackageNameID, nil
+}
+
+// ClearDependentPackageNameID clears the value of the "dependent_package_name_id" field.
+func (m *DependencyMutation) ClearDependentPackageNameID() {
+	m.dependent_package_name = nil
+	m.clearedFields[dependency.FieldDependentPackageNameID] = struct{}{}
+}
+
+// DependentPackageNameIDCleared returns if the "dependent_package_name_id" field was cleared in this mutation.
+func (m *DependencyMutation) DependentPackageNameIDCleared() bool {
+	_, ok := m.clearedFields[dependency.FieldDependentPackageNameID]
+	return ok
+}
+
+// ResetDependentPackageNameID resets all changes to the "dependent_package_name_id" field.
+func (m *DependencyMutation) ResetDependentPackageNameID() {
+	m.dependent_package_name = nil
+	delete(m.clearedFields, dependency.FieldDependentPackageNameID)
+}
+
+// SetDependentPackageVersionID sets the "dependent_package_version_id" field.
+func (m *DependencyMutation) SetDependentPackageVersionID(u uuid.UUID) {
+	m.dependent_package_version = &u
+}
+
+// DependentPackageVersionID returns the value of the "dependent_package_version_id" field in the mutation.
+func (m *DependencyMutation) DependentPackageVersionID() (r uuid.UUID, exists bool) {
+	v := m.dependent_package_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDependentPackageVersionID returns the old "dependent_package_version_id" field's value of the Dependency entity.
+// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DependencyMutation) OldDependentPackageVersionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDependentPackageVersionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDependentPackageVersionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDependentPackageVersionID: %w", err)
+	}
+	return oldValue.DependentPackageVersionID, nil
+}
+
+// ClearDependentPackageVersionID clears the value of the "dependent_package_version_id" field.
+func (m *DependencyMutation) ClearDependentPackageVersionID() {
+	m.dependent_package_version = nil
+	m.clearedFields[dependency.FieldDependentPackageVersionID] = struct{}{}
+}
+
+// DependentPackageVersionIDCleared returns if the "dependent_package_version_id" field was cleared in this mutation.
+func (m *DependencyMutation) DependentPackageVersionIDCleared() bool {
+	_, ok := m.clearedFields[dependency.FieldDependentPackageVersionID]
+	return ok
+}
+
+// ResetDependentPackageVersionID resets all changes to the "dependent_package_version_id" field.
+func (m *DependencyMutation) ResetDependentPackageVersionID() {
+	m.dependent_package_version = nil
+	delete(m.clearedFields, dependency.FieldDependentPackageVersionID)
+}
+
+// SetVersionRange sets the "version_range" field.
+func (m *DependencyMutation) SetVersionRange(s string) {
+	m.version_range = &s
+}
+
+// VersionRange returns the value of the "version_range" field in the mutation.
+func (m *DependencyMutation) VersionRange() (r string, exists bool) {
+	v := m.version_range
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersionRange returns the old "version_range" field's value of the Dependency entity.
+// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DependencyMutation) OldVersionRange(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersionRange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersionRange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersionRange: %w", err)
+	}
+	return oldValue.VersionRange, nil
+}
+
+// ResetVersionRange resets all changes to the "version_range" field.
+func (m *DependencyMutation) ResetVersionRange() {
+	m.version_range = nil
+}
+
+// SetDependencyType sets the "dependency_type" field.
+func (m *DependencyMutation) SetDependencyType(dt dependency.DependencyType) {
+	m.dependency_type = &dt
+}
+
+// DependencyType returns the value of the "dependency_type" field in the mutation.
+func (m *DependencyMutation) DependencyType() (r dependency.DependencyType, exists bool) {
+	v := m.dependency_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDependencyType returns the old "dependency_type" field's value of the Dependency entity.
+// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DependencyMutation) OldDependencyType(ctx context.Context) (v dependency.DependencyType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDependencyType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDependencyType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDependencyType: %w", err)
+	}
+	return oldValue.DependencyType, nil
+}
+
+// ResetDependencyType resets all changes to the "dependency_type" field.
+func (m *DependencyMutation) ResetDependencyType() {
+	m.dependency_type = nil
+}
+
+// SetJustification sets the "justification" field.
+func (m *DependencyMutation) SetJustification(s string) {
+	m.justification = &s
+}
+
+// Justification returns the value of the "justification" field in the mutation.
+func (m *DependencyMutation) Justification() (r string, exists bool) {
+	v := m.justification
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJustification returns the old "justification" field's value of the Dependency entity.
+// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DependencyMutation) OldJustification(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJustification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJustification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJustification: %w", err)
+	}
+	return oldValue.Justification, nil
+}
+
+// ResetJustification resets all changes to the "justification" field.
+func (m *DependencyMutation) ResetJustification() {
+	m.justification = nil
+}
+
+// SetOrigin sets the "origin" field.
+func (m *DependencyMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *DependencyMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the Dependency entity.
+// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DependencyMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *DependencyMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *DependencyMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *DependencyMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the Dependency entity.
+// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DependencyMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *DependencyMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (m *DependencyMutation) SetDocumentRef(s string) {
+	m.document_ref = &s
+}
+
+// DocumentRef returns the value of the "document_ref" field in the mutation.
+func (m *DependencyMutation) DocumentRef() (r string, exists bool) {
+	v := m.document_ref
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocumentRef returns the old "document_ref" field's value of the Dependency entity.
+// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DependencyMutation) OldDocumentRef(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocumentRef is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocumentRef requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocumentRef: %w", err)
+	}
+	return oldValue.DocumentRef, nil
+}
+
+// ResetDocumentRef resets all changes to the "document_ref" field.
+func (m *DependencyMutation) ResetDocumentRef() {
+	m.document_ref = nil
+}
+
+// ClearPackage clears the "package" edge to the PackageVersion entity.
+func (m *DependencyMutation) ClearPackage() {
+	m.cleared_package = true
+	m.clearedFields[dependency.FieldPackageID] = struct{}{}
+}
+
+// PackageCleared reports if the "package" edge to the PackageVersion entity was cleared.
+func (m *DependencyMutation) PackageCleared() bool {
+	return m.cleared_package
+}
+
+// PackageIDs returns the "package" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PackageID instead. It exists only for internal usage by the builders.
+func (m *DependencyMutation) PackageIDs() (ids []uuid.UUID) {
+	if id := m._package; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPackage resets all changes to the "package" edge.
+func (m *DependencyMutation) ResetPackage() {
+	m._package = nil
+	m.cleared_package = false
+}
+
+// ClearDependentPackageName clears the "dependent_package_name" edge to the PackageName entity.
+func (m *DependencyMutation) ClearDependentPackageName() {
+	m.cleareddependent_package_name = true
+	m.clearedFields[dependency.FieldDependentPackageNameID] = struct{}{}
+}
+
+// DependentPackageNameCleared reports if the "dependent_package_name" edge to the PackageName entity was cleared.
+func (m *DependencyMutation) DependentPackageNameCleared() bool {
+	return m.DependentPackageNameIDCleared() || m.cleareddependent_package_name
+}
+
+// DependentPackageNameIDs returns the "dependent_package_name" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DependentPackageNameID instead. It exists only for internal usage by the builders.
+func (m *DependencyMutation) DependentPackageNameIDs() (ids []uuid.UUID) {
+	if id := m.dependent_package_name; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDependentPackageName resets all changes to the "dependent_package_name" edge.
+func (m *DependencyMutation) ResetDependentPackageName() {
+	m.dependent_package_name = nil
+	m.cleareddependent_package_name = false
+}
+
+// ClearDependentPackageVersion clears the "dependent_package_version" edge to the PackageVersion entity.
+func (m *DependencyMutation) ClearDependentPackageVersion() {
+	m.cleareddependent_package_version = true
+	m.clearedFields[dependency.FieldDependentPackageVersionID] = struct{}{}
+}
+
+// DependentPackageVersionCleared reports if the "dependent_package_version" edge to the PackageVersion entity was cleared.
+func (m *DependencyMutation) DependentPackageVersionCleared() bool {
+	return m.DependentPackageVersionIDCleared() || m.cleareddependent_package_version
+}
+
+// DependentPackageVersionIDs returns the "dependent_package_version" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DependentPackageVersionID instead. It exists only for internal usage by the builders.
+func (m *DependencyMutation) DependentPackageVersionIDs() (ids []uuid.UUID) {
+	if id := m.dependent_package_version; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDependentPackageVersion resets all changes to the "dependent_package_version" edge.
+func (m *DependencyMutation) ResetDependentPackageVersion() {
+	m.dependent_package_version = nil
+	m.cleareddependent_package_version = false
+}
+
+// AddIncludedInSbomIDs adds the "included_in_sboms" edge to the BillOfMaterials entity by ids.
+func (m *DependencyMutation) AddIncludedInSbomIDs(ids ...uuid.UUID) {
+	if m.included_in_sboms == nil {
+		m.included_in_sboms = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.included_in_sboms[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIncludedInSboms clears the "included_in_sboms" edge to the BillOfMaterials entity.
+func (m *DependencyMutation) ClearIncludedInSboms() {
+	m.clearedincluded_in_sboms = true
+}
+
+// IncludedInSbomsCleared reports if the "included_in_sboms" edge to the BillOfMaterials entity was cleared.
+func (m *DependencyMutation) IncludedInSbomsCleared() bool {
+	return m.clearedincluded_in_sboms
+}
+
+// RemoveIncludedInSbomIDs removes the "included_in_sboms" edge to the BillOfMaterials entity by IDs.
+func (m *DependencyMutation) RemoveIncludedInSbomIDs(ids ...uuid.UUID) {
+	if m.removedincluded_in_sboms == nil {
+		m.removedincluded_in_sboms = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.included_in_sboms, ids[i])
+		m.removedincluded_in_sboms[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIncludedInSboms returns the removed IDs of the "included_in_sboms" edge to the BillOfMaterials entity.
+func (m *DependencyMutation) RemovedIncludedInSbomsIDs() (ids []uuid.UUID) {
+	for id := range m.removedincluded_in_sboms {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IncludedInSbomsIDs returns the "included_in_sboms" edge IDs in the mutation.
+func (m *DependencyMutation) IncludedInSbomsIDs() (ids []uuid.UUID) {
+	for id := range m.included_in_sboms {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIncludedInSboms resets all changes to the "included_in_sboms" edge.
+func (m *DependencyMutation) ResetIncludedInSboms() {
+	m.included_in_sboms = nil
+	m.clearedincluded_in_sboms = false
+	m.removedincluded_in_sboms = nil
+}
+
+// Where appends a list predicates to the DependencyMutation builder.
+func (m *DependencyMutation) Where(ps ...predicate.Dependency) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DependencyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DependencyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Dependency, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DependencyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DependencyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Dependency).
+func (m *DependencyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DependencyMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m._package != nil {
+		fields = append(fields, dependency.FieldPackageID)
+	}
+	if m.dependent_package_name != nil {
+		fields = append(fields, dependency.FieldDependentPackageNameID)
+	}
+	if m.dependent_package_version != nil {
+		fields = append(fields, dependency.FieldDependentPackageVersionID)
+	}
+	if m.version_range != nil {
+		fields = append(fields, dependency.FieldVersionRange)
+	}
+	if m.dependency_type != nil {
+		fields = append(fields, dependency.FieldDependencyType)
+	}
+	if m.justification != nil {
+		fields = append(fields, dependency.FieldJustification)
+	}
+	if m.origin != nil {
+		fields = append(fields, dependency.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, dependency.FieldCollector)
+	}
+	if m.document_ref != nil {
+		fields = append(fields, dependency.FieldDocumentRef)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DependencyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case dependency.FieldPackageID:
+		return m.PackageID()
+	case dependency.FieldDependentPackageNameID:
+		return m.DependentPackageNameID()
+	case dependency.FieldDependentPackageVersionID:
+		return m.DependentPackageVersionID()
+	case dependency.FieldVersionRange:
+		return m.VersionRange()
+	case dependency.FieldDependencyType:
+		return m.DependencyType()
+	case dependency.FieldJustification:
+		return m.Justification()
+	case dependency.FieldOrigin:
+		return m.Origin()
+	case dependency.FieldCollector:
+		return m.Collector()
+	case dependency.FieldDocumentRef:
+		return m.DocumentRef()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DependencyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case dependency.FieldPackageID:
+		return m.OldPackageID(ctx)
+	case dependency.FieldDependentPackageNameID:
+		return m.OldDependentPackageNameID(ctx)
+	case dependency.FieldDependentPackageVersionID:
+		return m.OldDependentPackageVersionID(ctx)
+	case dependency.FieldVersionRange:
+		return m.OldVersionRange(ctx)
+	case dependency.FieldDependencyType:
+		return m.OldDependencyType(ctx)
+	case dependency.FieldJustification:
+		return m.OldJustification(ctx)
+	case dependency.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case dependency.FieldCollector:
+		return m.OldCollector(ctx)
+	case dependency.FieldDocumentRef:
+		return m.OldDocumentRef(ctx)
+	}
+	return nil, fmt.Errorf("unknown Dependency field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DependencyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case dependency.FieldPackageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageID(v)
+		return nil
+	case dependency.FieldDependentPackageNameID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDependentPackageNameID(v)
+		return nil
+	case dependency.FieldDependentPackageVersionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDependentPackageVersionID(v)
+		return nil
+	case dependency.FieldVersionRange:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersionRange(v)
+		return nil
+	case dependency.FieldDependencyType:
+		v, ok := value.(dependency.DependencyType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDependencyType(v)
+		return nil
+	case dependency.FieldJustification:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJustification(v)
+		return nil
+	case dependency.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case dependency.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	case dependency.FieldDocumentRef:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocumentRef(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Dependency field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DependencyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DependencyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DependencyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Dependency numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DependencyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(dependency.FieldDependentPackageNameID) {
+		fields = append(fields, dependency.FieldDependentPackageNameID)
+	}
+	if m.FieldCleared(dependency.FieldDependentPackageVersionID) {
+		fields = append(fields, dependency.FieldDependentPackageVersionID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DependencyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DependencyMutation) ClearField(name string) error {
+	switch name {
+	case dependency.FieldDependentPackageNameID:
+		m.ClearDependentPackageNameID()
+		return nil
+	case dependency.FieldDependentPackageVersionID:
+		m.ClearDependentPackageVersionID()
+		return nil
+	}
+	return fmt.Errorf("unknown Dependency nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DependencyMutation) ResetField(name string) error {
+	switch name {
+	case dependency.FieldPackageID:
+		m.ResetPackageID()
+		return nil
+	case dependency.FieldDependentPackageNameID:
+		m.ResetDependentPackageNameID()
+		return nil
+	case dependency.FieldDependentPackageVersionID:
+		m.ResetDependentPackageVersionID()
+		return nil
+	case dependency.FieldVersionRange:
+		m.ResetVersionRange()
+		return nil
+	case dependency.FieldDependencyType:
+		m.ResetDependencyType()
+		return nil
+	case dependency.FieldJustification:
+		m.ResetJustification()
+		return nil
+	case dependency.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case dependency.FieldCollector:
+		m.ResetCollector()
+		return nil
+	case dependency.FieldDocumentRef:
+		m.ResetDocumentRef()
+		return nil
+	}
+	return fmt.Errorf("unknown Dependency field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DependencyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m._package != nil {
+		edges = append(edges, dependency.EdgePackage)
+	}
+	if m.dependent_package_name != nil {
+		edges = append(edges, dependency.EdgeDependentPackageName)
+	}
+	if m.dependent_package_version != nil {
+		edges = append(edges, dependency.EdgeDependentPackageVersion)
+	}
+	if m.included_in_sboms != nil {
+		edges = append(edges, dependency.EdgeIncludedInSboms)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DependencyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case dependency.EdgePackage:
+		if id := m._package; id != nil {
+			return []ent.Value{*id}
+		}
+	case dependency.EdgeDependentPackageName:
+		if id := m.dependent_package_name; id != nil {
+			return []ent.Value{*id}
+		}
+	case dependency.EdgeDependentPackageVersion:
+		if id := m.dependent_package_version; id != nil {
+			return []ent.Value{*id}
+		}
+	case dependency.EdgeIncludedInSboms:
+		ids := make([]ent.Value, 0, len(m.included_in_sboms))
+		for id := range m.included_in_sboms {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DependencyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedincluded_in_sboms != nil {
+		edges = append(edges, dependency.EdgeIncludedInSboms)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DependencyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case dependency.EdgeIncludedInSboms:
+		ids := make([]ent.Value, 0, len(m.removedincluded_in_sboms))
+		for id := range m.removedincluded_in_sboms {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DependencyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleared_package {
+		edges = append(edges, dependency.EdgePackage)
+	}
+	if m.cleareddependent_package_name {
+		edges = append(edges, dependency.EdgeDependentPackageName)
+	}
+	if m.cleareddependent_package_version {
+		edges = append(edges, dependency.EdgeDependentPackageVersion)
+	}
+	if m.clearedincluded_in_sboms {
+		edges = append(edges, dependency.EdgeIncludedInSboms)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DependencyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case dependency.EdgePackage:
+		return m.cleared_package
+	case dependency.EdgeDependentPackageName:
+		return m.cleareddependent_package_name
+	case dependency.EdgeDependentPackageVersion:
+		return m.cleareddependent_package_version
+	case dependency.EdgeIncludedInSboms:
+		return m.clearedincluded_in_sboms
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DependencyMutation) ClearEdge(name string) error {
+	switch name {
+	case dependency.EdgePackage:
+		m.ClearPackage()
+		return nil
+	case dependency.EdgeDependentPackageName:
+		m.ClearDependentPackageName()
+		return nil
+	case dependency.EdgeDependentPackageVersion:
+		m.ClearDependentPackageVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown Dependency unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DependencyMutation) ResetEdge(name string) error {
+	switch name {
+	case dependency.EdgePackage:
+		m.ResetPackage()
+		return nil
+	case dependency.EdgeDependentPackageName:
+		m.ResetDependentPackageName()
+		return nil
+	case dependency.EdgeDependentPackageVersion:
+		m.ResetDependentPackageVersion()
+		return nil
+	case dependency.EdgeIncludedInSboms:
+		m.ResetIncludedInSboms()
+		return nil
+	}
+	return fmt.Errorf("unknown Dependency edge %s", name)
+}
+
+// ExploitReferenceMutation represents an operation that mutates the ExploitReference nodes in the graph.
+type ExploitReferenceMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	source                  *string
+	url                     *string
+	verified                *bool
+	origin                  *string
+	collector               *string
+	document_ref            *string
+	clearedFields           map[string]struct{}
+	vulnerability_id        *uuid.UUID
+	clearedvulnerability_id bool
+	done                    bool
+	oldValue                func(context.Context) (*ExploitReference, error)
+	predicates              []predicate.ExploitReference
+}
+
+var _ ent.Mutation = (*ExploitReferenceMutation)(nil)
+
+// exploitreferenceOption allows management of the mutation configuration using functional options.
+type exploitreferenceOption func(*ExploitReferenceMutation)
+
+// newExploitReferenceMutation creates new mutation for the ExploitReference entity.
+func newExploitReferenceMutation(c config, op Op, opts ...exploitreferenceOption) *ExploitReferenceMutation {
+	m := &ExploitReferenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExploitReference,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExploitReferenceID sets the ID field of the mutation.
+func withExploitReferenceID(id uuid.UUID) exploitreferenceOption {
+	return func(m *ExploitReferenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExploitReference
+		)
+		m.oldValue = func(ctx context.Context) (*ExploitReference, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExploitReference.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExploitReference sets the old ExploitReference of the mutation.
+func withExploitReference(node *ExploitReference) exploitreferenceOption {
+	return func(m *ExploitReferenceMutation) {
+		m.oldValue = func(context.Context) (*ExploitReference, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExploitReferenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExploitReferenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ExploitReference entities.
+func (m *ExploitReferenceMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExploitReferenceMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExploitReferenceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExploitReference.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVulnerabilityIDID sets the "vulnerability_id_id" field.
+func (m *ExploitReferenceMutation) SetVulnerabilityIDID(u uuid.UUID) {
+	m.vulnerability_id = &u
+}
+
+// VulnerabilityIDID returns the value of the "vulnerability_id_id" field in the mutation.
+func (m *ExploitReferenceMutation) VulnerabilityIDID() (r uuid.UUID, exists bool) {
+	v := m.vulnerability_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVulnerabilityIDID returns the old "vulnerability_id_id" field's value of the ExploitReference entity.
+// If the ExploitReference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExploitReferenceMutation) OldVulnerabilityIDID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVulnerabilityIDID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVulnerabilityIDID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVulnerabilityIDID: %w", err)
+	}
+	return oldValue.VulnerabilityIDID, nil
+}
+
+// ResetVulnerabilityIDID resets all changes to the "vulnerability_id_id" field.
+func (m *ExploitReferenceMutation) ResetVulnerabilityIDID() {
+	m.vulnerability_id = nil
+}
+
+// SetSource sets the "source" field.
+func (m *ExploitReferenceMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *ExploitReferenceMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the ExploitReference entity.
+// If the ExploitReference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExploitReferenceMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *ExploitReferenceMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetURL sets the "url" field.
+func (m *ExploitReferenceMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *ExploitReferenceMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the ExploitReference entity.
+// If the ExploitReference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExploitReferenceMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *ExploitReferenceMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetVerified sets the "verified" field.
+func (m *ExploitReferenceMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *ExploitReferenceMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the ExploitReference entity.
+// If the ExploitReference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExploitReferenceMutation) OldVerified(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *ExploitReferenceMutation) ResetVerified() {
+	m.verified = nil
+}
+
+// SetOrigin sets the "origin" field.
+func (m *ExploitReferenceMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *ExploitReferenceMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the ExploitReference entity.
+// If the ExploitReference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExploitReferenceMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *ExploitReferenceMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *ExploitReferenceMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *ExploitReferenceMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the ExploitReference entity.
+// If the ExploitReference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExploitReferenceMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *ExploitReferenceMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (m *ExploitReferenceMutation) SetDocumentRef(s string) {
+	m.document_ref = &s
+}
+
+// DocumentRef returns the value of the "document_ref" field in the mutation.
+func (m *ExploitReferenceMutation) DocumentRef() (r string, exists bool) {
+	v := m.document_ref
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocumentRef returns the old "document_ref" field's value of the ExploitReference entity.
+// If the ExploitReference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExploitReferenceMutation) OldDocumentRef(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocumentRef is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocumentRef requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocumentRef: %w", err)
+	}
+	return oldValue.DocumentRef, nil
+}
+
+// ResetDocumentRef resets all changes to the "document_ref" field.
+func (m *ExploitReferenceMutation) ResetDocumentRef() {
+	m.document_ref = nil
+}
+
+// ClearVulnerabilityID clears the "vulnerability_id" edge to the VulnerabilityID entity.
+func (m *ExploitReferenceMutation) ClearVulnerabilityID() {
+	m.clearedvulnerability_id = true
+	m.clearedFields[exploitreference.FieldVulnerabilityIDID] = struct{}{}
+}
+
+// VulnerabilityIDCleared reports if the "vulnerability_id" edge to the VulnerabilityID entity was cleared.
+func (m *ExploitReferenceMutation) VulnerabilityIDCleared() bool {
+	return m.clearedvulnerability_id
+}
+
+// VulnerabilityIDIDs returns the "vulnerability_id" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VulnerabilityIDID instead. It exists only for internal usage by the builders.
+func (m *ExploitReferenceMutation) VulnerabilityIDIDs() (ids []uuid.UUID) {
+	if id := m.vulnerability_id; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetVulnerabilityID resets all changes to the "vulnerability_id" edge.
+func (m *ExploitReferenceMutation) ResetVulnerabilityID() {
+	m.vulnerability_id = nil
+	m.clearedvulnerability_id = false
+}
+
+// Where appends a list predicates to the ExploitReferenceMutation builder.
+func (m *ExploitReferenceMutation) Where(ps ...predicate.ExploitReference) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExploitReferenceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExploitReferenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExploitReference, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExploitReferenceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExploitReferenceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExploitReference).
+func (m *ExploitReferenceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExploitReferenceMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.vulnerability_id != nil {
+		fields = append(fields, exploitreference.FieldVulnerabilityIDID)
+	}
+	if m.source != nil {
+		fields = append(fields, exploitreference.FieldSource)
+	}
+	if m.url != nil {
+		fields = append(fields, exploitreference.FieldURL)
+	}
+	if m.verified != nil {
+		fields = append(fields, exploitreference.FieldVerified)
+	}
+	if m.origin != nil {
+		fields = append(fields, exploitreference.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, exploitreference.FieldCollector)
+	}
+	if m.document_ref != nil {
+		fields = append(fields, exploitreference.FieldDocumentRef)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExploitReferenceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case exploitreference.FieldVulnerabilityIDID:
+		return m.VulnerabilityIDID()
+	case exploitreference.FieldSource:
+		return m.Source()
+	case exploitreference.FieldURL:
+		return m.URL()
+	case exploitreference.FieldVerified:
+		return m.Verified()
+	case exploitreference.FieldOrigin:
+		return m.Origin()
+	case exploitreference.FieldCollector:
+		return m.Collector()
+	case exploitreference.FieldDocumentRef:
+		return m.DocumentRef()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExploitReferenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case exploitreference.FieldVulnerabilityIDID:
+		return m.OldVulnerabilityIDID(ctx)
+	case exploitreference.FieldSource:
+		return m.OldSource(ctx)
+	case exploitreference.FieldURL:
+		return m.OldURL(ctx)
+	case exploitreference.FieldVerified:
+		return m.OldVerified(ctx)
+	case exploitreference.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case exploitreference.FieldCollector:
+		return m.OldCollector(ctx)
+	case exploitreference.FieldDocumentRef:
+		return m.OldDocumentRef(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExploitReference field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExploitReferenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case exploitreference.FieldVulnerabilityIDID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDependentPackageVersionID(v)
+		m.SetVulnerabilityIDID(v)
 		return nil
-	case dependency.FieldVersionRange:
+	case exploitreference.FieldSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersionRange(v)
+		m.SetSource(v)
 		return nil
-	case dependency.FieldDependencyType:
-		v, ok := value.(dependency.DependencyType)
+	case exploitreference.FieldURL:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDependencyType(v)
+		m.SetURL(v)
 		return nil
-	case dependency.FieldJustification:
-		v, ok := value.(string)
+	case exploitreference.FieldVerified:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJustification(v)
+		m.SetVerified(v)
 		return nil
-	case dependency.FieldOrigin:
+	case exploitreference.FieldOrigin:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetOrigin(v)
 		return nil
-	case dependency.FieldCollector:
+	case exploitreference.FieldCollector:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCollector(v)
 		return nil
-	case dependency.FieldDocumentRef:
+	case exploitreference.FieldDocumentRef:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -9358,236 +11701,151 @@ func (m *DependencyMutation) SetField(name string, value ent.Value) error {
 		m.SetDocumentRef(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Dependency field %s", name)
+	return fmt.Errorf("unknown ExploitReference field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DependencyMutation) AddedFields() []string {
+func (m *ExploitReferenceMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DependencyMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ExploitReferenceMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DependencyMutation) AddField(name string, value ent.Value) error {
+func (m *ExploitReferenceMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Dependency numeric field %s", name)
+	return fmt.Errorf("unknown ExploitReference numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DependencyMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(dependency.FieldDependentPackageNameID) {
-		fields = append(fields, dependency.FieldDependentPackageNameID)
-	}
-	if m.FieldCleared(dependency.FieldDependentPackageVersionID) {
-		fields = append(fields, dependency.FieldDependentPackageVersionID)
-	}
-	return fields
+func (m *ExploitReferenceMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DependencyMutation) FieldCleared(name string) bool {
+func (m *ExploitReferenceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DependencyMutation) ClearField(name string) error {
-	switch name {
-	case dependency.FieldDependentPackageNameID:
-		m.ClearDependentPackageNameID()
-		return nil
-	case dependency.FieldDependentPackageVersionID:
-		m.ClearDependentPackageVersionID()
-		return nil
-	}
-	return fmt.Errorf("unknown Dependency nullable field %s", name)
+func (m *ExploitReferenceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExploitReference nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DependencyMutation) ResetField(name string) error {
+func (m *ExploitReferenceMutation) ResetField(name string) error {
 	switch name {
-	case dependency.FieldPackageID:
-		m.ResetPackageID()
-		return nil
-	case dependency.FieldDependentPackageNameID:
-		m.ResetDependentPackageNameID()
-		return nil
-	case dependency.FieldDependentPackageVersionID:
-		m.ResetDependentPackageVersionID()
+	case exploitreference.FieldVulnerabilityIDID:
+		m.ResetVulnerabilityIDID()
 		return nil
-	case dependency.FieldVersionRange:
-		m.ResetVersionRange()
+	case exploitreference.FieldSource:
+		m.ResetSource()
 		return nil
-	case dependency.FieldDependencyType:
-		m.ResetDependencyType()
+	case exploitreference.FieldURL:
+		m.ResetURL()
 		return nil
-	case dependency.FieldJustification:
-		m.ResetJustification()
+	case exploitreference.FieldVerified:
+		m.ResetVerified()
 		return nil
-	case dependency.FieldOrigin:
+	case exploitreference.FieldOrigin:
 		m.ResetOrigin()
 		return nil
-	case dependency.FieldCollector:
+	case exploitreference.FieldCollector:
 		m.ResetCollector()
 		return nil
-	case dependency.FieldDocumentRef:
+	case exploitreference.FieldDocumentRef:
 		m.ResetDocumentRef()
 		return nil
 	}
-	return fmt.Errorf("unknown Dependency field %s", name)
+	return fmt.Errorf("unknown ExploitReference field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DependencyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m._package != nil {
-		edges = append(edges, dependency.EdgePackage)
-	}
-	if m.dependent_package_name != nil {
-		edges = append(edges, dependency.EdgeDependentPackageName)
-	}
-	if m.dependent_package_version != nil {
-		edges = append(edges, dependency.EdgeDependentPackageVersion)
-	}
-	if m.included_in_sboms != nil {
-		edges = append(edges, dependency.EdgeIncludedInSboms)
+func (m *ExploitReferenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.vulnerability_id != nil {
+		edges = append(edges, exploitreference.EdgeVulnerabilityID)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DependencyMutation) AddedIDs(name string) []ent.Value {
+func (m *ExploitReferenceMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case dependency.EdgePackage:
-		if id := m._package; id != nil {
-			return []ent.Value{*id}
-		}
-	case dependency.EdgeDependentPackageName:
-		if id := m.dependent_package_name; id != nil {
-			return []ent.Value{*id}
-		}
-	case dependency.EdgeDependentPackageVersion:
-		if id := m.dependent_package_version; id != nil {
+	case exploitreference.EdgeVulnerabilityID:
+		if id := m.vulnerability_id; id != nil {
 			return []ent.Value{*id}
 		}
-	case dependency.EdgeIncludedInSboms:
-		ids := make([]ent.Value, 0, len(m.included_in_sboms))
-		for id := range m.included_in_sboms {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DependencyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedincluded_in_sboms != nil {
-		edges = append(edges, dependency.EdgeIncludedInSboms)
-	}
+func (m *ExploitReferenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DependencyMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case dependency.EdgeIncludedInSboms:
-		ids := make([]ent.Value, 0, len(m.removedincluded_in_sboms))
-		for id := range m.removedincluded_in_sboms {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ExploitReferenceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DependencyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.cleared_package {
-		edges = append(edges, dependency.EdgePackage)
-	}
-	if m.cleareddependent_package_name {
-		edges = append(edges, dependency.EdgeDependentPackageName)
-	}
-	if m.cleareddependent_package_version {
-		edges = append(edges, dependency.EdgeDependentPackageVersion)
-	}
-	if m.clearedincluded_in_sboms {
-		edges = append(edges, dependency.EdgeIncludedInSboms)
+func (m *ExploitReferenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedvulnerability_id {
+		edges = append(edges, exploitreference.EdgeVulnerabilityID)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DependencyMutation) EdgeCleared(name string) bool {
+func (m *ExploitReferenceMutation) EdgeCleared(name string) bool {
 	switch name {
-	case dependency.EdgePackage:
-		return m.cleared_package
-	case dependency.EdgeDependentPackageName:
-		return m.cleareddependent_package_name
-	case dependency.EdgeDependentPackageVersion:
-		return m.cleareddependent_package_version
-	case dependency.EdgeIncludedInSboms:
-		return m.clearedincluded_in_sboms
+	case exploitreference.EdgeVulnerabilityID:
+		return m.clearedvulnerability_id
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DependencyMutation) ClearEdge(name string) error {
+func (m *ExploitReferenceMutation) ClearEdge(name string) error {
 	switch name {
-	case dependency.EdgePackage:
-		m.ClearPackage()
-		return nil
-	case dependency.EdgeDependentPackageName:
-		m.ClearDependentPackageName()
-		return nil
-	case dependency.EdgeDependentPackageVersion:
-		m.ClearDependentPackageVersion()
+	case exploitreference.EdgeVulnerabilityID:
+		m.ClearVulnerabilityID()
 		return nil
 	}
-	return fmt.Errorf("unknown Dependency unique edge %s", name)
+	return fmt.Errorf("unknown ExploitReference unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DependencyMutation) ResetEdge(name string) error {
+func (m *ExploitReferenceMutation) ResetEdge(name string) error {
 	switch name {
-	case dependency.EdgePackage:
-		m.ResetPackage()
-		return nil
-	case dependency.EdgeDependentPackageName:
-		m.ResetDependentPackageName()
-		return nil
-	case dependency.EdgeDependentPackageVersion:
-		m.ResetDependentPackageVersion()
-		return nil
-	case dependency.EdgeIncludedInSboms:
-		m.ResetIncludedInSboms()
+	case exploitreference.EdgeVulnerabilityID:
+		m.ResetVulnerabilityID()
 		return nil
 	}
-	return fmt.Errorf("unknown Dependency edge %s", name)
+	return fmt.Errorf("unknown ExploitReference edge %s", name)
 }
 
 // HasMetadataMutation represents an operation that mutates the HasMetadata nodes in the graph.
@@ -14982,6 +17240,7 @@ type PackageVersionMutation struct {
 	qualifiers                *[]model.PackageQualifier
 	appendqualifiers          []model.PackageQualifier
 	hash                      *string
+	component_type            *string
 	clearedFields             map[string]struct{}
 	name                      *uuid.UUID
 	clearedname               bool
@@ -15345,6 +17604,42 @@ func (m *PackageVersionMutation) ResetHash() {
 	m.hash = nil
 }
 
+// SetComponentType sets the "component_type" field.
+func (m *PackageVersionMutation) SetComponentType(s string) {
+	m.component_type = &s
+}
+
+// ComponentType returns the value of the "component_type" field in the mutation.
+func (m *PackageVersionMutation) ComponentType() (r string, exists bool) {
+	v := m.component_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComponentType returns the old "component_type" field's value of the PackageVersion entity.
+// If the PackageVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackageVersionMutation) OldComponentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComponentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComponentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComponentType: %w", err)
+	}
+	return oldValue.ComponentType, nil
+}
+
+// ResetComponentType resets all changes to the "component_type" field.
+func (m *PackageVersionMutation) ResetComponentType() {
+	m.component_type = nil
+}
+
 // ClearName clears the "name" edge to the PackageName entity.
 func (m *PackageVersionMutation) ClearName() {
 	m.clearedname = true
@@ -16162,7 +18457,7 @@ func (m *PackageVersionMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *PackageVersionMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 6)
 	if m.name != nil {
 		fields = append(fields, packageversion.FieldNameID)
 	}
@@ -16178,6 +18473,9 @@ func (m *PackageVersionMutation) Fields() []string {
 	if m.hash != nil {
 		fields = append(fields, packageversion.FieldHash)
 	}
+	if m.component_type != nil {
+		fields = append(fields, packageversion.FieldComponentType)
+	}
 	return fields
 }
 
@@ -16196,6 +18494,8 @@ func (m *PackageVersionMutation) Field(name string) (ent.Value, bool) {
 		return m.Qualifiers()
 	case packageversion.FieldHash:
 		return m.Hash()
+	case packageversion.FieldComponentType:
+		return m.ComponentType()
 	}
 	return nil, false
 }
@@ -16215,6 +18515,8 @@ func (m *PackageVersionMutation) OldField(ctx context.Context, name string) (ent
 		return m.OldQualifiers(ctx)
 	case packageversion.FieldHash:
 		return m.OldHash(ctx)
+	case packageversion.FieldComponentType:
+		return m.OldComponentType(ctx)
 	}
 	return nil, fmt.Errorf("unknown PackageVersion field %s", name)
 }
@@ -16259,6 +18561,13 @@ func (m *PackageVersionMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetHash(v)
 		return nil
+	case packageversion.FieldComponentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComponentType(v)
+		return nil
 	}
 	return fmt.Errorf("unknown PackageVersion field %s", name)
 }
@@ -16332,6 +18641,9 @@ func (m *PackageVersionMutation) ResetField(name string) error {
 	case packageversion.FieldHash:
 		m.ResetHash()
 		return nil
+	case packageversion.FieldComponentType:
+		m.ResetComponentType()
+		return nil
 	}
 	return fmt.Errorf("unknown PackageVersion field %s", name)
 }
@@ -21775,30 +24087,33 @@ func (m *VulnEqualMutation) ResetEdge(name string) error {
 // VulnerabilityIDMutation represents an operation that mutates the VulnerabilityID nodes in the graph.
 type VulnerabilityIDMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *uuid.UUID
-	vulnerability_id         *string
-	_type                    *string
-	clearedFields            map[string]struct{}
-	vuln_equal_vuln_a        map[uuid.UUID]struct{}
-	removedvuln_equal_vuln_a map[uuid.UUID]struct{}
-	clearedvuln_equal_vuln_a bool
-	vuln_equal_vuln_b        map[uuid.UUID]struct{}
-	removedvuln_equal_vuln_b map[uuid.UUID]struct{}
-	clearedvuln_equal_vuln_b bool
-	metadata                 map[uuid.UUID]struct{}
-	removedmetadata          map[uuid.UUID]struct{}
-	clearedmetadata          bool
-	certify_vuln             map[uuid.UUID]struct{}
-	removedcertify_vuln      map[uuid.UUID]struct{}
-	clearedcertify_vuln      bool
-	vex                      map[uuid.UUID]struct{}
-	removedvex               map[uuid.UUID]struct{}
-	clearedvex               bool
-	done                     bool
-	oldValue                 func(context.Context) (*VulnerabilityID, error)
-	predicates               []predicate.VulnerabilityID
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	vulnerability_id          *string
+	_type                     *string
+	clearedFields             map[string]struct{}
+	vuln_equal_vuln_a         map[uuid.UUID]struct{}
+	removedvuln_equal_vuln_a  map[uuid.UUID]struct{}
+	clearedvuln_equal_vuln_a  bool
+	vuln_equal_vuln_b         map[uuid.UUID]struct{}
+	removedvuln_equal_vuln_b  map[uuid.UUID]struct{}
+	clearedvuln_equal_vuln_b  bool
+	metadata                  map[uuid.UUID]struct{}
+	removedmetadata           map[uuid.UUID]struct{}
+	clearedmetadata           bool
+	certify_vuln              map[uuid.UUID]struct{}
+	removedcertify_vuln       map[uuid.UUID]struct{}
+	clearedcertify_vuln       bool
+	vex                       map[uuid.UUID]struct{}
+	removedvex                map[uuid.UUID]struct{}
+	clearedvex                bool
+	exploit_references        map[uuid.UUID]struct{}
+	removedexploit_references map[uuid.UUID]struct{}
+	clearedexploit_references bool
+	done                      bool
+	oldValue                  func(context.Context) (*VulnerabilityID, error)
+	predicates                []predicate.VulnerabilityID
 }
 
 var _ ent.Mutation = (*VulnerabilityIDMutation)(nil)
@@ -22247,6 +24562,60 @@ func (m *VulnerabilityIDMutation) ResetVex() {
 	m.removedvex = nil
 }
 
+// AddExploitReferenceIDs adds the "exploit_references" edge to the ExploitReference entity by ids.
+func (m *VulnerabilityIDMutation) AddExploitReferenceIDs(ids ...uuid.UUID) {
+	if m.exploit_references == nil {
+		m.exploit_references = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.exploit_references[ids[i]] = struct{}{}
+	}
+}
+
+// ClearExploitReferences clears the "exploit_references" edge to the ExploitReference entity.
+func (m *VulnerabilityIDMutation) ClearExploitReferences() {
+	m.clearedexploit_references = true
+}
+
+// ExploitReferencesCleared reports if the "exploit_references" edge to the ExploitReference entity was cleared.
+func (m *VulnerabilityIDMutation) ExploitReferencesCleared() bool {
+	return m.clearedexploit_references
+}
+
+// RemoveExploitReferenceIDs removes the "exploit_references" edge to the ExploitReference entity by IDs.
+func (m *VulnerabilityIDMutation) RemoveExploitReferenceIDs(ids ...uuid.UUID) {
+	if m.removedexploit_references == nil {
+		m.removedexploit_references = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.exploit_references, ids[i])
+		m.removedexploit_references[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedExploitReferences returns the removed IDs of the "exploit_references" edge to the ExploitReference entity.
+func (m *VulnerabilityIDMutation) RemovedExploitReferencesIDs() (ids []uuid.UUID) {
+	for id := range m.removedexploit_references {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ExploitReferencesIDs returns the "exploit_references" edge IDs in the mutation.
+func (m *VulnerabilityIDMutation) ExploitReferencesIDs() (ids []uuid.UUID) {
+	for id := range m.exploit_references {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetExploitReferences resets all changes to the "exploit_references" edge.
+func (m *VulnerabilityIDMutation) ResetExploitReferences() {
+	m.exploit_references = nil
+	m.clearedexploit_references = false
+	m.removedexploit_references = nil
+}
+
 // Where appends a list predicates to the VulnerabilityIDMutation builder.
 func (m *VulnerabilityIDMutation) Where(ps ...predicate.VulnerabilityID) {
 	m.predicates = append(m.predicates, ps...)
@@ -22397,7 +24766,7 @@ func (m *VulnerabilityIDMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *VulnerabilityIDMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 6)
 	if m.vuln_equal_vuln_a != nil {
 		edges = append(edges, vulnerabilityid.EdgeVulnEqualVulnA)
 	}
@@ -22413,6 +24782,9 @@ func (m *VulnerabilityIDMutation) AddedEdges() []string {
 	if m.vex != nil {
 		edges = append(edges, vulnerabilityid.EdgeVex)
 	}
+	if m.exploit_references != nil {
+		edges = append(edges, vulnerabilityid.EdgeExploitReferences)
+	}
 	return edges
 }
 
@@ -22450,13 +24822,19 @@ func (m *VulnerabilityIDMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case vulnerabilityid.EdgeExploitReferences:
+		ids := make([]ent.Value, 0, len(m.exploit_references))
+		for id := range m.exploit_references {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *VulnerabilityIDMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 6)
 	if m.removedvuln_equal_vuln_a != nil {
 		edges = append(edges, vulnerabilityid.EdgeVulnEqualVulnA)
 	}
@@ -22472,6 +24850,9 @@ func (m *VulnerabilityIDMutation) RemovedEdges() []string {
 	if m.removedvex != nil {
 		edges = append(edges, vulnerabilityid.EdgeVex)
 	}
+	if m.removedexploit_references != nil {
+		edges = append(edges, vulnerabilityid.EdgeExploitReferences)
+	}
 	return edges
 }
 
@@ -22509,13 +24890,19 @@ func (m *VulnerabilityIDMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case vulnerabilityid.EdgeExploitReferences:
+		ids := make([]ent.Value, 0, len(m.removedexploit_references))
+		for id := range m.removedexploit_references {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *VulnerabilityIDMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 6)
 	if m.clearedvuln_equal_vuln_a {
 		edges = append(edges, vulnerabilityid.EdgeVulnEqualVulnA)
 	}
@@ -22531,6 +24918,9 @@ func (m *VulnerabilityIDMutation) ClearedEdges() []string {
 	if m.clearedvex {
 		edges = append(edges, vulnerabilityid.EdgeVex)
 	}
+	if m.clearedexploit_references {
+		edges = append(edges, vulnerabilityid.EdgeExploitReferences)
+	}
 	return edges
 }
 
@@ -22548,6 +24938,8 @@ func (m *VulnerabilityIDMutation) EdgeCleared(name string) bool {
 		return m.clearedcertify_vuln
 	case vulnerabilityid.EdgeVex:
 		return m.clearedvex
+	case vulnerabilityid.EdgeExploitReferences:
+		return m.clearedexploit_references
 	}
 	return false
 }
@@ -22579,6 +24971,9 @@ func (m *VulnerabilityIDMutation) ResetEdge(name string) error {
 	case vulnerabilityid.EdgeVex:
 		m.ResetVex()
 		return nil
+	case vulnerabilityid.EdgeExploitReferences:
+		m.ResetExploitReferences()
+		return nil
 	}
 	return fmt.Errorf("unknown VulnerabilityID edge %s", name)
 }
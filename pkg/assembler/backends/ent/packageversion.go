@@ -30,6 +30,8 @@ type PackageVersion struct {
 	Qualifiers []model.PackageQualifier `json:"qualifiers,omitempty"`
 	// A SHA1 of the qualifiers, subpath, version fields after sorting keys, used to ensure uniqueness of version records.
 	Hash string `json:"hash,omitempty"`
+	// The SBOM component type (e.g., library, application) recorded when the version was first ingested.
+	ComponentType string `json:"component_type,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PackageVersionQuery when eager-loading is set.
 	Edges        PackageVersionEdges `json:"edges"`
@@ -236,7 +238,7 @@ func (*PackageVersion) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case packageversion.FieldQualifiers:
 			values[i] = new([]byte)
-		case packageversion.FieldVersion, packageversion.FieldSubpath, packageversion.FieldHash:
+		case packageversion.FieldVersion, packageversion.FieldSubpath, packageversion.FieldHash, packageversion.FieldComponentType:
 			values[i] = new(sql.NullString)
 		case packageversion.FieldID, packageversion.FieldNameID:
 			values[i] = new(uuid.UUID)
@@ -293,6 +295,12 @@ func (pv *PackageVersion) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pv.Hash = value.String
 			}
+		case packageversion.FieldComponentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field component_type", values[i])
+			} else if value.Valid {
+				pv.ComponentType = value.String
+			}
 		default:
 			pv.selectValues.Set(columns[i], values[i])
 		}
@@ -418,6 +426,9 @@ func (pv *PackageVersion) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("hash=")
 	builder.WriteString(pv.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("component_type=")
+	builder.WriteString(pv.ComponentType)
 	builder.WriteByte(')')
 	return builder.String()
 }
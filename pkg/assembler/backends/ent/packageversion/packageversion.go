@@ -23,6 +23,8 @@ const (
 	FieldQualifiers = "qualifiers"
 	// FieldHash holds the string denoting the hash field in the database.
 	FieldHash = "hash"
+	// FieldComponentType holds the string denoting the component_type field in the database.
+	FieldComponentType = "component_type"
 	// EdgeName holds the string denoting the name edge name in mutations.
 	EdgeName = "name"
 	// EdgeOccurrences holds the string denoting the occurrences edge name in mutations.
@@ -168,6 +170,7 @@ var Columns = []string{
 	FieldSubpath,
 	FieldQualifiers,
 	FieldHash,
+	FieldComponentType,
 }
 
 var (
@@ -191,6 +194,8 @@ var (
 	DefaultVersion string
 	// DefaultSubpath holds the default value on creation for the "subpath" field.
 	DefaultSubpath string
+	// DefaultComponentType holds the default value on creation for the "component_type" field.
+	DefaultComponentType string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -223,6 +228,11 @@ func ByHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldHash, opts...).ToFunc()
 }
 
+// ByComponentType orders the results by the component_type field.
+func ByComponentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldComponentType, opts...).ToFunc()
+}
+
 // ByNameField orders the results by name field.
 func ByNameField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -74,6 +74,11 @@ func Hash(v string) predicate.PackageVersion {
 	return predicate.PackageVersion(sql.FieldEQ(FieldHash, v))
 }
 
+// ComponentType applies equality check predicate on the "component_type" field. It's identical to ComponentTypeEQ.
+func ComponentType(v string) predicate.PackageVersion {
+	return predicate.PackageVersion(sql.FieldEQ(FieldComponentType, v))
+}
+
 // NameIDEQ applies the EQ predicate on the "name_id" field.
 func NameIDEQ(v uuid.UUID) predicate.PackageVersion {
 	return predicate.PackageVersion(sql.FieldEQ(FieldNameID, v))
@@ -299,6 +304,71 @@ func HashContainsFold(v string) predicate.PackageVersion {
 	return predicate.PackageVersion(sql.FieldContainsFold(FieldHash, v))
 }
 
+// ComponentTypeEQ applies the EQ predicate on the "component_type" field.
+func ComponentTypeEQ(v string) predicate.PackageVersion {
+	return predicate.PackageVersion(sql.FieldEQ(FieldComponentType, v))
+}
+
+// ComponentTypeNEQ applies the NEQ predicate on the "component_type" field.
+func ComponentTypeNEQ(v string) predicate.PackageVersion {
+	return predicate.PackageVersion(sql.FieldNEQ(FieldComponentType, v))
+}
+
+// ComponentTypeIn applies the In predicate on the "component_type" field.
+func ComponentTypeIn(vs ...string) predicate.PackageVersion {
+	return predicate.PackageVersion(sql.FieldIn(FieldComponentType, vs...))
+}
+
+// ComponentTypeNotIn applies the NotIn predicate on the "component_type" field.
+func ComponentTypeNotIn(vs ...string) predicate.PackageVersion {
+	return predicate.PackageVersion(sql.FieldNotIn(FieldComponentType, vs...))
+}
+
+// ComponentTypeGT applies the GT predicate on the "component_type" field.
+func ComponentTypeGT(v string) predicate.PackageVersion {
+	return predicate.PackageVersion(sql.FieldGT(FieldComponentType, v))
+}
+
+// ComponentTypeGTE applies the GTE predicate on the "component_type" field.
+func ComponentTypeGTE(v string) predicate.PackageVersion {
+	return predicate.PackageVersion(sql.FieldGTE(FieldComponentType, v))
+}
+
+// ComponentTypeLT applies the LT predicate on the "component_type" field.
+func ComponentTypeLT(v string) predicate.PackageVersion {
+	return predicate.PackageVersion(sql.FieldLT(FieldComponentType, v))
+}
+
+// ComponentTypeLTE applies the LTE predicate on the "component_type" field.
+func ComponentTypeLTE(v string) predicate.PackageVersion {
+	return predicate.PackageVersion(sql.FieldLTE(FieldComponentType, v))
+}
+
+// ComponentTypeContains applies the Contains predicate on the "component_type" field.
+func ComponentTypeContains(v string) predicate.PackageVersion {
+	return predicate.PackageVersion(sql.FieldContains(FieldComponentType, v))
+}
+
+// ComponentTypeHasPrefix applies the HasPrefix predicate on the "component_type" field.
+func ComponentTypeHasPrefix(v string) predicate.PackageVersion {
+	return predicate.PackageVersion(sql.FieldHasPrefix(FieldComponentType, v))
+}
+
+// ComponentTypeHasSuffix applies the HasSuffix predicate on the "component_type" field.
+func ComponentTypeHasSuffix(v string) predicate.PackageVersion {
+	return predicate.PackageVersion(sql.FieldHasSuffix(FieldComponentType, v))
+}
+
+// ComponentTypeEqualFold applies the EqualFold predicate on the "component_type" field.
+func ComponentTypeEqualFold(v string) predicate.PackageVersion {
+	return predicate.PackageVersion(sql.FieldEqualFold(FieldComponentType, v))
+}
+
+// ComponentTypeContainsFold applies the ContainsFold predicate on the "component_type" field.
+func ComponentTypeContainsFold(v string) predicate.PackageVersion {
+	return predicate.PackageVersion(sql.FieldContainsFold(FieldComponentType, v))
+}
+
 // HasName applies the HasEdge predicate on the "name" edge.
 func HasName() predicate.PackageVersion {
 	return predicate.PackageVersion(func(s *sql.Selector) {
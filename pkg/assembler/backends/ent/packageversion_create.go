@@ -82,6 +82,20 @@ func (pvc *PackageVersionCreate) SetHash(s string) *PackageVersionCreate {
 	return pvc
 }
 
+// SetComponentType sets the "component_type" field.
+func (pvc *PackageVersionCreate) SetComponentType(s string) *PackageVersionCreate {
+	pvc.mutation.SetComponentType(s)
+	return pvc
+}
+
+// SetNillableComponentType sets the "component_type" field if the given value is not nil.
+func (pvc *PackageVersionCreate) SetNillableComponentType(s *string) *PackageVersionCreate {
+	if s != nil {
+		pvc.SetComponentType(*s)
+	}
+	return pvc
+}
+
 // SetID sets the "id" field.
 func (pvc *PackageVersionCreate) SetID(u uuid.UUID) *PackageVersionCreate {
 	pvc.mutation.SetID(u)
@@ -354,6 +368,10 @@ func (pvc *PackageVersionCreate) defaults() {
 		v := packageversion.DefaultSubpath
 		pvc.mutation.SetSubpath(v)
 	}
+	if _, ok := pvc.mutation.ComponentType(); !ok {
+		v := packageversion.DefaultComponentType
+		pvc.mutation.SetComponentType(v)
+	}
 	if _, ok := pvc.mutation.ID(); !ok {
 		v := packageversion.DefaultID()
 		pvc.mutation.SetID(v)
@@ -374,6 +392,9 @@ func (pvc *PackageVersionCreate) check() error {
 	if _, ok := pvc.mutation.Hash(); !ok {
 		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "PackageVersion.hash"`)}
 	}
+	if _, ok := pvc.mutation.ComponentType(); !ok {
+		return &ValidationError{Name: "component_type", err: errors.New(`ent: missing required field "PackageVersion.component_type"`)}
+	}
 	if _, ok := pvc.mutation.NameID(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required edge "PackageVersion.name"`)}
 	}
@@ -429,6 +450,10 @@ func (pvc *PackageVersionCreate) createSpec() (*PackageVersion, *sqlgraph.Create
 		_spec.SetField(packageversion.FieldHash, field.TypeString, value)
 		_node.Hash = value
 	}
+	if value, ok := pvc.mutation.ComponentType(); ok {
+		_spec.SetField(packageversion.FieldComponentType, field.TypeString, value)
+		_node.ComponentType = value
+	}
 	if nodes := pvc.mutation.NameIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -788,6 +813,18 @@ func (u *PackageVersionUpsert) UpdateHash() *PackageVersionUpsert {
 	return u
 }
 
+// SetComponentType sets the "component_type" field.
+func (u *PackageVersionUpsert) SetComponentType(v string) *PackageVersionUpsert {
+	u.Set(packageversion.FieldComponentType, v)
+	return u
+}
+
+// UpdateComponentType sets the "component_type" field to the value that was provided on create.
+func (u *PackageVersionUpsert) UpdateComponentType() *PackageVersionUpsert {
+	u.SetExcluded(packageversion.FieldComponentType)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -913,6 +950,20 @@ func (u *PackageVersionUpsertOne) UpdateHash() *PackageVersionUpsertOne {
 	})
 }
 
+// SetComponentType sets the "component_type" field.
+func (u *PackageVersionUpsertOne) SetComponentType(v string) *PackageVersionUpsertOne {
+	return u.Update(func(s *PackageVersionUpsert) {
+		s.SetComponentType(v)
+	})
+}
+
+// UpdateComponentType sets the "component_type" field to the value that was provided on create.
+func (u *PackageVersionUpsertOne) UpdateComponentType() *PackageVersionUpsertOne {
+	return u.Update(func(s *PackageVersionUpsert) {
+		s.UpdateComponentType()
+	})
+}
+
 // Exec executes the query.
 func (u *PackageVersionUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1205,6 +1256,20 @@ func (u *PackageVersionUpsertBulk) UpdateHash() *PackageVersionUpsertBulk {
 	})
 }
 
+// SetComponentType sets the "component_type" field.
+func (u *PackageVersionUpsertBulk) SetComponentType(v string) *PackageVersionUpsertBulk {
+	return u.Update(func(s *PackageVersionUpsert) {
+		s.SetComponentType(v)
+	})
+}
+
+// UpdateComponentType sets the "component_type" field to the value that was provided on create.
+func (u *PackageVersionUpsertBulk) UpdateComponentType() *PackageVersionUpsertBulk {
+	return u.Update(func(s *PackageVersionUpsert) {
+		s.UpdateComponentType()
+	})
+}
+
 // Exec executes the query.
 func (u *PackageVersionUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -116,6 +116,20 @@ func (pvu *PackageVersionUpdate) SetNillableHash(s *string) *PackageVersionUpdat
 	return pvu
 }
 
+// SetComponentType sets the "component_type" field.
+func (pvu *PackageVersionUpdate) SetComponentType(s string) *PackageVersionUpdate {
+	pvu.mutation.SetComponentType(s)
+	return pvu
+}
+
+// SetNillableComponentType sets the "component_type" field if the given value is not nil.
+func (pvu *PackageVersionUpdate) SetNillableComponentType(s *string) *PackageVersionUpdate {
+	if s != nil {
+		pvu.SetComponentType(*s)
+	}
+	return pvu
+}
+
 // SetName sets the "name" edge to the PackageName entity.
 func (pvu *PackageVersionUpdate) SetName(p *PackageName) *PackageVersionUpdate {
 	return pvu.SetNameID(p.ID)
@@ -703,6 +717,9 @@ func (pvu *PackageVersionUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := pvu.mutation.Hash(); ok {
 		_spec.SetField(packageversion.FieldHash, field.TypeString, value)
 	}
+	if value, ok := pvu.mutation.ComponentType(); ok {
+		_spec.SetField(packageversion.FieldComponentType, field.TypeString, value)
+	}
 	if pvu.mutation.NameCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1456,6 +1473,20 @@ func (pvuo *PackageVersionUpdateOne) SetNillableHash(s *string) *PackageVersionU
 	return pvuo
 }
 
+// SetComponentType sets the "component_type" field.
+func (pvuo *PackageVersionUpdateOne) SetComponentType(s string) *PackageVersionUpdateOne {
+	pvuo.mutation.SetComponentType(s)
+	return pvuo
+}
+
+// SetNillableComponentType sets the "component_type" field if the given value is not nil.
+func (pvuo *PackageVersionUpdateOne) SetNillableComponentType(s *string) *PackageVersionUpdateOne {
+	if s != nil {
+		pvuo.SetComponentType(*s)
+	}
+	return pvuo
+}
+
 // SetName sets the "name" edge to the PackageName entity.
 func (pvuo *PackageVersionUpdateOne) SetName(p *PackageName) *PackageVersionUpdateOne {
 	return pvuo.SetNameID(p.ID)
@@ -2073,6 +2104,9 @@ func (pvuo *PackageVersionUpdateOne) sqlSave(ctx context.Context) (_node *Packag
 	if value, ok := pvuo.mutation.Hash(); ok {
 		_spec.SetField(packageversion.FieldHash, field.TypeString, value)
 	}
+	if value, ok := pvuo.mutation.ComponentType(); ok {
+		_spec.SetField(packageversion.FieldComponentType, field.TypeString, value)
+	}
 	if pvuo.mutation.NameCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -17,16 +17,64 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 )
 
+// schemaRevisionsTable is where Atlas records the versioned migrations
+// applied to the database.
+const schemaRevisionsTable = "atlas_schema_revisions.atlas_schema_revisions"
+
+// sqlDriver returns the SQL driver of the client, looking through the drivers
+// wrapping it that return the driver they wrap from an Unwrap method.
+func (c *Client) sqlDriver() (*sql.Driver, bool) {
+	drv := c.driver
+	for {
+		switch d := drv.(type) {
+		case *sql.Driver:
+			return d, true
+		case interface{ Unwrap() dialect.Driver }:
+			drv = d.Unwrap()
+		default:
+			return nil, false
+		}
+	}
+}
+
 func (c *Client) Ping(ctx context.Context) error {
-	driver, ok := c.driver.(*sql.Driver)
+	driver, ok := c.sqlDriver()
 	if ok {
 		return driver.DB().PingContext(ctx)
 	}
 
 	return fmt.Errorf("connection does not support Ping")
 }
+
+// SchemaVersion returns the version of the last versioned migration applied to
+// the database, or an empty string if the schema is migrated automatically on
+// startup and so has no recorded version.
+func (c *Client) SchemaVersion(ctx context.Context) (string, error) {
+	driver, ok := c.sqlDriver()
+	if !ok {
+		return "", fmt.Errorf("connection does not support SchemaVersion")
+	}
+	db := driver.DB()
+
+	var tracked bool
+	if err := db.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", schemaRevisionsTable).Scan(&tracked); err != nil {
+		return "", fmt.Errorf("failed to look up the schema revisions table: %w", err)
+	}
+	if !tracked {
+		return "", nil
+	}
+	var version string
+	err := db.QueryRowContext(ctx, "SELECT version FROM "+schemaRevisionsTable+" ORDER BY version DESC LIMIT 1").Scan(&version)
+	if err != nil && !errors.Is(err, stdsql.ErrNoRows) {
+		return "", fmt.Errorf("failed to query the schema version: %w", err)
+	}
+	return version, nil
+}
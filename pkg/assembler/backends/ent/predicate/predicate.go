@@ -30,9 +30,15 @@ type CertifyVex func(*sql.Selector)
 // CertifyVuln is the predicate function for certifyvuln builders.
 type CertifyVuln func(*sql.Selector)
 
+// CertifyVulnArchive is the predicate function for certifyvulnarchive builders.
+type CertifyVulnArchive func(*sql.Selector)
+
 // Dependency is the predicate function for dependency builders.
 type Dependency func(*sql.Selector)
 
+// ExploitReference is the predicate function for exploitreference builders.
+type ExploitReference func(*sql.Selector)
+
 // HasMetadata is the predicate function for hasmetadata builders.
 type HasMetadata func(*sql.Selector)
 
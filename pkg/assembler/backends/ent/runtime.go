@@ -14,7 +14,9 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvulnarchive"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploitreference"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -92,12 +94,24 @@ func init() {
 	certifyvulnDescID := certifyvulnFields[0].Descriptor()
 	// certifyvuln.DefaultID holds the default value on creation for the id field.
 	certifyvuln.DefaultID = certifyvulnDescID.Default.(func() uuid.UUID)
+	certifyvulnarchiveFields := schema.CertifyVulnArchive{}.Fields()
+	_ = certifyvulnarchiveFields
+	// certifyvulnarchiveDescID is the schema descriptor for id field.
+	certifyvulnarchiveDescID := certifyvulnarchiveFields[0].Descriptor()
+	// certifyvulnarchive.DefaultID holds the default value on creation for the id field.
+	certifyvulnarchive.DefaultID = certifyvulnarchiveDescID.Default.(func() uuid.UUID)
 	dependencyFields := schema.Dependency{}.Fields()
 	_ = dependencyFields
 	// dependencyDescID is the schema descriptor for id field.
 	dependencyDescID := dependencyFields[0].Descriptor()
 	// dependency.DefaultID holds the default value on creation for the id field.
 	dependency.DefaultID = dependencyDescID.Default.(func() uuid.UUID)
+	exploitreferenceFields := schema.ExploitReference{}.Fields()
+	_ = exploitreferenceFields
+	// exploitreferenceDescID is the schema descriptor for id field.
+	exploitreferenceDescID := exploitreferenceFields[0].Descriptor()
+	// exploitreference.DefaultID holds the default value on creation for the id field.
+	exploitreference.DefaultID = exploitreferenceDescID.Default.(func() uuid.UUID)
 	hasmetadataFields := schema.HasMetadata{}.Fields()
 	_ = hasmetadataFields
 	// hasmetadataDescID is the schema descriptor for id field.
@@ -156,6 +170,10 @@ func init() {
 	packageversionDescSubpath := packageversionFields[3].Descriptor()
 	// packageversion.DefaultSubpath holds the default value on creation for the subpath field.
 	packageversion.DefaultSubpath = packageversionDescSubpath.Default.(string)
+	// packageversionDescComponentType is the schema descriptor for component_type field.
+	packageversionDescComponentType := packageversionFields[6].Descriptor()
+	// packageversion.DefaultComponentType holds the default value on creation for the component_type field.
+	packageversion.DefaultComponentType = packageversionDescComponentType.Default.(string)
 	// packageversionDescID is the schema descriptor for id field.
 	packageversionDescID := packageversionFields[0].Descriptor()
 	// packageversion.DefaultID holds the default value on creation for the id field.
@@ -70,6 +70,11 @@ func (Artifact) Edges() []ent.Edge {
 // NOTE: Given the nature of digests, we could treat them as unique identifiers
 // with a single index, but currently we index both alg and digest so that it is possible
 // to query all artifacts using a specific algorithm.
+//
+// Digests are stored lowercase, so a digest prefix lookup is a LIKE 'prefix%'
+// on the digest column. With the algorithm also given, Postgres can answer it
+// from this index as a range scan on digest (this needs the C collation or a
+// text_pattern_ops index).
 func (Artifact) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("algorithm", "digest").Unique(),
@@ -21,6 +21,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
 // CertifyVuln holds the schema definition for the CertifyVuln entity.
@@ -45,6 +46,12 @@ func (CertifyVuln) Fields() []ent.Field {
 		field.String("origin"),
 		field.String("collector"),
 		field.String("document_ref"),
+		field.Float("cvss_score").Optional().Nillable(),
+		field.Enum("remediation_status").Values(model.RemediationStatusOpen.String(), model.RemediationStatusStale.String()).Default(model.RemediationStatusOpen.String()),
+		// the HasSBOM or HasSourceAt that resolved the vulnerability, recorded
+		// after the certification was ingested
+		field.UUID("resolved_by_id", uuid.UUID{}).Optional().Nillable(),
+		field.String("resolved_by_type").Optional().Nillable(),
 	}
 }
 
@@ -60,5 +67,7 @@ func (CertifyVuln) Edges() []ent.Edge {
 func (CertifyVuln) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("db_uri", "db_version", "scanner_uri", "scanner_version", "origin", "collector", "time_scanned", "document_ref").Edges("vulnerability", "package").Unique(),
+		// lookup of the certifications ingested from a document
+		index.Fields("document_ref"),
 	}
 }
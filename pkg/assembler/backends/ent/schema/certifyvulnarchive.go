@@ -0,0 +1,56 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/index"
+)
+
+// CertifyVulnArchive holds the CertifyVuln records moved out of the
+// certify_vulns table once they are old enough to be rarely queried. It has
+// the same fields and edges, and archived records keep their ID.
+type CertifyVulnArchive struct {
+	ent.Schema
+}
+
+// Annotations of the CertifyVulnArchive.
+func (CertifyVulnArchive) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "certify_vuln_archive"},
+	}
+}
+
+// Fields of the CertifyVulnArchive.
+func (CertifyVulnArchive) Fields() []ent.Field {
+	return CertifyVuln{}.Fields()
+}
+
+// Edges of the CertifyVulnArchive.
+func (CertifyVulnArchive) Edges() []ent.Edge {
+	return CertifyVuln{}.Edges()
+}
+
+// Indexes of the CertifyVulnArchive. There is no unique index, as the same
+// scan can be ingested and archived again.
+func (CertifyVulnArchive) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("time_scanned"),
+		index.Fields("document_ref"),
+	}
+}
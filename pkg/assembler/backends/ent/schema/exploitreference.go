@@ -0,0 +1,60 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ExploitReference holds the schema definition for the ExploitReference entity.
+type ExploitReference struct {
+	ent.Schema
+}
+
+// Fields of the ExploitReference.
+func (ExploitReference) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(getUUIDv7).
+			Unique().
+			Immutable(),
+		field.UUID("vulnerability_id_id", getUUIDv7()),
+		field.String("source"),
+		field.String("url"),
+		field.Bool("verified"),
+		field.String("origin"),
+		field.String("collector"),
+		field.String("document_ref"),
+	}
+}
+
+// Edges of the ExploitReference.
+func (ExploitReference) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("vulnerability_id", VulnerabilityID.Type).Field("vulnerability_id_id").Required().Unique(),
+	}
+}
+
+// Indexes of the ExploitReference.
+func (ExploitReference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vulnerability_id_id", "source", "url").Unique(),
+	}
+}
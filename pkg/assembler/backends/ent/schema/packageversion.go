@@ -43,6 +43,7 @@ func (PackageVersion) Fields() []ent.Field {
 		field.String("subpath").Default(""),
 		field.JSON("qualifiers", []model.PackageQualifier{}).Optional(),
 		field.String("hash").Comment("A SHA1 of the qualifiers, subpath, version fields after sorting keys, used to ensure uniqueness of version records."),
+		field.String("component_type").Default("").Comment("The SBOM component type (e.g., library, application) recorded when the version was first ingested."),
 	}
 }
 
@@ -28,8 +28,12 @@ type Tx struct {
 	CertifyVex *CertifyVexClient
 	// CertifyVuln is the client for interacting with the CertifyVuln builders.
 	CertifyVuln *CertifyVulnClient
+	// CertifyVulnArchive is the client for interacting with the CertifyVulnArchive builders.
+	CertifyVulnArchive *CertifyVulnArchiveClient
 	// Dependency is the client for interacting with the Dependency builders.
 	Dependency *DependencyClient
+	// ExploitReference is the client for interacting with the ExploitReference builders.
+	ExploitReference *ExploitReferenceClient
 	// HasMetadata is the client for interacting with the HasMetadata builders.
 	HasMetadata *HasMetadataClient
 	// HasSourceAt is the client for interacting with the HasSourceAt builders.
@@ -197,7 +201,9 @@ func (tx *Tx) init() {
 	tx.CertifyScorecard = NewCertifyScorecardClient(tx.config)
 	tx.CertifyVex = NewCertifyVexClient(tx.config)
 	tx.CertifyVuln = NewCertifyVulnClient(tx.config)
+	tx.CertifyVulnArchive = NewCertifyVulnArchiveClient(tx.config)
 	tx.Dependency = NewDependencyClient(tx.config)
+	tx.ExploitReference = NewExploitReferenceClient(tx.config)
 	tx.HasMetadata = NewHasMetadataClient(tx.config)
 	tx.HasSourceAt = NewHasSourceAtClient(tx.config)
 	tx.HashEqual = NewHashEqualClient(tx.config)
@@ -39,17 +39,20 @@ type VulnerabilityIDEdges struct {
 	CertifyVuln []*CertifyVuln `json:"certify_vuln,omitempty"`
 	// Vex holds the value of the vex edge.
 	Vex []*CertifyVex `json:"vex,omitempty"`
+	// ExploitReferences holds the value of the exploit_references edge.
+	ExploitReferences []*ExploitReference `json:"exploit_references,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [6]bool
 	// totalCount holds the count of the edges above.
-	totalCount [5]map[string]int
-
-	namedVulnEqualVulnA map[string][]*VulnEqual
-	namedVulnEqualVulnB map[string][]*VulnEqual
-	namedMetadata       map[string][]*VulnerabilityMetadata
-	namedCertifyVuln    map[string][]*CertifyVuln
-	namedVex            map[string][]*CertifyVex
+	totalCount [6]map[string]int
+
+	namedVulnEqualVulnA    map[string][]*VulnEqual
+	namedVulnEqualVulnB    map[string][]*VulnEqual
+	namedMetadata          map[string][]*VulnerabilityMetadata
+	namedCertifyVuln       map[string][]*CertifyVuln
+	namedVex               map[string][]*CertifyVex
+	namedExploitReferences map[string][]*ExploitReference
 }
 
 // VulnEqualVulnAOrErr returns the VulnEqualVulnA value or an error if the edge
@@ -97,6 +100,15 @@ func (e VulnerabilityIDEdges) VexOrErr() ([]*CertifyVex, error) {
 	return nil, &NotLoadedError{edge: "vex"}
 }
 
+// ExploitReferencesOrErr returns the ExploitReferences value or an error if the edge
+// was not loaded in eager-loading.
+func (e VulnerabilityIDEdges) ExploitReferencesOrErr() ([]*ExploitReference, error) {
+	if e.loadedTypes[5] {
+		return e.ExploitReferences, nil
+	}
+	return nil, &NotLoadedError{edge: "exploit_references"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*VulnerabilityID) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -177,6 +189,11 @@ func (vi *VulnerabilityID) QueryVex() *CertifyVexQuery {
 	return NewVulnerabilityIDClient(vi.config).QueryVex(vi)
 }
 
+// QueryExploitReferences queries the "exploit_references" edge of the VulnerabilityID entity.
+func (vi *VulnerabilityID) QueryExploitReferences() *ExploitReferenceQuery {
+	return NewVulnerabilityIDClient(vi.config).QueryExploitReferences(vi)
+}
+
 // Update returns a builder for updating this VulnerabilityID.
 // Note that you need to call VulnerabilityID.Unwrap() before calling this method if this VulnerabilityID
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -329,5 +346,29 @@ func (vi *VulnerabilityID) appendNamedVex(name string, edges ...*CertifyVex) {
 	}
 }
 
+// NamedExploitReferences returns the ExploitReferences named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (vi *VulnerabilityID) NamedExploitReferences(name string) ([]*ExploitReference, error) {
+	if vi.Edges.namedExploitReferences == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := vi.Edges.namedExploitReferences[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (vi *VulnerabilityID) appendNamedExploitReferences(name string, edges ...*ExploitReference) {
+	if vi.Edges.namedExploitReferences == nil {
+		vi.Edges.namedExploitReferences = make(map[string][]*ExploitReference)
+	}
+	if len(edges) == 0 {
+		vi.Edges.namedExploitReferences[name] = []*ExploitReference{}
+	} else {
+		vi.Edges.namedExploitReferences[name] = append(vi.Edges.namedExploitReferences[name], edges...)
+	}
+}
+
 // VulnerabilityIDs is a parsable slice of VulnerabilityID.
 type VulnerabilityIDs []*VulnerabilityID
@@ -27,6 +27,8 @@ const (
 	EdgeCertifyVuln = "certify_vuln"
 	// EdgeVex holds the string denoting the vex edge name in mutations.
 	EdgeVex = "vex"
+	// EdgeExploitReferences holds the string denoting the exploit_references edge name in mutations.
+	EdgeExploitReferences = "exploit_references"
 	// Table holds the table name of the vulnerabilityid in the database.
 	Table = "vulnerability_ids"
 	// VulnEqualVulnATable is the table that holds the vuln_equal_vuln_a relation/edge.
@@ -64,6 +66,13 @@ const (
 	VexInverseTable = "certify_vexes"
 	// VexColumn is the table column denoting the vex relation/edge.
 	VexColumn = "vulnerability_id"
+	// ExploitReferencesTable is the table that holds the exploit_references relation/edge.
+	ExploitReferencesTable = "exploit_references"
+	// ExploitReferencesInverseTable is the table name for the ExploitReference entity.
+	// It exists in this package in order to avoid circular dependency with the "exploitreference" package.
+	ExploitReferencesInverseTable = "exploit_references"
+	// ExploitReferencesColumn is the table column denoting the exploit_references relation/edge.
+	ExploitReferencesColumn = "vulnerability_id_id"
 )
 
 // Columns holds all SQL columns for vulnerabilityid fields.
@@ -177,6 +186,20 @@ func ByVex(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newVexStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByExploitReferencesCount orders the results by exploit_references count.
+func ByExploitReferencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExploitReferencesStep(), opts...)
+	}
+}
+
+// ByExploitReferences orders the results by exploit_references terms.
+func ByExploitReferences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExploitReferencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newVulnEqualVulnAStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -212,3 +235,10 @@ func newVexStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, VexTable, VexColumn),
 	)
 }
+func newExploitReferencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExploitReferencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ExploitReferencesTable, ExploitReferencesColumn),
+	)
+}
@@ -309,6 +309,29 @@ func HasVexWith(preds ...predicate.CertifyVex) predicate.VulnerabilityID {
 	})
 }
 
+// HasExploitReferences applies the HasEdge predicate on the "exploit_references" edge.
+func HasExploitReferences() predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ExploitReferencesTable, ExploitReferencesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExploitReferencesWith applies the HasEdge predicate on the "exploit_references" edge with a given conditions (other predicates).
+func HasExploitReferencesWith(preds ...predicate.ExploitReference) predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(func(s *sql.Selector) {
+		step := newExploitReferencesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.VulnerabilityID) predicate.VulnerabilityID {
 	return predicate.VulnerabilityID(sql.AndPredicates(predicates...))
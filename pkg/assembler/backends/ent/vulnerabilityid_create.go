@@ -14,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploitreference"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
@@ -128,6 +129,21 @@ func (vic *VulnerabilityIDCreate) AddVex(c ...*CertifyVex) *VulnerabilityIDCreat
 	return vic.AddVexIDs(ids...)
 }
 
+// AddExploitReferenceIDs adds the "exploit_references" edge to the ExploitReference entity by IDs.
+func (vic *VulnerabilityIDCreate) AddExploitReferenceIDs(ids ...uuid.UUID) *VulnerabilityIDCreate {
+	vic.mutation.AddExploitReferenceIDs(ids...)
+	return vic
+}
+
+// AddExploitReferences adds the "exploit_references" edges to the ExploitReference entity.
+func (vic *VulnerabilityIDCreate) AddExploitReferences(e ...*ExploitReference) *VulnerabilityIDCreate {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return vic.AddExploitReferenceIDs(ids...)
+}
+
 // Mutation returns the VulnerabilityIDMutation object of the builder.
 func (vic *VulnerabilityIDCreate) Mutation() *VulnerabilityIDMutation {
 	return vic.mutation
@@ -306,6 +322,22 @@ func (vic *VulnerabilityIDCreate) createSpec() (*VulnerabilityID, *sqlgraph.Crea
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := vic.mutation.ExploitReferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   vulnerabilityid.ExploitReferencesTable,
+			Columns: []string{vulnerabilityid.ExploitReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exploitreference.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -14,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploitreference"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
@@ -23,22 +24,24 @@ import (
 // VulnerabilityIDQuery is the builder for querying VulnerabilityID entities.
 type VulnerabilityIDQuery struct {
 	config
-	ctx                     *QueryContext
-	order                   []vulnerabilityid.OrderOption
-	inters                  []Interceptor
-	predicates              []predicate.VulnerabilityID
-	withVulnEqualVulnA      *VulnEqualQuery
-	withVulnEqualVulnB      *VulnEqualQuery
-	withMetadata            *VulnerabilityMetadataQuery
-	withCertifyVuln         *CertifyVulnQuery
-	withVex                 *CertifyVexQuery
-	modifiers               []func(*sql.Selector)
-	loadTotal               []func(context.Context, []*VulnerabilityID) error
-	withNamedVulnEqualVulnA map[string]*VulnEqualQuery
-	withNamedVulnEqualVulnB map[string]*VulnEqualQuery
-	withNamedMetadata       map[string]*VulnerabilityMetadataQuery
-	withNamedCertifyVuln    map[string]*CertifyVulnQuery
-	withNamedVex            map[string]*CertifyVexQuery
+	ctx                        *QueryContext
+	order                      []vulnerabilityid.OrderOption
+	inters                     []Interceptor
+	predicates                 []predicate.VulnerabilityID
+	withVulnEqualVulnA         *VulnEqualQuery
+	withVulnEqualVulnB         *VulnEqualQuery
+	withMetadata               *VulnerabilityMetadataQuery
+	withCertifyVuln            *CertifyVulnQuery
+	withVex                    *CertifyVexQuery
+	withExploitReferences      *ExploitReferenceQuery
+	modifiers                  []func(*sql.Selector)
+	loadTotal                  []func(context.Context, []*VulnerabilityID) error
+	withNamedVulnEqualVulnA    map[string]*VulnEqualQuery
+	withNamedVulnEqualVulnB    map[string]*VulnEqualQuery
+	withNamedMetadata          map[string]*VulnerabilityMetadataQuery
+	withNamedCertifyVuln       map[string]*CertifyVulnQuery
+	withNamedVex               map[string]*CertifyVexQuery
+	withNamedExploitReferences map[string]*ExploitReferenceQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -185,6 +188,28 @@ func (viq *VulnerabilityIDQuery) QueryVex() *CertifyVexQuery {
 	return query
 }
 
+// QueryExploitReferences chains the current query on the "exploit_references" edge.
+func (viq *VulnerabilityIDQuery) QueryExploitReferences() *ExploitReferenceQuery {
+	query := (&ExploitReferenceClient{config: viq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := viq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := viq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vulnerabilityid.Table, vulnerabilityid.FieldID, selector),
+			sqlgraph.To(exploitreference.Table, exploitreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, vulnerabilityid.ExploitReferencesTable, vulnerabilityid.ExploitReferencesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(viq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first VulnerabilityID entity from the query.
 // Returns a *NotFoundError when no VulnerabilityID was found.
 func (viq *VulnerabilityIDQuery) First(ctx context.Context) (*VulnerabilityID, error) {
@@ -372,16 +397,17 @@ func (viq *VulnerabilityIDQuery) Clone() *VulnerabilityIDQuery {
 		return nil
 	}
 	return &VulnerabilityIDQuery{
-		config:             viq.config,
-		ctx:                viq.ctx.Clone(),
-		order:              append([]vulnerabilityid.OrderOption{}, viq.order...),
-		inters:             append([]Interceptor{}, viq.inters...),
-		predicates:         append([]predicate.VulnerabilityID{}, viq.predicates...),
-		withVulnEqualVulnA: viq.withVulnEqualVulnA.Clone(),
-		withVulnEqualVulnB: viq.withVulnEqualVulnB.Clone(),
-		withMetadata:       viq.withMetadata.Clone(),
-		withCertifyVuln:    viq.withCertifyVuln.Clone(),
-		withVex:            viq.withVex.Clone(),
+		config:                viq.config,
+		ctx:                   viq.ctx.Clone(),
+		order:                 append([]vulnerabilityid.OrderOption{}, viq.order...),
+		inters:                append([]Interceptor{}, viq.inters...),
+		predicates:            append([]predicate.VulnerabilityID{}, viq.predicates...),
+		withVulnEqualVulnA:    viq.withVulnEqualVulnA.Clone(),
+		withVulnEqualVulnB:    viq.withVulnEqualVulnB.Clone(),
+		withMetadata:          viq.withMetadata.Clone(),
+		withCertifyVuln:       viq.withCertifyVuln.Clone(),
+		withVex:               viq.withVex.Clone(),
+		withExploitReferences: viq.withExploitReferences.Clone(),
 		// clone intermediate query.
 		sql:  viq.sql.Clone(),
 		path: viq.path,
@@ -443,6 +469,17 @@ func (viq *VulnerabilityIDQuery) WithVex(opts ...func(*CertifyVexQuery)) *Vulner
 	return viq
 }
 
+// WithExploitReferences tells the query-builder to eager-load the nodes that are connected to
+// the "exploit_references" edge. The optional arguments are used to configure the query builder of the edge.
+func (viq *VulnerabilityIDQuery) WithExploitReferences(opts ...func(*ExploitReferenceQuery)) *VulnerabilityIDQuery {
+	query := (&ExploitReferenceClient{config: viq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	viq.withExploitReferences = query
+	return viq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -521,12 +558,13 @@ func (viq *VulnerabilityIDQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	var (
 		nodes       = []*VulnerabilityID{}
 		_spec       = viq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [6]bool{
 			viq.withVulnEqualVulnA != nil,
 			viq.withVulnEqualVulnB != nil,
 			viq.withMetadata != nil,
 			viq.withCertifyVuln != nil,
 			viq.withVex != nil,
+			viq.withExploitReferences != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -585,6 +623,15 @@ func (viq *VulnerabilityIDQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := viq.withExploitReferences; query != nil {
+		if err := viq.loadExploitReferences(ctx, query, nodes,
+			func(n *VulnerabilityID) { n.Edges.ExploitReferences = []*ExploitReference{} },
+			func(n *VulnerabilityID, e *ExploitReference) {
+				n.Edges.ExploitReferences = append(n.Edges.ExploitReferences, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range viq.withNamedVulnEqualVulnA {
 		if err := viq.loadVulnEqualVulnA(ctx, query, nodes,
 			func(n *VulnerabilityID) { n.appendNamedVulnEqualVulnA(name) },
@@ -620,6 +667,13 @@ func (viq *VulnerabilityIDQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	for name, query := range viq.withNamedExploitReferences {
+		if err := viq.loadExploitReferences(ctx, query, nodes,
+			func(n *VulnerabilityID) { n.appendNamedExploitReferences(name) },
+			func(n *VulnerabilityID, e *ExploitReference) { n.appendNamedExploitReferences(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range viq.loadTotal {
 		if err := viq.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -778,6 +832,36 @@ func (viq *VulnerabilityIDQuery) loadVex(ctx context.Context, query *CertifyVexQ
 	}
 	return nil
 }
+func (viq *VulnerabilityIDQuery) loadExploitReferences(ctx context.Context, query *ExploitReferenceQuery, nodes []*VulnerabilityID, init func(*VulnerabilityID), assign func(*VulnerabilityID, *ExploitReference)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*VulnerabilityID)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(exploitreference.FieldVulnerabilityIDID)
+	}
+	query.Where(predicate.ExploitReference(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(vulnerabilityid.ExploitReferencesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VulnerabilityIDID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "vulnerability_id_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (viq *VulnerabilityIDQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := viq.querySpec()
@@ -933,6 +1017,20 @@ func (viq *VulnerabilityIDQuery) WithNamedVex(name string, opts ...func(*Certify
 	return viq
 }
 
+// WithNamedExploitReferences tells the query-builder to eager-load the nodes that are connected to the "exploit_references"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (viq *VulnerabilityIDQuery) WithNamedExploitReferences(name string, opts ...func(*ExploitReferenceQuery)) *VulnerabilityIDQuery {
+	query := (&ExploitReferenceClient{config: viq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if viq.withNamedExploitReferences == nil {
+		viq.withNamedExploitReferences = make(map[string]*ExploitReferenceQuery)
+	}
+	viq.withNamedExploitReferences[name] = query
+	return viq
+}
+
 // VulnerabilityIDGroupBy is the group-by builder for VulnerabilityID entities.
 type VulnerabilityIDGroupBy struct {
 	selector
@@ -13,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploitreference"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
@@ -135,6 +136,21 @@ func (viu *VulnerabilityIDUpdate) AddVex(c ...*CertifyVex) *VulnerabilityIDUpdat
 	return viu.AddVexIDs(ids...)
 }
 
+// AddExploitReferenceIDs adds the "exploit_references" edge to the ExploitReference entity by IDs.
+func (viu *VulnerabilityIDUpdate) AddExploitReferenceIDs(ids ...uuid.UUID) *VulnerabilityIDUpdate {
+	viu.mutation.AddExploitReferenceIDs(ids...)
+	return viu
+}
+
+// AddExploitReferences adds the "exploit_references" edges to the ExploitReference entity.
+func (viu *VulnerabilityIDUpdate) AddExploitReferences(e ...*ExploitReference) *VulnerabilityIDUpdate {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return viu.AddExploitReferenceIDs(ids...)
+}
+
 // Mutation returns the VulnerabilityIDMutation object of the builder.
 func (viu *VulnerabilityIDUpdate) Mutation() *VulnerabilityIDMutation {
 	return viu.mutation
@@ -245,6 +261,27 @@ func (viu *VulnerabilityIDUpdate) RemoveVex(c ...*CertifyVex) *VulnerabilityIDUp
 	return viu.RemoveVexIDs(ids...)
 }
 
+// ClearExploitReferences clears all "exploit_references" edges to the ExploitReference entity.
+func (viu *VulnerabilityIDUpdate) ClearExploitReferences() *VulnerabilityIDUpdate {
+	viu.mutation.ClearExploitReferences()
+	return viu
+}
+
+// RemoveExploitReferenceIDs removes the "exploit_references" edge to ExploitReference entities by IDs.
+func (viu *VulnerabilityIDUpdate) RemoveExploitReferenceIDs(ids ...uuid.UUID) *VulnerabilityIDUpdate {
+	viu.mutation.RemoveExploitReferenceIDs(ids...)
+	return viu
+}
+
+// RemoveExploitReferences removes "exploit_references" edges to ExploitReference entities.
+func (viu *VulnerabilityIDUpdate) RemoveExploitReferences(e ...*ExploitReference) *VulnerabilityIDUpdate {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return viu.RemoveExploitReferenceIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (viu *VulnerabilityIDUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, viu.sqlSave, viu.mutation, viu.hooks)
@@ -525,6 +562,51 @@ func (viu *VulnerabilityIDUpdate) sqlSave(ctx context.Context) (n int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if viu.mutation.ExploitReferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   vulnerabilityid.ExploitReferencesTable,
+			Columns: []string{vulnerabilityid.ExploitReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exploitreference.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := viu.mutation.RemovedExploitReferencesIDs(); len(nodes) > 0 && !viu.mutation.ExploitReferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   vulnerabilityid.ExploitReferencesTable,
+			Columns: []string{vulnerabilityid.ExploitReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exploitreference.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := viu.mutation.ExploitReferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   vulnerabilityid.ExploitReferencesTable,
+			Columns: []string{vulnerabilityid.ExploitReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exploitreference.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, viu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{vulnerabilityid.Label}
@@ -648,6 +730,21 @@ func (viuo *VulnerabilityIDUpdateOne) AddVex(c ...*CertifyVex) *VulnerabilityIDU
 	return viuo.AddVexIDs(ids...)
 }
 
+// AddExploitReferenceIDs adds the "exploit_references" edge to the ExploitReference entity by IDs.
+func (viuo *VulnerabilityIDUpdateOne) AddExploitReferenceIDs(ids ...uuid.UUID) *VulnerabilityIDUpdateOne {
+	viuo.mutation.AddExploitReferenceIDs(ids...)
+	return viuo
+}
+
+// AddExploitReferences adds the "exploit_references" edges to the ExploitReference entity.
+func (viuo *VulnerabilityIDUpdateOne) AddExploitReferences(e ...*ExploitReference) *VulnerabilityIDUpdateOne {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return viuo.AddExploitReferenceIDs(ids...)
+}
+
 // Mutation returns the VulnerabilityIDMutation object of the builder.
 func (viuo *VulnerabilityIDUpdateOne) Mutation() *VulnerabilityIDMutation {
 	return viuo.mutation
@@ -758,6 +855,27 @@ func (viuo *VulnerabilityIDUpdateOne) RemoveVex(c ...*CertifyVex) *Vulnerability
 	return viuo.RemoveVexIDs(ids...)
 }
 
+// ClearExploitReferences clears all "exploit_references" edges to the ExploitReference entity.
+func (viuo *VulnerabilityIDUpdateOne) ClearExploitReferences() *VulnerabilityIDUpdateOne {
+	viuo.mutation.ClearExploitReferences()
+	return viuo
+}
+
+// RemoveExploitReferenceIDs removes the "exploit_references" edge to ExploitReference entities by IDs.
+func (viuo *VulnerabilityIDUpdateOne) RemoveExploitReferenceIDs(ids ...uuid.UUID) *VulnerabilityIDUpdateOne {
+	viuo.mutation.RemoveExploitReferenceIDs(ids...)
+	return viuo
+}
+
+// RemoveExploitReferences removes "exploit_references" edges to ExploitReference entities.
+func (viuo *VulnerabilityIDUpdateOne) RemoveExploitReferences(e ...*ExploitReference) *VulnerabilityIDUpdateOne {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return viuo.RemoveExploitReferenceIDs(ids...)
+}
+
 // Where appends a list predicates to the VulnerabilityIDUpdate builder.
 func (viuo *VulnerabilityIDUpdateOne) Where(ps ...predicate.VulnerabilityID) *VulnerabilityIDUpdateOne {
 	viuo.mutation.Where(ps...)
@@ -1068,6 +1186,51 @@ func (viuo *VulnerabilityIDUpdateOne) sqlSave(ctx context.Context) (_node *Vulne
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if viuo.mutation.ExploitReferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   vulnerabilityid.ExploitReferencesTable,
+			Columns: []string{vulnerabilityid.ExploitReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exploitreference.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := viuo.mutation.RemovedExploitReferencesIDs(); len(nodes) > 0 && !viuo.mutation.ExploitReferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   vulnerabilityid.ExploitReferencesTable,
+			Columns: []string{vulnerabilityid.ExploitReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exploitreference.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := viuo.mutation.ExploitReferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   vulnerabilityid.ExploitReferencesTable,
+			Columns: []string{vulnerabilityid.ExploitReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exploitreference.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &VulnerabilityID{config: viuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,80 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Glob patterns used in filters support two wildcards: "*" matches any sequence
+// of characters, including "/", and "?" matches any single character. All other
+// characters match themselves.
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// GlobToLike converts a glob pattern to a SQL LIKE pattern using "\" as the
+// escape character.
+func GlobToLike(glob string) string {
+	var b strings.Builder
+	for _, r := range glob {
+		switch r {
+		case '*':
+			b.WriteByte('%')
+		case '?':
+			b.WriteByte('_')
+		default:
+			b.WriteString(likeEscaper.Replace(string(r)))
+		}
+	}
+	return b.String()
+}
+
+// PrefixToLike converts a prefix to a SQL LIKE pattern using "\" as the escape
+// character.
+func PrefixToLike(prefix string) string {
+	return likeEscaper.Replace(prefix) + "%"
+}
+
+// ContainsToLike converts a substring to a SQL LIKE pattern using "\" as the
+// escape character.
+func ContainsToLike(substr string) string {
+	return "%" + likeEscaper.Replace(substr) + "%"
+}
+
+// SuffixToLike converts a suffix to a SQL LIKE pattern using "\" as the escape
+// character.
+func SuffixToLike(suffix string) string {
+	return "%" + likeEscaper.Replace(suffix)
+}
+
+// MatchGlob reports whether s matches the glob pattern in full.
+func MatchGlob(glob, s string) bool {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			b.WriteString("(?s:.*)")
+		case '?':
+			b.WriteString("(?s:.)")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String()).MatchString(s)
+}
@@ -0,0 +1,132 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// NodeID returns the ID of a node. Packages and sources return the ID of their
+// deepest level, e.g. the package version, and vulnerabilities the ID of their
+// vulnerability ID.
+func NodeID(node model.Node) (string, error) {
+	switch v := node.(type) {
+	case *model.Package:
+		if v != nil && len(v.Namespaces) > 0 && len(v.Namespaces[0].Names) > 0 && len(v.Namespaces[0].Names[0].Versions) > 0 {
+			return v.Namespaces[0].Names[0].Versions[0].ID, nil
+		} else if v != nil && len(v.Namespaces) > 0 && len(v.Namespaces[0].Names) > 0 {
+			return v.Namespaces[0].Names[0].ID, nil
+		} else if v != nil && len(v.Namespaces) > 0 {
+			return v.Namespaces[0].ID, nil
+		} else {
+			return v.ID, nil
+		}
+	case *model.Artifact:
+		return v.ID, nil
+	case *model.Builder:
+		return v.ID, nil
+	case *model.Source:
+		if v != nil && len(v.Namespaces) > 0 && len(v.Namespaces[0].Names) > 0 {
+			return v.Namespaces[0].Names[0].ID, nil
+		} else if v != nil && len(v.Namespaces) > 0 {
+			return v.Namespaces[0].ID, nil
+		} else {
+			return v.ID, nil
+		}
+	case *model.Vulnerability:
+		if len(v.VulnerabilityIDs) > 0 {
+			return v.VulnerabilityIDs[0].ID, nil
+		} else {
+			return v.ID, nil
+		}
+	case *model.License:
+		return v.ID, nil
+	case *model.CertifyBad:
+		return v.ID, nil
+	case *model.CertifyGood:
+		return v.ID, nil
+	case *model.CertifyLegal:
+		return v.ID, nil
+	case *model.CertifyScorecard:
+		return v.ID, nil
+	case *model.CertifyVEXStatement:
+		return v.ID, nil
+	case *model.CertifyVuln:
+		return v.ID, nil
+	case *model.HashEqual:
+		return v.ID, nil
+	case *model.HasMetadata:
+		return v.ID, nil
+	case *model.HasSbom:
+		return v.ID, nil
+	case *model.HasSlsa:
+		return v.ID, nil
+	case *model.HasSourceAt:
+		return v.ID, nil
+	case *model.IsDependency:
+		return v.ID, nil
+	case *model.IsOccurrence:
+		return v.ID, nil
+	case *model.PkgEqual:
+		return v.ID, nil
+	case *model.PointOfContact:
+		return v.ID, nil
+	case *model.VulnEqual:
+		return v.ID, nil
+	case *model.VulnerabilityMetadata:
+		return v.ID, nil
+	case *model.ExploitReference:
+		return v.ID, nil
+	default:
+		return "", fmt.Errorf("unknown type: %v", v)
+	}
+}
+
+// NeighborsRecursive walks the graph breadth first from node, up to maxDepth
+// hops, using neighbors to find the neighbors of each node. It returns the nodes
+// reached in the order they were first found, without node itself.
+func NeighborsRecursive(ctx context.Context, node string, usingOnly []model.Edge, maxDepth int,
+	neighbors func(context.Context, string, []model.Edge) ([]model.Node, error)) ([]model.Node, error) {
+	visited := map[string]bool{node: true}
+	var found []model.Node
+	frontier := []string{node}
+	for depth := 0; depth < maxDepth && len(frontier) > 0; depth++ {
+		var next []string
+		for _, id := range frontier {
+			nodes, err := neighbors(ctx, id, usingOnly)
+			if err != nil {
+				return nil, err
+			}
+			for _, n := range nodes {
+				nID, err := NodeID(n)
+				if err != nil {
+					return nil, fmt.Errorf("failed to convert model.Node to a specific type with error: %w", err)
+				}
+				if visited[nID] {
+					continue
+				}
+				visited[nID] = true
+				found = append(found, n)
+				next = append(next, nID)
+			}
+		}
+		frontier = next
+	}
+	return found, nil
+}
@@ -0,0 +1,99 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// sortStable sorts items in place by key, reversing the order for a descending direction.
+func sortStable[T any, K cmp.Ordered](items []T, key func(T) K, direction model.OrderDirection) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		if direction == model.OrderDirectionDesc {
+			return cmp.Compare(key(b), key(a))
+		}
+		return cmp.Compare(key(a), key(b))
+	})
+}
+
+// SortCertifyVulns sorts certifications in place. A nil order leaves them untouched.
+func SortCertifyVulns(cvs []*model.CertifyVuln, order *model.CertifyVulnOrder) {
+	if order == nil {
+		return
+	}
+	switch order.SortBy {
+	case model.CertifyVulnOrderFieldTimeScanned:
+		sortStable(cvs, func(cv *model.CertifyVuln) int64 { return cv.Metadata.TimeScanned.UnixNano() }, order.SortOrder)
+	case model.CertifyVulnOrderFieldScannerVersion:
+		sortStable(cvs, func(cv *model.CertifyVuln) string { return cv.Metadata.ScannerVersion }, order.SortOrder)
+	case model.CertifyVulnOrderFieldDbVersion:
+		sortStable(cvs, func(cv *model.CertifyVuln) string { return cv.Metadata.DbVersion }, order.SortOrder)
+	}
+}
+
+// SortHasSourceAts sorts HasSourceAt results in place. A nil order leaves them untouched.
+func SortHasSourceAts(hsas []*model.HasSourceAt, order *model.HasSourceAtOrder) {
+	if order == nil {
+		return
+	}
+	switch order.SortBy {
+	case model.HasSourceAtOrderFieldKnownSince:
+		sortStable(hsas, func(h *model.HasSourceAt) int64 { return h.KnownSince.UnixNano() }, order.SortOrder)
+	case model.HasSourceAtOrderFieldJustification:
+		sortStable(hsas, func(h *model.HasSourceAt) string { return h.Justification }, order.SortOrder)
+	}
+}
+
+// SortPackages returns the package versions of the package tries sorted by the order, one
+// package per version. A nil order returns the tries untouched.
+func SortPackages(pkgs []*model.Package, order *model.PackageOrder) []*model.Package {
+	if order == nil {
+		return pkgs
+	}
+	versions := SplitPackageVersions(pkgs)
+	switch order.SortBy {
+	case model.PackageOrderFieldType:
+		sortStable(versions, func(p *model.Package) string { return p.Type }, order.SortOrder)
+	case model.PackageOrderFieldNamespace:
+		sortStable(versions, func(p *model.Package) string { return p.Namespaces[0].Namespace }, order.SortOrder)
+	case model.PackageOrderFieldName:
+		sortStable(versions, func(p *model.Package) string { return p.Namespaces[0].Names[0].Name }, order.SortOrder)
+	case model.PackageOrderFieldVersion:
+		sortStable(versions, func(p *model.Package) string { return p.Namespaces[0].Names[0].Versions[0].Version }, order.SortOrder)
+	}
+	return versions
+}
+
+// SortSources returns the source names of the source tries sorted by the order, one source
+// per name. A nil order returns the tries untouched.
+func SortSources(srcs []*model.Source, order *model.SourceOrder) []*model.Source {
+	if order == nil {
+		return srcs
+	}
+	names := SplitSourceNames(srcs)
+	switch order.SortBy {
+	case model.SourceOrderFieldType:
+		sortStable(names, func(s *model.Source) string { return s.Type }, order.SortOrder)
+	case model.SourceOrderFieldNamespace:
+		sortStable(names, func(s *model.Source) string { return s.Namespaces[0].Namespace }, order.SortOrder)
+	case model.SourceOrderFieldName:
+		sortStable(names, func(s *model.Source) string { return s.Namespaces[0].Names[0].Name }, order.SortOrder)
+	}
+	return names
+}
@@ -0,0 +1,198 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// ValidatePaginationSpec checks that first and last are not both set and are
+// not negative. A nil spec is valid and selects all results.
+func ValidatePaginationSpec(pagination *model.PaginationSpec) error {
+	if pagination == nil {
+		return nil
+	}
+	if pagination.First != nil && pagination.Last != nil {
+		return fmt.Errorf("passing both first and last to paginate is not supported")
+	}
+	if pagination.First != nil && *pagination.First < 0 {
+		return fmt.Errorf("first cannot be less than zero")
+	}
+	if pagination.Last != nil && *pagination.Last < 0 {
+		return fmt.Errorf("last cannot be less than zero")
+	}
+	return nil
+}
+
+// PaginateSlice returns the page of items selected by pagination, along with
+// the page info describing it. It is used by backends that compute the full
+// result set of a query in memory.
+//
+// Items are ordered by ID (shorter IDs first, so numeric IDs sort in numeric
+// order) and cursors are the IDs of the items. items is sorted in place.
+func PaginateSlice[T any](items []T, getID func(T) string, pagination *model.PaginationSpec) ([]T, *model.PageInfo, error) {
+	if err := ValidatePaginationSpec(pagination); err != nil {
+		return nil, nil, err
+	}
+	if pagination == nil {
+		pagination = &model.PaginationSpec{}
+	}
+
+	slices.SortFunc(items, func(a, b T) int {
+		idA, idB := getID(a), getID(b)
+		if c := cmp.Compare(len(idA), len(idB)); c != 0 {
+			return c
+		}
+		return cmp.Compare(idA, idB)
+	})
+
+	indexOf := func(cursor string) (int, error) {
+		i := slices.IndexFunc(items, func(item T) bool { return getID(item) == cursor })
+		if i < 0 {
+			return 0, fmt.Errorf("invalid cursor %q", cursor)
+		}
+		return i, nil
+	}
+
+	start, end := 0, len(items)
+	if pagination.After != nil {
+		i, err := indexOf(*pagination.After)
+		if err != nil {
+			return nil, nil, err
+		}
+		start = i + 1
+	}
+	if pagination.Before != nil {
+		i, err := indexOf(*pagination.Before)
+		if err != nil {
+			return nil, nil, err
+		}
+		end = i
+	}
+	if start > end {
+		start = end
+	}
+
+	pageInfo := &model.PageInfo{
+		HasPreviousPage: start > 0,
+		HasNextPage:     end < len(items),
+	}
+	if pagination.First != nil && *pagination.First < end-start {
+		end = start + *pagination.First
+		pageInfo.HasNextPage = true
+	}
+	if pagination.Last != nil && *pagination.Last < end-start {
+		start = end - *pagination.Last
+		pageInfo.HasPreviousPage = true
+	}
+
+	page := items[start:end]
+	if len(page) > 0 {
+		startCursor := getID(page[0])
+		endCursor := getID(page[len(page)-1])
+		pageInfo.StartCursor = &startCursor
+		pageInfo.EndCursor = &endCursor
+	}
+	return page, pageInfo, nil
+}
+
+// SplitPackageVersions flattens package trees into one package per version,
+// each holding only the path from the package type down to that version. It
+// lets package trees be paginated per version.
+func SplitPackageVersions(pkgs []*model.Package) []*model.Package {
+	var out []*model.Package
+	for _, p := range pkgs {
+		for _, ns := range p.Namespaces {
+			for _, n := range ns.Names {
+				for _, v := range n.Versions {
+					out = append(out, &model.Package{
+						ID:   p.ID,
+						Type: p.Type,
+						Namespaces: []*model.PackageNamespace{{
+							ID:        ns.ID,
+							Namespace: ns.Namespace,
+							Names: []*model.PackageName{{
+								ID:       n.ID,
+								Name:     n.Name,
+								Versions: []*model.PackageVersion{v},
+							}},
+						}},
+					})
+				}
+			}
+		}
+	}
+	return out
+}
+
+// PackageVersionID returns the version ID of a package produced by
+// SplitPackageVersions.
+func PackageVersionID(p *model.Package) string {
+	return p.Namespaces[0].Names[0].Versions[0].ID
+}
+
+// SplitSourceNames flattens source trees into one source per name, so that
+// source trees can be paginated per name.
+func SplitSourceNames(srcs []*model.Source) []*model.Source {
+	var out []*model.Source
+	for _, s := range srcs {
+		for _, ns := range s.Namespaces {
+			for _, n := range ns.Names {
+				out = append(out, &model.Source{
+					ID:   s.ID,
+					Type: s.Type,
+					Namespaces: []*model.SourceNamespace{{
+						ID:        ns.ID,
+						Namespace: ns.Namespace,
+						Names:     []*model.SourceName{n},
+					}},
+				})
+			}
+		}
+	}
+	return out
+}
+
+// SourceNameID returns the name ID of a source produced by SplitSourceNames.
+func SourceNameID(s *model.Source) string {
+	return s.Namespaces[0].Names[0].ID
+}
+
+// SplitVulnerabilityIDs flattens vulnerability trees into one vulnerability
+// per vulnerability ID, so that they can be paginated per vulnerability ID.
+func SplitVulnerabilityIDs(vulns []*model.Vulnerability) []*model.Vulnerability {
+	var out []*model.Vulnerability
+	for _, v := range vulns {
+		for _, vulnID := range v.VulnerabilityIDs {
+			out = append(out, &model.Vulnerability{
+				ID:               v.ID,
+				Type:             v.Type,
+				VulnerabilityIDs: []*model.VulnerabilityID{vulnID},
+			})
+		}
+	}
+	return out
+}
+
+// VulnerabilityIDID returns the vulnerability ID node ID of a vulnerability
+// produced by SplitVulnerabilityIDs.
+func VulnerabilityIDID(v *model.Vulnerability) string {
+	return v.VulnerabilityIDs[0].ID
+}
@@ -0,0 +1,172 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// subscriberBufferSize is the number of published values a subscriber can fall
+// behind by before further values are dropped for it
+const subscriberBufferSize = 100
+
+// PubSub fans published values out to subscribers over channels. Subscribers
+// are registered under a key, and only receive the values published under it.
+// The zero value is ready to use.
+type PubSub[T any] struct {
+	mu   sync.Mutex
+	subs map[string]map[chan T]struct{}
+	n    int
+}
+
+// Subscribe registers a subscriber that receives the values published under key
+// until ctx is done, at which point the returned channel is closed.
+func (p *PubSub[T]) Subscribe(ctx context.Context, key string) <-chan T {
+	ch := make(chan T, subscriberBufferSize)
+	p.mu.Lock()
+	if p.subs == nil {
+		p.subs = map[string]map[chan T]struct{}{}
+	}
+	if p.subs[key] == nil {
+		p.subs[key] = map[chan T]struct{}{}
+	}
+	p.subs[key][ch] = struct{}{}
+	p.n++
+	p.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		p.mu.Lock()
+		delete(p.subs[key], ch)
+		if len(p.subs[key]) == 0 {
+			delete(p.subs, key)
+		}
+		p.n--
+		close(ch)
+		p.mu.Unlock()
+	}()
+	return ch
+}
+
+// HasSubscribers reports whether anyone is subscribed, so that publishers can
+// skip the work of finding what to publish.
+func (p *PubSub[T]) HasSubscribers() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.n > 0
+}
+
+// Publish sends the value to every subscriber of the given distinct keys
+// without blocking. Subscribers whose buffer is full miss the value.
+func (p *PubSub[T]) Publish(ctx context.Context, v T, keys ...string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, key := range keys {
+		for ch := range p.subs[key] {
+			select {
+			case ch <- v:
+			default:
+				logging.FromContext(ctx).Warnf("subscriber buffer full, dropping published value")
+			}
+		}
+	}
+}
+
+// CertifyVulnEvent is published when a CertifyVuln node is created, along with
+// the package it certifies so that subscribers are matched without a lookup
+type CertifyVulnEvent struct {
+	ID        string
+	Type      string
+	Namespace string
+	Name      string
+}
+
+// PublishCertifyVuln publishes the event to the subscribers filtering on its
+// package type, namespace and name, or any of them
+func PublishCertifyVuln(ctx context.Context, bus *PubSub[string], event CertifyVulnEvent) {
+	typ, namespace, name := &event.Type, &event.Namespace, &event.Name
+	keys := make([]string, 0, 8)
+	for _, t := range []*string{typ, nil} {
+		for _, ns := range []*string{namespace, nil} {
+			for _, n := range []*string{name, nil} {
+				keys = append(keys, pkgKey(t, ns, n))
+			}
+		}
+	}
+	bus.Publish(ctx, event.ID, keys...)
+}
+
+// pkgKey is the subscription key of a package type, namespace and name, nil
+// matching any
+func pkgKey(typ, namespace, name *string) string {
+	var sb strings.Builder
+	for _, field := range []*string{typ, namespace, name} {
+		if field == nil {
+			sb.WriteString("*\x00")
+		} else {
+			// prefixed so that a value is never taken for the wildcard
+			sb.WriteString("=" + *field + "\x00")
+		}
+	}
+	return sb.String()
+}
+
+// SubscribeCertifyVulns subscribes to the IDs of new CertifyVuln nodes published
+// on bus and streams the nodes matching filter, looked up with certifyVuln. Only
+// the nodes of packages with the type, namespace and name of the filter are
+// published to the subscriber, so the other nodes are not looked up.
+func SubscribeCertifyVulns(ctx context.Context, bus *PubSub[string], filter *model.CertifyVulnSpec,
+	certifyVuln func(context.Context, *model.CertifyVulnSpec) ([]*model.CertifyVuln, error)) <-chan *model.CertifyVuln {
+	var key string
+	if filter != nil && filter.Package != nil {
+		key = pkgKey(filter.Package.Type, filter.Package.Namespace, filter.Package.Name)
+	} else {
+		key = pkgKey(nil, nil, nil)
+	}
+	ids := bus.Subscribe(ctx, key)
+	out := make(chan *model.CertifyVuln)
+	go func() {
+		defer close(out)
+		for id := range ids {
+			spec := model.CertifyVulnSpec{}
+			if filter != nil {
+				if filter.ID != nil && *filter.ID != id {
+					continue
+				}
+				spec = *filter
+			}
+			spec.ID = &id
+			cvs, err := certifyVuln(ctx, &spec)
+			if err != nil {
+				logging.FromContext(ctx).Warnf("failed to look up new certifyVuln %s: %v", id, err)
+				continue
+			}
+			for _, cv := range cvs {
+				select {
+				case out <- cv:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
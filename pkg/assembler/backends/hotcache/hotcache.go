@@ -0,0 +1,174 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hotcache wraps a backend with an in-memory LRU cache of the results
+// of the Packages, Sources and Vulnerabilities queries, which collectors repeat
+// for the same canonical nodes on every ingestion.
+package hotcache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+)
+
+// hotCache embeds the wrapped backend so that every query and mutation other
+// than the cached ones and the ingestion of the cached node types is passed
+// through unchanged.
+//
+// Only the ingestion, merging and deletion of the nodes themselves invalidates
+// the caches: the evidence ingestion methods require their package, source and
+// vulnerability nodes to exist already, and the trees returned for these nodes
+// do not hold any evidence.
+type hotCache struct {
+	backends.Backend
+	packages        *cache[*model.Package]
+	sources         *cache[*model.Source]
+	vulnerabilities *cache[*model.Vulnerability]
+}
+
+// New returns a backend that caches up to cacheSize results of each of the
+// Packages, Sources and Vulnerabilities queries of backend for ttl, or until
+// nodes of the same type are ingested. A ttl of 0 keeps the results until they
+// are evicted or invalidated.
+//
+// The cached results are shared between callers and must not be modified.
+func New(backend backends.Backend, cacheSize int, ttl time.Duration) (backends.Backend, error) {
+	if cacheSize <= 0 {
+		return nil, fmt.Errorf("invalid hot cache size %d, must be positive", cacheSize)
+	}
+	if ttl < 0 {
+		return nil, fmt.Errorf("invalid hot cache TTL %v, must not be negative", ttl)
+	}
+	return &hotCache{
+		Backend:         backend,
+		packages:        newCache[*model.Package](cacheSize, ttl),
+		sources:         newCache[*model.Source](cacheSize, ttl),
+		vulnerabilities: newCache[*model.Vulnerability](cacheSize, ttl),
+	}, nil
+}
+
+// cache holds the results of one query keyed by the JSON encoding of its filter
+type cache[T any] struct {
+	lru *expirable.LRU[string, []T]
+
+	// generation is incremented on every invalidation so that a query that
+	// raced with an ingestion does not store its possibly stale result
+	mu         sync.Mutex
+	generation uint64
+}
+
+func newCache[T any](size int, ttl time.Duration) *cache[T] {
+	return &cache[T]{lru: expirable.NewLRU[string, []T](size, nil, ttl)}
+}
+
+// get returns the cached results for spec, running query on a miss
+func (c *cache[T]) get(ctx context.Context, spec interface{}, query func(context.Context) ([]T, error)) ([]T, error) {
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return query(ctx)
+	}
+	key := string(b)
+	if results, ok := c.lru.Get(key); ok {
+		return results, nil
+	}
+
+	c.mu.Lock()
+	generation := c.generation
+	c.mu.Unlock()
+
+	results, err := query(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if generation == c.generation {
+		c.lru.Add(key, results)
+	}
+	c.mu.Unlock()
+	return results, nil
+}
+
+func (c *cache[T]) invalidate() {
+	c.mu.Lock()
+	c.generation++
+	c.lru.Purge()
+	c.mu.Unlock()
+}
+
+func (h *hotCache) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+	return h.packages.get(ctx, pkgSpec, func(ctx context.Context) ([]*model.Package, error) {
+		return h.Backend.Packages(ctx, pkgSpec)
+	})
+}
+
+func (h *hotCache) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
+	return h.sources.get(ctx, sourceSpec, func(ctx context.Context) ([]*model.Source, error) {
+		return h.Backend.Sources(ctx, sourceSpec)
+	})
+}
+
+func (h *hotCache) Vulnerabilities(ctx context.Context, vulnSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
+	return h.vulnerabilities.get(ctx, vulnSpec, func(ctx context.Context) ([]*model.Vulnerability, error) {
+		return h.Backend.Vulnerabilities(ctx, vulnSpec)
+	})
+}
+
+func (h *hotCache) IngestPackage(ctx context.Context, pkg model.IDorPkgInput) (*model.PackageIDs, error) {
+	defer h.packages.invalidate()
+	return h.Backend.IngestPackage(ctx, pkg)
+}
+
+func (h *hotCache) IngestPackages(ctx context.Context, pkgs []*model.IDorPkgInput) ([]*model.PackageIDs, error) {
+	defer h.packages.invalidate()
+	return h.Backend.IngestPackages(ctx, pkgs)
+}
+
+func (h *hotCache) MergePackages(ctx context.Context, primary string, duplicates []string) (*model.Package, error) {
+	defer h.packages.invalidate()
+	return h.Backend.MergePackages(ctx, primary, duplicates)
+}
+
+func (h *hotCache) IngestSource(ctx context.Context, source model.IDorSourceInput) (*model.SourceIDs, error) {
+	defer h.sources.invalidate()
+	return h.Backend.IngestSource(ctx, source)
+}
+
+func (h *hotCache) IngestSources(ctx context.Context, sources []*model.IDorSourceInput) ([]*model.SourceIDs, error) {
+	defer h.sources.invalidate()
+	return h.Backend.IngestSources(ctx, sources)
+}
+
+func (h *hotCache) DeleteSource(ctx context.Context, id string) error {
+	defer h.sources.invalidate()
+	return h.Backend.DeleteSource(ctx, id)
+}
+
+func (h *hotCache) IngestVulnerability(ctx context.Context, vuln model.IDorVulnerabilityInput) (*model.VulnerabilityIDs, error) {
+	defer h.vulnerabilities.invalidate()
+	return h.Backend.IngestVulnerability(ctx, vuln)
+}
+
+func (h *hotCache) IngestVulnerabilities(ctx context.Context, vulns []*model.IDorVulnerabilityInput) ([]*model.VulnerabilityIDs, error) {
+	defer h.vulnerabilities.invalidate()
+	return h.Backend.IngestVulnerabilities(ctx, vulns)
+}
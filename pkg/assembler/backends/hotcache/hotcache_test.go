@@ -0,0 +1,164 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hotcache_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/hotcache"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestPackagesCached(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	h, err := hotcache.New(b, 10, 0)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	pypi := &model.PkgSpec{Type: ptrfrom.String("pypi")}
+	npm := &model.PkgSpec{Type: ptrfrom.String("npm")}
+	pkgs := []*model.Package{{ID: "1", Type: "pypi"}}
+	b.EXPECT().Packages(ctx, pypi).Return(pkgs, nil).Times(3)
+	b.EXPECT().Packages(ctx, npm).Return(nil, errors.New("backend failure")).Times(2)
+	b.EXPECT().IngestPackage(ctx, gomock.Any()).Return(&model.PackageIDs{}, nil)
+	b.EXPECT().MergePackages(ctx, "1", []string{"2"}).Return(pkgs[0], nil)
+
+	for i := 0; i < 2; i++ {
+		// an equal spec held in a different pointer hits the cache
+		got, err := h.Packages(ctx, &model.PkgSpec{Type: ptrfrom.String("pypi")})
+		if err != nil {
+			t.Fatalf("Packages() error = %v", err)
+		}
+		if len(got) != 1 || got[0].ID != "1" {
+			t.Errorf("Packages() = %v, want %v", got, pkgs)
+		}
+	}
+	// errors are not cached
+	for i := 0; i < 2; i++ {
+		if _, err := h.Packages(ctx, npm); err == nil {
+			t.Errorf("Packages() expected error")
+		}
+	}
+	// ingesting a package invalidates the cache
+	if _, err := h.IngestPackage(ctx, model.IDorPkgInput{PackageInput: &model.PkgInputSpec{Type: "pypi", Name: "django"}}); err != nil {
+		t.Fatalf("IngestPackage() error = %v", err)
+	}
+	if _, err := h.Packages(ctx, pypi); err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	// so does merging packages
+	if _, err := h.MergePackages(ctx, "1", []string{"2"}); err != nil {
+		t.Fatalf("MergePackages() error = %v", err)
+	}
+	if _, err := h.Packages(ctx, pypi); err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+}
+
+func TestSourcesAndVulnerabilitiesInvalidatedSeparately(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	h, err := hotcache.New(b, 10, 0)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	srcSpec := &model.SourceSpec{Name: ptrfrom.String("guac")}
+	vulnSpec := &model.VulnerabilitySpec{Type: ptrfrom.String("ghsa")}
+	b.EXPECT().Sources(ctx, srcSpec).Return([]*model.Source{{ID: "1"}}, nil).Times(3)
+	b.EXPECT().Vulnerabilities(ctx, vulnSpec).Return([]*model.Vulnerability{{ID: "2"}}, nil).Times(1)
+	b.EXPECT().IngestSources(ctx, gomock.Any()).Return(nil, nil)
+	b.EXPECT().DeleteSource(ctx, "1").Return(nil)
+
+	query := func() {
+		if _, err := h.Sources(ctx, srcSpec); err != nil {
+			t.Fatalf("Sources() error = %v", err)
+		}
+		if _, err := h.Vulnerabilities(ctx, vulnSpec); err != nil {
+			t.Fatalf("Vulnerabilities() error = %v", err)
+		}
+	}
+	query()
+	query()
+	if _, err := h.IngestSources(ctx, nil); err != nil {
+		t.Fatalf("IngestSources() error = %v", err)
+	}
+	query()
+	if err := h.DeleteSource(ctx, "1"); err != nil {
+		t.Fatalf("DeleteSource() error = %v", err)
+	}
+	query()
+}
+
+func TestTTL(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	h, err := hotcache.New(b, 10, 50*time.Millisecond)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	spec := &model.PkgSpec{}
+	b.EXPECT().Packages(ctx, spec).Return(nil, nil).Times(2)
+	for i := 0; i < 2; i++ {
+		if _, err := h.Packages(ctx, spec); err != nil {
+			t.Fatalf("Packages() error = %v", err)
+		}
+	}
+	time.Sleep(100 * time.Millisecond)
+	if _, err := h.Packages(ctx, spec); err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+}
+
+func TestNewInvalidArguments(t *testing.T) {
+	b := mocks.NewMockBackend(gomock.NewController(t))
+	if _, err := hotcache.New(b, 0, time.Minute); err == nil {
+		t.Errorf("New() expected error for zero cache size")
+	}
+	if _, err := hotcache.New(b, 10, -time.Minute); err == nil {
+		t.Errorf("New() expected error for negative TTL")
+	}
+}
+
+func TestPassThrough(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	h, err := hotcache.New(b, 10, 0)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	spec := &model.ArtifactSpec{}
+	b.EXPECT().Artifacts(ctx, spec).Return(nil, nil).Times(2)
+	for i := 0; i < 2; i++ {
+		if _, err := h.Artifacts(ctx, spec); err != nil {
+			t.Fatalf("Artifacts() error = %v", err)
+		}
+	}
+}
@@ -21,8 +21,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -146,7 +146,7 @@ func (c *demoClient) IngestArtifacts(ctx context.Context, artifacts []*model.IDo
 	for _, art := range artifacts {
 		modelArt, err := c.IngestArtifact(ctx, art)
 		if err != nil {
-			return nil, gqlerror.Errorf("ingestArtifact failed with err: %v", err)
+			return nil, errext.Errorf("ingestArtifact failed with err: %v", err)
 		}
 		modelArtifacts = append(modelArtifacts, modelArt)
 	}
@@ -230,7 +230,7 @@ func (c *demoClient) Artifacts(ctx context.Context, artifactSpec *model.Artifact
 	defer c.m.RUnlock()
 	a, err := c.artifactExact(ctx, artifactSpec)
 	if err != nil {
-		return nil, gqlerror.Errorf("Artifacts :: invalid spec %s", err)
+		return nil, errext.Errorf("Artifacts :: invalid spec %s", err)
 	}
 	if a != nil {
 		return []*model.Artifact{c.convArtifact(a)}, nil
@@ -238,6 +238,7 @@ func (c *demoClient) Artifacts(ctx context.Context, artifactSpec *model.Artifact
 
 	algorithm := strings.ToLower(nilToEmpty(artifactSpec.Algorithm))
 	digest := strings.ToLower(nilToEmpty(artifactSpec.Digest))
+	digestPrefix := toLower(artifactSpec.DigestPrefix)
 	var rv []*model.Artifact
 	var done bool
 	scn := c.kv.Keys(artCol)
@@ -263,7 +264,7 @@ func (c *demoClient) Artifacts(ctx context.Context, artifactSpec *model.Artifact
 				matchDigest = true
 			}
 
-			if matchDigest && matchAlgorithm {
+			if matchDigest && matchAlgorithm && !noMatchPrefixContains(digestPrefix, nil, a.Digest) {
 				rv = append(rv, c.convArtifact(a))
 			}
 		}
@@ -297,6 +298,9 @@ func (c *demoClient) buildArtifactResponse(ctx context.Context, ID string, filte
 	if filter != nil && noMatch(toLower(filter.Digest), artNode.Digest) {
 		return nil, nil
 	}
+	if filter != nil && noMatchPrefixContains(toLower(filter.DigestPrefix), nil, artNode.Digest) {
+		return nil, nil
+	}
 	art := &model.Artifact{
 		ID:        artNode.ThisID,
 		Algorithm: artNode.Algorithm,
@@ -311,14 +315,34 @@ func (c *demoClient) returnFoundArtifact(ctx context.Context, artIDorInput *mode
 	if artIDorInput.ArtifactID != nil {
 		foundArtStruct, err := byIDkv[*artStruct](ctx, *artIDorInput.ArtifactID, c)
 		if err != nil {
-			return nil, gqlerror.Errorf("failed to return artStruct node by ID with error: %v", err)
+			return nil, errext.Errorf("failed to return artStruct node by ID with error: %v", err)
 		}
 		return foundArtStruct, nil
 	} else {
 		foundArtStruct, err := c.artifactByInput(ctx, artIDorInput.ArtifactInput)
 		if err != nil {
-			return nil, gqlerror.Errorf("failed to artifactByInput with error: %v", err)
+			return nil, errext.Errorf("failed to artifactByInput with error: %v", err)
 		}
 		return foundArtStruct, nil
 	}
 }
+
+func (c *demoClient) ArtifactsList(ctx context.Context, artifactSpec model.ArtifactSpec, pagination *model.PaginationSpec) (*model.ArtifactConnection, error) {
+	results, err := c.Artifacts(ctx, &artifactSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.Artifact) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("ArtifactsList :: %v", err)
+	}
+	edges := make([]*model.ArtifactEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.ArtifactEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.ArtifactConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
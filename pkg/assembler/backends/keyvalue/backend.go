@@ -22,12 +22,15 @@ import (
 	"hash/fnv"
 	"math"
 	"reflect"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 	"github.com/guacsec/guac/pkg/assembler/kv/memmap"
@@ -35,6 +38,12 @@ import (
 
 func init() {
 	backends.Register("keyvalue", getBackend)
+	errext.RegisterClassifier(func(err error) errext.Code {
+		if errors.Is(err, kv.NotFoundError) {
+			return errext.ErrNotFound
+		}
+		return ""
+	})
 }
 
 // node is the common interface of all backend nodes.
@@ -107,6 +116,10 @@ const (
 	vulnMDCol   = "vulnMetadatas"
 	cVEXCol     = "certifyVEXs"
 	cVulnCol    = "certifyVulns"
+	exploitCol  = "exploitReferences"
+
+	// archived certifyVulns are kept by ID, outside of the index
+	cVulnArchiveCol = "certifyVulnArchive"
 )
 
 func typeColMap(col string) node {
@@ -167,8 +180,10 @@ func typeColMap(col string) node {
 		return &vulnerabilityMetadataLink{}
 	case cVEXCol:
 		return &vexLink{}
-	case cVulnCol:
+	case cVulnCol, cVulnArchiveCol:
 		return &certifyVulnerabilityLink{}
+	case exploitCol:
+		return &exploitReferenceLink{}
 	}
 	return &artStruct{}
 }
@@ -183,6 +198,9 @@ type demoClient struct {
 	id uint32
 	m  sync.RWMutex
 	kv kv.Store
+	// certifyVulnAdded publishes the IDs of newly created CertifyVuln nodes,
+	// keyed by the package they certify
+	certifyVulnAdded helper.PubSub[string]
 }
 
 func getBackend(ctx context.Context, opts backends.BackendArgs) (backends.Backend, error) {
@@ -201,6 +219,26 @@ func noMatch(filter *string, value string) bool {
 	return false
 }
 
+func noMatchPrefixContains(prefix, substr *string, value string) bool {
+	return (prefix != nil && !strings.HasPrefix(value, *prefix)) ||
+		(substr != nil && !strings.Contains(value, *substr))
+}
+
+func noMatchSubjectType(filter *model.SubjectType, pkgID, srcID, artID string) bool {
+	if filter == nil {
+		return false
+	}
+	switch *filter {
+	case model.SubjectTypePackage:
+		return pkgID == ""
+	case model.SubjectTypeSource:
+		return srcID == ""
+	case model.SubjectTypeArtifact:
+		return artID == ""
+	}
+	return false
+}
+
 func nilToEmpty(input *string) string {
 	if input == nil {
 		return ""
@@ -250,6 +288,73 @@ func setkv(ctx context.Context, coll string, n node, c *demoClient) error {
 	return c.kv.Set(ctx, coll, n.Key(), n)
 }
 
+func delkv(ctx context.Context, coll string, n node, c *demoClient) error {
+	return c.kv.Delete(ctx, coll, n.Key())
+}
+
+func (c *demoClient) removeFromIndex(ctx context.Context, id string) error {
+	return c.kv.Delete(ctx, indexCol, id)
+}
+
+// removeLink drops id from a list of back edges
+func removeLink(links []string, id string) []string {
+	return slices.DeleteFunc(links, func(l string) bool { return l == id })
+}
+
+// removeLinkFromAll drops id from every list of back edges of n
+func removeLinkFromAll(n node, id string) {
+	v := reflect.ValueOf(n).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Field(i); f.Type() == reflect.TypeOf([]string(nil)) {
+			f.Set(reflect.ValueOf(removeLink(f.Interface().([]string), id)))
+		}
+	}
+}
+
+// nodeByID returns the node with the given id along with its collection, when
+// the type of the node is not known in advance.
+func (c *demoClient) nodeByID(ctx context.Context, id string) (string, node, error) {
+	var k string
+	if err := c.kv.Get(ctx, indexCol, id, &k); err != nil {
+		return "", nil, fmt.Errorf("%w : id not found in index %q", err, id)
+	}
+	sub := strings.SplitN(k, ":", 2)
+	if len(sub) != 2 {
+		return "", nil, fmt.Errorf("Bad value was stored in index map: %v", k)
+	}
+	n := typeColMap(sub[0])
+	if err := c.kv.Get(ctx, sub[0], sub[1], &n); err != nil {
+		return "", nil, err
+	}
+	return sub[0], n, nil
+}
+
+// deleteLinkNode removes an evidence node along with the back edges pointing
+// to it from its other endpoints. The endpoint with id skip is left as is.
+func (c *demoClient) deleteLinkNode(ctx context.Context, id string, skip string) error {
+	col, link, err := c.nodeByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, neighbor := range link.Neighbors(processUsingOnly(nil)) {
+		if neighbor == skip {
+			continue
+		}
+		neighborCol, n, err := c.nodeByID(ctx, neighbor)
+		if err != nil {
+			return err
+		}
+		removeLinkFromAll(n, id)
+		if err := setkv(ctx, neighborCol, n, c); err != nil {
+			return err
+		}
+	}
+	if err := delkv(ctx, col, link, c); err != nil {
+		return err
+	}
+	return c.removeFromIndex(ctx, id)
+}
+
 func (c *demoClient) addToIndex(ctx context.Context, coll string, n node) error {
 	if err := validateType(n, coll); err != nil {
 		return err
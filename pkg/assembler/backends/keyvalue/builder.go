@@ -19,8 +19,8 @@ import (
 	"context"
 	"errors"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -67,7 +67,7 @@ func (c *demoClient) IngestBuilders(ctx context.Context, builders []*model.IDorB
 	for _, build := range builders {
 		modelBuild, err := c.IngestBuilder(ctx, build)
 		if err != nil {
-			return nil, gqlerror.Errorf("IngestBuilder failed with err: %v", err)
+			return nil, errext.Errorf("IngestBuilder failed with err: %v", err)
 		}
 		modelBuilders = append(modelBuilders, modelBuild)
 	}
@@ -185,14 +185,34 @@ func (c *demoClient) returnFoundBuilder(ctx context.Context, buildIDorInput *mod
 	if buildIDorInput.BuilderID != nil {
 		builderStruct, err := byIDkv[*builderStruct](ctx, *buildIDorInput.BuilderID, c)
 		if err != nil {
-			return nil, gqlerror.Errorf("failed to return builderStruct node by ID with error: %v", err)
+			return nil, errext.Errorf("failed to return builderStruct node by ID with error: %v", err)
 		}
 		return builderStruct, nil
 	} else {
 		builderStruct, err := c.builderByInput(ctx, buildIDorInput.BuilderInput)
 		if err != nil {
-			return nil, gqlerror.Errorf("failed to builderByInput with error: %v", err)
+			return nil, errext.Errorf("failed to builderByInput with error: %v", err)
 		}
 		return builderStruct, nil
 	}
 }
+
+func (c *demoClient) BuildersList(ctx context.Context, builderSpec model.BuilderSpec, pagination *model.PaginationSpec) (*model.BuilderConnection, error) {
+	results, err := c.Builders(ctx, &builderSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.Builder) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("BuildersList :: %v", err)
+	}
+	edges := make([]*model.BuilderEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.BuilderEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.BuilderConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -21,8 +21,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -86,19 +86,19 @@ func (c *demoClient) IngestCertifyBads(ctx context.Context, subjects model.Packa
 			subject := model.PackageSourceOrArtifactInput{Package: subjects.Packages[i]}
 			certifyBad, err = c.IngestCertifyBad(ctx, subject, pkgMatchType, *certifyBads[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestCertifyBad failed with err: %v", err)
+				return nil, errext.Errorf("IngestCertifyBad failed with err: %v", err)
 			}
 		} else if len(subjects.Sources) > 0 {
 			subject := model.PackageSourceOrArtifactInput{Source: subjects.Sources[i]}
 			certifyBad, err = c.IngestCertifyBad(ctx, subject, pkgMatchType, *certifyBads[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestCertifyBad failed with err: %v", err)
+				return nil, errext.Errorf("IngestCertifyBad failed with err: %v", err)
 			}
 		} else {
 			subject := model.PackageSourceOrArtifactInput{Artifact: subjects.Artifacts[i]}
 			certifyBad, err = c.IngestCertifyBad(ctx, subject, pkgMatchType, *certifyBads[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestCertifyBad failed with err: %v", err)
+				return nil, errext.Errorf("IngestCertifyBad failed with err: %v", err)
 			}
 		}
 		modelCertifyBads = append(modelCertifyBads, certifyBad)
@@ -131,20 +131,20 @@ func (c *demoClient) ingestCertifyBad(ctx context.Context, subject model.Package
 		var err error
 		in.PackageID, foundPkgNameOrVersionNode, err = c.returnFoundPkgBasedOnMatchType(ctx, subject.Package, pkgMatchType)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 	} else if subject.Artifact != nil {
 		var err error
 		foundArtStruct, err = c.returnFoundArtifact(ctx, subject.Artifact)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		in.ArtifactID = foundArtStruct.ID()
 	} else {
 		var err error
 		foundSrcName, err = c.returnFoundSource(ctx, subject.Source)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		in.SourceID = foundSrcName.ID()
 	}
@@ -203,7 +203,7 @@ func (c *demoClient) CertifyBad(ctx context.Context, filter *model.CertifyBadSpe
 		}
 		foundCertifyBad, err := c.buildCertifyBad(ctx, link, filter, true)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		return []*model.CertifyBad{foundCertifyBad}, nil
 	}
@@ -215,7 +215,7 @@ func (c *demoClient) CertifyBad(ctx context.Context, filter *model.CertifyBadSpe
 	if filter != nil && filter.Subject != nil && filter.Subject.Artifact != nil {
 		exactArtifact, err := c.artifactExact(ctx, filter.Subject.Artifact)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactArtifact != nil {
 			search = append(search, exactArtifact.BadLinks...)
@@ -225,7 +225,7 @@ func (c *demoClient) CertifyBad(ctx context.Context, filter *model.CertifyBadSpe
 	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
 		exactSource, err := c.exactSource(ctx, filter.Subject.Source)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactSource != nil {
 			search = append(search, exactSource.BadLinks...)
@@ -238,11 +238,11 @@ func (c *demoClient) CertifyBad(ctx context.Context, filter *model.CertifyBadSpe
 		for _, id := range search {
 			link, err := byIDkv[*badLink](ctx, id, c)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			out, err = c.addCBIfMatch(ctx, out, filter, link)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 		}
 	} else {
@@ -262,7 +262,7 @@ func (c *demoClient) CertifyBad(ctx context.Context, filter *model.CertifyBadSpe
 				}
 				out, err = c.addCBIfMatch(ctx, out, filter, link)
 				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+					return nil, errext.Errorf("%v :: %v", funcName, err)
 				}
 			}
 		}
@@ -276,9 +276,11 @@ func (c *demoClient) addCBIfMatch(ctx context.Context, out []*model.CertifyBad,
 
 	if filter != nil {
 		if noMatch(filter.Justification, link.Justification) ||
+			noMatchPrefixContains(filter.JustificationPrefix, filter.JustificationContains, link.Justification) ||
 			noMatch(filter.Collector, link.Collector) ||
 			noMatch(filter.Origin, link.Origin) ||
 			noMatch(filter.DocumentRef, link.DocumentRef) ||
+			noMatchSubjectType(filter.SubjectType, link.PackageID, link.SourceID, link.ArtifactID) ||
 			filter.KnownSince != nil && filter.KnownSince.After(link.KnownSince) {
 			return out, nil
 		}
@@ -342,7 +344,7 @@ func (c *demoClient) buildCertifyBad(ctx context.Context, link *badLink, filter
 	var subj model.PackageSourceOrArtifact
 	if link.PackageID != "" {
 		if p == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve package via packageID")
+			return nil, errext.Errorf("failed to retrieve package via packageID")
 		} else if p == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
@@ -350,7 +352,7 @@ func (c *demoClient) buildCertifyBad(ctx context.Context, link *badLink, filter
 	}
 	if link.ArtifactID != "" {
 		if a == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve artifact via artifactID")
+			return nil, errext.Errorf("failed to retrieve artifact via artifactID")
 		} else if a == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
@@ -358,7 +360,7 @@ func (c *demoClient) buildCertifyBad(ctx context.Context, link *badLink, filter
 	}
 	if link.SourceID != "" {
 		if s == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve source via sourceID")
+			return nil, errext.Errorf("failed to retrieve source via sourceID")
 		} else if s == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
@@ -376,3 +378,23 @@ func (c *demoClient) buildCertifyBad(ctx context.Context, link *badLink, filter
 	}
 	return &certifyBad, nil
 }
+
+func (c *demoClient) CertifyBadList(ctx context.Context, certifyBadSpec model.CertifyBadSpec, pagination *model.PaginationSpec) (*model.CertifyBadConnection, error) {
+	results, err := c.CertifyBad(ctx, &certifyBadSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.CertifyBad) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("CertifyBadList :: %v", err)
+	}
+	edges := make([]*model.CertifyBadEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.CertifyBadEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.CertifyBadConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
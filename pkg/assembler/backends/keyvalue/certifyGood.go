@@ -21,8 +21,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -85,19 +85,19 @@ func (c *demoClient) IngestCertifyGoods(ctx context.Context, subjects model.Pack
 			subject := model.PackageSourceOrArtifactInput{Package: subjects.Packages[i]}
 			certifyGood, err = c.IngestCertifyGood(ctx, subject, pkgMatchType, *certifyGoods[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestCertifyGood failed with err: %v", err)
+				return nil, errext.Errorf("IngestCertifyGood failed with err: %v", err)
 			}
 		} else if len(subjects.Sources) > 0 {
 			subject := model.PackageSourceOrArtifactInput{Source: subjects.Sources[i]}
 			certifyGood, err = c.IngestCertifyGood(ctx, subject, pkgMatchType, *certifyGoods[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestCertifyGood failed with err: %v", err)
+				return nil, errext.Errorf("IngestCertifyGood failed with err: %v", err)
 			}
 		} else {
 			subject := model.PackageSourceOrArtifactInput{Artifact: subjects.Artifacts[i]}
 			certifyGood, err = c.IngestCertifyGood(ctx, subject, pkgMatchType, *certifyGoods[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestCertifyGood failed with err: %v", err)
+				return nil, errext.Errorf("IngestCertifyGood failed with err: %v", err)
 			}
 		}
 		modelCertifyGoods = append(modelCertifyGoods, certifyGood)
@@ -130,20 +130,20 @@ func (c *demoClient) ingestCertifyGood(ctx context.Context, subject model.Packag
 		var err error
 		in.PackageID, foundPkgNameOrVersionNode, err = c.returnFoundPkgBasedOnMatchType(ctx, subject.Package, pkgMatchType)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 	} else if subject.Artifact != nil {
 		var err error
 		foundArtStruct, err = c.returnFoundArtifact(ctx, subject.Artifact)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		in.ArtifactID = foundArtStruct.ID()
 	} else {
 		var err error
 		foundSrcName, err = c.returnFoundSource(ctx, subject.Source)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		in.SourceID = foundSrcName.ID()
 	}
@@ -202,7 +202,7 @@ func (c *demoClient) CertifyGood(ctx context.Context, filter *model.CertifyGoodS
 		}
 		foundCertifyGood, err := c.buildCertifyGood(ctx, link, filter, true)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		return []*model.CertifyGood{foundCertifyGood}, nil
 	}
@@ -214,7 +214,7 @@ func (c *demoClient) CertifyGood(ctx context.Context, filter *model.CertifyGoodS
 	if filter != nil && filter.Subject != nil && filter.Subject.Artifact != nil {
 		exactArtifact, err := c.artifactExact(ctx, filter.Subject.Artifact)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactArtifact != nil {
 			search = append(search, exactArtifact.GoodLinks...)
@@ -224,7 +224,7 @@ func (c *demoClient) CertifyGood(ctx context.Context, filter *model.CertifyGoodS
 	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
 		exactSource, err := c.exactSource(ctx, filter.Subject.Source)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactSource != nil {
 			search = append(search, exactSource.GoodLinks...)
@@ -237,11 +237,11 @@ func (c *demoClient) CertifyGood(ctx context.Context, filter *model.CertifyGoodS
 		for _, id := range search {
 			link, err := byIDkv[*goodLink](ctx, id, c)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			out, err = c.addCGIfMatch(ctx, out, filter, link)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 		}
 	} else {
@@ -261,7 +261,7 @@ func (c *demoClient) CertifyGood(ctx context.Context, filter *model.CertifyGoodS
 				}
 				out, err = c.addCGIfMatch(ctx, out, filter, link)
 				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+					return nil, errext.Errorf("%v :: %v", funcName, err)
 				}
 			}
 		}
@@ -275,9 +275,11 @@ func (c *demoClient) addCGIfMatch(ctx context.Context, out []*model.CertifyGood,
 
 	if filter != nil {
 		if noMatch(filter.Justification, link.Justification) ||
+			noMatchPrefixContains(filter.JustificationPrefix, filter.JustificationContains, link.Justification) ||
 			noMatch(filter.Collector, link.Collector) ||
 			noMatch(filter.Origin, link.Origin) ||
 			noMatch(filter.DocumentRef, link.DocumentRef) ||
+			noMatchSubjectType(filter.SubjectType, link.PackageID, link.SourceID, link.ArtifactID) ||
 			filter.KnownSince != nil && filter.KnownSince.After(link.KnownSince) {
 			return out, nil
 		}
@@ -341,7 +343,7 @@ func (c *demoClient) buildCertifyGood(ctx context.Context, link *goodLink, filte
 	var subj model.PackageSourceOrArtifact
 	if link.PackageID != "" {
 		if p == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve package via packageID")
+			return nil, errext.Errorf("failed to retrieve package via packageID")
 		} else if p == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
@@ -349,7 +351,7 @@ func (c *demoClient) buildCertifyGood(ctx context.Context, link *goodLink, filte
 	}
 	if link.ArtifactID != "" {
 		if a == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve artifact via artifactID")
+			return nil, errext.Errorf("failed to retrieve artifact via artifactID")
 		} else if a == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
@@ -357,7 +359,7 @@ func (c *demoClient) buildCertifyGood(ctx context.Context, link *goodLink, filte
 	}
 	if link.SourceID != "" {
 		if s == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve source via sourceID")
+			return nil, errext.Errorf("failed to retrieve source via sourceID")
 		} else if s == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
@@ -375,3 +377,23 @@ func (c *demoClient) buildCertifyGood(ctx context.Context, link *goodLink, filte
 	}
 	return &certifyGood, nil
 }
+
+func (c *demoClient) CertifyGoodList(ctx context.Context, certifyGoodSpec model.CertifyGoodSpec, pagination *model.PaginationSpec) (*model.CertifyGoodConnection, error) {
+	results, err := c.CertifyGood(ctx, &certifyGoodSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.CertifyGood) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("CertifyGoodList :: %v", err)
+	}
+	edges := make([]*model.CertifyGoodEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.CertifyGoodEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.CertifyGoodConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -23,9 +23,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // Internal certifyLegal
@@ -93,13 +94,13 @@ func (c *demoClient) IngestCertifyLegals(ctx context.Context, subjects model.Pac
 			subject := model.PackageOrSourceInput{Package: subjects.Packages[i]}
 			l, err = c.IngestCertifyLegal(ctx, subject, declaredLicensesList[i], discoveredLicensesList[i], v)
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestCertifyLegals failed with err: %v", err)
+				return nil, errext.Errorf("IngestCertifyLegals failed with err: %v", err)
 			}
 		} else {
 			subject := model.PackageOrSourceInput{Source: subjects.Sources[i]}
 			l, err = c.IngestCertifyLegal(ctx, subject, declaredLicensesList[i], discoveredLicensesList[i], v)
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestCertifyLegals failed with err: %v", err)
+				return nil, errext.Errorf("IngestCertifyLegals failed with err: %v", err)
 			}
 		}
 		rv = append(rv, l)
@@ -132,7 +133,7 @@ func (c *demoClient) ingestCertifyLegal(ctx context.Context, subject model.Packa
 	for _, lis := range declaredLicenses {
 		l, err := c.returnFoundLicense(ctx, lis)
 		if err != nil {
-			return "", gqlerror.Errorf("%v :: License not found %q %v", funcName, lis.LicenseInput.Name, err)
+			return "", errext.Errorf("%v :: License not found %q %v", funcName, lis.LicenseInput.Name, err)
 		}
 		dec = append(dec, l.ThisID)
 	}
@@ -143,7 +144,7 @@ func (c *demoClient) ingestCertifyLegal(ctx context.Context, subject model.Packa
 	for _, lis := range discoveredLicenses {
 		l, err := c.returnFoundLicense(ctx, lis)
 		if err != nil {
-			return "", gqlerror.Errorf("%v :: License not found %q %v", funcName, lis.LicenseInput.Name, err)
+			return "", errext.Errorf("%v :: License not found %q %v", funcName, lis.LicenseInput.Name, err)
 		}
 		dis = append(dis, l.ThisID)
 	}
@@ -155,7 +156,7 @@ func (c *demoClient) ingestCertifyLegal(ctx context.Context, subject model.Packa
 		var err error
 		pkg, err = c.returnFoundPkgVersion(ctx, subject.Package)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		in.Pkg = pkg.ID()
 	}
@@ -165,7 +166,7 @@ func (c *demoClient) ingestCertifyLegal(ctx context.Context, subject model.Packa
 		var err error
 		src, err = c.returnFoundSource(ctx, subject.Source)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		in.Source = src.ID()
 	}
@@ -201,7 +202,7 @@ func (c *demoClient) ingestCertifyLegal(ctx context.Context, subject model.Packa
 	for _, lid := range dec {
 		l, err := byIDkv[*licStruct](ctx, lid, c)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		if err := l.setCertifyLegals(ctx, in.ThisID, c); err != nil {
 			return "", err
@@ -210,7 +211,7 @@ func (c *demoClient) ingestCertifyLegal(ctx context.Context, subject model.Packa
 	for _, lid := range dis {
 		l, err := byIDkv[*licStruct](ctx, lid, c)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		if err := l.setCertifyLegals(ctx, in.ThisID, c); err != nil {
 			return "", err
@@ -280,7 +281,7 @@ func (c *demoClient) CertifyLegal(ctx context.Context, filter *model.CertifyLega
 		// If found by id, ignore rest of fields in spec and return as a match
 		o, err := c.convLegal(ctx, link)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		return []*model.CertifyLegal{o}, nil
 	}
@@ -290,7 +291,7 @@ func (c *demoClient) CertifyLegal(ctx context.Context, filter *model.CertifyLega
 	if filter != nil && filter.Subject != nil && filter.Subject.Package != nil {
 		pkgs, err := c.findPackageVersion(ctx, filter.Subject.Package)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		foundOne = len(pkgs) > 0
 		for _, pkg := range pkgs {
@@ -300,7 +301,7 @@ func (c *demoClient) CertifyLegal(ctx context.Context, filter *model.CertifyLega
 	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
 		exactSource, err := c.exactSource(ctx, filter.Subject.Source)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactSource != nil {
 			search = append(search, exactSource.CertifyLegals...)
@@ -311,7 +312,7 @@ func (c *demoClient) CertifyLegal(ctx context.Context, filter *model.CertifyLega
 		for _, lSpec := range filter.DeclaredLicenses {
 			exactLicense, err := c.licenseExact(ctx, lSpec)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			if exactLicense != nil {
 				search = append(search, exactLicense.CertifyLegals...)
@@ -324,7 +325,7 @@ func (c *demoClient) CertifyLegal(ctx context.Context, filter *model.CertifyLega
 		for _, lSpec := range filter.DiscoveredLicenses {
 			exactLicense, err := c.licenseExact(ctx, lSpec)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			if exactLicense != nil {
 				search = append(search, exactLicense.CertifyLegals...)
@@ -339,11 +340,11 @@ func (c *demoClient) CertifyLegal(ctx context.Context, filter *model.CertifyLega
 		for _, id := range search {
 			link, err := byIDkv[*certifyLegalStruct](ctx, id, c)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			out, err = c.addLegalIfMatch(ctx, out, filter, link)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 		}
 	} else {
@@ -363,7 +364,7 @@ func (c *demoClient) CertifyLegal(ctx context.Context, filter *model.CertifyLega
 				}
 				out, err = c.addLegalIfMatch(ctx, out, filter, link)
 				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+					return nil, errext.Errorf("%v :: %v", funcName, err)
 				}
 			}
 		}
@@ -464,3 +465,23 @@ func (c *demoClient) matchLicenses(ctx context.Context, filter []*model.LicenseS
 	}
 	return true
 }
+
+func (c *demoClient) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, pagination *model.PaginationSpec) (*model.CertifyLegalConnection, error) {
+	results, err := c.CertifyLegal(ctx, &certifyLegalSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.CertifyLegal) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("CertifyLegalList :: %v", err)
+	}
+	edges := make([]*model.CertifyLegalEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.CertifyLegalEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.CertifyLegalConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -23,8 +23,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -76,7 +76,7 @@ func (c *demoClient) IngestScorecards(ctx context.Context, sources []*model.IDor
 	for i := range scorecards {
 		scorecard, err := c.IngestScorecard(ctx, *sources[i], *scorecards[i])
 		if err != nil {
-			return nil, gqlerror.Errorf("IngestScorecard failed with err: %v", err)
+			return nil, errext.Errorf("IngestScorecard failed with err: %v", err)
 		}
 		modelCertifyScorecards = append(modelCertifyScorecards, scorecard)
 	}
@@ -108,7 +108,7 @@ func (c *demoClient) certifyScorecard(ctx context.Context, source model.IDorSour
 
 	srcName, err := c.returnFoundSource(ctx, &source)
 	if err != nil {
-		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+		return "", errext.Errorf("%v ::  %s", funcName, err)
 	}
 	in.SourceID = srcName.ID()
 
@@ -141,6 +141,57 @@ func (c *demoClient) certifyScorecard(ctx context.Context, source model.IDorSour
 	return in.ThisID, nil
 }
 
+// Update CertifyScorecard
+
+func (c *demoClient) UpdateCertifyScorecard(ctx context.Context, id string, scorecard model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	funcName := "UpdateCertifyScorecard"
+
+	link, err := byIDkv[*scorecardLink](ctx, id, c)
+	if err != nil {
+		return nil, errext.Errorf("%v :: certifyScorecard with id %q not found", funcName, id)
+	}
+
+	updated := &scorecardLink{
+		ThisID:           link.ThisID,
+		SourceID:         link.SourceID,
+		TimeScanned:      scorecard.TimeScanned.UTC(),
+		AggregateScore:   scorecard.AggregateScore,
+		Checks:           getChecksFromInput(scorecard.Checks),
+		ScorecardVersion: scorecard.ScorecardVersion,
+		ScorecardCommit:  scorecard.ScorecardCommit,
+		Origin:           scorecard.Origin,
+		Collector:        scorecard.Collector,
+		DocumentRef:      scorecard.DocumentRef,
+	}
+	// building the node checks that the linked source still exists
+	out, err := c.buildScorecard(ctx, updated, nil, true)
+	if err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	if updated.Key() == link.Key() {
+		return out, nil
+	}
+
+	// the fields are part of the key, so the node moves to its new key
+	if _, err := byKeykv[*scorecardLink](ctx, cscCol, updated.Key(), c); err == nil {
+		return nil, errext.Errorf("%v :: update conflicts with an existing certifyScorecard", funcName)
+	} else if !errors.Is(err, kv.NotFoundError) {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	if err := delkv(ctx, cscCol, link, c); err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	if err := c.addToIndex(ctx, cscCol, updated); err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	if err := setkv(ctx, cscCol, updated, c); err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
 // Query CertifyScorecard
 func (c *demoClient) Scorecards(ctx context.Context, filter *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
 	c.m.RLock()
@@ -155,7 +206,7 @@ func (c *demoClient) Scorecards(ctx context.Context, filter *model.CertifyScorec
 		}
 		foundCertifyScorecard, err := c.buildScorecard(ctx, link, filter, true)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		return []*model.CertifyScorecard{foundCertifyScorecard}, nil
 	}
@@ -165,7 +216,7 @@ func (c *demoClient) Scorecards(ctx context.Context, filter *model.CertifyScorec
 	if filter != nil && filter.Source != nil {
 		exactSource, err := c.exactSource(ctx, filter.Source)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactSource != nil {
 			search = exactSource.ScorecardLinks
@@ -178,11 +229,11 @@ func (c *demoClient) Scorecards(ctx context.Context, filter *model.CertifyScorec
 		for _, id := range search {
 			link, err := byIDkv[*scorecardLink](ctx, id, c)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			out, err = c.addSCIfMatch(ctx, out, filter, link)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 		}
 	} else {
@@ -202,7 +253,7 @@ func (c *demoClient) Scorecards(ctx context.Context, filter *model.CertifyScorec
 				}
 				out, err = c.addSCIfMatch(ctx, out, filter, link)
 				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+					return nil, errext.Errorf("%v :: %v", funcName, err)
 				}
 			}
 		}
@@ -266,7 +317,7 @@ func (c *demoClient) buildScorecard(ctx context.Context, link *scorecardLink, fi
 
 	// if source not found during ingestion or if ID is provided in filter, send error. On query do not send error to continue search
 	if s == nil && ingestOrIDProvided {
-		return nil, gqlerror.Errorf("failed to retrieve source via sourceID")
+		return nil, errext.Errorf("failed to retrieve source via sourceID")
 	} else if s == nil && !ingestOrIDProvided {
 		return nil, nil
 	}
@@ -330,3 +381,23 @@ func noMatchChecks(checksFilter []*model.ScorecardCheckSpec, v map[string]int) b
 	}
 	return false
 }
+
+func (c *demoClient) ScorecardsList(ctx context.Context, certifyScorecardSpec model.CertifyScorecardSpec, pagination *model.PaginationSpec) (*model.CertifyScorecardConnection, error) {
+	results, err := c.Scorecards(ctx, &certifyScorecardSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.CertifyScorecard) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("ScorecardsList :: %v", err)
+	}
+	edges := make([]*model.CertifyScorecardEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.CertifyScorecardEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.CertifyScorecardConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -21,8 +21,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -92,13 +92,13 @@ func (c *demoClient) IngestVEXStatements(ctx context.Context, subjects model.Pac
 			subject := model.PackageOrArtifactInput{Package: subjects.Packages[i]}
 			certVex, err = c.IngestVEXStatement(ctx, subject, *vulnerabilities[i], *vexStatements[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestVEXStatement failed with err: %v", err)
+				return nil, errext.Errorf("IngestVEXStatement failed with err: %v", err)
 			}
 		} else {
 			subject := model.PackageOrArtifactInput{Artifact: subjects.Artifacts[i]}
 			certVex, err = c.IngestVEXStatement(ctx, subject, *vulnerabilities[i], *vexStatements[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestVEXStatement failed with err: %v", err)
+				return nil, errext.Errorf("IngestVEXStatement failed with err: %v", err)
 			}
 		}
 		modelVexStatementIDs = append(modelVexStatementIDs, certVex)
@@ -133,21 +133,21 @@ func (c *demoClient) ingestVEXStatement(ctx context.Context, subject model.Packa
 		var err error
 		foundPkgVersionNode, err = c.returnFoundPkgVersion(ctx, subject.Package)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		in.PackageID = foundPkgVersionNode.ID()
 	} else {
 		var err error
 		foundArtStruct, err = c.returnFoundArtifact(ctx, subject.Artifact)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		in.ArtifactID = foundArtStruct.ID()
 	}
 
 	foundVulnNode, err := c.returnFoundVulnerability(ctx, &vulnerability)
 	if err != nil {
-		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+		return "", errext.Errorf("%v ::  %s", funcName, err)
 	}
 	in.VulnerabilityID = foundVulnNode.ID()
 
@@ -205,7 +205,7 @@ func (c *demoClient) CertifyVEXStatement(ctx context.Context, filter *model.Cert
 		// If found by id, ignore rest of fields in spec and return as a match
 		foundCertifyVex, err := c.buildCertifyVEXStatement(ctx, link, filter, true)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		return []*model.CertifyVEXStatement{foundCertifyVex}, nil
 	}
@@ -216,7 +216,7 @@ func (c *demoClient) CertifyVEXStatement(ctx context.Context, filter *model.Cert
 	if filter != nil && filter.Subject != nil && filter.Subject.Artifact != nil {
 		exactArtifact, err := c.artifactExact(ctx, filter.Subject.Artifact)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactArtifact != nil {
 			search = append(search, exactArtifact.VexLinks...)
@@ -226,7 +226,7 @@ func (c *demoClient) CertifyVEXStatement(ctx context.Context, filter *model.Cert
 	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Package != nil {
 		pkgs, err := c.findPackageVersion(ctx, filter.Subject.Package)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		foundOne = len(pkgs) > 0
 		for _, pkg := range pkgs {
@@ -236,7 +236,7 @@ func (c *demoClient) CertifyVEXStatement(ctx context.Context, filter *model.Cert
 	if !foundOne && filter != nil && filter.Vulnerability != nil {
 		exactVuln, err := c.exactVulnerability(ctx, filter.Vulnerability)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactVuln != nil {
 			search = append(search, exactVuln.VexLinks...)
@@ -249,11 +249,11 @@ func (c *demoClient) CertifyVEXStatement(ctx context.Context, filter *model.Cert
 		for _, id := range search {
 			link, err := byIDkv[*vexLink](ctx, id, c)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			out, err = c.addVexIfMatch(ctx, out, filter, link)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 		}
 	} else {
@@ -273,7 +273,7 @@ func (c *demoClient) CertifyVEXStatement(ctx context.Context, filter *model.Cert
 				}
 				out, err = c.addVexIfMatch(ctx, out, filter, link)
 				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+					return nil, errext.Errorf("%v :: %v", funcName, err)
 				}
 			}
 		}
@@ -373,7 +373,7 @@ func (c *demoClient) buildCertifyVEXStatement(ctx context.Context, link *vexLink
 	var subj model.PackageOrArtifact
 	if link.PackageID != "" {
 		if p == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve package via packageID")
+			return nil, errext.Errorf("failed to retrieve package via packageID")
 		} else if p == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
@@ -381,7 +381,7 @@ func (c *demoClient) buildCertifyVEXStatement(ctx context.Context, link *vexLink
 	}
 	if link.ArtifactID != "" {
 		if a == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve artifact via artifactID")
+			return nil, errext.Errorf("failed to retrieve artifact via artifactID")
 		} else if a == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
@@ -390,7 +390,7 @@ func (c *demoClient) buildCertifyVEXStatement(ctx context.Context, link *vexLink
 
 	if link.VulnerabilityID != "" {
 		if vuln == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve vuln via vulnID")
+			return nil, errext.Errorf("failed to retrieve vuln via vulnID")
 		} else if vuln == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
@@ -410,3 +410,23 @@ func (c *demoClient) buildCertifyVEXStatement(ctx context.Context, link *vexLink
 		DocumentRef:      link.DocumentRef,
 	}, nil
 }
+
+func (c *demoClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, pagination *model.PaginationSpec) (*model.CertifyVEXStatementConnection, error) {
+	results, err := c.CertifyVEXStatement(ctx, &certifyVEXStatementSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.CertifyVEXStatement) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("CertifyVEXStatementList :: %v", err)
+	}
+	edges := make([]*model.CertifyVEXStatementEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.CertifyVEXStatementEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.CertifyVEXStatementConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -19,14 +19,17 @@ import (
 	"context"
 	"errors"
 	"reflect"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
+	"github.com/guacsec/guac/pkg/logging"
 )
 
 // Internal data: link between packages and vulnerabilities (certifyVulnerability)
@@ -42,6 +45,14 @@ type certifyVulnerabilityLink struct {
 	Origin          string
 	Collector       string
 	DocumentRef     string
+	// CVSSScore is reported by the scanner and is not part of the key
+	CVSSScore *float64
+	// RemediationStatus is not part of the key, so it can be updated in place
+	RemediationStatus model.RemediationStatus
+	// ResolvedByID and ResolvedByType are recorded after ingestion and are
+	// not part of the key either
+	ResolvedByID   string
+	ResolvedByType string
 }
 
 func (n *certifyVulnerabilityLink) ID() string { return n.ThisID }
@@ -60,6 +71,14 @@ func (n *certifyVulnerabilityLink) Key() string {
 	}, ":"))
 }
 
+// remediationStatus defaults links stored before the status was tracked to OPEN
+func (n *certifyVulnerabilityLink) remediationStatus() model.RemediationStatus {
+	if n.RemediationStatus == "" {
+		return model.RemediationStatusOpen
+	}
+	return n.RemediationStatus
+}
+
 func (n *certifyVulnerabilityLink) Neighbors(allowedEdges edgeMap) []string {
 	out := make([]string, 0, 2)
 	if allowedEdges[model.EdgeCertifyVulnPackage] {
@@ -81,7 +100,7 @@ func (c *demoClient) IngestCertifyVulns(ctx context.Context, pkgs []*model.IDorP
 	for i := range certifyVulns {
 		certifyVuln, err := c.IngestCertifyVuln(ctx, *pkgs[i], *vulnerabilities[i], *certifyVulns[i])
 		if err != nil {
-			return nil, gqlerror.Errorf("IngestCertifyVuln failed with err: %v", err)
+			return nil, errext.Errorf("IngestCertifyVuln failed with err: %v", err)
 		}
 		modelCertifyVulnList = append(modelCertifyVulnList, certifyVuln)
 	}
@@ -104,6 +123,9 @@ func (c *demoClient) ingestVulnerability(ctx context.Context, packageArg model.I
 		Origin:         certifyVuln.Origin,
 		Collector:      certifyVuln.Collector,
 		DocumentRef:    certifyVuln.DocumentRef,
+		CVSSScore:      certifyVuln.CVSSScore,
+
+		RemediationStatus: model.RemediationStatusOpen,
 	}
 
 	lock(&c.m, readOnly)
@@ -111,13 +133,13 @@ func (c *demoClient) ingestVulnerability(ctx context.Context, packageArg model.I
 
 	foundPackage, err := c.returnFoundPkgVersion(ctx, &packageArg)
 	if err != nil {
-		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+		return "", errext.Errorf("%v ::  %s", funcName, err)
 	}
 	in.PackageID = foundPackage.ID()
 
 	foundVulnNode, err := c.returnFoundVulnerability(ctx, &vulnerability)
 	if err != nil {
-		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+		return "", errext.Errorf("%v ::  %s", funcName, err)
 	}
 	in.VulnerabilityID = foundVulnNode.ID()
 
@@ -150,10 +172,119 @@ func (c *demoClient) ingestVulnerability(ctx context.Context, packageArg model.I
 	if err := setkv(ctx, cVulnCol, in, c); err != nil {
 		return "", err
 	}
+	if c.certifyVulnAdded.HasSubscribers() {
+		if err := c.publishCertifyVuln(ctx, in.ThisID, foundPackage); err != nil {
+			logging.FromContext(ctx).Warnf("failed to publish new certifyVuln %s: %v", in.ThisID, err)
+		}
+	}
 
 	return in.ThisID, nil
 }
 
+// publishCertifyVuln publishes the new CertifyVuln with the type, namespace and
+// name of the package version it certifies
+func (c *demoClient) publishCertifyVuln(ctx context.Context, id string, version *pkgVersion) error {
+	name, err := byIDkv[*pkgName](ctx, version.Parent, c)
+	if err != nil {
+		return err
+	}
+	namespace, err := byIDkv[*pkgNamespace](ctx, name.Parent, c)
+	if err != nil {
+		return err
+	}
+	typ, err := byIDkv[*pkgType](ctx, namespace.Parent, c)
+	if err != nil {
+		return err
+	}
+	helper.PublishCertifyVuln(ctx, &c.certifyVulnAdded, helper.CertifyVulnEvent{
+		ID:        id,
+		Type:      typ.Type,
+		Namespace: namespace.Namespace,
+		Name:      name.Name,
+	})
+	return nil
+}
+
+// CertifyVulnAdded streams the CertifyVuln nodes created after the call that match the filter
+func (c *demoClient) CertifyVulnAdded(ctx context.Context, filter *model.CertifyVulnSpec) (<-chan *model.CertifyVuln, error) {
+	return helper.SubscribeCertifyVulns(ctx, &c.certifyVulnAdded, filter, c.CertifyVuln), nil
+}
+
+// Delete CertifyVuln
+func (c *demoClient) DeleteCertifyVuln(ctx context.Context, id string) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	funcName := "DeleteCertifyVuln"
+
+	link, err := byIDkv[*certifyVulnerabilityLink](ctx, id, c)
+	if err != nil {
+		return errext.WithCode(errext.Errorf("%v :: certifyVuln with id %q not found", funcName, id), errext.ErrNotFound)
+	}
+	if err := c.deleteCertifyVulnLink(ctx, link); err != nil {
+		return errext.Errorf("%v :: %v", funcName, err)
+	}
+	return nil
+}
+
+// deleteCertifyVulnLink removes a certifyVuln and the backlinks to it from its
+// package and vulnerability.
+func (c *demoClient) deleteCertifyVulnLink(ctx context.Context, link *certifyVulnerabilityLink) error {
+	// remove the backlinks before the node itself
+	foundPackage, err := byIDkv[*pkgVersion](ctx, link.PackageID, c)
+	if err != nil {
+		return err
+	}
+	if err := foundPackage.unsetVulnerabilityLinks(ctx, link.ThisID, c); err != nil {
+		return err
+	}
+	foundVulnNode, err := byIDkv[*vulnIDNode](ctx, link.VulnerabilityID, c)
+	if err != nil {
+		return err
+	}
+	if err := foundVulnNode.unsetVulnerabilityLinks(ctx, link.ThisID, c); err != nil {
+		return err
+	}
+
+	if err := delkv(ctx, cVulnCol, link, c); err != nil {
+		return err
+	}
+	return c.removeFromIndex(ctx, link.ThisID)
+}
+
+func (c *demoClient) CertifyVulnByVulnerabilityIDs(ctx context.Context, vulnerabilityIDs []string, filter *model.CertifyVulnSpec) (map[string][]*model.CertifyVuln, error) {
+	rv := make(map[string][]*model.CertifyVuln, len(vulnerabilityIDs))
+	for _, vulnerabilityID := range vulnerabilityIDs {
+		spec := model.CertifyVulnSpec{}
+		if filter != nil {
+			spec = *filter
+		}
+		spec.Vulnerability = &model.VulnerabilitySpec{ID: ptrfrom.String(vulnerabilityID)}
+		certs, err := c.CertifyVuln(ctx, &spec)
+		if err != nil {
+			return nil, err
+		}
+		rv[vulnerabilityID] = certs
+	}
+	return rv, nil
+}
+
+func (c *demoClient) CertifyVulnByPackageIDs(ctx context.Context, packageIDs []string, filter *model.CertifyVulnSpec) (map[string][]*model.CertifyVuln, error) {
+	rv := make(map[string][]*model.CertifyVuln, len(packageIDs))
+	for _, packageID := range packageIDs {
+		spec := model.CertifyVulnSpec{}
+		if filter != nil {
+			spec = *filter
+		}
+		spec.Package = &model.PkgSpec{ID: ptrfrom.String(packageID)}
+		certs, err := c.CertifyVuln(ctx, &spec)
+		if err != nil {
+			return nil, err
+		}
+		rv[packageID] = certs
+	}
+	return rv, nil
+}
+
 // Query CertifyVuln
 func (c *demoClient) CertifyVuln(ctx context.Context, filter *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
 	c.m.RLock()
@@ -169,7 +300,7 @@ func (c *demoClient) CertifyVuln(ctx context.Context, filter *model.CertifyVulnS
 		// If found by id, ignore rest of fields in spec and return as a match
 		foundCertifyVuln, err := c.buildCertifyVulnerability(ctx, link, filter, true)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		return []*model.CertifyVuln{foundCertifyVuln}, nil
 	}
@@ -180,7 +311,7 @@ func (c *demoClient) CertifyVuln(ctx context.Context, filter *model.CertifyVulnS
 	if filter != nil && filter.Package != nil {
 		pkgs, err := c.findPackageVersion(ctx, filter.Package)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		foundOne = len(pkgs) > 0
 		for _, pkg := range pkgs {
@@ -195,7 +326,7 @@ func (c *demoClient) CertifyVuln(ctx context.Context, filter *model.CertifyVulnS
 			VulnerabilityID: ptrfrom.String(""),
 		})
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactVuln != nil {
 			search = append(search, exactVuln.CertifyVulnLinks...)
@@ -204,12 +335,12 @@ func (c *demoClient) CertifyVuln(ctx context.Context, filter *model.CertifyVulnS
 	} else if !foundOne && filter != nil && filter.Vulnerability != nil {
 		if filter.Vulnerability.NoVuln != nil && !*filter.Vulnerability.NoVuln {
 			if filter.Vulnerability.Type != nil && *filter.Vulnerability.Type == noVulnType {
-				return []*model.CertifyVuln{}, gqlerror.Errorf("novuln boolean set to false, cannot specify vulnerability type to be novuln")
+				return []*model.CertifyVuln{}, errext.Errorf("novuln boolean set to false, cannot specify vulnerability type to be novuln")
 			}
 		}
 		exactVuln, err := c.exactVulnerability(ctx, filter.Vulnerability)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactVuln != nil {
 			search = append(search, exactVuln.CertifyVulnLinks...)
@@ -222,11 +353,11 @@ func (c *demoClient) CertifyVuln(ctx context.Context, filter *model.CertifyVulnS
 		for _, id := range search {
 			link, err := byIDkv[*certifyVulnerabilityLink](ctx, id, c)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			out, err = c.addCVIfMatch(ctx, out, filter, link)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 		}
 	} else {
@@ -246,21 +377,233 @@ func (c *demoClient) CertifyVuln(ctx context.Context, filter *model.CertifyVulnS
 				}
 				out, err = c.addCVIfMatch(ctx, out, filter, link)
 				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+					return nil, errext.Errorf("%v :: %v", funcName, err)
 				}
 			}
 		}
 	}
 
+	if filter != nil {
+		helper.SortCertifyVulns(out, filter.Order)
+	}
 	return out, nil
 }
 
+func (c *demoClient) CheckScannerFreshness(ctx context.Context, scannerURI string, maxAge time.Duration) (*model.ScannerFreshnessResult, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	funcName := "CheckScannerFreshness"
+
+	staleBefore := time.Now().UTC().Add(-maxAge)
+	var latest *certifyVulnerabilityLink
+	staleCount := 0
+
+	var done bool
+	scn := c.kv.Keys(cVulnCol)
+	for !done {
+		var keys []string
+		var err error
+		keys, done, err = scn.Scan(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			link, err := byKeykv[*certifyVulnerabilityLink](ctx, cVulnCol, key, c)
+			if err != nil {
+				return nil, err
+			}
+			if link.ScannerURI != scannerURI {
+				continue
+			}
+			if link.TimeScanned.Before(staleBefore) {
+				staleCount++
+			}
+			if latest == nil || link.TimeScanned.After(latest.TimeScanned) {
+				latest = link
+			}
+		}
+	}
+
+	if latest == nil {
+		return nil, errext.Errorf("%v :: no vulnerability certifications found for scanner %s", funcName, scannerURI)
+	}
+
+	return &model.ScannerFreshnessResult{
+		LatestScanTime: latest.TimeScanned,
+		DbVersion:      latest.DBVersion,
+		IsFresh:        !latest.TimeScanned.Before(staleBefore),
+		StaleScanCount: staleCount,
+	}, nil
+}
+
+func (c *demoClient) MarkStaleVulns(ctx context.Context, olderThan time.Duration) (int, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	funcName := "MarkStaleVulns"
+
+	staleBefore := time.Now().UTC().Add(-olderThan)
+	var staleLinks []*certifyVulnerabilityLink
+
+	var done bool
+	scn := c.kv.Keys(cVulnCol)
+	for !done {
+		var keys []string
+		var err error
+		keys, done, err = scn.Scan(ctx)
+		if err != nil {
+			return 0, errext.Errorf("%v :: %v", funcName, err)
+		}
+		for _, key := range keys {
+			link, err := byKeykv[*certifyVulnerabilityLink](ctx, cVulnCol, key, c)
+			if err != nil {
+				return 0, errext.Errorf("%v :: %v", funcName, err)
+			}
+			if link.TimeScanned.Before(staleBefore) && link.RemediationStatus != model.RemediationStatusStale {
+				staleLinks = append(staleLinks, link)
+			}
+		}
+	}
+
+	for _, link := range staleLinks {
+		link.RemediationStatus = model.RemediationStatusStale
+		if err := setkv(ctx, cVulnCol, link, c); err != nil {
+			return 0, errext.Errorf("%v :: %v", funcName, err)
+		}
+	}
+	return len(staleLinks), nil
+}
+
+// resolvedByTypes maps the collections of the nodes that can resolve a
+// vulnerability to their GraphQL types
+var resolvedByTypes = map[string]string{
+	hasSBOMCol: "HasSBOM",
+	hsaCol:     "HasSourceAt",
+}
+
+func (c *demoClient) UpdateCertifyVulnResolution(ctx context.Context, id string, resolvedByID string) (*model.CertifyVuln, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	funcName := "UpdateCertifyVulnResolution"
+
+	link, err := byIDkv[*certifyVulnerabilityLink](ctx, id, c)
+	if err != nil {
+		return nil, errext.Errorf("%v :: certifyVuln with id %q not found", funcName, id)
+	}
+
+	var k string
+	if err := c.kv.Get(ctx, indexCol, resolvedByID, &k); err != nil {
+		return nil, errext.Errorf("%v :: node with id %q not found", funcName, resolvedByID)
+	}
+	resolvedByType, ok := resolvedByTypes[strings.SplitN(k, ":", 2)[0]]
+	if !ok {
+		return nil, errext.Errorf("%v :: id %q is not a hasSBOM or hasSourceAt", funcName, resolvedByID)
+	}
+
+	link.ResolvedByID = resolvedByID
+	link.ResolvedByType = resolvedByType
+	if err := setkv(ctx, cVulnCol, link, c); err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	return c.buildCertifyVulnerability(ctx, link, nil, true)
+}
+
+func (c *demoClient) ArchiveCertifyVulns(ctx context.Context, olderThan time.Duration) (int, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	funcName := "ArchiveCertifyVulns"
+
+	before := time.Now().UTC().Add(-olderThan)
+	var oldLinks []*certifyVulnerabilityLink
+
+	var done bool
+	scn := c.kv.Keys(cVulnCol)
+	for !done {
+		var keys []string
+		var err error
+		keys, done, err = scn.Scan(ctx)
+		if err != nil {
+			return 0, errext.Errorf("%v :: %v", funcName, err)
+		}
+		for _, key := range keys {
+			link, err := byKeykv[*certifyVulnerabilityLink](ctx, cVulnCol, key, c)
+			if err != nil {
+				return 0, errext.Errorf("%v :: %v", funcName, err)
+			}
+			if link.TimeScanned.Before(before) {
+				oldLinks = append(oldLinks, link)
+			}
+		}
+	}
+
+	// the archive is keyed by ID, as the same scan can be ingested and
+	// archived again
+	for i, link := range oldLinks {
+		if err := c.kv.Set(ctx, cVulnArchiveCol, link.ThisID, link); err != nil {
+			return i, errext.Errorf("%v :: %v", funcName, err)
+		}
+		if err := c.deleteCertifyVulnLink(ctx, link); err != nil {
+			return i, errext.Errorf("%v :: %v", funcName, err)
+		}
+	}
+	return len(oldLinks), nil
+}
+
+func (c *demoClient) ArchivedCertifyVuln(ctx context.Context, filter *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	funcName := "ArchivedCertifyVuln"
+
+	var out []*model.CertifyVuln
+	var done bool
+	scn := c.kv.Keys(cVulnArchiveCol)
+	for !done {
+		var keys []string
+		var err error
+		keys, done, err = scn.Scan(ctx)
+		if err != nil {
+			return nil, errext.Errorf("%v :: %v", funcName, err)
+		}
+		for _, key := range keys {
+			link, err := byKeykv[*certifyVulnerabilityLink](ctx, cVulnArchiveCol, key, c)
+			if err != nil {
+				return nil, errext.Errorf("%v :: %v", funcName, err)
+			}
+			if filter != nil && noMatch(filter.ID, link.ThisID) {
+				continue
+			}
+			out, err = c.addCVIfMatch(ctx, out, filter, link)
+			if err != nil {
+				return nil, errext.Errorf("%v :: %v", funcName, err)
+			}
+		}
+	}
+	return out, nil
+}
+
+// noMatchCVSSRange reports whether score falls outside the inclusive range,
+// a missing score never matches once either bound is set
+func noMatchCVSSRange(minScore, maxScore, score *float64) bool {
+	if minScore == nil && maxScore == nil {
+		return false
+	}
+	if score == nil {
+		return true
+	}
+	return (minScore != nil && *score < *minScore) || (maxScore != nil && *score > *maxScore)
+}
+
 func (c *demoClient) addCVIfMatch(ctx context.Context, out []*model.CertifyVuln,
 	filter *model.CertifyVulnSpec,
 	link *certifyVulnerabilityLink) ([]*model.CertifyVuln, error) {
 	if filter != nil && filter.TimeScanned != nil && !filter.TimeScanned.Equal(link.TimeScanned) {
 		return out, nil
 	}
+	if filter != nil && filter.TimeScannedAfter != nil && link.TimeScanned.Before(*filter.TimeScannedAfter) {
+		return out, nil
+	}
+	if filter != nil && filter.TimeScannedBefore != nil && link.TimeScanned.After(*filter.TimeScannedBefore) {
+		return out, nil
+	}
 	if filter != nil && noMatch(filter.DbURI, link.DBURI) {
 		return out, nil
 	}
@@ -270,6 +613,9 @@ func (c *demoClient) addCVIfMatch(ctx context.Context, out []*model.CertifyVuln,
 	if filter != nil && noMatch(filter.ScannerURI, link.ScannerURI) {
 		return out, nil
 	}
+	if filter != nil && len(filter.ScannerURIIn) > 0 && !slices.Contains(filter.ScannerURIIn, link.ScannerURI) {
+		return out, nil
+	}
 	if filter != nil && noMatch(filter.ScannerVersion, link.ScannerVersion) {
 		return out, nil
 	}
@@ -282,6 +628,9 @@ func (c *demoClient) addCVIfMatch(ctx context.Context, out []*model.CertifyVuln,
 	if filter != nil && noMatch(filter.DocumentRef, link.DocumentRef) {
 		return out, nil
 	}
+	if filter != nil && noMatchCVSSRange(filter.MinCVSS, filter.MaxCVSS, link.CVSSScore) {
+		return out, nil
+	}
 
 	foundCertifyVuln, err := c.buildCertifyVulnerability(ctx, link, filter, false)
 	if err != nil {
@@ -334,20 +683,20 @@ func (c *demoClient) buildCertifyVulnerability(ctx context.Context, link *certif
 
 	// if package not found during ingestion or if ID is provided in filter, send error. On query do not send error to continue search
 	if p == nil && ingestOrIDProvided {
-		return nil, gqlerror.Errorf("failed to retrieve package via packageID")
+		return nil, errext.Errorf("failed to retrieve package via packageID")
 	} else if p == nil && !ingestOrIDProvided {
 		return nil, nil
 	}
 
 	if link.VulnerabilityID != "" {
 		if vuln == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve vuln via vulnID")
+			return nil, errext.Errorf("failed to retrieve vuln via vulnID")
 		} else if vuln == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
 	}
 
-	return &model.CertifyVuln{
+	cv := &model.CertifyVuln{
 		ID:            link.ThisID,
 		Package:       p,
 		Vulnerability: vuln,
@@ -360,6 +709,86 @@ func (c *demoClient) buildCertifyVulnerability(ctx context.Context, link *certif
 			Origin:         link.Origin,
 			Collector:      link.Collector,
 			DocumentRef:    link.DocumentRef,
+			CVSSScore:      link.CVSSScore,
 		},
+		RemediationStatus: link.remediationStatus(),
+	}
+	if link.ResolvedByID != "" {
+		cv.ResolvedByID = ptrfrom.String(link.ResolvedByID)
+		cv.ResolvedByType = ptrfrom.String(link.ResolvedByType)
+	}
+	return cv, nil
+}
+
+func (c *demoClient) CertifyVulnCount(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec) (int, error) {
+	results, err := c.CertifyVuln(ctx, &certifyVulnSpec)
+	if err != nil {
+		return 0, err
+	}
+	return len(results), nil
+}
+
+func (c *demoClient) GetCertifyVulnsByDocumentRef(ctx context.Context, documentRef string) ([]*model.CertifyVuln, error) {
+	return c.CertifyVuln(ctx, &model.CertifyVulnSpec{DocumentRef: &documentRef})
+}
+
+func (c *demoClient) TopVulnerablePackages(ctx context.Context, limit int, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.PackageVulnCount, error) {
+	if limit <= 0 {
+		return nil, errext.WithCode(errext.Errorf("TopVulnerablePackages :: limit must be positive, got %d", limit), errext.ErrInvalidInput)
+	}
+	if certifyVulnSpec == nil {
+		certifyVulnSpec = &model.CertifyVulnSpec{}
+	}
+	results, err := c.CertifyVuln(ctx, certifyVulnSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	byPackage := make(map[string]*model.PackageVulnCount)
+	for _, cert := range results {
+		if cert.Vulnerability.Type == noVulnType {
+			continue
+		}
+		id := cert.Package.Namespaces[0].Names[0].Versions[0].ID
+		if count, ok := byPackage[id]; ok {
+			count.Count++
+		} else {
+			byPackage[id] = &model.PackageVulnCount{Package: cert.Package, Count: 1}
+		}
+	}
+
+	counts := make([]*model.PackageVulnCount, 0, len(byPackage))
+	for _, count := range byPackage {
+		counts = append(counts, count)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Package.Namespaces[0].Names[0].Versions[0].ID < counts[j].Package.Namespaces[0].Names[0].Versions[0].ID
+	})
+	if len(counts) > limit {
+		counts = counts[:limit]
+	}
+	return counts, nil
+}
+
+func (c *demoClient) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, pagination *model.PaginationSpec) (*model.CertifyVulnConnection, error) {
+	results, err := c.CertifyVuln(ctx, &certifyVulnSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.CertifyVuln) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("CertifyVulnList :: %v", err)
+	}
+	edges := make([]*model.CertifyVulnEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.CertifyVulnEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.CertifyVulnConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
 	}, nil
 }
@@ -0,0 +1,262 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/kv"
+)
+
+type exploitReferenceLink struct {
+	ThisID          string
+	VulnerabilityID string
+	Source          string
+	URL             string
+	Verified        bool
+	Origin          string
+	Collector       string
+	DocumentRef     string
+}
+
+func (n *exploitReferenceLink) ID() string { return n.ThisID }
+
+// Key only covers the identity of the reference, so re-ingesting the same
+// exploit updates it in place
+func (n *exploitReferenceLink) Key() string {
+	return hashKey(strings.Join([]string{
+		n.VulnerabilityID,
+		n.Source,
+		n.URL,
+	}, ":"))
+}
+
+func (n *exploitReferenceLink) Neighbors(allowedEdges edgeMap) []string {
+	if allowedEdges[model.EdgeExploitReferenceVulnerability] {
+		return []string{n.VulnerabilityID}
+	}
+	return nil
+}
+
+func (n *exploitReferenceLink) BuildModelNode(ctx context.Context, c *demoClient) (model.Node, error) {
+	return c.buildExploitReference(ctx, n, nil, true)
+}
+
+// Ingest ExploitReference
+
+func (c *demoClient) IngestExploitReference(ctx context.Context, vulnerability model.IDorVulnerabilityInput, exploitReference model.ExploitReferenceInputSpec) (string, error) {
+	return c.ingestExploitReference(ctx, vulnerability, exploitReference, true)
+}
+
+func (c *demoClient) ingestExploitReference(ctx context.Context, vulnerability model.IDorVulnerabilityInput, exploitReference model.ExploitReferenceInputSpec, readOnly bool) (string, error) {
+	funcName := "IngestExploitReference"
+
+	in := &exploitReferenceLink{
+		Source:      exploitReference.Source,
+		URL:         exploitReference.URL,
+		Verified:    exploitReference.Verified,
+		Origin:      exploitReference.Origin,
+		Collector:   exploitReference.Collector,
+		DocumentRef: exploitReference.DocumentRef,
+	}
+
+	lock(&c.m, readOnly)
+	defer unlock(&c.m, readOnly)
+
+	foundVulnNode, err := c.returnFoundVulnerability(ctx, &vulnerability)
+	if err != nil {
+		return "", errext.Errorf("%v ::  %s", funcName, err)
+	}
+	in.VulnerabilityID = foundVulnNode.ID()
+
+	out, err := byKeykv[*exploitReferenceLink](ctx, exploitCol, in.Key(), c)
+	if err == nil {
+		if out.Verified == in.Verified && out.Origin == in.Origin &&
+			out.Collector == in.Collector && out.DocumentRef == in.DocumentRef {
+			return out.ThisID, nil
+		}
+	} else if !errors.Is(err, kv.NotFoundError) {
+		return "", err
+	}
+
+	if readOnly {
+		c.m.RUnlock()
+		er, err := c.ingestExploitReference(ctx, vulnerability, exploitReference, false)
+		c.m.RLock() // relock so that defer unlock does not panic
+		return er, err
+	}
+
+	if out != nil {
+		// existing reference, update the mutable fields in place
+		in.ThisID = out.ThisID
+		if err := setkv(ctx, exploitCol, in, c); err != nil {
+			return "", err
+		}
+		return in.ThisID, nil
+	}
+
+	in.ThisID = c.getNextID()
+	if err := c.addToIndex(ctx, exploitCol, in); err != nil {
+		return "", err
+	}
+	if err := foundVulnNode.setExploitRefLinks(ctx, in.ThisID, c); err != nil {
+		return "", err
+	}
+	if err := setkv(ctx, exploitCol, in, c); err != nil {
+		return "", err
+	}
+
+	return in.ThisID, nil
+}
+
+// Query ExploitReference
+
+func (c *demoClient) ExploitReferences(ctx context.Context, filter *model.ExploitReferenceSpec) ([]*model.ExploitReference, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	funcName := "ExploitReferences"
+
+	if filter != nil && filter.ID != nil {
+		link, err := byIDkv[*exploitReferenceLink](ctx, *filter.ID, c)
+		if err != nil {
+			// Not found
+			return nil, nil
+		}
+		// If found by id, ignore rest of fields in spec and return as a match
+		foundExploitRef, err := c.buildExploitReference(ctx, link, filter, true)
+		if err != nil {
+			return nil, errext.Errorf("%v :: %v", funcName, err)
+		}
+		return []*model.ExploitReference{foundExploitRef}, nil
+	}
+
+	var search []string
+	foundOne := false
+	if filter != nil && filter.Vulnerability != nil {
+		exactVuln, err := c.exactVulnerability(ctx, filter.Vulnerability)
+		if err != nil {
+			return nil, errext.Errorf("%v :: %v", funcName, err)
+		}
+		if exactVuln != nil {
+			search = append(search, exactVuln.ExploitRefLinks...)
+			foundOne = true
+		}
+	}
+
+	var out []*model.ExploitReference
+	if foundOne {
+		for _, id := range search {
+			link, err := byIDkv[*exploitReferenceLink](ctx, id, c)
+			if err != nil {
+				return nil, errext.Errorf("%v :: %v", funcName, err)
+			}
+			out, err = c.addExploitReferenceMatch(ctx, out, filter, link)
+			if err != nil {
+				return nil, errext.Errorf("%v :: %v", funcName, err)
+			}
+		}
+	} else {
+		var done bool
+		scn := c.kv.Keys(exploitCol)
+		for !done {
+			var erKeys []string
+			var err error
+			erKeys, done, err = scn.Scan(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, erk := range erKeys {
+				link, err := byKeykv[*exploitReferenceLink](ctx, exploitCol, erk, c)
+				if err != nil {
+					return nil, err
+				}
+				out, err = c.addExploitReferenceMatch(ctx, out, filter, link)
+				if err != nil {
+					return nil, errext.Errorf("%v :: %v", funcName, err)
+				}
+			}
+		}
+	}
+
+	return out, nil
+}
+
+func (c *demoClient) addExploitReferenceMatch(ctx context.Context, out []*model.ExploitReference,
+	filter *model.ExploitReferenceSpec,
+	link *exploitReferenceLink) ([]*model.ExploitReference, error) {
+
+	if filter != nil && noMatch(filter.Source, link.Source) {
+		return out, nil
+	}
+	if filter != nil && noMatch(filter.URL, link.URL) {
+		return out, nil
+	}
+	if filter != nil && filter.Verified != nil && *filter.Verified != link.Verified {
+		return out, nil
+	}
+	if filter != nil && noMatch(filter.Origin, link.Origin) {
+		return out, nil
+	}
+	if filter != nil && noMatch(filter.Collector, link.Collector) {
+		return out, nil
+	}
+	if filter != nil && noMatch(filter.DocumentRef, link.DocumentRef) {
+		return out, nil
+	}
+
+	foundExploitRef, err := c.buildExploitReference(ctx, link, filter, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build exploit reference node from link")
+	}
+	if foundExploitRef == nil {
+		return out, nil
+	}
+	return append(out, foundExploitRef), nil
+}
+
+func (c *demoClient) buildExploitReference(ctx context.Context, link *exploitReferenceLink, filter *model.ExploitReferenceSpec, ingestOrIDProvided bool) (*model.ExploitReference, error) {
+	var vulnFilter *model.VulnerabilitySpec
+	if filter != nil {
+		vulnFilter = filter.Vulnerability
+	}
+
+	vuln, err := c.buildVulnResponse(ctx, link.VulnerabilityID, vulnFilter)
+	if err != nil {
+		return nil, err
+	}
+	if vuln == nil {
+		if ingestOrIDProvided {
+			return nil, errext.Errorf("failed to retrieve vuln via vulnID")
+		}
+		return nil, nil
+	}
+
+	return &model.ExploitReference{
+		ID:            link.ThisID,
+		Vulnerability: vuln,
+		Source:        link.Source,
+		URL:           link.URL,
+		Verified:      link.Verified,
+		Origin:        link.Origin,
+		Collector:     link.Collector,
+		DocumentRef:   link.DocumentRef,
+	}, nil
+}
@@ -21,8 +21,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -87,19 +87,19 @@ func (c *demoClient) IngestBulkHasMetadata(ctx context.Context, subjects model.P
 			subject := model.PackageSourceOrArtifactInput{Package: subjects.Packages[i]}
 			hasMetadata, err = c.IngestHasMetadata(ctx, subject, pkgMatchType, *hasMetadataList[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestHasMetadata failed with err: %v", err)
+				return nil, errext.Errorf("IngestHasMetadata failed with err: %v", err)
 			}
 		} else if len(subjects.Sources) > 0 {
 			subject := model.PackageSourceOrArtifactInput{Source: subjects.Sources[i]}
 			hasMetadata, err = c.IngestHasMetadata(ctx, subject, pkgMatchType, *hasMetadataList[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestHasMetadata failed with err: %v", err)
+				return nil, errext.Errorf("IngestHasMetadata failed with err: %v", err)
 			}
 		} else {
 			subject := model.PackageSourceOrArtifactInput{Artifact: subjects.Artifacts[i]}
 			hasMetadata, err = c.IngestHasMetadata(ctx, subject, pkgMatchType, *hasMetadataList[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestHasMetadata failed with err: %v", err)
+				return nil, errext.Errorf("IngestHasMetadata failed with err: %v", err)
 			}
 		}
 		modelHasMetadataIDs = append(modelHasMetadataIDs, hasMetadata)
@@ -134,20 +134,20 @@ func (c *demoClient) ingestHasMetadata(ctx context.Context, subject model.Packag
 		var err error
 		in.PackageID, foundPkgNameOrVersionNode, err = c.returnFoundPkgBasedOnMatchType(ctx, subject.Package, pkgMatchType)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 	} else if subject.Artifact != nil {
 		var err error
 		foundArtStruct, err = c.returnFoundArtifact(ctx, subject.Artifact)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		in.ArtifactID = foundArtStruct.ID()
 	} else {
 		var err error
 		srcName, err = c.returnFoundSource(ctx, subject.Source)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		in.SourceID = srcName.ID()
 	}
@@ -212,7 +212,7 @@ func (c *demoClient) HasMetadata(ctx context.Context, filter *model.HasMetadataS
 		}
 		found, err := c.buildHasMetadata(ctx, link, filter, true)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		return []*model.HasMetadata{found}, nil
 	}
@@ -224,7 +224,7 @@ func (c *demoClient) HasMetadata(ctx context.Context, filter *model.HasMetadataS
 	if filter != nil && filter.Subject != nil && filter.Subject.Artifact != nil {
 		exactArtifact, err := c.artifactExact(ctx, filter.Subject.Artifact)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactArtifact != nil {
 			search = append(search, exactArtifact.HasMetadataLinks...)
@@ -234,7 +234,7 @@ func (c *demoClient) HasMetadata(ctx context.Context, filter *model.HasMetadataS
 	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
 		exactSource, err := c.exactSource(ctx, filter.Subject.Source)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactSource != nil {
 			search = append(search, exactSource.HasMetadataLinks...)
@@ -247,11 +247,11 @@ func (c *demoClient) HasMetadata(ctx context.Context, filter *model.HasMetadataS
 		for _, id := range search {
 			link, err := byIDkv[*hasMetadataLink](ctx, id, c)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			out, err = c.addHMIfMatch(ctx, out, filter, link)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 		}
 	} else {
@@ -271,7 +271,7 @@ func (c *demoClient) HasMetadata(ctx context.Context, filter *model.HasMetadataS
 				}
 				out, err = c.addHMIfMatch(ctx, out, filter, link)
 				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+					return nil, errext.Errorf("%v :: %v", funcName, err)
 				}
 			}
 		}
@@ -363,7 +363,7 @@ func (c *demoClient) buildHasMetadata(ctx context.Context, link *hasMetadataLink
 	var subj model.PackageSourceOrArtifact
 	if link.PackageID != "" {
 		if p == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve package via packageID")
+			return nil, errext.Errorf("failed to retrieve package via packageID")
 		} else if p == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
@@ -371,7 +371,7 @@ func (c *demoClient) buildHasMetadata(ctx context.Context, link *hasMetadataLink
 	}
 	if link.ArtifactID != "" {
 		if a == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve artifact via artifactID")
+			return nil, errext.Errorf("failed to retrieve artifact via artifactID")
 		} else if a == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
@@ -379,7 +379,7 @@ func (c *demoClient) buildHasMetadata(ctx context.Context, link *hasMetadataLink
 	}
 	if link.SourceID != "" {
 		if s == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve source via sourceID")
+			return nil, errext.Errorf("failed to retrieve source via sourceID")
 		} else if s == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
@@ -399,3 +399,23 @@ func (c *demoClient) buildHasMetadata(ctx context.Context, link *hasMetadataLink
 	}
 	return &hasMetadata, nil
 }
+
+func (c *demoClient) HasMetadataList(ctx context.Context, hasMetadataSpec model.HasMetadataSpec, pagination *model.PaginationSpec) (*model.HasMetadataConnection, error) {
+	results, err := c.HasMetadata(ctx, &hasMetadataSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.HasMetadata) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("HasMetadataList :: %v", err)
+	}
+	edges := make([]*model.HasMetadataEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.HasMetadataEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.HasMetadataConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
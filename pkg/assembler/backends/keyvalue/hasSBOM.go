@@ -19,12 +19,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -101,13 +101,13 @@ func (c *demoClient) IngestHasSBOMs(ctx context.Context, subjects model.PackageO
 			subject := model.PackageOrArtifactInput{Package: subjects.Packages[i]}
 			hasSBOM, err = c.IngestHasSbom(ctx, subject, *hasSBOMs[i], *includes[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestHasSbom failed with err: %v", err)
+				return nil, errext.Errorf("IngestHasSbom failed with err: %v", err)
 			}
 		} else {
 			subject := model.PackageOrArtifactInput{Artifact: subjects.Artifacts[i]}
 			hasSBOM, err = c.IngestHasSbom(ctx, subject, *hasSBOMs[i], *includes[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestHasSbom failed with err: %v", err)
+				return nil, errext.Errorf("IngestHasSbom failed with err: %v", err)
 			}
 		}
 		modelHasSboms = append(modelHasSboms, hasSBOM)
@@ -134,13 +134,13 @@ func (c *demoClient) IngestHasSbom(ctx context.Context, subject model.PackageOrA
 	for _, id := range includes.Dependencies {
 		if _, err := byIDkv[*isDependencyLink](ctx, id, c); err != nil {
 			c.m.RUnlock()
-			return "", gqlerror.Errorf("%v :: dependency id %v is not an ingested isDependency", funcName, id)
+			return "", errext.Errorf("%v :: dependency id %v is not an ingested isDependency", funcName, id)
 		}
 	}
 	for _, id := range includes.Occurrences {
 		if _, err := byIDkv[*isOccurrenceStruct](ctx, id, c); err != nil {
 			c.m.RUnlock()
-			return "", gqlerror.Errorf("%v :: occurrence id %v is not an ingested isOccurrence", funcName, id)
+			return "", errext.Errorf("%v :: occurrence id %v is not an ingested isOccurrence", funcName, id)
 		}
 	}
 	c.m.RUnlock()
@@ -157,14 +157,14 @@ func (c *demoClient) IngestHasSbom(ctx context.Context, subject model.PackageOrA
 
 func (c *demoClient) validatePkgId(ctx context.Context, funcName string, id string) error {
 	if _, err := byIDkv[*pkgVersion](ctx, id, c); err != nil {
-		return gqlerror.Errorf("%v :: package id %v is not an ingested Package", funcName, id)
+		return errext.Errorf("%v :: package id %v is not an ingested Package", funcName, id)
 	}
 	return nil
 }
 
 func (c *demoClient) validateArtId(ctx context.Context, funcName string, id string) error {
 	if _, err := byIDkv[*artStruct](ctx, id, c); err != nil {
-		return gqlerror.Errorf("%v :: artifact id %v is not an ingested Artifact", funcName, id)
+		return errext.Errorf("%v :: artifact id %v is not an ingested Artifact", funcName, id)
 	}
 	return nil
 }
@@ -198,14 +198,14 @@ func (c *demoClient) ingestHasSbom(ctx context.Context, subject model.PackageOrA
 		var err error
 		pkg, err = c.returnFoundPkgVersion(ctx, subject.Package)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		in.Pkg = pkg.ID()
 	} else {
 		var err error
 		art, err = c.returnFoundArtifact(ctx, subject.Artifact)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		in.Artifact = art.ID()
 	}
@@ -335,7 +335,7 @@ func (c *demoClient) HasSBOM(ctx context.Context, filter *model.HasSBOMSpec) ([]
 		// If found by id, ignore rest of fields in spec and return as a match
 		sb, err := c.convHasSBOM(ctx, link)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		return []*model.HasSbom{sb}, nil
 	}
@@ -345,7 +345,7 @@ func (c *demoClient) HasSBOM(ctx context.Context, filter *model.HasSBOMSpec) ([]
 	if filter != nil && filter.Subject != nil && filter.Subject.Package != nil {
 		pkgs, err := c.findPackageVersion(ctx, filter.Subject.Package)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		foundOne = len(pkgs) > 0
 		for _, pkg := range pkgs {
@@ -355,7 +355,7 @@ func (c *demoClient) HasSBOM(ctx context.Context, filter *model.HasSBOMSpec) ([]
 	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Artifact != nil {
 		exactArt, err := c.artifactExact(ctx, filter.Subject.Artifact)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactArt != nil {
 			search = exactArt.HasSBOMs
@@ -368,11 +368,11 @@ func (c *demoClient) HasSBOM(ctx context.Context, filter *model.HasSBOMSpec) ([]
 		for _, id := range search {
 			link, err := byIDkv[*hasSBOMStruct](ctx, id, c)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			out, err = c.addHasSBOMIfMatch(ctx, out, filter, link)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 		}
 	} else {
@@ -392,7 +392,7 @@ func (c *demoClient) HasSBOM(ctx context.Context, filter *model.HasSBOMSpec) ([]
 				}
 				out, err = c.addHasSBOMIfMatch(ctx, out, filter, link)
 				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+					return nil, errext.Errorf("%v :: %v", funcName, err)
 				}
 			}
 		}
@@ -401,6 +401,45 @@ func (c *demoClient) HasSBOM(ctx context.Context, filter *model.HasSBOMSpec) ([]
 	return out, nil
 }
 
+func (c *demoClient) SBOMComponentBreakdown(ctx context.Context, hasSBOMID string) ([]*model.ComponentTypeCount, error) {
+	funcName := "SBOMComponentBreakdown"
+	c.m.RLock()
+	defer c.m.RUnlock()
+
+	link, err := byIDkv[*hasSBOMStruct](ctx, hasSBOMID, c)
+	if err != nil {
+		return nil, errext.Errorf("%v :: hasSBOM with ID %s not found", funcName, hasSBOMID)
+	}
+
+	counts := map[string]int{}
+	for _, id := range link.IncludedSoftware {
+		pv, err := byIDkv[*pkgVersion](ctx, id, c)
+		if err != nil {
+			// included artifacts do not have a component type
+			continue
+		}
+		if pv.ComponentType == "" {
+			continue
+		}
+		counts[pv.ComponentType]++
+	}
+
+	componentTypes := make([]string, 0, len(counts))
+	for componentType := range counts {
+		componentTypes = append(componentTypes, componentType)
+	}
+	slices.Sort(componentTypes)
+
+	out := make([]*model.ComponentTypeCount, 0, len(componentTypes))
+	for _, componentType := range componentTypes {
+		out = append(out, &model.ComponentTypeCount{
+			ComponentType: componentType,
+			Count:         counts[componentType],
+		})
+	}
+	return out, nil
+}
+
 func (c *demoClient) addHasSBOMIfMatch(ctx context.Context, out []*model.HasSbom,
 	filter *model.HasSBOMSpec, link *hasSBOMStruct) (
 	[]*model.HasSbom, error) {
@@ -423,6 +462,7 @@ func (c *demoClient) addHasSBOMIfMatch(ctx context.Context, out []*model.HasSbom
 		}
 
 		pkgFilters, artFilters := helper.GetPackageAndArtifactFilters(filter.IncludedSoftware)
+		pkgFilters = append(pkgFilters, filter.IncludedPackages...)
 		if !c.matchPackages(ctx, pkgFilters, pkgs) || !c.matchArtifacts(ctx, artFilters, artifacts) ||
 			!c.matchDependencies(ctx, filter.IncludedDependencies, link.IncludedDependencies) ||
 			!c.matchOccurrences(ctx, filter.IncludedOccurrences, link.IncludedOccurrences) {
@@ -457,3 +497,97 @@ func (c *demoClient) addHasSBOMIfMatch(ctx context.Context, out []*model.HasSbom
 	}
 	return append(out, sb), nil
 }
+
+func (c *demoClient) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, pagination *model.PaginationSpec) (*model.HasSBOMConnection, error) {
+	results, err := c.HasSBOM(ctx, &hasSBOMSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.HasSbom) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("HasSBOMList :: %v", err)
+	}
+	edges := make([]*model.HasSBOMEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.HasSBOMEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.HasSBOMConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+// removeIncludedOccurrences drops deleted occurrences from the SBOMs that
+// include them.
+func (c *demoClient) removeIncludedOccurrences(ctx context.Context, occurrences []string) error {
+	replaced := map[string]string{}
+	for _, o := range occurrences {
+		replaced[o] = ""
+	}
+	return c.replaceIncluded(ctx, replaced)
+}
+
+// replaceIncluded swaps the software, dependencies and occurrences included in
+// SBOMs for their replacement in replaced, or drops them if the replacement is
+// empty. The key of an SBOM covers its included nodes, so the updated SBOMs
+// are stored under a new key.
+func (c *demoClient) replaceIncluded(ctx context.Context, replaced map[string]string) error {
+	if len(replaced) == 0 {
+		return nil
+	}
+	replace := func(ids []string) ([]string, bool) {
+		if !slices.ContainsFunc(ids, func(id string) bool { _, ok := replaced[id]; return ok }) {
+			return ids, false
+		}
+		var out []string
+		for _, id := range ids {
+			if r, ok := replaced[id]; !ok {
+				out = append(out, id)
+			} else if r != "" {
+				out = append(out, r)
+			}
+		}
+		return helper.SortAndRemoveDups(out), true
+	}
+	var found []*hasSBOMStruct
+	var done bool
+	scn := c.kv.Keys(hasSBOMCol)
+	for !done {
+		var hsks []string
+		var err error
+		hsks, done, err = scn.Scan(ctx)
+		if err != nil {
+			return err
+		}
+		for _, hsk := range hsks {
+			link, err := byKeykv[*hasSBOMStruct](ctx, hasSBOMCol, hsk, c)
+			if err != nil {
+				return err
+			}
+			found = append(found, link)
+		}
+	}
+	// rekey once the scan is over
+	for _, link := range found {
+		software, softwareChanged := replace(link.IncludedSoftware)
+		dependencies, dependenciesChanged := replace(link.IncludedDependencies)
+		occurrences, occurrencesChanged := replace(link.IncludedOccurrences)
+		if !softwareChanged && !dependenciesChanged && !occurrencesChanged {
+			continue
+		}
+		if err := delkv(ctx, hasSBOMCol, link, c); err != nil {
+			return err
+		}
+		link.IncludedSoftware = software
+		link.IncludedDependencies = dependencies
+		link.IncludedOccurrences = occurrences
+		if err := c.addToIndex(ctx, hasSBOMCol, link); err != nil {
+			return err
+		}
+		if err := setkv(ctx, hasSBOMCol, link, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
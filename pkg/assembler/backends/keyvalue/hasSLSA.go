@@ -24,9 +24,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 type (
@@ -104,7 +105,7 @@ func (c *demoClient) HasSlsa(ctx context.Context, filter *model.HasSLSASpec) ([]
 		// If found by id, ignore rest of fields in spec and return as a match
 		hs, err := c.convSLSA(ctx, link)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		return []*model.HasSlsa{hs}, nil
 	}
@@ -120,7 +121,7 @@ func (c *demoClient) HasSlsa(ctx context.Context, filter *model.HasSLSASpec) ([]
 		if !foundOne && a != nil {
 			exactArtifact, err := c.artifactExact(ctx, a)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			if exactArtifact != nil {
 				search = append(search, exactArtifact.HasSLSAs...)
@@ -132,7 +133,7 @@ func (c *demoClient) HasSlsa(ctx context.Context, filter *model.HasSLSASpec) ([]
 	if !foundOne && filter != nil && filter.BuiltBy != nil {
 		exactBuilder, err := c.exactBuilder(ctx, filter.BuiltBy)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactBuilder != nil {
 			search = append(search, exactBuilder.HasSLSAs...)
@@ -145,11 +146,11 @@ func (c *demoClient) HasSlsa(ctx context.Context, filter *model.HasSLSASpec) ([]
 		for _, id := range search {
 			link, err := byIDkv[*hasSLSAStruct](ctx, id, c)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			out, err = c.addSLSAIfMatch(ctx, out, filter, link)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 		}
 	} else {
@@ -169,7 +170,7 @@ func (c *demoClient) HasSlsa(ctx context.Context, filter *model.HasSLSASpec) ([]
 				}
 				out, err = c.addSLSAIfMatch(ctx, out, filter, link)
 				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+					return nil, errext.Errorf("%v :: %v", funcName, err)
 				}
 			}
 		}
@@ -196,7 +197,7 @@ func (c *demoClient) IngestSLSAs(ctx context.Context, subjects []*model.IDorArti
 	for i := range subjects {
 		hasSLSA, err := c.IngestSLSA(ctx, *subjects[i], builtFromList[i], *builtByList[i], *slsaList[i])
 		if err != nil {
-			return nil, gqlerror.Errorf("IngestSLSA failed with err: %v", err)
+			return nil, errext.Errorf("IngestSLSA failed with err: %v", err)
 		}
 		modelHasSLSAList = append(modelHasSLSAList, hasSLSA)
 	}
@@ -238,7 +239,7 @@ func (c *demoClient) ingestSLSA(ctx context.Context,
 
 	s, err := c.returnFoundArtifact(ctx, &subject)
 	if err != nil {
-		return "", gqlerror.Errorf("IngestSLSA :: Subject artifact not found")
+		return "", errext.Errorf("IngestSLSA :: Subject artifact not found")
 	}
 	in.Subject = s.ThisID
 
@@ -247,7 +248,7 @@ func (c *demoClient) ingestSLSA(ctx context.Context,
 	for i, a := range builtFrom {
 		b, err := c.returnFoundArtifact(ctx, a)
 		if err != nil {
-			return "", gqlerror.Errorf("IngestSLSA :: BuiltFrom %d artifact not found", i)
+			return "", errext.Errorf("IngestSLSA :: BuiltFrom %d artifact not found", i)
 		}
 		bfs = append(bfs, b)
 		bfIDs = append(bfIDs, b.ID())
@@ -257,7 +258,7 @@ func (c *demoClient) ingestSLSA(ctx context.Context,
 
 	b, err := c.returnFoundBuilder(ctx, &builtBy)
 	if err != nil {
-		return "", gqlerror.Errorf("IngestSLSA :: Builder not found")
+		return "", errext.Errorf("IngestSLSA :: Builder not found")
 	}
 	in.BuiltBy = b.ThisID
 
@@ -375,3 +376,23 @@ func (c *demoClient) addSLSAIfMatch(ctx context.Context, out []*model.HasSlsa,
 	}
 	return append(out, hs), nil
 }
+
+func (c *demoClient) HasSLSAList(ctx context.Context, hasSLSASpec model.HasSLSASpec, pagination *model.PaginationSpec) (*model.HasSLSAConnection, error) {
+	results, err := c.HasSlsa(ctx, &hasSLSASpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.HasSlsa) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("HasSLSAList :: %v", err)
+	}
+	edges := make([]*model.HasSLSAEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.HasSLSAEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.HasSLSAConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -21,8 +21,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -75,7 +75,7 @@ func (c *demoClient) IngestHasSourceAts(ctx context.Context, pkgs []*model.IDorP
 	for i := range hasSourceAts {
 		hasMetadata, err := c.IngestHasSourceAt(ctx, *pkgs[i], *pkgMatchType, *sources[i], *hasSourceAts[i])
 		if err != nil {
-			return nil, gqlerror.Errorf("IngestHasSourceAt failed with err: %v", err)
+			return nil, errext.Errorf("IngestHasSourceAt failed with err: %v", err)
 		}
 		modelHasMetadataIDs = append(modelHasMetadataIDs, hasMetadata)
 	}
@@ -104,12 +104,12 @@ func (c *demoClient) ingestHasSourceAt(ctx context.Context, packageArg model.IDo
 	var err error
 	in.PackageID, pkgNameOrVersionNode, err = c.returnFoundPkgBasedOnMatchType(ctx, &packageArg, &pkgMatchType)
 	if err != nil {
-		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+		return "", errext.Errorf("%v ::  %s", funcName, err)
 	}
 
 	srcName, err := c.returnFoundSource(ctx, &source)
 	if err != nil {
-		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+		return "", errext.Errorf("%v ::  %s", funcName, err)
 	}
 	in.SourceID = srcName.ThisID
 
@@ -147,6 +147,55 @@ func (c *demoClient) ingestHasSourceAt(ctx context.Context, packageArg model.IDo
 	return in.ThisID, nil
 }
 
+// Update HasSourceAt
+
+func (c *demoClient) UpdateHasSourceAt(ctx context.Context, id string, hasSourceAt model.HasSourceAtInputSpec) (*model.HasSourceAt, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	funcName := "UpdateHasSourceAt"
+
+	link, err := byIDkv[*srcMapLink](ctx, id, c)
+	if err != nil {
+		return nil, errext.Errorf("%v :: hasSourceAt with id %q not found", funcName, id)
+	}
+
+	updated := &srcMapLink{
+		ThisID:        link.ThisID,
+		SourceID:      link.SourceID,
+		PackageID:     link.PackageID,
+		KnownSince:    hasSourceAt.KnownSince.UTC(),
+		Justification: hasSourceAt.Justification,
+		Origin:        hasSourceAt.Origin,
+		Collector:     hasSourceAt.Collector,
+		DocumentRef:   hasSourceAt.DocumentRef,
+	}
+	// building the node checks that the linked package and source still exist
+	out, err := c.buildHasSourceAt(ctx, updated, nil, true)
+	if err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	if updated.Key() == link.Key() {
+		return out, nil
+	}
+
+	// the fields are part of the key, so the node moves to its new key
+	if _, err := byKeykv[*srcMapLink](ctx, hsaCol, updated.Key(), c); err == nil {
+		return nil, errext.Errorf("%v :: update conflicts with an existing hasSourceAt", funcName)
+	} else if !errors.Is(err, kv.NotFoundError) {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	if err := delkv(ctx, hsaCol, link, c); err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	if err := c.addToIndex(ctx, hsaCol, updated); err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	if err := setkv(ctx, hsaCol, updated, c); err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
 // Query HasSourceAt
 func (c *demoClient) HasSourceAt(ctx context.Context, filter *model.HasSourceAtSpec) ([]*model.HasSourceAt, error) {
 	c.m.RLock()
@@ -160,7 +209,7 @@ func (c *demoClient) HasSourceAt(ctx context.Context, filter *model.HasSourceAtS
 		}
 		foundHasSourceAt, err := c.buildHasSourceAt(ctx, link, filter, true)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		return []*model.HasSourceAt{foundHasSourceAt}, nil
 	}
@@ -172,7 +221,7 @@ func (c *demoClient) HasSourceAt(ctx context.Context, filter *model.HasSourceAtS
 	if filter != nil && filter.Source != nil {
 		exactSource, err := c.exactSource(ctx, filter.Source)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactSource != nil {
 			search = append(search, exactSource.SrcMapLinks...)
@@ -185,11 +234,11 @@ func (c *demoClient) HasSourceAt(ctx context.Context, filter *model.HasSourceAtS
 		for _, id := range search {
 			link, err := byIDkv[*srcMapLink](ctx, id, c)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			out, err = c.addSrcIfMatch(ctx, out, filter, link)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 		}
 	} else {
@@ -209,12 +258,15 @@ func (c *demoClient) HasSourceAt(ctx context.Context, filter *model.HasSourceAtS
 				}
 				out, err = c.addSrcIfMatch(ctx, out, filter, link)
 				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+					return nil, errext.Errorf("%v :: %v", funcName, err)
 				}
 			}
 		}
 	}
 
+	if filter != nil {
+		helper.SortHasSourceAts(out, filter.Order)
+	}
 	return out, nil
 }
 
@@ -243,13 +295,13 @@ func (c *demoClient) buildHasSourceAt(ctx context.Context, link *srcMapLink, fil
 	}
 	// if package not found during ingestion or if ID is provided in filter, send error. On query do not send error to continue search
 	if p == nil && ingestOrIDProvided {
-		return nil, gqlerror.Errorf("failed to retrieve package via packageID")
+		return nil, errext.Errorf("failed to retrieve package via packageID")
 	} else if p == nil && !ingestOrIDProvided {
 		return nil, nil
 	}
 	// if source not found during ingestion or if ID is provided in filter, send error. On query do not send error to continue search
 	if s == nil && ingestOrIDProvided {
-		return nil, gqlerror.Errorf("failed to retrieve source via sourceID")
+		return nil, errext.Errorf("failed to retrieve source via sourceID")
 	} else if s == nil && !ingestOrIDProvided {
 		return nil, nil
 	}
@@ -294,3 +346,31 @@ func (c *demoClient) addSrcIfMatch(ctx context.Context, out []*model.HasSourceAt
 	}
 	return append(out, foundHasSourceAt), nil
 }
+
+func (c *demoClient) HasSourceAtCount(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec) (int, error) {
+	results, err := c.HasSourceAt(ctx, &hasSourceAtSpec)
+	if err != nil {
+		return 0, err
+	}
+	return len(results), nil
+}
+
+func (c *demoClient) HasSourceAtList(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec, pagination *model.PaginationSpec) (*model.HasSourceAtConnection, error) {
+	results, err := c.HasSourceAt(ctx, &hasSourceAtSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.HasSourceAt) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("HasSourceAtList :: %v", err)
+	}
+	edges := make([]*model.HasSourceAtEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.HasSourceAtEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.HasSourceAtConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
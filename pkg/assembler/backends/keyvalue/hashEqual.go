@@ -22,9 +22,10 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // Internal hashEqual
@@ -67,7 +68,7 @@ func (c *demoClient) IngestHashEquals(ctx context.Context, artifacts []*model.ID
 	for i := range hashEquals {
 		hashEqual, err := c.IngestHashEqual(ctx, *artifacts[i], *otherArtifacts[i], *hashEquals[i])
 		if err != nil {
-			return nil, gqlerror.Errorf("IngestHashEqual failed with err: %v", err)
+			return nil, errext.Errorf("IngestHashEqual failed with err: %v", err)
 		}
 		modelHashEquals = append(modelHashEquals, hashEqual)
 	}
@@ -91,11 +92,11 @@ func (c *demoClient) ingestHashEqual(ctx context.Context, artifact model.IDorArt
 
 	aInt1, err := c.returnFoundArtifact(ctx, &artifact)
 	if err != nil {
-		return "", gqlerror.Errorf("IngestHashEqual :: Artifact not found")
+		return "", errext.Errorf("IngestHashEqual :: Artifact not found")
 	}
 	aInt2, err := c.returnFoundArtifact(ctx, &equalArtifact)
 	if err != nil {
-		return "", gqlerror.Errorf("IngestHashEqual :: Artifact not found")
+		return "", errext.Errorf("IngestHashEqual :: Artifact not found")
 	}
 	artIDs := []string{aInt1.ThisID, aInt2.ThisID}
 	slices.Sort(artIDs)
@@ -148,7 +149,7 @@ func (c *demoClient) matchArtifacts(ctx context.Context, filter []*model.Artifac
 		} else if aSpec.ID != nil {
 			// We had an id but it didn't match
 			return false
-		} else if aSpec.Algorithm != nil || aSpec.Digest != nil {
+		} else if aSpec.Algorithm != nil || aSpec.Digest != nil || aSpec.DigestPrefix != nil {
 			matchPartial = append(matchPartial, aSpec)
 		}
 	}
@@ -167,7 +168,8 @@ func (c *demoClient) matchArtifacts(ctx context.Context, filter []*model.Artifac
 				return false
 			}
 			if (m.Algorithm == nil || strings.ToLower(*m.Algorithm) == a.Algorithm) &&
-				(m.Digest == nil || strings.ToLower(*m.Digest) == a.Digest) {
+				(m.Digest == nil || strings.ToLower(*m.Digest) == a.Digest) &&
+				!noMatchPrefixContains(toLower(m.DigestPrefix), nil, a.Digest) {
 				match = true
 				remove = i
 				break
@@ -196,7 +198,7 @@ func (c *demoClient) HashEqual(ctx context.Context, filter *model.HashEqualSpec)
 		// If found by id, ignore rest of fields in spec and return as a match
 		he, err := c.convHashEqual(ctx, link)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		return []*model.HashEqual{he}, nil
 	}
@@ -207,7 +209,7 @@ func (c *demoClient) HashEqual(ctx context.Context, filter *model.HashEqualSpec)
 		if !foundOne && a != nil {
 			exactArtifact, err := c.artifactExact(ctx, a)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			if exactArtifact != nil {
 				search = append(search, exactArtifact.HashEquals...)
@@ -222,11 +224,11 @@ func (c *demoClient) HashEqual(ctx context.Context, filter *model.HashEqualSpec)
 		for _, id := range search {
 			link, err := byIDkv[*hashEqualStruct](ctx, id, c)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			out, err = c.addHEIfMatch(ctx, out, filter, link)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 		}
 	} else {
@@ -246,7 +248,7 @@ func (c *demoClient) HashEqual(ctx context.Context, filter *model.HashEqualSpec)
 				}
 				out, err = c.addHEIfMatch(ctx, out, filter, link)
 				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+					return nil, errext.Errorf("%v :: %v", funcName, err)
 				}
 			}
 		}
@@ -290,3 +292,23 @@ func (c *demoClient) addHEIfMatch(ctx context.Context, out []*model.HashEqual,
 	}
 	return append(out, he), nil
 }
+
+func (c *demoClient) HashEqualList(ctx context.Context, hashEqualSpec model.HashEqualSpec, pagination *model.PaginationSpec) (*model.HashEqualConnection, error) {
+	results, err := c.HashEqual(ctx, &hashEqualSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.HashEqual) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("HashEqualList :: %v", err)
+	}
+	edges := make([]*model.HashEqualEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.HashEqualEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.HashEqualConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
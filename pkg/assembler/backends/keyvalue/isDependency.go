@@ -20,9 +20,8 @@ import (
 	"errors"
 	"strings"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -74,7 +73,7 @@ func (c *demoClient) IngestDependencies(ctx context.Context, pkgs []*model.IDorP
 	for i := range dependencies {
 		isDependency, err := c.IngestDependency(ctx, *pkgs[i], *depPkgs[i], depPkgMatchType, *dependencies[i])
 		if err != nil {
-			return nil, gqlerror.Errorf("IngestDependency failed with err: %v", err)
+			return nil, errext.Errorf("IngestDependency failed with err: %v", err)
 		}
 		modelIsDependencies = append(modelIsDependencies, isDependency)
 	}
@@ -106,12 +105,12 @@ func (c *demoClient) ingestDependency(ctx context.Context, packageArg model.IDor
 	var err error
 	inLink.DepPackageID, depPkg, err = c.returnFoundPkgBasedOnMatchType(ctx, &dependentPackageArg, &depPkgMatchType)
 	if err != nil {
-		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+		return "", errext.Errorf("%v ::  %s", funcName, err)
 	}
 
 	foundPkgVersion, err := c.returnFoundPkgVersion(ctx, &packageArg)
 	if err != nil {
-		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+		return "", errext.Errorf("%v ::  %s", funcName, err)
 	}
 	inLink.PackageID = foundPkgVersion.ID()
 
@@ -162,7 +161,7 @@ func (c *demoClient) IsDependency(ctx context.Context, filter *model.IsDependenc
 		}
 		foundIsDependency, err := c.buildIsDependency(ctx, link, filter, true)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		return []*model.IsDependency{foundIsDependency}, nil
 	}
@@ -172,7 +171,7 @@ func (c *demoClient) IsDependency(ctx context.Context, filter *model.IsDependenc
 	if filter != nil && filter.Package != nil {
 		pkgs, err := c.findPackageVersion(ctx, filter.Package)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		foundOne = len(pkgs) > 0
 		for _, pkg := range pkgs {
@@ -186,11 +185,11 @@ func (c *demoClient) IsDependency(ctx context.Context, filter *model.IsDependenc
 		for _, id := range search {
 			link, err := byIDkv[*isDependencyLink](ctx, id, c)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			out, err = c.addDepIfMatch(ctx, out, filter, link)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 		}
 	} else {
@@ -210,7 +209,7 @@ func (c *demoClient) IsDependency(ctx context.Context, filter *model.IsDependenc
 				}
 				out, err = c.addDepIfMatch(ctx, out, filter, link)
 				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+					return nil, errext.Errorf("%v :: %v", funcName, err)
 				}
 			}
 		}
@@ -242,13 +241,13 @@ func (c *demoClient) buildIsDependency(ctx context.Context, link *isDependencyLi
 
 	// if package not found during ingestion or if ID is provided in filter, send error. On query do not send error to continue search
 	if p == nil && ingestOrIDProvided {
-		return nil, gqlerror.Errorf("failed to retrieve package via packageID")
+		return nil, errext.Errorf("failed to retrieve package via packageID")
 	} else if p == nil && !ingestOrIDProvided {
 		return nil, nil
 	}
 	// if dependent package not found during ingestion or if ID is provided in filter, send error. On query do not send error to continue search
 	if dep == nil && ingestOrIDProvided {
-		return nil, gqlerror.Errorf("failed to retrieve dependent package via dependent packageID")
+		return nil, errext.Errorf("failed to retrieve dependent package via dependent packageID")
 	} else if dep == nil && !ingestOrIDProvided {
 		return nil, nil
 	}
@@ -336,3 +335,23 @@ func (c *demoClient) matchDependencies(ctx context.Context, filters []*model.IsD
 	}
 	return true
 }
+
+func (c *demoClient) IsDependencyList(ctx context.Context, isDependencySpec model.IsDependencySpec, pagination *model.PaginationSpec) (*model.IsDependencyConnection, error) {
+	results, err := c.IsDependency(ctx, &isDependencySpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.IsDependency) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("IsDependencyList :: %v", err)
+	}
+	edges := make([]*model.IsDependencyEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.IsDependencyEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.IsDependencyConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
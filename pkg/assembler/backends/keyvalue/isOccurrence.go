@@ -20,9 +20,8 @@ import (
 	"errors"
 	"strings"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -84,13 +83,13 @@ func (c *demoClient) IngestOccurrences(ctx context.Context, subjects model.Packa
 			subject := model.PackageOrSourceInput{Package: subjects.Packages[i]}
 			isOccurrence, err = c.IngestOccurrence(ctx, subject, *artifacts[i], *occurrences[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("ingestOccurrence failed with err: %v", err)
+				return nil, errext.Errorf("ingestOccurrence failed with err: %v", err)
 			}
 		} else {
 			subject := model.PackageOrSourceInput{Source: subjects.Sources[i]}
 			isOccurrence, err = c.IngestOccurrence(ctx, subject, *artifacts[i], *occurrences[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("ingestOccurrence failed with err: %v", err)
+				return nil, errext.Errorf("ingestOccurrence failed with err: %v", err)
 			}
 		}
 		modelIsOccurrences = append(modelIsOccurrences, isOccurrence)
@@ -119,7 +118,7 @@ func (c *demoClient) ingestOccurrence(ctx context.Context, subject model.Package
 
 	a, err := c.returnFoundArtifact(ctx, &artifact)
 	if err != nil {
-		return "", gqlerror.Errorf("%v :: Artifact not found %s", funcName, err)
+		return "", errext.Errorf("%v :: Artifact not found %s", funcName, err)
 	}
 	in.Artifact = a.ThisID
 
@@ -128,7 +127,7 @@ func (c *demoClient) ingestOccurrence(ctx context.Context, subject model.Package
 		var err error
 		pkgVer, err = c.returnFoundPkgVersion(ctx, subject.Package)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		in.Pkg = pkgVer.ThisID
 	}
@@ -138,7 +137,7 @@ func (c *demoClient) ingestOccurrence(ctx context.Context, subject model.Package
 		var err error
 		src, err = c.returnFoundSource(ctx, subject.Source)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		in.Source = src.ThisID
 	}
@@ -227,6 +226,9 @@ func (c *demoClient) artifactMatch(ctx context.Context, aID string, artifactSpec
 	if artifactSpec.Digest != nil && strings.ToLower(*artifactSpec.Digest) != m.Digest {
 		return false
 	}
+	if noMatchPrefixContains(toLower(artifactSpec.DigestPrefix), nil, m.Digest) {
+		return false
+	}
 	if artifactSpec.Algorithm != nil && strings.ToLower(*artifactSpec.Algorithm) != m.Algorithm {
 		return false
 	}
@@ -250,7 +252,7 @@ func (c *demoClient) IsOccurrence(ctx context.Context, filter *model.IsOccurrenc
 		// If found by id, ignore rest of fields in spec and return as a match
 		o, err := c.convOccurrence(ctx, link)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		return []*model.IsOccurrence{o}, nil
 	}
@@ -260,7 +262,7 @@ func (c *demoClient) IsOccurrence(ctx context.Context, filter *model.IsOccurrenc
 	if filter != nil && filter.Artifact != nil {
 		exactArtifact, err := c.artifactExact(ctx, filter.Artifact)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactArtifact != nil {
 			search = append(search, exactArtifact.Occurrences...)
@@ -270,7 +272,7 @@ func (c *demoClient) IsOccurrence(ctx context.Context, filter *model.IsOccurrenc
 	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Package != nil {
 		pkgs, err := c.findPackageVersion(ctx, filter.Subject.Package)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		foundOne = len(pkgs) > 0
 		for _, pkg := range pkgs {
@@ -280,7 +282,7 @@ func (c *demoClient) IsOccurrence(ctx context.Context, filter *model.IsOccurrenc
 	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
 		exactSource, err := c.exactSource(ctx, filter.Subject.Source)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactSource != nil {
 			search = append(search, exactSource.Occurrences...)
@@ -293,11 +295,11 @@ func (c *demoClient) IsOccurrence(ctx context.Context, filter *model.IsOccurrenc
 		for _, id := range search {
 			link, err := byIDkv[*isOccurrenceStruct](ctx, id, c)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			out, err = c.addOccIfMatch(ctx, out, filter, link)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 		}
 	} else {
@@ -317,7 +319,7 @@ func (c *demoClient) IsOccurrence(ctx context.Context, filter *model.IsOccurrenc
 				}
 				out, err = c.addOccIfMatch(ctx, out, filter, link)
 				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+					return nil, errext.Errorf("%v :: %v", funcName, err)
 				}
 			}
 		}
@@ -442,3 +444,23 @@ func (c *demoClient) matchOccurrences(ctx context.Context, filters []*model.IsOc
 	}
 	return true
 }
+
+func (c *demoClient) IsOccurrenceList(ctx context.Context, isOccurrenceSpec model.IsOccurrenceSpec, pagination *model.PaginationSpec) (*model.IsOccurrenceConnection, error) {
+	results, err := c.IsOccurrence(ctx, &isOccurrenceSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.IsOccurrence) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("IsOccurrenceList :: %v", err)
+	}
+	edges := make([]*model.IsOccurrenceEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.IsOccurrenceEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.IsOccurrenceConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
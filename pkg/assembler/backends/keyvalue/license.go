@@ -20,8 +20,8 @@ import (
 	"errors"
 	"strings"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -74,7 +74,7 @@ func (c *demoClient) IngestLicenses(ctx context.Context, licenses []*model.IDorL
 	for _, lic := range licenses {
 		modelLic, err := c.IngestLicense(ctx, lic)
 		if err != nil {
-			return nil, gqlerror.Errorf("ingestLicense failed with err: %v", err)
+			return nil, errext.Errorf("ingestLicense failed with err: %v", err)
 		}
 		modelLicenses = append(modelLicenses, modelLic)
 	}
@@ -162,7 +162,7 @@ func (c *demoClient) Licenses(ctx context.Context, licenseSpec *model.LicenseSpe
 	defer c.m.RUnlock()
 	a, err := c.licenseExact(ctx, licenseSpec)
 	if err != nil {
-		return nil, gqlerror.Errorf("Licenses :: invalid spec %s", err)
+		return nil, errext.Errorf("Licenses :: invalid spec %s", err)
 	}
 	if a != nil {
 		return []*model.License{c.convLicense(a)}, nil
@@ -212,14 +212,34 @@ func (c *demoClient) returnFoundLicense(ctx context.Context, licenseIDorInput *m
 	if licenseIDorInput.LicenseID != nil {
 		licStruct, err := byIDkv[*licStruct](ctx, *licenseIDorInput.LicenseID, c)
 		if err != nil {
-			return nil, gqlerror.Errorf("failed to return licStruct node by ID with error: %v", err)
+			return nil, errext.Errorf("failed to return licStruct node by ID with error: %v", err)
 		}
 		return licStruct, nil
 	} else {
 		licStruct, err := c.licenseByInput(ctx, licenseIDorInput.LicenseInput)
 		if err != nil {
-			return nil, gqlerror.Errorf("failed to licenseByInput with error: %v", err)
+			return nil, errext.Errorf("failed to licenseByInput with error: %v", err)
 		}
 		return licStruct, nil
 	}
 }
+
+func (c *demoClient) LicensesList(ctx context.Context, licenseSpec model.LicenseSpec, pagination *model.PaginationSpec) (*model.LicenseConnection, error) {
+	results, err := c.Licenses(ctx, &licenseSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.License) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("LicensesList :: %v", err)
+	}
+	edges := make([]*model.LicenseEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.LicenseEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.LicenseConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
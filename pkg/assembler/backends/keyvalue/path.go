@@ -17,12 +17,14 @@ package keyvalue
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/kv"
 )
 
 type edgeMap map[model.Edge]bool
@@ -57,6 +59,10 @@ func (c *demoClient) Neighbors(ctx context.Context, source string, usingOnly []m
 	return c.Nodes(ctx, neighbors)
 }
 
+func (c *demoClient) NeighborsRecursive(ctx context.Context, source string, usingOnly []model.Edge, maxDepth int) ([]model.Node, error) {
+	return helper.NeighborsRecursive(ctx, source, usingOnly, maxDepth, c.Neighbors)
+}
+
 func (c *demoClient) neighborsFromId(ctx context.Context, id string, allowedEdges edgeMap) ([]string, error) {
 	var k string
 	if err := c.kv.Get(ctx, indexCol, id, &k); err != nil {
@@ -99,8 +105,10 @@ func (c *demoClient) bfs(ctx context.Context, from, to string, maxLength int, al
 			break
 		}
 
+		// nodes at the maximum length are not expanded, but the target may
+		// still be queued after them at the same depth
 		if nowNode.depth >= maxLength {
-			break
+			continue
 		}
 
 		neighbors, err := c.neighborsFromId(ctx, now, allowedEdges)
@@ -127,7 +135,7 @@ func (c *demoClient) bfs(ctx context.Context, from, to string, maxLength int, al
 	}
 
 	if !found {
-		return nil, gqlerror.Errorf("No path found up to specified length")
+		return nil, nil
 	}
 
 	reversedPath := []string{}
@@ -168,7 +176,7 @@ func (c *demoClient) Node(ctx context.Context, id string) (model.Node, error) {
 
 	out, err := node.BuildModelNode(ctx, c)
 	if err != nil {
-		return nil, gqlerror.Errorf("Node: could not build node: %v", err)
+		return nil, errext.Errorf("Node: could not build node: %v", err)
 	}
 
 	return out, nil
@@ -185,3 +193,85 @@ func (c *demoClient) Nodes(ctx context.Context, ids []string) ([]model.Node, err
 	}
 	return rv, nil
 }
+
+func (c *demoClient) BatchNodes(ctx context.Context, ids []string) ([]model.Node, error) {
+	rv := make([]model.Node, 0, len(ids))
+	for _, id := range ids {
+		n, err := c.Node(ctx, id)
+		if err != nil {
+			if !errors.Is(err, kv.NotFoundError) {
+				return nil, err
+			}
+			n = nil
+		}
+		rv = append(rv, n)
+	}
+	return rv, nil
+}
+
+// NodeType returns the type of the node from the collection the index maps
+// its ID to, without reading the node itself.
+func (c *demoClient) NodeType(ctx context.Context, id string) (string, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+
+	var k string
+	if err := c.kv.Get(ctx, indexCol, id, &k); err != nil {
+		return "", fmt.Errorf("%w : id not found in index %q", err, id)
+	}
+	col, _, ok := strings.Cut(k, ":")
+	if !ok {
+		return "", fmt.Errorf("Bad value was stored in index map: %v", k)
+	}
+	switch col {
+	case artCol:
+		return "Artifact", nil
+	case pkgTypeCol, pkgNSCol, pkgNameCol, pkgVerCol:
+		return "Package", nil
+	case srcTypeCol, srcNSCol, srcNameCol:
+		return "Source", nil
+	case vulnTypeCol, vulnIDCol:
+		return "Vulnerability", nil
+	case builderCol:
+		return "Builder", nil
+	case licenseCol:
+		return "License", nil
+	case cbCol:
+		return "CertifyBad", nil
+	case cgCol:
+		return "CertifyGood", nil
+	case clCol:
+		return "CertifyLegal", nil
+	case cscCol:
+		return "CertifyScorecard", nil
+	case cVEXCol:
+		return "CertifyVEXStatement", nil
+	case cVulnCol:
+		return "CertifyVuln", nil
+	case hashEqCol:
+		return "HashEqual", nil
+	case hasMDCol:
+		return "HasMetadata", nil
+	case hasSBOMCol:
+		return "HasSBOM", nil
+	case slsaCol:
+		return "HasSLSA", nil
+	case hsaCol:
+		return "HasSourceAt", nil
+	case isDepCol:
+		return "IsDependency", nil
+	case occCol:
+		return "IsOccurrence", nil
+	case pkgEqCol:
+		return "PkgEqual", nil
+	case pocCol:
+		return "PointOfContact", nil
+	case vulnEqCol:
+		return "VulnEqual", nil
+	case vulnMDCol:
+		return "VulnerabilityMetadata", nil
+	case exploitCol:
+		return "ExploitReference", nil
+	}
+	return "", fmt.Errorf("unknown collection %q for id %q", col, id)
+}
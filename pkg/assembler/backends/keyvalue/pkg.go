@@ -23,9 +23,8 @@ import (
 	"slices"
 	"strings"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -60,6 +59,7 @@ type pkgVersion struct {
 	Version             string
 	Subpath             string
 	Qualifiers          map[string]string
+	ComponentType       string
 	SrcMapLinks         []string
 	IsDependencyLinks   []string
 	Occurrences         []string
@@ -239,6 +239,11 @@ func (p *pkgVersion) setVulnerabilityLinks(ctx context.Context, id string, c *de
 	return setkv(ctx, pkgVerCol, p, c)
 }
 
+func (p *pkgVersion) unsetVulnerabilityLinks(ctx context.Context, id string, c *demoClient) error {
+	p.CertifyVulnLinks = removeLink(p.CertifyVulnLinks, id)
+	return setkv(ctx, pkgVerCol, p, c)
+}
+
 // certifyVexStatement back edges
 func (p *pkgVersion) setVexLinks(ctx context.Context, id string, c *demoClient) error {
 	p.VexLinks = append(p.VexLinks, id)
@@ -357,7 +362,7 @@ func (c *demoClient) IngestPackages(ctx context.Context, pkgs []*model.IDorPkgIn
 	for _, pkg := range pkgs {
 		modelPkg, err := c.IngestPackage(ctx, *pkg)
 		if err != nil {
-			return nil, gqlerror.Errorf("ingestPackage failed with err: %v", err)
+			return nil, errext.Errorf("ingestPackage failed with err: %v", err)
 		}
 		modelPkgs = append(modelPkgs, modelPkg)
 	}
@@ -467,6 +472,9 @@ func (c *demoClient) IngestPackage(ctx context.Context, input model.IDorPkgInput
 		Version:    nilToEmpty(input.PackageInput.Version),
 		Subpath:    nilToEmpty(input.PackageInput.Subpath),
 		Qualifiers: getQualifiersFromInput(input.PackageInput.Qualifiers),
+		// ComponentType is not part of the key, so it is only recorded
+		// when the version is first ingested.
+		ComponentType: strings.ToLower(nilToEmpty(input.PackageInput.ComponentType)),
 	}
 	c.m.RLock()
 	outVersion, err := byKeykv[*pkgVersion](ctx, pkgVerCol, inVersion.Key(), c)
@@ -520,6 +528,351 @@ func hashVersionHelper(version string, subpath string, qualifiers map[string]str
 	// return fmt.Sprintf("%x", digest)
 }
 
+// Merge Packages
+
+func (c *demoClient) MergePackages(ctx context.Context, primary string, duplicates []string) (*model.Package, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	funcName := "MergePackages"
+
+	if slices.Contains(duplicates, primary) {
+		return nil, errext.Errorf("%v :: the primary package %q is also listed as a duplicate", funcName, primary)
+	}
+	// check all the IDs before changing anything
+	_, err := byIDkv[*pkgVersion](ctx, primary, c)
+	mergeVersions := err == nil
+	if !mergeVersions {
+		if _, err := byIDkv[*pkgName](ctx, primary, c); err != nil {
+			return nil, errext.Errorf("%v :: package version or name with id %q not found", funcName, primary)
+		}
+	}
+	for _, id := range duplicates {
+		if mergeVersions {
+			_, err = byIDkv[*pkgVersion](ctx, id, c)
+		} else {
+			_, err = byIDkv[*pkgName](ctx, id, c)
+		}
+		if err != nil {
+			return nil, errext.Errorf("%v :: duplicate %q is not a package node of the same kind as %q", funcName, id, primary)
+		}
+	}
+
+	// replaced maps the merged packages and the evidence dropped as a copy of
+	// the primary's to the node that takes their place, for the SBOMs that
+	// include them
+	replaced := map[string]string{}
+	for _, id := range duplicates {
+		if mergeVersions {
+			err = c.mergePkgVersion(ctx, primary, id, replaced)
+		} else {
+			err = c.mergePkgName(ctx, primary, id, replaced)
+		}
+		if err != nil {
+			return nil, errext.Errorf("%v :: %v", funcName, err)
+		}
+	}
+	if err := c.replaceIncluded(ctx, replaced); err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	p, err := c.buildPackageResponse(ctx, primary, nil)
+	if err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	if !mergeVersions {
+		// include the versions the primary name now has
+		name, err := byIDkv[*pkgName](ctx, primary, c)
+		if err != nil {
+			return nil, errext.Errorf("%v :: %v", funcName, err)
+		}
+		p.Namespaces[0].Names[0].Versions = c.buildPkgVersion(ctx, name, nil)
+	}
+	return p, nil
+}
+
+// mergePkgVersion moves the evidence of the version dupID to the version
+// primaryID and deletes dupID.
+func (c *demoClient) mergePkgVersion(ctx context.Context, primaryID, dupID string, replaced map[string]string) error {
+	dup, err := byIDkv[*pkgVersion](ctx, dupID, c)
+	if err != nil {
+		return err
+	}
+	kept, err := c.moveLinks(ctx, dupID, primaryID, linkLists(dup), replaced)
+	if err != nil {
+		return err
+	}
+	if err := c.moveArchivedCertifyVulns(ctx, dupID, primaryID); err != nil {
+		return err
+	}
+	// moving the links can change the stored primary
+	p, err := byIDkv[*pkgVersion](ctx, primaryID, c)
+	if err != nil {
+		return err
+	}
+	addLinks(p, dup, kept)
+	if err := setkv(ctx, pkgVerCol, p, c); err != nil {
+		return err
+	}
+
+	if err := delkv(ctx, pkgVerCol, dup, c); err != nil {
+		return err
+	}
+	if err := c.removeFromIndex(ctx, dupID); err != nil {
+		return err
+	}
+	name, err := byIDkv[*pkgName](ctx, dup.Parent, c)
+	if err != nil {
+		return err
+	}
+	name.Versions = removeLink(name.Versions, dupID)
+	if err := setkv(ctx, pkgNameCol, name, c); err != nil {
+		return err
+	}
+	replaced[dupID] = primaryID
+	return nil
+}
+
+// mergePkgName moves the versions and evidence of the name dupID to the name
+// primaryID and deletes dupID, along with its namespace and type if they are
+// left empty. Versions that the primary already has are merged into the
+// primary's.
+func (c *demoClient) mergePkgName(ctx context.Context, primaryID, dupID string, replaced map[string]string) error {
+	dup, err := byIDkv[*pkgName](ctx, dupID, c)
+	if err != nil {
+		return err
+	}
+	var moved []string
+	// merging a version updates the stored dup
+	for _, v := range slices.Clone(dup.Versions) {
+		version, err := byIDkv[*pkgVersion](ctx, v, c)
+		if err != nil {
+			return err
+		}
+		target := *version
+		target.Parent = primaryID
+		existing, err := byKeykv[*pkgVersion](ctx, pkgVerCol, target.Key(), c)
+		if err == nil {
+			if err := c.mergePkgVersion(ctx, existing.ThisID, v, replaced); err != nil {
+				return err
+			}
+			continue
+		}
+		if !errors.Is(err, kv.NotFoundError) {
+			return err
+		}
+		if err := delkv(ctx, pkgVerCol, version, c); err != nil {
+			return err
+		}
+		version.Parent = primaryID
+		if err := setkv(ctx, pkgVerCol, version, c); err != nil {
+			return err
+		}
+		if err := c.addToIndex(ctx, pkgVerCol, version); err != nil {
+			return err
+		}
+		moved = append(moved, v)
+	}
+	kept, err := c.moveLinks(ctx, dupID, primaryID, linkLists(dup), replaced)
+	if err != nil {
+		return err
+	}
+	p, err := byIDkv[*pkgName](ctx, primaryID, c)
+	if err != nil {
+		return err
+	}
+	p.Versions = append(p.Versions, moved...)
+	addLinks(p, dup, kept)
+	if err := setkv(ctx, pkgNameCol, p, c); err != nil {
+		return err
+	}
+
+	if err := delkv(ctx, pkgNameCol, dup, c); err != nil {
+		return err
+	}
+	if err := c.removeFromIndex(ctx, dupID); err != nil {
+		return err
+	}
+	replaced[dupID] = primaryID
+	namespace, err := byIDkv[*pkgNamespace](ctx, dup.Parent, c)
+	if err != nil {
+		return err
+	}
+	namespace.Names = removeLink(namespace.Names, dupID)
+	if len(namespace.Names) > 0 {
+		return setkv(ctx, pkgNSCol, namespace, c)
+	}
+	if err := delkv(ctx, pkgNSCol, namespace, c); err != nil {
+		return err
+	}
+	if err := c.removeFromIndex(ctx, namespace.ThisID); err != nil {
+		return err
+	}
+	pkgTypeNode, err := byIDkv[*pkgType](ctx, namespace.Parent, c)
+	if err != nil {
+		return err
+	}
+	pkgTypeNode.Namespaces = removeLink(pkgTypeNode.Namespaces, namespace.ThisID)
+	if len(pkgTypeNode.Namespaces) > 0 {
+		return setkv(ctx, pkgTypeCol, pkgTypeNode, c)
+	}
+	if err := delkv(ctx, pkgTypeCol, pkgTypeNode, c); err != nil {
+		return err
+	}
+	return c.removeFromIndex(ctx, pkgTypeNode.ThisID)
+}
+
+// moveLinks points the evidence nodes in links from the package node from to
+// the package node to, and returns the ones that were kept. An evidence node
+// that becomes a copy of one the primary already has is deleted, as is a
+// PkgEqual between the two packages.
+func (c *demoClient) moveLinks(ctx context.Context, from, to string, links []string, replaced map[string]string) (map[string]bool, error) {
+	kept := map[string]bool{}
+	for _, id := range links {
+		if _, ok := replaced[id]; ok || kept[id] {
+			// a link can be in several lists, e.g. a dependency of a
+			// package on itself
+			continue
+		}
+		col, stored, err := c.nodeByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		// the store can hand out the stored node itself, which must keep its
+		// key until it is deleted
+		link := reflect.New(reflect.TypeOf(stored).Elem()).Interface().(node)
+		reflect.ValueOf(link).Elem().Set(reflect.ValueOf(stored).Elem())
+		replacePkgID(link, from, to)
+		if pe, ok := link.(*pkgEqualStruct); ok && len(pe.Pkgs) < 2 {
+			if err := c.deleteLinkNode(ctx, id, ""); err != nil {
+				return nil, err
+			}
+			replaced[id] = ""
+			continue
+		}
+		existing := typeColMap(col)
+		err = c.kv.Get(ctx, col, link.Key(), &existing)
+		if err == nil && existing.ID() != id {
+			if err := c.deleteLinkNode(ctx, id, from); err != nil {
+				return nil, err
+			}
+			replaced[id] = existing.ID()
+			continue
+		}
+		if err != nil && !errors.Is(err, kv.NotFoundError) {
+			return nil, err
+		}
+		if err := c.kv.Delete(ctx, col, stored.Key()); err != nil {
+			return nil, err
+		}
+		if err := setkv(ctx, col, link, c); err != nil {
+			return nil, err
+		}
+		if err := c.addToIndex(ctx, col, link); err != nil {
+			return nil, err
+		}
+		kept[id] = true
+	}
+	return kept, nil
+}
+
+// moveArchivedCertifyVulns points the archived certifyVulns of the version
+// from to the version to. The archive is history, so copies are kept.
+func (c *demoClient) moveArchivedCertifyVulns(ctx context.Context, from, to string) error {
+	var done bool
+	scn := c.kv.Keys(cVulnArchiveCol)
+	for !done {
+		var keys []string
+		var err error
+		keys, done, err = scn.Scan(ctx)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			link, err := byKeykv[*certifyVulnerabilityLink](ctx, cVulnArchiveCol, key, c)
+			if err != nil {
+				return err
+			}
+			if link.PackageID != from {
+				continue
+			}
+			moved := *link
+			moved.PackageID = to
+			if err := c.kv.Set(ctx, cVulnArchiveCol, key, &moved); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// the fields of the evidence nodes that hold package IDs
+var (
+	pkgIDFields     = []string{"PackageID", "DepPackageID", "Pkg"}
+	pkgIDListFields = []string{"Pkgs", "IncludedSoftware"}
+)
+
+// replacePkgID points the package fields of link that hold from to to. The
+// lists of packages are kept sorted and without duplicates, as when ingested.
+func replacePkgID(link node, from, to string) {
+	v := reflect.ValueOf(link).Elem()
+	for _, name := range pkgIDFields {
+		if f := v.FieldByName(name); f.IsValid() && f.String() == from {
+			f.SetString(to)
+		}
+	}
+	for _, name := range pkgIDListFields {
+		f := v.FieldByName(name)
+		if !f.IsValid() {
+			continue
+		}
+		ids := f.Interface().([]string)
+		if !slices.Contains(ids, from) {
+			continue
+		}
+		ids = slices.Clone(ids)
+		for i := range ids {
+			if ids[i] == from {
+				ids[i] = to
+			}
+		}
+		f.Set(reflect.ValueOf(helper.SortAndRemoveDups(ids)))
+	}
+}
+
+// linkLists returns the IDs in the back edge lists of a package name or
+// version.
+func linkLists(n node) []string {
+	var out []string
+	v := reflect.ValueOf(n).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).Name == "Versions" {
+			continue
+		}
+		if f := v.Field(i); f.Type() == reflect.TypeOf([]string(nil)) {
+			out = append(out, f.Interface().([]string)...)
+		}
+	}
+	return out
+}
+
+// addLinks appends the back edges of from that are in kept to the same lists
+// of to, which must be of the same type.
+func addLinks(to, from node, kept map[string]bool) {
+	tv := reflect.ValueOf(to).Elem()
+	fv := reflect.ValueOf(from).Elem()
+	for i := 0; i < fv.NumField(); i++ {
+		if fv.Type().Field(i).Name == "Versions" || fv.Field(i).Type() != reflect.TypeOf([]string(nil)) {
+			continue
+		}
+		links := tv.Field(i).Interface().([]string)
+		for _, id := range fv.Field(i).Interface().([]string) {
+			if kept[id] && !slices.Contains(links, id) {
+				links = append(links, id)
+			}
+		}
+		tv.Field(i).Set(reflect.ValueOf(links))
+	}
+}
+
 // Query Package
 func (c *demoClient) Packages(ctx context.Context, filter *model.PkgSpec) ([]*model.Package, error) {
 	c.m.RLock()
@@ -578,6 +931,9 @@ func (c *demoClient) Packages(ctx context.Context, filter *model.PkgSpec) ([]*mo
 			}
 		}
 	}
+	if filter != nil {
+		return helper.SortPackages(out, filter.Order), nil
+	}
 	return out, nil
 }
 
@@ -792,7 +1148,7 @@ func (c *demoClient) getPackageNameFromInput(ctx context.Context, input model.Pk
 	}
 	pkgT, err := byKeykv[*pkgType](ctx, pkgTypeCol, inType.Key(), c)
 	if err != nil {
-		return nil, gqlerror.Errorf("Package type \"%s\" not found", input.Type)
+		return nil, errext.Errorf("Package type \"%s\" not found", input.Type)
 	}
 
 	inNS := &pkgNamespace{
@@ -801,7 +1157,7 @@ func (c *demoClient) getPackageNameFromInput(ctx context.Context, input model.Pk
 	}
 	pkgNS, err := byKeykv[*pkgNamespace](ctx, pkgNSCol, inNS.Key(), c)
 	if err != nil {
-		return nil, gqlerror.Errorf("Package namespace \"%s\" not found", nilToEmpty(input.Namespace))
+		return nil, errext.Errorf("Package namespace \"%s\" not found", nilToEmpty(input.Namespace))
 	}
 
 	inName := &pkgName{
@@ -810,7 +1166,7 @@ func (c *demoClient) getPackageNameFromInput(ctx context.Context, input model.Pk
 	}
 	pkgN, err := byKeykv[*pkgName](ctx, pkgNameCol, inName.Key(), c)
 	if err != nil {
-		return nil, gqlerror.Errorf("Package name \"%s\" not found", input.Name)
+		return nil, errext.Errorf("Package name \"%s\" not found", input.Name)
 	}
 
 	return pkgN, nil
@@ -819,7 +1175,7 @@ func (c *demoClient) getPackageNameFromInput(ctx context.Context, input model.Pk
 func (c *demoClient) getPackageVerFromInput(ctx context.Context, input model.PkgInputSpec) (*pkgVersion, error) {
 	pkgN, err := c.getPackageNameFromInput(ctx, input)
 	if err != nil {
-		return nil, gqlerror.Errorf("Package name \"%s\" not found", input.Name)
+		return nil, errext.Errorf("Package name \"%s\" not found", input.Name)
 	}
 
 	inVer := &pkgVersion{
@@ -830,7 +1186,7 @@ func (c *demoClient) getPackageVerFromInput(ctx context.Context, input model.Pkg
 	}
 	pkgVer, err := byKeykv[*pkgVersion](ctx, pkgVerCol, inVer.Key(), c)
 	if err != nil {
-		return nil, gqlerror.Errorf("No package matches input")
+		return nil, errext.Errorf("No package matches input")
 	}
 	return pkgVer, nil
 }
@@ -1047,13 +1403,13 @@ func (c *demoClient) returnFoundPkgVersion(ctx context.Context, pkgIDorInput *mo
 	if pkgIDorInput.PackageVersionID != nil {
 		foundPkgVersionNode, err := byIDkv[*pkgVersion](ctx, *pkgIDorInput.PackageVersionID, c)
 		if err != nil {
-			return nil, gqlerror.Errorf("failed to return pkgVersion node by ID with error: %v", err)
+			return nil, errext.Errorf("failed to return pkgVersion node by ID with error: %v", err)
 		}
 		return foundPkgVersionNode, nil
 	} else {
 		foundPkgVersionNode, err := c.getPackageVerFromInput(ctx, *pkgIDorInput.PackageInput)
 		if err != nil {
-			return nil, gqlerror.Errorf("failed to getPackageVerFromInput with error: %v", err)
+			return nil, errext.Errorf("failed to getPackageVerFromInput with error: %v", err)
 		}
 		return foundPkgVersionNode, nil
 	}
@@ -1065,21 +1421,52 @@ func (c *demoClient) returnFoundPkgBasedOnMatchType(ctx context.Context, pkgIDor
 		if pkgMatchType.Pkg == model.PkgMatchTypeSpecificVersion {
 			foundPkgVersionNode, err := byIDkv[*pkgVersion](ctx, *pkgIDorInput.PackageVersionID, c)
 			if err != nil {
-				return "", nil, gqlerror.Errorf("failed to return pkgVersion node by ID with error: %v", err)
+				return "", nil, errext.Errorf("failed to return pkgVersion node by ID with error: %v", err)
 			}
 			return *pkgIDorInput.PackageVersionID, foundPkgVersionNode, nil
 		} else {
 			foundPkgNameNode, err := byIDkv[*pkgName](ctx, *pkgIDorInput.PackageNameID, c)
 			if err != nil {
-				return "", nil, gqlerror.Errorf("failed to return pkgName node by ID with error: %v", err)
+				return "", nil, errext.Errorf("failed to return pkgName node by ID with error: %v", err)
 			}
 			return *pkgIDorInput.PackageNameID, foundPkgNameNode, nil
 		}
 	} else {
 		foundPkgNameorVersionNode, err := c.getPackageNameOrVerFromInput(ctx, *pkgIDorInput.PackageInput, *pkgMatchType)
 		if err != nil {
-			return "", nil, gqlerror.Errorf("failed to getPackageNameOrVerFromInput with error: %v", err)
+			return "", nil, errext.Errorf("failed to getPackageNameOrVerFromInput with error: %v", err)
 		}
 		return foundPkgNameorVersionNode.ID(), foundPkgNameorVersionNode, nil
 	}
 }
+
+func (c *demoClient) PackagesCount(ctx context.Context, pkgSpec model.PkgSpec) (int, error) {
+	results, err := c.Packages(ctx, &pkgSpec)
+	if err != nil {
+		return 0, err
+	}
+	// count package versions, as PackagesList pages over them
+	return len(helper.SplitPackageVersions(results)), nil
+}
+
+func (c *demoClient) PackagesList(ctx context.Context, pkgSpec model.PkgSpec, pagination *model.PaginationSpec) (*model.PackageConnection, error) {
+	results, err := c.Packages(ctx, &pkgSpec)
+	if err != nil {
+		return nil, err
+	}
+	// page over package versions, each returned as its own package tree
+	versions := helper.SplitPackageVersions(results)
+	page, pageInfo, err := helper.PaginateSlice(versions, helper.PackageVersionID, pagination)
+	if err != nil {
+		return nil, errext.Errorf("PackagesList :: %v", err)
+	}
+	edges := make([]*model.PackageEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.PackageEdge{Cursor: helper.PackageVersionID(n), Node: n})
+	}
+	return &model.PackageConnection{
+		TotalCount: len(versions),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
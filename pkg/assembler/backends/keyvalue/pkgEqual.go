@@ -22,9 +22,10 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 type pkgEqualStruct struct {
@@ -65,7 +66,7 @@ func (c *demoClient) IngestPkgEquals(ctx context.Context, pkgs []*model.IDorPkgI
 	for i := range pkgEquals {
 		pkgEqual, err := c.IngestPkgEqual(ctx, *pkgs[i], *otherPackages[i], *pkgEquals[i])
 		if err != nil {
-			return nil, gqlerror.Errorf("IngestPkgEqual failed with err: %v", err)
+			return nil, errext.Errorf("IngestPkgEqual failed with err: %v", err)
 		}
 		modelPkgEqualsIDs = append(modelPkgEqualsIDs, pkgEqual)
 	}
@@ -112,7 +113,7 @@ func (c *demoClient) ingestPkgEqual(ctx context.Context, pkg model.IDorPkgInput,
 	for _, pi := range []model.IDorPkgInput{pkg, depPkg} {
 		p, err := c.returnFoundPkgVersion(ctx, &pi)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		ps = append(ps, p)
 		pIDs = append(pIDs, p.ThisID)
@@ -166,7 +167,7 @@ func (c *demoClient) PkgEqual(ctx context.Context, filter *model.PkgEqualSpec) (
 		// If found by id, ignore rest of fields in spec and return as a match
 		pe, err := c.convPkgEqual(ctx, link)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		return []*model.PkgEqual{pe}, nil
 	}
@@ -175,7 +176,7 @@ func (c *demoClient) PkgEqual(ctx context.Context, filter *model.PkgEqualSpec) (
 	for _, p := range filter.Packages {
 		pkgs, err := c.findPackageVersion(ctx, p)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		for _, pkg := range pkgs {
 			search = append(search, pkg.PkgEquals...)
@@ -187,11 +188,11 @@ func (c *demoClient) PkgEqual(ctx context.Context, filter *model.PkgEqualSpec) (
 		for _, id := range search {
 			link, err := byIDkv[*pkgEqualStruct](ctx, id, c)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			out, err = c.addCPIfMatch(ctx, out, filter, link)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 		}
 	} else {
@@ -211,7 +212,7 @@ func (c *demoClient) PkgEqual(ctx context.Context, filter *model.PkgEqualSpec) (
 				}
 				out, err = c.addCPIfMatch(ctx, out, filter, link)
 				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+					return nil, errext.Errorf("%v :: %v", funcName, err)
 				}
 			}
 		}
@@ -255,3 +256,23 @@ func (c *demoClient) addCPIfMatch(ctx context.Context, out []*model.PkgEqual,
 	}
 	return append(out, pe), nil
 }
+
+func (c *demoClient) PkgEqualList(ctx context.Context, pkgEqualSpec model.PkgEqualSpec, pagination *model.PaginationSpec) (*model.PkgEqualConnection, error) {
+	results, err := c.PkgEqual(ctx, &pkgEqualSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.PkgEqual) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("PkgEqualList :: %v", err)
+	}
+	edges := make([]*model.PkgEqualEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.PkgEqualEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.PkgEqualConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
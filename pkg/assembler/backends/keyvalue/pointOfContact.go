@@ -21,8 +21,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -88,19 +88,19 @@ func (c *demoClient) IngestPointOfContacts(ctx context.Context, subjects model.P
 			subject := model.PackageSourceOrArtifactInput{Package: subjects.Packages[i]}
 			pointOfContact, err = c.IngestPointOfContact(ctx, subject, pkgMatchType, *pointOfContacts[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestPointOfContact failed with err: %v", err)
+				return nil, errext.Errorf("IngestPointOfContact failed with err: %v", err)
 			}
 		} else if len(subjects.Sources) > 0 {
 			subject := model.PackageSourceOrArtifactInput{Source: subjects.Sources[i]}
 			pointOfContact, err = c.IngestPointOfContact(ctx, subject, pkgMatchType, *pointOfContacts[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestPointOfContact failed with err: %v", err)
+				return nil, errext.Errorf("IngestPointOfContact failed with err: %v", err)
 			}
 		} else {
 			subject := model.PackageSourceOrArtifactInput{Artifact: subjects.Artifacts[i]}
 			pointOfContact, err = c.IngestPointOfContact(ctx, subject, pkgMatchType, *pointOfContacts[i])
 			if err != nil {
-				return nil, gqlerror.Errorf("IngestPointOfContact failed with err: %v", err)
+				return nil, errext.Errorf("IngestPointOfContact failed with err: %v", err)
 			}
 		}
 		modelPointOfContactIDs = append(modelPointOfContactIDs, pointOfContact)
@@ -135,20 +135,20 @@ func (c *demoClient) ingestPointOfContact(ctx context.Context, subject model.Pac
 		var err error
 		in.PackageID, foundPkgNameOrVersionNode, err = c.returnFoundPkgBasedOnMatchType(ctx, subject.Package, pkgMatchType)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 	} else if subject.Artifact != nil {
 		var err error
 		foundArtStruct, err = c.returnFoundArtifact(ctx, subject.Artifact)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		in.ArtifactID = foundArtStruct.ID()
 	} else {
 		var err error
 		srcName, err = c.returnFoundSource(ctx, subject.Source)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		in.SourceID = srcName.ID()
 	}
@@ -195,6 +195,58 @@ func (c *demoClient) ingestPointOfContact(ctx context.Context, subject model.Pac
 	return in.ThisID, nil
 }
 
+// Update PointOfContact
+
+func (c *demoClient) UpdatePointOfContact(ctx context.Context, id string, pointOfContact model.PointOfContactInputSpec) (*model.PointOfContact, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	funcName := "UpdatePointOfContact"
+
+	link, err := byIDkv[*pointOfContactLink](ctx, id, c)
+	if err != nil {
+		return nil, errext.Errorf("%v :: pointOfContact with id %q not found", funcName, id)
+	}
+
+	updated := &pointOfContactLink{
+		ThisID:        link.ThisID,
+		PackageID:     link.PackageID,
+		ArtifactID:    link.ArtifactID,
+		SourceID:      link.SourceID,
+		Email:         pointOfContact.Email,
+		Info:          pointOfContact.Info,
+		Since:         pointOfContact.Since.UTC(),
+		Justification: pointOfContact.Justification,
+		Origin:        pointOfContact.Origin,
+		Collector:     pointOfContact.Collector,
+		DocumentRef:   pointOfContact.DocumentRef,
+	}
+	// building the node checks that the linked subject still exists
+	out, err := c.buildPointOfContact(ctx, updated, nil, true)
+	if err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	if updated.Key() == link.Key() {
+		return out, nil
+	}
+
+	// the fields are part of the key, so the node moves to its new key
+	if _, err := byKeykv[*pointOfContactLink](ctx, pocCol, updated.Key(), c); err == nil {
+		return nil, errext.Errorf("%v :: update conflicts with an existing pointOfContact", funcName)
+	} else if !errors.Is(err, kv.NotFoundError) {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	if err := delkv(ctx, pocCol, link, c); err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	if err := c.addToIndex(ctx, pocCol, updated); err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	if err := setkv(ctx, pocCol, updated, c); err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
 // Query PointOfContact
 func (c *demoClient) PointOfContact(ctx context.Context, filter *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
 	funcName := "PointOfContact"
@@ -210,7 +262,7 @@ func (c *demoClient) PointOfContact(ctx context.Context, filter *model.PointOfCo
 		}
 		found, err := c.buildPointOfContact(ctx, link, filter, true)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		return []*model.PointOfContact{found}, nil
 	}
@@ -222,7 +274,7 @@ func (c *demoClient) PointOfContact(ctx context.Context, filter *model.PointOfCo
 	if filter != nil && filter.Subject != nil && filter.Subject.Artifact != nil {
 		exactArtifact, err := c.artifactExact(ctx, filter.Subject.Artifact)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactArtifact != nil {
 			search = append(search, exactArtifact.PointOfContactLinks...)
@@ -232,7 +284,7 @@ func (c *demoClient) PointOfContact(ctx context.Context, filter *model.PointOfCo
 	if !foundOne && filter != nil && filter.Subject != nil && filter.Subject.Source != nil {
 		exactSource, err := c.exactSource(ctx, filter.Subject.Source)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactSource != nil {
 			search = append(search, exactSource.PointOfContactLinks...)
@@ -245,11 +297,11 @@ func (c *demoClient) PointOfContact(ctx context.Context, filter *model.PointOfCo
 		for _, id := range search {
 			link, err := byIDkv[*pointOfContactLink](ctx, id, c)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			out, err = c.addPOCIfMatch(ctx, out, filter, link)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 		}
 	} else {
@@ -269,7 +321,7 @@ func (c *demoClient) PointOfContact(ctx context.Context, filter *model.PointOfCo
 				}
 				out, err = c.addPOCIfMatch(ctx, out, filter, link)
 				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+					return nil, errext.Errorf("%v :: %v", funcName, err)
 				}
 			}
 		}
@@ -361,7 +413,7 @@ func (c *demoClient) buildPointOfContact(ctx context.Context, link *pointOfConta
 	var subj model.PackageSourceOrArtifact
 	if link.PackageID != "" {
 		if p == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve package via packageID")
+			return nil, errext.Errorf("failed to retrieve package via packageID")
 		} else if p == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
@@ -369,7 +421,7 @@ func (c *demoClient) buildPointOfContact(ctx context.Context, link *pointOfConta
 	}
 	if link.ArtifactID != "" {
 		if a == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve artifact via artifactID")
+			return nil, errext.Errorf("failed to retrieve artifact via artifactID")
 		} else if a == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
@@ -377,7 +429,7 @@ func (c *demoClient) buildPointOfContact(ctx context.Context, link *pointOfConta
 	}
 	if link.SourceID != "" {
 		if s == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve source via sourceID")
+			return nil, errext.Errorf("failed to retrieve source via sourceID")
 		} else if s == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
@@ -397,3 +449,23 @@ func (c *demoClient) buildPointOfContact(ctx context.Context, link *pointOfConta
 	}
 	return &pointOfContact, nil
 }
+
+func (c *demoClient) PointOfContactList(ctx context.Context, pointOfContactSpec model.PointOfContactSpec, pagination *model.PaginationSpec) (*model.PointOfContactConnection, error) {
+	results, err := c.PointOfContact(ctx, &pointOfContactSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.PointOfContact) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("PointOfContactList :: %v", err)
+	}
+	edges := make([]*model.PointOfContactEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.PointOfContactEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.PointOfContactConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
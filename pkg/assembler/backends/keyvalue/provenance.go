@@ -0,0 +1,63 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func (c *demoClient) GetProvenance(ctx context.Context, pkgID string) (*model.ProvenanceSummary, error) {
+	funcName := "GetProvenance"
+	pkgSpec := &model.PkgSpec{ID: &pkgID}
+	summary := &model.ProvenanceSummary{}
+	var err error
+
+	if summary.HasSbom, err = c.HasSBOM(ctx, &model.HasSBOMSpec{Subject: &model.PackageOrArtifactSpec{Package: pkgSpec}}); err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	if summary.HasSourceAt, err = c.HasSourceAt(ctx, &model.HasSourceAtSpec{Package: pkgSpec}); err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	if summary.CertifyVulns, err = c.CertifyVuln(ctx, &model.CertifyVulnSpec{Package: pkgSpec}); err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	if summary.CertifyLegal, err = c.CertifyLegal(ctx, &model.CertifyLegalSpec{Subject: &model.PackageOrSourceSpec{Package: pkgSpec}}); err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+	if summary.IsOccurrence, err = c.IsOccurrence(ctx, &model.IsOccurrenceSpec{Subject: &model.PackageOrSourceSpec{Package: pkgSpec}}); err != nil {
+		return nil, errext.Errorf("%v :: %v", funcName, err)
+	}
+
+	// the SLSA attestations are those of the artifacts the package is an
+	// occurrence of
+	seen := map[string]bool{}
+	for _, occurrence := range summary.IsOccurrence {
+		artifactID := occurrence.Artifact.ID
+		if seen[artifactID] {
+			continue
+		}
+		seen[artifactID] = true
+		slsas, err := c.HasSlsa(ctx, &model.HasSLSASpec{Subject: &model.ArtifactSpec{ID: &artifactID}})
+		if err != nil {
+			return nil, errext.Errorf("%v :: %v", funcName, err)
+		}
+		summary.HasSlsa = append(summary.HasSlsa, slsas...)
+	}
+	return summary, nil
+}
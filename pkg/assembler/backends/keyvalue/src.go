@@ -21,8 +21,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -189,7 +189,7 @@ func (c *demoClient) IngestSources(ctx context.Context, sources []*model.IDorSou
 	for _, src := range sources {
 		modelSrc, err := c.IngestSource(ctx, *src)
 		if err != nil {
-			return nil, gqlerror.Errorf("IngestSources failed with err: %v", err)
+			return nil, errext.Errorf("IngestSources failed with err: %v", err)
 		}
 		modelSources = append(modelSources, modelSrc)
 	}
@@ -309,6 +309,82 @@ func (c *demoClient) IngestSource(ctx context.Context, input model.IDorSourceInp
 	}, nil
 }
 
+// Delete Source
+
+func (c *demoClient) DeleteSource(ctx context.Context, id string) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	funcName := "DeleteSource"
+
+	srcName, err := byIDkv[*srcNameNode](ctx, id, c)
+	if err != nil {
+		return errext.Errorf("%v :: source name with id %q not found", funcName, id)
+	}
+
+	// remove the evidence nodes attached to the source first
+	var links []string
+	links = append(links, srcName.SrcMapLinks...)
+	links = append(links, srcName.ScorecardLinks...)
+	links = append(links, srcName.Occurrences...)
+	links = append(links, srcName.BadLinks...)
+	links = append(links, srcName.GoodLinks...)
+	links = append(links, srcName.HasMetadataLinks...)
+	links = append(links, srcName.PointOfContactLinks...)
+	links = append(links, srcName.CertifyLegals...)
+	for _, link := range links {
+		if err := c.deleteLinkNode(ctx, link, id); err != nil {
+			return errext.Errorf("%v :: %v", funcName, err)
+		}
+	}
+	if err := c.removeIncludedOccurrences(ctx, srcName.Occurrences); err != nil {
+		return errext.Errorf("%v :: %v", funcName, err)
+	}
+
+	if err := delkv(ctx, srcNameCol, srcName, c); err != nil {
+		return errext.Errorf("%v :: %v", funcName, err)
+	}
+	if err := c.removeFromIndex(ctx, id); err != nil {
+		return errext.Errorf("%v :: %v", funcName, err)
+	}
+
+	// drop the namespace and type of the source if they are now empty
+	namespace, err := byIDkv[*srcNamespace](ctx, srcName.Parent, c)
+	if err != nil {
+		return errext.Errorf("%v :: %v", funcName, err)
+	}
+	namespace.Names = removeLink(namespace.Names, id)
+	if len(namespace.Names) > 0 {
+		if err := setkv(ctx, srcNSCol, namespace, c); err != nil {
+			return errext.Errorf("%v :: %v", funcName, err)
+		}
+		return nil
+	}
+	if err := delkv(ctx, srcNSCol, namespace, c); err != nil {
+		return errext.Errorf("%v :: %v", funcName, err)
+	}
+	if err := c.removeFromIndex(ctx, namespace.ThisID); err != nil {
+		return errext.Errorf("%v :: %v", funcName, err)
+	}
+	srcTypeNode, err := byIDkv[*srcType](ctx, namespace.Parent, c)
+	if err != nil {
+		return errext.Errorf("%v :: %v", funcName, err)
+	}
+	srcTypeNode.Namespaces = removeLink(srcTypeNode.Namespaces, namespace.ThisID)
+	if len(srcTypeNode.Namespaces) > 0 {
+		if err := setkv(ctx, srcTypeCol, srcTypeNode, c); err != nil {
+			return errext.Errorf("%v :: %v", funcName, err)
+		}
+		return nil
+	}
+	if err := delkv(ctx, srcTypeCol, srcTypeNode, c); err != nil {
+		return errext.Errorf("%v :: %v", funcName, err)
+	}
+	if err := c.removeFromIndex(ctx, srcTypeNode.ThisID); err != nil {
+		return errext.Errorf("%v :: %v", funcName, err)
+	}
+	return nil
+}
+
 // Query Source
 
 func (c *demoClient) Sources(ctx context.Context, filter *model.SourceSpec) ([]*model.Source, error) {
@@ -368,6 +444,9 @@ func (c *demoClient) Sources(ctx context.Context, filter *model.SourceSpec) ([]*
 			}
 		}
 	}
+	if filter != nil {
+		return helper.SortSources(out, filter.Order), nil
+	}
 	return out, nil
 }
 
@@ -440,7 +519,7 @@ func (c *demoClient) buildSourceName(ctx context.Context, srcNamespace *srcNames
 		if err != nil {
 			return nil
 		}
-		if filter != nil && noMatch(filter.Name, s.Name) {
+		if filter != nil && noMatchSrcName(filter, s.Name) {
 			continue
 		}
 		if filter != nil && noMatch(filter.Tag, s.Tag) {
@@ -464,6 +543,21 @@ func (c *demoClient) buildSourceName(ctx context.Context, srcNamespace *srcNames
 	return sns
 }
 
+// noMatchSrcName reports whether the name fails the name, prefix, substring,
+// suffix or glob filter
+func noMatchSrcName(filter *model.SourceSpec, name string) bool {
+	if noMatch(filter.Name, name) {
+		return true
+	}
+	if noMatchPrefixContains(filter.NamePrefix, filter.NameContains, name) {
+		return true
+	}
+	if filter.NameSuffix != nil && !strings.HasSuffix(name, *filter.NameSuffix) {
+		return true
+	}
+	return filter.NameGlob != nil && !helper.MatchGlob(*filter.NameGlob, name)
+}
+
 // Builds a model.Source to send as GraphQL response, starting from id.
 // The optional filter allows restricting output (on selection operations).
 func (c *demoClient) buildSourceResponse(ctx context.Context, id string, filter *model.SourceSpec) (*model.Source, error) {
@@ -475,7 +569,7 @@ func (c *demoClient) buildSourceResponse(ctx context.Context, id string, filter
 
 	snl := []*model.SourceName{}
 	if nameNode, err := byIDkv[*srcNameNode](ctx, currentID, c); err == nil {
-		if filter != nil && noMatch(filter.Name, nameNode.Name) {
+		if filter != nil && noMatchSrcName(filter, nameNode.Name) {
 			return nil, nil
 		}
 		if filter != nil && noMatch(filter.Tag, nameNode.Tag) {
@@ -540,7 +634,7 @@ func (c *demoClient) getSourceNameFromInput(ctx context.Context, input model.Sou
 	}
 	srcT, err := byKeykv[*srcType](ctx, srcTypeCol, inType.Key(), c)
 	if err != nil {
-		return nil, gqlerror.Errorf("Package type \"%s\" not found", input.Type)
+		return nil, errext.Errorf("Package type \"%s\" not found", input.Type)
 	}
 
 	inNS := &srcNamespace{
@@ -549,7 +643,7 @@ func (c *demoClient) getSourceNameFromInput(ctx context.Context, input model.Sou
 	}
 	srcNS, err := byKeykv[*srcNamespace](ctx, srcNSCol, inNS.Key(), c)
 	if err != nil {
-		return nil, gqlerror.Errorf("Package namespace \"%s\" not found", input.Namespace)
+		return nil, errext.Errorf("Package namespace \"%s\" not found", input.Namespace)
 	}
 
 	inName := &srcNameNode{
@@ -560,7 +654,7 @@ func (c *demoClient) getSourceNameFromInput(ctx context.Context, input model.Sou
 	}
 	srcN, err := byKeykv[*srcNameNode](ctx, srcNameCol, inName.Key(), c)
 	if err != nil {
-		return nil, gqlerror.Errorf("Package name \"%s\" not found", input.Name)
+		return nil, errext.Errorf("Package name \"%s\" not found", input.Name)
 	}
 
 	return srcN, nil
@@ -627,14 +721,45 @@ func (c *demoClient) returnFoundSource(ctx context.Context, srcIDorInput *model.
 	if srcIDorInput.SourceNameID != nil {
 		foundSrcNameNode, err := byIDkv[*srcNameNode](ctx, *srcIDorInput.SourceNameID, c)
 		if err != nil {
-			return nil, gqlerror.Errorf("failed to return srcNameNode node by ID with error: %v", err)
+			return nil, errext.Errorf("failed to return srcNameNode node by ID with error: %v", err)
 		}
 		return foundSrcNameNode, nil
 	} else {
 		foundSrcNameNode, err := c.getSourceNameFromInput(ctx, *srcIDorInput.SourceInput)
 		if err != nil {
-			return nil, gqlerror.Errorf("failed to getSourceNameFromInput with error: %v", err)
+			return nil, errext.Errorf("failed to getSourceNameFromInput with error: %v", err)
 		}
 		return foundSrcNameNode, nil
 	}
 }
+
+func (c *demoClient) SourcesCount(ctx context.Context, sourceSpec model.SourceSpec) (int, error) {
+	results, err := c.Sources(ctx, &sourceSpec)
+	if err != nil {
+		return 0, err
+	}
+	// count source names, as SourcesList pages over them
+	return len(helper.SplitSourceNames(results)), nil
+}
+
+func (c *demoClient) SourcesList(ctx context.Context, sourceSpec model.SourceSpec, pagination *model.PaginationSpec) (*model.SourceConnection, error) {
+	results, err := c.Sources(ctx, &sourceSpec)
+	if err != nil {
+		return nil, err
+	}
+	// page over source names, each returned as its own tree
+	leaves := helper.SplitSourceNames(results)
+	page, pageInfo, err := helper.PaginateSlice(leaves, helper.SourceNameID, pagination)
+	if err != nil {
+		return nil, errext.Errorf("SourcesList :: %v", err)
+	}
+	edges := make([]*model.SourceEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.SourceEdge{Cursor: helper.SourceNameID(n), Node: n})
+	}
+	return &model.SourceConnection{
+		TotalCount: len(leaves),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
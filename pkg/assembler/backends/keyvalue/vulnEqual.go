@@ -22,9 +22,10 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // Internal data: link between equal vulnerabilities (vulnEqual)
@@ -66,7 +67,7 @@ func (c *demoClient) IngestVulnEquals(ctx context.Context, vulnerabilities []*mo
 	for i := range vulnEquals {
 		vulnEqual, err := c.IngestVulnEqual(ctx, *vulnerabilities[i], *otherVulnerabilities[i], *vulnEquals[i])
 		if err != nil {
-			return nil, gqlerror.Errorf("IngestVulnEqual failed with err: %v", err)
+			return nil, errext.Errorf("IngestVulnEqual failed with err: %v", err)
 		}
 		modelHashEqualsIDs = append(modelHashEqualsIDs, vulnEqual)
 	}
@@ -95,7 +96,7 @@ func (c *demoClient) ingestVulnEqual(ctx context.Context, vulnerability model.ID
 	for _, vi := range []model.IDorVulnerabilityInput{vulnerability, otherVulnerability} {
 		v, err := c.returnFoundVulnerability(ctx, &vi)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		vs = append(vs, v)
 		vIDs = append(vIDs, v.ThisID)
@@ -166,7 +167,7 @@ func (c *demoClient) VulnEqual(ctx context.Context, filter *model.VulnEqualSpec)
 		// If found by id, ignore rest of fields in spec and return as a match
 		ve, err := c.convVulnEqual(ctx, link)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		return []*model.VulnEqual{ve}, nil
 	}
@@ -177,7 +178,7 @@ func (c *demoClient) VulnEqual(ctx context.Context, filter *model.VulnEqualSpec)
 		if !foundOne {
 			exactVuln, err := c.exactVulnerability(ctx, v)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			if exactVuln != nil {
 				search = append(search, exactVuln.VulnEqualLinks...)
@@ -192,11 +193,11 @@ func (c *demoClient) VulnEqual(ctx context.Context, filter *model.VulnEqualSpec)
 		for _, id := range search {
 			link, err := byIDkv[*vulnerabilityEqualLink](ctx, id, c)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			out, err = c.addVulnIfMatch(ctx, out, filter, link)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 		}
 	} else {
@@ -216,7 +217,7 @@ func (c *demoClient) VulnEqual(ctx context.Context, filter *model.VulnEqualSpec)
 				}
 				out, err = c.addVulnIfMatch(ctx, out, filter, link)
 				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+					return nil, errext.Errorf("%v :: %v", funcName, err)
 				}
 			}
 		}
@@ -258,3 +259,23 @@ func (c *demoClient) addVulnIfMatch(ctx context.Context, out []*model.VulnEqual,
 	}
 	return append(out, ve), nil
 }
+
+func (c *demoClient) VulnEqualList(ctx context.Context, vulnEqualSpec model.VulnEqualSpec, pagination *model.PaginationSpec) (*model.VulnEqualConnection, error) {
+	results, err := c.VulnEqual(ctx, &vulnEqualSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.VulnEqual) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("VulnEqualList :: %v", err)
+	}
+	edges := make([]*model.VulnEqualEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.VulnEqualEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.VulnEqualConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
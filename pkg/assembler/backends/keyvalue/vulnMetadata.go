@@ -23,9 +23,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 type vulnerabilityMetadataLink struct {
@@ -69,7 +70,7 @@ func (c *demoClient) IngestBulkVulnerabilityMetadata(ctx context.Context, vulner
 	for i := range vulnerabilityMetadataList {
 		vulnMetadata, err := c.IngestVulnerabilityMetadata(ctx, *vulnerabilities[i], *vulnerabilityMetadataList[i])
 		if err != nil {
-			return nil, gqlerror.Errorf("IngestVulnerabilityMetadata failed with err: %v", err)
+			return nil, errext.Errorf("IngestVulnerabilityMetadata failed with err: %v", err)
 		}
 		modelVulnMetadataIDList = append(modelVulnMetadataIDList, vulnMetadata)
 	}
@@ -97,7 +98,7 @@ func (c *demoClient) ingestVulnerabilityMetadata(ctx context.Context, vulnerabil
 
 	foundVulnNode, err := c.returnFoundVulnerability(ctx, &vulnerability)
 	if err != nil {
-		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+		return "", errext.Errorf("%v ::  %s", funcName, err)
 	}
 	in.VulnerabilityID = foundVulnNode.ID()
 
@@ -145,7 +146,7 @@ func (c *demoClient) VulnerabilityMetadata(ctx context.Context, filter *model.Vu
 		// If found by id, ignore rest of fields in spec and return as a match
 		foundVulnMetadata, err := c.buildVulnerabilityMetadata(ctx, link, filter, true)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		return []*model.VulnerabilityMetadata{foundVulnMetadata}, nil
 	}
@@ -156,7 +157,7 @@ func (c *demoClient) VulnerabilityMetadata(ctx context.Context, filter *model.Vu
 
 		exactVuln, err := c.exactVulnerability(ctx, filter.Vulnerability)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			return nil, errext.Errorf("%v :: %v", funcName, err)
 		}
 		if exactVuln != nil {
 			search = append(search, exactVuln.VulnMetadataLinks...)
@@ -169,11 +170,11 @@ func (c *demoClient) VulnerabilityMetadata(ctx context.Context, filter *model.Vu
 		for _, id := range search {
 			link, err := byIDkv[*vulnerabilityMetadataLink](ctx, id, c)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 			out, err = c.addVulnMetadataMatch(ctx, out, filter, link)
 			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				return nil, errext.Errorf("%v :: %v", funcName, err)
 			}
 		}
 	} else {
@@ -193,7 +194,7 @@ func (c *demoClient) VulnerabilityMetadata(ctx context.Context, filter *model.Vu
 				}
 				out, err = c.addVulnMetadataMatch(ctx, out, filter, link)
 				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+					return nil, errext.Errorf("%v :: %v", funcName, err)
 				}
 			}
 		}
@@ -208,7 +209,7 @@ func (c *demoClient) addVulnMetadataMatch(ctx context.Context, out []*model.Vuln
 
 	if filter != nil && filter.Comparator != nil {
 		if filter.ScoreValue == nil {
-			return out, gqlerror.Errorf("comparator set without a vulnerability score being specified")
+			return out, errext.Errorf("comparator set without a vulnerability score being specified")
 		}
 		switch *filter.Comparator {
 		case model.ComparatorEqual:
@@ -281,7 +282,7 @@ func (c *demoClient) buildVulnerabilityMetadata(ctx context.Context, link *vulne
 
 	if link.VulnerabilityID != "" {
 		if vuln == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve vuln via vulnID")
+			return nil, errext.Errorf("failed to retrieve vuln via vulnID")
 		} else if vuln == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
@@ -300,3 +301,23 @@ func (c *demoClient) buildVulnerabilityMetadata(ctx context.Context, link *vulne
 
 	return vulnMetadata, nil
 }
+
+func (c *demoClient) VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, pagination *model.PaginationSpec) (*model.VulnerabilityMetadataConnection, error) {
+	results, err := c.VulnerabilityMetadata(ctx, &vulnerabilityMetadataSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, pageInfo, err := helper.PaginateSlice(results, func(n *model.VulnerabilityMetadata) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, errext.Errorf("VulnerabilityMetadataList :: %v", err)
+	}
+	edges := make([]*model.VulnerabilityMetadataEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.VulnerabilityMetadataEdge{Cursor: n.ID, Node: n})
+	}
+	return &model.VulnerabilityMetadataConnection{
+		TotalCount: len(results),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
@@ -21,9 +21,9 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -44,6 +44,7 @@ type vulnIDNode struct {
 	VulnEqualLinks    []string
 	VexLinks          []string
 	VulnMetadataLinks []string
+	ExploitRefLinks   []string
 }
 
 func (n *vulnTypeStruct) ID() string { return n.ThisID }
@@ -84,6 +85,9 @@ func (n *vulnIDNode) Neighbors(allowedEdges edgeMap) []string {
 	if allowedEdges[model.EdgeVulnMetadataVulnerability] {
 		out = append(out, n.VulnMetadataLinks...)
 	}
+	if allowedEdges[model.EdgeVulnerabilityExploitReference] {
+		out = append(out, n.ExploitRefLinks...)
+	}
 
 	return out
 }
@@ -101,6 +105,11 @@ func (n *vulnIDNode) setVulnerabilityLinks(ctx context.Context, id string, c *de
 	return setkv(ctx, vulnIDCol, n, c)
 }
 
+func (n *vulnIDNode) unsetVulnerabilityLinks(ctx context.Context, id string, c *demoClient) error {
+	n.CertifyVulnLinks = removeLink(n.CertifyVulnLinks, id)
+	return setkv(ctx, vulnIDCol, n, c)
+}
+
 // equalVulnerability back edges
 func (n *vulnIDNode) setVulnEqualLinks(ctx context.Context, id string, c *demoClient) error {
 	n.VulnEqualLinks = append(n.VulnEqualLinks, id)
@@ -119,6 +128,12 @@ func (n *vulnIDNode) setVulnMetadataLinks(ctx context.Context, id string, c *dem
 	return setkv(ctx, vulnIDCol, n, c)
 }
 
+// exploitReference back edges
+func (n *vulnIDNode) setExploitRefLinks(ctx context.Context, id string, c *demoClient) error {
+	n.ExploitRefLinks = append(n.ExploitRefLinks, id)
+	return setkv(ctx, vulnIDCol, n, c)
+}
+
 func (n *vulnTypeStruct) addVulnID(ctx context.Context, vulnID string, c *demoClient) error {
 	n.VulnIDs = append(n.VulnIDs, vulnID)
 	return setkv(ctx, vulnTypeCol, n, c)
@@ -131,7 +146,7 @@ func (c *demoClient) IngestVulnerabilities(ctx context.Context, vulns []*model.I
 	for _, vuln := range vulns {
 		modelVuln, err := c.IngestVulnerability(ctx, *vuln)
 		if err != nil {
-			return nil, gqlerror.Errorf("IngestVulnerability failed with err: %v", err)
+			return nil, errext.Errorf("IngestVulnerability failed with err: %v", err)
 		}
 		modelVulnerabilities = append(modelVulnerabilities, modelVuln)
 	}
@@ -230,7 +245,7 @@ func (c *demoClient) Vulnerabilities(ctx context.Context, filter *model.Vulnerab
 
 	if filter.NoVuln != nil && !*filter.NoVuln {
 		if filter.Type != nil && *filter.Type == noVulnType {
-			return []*model.Vulnerability{}, gqlerror.Errorf("novuln boolean set to false, cannot specify vulnerability type to be novuln")
+			return []*model.Vulnerability{}, errext.Errorf("novuln boolean set to false, cannot specify vulnerability type to be novuln")
 		}
 	}
 
@@ -428,14 +443,36 @@ func (c *demoClient) returnFoundVulnerability(ctx context.Context, vulnIDorInput
 	if vulnIDorInput.VulnerabilityNodeID != nil {
 		foundVulnID, err := byIDkv[*vulnIDNode](ctx, *vulnIDorInput.VulnerabilityNodeID, c)
 		if err != nil {
-			return nil, gqlerror.Errorf("failed to return vulnIDNode node by ID with error: %v", err)
+			return nil, errext.Errorf("failed to return vulnIDNode node by ID with error: %v", err)
 		}
 		return foundVulnID, nil
 	} else {
 		foundVulnID, err := c.getVulnerabilityFromInput(ctx, *vulnIDorInput.VulnerabilityInput)
 		if err != nil {
-			return nil, gqlerror.Errorf("failed to getVulnerabilityFromInput with error: %v", err)
+			return nil, errext.Errorf("failed to getVulnerabilityFromInput with error: %v", err)
 		}
 		return foundVulnID, nil
 	}
 }
+
+func (c *demoClient) VulnerabilitiesList(ctx context.Context, vulnSpec model.VulnerabilitySpec, pagination *model.PaginationSpec) (*model.VulnerabilityConnection, error) {
+	results, err := c.Vulnerabilities(ctx, &vulnSpec)
+	if err != nil {
+		return nil, err
+	}
+	// page over vulnerability IDs, each returned as its own tree
+	leaves := helper.SplitVulnerabilityIDs(results)
+	page, pageInfo, err := helper.PaginateSlice(leaves, helper.VulnerabilityIDID, pagination)
+	if err != nil {
+		return nil, errext.Errorf("VulnerabilitiesList :: %v", err)
+	}
+	edges := make([]*model.VulnerabilityEdge, 0, len(page))
+	for _, n := range page {
+		edges = append(edges, &model.VulnerabilityEdge{Cursor: helper.VulnerabilityIDID(n), Node: n})
+	}
+	return &model.VulnerabilityConnection{
+		TotalCount: len(leaves),
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
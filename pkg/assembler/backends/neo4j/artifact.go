@@ -66,7 +66,15 @@ func (c *neo4jClient) Artifacts(ctx context.Context, artifactSpec *model.Artifac
 }
 
 func (c *neo4jClient) IngestArtifacts(ctx context.Context, artifacts []*model.IDorArtifactInput) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented: IngestArtifacts")
+	var artifactIDs []string
+	for _, artifact := range artifacts {
+		id, err := c.IngestArtifact(ctx, artifact)
+		if err != nil {
+			return nil, fmt.Errorf("IngestArtifact failed with err: %w", err)
+		}
+		artifactIDs = append(artifactIDs, id)
+	}
+	return artifactIDs, nil
 }
 
 func (c *neo4jClient) IngestArtifact(ctx context.Context, artifact *model.IDorArtifactInput) (string, error) {
@@ -139,3 +147,7 @@ func generateModelArtifact(algorithm, digest string) *model.Artifact {
 	}
 	return &artifact
 }
+
+func (c *neo4jClient) ArtifactsList(ctx context.Context, artifactSpec model.ArtifactSpec, pagination *model.PaginationSpec) (*model.ArtifactConnection, error) {
+	return nil, fmt.Errorf("not implemented: ArtifactsList")
+}
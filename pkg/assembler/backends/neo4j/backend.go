@@ -181,3 +181,11 @@ func (c *neo4jClient) IngestCertifyLegal(ctx context.Context, subject model.Pack
 func (c *neo4jClient) IngestCertifyLegals(ctx context.Context, subjects model.PackageOrSourceInputs, declaredLicensesList [][]*model.IDorLicenseInput, discoveredLicensesList [][]*model.IDorLicenseInput, certifyLegals []*model.CertifyLegalInputSpec) ([]string, error) {
 	panic(fmt.Errorf("not implemented: IngestCertifyLegals"))
 }
+
+func (c *neo4jClient) LicensesList(ctx context.Context, licenseSpec model.LicenseSpec, pagination *model.PaginationSpec) (*model.LicenseConnection, error) {
+	return nil, fmt.Errorf("not implemented: LicensesList")
+}
+
+func (c *neo4jClient) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, pagination *model.PaginationSpec) (*model.CertifyLegalConnection, error) {
+	return nil, fmt.Errorf("not implemented: CertifyLegalList")
+}
@@ -63,7 +63,15 @@ func (c *neo4jClient) Builders(ctx context.Context, builderSpec *model.BuilderSp
 }
 
 func (c *neo4jClient) IngestBuilders(ctx context.Context, builders []*model.IDorBuilderInput) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented: IngestBuilders")
+	var builderIDs []string
+	for _, builder := range builders {
+		id, err := c.IngestBuilder(ctx, builder)
+		if err != nil {
+			return nil, fmt.Errorf("IngestBuilder failed with err: %w", err)
+		}
+		builderIDs = append(builderIDs, id)
+	}
+	return builderIDs, nil
 }
 
 func (c *neo4jClient) IngestBuilder(ctx context.Context, builder *model.IDorBuilderInput) (string, error) {
@@ -104,3 +112,7 @@ func generateModelBuilder(uri string) *model.Builder {
 	}
 	return &builder
 }
+
+func (c *neo4jClient) BuildersList(ctx context.Context, builderSpec model.BuilderSpec, pagination *model.PaginationSpec) (*model.BuilderConnection, error) {
+	return nil, fmt.Errorf("not implemented: BuildersList")
+}
@@ -270,3 +270,7 @@ func (c *neo4jClient) IngestCertifyBad(ctx context.Context, subject model.Packag
 func (c *neo4jClient) IngestCertifyBads(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, certifyBads []*model.CertifyBadInputSpec) ([]string, error) {
 	return []string{}, fmt.Errorf("not implemented: IngestCertifyBads")
 }
+
+func (c *neo4jClient) CertifyBadList(ctx context.Context, certifyBadSpec model.CertifyBadSpec, pagination *model.PaginationSpec) (*model.CertifyBadConnection, error) {
+	return nil, fmt.Errorf("not implemented: CertifyBadList")
+}
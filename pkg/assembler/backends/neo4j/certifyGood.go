@@ -264,3 +264,7 @@ func (c *neo4jClient) IngestCertifyGood(ctx context.Context, subject model.Packa
 func (c *neo4jClient) IngestCertifyGoods(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, certifyGoods []*model.CertifyGoodInputSpec) ([]string, error) {
 	return []string{}, fmt.Errorf("not implemented: IngestCertifyGoods")
 }
+
+func (c *neo4jClient) CertifyGoodList(ctx context.Context, certifyGoodSpec model.CertifyGoodSpec, pagination *model.PaginationSpec) (*model.CertifyGoodConnection, error) {
+	return nil, fmt.Errorf("not implemented: CertifyGoodList")
+}
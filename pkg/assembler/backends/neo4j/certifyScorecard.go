@@ -307,3 +307,11 @@ RETURN type.type, ns.namespace, name.name, name.commit, name.tag, certifyScoreca
 
 	return result.(*model.CertifyScorecard).ID, nil
 }
+
+func (c *neo4jClient) UpdateCertifyScorecard(ctx context.Context, id string, scorecard model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
+	return nil, fmt.Errorf("not implemented: UpdateCertifyScorecard")
+}
+
+func (c *neo4jClient) ScorecardsList(ctx context.Context, certifyScorecardSpec model.CertifyScorecardSpec, pagination *model.PaginationSpec) (*model.CertifyScorecardConnection, error) {
+	return nil, fmt.Errorf("not implemented: ScorecardsList")
+}
@@ -647,3 +647,7 @@ func (c *neo4jClient) IngestVEXStatement(ctx context.Context, subject model.Pack
 func (c *neo4jClient) IngestVEXStatements(ctx context.Context, subjects model.PackageOrArtifactInputs, vulnerabilities []*model.IDorVulnerabilityInput, vexStatements []*model.VexStatementInputSpec) ([]string, error) {
 	return []string{}, fmt.Errorf("not implemented - IngestVEXStatements")
 }
+
+func (c *neo4jClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, pagination *model.PaginationSpec) (*model.CertifyVEXStatementConnection, error) {
+	return nil, fmt.Errorf("not implemented: CertifyVEXStatementList")
+}
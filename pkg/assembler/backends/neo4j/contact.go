@@ -33,3 +33,11 @@ func (c *neo4jClient) PointOfContact(ctx context.Context, pointOfContactSpec *mo
 func (c *neo4jClient) IngestPointOfContacts(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, pointOfContacts []*model.PointOfContactInputSpec) ([]string, error) {
 	return []string{}, fmt.Errorf("not implemented: IngestPointOfContacts")
 }
+
+func (c *neo4jClient) UpdatePointOfContact(ctx context.Context, id string, pointOfContact model.PointOfContactInputSpec) (*model.PointOfContact, error) {
+	return nil, fmt.Errorf("not implemented: UpdatePointOfContact")
+}
+
+func (c *neo4jClient) PointOfContactList(ctx context.Context, pointOfContactSpec model.PointOfContactSpec, pagination *model.PaginationSpec) (*model.PointOfContactConnection, error) {
+	return nil, fmt.Errorf("not implemented: PointOfContactList")
+}
@@ -0,0 +1,31 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neo4j
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func (c *neo4jClient) IngestExploitReference(ctx context.Context, vulnerability model.IDorVulnerabilityInput, exploitReference model.ExploitReferenceInputSpec) (string, error) {
+	return "", fmt.Errorf("not implemented - IngestExploitReference")
+}
+
+func (c *neo4jClient) ExploitReferences(ctx context.Context, exploitReferenceSpec *model.ExploitReferenceSpec) ([]*model.ExploitReference, error) {
+	return []*model.ExploitReference{}, fmt.Errorf("not implemented - ExploitReferences")
+}
@@ -33,3 +33,7 @@ func (c *neo4jClient) HasMetadata(ctx context.Context, hasMetadataSpec *model.Ha
 func (c *neo4jClient) IngestBulkHasMetadata(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, hasMetadataList []*model.HasMetadataInputSpec) ([]string, error) {
 	return []string{}, fmt.Errorf("not implemented: IngestBulkHasMetadata")
 }
+
+func (c *neo4jClient) HasMetadataList(ctx context.Context, hasMetadataSpec model.HasMetadataSpec, pagination *model.PaginationSpec) (*model.HasMetadataConnection, error) {
+	return nil, fmt.Errorf("not implemented: HasMetadataList")
+}
@@ -194,3 +194,15 @@ func (c *neo4jClient) IngestHasSbom(ctx context.Context, subject model.PackageOr
 func (c *neo4jClient) IngestHasSBOMs(ctx context.Context, subjects model.PackageOrArtifactInputs, hasSBOMs []*model.HasSBOMInputSpec, includes []*model.HasSBOMIncludesInputSpec) ([]string, error) {
 	return []string{}, fmt.Errorf("not implemented: IngestHasSBOMs")
 }
+
+func (c *neo4jClient) SBOMComponentBreakdown(ctx context.Context, hasSBOMID string) ([]*model.ComponentTypeCount, error) {
+	return nil, fmt.Errorf("not implemented: SBOMComponentBreakdown")
+}
+
+func (c *neo4jClient) GetProvenance(ctx context.Context, pkgID string) (*model.ProvenanceSummary, error) {
+	return nil, fmt.Errorf("not implemented: GetProvenance")
+}
+
+func (c *neo4jClient) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, pagination *model.PaginationSpec) (*model.HasSBOMConnection, error) {
+	return nil, fmt.Errorf("not implemented: HasSBOMList")
+}
@@ -604,3 +604,7 @@ func (c *neo4jClient) IngestSLSA(ctx context.Context, subject model.IDorArtifact
 func (c *neo4jClient) IngestSLSAs(ctx context.Context, subjects []*model.IDorArtifactInput, builtFromList [][]*model.IDorArtifactInput, builtByList []*model.IDorBuilderInput, slsaList []*model.SLSAInputSpec) ([]string, error) {
 	return []string{}, fmt.Errorf("not implemented: IngestSLSAs")
 }
+
+func (c *neo4jClient) HasSLSAList(ctx context.Context, hasSLSASpec model.HasSLSASpec, pagination *model.PaginationSpec) (*model.HasSLSAConnection, error) {
+	return nil, fmt.Errorf("not implemented: HasSLSAList")
+}
@@ -165,3 +165,15 @@ func (c *neo4jClient) IngestHasSourceAt(ctx context.Context, pkg model.IDorPkgIn
 func (c *neo4jClient) IngestHasSourceAts(ctx context.Context, pkgs []*model.IDorPkgInput, pkgMatchType *model.MatchFlags, sources []*model.IDorSourceInput, hasSourceAts []*model.HasSourceAtInputSpec) ([]string, error) {
 	panic(fmt.Errorf("not implemented: IngestHasSourceAts"))
 }
+
+func (c *neo4jClient) UpdateHasSourceAt(ctx context.Context, id string, hasSourceAt model.HasSourceAtInputSpec) (*model.HasSourceAt, error) {
+	return nil, fmt.Errorf("not implemented: UpdateHasSourceAt")
+}
+
+func (c *neo4jClient) HasSourceAtCount(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec) (int, error) {
+	return 0, fmt.Errorf("not implemented: HasSourceAtCount")
+}
+
+func (c *neo4jClient) HasSourceAtList(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec, pagination *model.PaginationSpec) (*model.HasSourceAtConnection, error) {
+	return nil, fmt.Errorf("not implemented: HasSourceAtList")
+}
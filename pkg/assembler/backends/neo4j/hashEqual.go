@@ -145,3 +145,7 @@ func (c *neo4jClient) IngestHashEqual(ctx context.Context, artifact model.IDorAr
 func (c *neo4jClient) IngestHashEquals(ctx context.Context, artifacts []*model.IDorArtifactInput, otherArtifacts []*model.IDorArtifactInput, hashEquals []*model.HashEqualInputSpec) ([]string, error) {
 	return []string{}, fmt.Errorf("not implemented: IngestHashEquals")
 }
+
+func (c *neo4jClient) HashEqualList(ctx context.Context, hashEqualSpec model.HashEqualSpec, pagination *model.PaginationSpec) (*model.HashEqualConnection, error) {
+	return nil, fmt.Errorf("not implemented: HashEqualList")
+}
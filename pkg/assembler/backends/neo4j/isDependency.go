@@ -282,3 +282,7 @@ func convertDependencyTypeToEnum(status string) (model.DependencyType, error) {
 	}
 	return model.DependencyTypeUnknown, fmt.Errorf("failed to convert DependencyType to enum")
 }
+
+func (c *neo4jClient) IsDependencyList(ctx context.Context, isDependencySpec model.IsDependencySpec, pagination *model.PaginationSpec) (*model.IsDependencyConnection, error) {
+	return nil, fmt.Errorf("not implemented: IsDependencyList")
+}
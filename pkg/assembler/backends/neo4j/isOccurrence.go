@@ -347,3 +347,7 @@ func (c *neo4jClient) IngestOccurrence(ctx context.Context, subject model.Packag
 		return "", gqlerror.Errorf("package or source not specified for IngestOccurrence")
 	}
 }
+
+func (c *neo4jClient) IsOccurrenceList(ctx context.Context, isOccurrenceSpec model.IsOccurrenceSpec, pagination *model.PaginationSpec) (*model.IsOccurrenceConnection, error) {
+	return nil, fmt.Errorf("not implemented: IsOccurrenceList")
+}
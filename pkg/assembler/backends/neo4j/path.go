@@ -30,6 +30,10 @@ func (c *neo4jClient) Neighbors(ctx context.Context, node string, usingOnly []mo
 	panic(fmt.Errorf("not implemented: Neighbors - neighbors"))
 }
 
+func (c *neo4jClient) NeighborsRecursive(ctx context.Context, node string, usingOnly []model.Edge, maxDepth int) ([]model.Node, error) {
+	return nil, fmt.Errorf("not implemented: NeighborsRecursive")
+}
+
 func (c *neo4jClient) Node(ctx context.Context, node string) (model.Node, error) {
 	panic(fmt.Errorf("not implemented: Node - node"))
 }
@@ -37,3 +41,11 @@ func (c *neo4jClient) Node(ctx context.Context, node string) (model.Node, error)
 func (c *neo4jClient) Nodes(ctx context.Context, nodes []string) ([]model.Node, error) {
 	panic(fmt.Errorf("not implemented: Nodes - nodes"))
 }
+
+func (c *neo4jClient) BatchNodes(ctx context.Context, nodes []string) ([]model.Node, error) {
+	panic(fmt.Errorf("not implemented: BatchNodes - batchNodes"))
+}
+
+func (c *neo4jClient) NodeType(ctx context.Context, node string) (string, error) {
+	return "", fmt.Errorf("not implemented: NodeType - nodeType")
+}
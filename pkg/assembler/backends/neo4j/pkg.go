@@ -356,7 +356,19 @@ func removeInvalidCharFromProperty(key string) string {
 }
 
 func (c *neo4jClient) IngestPackages(ctx context.Context, pkgs []*model.IDorPkgInput) ([]*model.PackageIDs, error) {
-	return []*model.PackageIDs{}, fmt.Errorf("not implemented: IngestPackages")
+	var pkgIDs []*model.PackageIDs
+	for _, pkg := range pkgs {
+		ids, err := c.IngestPackage(ctx, *pkg)
+		if err != nil {
+			return nil, fmt.Errorf("IngestPackage failed with err: %w", err)
+		}
+		pkgIDs = append(pkgIDs, ids)
+	}
+	return pkgIDs, nil
+}
+
+func (c *neo4jClient) MergePackages(ctx context.Context, primary string, duplicates []string) (*model.Package, error) {
+	return nil, fmt.Errorf("not implemented - MergePackages")
 }
 
 func (c *neo4jClient) IngestPackage(ctx context.Context, pkg model.IDorPkgInput) (*model.PackageIDs, error) {
@@ -577,3 +589,11 @@ func generateModelPackage(pkgType, namespaceStr, nameStr string, versionValue, s
 	}
 	return &pkg
 }
+
+func (c *neo4jClient) PackagesCount(ctx context.Context, pkgSpec model.PkgSpec) (int, error) {
+	return 0, fmt.Errorf("not implemented: PackagesCount")
+}
+
+func (c *neo4jClient) PackagesList(ctx context.Context, pkgSpec model.PkgSpec, pagination *model.PaginationSpec) (*model.PackageConnection, error) {
+	return nil, fmt.Errorf("not implemented: PackagesList")
+}
@@ -261,3 +261,7 @@ func (c *neo4jClient) IngestPkgEqual(ctx context.Context, pkg model.IDorPkgInput
 func (c *neo4jClient) IngestPkgEquals(ctx context.Context, pkgs []*model.IDorPkgInput, otherPackages []*model.IDorPkgInput, pkgEquals []*model.PkgEqualInputSpec) ([]string, error) {
 	return nil, fmt.Errorf("not implemented - IngestPkgEquals")
 }
+
+func (c *neo4jClient) PkgEqualList(ctx context.Context, pkgEqualSpec model.PkgEqualSpec, pagination *model.PaginationSpec) (*model.PkgEqualConnection, error) {
+	return nil, fmt.Errorf("not implemented: PkgEqualList")
+}
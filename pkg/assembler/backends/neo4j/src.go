@@ -241,7 +241,19 @@ func (c *neo4jClient) sourcesNamespace(ctx context.Context, sourceSpec *model.So
 }
 
 func (c *neo4jClient) IngestSources(ctx context.Context, sources []*model.IDorSourceInput) ([]*model.SourceIDs, error) {
-	return []*model.SourceIDs{}, fmt.Errorf("not implemented: IngestSources")
+	var srcIDs []*model.SourceIDs
+	for _, source := range sources {
+		ids, err := c.IngestSource(ctx, *source)
+		if err != nil {
+			return nil, fmt.Errorf("IngestSource failed with err: %w", err)
+		}
+		srcIDs = append(srcIDs, ids)
+	}
+	return srcIDs, nil
+}
+
+func (c *neo4jClient) DeleteSource(ctx context.Context, id string) error {
+	return fmt.Errorf("not implemented - DeleteSource")
 }
 
 func (c *neo4jClient) IngestSource(ctx context.Context, source model.IDorSourceInput) (*model.SourceIDs, error) {
@@ -394,3 +406,11 @@ func generateModelSource(srcType, namespaceStr, nameStr string, commitValue, tag
 	}
 	return &src
 }
+
+func (c *neo4jClient) SourcesCount(ctx context.Context, sourceSpec model.SourceSpec) (int, error) {
+	return 0, fmt.Errorf("not implemented: SourcesCount")
+}
+
+func (c *neo4jClient) SourcesList(ctx context.Context, sourceSpec model.SourceSpec, pagination *model.PaginationSpec) (*model.SourceConnection, error) {
+	return nil, fmt.Errorf("not implemented: SourcesList")
+}
@@ -199,3 +199,7 @@ func (c *neo4jClient) IngestVulnEqual(ctx context.Context, vulnerability model.I
 func (c *neo4jClient) IngestVulnEquals(ctx context.Context, vulnerabilities []*model.IDorVulnerabilityInput, otherVulnerabilities []*model.IDorVulnerabilityInput, vulnEquals []*model.VulnEqualInputSpec) ([]string, error) {
 	return []string{}, fmt.Errorf("not implemented - IngestVulnEquals")
 }
+
+func (c *neo4jClient) VulnEqualList(ctx context.Context, vulnEqualSpec model.VulnEqualSpec, pagination *model.PaginationSpec) (*model.VulnEqualConnection, error) {
+	return nil, fmt.Errorf("not implemented: VulnEqualList")
+}
@@ -33,3 +33,7 @@ func (c *neo4jClient) IngestBulkVulnerabilityMetadata(ctx context.Context, vulne
 func (c *neo4jClient) VulnerabilityMetadata(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec) ([]*model.VulnerabilityMetadata, error) {
 	return []*model.VulnerabilityMetadata{}, fmt.Errorf("not implemented - VulnerabilityMetadata")
 }
+
+func (c *neo4jClient) VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, pagination *model.PaginationSpec) (*model.VulnerabilityMetadataConnection, error) {
+	return nil, fmt.Errorf("not implemented: VulnerabilityMetadataList")
+}
@@ -204,3 +204,7 @@ func (c *neo4jClient) IngestVulnerability(ctx context.Context, vuln model.IDorVu
 // 	}
 // 	return &cve
 // }
+
+func (c *neo4jClient) VulnerabilitiesList(ctx context.Context, vulnSpec model.VulnerabilitySpec, pagination *model.PaginationSpec) (*model.VulnerabilityConnection, error) {
+	return nil, fmt.Errorf("not implemented: VulnerabilitiesList")
+}
@@ -0,0 +1,935 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ratelimit wraps a backend with a global limit on the rate of calls,
+// so that large ingestion jobs cannot saturate the connection pool of the
+// database behind it.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/logging"
+	"golang.org/x/time/rate"
+)
+
+// rateLimitedBackend takes a token from the limiter before every call to the
+// wrapped backend. The methods are forwarded explicitly instead of embedding
+// the wrapped backend so that a method added to backends.Backend cannot bypass
+// the limit.
+type rateLimitedBackend struct {
+	inner   backends.Backend
+	limiter *rate.Limiter
+
+	// throttled is set while calls are blocked, so that a warning is only
+	// logged when blocking begins rather than for every blocked call
+	throttled atomic.Bool
+}
+
+// NewRateLimitedBackend returns a backend that allows at most rps calls per
+// second to inner, with bursts of up to burst calls. Calls over the limit
+// block until a token is available, or fail if the context is done or its
+// deadline would expire first. burst must be positive, with a burst of zero
+// every call fails.
+func NewRateLimitedBackend(inner backends.Backend, rps float64, burst int) backends.Backend {
+	return &rateLimitedBackend{inner: inner, limiter: rate.NewLimiter(rate.Limit(rps), burst)}
+}
+
+func (r *rateLimitedBackend) wait(ctx context.Context) error {
+	if r.limiter.Allow() {
+		r.throttled.Store(false)
+		return nil
+	}
+	if r.throttled.CompareAndSwap(false, true) {
+		logging.FromContext(ctx).Warnf("backend rate limit of %v calls per second exceeded, blocking calls", r.limiter.Limit())
+	}
+	if err := r.limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("waiting for backend rate limit failed: %w", err)
+	}
+	return nil
+}
+
+func (r *rateLimitedBackend) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.Artifacts(ctx, artifactSpec)
+}
+
+func (r *rateLimitedBackend) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.Builders(ctx, builderSpec)
+}
+
+func (r *rateLimitedBackend) Licenses(ctx context.Context, licenseSpec *model.LicenseSpec) ([]*model.License, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.Licenses(ctx, licenseSpec)
+}
+
+func (r *rateLimitedBackend) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.Packages(ctx, pkgSpec)
+}
+
+func (r *rateLimitedBackend) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.Sources(ctx, sourceSpec)
+}
+
+func (r *rateLimitedBackend) Vulnerabilities(ctx context.Context, vulnSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.Vulnerabilities(ctx, vulnSpec)
+}
+
+func (r *rateLimitedBackend) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.CertifyBad(ctx, certifyBadSpec)
+}
+
+func (r *rateLimitedBackend) CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.CertifyGood(ctx, certifyGoodSpec)
+}
+
+func (r *rateLimitedBackend) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.CertifyVEXStatement(ctx, certifyVEXStatementSpec)
+}
+
+func (r *rateLimitedBackend) ArchivedCertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.ArchivedCertifyVuln(ctx, certifyVulnSpec)
+}
+
+func (r *rateLimitedBackend) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.CertifyVuln(ctx, certifyVulnSpec)
+}
+
+func (r *rateLimitedBackend) CertifyVulnByVulnerabilityIDs(ctx context.Context, vulnerabilityIDs []string, certifyVulnSpec *model.CertifyVulnSpec) (map[string][]*model.CertifyVuln, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.CertifyVulnByVulnerabilityIDs(ctx, vulnerabilityIDs, certifyVulnSpec)
+}
+
+func (r *rateLimitedBackend) CertifyVulnByPackageIDs(ctx context.Context, packageIDs []string, certifyVulnSpec *model.CertifyVulnSpec) (map[string][]*model.CertifyVuln, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.CertifyVulnByPackageIDs(ctx, packageIDs, certifyVulnSpec)
+}
+
+func (r *rateLimitedBackend) TopVulnerablePackages(ctx context.Context, limit int, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.PackageVulnCount, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.TopVulnerablePackages(ctx, limit, certifyVulnSpec)
+}
+
+func (r *rateLimitedBackend) GetCertifyVulnsByDocumentRef(ctx context.Context, documentRef string) ([]*model.CertifyVuln, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.GetCertifyVulnsByDocumentRef(ctx, documentRef)
+}
+
+func (r *rateLimitedBackend) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.CertifyLegal(ctx, certifyLegalSpec)
+}
+
+func (r *rateLimitedBackend) ExploitReferences(ctx context.Context, exploitReferenceSpec *model.ExploitReferenceSpec) ([]*model.ExploitReference, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.ExploitReferences(ctx, exploitReferenceSpec)
+}
+
+func (r *rateLimitedBackend) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.HasSBOM(ctx, hasSBOMSpec)
+}
+
+func (r *rateLimitedBackend) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.HasSlsa(ctx, hasSLSASpec)
+}
+
+func (r *rateLimitedBackend) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec) ([]*model.HasSourceAt, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.HasSourceAt(ctx, hasSourceAtSpec)
+}
+
+func (r *rateLimitedBackend) HasMetadata(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec) ([]*model.HasMetadata, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.HasMetadata(ctx, hasMetadataSpec)
+}
+
+func (r *rateLimitedBackend) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.HashEqual(ctx, hashEqualSpec)
+}
+
+func (r *rateLimitedBackend) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IsDependency(ctx, isDependencySpec)
+}
+
+func (r *rateLimitedBackend) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IsOccurrence(ctx, isOccurrenceSpec)
+}
+
+func (r *rateLimitedBackend) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec) ([]*model.PkgEqual, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.PkgEqual(ctx, pkgEqualSpec)
+}
+
+func (r *rateLimitedBackend) PointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.PointOfContact(ctx, pointOfContactSpec)
+}
+
+func (r *rateLimitedBackend) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.Scorecards(ctx, certifyScorecardSpec)
+}
+
+func (r *rateLimitedBackend) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.VulnEqual(ctx, vulnEqualSpec)
+}
+
+func (r *rateLimitedBackend) VulnerabilityMetadata(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec) ([]*model.VulnerabilityMetadata, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.VulnerabilityMetadata(ctx, vulnerabilityMetadataSpec)
+}
+
+func (r *rateLimitedBackend) ArtifactsList(ctx context.Context, artifactSpec model.ArtifactSpec, pagination *model.PaginationSpec) (*model.ArtifactConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.ArtifactsList(ctx, artifactSpec, pagination)
+}
+
+func (r *rateLimitedBackend) BuildersList(ctx context.Context, builderSpec model.BuilderSpec, pagination *model.PaginationSpec) (*model.BuilderConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.BuildersList(ctx, builderSpec, pagination)
+}
+
+func (r *rateLimitedBackend) LicensesList(ctx context.Context, licenseSpec model.LicenseSpec, pagination *model.PaginationSpec) (*model.LicenseConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.LicensesList(ctx, licenseSpec, pagination)
+}
+
+func (r *rateLimitedBackend) PackagesList(ctx context.Context, pkgSpec model.PkgSpec, pagination *model.PaginationSpec) (*model.PackageConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.PackagesList(ctx, pkgSpec, pagination)
+}
+
+func (r *rateLimitedBackend) SourcesList(ctx context.Context, sourceSpec model.SourceSpec, pagination *model.PaginationSpec) (*model.SourceConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.SourcesList(ctx, sourceSpec, pagination)
+}
+
+func (r *rateLimitedBackend) VulnerabilitiesList(ctx context.Context, vulnSpec model.VulnerabilitySpec, pagination *model.PaginationSpec) (*model.VulnerabilityConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.VulnerabilitiesList(ctx, vulnSpec, pagination)
+}
+
+func (r *rateLimitedBackend) CertifyBadList(ctx context.Context, certifyBadSpec model.CertifyBadSpec, pagination *model.PaginationSpec) (*model.CertifyBadConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.CertifyBadList(ctx, certifyBadSpec, pagination)
+}
+
+func (r *rateLimitedBackend) CertifyGoodList(ctx context.Context, certifyGoodSpec model.CertifyGoodSpec, pagination *model.PaginationSpec) (*model.CertifyGoodConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.CertifyGoodList(ctx, certifyGoodSpec, pagination)
+}
+
+func (r *rateLimitedBackend) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, pagination *model.PaginationSpec) (*model.CertifyVEXStatementConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.CertifyVEXStatementList(ctx, certifyVEXStatementSpec, pagination)
+}
+
+func (r *rateLimitedBackend) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, pagination *model.PaginationSpec) (*model.CertifyVulnConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.CertifyVulnList(ctx, certifyVulnSpec, pagination)
+}
+
+func (r *rateLimitedBackend) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, pagination *model.PaginationSpec) (*model.CertifyLegalConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.CertifyLegalList(ctx, certifyLegalSpec, pagination)
+}
+
+func (r *rateLimitedBackend) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, pagination *model.PaginationSpec) (*model.HasSBOMConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.HasSBOMList(ctx, hasSBOMSpec, pagination)
+}
+
+func (r *rateLimitedBackend) HasSLSAList(ctx context.Context, hasSLSASpec model.HasSLSASpec, pagination *model.PaginationSpec) (*model.HasSLSAConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.HasSLSAList(ctx, hasSLSASpec, pagination)
+}
+
+func (r *rateLimitedBackend) HasSourceAtList(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec, pagination *model.PaginationSpec) (*model.HasSourceAtConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.HasSourceAtList(ctx, hasSourceAtSpec, pagination)
+}
+
+func (r *rateLimitedBackend) HasMetadataList(ctx context.Context, hasMetadataSpec model.HasMetadataSpec, pagination *model.PaginationSpec) (*model.HasMetadataConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.HasMetadataList(ctx, hasMetadataSpec, pagination)
+}
+
+func (r *rateLimitedBackend) HashEqualList(ctx context.Context, hashEqualSpec model.HashEqualSpec, pagination *model.PaginationSpec) (*model.HashEqualConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.HashEqualList(ctx, hashEqualSpec, pagination)
+}
+
+func (r *rateLimitedBackend) IsDependencyList(ctx context.Context, isDependencySpec model.IsDependencySpec, pagination *model.PaginationSpec) (*model.IsDependencyConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IsDependencyList(ctx, isDependencySpec, pagination)
+}
+
+func (r *rateLimitedBackend) IsOccurrenceList(ctx context.Context, isOccurrenceSpec model.IsOccurrenceSpec, pagination *model.PaginationSpec) (*model.IsOccurrenceConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IsOccurrenceList(ctx, isOccurrenceSpec, pagination)
+}
+
+func (r *rateLimitedBackend) PkgEqualList(ctx context.Context, pkgEqualSpec model.PkgEqualSpec, pagination *model.PaginationSpec) (*model.PkgEqualConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.PkgEqualList(ctx, pkgEqualSpec, pagination)
+}
+
+func (r *rateLimitedBackend) PointOfContactList(ctx context.Context, pointOfContactSpec model.PointOfContactSpec, pagination *model.PaginationSpec) (*model.PointOfContactConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.PointOfContactList(ctx, pointOfContactSpec, pagination)
+}
+
+func (r *rateLimitedBackend) ScorecardsList(ctx context.Context, certifyScorecardSpec model.CertifyScorecardSpec, pagination *model.PaginationSpec) (*model.CertifyScorecardConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.ScorecardsList(ctx, certifyScorecardSpec, pagination)
+}
+
+func (r *rateLimitedBackend) VulnEqualList(ctx context.Context, vulnEqualSpec model.VulnEqualSpec, pagination *model.PaginationSpec) (*model.VulnEqualConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.VulnEqualList(ctx, vulnEqualSpec, pagination)
+}
+
+func (r *rateLimitedBackend) VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, pagination *model.PaginationSpec) (*model.VulnerabilityMetadataConnection, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.VulnerabilityMetadataList(ctx, vulnerabilityMetadataSpec, pagination)
+}
+
+func (r *rateLimitedBackend) PackagesCount(ctx context.Context, pkgSpec model.PkgSpec) (int, error) {
+	if err := r.wait(ctx); err != nil {
+		return 0, err
+	}
+	return r.inner.PackagesCount(ctx, pkgSpec)
+}
+
+func (r *rateLimitedBackend) SourcesCount(ctx context.Context, sourceSpec model.SourceSpec) (int, error) {
+	if err := r.wait(ctx); err != nil {
+		return 0, err
+	}
+	return r.inner.SourcesCount(ctx, sourceSpec)
+}
+
+func (r *rateLimitedBackend) CertifyVulnCount(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec) (int, error) {
+	if err := r.wait(ctx); err != nil {
+		return 0, err
+	}
+	return r.inner.CertifyVulnCount(ctx, certifyVulnSpec)
+}
+
+func (r *rateLimitedBackend) HasSourceAtCount(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec) (int, error) {
+	if err := r.wait(ctx); err != nil {
+		return 0, err
+	}
+	return r.inner.HasSourceAtCount(ctx, hasSourceAtSpec)
+}
+
+func (r *rateLimitedBackend) IngestArtifact(ctx context.Context, artifact *model.IDorArtifactInput) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestArtifact(ctx, artifact)
+}
+
+func (r *rateLimitedBackend) IngestArtifacts(ctx context.Context, artifacts []*model.IDorArtifactInput) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestArtifacts(ctx, artifacts)
+}
+
+func (r *rateLimitedBackend) IngestBuilder(ctx context.Context, builder *model.IDorBuilderInput) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestBuilder(ctx, builder)
+}
+
+func (r *rateLimitedBackend) IngestBuilders(ctx context.Context, builders []*model.IDorBuilderInput) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestBuilders(ctx, builders)
+}
+
+func (r *rateLimitedBackend) IngestLicense(ctx context.Context, license *model.IDorLicenseInput) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestLicense(ctx, license)
+}
+
+func (r *rateLimitedBackend) IngestLicenses(ctx context.Context, licenses []*model.IDorLicenseInput) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestLicenses(ctx, licenses)
+}
+
+func (r *rateLimitedBackend) IngestPackage(ctx context.Context, pkg model.IDorPkgInput) (*model.PackageIDs, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestPackage(ctx, pkg)
+}
+
+func (r *rateLimitedBackend) IngestPackages(ctx context.Context, pkgs []*model.IDorPkgInput) ([]*model.PackageIDs, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestPackages(ctx, pkgs)
+}
+
+func (r *rateLimitedBackend) IngestSource(ctx context.Context, source model.IDorSourceInput) (*model.SourceIDs, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestSource(ctx, source)
+}
+
+func (r *rateLimitedBackend) IngestSources(ctx context.Context, sources []*model.IDorSourceInput) ([]*model.SourceIDs, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestSources(ctx, sources)
+}
+
+func (r *rateLimitedBackend) IngestVulnerability(ctx context.Context, vuln model.IDorVulnerabilityInput) (*model.VulnerabilityIDs, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestVulnerability(ctx, vuln)
+}
+
+func (r *rateLimitedBackend) IngestVulnerabilities(ctx context.Context, vulns []*model.IDorVulnerabilityInput) ([]*model.VulnerabilityIDs, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestVulnerabilities(ctx, vulns)
+}
+
+func (r *rateLimitedBackend) IngestCertifyBad(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyBad model.CertifyBadInputSpec) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestCertifyBad(ctx, subject, pkgMatchType, certifyBad)
+}
+
+func (r *rateLimitedBackend) IngestCertifyBads(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, certifyBads []*model.CertifyBadInputSpec) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestCertifyBads(ctx, subjects, pkgMatchType, certifyBads)
+}
+
+func (r *rateLimitedBackend) IngestCertifyGood(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyGood model.CertifyGoodInputSpec) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestCertifyGood(ctx, subject, pkgMatchType, certifyGood)
+}
+
+func (r *rateLimitedBackend) IngestCertifyGoods(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, certifyGoods []*model.CertifyGoodInputSpec) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestCertifyGoods(ctx, subjects, pkgMatchType, certifyGoods)
+}
+
+func (r *rateLimitedBackend) IngestCertifyVuln(ctx context.Context, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, certifyVuln model.ScanMetadataInput) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestCertifyVuln(ctx, pkg, vulnerability, certifyVuln)
+}
+
+func (r *rateLimitedBackend) IngestCertifyVulns(ctx context.Context, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, certifyVulns []*model.ScanMetadataInput) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestCertifyVulns(ctx, pkgs, vulnerabilities, certifyVulns)
+}
+
+func (r *rateLimitedBackend) IngestCertifyLegal(ctx context.Context, subject model.PackageOrSourceInput, declaredLicenses []*model.IDorLicenseInput, discoveredLicenses []*model.IDorLicenseInput, certifyLegal *model.CertifyLegalInputSpec) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestCertifyLegal(ctx, subject, declaredLicenses, discoveredLicenses, certifyLegal)
+}
+
+func (r *rateLimitedBackend) IngestCertifyLegals(ctx context.Context, subjects model.PackageOrSourceInputs, declaredLicensesList [][]*model.IDorLicenseInput, discoveredLicensesList [][]*model.IDorLicenseInput, certifyLegals []*model.CertifyLegalInputSpec) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestCertifyLegals(ctx, subjects, declaredLicensesList, discoveredLicensesList, certifyLegals)
+}
+
+func (r *rateLimitedBackend) IngestDependency(ctx context.Context, pkg model.IDorPkgInput, depPkg model.IDorPkgInput, depPkgMatchType model.MatchFlags, dependency model.IsDependencyInputSpec) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestDependency(ctx, pkg, depPkg, depPkgMatchType, dependency)
+}
+
+func (r *rateLimitedBackend) IngestDependencies(ctx context.Context, pkgs []*model.IDorPkgInput, depPkgs []*model.IDorPkgInput, depPkgMatchType model.MatchFlags, dependencies []*model.IsDependencyInputSpec) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestDependencies(ctx, pkgs, depPkgs, depPkgMatchType, dependencies)
+}
+
+func (r *rateLimitedBackend) IngestExploitReference(ctx context.Context, vulnerability model.IDorVulnerabilityInput, exploitReference model.ExploitReferenceInputSpec) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestExploitReference(ctx, vulnerability, exploitReference)
+}
+
+func (r *rateLimitedBackend) IngestHasSbom(ctx context.Context, subject model.PackageOrArtifactInput, hasSbom model.HasSBOMInputSpec, includes model.HasSBOMIncludesInputSpec) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestHasSbom(ctx, subject, hasSbom, includes)
+}
+
+func (r *rateLimitedBackend) IngestHasSBOMs(ctx context.Context, subjects model.PackageOrArtifactInputs, hasSBOMs []*model.HasSBOMInputSpec, includes []*model.HasSBOMIncludesInputSpec) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestHasSBOMs(ctx, subjects, hasSBOMs, includes)
+}
+
+func (r *rateLimitedBackend) IngestHasSourceAt(ctx context.Context, pkg model.IDorPkgInput, pkgMatchType model.MatchFlags, source model.IDorSourceInput, hasSourceAt model.HasSourceAtInputSpec) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestHasSourceAt(ctx, pkg, pkgMatchType, source, hasSourceAt)
+}
+
+func (r *rateLimitedBackend) IngestHasSourceAts(ctx context.Context, pkgs []*model.IDorPkgInput, pkgMatchType *model.MatchFlags, sources []*model.IDorSourceInput, hasSourceAts []*model.HasSourceAtInputSpec) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestHasSourceAts(ctx, pkgs, pkgMatchType, sources, hasSourceAts)
+}
+
+func (r *rateLimitedBackend) IngestHasMetadata(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, hasMetadata model.HasMetadataInputSpec) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestHasMetadata(ctx, subject, pkgMatchType, hasMetadata)
+}
+
+func (r *rateLimitedBackend) IngestBulkHasMetadata(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, hasMetadataList []*model.HasMetadataInputSpec) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestBulkHasMetadata(ctx, subjects, pkgMatchType, hasMetadataList)
+}
+
+func (r *rateLimitedBackend) IngestHashEqual(ctx context.Context, artifact model.IDorArtifactInput, equalArtifact model.IDorArtifactInput, hashEqual model.HashEqualInputSpec) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestHashEqual(ctx, artifact, equalArtifact, hashEqual)
+}
+
+func (r *rateLimitedBackend) IngestHashEquals(ctx context.Context, artifacts []*model.IDorArtifactInput, otherArtifacts []*model.IDorArtifactInput, hashEquals []*model.HashEqualInputSpec) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestHashEquals(ctx, artifacts, otherArtifacts, hashEquals)
+}
+
+func (r *rateLimitedBackend) IngestOccurrence(ctx context.Context, subject model.PackageOrSourceInput, artifact model.IDorArtifactInput, occurrence model.IsOccurrenceInputSpec) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestOccurrence(ctx, subject, artifact, occurrence)
+}
+
+func (r *rateLimitedBackend) IngestOccurrences(ctx context.Context, subjects model.PackageOrSourceInputs, artifacts []*model.IDorArtifactInput, occurrences []*model.IsOccurrenceInputSpec) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestOccurrences(ctx, subjects, artifacts, occurrences)
+}
+
+func (r *rateLimitedBackend) IngestPkgEqual(ctx context.Context, pkg model.IDorPkgInput, depPkg model.IDorPkgInput, pkgEqual model.PkgEqualInputSpec) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestPkgEqual(ctx, pkg, depPkg, pkgEqual)
+}
+
+func (r *rateLimitedBackend) IngestPkgEquals(ctx context.Context, pkgs []*model.IDorPkgInput, otherPackages []*model.IDorPkgInput, pkgEquals []*model.PkgEqualInputSpec) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestPkgEquals(ctx, pkgs, otherPackages, pkgEquals)
+}
+
+func (r *rateLimitedBackend) IngestPointOfContact(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, pointOfContact model.PointOfContactInputSpec) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestPointOfContact(ctx, subject, pkgMatchType, pointOfContact)
+}
+
+func (r *rateLimitedBackend) IngestPointOfContacts(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, pointOfContacts []*model.PointOfContactInputSpec) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestPointOfContacts(ctx, subjects, pkgMatchType, pointOfContacts)
+}
+
+func (r *rateLimitedBackend) IngestSLSA(ctx context.Context, subject model.IDorArtifactInput, builtFrom []*model.IDorArtifactInput, builtBy model.IDorBuilderInput, slsa model.SLSAInputSpec) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestSLSA(ctx, subject, builtFrom, builtBy, slsa)
+}
+
+func (r *rateLimitedBackend) IngestSLSAs(ctx context.Context, subjects []*model.IDorArtifactInput, builtFromList [][]*model.IDorArtifactInput, builtByList []*model.IDorBuilderInput, slsaList []*model.SLSAInputSpec) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestSLSAs(ctx, subjects, builtFromList, builtByList, slsaList)
+}
+
+func (r *rateLimitedBackend) IngestScorecard(ctx context.Context, source model.IDorSourceInput, scorecard model.ScorecardInputSpec) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestScorecard(ctx, source, scorecard)
+}
+
+func (r *rateLimitedBackend) IngestScorecards(ctx context.Context, sources []*model.IDorSourceInput, scorecards []*model.ScorecardInputSpec) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestScorecards(ctx, sources, scorecards)
+}
+
+func (r *rateLimitedBackend) IngestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.IDorVulnerabilityInput, vexStatement model.VexStatementInputSpec) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestVEXStatement(ctx, subject, vulnerability, vexStatement)
+}
+
+func (r *rateLimitedBackend) IngestVEXStatements(ctx context.Context, subjects model.PackageOrArtifactInputs, vulnerabilities []*model.IDorVulnerabilityInput, vexStatements []*model.VexStatementInputSpec) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestVEXStatements(ctx, subjects, vulnerabilities, vexStatements)
+}
+
+func (r *rateLimitedBackend) IngestVulnEqual(ctx context.Context, vulnerability model.IDorVulnerabilityInput, otherVulnerability model.IDorVulnerabilityInput, vulnEqual model.VulnEqualInputSpec) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestVulnEqual(ctx, vulnerability, otherVulnerability, vulnEqual)
+}
+
+func (r *rateLimitedBackend) IngestVulnEquals(ctx context.Context, vulnerabilities []*model.IDorVulnerabilityInput, otherVulnerabilities []*model.IDorVulnerabilityInput, vulnEquals []*model.VulnEqualInputSpec) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestVulnEquals(ctx, vulnerabilities, otherVulnerabilities, vulnEquals)
+}
+
+func (r *rateLimitedBackend) IngestVulnerabilityMetadata(ctx context.Context, vulnerability model.IDorVulnerabilityInput, vulnerabilityMetadata model.VulnerabilityMetadataInputSpec) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.IngestVulnerabilityMetadata(ctx, vulnerability, vulnerabilityMetadata)
+}
+
+func (r *rateLimitedBackend) IngestBulkVulnerabilityMetadata(ctx context.Context, vulnerabilities []*model.IDorVulnerabilityInput, vulnerabilityMetadataList []*model.VulnerabilityMetadataInputSpec) ([]string, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.IngestBulkVulnerabilityMetadata(ctx, vulnerabilities, vulnerabilityMetadataList)
+}
+
+func (r *rateLimitedBackend) ArchiveCertifyVulns(ctx context.Context, olderThan time.Duration) (int, error) {
+	if err := r.wait(ctx); err != nil {
+		return 0, err
+	}
+	return r.inner.ArchiveCertifyVulns(ctx, olderThan)
+}
+
+func (r *rateLimitedBackend) MarkStaleVulns(ctx context.Context, olderThan time.Duration) (int, error) {
+	if err := r.wait(ctx); err != nil {
+		return 0, err
+	}
+	return r.inner.MarkStaleVulns(ctx, olderThan)
+}
+
+func (r *rateLimitedBackend) UpdateHasSourceAt(ctx context.Context, id string, hasSourceAt model.HasSourceAtInputSpec) (*model.HasSourceAt, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.UpdateHasSourceAt(ctx, id, hasSourceAt)
+}
+
+func (r *rateLimitedBackend) UpdatePointOfContact(ctx context.Context, id string, pointOfContact model.PointOfContactInputSpec) (*model.PointOfContact, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.UpdatePointOfContact(ctx, id, pointOfContact)
+}
+
+func (r *rateLimitedBackend) UpdateCertifyScorecard(ctx context.Context, id string, scorecard model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.UpdateCertifyScorecard(ctx, id, scorecard)
+}
+
+func (r *rateLimitedBackend) UpdateCertifyVulnResolution(ctx context.Context, id string, resolvedByID string) (*model.CertifyVuln, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.UpdateCertifyVulnResolution(ctx, id, resolvedByID)
+}
+
+func (r *rateLimitedBackend) DeleteCertifyVuln(ctx context.Context, id string) error {
+	if err := r.wait(ctx); err != nil {
+		return err
+	}
+	return r.inner.DeleteCertifyVuln(ctx, id)
+}
+
+func (r *rateLimitedBackend) DeleteSource(ctx context.Context, id string) error {
+	if err := r.wait(ctx); err != nil {
+		return err
+	}
+	return r.inner.DeleteSource(ctx, id)
+}
+
+func (r *rateLimitedBackend) MergePackages(ctx context.Context, primary string, duplicates []string) (*model.Package, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.MergePackages(ctx, primary, duplicates)
+}
+
+func (r *rateLimitedBackend) CheckScannerFreshness(ctx context.Context, scannerURI string, maxAge time.Duration) (*model.ScannerFreshnessResult, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.CheckScannerFreshness(ctx, scannerURI, maxAge)
+}
+
+func (r *rateLimitedBackend) SBOMComponentBreakdown(ctx context.Context, hasSBOMID string) ([]*model.ComponentTypeCount, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.SBOMComponentBreakdown(ctx, hasSBOMID)
+}
+
+func (r *rateLimitedBackend) GetProvenance(ctx context.Context, pkgID string) (*model.ProvenanceSummary, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.GetProvenance(ctx, pkgID)
+}
+
+func (r *rateLimitedBackend) CertifyVulnAdded(ctx context.Context, filter *model.CertifyVulnSpec) (<-chan *model.CertifyVuln, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.CertifyVulnAdded(ctx, filter)
+}
+
+func (r *rateLimitedBackend) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.Neighbors(ctx, node, usingOnly)
+}
+
+func (r *rateLimitedBackend) NeighborsRecursive(ctx context.Context, node string, usingOnly []model.Edge, maxDepth int) ([]model.Node, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.NeighborsRecursive(ctx, node, usingOnly, maxDepth)
+}
+
+func (r *rateLimitedBackend) Node(ctx context.Context, node string) (model.Node, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.Node(ctx, node)
+}
+
+func (r *rateLimitedBackend) Nodes(ctx context.Context, nodes []string) ([]model.Node, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.Nodes(ctx, nodes)
+}
+
+func (r *rateLimitedBackend) BatchNodes(ctx context.Context, nodes []string) ([]model.Node, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.BatchNodes(ctx, nodes)
+}
+
+func (r *rateLimitedBackend) NodeType(ctx context.Context, node string) (string, error) {
+	if err := r.wait(ctx); err != nil {
+		return "", err
+	}
+	return r.inner.NodeType(ctx, node)
+}
+
+func (r *rateLimitedBackend) Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.Path(ctx, subject, target, maxPathLength, usingOnly)
+}
+
+func (r *rateLimitedBackend) FindSoftware(ctx context.Context, searchText string) ([]model.PackageSourceOrArtifact, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.inner.FindSoftware(ctx, searchText)
+}
@@ -0,0 +1,85 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/pkg/assembler/backends/ratelimit"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestRateLimitBlocks(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	b.EXPECT().IngestCertifyVulns(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"1"}, nil).Times(3)
+	r := ratelimit.NewRateLimitedBackend(b, 20, 2)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		ids, err := r.IngestCertifyVulns(ctx, nil, nil, nil)
+		if err != nil {
+			t.Fatalf("IngestCertifyVulns() error = %v", err)
+		}
+		if len(ids) != 1 || ids[0] != "1" {
+			t.Errorf("IngestCertifyVulns() = %v, want [1]", ids)
+		}
+	}
+	// the burst is used up by the first two calls, the third one waits for
+	// a token, which is added every 50ms
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("calls over the burst did not block, took %v", elapsed)
+	}
+}
+
+func TestRateLimitDeadline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	b.EXPECT().Packages(gomock.Any(), gomock.Any()).Return([]*model.Package{}, nil).Times(1)
+	r := ratelimit.NewRateLimitedBackend(b, 0.1, 1)
+
+	if _, err := r.Packages(context.Background(), &model.PkgSpec{}); err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	// the next token is 10s away, past the deadline, so the call fails
+	// without reaching the backend
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := r.Packages(ctx, &model.PkgSpec{}); err == nil {
+		t.Errorf("Packages() expected error")
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("call waited %v for a token it could not get before the deadline", elapsed)
+	}
+}
+
+func TestRateLimitCanceled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	r := ratelimit.NewRateLimitedBackend(b, 1, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := r.PackagesCount(ctx, model.PkgSpec{}); err == nil {
+		t.Errorf("PackagesCount() expected error")
+	}
+}
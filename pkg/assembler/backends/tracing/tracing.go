@@ -0,0 +1,1054 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tracing wraps a backend with OpenTelemetry spans around every call,
+// so that the time spent in the database can be told apart from the time spent
+// resolving the GraphQL operations.
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const spanPrefix = "guac.backend."
+
+// tracedBackend wraps every method of a backend in a span named after the
+// method. The methods are forwarded explicitly instead of embedding the
+// wrapped backend so that a method added to backends.Backend cannot be left
+// untraced.
+type tracedBackend struct {
+	inner  backends.Backend
+	tracer trace.Tracer
+}
+
+// NewTracedBackend returns a backend that records a span for each call to
+// inner, named guac.backend.<MethodName>. The spans carry attributes for the
+// packages, sources, vulnerabilities and artifacts the call is about, the
+// number of entries of bulk ingestion calls and the IDs of the nodes queried
+// by ID. Calls that fail are marked with an error status.
+func NewTracedBackend(inner backends.Backend, tracer trace.Tracer) backends.Backend {
+	return &tracedBackend{inner: inner, tracer: tracer}
+}
+
+func (t *tracedBackend) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return t.tracer.Start(ctx, spanPrefix+method, trace.WithAttributes(attrs...))
+}
+
+// recordError marks span as failed if err is not nil and returns err
+func recordError(span trace.Span, err error) error {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+func appendString(attrs []attribute.KeyValue, key string, value *string) []attribute.KeyValue {
+	if value == nil {
+		return attrs
+	}
+	return append(attrs, attribute.String(key, *value))
+}
+
+func pkgSpecAttributes(spec *model.PkgSpec) []attribute.KeyValue {
+	if spec == nil {
+		return nil
+	}
+	var attrs []attribute.KeyValue
+	attrs = appendString(attrs, "pkg.node.id", spec.ID)
+	attrs = appendString(attrs, "pkg.type", spec.Type)
+	attrs = appendString(attrs, "pkg.namespace", spec.Namespace)
+	attrs = appendString(attrs, "pkg.name", spec.Name)
+	return appendString(attrs, "pkg.version", spec.Version)
+}
+
+func pkgInputAttributes(pkg *model.IDorPkgInput) []attribute.KeyValue {
+	if pkg == nil {
+		return nil
+	}
+	if pkg.PackageInput == nil {
+		return appendString(nil, "pkg.node.id", pkg.PackageVersionID)
+	}
+	attrs := []attribute.KeyValue{attribute.String("pkg.type", pkg.PackageInput.Type)}
+	attrs = appendString(attrs, "pkg.namespace", pkg.PackageInput.Namespace)
+	attrs = append(attrs, attribute.String("pkg.name", pkg.PackageInput.Name))
+	return appendString(attrs, "pkg.version", pkg.PackageInput.Version)
+}
+
+func sourceSpecAttributes(spec *model.SourceSpec) []attribute.KeyValue {
+	if spec == nil {
+		return nil
+	}
+	var attrs []attribute.KeyValue
+	attrs = appendString(attrs, "src.node.id", spec.ID)
+	attrs = appendString(attrs, "src.type", spec.Type)
+	attrs = appendString(attrs, "src.namespace", spec.Namespace)
+	return appendString(attrs, "src.name", spec.Name)
+}
+
+func sourceInputAttributes(src *model.IDorSourceInput) []attribute.KeyValue {
+	if src == nil {
+		return nil
+	}
+	if src.SourceInput == nil {
+		return appendString(nil, "src.node.id", src.SourceNameID)
+	}
+	return []attribute.KeyValue{
+		attribute.String("src.type", src.SourceInput.Type),
+		attribute.String("src.namespace", src.SourceInput.Namespace),
+		attribute.String("src.name", src.SourceInput.Name),
+	}
+}
+
+func vulnSpecAttributes(spec *model.VulnerabilitySpec) []attribute.KeyValue {
+	if spec == nil {
+		return nil
+	}
+	var attrs []attribute.KeyValue
+	attrs = appendString(attrs, "vuln.node.id", spec.ID)
+	attrs = appendString(attrs, "vuln.type", spec.Type)
+	return appendString(attrs, "vuln.id", spec.VulnerabilityID)
+}
+
+func vulnInputAttributes(vuln *model.IDorVulnerabilityInput) []attribute.KeyValue {
+	if vuln == nil {
+		return nil
+	}
+	if vuln.VulnerabilityInput == nil {
+		return appendString(nil, "vuln.node.id", vuln.VulnerabilityNodeID)
+	}
+	return []attribute.KeyValue{
+		attribute.String("vuln.type", vuln.VulnerabilityInput.Type),
+		attribute.String("vuln.id", vuln.VulnerabilityInput.VulnerabilityID),
+	}
+}
+
+func artifactSpecAttributes(spec *model.ArtifactSpec) []attribute.KeyValue {
+	if spec == nil {
+		return nil
+	}
+	var attrs []attribute.KeyValue
+	attrs = appendString(attrs, "artifact.node.id", spec.ID)
+	attrs = appendString(attrs, "artifact.algorithm", spec.Algorithm)
+	return appendString(attrs, "artifact.digest", spec.Digest)
+}
+
+func artifactInputAttributes(art *model.IDorArtifactInput) []attribute.KeyValue {
+	if art == nil {
+		return nil
+	}
+	if art.ArtifactInput == nil {
+		return appendString(nil, "artifact.node.id", art.ArtifactID)
+	}
+	return []attribute.KeyValue{
+		attribute.String("artifact.algorithm", art.ArtifactInput.Algorithm),
+		attribute.String("artifact.digest", art.ArtifactInput.Digest),
+	}
+}
+
+// subjectAttributes returns the attributes of whichever of the subjects of an
+// evidence node is set
+func subjectAttributes(pkg *model.IDorPkgInput, src *model.IDorSourceInput, art *model.IDorArtifactInput) []attribute.KeyValue {
+	switch {
+	case pkg != nil:
+		return pkgInputAttributes(pkg)
+	case src != nil:
+		return sourceInputAttributes(src)
+	default:
+		return artifactInputAttributes(art)
+	}
+}
+
+func certifyVulnSpecAttributes(spec *model.CertifyVulnSpec) []attribute.KeyValue {
+	if spec == nil {
+		return nil
+	}
+	attrs := appendString(nil, "node.id", spec.ID)
+	attrs = append(attrs, pkgSpecAttributes(spec.Package)...)
+	return append(attrs, vulnSpecAttributes(spec.Vulnerability)...)
+}
+
+func (t *tracedBackend) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
+	ctx, span := t.start(ctx, "Artifacts", artifactSpecAttributes(artifactSpec)...)
+	defer span.End()
+	r, err := t.inner.Artifacts(ctx, artifactSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
+	ctx, span := t.start(ctx, "Builders")
+	defer span.End()
+	r, err := t.inner.Builders(ctx, builderSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) Licenses(ctx context.Context, licenseSpec *model.LicenseSpec) ([]*model.License, error) {
+	ctx, span := t.start(ctx, "Licenses")
+	defer span.End()
+	r, err := t.inner.Licenses(ctx, licenseSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+	ctx, span := t.start(ctx, "Packages", pkgSpecAttributes(pkgSpec)...)
+	defer span.End()
+	r, err := t.inner.Packages(ctx, pkgSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
+	ctx, span := t.start(ctx, "Sources", sourceSpecAttributes(sourceSpec)...)
+	defer span.End()
+	r, err := t.inner.Sources(ctx, sourceSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) Vulnerabilities(ctx context.Context, vulnSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
+	ctx, span := t.start(ctx, "Vulnerabilities", vulnSpecAttributes(vulnSpec)...)
+	defer span.End()
+	r, err := t.inner.Vulnerabilities(ctx, vulnSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+	ctx, span := t.start(ctx, "CertifyBad")
+	defer span.End()
+	r, err := t.inner.CertifyBad(ctx, certifyBadSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
+	ctx, span := t.start(ctx, "CertifyGood")
+	defer span.End()
+	r, err := t.inner.CertifyGood(ctx, certifyGoodSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+	ctx, span := t.start(ctx, "CertifyVEXStatement")
+	defer span.End()
+	r, err := t.inner.CertifyVEXStatement(ctx, certifyVEXStatementSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) ArchivedCertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	ctx, span := t.start(ctx, "ArchivedCertifyVuln", certifyVulnSpecAttributes(certifyVulnSpec)...)
+	defer span.End()
+	r, err := t.inner.ArchivedCertifyVuln(ctx, certifyVulnSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	ctx, span := t.start(ctx, "CertifyVuln", certifyVulnSpecAttributes(certifyVulnSpec)...)
+	defer span.End()
+	r, err := t.inner.CertifyVuln(ctx, certifyVulnSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) CertifyVulnByVulnerabilityIDs(ctx context.Context, vulnerabilityIDs []string, certifyVulnSpec *model.CertifyVulnSpec) (map[string][]*model.CertifyVuln, error) {
+	ctx, span := t.start(ctx, "CertifyVulnByVulnerabilityIDs", append(certifyVulnSpecAttributes(certifyVulnSpec), attribute.Int("vulnerability.count", len(vulnerabilityIDs)))...)
+	defer span.End()
+	r, err := t.inner.CertifyVulnByVulnerabilityIDs(ctx, vulnerabilityIDs, certifyVulnSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) CertifyVulnByPackageIDs(ctx context.Context, packageIDs []string, certifyVulnSpec *model.CertifyVulnSpec) (map[string][]*model.CertifyVuln, error) {
+	ctx, span := t.start(ctx, "CertifyVulnByPackageIDs", append(certifyVulnSpecAttributes(certifyVulnSpec), attribute.Int("package.count", len(packageIDs)))...)
+	defer span.End()
+	r, err := t.inner.CertifyVulnByPackageIDs(ctx, packageIDs, certifyVulnSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) TopVulnerablePackages(ctx context.Context, limit int, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.PackageVulnCount, error) {
+	ctx, span := t.start(ctx, "TopVulnerablePackages", append(certifyVulnSpecAttributes(certifyVulnSpec), attribute.Int("limit", limit))...)
+	defer span.End()
+	r, err := t.inner.TopVulnerablePackages(ctx, limit, certifyVulnSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) GetCertifyVulnsByDocumentRef(ctx context.Context, documentRef string) ([]*model.CertifyVuln, error) {
+	ctx, span := t.start(ctx, "GetCertifyVulnsByDocumentRef", attribute.String("documentRef", documentRef))
+	defer span.End()
+	r, err := t.inner.GetCertifyVulnsByDocumentRef(ctx, documentRef)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
+	ctx, span := t.start(ctx, "CertifyLegal")
+	defer span.End()
+	r, err := t.inner.CertifyLegal(ctx, certifyLegalSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) ExploitReferences(ctx context.Context, exploitReferenceSpec *model.ExploitReferenceSpec) ([]*model.ExploitReference, error) {
+	ctx, span := t.start(ctx, "ExploitReferences")
+	defer span.End()
+	r, err := t.inner.ExploitReferences(ctx, exploitReferenceSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	ctx, span := t.start(ctx, "HasSBOM")
+	defer span.End()
+	r, err := t.inner.HasSBOM(ctx, hasSBOMSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
+	ctx, span := t.start(ctx, "HasSlsa")
+	defer span.End()
+	r, err := t.inner.HasSlsa(ctx, hasSLSASpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec) ([]*model.HasSourceAt, error) {
+	ctx, span := t.start(ctx, "HasSourceAt")
+	defer span.End()
+	r, err := t.inner.HasSourceAt(ctx, hasSourceAtSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) HasMetadata(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec) ([]*model.HasMetadata, error) {
+	ctx, span := t.start(ctx, "HasMetadata")
+	defer span.End()
+	r, err := t.inner.HasMetadata(ctx, hasMetadataSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
+	ctx, span := t.start(ctx, "HashEqual")
+	defer span.End()
+	r, err := t.inner.HashEqual(ctx, hashEqualSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
+	ctx, span := t.start(ctx, "IsDependency")
+	defer span.End()
+	r, err := t.inner.IsDependency(ctx, isDependencySpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
+	ctx, span := t.start(ctx, "IsOccurrence")
+	defer span.End()
+	r, err := t.inner.IsOccurrence(ctx, isOccurrenceSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec) ([]*model.PkgEqual, error) {
+	ctx, span := t.start(ctx, "PkgEqual")
+	defer span.End()
+	r, err := t.inner.PkgEqual(ctx, pkgEqualSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) PointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
+	ctx, span := t.start(ctx, "PointOfContact")
+	defer span.End()
+	r, err := t.inner.PointOfContact(ctx, pointOfContactSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+	ctx, span := t.start(ctx, "Scorecards")
+	defer span.End()
+	r, err := t.inner.Scorecards(ctx, certifyScorecardSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error) {
+	ctx, span := t.start(ctx, "VulnEqual")
+	defer span.End()
+	r, err := t.inner.VulnEqual(ctx, vulnEqualSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) VulnerabilityMetadata(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec) ([]*model.VulnerabilityMetadata, error) {
+	ctx, span := t.start(ctx, "VulnerabilityMetadata")
+	defer span.End()
+	r, err := t.inner.VulnerabilityMetadata(ctx, vulnerabilityMetadataSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) ArtifactsList(ctx context.Context, artifactSpec model.ArtifactSpec, pagination *model.PaginationSpec) (*model.ArtifactConnection, error) {
+	ctx, span := t.start(ctx, "ArtifactsList", artifactSpecAttributes(&artifactSpec)...)
+	defer span.End()
+	r, err := t.inner.ArtifactsList(ctx, artifactSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) BuildersList(ctx context.Context, builderSpec model.BuilderSpec, pagination *model.PaginationSpec) (*model.BuilderConnection, error) {
+	ctx, span := t.start(ctx, "BuildersList")
+	defer span.End()
+	r, err := t.inner.BuildersList(ctx, builderSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) LicensesList(ctx context.Context, licenseSpec model.LicenseSpec, pagination *model.PaginationSpec) (*model.LicenseConnection, error) {
+	ctx, span := t.start(ctx, "LicensesList")
+	defer span.End()
+	r, err := t.inner.LicensesList(ctx, licenseSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) PackagesList(ctx context.Context, pkgSpec model.PkgSpec, pagination *model.PaginationSpec) (*model.PackageConnection, error) {
+	ctx, span := t.start(ctx, "PackagesList", pkgSpecAttributes(&pkgSpec)...)
+	defer span.End()
+	r, err := t.inner.PackagesList(ctx, pkgSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) SourcesList(ctx context.Context, sourceSpec model.SourceSpec, pagination *model.PaginationSpec) (*model.SourceConnection, error) {
+	ctx, span := t.start(ctx, "SourcesList", sourceSpecAttributes(&sourceSpec)...)
+	defer span.End()
+	r, err := t.inner.SourcesList(ctx, sourceSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) VulnerabilitiesList(ctx context.Context, vulnSpec model.VulnerabilitySpec, pagination *model.PaginationSpec) (*model.VulnerabilityConnection, error) {
+	ctx, span := t.start(ctx, "VulnerabilitiesList", vulnSpecAttributes(&vulnSpec)...)
+	defer span.End()
+	r, err := t.inner.VulnerabilitiesList(ctx, vulnSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) CertifyBadList(ctx context.Context, certifyBadSpec model.CertifyBadSpec, pagination *model.PaginationSpec) (*model.CertifyBadConnection, error) {
+	ctx, span := t.start(ctx, "CertifyBadList")
+	defer span.End()
+	r, err := t.inner.CertifyBadList(ctx, certifyBadSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) CertifyGoodList(ctx context.Context, certifyGoodSpec model.CertifyGoodSpec, pagination *model.PaginationSpec) (*model.CertifyGoodConnection, error) {
+	ctx, span := t.start(ctx, "CertifyGoodList")
+	defer span.End()
+	r, err := t.inner.CertifyGoodList(ctx, certifyGoodSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, pagination *model.PaginationSpec) (*model.CertifyVEXStatementConnection, error) {
+	ctx, span := t.start(ctx, "CertifyVEXStatementList")
+	defer span.End()
+	r, err := t.inner.CertifyVEXStatementList(ctx, certifyVEXStatementSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, pagination *model.PaginationSpec) (*model.CertifyVulnConnection, error) {
+	ctx, span := t.start(ctx, "CertifyVulnList", certifyVulnSpecAttributes(&certifyVulnSpec)...)
+	defer span.End()
+	r, err := t.inner.CertifyVulnList(ctx, certifyVulnSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, pagination *model.PaginationSpec) (*model.CertifyLegalConnection, error) {
+	ctx, span := t.start(ctx, "CertifyLegalList")
+	defer span.End()
+	r, err := t.inner.CertifyLegalList(ctx, certifyLegalSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, pagination *model.PaginationSpec) (*model.HasSBOMConnection, error) {
+	ctx, span := t.start(ctx, "HasSBOMList")
+	defer span.End()
+	r, err := t.inner.HasSBOMList(ctx, hasSBOMSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) HasSLSAList(ctx context.Context, hasSLSASpec model.HasSLSASpec, pagination *model.PaginationSpec) (*model.HasSLSAConnection, error) {
+	ctx, span := t.start(ctx, "HasSLSAList")
+	defer span.End()
+	r, err := t.inner.HasSLSAList(ctx, hasSLSASpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) HasSourceAtList(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec, pagination *model.PaginationSpec) (*model.HasSourceAtConnection, error) {
+	ctx, span := t.start(ctx, "HasSourceAtList")
+	defer span.End()
+	r, err := t.inner.HasSourceAtList(ctx, hasSourceAtSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) HasMetadataList(ctx context.Context, hasMetadataSpec model.HasMetadataSpec, pagination *model.PaginationSpec) (*model.HasMetadataConnection, error) {
+	ctx, span := t.start(ctx, "HasMetadataList")
+	defer span.End()
+	r, err := t.inner.HasMetadataList(ctx, hasMetadataSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) HashEqualList(ctx context.Context, hashEqualSpec model.HashEqualSpec, pagination *model.PaginationSpec) (*model.HashEqualConnection, error) {
+	ctx, span := t.start(ctx, "HashEqualList")
+	defer span.End()
+	r, err := t.inner.HashEqualList(ctx, hashEqualSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IsDependencyList(ctx context.Context, isDependencySpec model.IsDependencySpec, pagination *model.PaginationSpec) (*model.IsDependencyConnection, error) {
+	ctx, span := t.start(ctx, "IsDependencyList")
+	defer span.End()
+	r, err := t.inner.IsDependencyList(ctx, isDependencySpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IsOccurrenceList(ctx context.Context, isOccurrenceSpec model.IsOccurrenceSpec, pagination *model.PaginationSpec) (*model.IsOccurrenceConnection, error) {
+	ctx, span := t.start(ctx, "IsOccurrenceList")
+	defer span.End()
+	r, err := t.inner.IsOccurrenceList(ctx, isOccurrenceSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) PkgEqualList(ctx context.Context, pkgEqualSpec model.PkgEqualSpec, pagination *model.PaginationSpec) (*model.PkgEqualConnection, error) {
+	ctx, span := t.start(ctx, "PkgEqualList")
+	defer span.End()
+	r, err := t.inner.PkgEqualList(ctx, pkgEqualSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) PointOfContactList(ctx context.Context, pointOfContactSpec model.PointOfContactSpec, pagination *model.PaginationSpec) (*model.PointOfContactConnection, error) {
+	ctx, span := t.start(ctx, "PointOfContactList")
+	defer span.End()
+	r, err := t.inner.PointOfContactList(ctx, pointOfContactSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) ScorecardsList(ctx context.Context, certifyScorecardSpec model.CertifyScorecardSpec, pagination *model.PaginationSpec) (*model.CertifyScorecardConnection, error) {
+	ctx, span := t.start(ctx, "ScorecardsList")
+	defer span.End()
+	r, err := t.inner.ScorecardsList(ctx, certifyScorecardSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) VulnEqualList(ctx context.Context, vulnEqualSpec model.VulnEqualSpec, pagination *model.PaginationSpec) (*model.VulnEqualConnection, error) {
+	ctx, span := t.start(ctx, "VulnEqualList")
+	defer span.End()
+	r, err := t.inner.VulnEqualList(ctx, vulnEqualSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, pagination *model.PaginationSpec) (*model.VulnerabilityMetadataConnection, error) {
+	ctx, span := t.start(ctx, "VulnerabilityMetadataList")
+	defer span.End()
+	r, err := t.inner.VulnerabilityMetadataList(ctx, vulnerabilityMetadataSpec, pagination)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) PackagesCount(ctx context.Context, pkgSpec model.PkgSpec) (int, error) {
+	ctx, span := t.start(ctx, "PackagesCount", pkgSpecAttributes(&pkgSpec)...)
+	defer span.End()
+	r, err := t.inner.PackagesCount(ctx, pkgSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) SourcesCount(ctx context.Context, sourceSpec model.SourceSpec) (int, error) {
+	ctx, span := t.start(ctx, "SourcesCount", sourceSpecAttributes(&sourceSpec)...)
+	defer span.End()
+	r, err := t.inner.SourcesCount(ctx, sourceSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) CertifyVulnCount(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec) (int, error) {
+	ctx, span := t.start(ctx, "CertifyVulnCount", certifyVulnSpecAttributes(&certifyVulnSpec)...)
+	defer span.End()
+	r, err := t.inner.CertifyVulnCount(ctx, certifyVulnSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) HasSourceAtCount(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec) (int, error) {
+	ctx, span := t.start(ctx, "HasSourceAtCount")
+	defer span.End()
+	r, err := t.inner.HasSourceAtCount(ctx, hasSourceAtSpec)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestArtifact(ctx context.Context, artifact *model.IDorArtifactInput) (string, error) {
+	ctx, span := t.start(ctx, "IngestArtifact", artifactInputAttributes(artifact)...)
+	defer span.End()
+	r, err := t.inner.IngestArtifact(ctx, artifact)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestArtifacts(ctx context.Context, artifacts []*model.IDorArtifactInput) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestArtifacts", attribute.Int("batch.size", len(artifacts)))
+	defer span.End()
+	r, err := t.inner.IngestArtifacts(ctx, artifacts)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestBuilder(ctx context.Context, builder *model.IDorBuilderInput) (string, error) {
+	ctx, span := t.start(ctx, "IngestBuilder")
+	defer span.End()
+	r, err := t.inner.IngestBuilder(ctx, builder)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestBuilders(ctx context.Context, builders []*model.IDorBuilderInput) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestBuilders", attribute.Int("batch.size", len(builders)))
+	defer span.End()
+	r, err := t.inner.IngestBuilders(ctx, builders)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestLicense(ctx context.Context, license *model.IDorLicenseInput) (string, error) {
+	ctx, span := t.start(ctx, "IngestLicense")
+	defer span.End()
+	r, err := t.inner.IngestLicense(ctx, license)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestLicenses(ctx context.Context, licenses []*model.IDorLicenseInput) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestLicenses", attribute.Int("batch.size", len(licenses)))
+	defer span.End()
+	r, err := t.inner.IngestLicenses(ctx, licenses)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestPackage(ctx context.Context, pkg model.IDorPkgInput) (*model.PackageIDs, error) {
+	ctx, span := t.start(ctx, "IngestPackage", pkgInputAttributes(&pkg)...)
+	defer span.End()
+	r, err := t.inner.IngestPackage(ctx, pkg)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestPackages(ctx context.Context, pkgs []*model.IDorPkgInput) ([]*model.PackageIDs, error) {
+	ctx, span := t.start(ctx, "IngestPackages", attribute.Int("batch.size", len(pkgs)))
+	defer span.End()
+	r, err := t.inner.IngestPackages(ctx, pkgs)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestSource(ctx context.Context, source model.IDorSourceInput) (*model.SourceIDs, error) {
+	ctx, span := t.start(ctx, "IngestSource", sourceInputAttributes(&source)...)
+	defer span.End()
+	r, err := t.inner.IngestSource(ctx, source)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestSources(ctx context.Context, sources []*model.IDorSourceInput) ([]*model.SourceIDs, error) {
+	ctx, span := t.start(ctx, "IngestSources", attribute.Int("batch.size", len(sources)))
+	defer span.End()
+	r, err := t.inner.IngestSources(ctx, sources)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestVulnerability(ctx context.Context, vuln model.IDorVulnerabilityInput) (*model.VulnerabilityIDs, error) {
+	ctx, span := t.start(ctx, "IngestVulnerability", vulnInputAttributes(&vuln)...)
+	defer span.End()
+	r, err := t.inner.IngestVulnerability(ctx, vuln)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestVulnerabilities(ctx context.Context, vulns []*model.IDorVulnerabilityInput) ([]*model.VulnerabilityIDs, error) {
+	ctx, span := t.start(ctx, "IngestVulnerabilities", attribute.Int("batch.size", len(vulns)))
+	defer span.End()
+	r, err := t.inner.IngestVulnerabilities(ctx, vulns)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestCertifyBad(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyBad model.CertifyBadInputSpec) (string, error) {
+	ctx, span := t.start(ctx, "IngestCertifyBad", subjectAttributes(subject.Package, subject.Source, subject.Artifact)...)
+	defer span.End()
+	r, err := t.inner.IngestCertifyBad(ctx, subject, pkgMatchType, certifyBad)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestCertifyBads(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, certifyBads []*model.CertifyBadInputSpec) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestCertifyBads", attribute.Int("batch.size", len(certifyBads)))
+	defer span.End()
+	r, err := t.inner.IngestCertifyBads(ctx, subjects, pkgMatchType, certifyBads)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestCertifyGood(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyGood model.CertifyGoodInputSpec) (string, error) {
+	ctx, span := t.start(ctx, "IngestCertifyGood", subjectAttributes(subject.Package, subject.Source, subject.Artifact)...)
+	defer span.End()
+	r, err := t.inner.IngestCertifyGood(ctx, subject, pkgMatchType, certifyGood)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestCertifyGoods(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, certifyGoods []*model.CertifyGoodInputSpec) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestCertifyGoods", attribute.Int("batch.size", len(certifyGoods)))
+	defer span.End()
+	r, err := t.inner.IngestCertifyGoods(ctx, subjects, pkgMatchType, certifyGoods)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestCertifyVuln(ctx context.Context, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, certifyVuln model.ScanMetadataInput) (string, error) {
+	ctx, span := t.start(ctx, "IngestCertifyVuln", append(pkgInputAttributes(&pkg), vulnInputAttributes(&vulnerability)...)...)
+	defer span.End()
+	r, err := t.inner.IngestCertifyVuln(ctx, pkg, vulnerability, certifyVuln)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestCertifyVulns(ctx context.Context, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, certifyVulns []*model.ScanMetadataInput) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestCertifyVulns", attribute.Int("batch.size", len(pkgs)))
+	defer span.End()
+	r, err := t.inner.IngestCertifyVulns(ctx, pkgs, vulnerabilities, certifyVulns)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestCertifyLegal(ctx context.Context, subject model.PackageOrSourceInput, declaredLicenses []*model.IDorLicenseInput, discoveredLicenses []*model.IDorLicenseInput, certifyLegal *model.CertifyLegalInputSpec) (string, error) {
+	ctx, span := t.start(ctx, "IngestCertifyLegal", subjectAttributes(subject.Package, subject.Source, nil)...)
+	defer span.End()
+	r, err := t.inner.IngestCertifyLegal(ctx, subject, declaredLicenses, discoveredLicenses, certifyLegal)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestCertifyLegals(ctx context.Context, subjects model.PackageOrSourceInputs, declaredLicensesList [][]*model.IDorLicenseInput, discoveredLicensesList [][]*model.IDorLicenseInput, certifyLegals []*model.CertifyLegalInputSpec) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestCertifyLegals", attribute.Int("batch.size", len(declaredLicensesList)))
+	defer span.End()
+	r, err := t.inner.IngestCertifyLegals(ctx, subjects, declaredLicensesList, discoveredLicensesList, certifyLegals)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestDependency(ctx context.Context, pkg model.IDorPkgInput, depPkg model.IDorPkgInput, depPkgMatchType model.MatchFlags, dependency model.IsDependencyInputSpec) (string, error) {
+	ctx, span := t.start(ctx, "IngestDependency", pkgInputAttributes(&pkg)...)
+	defer span.End()
+	r, err := t.inner.IngestDependency(ctx, pkg, depPkg, depPkgMatchType, dependency)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestDependencies(ctx context.Context, pkgs []*model.IDorPkgInput, depPkgs []*model.IDorPkgInput, depPkgMatchType model.MatchFlags, dependencies []*model.IsDependencyInputSpec) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestDependencies", attribute.Int("batch.size", len(pkgs)))
+	defer span.End()
+	r, err := t.inner.IngestDependencies(ctx, pkgs, depPkgs, depPkgMatchType, dependencies)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestExploitReference(ctx context.Context, vulnerability model.IDorVulnerabilityInput, exploitReference model.ExploitReferenceInputSpec) (string, error) {
+	ctx, span := t.start(ctx, "IngestExploitReference", vulnInputAttributes(&vulnerability)...)
+	defer span.End()
+	r, err := t.inner.IngestExploitReference(ctx, vulnerability, exploitReference)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestHasSbom(ctx context.Context, subject model.PackageOrArtifactInput, hasSbom model.HasSBOMInputSpec, includes model.HasSBOMIncludesInputSpec) (string, error) {
+	ctx, span := t.start(ctx, "IngestHasSbom", subjectAttributes(subject.Package, nil, subject.Artifact)...)
+	defer span.End()
+	r, err := t.inner.IngestHasSbom(ctx, subject, hasSbom, includes)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestHasSBOMs(ctx context.Context, subjects model.PackageOrArtifactInputs, hasSBOMs []*model.HasSBOMInputSpec, includes []*model.HasSBOMIncludesInputSpec) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestHasSBOMs", attribute.Int("batch.size", len(hasSBOMs)))
+	defer span.End()
+	r, err := t.inner.IngestHasSBOMs(ctx, subjects, hasSBOMs, includes)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestHasSourceAt(ctx context.Context, pkg model.IDorPkgInput, pkgMatchType model.MatchFlags, source model.IDorSourceInput, hasSourceAt model.HasSourceAtInputSpec) (string, error) {
+	ctx, span := t.start(ctx, "IngestHasSourceAt", append(pkgInputAttributes(&pkg), sourceInputAttributes(&source)...)...)
+	defer span.End()
+	r, err := t.inner.IngestHasSourceAt(ctx, pkg, pkgMatchType, source, hasSourceAt)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestHasSourceAts(ctx context.Context, pkgs []*model.IDorPkgInput, pkgMatchType *model.MatchFlags, sources []*model.IDorSourceInput, hasSourceAts []*model.HasSourceAtInputSpec) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestHasSourceAts", attribute.Int("batch.size", len(pkgs)))
+	defer span.End()
+	r, err := t.inner.IngestHasSourceAts(ctx, pkgs, pkgMatchType, sources, hasSourceAts)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestHasMetadata(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, hasMetadata model.HasMetadataInputSpec) (string, error) {
+	ctx, span := t.start(ctx, "IngestHasMetadata", subjectAttributes(subject.Package, subject.Source, subject.Artifact)...)
+	defer span.End()
+	r, err := t.inner.IngestHasMetadata(ctx, subject, pkgMatchType, hasMetadata)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestBulkHasMetadata(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, hasMetadataList []*model.HasMetadataInputSpec) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestBulkHasMetadata", attribute.Int("batch.size", len(hasMetadataList)))
+	defer span.End()
+	r, err := t.inner.IngestBulkHasMetadata(ctx, subjects, pkgMatchType, hasMetadataList)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestHashEqual(ctx context.Context, artifact model.IDorArtifactInput, equalArtifact model.IDorArtifactInput, hashEqual model.HashEqualInputSpec) (string, error) {
+	ctx, span := t.start(ctx, "IngestHashEqual", artifactInputAttributes(&artifact)...)
+	defer span.End()
+	r, err := t.inner.IngestHashEqual(ctx, artifact, equalArtifact, hashEqual)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestHashEquals(ctx context.Context, artifacts []*model.IDorArtifactInput, otherArtifacts []*model.IDorArtifactInput, hashEquals []*model.HashEqualInputSpec) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestHashEquals", attribute.Int("batch.size", len(artifacts)))
+	defer span.End()
+	r, err := t.inner.IngestHashEquals(ctx, artifacts, otherArtifacts, hashEquals)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestOccurrence(ctx context.Context, subject model.PackageOrSourceInput, artifact model.IDorArtifactInput, occurrence model.IsOccurrenceInputSpec) (string, error) {
+	ctx, span := t.start(ctx, "IngestOccurrence", append(subjectAttributes(subject.Package, subject.Source, nil), artifactInputAttributes(&artifact)...)...)
+	defer span.End()
+	r, err := t.inner.IngestOccurrence(ctx, subject, artifact, occurrence)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestOccurrences(ctx context.Context, subjects model.PackageOrSourceInputs, artifacts []*model.IDorArtifactInput, occurrences []*model.IsOccurrenceInputSpec) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestOccurrences", attribute.Int("batch.size", len(artifacts)))
+	defer span.End()
+	r, err := t.inner.IngestOccurrences(ctx, subjects, artifacts, occurrences)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestPkgEqual(ctx context.Context, pkg model.IDorPkgInput, depPkg model.IDorPkgInput, pkgEqual model.PkgEqualInputSpec) (string, error) {
+	ctx, span := t.start(ctx, "IngestPkgEqual", pkgInputAttributes(&pkg)...)
+	defer span.End()
+	r, err := t.inner.IngestPkgEqual(ctx, pkg, depPkg, pkgEqual)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestPkgEquals(ctx context.Context, pkgs []*model.IDorPkgInput, otherPackages []*model.IDorPkgInput, pkgEquals []*model.PkgEqualInputSpec) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestPkgEquals", attribute.Int("batch.size", len(pkgs)))
+	defer span.End()
+	r, err := t.inner.IngestPkgEquals(ctx, pkgs, otherPackages, pkgEquals)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestPointOfContact(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, pointOfContact model.PointOfContactInputSpec) (string, error) {
+	ctx, span := t.start(ctx, "IngestPointOfContact", subjectAttributes(subject.Package, subject.Source, subject.Artifact)...)
+	defer span.End()
+	r, err := t.inner.IngestPointOfContact(ctx, subject, pkgMatchType, pointOfContact)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestPointOfContacts(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, pointOfContacts []*model.PointOfContactInputSpec) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestPointOfContacts", attribute.Int("batch.size", len(pointOfContacts)))
+	defer span.End()
+	r, err := t.inner.IngestPointOfContacts(ctx, subjects, pkgMatchType, pointOfContacts)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestSLSA(ctx context.Context, subject model.IDorArtifactInput, builtFrom []*model.IDorArtifactInput, builtBy model.IDorBuilderInput, slsa model.SLSAInputSpec) (string, error) {
+	ctx, span := t.start(ctx, "IngestSLSA", artifactInputAttributes(&subject)...)
+	defer span.End()
+	r, err := t.inner.IngestSLSA(ctx, subject, builtFrom, builtBy, slsa)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestSLSAs(ctx context.Context, subjects []*model.IDorArtifactInput, builtFromList [][]*model.IDorArtifactInput, builtByList []*model.IDorBuilderInput, slsaList []*model.SLSAInputSpec) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestSLSAs", attribute.Int("batch.size", len(subjects)))
+	defer span.End()
+	r, err := t.inner.IngestSLSAs(ctx, subjects, builtFromList, builtByList, slsaList)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestScorecard(ctx context.Context, source model.IDorSourceInput, scorecard model.ScorecardInputSpec) (string, error) {
+	ctx, span := t.start(ctx, "IngestScorecard", sourceInputAttributes(&source)...)
+	defer span.End()
+	r, err := t.inner.IngestScorecard(ctx, source, scorecard)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestScorecards(ctx context.Context, sources []*model.IDorSourceInput, scorecards []*model.ScorecardInputSpec) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestScorecards", attribute.Int("batch.size", len(sources)))
+	defer span.End()
+	r, err := t.inner.IngestScorecards(ctx, sources, scorecards)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.IDorVulnerabilityInput, vexStatement model.VexStatementInputSpec) (string, error) {
+	ctx, span := t.start(ctx, "IngestVEXStatement", append(subjectAttributes(subject.Package, nil, subject.Artifact), vulnInputAttributes(&vulnerability)...)...)
+	defer span.End()
+	r, err := t.inner.IngestVEXStatement(ctx, subject, vulnerability, vexStatement)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestVEXStatements(ctx context.Context, subjects model.PackageOrArtifactInputs, vulnerabilities []*model.IDorVulnerabilityInput, vexStatements []*model.VexStatementInputSpec) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestVEXStatements", attribute.Int("batch.size", len(vulnerabilities)))
+	defer span.End()
+	r, err := t.inner.IngestVEXStatements(ctx, subjects, vulnerabilities, vexStatements)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestVulnEqual(ctx context.Context, vulnerability model.IDorVulnerabilityInput, otherVulnerability model.IDorVulnerabilityInput, vulnEqual model.VulnEqualInputSpec) (string, error) {
+	ctx, span := t.start(ctx, "IngestVulnEqual", vulnInputAttributes(&vulnerability)...)
+	defer span.End()
+	r, err := t.inner.IngestVulnEqual(ctx, vulnerability, otherVulnerability, vulnEqual)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestVulnEquals(ctx context.Context, vulnerabilities []*model.IDorVulnerabilityInput, otherVulnerabilities []*model.IDorVulnerabilityInput, vulnEquals []*model.VulnEqualInputSpec) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestVulnEquals", attribute.Int("batch.size", len(vulnerabilities)))
+	defer span.End()
+	r, err := t.inner.IngestVulnEquals(ctx, vulnerabilities, otherVulnerabilities, vulnEquals)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestVulnerabilityMetadata(ctx context.Context, vulnerability model.IDorVulnerabilityInput, vulnerabilityMetadata model.VulnerabilityMetadataInputSpec) (string, error) {
+	ctx, span := t.start(ctx, "IngestVulnerabilityMetadata", vulnInputAttributes(&vulnerability)...)
+	defer span.End()
+	r, err := t.inner.IngestVulnerabilityMetadata(ctx, vulnerability, vulnerabilityMetadata)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) IngestBulkVulnerabilityMetadata(ctx context.Context, vulnerabilities []*model.IDorVulnerabilityInput, vulnerabilityMetadataList []*model.VulnerabilityMetadataInputSpec) ([]string, error) {
+	ctx, span := t.start(ctx, "IngestBulkVulnerabilityMetadata", attribute.Int("batch.size", len(vulnerabilities)))
+	defer span.End()
+	r, err := t.inner.IngestBulkVulnerabilityMetadata(ctx, vulnerabilities, vulnerabilityMetadataList)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) ArchiveCertifyVulns(ctx context.Context, olderThan time.Duration) (int, error) {
+	ctx, span := t.start(ctx, "ArchiveCertifyVulns")
+	defer span.End()
+	r, err := t.inner.ArchiveCertifyVulns(ctx, olderThan)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) MarkStaleVulns(ctx context.Context, olderThan time.Duration) (int, error) {
+	ctx, span := t.start(ctx, "MarkStaleVulns")
+	defer span.End()
+	r, err := t.inner.MarkStaleVulns(ctx, olderThan)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) UpdateHasSourceAt(ctx context.Context, id string, hasSourceAt model.HasSourceAtInputSpec) (*model.HasSourceAt, error) {
+	ctx, span := t.start(ctx, "UpdateHasSourceAt", attribute.String("node.id", id))
+	defer span.End()
+	r, err := t.inner.UpdateHasSourceAt(ctx, id, hasSourceAt)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) UpdatePointOfContact(ctx context.Context, id string, pointOfContact model.PointOfContactInputSpec) (*model.PointOfContact, error) {
+	ctx, span := t.start(ctx, "UpdatePointOfContact", attribute.String("node.id", id))
+	defer span.End()
+	r, err := t.inner.UpdatePointOfContact(ctx, id, pointOfContact)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) UpdateCertifyScorecard(ctx context.Context, id string, scorecard model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
+	ctx, span := t.start(ctx, "UpdateCertifyScorecard", attribute.String("node.id", id))
+	defer span.End()
+	r, err := t.inner.UpdateCertifyScorecard(ctx, id, scorecard)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) UpdateCertifyVulnResolution(ctx context.Context, id string, resolvedByID string) (*model.CertifyVuln, error) {
+	ctx, span := t.start(ctx, "UpdateCertifyVulnResolution", attribute.String("node.id", id))
+	defer span.End()
+	r, err := t.inner.UpdateCertifyVulnResolution(ctx, id, resolvedByID)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) DeleteCertifyVuln(ctx context.Context, id string) error {
+	ctx, span := t.start(ctx, "DeleteCertifyVuln", attribute.String("node.id", id))
+	defer span.End()
+	return recordError(span, t.inner.DeleteCertifyVuln(ctx, id))
+}
+
+func (t *tracedBackend) DeleteSource(ctx context.Context, id string) error {
+	ctx, span := t.start(ctx, "DeleteSource", attribute.String("node.id", id))
+	defer span.End()
+	return recordError(span, t.inner.DeleteSource(ctx, id))
+}
+
+func (t *tracedBackend) MergePackages(ctx context.Context, primary string, duplicates []string) (*model.Package, error) {
+	ctx, span := t.start(ctx, "MergePackages", attribute.String("node.id", primary), attribute.Int("duplicates.count", len(duplicates)))
+	defer span.End()
+	r, err := t.inner.MergePackages(ctx, primary, duplicates)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) CheckScannerFreshness(ctx context.Context, scannerURI string, maxAge time.Duration) (*model.ScannerFreshnessResult, error) {
+	ctx, span := t.start(ctx, "CheckScannerFreshness", attribute.String("scanner.uri", scannerURI))
+	defer span.End()
+	r, err := t.inner.CheckScannerFreshness(ctx, scannerURI, maxAge)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) SBOMComponentBreakdown(ctx context.Context, hasSBOMID string) ([]*model.ComponentTypeCount, error) {
+	ctx, span := t.start(ctx, "SBOMComponentBreakdown", attribute.String("node.id", hasSBOMID))
+	defer span.End()
+	r, err := t.inner.SBOMComponentBreakdown(ctx, hasSBOMID)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) GetProvenance(ctx context.Context, pkgID string) (*model.ProvenanceSummary, error) {
+	ctx, span := t.start(ctx, "GetProvenance", attribute.String("node.id", pkgID))
+	defer span.End()
+	r, err := t.inner.GetProvenance(ctx, pkgID)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) CertifyVulnAdded(ctx context.Context, filter *model.CertifyVulnSpec) (<-chan *model.CertifyVuln, error) {
+	ctx, span := t.start(ctx, "CertifyVulnAdded", certifyVulnSpecAttributes(filter)...)
+	defer span.End()
+	r, err := t.inner.CertifyVulnAdded(ctx, filter)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
+	ctx, span := t.start(ctx, "Neighbors", attribute.String("node.id", node))
+	defer span.End()
+	r, err := t.inner.Neighbors(ctx, node, usingOnly)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) NeighborsRecursive(ctx context.Context, node string, usingOnly []model.Edge, maxDepth int) ([]model.Node, error) {
+	ctx, span := t.start(ctx, "NeighborsRecursive", attribute.String("node.id", node))
+	defer span.End()
+	r, err := t.inner.NeighborsRecursive(ctx, node, usingOnly, maxDepth)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) Node(ctx context.Context, node string) (model.Node, error) {
+	ctx, span := t.start(ctx, "Node", attribute.String("node.id", node))
+	defer span.End()
+	r, err := t.inner.Node(ctx, node)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) Nodes(ctx context.Context, nodes []string) ([]model.Node, error) {
+	ctx, span := t.start(ctx, "Nodes", attribute.Int("node.count", len(nodes)))
+	defer span.End()
+	r, err := t.inner.Nodes(ctx, nodes)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) BatchNodes(ctx context.Context, nodes []string) ([]model.Node, error) {
+	ctx, span := t.start(ctx, "BatchNodes", attribute.Int("node.count", len(nodes)))
+	defer span.End()
+	r, err := t.inner.BatchNodes(ctx, nodes)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) NodeType(ctx context.Context, node string) (string, error) {
+	ctx, span := t.start(ctx, "NodeType", attribute.String("node.id", node))
+	defer span.End()
+	r, err := t.inner.NodeType(ctx, node)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
+	ctx, span := t.start(ctx, "Path", attribute.String("path.subject", subject), attribute.String("path.target", target))
+	defer span.End()
+	r, err := t.inner.Path(ctx, subject, target, maxPathLength, usingOnly)
+	return r, recordError(span, err)
+}
+
+func (t *tracedBackend) FindSoftware(ctx context.Context, searchText string) ([]model.PackageSourceOrArtifact, error) {
+	ctx, span := t.start(ctx, "FindSoftware", attribute.String("search.text", searchText))
+	defer span.End()
+	r, err := t.inner.FindSoftware(ctx, searchText)
+	return r, recordError(span, err)
+}
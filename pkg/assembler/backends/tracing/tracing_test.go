@@ -0,0 +1,143 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracedBackend(t *testing.T) {
+	ctx := context.Background()
+	errQuery := errors.New("query failed")
+	tests := []struct {
+		name      string
+		setup     func(b *mocks.MockBackend)
+		call      func(b backends.Backend) error
+		wantSpan  string
+		wantAttrs map[string]attribute.Value
+		wantErr   bool
+	}{{
+		name: "Packages",
+		setup: func(b *mocks.MockBackend) {
+			b.EXPECT().Packages(gomock.Any(), gomock.Any()).Return([]*model.Package{}, nil)
+		},
+		call: func(b backends.Backend) error {
+			_, err := b.Packages(ctx, &model.PkgSpec{Type: ptrfrom.String("golang"), Name: ptrfrom.String("guac")})
+			return err
+		},
+		wantSpan: "guac.backend.Packages",
+		wantAttrs: map[string]attribute.Value{
+			"pkg.type": attribute.StringValue("golang"),
+			"pkg.name": attribute.StringValue("guac"),
+		},
+	}, {
+		name: "IngestCertifyVuln",
+		setup: func(b *mocks.MockBackend) {
+			b.EXPECT().IngestCertifyVuln(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("1", nil)
+		},
+		call: func(b backends.Backend) error {
+			_, err := b.IngestCertifyVuln(ctx,
+				model.IDorPkgInput{PackageInput: &model.PkgInputSpec{Type: "pypi", Name: "django", Version: ptrfrom.String("1.11.1")}},
+				model.IDorVulnerabilityInput{VulnerabilityInput: &model.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "ghsa-1234"}},
+				model.ScanMetadataInput{})
+			return err
+		},
+		wantSpan: "guac.backend.IngestCertifyVuln",
+		wantAttrs: map[string]attribute.Value{
+			"pkg.type":    attribute.StringValue("pypi"),
+			"pkg.name":    attribute.StringValue("django"),
+			"pkg.version": attribute.StringValue("1.11.1"),
+			"vuln.type":   attribute.StringValue("osv"),
+			"vuln.id":     attribute.StringValue("ghsa-1234"),
+		},
+	}, {
+		name: "IngestSources",
+		setup: func(b *mocks.MockBackend) {
+			b.EXPECT().IngestSources(gomock.Any(), gomock.Any()).Return(nil, nil)
+		},
+		call: func(b backends.Backend) error {
+			_, err := b.IngestSources(ctx, []*model.IDorSourceInput{{}, {}, {}})
+			return err
+		},
+		wantSpan: "guac.backend.IngestSources",
+		wantAttrs: map[string]attribute.Value{
+			"batch.size": attribute.IntValue(3),
+		},
+	}, {
+		name: "DeleteCertifyVuln error",
+		setup: func(b *mocks.MockBackend) {
+			b.EXPECT().DeleteCertifyVuln(gomock.Any(), "certify_vulns:1").Return(errQuery)
+		},
+		call: func(b backends.Backend) error {
+			return b.DeleteCertifyVuln(ctx, "certify_vulns:1")
+		},
+		wantSpan: "guac.backend.DeleteCertifyVuln",
+		wantAttrs: map[string]attribute.Value{
+			"node.id": attribute.StringValue("certify_vulns:1"),
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			inner := mocks.NewMockBackend(ctrl)
+			tt.setup(inner)
+			recorder := tracetest.NewSpanRecorder()
+			provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+			b := NewTracedBackend(inner, provider.Tracer("test"))
+
+			if err := tt.call(b); (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error: %v", err, tt.wantErr)
+			}
+
+			spans := recorder.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("got %d spans, want 1", len(spans))
+			}
+			span := spans[0]
+			if span.Name() != tt.wantSpan {
+				t.Errorf("got span name %q, want %q", span.Name(), tt.wantSpan)
+			}
+			gotAttrs := map[string]attribute.Value{}
+			for _, kv := range span.Attributes() {
+				gotAttrs[string(kv.Key)] = kv.Value
+			}
+			if diff := cmp.Diff(tt.wantAttrs, gotAttrs, cmp.AllowUnexported(attribute.Value{})); diff != "" {
+				t.Errorf("Unexpected attributes (-want +got):\n%s", diff)
+			}
+			wantCode := codes.Unset
+			if tt.wantErr {
+				wantCode = codes.Error
+			}
+			if span.Status().Code != wantCode {
+				t.Errorf("got status %v, want %v", span.Status().Code, wantCode)
+			}
+		})
+	}
+}
@@ -2376,6 +2376,8 @@ type AllCertifyVulnMetadataScanMetadata struct {
 	Origin string `json:"origin"`
 	// GUAC collector for the document
 	Collector string `json:"collector"`
+	// CVSS score of the vulnerability as reported by the scanner, if known
+	CvssScore *float64 `json:"cvssScore"`
 }
 
 // GetDbUri returns AllCertifyVulnMetadataScanMetadata.DbUri, and is useful for accessing the field via an interface.
@@ -2399,6 +2401,9 @@ func (v *AllCertifyVulnMetadataScanMetadata) GetOrigin() string { return v.Origi
 // GetCollector returns AllCertifyVulnMetadataScanMetadata.Collector, and is useful for accessing the field via an interface.
 func (v *AllCertifyVulnMetadataScanMetadata) GetCollector() string { return v.Collector }
 
+// GetCvssScore returns AllCertifyVulnMetadataScanMetadata.CvssScore, and is useful for accessing the field via an interface.
+func (v *AllCertifyVulnMetadataScanMetadata) GetCvssScore() *float64 { return v.CvssScore }
+
 // AllCertifyVulnPackage includes the requested fields of the GraphQL type Package.
 // The GraphQL type's documentation follows.
 //
@@ -6520,10 +6525,14 @@ func (v *ArtifactInputSpec) GetDigest() string { return v.Digest }
 // ArtifactSpec allows filtering the list of artifacts to return in a query.
 //
 // The checksum fields are canonicalized to be lowercase.
+//
+// digestPrefix matches the artifacts whose digest starts with the given hex
+// characters, e.g. the first 12 characters shown by many security tools.
 type ArtifactSpec struct {
-	Id        *string `json:"id"`
-	Algorithm *string `json:"algorithm"`
-	Digest    *string `json:"digest"`
+	Id           *string `json:"id"`
+	Algorithm    *string `json:"algorithm"`
+	Digest       *string `json:"digest"`
+	DigestPrefix *string `json:"digestPrefix"`
 }
 
 // GetId returns ArtifactSpec.Id, and is useful for accessing the field via an interface.
@@ -6535,6 +6544,9 @@ func (v *ArtifactSpec) GetAlgorithm() *string { return v.Algorithm }
 // GetDigest returns ArtifactSpec.Digest, and is useful for accessing the field via an interface.
 func (v *ArtifactSpec) GetDigest() *string { return v.Digest }
 
+// GetDigestPrefix returns ArtifactSpec.DigestPrefix, and is useful for accessing the field via an interface.
+func (v *ArtifactSpec) GetDigestPrefix() *string { return v.DigestPrefix }
+
 // ArtifactsArtifactsArtifact includes the requested fields of the GraphQL type Artifact.
 // The GraphQL type's documentation follows.
 //
@@ -6662,14 +6674,24 @@ func (v *CertifyBadInputSpec) GetDocumentRef() string { return v.DocumentRef }
 //
 // If KnownSince is specified, the returned value will be after or equal to the specified time.
 // Any nodes time that is before KnownSince is excluded.
+//
+// justificationPrefix matches the justifications starting with the given string
+// and justificationContains matches the justifications containing it. Both can be
+// combined with each other and with justification.
+//
+// subjectType restricts the results to the certifications of a package, a source
+// or an artifact.
 type CertifyBadSpec struct {
-	Id            *string                      `json:"id"`
-	Subject       *PackageSourceOrArtifactSpec `json:"subject"`
-	Justification *string                      `json:"justification"`
-	KnownSince    *time.Time                   `json:"knownSince"`
-	Origin        *string                      `json:"origin"`
-	Collector     *string                      `json:"collector"`
-	DocumentRef   *string                      `json:"documentRef"`
+	Id                    *string                      `json:"id"`
+	Subject               *PackageSourceOrArtifactSpec `json:"subject"`
+	SubjectType           *SubjectType                 `json:"subjectType"`
+	Justification         *string                      `json:"justification"`
+	JustificationPrefix   *string                      `json:"justificationPrefix"`
+	JustificationContains *string                      `json:"justificationContains"`
+	KnownSince            *time.Time                   `json:"knownSince"`
+	Origin                *string                      `json:"origin"`
+	Collector             *string                      `json:"collector"`
+	DocumentRef           *string                      `json:"documentRef"`
 }
 
 // GetId returns CertifyBadSpec.Id, and is useful for accessing the field via an interface.
@@ -6678,9 +6700,18 @@ func (v *CertifyBadSpec) GetId() *string { return v.Id }
 // GetSubject returns CertifyBadSpec.Subject, and is useful for accessing the field via an interface.
 func (v *CertifyBadSpec) GetSubject() *PackageSourceOrArtifactSpec { return v.Subject }
 
+// GetSubjectType returns CertifyBadSpec.SubjectType, and is useful for accessing the field via an interface.
+func (v *CertifyBadSpec) GetSubjectType() *SubjectType { return v.SubjectType }
+
 // GetJustification returns CertifyBadSpec.Justification, and is useful for accessing the field via an interface.
 func (v *CertifyBadSpec) GetJustification() *string { return v.Justification }
 
+// GetJustificationPrefix returns CertifyBadSpec.JustificationPrefix, and is useful for accessing the field via an interface.
+func (v *CertifyBadSpec) GetJustificationPrefix() *string { return v.JustificationPrefix }
+
+// GetJustificationContains returns CertifyBadSpec.JustificationContains, and is useful for accessing the field via an interface.
+func (v *CertifyBadSpec) GetJustificationContains() *string { return v.JustificationContains }
+
 // GetKnownSince returns CertifyBadSpec.KnownSince, and is useful for accessing the field via an interface.
 func (v *CertifyBadSpec) GetKnownSince() *time.Time { return v.KnownSince }
 
@@ -7318,6 +7349,7 @@ const (
 	EdgeSourceTypeSourceNamespace        Edge = "SOURCE_TYPE_SOURCE_NAMESPACE"
 	EdgeVulnerabilityCertifyVexStatement Edge = "VULNERABILITY_CERTIFY_VEX_STATEMENT"
 	EdgeVulnerabilityCertifyVuln         Edge = "VULNERABILITY_CERTIFY_VULN"
+	EdgeVulnerabilityExploitReference    Edge = "VULNERABILITY_EXPLOIT_REFERENCE"
 	EdgeVulnerabilityIdVulnerabilityType Edge = "VULNERABILITY_ID_VULNERABILITY_TYPE"
 	EdgeVulnerabilityTypeVulnerabilityId Edge = "VULNERABILITY_TYPE_VULNERABILITY_ID"
 	EdgeVulnerabilityVulnEqual           Edge = "VULNERABILITY_VULN_EQUAL"
@@ -7337,6 +7369,7 @@ const (
 	EdgeCertifyVexStatementVulnerability Edge = "CERTIFY_VEX_STATEMENT_VULNERABILITY"
 	EdgeCertifyVulnPackage               Edge = "CERTIFY_VULN_PACKAGE"
 	EdgeCertifyVulnVulnerability         Edge = "CERTIFY_VULN_VULNERABILITY"
+	EdgeExploitReferenceVulnerability    Edge = "EXPLOIT_REFERENCE_VULNERABILITY"
 	EdgeHashEqualArtifact                Edge = "HASH_EQUAL_ARTIFACT"
 	EdgeHasMetadataArtifact              Edge = "HAS_METADATA_ARTIFACT"
 	EdgeHasMetadataPackage               Edge = "HAS_METADATA_PACKAGE"
@@ -7915,6 +7948,9 @@ func (v *HasSBOMInputSpec) GetDocumentRef() string { return v.DocumentRef }
 //
 // If KnownSince is specified, the returned value will be after or equal to the specified time.
 // Any nodes time that is before KnownSince is excluded.
+//
+// IncludedPackages returns only the SBOMs that include a package version matching
+// each of the specs, to find the SBOMs that include a given package.
 type HasSBOMSpec struct {
 	Id                   *string                 `json:"id"`
 	Subject              *PackageOrArtifactSpec  `json:"subject"`
@@ -7929,6 +7965,7 @@ type HasSBOMSpec struct {
 	IncludedSoftware     []PackageOrArtifactSpec `json:"includedSoftware"`
 	IncludedDependencies []IsDependencySpec      `json:"includedDependencies"`
 	IncludedOccurrences  []IsOccurrenceSpec      `json:"includedOccurrences"`
+	IncludedPackages     []PkgSpec               `json:"includedPackages"`
 }
 
 // GetId returns HasSBOMSpec.Id, and is useful for accessing the field via an interface.
@@ -7970,6 +8007,9 @@ func (v *HasSBOMSpec) GetIncludedDependencies() []IsDependencySpec { return v.In
 // GetIncludedOccurrences returns HasSBOMSpec.IncludedOccurrences, and is useful for accessing the field via an interface.
 func (v *HasSBOMSpec) GetIncludedOccurrences() []IsOccurrenceSpec { return v.IncludedOccurrences }
 
+// GetIncludedPackages returns HasSBOMSpec.IncludedPackages, and is useful for accessing the field via an interface.
+func (v *HasSBOMSpec) GetIncludedPackages() []PkgSpec { return v.IncludedPackages }
+
 // HasSBOMsHasSBOM includes the requested fields of the GraphQL type HasSBOM.
 type HasSBOMsHasSBOM struct {
 	AllHasSBOMTree `json:"-"`
@@ -10250,6 +10290,25 @@ func (v *NeighborsNeighborsCertifyVuln) __premarshalJSON() (*__premarshalNeighbo
 	return &retval, nil
 }
 
+// NeighborsNeighborsExploitReference includes the requested fields of the GraphQL type ExploitReference.
+// The GraphQL type's documentation follows.
+//
+// ExploitReference is an attestation that a public exploit or proof of concept
+// exists for a vulnerability.
+//
+// The source is the database the exploit was found in and must be one of
+// EXPLOIT_DB, GITHUB_POC or METASPLOIT. It will be enforced to be all uppercase.
+//
+// A vulnerability ID can have many exploit references. A reference is identified
+// by its vulnerability, source and url: ingesting the same reference again
+// updates it instead of creating a new one (e.g., to mark it as verified).
+type NeighborsNeighborsExploitReference struct {
+	Typename *string `json:"__typename"`
+}
+
+// GetTypename returns NeighborsNeighborsExploitReference.Typename, and is useful for accessing the field via an interface.
+func (v *NeighborsNeighborsExploitReference) GetTypename() *string { return v.Typename }
+
 // NeighborsNeighborsHasMetadata includes the requested fields of the GraphQL type HasMetadata.
 // The GraphQL type's documentation follows.
 //
@@ -11159,6 +11218,7 @@ func (v *NeighborsNeighborsLicense) __premarshalJSON() (*__premarshalNeighborsNe
 // NeighborsNeighborsCertifyScorecard
 // NeighborsNeighborsCertifyVEXStatement
 // NeighborsNeighborsCertifyVuln
+// NeighborsNeighborsExploitReference
 // NeighborsNeighborsHasMetadata
 // NeighborsNeighborsHasSBOM
 // NeighborsNeighborsHasSLSA
@@ -11195,6 +11255,7 @@ func (v *NeighborsNeighborsCertifyLegal) implementsGraphQLInterfaceNeighborsNeig
 func (v *NeighborsNeighborsCertifyScorecard) implementsGraphQLInterfaceNeighborsNeighborsNode()    {}
 func (v *NeighborsNeighborsCertifyVEXStatement) implementsGraphQLInterfaceNeighborsNeighborsNode() {}
 func (v *NeighborsNeighborsCertifyVuln) implementsGraphQLInterfaceNeighborsNeighborsNode()         {}
+func (v *NeighborsNeighborsExploitReference) implementsGraphQLInterfaceNeighborsNeighborsNode()    {}
 func (v *NeighborsNeighborsHasMetadata) implementsGraphQLInterfaceNeighborsNeighborsNode()         {}
 func (v *NeighborsNeighborsHasSBOM) implementsGraphQLInterfaceNeighborsNeighborsNode()             {}
 func (v *NeighborsNeighborsHasSLSA) implementsGraphQLInterfaceNeighborsNeighborsNode()             {}
@@ -11250,6 +11311,9 @@ func __unmarshalNeighborsNeighborsNode(b []byte, v *NeighborsNeighborsNode) erro
 	case "CertifyVuln":
 		*v = new(NeighborsNeighborsCertifyVuln)
 		return json.Unmarshal(b, *v)
+	case "ExploitReference":
+		*v = new(NeighborsNeighborsExploitReference)
+		return json.Unmarshal(b, *v)
 	case "HasMetadata":
 		*v = new(NeighborsNeighborsHasMetadata)
 		return json.Unmarshal(b, *v)
@@ -11404,6 +11468,14 @@ func __marshalNeighborsNeighborsNode(v *NeighborsNeighborsNode) ([]byte, error)
 			*__premarshalNeighborsNeighborsCertifyVuln
 		}{typename, premarshaled}
 		return json.Marshal(result)
+	case *NeighborsNeighborsExploitReference:
+		typename = "ExploitReference"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*NeighborsNeighborsExploitReference
+		}{typename, v}
+		return json.Marshal(result)
 	case *NeighborsNeighborsHasMetadata:
 		typename = "HasMetadata"
 
@@ -12416,6 +12488,7 @@ func (v *NeighborsResponse) __premarshalJSON() (*__premarshalNeighborsResponse,
 // NodeNodeCertifyScorecard
 // NodeNodeCertifyVEXStatement
 // NodeNodeCertifyVuln
+// NodeNodeExploitReference
 // NodeNodeHasMetadata
 // NodeNodeHasSBOM
 // NodeNodeHasSLSA
@@ -12452,6 +12525,7 @@ func (v *NodeNodeCertifyLegal) implementsGraphQLInterfaceNodeNode()          {}
 func (v *NodeNodeCertifyScorecard) implementsGraphQLInterfaceNodeNode()      {}
 func (v *NodeNodeCertifyVEXStatement) implementsGraphQLInterfaceNodeNode()   {}
 func (v *NodeNodeCertifyVuln) implementsGraphQLInterfaceNodeNode()           {}
+func (v *NodeNodeExploitReference) implementsGraphQLInterfaceNodeNode()      {}
 func (v *NodeNodeHasMetadata) implementsGraphQLInterfaceNodeNode()           {}
 func (v *NodeNodeHasSBOM) implementsGraphQLInterfaceNodeNode()               {}
 func (v *NodeNodeHasSLSA) implementsGraphQLInterfaceNodeNode()               {}
@@ -12506,6 +12580,9 @@ func __unmarshalNodeNode(b []byte, v *NodeNode) error {
 	case "CertifyVuln":
 		*v = new(NodeNodeCertifyVuln)
 		return json.Unmarshal(b, *v)
+	case "ExploitReference":
+		*v = new(NodeNodeExploitReference)
+		return json.Unmarshal(b, *v)
 	case "HasMetadata":
 		*v = new(NodeNodeHasMetadata)
 		return json.Unmarshal(b, *v)
@@ -12660,6 +12737,14 @@ func __marshalNodeNode(v *NodeNode) ([]byte, error) {
 			*__premarshalNodeNodeCertifyVuln
 		}{typename, premarshaled}
 		return json.Marshal(result)
+	case *NodeNodeExploitReference:
+		typename = "ExploitReference"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*NodeNodeExploitReference
+		}{typename, v}
+		return json.Marshal(result)
 	case *NodeNodeHasMetadata:
 		typename = "HasMetadata"
 
@@ -13693,6 +13778,25 @@ func (v *NodeNodeCertifyVuln) __premarshalJSON() (*__premarshalNodeNodeCertifyVu
 	return &retval, nil
 }
 
+// NodeNodeExploitReference includes the requested fields of the GraphQL type ExploitReference.
+// The GraphQL type's documentation follows.
+//
+// ExploitReference is an attestation that a public exploit or proof of concept
+// exists for a vulnerability.
+//
+// The source is the database the exploit was found in and must be one of
+// EXPLOIT_DB, GITHUB_POC or METASPLOIT. It will be enforced to be all uppercase.
+//
+// A vulnerability ID can have many exploit references. A reference is identified
+// by its vulnerability, source and url: ingesting the same reference again
+// updates it instead of creating a new one (e.g., to mark it as verified).
+type NodeNodeExploitReference struct {
+	Typename *string `json:"__typename"`
+}
+
+// GetTypename returns NodeNodeExploitReference.Typename, and is useful for accessing the field via an interface.
+func (v *NodeNodeExploitReference) GetTypename() *string { return v.Typename }
+
 // NodeNodeHasMetadata includes the requested fields of the GraphQL type HasMetadata.
 // The GraphQL type's documentation follows.
 //
@@ -16202,6 +16306,25 @@ func (v *NodesNodesCertifyVuln) __premarshalJSON() (*__premarshalNodesNodesCerti
 	return &retval, nil
 }
 
+// NodesNodesExploitReference includes the requested fields of the GraphQL type ExploitReference.
+// The GraphQL type's documentation follows.
+//
+// ExploitReference is an attestation that a public exploit or proof of concept
+// exists for a vulnerability.
+//
+// The source is the database the exploit was found in and must be one of
+// EXPLOIT_DB, GITHUB_POC or METASPLOIT. It will be enforced to be all uppercase.
+//
+// A vulnerability ID can have many exploit references. A reference is identified
+// by its vulnerability, source and url: ingesting the same reference again
+// updates it instead of creating a new one (e.g., to mark it as verified).
+type NodesNodesExploitReference struct {
+	Typename *string `json:"__typename"`
+}
+
+// GetTypename returns NodesNodesExploitReference.Typename, and is useful for accessing the field via an interface.
+func (v *NodesNodesExploitReference) GetTypename() *string { return v.Typename }
+
 // NodesNodesHasMetadata includes the requested fields of the GraphQL type HasMetadata.
 // The GraphQL type's documentation follows.
 //
@@ -17091,6 +17214,7 @@ func (v *NodesNodesLicense) __premarshalJSON() (*__premarshalNodesNodesLicense,
 // NodesNodesCertifyScorecard
 // NodesNodesCertifyVEXStatement
 // NodesNodesCertifyVuln
+// NodesNodesExploitReference
 // NodesNodesHasMetadata
 // NodesNodesHasSBOM
 // NodesNodesHasSLSA
@@ -17127,6 +17251,7 @@ func (v *NodesNodesCertifyLegal) implementsGraphQLInterfaceNodesNodesNode()
 func (v *NodesNodesCertifyScorecard) implementsGraphQLInterfaceNodesNodesNode()      {}
 func (v *NodesNodesCertifyVEXStatement) implementsGraphQLInterfaceNodesNodesNode()   {}
 func (v *NodesNodesCertifyVuln) implementsGraphQLInterfaceNodesNodesNode()           {}
+func (v *NodesNodesExploitReference) implementsGraphQLInterfaceNodesNodesNode()      {}
 func (v *NodesNodesHasMetadata) implementsGraphQLInterfaceNodesNodesNode()           {}
 func (v *NodesNodesHasSBOM) implementsGraphQLInterfaceNodesNodesNode()               {}
 func (v *NodesNodesHasSLSA) implementsGraphQLInterfaceNodesNodesNode()               {}
@@ -17181,6 +17306,9 @@ func __unmarshalNodesNodesNode(b []byte, v *NodesNodesNode) error {
 	case "CertifyVuln":
 		*v = new(NodesNodesCertifyVuln)
 		return json.Unmarshal(b, *v)
+	case "ExploitReference":
+		*v = new(NodesNodesExploitReference)
+		return json.Unmarshal(b, *v)
 	case "HasMetadata":
 		*v = new(NodesNodesHasMetadata)
 		return json.Unmarshal(b, *v)
@@ -17335,6 +17463,14 @@ func __marshalNodesNodesNode(v *NodesNodesNode) ([]byte, error) {
 			*__premarshalNodesNodesCertifyVuln
 		}{typename, premarshaled}
 		return json.Marshal(result)
+	case *NodesNodesExploitReference:
+		typename = "ExploitReference"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*NodesNodesExploitReference
+		}{typename, v}
+		return json.Marshal(result)
 	case *NodesNodesHasMetadata:
 		typename = "HasMetadata"
 
@@ -18329,6 +18465,14 @@ func (v *NodesResponse) __premarshalJSON() (*__premarshalNodesResponse, error) {
 	return &retval, nil
 }
 
+// OrderDirection selects whether results are sorted in ascending or descending order.
+type OrderDirection string
+
+const (
+	OrderDirectionAsc  OrderDirection = "ASC"
+	OrderDirectionDesc OrderDirection = "DESC"
+)
+
 // PackageNamesPackagesPackage includes the requested fields of the GraphQL type Package.
 // The GraphQL type's documentation follows.
 //
@@ -18525,6 +18669,28 @@ func (v *PackageOrSourceSpec) GetPackage() *PkgSpec { return v.Package }
 // GetSource returns PackageOrSourceSpec.Source, and is useful for accessing the field via an interface.
 func (v *PackageOrSourceSpec) GetSource() *SourceSpec { return v.Source }
 
+// PackageOrder sorts packages by a field, ascending by default.
+type PackageOrder struct {
+	SortBy    PackageOrderField `json:"sortBy"`
+	SortOrder OrderDirection    `json:"sortOrder"`
+}
+
+// GetSortBy returns PackageOrder.SortBy, and is useful for accessing the field via an interface.
+func (v *PackageOrder) GetSortBy() PackageOrderField { return v.SortBy }
+
+// GetSortOrder returns PackageOrder.SortOrder, and is useful for accessing the field via an interface.
+func (v *PackageOrder) GetSortOrder() OrderDirection { return v.SortOrder }
+
+// PackageOrderField is the field package versions are sorted on.
+type PackageOrderField string
+
+const (
+	PackageOrderFieldType      PackageOrderField = "TYPE"
+	PackageOrderFieldNamespace PackageOrderField = "NAMESPACE"
+	PackageOrderFieldName      PackageOrderField = "NAME"
+	PackageOrderFieldVersion   PackageOrderField = "VERSION"
+)
+
 // PackageQualifierInputSpec allows specifying package qualifiers in mutations.
 type PackageQualifierInputSpec struct {
 	Key   string `json:"key"`
@@ -19728,6 +19894,25 @@ func (v *PathPathCertifyVuln) __premarshalJSON() (*__premarshalPathPathCertifyVu
 	return &retval, nil
 }
 
+// PathPathExploitReference includes the requested fields of the GraphQL type ExploitReference.
+// The GraphQL type's documentation follows.
+//
+// ExploitReference is an attestation that a public exploit or proof of concept
+// exists for a vulnerability.
+//
+// The source is the database the exploit was found in and must be one of
+// EXPLOIT_DB, GITHUB_POC or METASPLOIT. It will be enforced to be all uppercase.
+//
+// A vulnerability ID can have many exploit references. A reference is identified
+// by its vulnerability, source and url: ingesting the same reference again
+// updates it instead of creating a new one (e.g., to mark it as verified).
+type PathPathExploitReference struct {
+	Typename *string `json:"__typename"`
+}
+
+// GetTypename returns PathPathExploitReference.Typename, and is useful for accessing the field via an interface.
+func (v *PathPathExploitReference) GetTypename() *string { return v.Typename }
+
 // PathPathHasMetadata includes the requested fields of the GraphQL type HasMetadata.
 // The GraphQL type's documentation follows.
 //
@@ -20613,6 +20798,7 @@ func (v *PathPathLicense) __premarshalJSON() (*__premarshalPathPathLicense, erro
 // PathPathCertifyScorecard
 // PathPathCertifyVEXStatement
 // PathPathCertifyVuln
+// PathPathExploitReference
 // PathPathHasMetadata
 // PathPathHasSBOM
 // PathPathHasSLSA
@@ -20649,6 +20835,7 @@ func (v *PathPathCertifyLegal) implementsGraphQLInterfacePathPathNode()
 func (v *PathPathCertifyScorecard) implementsGraphQLInterfacePathPathNode()      {}
 func (v *PathPathCertifyVEXStatement) implementsGraphQLInterfacePathPathNode()   {}
 func (v *PathPathCertifyVuln) implementsGraphQLInterfacePathPathNode()           {}
+func (v *PathPathExploitReference) implementsGraphQLInterfacePathPathNode()      {}
 func (v *PathPathHasMetadata) implementsGraphQLInterfacePathPathNode()           {}
 func (v *PathPathHasSBOM) implementsGraphQLInterfacePathPathNode()               {}
 func (v *PathPathHasSLSA) implementsGraphQLInterfacePathPathNode()               {}
@@ -20703,6 +20890,9 @@ func __unmarshalPathPathNode(b []byte, v *PathPathNode) error {
 	case "CertifyVuln":
 		*v = new(PathPathCertifyVuln)
 		return json.Unmarshal(b, *v)
+	case "ExploitReference":
+		*v = new(PathPathExploitReference)
+		return json.Unmarshal(b, *v)
 	case "HasMetadata":
 		*v = new(PathPathHasMetadata)
 		return json.Unmarshal(b, *v)
@@ -20857,6 +21047,14 @@ func __marshalPathPathNode(v *PathPathNode) ([]byte, error) {
 			*__premarshalPathPathCertifyVuln
 		}{typename, premarshaled}
 		return json.Marshal(result)
+	case *PathPathExploitReference:
+		typename = "ExploitReference"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PathPathExploitReference
+		}{typename, v}
+		return json.Marshal(result)
 	case *PathPathHasMetadata:
 		typename = "HasMetadata"
 
@@ -21873,13 +22071,19 @@ func (v *PkgEqualInputSpec) GetDocumentRef() string { return v.DocumentRef }
 //
 // This is different than PkgSpec because we want to encode mandatory fields:
 // type and name. All optional fields are given empty default values.
+//
+// componentType optionally records the kind of component the package version is
+// (e.g., library, application, container) as reported by the SBOM it was found
+// in. It is only stored when the package version is first ingested and is used
+// to compute SBOM composition breakdowns.
 type PkgInputSpec struct {
-	Type       string                      `json:"type"`
-	Namespace  *string                     `json:"namespace"`
-	Name       string                      `json:"name"`
-	Version    *string                     `json:"version"`
-	Qualifiers []PackageQualifierInputSpec `json:"qualifiers"`
-	Subpath    *string                     `json:"subpath"`
+	Type          string                      `json:"type"`
+	Namespace     *string                     `json:"namespace"`
+	Name          string                      `json:"name"`
+	Version       *string                     `json:"version"`
+	Qualifiers    []PackageQualifierInputSpec `json:"qualifiers"`
+	Subpath       *string                     `json:"subpath"`
+	ComponentType *string                     `json:"componentType"`
 }
 
 // GetType returns PkgInputSpec.Type, and is useful for accessing the field via an interface.
@@ -21900,6 +22104,9 @@ func (v *PkgInputSpec) GetQualifiers() []PackageQualifierInputSpec { return v.Qu
 // GetSubpath returns PkgInputSpec.Subpath, and is useful for accessing the field via an interface.
 func (v *PkgInputSpec) GetSubpath() *string { return v.Subpath }
 
+// GetComponentType returns PkgInputSpec.ComponentType, and is useful for accessing the field via an interface.
+func (v *PkgInputSpec) GetComponentType() *string { return v.ComponentType }
+
 // PkgMatchType is an enum to determine if the attestation should be done at the
 // specific version or package name.
 type PkgMatchType string
@@ -21924,6 +22131,10 @@ const (
 // we must also return the same set of nodes it the qualifiers list is empty. To
 // match on nodes that don't contain any qualifier, set matchOnlyEmptyQualifiers
 // to true. If this field is true, then the qualifiers argument is ignored.
+//
+// order sorts the package versions returned by the packages query. It is ignored
+// by packagesList, which is always ordered by ID to keep cursors stable, and when
+// the PkgSpec is nested in the filter of another query.
 type PkgSpec struct {
 	Id                       *string                `json:"id"`
 	Type                     *string                `json:"type"`
@@ -21933,6 +22144,7 @@ type PkgSpec struct {
 	Qualifiers               []PackageQualifierSpec `json:"qualifiers"`
 	MatchOnlyEmptyQualifiers *bool                  `json:"matchOnlyEmptyQualifiers"`
 	Subpath                  *string                `json:"subpath"`
+	Order                    *PackageOrder          `json:"order"`
 }
 
 // GetId returns PkgSpec.Id, and is useful for accessing the field via an interface.
@@ -21959,6 +22171,9 @@ func (v *PkgSpec) GetMatchOnlyEmptyQualifiers() *bool { return v.MatchOnlyEmptyQ
 // GetSubpath returns PkgSpec.Subpath, and is useful for accessing the field via an interface.
 func (v *PkgSpec) GetSubpath() *string { return v.Subpath }
 
+// GetOrder returns PkgSpec.Order, and is useful for accessing the field via an interface.
+func (v *PkgSpec) GetOrder() *PackageOrder { return v.Order }
+
 // PointOfContactInputSpec represents the mutation input to ingest a PointOfContact evidence.
 type PointOfContactInputSpec struct {
 	Email         string    `json:"email"`
@@ -22050,6 +22265,7 @@ type ScanMetadataInput struct {
 	Origin         string    `json:"origin"`
 	Collector      string    `json:"collector"`
 	DocumentRef    string    `json:"documentRef"`
+	CvssScore      *float64  `json:"cvssScore"`
 }
 
 // GetTimeScanned returns ScanMetadataInput.TimeScanned, and is useful for accessing the field via an interface.
@@ -22076,6 +22292,9 @@ func (v *ScanMetadataInput) GetCollector() string { return v.Collector }
 // GetDocumentRef returns ScanMetadataInput.DocumentRef, and is useful for accessing the field via an interface.
 func (v *ScanMetadataInput) GetDocumentRef() string { return v.DocumentRef }
 
+// GetCvssScore returns ScanMetadataInput.CvssScore, and is useful for accessing the field via an interface.
+func (v *ScanMetadataInput) GetCvssScore() *float64 { return v.CvssScore }
+
 // ScorecardCheckInputSpec represents the mutation input for a Scorecard check.
 type ScorecardCheckInputSpec struct {
 	Check string `json:"check"`
@@ -22250,6 +22469,27 @@ func (v *SourceInputSpec) GetTag() *string { return v.Tag }
 // GetCommit returns SourceInputSpec.Commit, and is useful for accessing the field via an interface.
 func (v *SourceInputSpec) GetCommit() *string { return v.Commit }
 
+// SourceOrder sorts sources by a field, ascending by default.
+type SourceOrder struct {
+	SortBy    SourceOrderField `json:"sortBy"`
+	SortOrder OrderDirection   `json:"sortOrder"`
+}
+
+// GetSortBy returns SourceOrder.SortBy, and is useful for accessing the field via an interface.
+func (v *SourceOrder) GetSortBy() SourceOrderField { return v.SortBy }
+
+// GetSortOrder returns SourceOrder.SortOrder, and is useful for accessing the field via an interface.
+func (v *SourceOrder) GetSortOrder() OrderDirection { return v.SortOrder }
+
+// SourceOrderField is the field source names are sorted on.
+type SourceOrderField string
+
+const (
+	SourceOrderFieldType      SourceOrderField = "TYPE"
+	SourceOrderFieldNamespace SourceOrderField = "NAMESPACE"
+	SourceOrderFieldName      SourceOrderField = "NAME"
+)
+
 // SourceSpec allows filtering the list of sources to return in a query.
 //
 // Empty string at a field means matching with the empty string. Missing field
@@ -22258,13 +22498,28 @@ func (v *SourceInputSpec) GetCommit() *string { return v.Commit }
 // It is an error to specify both tag and commit fields, except it both are set as
 // empty string (in which case the returned sources are only those for which there
 // is no tag/commit information).
+//
+// namePrefix matches the source names starting with the given string. nameGlob
+// matches the source names against a glob pattern, where "*" matches any sequence
+// of characters and "?" matches any single character (e.g. "guac-*" or "*-sdk").
+// nameContains and nameSuffix match the source names containing or ending with
+// the given string. All of these can be combined with each other and with name.
+//
+// order sorts the source names returned by the sources query. It is ignored by
+// sourcesList, which is always ordered by ID to keep cursors stable, and when the
+// SourceSpec is nested in the filter of another query.
 type SourceSpec struct {
-	Id        *string `json:"id"`
-	Type      *string `json:"type"`
-	Namespace *string `json:"namespace"`
-	Name      *string `json:"name"`
-	Tag       *string `json:"tag"`
-	Commit    *string `json:"commit"`
+	Id           *string      `json:"id"`
+	Type         *string      `json:"type"`
+	Namespace    *string      `json:"namespace"`
+	Name         *string      `json:"name"`
+	NamePrefix   *string      `json:"namePrefix"`
+	NameGlob     *string      `json:"nameGlob"`
+	NameContains *string      `json:"nameContains"`
+	NameSuffix   *string      `json:"nameSuffix"`
+	Tag          *string      `json:"tag"`
+	Commit       *string      `json:"commit"`
+	Order        *SourceOrder `json:"order"`
 }
 
 // GetId returns SourceSpec.Id, and is useful for accessing the field via an interface.
@@ -22279,12 +22534,27 @@ func (v *SourceSpec) GetNamespace() *string { return v.Namespace }
 // GetName returns SourceSpec.Name, and is useful for accessing the field via an interface.
 func (v *SourceSpec) GetName() *string { return v.Name }
 
+// GetNamePrefix returns SourceSpec.NamePrefix, and is useful for accessing the field via an interface.
+func (v *SourceSpec) GetNamePrefix() *string { return v.NamePrefix }
+
+// GetNameGlob returns SourceSpec.NameGlob, and is useful for accessing the field via an interface.
+func (v *SourceSpec) GetNameGlob() *string { return v.NameGlob }
+
+// GetNameContains returns SourceSpec.NameContains, and is useful for accessing the field via an interface.
+func (v *SourceSpec) GetNameContains() *string { return v.NameContains }
+
+// GetNameSuffix returns SourceSpec.NameSuffix, and is useful for accessing the field via an interface.
+func (v *SourceSpec) GetNameSuffix() *string { return v.NameSuffix }
+
 // GetTag returns SourceSpec.Tag, and is useful for accessing the field via an interface.
 func (v *SourceSpec) GetTag() *string { return v.Tag }
 
 // GetCommit returns SourceSpec.Commit, and is useful for accessing the field via an interface.
 func (v *SourceSpec) GetCommit() *string { return v.Commit }
 
+// GetOrder returns SourceSpec.Order, and is useful for accessing the field via an interface.
+func (v *SourceSpec) GetOrder() *SourceOrder { return v.Order }
+
 // SourcesResponse is returned by Sources on success.
 type SourcesResponse struct {
 	// Returns all sources matching a filter.
@@ -22373,6 +22643,15 @@ func (v *SourcesSourcesSource) __premarshalJSON() (*__premarshalSourcesSourcesSo
 	return &retval, nil
 }
 
+// SubjectType is the kind of node a certification is attached to.
+type SubjectType string
+
+const (
+	SubjectTypePackage  SubjectType = "PACKAGE"
+	SubjectTypeSource   SubjectType = "SOURCE"
+	SubjectTypeArtifact SubjectType = "ARTIFACT"
+)
+
 // Records the justification included in the VEX statement.
 type VexJustification string
 
@@ -27353,6 +27632,7 @@ fragment AllCertifyVuln on CertifyVuln {
 		timeScanned
 		origin
 		collector
+		cvssScore
 	}
 }
 fragment AllVulnEqual on VulnEqual {
@@ -27830,6 +28110,7 @@ fragment AllCertifyVuln on CertifyVuln {
 		timeScanned
 		origin
 		collector
+		cvssScore
 	}
 }
 fragment AllVulnEqual on VulnEqual {
@@ -28305,6 +28586,7 @@ fragment AllCertifyVuln on CertifyVuln {
 		timeScanned
 		origin
 		collector
+		cvssScore
 	}
 }
 fragment AllVulnEqual on VulnEqual {
@@ -29009,6 +29291,7 @@ fragment AllCertifyVuln on CertifyVuln {
 		timeScanned
 		origin
 		collector
+		cvssScore
 	}
 }
 fragment AllVulnEqual on VulnEqual {
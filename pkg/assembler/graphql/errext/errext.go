@@ -0,0 +1,111 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package errext attaches machine readable error codes to GraphQL errors, so
+// that clients can tell a missing node from a constraint violation or a
+// timeout. The code is returned in the `extensions.code` field of the error.
+package errext
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Code classifies an error returned by the GraphQL server.
+type Code string
+
+const (
+	ErrNotFound            Code = "NOT_FOUND"
+	ErrConstraintViolation Code = "CONSTRAINT_VIOLATION"
+	ErrTimeout             Code = "TIMEOUT"
+	ErrInvalidInput        Code = "INVALID_INPUT"
+)
+
+// CodeExtension is the key of the error code in the error extensions.
+const CodeExtension = "code"
+
+// Classifier returns the code of errors it recognizes and "" otherwise.
+type Classifier func(error) Code
+
+var classifiers []Classifier
+
+// RegisterClassifier adds a classifier for backend specific errors. Backends
+// register their classifier when they are initialized.
+func RegisterClassifier(c Classifier) {
+	classifiers = append(classifiers, c)
+}
+
+// WithCode sets the `extensions.code` field of err to code. An empty code
+// leaves err unchanged.
+func WithCode(err *gqlerror.Error, code Code) *gqlerror.Error {
+	if err == nil || code == "" {
+		return err
+	}
+	if err.Extensions == nil {
+		err.Extensions = map[string]interface{}{}
+	}
+	err.Extensions[CodeExtension] = code
+	return err
+}
+
+// Errorf formats a GraphQL error like gqlerror.Errorf. The first error in args
+// is kept as the wrapped error and its code, if any, is attached.
+func Errorf(format string, args ...interface{}) *gqlerror.Error {
+	gqlErr := gqlerror.Errorf(format, args...)
+	for _, arg := range args {
+		if err, ok := arg.(error); ok {
+			gqlErr.Err = err
+			return WithCode(gqlErr, Classify(err))
+		}
+	}
+	return gqlErr
+}
+
+// Classify returns the code of err, or "" if the error is not recognized. A
+// code already attached to a wrapped GraphQL error takes precedence over the
+// registered classifiers.
+func Classify(err error) Code {
+	if err == nil {
+		return ""
+	}
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) {
+		if code, ok := gqlErr.Extensions[CodeExtension].(Code); ok {
+			return code
+		}
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrTimeout
+	}
+	for _, c := range classifiers {
+		if code := c(err); code != "" {
+			return code
+		}
+	}
+	return ""
+}
+
+// ErrorPresenter is a gqlgen error presenter that adds the code of
+// classified errors to the response.
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	if _, ok := gqlErr.Extensions[CodeExtension]; ok {
+		return gqlErr
+	}
+	return WithCode(gqlErr, Classify(err))
+}
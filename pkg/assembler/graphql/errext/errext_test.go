@@ -0,0 +1,92 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errext
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+var errConflict = errors.New("conflict")
+
+func init() {
+	RegisterClassifier(func(err error) Code {
+		if errors.Is(err, errConflict) {
+			return ErrConstraintViolation
+		}
+		return ""
+	})
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want Code
+	}{{
+		name: "nil",
+		err:  nil,
+		want: "",
+	}, {
+		name: "unknown",
+		err:  errors.New("boom"),
+		want: "",
+	}, {
+		name: "timeout",
+		err:  fmt.Errorf("query failed: %w", context.DeadlineExceeded),
+		want: ErrTimeout,
+	}, {
+		name: "registered classifier",
+		err:  fmt.Errorf("insert failed: %w", errConflict),
+		want: ErrConstraintViolation,
+	}, {
+		name: "coded graphql error",
+		err:  fmt.Errorf("validation: %w", WithCode(gqlerror.Errorf("bad input"), ErrInvalidInput)),
+		want: ErrInvalidInput,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Classify(tt.err); got != tt.want {
+				t.Errorf("Classify() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithCode(t *testing.T) {
+	err := WithCode(gqlerror.Errorf("missing"), ErrNotFound)
+	if got := err.Extensions[CodeExtension]; got != ErrNotFound {
+		t.Errorf("got code %v, want %v", got, ErrNotFound)
+	}
+	if err := WithCode(gqlerror.Errorf("unknown"), ""); err.Extensions != nil {
+		t.Errorf("expected no extensions for an empty code, got %v", err.Extensions)
+	}
+}
+
+func TestErrorPresenter(t *testing.T) {
+	got := ErrorPresenter(context.Background(), fmt.Errorf("insert failed: %w", errConflict))
+	if got.Extensions[CodeExtension] != ErrConstraintViolation {
+		t.Errorf("got extensions %v, want code %v", got.Extensions, ErrConstraintViolation)
+	}
+	got = ErrorPresenter(context.Background(), errors.New("boom"))
+	if _, ok := got.Extensions[CodeExtension]; ok {
+		t.Errorf("expected no code for an unclassified error, got %v", got.Extensions)
+	}
+}
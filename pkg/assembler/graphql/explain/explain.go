@@ -0,0 +1,143 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package explain serves the database queries and resolver timings of GraphQL
+// operations, for developers tuning the performance of the GraphQL API.
+package explain
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DevModeEnv is the environment variable enabling the development endpoints
+// of the GraphQL server, such as the explain endpoint, when set to true.
+const DevModeEnv = "GUAC_DEV_MODE"
+
+// DevMode reports whether DevModeEnv enables the development endpoints.
+func DevMode() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(DevModeEnv))
+	return enabled
+}
+
+// Query is a database query run by a backend while resolving an operation,
+// such as an ent query, with the SQL statements it ran.
+type Query struct {
+	// Node is the type of node queried
+	Node string `json:"node"`
+	// Op is the kind of query, for example All or Count for ent
+	Op         string       `json:"op"`
+	DurationMS float64      `json:"durationMs"`
+	Statements []*Statement `json:"statements"`
+}
+
+// Statement is a SQL statement with the plan the database chose for it.
+type Statement struct {
+	SQL  string        `json:"sql"`
+	Args []interface{} `json:"args,omitempty"`
+	// Plan holds the lines returned by EXPLAIN for the statement
+	Plan []string `json:"plan,omitempty"`
+	// Error is set when the statement could not be explained
+	Error string `json:"error,omitempty"`
+}
+
+// FieldTiming is the time spent in the resolver of a field.
+type FieldTiming struct {
+	Path       string  `json:"path"`
+	DurationMS float64 `json:"durationMs"`
+}
+
+// Recorder collects the queries and resolver timings of an operation. The
+// fields of an operation are resolved concurrently, so it is safe for
+// concurrent use.
+type Recorder struct {
+	mu      sync.Mutex
+	queries []*Query
+	fields  []FieldTiming
+}
+
+type recorderCtxKey struct{}
+
+type queryCtxKey struct{}
+
+// WithRecorder returns a context recording the queries run with it into the
+// returned recorder.
+func WithRecorder(ctx context.Context) (context.Context, *Recorder) {
+	r := &Recorder{}
+	return context.WithValue(ctx, recorderCtxKey{}, r), r
+}
+
+// FromContext returns the recorder of the context, nil if the queries run with
+// it are not recorded.
+func FromContext(ctx context.Context) *Recorder {
+	r, _ := ctx.Value(recorderCtxKey{}).(*Recorder)
+	return r
+}
+
+// StartQuery records a query of node and returns a context that attributes the
+// statements added with it to the query, and a function to call once the query
+// is done.
+func (r *Recorder) StartQuery(ctx context.Context, node, op string) (context.Context, func()) {
+	q := &Query{Node: node, Op: op}
+	r.mu.Lock()
+	r.queries = append(r.queries, q)
+	r.mu.Unlock()
+	start := time.Now()
+	return context.WithValue(ctx, queryCtxKey{}, q), func() {
+		r.mu.Lock()
+		q.DurationMS = milliseconds(time.Since(start))
+		r.mu.Unlock()
+	}
+}
+
+// AddStatement adds a statement to the query of the context, or to a query of
+// its own when it is not run for a query started with StartQuery.
+func (r *Recorder) AddStatement(ctx context.Context, s *Statement) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if q, ok := ctx.Value(queryCtxKey{}).(*Query); ok {
+		q.Statements = append(q.Statements, s)
+		return
+	}
+	r.queries = append(r.queries, &Query{Statements: []*Statement{s}})
+}
+
+func (r *Recorder) addField(path string, d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fields = append(r.fields, FieldTiming{Path: path, DurationMS: milliseconds(d)})
+}
+
+// Queries returns the queries recorded, in the order they started.
+func (r *Recorder) Queries() []*Query {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*Query{}, r.queries...)
+}
+
+// Fields returns the resolver timings recorded, in the order the resolvers
+// returned.
+func (r *Recorder) Fields() []FieldTiming {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]FieldTiming{}, r.fields...)
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,155 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/executor"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Path is where the explain handler is served.
+const Path = "/debug/explain"
+
+// Handler executes the GraphQL operation posted to it and responds with its
+// result, the database queries run to resolve it and the time spent in each
+// field resolver. Only queries are accepted, as the operation is run against
+// the database of the server.
+//
+// The queries are recorded by the backends supporting it, such as ent when
+// its Explain option is set.
+type Handler struct {
+	exec *executor.Executor
+}
+
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+type response struct {
+	Data    json.RawMessage `json:"data,omitempty"`
+	Errors  gqlerror.List   `json:"errors,omitempty"`
+	Queries []*Query        `json:"queries"`
+	Fields  []FieldTiming   `json:"fields"`
+}
+
+// NewHandler returns a handler executing operations against es.
+func NewHandler(es graphql.ExecutableSchema) *Handler {
+	exec := executor.New(es)
+	exec.Use(fieldTimer{})
+	return &Handler{exec: exec}
+}
+
+// AroundOperations adds a middleware run around every operation, like
+// handler.Server.AroundOperations.
+func (h *Handler) AroundOperations(f graphql.OperationMiddleware) {
+	h.exec.AroundOperations(f)
+}
+
+// SetErrorPresenter sets the function presenting the errors of the
+// operations, like handler.Server.SetErrorPresenter.
+func (h *Handler) SetErrorPresenter(f graphql.ErrorPresenterFunc) {
+	h.exec.SetErrorPresenter(f)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx := graphql.StartOperationTrace(r.Context())
+	rc, errs := h.exec.CreateOperationContext(ctx, &graphql.RawParams{
+		Query:         req.Query,
+		OperationName: req.OperationName,
+		Variables:     req.Variables,
+		ReadTime: graphql.TraceTiming{
+			Start: graphql.GetStartTime(ctx),
+			End:   graphql.Now(),
+		},
+	})
+	if errs != nil {
+		writeResponse(w, http.StatusUnprocessableEntity, h.exec.DispatchError(graphql.WithOperationContext(ctx, rc), errs), nil)
+		return
+	}
+	if rc.Operation.Operation != ast.Query {
+		http.Error(w, fmt.Sprintf("only queries can be explained, got a %s", rc.Operation.Operation), http.StatusBadRequest)
+		return
+	}
+
+	ctx, recorder := WithRecorder(ctx)
+	responses, ctx := h.exec.DispatchOperation(ctx, rc)
+	writeResponse(w, http.StatusOK, responses(ctx), recorder)
+}
+
+func writeResponse(w http.ResponseWriter, status int, resp *graphql.Response, recorder *Recorder) {
+	out := response{Queries: []*Query{}, Fields: []FieldTiming{}}
+	if resp != nil {
+		out.Data = resp.Data
+		out.Errors = resp.Errors
+	}
+	if recorder != nil {
+		out.Queries = recorder.Queries()
+		out.Fields = recorder.Fields()
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(out)
+}
+
+// fieldTimer is a gqlgen handler extension recording the time spent in the
+// field resolvers. Fields that are plain struct accesses are not recorded.
+type fieldTimer struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = fieldTimer{}
+
+func (fieldTimer) ExtensionName() string {
+	return "ExplainFieldTimer"
+}
+
+func (fieldTimer) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (fieldTimer) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	recorder := FromContext(ctx)
+	if fc == nil || !fc.IsResolver || recorder == nil {
+		return next(ctx)
+	}
+	start := time.Now()
+	res, err := next(ctx)
+	recorder.addField(fc.Path().String(), time.Since(start))
+	return res, err
+}
@@ -0,0 +1,129 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explain_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/pkg/assembler/graphql/explain"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+)
+
+type explainResponse struct {
+	Data    json.RawMessage       `json:"data"`
+	Errors  []json.RawMessage     `json:"errors"`
+	Queries []*explain.Query      `json:"queries"`
+	Fields  []explain.FieldTiming `json:"fields"`
+}
+
+func TestHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	// the backend records the queries it runs like the ent backend does
+	b.EXPECT().GetProvenance(gomock.Any(), "pkg").DoAndReturn(func(ctx context.Context, pkgID string) (*model.ProvenanceSummary, error) {
+		if recorder := explain.FromContext(ctx); recorder != nil {
+			ctx, done := recorder.StartQuery(ctx, "BillOfMaterials", "All")
+			recorder.AddStatement(ctx, &explain.Statement{SQL: "SELECT 1", Plan: []string{"Result"}})
+			done()
+			recorder.AddStatement(context.Background(), &explain.Statement{SQL: "SELECT 2"})
+		}
+		return &model.ProvenanceSummary{}, nil
+	}).AnyTimes()
+	h := explain.NewHandler(generated.NewExecutableSchema(generated.Config{Resolvers: &resolvers.Resolver{Backend: b}}))
+
+	tests := []struct {
+		name        string
+		method      string
+		body        string
+		wantStatus  int
+		wantQueries []*explain.Query
+		wantFields  []string
+	}{{
+		name:       "not a post",
+		method:     http.MethodGet,
+		wantStatus: http.StatusMethodNotAllowed,
+	}, {
+		name:       "invalid body",
+		method:     http.MethodPost,
+		body:       "{",
+		wantStatus: http.StatusBadRequest,
+	}, {
+		name:       "invalid query",
+		method:     http.MethodPost,
+		body:       `{"query": "{ provenance { hasSBOM { id } } }"}`,
+		wantStatus: http.StatusUnprocessableEntity,
+	}, {
+		name:       "mutation",
+		method:     http.MethodPost,
+		body:       `{"query": "mutation { ingestArtifact(artifact: {artifactInput: {algorithm: \"sha256\", digest: \"abc\"}}) }"}`,
+		wantStatus: http.StatusBadRequest,
+	}, {
+		name:       "query",
+		method:     http.MethodPost,
+		body:       `{"query": "query Provenance($pkg: ID!) { provenance(pkg: $pkg) { hasSBOM { id } } }", "variables": {"pkg": "pkg"}}`,
+		wantStatus: http.StatusOK,
+		wantQueries: []*explain.Query{{
+			Node:       "BillOfMaterials",
+			Op:         "All",
+			Statements: []*explain.Statement{{SQL: "SELECT 1", Plan: []string{"Result"}}},
+		}, {
+			Statements: []*explain.Statement{{SQL: "SELECT 2"}},
+		}},
+		wantFields: []string{"provenance"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(tt.method, explain.Path, strings.NewReader(tt.body)))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var got explainResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			if len(got.Errors) != 0 {
+				t.Fatalf("unexpected errors %s", got.Errors)
+			}
+			for _, q := range got.Queries {
+				q.DurationMS = 0
+			}
+			if diff := cmp.Diff(tt.wantQueries, got.Queries); diff != "" {
+				t.Errorf("queries (-want +got):\n%s", diff)
+			}
+			var fields []string
+			for _, f := range got.Fields {
+				fields = append(fields, f.Path)
+			}
+			if diff := cmp.Diff(tt.wantFields, fields); diff != "" {
+				t.Errorf("fields (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"sync/atomic"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
@@ -33,9 +34,14 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	CertifyVuln() CertifyVulnResolver
 	Mutation() MutationResolver
 	Package() PackageResolver
+	PackageVersion() PackageVersionResolver
 	Query() QueryResolver
+	SourceName() SourceNameResolver
+	Subscription() SubscriptionResolver
+	Vulnerability() VulnerabilityResolver
 }
 
 type DirectiveRoot struct {
@@ -49,11 +55,33 @@ type ComplexityRoot struct {
 		ID        func(childComplexity int) int
 	}
 
+	ArtifactConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	ArtifactEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	Builder struct {
 		ID  func(childComplexity int) int
 		URI func(childComplexity int) int
 	}
 
+	BuilderConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	BuilderEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	CertifyBad struct {
 		Collector     func(childComplexity int) int
 		DocumentRef   func(childComplexity int) int
@@ -64,6 +92,17 @@ type ComplexityRoot struct {
 		Subject       func(childComplexity int) int
 	}
 
+	CertifyBadConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyBadEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	CertifyGood struct {
 		Collector     func(childComplexity int) int
 		DocumentRef   func(childComplexity int) int
@@ -74,6 +113,17 @@ type ComplexityRoot struct {
 		Subject       func(childComplexity int) int
 	}
 
+	CertifyGoodConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyGoodEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	CertifyLegal struct {
 		Attribution        func(childComplexity int) int
 		Collector          func(childComplexity int) int
@@ -89,12 +139,34 @@ type ComplexityRoot struct {
 		TimeScanned        func(childComplexity int) int
 	}
 
+	CertifyLegalConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyLegalEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	CertifyScorecard struct {
 		ID        func(childComplexity int) int
 		Scorecard func(childComplexity int) int
 		Source    func(childComplexity int) int
 	}
 
+	CertifyScorecardConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyScorecardEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	CertifyVEXStatement struct {
 		Collector        func(childComplexity int) int
 		DocumentRef      func(childComplexity int) int
@@ -109,13 +181,81 @@ type ComplexityRoot struct {
 		Vulnerability    func(childComplexity int) int
 	}
 
+	CertifyVEXStatementConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyVEXStatementEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	CertifyVuln struct {
+		ID                func(childComplexity int) int
+		Metadata          func(childComplexity int) int
+		Package           func(childComplexity int) int
+		RemediationStatus func(childComplexity int) int
+		ResolvedBy        func(childComplexity int) int
+		Stale             func(childComplexity int) int
+		Vulnerability     func(childComplexity int) int
+	}
+
+	CertifyVulnConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyVulnEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	CommitConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	CommitEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	ComponentTypeCount struct {
+		ComponentType func(childComplexity int) int
+		Count         func(childComplexity int) int
+	}
+
+	DependencyEdge struct {
+		From         func(childComplexity int) int
+		IsDependency func(childComplexity int) int
+		To           func(childComplexity int) int
+	}
+
+	DependencyGraph struct {
+		Edges func(childComplexity int) int
+		Nodes func(childComplexity int) int
+	}
+
+	ExploitReference struct {
+		Collector     func(childComplexity int) int
+		DocumentRef   func(childComplexity int) int
 		ID            func(childComplexity int) int
-		Metadata      func(childComplexity int) int
-		Package       func(childComplexity int) int
+		Origin        func(childComplexity int) int
+		Source        func(childComplexity int) int
+		URL           func(childComplexity int) int
+		Verified      func(childComplexity int) int
 		Vulnerability func(childComplexity int) int
 	}
 
+	GitCommit struct {
+		AuthorDate func(childComplexity int) int
+		Message    func(childComplexity int) int
+		Sha        func(childComplexity int) int
+	}
+
 	HasMetadata struct {
 		Collector     func(childComplexity int) int
 		DocumentRef   func(childComplexity int) int
@@ -128,6 +268,17 @@ type ComplexityRoot struct {
 		Value         func(childComplexity int) int
 	}
 
+	HasMetadataConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	HasMetadataEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	HasSBOM struct {
 		Algorithm            func(childComplexity int) int
 		Collector            func(childComplexity int) int
@@ -144,12 +295,34 @@ type ComplexityRoot struct {
 		URI                  func(childComplexity int) int
 	}
 
+	HasSBOMConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	HasSBOMEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	HasSLSA struct {
 		ID      func(childComplexity int) int
 		Slsa    func(childComplexity int) int
 		Subject func(childComplexity int) int
 	}
 
+	HasSLSAConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	HasSLSAEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	HasSourceAt struct {
 		Collector     func(childComplexity int) int
 		DocumentRef   func(childComplexity int) int
@@ -161,6 +334,17 @@ type ComplexityRoot struct {
 		Source        func(childComplexity int) int
 	}
 
+	HasSourceAtConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	HasSourceAtEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	HashEqual struct {
 		Artifacts     func(childComplexity int) int
 		Collector     func(childComplexity int) int
@@ -170,6 +354,17 @@ type ComplexityRoot struct {
 		Origin        func(childComplexity int) int
 	}
 
+	HashEqualConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	HashEqualEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	IsDependency struct {
 		Collector         func(childComplexity int) int
 		DependencyPackage func(childComplexity int) int
@@ -182,6 +377,17 @@ type ComplexityRoot struct {
 		VersionRange      func(childComplexity int) int
 	}
 
+	IsDependencyConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	IsDependencyEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	IsOccurrence struct {
 		Artifact      func(childComplexity int) int
 		Collector     func(childComplexity int) int
@@ -192,6 +398,17 @@ type ComplexityRoot struct {
 		Subject       func(childComplexity int) int
 	}
 
+	IsOccurrenceConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	IsOccurrenceEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	License struct {
 		ID          func(childComplexity int) int
 		Inline      func(childComplexity int) int
@@ -199,7 +416,21 @@ type ComplexityRoot struct {
 		Name        func(childComplexity int) int
 	}
 
+	LicenseConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	LicenseEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	Mutation struct {
+		ArchiveCertifyVulns             func(childComplexity int, olderThan time.Duration) int
+		DeleteCertifyVuln               func(childComplexity int, id string) int
+		DeleteSource                    func(childComplexity int, id string) int
 		IngestArtifact                  func(childComplexity int, artifact *model.IDorArtifactInput) int
 		IngestArtifacts                 func(childComplexity int, artifacts []*model.IDorArtifactInput) int
 		IngestBuilder                   func(childComplexity int, builder *model.IDorBuilderInput) int
@@ -216,6 +447,7 @@ type ComplexityRoot struct {
 		IngestCertifyVulns              func(childComplexity int, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, certifyVulns []*model.ScanMetadataInput) int
 		IngestDependencies              func(childComplexity int, pkgs []*model.IDorPkgInput, depPkgs []*model.IDorPkgInput, depPkgMatchType model.MatchFlags, dependencies []*model.IsDependencyInputSpec) int
 		IngestDependency                func(childComplexity int, pkg model.IDorPkgInput, depPkg model.IDorPkgInput, depPkgMatchType model.MatchFlags, dependency model.IsDependencyInputSpec) int
+		IngestExploitReference          func(childComplexity int, vulnerability model.IDorVulnerabilityInput, exploitReference model.ExploitReferenceInputSpec) int
 		IngestHasMetadata               func(childComplexity int, subject model.PackageSourceOrArtifactInput, pkgMatchType model.MatchFlags, hasMetadata model.HasMetadataInputSpec) int
 		IngestHasSBOMs                  func(childComplexity int, subjects model.PackageOrArtifactInputs, hasSBOMs []*model.HasSBOMInputSpec, includes []*model.HasSBOMIncludesInputSpec) int
 		IngestHasSbom                   func(childComplexity int, subject model.PackageOrArtifactInput, hasSbom model.HasSBOMInputSpec, includes model.HasSBOMIncludesInputSpec) int
@@ -246,6 +478,12 @@ type ComplexityRoot struct {
 		IngestVulnerabilities           func(childComplexity int, vulns []*model.IDorVulnerabilityInput) int
 		IngestVulnerability             func(childComplexity int, vuln model.IDorVulnerabilityInput) int
 		IngestVulnerabilityMetadata     func(childComplexity int, vulnerability model.IDorVulnerabilityInput, vulnerabilityMetadata model.VulnerabilityMetadataInputSpec) int
+		MarkStaleVulns                  func(childComplexity int, olderThan time.Duration) int
+		MergePackages                   func(childComplexity int, primary string, duplicates []string) int
+		UpdateCertifyScorecard          func(childComplexity int, id string, scorecard model.ScorecardInputSpec) int
+		UpdateCertifyVulnResolution     func(childComplexity int, id string, resolvedBy string) int
+		UpdateHasSourceAt               func(childComplexity int, id string, hasSourceAt model.HasSourceAtInputSpec) int
+		UpdatePointOfContact            func(childComplexity int, id string, pointOfContact model.PointOfContactInputSpec) int
 	}
 
 	Package struct {
@@ -254,6 +492,17 @@ type ComplexityRoot struct {
 		Type       func(childComplexity int) int
 	}
 
+	PackageConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	PackageEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	PackageIDs struct {
 		PackageNameID      func(childComplexity int) int
 		PackageNamespaceID func(childComplexity int) int
@@ -279,11 +528,25 @@ type ComplexityRoot struct {
 	}
 
 	PackageVersion struct {
-		ID         func(childComplexity int) int
-		Purl       func(childComplexity int) int
-		Qualifiers func(childComplexity int) int
-		Subpath    func(childComplexity int) int
-		Version    func(childComplexity int) int
+		ID              func(childComplexity int) int
+		LatestScorecard func(childComplexity int) int
+		Purl            func(childComplexity int) int
+		Qualifiers      func(childComplexity int) int
+		Subpath         func(childComplexity int) int
+		Version         func(childComplexity int) int
+		Vulnerabilities func(childComplexity int, filter *model.CertifyVulnSpec) int
+	}
+
+	PackageVulnCount struct {
+		Count   func(childComplexity int) int
+		Package func(childComplexity int) int
+	}
+
+	PageInfo struct {
+		EndCursor       func(childComplexity int) int
+		HasNextPage     func(childComplexity int) int
+		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
 	}
 
 	PkgEqual struct {
@@ -295,6 +558,17 @@ type ComplexityRoot struct {
 		Packages      func(childComplexity int) int
 	}
 
+	PkgEqualConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	PkgEqualEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	PointOfContact struct {
 		Collector     func(childComplexity int) int
 		DocumentRef   func(childComplexity int) int
@@ -307,35 +581,102 @@ type ComplexityRoot struct {
 		Subject       func(childComplexity int) int
 	}
 
+	PointOfContactConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	PointOfContactEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	ProvenanceSummary struct {
+		CertifyLegal func(childComplexity int) int
+		CertifyVulns func(childComplexity int) int
+		HasSbom      func(childComplexity int) int
+		HasSlsa      func(childComplexity int) int
+		HasSourceAt  func(childComplexity int) int
+		IsOccurrence func(childComplexity int) int
+	}
+
 	Query struct {
-		Artifacts             func(childComplexity int, artifactSpec model.ArtifactSpec) int
-		Builders              func(childComplexity int, builderSpec model.BuilderSpec) int
-		CertifyBad            func(childComplexity int, certifyBadSpec model.CertifyBadSpec) int
-		CertifyGood           func(childComplexity int, certifyGoodSpec model.CertifyGoodSpec) int
-		CertifyLegal          func(childComplexity int, certifyLegalSpec model.CertifyLegalSpec) int
-		CertifyVEXStatement   func(childComplexity int, certifyVEXStatementSpec model.CertifyVEXStatementSpec) int
-		CertifyVuln           func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec) int
-		FindSoftware          func(childComplexity int, searchText string) int
-		HasMetadata           func(childComplexity int, hasMetadataSpec model.HasMetadataSpec) int
-		HasSbom               func(childComplexity int, hasSBOMSpec model.HasSBOMSpec) int
-		HasSlsa               func(childComplexity int, hasSLSASpec model.HasSLSASpec) int
-		HasSourceAt           func(childComplexity int, hasSourceAtSpec model.HasSourceAtSpec) int
-		HashEqual             func(childComplexity int, hashEqualSpec model.HashEqualSpec) int
-		IsDependency          func(childComplexity int, isDependencySpec model.IsDependencySpec) int
-		IsOccurrence          func(childComplexity int, isOccurrenceSpec model.IsOccurrenceSpec) int
-		Licenses              func(childComplexity int, licenseSpec model.LicenseSpec) int
-		Neighbors             func(childComplexity int, node string, usingOnly []model.Edge) int
-		Node                  func(childComplexity int, node string) int
-		Nodes                 func(childComplexity int, nodes []string) int
-		Packages              func(childComplexity int, pkgSpec model.PkgSpec) int
-		Path                  func(childComplexity int, subject string, target string, maxPathLength int, usingOnly []model.Edge) int
-		PkgEqual              func(childComplexity int, pkgEqualSpec model.PkgEqualSpec) int
-		PointOfContact        func(childComplexity int, pointOfContactSpec model.PointOfContactSpec) int
-		Scorecards            func(childComplexity int, scorecardSpec model.CertifyScorecardSpec) int
-		Sources               func(childComplexity int, sourceSpec model.SourceSpec) int
-		VulnEqual             func(childComplexity int, vulnEqualSpec model.VulnEqualSpec) int
-		Vulnerabilities       func(childComplexity int, vulnSpec model.VulnerabilitySpec) int
-		VulnerabilityMetadata func(childComplexity int, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec) int
+		ArchivedCertifyVuln       func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec) int
+		Artifacts                 func(childComplexity int, artifactSpec model.ArtifactSpec) int
+		ArtifactsList             func(childComplexity int, artifactSpec model.ArtifactSpec, pagination *model.PaginationSpec) int
+		BatchNodes                func(childComplexity int, ids []string) int
+		Builders                  func(childComplexity int, builderSpec model.BuilderSpec) int
+		BuildersList              func(childComplexity int, builderSpec model.BuilderSpec, pagination *model.PaginationSpec) int
+		CertifyBad                func(childComplexity int, certifyBadSpec model.CertifyBadSpec) int
+		CertifyBadList            func(childComplexity int, certifyBadSpec model.CertifyBadSpec, pagination *model.PaginationSpec) int
+		CertifyGood               func(childComplexity int, certifyGoodSpec model.CertifyGoodSpec) int
+		CertifyGoodList           func(childComplexity int, certifyGoodSpec model.CertifyGoodSpec, pagination *model.PaginationSpec) int
+		CertifyLegal              func(childComplexity int, certifyLegalSpec model.CertifyLegalSpec) int
+		CertifyLegalList          func(childComplexity int, certifyLegalSpec model.CertifyLegalSpec, pagination *model.PaginationSpec) int
+		CertifyVEXStatement       func(childComplexity int, certifyVEXStatementSpec model.CertifyVEXStatementSpec) int
+		CertifyVEXStatementList   func(childComplexity int, certifyVEXStatementSpec model.CertifyVEXStatementSpec, pagination *model.PaginationSpec) int
+		CertifyVuln               func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec) int
+		CertifyVulnByDocumentRef  func(childComplexity int, documentRef string) int
+		CertifyVulnCount          func(childComplexity int, certifyVulnSpec *model.CertifyVulnSpec) int
+		CertifyVulnList           func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec, pagination *model.PaginationSpec) int
+		CheckScannerFreshness     func(childComplexity int, scannerURI string, maxAge time.Duration) int
+		DependencyGraph           func(childComplexity int, root string, maxDepth int) int
+		DiffSbom                  func(childComplexity int, sbom1 string, sbom2 string) int
+		ExploitReferences         func(childComplexity int, exploitReferenceSpec model.ExploitReferenceSpec) int
+		FindSoftware              func(childComplexity int, searchText string) int
+		GetPackageByPurl          func(childComplexity int, purl string) int
+		HasMetadata               func(childComplexity int, hasMetadataSpec model.HasMetadataSpec) int
+		HasMetadataList           func(childComplexity int, hasMetadataSpec model.HasMetadataSpec, pagination *model.PaginationSpec) int
+		HasSBOMList               func(childComplexity int, hasSBOMSpec model.HasSBOMSpec, pagination *model.PaginationSpec) int
+		HasSLSAList               func(childComplexity int, hasSLSASpec model.HasSLSASpec, pagination *model.PaginationSpec) int
+		HasSbom                   func(childComplexity int, hasSBOMSpec model.HasSBOMSpec) int
+		HasSlsa                   func(childComplexity int, hasSLSASpec model.HasSLSASpec) int
+		HasSourceAt               func(childComplexity int, hasSourceAtSpec model.HasSourceAtSpec) int
+		HasSourceAtCount          func(childComplexity int, hasSourceAtSpec *model.HasSourceAtSpec) int
+		HasSourceAtList           func(childComplexity int, hasSourceAtSpec model.HasSourceAtSpec, pagination *model.PaginationSpec) int
+		HashEqual                 func(childComplexity int, hashEqualSpec model.HashEqualSpec) int
+		HashEqualList             func(childComplexity int, hashEqualSpec model.HashEqualSpec, pagination *model.PaginationSpec) int
+		IsDependency              func(childComplexity int, isDependencySpec model.IsDependencySpec) int
+		IsDependencyList          func(childComplexity int, isDependencySpec model.IsDependencySpec, pagination *model.PaginationSpec) int
+		IsOccurrence              func(childComplexity int, isOccurrenceSpec model.IsOccurrenceSpec) int
+		IsOccurrenceList          func(childComplexity int, isOccurrenceSpec model.IsOccurrenceSpec, pagination *model.PaginationSpec) int
+		Licenses                  func(childComplexity int, licenseSpec model.LicenseSpec) int
+		LicensesList              func(childComplexity int, licenseSpec model.LicenseSpec, pagination *model.PaginationSpec) int
+		Neighbors                 func(childComplexity int, node string, usingOnly []model.Edge) int
+		NeighborsRecursive        func(childComplexity int, node string, edges []model.Edge, maxDepth int) int
+		Node                      func(childComplexity int, node string) int
+		NodeType                  func(childComplexity int, id string) int
+		Nodes                     func(childComplexity int, nodes []string) int
+		Packages                  func(childComplexity int, pkgSpec model.PkgSpec) int
+		PackagesCount             func(childComplexity int, pkgSpec *model.PkgSpec) int
+		PackagesList              func(childComplexity int, pkgSpec model.PkgSpec, pagination *model.PaginationSpec) int
+		Path                      func(childComplexity int, subject string, target string, maxPathLength int, usingOnly []model.Edge) int
+		PkgEqual                  func(childComplexity int, pkgEqualSpec model.PkgEqualSpec) int
+		PkgEqualList              func(childComplexity int, pkgEqualSpec model.PkgEqualSpec, pagination *model.PaginationSpec) int
+		PointOfContact            func(childComplexity int, pointOfContactSpec model.PointOfContactSpec) int
+		PointOfContactList        func(childComplexity int, pointOfContactSpec model.PointOfContactSpec, pagination *model.PaginationSpec) int
+		Provenance                func(childComplexity int, pkg string) int
+		SBOMComponentBreakdown    func(childComplexity int, hasSbomid string) int
+		Scorecards                func(childComplexity int, scorecardSpec model.CertifyScorecardSpec) int
+		ScorecardsList            func(childComplexity int, scorecardSpec model.CertifyScorecardSpec, pagination *model.PaginationSpec) int
+		Sources                   func(childComplexity int, sourceSpec model.SourceSpec) int
+		SourcesCount              func(childComplexity int, sourceSpec *model.SourceSpec) int
+		SourcesList               func(childComplexity int, sourceSpec model.SourceSpec, pagination *model.PaginationSpec) int
+		TopVulnerablePackages     func(childComplexity int, limit int, filter *model.CertifyVulnSpec) int
+		VulnEqual                 func(childComplexity int, vulnEqualSpec model.VulnEqualSpec) int
+		VulnEqualList             func(childComplexity int, vulnEqualSpec model.VulnEqualSpec, pagination *model.PaginationSpec) int
+		Vulnerabilities           func(childComplexity int, vulnSpec model.VulnerabilitySpec) int
+		VulnerabilitiesList       func(childComplexity int, vulnSpec model.VulnerabilitySpec, pagination *model.PaginationSpec) int
+		VulnerabilityMetadata     func(childComplexity int, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec) int
+		VulnerabilityMetadataList func(childComplexity int, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, pagination *model.PaginationSpec) int
+		VulnerableInSbom          func(childComplexity int, vulnSpec model.VulnerabilitySpec, filter *model.HasSBOMSpec) int
+	}
+
+	SBOMDiff struct {
+		Added     func(childComplexity int) int
+		Removed   func(childComplexity int) int
+		Unchanged func(childComplexity int) int
 	}
 
 	SLSA struct {
@@ -357,6 +698,7 @@ type ComplexityRoot struct {
 	}
 
 	ScanMetadata struct {
+		CVSSScore      func(childComplexity int) int
 		Collector      func(childComplexity int) int
 		DbURI          func(childComplexity int) int
 		DbVersion      func(childComplexity int) int
@@ -367,6 +709,13 @@ type ComplexityRoot struct {
 		TimeScanned    func(childComplexity int) int
 	}
 
+	ScannerFreshnessResult struct {
+		DbVersion      func(childComplexity int) int
+		IsFresh        func(childComplexity int) int
+		LatestScanTime func(childComplexity int) int
+		StaleScanCount func(childComplexity int) int
+	}
+
 	Scorecard struct {
 		AggregateScore   func(childComplexity int) int
 		Checks           func(childComplexity int) int
@@ -389,6 +738,17 @@ type ComplexityRoot struct {
 		Type       func(childComplexity int) int
 	}
 
+	SourceConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	SourceEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	SourceIDs struct {
 		SourceNameID      func(childComplexity int) int
 		SourceNamespaceID func(childComplexity int) int
@@ -396,10 +756,12 @@ type ComplexityRoot struct {
 	}
 
 	SourceName struct {
-		Commit func(childComplexity int) int
-		ID     func(childComplexity int) int
-		Name   func(childComplexity int) int
-		Tag    func(childComplexity int) int
+		Commit        func(childComplexity int) int
+		CommitHistory func(childComplexity int, first int, after *string) int
+		ID            func(childComplexity int) int
+		Name          func(childComplexity int) int
+		RecentCommits func(childComplexity int, limit int) int
+		Tag           func(childComplexity int) int
 	}
 
 	SourceNamespace struct {
@@ -408,6 +770,11 @@ type ComplexityRoot struct {
 		Namespace func(childComplexity int) int
 	}
 
+	Subscription struct {
+		CertifyVulnAdded      func(childComplexity int, filter *model.CertifyVulnSpec) int
+		CertifyVulnForPackage func(childComplexity int, pkgSpec model.PkgSpec) int
+	}
+
 	VulnEqual struct {
 		Collector       func(childComplexity int) int
 		DocumentRef     func(childComplexity int) int
@@ -417,10 +784,35 @@ type ComplexityRoot struct {
 		Vulnerabilities func(childComplexity int) int
 	}
 
+	VulnEqualConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	VulnEqualEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	Vulnerability struct {
-		ID               func(childComplexity int) int
-		Type             func(childComplexity int) int
-		VulnerabilityIDs func(childComplexity int) int
+		CertifyVulns      func(childComplexity int, filter *model.CertifyVulnSpec) int
+		ExploitReferences func(childComplexity int) int
+		ID                func(childComplexity int) int
+		IsFixed           func(childComplexity int, pkgSpec *model.PkgSpec) int
+		Type              func(childComplexity int) int
+		VulnerabilityIDs  func(childComplexity int) int
+	}
+
+	VulnerabilityConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	VulnerabilityEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	VulnerabilityID struct {
@@ -443,6 +835,17 @@ type ComplexityRoot struct {
 		Timestamp     func(childComplexity int) int
 		Vulnerability func(childComplexity int) int
 	}
+
+	VulnerabilityMetadataConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	VulnerabilityMetadataEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
 }
 
 type executableSchema struct {
@@ -485,6 +888,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Artifact.ID(childComplexity), true
 
+	case "ArtifactConnection.edges":
+		if e.complexity.ArtifactConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.ArtifactConnection.Edges(childComplexity), true
+
+	case "ArtifactConnection.pageInfo":
+		if e.complexity.ArtifactConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.ArtifactConnection.PageInfo(childComplexity), true
+
+	case "ArtifactConnection.totalCount":
+		if e.complexity.ArtifactConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.ArtifactConnection.TotalCount(childComplexity), true
+
+	case "ArtifactEdge.cursor":
+		if e.complexity.ArtifactEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.ArtifactEdge.Cursor(childComplexity), true
+
+	case "ArtifactEdge.node":
+		if e.complexity.ArtifactEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.ArtifactEdge.Node(childComplexity), true
+
 	case "Builder.id":
 		if e.complexity.Builder.ID == nil {
 			break
@@ -499,6 +937,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Builder.URI(childComplexity), true
 
+	case "BuilderConnection.edges":
+		if e.complexity.BuilderConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.BuilderConnection.Edges(childComplexity), true
+
+	case "BuilderConnection.pageInfo":
+		if e.complexity.BuilderConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.BuilderConnection.PageInfo(childComplexity), true
+
+	case "BuilderConnection.totalCount":
+		if e.complexity.BuilderConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.BuilderConnection.TotalCount(childComplexity), true
+
+	case "BuilderEdge.cursor":
+		if e.complexity.BuilderEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.BuilderEdge.Cursor(childComplexity), true
+
+	case "BuilderEdge.node":
+		if e.complexity.BuilderEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.BuilderEdge.Node(childComplexity), true
+
 	case "CertifyBad.collector":
 		if e.complexity.CertifyBad.Collector == nil {
 			break
@@ -548,6 +1021,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyBad.Subject(childComplexity), true
 
+	case "CertifyBadConnection.edges":
+		if e.complexity.CertifyBadConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CertifyBadConnection.Edges(childComplexity), true
+
+	case "CertifyBadConnection.pageInfo":
+		if e.complexity.CertifyBadConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyBadConnection.PageInfo(childComplexity), true
+
+	case "CertifyBadConnection.totalCount":
+		if e.complexity.CertifyBadConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyBadConnection.TotalCount(childComplexity), true
+
+	case "CertifyBadEdge.cursor":
+		if e.complexity.CertifyBadEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyBadEdge.Cursor(childComplexity), true
+
+	case "CertifyBadEdge.node":
+		if e.complexity.CertifyBadEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyBadEdge.Node(childComplexity), true
+
 	case "CertifyGood.collector":
 		if e.complexity.CertifyGood.Collector == nil {
 			break
@@ -597,6 +1105,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyGood.Subject(childComplexity), true
 
+	case "CertifyGoodConnection.edges":
+		if e.complexity.CertifyGoodConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CertifyGoodConnection.Edges(childComplexity), true
+
+	case "CertifyGoodConnection.pageInfo":
+		if e.complexity.CertifyGoodConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyGoodConnection.PageInfo(childComplexity), true
+
+	case "CertifyGoodConnection.totalCount":
+		if e.complexity.CertifyGoodConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyGoodConnection.TotalCount(childComplexity), true
+
+	case "CertifyGoodEdge.cursor":
+		if e.complexity.CertifyGoodEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyGoodEdge.Cursor(childComplexity), true
+
+	case "CertifyGoodEdge.node":
+		if e.complexity.CertifyGoodEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyGoodEdge.Node(childComplexity), true
+
 	case "CertifyLegal.attribution":
 		if e.complexity.CertifyLegal.Attribution == nil {
 			break
@@ -681,6 +1224,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyLegal.TimeScanned(childComplexity), true
 
+	case "CertifyLegalConnection.edges":
+		if e.complexity.CertifyLegalConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CertifyLegalConnection.Edges(childComplexity), true
+
+	case "CertifyLegalConnection.pageInfo":
+		if e.complexity.CertifyLegalConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyLegalConnection.PageInfo(childComplexity), true
+
+	case "CertifyLegalConnection.totalCount":
+		if e.complexity.CertifyLegalConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyLegalConnection.TotalCount(childComplexity), true
+
+	case "CertifyLegalEdge.cursor":
+		if e.complexity.CertifyLegalEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyLegalEdge.Cursor(childComplexity), true
+
+	case "CertifyLegalEdge.node":
+		if e.complexity.CertifyLegalEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyLegalEdge.Node(childComplexity), true
+
 	case "CertifyScorecard.id":
 		if e.complexity.CertifyScorecard.ID == nil {
 			break
@@ -702,6 +1280,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyScorecard.Source(childComplexity), true
 
+	case "CertifyScorecardConnection.edges":
+		if e.complexity.CertifyScorecardConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecardConnection.Edges(childComplexity), true
+
+	case "CertifyScorecardConnection.pageInfo":
+		if e.complexity.CertifyScorecardConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecardConnection.PageInfo(childComplexity), true
+
+	case "CertifyScorecardConnection.totalCount":
+		if e.complexity.CertifyScorecardConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecardConnection.TotalCount(childComplexity), true
+
+	case "CertifyScorecardEdge.cursor":
+		if e.complexity.CertifyScorecardEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecardEdge.Cursor(childComplexity), true
+
+	case "CertifyScorecardEdge.node":
+		if e.complexity.CertifyScorecardEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecardEdge.Node(childComplexity), true
+
 	case "CertifyVEXStatement.collector":
 		if e.complexity.CertifyVEXStatement.Collector == nil {
 			break
@@ -779,15 +1392,50 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyVEXStatement.Vulnerability(childComplexity), true
 
-	case "CertifyVuln.id":
-		if e.complexity.CertifyVuln.ID == nil {
+	case "CertifyVEXStatementConnection.edges":
+		if e.complexity.CertifyVEXStatementConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.CertifyVuln.ID(childComplexity), true
+		return e.complexity.CertifyVEXStatementConnection.Edges(childComplexity), true
 
-	case "CertifyVuln.metadata":
-		if e.complexity.CertifyVuln.Metadata == nil {
+	case "CertifyVEXStatementConnection.pageInfo":
+		if e.complexity.CertifyVEXStatementConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatementConnection.PageInfo(childComplexity), true
+
+	case "CertifyVEXStatementConnection.totalCount":
+		if e.complexity.CertifyVEXStatementConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatementConnection.TotalCount(childComplexity), true
+
+	case "CertifyVEXStatementEdge.cursor":
+		if e.complexity.CertifyVEXStatementEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatementEdge.Cursor(childComplexity), true
+
+	case "CertifyVEXStatementEdge.node":
+		if e.complexity.CertifyVEXStatementEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatementEdge.Node(childComplexity), true
+
+	case "CertifyVuln.id":
+		if e.complexity.CertifyVuln.ID == nil {
+			break
+		}
+
+		return e.complexity.CertifyVuln.ID(childComplexity), true
+
+	case "CertifyVuln.metadata":
+		if e.complexity.CertifyVuln.Metadata == nil {
 			break
 		}
 
@@ -800,6 +1448,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyVuln.Package(childComplexity), true
 
+	case "CertifyVuln.remediationStatus":
+		if e.complexity.CertifyVuln.RemediationStatus == nil {
+			break
+		}
+
+		return e.complexity.CertifyVuln.RemediationStatus(childComplexity), true
+
+	case "CertifyVuln.resolvedBy":
+		if e.complexity.CertifyVuln.ResolvedBy == nil {
+			break
+		}
+
+		return e.complexity.CertifyVuln.ResolvedBy(childComplexity), true
+
+	case "CertifyVuln.stale":
+		if e.complexity.CertifyVuln.Stale == nil {
+			break
+		}
+
+		return e.complexity.CertifyVuln.Stale(childComplexity), true
+
 	case "CertifyVuln.vulnerability":
 		if e.complexity.CertifyVuln.Vulnerability == nil {
 			break
@@ -807,6 +1476,195 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyVuln.Vulnerability(childComplexity), true
 
+	case "CertifyVulnConnection.edges":
+		if e.complexity.CertifyVulnConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CertifyVulnConnection.Edges(childComplexity), true
+
+	case "CertifyVulnConnection.pageInfo":
+		if e.complexity.CertifyVulnConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyVulnConnection.PageInfo(childComplexity), true
+
+	case "CertifyVulnConnection.totalCount":
+		if e.complexity.CertifyVulnConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyVulnConnection.TotalCount(childComplexity), true
+
+	case "CertifyVulnEdge.cursor":
+		if e.complexity.CertifyVulnEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyVulnEdge.Cursor(childComplexity), true
+
+	case "CertifyVulnEdge.node":
+		if e.complexity.CertifyVulnEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyVulnEdge.Node(childComplexity), true
+
+	case "CommitConnection.edges":
+		if e.complexity.CommitConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CommitConnection.Edges(childComplexity), true
+
+	case "CommitConnection.pageInfo":
+		if e.complexity.CommitConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CommitConnection.PageInfo(childComplexity), true
+
+	case "CommitEdge.cursor":
+		if e.complexity.CommitEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CommitEdge.Cursor(childComplexity), true
+
+	case "CommitEdge.node":
+		if e.complexity.CommitEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CommitEdge.Node(childComplexity), true
+
+	case "ComponentTypeCount.componentType":
+		if e.complexity.ComponentTypeCount.ComponentType == nil {
+			break
+		}
+
+		return e.complexity.ComponentTypeCount.ComponentType(childComplexity), true
+
+	case "ComponentTypeCount.count":
+		if e.complexity.ComponentTypeCount.Count == nil {
+			break
+		}
+
+		return e.complexity.ComponentTypeCount.Count(childComplexity), true
+
+	case "DependencyEdge.from":
+		if e.complexity.DependencyEdge.From == nil {
+			break
+		}
+
+		return e.complexity.DependencyEdge.From(childComplexity), true
+
+	case "DependencyEdge.isDependency":
+		if e.complexity.DependencyEdge.IsDependency == nil {
+			break
+		}
+
+		return e.complexity.DependencyEdge.IsDependency(childComplexity), true
+
+	case "DependencyEdge.to":
+		if e.complexity.DependencyEdge.To == nil {
+			break
+		}
+
+		return e.complexity.DependencyEdge.To(childComplexity), true
+
+	case "DependencyGraph.edges":
+		if e.complexity.DependencyGraph.Edges == nil {
+			break
+		}
+
+		return e.complexity.DependencyGraph.Edges(childComplexity), true
+
+	case "DependencyGraph.nodes":
+		if e.complexity.DependencyGraph.Nodes == nil {
+			break
+		}
+
+		return e.complexity.DependencyGraph.Nodes(childComplexity), true
+
+	case "ExploitReference.collector":
+		if e.complexity.ExploitReference.Collector == nil {
+			break
+		}
+
+		return e.complexity.ExploitReference.Collector(childComplexity), true
+
+	case "ExploitReference.documentRef":
+		if e.complexity.ExploitReference.DocumentRef == nil {
+			break
+		}
+
+		return e.complexity.ExploitReference.DocumentRef(childComplexity), true
+
+	case "ExploitReference.id":
+		if e.complexity.ExploitReference.ID == nil {
+			break
+		}
+
+		return e.complexity.ExploitReference.ID(childComplexity), true
+
+	case "ExploitReference.origin":
+		if e.complexity.ExploitReference.Origin == nil {
+			break
+		}
+
+		return e.complexity.ExploitReference.Origin(childComplexity), true
+
+	case "ExploitReference.source":
+		if e.complexity.ExploitReference.Source == nil {
+			break
+		}
+
+		return e.complexity.ExploitReference.Source(childComplexity), true
+
+	case "ExploitReference.url":
+		if e.complexity.ExploitReference.URL == nil {
+			break
+		}
+
+		return e.complexity.ExploitReference.URL(childComplexity), true
+
+	case "ExploitReference.verified":
+		if e.complexity.ExploitReference.Verified == nil {
+			break
+		}
+
+		return e.complexity.ExploitReference.Verified(childComplexity), true
+
+	case "ExploitReference.vulnerability":
+		if e.complexity.ExploitReference.Vulnerability == nil {
+			break
+		}
+
+		return e.complexity.ExploitReference.Vulnerability(childComplexity), true
+
+	case "GitCommit.authorDate":
+		if e.complexity.GitCommit.AuthorDate == nil {
+			break
+		}
+
+		return e.complexity.GitCommit.AuthorDate(childComplexity), true
+
+	case "GitCommit.message":
+		if e.complexity.GitCommit.Message == nil {
+			break
+		}
+
+		return e.complexity.GitCommit.Message(childComplexity), true
+
+	case "GitCommit.sha":
+		if e.complexity.GitCommit.Sha == nil {
+			break
+		}
+
+		return e.complexity.GitCommit.Sha(childComplexity), true
+
 	case "HasMetadata.collector":
 		if e.complexity.HasMetadata.Collector == nil {
 			break
@@ -870,6 +1728,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HasMetadata.Value(childComplexity), true
 
+	case "HasMetadataConnection.edges":
+		if e.complexity.HasMetadataConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.HasMetadataConnection.Edges(childComplexity), true
+
+	case "HasMetadataConnection.pageInfo":
+		if e.complexity.HasMetadataConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.HasMetadataConnection.PageInfo(childComplexity), true
+
+	case "HasMetadataConnection.totalCount":
+		if e.complexity.HasMetadataConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.HasMetadataConnection.TotalCount(childComplexity), true
+
+	case "HasMetadataEdge.cursor":
+		if e.complexity.HasMetadataEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.HasMetadataEdge.Cursor(childComplexity), true
+
+	case "HasMetadataEdge.node":
+		if e.complexity.HasMetadataEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.HasMetadataEdge.Node(childComplexity), true
+
 	case "HasSBOM.algorithm":
 		if e.complexity.HasSBOM.Algorithm == nil {
 			break
@@ -961,6 +1854,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HasSBOM.URI(childComplexity), true
 
+	case "HasSBOMConnection.edges":
+		if e.complexity.HasSBOMConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.HasSBOMConnection.Edges(childComplexity), true
+
+	case "HasSBOMConnection.pageInfo":
+		if e.complexity.HasSBOMConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.HasSBOMConnection.PageInfo(childComplexity), true
+
+	case "HasSBOMConnection.totalCount":
+		if e.complexity.HasSBOMConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.HasSBOMConnection.TotalCount(childComplexity), true
+
+	case "HasSBOMEdge.cursor":
+		if e.complexity.HasSBOMEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.HasSBOMEdge.Cursor(childComplexity), true
+
+	case "HasSBOMEdge.node":
+		if e.complexity.HasSBOMEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.HasSBOMEdge.Node(childComplexity), true
+
 	case "HasSLSA.id":
 		if e.complexity.HasSLSA.ID == nil {
 			break
@@ -982,6 +1910,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HasSLSA.Subject(childComplexity), true
 
+	case "HasSLSAConnection.edges":
+		if e.complexity.HasSLSAConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.HasSLSAConnection.Edges(childComplexity), true
+
+	case "HasSLSAConnection.pageInfo":
+		if e.complexity.HasSLSAConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.HasSLSAConnection.PageInfo(childComplexity), true
+
+	case "HasSLSAConnection.totalCount":
+		if e.complexity.HasSLSAConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.HasSLSAConnection.TotalCount(childComplexity), true
+
+	case "HasSLSAEdge.cursor":
+		if e.complexity.HasSLSAEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.HasSLSAEdge.Cursor(childComplexity), true
+
+	case "HasSLSAEdge.node":
+		if e.complexity.HasSLSAEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.HasSLSAEdge.Node(childComplexity), true
+
 	case "HasSourceAt.collector":
 		if e.complexity.HasSourceAt.Collector == nil {
 			break
@@ -1038,6 +2001,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HasSourceAt.Source(childComplexity), true
 
+	case "HasSourceAtConnection.edges":
+		if e.complexity.HasSourceAtConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtConnection.Edges(childComplexity), true
+
+	case "HasSourceAtConnection.pageInfo":
+		if e.complexity.HasSourceAtConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtConnection.PageInfo(childComplexity), true
+
+	case "HasSourceAtConnection.totalCount":
+		if e.complexity.HasSourceAtConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtConnection.TotalCount(childComplexity), true
+
+	case "HasSourceAtEdge.cursor":
+		if e.complexity.HasSourceAtEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtEdge.Cursor(childComplexity), true
+
+	case "HasSourceAtEdge.node":
+		if e.complexity.HasSourceAtEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtEdge.Node(childComplexity), true
+
 	case "HashEqual.artifacts":
 		if e.complexity.HashEqual.Artifacts == nil {
 			break
@@ -1080,6 +2078,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HashEqual.Origin(childComplexity), true
 
+	case "HashEqualConnection.edges":
+		if e.complexity.HashEqualConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.HashEqualConnection.Edges(childComplexity), true
+
+	case "HashEqualConnection.pageInfo":
+		if e.complexity.HashEqualConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.HashEqualConnection.PageInfo(childComplexity), true
+
+	case "HashEqualConnection.totalCount":
+		if e.complexity.HashEqualConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.HashEqualConnection.TotalCount(childComplexity), true
+
+	case "HashEqualEdge.cursor":
+		if e.complexity.HashEqualEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.HashEqualEdge.Cursor(childComplexity), true
+
+	case "HashEqualEdge.node":
+		if e.complexity.HashEqualEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.HashEqualEdge.Node(childComplexity), true
+
 	case "IsDependency.collector":
 		if e.complexity.IsDependency.Collector == nil {
 			break
@@ -1143,29 +2176,64 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.IsDependency.VersionRange(childComplexity), true
 
-	case "IsOccurrence.artifact":
-		if e.complexity.IsOccurrence.Artifact == nil {
+	case "IsDependencyConnection.edges":
+		if e.complexity.IsDependencyConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.IsOccurrence.Artifact(childComplexity), true
+		return e.complexity.IsDependencyConnection.Edges(childComplexity), true
 
-	case "IsOccurrence.collector":
-		if e.complexity.IsOccurrence.Collector == nil {
+	case "IsDependencyConnection.pageInfo":
+		if e.complexity.IsDependencyConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.IsOccurrence.Collector(childComplexity), true
+		return e.complexity.IsDependencyConnection.PageInfo(childComplexity), true
 
-	case "IsOccurrence.documentRef":
-		if e.complexity.IsOccurrence.DocumentRef == nil {
+	case "IsDependencyConnection.totalCount":
+		if e.complexity.IsDependencyConnection.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.IsOccurrence.DocumentRef(childComplexity), true
+		return e.complexity.IsDependencyConnection.TotalCount(childComplexity), true
 
-	case "IsOccurrence.id":
-		if e.complexity.IsOccurrence.ID == nil {
+	case "IsDependencyEdge.cursor":
+		if e.complexity.IsDependencyEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.IsDependencyEdge.Cursor(childComplexity), true
+
+	case "IsDependencyEdge.node":
+		if e.complexity.IsDependencyEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.IsDependencyEdge.Node(childComplexity), true
+
+	case "IsOccurrence.artifact":
+		if e.complexity.IsOccurrence.Artifact == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.Artifact(childComplexity), true
+
+	case "IsOccurrence.collector":
+		if e.complexity.IsOccurrence.Collector == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.Collector(childComplexity), true
+
+	case "IsOccurrence.documentRef":
+		if e.complexity.IsOccurrence.DocumentRef == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.DocumentRef(childComplexity), true
+
+	case "IsOccurrence.id":
+		if e.complexity.IsOccurrence.ID == nil {
 			break
 		}
 
@@ -1192,6 +2260,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.IsOccurrence.Subject(childComplexity), true
 
+	case "IsOccurrenceConnection.edges":
+		if e.complexity.IsOccurrenceConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrenceConnection.Edges(childComplexity), true
+
+	case "IsOccurrenceConnection.pageInfo":
+		if e.complexity.IsOccurrenceConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrenceConnection.PageInfo(childComplexity), true
+
+	case "IsOccurrenceConnection.totalCount":
+		if e.complexity.IsOccurrenceConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrenceConnection.TotalCount(childComplexity), true
+
+	case "IsOccurrenceEdge.cursor":
+		if e.complexity.IsOccurrenceEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrenceEdge.Cursor(childComplexity), true
+
+	case "IsOccurrenceEdge.node":
+		if e.complexity.IsOccurrenceEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrenceEdge.Node(childComplexity), true
+
 	case "License.id":
 		if e.complexity.License.ID == nil {
 			break
@@ -1220,6 +2323,77 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.License.Name(childComplexity), true
 
+	case "LicenseConnection.edges":
+		if e.complexity.LicenseConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.LicenseConnection.Edges(childComplexity), true
+
+	case "LicenseConnection.pageInfo":
+		if e.complexity.LicenseConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.LicenseConnection.PageInfo(childComplexity), true
+
+	case "LicenseConnection.totalCount":
+		if e.complexity.LicenseConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.LicenseConnection.TotalCount(childComplexity), true
+
+	case "LicenseEdge.cursor":
+		if e.complexity.LicenseEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.LicenseEdge.Cursor(childComplexity), true
+
+	case "LicenseEdge.node":
+		if e.complexity.LicenseEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.LicenseEdge.Node(childComplexity), true
+
+	case "Mutation.archiveCertifyVulns":
+		if e.complexity.Mutation.ArchiveCertifyVulns == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_archiveCertifyVulns_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ArchiveCertifyVulns(childComplexity, args["olderThan"].(time.Duration)), true
+
+	case "Mutation.deleteCertifyVuln":
+		if e.complexity.Mutation.DeleteCertifyVuln == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteCertifyVuln_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteCertifyVuln(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteSource":
+		if e.complexity.Mutation.DeleteSource == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteSource_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteSource(childComplexity, args["id"].(string)), true
+
 	case "Mutation.ingestArtifact":
 		if e.complexity.Mutation.IngestArtifact == nil {
 			break
@@ -1412,6 +2586,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.IngestDependency(childComplexity, args["pkg"].(model.IDorPkgInput), args["depPkg"].(model.IDorPkgInput), args["depPkgMatchType"].(model.MatchFlags), args["dependency"].(model.IsDependencyInputSpec)), true
 
+	case "Mutation.ingestExploitReference":
+		if e.complexity.Mutation.IngestExploitReference == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestExploitReference_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestExploitReference(childComplexity, args["vulnerability"].(model.IDorVulnerabilityInput), args["exploitReference"].(model.ExploitReferenceInputSpec)), true
+
 	case "Mutation.ingestHasMetadata":
 		if e.complexity.Mutation.IngestHasMetadata == nil {
 			break
@@ -1772,6 +2958,78 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.IngestVulnerabilityMetadata(childComplexity, args["vulnerability"].(model.IDorVulnerabilityInput), args["vulnerabilityMetadata"].(model.VulnerabilityMetadataInputSpec)), true
 
+	case "Mutation.markStaleVulns":
+		if e.complexity.Mutation.MarkStaleVulns == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_markStaleVulns_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.MarkStaleVulns(childComplexity, args["olderThan"].(time.Duration)), true
+
+	case "Mutation.mergePackages":
+		if e.complexity.Mutation.MergePackages == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_mergePackages_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.MergePackages(childComplexity, args["primary"].(string), args["duplicates"].([]string)), true
+
+	case "Mutation.updateCertifyScorecard":
+		if e.complexity.Mutation.UpdateCertifyScorecard == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateCertifyScorecard_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateCertifyScorecard(childComplexity, args["id"].(string), args["scorecard"].(model.ScorecardInputSpec)), true
+
+	case "Mutation.updateCertifyVulnResolution":
+		if e.complexity.Mutation.UpdateCertifyVulnResolution == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateCertifyVulnResolution_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateCertifyVulnResolution(childComplexity, args["id"].(string), args["resolvedBy"].(string)), true
+
+	case "Mutation.updateHasSourceAt":
+		if e.complexity.Mutation.UpdateHasSourceAt == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateHasSourceAt_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateHasSourceAt(childComplexity, args["id"].(string), args["hasSourceAt"].(model.HasSourceAtInputSpec)), true
+
+	case "Mutation.updatePointOfContact":
+		if e.complexity.Mutation.UpdatePointOfContact == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updatePointOfContact_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdatePointOfContact(childComplexity, args["id"].(string), args["pointOfContact"].(model.PointOfContactInputSpec)), true
+
 	case "Package.id":
 		if e.complexity.Package.ID == nil {
 			break
@@ -1793,6 +3051,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Package.Type(childComplexity), true
 
+	case "PackageConnection.edges":
+		if e.complexity.PackageConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.PackageConnection.Edges(childComplexity), true
+
+	case "PackageConnection.pageInfo":
+		if e.complexity.PackageConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.PackageConnection.PageInfo(childComplexity), true
+
+	case "PackageConnection.totalCount":
+		if e.complexity.PackageConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.PackageConnection.TotalCount(childComplexity), true
+
+	case "PackageEdge.cursor":
+		if e.complexity.PackageEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.PackageEdge.Cursor(childComplexity), true
+
+	case "PackageEdge.node":
+		if e.complexity.PackageEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.PackageEdge.Node(childComplexity), true
+
 	case "PackageIDs.packageNameID":
 		if e.complexity.PackageIDs.PackageNameID == nil {
 			break
@@ -1884,6 +3177,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PackageVersion.ID(childComplexity), true
 
+	case "PackageVersion.latestScorecard":
+		if e.complexity.PackageVersion.LatestScorecard == nil {
+			break
+		}
+
+		return e.complexity.PackageVersion.LatestScorecard(childComplexity), true
+
 	case "PackageVersion.purl":
 		if e.complexity.PackageVersion.Purl == nil {
 			break
@@ -1912,6 +3212,60 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PackageVersion.Version(childComplexity), true
 
+	case "PackageVersion.vulnerabilities":
+		if e.complexity.PackageVersion.Vulnerabilities == nil {
+			break
+		}
+
+		args, err := ec.field_PackageVersion_vulnerabilities_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.PackageVersion.Vulnerabilities(childComplexity, args["filter"].(*model.CertifyVulnSpec)), true
+
+	case "PackageVulnCount.count":
+		if e.complexity.PackageVulnCount.Count == nil {
+			break
+		}
+
+		return e.complexity.PackageVulnCount.Count(childComplexity), true
+
+	case "PackageVulnCount.package":
+		if e.complexity.PackageVulnCount.Package == nil {
+			break
+		}
+
+		return e.complexity.PackageVulnCount.Package(childComplexity), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
 	case "PkgEqual.collector":
 		if e.complexity.PkgEqual.Collector == nil {
 			break
@@ -1954,6 +3308,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PkgEqual.Packages(childComplexity), true
 
+	case "PkgEqualConnection.edges":
+		if e.complexity.PkgEqualConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.PkgEqualConnection.Edges(childComplexity), true
+
+	case "PkgEqualConnection.pageInfo":
+		if e.complexity.PkgEqualConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.PkgEqualConnection.PageInfo(childComplexity), true
+
+	case "PkgEqualConnection.totalCount":
+		if e.complexity.PkgEqualConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.PkgEqualConnection.TotalCount(childComplexity), true
+
+	case "PkgEqualEdge.cursor":
+		if e.complexity.PkgEqualEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.PkgEqualEdge.Cursor(childComplexity), true
+
+	case "PkgEqualEdge.node":
+		if e.complexity.PkgEqualEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.PkgEqualEdge.Node(childComplexity), true
+
 	case "PointOfContact.collector":
 		if e.complexity.PointOfContact.Collector == nil {
 			break
@@ -2017,54 +3406,203 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PointOfContact.Subject(childComplexity), true
 
-	case "Query.artifacts":
-		if e.complexity.Query.Artifacts == nil {
+	case "PointOfContactConnection.edges":
+		if e.complexity.PointOfContactConnection.Edges == nil {
 			break
 		}
 
-		args, err := ec.field_Query_artifacts_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.PointOfContactConnection.Edges(childComplexity), true
+
+	case "PointOfContactConnection.pageInfo":
+		if e.complexity.PointOfContactConnection.PageInfo == nil {
+			break
 		}
 
-		return e.complexity.Query.Artifacts(childComplexity, args["artifactSpec"].(model.ArtifactSpec)), true
+		return e.complexity.PointOfContactConnection.PageInfo(childComplexity), true
 
-	case "Query.builders":
-		if e.complexity.Query.Builders == nil {
+	case "PointOfContactConnection.totalCount":
+		if e.complexity.PointOfContactConnection.TotalCount == nil {
 			break
 		}
 
-		args, err := ec.field_Query_builders_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.PointOfContactConnection.TotalCount(childComplexity), true
+
+	case "PointOfContactEdge.cursor":
+		if e.complexity.PointOfContactEdge.Cursor == nil {
+			break
 		}
 
-		return e.complexity.Query.Builders(childComplexity, args["builderSpec"].(model.BuilderSpec)), true
+		return e.complexity.PointOfContactEdge.Cursor(childComplexity), true
 
-	case "Query.CertifyBad":
-		if e.complexity.Query.CertifyBad == nil {
+	case "PointOfContactEdge.node":
+		if e.complexity.PointOfContactEdge.Node == nil {
 			break
 		}
 
-		args, err := ec.field_Query_CertifyBad_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.PointOfContactEdge.Node(childComplexity), true
+
+	case "ProvenanceSummary.certifyLegal":
+		if e.complexity.ProvenanceSummary.CertifyLegal == nil {
+			break
 		}
 
-		return e.complexity.Query.CertifyBad(childComplexity, args["certifyBadSpec"].(model.CertifyBadSpec)), true
+		return e.complexity.ProvenanceSummary.CertifyLegal(childComplexity), true
 
-	case "Query.CertifyGood":
-		if e.complexity.Query.CertifyGood == nil {
+	case "ProvenanceSummary.certifyVulns":
+		if e.complexity.ProvenanceSummary.CertifyVulns == nil {
 			break
 		}
 
-		args, err := ec.field_Query_CertifyGood_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ProvenanceSummary.CertifyVulns(childComplexity), true
+
+	case "ProvenanceSummary.hasSBOM":
+		if e.complexity.ProvenanceSummary.HasSbom == nil {
+			break
+		}
+
+		return e.complexity.ProvenanceSummary.HasSbom(childComplexity), true
+
+	case "ProvenanceSummary.hasSLSA":
+		if e.complexity.ProvenanceSummary.HasSlsa == nil {
+			break
+		}
+
+		return e.complexity.ProvenanceSummary.HasSlsa(childComplexity), true
+
+	case "ProvenanceSummary.hasSourceAt":
+		if e.complexity.ProvenanceSummary.HasSourceAt == nil {
+			break
+		}
+
+		return e.complexity.ProvenanceSummary.HasSourceAt(childComplexity), true
+
+	case "ProvenanceSummary.isOccurrence":
+		if e.complexity.ProvenanceSummary.IsOccurrence == nil {
+			break
+		}
+
+		return e.complexity.ProvenanceSummary.IsOccurrence(childComplexity), true
+
+	case "Query.archivedCertifyVuln":
+		if e.complexity.Query.ArchivedCertifyVuln == nil {
+			break
+		}
+
+		args, err := ec.field_Query_archivedCertifyVuln_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ArchivedCertifyVuln(childComplexity, args["certifyVulnSpec"].(model.CertifyVulnSpec)), true
+
+	case "Query.artifacts":
+		if e.complexity.Query.Artifacts == nil {
+			break
+		}
+
+		args, err := ec.field_Query_artifacts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Artifacts(childComplexity, args["artifactSpec"].(model.ArtifactSpec)), true
+
+	case "Query.artifactsList":
+		if e.complexity.Query.ArtifactsList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_artifactsList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ArtifactsList(childComplexity, args["artifactSpec"].(model.ArtifactSpec), args["pagination"].(*model.PaginationSpec)), true
+
+	case "Query.batchNodes":
+		if e.complexity.Query.BatchNodes == nil {
+			break
+		}
+
+		args, err := ec.field_Query_batchNodes_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.BatchNodes(childComplexity, args["ids"].([]string)), true
+
+	case "Query.builders":
+		if e.complexity.Query.Builders == nil {
+			break
+		}
+
+		args, err := ec.field_Query_builders_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Builders(childComplexity, args["builderSpec"].(model.BuilderSpec)), true
+
+	case "Query.buildersList":
+		if e.complexity.Query.BuildersList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_buildersList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.BuildersList(childComplexity, args["builderSpec"].(model.BuilderSpec), args["pagination"].(*model.PaginationSpec)), true
+
+	case "Query.CertifyBad":
+		if e.complexity.Query.CertifyBad == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyBad_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyBad(childComplexity, args["certifyBadSpec"].(model.CertifyBadSpec)), true
+
+	case "Query.CertifyBadList":
+		if e.complexity.Query.CertifyBadList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyBadList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyBadList(childComplexity, args["certifyBadSpec"].(model.CertifyBadSpec), args["pagination"].(*model.PaginationSpec)), true
+
+	case "Query.CertifyGood":
+		if e.complexity.Query.CertifyGood == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyGood_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
 		return e.complexity.Query.CertifyGood(childComplexity, args["certifyGoodSpec"].(model.CertifyGoodSpec)), true
 
+	case "Query.CertifyGoodList":
+		if e.complexity.Query.CertifyGoodList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyGoodList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyGoodList(childComplexity, args["certifyGoodSpec"].(model.CertifyGoodSpec), args["pagination"].(*model.PaginationSpec)), true
+
 	case "Query.CertifyLegal":
 		if e.complexity.Query.CertifyLegal == nil {
 			break
@@ -2077,6 +3615,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.CertifyLegal(childComplexity, args["certifyLegalSpec"].(model.CertifyLegalSpec)), true
 
+	case "Query.CertifyLegalList":
+		if e.complexity.Query.CertifyLegalList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyLegalList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyLegalList(childComplexity, args["certifyLegalSpec"].(model.CertifyLegalSpec), args["pagination"].(*model.PaginationSpec)), true
+
 	case "Query.CertifyVEXStatement":
 		if e.complexity.Query.CertifyVEXStatement == nil {
 			break
@@ -2089,6 +3639,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.CertifyVEXStatement(childComplexity, args["certifyVEXStatementSpec"].(model.CertifyVEXStatementSpec)), true
 
+	case "Query.CertifyVEXStatementList":
+		if e.complexity.Query.CertifyVEXStatementList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyVEXStatementList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyVEXStatementList(childComplexity, args["certifyVEXStatementSpec"].(model.CertifyVEXStatementSpec), args["pagination"].(*model.PaginationSpec)), true
+
 	case "Query.CertifyVuln":
 		if e.complexity.Query.CertifyVuln == nil {
 			break
@@ -2101,6 +3663,90 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.CertifyVuln(childComplexity, args["certifyVulnSpec"].(model.CertifyVulnSpec)), true
 
+	case "Query.CertifyVulnByDocumentRef":
+		if e.complexity.Query.CertifyVulnByDocumentRef == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyVulnByDocumentRef_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyVulnByDocumentRef(childComplexity, args["documentRef"].(string)), true
+
+	case "Query.CertifyVulnCount":
+		if e.complexity.Query.CertifyVulnCount == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyVulnCount_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyVulnCount(childComplexity, args["certifyVulnSpec"].(*model.CertifyVulnSpec)), true
+
+	case "Query.CertifyVulnList":
+		if e.complexity.Query.CertifyVulnList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyVulnList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyVulnList(childComplexity, args["certifyVulnSpec"].(model.CertifyVulnSpec), args["pagination"].(*model.PaginationSpec)), true
+
+	case "Query.CheckScannerFreshness":
+		if e.complexity.Query.CheckScannerFreshness == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CheckScannerFreshness_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CheckScannerFreshness(childComplexity, args["scannerURI"].(string), args["maxAge"].(time.Duration)), true
+
+	case "Query.dependencyGraph":
+		if e.complexity.Query.DependencyGraph == nil {
+			break
+		}
+
+		args, err := ec.field_Query_dependencyGraph_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DependencyGraph(childComplexity, args["root"].(string), args["maxDepth"].(int)), true
+
+	case "Query.diffSBOM":
+		if e.complexity.Query.DiffSbom == nil {
+			break
+		}
+
+		args, err := ec.field_Query_diffSBOM_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DiffSbom(childComplexity, args["sbom1"].(string), args["sbom2"].(string)), true
+
+	case "Query.ExploitReferences":
+		if e.complexity.Query.ExploitReferences == nil {
+			break
+		}
+
+		args, err := ec.field_Query_ExploitReferences_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ExploitReferences(childComplexity, args["exploitReferenceSpec"].(model.ExploitReferenceSpec)), true
+
 	case "Query.findSoftware":
 		if e.complexity.Query.FindSoftware == nil {
 			break
@@ -2113,6 +3759,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.FindSoftware(childComplexity, args["searchText"].(string)), true
 
+	case "Query.getPackageByPURL":
+		if e.complexity.Query.GetPackageByPurl == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getPackageByPURL_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetPackageByPurl(childComplexity, args["purl"].(string)), true
+
 	case "Query.HasMetadata":
 		if e.complexity.Query.HasMetadata == nil {
 			break
@@ -2125,6 +3783,42 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.HasMetadata(childComplexity, args["hasMetadataSpec"].(model.HasMetadataSpec)), true
 
+	case "Query.HasMetadataList":
+		if e.complexity.Query.HasMetadataList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HasMetadataList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HasMetadataList(childComplexity, args["hasMetadataSpec"].(model.HasMetadataSpec), args["pagination"].(*model.PaginationSpec)), true
+
+	case "Query.HasSBOMList":
+		if e.complexity.Query.HasSBOMList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HasSBOMList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HasSBOMList(childComplexity, args["hasSBOMSpec"].(model.HasSBOMSpec), args["pagination"].(*model.PaginationSpec)), true
+
+	case "Query.HasSLSAList":
+		if e.complexity.Query.HasSLSAList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HasSLSAList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HasSLSAList(childComplexity, args["hasSLSASpec"].(model.HasSLSASpec), args["pagination"].(*model.PaginationSpec)), true
+
 	case "Query.HasSBOM":
 		if e.complexity.Query.HasSbom == nil {
 			break
@@ -2161,6 +3855,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.HasSourceAt(childComplexity, args["hasSourceAtSpec"].(model.HasSourceAtSpec)), true
 
+	case "Query.HasSourceAtCount":
+		if e.complexity.Query.HasSourceAtCount == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HasSourceAtCount_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HasSourceAtCount(childComplexity, args["hasSourceAtSpec"].(*model.HasSourceAtSpec)), true
+
+	case "Query.HasSourceAtList":
+		if e.complexity.Query.HasSourceAtList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HasSourceAtList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HasSourceAtList(childComplexity, args["hasSourceAtSpec"].(model.HasSourceAtSpec), args["pagination"].(*model.PaginationSpec)), true
+
 	case "Query.HashEqual":
 		if e.complexity.Query.HashEqual == nil {
 			break
@@ -2173,6 +3891,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.HashEqual(childComplexity, args["hashEqualSpec"].(model.HashEqualSpec)), true
 
+	case "Query.HashEqualList":
+		if e.complexity.Query.HashEqualList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HashEqualList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HashEqualList(childComplexity, args["hashEqualSpec"].(model.HashEqualSpec), args["pagination"].(*model.PaginationSpec)), true
+
 	case "Query.IsDependency":
 		if e.complexity.Query.IsDependency == nil {
 			break
@@ -2185,6 +3915,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.IsDependency(childComplexity, args["isDependencySpec"].(model.IsDependencySpec)), true
 
+	case "Query.IsDependencyList":
+		if e.complexity.Query.IsDependencyList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_IsDependencyList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.IsDependencyList(childComplexity, args["isDependencySpec"].(model.IsDependencySpec), args["pagination"].(*model.PaginationSpec)), true
+
 	case "Query.IsOccurrence":
 		if e.complexity.Query.IsOccurrence == nil {
 			break
@@ -2197,6 +3939,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.IsOccurrence(childComplexity, args["isOccurrenceSpec"].(model.IsOccurrenceSpec)), true
 
+	case "Query.IsOccurrenceList":
+		if e.complexity.Query.IsOccurrenceList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_IsOccurrenceList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.IsOccurrenceList(childComplexity, args["isOccurrenceSpec"].(model.IsOccurrenceSpec), args["pagination"].(*model.PaginationSpec)), true
+
 	case "Query.licenses":
 		if e.complexity.Query.Licenses == nil {
 			break
@@ -2209,6 +3963,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Licenses(childComplexity, args["licenseSpec"].(model.LicenseSpec)), true
 
+	case "Query.licensesList":
+		if e.complexity.Query.LicensesList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_licensesList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.LicensesList(childComplexity, args["licenseSpec"].(model.LicenseSpec), args["pagination"].(*model.PaginationSpec)), true
+
 	case "Query.neighbors":
 		if e.complexity.Query.Neighbors == nil {
 			break
@@ -2221,137 +3987,350 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Neighbors(childComplexity, args["node"].(string), args["usingOnly"].([]model.Edge)), true
 
+	case "Query.neighborsRecursive":
+		if e.complexity.Query.NeighborsRecursive == nil {
+			break
+		}
+
+		args, err := ec.field_Query_neighborsRecursive_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.NeighborsRecursive(childComplexity, args["node"].(string), args["edges"].([]model.Edge), args["maxDepth"].(int)), true
+
 	case "Query.node":
 		if e.complexity.Query.Node == nil {
 			break
 		}
 
-		args, err := ec.field_Query_node_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_node_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Node(childComplexity, args["node"].(string)), true
+
+	case "Query.nodeType":
+		if e.complexity.Query.NodeType == nil {
+			break
+		}
+
+		args, err := ec.field_Query_nodeType_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.NodeType(childComplexity, args["id"].(string)), true
+
+	case "Query.nodes":
+		if e.complexity.Query.Nodes == nil {
+			break
+		}
+
+		args, err := ec.field_Query_nodes_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Nodes(childComplexity, args["nodes"].([]string)), true
+
+	case "Query.packages":
+		if e.complexity.Query.Packages == nil {
+			break
+		}
+
+		args, err := ec.field_Query_packages_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Packages(childComplexity, args["pkgSpec"].(model.PkgSpec)), true
+
+	case "Query.packagesCount":
+		if e.complexity.Query.PackagesCount == nil {
+			break
+		}
+
+		args, err := ec.field_Query_packagesCount_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PackagesCount(childComplexity, args["pkgSpec"].(*model.PkgSpec)), true
+
+	case "Query.packagesList":
+		if e.complexity.Query.PackagesList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_packagesList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PackagesList(childComplexity, args["pkgSpec"].(model.PkgSpec), args["pagination"].(*model.PaginationSpec)), true
+
+	case "Query.path":
+		if e.complexity.Query.Path == nil {
+			break
+		}
+
+		args, err := ec.field_Query_path_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Path(childComplexity, args["subject"].(string), args["target"].(string), args["maxPathLength"].(int), args["usingOnly"].([]model.Edge)), true
+
+	case "Query.PkgEqual":
+		if e.complexity.Query.PkgEqual == nil {
+			break
+		}
+
+		args, err := ec.field_Query_PkgEqual_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PkgEqual(childComplexity, args["pkgEqualSpec"].(model.PkgEqualSpec)), true
+
+	case "Query.PkgEqualList":
+		if e.complexity.Query.PkgEqualList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_PkgEqualList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PkgEqualList(childComplexity, args["pkgEqualSpec"].(model.PkgEqualSpec), args["pagination"].(*model.PaginationSpec)), true
+
+	case "Query.PointOfContact":
+		if e.complexity.Query.PointOfContact == nil {
+			break
+		}
+
+		args, err := ec.field_Query_PointOfContact_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PointOfContact(childComplexity, args["pointOfContactSpec"].(model.PointOfContactSpec)), true
+
+	case "Query.PointOfContactList":
+		if e.complexity.Query.PointOfContactList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_PointOfContactList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PointOfContactList(childComplexity, args["pointOfContactSpec"].(model.PointOfContactSpec), args["pagination"].(*model.PaginationSpec)), true
+
+	case "Query.provenance":
+		if e.complexity.Query.Provenance == nil {
+			break
+		}
+
+		args, err := ec.field_Query_provenance_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Provenance(childComplexity, args["pkg"].(string)), true
+
+	case "Query.SBOMComponentBreakdown":
+		if e.complexity.Query.SBOMComponentBreakdown == nil {
+			break
+		}
+
+		args, err := ec.field_Query_SBOMComponentBreakdown_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SBOMComponentBreakdown(childComplexity, args["hasSBOMID"].(string)), true
+
+	case "Query.scorecards":
+		if e.complexity.Query.Scorecards == nil {
+			break
+		}
+
+		args, err := ec.field_Query_scorecards_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Scorecards(childComplexity, args["scorecardSpec"].(model.CertifyScorecardSpec)), true
+
+	case "Query.scorecardsList":
+		if e.complexity.Query.ScorecardsList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_scorecardsList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ScorecardsList(childComplexity, args["scorecardSpec"].(model.CertifyScorecardSpec), args["pagination"].(*model.PaginationSpec)), true
+
+	case "Query.sources":
+		if e.complexity.Query.Sources == nil {
+			break
+		}
+
+		args, err := ec.field_Query_sources_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Sources(childComplexity, args["sourceSpec"].(model.SourceSpec)), true
+
+	case "Query.sourcesCount":
+		if e.complexity.Query.SourcesCount == nil {
+			break
+		}
+
+		args, err := ec.field_Query_sourcesCount_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Node(childComplexity, args["node"].(string)), true
+		return e.complexity.Query.SourcesCount(childComplexity, args["sourceSpec"].(*model.SourceSpec)), true
 
-	case "Query.nodes":
-		if e.complexity.Query.Nodes == nil {
+	case "Query.sourcesList":
+		if e.complexity.Query.SourcesList == nil {
 			break
 		}
 
-		args, err := ec.field_Query_nodes_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_sourcesList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Nodes(childComplexity, args["nodes"].([]string)), true
+		return e.complexity.Query.SourcesList(childComplexity, args["sourceSpec"].(model.SourceSpec), args["pagination"].(*model.PaginationSpec)), true
 
-	case "Query.packages":
-		if e.complexity.Query.Packages == nil {
+	case "Query.topVulnerablePackages":
+		if e.complexity.Query.TopVulnerablePackages == nil {
 			break
 		}
 
-		args, err := ec.field_Query_packages_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_topVulnerablePackages_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Packages(childComplexity, args["pkgSpec"].(model.PkgSpec)), true
+		return e.complexity.Query.TopVulnerablePackages(childComplexity, args["limit"].(int), args["filter"].(*model.CertifyVulnSpec)), true
 
-	case "Query.path":
-		if e.complexity.Query.Path == nil {
+	case "Query.vulnEqual":
+		if e.complexity.Query.VulnEqual == nil {
 			break
 		}
 
-		args, err := ec.field_Query_path_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_vulnEqual_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Path(childComplexity, args["subject"].(string), args["target"].(string), args["maxPathLength"].(int), args["usingOnly"].([]model.Edge)), true
+		return e.complexity.Query.VulnEqual(childComplexity, args["vulnEqualSpec"].(model.VulnEqualSpec)), true
 
-	case "Query.PkgEqual":
-		if e.complexity.Query.PkgEqual == nil {
+	case "Query.vulnEqualList":
+		if e.complexity.Query.VulnEqualList == nil {
 			break
 		}
 
-		args, err := ec.field_Query_PkgEqual_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_vulnEqualList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.PkgEqual(childComplexity, args["pkgEqualSpec"].(model.PkgEqualSpec)), true
+		return e.complexity.Query.VulnEqualList(childComplexity, args["vulnEqualSpec"].(model.VulnEqualSpec), args["pagination"].(*model.PaginationSpec)), true
 
-	case "Query.PointOfContact":
-		if e.complexity.Query.PointOfContact == nil {
+	case "Query.vulnerabilities":
+		if e.complexity.Query.Vulnerabilities == nil {
 			break
 		}
 
-		args, err := ec.field_Query_PointOfContact_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_vulnerabilities_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.PointOfContact(childComplexity, args["pointOfContactSpec"].(model.PointOfContactSpec)), true
+		return e.complexity.Query.Vulnerabilities(childComplexity, args["vulnSpec"].(model.VulnerabilitySpec)), true
 
-	case "Query.scorecards":
-		if e.complexity.Query.Scorecards == nil {
+	case "Query.vulnerabilitiesList":
+		if e.complexity.Query.VulnerabilitiesList == nil {
 			break
 		}
 
-		args, err := ec.field_Query_scorecards_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_vulnerabilitiesList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Scorecards(childComplexity, args["scorecardSpec"].(model.CertifyScorecardSpec)), true
+		return e.complexity.Query.VulnerabilitiesList(childComplexity, args["vulnSpec"].(model.VulnerabilitySpec), args["pagination"].(*model.PaginationSpec)), true
 
-	case "Query.sources":
-		if e.complexity.Query.Sources == nil {
+	case "Query.vulnerabilityMetadata":
+		if e.complexity.Query.VulnerabilityMetadata == nil {
 			break
 		}
 
-		args, err := ec.field_Query_sources_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_vulnerabilityMetadata_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Sources(childComplexity, args["sourceSpec"].(model.SourceSpec)), true
+		return e.complexity.Query.VulnerabilityMetadata(childComplexity, args["vulnerabilityMetadataSpec"].(model.VulnerabilityMetadataSpec)), true
 
-	case "Query.vulnEqual":
-		if e.complexity.Query.VulnEqual == nil {
+	case "Query.vulnerabilityMetadataList":
+		if e.complexity.Query.VulnerabilityMetadataList == nil {
 			break
 		}
 
-		args, err := ec.field_Query_vulnEqual_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_vulnerabilityMetadataList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.VulnEqual(childComplexity, args["vulnEqualSpec"].(model.VulnEqualSpec)), true
+		return e.complexity.Query.VulnerabilityMetadataList(childComplexity, args["vulnerabilityMetadataSpec"].(model.VulnerabilityMetadataSpec), args["pagination"].(*model.PaginationSpec)), true
 
-	case "Query.vulnerabilities":
-		if e.complexity.Query.Vulnerabilities == nil {
+	case "Query.vulnerableInSBOM":
+		if e.complexity.Query.VulnerableInSbom == nil {
 			break
 		}
 
-		args, err := ec.field_Query_vulnerabilities_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_vulnerableInSBOM_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Vulnerabilities(childComplexity, args["vulnSpec"].(model.VulnerabilitySpec)), true
+		return e.complexity.Query.VulnerableInSbom(childComplexity, args["vulnSpec"].(model.VulnerabilitySpec), args["filter"].(*model.HasSBOMSpec)), true
 
-	case "Query.vulnerabilityMetadata":
-		if e.complexity.Query.VulnerabilityMetadata == nil {
+	case "SBOMDiff.added":
+		if e.complexity.SBOMDiff.Added == nil {
 			break
 		}
 
-		args, err := ec.field_Query_vulnerabilityMetadata_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.SBOMDiff.Added(childComplexity), true
+
+	case "SBOMDiff.removed":
+		if e.complexity.SBOMDiff.Removed == nil {
+			break
 		}
 
-		return e.complexity.Query.VulnerabilityMetadata(childComplexity, args["vulnerabilityMetadataSpec"].(model.VulnerabilityMetadataSpec)), true
+		return e.complexity.SBOMDiff.Removed(childComplexity), true
+
+	case "SBOMDiff.unchanged":
+		if e.complexity.SBOMDiff.Unchanged == nil {
+			break
+		}
+
+		return e.complexity.SBOMDiff.Unchanged(childComplexity), true
 
 	case "SLSA.buildType":
 		if e.complexity.SLSA.BuildType == nil {
@@ -2437,6 +4416,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SLSAPredicate.Value(childComplexity), true
 
+	case "ScanMetadata.cvssScore":
+		if e.complexity.ScanMetadata.CVSSScore == nil {
+			break
+		}
+
+		return e.complexity.ScanMetadata.CVSSScore(childComplexity), true
+
 	case "ScanMetadata.collector":
 		if e.complexity.ScanMetadata.Collector == nil {
 			break
@@ -2493,6 +4479,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ScanMetadata.TimeScanned(childComplexity), true
 
+	case "ScannerFreshnessResult.dbVersion":
+		if e.complexity.ScannerFreshnessResult.DbVersion == nil {
+			break
+		}
+
+		return e.complexity.ScannerFreshnessResult.DbVersion(childComplexity), true
+
+	case "ScannerFreshnessResult.isFresh":
+		if e.complexity.ScannerFreshnessResult.IsFresh == nil {
+			break
+		}
+
+		return e.complexity.ScannerFreshnessResult.IsFresh(childComplexity), true
+
+	case "ScannerFreshnessResult.latestScanTime":
+		if e.complexity.ScannerFreshnessResult.LatestScanTime == nil {
+			break
+		}
+
+		return e.complexity.ScannerFreshnessResult.LatestScanTime(childComplexity), true
+
+	case "ScannerFreshnessResult.staleScanCount":
+		if e.complexity.ScannerFreshnessResult.StaleScanCount == nil {
+			break
+		}
+
+		return e.complexity.ScannerFreshnessResult.StaleScanCount(childComplexity), true
+
 	case "Scorecard.aggregateScore":
 		if e.complexity.Scorecard.AggregateScore == nil {
 			break
@@ -2584,6 +4598,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Source.Type(childComplexity), true
 
+	case "SourceConnection.edges":
+		if e.complexity.SourceConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.SourceConnection.Edges(childComplexity), true
+
+	case "SourceConnection.pageInfo":
+		if e.complexity.SourceConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.SourceConnection.PageInfo(childComplexity), true
+
+	case "SourceConnection.totalCount":
+		if e.complexity.SourceConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SourceConnection.TotalCount(childComplexity), true
+
+	case "SourceEdge.cursor":
+		if e.complexity.SourceEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.SourceEdge.Cursor(childComplexity), true
+
+	case "SourceEdge.node":
+		if e.complexity.SourceEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SourceEdge.Node(childComplexity), true
+
 	case "SourceIDs.sourceNameID":
 		if e.complexity.SourceIDs.SourceNameID == nil {
 			break
@@ -2612,6 +4661,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SourceName.Commit(childComplexity), true
 
+	case "SourceName.commitHistory":
+		if e.complexity.SourceName.CommitHistory == nil {
+			break
+		}
+
+		args, err := ec.field_SourceName_commitHistory_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.SourceName.CommitHistory(childComplexity, args["first"].(int), args["after"].(*string)), true
+
 	case "SourceName.id":
 		if e.complexity.SourceName.ID == nil {
 			break
@@ -2626,6 +4687,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SourceName.Name(childComplexity), true
 
+	case "SourceName.recentCommits":
+		if e.complexity.SourceName.RecentCommits == nil {
+			break
+		}
+
+		args, err := ec.field_SourceName_recentCommits_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.SourceName.RecentCommits(childComplexity, args["limit"].(int)), true
+
 	case "SourceName.tag":
 		if e.complexity.SourceName.Tag == nil {
 			break
@@ -2654,6 +4727,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SourceNamespace.Namespace(childComplexity), true
 
+	case "Subscription.certifyVulnAdded":
+		if e.complexity.Subscription.CertifyVulnAdded == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_certifyVulnAdded_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.CertifyVulnAdded(childComplexity, args["filter"].(*model.CertifyVulnSpec)), true
+
+	case "Subscription.certifyVulnForPackage":
+		if e.complexity.Subscription.CertifyVulnForPackage == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_certifyVulnForPackage_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.CertifyVulnForPackage(childComplexity, args["pkgSpec"].(model.PkgSpec)), true
+
 	case "VulnEqual.collector":
 		if e.complexity.VulnEqual.Collector == nil {
 			break
@@ -2696,6 +4793,60 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.VulnEqual.Vulnerabilities(childComplexity), true
 
+	case "VulnEqualConnection.edges":
+		if e.complexity.VulnEqualConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.VulnEqualConnection.Edges(childComplexity), true
+
+	case "VulnEqualConnection.pageInfo":
+		if e.complexity.VulnEqualConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.VulnEqualConnection.PageInfo(childComplexity), true
+
+	case "VulnEqualConnection.totalCount":
+		if e.complexity.VulnEqualConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.VulnEqualConnection.TotalCount(childComplexity), true
+
+	case "VulnEqualEdge.cursor":
+		if e.complexity.VulnEqualEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.VulnEqualEdge.Cursor(childComplexity), true
+
+	case "VulnEqualEdge.node":
+		if e.complexity.VulnEqualEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.VulnEqualEdge.Node(childComplexity), true
+
+	case "Vulnerability.certifyVulns":
+		if e.complexity.Vulnerability.CertifyVulns == nil {
+			break
+		}
+
+		args, err := ec.field_Vulnerability_certifyVulns_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Vulnerability.CertifyVulns(childComplexity, args["filter"].(*model.CertifyVulnSpec)), true
+
+	case "Vulnerability.exploitReferences":
+		if e.complexity.Vulnerability.ExploitReferences == nil {
+			break
+		}
+
+		return e.complexity.Vulnerability.ExploitReferences(childComplexity), true
+
 	case "Vulnerability.id":
 		if e.complexity.Vulnerability.ID == nil {
 			break
@@ -2703,6 +4854,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Vulnerability.ID(childComplexity), true
 
+	case "Vulnerability.isFixed":
+		if e.complexity.Vulnerability.IsFixed == nil {
+			break
+		}
+
+		args, err := ec.field_Vulnerability_isFixed_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Vulnerability.IsFixed(childComplexity, args["pkgSpec"].(*model.PkgSpec)), true
+
 	case "Vulnerability.type":
 		if e.complexity.Vulnerability.Type == nil {
 			break
@@ -2717,6 +4880,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Vulnerability.VulnerabilityIDs(childComplexity), true
 
+	case "VulnerabilityConnection.edges":
+		if e.complexity.VulnerabilityConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityConnection.Edges(childComplexity), true
+
+	case "VulnerabilityConnection.pageInfo":
+		if e.complexity.VulnerabilityConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityConnection.PageInfo(childComplexity), true
+
+	case "VulnerabilityConnection.totalCount":
+		if e.complexity.VulnerabilityConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityConnection.TotalCount(childComplexity), true
+
+	case "VulnerabilityEdge.cursor":
+		if e.complexity.VulnerabilityEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityEdge.Cursor(childComplexity), true
+
+	case "VulnerabilityEdge.node":
+		if e.complexity.VulnerabilityEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityEdge.Node(childComplexity), true
+
 	case "VulnerabilityID.id":
 		if e.complexity.VulnerabilityID.ID == nil {
 			break
@@ -2801,6 +4999,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.VulnerabilityMetadata.Vulnerability(childComplexity), true
 
+	case "VulnerabilityMetadataConnection.edges":
+		if e.complexity.VulnerabilityMetadataConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityMetadataConnection.Edges(childComplexity), true
+
+	case "VulnerabilityMetadataConnection.pageInfo":
+		if e.complexity.VulnerabilityMetadataConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityMetadataConnection.PageInfo(childComplexity), true
+
+	case "VulnerabilityMetadataConnection.totalCount":
+		if e.complexity.VulnerabilityMetadataConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityMetadataConnection.TotalCount(childComplexity), true
+
+	case "VulnerabilityMetadataEdge.cursor":
+		if e.complexity.VulnerabilityMetadataEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityMetadataEdge.Cursor(childComplexity), true
+
+	case "VulnerabilityMetadataEdge.node":
+		if e.complexity.VulnerabilityMetadataEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityMetadataEdge.Node(childComplexity), true
+
 	}
 	return 0, false
 }
@@ -2821,7 +5054,10 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputCertifyLegalSpec,
 		ec.unmarshalInputCertifyScorecardSpec,
 		ec.unmarshalInputCertifyVEXStatementSpec,
+		ec.unmarshalInputCertifyVulnOrder,
 		ec.unmarshalInputCertifyVulnSpec,
+		ec.unmarshalInputExploitReferenceInputSpec,
+		ec.unmarshalInputExploitReferenceSpec,
 		ec.unmarshalInputHasMetadataInputSpec,
 		ec.unmarshalInputHasMetadataSpec,
 		ec.unmarshalInputHasSBOMIncludesInputSpec,
@@ -2829,6 +5065,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputHasSBOMSpec,
 		ec.unmarshalInputHasSLSASpec,
 		ec.unmarshalInputHasSourceAtInputSpec,
+		ec.unmarshalInputHasSourceAtOrder,
 		ec.unmarshalInputHasSourceAtSpec,
 		ec.unmarshalInputHashEqualInputSpec,
 		ec.unmarshalInputHashEqualSpec,
@@ -2851,11 +5088,13 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputPackageOrSourceInput,
 		ec.unmarshalInputPackageOrSourceInputs,
 		ec.unmarshalInputPackageOrSourceSpec,
+		ec.unmarshalInputPackageOrder,
 		ec.unmarshalInputPackageQualifierInputSpec,
 		ec.unmarshalInputPackageQualifierSpec,
 		ec.unmarshalInputPackageSourceOrArtifactInput,
 		ec.unmarshalInputPackageSourceOrArtifactInputs,
 		ec.unmarshalInputPackageSourceOrArtifactSpec,
+		ec.unmarshalInputPaginationSpec,
 		ec.unmarshalInputPkgEqualInputSpec,
 		ec.unmarshalInputPkgEqualSpec,
 		ec.unmarshalInputPkgInputSpec,
@@ -2870,6 +5109,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputScorecardCheckSpec,
 		ec.unmarshalInputScorecardInputSpec,
 		ec.unmarshalInputSourceInputSpec,
+		ec.unmarshalInputSourceOrder,
 		ec.unmarshalInputSourceSpec,
 		ec.unmarshalInputVexStatementInputSpec,
 		ec.unmarshalInputVulnEqualInputSpec,
@@ -2923,6 +5163,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -3013,11 +5270,15 @@ type Artifact {
 ArtifactSpec allows filtering the list of artifacts to return in a query.
 
 The checksum fields are canonicalized to be lowercase.
+
+digestPrefix matches the artifacts whose digest starts with the given hex
+characters, e.g. the first 12 characters shown by many security tools.
 """
 input ArtifactSpec {
   id: ID
   algorithm: String
   digest: String
+  digestPrefix: String
 }
 
 """
@@ -3042,9 +5303,38 @@ input IDorArtifactInput {
   artifactInput: ArtifactInputSpec
 }
 
+"""
+ArtifactConnection returns the paginated results for Artifact.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the ArtifactEdge which contains the current cursor
+and the Artifact node itself
+"""
+type ArtifactConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [ArtifactEdge!]!
+}
+
+"""
+ArtifactEdge contains the cursor for the resulting node and
+the Artifact node itself.
+"""
+type ArtifactEdge {
+  cursor: ID!
+  node: Artifact!
+}
+
 extend type Query {
   "Returns all artifacts matching a filter."
   artifacts(artifactSpec: ArtifactSpec!): [Artifact!]!
+  "Returns a paginated list of artifacts matching the input filter."
+  artifactsList(artifactSpec: ArtifactSpec!, pagination: PaginationSpec): ArtifactConnection!
 }
 
 extend type Mutation {
@@ -3095,20 +5385,49 @@ input BuilderInputSpec {
 }
 
 """
-IDorBuilderInput allows for specifying either the builder ID or the BuilderInputSpec.
-
-Either the ID or the BuilderInputSpec must be specified. Both cannot be nil.
-
-If the ID is specified, the BuilderInputSpec is not used.
+IDorBuilderInput allows for specifying either the builder ID or the BuilderInputSpec.
+
+Either the ID or the BuilderInputSpec must be specified. Both cannot be nil.
+
+If the ID is specified, the BuilderInputSpec is not used.
+"""
+input IDorBuilderInput {
+  builderID: ID
+  builderInput: BuilderInputSpec
+}
+
+"""
+BuilderConnection returns the paginated results for Builder.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the BuilderEdge which contains the current cursor
+and the Builder node itself
+"""
+type BuilderConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [BuilderEdge!]!
+}
+
+"""
+BuilderEdge contains the cursor for the resulting node and
+the Builder node itself.
 """
-input IDorBuilderInput {
-  builderID: ID
-  builderInput: BuilderInputSpec
+type BuilderEdge {
+  cursor: ID!
+  node: Builder!
 }
 
 extend type Query {
   "Returns all builders matching a filter."
   builders(builderSpec: BuilderSpec!): [Builder!]!
+  "Returns a paginated list of builders matching the input filter."
+  buildersList(builderSpec: BuilderSpec!, pagination: PaginationSpec): BuilderConnection!
 }
 
 extend type Mutation {
@@ -3218,11 +5537,21 @@ and optionally a tag and a commit.
 
 If KnownSince is specified, the returned value will be after or equal to the specified time.
 Any nodes time that is before KnownSince is excluded.
+
+justificationPrefix matches the justifications starting with the given string
+and justificationContains matches the justifications containing it. Both can be
+combined with each other and with justification.
+
+subjectType restricts the results to the certifications of a package, a source
+or an artifact.
 """
 input CertifyBadSpec {
   id: ID
   subject: PackageSourceOrArtifactSpec
+  subjectType: SubjectType
   justification: String
+  justificationPrefix: String
+  justificationContains: String
   knownSince: Time
   origin: String
   collector: String
@@ -3255,9 +5584,45 @@ input MatchFlags {
   pkg: PkgMatchType!
 }
 
+"SubjectType is the kind of node a certification is attached to."
+enum SubjectType {
+  PACKAGE
+  SOURCE
+  ARTIFACT
+}
+
+"""
+CertifyBadConnection returns the paginated results for CertifyBad.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the CertifyBadEdge which contains the current cursor
+and the CertifyBad node itself
+"""
+type CertifyBadConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyBadEdge!]!
+}
+
+"""
+CertifyBadEdge contains the cursor for the resulting node and
+the CertifyBad node itself.
+"""
+type CertifyBadEdge {
+  cursor: ID!
+  node: CertifyBad!
+}
+
 extend type Query {
   "Returns all CertifyBad attestations matching a filter."
   CertifyBad(certifyBadSpec: CertifyBadSpec!): [CertifyBad!]!
+  "Returns a paginated list of CertifyBad attestations matching the input filter."
+  CertifyBadList(certifyBadSpec: CertifyBadSpec!, pagination: PaginationSpec): CertifyBadConnection!
 }
 
 extend type Mutation {
@@ -3336,11 +5701,21 @@ and optionally a tag and a commit.
 
 If KnownSince is specified, the returned value will be after or equal to the specified time.
 Any nodes time that is before KnownSince is excluded.
+
+justificationPrefix matches the justifications starting with the given string
+and justificationContains matches the justifications containing it. Both can be
+combined with each other and with justification.
+
+subjectType restricts the results to the certifications of a package, a source
+or an artifact.
 """
 input CertifyGoodSpec {
   id: ID
   subject: PackageSourceOrArtifactSpec
+  subjectType: SubjectType
   justification: String
+  justificationPrefix: String
+  justificationContains: String
   knownSince: Time
   origin: String
   collector: String
@@ -3358,9 +5733,38 @@ input CertifyGoodInputSpec {
   documentRef: String!
 }
 
+"""
+CertifyGoodConnection returns the paginated results for CertifyGood.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the CertifyGoodEdge which contains the current cursor
+and the CertifyGood node itself
+"""
+type CertifyGoodConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyGoodEdge!]!
+}
+
+"""
+CertifyGoodEdge contains the cursor for the resulting node and
+the CertifyGood node itself.
+"""
+type CertifyGoodEdge {
+  cursor: ID!
+  node: CertifyGood!
+}
+
 extend type Query {
   "Returns all CertifyGood attestations matching a filter."
   CertifyGood(certifyGoodSpec: CertifyGoodSpec!): [CertifyGood!]!
+  "Returns a paginated list of CertifyGood attestations matching the input filter."
+  CertifyGoodList(certifyGoodSpec: CertifyGoodSpec!, pagination: PaginationSpec): CertifyGoodConnection!
 }
 
 extend type Mutation {
@@ -3476,9 +5880,38 @@ input CertifyLegalInputSpec {
   documentRef: String!
 }
 
+"""
+CertifyLegalConnection returns the paginated results for CertifyLegal.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the CertifyLegalEdge which contains the current cursor
+and the CertifyLegal node itself
+"""
+type CertifyLegalConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyLegalEdge!]!
+}
+
+"""
+CertifyLegalEdge contains the cursor for the resulting node and
+the CertifyLegal node itself.
+"""
+type CertifyLegalEdge {
+  cursor: ID!
+  node: CertifyLegal!
+}
+
 extend type Query {
   "Returns all legal certifications matching the input filter."
   CertifyLegal(certifyLegalSpec: CertifyLegalSpec!): [CertifyLegal!]!
+  "Returns a paginated list of legal certifications matching the input filter."
+  CertifyLegalList(certifyLegalSpec: CertifyLegalSpec!, pagination: PaginationSpec): CertifyLegalConnection!
 }
 
 extend type Mutation {
@@ -3613,9 +6046,38 @@ input ScorecardCheckInputSpec {
   score: Int!
 }
 
+"""
+CertifyScorecardConnection returns the paginated results for CertifyScorecard.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the CertifyScorecardEdge which contains the current cursor
+and the CertifyScorecard node itself
+"""
+type CertifyScorecardConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyScorecardEdge!]!
+}
+
+"""
+CertifyScorecardEdge contains the cursor for the resulting node and
+the CertifyScorecard node itself.
+"""
+type CertifyScorecardEdge {
+  cursor: ID!
+  node: CertifyScorecard!
+}
+
 extend type Query {
   "Returns all Scorecard certifications matching the filter."
   scorecards(scorecardSpec: CertifyScorecardSpec!): [CertifyScorecard!]!
+  "Returns a paginated list of Scorecard certifications matching the input filter."
+  scorecardsList(scorecardSpec: CertifyScorecardSpec!, pagination: PaginationSpec): CertifyScorecardConnection!
 }
 
 extend type Mutation {
@@ -3626,6 +6088,13 @@ extend type Mutation {
     sources: [IDorSourceInput!]!
     scorecards: [ScorecardInputSpec!]!
   ): [ID!]!
+  """
+  Updates the fields of an existing CertifyScorecard with the results of a new
+  scan, for example when the score of the repository improved. The source it
+  links is kept. Returns the updated CertifyScorecard and an error if no
+  CertifyScorecard exists with the given ID.
+  """
+  updateCertifyScorecard(id: ID!, scorecard: ScorecardInputSpec!): CertifyScorecard!
 }
 `, BuiltIn: false},
 	{Name: "../schema/certifyVEXStatement.graphql", Input: `#
@@ -3761,11 +6230,40 @@ input VexStatementInputSpec {
   documentRef: String!
 }
 
+"""
+CertifyVEXStatementConnection returns the paginated results for CertifyVEXStatement.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the CertifyVEXStatementEdge which contains the current cursor
+and the CertifyVEXStatement node itself
+"""
+type CertifyVEXStatementConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyVEXStatementEdge!]!
+}
+
+"""
+CertifyVEXStatementEdge contains the cursor for the resulting node and
+the CertifyVEXStatement node itself.
+"""
+type CertifyVEXStatementEdge {
+  cursor: ID!
+  node: CertifyVEXStatement!
+}
+
 extend type Query {
   "Returns all VEX certifications matching the input filter."
   CertifyVEXStatement(
     certifyVEXStatementSpec: CertifyVEXStatementSpec!
   ): [CertifyVEXStatement!]!
+  "Returns a paginated list of VEX statements matching the input filter."
+  CertifyVEXStatementList(certifyVEXStatementSpec: CertifyVEXStatementSpec!, pagination: PaginationSpec): CertifyVEXStatementConnection!
 }
 
 extend type Mutation {
@@ -3817,6 +6315,31 @@ type CertifyVuln {
   vulnerability: Vulnerability!
   "Metadata attached to the certification"
   metadata: ScanMetadata!
+  "Remediation status of the finding"
+  remediationStatus: RemediationStatus!
+  """
+  True if the finding was scanned longer ago than the stale threshold
+  configured on the server. Always false if no threshold is configured.
+  """
+  stale: Boolean!
+  """
+  The HasSBOM of the package version or the HasSourceAt of the source commit
+  that resolved the vulnerability, if recorded with updateCertifyVulnResolution
+  """
+  resolvedBy: Node
+}
+
+"""
+RemediationStatus is the triage state of a vulnerability certification.
+
+Findings are OPEN when ingested and are moved to STALE once their scan is too
+old to be trusted, either by markStaleVulns or by the server's background job.
+"""
+enum RemediationStatus {
+  "finding is current"
+  OPEN
+  "finding is from a scan that is too old and should be rescanned"
+  STALE
 }
 
 """
@@ -3841,6 +6364,8 @@ type ScanMetadata {
   collector: String!
   "Reference location of the document in the persistent blob store (if that is configured)"
   documentRef: String!
+  "CVSS score of the vulnerability as reported by the scanner, if known"
+  cvssScore: Float
 }
 
 """
@@ -3852,6 +6377,19 @@ with the package.
 
 Only one vulnerability (or NoVuln vulnerability type) may be
 specified.
+
+minCVSS and maxCVSS select certifications whose CVSS score falls within the
+inclusive range. Certifications without a score never match a range.
+
+timeScannedAfter and timeScannedBefore select certifications scanned within
+the inclusive time range, either bound can be left open.
+
+scannerUriIn selects certifications reported by any of the listed scanners.
+It is combined with scannerUri like every other filter, and ignored when
+empty.
+
+order sorts the results of the certifyVuln query. It is ignored by
+CertifyVulnList, which is always ordered by ID to keep cursors stable.
 """
 input CertifyVulnSpec {
   id: ID
@@ -3861,10 +6399,29 @@ input CertifyVulnSpec {
   dbUri: String
   dbVersion: String
   scannerUri: String
+  scannerUriIn: [String!]
   scannerVersion: String
   origin: String
   collector: String
   documentRef: String
+  minCVSS: Float
+  maxCVSS: Float
+  timeScannedAfter: Time
+  timeScannedBefore: Time
+  order: CertifyVulnOrder
+}
+
+"CertifyVulnOrderField is the field CertifyVuln results are sorted on."
+enum CertifyVulnOrderField {
+  TIME_SCANNED
+  SCANNER_VERSION
+  DB_VERSION
+}
+
+"CertifyVulnOrder sorts CertifyVuln results by a field, ascending by default."
+input CertifyVulnOrder {
+  sortBy: CertifyVulnOrderField!
+  sortOrder: OrderDirection! = ASC
 }
 
 """
@@ -3880,11 +6437,110 @@ input ScanMetadataInput {
   origin: String!
   collector: String!
   documentRef: String!
+  cvssScore: Float
+}
+
+"""
+Define the Duration scalar, to be used across GUAC. It follows ISO 8601
+duration format (e.g., "P1D" or "PT12H").
+
+This is implicit via https://gqlgen.com/reference/scalars/
+"""
+scalar Duration
+
+"""
+ScannerFreshnessResult reports how recent the vulnerability data produced by a
+scanner is.
+"""
+type ScannerFreshnessResult {
+  "Time of the most recent scan by the scanner (in RFC 3339 format)"
+  latestScanTime: Time!
+  "Version of the vulnerability database used in the most recent scan"
+  dbVersion: String!
+  "True if the most recent scan is not older than the requested maximum age"
+  isFresh: Boolean!
+  "Number of certifications from the scanner that are older than the requested maximum age"
+  staleScanCount: Int!
+}
+
+"""
+CertifyVulnConnection returns the paginated results for CertifyVuln.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the CertifyVulnEdge which contains the current cursor
+and the CertifyVuln node itself
+"""
+type CertifyVulnConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyVulnEdge!]!
+}
+
+"""
+CertifyVulnEdge contains the cursor for the resulting node and
+the CertifyVuln node itself.
+"""
+type CertifyVulnEdge {
+  cursor: ID!
+  node: CertifyVuln!
+}
+
+"""
+PackageVulnCount is the number of vulnerability certifications of a package
+version.
+"""
+type PackageVulnCount {
+  package: Package!
+  count: Int!
 }
 
 extend type Query {
   "Returns all vulnerability certifications matching the input filter."
   CertifyVuln(certifyVulnSpec: CertifyVulnSpec!): [CertifyVuln!]!
+  "Returns a paginated list of vulnerability certifications matching the input filter."
+  CertifyVulnList(certifyVulnSpec: CertifyVulnSpec!, pagination: PaginationSpec): CertifyVulnConnection!
+  "Returns the number of vulnerability certifications matching the filter."
+  CertifyVulnCount(certifyVulnSpec: CertifyVulnSpec): Int!
+  "Reports whether the vulnerability database used by a scanner is stale, based on the time of its most recent scan."
+  CheckScannerFreshness(scannerURI: String!, maxAge: Duration!): ScannerFreshnessResult!
+  """
+  Returns the limit package versions with the most vulnerability
+  certifications matching the filter, sorted by decreasing count.
+  Certifications that no vulnerability was found are not counted.
+  """
+  topVulnerablePackages(limit: Int!, filter: CertifyVulnSpec): [PackageVulnCount!]!
+  """
+  Returns the vulnerability certifications ingested from the document with
+  the given reference, for example to find what a re-ingested document
+  previously produced.
+  """
+  CertifyVulnByDocumentRef(documentRef: String!): [CertifyVuln!]!
+  """
+  Returns the vulnerability certifications matching the filter that were moved
+  to the archive by archiveCertifyVulns.
+
+  Archived certifications are not part of the graph: their IDs can be used in
+  the filter of this query, but not with node, nodes or neighbors.
+  """
+  archivedCertifyVuln(certifyVulnSpec: CertifyVulnSpec!): [CertifyVuln!]!
+}
+
+extend type Subscription {
+  """
+  Streams vulnerability certifications as they are created. Only certifications
+  matching the optional filter are sent.
+  """
+  certifyVulnAdded(filter: CertifyVulnSpec): CertifyVuln
+  """
+  Streams vulnerability certifications of the packages matching pkgSpec as they
+  are created. An empty type, namespace or name matches any value.
+  """
+  certifyVulnForPackage(pkgSpec: PkgSpec!): CertifyVuln
 }
 
 extend type Mutation {
@@ -3900,6 +6556,30 @@ extend type Mutation {
     vulnerabilities: [IDorVulnerabilityInput!]!
     certifyVulns: [ScanMetadataInput!]!
   ): [ID!]!
+  """
+  Marks all vulnerability certifications scanned longer ago than olderThan as
+  STALE. Returns the number of certifications that were updated.
+  """
+  markStaleVulns(olderThan: Duration!): Int!
+  """
+  Moves all vulnerability certifications scanned longer ago than olderThan to
+  the archive, where they can still be queried with archivedCertifyVuln.
+  Returns the number of certifications that were archived.
+  """
+  archiveCertifyVulns(olderThan: Duration!): Int!
+  """
+  Deletes a vulnerability certification, for example after the vulnerability
+  was retracted or the package was patched. Returns true on success and an
+  error if no certification exists with the given ID.
+  """
+  deleteCertifyVuln(id: ID!): Boolean!
+  """
+  Records that the vulnerability certified by id was resolved by the package
+  version or source commit of resolvedBy, the ID of a HasSBOM or a HasSourceAt.
+  Returns the updated certification and an error if either node does not
+  exist.
+  """
+  updateCertifyVulnResolution(id: ID!, resolvedBy: ID!): CertifyVuln!
 }
 `, BuiltIn: false},
 	{Name: "../schema/contact.graphql", Input: `#
@@ -4000,9 +6680,38 @@ input PointOfContactInputSpec {
   documentRef: String!
 }
 
+"""
+PointOfContactConnection returns the paginated results for PointOfContact.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the PointOfContactEdge which contains the current cursor
+and the PointOfContact node itself
+"""
+type PointOfContactConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [PointOfContactEdge!]!
+}
+
+"""
+PointOfContactEdge contains the cursor for the resulting node and
+the PointOfContact node itself.
+"""
+type PointOfContactEdge {
+  cursor: ID!
+  node: PointOfContact!
+}
+
 extend type Query {
   "Returns all PointOfContact attestations matching a filter."
   PointOfContact(pointOfContactSpec: PointOfContactSpec!): [PointOfContact!]!
+  "Returns a paginated list of PointOfContact attestations matching the input filter."
+  PointOfContactList(pointOfContactSpec: PointOfContactSpec!, pagination: PaginationSpec): PointOfContactConnection!
 }
 
 extend type Mutation {
@@ -4018,6 +6727,12 @@ extend type Mutation {
     pkgMatchType: MatchFlags!
     pointOfContacts: [PointOfContactInputSpec!]!
   ): [ID!]!
+  """
+  Updates the fields of an existing PointOfContact, for example when a contact
+  email changes. The subject it is attached to is kept. Returns the updated
+  PointOfContact and an error if no PointOfContact exists with the given ID.
+  """
+  updatePointOfContact(id: ID!, pointOfContact: PointOfContactInputSpec!): PointOfContact!
 }
 `, BuiltIn: false},
 	{Name: "../schema/directive.graphql", Input: `directive @filter(keyName: String = "id", operation: FilterOperation = CONTAINS, value: String = "") on FIELD
@@ -4025,6 +6740,92 @@ enum FilterOperation {
   CONTAINS
   STARTSWITH
 }`, BuiltIn: false},
+	{Name: "../schema/exploitReference.graphql", Input: `#
+# Copyright 2024 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the ExploitReference evidence node
+
+"""
+ExploitReference is an attestation that a public exploit or proof of concept
+exists for a vulnerability.
+
+The source is the database the exploit was found in and must be one of
+EXPLOIT_DB, GITHUB_POC or METASPLOIT. It will be enforced to be all uppercase.
+
+A vulnerability ID can have many exploit references. A reference is identified
+by its vulnerability, source and url: ingesting the same reference again
+updates it instead of creating a new one (e.g., to mark it as verified).
+"""
+type ExploitReference {
+  id: ID!
+  "The vulnerability that the exploit targets"
+  vulnerability: Vulnerability!
+  "Database the exploit was found in"
+  source: String!
+  "Location of the exploit or proof of concept"
+  url: String!
+  "True if the exploit has been confirmed to work"
+  verified: Boolean!
+  "Document from which this attestation is generated from"
+  origin: String!
+  "GUAC collector for the document"
+  collector: String!
+  "Reference location of the document in the persistent blob store (if that is configured)"
+  documentRef: String!
+}
+
+"""
+ExploitReferenceSpec allows filtering the list of exploit references to return
+in a query.
+"""
+input ExploitReferenceSpec {
+  id: ID
+  vulnerability: VulnerabilitySpec
+  source: String
+  url: String
+  verified: Boolean
+  origin: String
+  collector: String
+  documentRef: String
+}
+
+"""
+ExploitReferenceInputSpec represents the mutation input to ingest an exploit
+reference.
+"""
+input ExploitReferenceInputSpec {
+  source: String!
+  url: String!
+  verified: Boolean!
+  origin: String!
+  collector: String!
+  documentRef: String!
+}
+
+extend type Query {
+  "Returns all exploit references matching a filter."
+  ExploitReferences(exploitReferenceSpec: ExploitReferenceSpec!): [ExploitReference!]!
+}
+
+extend type Mutation {
+  "Adds or updates a reference to an exploit for a vulnerability. The returned ID can be empty string."
+  ingestExploitReference(vulnerability: IDorVulnerabilityInput!, exploitReference: ExploitReferenceInputSpec!): ID!
+}
+`, BuiltIn: false},
 	{Name: "../schema/hasSBOM.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
 #
@@ -4079,6 +6880,9 @@ Only the package or artifact can be added, not both.
 
 If KnownSince is specified, the returned value will be after or equal to the specified time.
 Any nodes time that is before KnownSince is excluded.
+
+IncludedPackages returns only the SBOMs that include a package version matching
+each of the specs, to find the SBOMs that include a given package.
 """
 input HasSBOMSpec {
   id: ID
@@ -4094,6 +6898,7 @@ input HasSBOMSpec {
   includedSoftware: [PackageOrArtifactSpec!]
   includedDependencies: [IsDependencySpec!]
   includedOccurrences: [IsOccurrenceSpec!]
+  includedPackages: [PkgSpec!]
 }
 
 input HasSBOMIncludesInputSpec {
@@ -4115,9 +6920,75 @@ input HasSBOMInputSpec {
   documentRef: String!
 }
 
+"""
+ComponentTypeCount is the number of packages of a given component type included
+in an SBOM.
+
+componentType is the lowercased CycloneDX component type or SPDX primary
+package purpose (e.g., library, application, container, firmware).
+"""
+type ComponentTypeCount {
+  componentType: String!
+  count: Int!
+}
+
+"""
+SBOMDiff compares the packages included in two SBOMs. Packages are matched by
+purl.
+"""
+type SBOMDiff {
+  "Packages included in the second SBOM only"
+  added: [Package!]!
+  "Packages included in the first SBOM only"
+  removed: [Package!]!
+  "Packages included in both SBOMs"
+  unchanged: [Package!]!
+}
+
+"""
+HasSBOMConnection returns the paginated results for HasSBOM.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the HasSBOMEdge which contains the current cursor
+and the HasSBOM node itself
+"""
+type HasSBOMConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [HasSBOMEdge!]!
+}
+
+"""
+HasSBOMEdge contains the cursor for the resulting node and
+the HasSBOM node itself.
+"""
+type HasSBOMEdge {
+  cursor: ID!
+  node: HasSBOM!
+}
+
 extend type Query {
   "Returns all SBOM certifications."
   HasSBOM(hasSBOMSpec: HasSBOMSpec!): [HasSBOM!]!
+  "Returns a paginated list of SBOM certifications matching the input filter."
+  HasSBOMList(hasSBOMSpec: HasSBOMSpec!, pagination: PaginationSpec): HasSBOMConnection!
+  "Returns the number of included packages per component type for the SBOM with the given ID."
+  SBOMComponentBreakdown(hasSBOMID: ID!): [ComponentTypeCount!]!
+  """
+  Returns the SBOMs including any package certified as affected by a
+  vulnerability matching vulnSpec, optionally restricted by filter.
+  """
+  vulnerableInSBOM(vulnSpec: VulnerabilitySpec!, filter: HasSBOMSpec): [HasSBOM!]!
+  """
+  Compares the software included in the SBOMs with IDs sbom1 and sbom2,
+  returning the packages added and removed in sbom2.
+  """
+  diffSBOM(sbom1: ID!, sbom2: ID!): SBOMDiff!
 }
 
 extend type Mutation {
@@ -4268,9 +7139,38 @@ input SLSAPredicateInputSpec {
   value: String!
 }
 
+"""
+HasSLSAConnection returns the paginated results for HasSLSA.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the HasSLSAEdge which contains the current cursor
+and the HasSLSA node itself
+"""
+type HasSLSAConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [HasSLSAEdge!]!
+}
+
+"""
+HasSLSAEdge contains the cursor for the resulting node and
+the HasSLSA node itself.
+"""
+type HasSLSAEdge {
+  cursor: ID!
+  node: HasSLSA!
+}
+
 extend type Query {
   "Returns all SLSA attestations matching the filter."
   HasSLSA(hasSLSASpec: HasSLSASpec!): [HasSLSA!]!
+  "Returns a paginated list of SLSA attestations matching the input filter."
+  HasSLSAList(hasSLSASpec: HasSLSASpec!, pagination: PaginationSpec): HasSLSAConnection!
 }
 
 extend type Mutation {
@@ -4328,7 +7228,12 @@ type HasSourceAt {
   documentRef: String!
 }
 
-"HasSourceAtSpec allows filtering the list of HasSourceAt to return."
+"""
+HasSourceAtSpec allows filtering the list of HasSourceAt to return.
+
+order sorts the results of the HasSourceAt query. It is ignored by
+HasSourceAtList, which is always ordered by ID to keep cursors stable.
+"""
 input HasSourceAtSpec {
   id: ID
   package: PkgSpec
@@ -4338,6 +7243,19 @@ input HasSourceAtSpec {
   origin: String
   collector: String
   documentRef: String
+  order: HasSourceAtOrder
+}
+
+"HasSourceAtOrderField is the field HasSourceAt results are sorted on."
+enum HasSourceAtOrderField {
+  KNOWN_SINCE
+  JUSTIFICATION
+}
+
+"HasSourceAtOrder sorts HasSourceAt results by a field, ascending by default."
+input HasSourceAtOrder {
+  sortBy: HasSourceAtOrderField!
+  sortOrder: OrderDirection! = ASC
 }
 
 "HasSourceAtInputSpec is the same as HasSourceAt but for mutation input."
@@ -4349,9 +7267,40 @@ input HasSourceAtInputSpec {
   documentRef: String!
 }
 
+"""
+HasSourceAtConnection returns the paginated results for HasSourceAt.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the HasSourceAtEdge which contains the current cursor
+and the HasSourceAt node itself
+"""
+type HasSourceAtConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [HasSourceAtEdge!]!
+}
+
+"""
+HasSourceAtEdge contains the cursor for the resulting node and
+the HasSourceAt node itself.
+"""
+type HasSourceAtEdge {
+  cursor: ID!
+  node: HasSourceAt!
+}
+
 extend type Query {
   "Returns all source mappings that match the filter."
   HasSourceAt(hasSourceAtSpec: HasSourceAtSpec!): [HasSourceAt!]!
+  "Returns a paginated list of HasSourceAt attestations matching the input filter."
+  HasSourceAtList(hasSourceAtSpec: HasSourceAtSpec!, pagination: PaginationSpec): HasSourceAtConnection!
+  "Returns the number of HasSourceAt attestations matching the filter."
+  HasSourceAtCount(hasSourceAtSpec: HasSourceAtSpec): Int!
 }
 
 extend type Mutation {
@@ -4369,6 +7318,12 @@ extend type Mutation {
     sources: [IDorSourceInput!]!
     hasSourceAts: [HasSourceAtInputSpec!]!
   ):[ID!]!
+  """
+  Updates the fields of an existing HasSourceAt, for example to correct a wrong
+  knownSince timestamp. The package and source it links are kept. Returns the
+  updated HasSourceAt and an error if no HasSourceAt exists with the given ID.
+  """
+  updateHasSourceAt(id: ID!, hasSourceAt: HasSourceAtInputSpec!): HasSourceAt!
 }
 `, BuiltIn: false},
 	{Name: "../schema/hashEqual.graphql", Input: `#
@@ -4429,9 +7384,38 @@ input HashEqualInputSpec {
   documentRef: String!
 }
 
+"""
+HashEqualConnection returns the paginated results for HashEqual.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the HashEqualEdge which contains the current cursor
+and the HashEqual node itself
+"""
+type HashEqualConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [HashEqualEdge!]!
+}
+
+"""
+HashEqualEdge contains the cursor for the resulting node and
+the HashEqual node itself.
+"""
+type HashEqualEdge {
+  cursor: ID!
+  node: HashEqual!
+}
+
 extend type Query {
   "Returns all artifact equality statements matching a filter."
   HashEqual(hashEqualSpec: HashEqualSpec!): [HashEqual!]!
+  "Returns a paginated list of artifact equality attestations matching the input filter."
+  HashEqualList(hashEqualSpec: HashEqualSpec!, pagination: PaginationSpec): HashEqualConnection!
 }
 
 extend type Mutation {
@@ -4530,9 +7514,69 @@ input IsDependencyInputSpec {
   documentRef: String!
 }
 
+"""
+IsDependencyConnection returns the paginated results for IsDependency.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the IsDependencyEdge which contains the current cursor
+and the IsDependency node itself
+"""
+type IsDependencyConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [IsDependencyEdge!]!
+}
+
+"""
+IsDependencyEdge contains the cursor for the resulting node and
+the IsDependency node itself.
+"""
+type IsDependencyEdge {
+  cursor: ID!
+  node: IsDependency!
+}
+
+"""
+DependencyEdge is a dependency found while walking the dependency graph of a
+package version.
+"""
+type DependencyEdge {
+  "ID of the package version that has the dependency"
+  from: ID!
+  "ID of the dependency, the package version or the package name if the dependency is not on a version"
+  to: ID!
+  "The attestation recording the dependency"
+  isDependency: IsDependency!
+}
+
+"""
+DependencyGraph is the transitive closure of the dependencies of a package
+version.
+
+nodes contains the root package version and each of its dependencies once, and
+edges the dependencies between them.
+"""
+type DependencyGraph {
+  nodes: [Package!]!
+  edges: [DependencyEdge!]!
+}
+
 extend type Query {
   "Returns all package dependencies that match the filter."
   IsDependency(isDependencySpec: IsDependencySpec!): [IsDependency!]!
+  "Returns a paginated list of dependency attestations matching the input filter."
+  IsDependencyList(isDependencySpec: IsDependencySpec!, pagination: PaginationSpec): IsDependencyConnection!
+  """
+  Returns the dependencies of the root package version and, transitively,
+  theirs, up to maxDepth dependency hops away from the root. Dependencies on a
+  package name, instead of a version, are included but not followed.
+  """
+  dependencyGraph(root: ID!, maxDepth: Int!): DependencyGraph!
 }
 
 extend type Mutation {
@@ -4646,9 +7690,38 @@ input IsOccurrenceInputSpec {
   documentRef: String!
 }
 
+"""
+IsOccurrenceConnection returns the paginated results for IsOccurrence.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the IsOccurrenceEdge which contains the current cursor
+and the IsOccurrence node itself
+"""
+type IsOccurrenceConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [IsOccurrenceEdge!]!
+}
+
+"""
+IsOccurrenceEdge contains the cursor for the resulting node and
+the IsOccurrence node itself.
+"""
+type IsOccurrenceEdge {
+  cursor: ID!
+  node: IsOccurrence!
+}
+
 extend type Query {
   "Returns all artifacts-source/package mappings that match a filter."
   IsOccurrence(isOccurrenceSpec: IsOccurrenceSpec!): [IsOccurrence!]!
+  "Returns a paginated list of occurrence attestations matching the input filter."
+  IsOccurrenceList(isOccurrenceSpec: IsOccurrenceSpec!, pagination: PaginationSpec): IsOccurrenceConnection!
 }
 
 extend type Mutation {
@@ -4751,9 +7824,38 @@ input IDorLicenseInput {
   licenseInput: LicenseInputSpec
 }
 
+"""
+LicenseConnection returns the paginated results for License.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the LicenseEdge which contains the current cursor
+and the License node itself
+"""
+type LicenseConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [LicenseEdge!]!
+}
+
+"""
+LicenseEdge contains the cursor for the resulting node and
+the License node itself.
+"""
+type LicenseEdge {
+  cursor: ID!
+  node: License!
+}
+
 extend type Query {
   "Returns all licenses matching a filter."
   licenses(licenseSpec: LicenseSpec!): [License!]!
+  "Returns a paginated list of licenses matching the input filter."
+  licensesList(licenseSpec: LicenseSpec!, pagination: PaginationSpec): LicenseConnection!
 }
 
 extend type Mutation {
@@ -4856,9 +7958,38 @@ input HasMetadataInputSpec {
   documentRef: String!
 }
 
+"""
+HasMetadataConnection returns the paginated results for HasMetadata.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the HasMetadataEdge which contains the current cursor
+and the HasMetadata node itself
+"""
+type HasMetadataConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [HasMetadataEdge!]!
+}
+
+"""
+HasMetadataEdge contains the cursor for the resulting node and
+the HasMetadata node itself.
+"""
+type HasMetadataEdge {
+  cursor: ID!
+  node: HasMetadata!
+}
+
 extend type Query {
   "Returns all HasMetdata attestations matching a filter."
   HasMetadata(hasMetadataSpec: HasMetadataSpec!): [HasMetadata!]!
+  "Returns a paginated list of HasMetadata attestations matching the input filter."
+  HasMetadataList(hasMetadataSpec: HasMetadataSpec!, pagination: PaginationSpec): HasMetadataConnection!
 }
 
 extend type Mutation {
@@ -4875,6 +8006,31 @@ extend type Mutation {
     hasMetadataList: [HasMetadataInputSpec!]!
   ): [ID!]!
 }
+`, BuiltIn: false},
+	{Name: "../schema/order.graphql", Input: `#
+# Copyright 2024 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines the GraphQL types shared by the orderings of query results.
+
+"OrderDirection selects whether results are sorted in ascending or descending order."
+enum OrderDirection {
+  ASC
+  DESC
+}
 `, BuiltIn: false},
 	{Name: "../schema/package.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
@@ -4976,6 +8132,18 @@ type PackageVersion {
   version: String!
   qualifiers: [PackageQualifier!]!
   subpath: String!
+  """
+  Vulnerability certifications of the package version.
+
+  The package field of the filter is ignored.
+  """
+  vulnerabilities(filter: CertifyVulnSpec): [CertifyVuln!]!
+  """
+  Most recent scorecard of the source repositories the package version, or
+  all versions of its package name, is built from. Null if there is no such
+  source or scorecard.
+  """
+  latestScorecard: CertifyScorecard
 }
 
 """
@@ -5020,6 +8188,10 @@ nodes with any number of qualifiers if no qualifiers are passed in the input,
 we must also return the same set of nodes it the qualifiers list is empty. To
 match on nodes that don't contain any qualifier, set matchOnlyEmptyQualifiers
 to true. If this field is true, then the qualifiers argument is ignored.
+
+order sorts the package versions returned by the packages query. It is ignored
+by packagesList, which is always ordered by ID to keep cursors stable, and when
+the PkgSpec is nested in the filter of another query.
 """
 input PkgSpec {
   id: ID
@@ -5030,6 +8202,21 @@ input PkgSpec {
   qualifiers: [PackageQualifierSpec!] = []
   matchOnlyEmptyQualifiers: Boolean = false
   subpath: String
+  order: PackageOrder
+}
+
+"PackageOrderField is the field package versions are sorted on."
+enum PackageOrderField {
+  TYPE
+  NAMESPACE
+  NAME
+  VERSION
+}
+
+"PackageOrder sorts packages by a field, ascending by default."
+input PackageOrder {
+  sortBy: PackageOrderField!
+  sortOrder: OrderDirection! = ASC
 }
 
 """
@@ -5051,6 +8238,11 @@ PkgInputSpec specifies a package for mutations.
 
 This is different than PkgSpec because we want to encode mandatory fields:
 type and name. All optional fields are given empty default values.
+
+componentType optionally records the kind of component the package version is
+(e.g., library, application, container) as reported by the SBOM it was found
+in. It is only stored when the package version is first ingested and is used
+to compute SBOM composition breakdowns.
 """
 input PkgInputSpec {
   type: String!
@@ -5059,6 +8251,7 @@ input PkgInputSpec {
   version: String = ""
   qualifiers: [PackageQualifierInputSpec!] = []
   subpath: String = ""
+  componentType: String
 }
 
 "PackageQualifierInputSpec allows specifying package qualifiers in mutations."
@@ -5082,9 +8275,42 @@ input IDorPkgInput {
   packageInput: PkgInputSpec
 }
 
+"""
+PackageConnection returns the paginated results for Package.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the PackageEdge which contains the current cursor
+and the Package node itself
+"""
+type PackageConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [PackageEdge!]!
+}
+
+"""
+PackageEdge contains the cursor for the resulting node and
+the Package node itself.
+"""
+type PackageEdge {
+  cursor: ID!
+  node: Package!
+}
+
 extend type Query {
   "Returns all packages matching a filter."
   packages(pkgSpec: PkgSpec!): [Package!]!
+  "Returns a paginated list of packages matching the input filter."
+  packagesList(pkgSpec: PkgSpec!, pagination: PaginationSpec): PackageConnection!
+  "Returns the number of package versions matching the filter, as counted by packagesList."
+  packagesCount(pkgSpec: PkgSpec): Int!
+  "Returns the package matching a Package URL (purl), or null if it has not been ingested."
+  getPackageByPURL(purl: String!): Package
 }
 
 extend type Mutation {
@@ -5092,6 +8318,65 @@ extend type Mutation {
   ingestPackage(pkg: IDorPkgInput!): PackageIDs!
   "Bulk ingests packages and returns the list of corresponding package hierarchies containing only the IDs. The returned array of IDs must be in the same order as the inputs."
   ingestPackages(pkgs: [IDorPkgInput!]!): [PackageIDs!]!
+  """
+  Merges duplicate package nodes, for example the same package ingested with
+  different namespace casing, into the primary node. The IDs must all be
+  package versions or all be package names. The evidence attached to the
+  duplicates is moved to the primary and the duplicates are deleted. When
+  merging names, the versions of a duplicate name are moved to the primary name,
+  or merged with the primary's version of the same version, subpath and
+  qualifiers. Returns the merged package.
+  """
+  mergePackages(primary: ID!, duplicates: [ID!]!): Package!
+}
+`, BuiltIn: false},
+	{Name: "../schema/pagination.graphql", Input: `#
+# Copyright 2024 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines the GraphQL types used for cursor based pagination of list queries.
+
+"""
+PaginationSpec selects a window of results from a list query.
+
+Cursors are opaque IDs returned in the pageInfo and edges of a previous page.
+Use first and after to page forward, or last and before to page backward.
+first and last cannot be specified together and must not be negative.
+
+If neither first nor last is specified, the backend returns at most its
+maximum page size of results.
+"""
+input PaginationSpec {
+  first: Int
+  after: ID
+  last: Int
+  before: ID
+}
+
+"""
+PageInfo describes the window of results returned by a list query.
+
+startCursor and endCursor are the cursors of the first and last edges in the
+page. They are null when the page is empty.
+"""
+type PageInfo {
+  hasNextPage: Boolean!
+  hasPreviousPage: Boolean!
+  startCursor: ID
+  endCursor: ID
 }
 `, BuiltIn: false},
 	{Name: "../schema/path.graphql", Input: `#
@@ -5144,6 +8429,7 @@ union Node =
   | VulnerabilityMetadata
   | License
   | CertifyLegal
+  | ExploitReference
 
 """
 Edge allows filtering path/neighbors output to only contain a subset of all
@@ -5202,6 +8488,7 @@ enum Edge {
   SOURCE_TYPE_SOURCE_NAMESPACE
   VULNERABILITY_CERTIFY_VEX_STATEMENT
   VULNERABILITY_CERTIFY_VULN
+  VULNERABILITY_EXPLOIT_REFERENCE
   VULNERABILITY_ID_VULNERABILITY_TYPE
   VULNERABILITY_TYPE_VULNERABILITY_ID
   VULNERABILITY_VULN_EQUAL
@@ -5222,6 +8509,7 @@ enum Edge {
   CERTIFY_VEX_STATEMENT_VULNERABILITY
   CERTIFY_VULN_PACKAGE
   CERTIFY_VULN_VULNERABILITY
+  EXPLOIT_REFERENCE_VULNERABILITY
   HASH_EQUAL_ARTIFACT
   HAS_METADATA_ARTIFACT
   HAS_METADATA_PACKAGE
@@ -5275,6 +8563,15 @@ extend type Query {
   """
   neighbors(node: ID!, usingOnly: [Edge!]!): [Node!]!
 
+  """
+  neighborsRecursive returns all the nodes reachable from a node in at most
+  maxDepth hops, in breadth first order and without the node itself.
+
+  Specifying any Edge value in ` + "`" + `edges` + "`" + ` restricts every hop to the
+  corresponding GUAC evidence trees, like ` + "`" + `usingOnly` + "`" + ` does for neighbors.
+  """
+  neighborsRecursive(node: ID!, edges: [Edge!]!, maxDepth: Int!): [Node!]!
+
   """
   node returns a single node, regardless of type.
 
@@ -5288,6 +8585,23 @@ extend type Query {
   The input is an array of IDs to retrieve.
   """
   nodes(nodes: [ID!]!): [Node!]!
+
+  """
+  batchNodes returns the nodes for an array of IDs, regardless of type.
+
+  The result has the same order as the input, with null for IDs that do not
+  match any node.
+  """
+  batchNodes(ids: [ID!]!): [Node]!
+
+  """
+  nodeType returns the type name of a node, as ` + "`" + `__typename` + "`" + ` would for the node
+  query, e.g. "Package" or "CertifyVuln".
+
+  Backends whose IDs encode the node type decode it from the ID alone, without
+  fetching the node, so the node is not guaranteed to exist.
+  """
+  nodeType(id: ID!): String!
 }
 `, BuiltIn: false},
 	{Name: "../schema/pkgEqual.graphql", Input: `#
@@ -5348,9 +8662,38 @@ input PkgEqualInputSpec {
   documentRef: String!
 }
 
+"""
+PkgEqualConnection returns the paginated results for PkgEqual.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the PkgEqualEdge which contains the current cursor
+and the PkgEqual node itself
+"""
+type PkgEqualConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [PkgEqualEdge!]!
+}
+
+"""
+PkgEqualEdge contains the cursor for the resulting node and
+the PkgEqual node itself.
+"""
+type PkgEqualEdge {
+  cursor: ID!
+  node: PkgEqual!
+}
+
 extend type Query {
   "Returns all package equality statements matching a filter."
   PkgEqual(pkgEqualSpec: PkgEqualSpec!): [PkgEqual!]!
+  "Returns a paginated list of package equality attestations matching the input filter."
+  PkgEqualList(pkgEqualSpec: PkgEqualSpec!, pagination: PaginationSpec): PkgEqualConnection!
 }
 
 extend type Mutation {
@@ -5367,6 +8710,49 @@ extend type Mutation {
     pkgEquals: [PkgEqualInputSpec!]!
   ): [ID!]!
 }
+`, BuiltIn: false},
+	{Name: "../schema/provenance.graphql", Input: `#
+# Copyright 2024 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the provenance summary of a package
+
+"""
+ProvenanceSummary gathers the supply chain attestations recorded for a
+package.
+
+hasSLSA contains the SLSA attestations of the artifacts that are occurrences of
+the package.
+"""
+type ProvenanceSummary {
+  hasSBOM: [HasSBOM!]!
+  hasSLSA: [HasSLSA!]!
+  hasSourceAt: [HasSourceAt!]!
+  certifyVulns: [CertifyVuln!]!
+  certifyLegal: [CertifyLegal!]!
+  isOccurrence: [IsOccurrence!]!
+}
+
+extend type Query {
+  """
+  Returns the SBOMs, SLSA attestations, source links, vulnerability and legal
+  certifications and occurrences recorded for the package with the given ID.
+  """
+  provenance(pkg: ID!): ProvenanceSummary!
+}
 `, BuiltIn: false},
 	{Name: "../schema/search.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
@@ -5470,6 +8856,44 @@ type SourceName {
   name: String!
   tag: String
   commit: String
+  """
+  The limit most recent commits of the repository, fetched from the GitHub API.
+
+  Only set for git sources hosted on github.com. Null if the API can not be
+  reached.
+  """
+  recentCommits(limit: Int!): [GitCommit!]
+  """
+  The commit history of the repository, newest first, fetched from the GitHub
+  API a page at a time.
+
+  first is the number of commits to return and after the endCursor of the
+  previous page. Empty for sources not hosted on github.com.
+  """
+  commitHistory(first: Int!, after: String): CommitConnection!
+}
+
+"GitCommit is a commit of a git repository."
+type GitCommit {
+  sha: String!
+  message: String!
+  authorDate: Time!
+}
+
+"""
+CommitConnection is a page of the commit history of a repository.
+
+The cursors are derived from the author dates of the commits.
+"""
+type CommitConnection {
+  edges: [CommitEdge!]!
+  pageInfo: PageInfo!
+}
+
+"CommitEdge is a commit of a CommitConnection."
+type CommitEdge {
+  cursor: ID!
+  node: GitCommit!
 }
 
 """
@@ -5490,14 +8914,42 @@ means retrieving all possible matches.
 It is an error to specify both tag and commit fields, except it both are set as
 empty string (in which case the returned sources are only those for which there
 is no tag/commit information).
+
+namePrefix matches the source names starting with the given string. nameGlob
+matches the source names against a glob pattern, where "*" matches any sequence
+of characters and "?" matches any single character (e.g. "guac-*" or "*-sdk").
+nameContains and nameSuffix match the source names containing or ending with
+the given string. All of these can be combined with each other and with name.
+
+order sorts the source names returned by the sources query. It is ignored by
+sourcesList, which is always ordered by ID to keep cursors stable, and when the
+SourceSpec is nested in the filter of another query.
 """
 input SourceSpec {
   id: ID
   type: String
   namespace: String
   name: String
+  namePrefix: String
+  nameGlob: String
+  nameContains: String
+  nameSuffix: String
   tag: String
   commit: String
+  order: SourceOrder
+}
+
+"SourceOrderField is the field source names are sorted on."
+enum SourceOrderField {
+  TYPE
+  NAMESPACE
+  NAME
+}
+
+"SourceOrder sorts sources by a field, ascending by default."
+input SourceOrder {
+  sortBy: SourceOrderField!
+  sortOrder: OrderDirection! = ASC
 }
 
 """
@@ -5532,9 +8984,40 @@ input IDorSourceInput {
   sourceInput: SourceInputSpec
 }
 
+"""
+SourceConnection returns the paginated results for Source.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the SourceEdge which contains the current cursor
+and the Source node itself
+"""
+type SourceConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [SourceEdge!]!
+}
+
+"""
+SourceEdge contains the cursor for the resulting node and
+the Source node itself.
+"""
+type SourceEdge {
+  cursor: ID!
+  node: Source!
+}
+
 extend type Query {
   "Returns all sources matching a filter."
   sources(sourceSpec: SourceSpec!): [Source!]!
+  "Returns a paginated list of sources matching the input filter."
+  sourcesList(sourceSpec: SourceSpec!, pagination: PaginationSpec): SourceConnection!
+  "Returns the number of source names matching the filter, as counted by sourcesList."
+  sourcesCount(sourceSpec: SourceSpec): Int!
 }
 
 extend type Mutation {
@@ -5542,6 +9025,13 @@ extend type Mutation {
   ingestSource(source: IDorSourceInput!): SourceIDs!
   "Bulk ingests sources and returns the list of corresponding source trie path. The returned array of IDs must be in the same order as the inputs."
   ingestSources(sources: [IDorSourceInput!]!): [SourceIDs!]!
+  """
+  Deletes a source name, for example after the repository was archived or
+  renamed, together with the HasSourceAt, CertifyScorecard, IsOccurrence and
+  other evidence nodes attached to it. Returns true on success and an error if
+  no source name exists with the given ID.
+  """
+  deleteSource(id: ID!): Boolean!
 }
 `, BuiltIn: false},
 	{Name: "../schema/vulnEqual.graphql", Input: `#
@@ -5603,9 +9093,38 @@ input VulnEqualInputSpec {
   documentRef: String!
 }
 
+"""
+VulnEqualConnection returns the paginated results for VulnEqual.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the VulnEqualEdge which contains the current cursor
+and the VulnEqual node itself
+"""
+type VulnEqualConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [VulnEqualEdge!]!
+}
+
+"""
+VulnEqualEdge contains the cursor for the resulting node and
+the VulnEqual node itself.
+"""
+type VulnEqualEdge {
+  cursor: ID!
+  node: VulnEqual!
+}
+
 extend type Query {
   "Returns all equal vulnerability mappings that match a filter."
   vulnEqual(vulnEqualSpec: VulnEqualSpec!): [VulnEqual!]!
+  "Returns a paginated list of vulnerability equality attestations matching the input filter."
+  vulnEqualList(vulnEqualSpec: VulnEqualSpec!, pagination: PaginationSpec): VulnEqualConnection!
 }
 
 extend type Mutation {
@@ -5737,9 +9256,38 @@ input VulnerabilityMetadataInputSpec {
   documentRef: String!
 }
 
+"""
+VulnerabilityMetadataConnection returns the paginated results for VulnerabilityMetadata.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the VulnerabilityMetadataEdge which contains the current cursor
+and the VulnerabilityMetadata node itself
+"""
+type VulnerabilityMetadataConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [VulnerabilityMetadataEdge!]!
+}
+
+"""
+VulnerabilityMetadataEdge contains the cursor for the resulting node and
+the VulnerabilityMetadata node itself.
+"""
+type VulnerabilityMetadataEdge {
+  cursor: ID!
+  node: VulnerabilityMetadata!
+}
+
 extend type Query {
   "Returns all vulnerabilityMetadata attestations matching a filter."
   vulnerabilityMetadata(vulnerabilityMetadataSpec: VulnerabilityMetadataSpec!): [VulnerabilityMetadata!]!
+  "Returns a paginated list of vulnerability metadata matching the input filter."
+  vulnerabilityMetadataList(vulnerabilityMetadataSpec: VulnerabilityMetadataSpec!, pagination: PaginationSpec): VulnerabilityMetadataConnection!
 }
 
 extend type Mutation {
@@ -5800,6 +9348,20 @@ type Vulnerability {
   id: ID!
   type: String!
   vulnerabilityIDs: [VulnerabilityID!]!
+  "Public exploits known for any of the vulnerability IDs"
+  exploitReferences: [ExploitReference!]!
+  """
+  Certifications of packages affected by any of the vulnerability IDs.
+
+  The vulnerability field of the filter is ignored.
+  """
+  certifyVulns(filter: CertifyVulnSpec): [CertifyVuln!]!
+  """
+  True if a VEX statement about any of the vulnerability IDs, for a package
+  matching pkgSpec if set, has the FIXED status, false if only other statuses
+  were stated. Null if there is no VEX statement.
+  """
+  isFixed(pkgSpec: PkgSpec): Boolean
 }
 
 """
@@ -5866,9 +9428,38 @@ input IDorVulnerabilityInput {
   vulnerabilityInput: VulnerabilityInputSpec
 }
 
+"""
+VulnerabilityConnection returns the paginated results for Vulnerability.
+
+totalCount is the total number of results matching the filter across all pages.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the VulnerabilityEdge which contains the current cursor
+and the Vulnerability node itself
+"""
+type VulnerabilityConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [VulnerabilityEdge!]!
+}
+
+"""
+VulnerabilityEdge contains the cursor for the resulting node and
+the Vulnerability node itself.
+"""
+type VulnerabilityEdge {
+  cursor: ID!
+  node: Vulnerability!
+}
+
 extend type Query {
   "Returns all vulnerabilities matching a filter."
   vulnerabilities(vulnSpec: VulnerabilitySpec!): [Vulnerability!]!
+  "Returns a paginated list of vulnerabilities matching the input filter."
+  vulnerabilitiesList(vulnSpec: VulnerabilitySpec!, pagination: PaginationSpec): VulnerabilityConnection!
 }
 
 extend type Mutation {
@@ -0,0 +1,265 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/golang/mock/gomock"
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/middleware"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+const testScope = "guac:read"
+
+type gqlResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+}
+
+// newTestKey returns a freshly generated RSA signing key with the given key ID.
+func newTestKey(t *testing.T, kid string) jwk.Key {
+	t.Helper()
+	raw, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate RSA key: %v", err)
+	}
+	key, err := jwk.FromRaw(raw)
+	if err != nil {
+		t.Fatalf("failed to create JWK: %v", err)
+	}
+	if err := key.Set(jwk.KeyIDKey, kid); err != nil {
+		t.Fatalf("failed to set key ID: %v", err)
+	}
+	if err := key.Set(jwk.AlgorithmKey, jwa.RS256); err != nil {
+		t.Fatalf("failed to set key algorithm: %v", err)
+	}
+	return key
+}
+
+func signToken(t *testing.T, key jwk.Key, expiration time.Time, claims map[string]interface{}) string {
+	t.Helper()
+	b := jwt.NewBuilder().Subject("guac-test").IssuedAt(time.Now()).Expiration(expiration)
+	for k, v := range claims {
+		b = b.Claim(k, v)
+	}
+	token, err := b.Build()
+	if err != nil {
+		t.Fatalf("failed to build token: %v", err)
+	}
+	signed, err := jwt.Sign(token, jwt.WithKey(jwa.RS256, key))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return string(signed)
+}
+
+func TestAuth(t *testing.T) {
+	key := newTestKey(t, "test-key")
+	otherKey := newTestKey(t, "test-key")
+	publicKey, err := jwk.PublicKeyOf(key)
+	if err != nil {
+		t.Fatalf("failed to get public key: %v", err)
+	}
+	keys := jwk.NewSet()
+	if err := keys.AddKey(publicKey); err != nil {
+		t.Fatalf("failed to add key to set: %v", err)
+	}
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(keys)
+	}))
+	defer jwks.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	auth, err := middleware.NewAuth(ctx, jwks.URL)
+	if err != nil {
+		t.Fatalf("NewAuth() error = %v", err)
+	}
+
+	valid := time.Now().Add(time.Hour)
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+		wantCode      string
+		wantCalls     bool
+	}{{
+		name:          "valid token",
+		authorization: "Bearer " + signToken(t, key, valid, map[string]interface{}{"scope": "openid " + testScope}),
+		wantStatus:    http.StatusOK,
+		wantCalls:     true,
+	}, {
+		name:          "scope in scp list",
+		authorization: "Bearer " + signToken(t, key, valid, map[string]interface{}{"scp": []string{testScope}}),
+		wantStatus:    http.StatusOK,
+		wantCalls:     true,
+	}, {
+		name:          "expired token",
+		authorization: "Bearer " + signToken(t, key, time.Now().Add(-time.Hour), map[string]interface{}{"scope": testScope}),
+		wantStatus:    http.StatusUnauthorized,
+	}, {
+		name:          "token signed by another key",
+		authorization: "Bearer " + signToken(t, otherKey, valid, map[string]interface{}{"scope": testScope}),
+		wantStatus:    http.StatusUnauthorized,
+	}, {
+		name:          "not a bearer token",
+		authorization: "Basic Z3VhYzpndWFj",
+		wantStatus:    http.StatusUnauthorized,
+	}, {
+		name:       "missing token",
+		wantStatus: http.StatusOK,
+		wantCode:   string(middleware.UnauthorizedCode),
+	}, {
+		name:          "missing scope",
+		authorization: "Bearer " + signToken(t, key, valid, map[string]interface{}{"scope": "openid"}),
+		wantStatus:    http.StatusOK,
+		wantCode:      string(middleware.UnauthorizedCode),
+	}}
+
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: &resolvers.Resolver{Backend: b}}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(middleware.RequireScope(testScope))
+	server := httptest.NewServer(auth.Handler(srv))
+	defer server.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			times := 0
+			if tt.wantCalls {
+				times = 1
+			}
+			b.EXPECT().CertifyVuln(gomock.Any(), gomock.Any()).Return([]*model.CertifyVuln{}, nil).Times(times)
+
+			req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(certifyVulnQuery))
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("query failed: %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("got status %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if resp.StatusCode != http.StatusOK {
+				return
+			}
+
+			var got gqlResponse
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if tt.wantCode == "" {
+				if len(got.Errors) != 0 {
+					t.Fatalf("unexpected errors: %+v", got.Errors)
+				}
+				if _, ok := got.Data["CertifyVuln"]; !ok {
+					t.Errorf("response is missing CertifyVuln: %+v", got.Data)
+				}
+				return
+			}
+			if len(got.Errors) != 1 {
+				t.Fatalf("got errors %+v, want one", got.Errors)
+			}
+			if code := got.Errors[0].Extensions["code"]; code != tt.wantCode {
+				t.Errorf("got code %v, want %q", code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestRequireScopeHandler(t *testing.T) {
+	key := newTestKey(t, "test-key")
+	publicKey, err := jwk.PublicKeyOf(key)
+	if err != nil {
+		t.Fatalf("failed to get public key: %v", err)
+	}
+	keys := jwk.NewSet()
+	if err := keys.AddKey(publicKey); err != nil {
+		t.Fatalf("failed to add key to set: %v", err)
+	}
+	auth := middleware.NewAuthWithKeySet(keys)
+	h := auth.Handler(middleware.RequireScopeHandler(testScope, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	valid := time.Now().Add(time.Hour)
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+	}{{
+		name:          "valid token",
+		authorization: "Bearer " + signToken(t, key, valid, map[string]interface{}{"scope": testScope}),
+		wantStatus:    http.StatusOK,
+	}, {
+		name:       "missing token",
+		wantStatus: http.StatusUnauthorized,
+	}, {
+		name:          "missing scope",
+		authorization: "Bearer " + signToken(t, key, valid, map[string]interface{}{"scope": "openid"}),
+		wantStatus:    http.StatusUnauthorized,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/export", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			if w.Code != tt.wantStatus {
+				t.Errorf("got status %d, want %d", w.Code, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestNewAuthUnavailableJWKS(t *testing.T) {
+	jwks := httptest.NewServer(http.NotFoundHandler())
+	defer jwks.Close()
+	if _, err := middleware.NewAuth(context.Background(), jwks.URL); err == nil {
+		t.Errorf("expected error fetching an unavailable JWKS")
+	}
+}
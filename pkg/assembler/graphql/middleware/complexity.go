@@ -0,0 +1,143 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	// DefaultComplexityLimit is the complexity limit used when none is
+	// configured.
+	DefaultComplexityLimit = 1000
+	// ComplexityLimitExceededCode is the `extensions.code` of the error
+	// returned for an operation over the limit.
+	ComplexityLimitExceededCode errext.Code = "COMPLEXITY_LIMIT_EXCEEDED"
+)
+
+// Complexity of a field, added to the complexity of its selections.
+const (
+	scalarFieldComplexity         = 1
+	edgeFieldComplexity           = 3
+	filteredListFieldComplexity   = 5
+	unfilteredListFieldComplexity = 10
+)
+
+// ComplexityLimit is a gqlgen handler extension rejecting operations whose
+// complexity is over a limit, before they are executed. The complexity of an
+// operation is the sum of the complexity of the fields it selects: 1 for a
+// scalar or enum field, 3 for a field linking to another node, and for a list
+// field 5 if it is given arguments to filter the list and 10 if it is not.
+//
+// Unlike gqlgen's extension.ComplexityLimit, the fragments selecting
+// different types of a union or interface are not added up: only the most
+// complex one counts, as a node only has one type. This keeps queries such as
+// neighbors, which select every node type, usable.
+type ComplexityLimit struct {
+	limit int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = &ComplexityLimit{}
+
+// NewComplexityLimit creates the extension, rejecting operations with a
+// complexity over limit.
+func NewComplexityLimit(limit int) (*ComplexityLimit, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("complexity limit must be positive, got %d", limit)
+	}
+	return &ComplexityLimit{limit: limit}, nil
+}
+
+func (c *ComplexityLimit) ExtensionName() string {
+	return "ComplexityLimit"
+}
+
+func (c *ComplexityLimit) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (c *ComplexityLimit) MutateOperationContext(_ context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	op := rc.Doc.Operations.ForName(rc.OperationName)
+	if op == nil {
+		return nil
+	}
+	got := Complexity(op, rc.Variables)
+	if got > c.limit {
+		return errext.WithCode(gqlerror.Errorf("operation has complexity %d, which exceeds the limit of %d", got, c.limit), ComplexityLimitExceededCode)
+	}
+	return nil
+}
+
+// Complexity returns the complexity of a validated operation, as computed by
+// ComplexityLimit.
+func Complexity(op *ast.OperationDefinition, vars map[string]interface{}) int {
+	return selectionSetComplexity(op.SelectionSet, vars)
+}
+
+func selectionSetComplexity(selectionSet ast.SelectionSet, vars map[string]interface{}) int {
+	var total int
+	// complexity of the fragments of each type condition
+	byType := map[string]int{}
+	for _, selection := range selectionSet {
+		switch s := selection.(type) {
+		case *ast.Field:
+			total += fieldComplexity(s, vars)
+		case *ast.InlineFragment:
+			if s.TypeCondition == "" {
+				total += selectionSetComplexity(s.SelectionSet, vars)
+			} else {
+				byType[s.TypeCondition] += selectionSetComplexity(s.SelectionSet, vars)
+			}
+		case *ast.FragmentSpread:
+			byType[s.Definition.TypeCondition] += selectionSetComplexity(s.Definition.SelectionSet, vars)
+		}
+	}
+	var maxFragment int
+	for _, c := range byType {
+		maxFragment = max(maxFragment, c)
+	}
+	return total + maxFragment
+}
+
+func fieldComplexity(field *ast.Field, vars map[string]interface{}) int {
+	// introspection
+	if strings.HasPrefix(field.Name, "__") || field.Definition == nil {
+		return 0
+	}
+	children := selectionSetComplexity(field.SelectionSet, vars)
+	if field.Definition.Type.Elem != nil {
+		for _, arg := range field.ArgumentMap(vars) {
+			if arg != nil {
+				return filteredListFieldComplexity + children
+			}
+		}
+		return unfilteredListFieldComplexity + children
+	}
+	if len(field.SelectionSet) > 0 {
+		return edgeFieldComplexity + children
+	}
+	return scalarFieldComplexity
+}
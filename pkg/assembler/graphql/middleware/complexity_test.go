@@ -0,0 +1,190 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/golang/mock/gomock"
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/middleware"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"github.com/vektah/gqlparser/v2"
+)
+
+func TestComplexity(t *testing.T) {
+	schema := generated.NewExecutableSchema(generated.Config{}).Schema()
+	tests := []struct {
+		name  string
+		query string
+		want  int
+	}{{
+		name:  "scalar fields",
+		query: `{ packages(pkgSpec: {}) { id type } }`,
+		want:  5 + 1 + 1,
+	}, {
+		name:  "unfiltered lists",
+		query: `{ packages(pkgSpec: {}) { namespaces { names { versions { id } } } } }`,
+		want:  5 + 10 + 10 + 10 + 1,
+	}, {
+		name:  "variables",
+		query: `query Q($p: PkgSpec!) { packages(pkgSpec: $p) { id } }`,
+		want:  5 + 1,
+	}, {
+		name:  "edge field",
+		query: `{ CertifyVuln(certifyVulnSpec: {}) { id package { id } } }`,
+		want:  5 + 1 + 3 + 1,
+	}, {
+		name:  "union takes the most complex fragment",
+		query: `{ node(node: "1") { ... on Package { id type } ... on Artifact { id } } }`,
+		want:  3 + 2,
+	}, {
+		name: "named fragments",
+		query: `{ node(node: "1") { __typename ...pkg ...art } }
+fragment pkg on Package { id namespaces { id } }
+fragment art on Artifact { id }`,
+		want: 3 + 1 + 10 + 1,
+	}, {
+		name:  "introspection",
+		query: `{ __typename __schema { types { name } } }`,
+		want:  0,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, errs := gqlparser.LoadQuery(schema, tt.query)
+			if errs != nil {
+				t.Fatalf("failed to load query: %v", errs)
+			}
+			if got := middleware.Complexity(doc.Operations[0], map[string]interface{}{"p": map[string]interface{}{}}); got != tt.want {
+				t.Errorf("Complexity() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// The operations used by the GUAC clients must be allowed by the default
+// limit.
+func TestClientOperationsComplexity(t *testing.T) {
+	schema := generated.NewExecutableSchema(generated.Config{}).Schema()
+	files, err := filepath.Glob("../../clients/operations/*.graphql")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("failed to find client operations: %v", err)
+	}
+	// fragments are shared between the files
+	var operations bytes.Buffer
+	for _, f := range files {
+		b, err := os.ReadFile(f)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", f, err)
+		}
+		operations.Write(b)
+		operations.WriteString("\n")
+	}
+	doc, errs := gqlparser.LoadQuery(schema, operations.String())
+	if errs != nil {
+		t.Fatalf("failed to load client operations: %v", errs)
+	}
+	for _, op := range doc.Operations {
+		if got := middleware.Complexity(op, nil); got > middleware.DefaultComplexityLimit {
+			t.Errorf("operation %s has complexity %d, over the default limit %d", op.Name, got, middleware.DefaultComplexityLimit)
+		}
+	}
+}
+
+func TestComplexityLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		wantErr   string
+		wantCalls bool
+	}{{
+		name:      "under the limit",
+		query:     `{ packages(pkgSpec: {}) { id type } }`,
+		wantCalls: true,
+	}, {
+		name:    "over the limit",
+		query:   `{ packages(pkgSpec: {}) { namespaces { names { versions { id } } } } }`,
+		wantErr: "operation has complexity 36, which exceeds the limit of 20",
+	}}
+
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	complexityLimit, err := middleware.NewComplexityLimit(20)
+	if err != nil {
+		t.Fatalf("NewComplexityLimit() error = %v", err)
+	}
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: &resolvers.Resolver{Backend: b}}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(complexityLimit)
+	server := httptest.NewServer(srv)
+	defer server.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			times := 0
+			if tt.wantCalls {
+				times = 1
+			}
+			b.EXPECT().Packages(gomock.Any(), gomock.Any()).Return([]*model.Package{}, nil).Times(times)
+
+			body, err := json.Marshal(map[string]interface{}{"query": tt.query})
+			if err != nil {
+				t.Fatalf("failed to encode request: %v", err)
+			}
+			resp, err := http.Post(server.URL, "application/json", bytes.NewReader(body))
+			if err != nil {
+				t.Fatalf("query failed: %v", err)
+			}
+			defer resp.Body.Close()
+			var got gqlResponse
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if tt.wantErr == "" {
+				if len(got.Errors) != 0 {
+					t.Fatalf("unexpected errors: %+v", got.Errors)
+				}
+				return
+			}
+			if len(got.Errors) != 1 {
+				t.Fatalf("got errors %+v, want %q", got.Errors, tt.wantErr)
+			}
+			if got.Errors[0].Message != tt.wantErr {
+				t.Errorf("got error %q, want %q", got.Errors[0].Message, tt.wantErr)
+			}
+			if code := got.Errors[0].Extensions["code"]; code != string(middleware.ComplexityLimitExceededCode) {
+				t.Errorf("got code %v, want %q", code, middleware.ComplexityLimitExceededCode)
+			}
+		})
+	}
+}
+
+func TestNewComplexityLimitInvalid(t *testing.T) {
+	if _, err := middleware.NewComplexityLimit(0); err == nil {
+		t.Errorf("expected error creating a limit of 0")
+	}
+}
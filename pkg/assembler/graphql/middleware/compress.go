@@ -0,0 +1,198 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultCompressMinSize is the size, in bytes, under which responses are sent
+// uncompressed by default.
+const DefaultCompressMinSize = 1024
+
+// Compress is an HTTP middleware that gzip compresses the responses to clients
+// sending `Accept-Encoding: gzip`. Responses are buffered until they reach the
+// minimum size, smaller ones are sent as is since compressing them saves
+// little. WebSocket upgrades, used by subscriptions, are passed through.
+type Compress struct {
+	minSize int
+	writers sync.Pool
+}
+
+// NewCompress creates the middleware compressing with the given gzip level,
+// from gzip.HuffmanOnly to gzip.BestCompression, the responses of at least
+// minSize bytes.
+func NewCompress(level, minSize int) (*Compress, error) {
+	if _, err := gzip.NewWriterLevel(io.Discard, level); err != nil {
+		return nil, fmt.Errorf("invalid compression level: %w", err)
+	}
+	if minSize < 0 {
+		return nil, fmt.Errorf("invalid minimum size to compress: %d", minSize)
+	}
+	c := &Compress{minSize: minSize}
+	c.writers.New = func() interface{} {
+		// the level was checked above
+		gz, _ := gzip.NewWriterLevel(io.Discard, level)
+		return gz
+	}
+	return c, nil
+}
+
+// Handler wraps next with the response compression.
+func (c *Compress) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Upgrade") != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Add("Vary", "Accept-Encoding")
+		if !acceptsGzip(r.Header.Get("Accept-Encoding")) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		cw := &compressWriter{ResponseWriter: w, c: c}
+		defer cw.close()
+		next.ServeHTTP(cw, r)
+	})
+}
+
+// acceptsGzip reports whether the Accept-Encoding header value accepts gzip,
+// explicitly or through the * wildcard, with a non-zero quality.
+func acceptsGzip(header string) bool {
+	wildcard := false
+	for _, encoding := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(encoding, ";")
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				q = f
+			}
+		}
+		switch strings.TrimSpace(name) {
+		case "gzip":
+			return q > 0
+		case "*":
+			wildcard = q > 0
+		}
+	}
+	return wildcard
+}
+
+// compressWriter buffers the response until it is large enough to be
+// compressed or complete, and only then writes the headers.
+type compressWriter struct {
+	http.ResponseWriter
+	c *Compress
+
+	status      int
+	wroteHeader bool
+	buf         []byte
+	gz          *gzip.Writer
+}
+
+func (w *compressWriter) WriteHeader(status int) {
+	if w.wroteHeader || w.status != 0 {
+		return
+	}
+	w.status = status
+}
+
+func (w *compressWriter) Write(p []byte) (int, error) {
+	if w.gz != nil {
+		return w.gz.Write(p)
+	}
+	if w.wroteHeader {
+		return w.ResponseWriter.Write(p)
+	}
+	w.buf = append(w.buf, p...)
+	if len(w.buf) < w.c.minSize {
+		return len(p), nil
+	}
+	if err := w.startGzip(); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Flush sends the buffered response, uncompressed if compression has not
+// started yet.
+func (w *compressWriter) Flush() {
+	if !w.wroteHeader {
+		_ = w.writePlain()
+	}
+	if w.gz != nil {
+		_ = w.gz.Flush()
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *compressWriter) startGzip() error {
+	h := w.Header()
+	if h.Get("Content-Encoding") != "" {
+		// already encoded by the handler
+		return w.writePlain()
+	}
+	if h.Get("Content-Type") == "" {
+		h.Set("Content-Type", http.DetectContentType(w.buf))
+	}
+	h.Set("Content-Encoding", "gzip")
+	h.Del("Content-Length")
+	w.writeHeader()
+
+	w.gz = w.c.writers.Get().(*gzip.Writer)
+	w.gz.Reset(w.ResponseWriter)
+	_, err := w.gz.Write(w.buf)
+	w.buf = nil
+	return err
+}
+
+func (w *compressWriter) writePlain() error {
+	w.writeHeader()
+	if len(w.buf) == 0 {
+		return nil
+	}
+	_, err := w.ResponseWriter.Write(w.buf)
+	w.buf = nil
+	return err
+}
+
+func (w *compressWriter) writeHeader() {
+	w.wroteHeader = true
+	if w.status != 0 {
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+}
+
+func (w *compressWriter) close() {
+	if w.gz == nil {
+		if !w.wroteHeader {
+			_ = w.writePlain()
+		}
+		return
+	}
+	_ = w.gz.Close()
+	w.gz.Reset(io.Discard)
+	w.c.writers.Put(w.gz)
+	w.gz = nil
+}
@@ -0,0 +1,246 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/golang/mock/gomock"
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/middleware"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+)
+
+func TestCompress(t *testing.T) {
+	large := `{"data":"` + strings.Repeat("guac", middleware.DefaultCompressMinSize) + `"}`
+	small := `{"data":"guac"}`
+	tests := []struct {
+		name           string
+		acceptEncoding string
+		body           string
+		status         int
+		wantGzip       bool
+	}{{
+		name:           "large response",
+		acceptEncoding: "gzip, deflate, br",
+		body:           large,
+		wantGzip:       true,
+	}, {
+		name:           "large response with status",
+		acceptEncoding: "gzip",
+		body:           large,
+		status:         http.StatusBadRequest,
+		wantGzip:       true,
+	}, {
+		name:           "small response",
+		acceptEncoding: "gzip",
+		body:           small,
+	}, {
+		name: "gzip not accepted",
+		body: large,
+	}, {
+		name:           "gzip refused",
+		acceptEncoding: "gzip;q=0, *",
+		body:           large,
+	}, {
+		name:           "wildcard",
+		acceptEncoding: "br;q=1.0, *;q=0.5",
+		body:           large,
+		wantGzip:       true,
+	}}
+
+	c, err := middleware.NewCompress(gzip.DefaultCompression, middleware.DefaultCompressMinSize)
+	if err != nil {
+		t.Fatalf("NewCompress() error = %v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := c.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Content-Length", fmt.Sprint(len(tt.body)))
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+				}
+				// write in chunks, as the gqlgen transports do
+				for body := tt.body; body != ""; {
+					n := min(len(body), 100)
+					_, _ = io.WriteString(w, body[:n])
+					body = body[n:]
+				}
+			}))
+			req := httptest.NewRequest(http.MethodPost, "/query", nil)
+			if tt.acceptEncoding != "" {
+				req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			wantStatus := http.StatusOK
+			if tt.status != 0 {
+				wantStatus = tt.status
+			}
+			if rec.Code != wantStatus {
+				t.Errorf("got status %d, want %d", rec.Code, wantStatus)
+			}
+			if got := rec.Header().Get("Vary"); got != "Accept-Encoding" {
+				t.Errorf("got Vary %q, want Accept-Encoding", got)
+			}
+			if got := rec.Header().Get("Content-Type"); got != "application/json" {
+				t.Errorf("got Content-Type %q, want application/json", got)
+			}
+			body := rec.Body.Bytes()
+			if gotGzip := rec.Header().Get("Content-Encoding") == "gzip"; gotGzip != tt.wantGzip {
+				t.Fatalf("got compressed %v, want %v", gotGzip, tt.wantGzip)
+			}
+			if tt.wantGzip {
+				if rec.Header().Get("Content-Length") != "" {
+					t.Errorf("Content-Length of the uncompressed body was sent")
+				}
+				body = gunzip(t, body)
+			}
+			if string(body) != tt.body {
+				t.Errorf("got body %q, want %q", body, tt.body)
+			}
+		})
+	}
+}
+
+func TestNewCompressInvalid(t *testing.T) {
+	if _, err := middleware.NewCompress(gzip.BestCompression+1, middleware.DefaultCompressMinSize); err == nil {
+		t.Errorf("expected error for an invalid compression level")
+	}
+	if _, err := middleware.NewCompress(gzip.DefaultCompression, -1); err == nil {
+		t.Errorf("expected error for a negative minimum size")
+	}
+}
+
+func gunzip(t testing.TB, body []byte) []byte {
+	t.Helper()
+	r, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("failed to read gzip body: %v", err)
+	}
+	defer r.Close()
+	b, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to decompress body: %v", err)
+	}
+	return b
+}
+
+const packagesQuery = `{"query":"{ packages(pkgSpec: {}) { id type namespaces { id namespace names { id name versions { id version qualifiers { key value } subpath } } } } }"}`
+
+// packagesServer returns a GraphQL server whose backend returns n packages for
+// every packages query.
+func packagesServer(t gomock.TestReporter, n int) http.Handler {
+	pkgs := make([]*model.Package, 0, n)
+	for i := 0; i < n; i++ {
+		pkgs = append(pkgs, &model.Package{
+			ID:   fmt.Sprintf("package_types:%d", i),
+			Type: "pypi",
+			Namespaces: []*model.PackageNamespace{{
+				ID:        fmt.Sprintf("package_namespaces:%d", i),
+				Namespace: "",
+				Names: []*model.PackageName{{
+					ID:   fmt.Sprintf("package_names:%d", i),
+					Name: fmt.Sprintf("package-%d", i),
+					Versions: []*model.PackageVersion{{
+						ID:         fmt.Sprintf("package_versions:%d", i),
+						Version:    fmt.Sprintf("1.%d.0", i),
+						Qualifiers: []*model.PackageQualifier{},
+					}},
+				}},
+			}},
+		})
+	}
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	b.EXPECT().Packages(gomock.Any(), gomock.Any()).Return(pkgs, nil).AnyTimes()
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: &resolvers.Resolver{Backend: b}}))
+	srv.AddTransport(transport.POST{})
+	return srv
+}
+
+func queryPackages(t testing.TB, h http.Handler) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(packagesQuery))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got status %d: %s", rec.Code, rec.Body)
+	}
+	return rec
+}
+
+func TestCompressPackagesResponse(t *testing.T) {
+	const numPackages = 10000
+	srv := packagesServer(t, numPackages)
+	c, err := middleware.NewCompress(gzip.DefaultCompression, middleware.DefaultCompressMinSize)
+	if err != nil {
+		t.Fatalf("NewCompress() error = %v", err)
+	}
+
+	plain := queryPackages(t, srv).Body.Bytes()
+	rec := queryPackages(t, c.Handler(srv))
+	if rec.Header().Get("Content-Encoding") != "gzip" {
+		t.Fatalf("response was not compressed")
+	}
+	compressed := rec.Body.Bytes()
+	if !bytes.Equal(gunzip(t, compressed), plain) {
+		t.Fatalf("decompressed response differs from the uncompressed one")
+	}
+	if len(compressed)*2 > len(plain) {
+		t.Errorf("compressed %d bytes to %d, want at least a 50%% reduction", len(plain), len(compressed))
+	}
+}
+
+func BenchmarkCompressPackagesResponse(b *testing.B) {
+	const numPackages = 10000
+	srv := packagesServer(b, numPackages)
+	plainSize := queryPackages(b, srv).Body.Len()
+	for _, level := range []int{gzip.BestSpeed, gzip.DefaultCompression, gzip.BestCompression} {
+		b.Run(fmt.Sprintf("level %d", level), func(b *testing.B) {
+			c, err := middleware.NewCompress(level, middleware.DefaultCompressMinSize)
+			if err != nil {
+				b.Fatalf("NewCompress() error = %v", err)
+			}
+			h := c.Handler(srv)
+			var size int
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				size = queryPackages(b, h).Body.Len()
+			}
+			b.StopTimer()
+			reduction := 1 - float64(size)/float64(plainSize)
+			b.ReportMetric(100*reduction, "%reduction")
+			if reduction < 0.5 {
+				b.Errorf("compressed %d bytes to %d, want at least a 50%% reduction", plainSize, size)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package middleware holds gqlgen handler extensions for the GraphQL server.
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	ResolverDurationMetric = "graphql_resolver_duration_seconds"
+	ResolverErrorsMetric   = "graphql_resolver_errors_total"
+)
+
+// Metrics is a gqlgen handler extension that records the latency and errors of
+// every field resolver, labeled by the GraphQL object and field. Fields that are
+// plain struct accesses are not recorded.
+type Metrics struct {
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = &Metrics{}
+
+// NewMetrics creates the resolver metrics and registers them with registerer.
+func NewMetrics(registerer prometheus.Registerer) (*Metrics, error) {
+	m := &Metrics{
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    ResolverDurationMetric,
+			Help:    "Time spent executing GraphQL field resolvers.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"object", "field"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: ResolverErrorsMetric,
+			Help: "Number of GraphQL field resolvers that returned an error.",
+		}, []string{"object", "field"}),
+	}
+	if err := registerer.Register(m.duration); err != nil {
+		return nil, fmt.Errorf("failed to register histogram %q: %w", ResolverDurationMetric, err)
+	}
+	if err := registerer.Register(m.errors); err != nil {
+		return nil, fmt.Errorf("failed to register counter %q: %w", ResolverErrorsMetric, err)
+	}
+	return m, nil
+}
+
+func (m *Metrics) ExtensionName() string {
+	return "ResolverMetrics"
+}
+
+func (m *Metrics) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (m *Metrics) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+	start := time.Now()
+	res, err := next(ctx)
+	m.duration.WithLabelValues(fc.Object, fc.Field.Name).Observe(time.Since(start).Seconds())
+	if err != nil {
+		m.errors.WithLabelValues(fc.Object, fc.Field.Name).Inc()
+	}
+	return res, err
+}
@@ -0,0 +1,99 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/golang/mock/gomock"
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/middleware"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const certifyVulnQuery = `{"query":"{ CertifyVuln(certifyVulnSpec: {}) { id } }"}`
+
+func TestMetrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	gomock.InOrder(
+		b.EXPECT().CertifyVuln(gomock.Any(), gomock.Any()).Return([]*model.CertifyVuln{}, nil),
+		b.EXPECT().CertifyVuln(gomock.Any(), gomock.Any()).Return(nil, errors.New("backend failure")),
+	)
+
+	registry := prometheus.NewRegistry()
+	metrics, err := middleware.NewMetrics(registry)
+	if err != nil {
+		t.Fatalf("NewMetrics() error = %v", err)
+	}
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &resolvers.Resolver{Backend: b}}))
+	srv.Use(metrics)
+
+	mux := http.NewServeMux()
+	mux.Handle("/query", srv)
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	for i := 0; i < 2; i++ {
+		resp, err := http.Post(server.URL+"/query", "application/json", strings.NewReader(certifyVulnQuery))
+		if err != nil {
+			t.Fatalf("query failed: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	resp, err := http.Get(server.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("failed to get metrics: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read metrics: %v", err)
+	}
+	for _, want := range []string{
+		`graphql_resolver_duration_seconds_count{field="CertifyVuln",object="Query"} 2`,
+		`graphql_resolver_errors_total{field="CertifyVuln",object="Query"} 1`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("metrics do not contain %q:\n%s", want, body)
+		}
+	}
+	if strings.Contains(string(body), `field="id"`) {
+		t.Errorf("metrics contain non resolver field id:\n%s", body)
+	}
+}
+
+func TestNewMetricsRegistersOnce(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	if _, err := middleware.NewMetrics(registry); err != nil {
+		t.Fatalf("NewMetrics() error = %v", err)
+	}
+	if _, err := middleware.NewMetrics(registry); err == nil {
+		t.Errorf("expected error registering the metrics twice")
+	}
+}
@@ -52,6 +52,29 @@ func (Artifact) IsPackageOrArtifact() {}
 
 func (Artifact) IsNode() {}
 
+// ArtifactConnection returns the paginated results for Artifact.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the ArtifactEdge which contains the current cursor
+// and the Artifact node itself
+type ArtifactConnection struct {
+	TotalCount int             `json:"totalCount"`
+	PageInfo   *PageInfo       `json:"pageInfo"`
+	Edges      []*ArtifactEdge `json:"edges"`
+}
+
+// ArtifactEdge contains the cursor for the resulting node and
+// the Artifact node itself.
+type ArtifactEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *Artifact `json:"node"`
+}
+
 // ArtifactInputSpec specifies an artifact for mutations.
 //
 // The checksum fields are canonicalized to be lowercase.
@@ -63,10 +86,14 @@ type ArtifactInputSpec struct {
 // ArtifactSpec allows filtering the list of artifacts to return in a query.
 //
 // The checksum fields are canonicalized to be lowercase.
+//
+// digestPrefix matches the artifacts whose digest starts with the given hex
+// characters, e.g. the first 12 characters shown by many security tools.
 type ArtifactSpec struct {
-	ID        *string `json:"id,omitempty"`
-	Algorithm *string `json:"algorithm,omitempty"`
-	Digest    *string `json:"digest,omitempty"`
+	ID           *string `json:"id,omitempty"`
+	Algorithm    *string `json:"algorithm,omitempty"`
+	Digest       *string `json:"digest,omitempty"`
+	DigestPrefix *string `json:"digestPrefix,omitempty"`
 }
 
 // Builder represents the builder (e.g., FRSCA or GitHub Actions).
@@ -79,6 +106,29 @@ type Builder struct {
 
 func (Builder) IsNode() {}
 
+// BuilderConnection returns the paginated results for Builder.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the BuilderEdge which contains the current cursor
+// and the Builder node itself
+type BuilderConnection struct {
+	TotalCount int            `json:"totalCount"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	Edges      []*BuilderEdge `json:"edges"`
+}
+
+// BuilderEdge contains the cursor for the resulting node and
+// the Builder node itself.
+type BuilderEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *Builder `json:"node"`
+}
+
 // BuilderInputSpec specifies a builder for mutations.
 type BuilderInputSpec struct {
 	URI string `json:"uri"`
@@ -119,6 +169,29 @@ type CertifyBad struct {
 
 func (CertifyBad) IsNode() {}
 
+// CertifyBadConnection returns the paginated results for CertifyBad.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the CertifyBadEdge which contains the current cursor
+// and the CertifyBad node itself
+type CertifyBadConnection struct {
+	TotalCount int               `json:"totalCount"`
+	PageInfo   *PageInfo         `json:"pageInfo"`
+	Edges      []*CertifyBadEdge `json:"edges"`
+}
+
+// CertifyBadEdge contains the cursor for the resulting node and
+// the CertifyBad node itself.
+type CertifyBadEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   *CertifyBad `json:"node"`
+}
+
 // CertifyBadInputSpec represents the mutation input to ingest a CertifyBad
 // evidence.
 type CertifyBadInputSpec struct {
@@ -141,14 +214,24 @@ type CertifyBadInputSpec struct {
 //
 // If KnownSince is specified, the returned value will be after or equal to the specified time.
 // Any nodes time that is before KnownSince is excluded.
+//
+// justificationPrefix matches the justifications starting with the given string
+// and justificationContains matches the justifications containing it. Both can be
+// combined with each other and with justification.
+//
+// subjectType restricts the results to the certifications of a package, a source
+// or an artifact.
 type CertifyBadSpec struct {
-	ID            *string                      `json:"id,omitempty"`
-	Subject       *PackageSourceOrArtifactSpec `json:"subject,omitempty"`
-	Justification *string                      `json:"justification,omitempty"`
-	KnownSince    *time.Time                   `json:"knownSince,omitempty"`
-	Origin        *string                      `json:"origin,omitempty"`
-	Collector     *string                      `json:"collector,omitempty"`
-	DocumentRef   *string                      `json:"documentRef,omitempty"`
+	ID                    *string                      `json:"id,omitempty"`
+	Subject               *PackageSourceOrArtifactSpec `json:"subject,omitempty"`
+	SubjectType           *SubjectType                 `json:"subjectType,omitempty"`
+	Justification         *string                      `json:"justification,omitempty"`
+	JustificationPrefix   *string                      `json:"justificationPrefix,omitempty"`
+	JustificationContains *string                      `json:"justificationContains,omitempty"`
+	KnownSince            *time.Time                   `json:"knownSince,omitempty"`
+	Origin                *string                      `json:"origin,omitempty"`
+	Collector             *string                      `json:"collector,omitempty"`
+	DocumentRef           *string                      `json:"documentRef,omitempty"`
 }
 
 // CertifyGood is an attestation that a package, source, or artifact is considered
@@ -180,6 +263,29 @@ type CertifyGood struct {
 
 func (CertifyGood) IsNode() {}
 
+// CertifyGoodConnection returns the paginated results for CertifyGood.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the CertifyGoodEdge which contains the current cursor
+// and the CertifyGood node itself
+type CertifyGoodConnection struct {
+	TotalCount int                `json:"totalCount"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	Edges      []*CertifyGoodEdge `json:"edges"`
+}
+
+// CertifyGoodEdge contains the cursor for the resulting node and
+// the CertifyGood node itself.
+type CertifyGoodEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *CertifyGood `json:"node"`
+}
+
 // CertifyGoodInputSpec represents the mutation input to ingest a CertifyGood evidence.
 type CertifyGoodInputSpec struct {
 	Justification string    `json:"justification"`
@@ -201,14 +307,24 @@ type CertifyGoodInputSpec struct {
 //
 // If KnownSince is specified, the returned value will be after or equal to the specified time.
 // Any nodes time that is before KnownSince is excluded.
+//
+// justificationPrefix matches the justifications starting with the given string
+// and justificationContains matches the justifications containing it. Both can be
+// combined with each other and with justification.
+//
+// subjectType restricts the results to the certifications of a package, a source
+// or an artifact.
 type CertifyGoodSpec struct {
-	ID            *string                      `json:"id,omitempty"`
-	Subject       *PackageSourceOrArtifactSpec `json:"subject,omitempty"`
-	Justification *string                      `json:"justification,omitempty"`
-	KnownSince    *time.Time                   `json:"knownSince,omitempty"`
-	Origin        *string                      `json:"origin,omitempty"`
-	Collector     *string                      `json:"collector,omitempty"`
-	DocumentRef   *string                      `json:"documentRef,omitempty"`
+	ID                    *string                      `json:"id,omitempty"`
+	Subject               *PackageSourceOrArtifactSpec `json:"subject,omitempty"`
+	SubjectType           *SubjectType                 `json:"subjectType,omitempty"`
+	Justification         *string                      `json:"justification,omitempty"`
+	JustificationPrefix   *string                      `json:"justificationPrefix,omitempty"`
+	JustificationContains *string                      `json:"justificationContains,omitempty"`
+	KnownSince            *time.Time                   `json:"knownSince,omitempty"`
+	Origin                *string                      `json:"origin,omitempty"`
+	Collector             *string                      `json:"collector,omitempty"`
+	DocumentRef           *string                      `json:"documentRef,omitempty"`
 }
 
 // CertifyLegal is an attestation to attach legal information to a package or source.
@@ -253,6 +369,29 @@ type CertifyLegal struct {
 
 func (CertifyLegal) IsNode() {}
 
+// CertifyLegalConnection returns the paginated results for CertifyLegal.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the CertifyLegalEdge which contains the current cursor
+// and the CertifyLegal node itself
+type CertifyLegalConnection struct {
+	TotalCount int                 `json:"totalCount"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+	Edges      []*CertifyLegalEdge `json:"edges"`
+}
+
+// CertifyLegalEdge contains the cursor for the resulting node and
+// the CertifyLegal node itself.
+type CertifyLegalEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *CertifyLegal `json:"node"`
+}
+
 // CertifyLegalInputSpec represents the input for certifying legal information in
 // mutations.
 type CertifyLegalInputSpec struct {
@@ -298,6 +437,29 @@ type CertifyScorecard struct {
 
 func (CertifyScorecard) IsNode() {}
 
+// CertifyScorecardConnection returns the paginated results for CertifyScorecard.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the CertifyScorecardEdge which contains the current cursor
+// and the CertifyScorecard node itself
+type CertifyScorecardConnection struct {
+	TotalCount int                     `json:"totalCount"`
+	PageInfo   *PageInfo               `json:"pageInfo"`
+	Edges      []*CertifyScorecardEdge `json:"edges"`
+}
+
+// CertifyScorecardEdge contains the cursor for the resulting node and
+// the CertifyScorecard node itself.
+type CertifyScorecardEdge struct {
+	Cursor string            `json:"cursor"`
+	Node   *CertifyScorecard `json:"node"`
+}
+
 // CertifyScorecardSpec allows filtering the list of Scorecards to return.
 type CertifyScorecardSpec struct {
 	ID               *string               `json:"id,omitempty"`
@@ -340,6 +502,29 @@ type CertifyVEXStatement struct {
 
 func (CertifyVEXStatement) IsNode() {}
 
+// CertifyVEXStatementConnection returns the paginated results for CertifyVEXStatement.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the CertifyVEXStatementEdge which contains the current cursor
+// and the CertifyVEXStatement node itself
+type CertifyVEXStatementConnection struct {
+	TotalCount int                        `json:"totalCount"`
+	PageInfo   *PageInfo                  `json:"pageInfo"`
+	Edges      []*CertifyVEXStatementEdge `json:"edges"`
+}
+
+// CertifyVEXStatementEdge contains the cursor for the resulting node and
+// the CertifyVEXStatement node itself.
+type CertifyVEXStatementEdge struct {
+	Cursor string               `json:"cursor"`
+	Node   *CertifyVEXStatement `json:"node"`
+}
+
 // CertifyVEXStatementSpec allows filtering the list of VEX statements to
 // return in a query.
 //
@@ -373,10 +558,51 @@ type CertifyVuln struct {
 	Vulnerability *Vulnerability `json:"vulnerability"`
 	// Metadata attached to the certification
 	Metadata *ScanMetadata `json:"metadata"`
+	// Remediation status of the finding
+	RemediationStatus RemediationStatus `json:"remediationStatus"`
+	// True if the finding was scanned longer ago than the stale threshold
+	// configured on the server. Always false if no threshold is configured.
+	Stale bool `json:"stale"`
+	// The HasSBOM of the package version or the HasSourceAt of the source commit
+	// that resolved the vulnerability, if recorded with updateCertifyVulnResolution
+	ResolvedBy Node `json:"resolvedBy,omitempty"`
+	// ID of the HasSBOM or HasSourceAt that resolved the vulnerability
+	ResolvedByID *string `json:"-"`
+	// GraphQL type of the node that resolved the vulnerability, HasSBOM or HasSourceAt
+	ResolvedByType *string `json:"-"`
 }
 
 func (CertifyVuln) IsNode() {}
 
+// CertifyVulnConnection returns the paginated results for CertifyVuln.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the CertifyVulnEdge which contains the current cursor
+// and the CertifyVuln node itself
+type CertifyVulnConnection struct {
+	TotalCount int                `json:"totalCount"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	Edges      []*CertifyVulnEdge `json:"edges"`
+}
+
+// CertifyVulnEdge contains the cursor for the resulting node and
+// the CertifyVuln node itself.
+type CertifyVulnEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *CertifyVuln `json:"node"`
+}
+
+// CertifyVulnOrder sorts CertifyVuln results by a field, ascending by default.
+type CertifyVulnOrder struct {
+	SortBy    CertifyVulnOrderField `json:"sortBy"`
+	SortOrder OrderDirection        `json:"sortOrder"`
+}
+
 // CertifyVulnSpec allows filtering the list of vulnerability certifications to
 // return in a query.
 //
@@ -385,18 +611,142 @@ func (CertifyVuln) IsNode() {}
 //
 // Only one vulnerability (or NoVuln vulnerability type) may be
 // specified.
+//
+// minCVSS and maxCVSS select certifications whose CVSS score falls within the
+// inclusive range. Certifications without a score never match a range.
+//
+// timeScannedAfter and timeScannedBefore select certifications scanned within
+// the inclusive time range, either bound can be left open.
+//
+// scannerUriIn selects certifications reported by any of the listed scanners.
+// It is combined with scannerUri like every other filter, and ignored when
+// empty.
+//
+// order sorts the results of the certifyVuln query. It is ignored by
+// CertifyVulnList, which is always ordered by ID to keep cursors stable.
 type CertifyVulnSpec struct {
-	ID             *string            `json:"id,omitempty"`
-	Package        *PkgSpec           `json:"package,omitempty"`
-	Vulnerability  *VulnerabilitySpec `json:"vulnerability,omitempty"`
-	TimeScanned    *time.Time         `json:"timeScanned,omitempty"`
-	DbURI          *string            `json:"dbUri,omitempty"`
-	DbVersion      *string            `json:"dbVersion,omitempty"`
-	ScannerURI     *string            `json:"scannerUri,omitempty"`
-	ScannerVersion *string            `json:"scannerVersion,omitempty"`
-	Origin         *string            `json:"origin,omitempty"`
-	Collector      *string            `json:"collector,omitempty"`
-	DocumentRef    *string            `json:"documentRef,omitempty"`
+	ID                *string            `json:"id,omitempty"`
+	Package           *PkgSpec           `json:"package,omitempty"`
+	Vulnerability     *VulnerabilitySpec `json:"vulnerability,omitempty"`
+	TimeScanned       *time.Time         `json:"timeScanned,omitempty"`
+	DbURI             *string            `json:"dbUri,omitempty"`
+	DbVersion         *string            `json:"dbVersion,omitempty"`
+	ScannerURI        *string            `json:"scannerUri,omitempty"`
+	ScannerURIIn      []string           `json:"scannerUriIn,omitempty"`
+	ScannerVersion    *string            `json:"scannerVersion,omitempty"`
+	Origin            *string            `json:"origin,omitempty"`
+	Collector         *string            `json:"collector,omitempty"`
+	DocumentRef       *string            `json:"documentRef,omitempty"`
+	MinCVSS           *float64           `json:"minCVSS,omitempty"`
+	MaxCVSS           *float64           `json:"maxCVSS,omitempty"`
+	TimeScannedAfter  *time.Time         `json:"timeScannedAfter,omitempty"`
+	TimeScannedBefore *time.Time         `json:"timeScannedBefore,omitempty"`
+	Order             *CertifyVulnOrder  `json:"order,omitempty"`
+}
+
+// CommitConnection is a page of the commit history of a repository.
+//
+// The cursors are derived from the author dates of the commits.
+type CommitConnection struct {
+	Edges    []*CommitEdge `json:"edges"`
+	PageInfo *PageInfo     `json:"pageInfo"`
+}
+
+// CommitEdge is a commit of a CommitConnection.
+type CommitEdge struct {
+	Cursor string     `json:"cursor"`
+	Node   *GitCommit `json:"node"`
+}
+
+// ComponentTypeCount is the number of packages of a given component type included
+// in an SBOM.
+//
+// componentType is the lowercased CycloneDX component type or SPDX primary
+// package purpose (e.g., library, application, container, firmware).
+type ComponentTypeCount struct {
+	ComponentType string `json:"componentType"`
+	Count         int    `json:"count"`
+}
+
+// DependencyEdge is a dependency found while walking the dependency graph of a
+// package version.
+type DependencyEdge struct {
+	// ID of the package version that has the dependency
+	From string `json:"from"`
+	// ID of the dependency, the package version or the package name if the dependency is not on a version
+	To string `json:"to"`
+	// The attestation recording the dependency
+	IsDependency *IsDependency `json:"isDependency"`
+}
+
+// DependencyGraph is the transitive closure of the dependencies of a package
+// version.
+//
+// nodes contains the root package version and each of its dependencies once, and
+// edges the dependencies between them.
+type DependencyGraph struct {
+	Nodes []*Package        `json:"nodes"`
+	Edges []*DependencyEdge `json:"edges"`
+}
+
+// ExploitReference is an attestation that a public exploit or proof of concept
+// exists for a vulnerability.
+//
+// The source is the database the exploit was found in and must be one of
+// EXPLOIT_DB, GITHUB_POC or METASPLOIT. It will be enforced to be all uppercase.
+//
+// A vulnerability ID can have many exploit references. A reference is identified
+// by its vulnerability, source and url: ingesting the same reference again
+// updates it instead of creating a new one (e.g., to mark it as verified).
+type ExploitReference struct {
+	ID string `json:"id"`
+	// The vulnerability that the exploit targets
+	Vulnerability *Vulnerability `json:"vulnerability"`
+	// Database the exploit was found in
+	Source string `json:"source"`
+	// Location of the exploit or proof of concept
+	URL string `json:"url"`
+	// True if the exploit has been confirmed to work
+	Verified bool `json:"verified"`
+	// Document from which this attestation is generated from
+	Origin string `json:"origin"`
+	// GUAC collector for the document
+	Collector string `json:"collector"`
+	// Reference location of the document in the persistent blob store (if that is configured)
+	DocumentRef string `json:"documentRef"`
+}
+
+func (ExploitReference) IsNode() {}
+
+// ExploitReferenceInputSpec represents the mutation input to ingest an exploit
+// reference.
+type ExploitReferenceInputSpec struct {
+	Source      string `json:"source"`
+	URL         string `json:"url"`
+	Verified    bool   `json:"verified"`
+	Origin      string `json:"origin"`
+	Collector   string `json:"collector"`
+	DocumentRef string `json:"documentRef"`
+}
+
+// ExploitReferenceSpec allows filtering the list of exploit references to return
+// in a query.
+type ExploitReferenceSpec struct {
+	ID            *string            `json:"id,omitempty"`
+	Vulnerability *VulnerabilitySpec `json:"vulnerability,omitempty"`
+	Source        *string            `json:"source,omitempty"`
+	URL           *string            `json:"url,omitempty"`
+	Verified      *bool              `json:"verified,omitempty"`
+	Origin        *string            `json:"origin,omitempty"`
+	Collector     *string            `json:"collector,omitempty"`
+	DocumentRef   *string            `json:"documentRef,omitempty"`
+}
+
+// GitCommit is a commit of a git repository.
+type GitCommit struct {
+	Sha        string    `json:"sha"`
+	Message    string    `json:"message"`
+	AuthorDate time.Time `json:"authorDate"`
 }
 
 // HasMetadata is an attestation that a package, source, or artifact has a certain
@@ -435,6 +785,29 @@ type HasMetadata struct {
 
 func (HasMetadata) IsNode() {}
 
+// HasMetadataConnection returns the paginated results for HasMetadata.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the HasMetadataEdge which contains the current cursor
+// and the HasMetadata node itself
+type HasMetadataConnection struct {
+	TotalCount int                `json:"totalCount"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	Edges      []*HasMetadataEdge `json:"edges"`
+}
+
+// HasMetadataEdge contains the cursor for the resulting node and
+// the HasMetadata node itself.
+type HasMetadataEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *HasMetadata `json:"node"`
+}
+
 // HasMetadataInputSpec represents the mutation input to ingest a CertifyGood evidence.
 type HasMetadataInputSpec struct {
 	Key           string    `json:"key"`
@@ -499,6 +872,29 @@ type HasSbom struct {
 
 func (HasSbom) IsNode() {}
 
+// HasSBOMConnection returns the paginated results for HasSBOM.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the HasSBOMEdge which contains the current cursor
+// and the HasSBOM node itself
+type HasSBOMConnection struct {
+	TotalCount int            `json:"totalCount"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	Edges      []*HasSBOMEdge `json:"edges"`
+}
+
+// HasSBOMEdge contains the cursor for the resulting node and
+// the HasSBOM node itself.
+type HasSBOMEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *HasSbom `json:"node"`
+}
+
 type HasSBOMIncludesInputSpec struct {
 	Packages     []string `json:"packages"`
 	Artifacts    []string `json:"artifacts"`
@@ -524,6 +920,9 @@ type HasSBOMInputSpec struct {
 //
 // If KnownSince is specified, the returned value will be after or equal to the specified time.
 // Any nodes time that is before KnownSince is excluded.
+//
+// IncludedPackages returns only the SBOMs that include a package version matching
+// each of the specs, to find the SBOMs that include a given package.
 type HasSBOMSpec struct {
 	ID                   *string                  `json:"id,omitempty"`
 	Subject              *PackageOrArtifactSpec   `json:"subject,omitempty"`
@@ -538,6 +937,7 @@ type HasSBOMSpec struct {
 	IncludedSoftware     []*PackageOrArtifactSpec `json:"includedSoftware,omitempty"`
 	IncludedDependencies []*IsDependencySpec      `json:"includedDependencies,omitempty"`
 	IncludedOccurrences  []*IsOccurrenceSpec      `json:"includedOccurrences,omitempty"`
+	IncludedPackages     []*PkgSpec               `json:"includedPackages,omitempty"`
 }
 
 // HasSLSA records that a subject node has a SLSA attestation.
@@ -551,6 +951,29 @@ type HasSlsa struct {
 
 func (HasSlsa) IsNode() {}
 
+// HasSLSAConnection returns the paginated results for HasSLSA.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the HasSLSAEdge which contains the current cursor
+// and the HasSLSA node itself
+type HasSLSAConnection struct {
+	TotalCount int            `json:"totalCount"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	Edges      []*HasSLSAEdge `json:"edges"`
+}
+
+// HasSLSAEdge contains the cursor for the resulting node and
+// the HasSLSA node itself.
+type HasSLSAEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *HasSlsa `json:"node"`
+}
+
 // HasSLSASpec allows filtering the list of HasSLSA to return.
 type HasSLSASpec struct {
 	ID          *string              `json:"id,omitempty"`
@@ -588,6 +1011,29 @@ type HasSourceAt struct {
 
 func (HasSourceAt) IsNode() {}
 
+// HasSourceAtConnection returns the paginated results for HasSourceAt.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the HasSourceAtEdge which contains the current cursor
+// and the HasSourceAt node itself
+type HasSourceAtConnection struct {
+	TotalCount int                `json:"totalCount"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	Edges      []*HasSourceAtEdge `json:"edges"`
+}
+
+// HasSourceAtEdge contains the cursor for the resulting node and
+// the HasSourceAt node itself.
+type HasSourceAtEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *HasSourceAt `json:"node"`
+}
+
 // HasSourceAtInputSpec is the same as HasSourceAt but for mutation input.
 type HasSourceAtInputSpec struct {
 	KnownSince    time.Time `json:"knownSince"`
@@ -597,16 +1043,26 @@ type HasSourceAtInputSpec struct {
 	DocumentRef   string    `json:"documentRef"`
 }
 
+// HasSourceAtOrder sorts HasSourceAt results by a field, ascending by default.
+type HasSourceAtOrder struct {
+	SortBy    HasSourceAtOrderField `json:"sortBy"`
+	SortOrder OrderDirection        `json:"sortOrder"`
+}
+
 // HasSourceAtSpec allows filtering the list of HasSourceAt to return.
+//
+// order sorts the results of the HasSourceAt query. It is ignored by
+// HasSourceAtList, which is always ordered by ID to keep cursors stable.
 type HasSourceAtSpec struct {
-	ID            *string     `json:"id,omitempty"`
-	Package       *PkgSpec    `json:"package,omitempty"`
-	Source        *SourceSpec `json:"source,omitempty"`
-	KnownSince    *time.Time  `json:"knownSince,omitempty"`
-	Justification *string     `json:"justification,omitempty"`
-	Origin        *string     `json:"origin,omitempty"`
-	Collector     *string     `json:"collector,omitempty"`
-	DocumentRef   *string     `json:"documentRef,omitempty"`
+	ID            *string           `json:"id,omitempty"`
+	Package       *PkgSpec          `json:"package,omitempty"`
+	Source        *SourceSpec       `json:"source,omitempty"`
+	KnownSince    *time.Time        `json:"knownSince,omitempty"`
+	Justification *string           `json:"justification,omitempty"`
+	Origin        *string           `json:"origin,omitempty"`
+	Collector     *string           `json:"collector,omitempty"`
+	DocumentRef   *string           `json:"documentRef,omitempty"`
+	Order         *HasSourceAtOrder `json:"order,omitempty"`
 }
 
 // HashEqual is an attestation that a set of artifacts are identical.
@@ -626,6 +1082,29 @@ type HashEqual struct {
 
 func (HashEqual) IsNode() {}
 
+// HashEqualConnection returns the paginated results for HashEqual.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the HashEqualEdge which contains the current cursor
+// and the HashEqual node itself
+type HashEqualConnection struct {
+	TotalCount int              `json:"totalCount"`
+	PageInfo   *PageInfo        `json:"pageInfo"`
+	Edges      []*HashEqualEdge `json:"edges"`
+}
+
+// HashEqualEdge contains the cursor for the resulting node and
+// the HashEqual node itself.
+type HashEqualEdge struct {
+	Cursor string     `json:"cursor"`
+	Node   *HashEqual `json:"node"`
+}
+
 // HashEqualInputSpec represents the input to certify that packages are similar.
 type HashEqualInputSpec struct {
 	Justification string `json:"justification"`
@@ -737,6 +1216,29 @@ type IsDependency struct {
 
 func (IsDependency) IsNode() {}
 
+// IsDependencyConnection returns the paginated results for IsDependency.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the IsDependencyEdge which contains the current cursor
+// and the IsDependency node itself
+type IsDependencyConnection struct {
+	TotalCount int                 `json:"totalCount"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+	Edges      []*IsDependencyEdge `json:"edges"`
+}
+
+// IsDependencyEdge contains the cursor for the resulting node and
+// the IsDependency node itself.
+type IsDependencyEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *IsDependency `json:"node"`
+}
+
 // IsDependencyInputSpec is the input to record a new dependency.
 type IsDependencyInputSpec struct {
 	// versionRange should be specified for depedentPackages that point to PackageName
@@ -787,6 +1289,29 @@ type IsOccurrence struct {
 
 func (IsOccurrence) IsNode() {}
 
+// IsOccurrenceConnection returns the paginated results for IsOccurrence.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the IsOccurrenceEdge which contains the current cursor
+// and the IsOccurrence node itself
+type IsOccurrenceConnection struct {
+	TotalCount int                 `json:"totalCount"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+	Edges      []*IsOccurrenceEdge `json:"edges"`
+}
+
+// IsOccurrenceEdge contains the cursor for the resulting node and
+// the IsOccurrence node itself.
+type IsOccurrenceEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *IsOccurrence `json:"node"`
+}
+
 // IsOccurrenceInputSpec represents the input to record an artifact's origin.
 type IsOccurrenceInputSpec struct {
 	Justification string `json:"justification"`
@@ -841,6 +1366,29 @@ type License struct {
 
 func (License) IsNode() {}
 
+// LicenseConnection returns the paginated results for License.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the LicenseEdge which contains the current cursor
+// and the License node itself
+type LicenseConnection struct {
+	TotalCount int            `json:"totalCount"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	Edges      []*LicenseEdge `json:"edges"`
+}
+
+// LicenseEdge contains the cursor for the resulting node and
+// the License node itself.
+type LicenseEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *License `json:"node"`
+}
+
 // LicenseInputSpec specifies an license for mutations. One of inline or
 // listVersion should be empty or missing.
 type LicenseInputSpec struct {
@@ -893,6 +1441,29 @@ func (Package) IsPackageOrSource() {}
 
 func (Package) IsNode() {}
 
+// PackageConnection returns the paginated results for Package.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the PackageEdge which contains the current cursor
+// and the Package node itself
+type PackageConnection struct {
+	TotalCount int            `json:"totalCount"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	Edges      []*PackageEdge `json:"edges"`
+}
+
+// PackageEdge contains the cursor for the resulting node and
+// the Package node itself.
+type PackageEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *Package `json:"node"`
+}
+
 // The IDs of the ingested package
 type PackageIDs struct {
 	PackageTypeID      string `json:"packageTypeID"`
@@ -977,6 +1548,12 @@ type PackageOrSourceSpec struct {
 	Source  *SourceSpec `json:"source,omitempty"`
 }
 
+// PackageOrder sorts packages by a field, ascending by default.
+type PackageOrder struct {
+	SortBy    PackageOrderField `json:"sortBy"`
+	SortOrder OrderDirection    `json:"sortOrder"`
+}
+
 // PackageQualifier is a qualifier for a package, a key-value pair.
 //
 // In the pURL representation, it is a part of the <qualifiers> part of the
@@ -1064,6 +1641,47 @@ type PackageVersion struct {
 	Version    string              `json:"version"`
 	Qualifiers []*PackageQualifier `json:"qualifiers"`
 	Subpath    string              `json:"subpath"`
+	// Vulnerability certifications of the package version.
+	//
+	// The package field of the filter is ignored.
+	Vulnerabilities []*CertifyVuln `json:"vulnerabilities"`
+	// Most recent scorecard of the source repositories the package version, or
+	// all versions of its package name, is built from. Null if there is no such
+	// source or scorecard.
+	LatestScorecard *CertifyScorecard `json:"latestScorecard,omitempty"`
+}
+
+// PackageVulnCount is the number of vulnerability certifications of a package
+// version.
+type PackageVulnCount struct {
+	Package *Package `json:"package"`
+	Count   int      `json:"count"`
+}
+
+// PageInfo describes the window of results returned by a list query.
+//
+// startCursor and endCursor are the cursors of the first and last edges in the
+// page. They are null when the page is empty.
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+}
+
+// PaginationSpec selects a window of results from a list query.
+//
+// Cursors are opaque IDs returned in the pageInfo and edges of a previous page.
+// Use first and after to page forward, or last and before to page backward.
+// first and last cannot be specified together and must not be negative.
+//
+// If neither first nor last is specified, the backend returns at most its
+// maximum page size of results.
+type PaginationSpec struct {
+	First  *int    `json:"first,omitempty"`
+	After  *string `json:"after,omitempty"`
+	Last   *int    `json:"last,omitempty"`
+	Before *string `json:"before,omitempty"`
 }
 
 // PkgEqual is an attestation that a set of packages are similar.
@@ -1083,6 +1701,29 @@ type PkgEqual struct {
 
 func (PkgEqual) IsNode() {}
 
+// PkgEqualConnection returns the paginated results for PkgEqual.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the PkgEqualEdge which contains the current cursor
+// and the PkgEqual node itself
+type PkgEqualConnection struct {
+	TotalCount int             `json:"totalCount"`
+	PageInfo   *PageInfo       `json:"pageInfo"`
+	Edges      []*PkgEqualEdge `json:"edges"`
+}
+
+// PkgEqualEdge contains the cursor for the resulting node and
+// the PkgEqual node itself.
+type PkgEqualEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *PkgEqual `json:"node"`
+}
+
 // PkgEqualInputSpec represents the input to certify that packages are similar.
 type PkgEqualInputSpec struct {
 	Justification string `json:"justification"`
@@ -1109,13 +1750,19 @@ type PkgEqualSpec struct {
 //
 // This is different than PkgSpec because we want to encode mandatory fields:
 // type and name. All optional fields are given empty default values.
+//
+// componentType optionally records the kind of component the package version is
+// (e.g., library, application, container) as reported by the SBOM it was found
+// in. It is only stored when the package version is first ingested and is used
+// to compute SBOM composition breakdowns.
 type PkgInputSpec struct {
-	Type       string                       `json:"type"`
-	Namespace  *string                      `json:"namespace,omitempty"`
-	Name       string                       `json:"name"`
-	Version    *string                      `json:"version,omitempty"`
-	Qualifiers []*PackageQualifierInputSpec `json:"qualifiers,omitempty"`
-	Subpath    *string                      `json:"subpath,omitempty"`
+	Type          string                       `json:"type"`
+	Namespace     *string                      `json:"namespace,omitempty"`
+	Name          string                       `json:"name"`
+	Version       *string                      `json:"version,omitempty"`
+	Qualifiers    []*PackageQualifierInputSpec `json:"qualifiers,omitempty"`
+	Subpath       *string                      `json:"subpath,omitempty"`
+	ComponentType *string                      `json:"componentType,omitempty"`
 }
 
 // PkgSpec allows filtering the list of sources to return in a query.
@@ -1133,6 +1780,10 @@ type PkgInputSpec struct {
 // we must also return the same set of nodes it the qualifiers list is empty. To
 // match on nodes that don't contain any qualifier, set matchOnlyEmptyQualifiers
 // to true. If this field is true, then the qualifiers argument is ignored.
+//
+// order sorts the package versions returned by the packages query. It is ignored
+// by packagesList, which is always ordered by ID to keep cursors stable, and when
+// the PkgSpec is nested in the filter of another query.
 type PkgSpec struct {
 	ID                       *string                 `json:"id,omitempty"`
 	Type                     *string                 `json:"type,omitempty"`
@@ -1142,6 +1793,7 @@ type PkgSpec struct {
 	Qualifiers               []*PackageQualifierSpec `json:"qualifiers,omitempty"`
 	MatchOnlyEmptyQualifiers *bool                   `json:"matchOnlyEmptyQualifiers,omitempty"`
 	Subpath                  *string                 `json:"subpath,omitempty"`
+	Order                    *PackageOrder           `json:"order,omitempty"`
 }
 
 // PointOfContact is an attestation of how to get in touch with the person(s) responsible
@@ -1187,6 +1839,29 @@ type PointOfContact struct {
 
 func (PointOfContact) IsNode() {}
 
+// PointOfContactConnection returns the paginated results for PointOfContact.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the PointOfContactEdge which contains the current cursor
+// and the PointOfContact node itself
+type PointOfContactConnection struct {
+	TotalCount int                   `json:"totalCount"`
+	PageInfo   *PageInfo             `json:"pageInfo"`
+	Edges      []*PointOfContactEdge `json:"edges"`
+}
+
+// PointOfContactEdge contains the cursor for the resulting node and
+// the PointOfContact node itself.
+type PointOfContactEdge struct {
+	Cursor string          `json:"cursor"`
+	Node   *PointOfContact `json:"node"`
+}
+
 // PointOfContactInputSpec represents the mutation input to ingest a PointOfContact evidence.
 type PointOfContactInputSpec struct {
 	Email         string    `json:"email"`
@@ -1221,9 +1896,34 @@ type PointOfContactSpec struct {
 	DocumentRef   *string                      `json:"documentRef,omitempty"`
 }
 
+// ProvenanceSummary gathers the supply chain attestations recorded for a
+// package.
+//
+// hasSLSA contains the SLSA attestations of the artifacts that are occurrences of
+// the package.
+type ProvenanceSummary struct {
+	HasSbom      []*HasSbom      `json:"hasSBOM"`
+	HasSlsa      []*HasSlsa      `json:"hasSLSA"`
+	HasSourceAt  []*HasSourceAt  `json:"hasSourceAt"`
+	CertifyVulns []*CertifyVuln  `json:"certifyVulns"`
+	CertifyLegal []*CertifyLegal `json:"certifyLegal"`
+	IsOccurrence []*IsOccurrence `json:"isOccurrence"`
+}
+
 type Query struct {
 }
 
+// SBOMDiff compares the packages included in two SBOMs. Packages are matched by
+// purl.
+type SBOMDiff struct {
+	// Packages included in the second SBOM only
+	Added []*Package `json:"added"`
+	// Packages included in the first SBOM only
+	Removed []*Package `json:"removed"`
+	// Packages included in both SBOMs
+	Unchanged []*Package `json:"unchanged"`
+}
+
 // SLSA contains all of the fields present in a SLSA attestation.
 //
 // The materials and builders are objects of the HasSLSA predicate, everything
@@ -1331,6 +2031,8 @@ type ScanMetadata struct {
 	Collector string `json:"collector"`
 	// Reference location of the document in the persistent blob store (if that is configured)
 	DocumentRef string `json:"documentRef"`
+	// CVSS score of the vulnerability as reported by the scanner, if known
+	CVSSScore *float64 `json:"cvssScore,omitempty"`
 }
 
 // ScanMetadataInput represents the input for certifying vulnerability
@@ -1344,6 +2046,20 @@ type ScanMetadataInput struct {
 	Origin         string    `json:"origin"`
 	Collector      string    `json:"collector"`
 	DocumentRef    string    `json:"documentRef"`
+	CVSSScore      *float64  `json:"cvssScore,omitempty"`
+}
+
+// ScannerFreshnessResult reports how recent the vulnerability data produced by a
+// scanner is.
+type ScannerFreshnessResult struct {
+	// Time of the most recent scan by the scanner (in RFC 3339 format)
+	LatestScanTime time.Time `json:"latestScanTime"`
+	// Version of the vulnerability database used in the most recent scan
+	DbVersion string `json:"dbVersion"`
+	// True if the most recent scan is not older than the requested maximum age
+	IsFresh bool `json:"isFresh"`
+	// Number of certifications from the scanner that are older than the requested maximum age
+	StaleScanCount int `json:"staleScanCount"`
 }
 
 // Scorecard contains all of the fields present in a Scorecard attestation.
@@ -1438,6 +2154,29 @@ func (Source) IsPackageOrSource() {}
 
 func (Source) IsNode() {}
 
+// SourceConnection returns the paginated results for Source.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the SourceEdge which contains the current cursor
+// and the Source node itself
+type SourceConnection struct {
+	TotalCount int           `json:"totalCount"`
+	PageInfo   *PageInfo     `json:"pageInfo"`
+	Edges      []*SourceEdge `json:"edges"`
+}
+
+// SourceEdge contains the cursor for the resulting node and
+// the Source node itself.
+type SourceEdge struct {
+	Cursor string  `json:"cursor"`
+	Node   *Source `json:"node"`
+}
+
 // The IDs of the ingested source
 type SourceIDs struct {
 	SourceTypeID      string `json:"sourceTypeID"`
@@ -1472,6 +2211,17 @@ type SourceName struct {
 	Name   string  `json:"name"`
 	Tag    *string `json:"tag,omitempty"`
 	Commit *string `json:"commit,omitempty"`
+	// The limit most recent commits of the repository, fetched from the GitHub API.
+	//
+	// Only set for git sources hosted on github.com. Null if the API can not be
+	// reached.
+	RecentCommits []*GitCommit `json:"recentCommits,omitempty"`
+	// The commit history of the repository, newest first, fetched from the GitHub
+	// API a page at a time.
+	//
+	// first is the number of commits to return and after the endCursor of the
+	// previous page. Empty for sources not hosted on github.com.
+	CommitHistory *CommitConnection `json:"commitHistory"`
 }
 
 // SourceNamespace is a namespace for sources.
@@ -1485,6 +2235,12 @@ type SourceNamespace struct {
 	Names     []*SourceName `json:"names"`
 }
 
+// SourceOrder sorts sources by a field, ascending by default.
+type SourceOrder struct {
+	SortBy    SourceOrderField `json:"sortBy"`
+	SortOrder OrderDirection   `json:"sortOrder"`
+}
+
 // SourceSpec allows filtering the list of sources to return in a query.
 //
 // Empty string at a field means matching with the empty string. Missing field
@@ -1493,13 +2249,31 @@ type SourceNamespace struct {
 // It is an error to specify both tag and commit fields, except it both are set as
 // empty string (in which case the returned sources are only those for which there
 // is no tag/commit information).
+//
+// namePrefix matches the source names starting with the given string. nameGlob
+// matches the source names against a glob pattern, where "*" matches any sequence
+// of characters and "?" matches any single character (e.g. "guac-*" or "*-sdk").
+// nameContains and nameSuffix match the source names containing or ending with
+// the given string. All of these can be combined with each other and with name.
+//
+// order sorts the source names returned by the sources query. It is ignored by
+// sourcesList, which is always ordered by ID to keep cursors stable, and when the
+// SourceSpec is nested in the filter of another query.
 type SourceSpec struct {
-	ID        *string `json:"id,omitempty"`
-	Type      *string `json:"type,omitempty"`
-	Namespace *string `json:"namespace,omitempty"`
-	Name      *string `json:"name,omitempty"`
-	Tag       *string `json:"tag,omitempty"`
-	Commit    *string `json:"commit,omitempty"`
+	ID           *string      `json:"id,omitempty"`
+	Type         *string      `json:"type,omitempty"`
+	Namespace    *string      `json:"namespace,omitempty"`
+	Name         *string      `json:"name,omitempty"`
+	NamePrefix   *string      `json:"namePrefix,omitempty"`
+	NameGlob     *string      `json:"nameGlob,omitempty"`
+	NameContains *string      `json:"nameContains,omitempty"`
+	NameSuffix   *string      `json:"nameSuffix,omitempty"`
+	Tag          *string      `json:"tag,omitempty"`
+	Commit       *string      `json:"commit,omitempty"`
+	Order        *SourceOrder `json:"order,omitempty"`
+}
+
+type Subscription struct {
 }
 
 // VexStatementInputSpec represents the input to ingest VEX statements.
@@ -1533,6 +2307,29 @@ type VulnEqual struct {
 
 func (VulnEqual) IsNode() {}
 
+// VulnEqualConnection returns the paginated results for VulnEqual.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the VulnEqualEdge which contains the current cursor
+// and the VulnEqual node itself
+type VulnEqualConnection struct {
+	TotalCount int              `json:"totalCount"`
+	PageInfo   *PageInfo        `json:"pageInfo"`
+	Edges      []*VulnEqualEdge `json:"edges"`
+}
+
+// VulnEqualEdge contains the cursor for the resulting node and
+// the VulnEqual node itself.
+type VulnEqualEdge struct {
+	Cursor string     `json:"cursor"`
+	Node   *VulnEqual `json:"node"`
+}
+
 // VulnEqualInputSpec represents the input to link vulnerabilities to each other.
 type VulnEqualInputSpec struct {
 	Justification string `json:"justification"`
@@ -1581,10 +2378,43 @@ type Vulnerability struct {
 	ID               string             `json:"id"`
 	Type             string             `json:"type"`
 	VulnerabilityIDs []*VulnerabilityID `json:"vulnerabilityIDs"`
+	// Public exploits known for any of the vulnerability IDs
+	ExploitReferences []*ExploitReference `json:"exploitReferences"`
+	// Certifications of packages affected by any of the vulnerability IDs.
+	//
+	// The vulnerability field of the filter is ignored.
+	CertifyVulns []*CertifyVuln `json:"certifyVulns"`
+	// True if a VEX statement about any of the vulnerability IDs, for a package
+	// matching pkgSpec if set, has the FIXED status, false if only other statuses
+	// were stated. Null if there is no VEX statement.
+	IsFixed *bool `json:"isFixed,omitempty"`
 }
 
 func (Vulnerability) IsNode() {}
 
+// VulnerabilityConnection returns the paginated results for Vulnerability.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the VulnerabilityEdge which contains the current cursor
+// and the Vulnerability node itself
+type VulnerabilityConnection struct {
+	TotalCount int                  `json:"totalCount"`
+	PageInfo   *PageInfo            `json:"pageInfo"`
+	Edges      []*VulnerabilityEdge `json:"edges"`
+}
+
+// VulnerabilityEdge contains the cursor for the resulting node and
+// the Vulnerability node itself.
+type VulnerabilityEdge struct {
+	Cursor string         `json:"cursor"`
+	Node   *Vulnerability `json:"node"`
+}
+
 // VulnerabilityID is a specific vulnerability ID associated with the type of the vulnerability.
 //
 // This will be enforced to be all lowercase.
@@ -1651,6 +2481,29 @@ type VulnerabilityMetadata struct {
 
 func (VulnerabilityMetadata) IsNode() {}
 
+// VulnerabilityMetadataConnection returns the paginated results for VulnerabilityMetadata.
+//
+// totalCount is the total number of results matching the filter across all pages.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the VulnerabilityMetadataEdge which contains the current cursor
+// and the VulnerabilityMetadata node itself
+type VulnerabilityMetadataConnection struct {
+	TotalCount int                          `json:"totalCount"`
+	PageInfo   *PageInfo                    `json:"pageInfo"`
+	Edges      []*VulnerabilityMetadataEdge `json:"edges"`
+}
+
+// VulnerabilityMetadataEdge contains the cursor for the resulting node and
+// the VulnerabilityMetadata node itself.
+type VulnerabilityMetadataEdge struct {
+	Cursor string                 `json:"cursor"`
+	Node   *VulnerabilityMetadata `json:"node"`
+}
+
 // VulnerabilityMetadataInputSpec represents the mutation input to ingest a vulnerability metadata.
 type VulnerabilityMetadataInputSpec struct {
 	ScoreType   VulnerabilityScoreType `json:"scoreType"`
@@ -1698,6 +2551,50 @@ type VulnerabilitySpec struct {
 	NoVuln          *bool   `json:"noVuln,omitempty"`
 }
 
+// CertifyVulnOrderField is the field CertifyVuln results are sorted on.
+type CertifyVulnOrderField string
+
+const (
+	CertifyVulnOrderFieldTimeScanned    CertifyVulnOrderField = "TIME_SCANNED"
+	CertifyVulnOrderFieldScannerVersion CertifyVulnOrderField = "SCANNER_VERSION"
+	CertifyVulnOrderFieldDbVersion      CertifyVulnOrderField = "DB_VERSION"
+)
+
+var AllCertifyVulnOrderField = []CertifyVulnOrderField{
+	CertifyVulnOrderFieldTimeScanned,
+	CertifyVulnOrderFieldScannerVersion,
+	CertifyVulnOrderFieldDbVersion,
+}
+
+func (e CertifyVulnOrderField) IsValid() bool {
+	switch e {
+	case CertifyVulnOrderFieldTimeScanned, CertifyVulnOrderFieldScannerVersion, CertifyVulnOrderFieldDbVersion:
+		return true
+	}
+	return false
+}
+
+func (e CertifyVulnOrderField) String() string {
+	return string(e)
+}
+
+func (e *CertifyVulnOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CertifyVulnOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CertifyVulnOrderField", str)
+	}
+	return nil
+}
+
+func (e CertifyVulnOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // The Comparator is used by the vulnerability score filter on ranges
 type Comparator string
 
@@ -1850,6 +2747,7 @@ const (
 	EdgeSourceTypeSourceNamespace        Edge = "SOURCE_TYPE_SOURCE_NAMESPACE"
 	EdgeVulnerabilityCertifyVexStatement Edge = "VULNERABILITY_CERTIFY_VEX_STATEMENT"
 	EdgeVulnerabilityCertifyVuln         Edge = "VULNERABILITY_CERTIFY_VULN"
+	EdgeVulnerabilityExploitReference    Edge = "VULNERABILITY_EXPLOIT_REFERENCE"
 	EdgeVulnerabilityIDVulnerabilityType Edge = "VULNERABILITY_ID_VULNERABILITY_TYPE"
 	EdgeVulnerabilityTypeVulnerabilityID Edge = "VULNERABILITY_TYPE_VULNERABILITY_ID"
 	EdgeVulnerabilityVulnEqual           Edge = "VULNERABILITY_VULN_EQUAL"
@@ -1869,6 +2767,7 @@ const (
 	EdgeCertifyVexStatementVulnerability Edge = "CERTIFY_VEX_STATEMENT_VULNERABILITY"
 	EdgeCertifyVulnPackage               Edge = "CERTIFY_VULN_PACKAGE"
 	EdgeCertifyVulnVulnerability         Edge = "CERTIFY_VULN_VULNERABILITY"
+	EdgeExploitReferenceVulnerability    Edge = "EXPLOIT_REFERENCE_VULNERABILITY"
 	EdgeHashEqualArtifact                Edge = "HASH_EQUAL_ARTIFACT"
 	EdgeHasMetadataArtifact              Edge = "HAS_METADATA_ARTIFACT"
 	EdgeHasMetadataPackage               Edge = "HAS_METADATA_PACKAGE"
@@ -1939,6 +2838,7 @@ var AllEdge = []Edge{
 	EdgeSourceTypeSourceNamespace,
 	EdgeVulnerabilityCertifyVexStatement,
 	EdgeVulnerabilityCertifyVuln,
+	EdgeVulnerabilityExploitReference,
 	EdgeVulnerabilityIDVulnerabilityType,
 	EdgeVulnerabilityTypeVulnerabilityID,
 	EdgeVulnerabilityVulnEqual,
@@ -1958,6 +2858,7 @@ var AllEdge = []Edge{
 	EdgeCertifyVexStatementVulnerability,
 	EdgeCertifyVulnPackage,
 	EdgeCertifyVulnVulnerability,
+	EdgeExploitReferenceVulnerability,
 	EdgeHashEqualArtifact,
 	EdgeHasMetadataArtifact,
 	EdgeHasMetadataPackage,
@@ -1986,7 +2887,7 @@ var AllEdge = []Edge{
 
 func (e Edge) IsValid() bool {
 	switch e {
-	case EdgeArtifactCertifyBad, EdgeArtifactCertifyGood, EdgeArtifactCertifyVexStatement, EdgeArtifactHashEqual, EdgeArtifactHasMetadata, EdgeArtifactHasSbom, EdgeArtifactHasSlsa, EdgeArtifactIsOccurrence, EdgeArtifactPointOfContact, EdgeBuilderHasSlsa, EdgeLicenseCertifyLegal, EdgePackageCertifyBad, EdgePackageCertifyGood, EdgePackageCertifyLegal, EdgePackageCertifyVexStatement, EdgePackageCertifyVuln, EdgePackageHasMetadata, EdgePackageHasSbom, EdgePackageHasSourceAt, EdgePackageIsDependency, EdgePackageIsOccurrence, EdgePackageNamePackageNamespace, EdgePackageNamePackageVersion, EdgePackageNamespacePackageName, EdgePackageNamespacePackageType, EdgePackagePkgEqual, EdgePackagePointOfContact, EdgePackageTypePackageNamespace, EdgePackageVersionPackageName, EdgeSourceCertifyBad, EdgeSourceCertifyGood, EdgeSourceCertifyLegal, EdgeSourceCertifyScorecard, EdgeSourceHasMetadata, EdgeSourceHasSourceAt, EdgeSourceIsOccurrence, EdgeSourceNameSourceNamespace, EdgeSourceNamespaceSourceName, EdgeSourceNamespaceSourceType, EdgeSourcePointOfContact, EdgeSourceTypeSourceNamespace, EdgeVulnerabilityCertifyVexStatement, EdgeVulnerabilityCertifyVuln, EdgeVulnerabilityIDVulnerabilityType, EdgeVulnerabilityTypeVulnerabilityID, EdgeVulnerabilityVulnEqual, EdgeVulnerabilityVulnMetadata, EdgeCertifyBadArtifact, EdgeCertifyBadPackage, EdgeCertifyBadSource, EdgeCertifyGoodArtifact, EdgeCertifyGoodPackage, EdgeCertifyGoodSource, EdgeCertifyLegalLicense, EdgeCertifyLegalPackage, EdgeCertifyLegalSource, EdgeCertifyScorecardSource, EdgeCertifyVexStatementArtifact, EdgeCertifyVexStatementPackage, EdgeCertifyVexStatementVulnerability, EdgeCertifyVulnPackage, EdgeCertifyVulnVulnerability, EdgeHashEqualArtifact, EdgeHasMetadataArtifact, EdgeHasMetadataPackage, EdgeHasMetadataSource, EdgeHasSbomArtifact, EdgeHasSbomPackage, EdgeHasSbomIncludedSoftware, EdgeHasSbomIncludedDependencies, EdgeHasSbomIncludedOccurrences, EdgeHasSlsaBuiltBy, EdgeHasSlsaMaterials, EdgeHasSlsaSubject, EdgeHasSourceAtPackage, EdgeHasSourceAtSource, EdgeIsDependencyPackage, EdgeIsOccurrenceArtifact, EdgeIsOccurrencePackage, EdgeIsOccurrenceSource, EdgePkgEqualPackage, EdgePointOfContactArtifact, EdgePointOfContactPackage, EdgePointOfContactSource, EdgeVulnEqualVulnerability, EdgeVulnMetadataVulnerability:
+	case EdgeArtifactCertifyBad, EdgeArtifactCertifyGood, EdgeArtifactCertifyVexStatement, EdgeArtifactHashEqual, EdgeArtifactHasMetadata, EdgeArtifactHasSbom, EdgeArtifactHasSlsa, EdgeArtifactIsOccurrence, EdgeArtifactPointOfContact, EdgeBuilderHasSlsa, EdgeLicenseCertifyLegal, EdgePackageCertifyBad, EdgePackageCertifyGood, EdgePackageCertifyLegal, EdgePackageCertifyVexStatement, EdgePackageCertifyVuln, EdgePackageHasMetadata, EdgePackageHasSbom, EdgePackageHasSourceAt, EdgePackageIsDependency, EdgePackageIsOccurrence, EdgePackageNamePackageNamespace, EdgePackageNamePackageVersion, EdgePackageNamespacePackageName, EdgePackageNamespacePackageType, EdgePackagePkgEqual, EdgePackagePointOfContact, EdgePackageTypePackageNamespace, EdgePackageVersionPackageName, EdgeSourceCertifyBad, EdgeSourceCertifyGood, EdgeSourceCertifyLegal, EdgeSourceCertifyScorecard, EdgeSourceHasMetadata, EdgeSourceHasSourceAt, EdgeSourceIsOccurrence, EdgeSourceNameSourceNamespace, EdgeSourceNamespaceSourceName, EdgeSourceNamespaceSourceType, EdgeSourcePointOfContact, EdgeSourceTypeSourceNamespace, EdgeVulnerabilityCertifyVexStatement, EdgeVulnerabilityCertifyVuln, EdgeVulnerabilityExploitReference, EdgeVulnerabilityIDVulnerabilityType, EdgeVulnerabilityTypeVulnerabilityID, EdgeVulnerabilityVulnEqual, EdgeVulnerabilityVulnMetadata, EdgeCertifyBadArtifact, EdgeCertifyBadPackage, EdgeCertifyBadSource, EdgeCertifyGoodArtifact, EdgeCertifyGoodPackage, EdgeCertifyGoodSource, EdgeCertifyLegalLicense, EdgeCertifyLegalPackage, EdgeCertifyLegalSource, EdgeCertifyScorecardSource, EdgeCertifyVexStatementArtifact, EdgeCertifyVexStatementPackage, EdgeCertifyVexStatementVulnerability, EdgeCertifyVulnPackage, EdgeCertifyVulnVulnerability, EdgeExploitReferenceVulnerability, EdgeHashEqualArtifact, EdgeHasMetadataArtifact, EdgeHasMetadataPackage, EdgeHasMetadataSource, EdgeHasSbomArtifact, EdgeHasSbomPackage, EdgeHasSbomIncludedSoftware, EdgeHasSbomIncludedDependencies, EdgeHasSbomIncludedOccurrences, EdgeHasSlsaBuiltBy, EdgeHasSlsaMaterials, EdgeHasSlsaSubject, EdgeHasSourceAtPackage, EdgeHasSourceAtSource, EdgeIsDependencyPackage, EdgeIsOccurrenceArtifact, EdgeIsOccurrencePackage, EdgeIsOccurrenceSource, EdgePkgEqualPackage, EdgePointOfContactArtifact, EdgePointOfContactPackage, EdgePointOfContactSource, EdgeVulnEqualVulnerability, EdgeVulnMetadataVulnerability:
 		return true
 	}
 	return false
@@ -2054,6 +2955,136 @@ func (e FilterOperation) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// HasSourceAtOrderField is the field HasSourceAt results are sorted on.
+type HasSourceAtOrderField string
+
+const (
+	HasSourceAtOrderFieldKnownSince    HasSourceAtOrderField = "KNOWN_SINCE"
+	HasSourceAtOrderFieldJustification HasSourceAtOrderField = "JUSTIFICATION"
+)
+
+var AllHasSourceAtOrderField = []HasSourceAtOrderField{
+	HasSourceAtOrderFieldKnownSince,
+	HasSourceAtOrderFieldJustification,
+}
+
+func (e HasSourceAtOrderField) IsValid() bool {
+	switch e {
+	case HasSourceAtOrderFieldKnownSince, HasSourceAtOrderFieldJustification:
+		return true
+	}
+	return false
+}
+
+func (e HasSourceAtOrderField) String() string {
+	return string(e)
+}
+
+func (e *HasSourceAtOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = HasSourceAtOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid HasSourceAtOrderField", str)
+	}
+	return nil
+}
+
+func (e HasSourceAtOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// OrderDirection selects whether results are sorted in ascending or descending order.
+type OrderDirection string
+
+const (
+	OrderDirectionAsc  OrderDirection = "ASC"
+	OrderDirectionDesc OrderDirection = "DESC"
+)
+
+var AllOrderDirection = []OrderDirection{
+	OrderDirectionAsc,
+	OrderDirectionDesc,
+}
+
+func (e OrderDirection) IsValid() bool {
+	switch e {
+	case OrderDirectionAsc, OrderDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e OrderDirection) String() string {
+	return string(e)
+}
+
+func (e *OrderDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderDirection", str)
+	}
+	return nil
+}
+
+func (e OrderDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// PackageOrderField is the field package versions are sorted on.
+type PackageOrderField string
+
+const (
+	PackageOrderFieldType      PackageOrderField = "TYPE"
+	PackageOrderFieldNamespace PackageOrderField = "NAMESPACE"
+	PackageOrderFieldName      PackageOrderField = "NAME"
+	PackageOrderFieldVersion   PackageOrderField = "VERSION"
+)
+
+var AllPackageOrderField = []PackageOrderField{
+	PackageOrderFieldType,
+	PackageOrderFieldNamespace,
+	PackageOrderFieldName,
+	PackageOrderFieldVersion,
+}
+
+func (e PackageOrderField) IsValid() bool {
+	switch e {
+	case PackageOrderFieldType, PackageOrderFieldNamespace, PackageOrderFieldName, PackageOrderFieldVersion:
+		return true
+	}
+	return false
+}
+
+func (e PackageOrderField) String() string {
+	return string(e)
+}
+
+func (e *PackageOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PackageOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PackageOrderField", str)
+	}
+	return nil
+}
+
+func (e PackageOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // PkgMatchType is an enum to determine if the attestation should be done at the
 // specific version or package name.
 type PkgMatchType string
@@ -2097,6 +3128,141 @@ func (e PkgMatchType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// RemediationStatus is the triage state of a vulnerability certification.
+//
+// Findings are OPEN when ingested and are moved to STALE once their scan is too
+// old to be trusted, either by markStaleVulns or by the server's background job.
+type RemediationStatus string
+
+const (
+	// finding is current
+	RemediationStatusOpen RemediationStatus = "OPEN"
+	// finding is from a scan that is too old and should be rescanned
+	RemediationStatusStale RemediationStatus = "STALE"
+)
+
+var AllRemediationStatus = []RemediationStatus{
+	RemediationStatusOpen,
+	RemediationStatusStale,
+}
+
+func (e RemediationStatus) IsValid() bool {
+	switch e {
+	case RemediationStatusOpen, RemediationStatusStale:
+		return true
+	}
+	return false
+}
+
+func (e RemediationStatus) String() string {
+	return string(e)
+}
+
+func (e *RemediationStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RemediationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RemediationStatus", str)
+	}
+	return nil
+}
+
+func (e RemediationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// SourceOrderField is the field source names are sorted on.
+type SourceOrderField string
+
+const (
+	SourceOrderFieldType      SourceOrderField = "TYPE"
+	SourceOrderFieldNamespace SourceOrderField = "NAMESPACE"
+	SourceOrderFieldName      SourceOrderField = "NAME"
+)
+
+var AllSourceOrderField = []SourceOrderField{
+	SourceOrderFieldType,
+	SourceOrderFieldNamespace,
+	SourceOrderFieldName,
+}
+
+func (e SourceOrderField) IsValid() bool {
+	switch e {
+	case SourceOrderFieldType, SourceOrderFieldNamespace, SourceOrderFieldName:
+		return true
+	}
+	return false
+}
+
+func (e SourceOrderField) String() string {
+	return string(e)
+}
+
+func (e *SourceOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SourceOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SourceOrderField", str)
+	}
+	return nil
+}
+
+func (e SourceOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// SubjectType is the kind of node a certification is attached to.
+type SubjectType string
+
+const (
+	SubjectTypePackage  SubjectType = "PACKAGE"
+	SubjectTypeSource   SubjectType = "SOURCE"
+	SubjectTypeArtifact SubjectType = "ARTIFACT"
+)
+
+var AllSubjectType = []SubjectType{
+	SubjectTypePackage,
+	SubjectTypeSource,
+	SubjectTypeArtifact,
+}
+
+func (e SubjectType) IsValid() bool {
+	switch e {
+	case SubjectTypePackage, SubjectTypeSource, SubjectTypeArtifact:
+		return true
+	}
+	return false
+}
+
+func (e SubjectType) String() string {
+	return string(e)
+}
+
+func (e *SubjectType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SubjectType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SubjectType", str)
+	}
+	return nil
+}
+
+func (e SubjectType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Records the justification included in the VEX statement.
 type VexJustification string
 
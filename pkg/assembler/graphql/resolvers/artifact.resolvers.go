@@ -23,9 +23,23 @@ func (r *mutationResolver) IngestArtifacts(ctx context.Context, artifacts []*mod
 
 // Artifacts is the resolver for the artifacts field.
 func (r *queryResolver) Artifacts(ctx context.Context, artifactSpec model.ArtifactSpec) ([]*model.Artifact, error) {
+	if err := validateArtifactSpec(artifactSpec); err != nil {
+		return nil, err
+	}
 	return r.Backend.Artifacts(ctx, &artifactSpec)
 }
 
+// ArtifactsList is the resolver for the artifactsList field.
+func (r *queryResolver) ArtifactsList(ctx context.Context, artifactSpec model.ArtifactSpec, pagination *model.PaginationSpec) (*model.ArtifactConnection, error) {
+	if err := validatePaginationSpec("ArtifactsList", pagination); err != nil {
+		return nil, err
+	}
+	if err := validateArtifactSpec(artifactSpec); err != nil {
+		return nil, err
+	}
+	return r.Backend.ArtifactsList(ctx, artifactSpec, pagination)
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
@@ -0,0 +1,83 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+)
+
+func TestArtifacts(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Query       model.ArtifactSpec
+		ExpQueryErr bool
+	}{
+		{
+			Name:  "No prefix",
+			Query: model.ArtifactSpec{Algorithm: ptrfrom.String("sha256")},
+		},
+		{
+			Name:  "Lowercase prefix",
+			Query: model.ArtifactSpec{DigestPrefix: ptrfrom.String("6bbb0da1")},
+		},
+		{
+			Name:  "Uppercase prefix",
+			Query: model.ArtifactSpec{Algorithm: ptrfrom.String("sha1"), DigestPrefix: ptrfrom.String("7A8F")},
+		},
+		{
+			Name:        "Empty prefix",
+			Query:       model.ArtifactSpec{DigestPrefix: ptrfrom.String("")},
+			ExpQueryErr: true,
+		},
+		{
+			Name:        "Non hex prefix",
+			Query:       model.ArtifactSpec{DigestPrefix: ptrfrom.String("xyz")},
+			ExpQueryErr: true,
+		},
+		{
+			Name:        "Prefix with algorithm",
+			Query:       model.ArtifactSpec{DigestPrefix: ptrfrom.String("sha256:6bbb")},
+			ExpQueryErr: true,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpQueryErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				Artifacts(ctx, &test.Query).
+				Times(times)
+			_, err := r.Query().Artifacts(ctx, test.Query)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+		})
+	}
+}
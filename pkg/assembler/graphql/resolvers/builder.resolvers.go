@@ -24,3 +24,11 @@ func (r *mutationResolver) IngestBuilders(ctx context.Context, builders []*model
 func (r *queryResolver) Builders(ctx context.Context, builderSpec model.BuilderSpec) ([]*model.Builder, error) {
 	return r.Backend.Builders(ctx, &builderSpec)
 }
+
+// BuildersList is the resolver for the buildersList field.
+func (r *queryResolver) BuildersList(ctx context.Context, builderSpec model.BuilderSpec, pagination *model.PaginationSpec) (*model.BuilderConnection, error) {
+	if err := validatePaginationSpec("BuildersList", pagination); err != nil {
+		return nil, err
+	}
+	return r.Backend.BuildersList(ctx, builderSpec, pagination)
+}
@@ -7,6 +7,7 @@ package resolvers
 import (
 	"context"
 
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -15,10 +16,10 @@ import (
 func (r *mutationResolver) IngestCertifyBad(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType model.MatchFlags, certifyBad model.CertifyBadInputSpec) (string, error) {
 	funcName := "IngestCertifyBad"
 	if err := validatePackageSourceOrArtifactInput(&subject, funcName); err != nil {
-		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+		return "", errext.Errorf("%v ::  %s", funcName, err)
 	}
 	if certifyBad.KnownSince.IsZero() {
-		return "", gqlerror.Errorf("certifyBad.KnownSince is a zero time")
+		return "", errext.WithCode(gqlerror.Errorf("certifyBad.KnownSince is a zero time"), errext.ErrInvalidInput)
 	}
 	return r.Backend.IngestCertifyBad(ctx, subject, &pkgMatchType, certifyBad)
 }
@@ -30,29 +31,29 @@ func (r *mutationResolver) IngestCertifyBads(ctx context.Context, subjects model
 	ingestedCertifyBadsIDS := []string{}
 	if len(subjects.Packages) > 0 {
 		if len(subjects.Packages) != len(certifyBads) {
-			return ingestedCertifyBadsIDS, gqlerror.Errorf("%v :: uneven packages and certifyBads for ingestion", funcName)
+			return ingestedCertifyBadsIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven packages and certifyBads for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if len(subjects.Artifacts) > 0 {
 		if len(subjects.Artifacts) != len(certifyBads) {
-			return ingestedCertifyBadsIDS, gqlerror.Errorf("%v :: uneven artifacts and certifyBads for ingestion", funcName)
+			return ingestedCertifyBadsIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven artifacts and certifyBads for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if len(subjects.Sources) > 0 {
 		if len(subjects.Sources) != len(certifyBads) {
-			return ingestedCertifyBadsIDS, gqlerror.Errorf("%v :: uneven sources and certifyBads for ingestion", funcName)
+			return ingestedCertifyBadsIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven sources and certifyBads for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if valuesDefined != 1 {
-		return ingestedCertifyBadsIDS, gqlerror.Errorf("%v :: must specify at most packages, artifacts or sources", funcName)
+		return ingestedCertifyBadsIDS, errext.WithCode(gqlerror.Errorf("%v :: must specify at most packages, artifacts or sources", funcName), errext.ErrInvalidInput)
 	}
 
 	for _, certifyBad := range certifyBads {
 		if certifyBad.KnownSince.IsZero() {
-			return ingestedCertifyBadsIDS, gqlerror.Errorf("certifyBads contains a zero time")
+			return ingestedCertifyBadsIDS, errext.WithCode(gqlerror.Errorf("certifyBads contains a zero time"), errext.ErrInvalidInput)
 		}
 	}
 
@@ -62,7 +63,18 @@ func (r *mutationResolver) IngestCertifyBads(ctx context.Context, subjects model
 // CertifyBad is the resolver for the CertifyBad field.
 func (r *queryResolver) CertifyBad(ctx context.Context, certifyBadSpec model.CertifyBadSpec) ([]*model.CertifyBad, error) {
 	if err := validatePackageSourceOrArtifactQueryFilter(certifyBadSpec.Subject); err != nil {
-		return nil, gqlerror.Errorf("CertifyBad :: %s", err)
+		return nil, errext.Errorf("CertifyBad :: %s", err)
 	}
 	return r.Backend.CertifyBad(ctx, &certifyBadSpec)
 }
+
+// CertifyBadList is the resolver for the CertifyBadList field.
+func (r *queryResolver) CertifyBadList(ctx context.Context, certifyBadSpec model.CertifyBadSpec, pagination *model.PaginationSpec) (*model.CertifyBadConnection, error) {
+	if err := validatePaginationSpec("CertifyBadList", pagination); err != nil {
+		return nil, err
+	}
+	if err := validatePackageSourceOrArtifactQueryFilter(certifyBadSpec.Subject); err != nil {
+		return nil, errext.Errorf("CertifyBadList :: %s", err)
+	}
+	return r.Backend.CertifyBadList(ctx, certifyBadSpec, pagination)
+}
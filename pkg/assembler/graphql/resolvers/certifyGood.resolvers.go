@@ -7,6 +7,7 @@ package resolvers
 import (
 	"context"
 
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -18,7 +19,7 @@ func (r *mutationResolver) IngestCertifyGood(ctx context.Context, subject model.
 		return "", err
 	}
 	if certifyGood.KnownSince.IsZero() {
-		return "", gqlerror.Errorf("certifyGood.KnownSince is a zero time")
+		return "", errext.WithCode(gqlerror.Errorf("certifyGood.KnownSince is a zero time"), errext.ErrInvalidInput)
 	}
 	return r.Backend.IngestCertifyGood(ctx, subject, &pkgMatchType, certifyGood)
 }
@@ -30,29 +31,29 @@ func (r *mutationResolver) IngestCertifyGoods(ctx context.Context, subjects mode
 	ingestedCertifyGoodsIDS := []string{}
 	if len(subjects.Packages) > 0 {
 		if len(subjects.Packages) != len(certifyGoods) {
-			return ingestedCertifyGoodsIDS, gqlerror.Errorf("%v :: uneven packages and certifyGoods for ingestion", funcName)
+			return ingestedCertifyGoodsIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven packages and certifyGoods for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if len(subjects.Artifacts) > 0 {
 		if len(subjects.Artifacts) != len(certifyGoods) {
-			return ingestedCertifyGoodsIDS, gqlerror.Errorf("%v :: uneven artifacts and certifyGoods for ingestion", funcName)
+			return ingestedCertifyGoodsIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven artifacts and certifyGoods for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if len(subjects.Sources) > 0 {
 		if len(subjects.Sources) != len(certifyGoods) {
-			return ingestedCertifyGoodsIDS, gqlerror.Errorf("%v :: uneven sources and certifyGoods for ingestion", funcName)
+			return ingestedCertifyGoodsIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven sources and certifyGoods for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if valuesDefined != 1 {
-		return ingestedCertifyGoodsIDS, gqlerror.Errorf("%v :: must specify at most packages, artifacts or sources", funcName)
+		return ingestedCertifyGoodsIDS, errext.WithCode(gqlerror.Errorf("%v :: must specify at most packages, artifacts or sources", funcName), errext.ErrInvalidInput)
 	}
 
 	for _, certifyGood := range certifyGoods {
 		if certifyGood.KnownSince.IsZero() {
-			return ingestedCertifyGoodsIDS, gqlerror.Errorf("certifyGoods contains a zero time")
+			return ingestedCertifyGoodsIDS, errext.WithCode(gqlerror.Errorf("certifyGoods contains a zero time"), errext.ErrInvalidInput)
 		}
 	}
 
@@ -62,7 +63,18 @@ func (r *mutationResolver) IngestCertifyGoods(ctx context.Context, subjects mode
 // CertifyGood is the resolver for the CertifyGood field.
 func (r *queryResolver) CertifyGood(ctx context.Context, certifyGoodSpec model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
 	if err := validatePackageSourceOrArtifactQueryFilter(certifyGoodSpec.Subject); err != nil {
-		return nil, gqlerror.Errorf("CertifyGood :: %s", err)
+		return nil, errext.Errorf("CertifyGood :: %s", err)
 	}
 	return r.Backend.CertifyGood(ctx, &certifyGoodSpec)
 }
+
+// CertifyGoodList is the resolver for the CertifyGoodList field.
+func (r *queryResolver) CertifyGoodList(ctx context.Context, certifyGoodSpec model.CertifyGoodSpec, pagination *model.PaginationSpec) (*model.CertifyGoodConnection, error) {
+	if err := validatePaginationSpec("CertifyGoodList", pagination); err != nil {
+		return nil, err
+	}
+	if err := validatePackageSourceOrArtifactQueryFilter(certifyGoodSpec.Subject); err != nil {
+		return nil, errext.Errorf("CertifyGoodList :: %s", err)
+	}
+	return r.Backend.CertifyGoodList(ctx, certifyGoodSpec, pagination)
+}
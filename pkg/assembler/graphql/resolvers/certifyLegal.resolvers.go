@@ -7,6 +7,7 @@ package resolvers
 import (
 	"context"
 
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -15,7 +16,7 @@ import (
 func (r *mutationResolver) IngestCertifyLegal(ctx context.Context, subject model.PackageOrSourceInput, declaredLicenses []*model.IDorLicenseInput, discoveredLicenses []*model.IDorLicenseInput, certifyLegal model.CertifyLegalInputSpec) (string, error) {
 	funcName := "IngestCertifyLegal"
 	if err := validatePackageOrSourceInput(&subject, funcName); err != nil {
-		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+		return "", errext.Errorf("%v ::  %s", funcName, err)
 	}
 
 	return r.Backend.IngestCertifyLegal(ctx, subject, declaredLicenses, discoveredLicenses, &certifyLegal)
@@ -27,22 +28,22 @@ func (r *mutationResolver) IngestCertifyLegals(ctx context.Context, subjects mod
 	valuesDefined := 0
 	if (len(certifyLegals) != len(discoveredLicensesList)) ||
 		(len(certifyLegals) != len(declaredLicensesList)) {
-		return nil, gqlerror.Errorf("%v :: must specify equal length license lists and certifyLegals", funcName)
+		return nil, errext.WithCode(gqlerror.Errorf("%v :: must specify equal length license lists and certifyLegals", funcName), errext.ErrInvalidInput)
 	}
 	if len(subjects.Packages) > 0 {
 		if len(subjects.Packages) != len(certifyLegals) {
-			return nil, gqlerror.Errorf("%v :: uneven packages for ingestion", funcName)
+			return nil, errext.WithCode(gqlerror.Errorf("%v :: uneven packages for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if len(subjects.Sources) > 0 {
 		if len(subjects.Sources) != len(certifyLegals) {
-			return nil, gqlerror.Errorf("%v :: uneven sources for ingestion", funcName)
+			return nil, errext.WithCode(gqlerror.Errorf("%v :: uneven sources for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if valuesDefined != 1 {
-		return nil, gqlerror.Errorf("%v :: must specify at most packages or sources", funcName)
+		return nil, errext.WithCode(gqlerror.Errorf("%v :: must specify at most packages or sources", funcName), errext.ErrInvalidInput)
 	}
 	return r.Backend.IngestCertifyLegals(ctx, subjects, declaredLicensesList, discoveredLicensesList, certifyLegals)
 }
@@ -50,8 +51,19 @@ func (r *mutationResolver) IngestCertifyLegals(ctx context.Context, subjects mod
 // CertifyLegal is the resolver for the CertifyLegal field.
 func (r *queryResolver) CertifyLegal(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
 	if err := validatePackageOrSourceQueryFilter(certifyLegalSpec.Subject); err != nil {
-		return nil, gqlerror.Errorf("CertifyLegal :: %v", err)
+		return nil, errext.Errorf("CertifyLegal :: %v", err)
 	}
 
 	return r.Backend.CertifyLegal(ctx, &certifyLegalSpec)
 }
+
+// CertifyLegalList is the resolver for the CertifyLegalList field.
+func (r *queryResolver) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, pagination *model.PaginationSpec) (*model.CertifyLegalConnection, error) {
+	if err := validatePaginationSpec("CertifyLegalList", pagination); err != nil {
+		return nil, err
+	}
+	if err := validatePackageOrSourceQueryFilter(certifyLegalSpec.Subject); err != nil {
+		return nil, errext.Errorf("CertifyLegalList :: %s", err)
+	}
+	return r.Backend.CertifyLegalList(ctx, certifyLegalSpec, pagination)
+}
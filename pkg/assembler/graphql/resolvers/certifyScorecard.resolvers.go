@@ -6,9 +6,10 @@ package resolvers
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // IngestScorecard is the resolver for the ingestScorecard field.
@@ -21,12 +22,28 @@ func (r *mutationResolver) IngestScorecards(ctx context.Context, sources []*mode
 	funcName := "IngestScorecards"
 	ingestedScorecardsIDS := []string{}
 	if len(sources) != len(scorecards) {
-		return ingestedScorecardsIDS, fmt.Errorf("%v :: uneven source and scorecards for ingestion", funcName)
+		return ingestedScorecardsIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven source and scorecards for ingestion", funcName), errext.ErrInvalidInput)
 	}
 	return r.Backend.IngestScorecards(ctx, sources, scorecards)
 }
 
+// UpdateCertifyScorecard is the resolver for the updateCertifyScorecard field.
+func (r *mutationResolver) UpdateCertifyScorecard(ctx context.Context, id string, scorecard model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
+	if id == "" {
+		return nil, errext.WithCode(gqlerror.Errorf("UpdateCertifyScorecard :: id must be specified"), errext.ErrInvalidInput)
+	}
+	return r.Backend.UpdateCertifyScorecard(ctx, id, scorecard)
+}
+
 // Scorecards is the resolver for the scorecards field.
 func (r *queryResolver) Scorecards(ctx context.Context, scorecardSpec model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
 	return r.Backend.Scorecards(ctx, &scorecardSpec)
 }
+
+// ScorecardsList is the resolver for the scorecardsList field.
+func (r *queryResolver) ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, pagination *model.PaginationSpec) (*model.CertifyScorecardConnection, error) {
+	if err := validatePaginationSpec("ScorecardsList", pagination); err != nil {
+		return nil, err
+	}
+	return r.Backend.ScorecardsList(ctx, scorecardSpec, pagination)
+}
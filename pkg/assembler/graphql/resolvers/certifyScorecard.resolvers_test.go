@@ -94,3 +94,46 @@ func TestIngestScorecards(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateCertifyScorecard(t *testing.T) {
+	spec := model.ScorecardInputSpec{
+		AggregateScore: 7.5,
+		TimeScanned:    testdata.T1,
+	}
+	tests := []struct {
+		Name        string
+		ID          string
+		ExpQueryErr bool
+	}{
+		{
+			Name:        "Empty ID",
+			ID:          "",
+			ExpQueryErr: true,
+		},
+		{
+			Name: "Happy path",
+			ID:   "certify_scorecards:123",
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpQueryErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				UpdateCertifyScorecard(ctx, test.ID, spec).
+				Return(&model.CertifyScorecard{ID: test.ID}, nil).
+				Times(times)
+			_, err := r.Mutation().UpdateCertifyScorecard(ctx, test.ID, spec)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+		})
+	}
+}
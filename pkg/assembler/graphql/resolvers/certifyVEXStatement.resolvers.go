@@ -8,6 +8,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -16,20 +17,20 @@ import (
 func (r *mutationResolver) IngestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.IDorVulnerabilityInput, vexStatement model.VexStatementInputSpec) (string, error) {
 	funcName := "IngestVEXStatement"
 	if err := validatePackageOrArtifactInput(&subject, funcName); err != nil {
-		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+		return "", errext.Errorf("%v ::  %s", funcName, err)
 	}
 	err := validateVexInput(vexStatement)
 	if err != nil {
-		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+		return "", errext.Errorf("%v ::  %s", funcName, err)
 	}
 	if vulnerability.VulnerabilityInput != nil {
 		err = validateNoVul(*vulnerability.VulnerabilityInput)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		err = validateVulnerabilityIDInputSpec(*vulnerability.VulnerabilityInput)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
 		return r.Backend.IngestVEXStatement(ctx, subject, model.IDorVulnerabilityInput{
@@ -48,21 +49,21 @@ func (r *mutationResolver) IngestVEXStatements(ctx context.Context, subjects mod
 	valuesDefined := 0
 	if len(subjects.Packages) > 0 {
 		if len(subjects.Packages) != len(vexStatements) {
-			return []string{}, gqlerror.Errorf("uneven packages and vexStatements for ingestion")
+			return []string{}, errext.WithCode(gqlerror.Errorf("uneven packages and vexStatements for ingestion"), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if len(subjects.Artifacts) > 0 {
 		if len(subjects.Artifacts) != len(vexStatements) {
-			return []string{}, gqlerror.Errorf("uneven artifact and vexStatements for ingestion")
+			return []string{}, errext.WithCode(gqlerror.Errorf("uneven artifact and vexStatements for ingestion"), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if len(vulnerabilities) != len(vexStatements) {
-		return []string{}, gqlerror.Errorf("uneven vulnerabilities and vexStatements for ingestion")
+		return []string{}, errext.WithCode(gqlerror.Errorf("uneven vulnerabilities and vexStatements for ingestion"), errext.ErrInvalidInput)
 	}
 	if valuesDefined != 1 {
-		return []string{}, gqlerror.Errorf("must specify at most packages or artifacts for %v", "IngestVEXStatements")
+		return []string{}, errext.WithCode(gqlerror.Errorf("must specify at most packages or artifacts for %v", "IngestVEXStatements"), errext.ErrInvalidInput)
 	}
 
 	var lowercaseVulnList []*model.IDorVulnerabilityInput
@@ -73,12 +74,12 @@ func (r *mutationResolver) IngestVEXStatements(ctx context.Context, subjects mod
 		}
 		err := validateNoVul(*v.VulnerabilityInput)
 		if err != nil {
-			return []string{}, gqlerror.Errorf("%v ::  %s", funcName, err)
+			return []string{}, errext.Errorf("%v ::  %s", funcName, err)
 		}
 
 		err = validateVulnerabilityIDInputSpec(*v.VulnerabilityInput)
 		if err != nil {
-			return []string{}, gqlerror.Errorf("%v ::  %s", funcName, err)
+			return []string{}, errext.Errorf("%v ::  %s", funcName, err)
 		}
 
 		lowercaseVulnInput := model.VulnerabilityInputSpec{
@@ -98,7 +99,7 @@ func (r *mutationResolver) IngestVEXStatements(ctx context.Context, subjects mod
 // CertifyVEXStatement is the resolver for the CertifyVEXStatement field.
 func (r *queryResolver) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
 	if err := validatePackageOrArtifactQueryFilter(certifyVEXStatementSpec.Subject); err != nil {
-		return nil, gqlerror.Errorf("CertifyVEXStatement :: %s", err)
+		return nil, errext.Errorf("CertifyVEXStatement :: %s", err)
 	}
 
 	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
@@ -139,3 +140,17 @@ func (r *queryResolver) CertifyVEXStatement(ctx context.Context, certifyVEXState
 		return r.Backend.CertifyVEXStatement(ctx, &certifyVEXStatementSpec)
 	}
 }
+
+// CertifyVEXStatementList is the resolver for the CertifyVEXStatementList field.
+func (r *queryResolver) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, pagination *model.PaginationSpec) (*model.CertifyVEXStatementConnection, error) {
+	if err := validatePaginationSpec("CertifyVEXStatementList", pagination); err != nil {
+		return nil, err
+	}
+	if err := validatePackageOrArtifactQueryFilter(certifyVEXStatementSpec.Subject); err != nil {
+		return nil, errext.Errorf("CertifyVEXStatementList :: %s", err)
+	}
+
+	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+	certifyVEXStatementSpec.Vulnerability = lowercaseVulnerabilitySpec(certifyVEXStatementSpec.Vulnerability)
+	return r.Backend.CertifyVEXStatementList(ctx, certifyVEXStatementSpec, pagination)
+}
@@ -7,11 +7,39 @@ package resolvers
 import (
 	"context"
 	"strings"
+	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
+// Stale is the resolver for the stale field.
+func (r *certifyVulnResolver) Stale(ctx context.Context, obj *model.CertifyVuln) (bool, error) {
+	if r.StaleAfter <= 0 || obj.Metadata == nil {
+		return false, nil
+	}
+	return obj.Metadata.TimeScanned.Add(r.StaleAfter).Before(time.Now()), nil
+}
+
+// ResolvedBy is the resolver for the resolvedBy field.
+func (r *certifyVulnResolver) ResolvedBy(ctx context.Context, obj *model.CertifyVuln) (model.Node, error) {
+	if obj.ResolvedByID == nil {
+		return nil, nil
+	}
+	node, err := r.Backend.Node(ctx, *obj.ResolvedByID)
+	if err != nil {
+		// the HasSBOM or HasSourceAt can be deleted after it resolved the
+		// vulnerability, e.g. along with its source
+		if errext.Classify(err) == errext.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return node, nil
+}
+
 // IngestCertifyVuln is the resolver for the ingestCertifyVuln field.
 func (r *mutationResolver) IngestCertifyVuln(ctx context.Context, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, certifyVuln model.ScanMetadataInput) (string, error) {
 	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
@@ -19,7 +47,7 @@ func (r *mutationResolver) IngestCertifyVuln(ctx context.Context, pkg model.IDor
 		funcName := "IngestCertifyVuln"
 		err := validateVulnerabilityIDInputSpec(*vulnerability.VulnerabilityInput)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		return r.Backend.IngestCertifyVuln(ctx, pkg, model.IDorVulnerabilityInput{
 			VulnerabilityTypeID: vulnerability.VulnerabilityTypeID,
@@ -36,10 +64,10 @@ func (r *mutationResolver) IngestCertifyVulns(ctx context.Context, pkgs []*model
 	funcName := "IngestCertifyVulns"
 	ingestedCertifyVulnsIDS := []string{}
 	if len(pkgs) != len(vulnerabilities) {
-		return ingestedCertifyVulnsIDS, gqlerror.Errorf("%v :: uneven packages and vulnerabilities for ingestion", funcName)
+		return ingestedCertifyVulnsIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven packages and vulnerabilities for ingestion", funcName), errext.ErrInvalidInput)
 	}
 	if len(pkgs) != len(certifyVulns) {
-		return ingestedCertifyVulnsIDS, gqlerror.Errorf("%v :: uneven packages and certifyVuln for ingestion", funcName)
+		return ingestedCertifyVulnsIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven packages and certifyVuln for ingestion", funcName), errext.ErrInvalidInput)
 	}
 
 	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
@@ -51,7 +79,7 @@ func (r *mutationResolver) IngestCertifyVulns(ctx context.Context, pkgs []*model
 		}
 		err := validateVulnerabilityIDInputSpec(*v.VulnerabilityInput)
 		if err != nil {
-			return []string{}, gqlerror.Errorf("%v ::  %s", funcName, err)
+			return []string{}, errext.Errorf("%v ::  %s", funcName, err)
 		}
 
 		lowercaseVulnInput := model.VulnerabilityInputSpec{
@@ -68,50 +96,178 @@ func (r *mutationResolver) IngestCertifyVulns(ctx context.Context, pkgs []*model
 	return r.Backend.IngestCertifyVulns(ctx, pkgs, lowercaseVulnList, certifyVulns)
 }
 
+// MarkStaleVulns is the resolver for the markStaleVulns field.
+func (r *mutationResolver) MarkStaleVulns(ctx context.Context, olderThan time.Duration) (int, error) {
+	if olderThan < 0 {
+		return 0, errext.WithCode(gqlerror.Errorf("MarkStaleVulns :: olderThan must not be negative"), errext.ErrInvalidInput)
+	}
+	return r.Backend.MarkStaleVulns(ctx, olderThan)
+}
+
+// ArchiveCertifyVulns is the resolver for the archiveCertifyVulns field.
+func (r *mutationResolver) ArchiveCertifyVulns(ctx context.Context, olderThan time.Duration) (int, error) {
+	if olderThan < 0 {
+		return 0, errext.WithCode(gqlerror.Errorf("ArchiveCertifyVulns :: olderThan must not be negative"), errext.ErrInvalidInput)
+	}
+	return r.Backend.ArchiveCertifyVulns(ctx, olderThan)
+}
+
+// DeleteCertifyVuln is the resolver for the deleteCertifyVuln field.
+func (r *mutationResolver) DeleteCertifyVuln(ctx context.Context, id string) (bool, error) {
+	if id == "" {
+		return false, errext.WithCode(gqlerror.Errorf("DeleteCertifyVuln :: id must be specified"), errext.ErrInvalidInput)
+	}
+	if err := r.Backend.DeleteCertifyVuln(ctx, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// UpdateCertifyVulnResolution is the resolver for the updateCertifyVulnResolution field.
+func (r *mutationResolver) UpdateCertifyVulnResolution(ctx context.Context, id string, resolvedBy string) (*model.CertifyVuln, error) {
+	funcName := "UpdateCertifyVulnResolution"
+	if id == "" {
+		return nil, errext.WithCode(gqlerror.Errorf("%v :: id must be specified", funcName), errext.ErrInvalidInput)
+	}
+	if resolvedBy == "" {
+		return nil, errext.WithCode(gqlerror.Errorf("%v :: resolvedBy must be specified", funcName), errext.ErrInvalidInput)
+	}
+	return r.Backend.UpdateCertifyVulnResolution(ctx, id, resolvedBy)
+}
+
 // CertifyVuln is the resolver for the CertifyVuln field.
 func (r *queryResolver) CertifyVuln(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	if certifyVulnSpec.Vulnerability != nil {
+		if err := validateVulnerabilitySpec(*certifyVulnSpec.Vulnerability); err != nil {
+			return nil, errext.Errorf("CertifyVuln :: %s", err)
+		}
+	}
+
 	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+	certifyVulnSpec.Vulnerability = lowercaseVulnerabilitySpec(certifyVulnSpec.Vulnerability)
+	return r.Backend.CertifyVuln(ctx, &certifyVulnSpec)
+}
 
+// CertifyVulnList is the resolver for the CertifyVulnList field.
+func (r *queryResolver) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, pagination *model.PaginationSpec) (*model.CertifyVulnConnection, error) {
+	if err := validatePaginationSpec("CertifyVulnList", pagination); err != nil {
+		return nil, err
+	}
 	if certifyVulnSpec.Vulnerability != nil {
+		if err := validateVulnerabilitySpec(*certifyVulnSpec.Vulnerability); err != nil {
+			return nil, errext.Errorf("CertifyVulnList :: %s", err)
+		}
+	}
 
-		var typeLowerCase *string = nil
-		var vulnIDLowerCase *string = nil
-		if certifyVulnSpec.Vulnerability.Type != nil {
-			lower := strings.ToLower(*certifyVulnSpec.Vulnerability.Type)
-			typeLowerCase = &lower
+	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+	certifyVulnSpec.Vulnerability = lowercaseVulnerabilitySpec(certifyVulnSpec.Vulnerability)
+	return r.Backend.CertifyVulnList(ctx, certifyVulnSpec, pagination)
+}
+
+// CertifyVulnCount is the resolver for the CertifyVulnCount field.
+func (r *queryResolver) CertifyVulnCount(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) (int, error) {
+	if certifyVulnSpec == nil {
+		certifyVulnSpec = &model.CertifyVulnSpec{}
+	}
+	if certifyVulnSpec.Vulnerability != nil {
+		if err := validateVulnerabilitySpec(*certifyVulnSpec.Vulnerability); err != nil {
+			return 0, errext.Errorf("CertifyVulnCount :: %s", err)
 		}
-		if certifyVulnSpec.Vulnerability.VulnerabilityID != nil {
-			lower := strings.ToLower(*certifyVulnSpec.Vulnerability.VulnerabilityID)
-			vulnIDLowerCase = &lower
+	}
+
+	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+	certifyVulnSpec.Vulnerability = lowercaseVulnerabilitySpec(certifyVulnSpec.Vulnerability)
+	return r.Backend.CertifyVulnCount(ctx, *certifyVulnSpec)
+}
+
+// CheckScannerFreshness is the resolver for the CheckScannerFreshness field.
+func (r *queryResolver) CheckScannerFreshness(ctx context.Context, scannerURI string, maxAge time.Duration) (*model.ScannerFreshnessResult, error) {
+	if maxAge < 0 {
+		return nil, errext.WithCode(gqlerror.Errorf("CheckScannerFreshness :: maxAge must not be negative"), errext.ErrInvalidInput)
+	}
+	return r.Backend.CheckScannerFreshness(ctx, scannerURI, maxAge)
+}
+
+// TopVulnerablePackages is the resolver for the topVulnerablePackages field.
+func (r *queryResolver) TopVulnerablePackages(ctx context.Context, limit int, filter *model.CertifyVulnSpec) ([]*model.PackageVulnCount, error) {
+	if limit <= 0 {
+		return nil, errext.WithCode(gqlerror.Errorf("TopVulnerablePackages :: limit must be positive"), errext.ErrInvalidInput)
+	}
+	if filter == nil {
+		filter = &model.CertifyVulnSpec{}
+	}
+	if filter.Vulnerability != nil {
+		if err := validateVulnerabilitySpec(*filter.Vulnerability); err != nil {
+			return nil, errext.Errorf("TopVulnerablePackages :: %s", err)
 		}
+	}
+
+	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+	filter.Vulnerability = lowercaseVulnerabilitySpec(filter.Vulnerability)
+	counts, err := r.Backend.TopVulnerablePackages(ctx, limit, filter)
+	if err != nil {
+		return nil, errext.Errorf("TopVulnerablePackages :: %s", err)
+	}
+	return counts, nil
+}
+
+// CertifyVulnByDocumentRef is the resolver for the CertifyVulnByDocumentRef field.
+func (r *queryResolver) CertifyVulnByDocumentRef(ctx context.Context, documentRef string) ([]*model.CertifyVuln, error) {
+	if documentRef == "" {
+		return nil, errext.WithCode(gqlerror.Errorf("CertifyVulnByDocumentRef :: documentRef must not be empty"), errext.ErrInvalidInput)
+	}
+	certifyVulns, err := r.Backend.GetCertifyVulnsByDocumentRef(ctx, documentRef)
+	if err != nil {
+		return nil, errext.Errorf("CertifyVulnByDocumentRef :: %s", err)
+	}
+	return certifyVulns, nil
+}
 
-		if certifyVulnSpec.Vulnerability.NoVuln != nil && !*certifyVulnSpec.Vulnerability.NoVuln {
-			if certifyVulnSpec.Vulnerability.Type != nil && *typeLowerCase == "novuln" {
-				return []*model.CertifyVuln{}, gqlerror.Errorf("novuln boolean set to false, cannot specify vulnerability type to be novuln")
-			}
+// ArchivedCertifyVuln is the resolver for the archivedCertifyVuln field.
+func (r *queryResolver) ArchivedCertifyVuln(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	if certifyVulnSpec.Vulnerability != nil {
+		if err := validateVulnerabilitySpec(*certifyVulnSpec.Vulnerability); err != nil {
+			return nil, errext.Errorf("ArchivedCertifyVuln :: %s", err)
 		}
+	}
 
-		lowercaseVulnFilter := model.VulnerabilitySpec{
-			ID:              certifyVulnSpec.Vulnerability.ID,
-			Type:            typeLowerCase,
-			VulnerabilityID: vulnIDLowerCase,
-			NoVuln:          certifyVulnSpec.Vulnerability.NoVuln,
+	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+	certifyVulnSpec.Vulnerability = lowercaseVulnerabilitySpec(certifyVulnSpec.Vulnerability)
+	return r.Backend.ArchivedCertifyVuln(ctx, &certifyVulnSpec)
+}
+
+// CertifyVulnAdded is the resolver for the certifyVulnAdded field.
+func (r *subscriptionResolver) CertifyVulnAdded(ctx context.Context, filter *model.CertifyVulnSpec) (<-chan *model.CertifyVuln, error) {
+	if filter == nil {
+		filter = &model.CertifyVulnSpec{}
+	}
+	if filter.Vulnerability != nil {
+		if err := validateVulnerabilitySpec(*filter.Vulnerability); err != nil {
+			return nil, errext.Errorf("CertifyVulnAdded :: %s", err)
 		}
+	}
+
+	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+	filter.Vulnerability = lowercaseVulnerabilitySpec(filter.Vulnerability)
+	return r.Backend.CertifyVulnAdded(ctx, filter)
+}
 
-		lowercaseCertifyVulnFilter := model.CertifyVulnSpec{
-			ID:             certifyVulnSpec.ID,
-			Package:        certifyVulnSpec.Package,
-			Vulnerability:  &lowercaseVulnFilter,
-			TimeScanned:    certifyVulnSpec.TimeScanned,
-			DbURI:          certifyVulnSpec.DbURI,
-			DbVersion:      certifyVulnSpec.DbVersion,
-			ScannerURI:     certifyVulnSpec.ScannerURI,
-			ScannerVersion: certifyVulnSpec.ScannerVersion,
-			Origin:         certifyVulnSpec.Origin,
-			Collector:      certifyVulnSpec.Collector,
+// CertifyVulnForPackage is the resolver for the certifyVulnForPackage field.
+func (r *subscriptionResolver) CertifyVulnForPackage(ctx context.Context, pkgSpec model.PkgSpec) (<-chan *model.CertifyVuln, error) {
+	// empty strings are wildcards for the package type, namespace and name
+	for _, field := range []**string{&pkgSpec.Type, &pkgSpec.Namespace, &pkgSpec.Name} {
+		if *field != nil && **field == "" {
+			*field = nil
 		}
-		return r.Backend.CertifyVuln(ctx, &lowercaseCertifyVulnFilter)
-	} else {
-		return r.Backend.CertifyVuln(ctx, &certifyVulnSpec)
 	}
+	return r.Backend.CertifyVulnAdded(ctx, &model.CertifyVulnSpec{Package: &pkgSpec})
 }
+
+// CertifyVuln returns generated.CertifyVulnResolver implementation.
+func (r *Resolver) CertifyVuln() generated.CertifyVulnResolver { return &certifyVulnResolver{r} }
+
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type certifyVulnResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
@@ -17,14 +17,21 @@ package resolvers_test
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 var t1, _ = time.Parse(time.RFC3339, "2023-01-01T00:00:00Z")
@@ -135,3 +142,642 @@ func TestIngestCertifyVulns(t *testing.T) {
 		})
 	}
 }
+
+func TestCertifyVuln(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Query       model.CertifyVulnSpec
+		ExpBackend  *model.CertifyVulnSpec
+		ExpQueryErr bool
+	}{
+		{
+			Name: "Novuln false with type novuln",
+			Query: model.CertifyVulnSpec{
+				Vulnerability: &model.VulnerabilitySpec{
+					Type:   ptrfrom.String("NoVuln"),
+					NoVuln: ptrfrom.Bool(false),
+				},
+			},
+			ExpQueryErr: true,
+		},
+		{
+			Name: "Vulnerability is lowercased and document ref is kept",
+			Query: model.CertifyVulnSpec{
+				Vulnerability: &model.VulnerabilitySpec{
+					Type:            ptrfrom.String("CVE"),
+					VulnerabilityID: ptrfrom.String("CVE-2014-8140"),
+				},
+				DocumentRef: ptrfrom.String("sha256_1234"),
+			},
+			ExpBackend: &model.CertifyVulnSpec{
+				Vulnerability: &model.VulnerabilitySpec{
+					Type:            ptrfrom.String("cve"),
+					VulnerabilityID: ptrfrom.String("cve-2014-8140"),
+				},
+				DocumentRef: ptrfrom.String("sha256_1234"),
+			},
+			ExpQueryErr: false,
+		},
+		{
+			Name: "Vulnerability is lowercased and the CVSS range is kept",
+			Query: model.CertifyVulnSpec{
+				Vulnerability: &model.VulnerabilitySpec{
+					Type: ptrfrom.String("CVE"),
+				},
+				MinCVSS: ptrfrom.Float64(7),
+				MaxCVSS: ptrfrom.Float64(9.5),
+			},
+			ExpBackend: &model.CertifyVulnSpec{
+				Vulnerability: &model.VulnerabilitySpec{
+					Type: ptrfrom.String("cve"),
+				},
+				MinCVSS: ptrfrom.Float64(7),
+				MaxCVSS: ptrfrom.Float64(9.5),
+			},
+			ExpQueryErr: false,
+		},
+		{
+			Name: "Vulnerability is lowercased and the scan time range is kept",
+			Query: model.CertifyVulnSpec{
+				Vulnerability: &model.VulnerabilitySpec{
+					Type: ptrfrom.String("CVE"),
+				},
+				TimeScannedAfter:  ptrfrom.Time(t1),
+				TimeScannedBefore: ptrfrom.Time(t1.Add(24 * time.Hour)),
+			},
+			ExpBackend: &model.CertifyVulnSpec{
+				Vulnerability: &model.VulnerabilitySpec{
+					Type: ptrfrom.String("cve"),
+				},
+				TimeScannedAfter:  ptrfrom.Time(t1),
+				TimeScannedBefore: ptrfrom.Time(t1.Add(24 * time.Hour)),
+			},
+			ExpQueryErr: false,
+		},
+		{
+			Name: "Vulnerability is lowercased and the order is kept",
+			Query: model.CertifyVulnSpec{
+				Vulnerability: &model.VulnerabilitySpec{
+					Type: ptrfrom.String("CVE"),
+				},
+				Order: &model.CertifyVulnOrder{SortBy: model.CertifyVulnOrderFieldTimeScanned, SortOrder: model.OrderDirectionDesc},
+			},
+			ExpBackend: &model.CertifyVulnSpec{
+				Vulnerability: &model.VulnerabilitySpec{
+					Type: ptrfrom.String("cve"),
+				},
+				Order: &model.CertifyVulnOrder{SortBy: model.CertifyVulnOrderFieldTimeScanned, SortOrder: model.OrderDirectionDesc},
+			},
+			ExpQueryErr: false,
+		},
+		{
+			Name: "Vulnerability is lowercased and the scanner URIs are kept",
+			Query: model.CertifyVulnSpec{
+				Vulnerability: &model.VulnerabilitySpec{
+					Type: ptrfrom.String("CVE"),
+				},
+				ScannerURIIn: []string{"osv.dev", "trivy"},
+			},
+			ExpBackend: &model.CertifyVulnSpec{
+				Vulnerability: &model.VulnerabilitySpec{
+					Type: ptrfrom.String("cve"),
+				},
+				ScannerURIIn: []string{"osv.dev", "trivy"},
+			},
+			ExpQueryErr: false,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpQueryErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				CertifyVuln(ctx, test.ExpBackend).
+				Times(times)
+			_, err := r.Query().CertifyVuln(ctx, test.Query)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+		})
+	}
+}
+
+func TestCheckScannerFreshness(t *testing.T) {
+	tests := []struct {
+		Name        string
+		ScannerURI  string
+		MaxAge      time.Duration
+		ExpQueryErr bool
+	}{
+		{
+			Name:        "Negative max age",
+			ScannerURI:  "test scanner uri",
+			MaxAge:      -time.Hour,
+			ExpQueryErr: true,
+		},
+		{
+			Name:        "Happy path",
+			ScannerURI:  "test scanner uri",
+			MaxAge:      24 * time.Hour,
+			ExpQueryErr: false,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpQueryErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				CheckScannerFreshness(ctx, test.ScannerURI, test.MaxAge).
+				Times(times)
+			_, err := r.Query().CheckScannerFreshness(ctx, test.ScannerURI, test.MaxAge)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+		})
+	}
+}
+
+func TestTopVulnerablePackages(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Limit       int
+		Filter      *model.CertifyVulnSpec
+		ExpFilter   *model.CertifyVulnSpec
+		ExpQueryErr bool
+	}{
+		{
+			Name:        "Zero limit",
+			Limit:       0,
+			ExpQueryErr: true,
+		},
+		{
+			Name:  "Invalid vulnerability filter",
+			Limit: 5,
+			Filter: &model.CertifyVulnSpec{
+				Vulnerability: &model.VulnerabilitySpec{
+					NoVuln: ptrfrom.Bool(false),
+					Type:   ptrfrom.String("novuln"),
+				},
+			},
+			ExpQueryErr: true,
+		},
+		{
+			Name:      "No filter",
+			Limit:     5,
+			ExpFilter: &model.CertifyVulnSpec{},
+		},
+		{
+			Name:  "Vulnerability filter is lowercased",
+			Limit: 5,
+			Filter: &model.CertifyVulnSpec{
+				Vulnerability: &model.VulnerabilitySpec{Type: ptrfrom.String("CVE")},
+			},
+			ExpFilter: &model.CertifyVulnSpec{
+				Vulnerability: &model.VulnerabilitySpec{Type: ptrfrom.String("cve")},
+			},
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpQueryErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				TopVulnerablePackages(ctx, test.Limit, test.ExpFilter).
+				Times(times)
+			_, err := r.Query().TopVulnerablePackages(ctx, test.Limit, test.Filter)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+		})
+	}
+}
+
+func TestCertifyVulnByDocumentRef(t *testing.T) {
+	tests := []struct {
+		Name        string
+		DocumentRef string
+		ExpQueryErr bool
+	}{
+		{
+			Name:        "Empty document reference",
+			DocumentRef: "",
+			ExpQueryErr: true,
+		},
+		{
+			Name:        "Happy path",
+			DocumentRef: "sha256_abc",
+			ExpQueryErr: false,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpQueryErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				GetCertifyVulnsByDocumentRef(ctx, test.DocumentRef).
+				Times(times)
+			_, err := r.Query().CertifyVulnByDocumentRef(ctx, test.DocumentRef)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+		})
+	}
+}
+
+func TestMarkStaleVulns(t *testing.T) {
+	tests := []struct {
+		Name        string
+		OlderThan   time.Duration
+		ExpQueryErr bool
+	}{
+		{
+			Name:        "Negative duration",
+			OlderThan:   -time.Hour,
+			ExpQueryErr: true,
+		},
+		{
+			Name:        "Happy path",
+			OlderThan:   30 * 24 * time.Hour,
+			ExpQueryErr: false,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpQueryErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				MarkStaleVulns(ctx, test.OlderThan).
+				Times(times)
+			_, err := r.Mutation().MarkStaleVulns(ctx, test.OlderThan)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+		})
+	}
+}
+
+func TestArchiveCertifyVulns(t *testing.T) {
+	tests := []struct {
+		Name        string
+		OlderThan   time.Duration
+		ExpQueryErr bool
+	}{
+		{
+			Name:        "Negative duration",
+			OlderThan:   -time.Hour,
+			ExpQueryErr: true,
+		},
+		{
+			Name:        "Happy path",
+			OlderThan:   90 * 24 * time.Hour,
+			ExpQueryErr: false,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpQueryErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				ArchiveCertifyVulns(ctx, test.OlderThan).
+				Times(times)
+			_, err := r.Mutation().ArchiveCertifyVulns(ctx, test.OlderThan)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+		})
+	}
+}
+
+func TestDeleteCertifyVuln(t *testing.T) {
+	tests := []struct {
+		Name        string
+		ID          string
+		BackendErr  error
+		ExpQueryErr bool
+	}{
+		{
+			Name:        "Empty ID",
+			ID:          "",
+			ExpQueryErr: true,
+		},
+		{
+			Name:        "Not found",
+			ID:          "certify_vulns:unknown",
+			BackendErr:  gqlerror.Errorf("DeleteCertifyVuln :: certifyVuln with id certify_vulns:unknown not found"),
+			ExpQueryErr: true,
+		},
+		{
+			Name: "Happy path",
+			ID:   "certify_vulns:123",
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ID == "" {
+				times = 0
+			}
+			b.
+				EXPECT().
+				DeleteCertifyVuln(ctx, test.ID).
+				Return(test.BackendErr).
+				Times(times)
+			got, err := r.Mutation().DeleteCertifyVuln(ctx, test.ID)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+			if got == test.ExpQueryErr {
+				t.Errorf("DeleteCertifyVuln() = %v, want %v", got, !test.ExpQueryErr)
+			}
+		})
+	}
+}
+
+func TestUpdateCertifyVulnResolution(t *testing.T) {
+	tests := []struct {
+		Name         string
+		ID           string
+		ResolvedByID string
+		ExpQueryErr  bool
+	}{
+		{
+			Name:         "Empty ID",
+			ResolvedByID: "has_source_ats:456",
+			ExpQueryErr:  true,
+		},
+		{
+			Name:        "Empty resolvedBy",
+			ID:          "certify_vulns:123",
+			ExpQueryErr: true,
+		},
+		{
+			Name:         "Happy path",
+			ID:           "certify_vulns:123",
+			ResolvedByID: "has_source_ats:456",
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpQueryErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				UpdateCertifyVulnResolution(ctx, test.ID, test.ResolvedByID).
+				Return(&model.CertifyVuln{ID: test.ID}, nil).
+				Times(times)
+			_, err := r.Mutation().UpdateCertifyVulnResolution(ctx, test.ID, test.ResolvedByID)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+		})
+	}
+}
+
+func TestCertifyVulnResolvedBy(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	r := resolvers.Resolver{Backend: b}
+
+	// an unresolved certification does not hit the backend
+	got, err := r.CertifyVuln().ResolvedBy(ctx, &model.CertifyVuln{})
+	if err != nil || got != nil {
+		t.Fatalf("ResolvedBy() = %v, %v, want nil", got, err)
+	}
+
+	hsa := &model.HasSourceAt{ID: "has_source_ats:456"}
+	b.
+		EXPECT().
+		Node(ctx, hsa.ID).
+		Return(hsa, nil).
+		Times(1)
+	got, err = r.CertifyVuln().ResolvedBy(ctx, &model.CertifyVuln{
+		ResolvedByID:   &hsa.ID,
+		ResolvedByType: ptrfrom.String("HasSourceAt"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != hsa {
+		t.Errorf("ResolvedBy() = %v, want %v", got, hsa)
+	}
+
+	// a node deleted after it resolved the certification is null
+	deleted := "has_sboms:789"
+	b.
+		EXPECT().
+		Node(ctx, deleted).
+		Return(nil, errext.WithCode(gqlerror.Errorf("HasSBOM with id 789 not found"), errext.ErrNotFound)).
+		Times(1)
+	got, err = r.CertifyVuln().ResolvedBy(ctx, &model.CertifyVuln{
+		ResolvedByID:   &deleted,
+		ResolvedByType: ptrfrom.String("HasSBOM"),
+	})
+	if err != nil || got != nil {
+		t.Errorf("ResolvedBy() of a deleted node = %v, %v, want nil", got, err)
+	}
+
+	// other backend errors are returned
+	b.
+		EXPECT().
+		Node(ctx, hsa.ID).
+		Return(nil, gqlerror.Errorf("connection refused")).
+		Times(1)
+	if _, err := r.CertifyVuln().ResolvedBy(ctx, &model.CertifyVuln{
+		ResolvedByID:   &hsa.ID,
+		ResolvedByType: ptrfrom.String("HasSourceAt"),
+	}); err == nil {
+		t.Errorf("ResolvedBy() did not return the backend error")
+	}
+}
+
+func TestCertifyVulnStale(t *testing.T) {
+	now := time.Now().UTC()
+	tests := []struct {
+		Name        string
+		StaleAfter  time.Duration
+		TimeScanned time.Time
+		ExpStale    bool
+	}{
+		{
+			Name:        "Threshold not configured",
+			TimeScanned: t1,
+			ExpStale:    false,
+		},
+		{
+			Name:        "Scan older than threshold",
+			StaleAfter:  30 * 24 * time.Hour,
+			TimeScanned: t1,
+			ExpStale:    true,
+		},
+		{
+			Name:        "Scan within threshold",
+			StaleAfter:  30 * 24 * time.Hour,
+			TimeScanned: now.Add(-24 * time.Hour),
+			ExpStale:    false,
+		},
+	}
+	ctx := context.Background()
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			r := resolvers.Resolver{StaleAfter: test.StaleAfter}
+			got, err := r.CertifyVuln().Stale(ctx, &model.CertifyVuln{
+				Metadata: &model.ScanMetadata{TimeScanned: test.TimeScanned},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.ExpStale {
+				t.Errorf("Stale() = %v, want %v", got, test.ExpStale)
+			}
+		})
+	}
+}
+
+func TestCertifyVulnForPackage(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", ctx, nil)
+	if err != nil {
+		t.Fatalf("Could not create backend: %v", err)
+	}
+	for _, p := range []*model.PkgInputSpec{testdata.P1, testdata.P4} {
+		if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p}); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}); err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	r := resolvers.Resolver{Backend: b}
+
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	subscribers := []struct {
+		Name    string
+		PkgSpec model.PkgSpec
+		// number of "last" events after which all events have been received
+		Lasts  int
+		Expect []string
+		got    []string
+	}{
+		{
+			Name:    "type with wildcard namespace and name",
+			PkgSpec: model.PkgSpec{Type: ptrfrom.String("pypi"), Namespace: ptrfrom.String(""), Name: ptrfrom.String("")},
+			Lasts:   1,
+			Expect:  []string{"tensorflow-1", "tensorflow-2", "last"},
+		},
+		{
+			Name:    "namespace and name with wildcard type",
+			PkgSpec: model.PkgSpec{Type: ptrfrom.String(""), Namespace: ptrfrom.String("openssl.org"), Name: ptrfrom.String("openssl")},
+			Lasts:   1,
+			Expect:  []string{"openssl-1", "last"},
+		},
+		{
+			Name:    "all wildcards",
+			PkgSpec: model.PkgSpec{Type: ptrfrom.String(""), Namespace: ptrfrom.String(""), Name: ptrfrom.String("")},
+			Lasts:   2,
+			Expect:  []string{"tensorflow-1", "openssl-1", "tensorflow-2", "last", "last"},
+		},
+	}
+	var wg sync.WaitGroup
+	for i := range subscribers {
+		s := &subscribers[i]
+		ch, err := r.Subscription().CertifyVulnForPackage(subCtx, s.PkgSpec)
+		if err != nil {
+			t.Fatalf("CertifyVulnForPackage(%s) error = %v", s.Name, err)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lasts := 0
+			for lasts < s.Lasts {
+				select {
+				case cv := <-ch:
+					s.got = append(s.got, cv.Metadata.DocumentRef)
+					if cv.Metadata.DocumentRef == "last" {
+						lasts++
+					}
+				case <-time.After(5 * time.Second):
+					return
+				}
+			}
+		}()
+	}
+
+	ingests := []struct {
+		pkg    *model.PkgInputSpec
+		docRef string
+	}{
+		{testdata.P1, "tensorflow-1"},
+		{testdata.P4, "openssl-1"},
+		{testdata.P1, "tensorflow-2"},
+		{testdata.P1, "last"},
+		{testdata.P4, "last"},
+	}
+	for _, i := range ingests {
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: i.pkg},
+			model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1},
+			model.ScanMetadataInput{TimeScanned: t1, DocumentRef: i.docRef}); err != nil {
+			t.Fatalf("Could not ingest certify vuln: %v", err)
+		}
+	}
+	wg.Wait()
+
+	for _, s := range subscribers {
+		if diff := cmp.Diff(s.Expect, s.got); diff != "" {
+			t.Errorf("%s: unexpected events (-want +got):\n%s", s.Name, diff)
+		}
+	}
+}
@@ -7,6 +7,7 @@ package resolvers
 import (
 	"context"
 
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -15,7 +16,7 @@ import (
 func (r *mutationResolver) IngestPointOfContact(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType model.MatchFlags, pointOfContact model.PointOfContactInputSpec) (string, error) {
 	funcName := "IngestPointOfContact"
 	if err := validatePackageSourceOrArtifactInput(&subject, funcName); err != nil {
-		return "", gqlerror.Errorf("%v :: %s", funcName, err)
+		return "", errext.Errorf("%v :: %s", funcName, err)
 	}
 	return r.Backend.IngestPointOfContact(ctx, subject, &pkgMatchType, pointOfContact)
 }
@@ -26,32 +27,55 @@ func (r *mutationResolver) IngestPointOfContacts(ctx context.Context, subjects m
 	valuesDefined := 0
 	if len(subjects.Packages) > 0 {
 		if len(subjects.Packages) != len(pointOfContacts) {
-			return []string{}, gqlerror.Errorf("%v :: uneven packages and pointOfContacts for ingestion", funcName)
+			return []string{}, errext.WithCode(gqlerror.Errorf("%v :: uneven packages and pointOfContacts for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if len(subjects.Artifacts) > 0 {
 		if len(subjects.Artifacts) != len(pointOfContacts) {
-			return []string{}, gqlerror.Errorf("%v :: uneven artifacts and pointOfContacts for ingestion", funcName)
+			return []string{}, errext.WithCode(gqlerror.Errorf("%v :: uneven artifacts and pointOfContacts for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if len(subjects.Sources) > 0 {
 		if len(subjects.Sources) != len(pointOfContacts) {
-			return []string{}, gqlerror.Errorf("%v :: uneven sources and pointOfContacts for ingestion", funcName)
+			return []string{}, errext.WithCode(gqlerror.Errorf("%v :: uneven sources and pointOfContacts for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if valuesDefined != 1 {
-		return []string{}, gqlerror.Errorf("%v :: must specify at most packages, artifacts or sources", funcName)
+		return []string{}, errext.WithCode(gqlerror.Errorf("%v :: must specify at most packages, artifacts or sources", funcName), errext.ErrInvalidInput)
 	}
 	return r.Backend.IngestPointOfContacts(ctx, subjects, &pkgMatchType, pointOfContacts)
 }
 
+// UpdatePointOfContact is the resolver for the updatePointOfContact field.
+func (r *mutationResolver) UpdatePointOfContact(ctx context.Context, id string, pointOfContact model.PointOfContactInputSpec) (*model.PointOfContact, error) {
+	funcName := "UpdatePointOfContact"
+	if id == "" {
+		return nil, errext.WithCode(gqlerror.Errorf("%v :: id must be specified", funcName), errext.ErrInvalidInput)
+	}
+	if pointOfContact.Email == "" || pointOfContact.Info == "" {
+		return nil, errext.WithCode(gqlerror.Errorf("%v :: email and info must not be empty", funcName), errext.ErrInvalidInput)
+	}
+	return r.Backend.UpdatePointOfContact(ctx, id, pointOfContact)
+}
+
 // PointOfContact is the resolver for the PointOfContact field.
 func (r *queryResolver) PointOfContact(ctx context.Context, pointOfContactSpec model.PointOfContactSpec) ([]*model.PointOfContact, error) {
 	if err := validatePackageSourceOrArtifactQueryFilter(pointOfContactSpec.Subject); err != nil {
-		return nil, gqlerror.Errorf("PointOfContact :: %s", err)
+		return nil, errext.Errorf("PointOfContact :: %s", err)
 	}
 	return r.Backend.PointOfContact(ctx, &pointOfContactSpec)
 }
+
+// PointOfContactList is the resolver for the PointOfContactList field.
+func (r *queryResolver) PointOfContactList(ctx context.Context, pointOfContactSpec model.PointOfContactSpec, pagination *model.PaginationSpec) (*model.PointOfContactConnection, error) {
+	if err := validatePaginationSpec("PointOfContactList", pagination); err != nil {
+		return nil, err
+	}
+	if err := validatePackageSourceOrArtifactQueryFilter(pointOfContactSpec.Subject); err != nil {
+		return nil, errext.Errorf("PointOfContactList :: %s", err)
+	}
+	return r.Backend.PointOfContactList(ctx, pointOfContactSpec, pagination)
+}
@@ -279,3 +279,62 @@ func TestPointOfContact(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdatePointOfContact(t *testing.T) {
+	spec := model.PointOfContactInputSpec{
+		Email: "a@b.com",
+		Info:  "info1",
+		Since: time.Unix(1e9, 0),
+	}
+	tests := []struct {
+		Name        string
+		ID          string
+		Spec        model.PointOfContactInputSpec
+		ExpQueryErr bool
+	}{
+		{
+			Name:        "Empty ID",
+			ID:          "",
+			Spec:        spec,
+			ExpQueryErr: true,
+		},
+		{
+			Name:        "Empty email",
+			ID:          "point_of_contacts:123",
+			Spec:        model.PointOfContactInputSpec{Info: "info1"},
+			ExpQueryErr: true,
+		},
+		{
+			Name:        "Empty info",
+			ID:          "point_of_contacts:123",
+			Spec:        model.PointOfContactInputSpec{Email: "a@b.com"},
+			ExpQueryErr: true,
+		},
+		{
+			Name: "Happy path",
+			ID:   "point_of_contacts:123",
+			Spec: spec,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpQueryErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				UpdatePointOfContact(ctx, test.ID, test.Spec).
+				Return(&model.PointOfContact{ID: test.ID}, nil).
+				Times(times)
+			_, err := r.Mutation().UpdatePointOfContact(ctx, test.ID, test.Spec)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.44
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// IngestExploitReference is the resolver for the ingestExploitReference field.
+func (r *mutationResolver) IngestExploitReference(ctx context.Context, vulnerability model.IDorVulnerabilityInput, exploitReference model.ExploitReferenceInputSpec) (string, error) {
+	funcName := "IngestExploitReference"
+
+	source, err := validateExploitReferenceSource(exploitReference.Source)
+	if err != nil {
+		return "", errext.Errorf("%v ::  %s", funcName, err)
+	}
+	exploitReference.Source = source
+
+	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+	if vulnerability.VulnerabilityInput != nil {
+		err := validateNoVul(*vulnerability.VulnerabilityInput)
+		if err != nil {
+			return "", errext.Errorf("%v ::  %s", funcName, err)
+		}
+
+		err = validateVulnerabilityIDInputSpec(*vulnerability.VulnerabilityInput)
+		if err != nil {
+			return "", errext.Errorf("%v ::  %s", funcName, err)
+		}
+		return r.Backend.IngestExploitReference(ctx, model.IDorVulnerabilityInput{
+			VulnerabilityTypeID: vulnerability.VulnerabilityTypeID,
+			VulnerabilityNodeID: vulnerability.VulnerabilityNodeID,
+			VulnerabilityInput:  &model.VulnerabilityInputSpec{Type: strings.ToLower(vulnerability.VulnerabilityInput.Type), VulnerabilityID: strings.ToLower(vulnerability.VulnerabilityInput.VulnerabilityID)},
+		}, exploitReference)
+	} else {
+		return r.Backend.IngestExploitReference(ctx, vulnerability, exploitReference)
+	}
+}
+
+// ExploitReferences is the resolver for the ExploitReferences field.
+func (r *queryResolver) ExploitReferences(ctx context.Context, exploitReferenceSpec model.ExploitReferenceSpec) ([]*model.ExploitReference, error) {
+	if exploitReferenceSpec.Vulnerability != nil {
+		if err := validateVulnerabilitySpec(*exploitReferenceSpec.Vulnerability); err != nil {
+			return nil, errext.Errorf("ExploitReferences :: %s", err)
+		}
+	}
+
+	// source is stored uppercase, vulnerability type and ID lowercase
+	if exploitReferenceSpec.Source != nil {
+		upper := strings.ToUpper(*exploitReferenceSpec.Source)
+		exploitReferenceSpec.Source = &upper
+	}
+	exploitReferenceSpec.Vulnerability = lowercaseVulnerabilitySpec(exploitReferenceSpec.Vulnerability)
+	return r.Backend.ExploitReferences(ctx, &exploitReferenceSpec)
+}
@@ -0,0 +1,90 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+)
+
+func TestIngestExploitReference(t *testing.T) {
+	tests := []struct {
+		Name         string
+		Vuln         *model.VulnerabilityInputSpec
+		Exploit      model.ExploitReferenceInputSpec
+		ExpSource    string
+		ExpIngestErr bool
+	}{
+		{
+			Name: "Ingest with type novuln",
+			Vuln: &model.VulnerabilityInputSpec{
+				Type: "novuln",
+			},
+			Exploit: model.ExploitReferenceInputSpec{
+				Source: "EXPLOIT_DB",
+				URL:    "https://www.exploit-db.com/exploits/50592",
+			},
+			ExpIngestErr: true,
+		},
+		{
+			Name: "Ingest with unknown source",
+			Vuln: testdata.O1,
+			Exploit: model.ExploitReferenceInputSpec{
+				Source: "PASTEBIN",
+				URL:    "https://pastebin.com/abc",
+			},
+			ExpIngestErr: true,
+		},
+		{
+			Name: "Source is uppercased",
+			Vuln: testdata.O1,
+			Exploit: model.ExploitReferenceInputSpec{
+				Source: "metasploit",
+				URL:    "https://github.com/rapid7/metasploit-framework",
+			},
+			ExpSource: "METASPLOIT",
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpIngestErr {
+				times = 0
+			}
+			expExploit := test.Exploit
+			expExploit.Source = test.ExpSource
+			b.
+				EXPECT().
+				IngestExploitReference(ctx, gomock.Any(), expExploit).
+				Return("xyz", nil).
+				Times(times)
+			_, err := r.Mutation().IngestExploitReference(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: test.Vuln}, test.Exploit)
+			if (err != nil) != test.ExpIngestErr {
+				t.Fatalf("did not get expected ingest error, want: %v, got: %v", test.ExpIngestErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,341 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+	"encoding/base64"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/guacsec/guac/internal/client"
+	"github.com/guacsec/guac/internal/client/githubclient"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// recentCommitsTTL is how long the recent commits of a repository are cached,
+// so that repeated queries do not use up the GitHub API rate limit.
+const recentCommitsTTL = 5 * time.Minute
+
+// recentCommitsTimeout bounds the GitHub API call, so that an unreachable API
+// does not hold up the rest of the query.
+const recentCommitsTimeout = 10 * time.Second
+
+// commitHistoryBatchSize is the number of commits fetched from the GitHub API
+// at a time, the most it returns in a single page.
+const commitHistoryBatchSize = 100
+
+type commitCacheKey struct {
+	token string
+	owner string
+	repo  string
+	limit int
+}
+
+type commitCacheEntry struct {
+	commits []*model.GitCommit
+	expires time.Time
+}
+
+// commitCache holds the recent commits fetched from GitHub. The zero value is
+// an empty cache.
+type commitCache struct {
+	mu      sync.Mutex
+	entries map[commitCacheKey]commitCacheEntry
+}
+
+func (c *commitCache) get(key commitCacheKey, now time.Time) ([]*model.GitCommit, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !now.Before(entry.expires) {
+		return nil, false
+	}
+	return entry.commits, true
+}
+
+func (c *commitCache) put(key commitCacheKey, commits []*model.GitCommit, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = map[commitCacheKey]commitCacheEntry{}
+	}
+	// expired entries are dropped here so that the cache only holds the
+	// repositories queried in the last TTL
+	for k, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = commitCacheEntry{commits: commits, expires: now.Add(recentCommitsTTL)}
+}
+
+func defaultGithubClient(token string) githubclient.GithubClient {
+	return githubclient.NewUnverifiedGithubClient(token)
+}
+
+// githubRepo returns the owner and name of the GitHub repository of a git
+// source, e.g. guacsec and guac for the namespace github.com/guacsec and the
+// name guac.
+func githubRepo(src *model.Source) (string, string, bool) {
+	if src.Type != "git" || len(src.Namespaces) == 0 || len(src.Namespaces[0].Names) == 0 {
+		return "", "", false
+	}
+	owner, ok := strings.CutPrefix(src.Namespaces[0].Namespace, "github.com/")
+	if !ok || owner == "" || strings.Contains(owner, "/") {
+		return "", "", false
+	}
+	repo := strings.TrimSuffix(src.Namespaces[0].Names[0].Name, ".git")
+	if repo == "" {
+		return "", "", false
+	}
+	return owner, repo, true
+}
+
+// recentCommits returns the limit most recent commits of a GitHub repository,
+// using the GitHub token of the context if there is one, or nil if they can
+// not be fetched.
+func (r *Resolver) recentCommits(ctx context.Context, owner, repo string, limit int) []*model.GitCommit {
+	token := githubclient.TokenFromContext(ctx)
+	key := commitCacheKey{token: token, owner: owner, repo: repo, limit: limit}
+	if commits, ok := r.commits.get(key, time.Now()); ok {
+		return commits
+	}
+
+	newClient := r.GithubClient
+	if newClient == nil {
+		newClient = defaultGithubClient
+	}
+	ctx, cancel := context.WithTimeout(ctx, recentCommitsTimeout)
+	defer cancel()
+	commits, err := newClient(token).ListCommits(ctx, owner, repo, limit)
+	if err != nil {
+		logging.FromContext(ctx).Debugf("unable to fetch the recent commits of %s/%s: %v", owner, repo, err)
+		return nil
+	}
+
+	res := make([]*model.GitCommit, 0, len(commits))
+	for _, commit := range commits {
+		res = append(res, toModelGitCommit(commit))
+	}
+	r.commits.put(key, res, time.Now())
+	return res
+}
+
+func toModelGitCommit(commit *client.Commit) *model.GitCommit {
+	return &model.GitCommit{
+		Sha:        commit.SHA,
+		Message:    commit.Message,
+		AuthorDate: commit.AuthorDate,
+	}
+}
+
+// encodeCommitCursor returns the cursor of a commit in the commit history,
+// its base64 encoded RFC3339 author date.
+func encodeCommitCursor(authorDate time.Time) string {
+	return base64.StdEncoding.EncodeToString([]byte(authorDate.UTC().Format(time.RFC3339)))
+}
+
+func decodeCommitCursor(cursor string) (time.Time, error) {
+	date, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339, string(date))
+}
+
+type commitHistoryKey struct {
+	token string
+	owner string
+	repo  string
+}
+
+// commitHistory buffers the commits of a repository fetched so far, newest
+// first. It holds every commit authored no later than until, or since the head
+// of the branch if until is zero, down to the oldest one fetched.
+type commitHistory struct {
+	mu        sync.Mutex
+	until     time.Time
+	commits   []*model.GitCommit
+	seen      map[string]bool
+	exhausted bool
+	// expires is set when the history is created and never changes
+	expires time.Time
+}
+
+// restart empties the buffer, which then fills from until.
+func (h *commitHistory) restart(until time.Time) {
+	h.until = until
+	h.commits = nil
+	h.seen = map[string]bool{}
+	h.exhausted = false
+}
+
+// authoredBefore returns the buffered commits authored before date, or all of
+// them if date is zero.
+func (h *commitHistory) authoredBefore(date time.Time) []*model.GitCommit {
+	if date.IsZero() {
+		return h.commits
+	}
+	i := sort.Search(len(h.commits), func(i int) bool {
+		return h.commits[i].AuthorDate.Truncate(time.Second).Before(date)
+	})
+	return h.commits[i:]
+}
+
+// fetch adds the next batch of commits, older than the ones buffered, marking
+// the history exhausted once there are none left.
+func (h *commitHistory) fetch(ctx context.Context, gc githubclient.GithubClient, owner, repo string) error {
+	until := h.until
+	if n := len(h.commits); n > 0 {
+		until = h.commits[n-1].AuthorDate
+	}
+	ctx, cancel := context.WithTimeout(ctx, recentCommitsTimeout)
+	defer cancel()
+	commits, err := gc.ListCommitsUntil(ctx, owner, repo, until, commitHistoryBatchSize)
+	if err != nil {
+		return err
+	}
+
+	// until is inclusive, so the oldest buffered commits are listed again
+	added := 0
+	for _, commit := range commits {
+		if h.seen[commit.SHA] {
+			continue
+		}
+		h.seen[commit.SHA] = true
+		h.commits = append(h.commits, toModelGitCommit(commit))
+		added++
+	}
+	sort.SliceStable(h.commits, func(i, j int) bool {
+		return h.commits[i].AuthorDate.After(h.commits[j].AuthorDate)
+	})
+	if added == 0 || len(commits) < commitHistoryBatchSize {
+		h.exhausted = true
+	}
+	return nil
+}
+
+// commitHistoryCache holds the commit histories being paged through. The zero
+// value is an empty cache.
+type commitHistoryCache struct {
+	mu      sync.Mutex
+	entries map[commitHistoryKey]*commitHistory
+}
+
+// get returns the history of a repository, starting a new one if there is
+// none or it expired.
+func (c *commitHistoryCache) get(key commitHistoryKey, now time.Time) *commitHistory {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if h, ok := c.entries[key]; ok && now.Before(h.expires) {
+		return h
+	}
+	if c.entries == nil {
+		c.entries = map[commitHistoryKey]*commitHistory{}
+	}
+	for k, h := range c.entries {
+		if !now.Before(h.expires) {
+			delete(c.entries, k)
+		}
+	}
+	h := &commitHistory{seen: map[string]bool{}, expires: now.Add(recentCommitsTTL)}
+	c.entries[key] = h
+	return h
+}
+
+// commitPageEnd returns where the page of the first commits ends, moved so
+// that it does not split commits authored in the same second, as they share
+// a cursor.
+func commitPageEnd(commits []*model.GitCommit, first int) int {
+	sameSecond := func(i int) bool {
+		return commits[i-1].AuthorDate.Truncate(time.Second).Equal(commits[i].AuthorDate.Truncate(time.Second))
+	}
+	end := first
+	for end > 0 && end < len(commits) && sameSecond(end) {
+		end--
+	}
+	if end > 0 {
+		return end
+	}
+	// the whole page was authored in the same second, so it grows instead
+	end = first
+	for end < len(commits) && sameSecond(end) {
+		end++
+	}
+	return end
+}
+
+// commitHistoryPage returns the first commits of a GitHub repository authored
+// before the date of the after cursor, or since the head of the branch if it
+// is zero, and whether there are more. Commits are fetched from the GitHub API
+// with the token of the context, only when the buffered ones run out.
+func (r *Resolver) commitHistoryPage(ctx context.Context, owner, repo string, after time.Time, first int) ([]*model.GitCommit, bool, error) {
+	token := githubclient.TokenFromContext(ctx)
+	h := r.history.get(commitHistoryKey{token: token, owner: owner, repo: repo}, time.Now())
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// the buffer is started from the cursor, rather than fetching every newer
+	// commit, and restarted when the cursor is newer than what it covers
+	if len(h.commits) == 0 && !h.exhausted || !h.until.IsZero() && (after.IsZero() || after.After(h.until)) {
+		h.restart(after)
+	}
+
+	newClient := r.GithubClient
+	if newClient == nil {
+		newClient = defaultGithubClient
+	}
+	gc := newClient(token)
+	older := h.authoredBefore(after)
+	for len(older) <= first && !h.exhausted {
+		if err := h.fetch(ctx, gc, owner, repo); err != nil {
+			return nil, false, err
+		}
+		older = h.authoredBefore(after)
+	}
+
+	end := len(older)
+	if end > first {
+		end = commitPageEnd(older, first)
+	}
+	// the buffer is sorted again by later fetches, so the page is copied
+	page := append([]*model.GitCommit(nil), older[:end]...)
+	return page, end < len(older), nil
+}
+
+// toCommitConnection returns a page of the commit history as a connection.
+func toCommitConnection(commits []*model.GitCommit, hasNextPage, hasPreviousPage bool) *model.CommitConnection {
+	edges := make([]*model.CommitEdge, 0, len(commits))
+	for _, commit := range commits {
+		edges = append(edges, &model.CommitEdge{
+			Cursor: encodeCommitCursor(commit.AuthorDate),
+			Node:   commit,
+		})
+	}
+	pageInfo := &model.PageInfo{
+		HasNextPage:     hasNextPage,
+		HasPreviousPage: hasPreviousPage,
+	}
+	if len(edges) > 0 {
+		pageInfo.StartCursor = &edges[0].Cursor
+		pageInfo.EndCursor = &edges[len(edges)-1].Cursor
+	}
+	return &model.CommitConnection{Edges: edges, PageInfo: pageInfo}
+}
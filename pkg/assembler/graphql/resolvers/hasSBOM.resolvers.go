@@ -6,7 +6,10 @@ package resolvers
 
 import (
 	"context"
+	"slices"
 
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -15,10 +18,10 @@ import (
 func (r *mutationResolver) IngestHasSbom(ctx context.Context, subject model.PackageOrArtifactInput, hasSbom model.HasSBOMInputSpec, includes model.HasSBOMIncludesInputSpec) (string, error) {
 	funcName := "IngestHasSbom"
 	if err := validatePackageOrArtifactInput(&subject, funcName); err != nil {
-		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+		return "", errext.Errorf("%v ::  %s", funcName, err)
 	}
 	if hasSbom.KnownSince.IsZero() {
-		return "", gqlerror.Errorf("hasSbom.KnownSince is a zero time")
+		return "", errext.WithCode(gqlerror.Errorf("hasSbom.KnownSince is a zero time"), errext.ErrInvalidInput)
 	}
 
 	return r.Backend.IngestHasSbom(ctx, subject, hasSbom, includes)
@@ -31,28 +34,28 @@ func (r *mutationResolver) IngestHasSBOMs(ctx context.Context, subjects model.Pa
 	ingestedHasSBOMSIDS := []string{}
 	if len(subjects.Packages) > 0 {
 		if len(subjects.Packages) != len(hasSBOMs) {
-			return ingestedHasSBOMSIDS, gqlerror.Errorf("%v :: uneven packages and hasSBOMs for ingestion", funcName)
+			return ingestedHasSBOMSIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven packages and hasSBOMs for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if len(subjects.Artifacts) > 0 {
 		if len(subjects.Artifacts) != len(hasSBOMs) {
-			return ingestedHasSBOMSIDS, gqlerror.Errorf("%v :: uneven artifact and hasSBOMs for ingestion", funcName)
+			return ingestedHasSBOMSIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven artifact and hasSBOMs for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if valuesDefined != 1 {
-		return ingestedHasSBOMSIDS, gqlerror.Errorf("%v :: must specify at most packages or artifacts for ingestion", funcName)
+		return ingestedHasSBOMSIDS, errext.WithCode(gqlerror.Errorf("%v :: must specify at most packages or artifacts for ingestion", funcName), errext.ErrInvalidInput)
 	}
 
 	for _, hasSbom := range hasSBOMs {
 		if hasSbom.KnownSince.IsZero() {
-			return ingestedHasSBOMSIDS, gqlerror.Errorf("hasSBOMS contains a zero time")
+			return ingestedHasSBOMSIDS, errext.WithCode(gqlerror.Errorf("hasSBOMS contains a zero time"), errext.ErrInvalidInput)
 		}
 	}
 
 	if len(hasSBOMs) != len(includes) {
-		return ingestedHasSBOMSIDS, gqlerror.Errorf("%v :: uneven hasSBOMs and includes for ingestion", funcName)
+		return ingestedHasSBOMSIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven hasSBOMs and includes for ingestion", funcName), errext.ErrInvalidInput)
 	}
 	return r.Backend.IngestHasSBOMs(ctx, subjects, hasSBOMs, includes)
 }
@@ -60,7 +63,97 @@ func (r *mutationResolver) IngestHasSBOMs(ctx context.Context, subjects model.Pa
 // HasSbom is the resolver for the HasSBOM field.
 func (r *queryResolver) HasSbom(ctx context.Context, hasSBOMSpec model.HasSBOMSpec) ([]*model.HasSbom, error) {
 	if err := validatePackageOrArtifactQueryFilter(hasSBOMSpec.Subject); err != nil {
-		return nil, gqlerror.Errorf("%v :: %s", "HasSBOM", err)
+		return nil, errext.Errorf("%v :: %s", "HasSBOM", err)
 	}
 	return r.Backend.HasSBOM(ctx, &hasSBOMSpec)
 }
+
+// HasSBOMList is the resolver for the HasSBOMList field.
+func (r *queryResolver) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, pagination *model.PaginationSpec) (*model.HasSBOMConnection, error) {
+	if err := validatePaginationSpec("HasSBOMList", pagination); err != nil {
+		return nil, err
+	}
+	if err := validatePackageOrArtifactQueryFilter(hasSBOMSpec.Subject); err != nil {
+		return nil, errext.Errorf("HasSBOMList :: %s", err)
+	}
+	return r.Backend.HasSBOMList(ctx, hasSBOMSpec, pagination)
+}
+
+// SBOMComponentBreakdown is the resolver for the SBOMComponentBreakdown field.
+func (r *queryResolver) SBOMComponentBreakdown(ctx context.Context, hasSbomid string) ([]*model.ComponentTypeCount, error) {
+	if hasSbomid == "" {
+		return nil, errext.WithCode(gqlerror.Errorf("SBOMComponentBreakdown :: hasSBOMID must not be empty"), errext.ErrInvalidInput)
+	}
+	return r.Backend.SBOMComponentBreakdown(ctx, hasSbomid)
+}
+
+// VulnerableInSbom is the resolver for the vulnerableInSBOM field.
+func (r *queryResolver) VulnerableInSbom(ctx context.Context, vulnSpec model.VulnerabilitySpec, filter *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	funcName := "VulnerableInSBOM"
+	if vulnSpec.NoVuln != nil && *vulnSpec.NoVuln {
+		return nil, errext.WithCode(gqlerror.Errorf("%v :: vulnSpec must not match the novuln vulnerability", funcName), errext.ErrInvalidInput)
+	}
+	hasSBOMSpec := model.HasSBOMSpec{}
+	if filter != nil {
+		if err := validatePackageOrArtifactQueryFilter(filter.Subject); err != nil {
+			return nil, errext.Errorf("%v :: %s", funcName, err)
+		}
+		hasSBOMSpec = *filter
+	}
+
+	certs, err := r.Backend.CertifyVuln(ctx, &model.CertifyVulnSpec{Vulnerability: &vulnSpec})
+	if err != nil {
+		return nil, errext.Errorf("%v :: %s", funcName, err)
+	}
+	var pkgIDs []string
+	seenPkgs := map[string]bool{}
+	for _, cert := range certs {
+		pkgID := cert.Package.Namespaces[0].Names[0].Versions[0].ID
+		if !seenPkgs[pkgID] {
+			seenPkgs[pkgID] = true
+			pkgIDs = append(pkgIDs, pkgID)
+		}
+	}
+
+	// the included software of the filter must all be included, so each
+	// affected package is queried separately to get the SBOMs including any
+	hasSBOMs := []*model.HasSbom{}
+	seenSBOMs := map[string]bool{}
+	for _, pkgID := range pkgIDs {
+		spec := hasSBOMSpec
+		spec.IncludedSoftware = append(slices.Clone(hasSBOMSpec.IncludedSoftware), &model.PackageOrArtifactSpec{
+			Package: &model.PkgSpec{ID: ptrfrom.String(pkgID)},
+		})
+		sboms, err := r.Backend.HasSBOM(ctx, &spec)
+		if err != nil {
+			return nil, errext.Errorf("%v :: %s", funcName, err)
+		}
+		for _, sbom := range sboms {
+			if !seenSBOMs[sbom.ID] {
+				seenSBOMs[sbom.ID] = true
+				hasSBOMs = append(hasSBOMs, sbom)
+			}
+		}
+	}
+	return hasSBOMs, nil
+}
+
+// DiffSbom is the resolver for the diffSBOM field.
+func (r *queryResolver) DiffSbom(ctx context.Context, sbom1 string, sbom2 string) (*model.SBOMDiff, error) {
+	funcName := "DiffSBOM"
+	if sbom1 == "" || sbom2 == "" {
+		return nil, errext.WithCode(gqlerror.Errorf("%v :: sbom1 and sbom2 must not be empty", funcName), errext.ErrInvalidInput)
+	}
+	sboms := make([]*model.HasSbom, 0, 2)
+	for _, id := range []string{sbom1, sbom2} {
+		found, err := r.Backend.HasSBOM(ctx, &model.HasSBOMSpec{ID: ptrfrom.String(id)})
+		if err != nil {
+			return nil, errext.Errorf("%v :: %s", funcName, err)
+		}
+		if len(found) == 0 {
+			return nil, errext.WithCode(gqlerror.Errorf("%v :: HasSBOM %s not found", funcName, id), errext.ErrNotFound)
+		}
+		sboms = append(sboms, found[0])
+	}
+	return diffIncludedPackages(sboms[0], sboms[1]), nil
+}
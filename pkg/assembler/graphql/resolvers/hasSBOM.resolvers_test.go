@@ -17,12 +17,15 @@ package resolvers_test
 
 import (
 	"context"
+	"sort"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/internal/testing/mocks"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
 )
@@ -278,3 +281,350 @@ func TestHasSbom(t *testing.T) {
 		})
 	}
 }
+
+func TestSBOMComponentBreakdown(t *testing.T) {
+	tests := []struct {
+		Name        string
+		HasSBOMID   string
+		ExpQueryErr bool
+	}{
+		{
+			Name:        "Empty ID",
+			HasSBOMID:   "",
+			ExpQueryErr: true,
+		},
+		{
+			Name:        "Happy path",
+			HasSBOMID:   "test hasSBOM ID",
+			ExpQueryErr: false,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpQueryErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				SBOMComponentBreakdown(ctx, test.HasSBOMID).
+				Times(times)
+			_, err := r.Query().SBOMComponentBreakdown(ctx, test.HasSBOMID)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+		})
+	}
+}
+
+func TestVulnerableInSBOM(t *testing.T) {
+	vulnSpec := model.VulnerabilitySpec{Type: ptrfrom.String("osv"), VulnerabilityID: ptrfrom.String("osv-2022-1")}
+	certFor := func(pkgID string) *model.CertifyVuln {
+		return &model.CertifyVuln{Package: &model.Package{Namespaces: []*model.PackageNamespace{{
+			Names: []*model.PackageName{{Versions: []*model.PackageVersion{{ID: pkgID}}}},
+		}}}}
+	}
+	includes := func(pkgID string) []*model.PackageOrArtifactSpec {
+		return []*model.PackageOrArtifactSpec{{Package: &model.PkgSpec{ID: ptrfrom.String(pkgID)}}}
+	}
+	sbom1 := &model.HasSbom{ID: "sbom1", URI: "test uri"}
+	sbom2 := &model.HasSbom{ID: "sbom2", URI: "test uri"}
+
+	tests := []struct {
+		Name        string
+		VulnSpec    model.VulnerabilitySpec
+		Filter      *model.HasSBOMSpec
+		Certs       []*model.CertifyVuln
+		SBOMs       map[string][]*model.HasSbom
+		ExpSBOMs    []*model.HasSbom
+		ExpQueryErr bool
+	}{
+		{
+			Name:     "Vulnerable package in SBOM",
+			VulnSpec: vulnSpec,
+			Certs:    []*model.CertifyVuln{certFor("pkg1")},
+			SBOMs:    map[string][]*model.HasSbom{"pkg1": {sbom1}},
+			ExpSBOMs: []*model.HasSbom{sbom1},
+		},
+		{
+			Name:     "Union of the SBOMs of the packages",
+			VulnSpec: vulnSpec,
+			Filter:   &model.HasSBOMSpec{URI: ptrfrom.String("test uri")},
+			Certs:    []*model.CertifyVuln{certFor("pkg1"), certFor("pkg2"), certFor("pkg1")},
+			SBOMs: map[string][]*model.HasSbom{
+				"pkg1": {sbom1, sbom2},
+				"pkg2": {sbom2},
+			},
+			ExpSBOMs: []*model.HasSbom{sbom1, sbom2},
+		},
+		{
+			Name:     "Vulnerability not affecting any package",
+			VulnSpec: vulnSpec,
+			ExpSBOMs: []*model.HasSbom{},
+		},
+		{
+			Name:        "novuln",
+			VulnSpec:    model.VulnerabilitySpec{NoVuln: ptrfrom.Bool(true)},
+			ExpQueryErr: true,
+		},
+		{
+			Name:     "Filter with two subjects",
+			VulnSpec: vulnSpec,
+			Filter: &model.HasSBOMSpec{Subject: &model.PackageOrArtifactSpec{
+				Package:  &model.PkgSpec{Version: ptrfrom.String("2.11.1")},
+				Artifact: &model.ArtifactSpec{Algorithm: ptrfrom.String("asdf")},
+			}},
+			ExpQueryErr: true,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpQueryErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				CertifyVuln(ctx, &model.CertifyVulnSpec{Vulnerability: &test.VulnSpec}).
+				Return(test.Certs, nil).
+				Times(times)
+			for pkgID, sboms := range test.SBOMs {
+				spec := model.HasSBOMSpec{}
+				if test.Filter != nil {
+					spec = *test.Filter
+				}
+				spec.IncludedSoftware = includes(pkgID)
+				b.
+					EXPECT().
+					HasSBOM(ctx, &spec).
+					Return(sboms, nil).
+					Times(1)
+			}
+			got, err := r.Query().VulnerableInSbom(ctx, test.VulnSpec, test.Filter)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(test.ExpSBOMs, got); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVulnerableInSBOMWithBackend(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", ctx, nil)
+	if err != nil {
+		t.Fatalf("Could not create backend: %v", err)
+	}
+	pkgIDs := map[*model.PkgInputSpec]string{}
+	for _, p := range []*model.PkgInputSpec{testdata.P1, testdata.P2, testdata.P4} {
+		ids, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p})
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		pkgIDs[p] = ids.PackageVersionID
+	}
+	for _, v := range []*model.VulnerabilityInputSpec{testdata.C1, testdata.C2} {
+		if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: v}); err != nil {
+			t.Fatalf("Could not ingest vulnerability: %v", err)
+		}
+	}
+	// C1 affects P1 and C2 affects P4
+	for pkg, vuln := range map[*model.PkgInputSpec]*model.VulnerabilityInputSpec{testdata.P1: testdata.C1, testdata.P4: testdata.C2} {
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: pkg},
+			model.IDorVulnerabilityInput{VulnerabilityInput: vuln},
+			model.ScanMetadataInput{TimeScanned: t1}); err != nil {
+			t.Fatalf("Could not ingest certify vuln: %v", err)
+		}
+	}
+	// the P1 SBOM includes P1 and P2, the P4 SBOM includes P4
+	sbomIDs := map[string]string{}
+	for uri, sbom := range map[string]struct {
+		subject  *model.PkgInputSpec
+		includes []*model.PkgInputSpec
+	}{
+		"sbom-p1": {testdata.P1, []*model.PkgInputSpec{testdata.P1, testdata.P2}},
+		"sbom-p4": {testdata.P4, []*model.PkgInputSpec{testdata.P4}},
+	} {
+		var included []string
+		for _, p := range sbom.includes {
+			included = append(included, pkgIDs[p])
+		}
+		id, err := b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: sbom.subject}},
+			model.HasSBOMInputSpec{URI: uri}, model.HasSBOMIncludesInputSpec{Packages: included})
+		if err != nil {
+			t.Fatalf("Could not ingest HasSBOM: %v", err)
+		}
+		sbomIDs[uri] = id
+	}
+	r := resolvers.Resolver{Backend: b}
+
+	tests := []struct {
+		Name     string
+		VulnSpec model.VulnerabilitySpec
+		Filter   *model.HasSBOMSpec
+		ExpURIs  []string
+	}{
+		{
+			Name:     "Known vulnerability",
+			VulnSpec: model.VulnerabilitySpec{Type: ptrfrom.String("cve"), VulnerabilityID: ptrfrom.String("cve-2019-13110")},
+			ExpURIs:  []string{"sbom-p1"},
+		},
+		{
+			Name:     "Vulnerability type",
+			VulnSpec: model.VulnerabilitySpec{Type: ptrfrom.String("cve")},
+			ExpURIs:  []string{"sbom-p1", "sbom-p4"},
+		},
+		{
+			Name:     "Filtered by URI",
+			VulnSpec: model.VulnerabilitySpec{Type: ptrfrom.String("cve")},
+			Filter:   &model.HasSBOMSpec{URI: ptrfrom.String("sbom-p4")},
+			ExpURIs:  []string{"sbom-p4"},
+		},
+		{
+			Name:     "Unknown vulnerability",
+			VulnSpec: model.VulnerabilitySpec{Type: ptrfrom.String("ghsa")},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := r.Query().VulnerableInSbom(ctx, test.VulnSpec, test.Filter)
+			if err != nil {
+				t.Fatalf("VulnerableInSbom() error = %v", err)
+			}
+			var gotURIs []string
+			for _, sbom := range got {
+				if sbom.ID != sbomIDs[sbom.URI] {
+					t.Errorf("got ID %s for SBOM %s, want %s", sbom.ID, sbom.URI, sbomIDs[sbom.URI])
+				}
+				gotURIs = append(gotURIs, sbom.URI)
+			}
+			sort.Strings(gotURIs)
+			if diff := cmp.Diff(test.ExpURIs, gotURIs); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffSBOMWithBackend(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", ctx, nil)
+	if err != nil {
+		t.Fatalf("Could not create backend: %v", err)
+	}
+	pkgIDs := map[*model.PkgInputSpec]string{}
+	for _, p := range []*model.PkgInputSpec{testdata.P1, testdata.P2, testdata.P3, testdata.P4} {
+		ids, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p})
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		pkgIDs[p] = ids.PackageVersionID
+	}
+	artID, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A1})
+	if err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	// the new SBOM drops P1, keeps P2 and P3 and adds P4
+	sbomIDs := map[string]string{}
+	for uri, includes := range map[string][]*model.PkgInputSpec{
+		"sbom-v1": {testdata.P1, testdata.P2, testdata.P3},
+		"sbom-v2": {testdata.P2, testdata.P3, testdata.P4},
+		"sbom-v3": {testdata.P2, testdata.P3, testdata.P4},
+	} {
+		var included []string
+		for _, p := range includes {
+			included = append(included, pkgIDs[p])
+		}
+		id, err := b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: testdata.P4}},
+			model.HasSBOMInputSpec{URI: uri}, model.HasSBOMIncludesInputSpec{Packages: included, Artifacts: []string{artID}})
+		if err != nil {
+			t.Fatalf("Could not ingest HasSBOM: %v", err)
+		}
+		sbomIDs[uri] = id
+	}
+	r := resolvers.Resolver{Backend: b}
+
+	versionIDs := func(pkgs []*model.Package) []string {
+		var ids []string
+		for _, p := range pkgs {
+			ids = append(ids, p.Namespaces[0].Names[0].Versions[0].ID)
+		}
+		sort.Strings(ids)
+		return ids
+	}
+	idsOf := func(pkgs ...*model.PkgInputSpec) []string {
+		var ids []string
+		for _, p := range pkgs {
+			ids = append(ids, pkgIDs[p])
+		}
+		sort.Strings(ids)
+		return ids
+	}
+	tests := []struct {
+		Name         string
+		SBOM1, SBOM2 string
+		ExpAdded     []string
+		ExpRemoved   []string
+		ExpUnchanged []string
+	}{
+		{
+			Name:         "New version",
+			SBOM1:        "sbom-v1",
+			SBOM2:        "sbom-v2",
+			ExpAdded:     idsOf(testdata.P4),
+			ExpRemoved:   idsOf(testdata.P1),
+			ExpUnchanged: idsOf(testdata.P2, testdata.P3),
+		},
+		{
+			Name:         "Reversed",
+			SBOM1:        "sbom-v2",
+			SBOM2:        "sbom-v1",
+			ExpAdded:     idsOf(testdata.P1),
+			ExpRemoved:   idsOf(testdata.P4),
+			ExpUnchanged: idsOf(testdata.P2, testdata.P3),
+		},
+		{
+			Name:         "Same packages",
+			SBOM1:        "sbom-v2",
+			SBOM2:        "sbom-v3",
+			ExpUnchanged: idsOf(testdata.P2, testdata.P3, testdata.P4),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := r.Query().DiffSbom(ctx, sbomIDs[test.SBOM1], sbomIDs[test.SBOM2])
+			if err != nil {
+				t.Fatalf("DiffSbom() error = %v", err)
+			}
+			if diff := cmp.Diff(test.ExpAdded, versionIDs(got.Added)); diff != "" {
+				t.Errorf("Unexpected added packages (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.ExpRemoved, versionIDs(got.Removed)); diff != "" {
+				t.Errorf("Unexpected removed packages (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.ExpUnchanged, versionIDs(got.Unchanged)); diff != "" {
+				t.Errorf("Unexpected unchanged packages (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if _, err := r.Query().DiffSbom(ctx, sbomIDs["sbom-v1"], ""); err == nil {
+		t.Errorf("expected error for an empty SBOM ID")
+	}
+	if _, err := r.Query().DiffSbom(ctx, sbomIDs["sbom-v1"], artID); err == nil {
+		t.Errorf("expected error for an unknown SBOM ID")
+	}
+}
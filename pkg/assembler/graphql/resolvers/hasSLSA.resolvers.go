@@ -7,6 +7,7 @@ package resolvers
 import (
 	"context"
 
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -14,7 +15,7 @@ import (
 // IngestSlsa is the resolver for the ingestSLSA field.
 func (r *mutationResolver) IngestSlsa(ctx context.Context, subject model.IDorArtifactInput, builtFrom []*model.IDorArtifactInput, builtBy model.IDorBuilderInput, slsa model.SLSAInputSpec) (string, error) {
 	if len(builtFrom) < 1 {
-		return "", gqlerror.Errorf("IngestSLSA :: Must have at least 1 builtFrom")
+		return "", errext.WithCode(gqlerror.Errorf("IngestSLSA :: Must have at least 1 builtFrom"), errext.ErrInvalidInput)
 	}
 
 	return r.Backend.IngestSLSA(ctx, subject, builtFrom, builtBy, slsa)
@@ -25,13 +26,13 @@ func (r *mutationResolver) IngestSLSAs(ctx context.Context, subjects []*model.ID
 	funcName := "IngestSLSAs"
 	ingestedSLSAIDS := []string{}
 	if len(subjects) != len(slsaList) {
-		return ingestedSLSAIDS, gqlerror.Errorf("%v :: uneven subjects and slsa attestation for ingestion", funcName)
+		return ingestedSLSAIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven subjects and slsa attestation for ingestion", funcName), errext.ErrInvalidInput)
 	}
 	if len(subjects) != len(builtFromList) {
-		return ingestedSLSAIDS, gqlerror.Errorf("%v :: uneven subjects and built from artifact list for ingestion", funcName)
+		return ingestedSLSAIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven subjects and built from artifact list for ingestion", funcName), errext.ErrInvalidInput)
 	}
 	if len(subjects) != len(builtByList) {
-		return ingestedSLSAIDS, gqlerror.Errorf("%v :: uneven subjects and built by for ingestion", funcName)
+		return ingestedSLSAIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven subjects and built by for ingestion", funcName), errext.ErrInvalidInput)
 	}
 
 	return r.Backend.IngestSLSAs(ctx, subjects, builtFromList, builtByList, slsaList)
@@ -41,3 +42,11 @@ func (r *mutationResolver) IngestSLSAs(ctx context.Context, subjects []*model.ID
 func (r *queryResolver) HasSlsa(ctx context.Context, hasSLSASpec model.HasSLSASpec) ([]*model.HasSlsa, error) {
 	return r.Backend.HasSlsa(ctx, &hasSLSASpec)
 }
+
+// HasSLSAList is the resolver for the HasSLSAList field.
+func (r *queryResolver) HasSLSAList(ctx context.Context, hasSLSASpec model.HasSLSASpec, pagination *model.PaginationSpec) (*model.HasSLSAConnection, error) {
+	if err := validatePaginationSpec("HasSLSAList", pagination); err != nil {
+		return nil, err
+	}
+	return r.Backend.HasSLSAList(ctx, hasSLSASpec, pagination)
+}
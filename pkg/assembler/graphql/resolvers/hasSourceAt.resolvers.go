@@ -7,6 +7,7 @@ package resolvers
 import (
 	"context"
 
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -20,15 +21,39 @@ func (r *mutationResolver) IngestHasSourceAt(ctx context.Context, pkg model.IDor
 func (r *mutationResolver) IngestHasSourceAts(ctx context.Context, pkgs []*model.IDorPkgInput, pkgMatchType model.MatchFlags, sources []*model.IDorSourceInput, hasSourceAts []*model.HasSourceAtInputSpec) ([]string, error) {
 	funcName := "IngestHasSourceAts"
 	if len(pkgs) != len(sources) {
-		return []string{}, gqlerror.Errorf("%v :: uneven packages and sources for ingestion", funcName)
+		return []string{}, errext.WithCode(gqlerror.Errorf("%v :: uneven packages and sources for ingestion", funcName), errext.ErrInvalidInput)
 	}
 	if len(pkgs) != len(hasSourceAts) {
-		return []string{}, gqlerror.Errorf("%v :: uneven packages and hasSourceAt for ingestion", funcName)
+		return []string{}, errext.WithCode(gqlerror.Errorf("%v :: uneven packages and hasSourceAt for ingestion", funcName), errext.ErrInvalidInput)
 	}
 	return r.Backend.IngestHasSourceAts(ctx, pkgs, &pkgMatchType, sources, hasSourceAts)
 }
 
+// UpdateHasSourceAt is the resolver for the updateHasSourceAt field.
+func (r *mutationResolver) UpdateHasSourceAt(ctx context.Context, id string, hasSourceAt model.HasSourceAtInputSpec) (*model.HasSourceAt, error) {
+	if id == "" {
+		return nil, errext.WithCode(gqlerror.Errorf("UpdateHasSourceAt :: id must be specified"), errext.ErrInvalidInput)
+	}
+	return r.Backend.UpdateHasSourceAt(ctx, id, hasSourceAt)
+}
+
 // HasSourceAt is the resolver for the HasSourceAt field.
 func (r *queryResolver) HasSourceAt(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec) ([]*model.HasSourceAt, error) {
 	return r.Backend.HasSourceAt(ctx, &hasSourceAtSpec)
 }
+
+// HasSourceAtList is the resolver for the HasSourceAtList field.
+func (r *queryResolver) HasSourceAtList(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec, pagination *model.PaginationSpec) (*model.HasSourceAtConnection, error) {
+	if err := validatePaginationSpec("HasSourceAtList", pagination); err != nil {
+		return nil, err
+	}
+	return r.Backend.HasSourceAtList(ctx, hasSourceAtSpec, pagination)
+}
+
+// HasSourceAtCount is the resolver for the HasSourceAtCount field.
+func (r *queryResolver) HasSourceAtCount(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec) (int, error) {
+	if hasSourceAtSpec == nil {
+		hasSourceAtSpec = &model.HasSourceAtSpec{}
+	}
+	return r.Backend.HasSourceAtCount(ctx, *hasSourceAtSpec)
+}
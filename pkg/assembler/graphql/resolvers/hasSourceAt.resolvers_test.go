@@ -129,3 +129,46 @@ func TestIngestHasSourceAts(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateHasSourceAt(t *testing.T) {
+	spec := model.HasSourceAtInputSpec{
+		KnownSince:    testdata.T1,
+		Justification: "test justification",
+	}
+	tests := []struct {
+		Name        string
+		ID          string
+		ExpQueryErr bool
+	}{
+		{
+			Name:        "Empty ID",
+			ID:          "",
+			ExpQueryErr: true,
+		},
+		{
+			Name: "Happy path",
+			ID:   "has_source_ats:123",
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpQueryErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				UpdateHasSourceAt(ctx, test.ID, spec).
+				Return(&model.HasSourceAt{ID: test.ID}, nil).
+				Times(times)
+			_, err := r.Mutation().UpdateHasSourceAt(ctx, test.ID, spec)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ package resolvers
 import (
 	"context"
 
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -21,9 +22,9 @@ func (r *mutationResolver) IngestHashEquals(ctx context.Context, artifacts []*mo
 	funcName := "IngestHashEquals"
 	ingestedHashEqualsIDS := []string{}
 	if len(artifacts) != len(otherArtifacts) {
-		return ingestedHashEqualsIDS, gqlerror.Errorf("%v :: uneven artifacts and other artifacts for ingestion", funcName)
+		return ingestedHashEqualsIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven artifacts and other artifacts for ingestion", funcName), errext.ErrInvalidInput)
 	} else if len(artifacts) != len(hashEquals) {
-		return ingestedHashEqualsIDS, gqlerror.Errorf("%v :: uneven artifacts and hashEquals for ingestion", funcName)
+		return ingestedHashEqualsIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven artifacts and hashEquals for ingestion", funcName), errext.ErrInvalidInput)
 	}
 
 	return r.Backend.IngestHashEquals(ctx, artifacts, otherArtifacts, hashEquals)
@@ -32,7 +33,18 @@ func (r *mutationResolver) IngestHashEquals(ctx context.Context, artifacts []*mo
 // HashEqual is the resolver for the HashEqual field.
 func (r *queryResolver) HashEqual(ctx context.Context, hashEqualSpec model.HashEqualSpec) ([]*model.HashEqual, error) {
 	if hashEqualSpec.Artifacts != nil && len(hashEqualSpec.Artifacts) > 2 {
-		return nil, gqlerror.Errorf("HashEqual :: Provided spec has too many Artifacts")
+		return nil, errext.WithCode(gqlerror.Errorf("HashEqual :: Provided spec has too many Artifacts"), errext.ErrInvalidInput)
 	}
 	return r.Backend.HashEqual(ctx, &hashEqualSpec)
 }
+
+// HashEqualList is the resolver for the HashEqualList field.
+func (r *queryResolver) HashEqualList(ctx context.Context, hashEqualSpec model.HashEqualSpec, pagination *model.PaginationSpec) (*model.HashEqualConnection, error) {
+	if err := validatePaginationSpec("HashEqualList", pagination); err != nil {
+		return nil, err
+	}
+	if len(hashEqualSpec.Artifacts) > 2 {
+		return nil, errext.WithCode(gqlerror.Errorf("HashEqualList :: Provided spec has too many Artifacts"), errext.ErrInvalidInput)
+	}
+	return r.Backend.HashEqualList(ctx, hashEqualSpec, pagination)
+}
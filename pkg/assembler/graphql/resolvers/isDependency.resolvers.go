@@ -7,6 +7,9 @@ package resolvers
 import (
 	"context"
 
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -15,7 +18,7 @@ import (
 func (r *mutationResolver) IngestDependency(ctx context.Context, pkg model.IDorPkgInput, depPkg model.IDorPkgInput, depPkgMatchType model.MatchFlags, dependency model.IsDependencyInputSpec) (string, error) {
 	funcName := "IngestDependency"
 	if !dependency.DependencyType.IsValid() {
-		return "", gqlerror.Errorf("%v :: dependency type was not valid", funcName)
+		return "", errext.WithCode(gqlerror.Errorf("%v :: dependency type was not valid", funcName), errext.ErrInvalidInput)
 	}
 
 	return r.Backend.IngestDependency(ctx, pkg, depPkg, depPkgMatchType, dependency)
@@ -26,14 +29,14 @@ func (r *mutationResolver) IngestDependencies(ctx context.Context, pkgs []*model
 	funcName := "IngestDependencies"
 	ingestedDependenciesIDS := []string{}
 	if len(pkgs) != len(depPkgs) {
-		return ingestedDependenciesIDS, gqlerror.Errorf("%v :: uneven packages and dependent packages for ingestion", funcName)
+		return ingestedDependenciesIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven packages and dependent packages for ingestion", funcName), errext.ErrInvalidInput)
 	}
 	if len(pkgs) != len(dependencies) {
-		return ingestedDependenciesIDS, gqlerror.Errorf("%v :: uneven packages and dependencies nodes for ingestion", funcName)
+		return ingestedDependenciesIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven packages and dependencies nodes for ingestion", funcName), errext.ErrInvalidInput)
 	}
 	for _, dependency := range dependencies {
 		if dependency != nil && !dependency.DependencyType.IsValid() {
-			return ingestedDependenciesIDS, gqlerror.Errorf("%v :: not all dependencies had valid types", funcName)
+			return ingestedDependenciesIDS, errext.WithCode(gqlerror.Errorf("%v :: not all dependencies had valid types", funcName), errext.ErrInvalidInput)
 		}
 	}
 
@@ -44,8 +47,76 @@ func (r *mutationResolver) IngestDependencies(ctx context.Context, pkgs []*model
 func (r *queryResolver) IsDependency(ctx context.Context, isDependencySpec model.IsDependencySpec) ([]*model.IsDependency, error) {
 	funcName := "IsDependency"
 	if isDependencySpec.DependencyType != nil && !isDependencySpec.DependencyType.IsValid() {
-		return nil, gqlerror.Errorf("%s :: dependency type was not valid", funcName)
+		return nil, errext.WithCode(gqlerror.Errorf("%s :: dependency type was not valid", funcName), errext.ErrInvalidInput)
 	}
 
 	return r.Backend.IsDependency(ctx, &isDependencySpec)
 }
+
+// IsDependencyList is the resolver for the IsDependencyList field.
+func (r *queryResolver) IsDependencyList(ctx context.Context, isDependencySpec model.IsDependencySpec, pagination *model.PaginationSpec) (*model.IsDependencyConnection, error) {
+	if err := validatePaginationSpec("IsDependencyList", pagination); err != nil {
+		return nil, err
+	}
+	if isDependencySpec.DependencyType != nil && !isDependencySpec.DependencyType.IsValid() {
+		return nil, errext.WithCode(gqlerror.Errorf("IsDependencyList :: dependency type was not valid"), errext.ErrInvalidInput)
+	}
+	return r.Backend.IsDependencyList(ctx, isDependencySpec, pagination)
+}
+
+// DependencyGraph is the resolver for the dependencyGraph field.
+func (r *queryResolver) DependencyGraph(ctx context.Context, root string, maxDepth int) (*model.DependencyGraph, error) {
+	funcName := "DependencyGraph"
+	if maxDepth <= 0 {
+		return nil, errext.WithCode(gqlerror.Errorf("%s :: maxDepth argument must be positive, got %d", funcName, maxDepth), errext.ErrInvalidInput)
+	}
+
+	pkgs, err := r.Backend.Packages(ctx, &model.PkgSpec{ID: &root})
+	if err != nil {
+		return nil, errext.Errorf("%s :: %s", funcName, err)
+	}
+	if len(pkgs) != 1 || !isPackageVersion(pkgs[0]) {
+		return nil, errext.WithCode(gqlerror.Errorf("%s :: root %q is not a package version", funcName, root), errext.ErrInvalidInput)
+	}
+
+	graph := &model.DependencyGraph{
+		Nodes: []*model.Package{pkgs[0]},
+		Edges: []*model.DependencyEdge{},
+	}
+	seen := map[string]bool{root: true}
+	frontier := []string{root}
+	// each package version is only visited once, so every dependency
+	// attestation is found once
+	for depth := 0; depth < maxDepth && len(frontier) > 0; depth++ {
+		var next []string
+		for _, pkgID := range frontier {
+			deps, err := r.Backend.IsDependency(ctx, &model.IsDependencySpec{Package: &model.PkgSpec{ID: ptrfrom.String(pkgID)}})
+			if err != nil {
+				return nil, errext.Errorf("%s :: %s", funcName, err)
+			}
+			for _, dep := range deps {
+				depID, err := helper.NodeID(dep.DependencyPackage)
+				if err != nil {
+					return nil, errext.Errorf("%s :: %s", funcName, err)
+				}
+				graph.Edges = append(graph.Edges, &model.DependencyEdge{From: pkgID, To: depID, IsDependency: dep})
+				if seen[depID] {
+					continue
+				}
+				seen[depID] = true
+				graph.Nodes = append(graph.Nodes, dep.DependencyPackage)
+				// a dependency on a package name does not tell which of its
+				// versions is used, so it is not followed
+				if isPackageVersion(dep.DependencyPackage) {
+					next = append(next, depID)
+				}
+			}
+		}
+		frontier = next
+	}
+	return graph, nil
+}
+
+func isPackageVersion(pkg *model.Package) bool {
+	return pkg != nil && len(pkg.Namespaces) > 0 && len(pkg.Namespaces[0].Names) > 0 && len(pkg.Namespaces[0].Names[0].Versions) > 0
+}
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/internal/testing/mocks"
 	"github.com/guacsec/guac/internal/testing/testdata"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
@@ -237,6 +238,153 @@ func TestIsDependency(t *testing.T) {
 	}
 }
 
+func TestDependencyGraph(t *testing.T) {
+	pkgVersion := func(name string) *model.Package {
+		return &model.Package{
+			ID:   "type-" + name,
+			Type: "npm",
+			Namespaces: []*model.PackageNamespace{{
+				ID: "namespace-" + name,
+				Names: []*model.PackageName{{
+					ID:       "name-" + name,
+					Name:     name,
+					Versions: []*model.PackageVersion{{ID: name, Version: "1.0.0"}},
+				}},
+			}},
+		}
+	}
+	pkgName := func(name string) *model.Package {
+		pkg := pkgVersion(name)
+		pkg.Namespaces[0].Names[0].Versions = nil
+		return pkg
+	}
+	// a depends on b and c, which both depend on d, which depends on e and on
+	// the unversioned f
+	deps := map[string][]*model.IsDependency{
+		"a": {
+			{ID: "a-b", Package: pkgVersion("a"), DependencyPackage: pkgVersion("b")},
+			{ID: "a-c", Package: pkgVersion("a"), DependencyPackage: pkgVersion("c")},
+		},
+		"b": {{ID: "b-d", Package: pkgVersion("b"), DependencyPackage: pkgVersion("d")}},
+		"c": {{ID: "c-d", Package: pkgVersion("c"), DependencyPackage: pkgVersion("d")}},
+		"d": {
+			{ID: "d-e", Package: pkgVersion("d"), DependencyPackage: pkgVersion("e")},
+			{ID: "d-f", Package: pkgVersion("d"), DependencyPackage: pkgName("f")},
+		},
+	}
+
+	tests := []struct {
+		Name     string
+		Root     string
+		MaxDepth int
+		ExpNodes []string
+		ExpEdges int
+		ExpErr   bool
+	}{
+		{
+			Name:     "Zero depth",
+			Root:     "a",
+			MaxDepth: 0,
+			ExpErr:   true,
+		},
+		{
+			Name:     "Root not found",
+			Root:     "z",
+			MaxDepth: 3,
+			ExpErr:   true,
+		},
+		{
+			Name:     "Direct dependencies",
+			Root:     "a",
+			MaxDepth: 1,
+			ExpNodes: []string{"a", "b", "c"},
+			ExpEdges: 2,
+		},
+		{
+			Name:     "Shared dependency is a single node",
+			Root:     "a",
+			MaxDepth: 2,
+			ExpNodes: []string{"a", "b", "c", "d"},
+			ExpEdges: 4,
+		},
+		{
+			Name:     "Full tree",
+			Root:     "a",
+			MaxDepth: 3,
+			ExpNodes: []string{"a", "b", "c", "d", "e", "name-f"},
+			ExpEdges: 6,
+		},
+		{
+			Name:     "Depth past the leaves",
+			Root:     "a",
+			MaxDepth: 10,
+			ExpNodes: []string{"a", "b", "c", "d", "e", "name-f"},
+			ExpEdges: 6,
+		},
+		{
+			Name:     "Subtree",
+			Root:     "c",
+			MaxDepth: 10,
+			ExpNodes: []string{"c", "d", "e", "name-f"},
+			ExpEdges: 3,
+		},
+	}
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+
+			b.EXPECT().
+				Packages(ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, spec *model.PkgSpec) ([]*model.Package, error) {
+					if _, ok := deps[*spec.ID]; !ok && *spec.ID != "e" {
+						return nil, nil
+					}
+					return []*model.Package{pkgVersion(*spec.ID)}, nil
+				}).
+				AnyTimes()
+			visited := map[string]int{}
+			b.EXPECT().
+				IsDependency(ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, spec *model.IsDependencySpec) ([]*model.IsDependency, error) {
+					visited[*spec.Package.ID]++
+					return deps[*spec.Package.ID], nil
+				}).
+				AnyTimes()
+
+			graph, err := r.Query().DependencyGraph(ctx, test.Root, test.MaxDepth)
+			if (err != nil) != test.ExpErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpErr, err)
+			}
+			if err != nil {
+				return
+			}
+			var nodes []string
+			for _, node := range graph.Nodes {
+				id := node.Namespaces[0].Names[0].ID
+				if versions := node.Namespaces[0].Names[0].Versions; len(versions) > 0 {
+					id = versions[0].ID
+				}
+				nodes = append(nodes, id)
+			}
+			if diff := cmp.Diff(test.ExpNodes, nodes); diff != "" {
+				t.Errorf("unexpected nodes (-want +got):\n%s", diff)
+			}
+			if len(graph.Edges) != test.ExpEdges {
+				t.Errorf("got %d edges, want %d", len(graph.Edges), test.ExpEdges)
+			}
+			for id, count := range visited {
+				if count > 1 {
+					t.Errorf("dependencies of %s queried %d times", id, count)
+				}
+			}
+		})
+	}
+}
+
 func TestDependencyTypeIsValid(t *testing.T) {
 	tests := []struct {
 		Name       string
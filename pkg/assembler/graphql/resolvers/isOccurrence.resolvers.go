@@ -7,6 +7,7 @@ package resolvers
 import (
 	"context"
 
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -15,7 +16,7 @@ import (
 func (r *mutationResolver) IngestOccurrence(ctx context.Context, subject model.PackageOrSourceInput, artifact model.IDorArtifactInput, occurrence model.IsOccurrenceInputSpec) (string, error) {
 	funcName := "IngestOccurrence"
 	if err := validatePackageOrSourceInput(&subject, funcName); err != nil {
-		return "", gqlerror.Errorf("%v :: %s", funcName, err)
+		return "", errext.Errorf("%v :: %s", funcName, err)
 	}
 	return r.Backend.IngestOccurrence(ctx, subject, artifact, occurrence)
 }
@@ -27,24 +28,24 @@ func (r *mutationResolver) IngestOccurrences(ctx context.Context, subjects model
 	valuesDefined := 0
 	if len(subjects.Packages) > 0 {
 		if len(subjects.Packages) != len(artifacts) {
-			return ingestedOccurrencesIDs, gqlerror.Errorf("%v :: uneven packages and artifacts for ingestion", funcName)
+			return ingestedOccurrencesIDs, errext.WithCode(gqlerror.Errorf("%v :: uneven packages and artifacts for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		if len(subjects.Packages) != len(occurrences) {
-			return ingestedOccurrencesIDs, gqlerror.Errorf("%v :: uneven packages and occurrence for ingestion", funcName)
+			return ingestedOccurrencesIDs, errext.WithCode(gqlerror.Errorf("%v :: uneven packages and occurrence for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if len(subjects.Sources) > 0 {
 		if len(subjects.Sources) != len(artifacts) {
-			return ingestedOccurrencesIDs, gqlerror.Errorf("%v :: uneven Sources and artifacts for ingestion", funcName)
+			return ingestedOccurrencesIDs, errext.WithCode(gqlerror.Errorf("%v :: uneven Sources and artifacts for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		if len(subjects.Sources) != len(occurrences) {
-			return ingestedOccurrencesIDs, gqlerror.Errorf("%v :: uneven Sources and occurrence for ingestion", funcName)
+			return ingestedOccurrencesIDs, errext.WithCode(gqlerror.Errorf("%v :: uneven Sources and occurrence for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if valuesDefined != 1 {
-		return ingestedOccurrencesIDs, gqlerror.Errorf("%v :: must specify at most packages or sources", funcName)
+		return ingestedOccurrencesIDs, errext.WithCode(gqlerror.Errorf("%v :: must specify at most packages or sources", funcName), errext.ErrInvalidInput)
 	}
 
 	return r.Backend.IngestOccurrences(ctx, subjects, artifacts, occurrences)
@@ -53,7 +54,18 @@ func (r *mutationResolver) IngestOccurrences(ctx context.Context, subjects model
 // IsOccurrence is the resolver for the IsOccurrence field.
 func (r *queryResolver) IsOccurrence(ctx context.Context, isOccurrenceSpec model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
 	if err := validatePackageOrSourceQueryFilter(isOccurrenceSpec.Subject); err != nil {
-		return nil, gqlerror.Errorf("IsOccurrence :: %s", err)
+		return nil, errext.Errorf("IsOccurrence :: %s", err)
 	}
 	return r.Backend.IsOccurrence(ctx, &isOccurrenceSpec)
 }
+
+// IsOccurrenceList is the resolver for the IsOccurrenceList field.
+func (r *queryResolver) IsOccurrenceList(ctx context.Context, isOccurrenceSpec model.IsOccurrenceSpec, pagination *model.PaginationSpec) (*model.IsOccurrenceConnection, error) {
+	if err := validatePaginationSpec("IsOccurrenceList", pagination); err != nil {
+		return nil, err
+	}
+	if err := validatePackageOrSourceQueryFilter(isOccurrenceSpec.Subject); err != nil {
+		return nil, errext.Errorf("IsOccurrenceList :: %s", err)
+	}
+	return r.Backend.IsOccurrenceList(ctx, isOccurrenceSpec, pagination)
+}
@@ -36,3 +36,11 @@ func (r *mutationResolver) IngestLicenses(ctx context.Context, licenses []*model
 func (r *queryResolver) Licenses(ctx context.Context, licenseSpec model.LicenseSpec) ([]*model.License, error) {
 	return r.Backend.Licenses(ctx, &licenseSpec)
 }
+
+// LicensesList is the resolver for the licensesList field.
+func (r *queryResolver) LicensesList(ctx context.Context, licenseSpec model.LicenseSpec, pagination *model.PaginationSpec) (*model.LicenseConnection, error) {
+	if err := validatePaginationSpec("LicensesList", pagination); err != nil {
+		return nil, err
+	}
+	return r.Backend.LicensesList(ctx, licenseSpec, pagination)
+}
@@ -0,0 +1,149 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// loaderWait is how long a loader waits for more keys before querying the
+// backend. gqlgen resolves the fields of list elements concurrently, so the keys
+// of all elements arrive in quick succession, but the elements of nested lists
+// can take longer than a single window to reach the loader. Each key extends the
+// wait, up to loaderMaxWait after the first key of a batch.
+const (
+	loaderWait    = 2 * time.Millisecond
+	loaderMaxWait = 20 * time.Millisecond
+)
+
+type loadersKey struct{}
+
+// loaders holds the loaders of a single GraphQL operation.
+type loaders struct {
+	mu sync.Mutex
+	// certifyVulns has one loader per distinct certifyVulns filter, for
+	// vulnerabilities and packages separately
+	certifyVulns map[string]*certifyVulnLoader
+}
+
+// certifyVulnFetch queries the CertifyVuln nodes of a batch of node IDs.
+type certifyVulnFetch func(ctx context.Context, ids []string, filter *model.CertifyVulnSpec) (map[string][]*model.CertifyVuln, error)
+
+// WithLoaders returns a context in which field resolvers batch the backend
+// queries of all the nodes of a list into a single query.
+func WithLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersKey{}, &loaders{certifyVulns: map[string]*certifyVulnLoader{}})
+}
+
+// LoaderMiddleware installs the loaders for each operation. Register it with
+// handler.Server.AroundOperations.
+func LoaderMiddleware(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	return next(WithLoaders(ctx))
+}
+
+// loadCertifyVulns returns the CertifyVuln nodes matching filter for each of the
+// vulnerability ID nodes. Without loaders in the context, the backend is
+// queried directly.
+func loadCertifyVulns(ctx context.Context, backend backends.Backend, vulnerabilityIDs []string, filter *model.CertifyVulnSpec) (map[string][]*model.CertifyVuln, error) {
+	return loadCertifyVulnsBy(ctx, "vulnerability", backend.CertifyVulnByVulnerabilityIDs, vulnerabilityIDs, filter)
+}
+
+// loadPackageCertifyVulns returns the CertifyVuln nodes matching filter for
+// each of the package version nodes. Without loaders in the context, the
+// backend is queried directly.
+func loadPackageCertifyVulns(ctx context.Context, backend backends.Backend, packageIDs []string, filter *model.CertifyVulnSpec) (map[string][]*model.CertifyVuln, error) {
+	return loadCertifyVulnsBy(ctx, "package", backend.CertifyVulnByPackageIDs, packageIDs, filter)
+}
+
+func loadCertifyVulnsBy(ctx context.Context, kind string, fetch certifyVulnFetch, ids []string, filter *model.CertifyVulnSpec) (map[string][]*model.CertifyVuln, error) {
+	l, ok := ctx.Value(loadersKey{}).(*loaders)
+	if !ok {
+		return fetch(ctx, ids, filter)
+	}
+	key, err := json.Marshal(filter)
+	if err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	loader, ok := l.certifyVulns[kind+string(key)]
+	if !ok {
+		loader = &certifyVulnLoader{fetchFn: fetch, filter: filter}
+		l.certifyVulns[kind+string(key)] = loader
+	}
+	l.mu.Unlock()
+	return loader.load(ctx, ids)
+}
+
+type certifyVulnLoader struct {
+	fetchFn certifyVulnFetch
+	filter  *model.CertifyVulnSpec
+
+	mu    sync.Mutex
+	batch *certifyVulnBatch
+}
+
+type certifyVulnBatch struct {
+	ids      []string
+	timer    *time.Timer
+	deadline time.Time
+	done     chan struct{}
+	results  map[string][]*model.CertifyVuln
+	err      error
+}
+
+func (l *certifyVulnLoader) load(ctx context.Context, ids []string) (map[string][]*model.CertifyVuln, error) {
+	l.mu.Lock()
+	b := l.batch
+	if b == nil {
+		b = &certifyVulnBatch{deadline: time.Now().Add(loaderMaxWait), done: make(chan struct{})}
+		l.batch = b
+		b.timer = time.AfterFunc(loaderWait, func() { l.fetch(ctx, b) })
+	} else if wait := time.Until(b.deadline); wait > 0 {
+		b.timer.Reset(min(wait, loaderWait))
+	}
+	b.ids = append(b.ids, ids...)
+	l.mu.Unlock()
+
+	select {
+	case <-b.done:
+		return b.results, b.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (l *certifyVulnLoader) fetch(ctx context.Context, b *certifyVulnBatch) {
+	// close the batch, later keys go to a new one
+	l.mu.Lock()
+	if l.batch != b {
+		// the timer was reset after it had already fired
+		l.mu.Unlock()
+		return
+	}
+	l.batch = nil
+	l.mu.Unlock()
+
+	// release the waiters even if the backend panics
+	defer close(b.done)
+	b.results, b.err = l.fetchFn(ctx, b.ids, l.filter)
+}
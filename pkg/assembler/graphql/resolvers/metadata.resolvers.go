@@ -7,6 +7,7 @@ package resolvers
 import (
 	"context"
 
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -15,7 +16,7 @@ import (
 func (r *mutationResolver) IngestHasMetadata(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType model.MatchFlags, hasMetadata model.HasMetadataInputSpec) (string, error) {
 	funcName := "IngestHasMetadata"
 	if err := validatePackageSourceOrArtifactInput(&subject, funcName); err != nil {
-		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+		return "", errext.Errorf("%v ::  %s", funcName, err)
 	}
 
 	return r.Backend.IngestHasMetadata(ctx, subject, &pkgMatchType, hasMetadata)
@@ -27,24 +28,24 @@ func (r *mutationResolver) IngestBulkHasMetadata(ctx context.Context, subjects m
 	valuesDefined := 0
 	if len(subjects.Packages) > 0 {
 		if len(subjects.Packages) != len(hasMetadataList) {
-			return []string{}, gqlerror.Errorf("%v :: uneven packages and hasMetadata for ingestion", funcName)
+			return []string{}, errext.WithCode(gqlerror.Errorf("%v :: uneven packages and hasMetadata for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if len(subjects.Artifacts) > 0 {
 		if len(subjects.Artifacts) != len(hasMetadataList) {
-			return []string{}, gqlerror.Errorf("%v :: uneven artifacts and hasMetadata for ingestion", funcName)
+			return []string{}, errext.WithCode(gqlerror.Errorf("%v :: uneven artifacts and hasMetadata for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if len(subjects.Sources) > 0 {
 		if len(subjects.Sources) != len(hasMetadataList) {
-			return []string{}, gqlerror.Errorf("%v :: uneven sources and hasMetadata for ingestion", funcName)
+			return []string{}, errext.WithCode(gqlerror.Errorf("%v :: uneven sources and hasMetadata for ingestion", funcName), errext.ErrInvalidInput)
 		}
 		valuesDefined = valuesDefined + 1
 	}
 	if valuesDefined != 1 {
-		return []string{}, gqlerror.Errorf("%v :: must specify at most packages, artifacts or sources", funcName)
+		return []string{}, errext.WithCode(gqlerror.Errorf("%v :: must specify at most packages, artifacts or sources", funcName), errext.ErrInvalidInput)
 	}
 	return r.Backend.IngestBulkHasMetadata(ctx, subjects, &pkgMatchType, hasMetadataList)
 }
@@ -52,7 +53,18 @@ func (r *mutationResolver) IngestBulkHasMetadata(ctx context.Context, subjects m
 // HasMetadata is the resolver for the HasMetadata field.
 func (r *queryResolver) HasMetadata(ctx context.Context, hasMetadataSpec model.HasMetadataSpec) ([]*model.HasMetadata, error) {
 	if err := validatePackageSourceOrArtifactQueryFilter(hasMetadataSpec.Subject); err != nil {
-		return nil, gqlerror.Errorf("HasMetadata ::  %s", err)
+		return nil, errext.Errorf("HasMetadata ::  %s", err)
 	}
 	return r.Backend.HasMetadata(ctx, &hasMetadataSpec)
 }
+
+// HasMetadataList is the resolver for the HasMetadataList field.
+func (r *queryResolver) HasMetadataList(ctx context.Context, hasMetadataSpec model.HasMetadataSpec, pagination *model.PaginationSpec) (*model.HasMetadataConnection, error) {
+	if err := validatePaginationSpec("HasMetadataList", pagination); err != nil {
+		return nil, err
+	}
+	if err := validatePackageSourceOrArtifactQueryFilter(hasMetadataSpec.Subject); err != nil {
+		return nil, errext.Errorf("HasMetadataList :: %s", err)
+	}
+	return r.Backend.HasMetadataList(ctx, hasMetadataSpec, pagination)
+}
@@ -2,10 +2,19 @@ package resolvers_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
 )
@@ -43,3 +52,308 @@ func TestPackages(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPackageByPurl(t *testing.T) {
+	angular := &model.Package{ID: "1", Type: "npm"}
+	django := &model.Package{ID: "2", Type: "pypi"}
+	tests := []struct {
+		Name        string
+		Purl        string
+		ExpSpec     *model.PkgSpec
+		Backend     []*model.Package
+		ExpPackage  *model.Package
+		ExpQueryErr bool
+	}{
+		{
+			Name: "npm scoped package",
+			Purl: "pkg:npm/%40angular/core@15.0.0",
+			ExpSpec: &model.PkgSpec{
+				Type:                     ptrfrom.String("npm"),
+				Namespace:                ptrfrom.String("@angular"),
+				Name:                     ptrfrom.String("core"),
+				Version:                  ptrfrom.String("15.0.0"),
+				Subpath:                  ptrfrom.String(""),
+				MatchOnlyEmptyQualifiers: ptrfrom.Bool(true),
+			},
+			Backend:    []*model.Package{angular},
+			ExpPackage: angular,
+		},
+		{
+			Name: "pypi package",
+			Purl: "pkg:pypi/django@4.2",
+			ExpSpec: &model.PkgSpec{
+				Type:                     ptrfrom.String("pypi"),
+				Namespace:                ptrfrom.String(""),
+				Name:                     ptrfrom.String("django"),
+				Version:                  ptrfrom.String("4.2"),
+				Subpath:                  ptrfrom.String(""),
+				MatchOnlyEmptyQualifiers: ptrfrom.Bool(true),
+			},
+			Backend:    []*model.Package{django},
+			ExpPackage: django,
+		},
+		{
+			Name: "not found",
+			Purl: "pkg:pypi/django@4.2",
+			ExpSpec: &model.PkgSpec{
+				Type:                     ptrfrom.String("pypi"),
+				Namespace:                ptrfrom.String(""),
+				Name:                     ptrfrom.String("django"),
+				Version:                  ptrfrom.String("4.2"),
+				Subpath:                  ptrfrom.String(""),
+				MatchOnlyEmptyQualifiers: ptrfrom.Bool(true),
+			},
+			Backend: []*model.Package{},
+		},
+		{
+			Name:        "malformed purl",
+			Purl:        "npm/angular/core@15.0.0",
+			ExpQueryErr: true,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpQueryErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				Packages(ctx, test.ExpSpec).
+				Return(test.Backend, nil).
+				Times(times)
+			got, err := r.Query().GetPackageByPurl(ctx, test.Purl)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+			if diff := cmp.Diff(test.ExpPackage, got); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMergePackages(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Primary     string
+		Duplicates  []string
+		ExpMergeErr bool
+	}{
+		{
+			Name:       "Happy path",
+			Primary:    "1",
+			Duplicates: []string{"2", "3"},
+		},
+		{
+			Name:        "No primary",
+			Duplicates:  []string{"2"},
+			ExpMergeErr: true,
+		},
+		{
+			Name:        "No duplicates",
+			Primary:     "1",
+			ExpMergeErr: true,
+		},
+		{
+			Name:        "Empty duplicate",
+			Primary:     "1",
+			Duplicates:  []string{"2", ""},
+			ExpMergeErr: true,
+		},
+		{
+			Name:        "Primary listed as duplicate",
+			Primary:     "1",
+			Duplicates:  []string{"2", "1"},
+			ExpMergeErr: true,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpMergeErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				MergePackages(ctx, test.Primary, test.Duplicates).
+				Return(&model.Package{ID: test.Primary}, nil).
+				Times(times)
+			_, err := r.Mutation().MergePackages(ctx, test.Primary, test.Duplicates)
+			if (err != nil) != test.ExpMergeErr {
+				t.Fatalf("did not get merge error, want: %v, got: %v", test.ExpMergeErr, err)
+			}
+		})
+	}
+}
+
+func TestPackageVersionVulnerabilities(t *testing.T) {
+	const numPackages = 100
+	pkgs := make([]*model.Package, 0, numPackages)
+	versionIDs := make([]string, 0, numPackages)
+	certs := map[string][]*model.CertifyVuln{}
+	for i := 0; i < numPackages; i++ {
+		versionID := fmt.Sprintf("version-%d", i)
+		pkgs = append(pkgs, &model.Package{
+			ID:   fmt.Sprintf("type-%d", i),
+			Type: "pypi",
+			Namespaces: []*model.PackageNamespace{{
+				Names: []*model.PackageName{{
+					Name:     fmt.Sprintf("name-%d", i),
+					Versions: []*model.PackageVersion{{ID: versionID, Version: "1.0"}},
+				}},
+			}},
+		})
+		versionIDs = append(versionIDs, versionID)
+		// only every other version is vulnerable
+		if i%2 == 0 {
+			certs[versionID] = []*model.CertifyVuln{{ID: fmt.Sprintf("cert-%d", i)}}
+		}
+	}
+
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	// the vulnerabilities of all the package versions are loaded with one
+	// query, for a total of two backend queries
+	b.
+		EXPECT().
+		Packages(gomock.Any(), gomock.Any()).
+		Return(pkgs, nil).
+		Times(1)
+	b.
+		EXPECT().
+		CertifyVulnByPackageIDs(gomock.Any(), gomock.InAnyOrder(versionIDs), &model.CertifyVulnSpec{Origin: ptrfrom.String("osv")}).
+		Return(certs, nil).
+		Times(1)
+
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &resolvers.Resolver{Backend: b}}))
+	srv.AroundOperations(resolvers.LoaderMiddleware)
+	var resp struct {
+		Packages []struct {
+			Namespaces []struct {
+				Names []struct {
+					Versions []struct {
+						ID              string
+						Vulnerabilities []struct{ ID string }
+					}
+				}
+			}
+		}
+	}
+	query := `{ packages(pkgSpec: {}) { namespaces { names { versions { id vulnerabilities(filter: {origin: "osv", package: {type: "deb"}}) { id } } } } } }`
+	if err := client.New(srv).Post(query, &resp); err != nil {
+		t.Fatalf("query failed: %v", err)
+	}
+
+	if len(resp.Packages) != numPackages {
+		t.Fatalf("got %d packages, want %d", len(resp.Packages), numPackages)
+	}
+	for i, pkg := range resp.Packages {
+		version := pkg.Namespaces[0].Names[0].Versions[0]
+		var got []string
+		for _, vuln := range version.Vulnerabilities {
+			got = append(got, vuln.ID)
+		}
+		var want []string
+		for _, cert := range certs[version.ID] {
+			want = append(want, cert.ID)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Unexpected vulnerabilities of package %d (-want +got):\n%s", i, diff)
+		}
+	}
+}
+
+func TestPackageVersionLatestScorecard(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", ctx, nil)
+	if err != nil {
+		t.Fatalf("Could not create backend: %v", err)
+	}
+	pkgIDs := map[*model.PkgInputSpec]string{}
+	for _, p := range []*model.PkgInputSpec{testdata.P2, testdata.P3, testdata.P4} {
+		ids, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p})
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		pkgIDs[p] = ids.PackageVersionID
+	}
+	for _, s := range []*model.SourceInputSpec{testdata.S1, testdata.S2} {
+		if _, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: s}); err != nil {
+			t.Fatalf("Could not ingest source: %v", err)
+		}
+	}
+	// P2 is built from S1, all the versions of P4 from S2 and P3 has no source
+	for p, link := range map[*model.PkgInputSpec]struct {
+		match model.PkgMatchType
+		src   *model.SourceInputSpec
+	}{
+		testdata.P2: {model.PkgMatchTypeSpecificVersion, testdata.S1},
+		testdata.P4: {model.PkgMatchTypeAllVersions, testdata.S2},
+	} {
+		if _, err := b.IngestHasSourceAt(ctx, model.IDorPkgInput{PackageInput: p}, model.MatchFlags{Pkg: link.match},
+			model.IDorSourceInput{SourceInput: link.src}, model.HasSourceAtInputSpec{Justification: "test"}); err != nil {
+			t.Fatalf("Could not ingest HasSourceAt: %v", err)
+		}
+	}
+	for _, sc := range []struct {
+		src   *model.SourceInputSpec
+		score float64
+		time  time.Time
+	}{
+		{testdata.S1, 4, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{testdata.S1, 7.5, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{testdata.S1, 6, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{testdata.S2, 9, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+	} {
+		if _, err := b.IngestScorecard(ctx, model.IDorSourceInput{SourceInput: sc.src},
+			model.ScorecardInputSpec{AggregateScore: sc.score, TimeScanned: sc.time}); err != nil {
+			t.Fatalf("Could not ingest scorecard: %v", err)
+		}
+	}
+	r := resolvers.Resolver{Backend: b}
+
+	tests := []struct {
+		Name     string
+		Pkg      *model.PkgInputSpec
+		ExpScore *float64
+	}{
+		{
+			Name:     "Version built from source",
+			Pkg:      testdata.P2,
+			ExpScore: ptrfrom.Float64(7.5),
+		},
+		{
+			Name:     "All versions built from source",
+			Pkg:      testdata.P4,
+			ExpScore: ptrfrom.Float64(9),
+		},
+		{
+			Name: "No source",
+			Pkg:  testdata.P3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := r.PackageVersion().LatestScorecard(ctx, &model.PackageVersion{ID: pkgIDs[test.Pkg]})
+			if err != nil {
+				t.Fatalf("LatestScorecard() error = %v", err)
+			}
+			var gotScore *float64
+			if got != nil {
+				gotScore = &got.Scorecard.AggregateScore
+			}
+			if diff := cmp.Diff(test.ExpScore, gotScore); diff != "" {
+				t.Errorf("Unexpected scorecard score (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
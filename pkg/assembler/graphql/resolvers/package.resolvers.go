@@ -7,9 +7,12 @@ package resolvers
 import (
 	"context"
 
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // IngestPackage is the resolver for the ingestPackage field.
@@ -24,17 +27,149 @@ func (r *mutationResolver) IngestPackages(ctx context.Context, pkgs []*model.IDo
 	return r.Backend.IngestPackages(ctx, pkgs)
 }
 
+// MergePackages is the resolver for the mergePackages field.
+func (r *mutationResolver) MergePackages(ctx context.Context, primary string, duplicates []string) (*model.Package, error) {
+	funcName := "MergePackages"
+	if primary == "" {
+		return nil, errext.WithCode(gqlerror.Errorf("%v :: primary must be specified", funcName), errext.ErrInvalidInput)
+	}
+	if len(duplicates) == 0 {
+		return nil, errext.WithCode(gqlerror.Errorf("%v :: at least one duplicate must be specified", funcName), errext.ErrInvalidInput)
+	}
+	for _, id := range duplicates {
+		if id == "" || id == primary {
+			return nil, errext.WithCode(gqlerror.Errorf("%v :: duplicate %q must be a non-empty ID other than the primary", funcName, id), errext.ErrInvalidInput)
+		}
+	}
+	return r.Backend.MergePackages(ctx, primary, duplicates)
+}
+
 // Namespaces is the resolver for the namespaces field.
 func (r *packageResolver) Namespaces(ctx context.Context, obj *model.Package) ([]*model.PackageNamespace, error) {
 	return helpers.UpdatePurlForPackageNamespaces(obj)
 }
 
+// Vulnerabilities is the resolver for the vulnerabilities field.
+func (r *packageVersionResolver) Vulnerabilities(ctx context.Context, obj *model.PackageVersion, filter *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	spec := model.CertifyVulnSpec{}
+	if filter != nil {
+		spec = *filter
+		spec.Package = nil
+	}
+	// the certifications of all the package versions of a list are loaded in
+	// a single backend query
+	certs, err := loadPackageCertifyVulns(ctx, r.Backend, []string{obj.ID}, &spec)
+	if err != nil {
+		return nil, errext.Errorf("PackageVersion.Vulnerabilities :: %s", err)
+	}
+	if certs[obj.ID] == nil {
+		return []*model.CertifyVuln{}, nil
+	}
+	return certs[obj.ID], nil
+}
+
+// LatestScorecard is the resolver for the latestScorecard field.
+func (r *packageVersionResolver) LatestScorecard(ctx context.Context, obj *model.PackageVersion) (*model.CertifyScorecard, error) {
+	funcName := "PackageVersion.LatestScorecard"
+	// sources can be linked to the version or to its package name
+	pkgs, err := r.Backend.Packages(ctx, &model.PkgSpec{ID: &obj.ID})
+	if err != nil {
+		return nil, errext.Errorf("%v :: %s", funcName, err)
+	}
+	pkgIDs := []string{obj.ID}
+	if len(pkgs) > 0 {
+		pkgIDs = append(pkgIDs, pkgs[0].Namespaces[0].Names[0].ID)
+	}
+
+	var sourceIDs []string
+	seen := map[string]bool{}
+	for _, pkgID := range pkgIDs {
+		hasSourceAts, err := r.Backend.HasSourceAt(ctx, &model.HasSourceAtSpec{Package: &model.PkgSpec{ID: ptrfrom.String(pkgID)}})
+		if err != nil {
+			return nil, errext.Errorf("%v :: %s", funcName, err)
+		}
+		for _, hsa := range hasSourceAts {
+			srcID := hsa.Source.Namespaces[0].Names[0].ID
+			if !seen[srcID] {
+				seen[srcID] = true
+				sourceIDs = append(sourceIDs, srcID)
+			}
+		}
+	}
+
+	var latest *model.CertifyScorecard
+	for _, srcID := range sourceIDs {
+		scorecards, err := r.Backend.Scorecards(ctx, &model.CertifyScorecardSpec{Source: &model.SourceSpec{ID: ptrfrom.String(srcID)}})
+		if err != nil {
+			return nil, errext.Errorf("%v :: %s", funcName, err)
+		}
+		for _, scorecard := range scorecards {
+			if latest == nil || scorecard.Scorecard.TimeScanned.After(latest.Scorecard.TimeScanned) {
+				latest = scorecard
+			}
+		}
+	}
+	return latest, nil
+}
+
 // Packages is the resolver for the packages field.
 func (r *queryResolver) Packages(ctx context.Context, pkgSpec model.PkgSpec) ([]*model.Package, error) {
 	return r.Backend.Packages(ctx, &pkgSpec)
 }
 
+// PackagesList is the resolver for the packagesList field.
+func (r *queryResolver) PackagesList(ctx context.Context, pkgSpec model.PkgSpec, pagination *model.PaginationSpec) (*model.PackageConnection, error) {
+	if err := validatePaginationSpec("PackagesList", pagination); err != nil {
+		return nil, err
+	}
+	return r.Backend.PackagesList(ctx, pkgSpec, pagination)
+}
+
+// PackagesCount is the resolver for the packagesCount field.
+func (r *queryResolver) PackagesCount(ctx context.Context, pkgSpec *model.PkgSpec) (int, error) {
+	if pkgSpec == nil {
+		pkgSpec = &model.PkgSpec{}
+	}
+	return r.Backend.PackagesCount(ctx, *pkgSpec)
+}
+
+// GetPackageByPurl is the resolver for the getPackageByPURL field.
+func (r *queryResolver) GetPackageByPurl(ctx context.Context, purl string) (*model.Package, error) {
+	pkgInput, err := helpers.PurlToPkg(purl)
+	if err != nil {
+		return nil, errext.WithCode(gqlerror.Errorf("GetPackageByPurl :: %s", err), errext.ErrInvalidInput)
+	}
+	// match the package version exactly as ingesting the purl would have created it
+	matchOnlyEmptyQualifiers := len(pkgInput.Qualifiers) == 0
+	pkgSpec := model.PkgSpec{
+		Type:                     &pkgInput.Type,
+		Namespace:                pkgInput.Namespace,
+		Name:                     &pkgInput.Name,
+		Version:                  pkgInput.Version,
+		Subpath:                  pkgInput.Subpath,
+		MatchOnlyEmptyQualifiers: &matchOnlyEmptyQualifiers,
+	}
+	for _, q := range pkgInput.Qualifiers {
+		value := q.Value
+		pkgSpec.Qualifiers = append(pkgSpec.Qualifiers, &model.PackageQualifierSpec{Key: q.Key, Value: &value})
+	}
+	pkgs, err := r.Packages(ctx, pkgSpec)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) == 0 {
+		return nil, nil
+	}
+	return pkgs[0], nil
+}
+
 // Package returns generated.PackageResolver implementation.
 func (r *Resolver) Package() generated.PackageResolver { return &packageResolver{r} }
 
+// PackageVersion returns generated.PackageVersionResolver implementation.
+func (r *Resolver) PackageVersion() generated.PackageVersionResolver {
+	return &packageVersionResolver{r}
+}
+
 type packageResolver struct{ *Resolver }
+type packageVersionResolver struct{ *Resolver }
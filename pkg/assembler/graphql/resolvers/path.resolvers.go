@@ -7,6 +7,7 @@ package resolvers
 import (
 	"context"
 
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -14,7 +15,7 @@ import (
 // Path is the resolver for the path field.
 func (r *queryResolver) Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
 	if maxPathLength <= 0 {
-		return nil, gqlerror.Errorf("Path :: maxPathLength argument must be positive, got %d", maxPathLength)
+		return nil, errext.WithCode(gqlerror.Errorf("Path :: maxPathLength argument must be positive, got %d", maxPathLength), errext.ErrInvalidInput)
 	}
 
 	return r.Backend.Path(ctx, subject, target, maxPathLength, usingOnly)
@@ -25,6 +26,15 @@ func (r *queryResolver) Neighbors(ctx context.Context, node string, usingOnly []
 	return r.Backend.Neighbors(ctx, node, usingOnly)
 }
 
+// NeighborsRecursive is the resolver for the neighborsRecursive field.
+func (r *queryResolver) NeighborsRecursive(ctx context.Context, node string, edges []model.Edge, maxDepth int) ([]model.Node, error) {
+	if maxDepth <= 0 {
+		return nil, errext.WithCode(gqlerror.Errorf("NeighborsRecursive :: maxDepth argument must be positive, got %d", maxDepth), errext.ErrInvalidInput)
+	}
+
+	return r.Backend.NeighborsRecursive(ctx, node, edges, maxDepth)
+}
+
 // Node is the resolver for the node field.
 func (r *queryResolver) Node(ctx context.Context, node string) (model.Node, error) {
 	return r.Backend.Node(ctx, node)
@@ -34,3 +44,13 @@ func (r *queryResolver) Node(ctx context.Context, node string) (model.Node, erro
 func (r *queryResolver) Nodes(ctx context.Context, nodes []string) ([]model.Node, error) {
 	return r.Backend.Nodes(ctx, nodes)
 }
+
+// BatchNodes is the resolver for the batchNodes field.
+func (r *queryResolver) BatchNodes(ctx context.Context, ids []string) ([]model.Node, error) {
+	return r.Backend.BatchNodes(ctx, ids)
+}
+
+// NodeType is the resolver for the nodeType field.
+func (r *queryResolver) NodeType(ctx context.Context, id string) (string, error) {
+	return r.Backend.NodeType(ctx, id)
+}
@@ -17,10 +17,22 @@ package resolvers_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"testing"
 
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+
+	// registers the ent error classifier
+	_ "github.com/guacsec/guac/pkg/assembler/backends/ent/backend"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
 )
@@ -88,3 +100,96 @@ func TestPath(t *testing.T) {
 		})
 	}
 }
+
+func TestNeighborsRecursive(t *testing.T) {
+	tests := []struct {
+		Name     string
+		MaxDepth int
+		ExpErr   bool
+	}{
+		{
+			Name:     "Zero maxDepth",
+			MaxDepth: 0,
+			ExpErr:   true,
+		},
+		{
+			Name:     "Happy path",
+			MaxDepth: 3,
+			ExpErr:   false,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	edges := []model.Edge{model.EdgePackageIsDependency, model.EdgeIsDependencyPackage}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				NeighborsRecursive(ctx, "a", edges, test.MaxDepth).
+				Return([]model.Node{}, nil).
+				Times(times)
+			_, err := r.Query().NeighborsRecursive(ctx, "a", edges, test.MaxDepth)
+			if (err != nil) != test.ExpErr {
+				t.Fatalf("did not get expected error, want: %v, got: %v", test.ExpErr, err)
+			}
+		})
+	}
+}
+
+func TestBatchNodes(t *testing.T) {
+	ids := []string{"src-1", "missing", "pkg-1"}
+	want := []model.Node{&model.Source{ID: "src-1"}, nil, &model.Package{ID: "pkg-1"}}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	r := resolvers.Resolver{Backend: b}
+	b.
+		EXPECT().
+		BatchNodes(ctx, ids).
+		Return(want, nil).
+		Times(1)
+	got, err := r.Query().BatchNodes(ctx, ids)
+	if err != nil {
+		t.Fatalf("did not expect error, got %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+	}
+}
+
+func TestNodeNotFoundErrorCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	id := "package_versions:" + uuid.NewString()
+	b.
+		EXPECT().
+		Node(gomock.Any(), id).
+		Return(nil, fmt.Errorf("failed to query node: %w", &ent.NotFoundError{})).
+		Times(1)
+
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &resolvers.Resolver{Backend: b}}))
+	srv.SetErrorPresenter(errext.ErrorPresenter)
+	resp, err := client.New(srv).RawPost(`query($id: ID!) { node(node: $id) { __typename } }`, client.Var("id", id))
+	if err != nil {
+		t.Fatalf("RawPost() error = %v", err)
+	}
+	var errs []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	}
+	if err := json.Unmarshal(resp.Errors, &errs); err != nil {
+		t.Fatalf("failed to unmarshal errors %s: %v", resp.Errors, err)
+	}
+	if len(errs) != 1 {
+		t.Fatalf("got %d errors, want 1: %s", len(errs), resp.Errors)
+	}
+	if got := errs[0].Extensions[errext.CodeExtension]; got != string(errext.ErrNotFound) {
+		t.Errorf("got extensions.code %v, want %v", got, errext.ErrNotFound)
+	}
+}
@@ -7,6 +7,7 @@ package resolvers
 import (
 	"context"
 
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -21,9 +22,9 @@ func (r *mutationResolver) IngestPkgEquals(ctx context.Context, pkgs []*model.ID
 	funcName := "IngestPkgEquals"
 	ingestedHashEqualsIDS := []string{}
 	if len(pkgs) != len(otherPackages) {
-		return ingestedHashEqualsIDS, gqlerror.Errorf("%v :: uneven packages and other packages for ingestion", funcName)
+		return ingestedHashEqualsIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven packages and other packages for ingestion", funcName), errext.ErrInvalidInput)
 	} else if len(pkgs) != len(pkgEquals) {
-		return ingestedHashEqualsIDS, gqlerror.Errorf("%v :: uneven packages and pkgEquals for ingestion", funcName)
+		return ingestedHashEqualsIDS, errext.WithCode(gqlerror.Errorf("%v :: uneven packages and pkgEquals for ingestion", funcName), errext.ErrInvalidInput)
 	}
 
 	return r.Backend.IngestPkgEquals(ctx, pkgs, otherPackages, pkgEquals)
@@ -32,7 +33,18 @@ func (r *mutationResolver) IngestPkgEquals(ctx context.Context, pkgs []*model.ID
 // PkgEqual is the resolver for the PkgEqual field.
 func (r *queryResolver) PkgEqual(ctx context.Context, pkgEqualSpec model.PkgEqualSpec) ([]*model.PkgEqual, error) {
 	if len(pkgEqualSpec.Packages) > 2 {
-		return nil, gqlerror.Errorf("PkgEqual :: too many packages in query, max 2, got: %v", len(pkgEqualSpec.Packages))
+		return nil, errext.WithCode(gqlerror.Errorf("PkgEqual :: too many packages in query, max 2, got: %v", len(pkgEqualSpec.Packages)), errext.ErrInvalidInput)
 	}
 	return r.Backend.PkgEqual(ctx, &pkgEqualSpec)
 }
+
+// PkgEqualList is the resolver for the PkgEqualList field.
+func (r *queryResolver) PkgEqualList(ctx context.Context, pkgEqualSpec model.PkgEqualSpec, pagination *model.PaginationSpec) (*model.PkgEqualConnection, error) {
+	if err := validatePaginationSpec("PkgEqualList", pagination); err != nil {
+		return nil, err
+	}
+	if len(pkgEqualSpec.Packages) > 2 {
+		return nil, errext.WithCode(gqlerror.Errorf("PkgEqualList :: too many packages in query, max 2, got: %v", len(pkgEqualSpec.Packages)), errext.ErrInvalidInput)
+	}
+	return r.Backend.PkgEqualList(ctx, pkgEqualSpec, pagination)
+}
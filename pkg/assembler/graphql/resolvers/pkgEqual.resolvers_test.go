@@ -170,3 +170,81 @@ func TestPkgEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestPkgEqualList(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Query       model.PkgEqualSpec
+		Pagination  *model.PaginationSpec
+		ExpQueryErr bool
+	}{
+		{
+			Name: "Query three",
+			Query: model.PkgEqualSpec{
+				Packages: []*model.PkgSpec{
+					{
+						Name: ptrfrom.String("somename"),
+					},
+					{
+						Version: ptrfrom.String("1.2.3"),
+					},
+					{
+						Type: ptrfrom.String("asdf"),
+					},
+				},
+			},
+			ExpQueryErr: true,
+		},
+		{
+			Name: "First and last",
+			Query: model.PkgEqualSpec{
+				Justification: ptrfrom.String("test justification"),
+			},
+			Pagination: &model.PaginationSpec{
+				First: ptrfrom.Int(1),
+				Last:  ptrfrom.Int(1),
+			},
+			ExpQueryErr: true,
+		},
+		{
+			Name: "Negative first",
+			Query: model.PkgEqualSpec{
+				Justification: ptrfrom.String("test justification"),
+			},
+			Pagination: &model.PaginationSpec{
+				First: ptrfrom.Int(-1),
+			},
+			ExpQueryErr: true,
+		},
+		{
+			Name: "Happy path",
+			Query: model.PkgEqualSpec{
+				Justification: ptrfrom.String("test justification"),
+			},
+			Pagination: &model.PaginationSpec{
+				First: ptrfrom.Int(10),
+			},
+			ExpQueryErr: false,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpQueryErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				PkgEqualList(ctx, test.Query, test.Pagination).
+				Times(times)
+			_, err := r.Query().PkgEqualList(ctx, test.Query, test.Pagination)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.44
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Provenance is the resolver for the provenance field.
+func (r *queryResolver) Provenance(ctx context.Context, pkg string) (*model.ProvenanceSummary, error) {
+	if pkg == "" {
+		return nil, errext.WithCode(gqlerror.Errorf("Provenance :: pkg must not be empty"), errext.ErrInvalidInput)
+	}
+	summary, err := r.Backend.GetProvenance(ctx, pkg)
+	if err != nil {
+		return nil, errext.Errorf("Provenance :: %s", err)
+	}
+	return summary, nil
+}
@@ -20,9 +20,22 @@ package resolvers
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 import (
+	"time"
+
+	"github.com/guacsec/guac/internal/client/githubclient"
 	"github.com/guacsec/guac/pkg/assembler/backends"
 )
 
 type Resolver struct {
 	Backend backends.Backend
+	// StaleAfter is the age after which a CertifyVuln is reported as stale.
+	// Zero disables the check.
+	StaleAfter time.Duration
+	// GithubClient returns the client used to fetch the recent commits of
+	// sources hosted on GitHub, given the GitHub token of the request context.
+	// Nil uses an unverified client of the public GitHub API.
+	GithubClient func(token string) githubclient.GithubClient
+
+	commits commitCache
+	history commitHistoryCache
 }
@@ -0,0 +1,90 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"sort"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+)
+
+// diffIncludedPackages compares the packages included in two SBOMs by purl.
+// Each list of the result is sorted by purl.
+func diffIncludedPackages(before, after *model.HasSbom) *model.SBOMDiff {
+	beforePkgs := includedPackagesByPurl(before)
+	afterPkgs := includedPackagesByPurl(after)
+	diff := &model.SBOMDiff{
+		Added:     []*model.Package{},
+		Removed:   []*model.Package{},
+		Unchanged: []*model.Package{},
+	}
+	for _, purl := range sortedKeys(afterPkgs) {
+		if _, ok := beforePkgs[purl]; ok {
+			diff.Unchanged = append(diff.Unchanged, afterPkgs[purl])
+		} else {
+			diff.Added = append(diff.Added, afterPkgs[purl])
+		}
+	}
+	for _, purl := range sortedKeys(beforePkgs) {
+		if _, ok := afterPkgs[purl]; !ok {
+			diff.Removed = append(diff.Removed, beforePkgs[purl])
+		}
+	}
+	return diff
+}
+
+// includedPackagesByPurl returns the package versions included in sbom,
+// keyed by purl. Included artifacts are ignored.
+func includedPackagesByPurl(sbom *model.HasSbom) map[string]*model.Package {
+	var pkgs []*model.Package
+	for _, software := range sbom.IncludedSoftware {
+		if pkg, ok := software.(*model.Package); ok {
+			pkgs = append(pkgs, pkg)
+		}
+	}
+	byPurl := map[string]*model.Package{}
+	for _, pkg := range helper.SplitPackageVersions(pkgs) {
+		byPurl[packageVersionPurl(pkg)] = pkg
+	}
+	return byPurl
+}
+
+// packageVersionPurl returns the purl of a package trie holding a single
+// version.
+func packageVersionPurl(pkg *model.Package) string {
+	ns := pkg.Namespaces[0]
+	name := ns.Names[0]
+	version := name.Versions[0]
+	if version.Purl != "" {
+		return version.Purl
+	}
+	var qualifiers []string
+	for _, q := range version.Qualifiers {
+		qualifiers = append(qualifiers, q.Key, q.Value)
+	}
+	return helpers.PkgToPurl(pkg.Type, ns.Namespace, name.Name, version.Version, version.Subpath, qualifiers)
+}
+
+func sortedKeys(m map[string]*model.Package) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -6,7 +6,10 @@ package resolvers
 
 import (
 	"context"
+	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -21,13 +24,108 @@ func (r *mutationResolver) IngestSources(ctx context.Context, sources []*model.I
 	return r.Backend.IngestSources(ctx, sources)
 }
 
+// DeleteSource is the resolver for the deleteSource field.
+func (r *mutationResolver) DeleteSource(ctx context.Context, id string) (bool, error) {
+	if id == "" {
+		return false, errext.WithCode(gqlerror.Errorf("DeleteSource :: id must be specified"), errext.ErrInvalidInput)
+	}
+	if err := r.Backend.DeleteSource(ctx, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // Sources is the resolver for the sources field.
 func (r *queryResolver) Sources(ctx context.Context, sourceSpec model.SourceSpec) ([]*model.Source, error) {
 	if sourceSpec.Commit != nil && sourceSpec.Tag != nil {
 		if *sourceSpec.Commit != "" && *sourceSpec.Tag != "" {
-			return nil, gqlerror.Errorf("Sources :: Passing both commit and tag selectors is an error")
+			return nil, errext.WithCode(gqlerror.Errorf("Sources :: Passing both commit and tag selectors is an error"), errext.ErrInvalidInput)
 		}
 	}
 
 	return r.Backend.Sources(ctx, &sourceSpec)
 }
+
+// SourcesList is the resolver for the sourcesList field.
+func (r *queryResolver) SourcesList(ctx context.Context, sourceSpec model.SourceSpec, pagination *model.PaginationSpec) (*model.SourceConnection, error) {
+	if err := validatePaginationSpec("SourcesList", pagination); err != nil {
+		return nil, err
+	}
+	if sourceSpec.Commit != nil && sourceSpec.Tag != nil {
+		if *sourceSpec.Commit != "" && *sourceSpec.Tag != "" {
+			return nil, errext.WithCode(gqlerror.Errorf("SourcesList :: Passing both commit and tag selectors is an error"), errext.ErrInvalidInput)
+		}
+	}
+	return r.Backend.SourcesList(ctx, sourceSpec, pagination)
+}
+
+// SourcesCount is the resolver for the sourcesCount field.
+func (r *queryResolver) SourcesCount(ctx context.Context, sourceSpec *model.SourceSpec) (int, error) {
+	if sourceSpec == nil {
+		sourceSpec = &model.SourceSpec{}
+	}
+	if sourceSpec.Commit != nil && sourceSpec.Tag != nil {
+		if *sourceSpec.Commit != "" && *sourceSpec.Tag != "" {
+			return 0, errext.WithCode(gqlerror.Errorf("SourcesCount :: Passing both commit and tag selectors is an error"), errext.ErrInvalidInput)
+		}
+	}
+	return r.Backend.SourcesCount(ctx, *sourceSpec)
+}
+
+// RecentCommits is the resolver for the recentCommits field.
+func (r *sourceNameResolver) RecentCommits(ctx context.Context, obj *model.SourceName, limit int) ([]*model.GitCommit, error) {
+	funcName := "SourceName.RecentCommits"
+	if limit <= 0 {
+		return nil, errext.WithCode(gqlerror.Errorf("%v :: limit must be positive, got %d", funcName, limit), errext.ErrInvalidInput)
+	}
+	// the repository is identified by the type and namespace of the source
+	// name, which are not part of it
+	srcs, err := r.Backend.Sources(ctx, &model.SourceSpec{ID: &obj.ID})
+	if err != nil {
+		return nil, errext.Errorf("%v :: %s", funcName, err)
+	}
+	if len(srcs) == 0 {
+		return nil, nil
+	}
+	owner, repo, ok := githubRepo(srcs[0])
+	if !ok {
+		return nil, nil
+	}
+	return r.recentCommits(ctx, owner, repo, limit), nil
+}
+
+// CommitHistory is the resolver for the commitHistory field.
+func (r *sourceNameResolver) CommitHistory(ctx context.Context, obj *model.SourceName, first int, after *string) (*model.CommitConnection, error) {
+	funcName := "SourceName.CommitHistory"
+	if first <= 0 {
+		return nil, errext.WithCode(gqlerror.Errorf("%v :: first must be positive, got %d", funcName, first), errext.ErrInvalidInput)
+	}
+	var afterDate time.Time
+	if after != nil {
+		var err error
+		if afterDate, err = decodeCommitCursor(*after); err != nil {
+			return nil, errext.WithCode(gqlerror.Errorf("%v :: invalid cursor %q", funcName, *after), errext.ErrInvalidInput)
+		}
+	}
+	srcs, err := r.Backend.Sources(ctx, &model.SourceSpec{ID: &obj.ID})
+	if err != nil {
+		return nil, errext.Errorf("%v :: %s", funcName, err)
+	}
+	if len(srcs) == 0 {
+		return toCommitConnection(nil, false, after != nil), nil
+	}
+	owner, repo, ok := githubRepo(srcs[0])
+	if !ok {
+		return toCommitConnection(nil, false, after != nil), nil
+	}
+	commits, hasNextPage, err := r.commitHistoryPage(ctx, owner, repo, afterDate, first)
+	if err != nil {
+		return nil, errext.Errorf("%v :: unable to fetch the commits of %s/%s: %s", funcName, owner, repo, err)
+	}
+	return toCommitConnection(commits, hasNextPage, after != nil), nil
+}
+
+// SourceName returns generated.SourceNameResolver implementation.
+func (r *Resolver) SourceName() generated.SourceNameResolver { return &sourceNameResolver{r} }
+
+type sourceNameResolver struct{ *Resolver }
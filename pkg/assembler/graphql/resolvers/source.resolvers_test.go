@@ -17,13 +17,21 @@ package resolvers_test
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/client"
+	"github.com/guacsec/guac/internal/client/githubclient"
 	"github.com/guacsec/guac/internal/testing/mocks"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func TestSources(t *testing.T) {
@@ -72,3 +80,304 @@ func TestSources(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteSource(t *testing.T) {
+	tests := []struct {
+		Name        string
+		ID          string
+		BackendErr  error
+		ExpQueryErr bool
+	}{
+		{
+			Name:        "Empty ID",
+			ID:          "",
+			ExpQueryErr: true,
+		},
+		{
+			Name:        "Not found",
+			ID:          "source_names:unknown",
+			BackendErr:  gqlerror.Errorf("DeleteSource :: source name with id source_names:unknown not found"),
+			ExpQueryErr: true,
+		},
+		{
+			Name: "Happy path",
+			ID:   "source_names:123",
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ID == "" {
+				times = 0
+			}
+			b.
+				EXPECT().
+				DeleteSource(ctx, test.ID).
+				Return(test.BackendErr).
+				Times(times)
+			got, err := r.Mutation().DeleteSource(ctx, test.ID)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+			if got == test.ExpQueryErr {
+				t.Errorf("DeleteSource() = %v, want %v", got, !test.ExpQueryErr)
+			}
+		})
+	}
+}
+
+// fakeGithubClient lists fixed commits, counting the calls
+type fakeGithubClient struct {
+	githubclient.GithubClient
+	commits []*client.Commit
+	err     error
+	calls   int
+	owner   string
+	repo    string
+	until   []time.Time
+}
+
+func (f *fakeGithubClient) ListCommits(ctx context.Context, owner, repo string, limit int) ([]*client.Commit, error) {
+	f.calls++
+	f.owner, f.repo = owner, repo
+	if f.err != nil {
+		return nil, f.err
+	}
+	if limit < len(f.commits) {
+		return f.commits[:limit], nil
+	}
+	return f.commits, nil
+}
+
+func (f *fakeGithubClient) ListCommitsUntil(ctx context.Context, owner, repo string, until time.Time, limit int) ([]*client.Commit, error) {
+	f.calls++
+	f.owner, f.repo = owner, repo
+	f.until = append(f.until, until)
+	if f.err != nil {
+		return nil, f.err
+	}
+	var res []*client.Commit
+	for _, commit := range f.commits {
+		if len(res) == limit {
+			break
+		}
+		if until.IsZero() || !commit.AuthorDate.After(until) {
+			res = append(res, commit)
+		}
+	}
+	return res, nil
+}
+
+func TestRecentCommits(t *testing.T) {
+	authorDate := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	commits := []*client.Commit{
+		{SHA: "a05760afde49e6f2bf24a40eae3079f515df9815", Message: "second", AuthorDate: authorDate},
+		{SHA: "5e3c1d2f8b7a6c4d9e0f1a2b3c4d5e6f7a8b9c0d", Message: "first", AuthorDate: authorDate.Add(-time.Hour)},
+	}
+	source := func(srcType, namespace, name string) *model.Source {
+		return &model.Source{
+			Type: srcType,
+			Namespaces: []*model.SourceNamespace{{
+				Namespace: namespace,
+				Names:     []*model.SourceName{{ID: "1", Name: name}},
+			}},
+		}
+	}
+	tests := []struct {
+		Name        string
+		Source      *model.Source
+		Limit       int
+		ClientErr   error
+		ExpCommits  []*model.GitCommit
+		ExpRepo     string
+		ExpCalls    int
+		ExpQueryErr bool
+	}{
+		{
+			Name:        "Zero limit",
+			Source:      source("git", "github.com/guacsec", "guac"),
+			Limit:       0,
+			ExpQueryErr: true,
+		},
+		{
+			Name:   "GitHub repository",
+			Source: source("git", "github.com/guacsec", "guac.git"),
+			Limit:  1,
+			ExpCommits: []*model.GitCommit{
+				{Sha: "a05760afde49e6f2bf24a40eae3079f515df9815", Message: "second", AuthorDate: authorDate},
+			},
+			ExpRepo:  "guacsec/guac",
+			ExpCalls: 1,
+		},
+		{
+			Name:   "Other host",
+			Source: source("git", "gitlab.com/guacsec", "guac"),
+			Limit:  1,
+		},
+		{
+			Name:   "Not git",
+			Source: source("svn", "github.com/guacsec", "guac"),
+			Limit:  1,
+		},
+		{
+			Name:      "API unreachable",
+			Source:    source("git", "github.com/guacsec", "guac"),
+			Limit:     1,
+			ClientErr: errors.New("connection refused"),
+			ExpRepo:   "guacsec/guac",
+			ExpCalls:  1,
+		},
+	}
+	ctx := githubclient.WithToken(context.Background(), "token")
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			gc := &fakeGithubClient{commits: commits, err: test.ClientErr}
+			r := resolvers.Resolver{
+				Backend: b,
+				GithubClient: func(token string) githubclient.GithubClient {
+					if token != "token" {
+						t.Errorf("got token %q, want the token of the context", token)
+					}
+					return gc
+				},
+			}
+			times := 2
+			if test.ExpQueryErr {
+				times = 0
+			}
+			b.EXPECT().
+				Sources(ctx, &model.SourceSpec{ID: ptrfrom.String("1")}).
+				Return([]*model.Source{test.Source}, nil).
+				Times(times)
+
+			// the second call is served from the cache, unless the first one
+			// failed
+			for i := 0; i < 2; i++ {
+				got, err := r.SourceName().RecentCommits(ctx, &model.SourceName{ID: "1"}, test.Limit)
+				if (err != nil) != test.ExpQueryErr {
+					t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+				}
+				if diff := cmp.Diff(test.ExpCommits, got); diff != "" {
+					t.Errorf("unexpected commits (-want +got):\n%s", diff)
+				}
+			}
+			expCalls := test.ExpCalls
+			if test.ClientErr != nil {
+				expCalls *= 2
+			}
+			if gc.calls != expCalls {
+				t.Errorf("got %d GitHub API calls, want %d", gc.calls, expCalls)
+			}
+			if gc.calls > 0 && gc.owner+"/"+gc.repo != test.ExpRepo {
+				t.Errorf("got repository %s/%s, want %s", gc.owner, gc.repo, test.ExpRepo)
+			}
+		})
+	}
+}
+
+func TestCommitHistory(t *testing.T) {
+	head := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	// the third and fourth commits are authored in the same second
+	var commits []*client.Commit
+	for i, age := range []int{0, 1, 2, 2, 3} {
+		commits = append(commits, &client.Commit{
+			SHA:        fmt.Sprintf("sha%d", i),
+			Message:    fmt.Sprintf("commit %d", i),
+			AuthorDate: head.Add(-time.Duration(age) * time.Hour),
+		})
+	}
+	src := &model.Source{
+		Type: "git",
+		Namespaces: []*model.SourceNamespace{{
+			Namespace: "github.com/guacsec",
+			Names:     []*model.SourceName{{ID: "1", Name: "guac"}},
+		}},
+	}
+	ctx := githubclient.WithToken(context.Background(), "token")
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	b.EXPECT().
+		Sources(ctx, &model.SourceSpec{ID: ptrfrom.String("1")}).
+		Return([]*model.Source{src}, nil).
+		AnyTimes()
+	newResolver := func(gc *fakeGithubClient) resolvers.Resolver {
+		return resolvers.Resolver{
+			Backend:      b,
+			GithubClient: func(string) githubclient.GithubClient { return gc },
+		}
+	}
+
+	t.Run("Pages through the history", func(t *testing.T) {
+		gc := &fakeGithubClient{commits: commits}
+		r := newResolver(gc)
+		var got []string
+		var after *string
+		for pages := 0; ; pages++ {
+			if pages == len(commits) {
+				t.Fatalf("history did not end after %d pages", pages)
+			}
+			conn, err := r.SourceName().CommitHistory(ctx, &model.SourceName{ID: "1"}, 2, after)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if conn.PageInfo.HasPreviousPage != (after != nil) {
+				t.Errorf("got hasPreviousPage %v on page %d", conn.PageInfo.HasPreviousPage, pages)
+			}
+			for _, edge := range conn.Edges {
+				got = append(got, edge.Node.Sha)
+			}
+			if !conn.PageInfo.HasNextPage {
+				break
+			}
+			after = conn.PageInfo.EndCursor
+		}
+		// the page boundary does not split the commits of the same second
+		want := []string{"sha0", "sha1", "sha2", "sha3", "sha4"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("unexpected commits (-want +got):\n%s", diff)
+		}
+		if gc.calls != 1 {
+			t.Errorf("got %d GitHub API calls, want the buffered history to be used", gc.calls)
+		}
+	})
+
+	t.Run("Cursor is sent as until", func(t *testing.T) {
+		gc := &fakeGithubClient{commits: commits}
+		r := newResolver(gc)
+		after := base64.StdEncoding.EncodeToString([]byte(head.Add(-time.Hour).Format(time.RFC3339)))
+		conn, err := r.SourceName().CommitHistory(ctx, &model.SourceName{ID: "1"}, 1, &after)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// the first page would split sha2 and sha3, so it holds both
+		if len(conn.Edges) != 2 || conn.Edges[0].Node.Sha != "sha2" || !conn.PageInfo.HasNextPage {
+			t.Errorf("unexpected page %+v", conn)
+		}
+		if len(gc.until) == 0 || !gc.until[0].Equal(head.Add(-time.Hour)) {
+			t.Errorf("got until %v, want the date of the cursor", gc.until)
+		}
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		r := newResolver(&fakeGithubClient{commits: commits})
+		if _, err := r.SourceName().CommitHistory(ctx, &model.SourceName{ID: "1"}, 0, nil); err == nil {
+			t.Errorf("expected an error for a zero first")
+		}
+		if _, err := r.SourceName().CommitHistory(ctx, &model.SourceName{ID: "1"}, 1, ptrfrom.String("not a cursor")); err == nil {
+			t.Errorf("expected an error for an invalid cursor")
+		}
+	})
+
+	t.Run("API unreachable", func(t *testing.T) {
+		r := newResolver(&fakeGithubClient{err: errors.New("connection refused")})
+		if _, err := r.SourceName().CommitHistory(ctx, &model.SourceName{ID: "1"}, 1, nil); err == nil {
+			t.Errorf("expected an error when the API can not be reached")
+		}
+	})
+}
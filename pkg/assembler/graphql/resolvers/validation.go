@@ -18,6 +18,8 @@ package resolvers
 import (
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -37,7 +39,7 @@ func validatePackageSourceOrArtifactQueryFilter(subject *model.PackageSourceOrAr
 			subjectDefined = subjectDefined + 1
 		}
 		if subjectDefined != 1 {
-			return gqlerror.Errorf("must specify at most one subject (package, source, or artifact)")
+			return errext.WithCode(gqlerror.Errorf("must specify at most one subject (package, source, or artifact)"), errext.ErrInvalidInput)
 		}
 	}
 	return nil
@@ -55,7 +57,7 @@ func validatePackageSourceOrArtifactInput(item *model.PackageSourceOrArtifactInp
 		valuesDefined = valuesDefined + 1
 	}
 	if valuesDefined != 1 {
-		return gqlerror.Errorf("Must specify at most one package, source, or artifact for %v", path)
+		return errext.WithCode(gqlerror.Errorf("Must specify at most one package, source, or artifact for %v", path), errext.ErrInvalidInput)
 	}
 
 	return nil
@@ -70,7 +72,7 @@ func validatePackageOrSourceInput(item *model.PackageOrSourceInput, path string)
 		valuesDefined = valuesDefined + 1
 	}
 	if valuesDefined != 1 {
-		return gqlerror.Errorf("Must specify at most one package or source for %v", path)
+		return errext.WithCode(gqlerror.Errorf("Must specify at most one package or source for %v", path), errext.ErrInvalidInput)
 	}
 
 	return nil
@@ -88,7 +90,7 @@ func validatePackageOrSourceQueryFilter(subject *model.PackageOrSourceSpec) erro
 			subjectDefined = subjectDefined + 1
 		}
 		if subjectDefined != 1 {
-			return gqlerror.Errorf("must specify at most one subject (package or source)")
+			return errext.WithCode(gqlerror.Errorf("must specify at most one subject (package or source)"), errext.ErrInvalidInput)
 		}
 	}
 	return nil
@@ -103,7 +105,7 @@ func validatePackageOrArtifactInput(item *model.PackageOrArtifactInput, path str
 		valuesDefined = valuesDefined + 1
 	}
 	if valuesDefined != 1 {
-		return gqlerror.Errorf("Must specify at most one package or artifact for %v", path)
+		return errext.WithCode(gqlerror.Errorf("Must specify at most one package or artifact for %v", path), errext.ErrInvalidInput)
 	}
 
 	return nil
@@ -121,7 +123,7 @@ func validatePackageOrArtifactQueryFilter(subject *model.PackageOrArtifactSpec)
 			subjectDefined = subjectDefined + 1
 		}
 		if subjectDefined != 1 {
-			return gqlerror.Errorf("must specify at most one subject (package or artifact)")
+			return errext.WithCode(gqlerror.Errorf("must specify at most one subject (package or artifact)"), errext.ErrInvalidInput)
 		}
 	}
 	return nil
@@ -137,16 +139,16 @@ func validateLicenseInput(license *model.LicenseInputSpec) error {
 		listVersion = *license.ListVersion
 	}
 	if inline == "" && listVersion == "" {
-		return gqlerror.Errorf("Neither Inline nor ListVersion are provided.")
+		return errext.WithCode(gqlerror.Errorf("Neither Inline nor ListVersion are provided."), errext.ErrInvalidInput)
 	}
 	if inline != "" && listVersion != "" {
-		return gqlerror.Errorf("Both Inline and ListVersion are provided.")
+		return errext.WithCode(gqlerror.Errorf("Both Inline and ListVersion are provided."), errext.ErrInvalidInput)
 	}
 	if inline == "" && strings.HasPrefix(license.Name, "LicenseRef") {
-		return gqlerror.Errorf("LicenseRef name provided without inline.")
+		return errext.WithCode(gqlerror.Errorf("LicenseRef name provided without inline."), errext.ErrInvalidInput)
 	}
 	if listVersion == "" && !strings.HasPrefix(license.Name, "LicenseRef") {
-		return gqlerror.Errorf("Inline provided provided with non LicenseRef name.")
+		return errext.WithCode(gqlerror.Errorf("Inline provided provided with non LicenseRef name."), errext.ErrInvalidInput)
 	}
 	return nil
 }
@@ -159,23 +161,23 @@ For [status] “affected”, MUST include one [action_statement]
 */
 func validateVexInput(vexStatement model.VexStatementInputSpec) error {
 	if vexStatement.Status == model.VexStatusNotAffected && vexStatement.VexJustification == model.VexJustificationNotProvided && vexStatement.Statement == "" {
-		return gqlerror.Errorf("for [status] “not_affected”, if [justification] is not provided then [statement] MUST be provided")
+		return errext.WithCode(gqlerror.Errorf("for [status] “not_affected”, if [justification] is not provided then [statement] MUST be provided"), errext.ErrInvalidInput)
 	} else if vexStatement.Status == model.VexStatusAffected && vexStatement.VexJustification == model.VexJustificationNotProvided && vexStatement.Statement == "" {
-		return gqlerror.Errorf("for [status] “affected”, MUST include one [statement]")
+		return errext.WithCode(gqlerror.Errorf("for [status] “affected”, MUST include one [statement]"), errext.ErrInvalidInput)
 	}
 	return nil
 }
 
 func validateNoVul(vulnerability model.VulnerabilityInputSpec) error {
 	if strings.ToLower(vulnerability.Type) == "novuln" {
-		return gqlerror.Errorf("novuln type cannot be used")
+		return errext.WithCode(gqlerror.Errorf("novuln type cannot be used"), errext.ErrInvalidInput)
 	}
 	return nil
 }
 
 func validateVulnerabilityIDInputSpec(vulnerability model.VulnerabilityInputSpec) error {
 	if strings.ToLower(vulnerability.Type) != "novuln" && vulnerability.VulnerabilityID == "" {
-		return gqlerror.Errorf("vulnerabilityID must be specified for type %s", vulnerability.Type)
+		return errext.WithCode(gqlerror.Errorf("vulnerabilityID must be specified for type %s", vulnerability.Type), errext.ErrInvalidInput)
 	}
 	return nil
 }
@@ -183,8 +185,61 @@ func validateVulnerabilityIDInputSpec(vulnerability model.VulnerabilityInputSpec
 func validateVulnerabilitySpec(vulnerability model.VulnerabilitySpec) error {
 	if vulnerability.NoVuln != nil && !*vulnerability.NoVuln {
 		if vulnerability.Type != nil && strings.EqualFold(*vulnerability.Type, "novuln") {
-			return gqlerror.Errorf("novuln boolean set to false, cannot specify vulnerability type to be novuln")
+			return errext.WithCode(gqlerror.Errorf("novuln boolean set to false, cannot specify vulnerability type to be novuln"), errext.ErrInvalidInput)
 		}
 	}
 	return nil
 }
+
+func validateArtifactSpec(artifact model.ArtifactSpec) error {
+	if artifact.DigestPrefix == nil {
+		return nil
+	}
+	if *artifact.DigestPrefix == "" || strings.Trim(*artifact.DigestPrefix, "0123456789abcdefABCDEF") != "" {
+		return errext.WithCode(gqlerror.Errorf("digestPrefix %q must be a non-empty hex string", *artifact.DigestPrefix), errext.ErrInvalidInput)
+	}
+	return nil
+}
+
+func validatePaginationSpec(funcName string, pagination *model.PaginationSpec) error {
+	if err := helper.ValidatePaginationSpec(pagination); err != nil {
+		return errext.WithCode(gqlerror.Errorf("%v :: %v", funcName, err), errext.ErrInvalidInput)
+	}
+	return nil
+}
+
+// lowercaseVulnerabilitySpec returns a copy of the vulnerability filter with
+// the type and vulnerability ID lowercased, as they are stored by the backends
+func lowercaseVulnerabilitySpec(vulnerability *model.VulnerabilitySpec) *model.VulnerabilitySpec {
+	if vulnerability == nil {
+		return nil
+	}
+	lowercaseVulnFilter := *vulnerability
+	if vulnerability.Type != nil {
+		lower := strings.ToLower(*vulnerability.Type)
+		lowercaseVulnFilter.Type = &lower
+	}
+	if vulnerability.VulnerabilityID != nil {
+		lower := strings.ToLower(*vulnerability.VulnerabilityID)
+		lowercaseVulnFilter.VulnerabilityID = &lower
+	}
+	return &lowercaseVulnFilter
+}
+
+// exploitReferenceSources are the known public exploit databases an
+// ExploitReference can point at
+var exploitReferenceSources = map[string]bool{
+	"EXPLOIT_DB": true,
+	"GITHUB_POC": true,
+	"METASPLOIT": true,
+}
+
+// validateExploitReferenceSource checks the source against the known exploit
+// databases and returns it uppercased, as it is stored by the backends
+func validateExploitReferenceSource(source string) (string, error) {
+	upper := strings.ToUpper(source)
+	if !exploitReferenceSources[upper] {
+		return "", errext.WithCode(gqlerror.Errorf("unknown exploit reference source %q, must be one of EXPLOIT_DB, GITHUB_POC or METASPLOIT", source), errext.ErrInvalidInput)
+	}
+	return upper, nil
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -18,11 +19,11 @@ func (r *mutationResolver) IngestVulnEqual(ctx context.Context, vulnerability mo
 	var vulnLowerCase model.IDorVulnerabilityInput
 	if vulnerability.VulnerabilityInput != nil {
 		if err := validateNoVul(*vulnerability.VulnerabilityInput); err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 
 		if err := validateVulnerabilityIDInputSpec(*vulnerability.VulnerabilityInput); err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 
 		vulnLowerCase = model.IDorVulnerabilityInput{
@@ -37,11 +38,11 @@ func (r *mutationResolver) IngestVulnEqual(ctx context.Context, vulnerability mo
 	var otherVulnLowerCase model.IDorVulnerabilityInput
 	if otherVulnerability.VulnerabilityInput != nil {
 		if err := validateNoVul(*otherVulnerability.VulnerabilityInput); err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 
 		if err := validateVulnerabilityIDInputSpec(*otherVulnerability.VulnerabilityInput); err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		otherVulnLowerCase = model.IDorVulnerabilityInput{
 			VulnerabilityTypeID: otherVulnerability.VulnerabilityTypeID,
@@ -63,9 +64,9 @@ func (r *mutationResolver) IngestVulnEquals(ctx context.Context, vulnerabilities
 	funcName := "IngestVulnEquals"
 
 	if len(vulnerabilities) != len(otherVulnerabilities) {
-		return []string{}, gqlerror.Errorf("%v :: uneven vulnerabilities and other vulnerabilities for ingestion", funcName)
+		return []string{}, errext.WithCode(gqlerror.Errorf("%v :: uneven vulnerabilities and other vulnerabilities for ingestion", funcName), errext.ErrInvalidInput)
 	} else if len(vulnerabilities) != len(vulnEquals) {
-		return []string{}, gqlerror.Errorf("%v :: uneven artifacts and hashEquals for ingestion", funcName)
+		return []string{}, errext.WithCode(gqlerror.Errorf("%v :: uneven artifacts and hashEquals for ingestion", funcName), errext.ErrInvalidInput)
 	}
 
 	var lowercaseVulnList []*model.IDorVulnerabilityInput
@@ -75,11 +76,11 @@ func (r *mutationResolver) IngestVulnEquals(ctx context.Context, vulnerabilities
 			lowercaseVulnList = append(lowercaseVulnList, vulnerabilities[i])
 		} else {
 			if err := validateNoVul(*vulnerabilities[i].VulnerabilityInput); err != nil {
-				return []string{}, gqlerror.Errorf("%v ::  %s", funcName, err)
+				return []string{}, errext.Errorf("%v ::  %s", funcName, err)
 			}
 
 			if err := validateVulnerabilityIDInputSpec(*vulnerabilities[i].VulnerabilityInput); err != nil {
-				return []string{}, gqlerror.Errorf("%v ::  %s", funcName, err)
+				return []string{}, errext.Errorf("%v ::  %s", funcName, err)
 			}
 
 			lowercaseVulnInput := model.VulnerabilityInputSpec{
@@ -98,11 +99,11 @@ func (r *mutationResolver) IngestVulnEquals(ctx context.Context, vulnerabilities
 			lowercaseOtherVulnList = append(lowercaseOtherVulnList, otherVulnerabilities[i])
 		} else {
 			if err := validateNoVul(*otherVulnerabilities[i].VulnerabilityInput); err != nil {
-				return []string{}, gqlerror.Errorf("%v ::  %s", funcName, err)
+				return []string{}, errext.Errorf("%v ::  %s", funcName, err)
 			}
 
 			if err := validateVulnerabilityIDInputSpec(*otherVulnerabilities[i].VulnerabilityInput); err != nil {
-				return []string{}, gqlerror.Errorf("%v ::  %s", funcName, err)
+				return []string{}, errext.Errorf("%v ::  %s", funcName, err)
 			}
 
 			lowercaseOtherVulnInput := model.VulnerabilityInputSpec{
@@ -127,7 +128,7 @@ func (r *queryResolver) VulnEqual(ctx context.Context, vulnEqualSpec model.VulnE
 	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
 
 	if vulnEqualSpec.Vulnerabilities != nil && len(vulnEqualSpec.Vulnerabilities) > 2 {
-		return nil, gqlerror.Errorf("VulnEqual :: cannot specify more than 2 vulnerabilities in VulnEqual")
+		return nil, errext.WithCode(gqlerror.Errorf("VulnEqual :: cannot specify more than 2 vulnerabilities in VulnEqual"), errext.ErrInvalidInput)
 	}
 
 	if len(vulnEqualSpec.Vulnerabilities) > 0 {
@@ -165,3 +166,21 @@ func (r *queryResolver) VulnEqual(ctx context.Context, vulnEqualSpec model.VulnE
 		return r.Backend.VulnEqual(ctx, &vulnEqualSpec)
 	}
 }
+
+// VulnEqualList is the resolver for the vulnEqualList field.
+func (r *queryResolver) VulnEqualList(ctx context.Context, vulnEqualSpec model.VulnEqualSpec, pagination *model.PaginationSpec) (*model.VulnEqualConnection, error) {
+	if err := validatePaginationSpec("VulnEqualList", pagination); err != nil {
+		return nil, err
+	}
+	if len(vulnEqualSpec.Vulnerabilities) > 2 {
+		return nil, errext.WithCode(gqlerror.Errorf("VulnEqualList :: cannot specify more than 2 vulnerabilities in VulnEqual"), errext.ErrInvalidInput)
+	}
+
+	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+	lowercaseVulnFilters := make([]*model.VulnerabilitySpec, 0, len(vulnEqualSpec.Vulnerabilities))
+	for _, v := range vulnEqualSpec.Vulnerabilities {
+		lowercaseVulnFilters = append(lowercaseVulnFilters, lowercaseVulnerabilitySpec(v))
+	}
+	vulnEqualSpec.Vulnerabilities = lowercaseVulnFilters
+	return r.Backend.VulnEqualList(ctx, vulnEqualSpec, pagination)
+}
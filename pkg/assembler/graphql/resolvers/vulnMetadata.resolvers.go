@@ -8,6 +8,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -19,12 +20,12 @@ func (r *mutationResolver) IngestVulnerabilityMetadata(ctx context.Context, vuln
 	if vulnerability.VulnerabilityInput != nil {
 		err := validateNoVul(*vulnerability.VulnerabilityInput)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 
 		err = validateVulnerabilityIDInputSpec(*vulnerability.VulnerabilityInput)
 		if err != nil {
-			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+			return "", errext.Errorf("%v ::  %s", funcName, err)
 		}
 		return r.Backend.IngestVulnerabilityMetadata(ctx, model.IDorVulnerabilityInput{
 			VulnerabilityTypeID: vulnerability.VulnerabilityTypeID,
@@ -40,7 +41,7 @@ func (r *mutationResolver) IngestVulnerabilityMetadata(ctx context.Context, vuln
 func (r *mutationResolver) IngestBulkVulnerabilityMetadata(ctx context.Context, vulnerabilities []*model.IDorVulnerabilityInput, vulnerabilityMetadataList []*model.VulnerabilityMetadataInputSpec) ([]string, error) {
 	funcName := "IngestVulnerabilityMetadatas"
 	if len(vulnerabilities) != len(vulnerabilityMetadataList) {
-		return []string{}, gqlerror.Errorf("%v :: uneven vulnerabilities and vulnerabilityMetadata for ingestion", funcName)
+		return []string{}, errext.WithCode(gqlerror.Errorf("%v :: uneven vulnerabilities and vulnerabilityMetadata for ingestion", funcName), errext.ErrInvalidInput)
 	}
 
 	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
@@ -52,12 +53,12 @@ func (r *mutationResolver) IngestBulkVulnerabilityMetadata(ctx context.Context,
 		}
 		err := validateNoVul(*v.VulnerabilityInput)
 		if err != nil {
-			return []string{}, gqlerror.Errorf("%v ::  %s", funcName, err)
+			return []string{}, errext.Errorf("%v ::  %s", funcName, err)
 		}
 
 		err = validateVulnerabilityIDInputSpec(*v.VulnerabilityInput)
 		if err != nil {
-			return []string{}, gqlerror.Errorf("%v ::  %s", funcName, err)
+			return []string{}, errext.Errorf("%v ::  %s", funcName, err)
 		}
 
 		lowercaseVulnInput := model.VulnerabilityInputSpec{
@@ -79,7 +80,7 @@ func (r *queryResolver) VulnerabilityMetadata(ctx context.Context, vulnerability
 	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
 
 	if vulnerabilityMetadataSpec.Comparator != nil && vulnerabilityMetadataSpec.ScoreValue == nil {
-		return []*model.VulnerabilityMetadata{}, gqlerror.Errorf("%v :: comparator cannot be set without a score value specified", funcName)
+		return []*model.VulnerabilityMetadata{}, errext.WithCode(gqlerror.Errorf("%v :: comparator cannot be set without a score value specified", funcName), errext.ErrInvalidInput)
 	}
 
 	if vulnerabilityMetadataSpec.Vulnerability != nil {
@@ -97,7 +98,7 @@ func (r *queryResolver) VulnerabilityMetadata(ctx context.Context, vulnerability
 
 		err := validateVulnerabilitySpec(*vulnerabilityMetadataSpec.Vulnerability)
 		if err != nil {
-			return []*model.VulnerabilityMetadata{}, gqlerror.Errorf("%v ::  %s", funcName, err)
+			return []*model.VulnerabilityMetadata{}, errext.Errorf("%v ::  %s", funcName, err)
 		}
 
 		lowercaseVulnFilter := model.VulnerabilitySpec{
@@ -122,3 +123,22 @@ func (r *queryResolver) VulnerabilityMetadata(ctx context.Context, vulnerability
 		return r.Backend.VulnerabilityMetadata(ctx, &vulnerabilityMetadataSpec)
 	}
 }
+
+// VulnerabilityMetadataList is the resolver for the vulnerabilityMetadataList field.
+func (r *queryResolver) VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, pagination *model.PaginationSpec) (*model.VulnerabilityMetadataConnection, error) {
+	if err := validatePaginationSpec("VulnerabilityMetadataList", pagination); err != nil {
+		return nil, err
+	}
+	if vulnerabilityMetadataSpec.Comparator != nil && vulnerabilityMetadataSpec.ScoreValue == nil {
+		return nil, errext.WithCode(gqlerror.Errorf("VulnerabilityMetadataList :: comparator cannot be set without a score value specified"), errext.ErrInvalidInput)
+	}
+	if vulnerabilityMetadataSpec.Vulnerability != nil {
+		if err := validateVulnerabilitySpec(*vulnerabilityMetadataSpec.Vulnerability); err != nil {
+			return nil, errext.Errorf("VulnerabilityMetadataList :: %s", err)
+		}
+	}
+
+	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+	vulnerabilityMetadataSpec.Vulnerability = lowercaseVulnerabilitySpec(vulnerabilityMetadataSpec.Vulnerability)
+	return r.Backend.VulnerabilityMetadataList(ctx, vulnerabilityMetadataSpec, pagination)
+}
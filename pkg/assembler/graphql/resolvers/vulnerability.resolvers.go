@@ -8,8 +8,10 @@ import (
 	"context"
 	"strings"
 
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/errext"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // IngestVulnerability is the resolver for the ingestVulnerability field.
@@ -18,7 +20,7 @@ func (r *mutationResolver) IngestVulnerability(ctx context.Context, vuln model.I
 	if vuln.VulnerabilityInput != nil {
 		err := validateVulnerabilityIDInputSpec(*vuln.VulnerabilityInput)
 		if err != nil {
-			return nil, gqlerror.Errorf("%v ::  %s", funcName, err)
+			return nil, errext.Errorf("%v ::  %s", funcName, err)
 		}
 		// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
 		return r.Backend.IngestVulnerability(ctx, model.IDorVulnerabilityInput{
@@ -43,7 +45,7 @@ func (r *mutationResolver) IngestVulnerabilities(ctx context.Context, vulns []*m
 		}
 		err := validateVulnerabilityIDInputSpec(*v.VulnerabilityInput)
 		if err != nil {
-			return []*model.VulnerabilityIDs{}, gqlerror.Errorf("%v ::  %s", funcName, err)
+			return []*model.VulnerabilityIDs{}, errext.Errorf("%v ::  %s", funcName, err)
 		}
 
 		lowercaseVulnInput := model.VulnerabilityInputSpec{
@@ -77,9 +79,91 @@ func (r *queryResolver) Vulnerabilities(ctx context.Context, vulnSpec model.Vuln
 
 	err := validateVulnerabilitySpec(vulnSpec)
 	if err != nil {
-		return []*model.Vulnerability{}, gqlerror.Errorf("IngestVulnerabilityMetadata ::  %s", err)
+		return []*model.Vulnerability{}, errext.Errorf("IngestVulnerabilityMetadata ::  %s", err)
 	}
 
 	return r.Backend.Vulnerabilities(ctx, &model.VulnerabilitySpec{ID: vulnSpec.ID, Type: typeLowerCase,
 		VulnerabilityID: vulnIDLowerCase, NoVuln: vulnSpec.NoVuln})
 }
+
+// VulnerabilitiesList is the resolver for the vulnerabilitiesList field.
+func (r *queryResolver) VulnerabilitiesList(ctx context.Context, vulnSpec model.VulnerabilitySpec, pagination *model.PaginationSpec) (*model.VulnerabilityConnection, error) {
+	if err := validatePaginationSpec("VulnerabilitiesList", pagination); err != nil {
+		return nil, err
+	}
+	if err := validateVulnerabilitySpec(vulnSpec); err != nil {
+		return nil, errext.Errorf("VulnerabilitiesList :: %s", err)
+	}
+
+	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+	return r.Backend.VulnerabilitiesList(ctx, *lowercaseVulnerabilitySpec(&vulnSpec), pagination)
+}
+
+// ExploitReferences is the resolver for the exploitReferences field.
+func (r *vulnerabilityResolver) ExploitReferences(ctx context.Context, obj *model.Vulnerability) ([]*model.ExploitReference, error) {
+	exploitReferences := []*model.ExploitReference{}
+	for _, vulnID := range obj.VulnerabilityIDs {
+		refs, err := r.Backend.ExploitReferences(ctx, &model.ExploitReferenceSpec{
+			Vulnerability: &model.VulnerabilitySpec{ID: &vulnID.ID},
+		})
+		if err != nil {
+			return nil, errext.Errorf("Vulnerability.ExploitReferences :: %s", err)
+		}
+		exploitReferences = append(exploitReferences, refs...)
+	}
+	return exploitReferences, nil
+}
+
+// CertifyVulns is the resolver for the certifyVulns field.
+func (r *vulnerabilityResolver) CertifyVulns(ctx context.Context, obj *model.Vulnerability, filter *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	spec := model.CertifyVulnSpec{}
+	if filter != nil {
+		spec = *filter
+		spec.Vulnerability = nil
+	}
+	vulnIDs := make([]string, 0, len(obj.VulnerabilityIDs))
+	for _, vulnID := range obj.VulnerabilityIDs {
+		vulnIDs = append(vulnIDs, vulnID.ID)
+	}
+	// the certifications of all the vulnerabilities of a list are loaded in
+	// a single backend query
+	certs, err := loadCertifyVulns(ctx, r.Backend, vulnIDs, &spec)
+	if err != nil {
+		return nil, errext.Errorf("Vulnerability.CertifyVulns :: %s", err)
+	}
+	certifyVulns := []*model.CertifyVuln{}
+	for _, vulnID := range vulnIDs {
+		certifyVulns = append(certifyVulns, certs[vulnID]...)
+	}
+	return certifyVulns, nil
+}
+
+// IsFixed is the resolver for the isFixed field.
+func (r *vulnerabilityResolver) IsFixed(ctx context.Context, obj *model.Vulnerability, pkgSpec *model.PkgSpec) (*bool, error) {
+	var subject *model.PackageOrArtifactSpec
+	if pkgSpec != nil {
+		subject = &model.PackageOrArtifactSpec{Package: pkgSpec}
+	}
+	var isFixed *bool
+	for _, vulnID := range obj.VulnerabilityIDs {
+		statements, err := r.Backend.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{
+			Subject:       subject,
+			Vulnerability: &model.VulnerabilitySpec{ID: &vulnID.ID},
+		})
+		if err != nil {
+			return nil, errext.Errorf("Vulnerability.IsFixed :: %s", err)
+		}
+		for _, statement := range statements {
+			if statement.Status == model.VexStatusFixed {
+				return ptrfrom.Bool(true), nil
+			}
+			isFixed = ptrfrom.Bool(false)
+		}
+	}
+	return isFixed, nil
+}
+
+// Vulnerability returns generated.VulnerabilityResolver implementation.
+func (r *Resolver) Vulnerability() generated.VulnerabilityResolver { return &vulnerabilityResolver{r} }
+
+type vulnerabilityResolver struct{ *Resolver }
@@ -17,9 +17,11 @@ package resolvers_test
 
 import (
 	"context"
+	"sync"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/internal/testing/mocks"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
@@ -72,3 +74,207 @@ func TestVulnerabilities(t *testing.T) {
 		})
 	}
 }
+
+func TestVulnerabilitiesList(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Query       model.VulnerabilitySpec
+		Pagination  *model.PaginationSpec
+		ExpBackend  model.VulnerabilitySpec
+		ExpQueryErr bool
+	}{
+		{
+			Name: "Novuln false with type novuln",
+			Query: model.VulnerabilitySpec{
+				Type:   ptrfrom.String("NoVuln"),
+				NoVuln: ptrfrom.Bool(false),
+			},
+			ExpQueryErr: true,
+		},
+		{
+			Name: "Invalid pagination",
+			Query: model.VulnerabilitySpec{
+				VulnerabilityID: ptrfrom.String("CVE-2014-8140"),
+			},
+			Pagination: &model.PaginationSpec{
+				Last: ptrfrom.Int(-1),
+			},
+			ExpQueryErr: true,
+		},
+		{
+			Name: "Happy path lowercases filter",
+			Query: model.VulnerabilitySpec{
+				Type:            ptrfrom.String("CVE"),
+				VulnerabilityID: ptrfrom.String("CVE-2014-8140"),
+			},
+			Pagination: &model.PaginationSpec{
+				First: ptrfrom.Int(5),
+			},
+			ExpBackend: model.VulnerabilitySpec{
+				Type:            ptrfrom.String("cve"),
+				VulnerabilityID: ptrfrom.String("cve-2014-8140"),
+			},
+			ExpQueryErr: false,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpQueryErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				VulnerabilitiesList(ctx, test.ExpBackend, test.Pagination).
+				Times(times)
+			_, err := r.Query().VulnerabilitiesList(ctx, test.Query, test.Pagination)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+		})
+	}
+}
+
+func TestVulnerabilityCertifyVulns(t *testing.T) {
+	vulns := []*model.Vulnerability{
+		{ID: "v1", Type: "ghsa", VulnerabilityIDs: []*model.VulnerabilityID{{ID: "ghsa-1", VulnerabilityID: "ghsa-h45f-rjvw-2rv2"}}},
+		{ID: "v2", Type: "cve", VulnerabilityIDs: []*model.VulnerabilityID{{ID: "cve-1", VulnerabilityID: "cve-2019-13110"}, {ID: "cve-2", VulnerabilityID: "cve-2014-8139"}}},
+	}
+	certs := map[string][]*model.CertifyVuln{
+		"ghsa-1": {{ID: "c1", Package: &model.Package{Type: "pypi"}}},
+		"cve-1":  {{ID: "c2", Package: &model.Package{Type: "conan"}}},
+		"cve-2":  {{ID: "c3", Package: &model.Package{Type: "deb"}}},
+	}
+	filter := &model.CertifyVulnSpec{
+		Origin:        ptrfrom.String("osv"),
+		Vulnerability: &model.VulnerabilitySpec{Type: ptrfrom.String("cve")},
+	}
+
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	r := resolvers.Resolver{Backend: b}
+	// all the vulnerabilities of the operation are loaded with one query
+	b.
+		EXPECT().
+		CertifyVulnByVulnerabilityIDs(gomock.Any(), gomock.InAnyOrder([]string{"ghsa-1", "cve-1", "cve-2"}),
+			&model.CertifyVulnSpec{Origin: ptrfrom.String("osv")}).
+		Return(certs, nil).
+		Times(1)
+
+	ctx := resolvers.WithLoaders(context.Background())
+	got := make([][]*model.CertifyVuln, len(vulns))
+	errs := make([]error, len(vulns))
+	var wg sync.WaitGroup
+	for i, vuln := range vulns {
+		wg.Add(1)
+		go func(i int, vuln *model.Vulnerability) {
+			defer wg.Done()
+			got[i], errs[i] = r.Vulnerability().CertifyVulns(ctx, vuln, filter)
+		}(i, vuln)
+	}
+	wg.Wait()
+
+	want := [][]string{{"pypi"}, {"conan", "deb"}}
+	for i := range vulns {
+		if errs[i] != nil {
+			t.Fatalf("unexpected error: %v", errs[i])
+		}
+		var gotTypes []string
+		for _, cert := range got[i] {
+			gotTypes = append(gotTypes, cert.Package.Type)
+		}
+		if diff := cmp.Diff(want[i], gotTypes); diff != "" {
+			t.Errorf("Unexpected packages of %s (-want +got):\n%s", vulns[i].ID, diff)
+		}
+	}
+}
+
+func TestVulnerabilityCertifyVulnsWithoutLoaders(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	r := resolvers.Resolver{Backend: b}
+	b.
+		EXPECT().
+		CertifyVulnByVulnerabilityIDs(ctx, []string{"ghsa-1"}, &model.CertifyVulnSpec{}).
+		Return(map[string][]*model.CertifyVuln{}, nil).
+		Times(1)
+
+	got, err := r.Vulnerability().CertifyVulns(ctx, &model.Vulnerability{
+		VulnerabilityIDs: []*model.VulnerabilityID{{ID: "ghsa-1"}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got == nil || len(got) != 0 {
+		t.Errorf("expected an empty list, got %v", got)
+	}
+}
+
+func TestVulnerabilityIsFixed(t *testing.T) {
+	vuln := &model.Vulnerability{
+		ID:               "v1",
+		Type:             "cve",
+		VulnerabilityIDs: []*model.VulnerabilityID{{ID: "cve-1", VulnerabilityID: "cve-2019-13110"}},
+	}
+	pkgSpec := &model.PkgSpec{Type: ptrfrom.String("pypi"), Name: ptrfrom.String("django")}
+	tests := []struct {
+		name     string
+		statuses []model.VexStatus
+		want     *bool
+	}{{
+		name: "no VEX statement",
+	}, {
+		name:     "not affected",
+		statuses: []model.VexStatus{model.VexStatusNotAffected},
+		want:     ptrfrom.Bool(false),
+	}, {
+		name:     "affected",
+		statuses: []model.VexStatus{model.VexStatusAffected},
+		want:     ptrfrom.Bool(false),
+	}, {
+		name:     "under investigation",
+		statuses: []model.VexStatus{model.VexStatusUnderInvestigation},
+		want:     ptrfrom.Bool(false),
+	}, {
+		name:     "fixed",
+		statuses: []model.VexStatus{model.VexStatusFixed},
+		want:     ptrfrom.Bool(true),
+	}, {
+		name:     "fixed among other statuses",
+		statuses: []model.VexStatus{model.VexStatusAffected, model.VexStatusFixed},
+		want:     ptrfrom.Bool(true),
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			var statements []*model.CertifyVEXStatement
+			for _, status := range tt.statuses {
+				statements = append(statements, &model.CertifyVEXStatement{Status: status})
+			}
+			b.
+				EXPECT().
+				CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{
+					Subject:       &model.PackageOrArtifactSpec{Package: pkgSpec},
+					Vulnerability: &model.VulnerabilitySpec{ID: ptrfrom.String("cve-1")},
+				}).
+				Return(statements, nil).
+				Times(1)
+
+			got, err := r.Vulnerability().IsFixed(ctx, vuln, pkgSpec)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Unexpected isFixed (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// CanonicalizePkgSpec returns a copy of spec in canonical form, so that the
+// same package written in different ways maps to a single node: the type and
+// namespace are lowercased, whitespace is trimmed from the version, surrounding
+// slashes are trimmed from the subpath and the qualifiers are sorted by key and
+// value.
+func CanonicalizePkgSpec(spec *model.PkgSpec) *model.PkgSpec {
+	if spec == nil {
+		return nil
+	}
+	rv := *spec
+	rv.Type = canonicalLower(spec.Type)
+	rv.Namespace = canonicalLower(spec.Namespace)
+	rv.Version = canonicalVersion(spec.Version)
+	rv.Subpath = canonicalSubpath(spec.Subpath)
+	if spec.Qualifiers != nil {
+		rv.Qualifiers = make([]*model.PackageQualifierSpec, len(spec.Qualifiers))
+		copy(rv.Qualifiers, spec.Qualifiers)
+		sort.SliceStable(rv.Qualifiers, func(i, j int) bool {
+			a, b := rv.Qualifiers[i], rv.Qualifiers[j]
+			if a.Key != b.Key {
+				return a.Key < b.Key
+			}
+			return valueOrEmpty(a.Value) < valueOrEmpty(b.Value)
+		})
+	}
+	return &rv
+}
+
+// CanonicalizePkgInputSpec is CanonicalizePkgSpec for ingestion inputs.
+func CanonicalizePkgInputSpec(spec *model.PkgInputSpec) *model.PkgInputSpec {
+	if spec == nil {
+		return nil
+	}
+	rv := *spec
+	rv.Type = strings.ToLower(spec.Type)
+	rv.Namespace = canonicalLower(spec.Namespace)
+	rv.Version = canonicalVersion(spec.Version)
+	rv.Subpath = canonicalSubpath(spec.Subpath)
+	if spec.Qualifiers != nil {
+		rv.Qualifiers = make([]*model.PackageQualifierInputSpec, len(spec.Qualifiers))
+		copy(rv.Qualifiers, spec.Qualifiers)
+		sort.SliceStable(rv.Qualifiers, func(i, j int) bool {
+			a, b := rv.Qualifiers[i], rv.Qualifiers[j]
+			if a.Key != b.Key {
+				return a.Key < b.Key
+			}
+			return a.Value < b.Value
+		})
+	}
+	return &rv
+}
+
+func canonicalLower(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	lower := strings.ToLower(*s)
+	return &lower
+}
+
+func canonicalVersion(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*s)
+	return &trimmed
+}
+
+func canonicalSubpath(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	trimmed := strings.Trim(strings.TrimSpace(*s), "/")
+	return &trimmed
+}
+
+func valueOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,147 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestCanonicalizePkgSpec(t *testing.T) {
+	tests := []struct {
+		name string
+		spec *model.PkgSpec
+		want *model.PkgSpec
+	}{{
+		name: "nil",
+	}, {
+		name: "empty",
+		spec: &model.PkgSpec{},
+		want: &model.PkgSpec{},
+	}, {
+		name: "type and namespace lowercased, name kept",
+		spec: &model.PkgSpec{Type: ptrfrom.String("Golang"), Namespace: ptrfrom.String("GitHub.com/Sirupsen"), Name: ptrfrom.String("Logrus")},
+		want: &model.PkgSpec{Type: ptrfrom.String("golang"), Namespace: ptrfrom.String("github.com/sirupsen"), Name: ptrfrom.String("Logrus")},
+	}, {
+		name: "version and subpath trimmed",
+		spec: &model.PkgSpec{Version: ptrfrom.String(" 1.2.3\n"), Subpath: ptrfrom.String("/lib/foo/")},
+		want: &model.PkgSpec{Version: ptrfrom.String("1.2.3"), Subpath: ptrfrom.String("lib/foo")},
+	}, {
+		name: "qualifiers sorted",
+		spec: &model.PkgSpec{Qualifiers: []*model.PackageQualifierSpec{
+			{Key: "os", Value: ptrfrom.String("linux")},
+			{Key: "arch", Value: ptrfrom.String("amd64")},
+			{Key: "distro"},
+		}},
+		want: &model.PkgSpec{Qualifiers: []*model.PackageQualifierSpec{
+			{Key: "arch", Value: ptrfrom.String("amd64")},
+			{Key: "distro"},
+			{Key: "os", Value: ptrfrom.String("linux")},
+		}},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var before model.PkgSpec
+			if tt.spec != nil {
+				before = *tt.spec
+			}
+			got := CanonicalizePkgSpec(tt.spec)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Unexpected results (-want +got):\n%s", diff)
+			}
+			if tt.spec != nil {
+				if diff := cmp.Diff(before, *tt.spec); diff != "" {
+					t.Errorf("CanonicalizePkgSpec modified its input (-before +after):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCanonicalizePkgSpecRoundTrip(t *testing.T) {
+	tests := []struct {
+		purls []string
+		want  string
+	}{{
+		purls: []string{
+			"pkg:deb/debian/curl@7.50.3-1?arch=i386&distro=jessie",
+			"pkg:deb/debian/curl@7.50.3-1?distro=jessie&arch=i386",
+		},
+		want: "pkg:deb/debian/curl@7.50.3-1?arch=i386&distro=jessie",
+	}, {
+		purls: []string{
+			"pkg:rpm/fedora/curl@7.50.3-1.fc25?arch=i386&distro=fedora-25",
+			"pkg:rpm/Fedora/curl@7.50.3-1.fc25?distro=fedora-25&arch=i386",
+		},
+		want: "pkg:rpm/fedora/curl@7.50.3-1.fc25?arch=i386&distro=fedora-25",
+	}, {
+		purls: []string{
+			"pkg:github/package-url/purl-spec@244fd47e07d1004#everybody/loves/dogs",
+			"pkg:github/Package-URL/purl-spec@244fd47e07d1004#/everybody/loves/dogs/",
+		},
+		want: "pkg:github/package-url/purl-spec@244fd47e07d1004#everybody/loves/dogs",
+	}, {
+		purls: []string{
+			"pkg:maven/org.apache.xmlgraphics/batik-anim@1.9.1?classifier=dist&type=zip",
+			"pkg:maven/org.apache.xmlgraphics/batik-anim@1.9.1?type=zip&classifier=dist",
+		},
+		want: "pkg:maven/org.apache.xmlgraphics/batik-anim@1.9.1?classifier=dist&type=zip",
+	}, {
+		purls: []string{
+			"pkg:apk/alpine/curl@7.83.0-r0?arch=x86&distro=alpine-3.16",
+			"pkg:apk/alpine/curl@%207.83.0-r0?distro=alpine-3.16&arch=x86",
+		},
+		want: "pkg:apk/alpine/curl@7.83.0-r0?arch=x86&distro=alpine-3.16",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			first := CanonicalizePkgSpec(purlToSpec(t, tt.purls[0]))
+			for _, p := range tt.purls {
+				spec := CanonicalizePkgSpec(purlToSpec(t, p))
+				if diff := cmp.Diff(first, spec); diff != "" {
+					t.Errorf("canonical spec of %q differs from %q (-want +got):\n%s", p, tt.purls[0], diff)
+				}
+				if got := specToPurl(spec); got != tt.want {
+					t.Errorf("canonical purl of %q = %q, want %q", p, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func purlToSpec(t *testing.T, p string) *model.PkgSpec {
+	t.Helper()
+	pkg, err := PurlToPkg(p)
+	if err != nil {
+		t.Fatalf("PurlToPkg(%q) error = %v", p, err)
+	}
+	spec := &model.PkgSpec{Type: &pkg.Type, Namespace: pkg.Namespace, Name: &pkg.Name, Version: pkg.Version, Subpath: pkg.Subpath}
+	for _, q := range pkg.Qualifiers {
+		spec.Qualifiers = append(spec.Qualifiers, &model.PackageQualifierSpec{Key: q.Key, Value: ptrfrom.String(q.Value)})
+	}
+	return spec
+}
+
+func specToPurl(spec *model.PkgSpec) string {
+	var qualifiers []string
+	for _, q := range spec.Qualifiers {
+		qualifiers = append(qualifiers, q.Key, *q.Value)
+	}
+	return PkgToPurl(*spec.Type, *spec.Namespace, *spec.Name, *spec.Version, *spec.Subpath, qualifiers)
+}
@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	gqlmodel "github.com/guacsec/guac/pkg/assembler/graphql/model"
 	purl "github.com/package-url/packageurl-go"
 )
 
@@ -42,6 +43,80 @@ func PurlToPkg(purlUri string) (*model.PkgInputSpec, error) {
 	return purlConvert(p)
 }
 
+// FromPURL converts a purl URI string into a package filter that matches the
+// package node PurlToPkg would create for it. Components missing from the purl
+// match the empty value, and the qualifiers are sorted by key.
+func FromPURL(purlUri string) (*gqlmodel.PkgSpec, error) {
+	p, err := purl.FromString(purlUri)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse purl %s: %v", purlUri, err)
+	}
+
+	pkgInput, err := purlConvert(p)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert purl %s: %v", purlUri, err)
+	}
+
+	spec := &gqlmodel.PkgSpec{
+		Type:      &pkgInput.Type,
+		Namespace: pkgInput.Namespace,
+		Name:      &pkgInput.Name,
+		Version:   pkgInput.Version,
+		Subpath:   pkgInput.Subpath,
+	}
+	for _, qualifier := range pkgInput.Qualifiers {
+		// to prevent https://github.com/golang/go/discussions/56010
+		qualifier := qualifier
+		spec.Qualifiers = append(spec.Qualifiers, &gqlmodel.PackageQualifierSpec{
+			Key:   qualifier.Key,
+			Value: &qualifier.Value,
+		})
+	}
+	sort.Slice(spec.Qualifiers, func(i, j int) bool {
+		return spec.Qualifiers[i].Key < spec.Qualifiers[j].Key
+	})
+	return spec, nil
+}
+
+// ToPURL converts a package tree into a purl, the inverse of FromPURL. The tree
+// must have a single namespace and name, and at most one version; the version
+// is omitted from the purl if the tree has none or it is empty. Qualifiers are
+// encoded in sorted key order.
+func ToPURL(p *gqlmodel.Package) (string, error) {
+	if p == nil {
+		return "", fmt.Errorf("unable to convert package to purl: package is nil")
+	}
+	if len(p.Namespaces) != 1 {
+		return "", fmt.Errorf("unable to convert package %s to purl: expected one namespace, found %d", p.ID, len(p.Namespaces))
+	}
+	ns := p.Namespaces[0]
+	if len(ns.Names) != 1 {
+		return "", fmt.Errorf("unable to convert package %s to purl: expected one name, found %d", p.ID, len(ns.Names))
+	}
+	name := ns.Names[0]
+	if len(name.Versions) > 1 {
+		return "", fmt.Errorf("unable to convert package %s to purl: expected at most one version, found %d", p.ID, len(name.Versions))
+	}
+
+	var version, subpath string
+	qualifiers := []string{}
+	if len(name.Versions) == 1 {
+		v := name.Versions[0]
+		version = v.Version
+		subpath = v.Subpath
+
+		sorted := make([]*gqlmodel.PackageQualifier, len(v.Qualifiers))
+		copy(sorted, v.Qualifiers)
+		sort.Slice(sorted, func(i, j int) bool {
+			return sorted[i].Key < sorted[j].Key
+		})
+		for _, q := range sorted {
+			qualifiers = append(qualifiers, q.Key, q.Value)
+		}
+	}
+	return PkgToPurl(p.Type, ns.Namespace, name.Name, version, subpath, qualifiers), nil
+}
+
 // AllPkgTreeToPurl takes one package trie evaluation and converts it into a PURL
 // it will only do this for one PURL, and will ignore other pkg tries in the fragment
 func AllPkgTreeToPurl(v *model.AllPkgTree) string {
@@ -22,6 +22,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	gqlmodel "github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
 var cmpOpts = []cmp.Option{
@@ -977,3 +978,171 @@ func TestGuacFilePurl(t *testing.T) {
 func strP(s string) *string {
 	return &s
 }
+
+func TestFromPURL(t *testing.T) {
+	// canonical purls of the purl-spec test suite, for the types without
+	// special handling of the namespace
+	canonicalPurls := []string{
+		"pkg:maven/org.apache.commons/io",
+		"pkg:maven/org.apache.xmlgraphics/batik-anim@1.9.1?classifier=sources",
+		"pkg:maven/org.apache.xmlgraphics/batik-anim@1.9.1?type=pom",
+		"pkg:maven/net.sf.jacob-project/jacob@1.14.3?classifier=x86&type=dll",
+		"pkg:npm/%40angular/animation@12.3.1",
+		"pkg:npm/foobar@12.3.1",
+		"pkg:nuget/EnterpriseLibrary.Common@6.0.1304",
+		"pkg:pypi/django@1.11.1",
+		"pkg:rpm/fedora/curl@7.50.3-1.fc25?arch=i386&distro=fedora-25",
+		"pkg:gem/jruby-launcher@1.1.2?platform=java",
+		"pkg:github/package-url/purl-spec@244fd47e07d10",
+		"pkg:golang/google.golang.org/genproto#googleapis/api/annotations",
+		"pkg:golang/github.com/gorilla/context@234fd47e07d1004f0aed9c#api",
+		"pkg:bitbucket/birkenfeld/pygments-main@244fd47e07d1014f0aed9c",
+		"pkg:deb/debian/curl@7.50.3-1?arch=i386&distro=jessie",
+		"pkg:cargo/rand@0.7.2",
+		"pkg:composer/laravel/laravel@5.5.0",
+		"pkg:conan/openssl.org/openssl@3.0.3?channel=stable&user=bincrafters",
+		"pkg:swift/github.com/Alamofire/Alamofire@5.4.3",
+		"pkg:generic/openssl@1.1.10g",
+	}
+	for _, purlUri := range canonicalPurls {
+		t.Run(purlUri, func(t *testing.T) {
+			spec, err := FromPURL(purlUri)
+			if err != nil {
+				t.Fatalf("FromPURL() error = %v", err)
+			}
+			qualifiers := []string{}
+			for _, q := range spec.Qualifiers {
+				qualifiers = append(qualifiers, q.Key, *q.Value)
+			}
+			got := PkgToPurl(*spec.Type, *spec.Namespace, *spec.Name, *spec.Version, *spec.Subpath, qualifiers)
+			if got != purlUri {
+				t.Errorf("FromPURL() does not round trip, got %s, want %s", got, purlUri)
+			}
+		})
+	}
+}
+
+func TestFromPURLFields(t *testing.T) {
+	got, err := FromPURL("pkg:maven/net.sf.jacob-project/jacob@1.14.3?type=dll&classifier=x86#lib")
+	if err != nil {
+		t.Fatalf("FromPURL() error = %v", err)
+	}
+	want := &gqlmodel.PkgSpec{
+		Type:      strP("maven"),
+		Namespace: strP("net.sf.jacob-project"),
+		Name:      strP("jacob"),
+		Version:   strP("1.14.3"),
+		Subpath:   strP("lib"),
+		Qualifiers: []*gqlmodel.PackageQualifierSpec{
+			{Key: "classifier", Value: strP("x86")},
+			{Key: "type", Value: strP("dll")},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FromPURL() mismatch (-want +got):\n%s", diff)
+	}
+
+	for _, purlUri := range []string{
+		"",
+		"npm/foobar@12.3.1",
+		"pkg:npm",
+		"pkg:npm/foobar?%",
+		"pkg:unknowntype/foobar@1.0",
+	} {
+		if _, err := FromPURL(purlUri); err == nil {
+			t.Errorf("FromPURL(%q) expected an error", purlUri)
+		}
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	pkgTree := func(typ, namespace, name string, versions ...*gqlmodel.PackageVersion) *gqlmodel.Package {
+		return &gqlmodel.Package{
+			ID:   "1",
+			Type: typ,
+			Namespaces: []*gqlmodel.PackageNamespace{{
+				Namespace: namespace,
+				Names: []*gqlmodel.PackageName{{
+					Name:     name,
+					Versions: versions,
+				}},
+			}},
+		}
+	}
+	testCases := []struct {
+		name    string
+		pkg     *gqlmodel.Package
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "npm scoped",
+			pkg:  pkgTree("npm", "@angular", "animation", &gqlmodel.PackageVersion{Version: "12.3.1"}),
+			want: "pkg:npm/%40angular/animation@12.3.1",
+		}, {
+			name: "npm without version",
+			pkg:  pkgTree("npm", "", "foobar"),
+			want: "pkg:npm/foobar",
+		}, {
+			name: "npm empty version",
+			pkg:  pkgTree("npm", "", "foobar", &gqlmodel.PackageVersion{}),
+			want: "pkg:npm/foobar",
+		}, {
+			name: "pypi",
+			pkg:  pkgTree("pypi", "", "django", &gqlmodel.PackageVersion{Version: "1.11.1"}),
+			want: "pkg:pypi/django@1.11.1",
+		}, {
+			name: "maven with group id and qualifiers",
+			pkg: pkgTree("maven", "net.sf.jacob-project", "jacob", &gqlmodel.PackageVersion{
+				Version: "1.14.3",
+				Qualifiers: []*gqlmodel.PackageQualifier{
+					{Key: "type", Value: "dll"},
+					{Key: "classifier", Value: "x86"},
+				},
+			}),
+			want: "pkg:maven/net.sf.jacob-project/jacob@1.14.3?classifier=x86&type=dll",
+		}, {
+			name: "golang with subpath",
+			pkg:  pkgTree("golang", "github.com/gorilla", "context", &gqlmodel.PackageVersion{Version: "234fd47e07d1004f0aed9c", Subpath: "api"}),
+			want: "pkg:golang/github.com/gorilla/context@234fd47e07d1004f0aed9c#api",
+		}, {
+			name: "oci",
+			pkg: pkgTree("oci", "docker.io/library", "debian", &gqlmodel.PackageVersion{
+				Version:    "sha256:244fd47e07d10",
+				Qualifiers: []*gqlmodel.PackageQualifier{{Key: "arch", Value: "amd64"}},
+			}),
+			want: "pkg:oci/debian@sha256%3A244fd47e07d10?arch=amd64&repository_url=docker.io%2Flibrary",
+		}, {
+			name:    "nil package",
+			wantErr: true,
+		}, {
+			name: "two namespaces",
+			pkg: &gqlmodel.Package{Type: "npm", Namespaces: []*gqlmodel.PackageNamespace{
+				{Names: []*gqlmodel.PackageName{{Name: "foobar"}}},
+				{Namespace: "@angular", Names: []*gqlmodel.PackageName{{Name: "animation"}}},
+			}},
+			wantErr: true,
+		}, {
+			name: "two names",
+			pkg: &gqlmodel.Package{Type: "npm", Namespaces: []*gqlmodel.PackageNamespace{
+				{Names: []*gqlmodel.PackageName{{Name: "foobar"}, {Name: "foobaz"}}},
+			}},
+			wantErr: true,
+		}, {
+			name:    "two versions",
+			pkg:     pkgTree("pypi", "", "django", &gqlmodel.PackageVersion{Version: "1.11.1"}, &gqlmodel.PackageVersion{Version: "1.11.2"}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToPURL(tt.pkg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToPURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ToPURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -70,6 +70,11 @@ func (s *store) Set(ctx context.Context, c, k string, v any) error {
 	return s.c.Put(ctx, []byte(ck), bts)
 }
 
+func (s *store) Delete(ctx context.Context, c, k string) error {
+	ck := strings.Join([]string{c, k}, ":")
+	return s.c.Delete(ctx, []byte(ck))
+}
+
 func (s *store) Keys(c string) kv.Scanner {
 	return &scanner{
 		c:      s.c,
@@ -0,0 +1,221 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"golang.org/x/exp/maps"
+)
+
+// nodeType describes how queries for a type of node are translated to GraphQL
+type nodeType struct {
+	// name is the node type as written in queries
+	name string
+	// field, specArg and specType describe the GraphQL query field returning the nodes
+	field    string
+	specArg  string
+	specType string
+	// selection is the selection set requested for each node
+	selection string
+	// filters maps the lowercased query fields to their path in the spec
+	filters map[string][]string
+	// orders maps the lowercased query fields to the order field enum value
+	orders map[string]string
+}
+
+const pkgSelection = `id type namespaces { id namespace names { id name versions { id purl version subpath qualifiers { key value } } } }`
+
+var nodeTypes = []nodeType{
+	{
+		name:     "CertifyVuln",
+		field:    "CertifyVuln",
+		specArg:  "certifyVulnSpec",
+		specType: "CertifyVulnSpec!",
+		selection: `id package { ` + pkgSelection + ` } vulnerability { id type vulnerabilityIDs { id vulnerabilityID } } ` +
+			`metadata { dbUri dbVersion scannerUri scannerVersion timeScanned origin collector documentRef }`,
+		filters: map[string][]string{
+			"id":                            {"id"},
+			"package.id":                    {"package", "id"},
+			"package.type":                  {"package", "type"},
+			"package.namespace":             {"package", "namespace"},
+			"package.name":                  {"package", "name"},
+			"package.version":               {"package", "version"},
+			"package.subpath":               {"package", "subpath"},
+			"vulnerability.id":              {"vulnerability", "id"},
+			"vulnerability.type":            {"vulnerability", "type"},
+			"vulnerability.vulnerabilityid": {"vulnerability", "vulnerabilityID"},
+			"vulnerability.novuln":          {"vulnerability", "noVuln"},
+			"metadata.timescanned":          {"timeScanned"},
+			"metadata.dburi":                {"dbUri"},
+			"metadata.dbversion":            {"dbVersion"},
+			"metadata.scanneruri":           {"scannerUri"},
+			"metadata.scannerversion":       {"scannerVersion"},
+			"metadata.origin":               {"origin"},
+			"metadata.collector":            {"collector"},
+			"metadata.documentref":          {"documentRef"},
+		},
+		orders: map[string]string{
+			"metadata.timescanned":    "TIME_SCANNED",
+			"metadata.scannerversion": "SCANNER_VERSION",
+			"metadata.dbversion":      "DB_VERSION",
+		},
+	},
+	{
+		name:      "Package",
+		field:     "packages",
+		specArg:   "pkgSpec",
+		specType:  "PkgSpec!",
+		selection: pkgSelection,
+		filters: map[string][]string{
+			"id":        {"id"},
+			"type":      {"type"},
+			"namespace": {"namespace"},
+			"name":      {"name"},
+			"version":   {"version"},
+			"subpath":   {"subpath"},
+		},
+		orders: map[string]string{
+			"type":      "TYPE",
+			"namespace": "NAMESPACE",
+			"name":      "NAME",
+			"version":   "VERSION",
+		},
+	},
+	{
+		name:      "Source",
+		field:     "sources",
+		specArg:   "sourceSpec",
+		specType:  "SourceSpec!",
+		selection: `id type namespaces { id namespace names { id name tag commit } }`,
+		filters: map[string][]string{
+			"id":           {"id"},
+			"type":         {"type"},
+			"namespace":    {"namespace"},
+			"name":         {"name"},
+			"nameprefix":   {"namePrefix"},
+			"nameglob":     {"nameGlob"},
+			"namecontains": {"nameContains"},
+			"namesuffix":   {"nameSuffix"},
+			"tag":          {"tag"},
+			"commit":       {"commit"},
+		},
+		orders: map[string]string{
+			"type":      "TYPE",
+			"namespace": "NAMESPACE",
+			"name":      "NAME",
+		},
+	},
+}
+
+func lookupNodeType(name string) (*nodeType, error) {
+	var names []string
+	for i := range nodeTypes {
+		if strings.EqualFold(nodeTypes[i].name, name) {
+			return &nodeTypes[i], nil
+		}
+		names = append(names, nodeTypes[i].name)
+	}
+	return nil, fmt.Errorf("unsupported node type %q, expected one of %s", name, strings.Join(names, ", "))
+}
+
+// fieldNames lists the fields of a nodeType mapping for error messages
+func fieldNames[V any](m map[string]V) string {
+	names := maps.Keys(m)
+	slices.Sort(names)
+	return strings.Join(names, ", ")
+}
+
+// Transpile translates the query to a GraphQL request. The conditions and the
+// ordering are passed in the spec variable of the query field for the node type.
+// The limit is not part of the request, it is applied by Run.
+func Transpile(q *Query) (*graphql.Request, error) {
+	node, err := lookupNodeType(q.Node)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := map[string]interface{}{}
+	for _, c := range q.Where {
+		field := strings.Join(c.Field, ".")
+		path, ok := node.filters[strings.ToLower(field)]
+		if !ok {
+			return nil, fmt.Errorf("cannot filter %s on %q, expected one of %s", node.name, field, fieldNames(node.filters))
+		}
+		obj := spec
+		for _, key := range path[:len(path)-1] {
+			child, ok := obj[key].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				obj[key] = child
+			}
+			obj = child
+		}
+		last := path[len(path)-1]
+		if _, ok := obj[last]; ok {
+			return nil, fmt.Errorf("%q is filtered on more than once", field)
+		}
+		obj[last] = c.Value
+	}
+	if q.OrderBy != nil {
+		field := strings.Join(q.OrderBy.Field, ".")
+		sortBy, ok := node.orders[strings.ToLower(field)]
+		if !ok {
+			return nil, fmt.Errorf("cannot order %s by %q, expected one of %s", node.name, field, fieldNames(node.orders))
+		}
+		sortOrder := "ASC"
+		if q.OrderBy.Descending {
+			sortOrder = "DESC"
+		}
+		spec["order"] = map[string]interface{}{"sortBy": sortBy, "sortOrder": sortOrder}
+	}
+
+	opName := "Find" + node.name
+	return &graphql.Request{
+		OpName: opName,
+		Query: fmt.Sprintf("query %s($spec: %s) {\n  %s(%s: $spec) {\n    %s\n  }\n}",
+			opName, node.specType, node.field, node.specArg, node.selection),
+		Variables: map[string]interface{}{"spec": spec},
+	}, nil
+}
+
+// Run executes the query with the GraphQL client and returns the nodes found,
+// at most q.Limit of them.
+func Run(ctx context.Context, client graphql.Client, q *Query) ([]json.RawMessage, error) {
+	req, err := Transpile(q)
+	if err != nil {
+		return nil, err
+	}
+	node, err := lookupNodeType(q.Node)
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string][]json.RawMessage
+	if err := client.MakeRequest(ctx, req, &graphql.Response{Data: &data}); err != nil {
+		return nil, fmt.Errorf("error running %s query: %w", node.name, err)
+	}
+	nodes := data[node.field]
+	if q.Limit > 0 && len(nodes) > q.Limit {
+		nodes = nodes[:q.Limit]
+	}
+	return nodes, nil
+}
@@ -0,0 +1,184 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+)
+
+func TestTranspile(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		wantOpName    string
+		wantVariables map[string]interface{}
+		wantErr       bool
+	}{
+		{
+			name:       "certifyVuln",
+			query:      `FIND certifyvuln WHERE package.type = "pypi" AND metadata.scannerUri = "grype" AND vulnerability.noVuln = false ORDER BY metadata.timeScanned DESC`,
+			wantOpName: "FindCertifyVuln",
+			wantVariables: map[string]interface{}{"spec": map[string]interface{}{
+				"package":       map[string]interface{}{"type": "pypi"},
+				"vulnerability": map[string]interface{}{"noVuln": false},
+				"scannerUri":    "grype",
+				"order":         map[string]interface{}{"sortBy": "TIME_SCANNED", "sortOrder": "DESC"},
+			}},
+		},
+		{
+			name:          "package",
+			query:         `FIND Package WHERE name = "openssl" ORDER BY version`,
+			wantOpName:    "FindPackage",
+			wantVariables: map[string]interface{}{"spec": map[string]interface{}{"name": "openssl", "order": map[string]interface{}{"sortBy": "VERSION", "sortOrder": "ASC"}}},
+		},
+		{
+			name:          "source",
+			query:         `FIND Source WHERE nameGlob = "guac-*" LIMIT 3`,
+			wantOpName:    "FindSource",
+			wantVariables: map[string]interface{}{"spec": map[string]interface{}{"nameGlob": "guac-*"}},
+		},
+		{
+			name:    "unknown node",
+			query:   "FIND HasSBOM",
+			wantErr: true,
+		},
+		{
+			name:    "unknown filter",
+			query:   `FIND Source WHERE version = "1"`,
+			wantErr: true,
+		},
+		{
+			name:    "repeated filter",
+			query:   `FIND Source WHERE name = "a" AND name = "b"`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown order",
+			query:   `FIND Source ORDER BY tag`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := Parse(tt.query)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			got, err := Transpile(q)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Transpile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.OpName != tt.wantOpName {
+				t.Errorf("Transpile() OpName = %s, want %s", got.OpName, tt.wantOpName)
+			}
+			if diff := cmp.Diff(tt.wantVariables, got.Variables); diff != "" {
+				t.Errorf("Unexpected variables. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", nil, nil)
+	if err != nil {
+		t.Fatalf("error creating keyvalue backend: %v", err)
+	}
+	vuln := model.IDorVulnerabilityInput{VulnerabilityInput: &model.VulnerabilityInputSpec{Type: "ghsa", VulnerabilityID: "ghsa-h45f-rjvw-2rv2"}}
+	if _, err := b.IngestVulnerability(ctx, vuln); err != nil {
+		t.Fatalf("IngestVulnerability() error = %v", err)
+	}
+	for i, p := range []struct {
+		pkg     *model.PkgInputSpec
+		scanner string
+	}{
+		{&model.PkgInputSpec{Type: "pypi", Name: "django", Version: ptrfrom.String("1.0")}, "grype"},
+		{&model.PkgInputSpec{Type: "pypi", Name: "flask", Version: ptrfrom.String("2.0")}, "grype"},
+		{&model.PkgInputSpec{Type: "pypi", Name: "requests", Version: ptrfrom.String("3.0")}, "osv"},
+		{&model.PkgInputSpec{Type: "npm", Name: "react", Version: ptrfrom.String("4.0")}, "grype"},
+	} {
+		if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p.pkg}); err != nil {
+			t.Fatalf("IngestPackage() error = %v", err)
+		}
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: p.pkg}, vuln,
+			model.ScanMetadataInput{ScannerURI: p.scanner, TimeScanned: time.Unix(1e9+int64(i), 0)}); err != nil {
+			t.Fatalf("IngestCertifyVuln() error = %v", err)
+		}
+	}
+
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &resolvers.Resolver{Backend: b}}))
+	server := httptest.NewServer(srv)
+	defer server.Close()
+	client := graphql.NewClient(server.URL, http.DefaultClient)
+
+	tests := []struct {
+		name      string
+		query     string
+		wantNames []string
+	}{
+		{
+			name:      "filter",
+			query:     `FIND CertifyVuln WHERE package.type = "pypi" AND metadata.scannerUri = "grype" ORDER BY metadata.timeScanned`,
+			wantNames: []string{"django", "flask"},
+		},
+		{
+			name:      "limit",
+			query:     `FIND CertifyVuln WHERE metadata.scannerUri = "grype" ORDER BY metadata.timeScanned DESC LIMIT 2`,
+			wantNames: []string{"react", "flask"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := Parse(tt.query)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			nodes, err := Run(ctx, client, q)
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			var names []string
+			for _, n := range nodes {
+				var cv model.CertifyVuln
+				if err := json.Unmarshal(n, &cv); err != nil {
+					t.Fatalf("failed to decode node: %v", err)
+				}
+				names = append(names, cv.Package.Namespaces[0].Names[0].Name)
+			}
+			if diff := cmp.Diff(tt.wantNames, names); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,320 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gql implements the GUAC Query Language, a small query language for
+// ad-hoc lookups of graph nodes that is translated to GraphQL. A query has the
+// form
+//
+//	FIND <node> [WHERE <field> = <value> {AND <field> = <value>}]
+//	  [ORDER BY <field> [ASC | DESC]] [LIMIT <n>]
+//
+// for example
+//
+//	FIND CertifyVuln WHERE package.type = "pypi" AND metadata.scannerUri = "grype" LIMIT 10
+//
+// Keywords are case insensitive. Fields are dotted paths, values are double
+// quoted strings, numbers, true or false.
+package gql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Query is a parsed GUAC Query Language query.
+type Query struct {
+	// Node is the type of the nodes to find, e.g. CertifyVuln
+	Node string
+	// Where holds the conditions all the nodes must match
+	Where []Condition
+	// OrderBy sorts the nodes, nil keeps the backend order
+	OrderBy *OrderBy
+	// Limit is the maximum number of nodes returned, 0 for no limit
+	Limit int
+}
+
+// Condition requires a field of the nodes to equal a value.
+type Condition struct {
+	Field []string
+	// Value is a string, int64, float64 or bool
+	Value interface{}
+}
+
+// OrderBy sorts nodes on a field.
+type OrderBy struct {
+	Field      []string
+	Descending bool
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokString
+	tokNumber
+	tokEquals
+	tokDot
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "end of query"
+	}
+	return strconv.Quote(t.text)
+}
+
+// lex splits the input into tokens, ending with a tokEOF token
+func lex(input string) ([]token, error) {
+	var tokens []token
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '=':
+			tokens = append(tokens, token{kind: tokEquals, text: "=", pos: i})
+			i++
+		case r == '.':
+			tokens = append(tokens, token{kind: tokDot, text: ".", pos: i})
+			i++
+		case r == '"':
+			start := i
+			i++
+			for i < len(runes) && runes[i] != '"' {
+				if runes[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("unterminated string at position %d", start)
+			}
+			i++
+			s, err := strconv.Unquote(string(runes[start:i]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid string at position %d: %w", start, err)
+			}
+			tokens = append(tokens, token{kind: tokString, text: s, pos: start})
+		case r == '-' || unicode.IsDigit(r):
+			start := i
+			i++
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokNumber, text: string(runes[start:i]), pos: start})
+		case r == '_' || unicode.IsLetter(r):
+			start := i
+			for i < len(runes) && (runes[i] == '_' || unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokIdent, text: string(runes[start:i]), pos: start})
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", r, i)
+		}
+	}
+	return append(tokens, token{kind: tokEOF, pos: len(runes)}), nil
+}
+
+// parser is a recursive descent parser over the tokens of a query
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Parse parses a GUAC Query Language query.
+func Parse(input string) (*Query, error) {
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	return p.parseQuery()
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) errorf(t token, format string, args ...interface{}) error {
+	return fmt.Errorf("%s at position %d", fmt.Sprintf(format, args...), t.pos)
+}
+
+// isKeyword reports whether the next token is the given keyword
+func (p *parser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == tokIdent && strings.EqualFold(t.text, keyword)
+}
+
+func (p *parser) expectKeyword(keyword string) error {
+	if !p.isKeyword(keyword) {
+		t := p.peek()
+		return p.errorf(t, "expected %s, found %s", keyword, t)
+	}
+	p.next()
+	return nil
+}
+
+// query := FIND ident [WHERE conditions] [ORDER BY orderBy] [LIMIT number] EOF
+func (p *parser) parseQuery() (*Query, error) {
+	if err := p.expectKeyword("FIND"); err != nil {
+		return nil, err
+	}
+	t := p.next()
+	if t.kind != tokIdent {
+		return nil, p.errorf(t, "expected node type, found %s", t)
+	}
+	q := &Query{Node: t.text}
+
+	if p.isKeyword("WHERE") {
+		p.next()
+		conditions, err := p.parseConditions()
+		if err != nil {
+			return nil, err
+		}
+		q.Where = conditions
+	}
+	if p.isKeyword("ORDER") {
+		p.next()
+		if err := p.expectKeyword("BY"); err != nil {
+			return nil, err
+		}
+		orderBy, err := p.parseOrderBy()
+		if err != nil {
+			return nil, err
+		}
+		q.OrderBy = orderBy
+	}
+	if p.isKeyword("LIMIT") {
+		p.next()
+		t := p.next()
+		limit, err := strconv.Atoi(t.text)
+		if t.kind != tokNumber || err != nil || limit <= 0 {
+			return nil, p.errorf(t, "expected positive integer limit, found %s", t)
+		}
+		q.Limit = limit
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, p.errorf(t, "unexpected %s", t)
+	}
+	return q, nil
+}
+
+// conditions := condition {AND condition}
+func (p *parser) parseConditions() ([]Condition, error) {
+	var conditions []Condition
+	for {
+		c, err := p.parseCondition()
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, *c)
+		if !p.isKeyword("AND") {
+			return conditions, nil
+		}
+		p.next()
+	}
+}
+
+// condition := field "=" value
+func (p *parser) parseCondition() (*Condition, error) {
+	field, err := p.parseField()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.next(); t.kind != tokEquals {
+		return nil, p.errorf(t, "expected =, found %s", t)
+	}
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	return &Condition{Field: field, Value: value}, nil
+}
+
+// orderBy := field [ASC | DESC]
+func (p *parser) parseOrderBy() (*OrderBy, error) {
+	field, err := p.parseField()
+	if err != nil {
+		return nil, err
+	}
+	orderBy := &OrderBy{Field: field}
+	if p.isKeyword("DESC") {
+		p.next()
+		orderBy.Descending = true
+	} else if p.isKeyword("ASC") {
+		p.next()
+	}
+	return orderBy, nil
+}
+
+// field := ident {"." ident}
+func (p *parser) parseField() ([]string, error) {
+	var field []string
+	for {
+		t := p.next()
+		if t.kind != tokIdent {
+			return nil, p.errorf(t, "expected field name, found %s", t)
+		}
+		field = append(field, t.text)
+		if p.peek().kind != tokDot {
+			return field, nil
+		}
+		p.next()
+	}
+}
+
+// value := string | number | true | false
+func (p *parser) parseValue() (interface{}, error) {
+	t := p.next()
+	switch t.kind {
+	case tokString:
+		return t.text, nil
+	case tokNumber:
+		if i, err := strconv.ParseInt(t.text, 10, 64); err == nil {
+			return i, nil
+		}
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, p.errorf(t, "invalid number %s", t)
+		}
+		return f, nil
+	case tokIdent:
+		if strings.EqualFold(t.text, "true") {
+			return true, nil
+		}
+		if strings.EqualFold(t.text, "false") {
+			return false, nil
+		}
+	}
+	return nil, p.errorf(t, "expected value, found %s", t)
+}
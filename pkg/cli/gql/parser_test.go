@@ -0,0 +1,127 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gql
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    *Query
+		wantErr bool
+	}{
+		{
+			name:  "find only",
+			input: "FIND Package",
+			want:  &Query{Node: "Package"},
+		},
+		{
+			name:  "where and",
+			input: `FIND CertifyVuln WHERE package.type = "pypi" AND metadata.scannerUri = "grype"`,
+			want: &Query{
+				Node: "CertifyVuln",
+				Where: []Condition{
+					{Field: []string{"package", "type"}, Value: "pypi"},
+					{Field: []string{"metadata", "scannerUri"}, Value: "grype"},
+				},
+			},
+		},
+		{
+			name:  "lowercase keywords and value types",
+			input: `find CertifyVuln where vulnerability.noVuln = false and package.name = "a \"b\"" and x = -1.5 and y = 3`,
+			want: &Query{
+				Node: "CertifyVuln",
+				Where: []Condition{
+					{Field: []string{"vulnerability", "noVuln"}, Value: false},
+					{Field: []string{"package", "name"}, Value: `a "b"`},
+					{Field: []string{"x"}, Value: -1.5},
+					{Field: []string{"y"}, Value: int64(3)},
+				},
+			},
+		},
+		{
+			name:  "order by and limit",
+			input: "FIND Source WHERE type = \"git\"\nORDER BY name DESC LIMIT 5",
+			want: &Query{
+				Node:    "Source",
+				Where:   []Condition{{Field: []string{"type"}, Value: "git"}},
+				OrderBy: &OrderBy{Field: []string{"name"}, Descending: true},
+				Limit:   5,
+			},
+		},
+		{
+			name:  "order by asc",
+			input: "FIND Package ORDER BY version ASC",
+			want:  &Query{Node: "Package", OrderBy: &OrderBy{Field: []string{"version"}}},
+		},
+		{
+			name:    "missing find",
+			input:   "Package",
+			wantErr: true,
+		},
+		{
+			name:    "missing node",
+			input:   "FIND",
+			wantErr: true,
+		},
+		{
+			name:    "missing value",
+			input:   "FIND Package WHERE name =",
+			wantErr: true,
+		},
+		{
+			name:    "unterminated string",
+			input:   `FIND Package WHERE name = "abc`,
+			wantErr: true,
+		},
+		{
+			name:    "zero limit",
+			input:   "FIND Package LIMIT 0",
+			wantErr: true,
+		},
+		{
+			name:    "limit before order",
+			input:   "FIND Package LIMIT 1 ORDER BY name",
+			wantErr: true,
+		},
+		{
+			name:    "order without by",
+			input:   "FIND Package ORDER name",
+			wantErr: true,
+		},
+		{
+			name:    "unexpected character",
+			input:   "FIND Package WHERE name != \"a\"",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/spf13/pflag"
 )
@@ -51,6 +52,19 @@ func init() {
 	set.String("gql-tls-key-file", "", "path to the TLS key in PEM format for graphql api server")
 	set.Bool("gql-debug", false, "debug flag which enables the graphQL playground")
 	set.Bool("gql-trace", false, "flag which enables tracing of graphQL requests and responses on the console")
+	set.Bool("gql-backend-trace", false, "flag which enables tracing of the calls to the backend, logging the span of each call with its duration on the console")
+	set.Int("gql-stale-after-days", 0, "number of days after which vulnerability certifications are reported as stale and periodically marked STALE (0 disables)")
+	set.Int("gql-apq-cache-size", 1000, "number of automatic persisted queries, sent by clients as a hash instead of the query text, to keep in memory (0 disables)")
+	set.Int("gql-hotcache-size", 0, "number of results of each of the packages, sources and vulnerabilities queries to cache in memory in front of the backend (0 disables)")
+	set.String("gql-hotcache-ttl", "5m", "how long results are kept in the hot cache, m, h, s, etc. (0 keeps them until evicted)")
+	set.Float64("gql-rate-limit-rps", 0, "maximum number of calls per second to the backend, calls over the limit wait for their turn (0 disables)")
+	set.Int("gql-rate-limit-burst", 100, "number of calls to the backend allowed at once above the rate limit")
+	set.String("gql-auth-jwks-url", "", "URL of the JWKS used to validate the Bearer JWT required on graphql api server requests (empty disables authentication)")
+	set.String("gql-auth-scope", "", "scope the Bearer JWT must grant when authentication is enabled (empty only requires a valid token)")
+	set.Int("gql-gzip-level", -1, "gzip compression level of the graphql api server responses, from -2 (huffman only) and -1 (default) to 9 (best compression) (0 disables)")
+	set.Int("gql-gzip-min-size", 1024, "size in bytes under which graphql api server responses are not compressed")
+	set.Int("gql-complexity-limit", 1000, "maximum complexity of the graphql operations, operations over it are rejected before being executed (0 disables)")
+	set.Int("gql-health-port", 0, "port serving /healthz, which checks the database connection of the graphql api server, for liveness and readiness probes (0 disables)")
 
 	set.String("neo4j-addr", "neo4j://localhost:7687", "address to neo4j db")
 	set.String("neo4j-user", "", "neo4j user credential to connect to graph db")
@@ -70,6 +84,7 @@ func init() {
 	set.String("db-driver", "postgres", "database driver to use, one of [postgres | sqlite3 | mysql] or anything supported by sql.DB")
 	set.Bool("db-debug", false, "enable debug logging for database queries")
 	set.Bool("db-migrate", true, "automatically run database migrations on start")
+	set.Bool("db-skip-existing-certify-vulns", false, "look up each certify vuln before ingesting it, avoiding a write when an ingestion is re-run")
 
 	set.String("arango-addr", "http://localhost:8529", "address to arango db")
 	set.String("arango-user", "", "arango user to connect to graph db")
@@ -108,7 +123,10 @@ func init() {
 	set.Bool("is-pkg-version-stop", false, "for query path are you inputting a packageVersion to stop the search at (if false then packageName)")
 
 	// Google Cloud platform flags
-	set.String("gcp-credentials-path", "", "Path to the Google Cloud service account credentials json file.\nAlternatively you can set GOOGLE_APPLICATION_CREDENTIALS=<path> in your environment.")
+	set.String("gcp-credentials-path", "", "Path to the Google Cloud service account credentials json file.\nWithout it, the application default credentials are used, e.g. GOOGLE_APPLICATION_CREDENTIALS=<path> or Workload Identity Federation.")
+	set.String("gcp-project-id", "", "Google Cloud project billed for the requests, required for requester pays buckets")
+	set.String("gcs-prefix", "", "object name prefix to restrict collection to in the GCS bucket")
+	set.String("gcs-poll-interval", "", "if set, list the GCS bucket on this interval (m, h, s, etc.) and collect the objects updated since the previous listing")
 
 	// S3 flags
 	set.String("s3-url", "", "url of the s3 endpoint")
@@ -118,6 +136,8 @@ func init() {
 	set.String("s3-mp-endpoint", "", "endpoint for the message provider")
 	set.String("s3-queues", "", "comma-separated list of queue/topic names")
 	set.String("s3-region", "us-east-1", "aws region")
+	set.String("s3-prefix", "", "key prefix to restrict collection to when polling by listing the bucket")
+	set.String("s3-poll-interval", "", "if polling, list the bucket on this interval (m, h, s, etc.) instead of listening to bucket notifications on queues")
 
 	// KeyValue Backend Store options.
 	set.String("kv-store", "memmap", "Which keyvalue store to use: memmap, redis, tikv.")
@@ -129,11 +149,28 @@ func init() {
 	set.String("github-sbom", "", "name of sbom file to look for in github release.")
 	set.String("github-workflow-file", "", "name of workflow file to look for in github workflow. \nThis will be the name of the actual file, not the workflow name (i.e. ci.yaml).")
 
+	// OCI collector options
+	set.StringSlice("referrer-types", nil, "artifact types of the OCI referrers to collect, e.g. application/spdx+json (default the SPDX, CycloneDX and in-toto types)")
+
 	// Files collector options
+	set.Bool("watch", false, "if polling, watch the directory for file changes instead of re-walking it on every interval")
+	set.Bool("compress", false, "gzip compress the collected files, to reduce the memory used by large documents waiting to be processed")
+	set.Int("file-concurrency", 1, "number of files read at once while walking the directory, to speed up collection from high latency storage such as NFS")
 	set.Bool("use-blob-url", false, "use blob URL for origin instead of source URL (useful if the blob store is persistent and we want to store the blob source location)")
 
 	set.String("header-file", "", "a text file containing HTTP headers to send to the GQL server, in RFC 822 format")
 
+	// sbom-list options
+	set.String("format", "json", "output format: json or csv")
+
+	// guacdiff options
+	set.StringSliceP("diff-node-types", "t", nil, "node types to compare: package, certifyVuln, hasSBOM, isDependency (default all)")
+
+	// guacwatch options
+	set.String("watch-webhook-url", "", "URL the new vulnerability certifications are posted to")
+	set.String("watch-webhook-secret", "", "shared secret used to sign the webhook requests with HMAC-SHA256")
+	set.Duration("watch-retry-delay", time.Second, "wait between attempts of a failed webhook request")
+
 	set.VisitAll(func(f *pflag.Flag) {
 		flagStore[f.Name] = f
 	})
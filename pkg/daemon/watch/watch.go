@@ -0,0 +1,217 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package watch implements a daemon that follows the CertifyVuln nodes added
+// to a GUAC server and reports each of them to a webhook.
+package watch
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/logging"
+	graphql "github.com/hasura/go-graphql-client"
+)
+
+const (
+	// SignatureHeader carries the hex encoded HMAC-SHA256 of the request body,
+	// prefixed with "sha256=", when a secret is configured.
+	SignatureHeader = "X-Guac-Signature-256"
+
+	// maxRetries is how many times a failed webhook POST is retried
+	maxRetries = 3
+
+	noVulnType = "novuln"
+)
+
+const certifyVulnAddedSubscription = `
+subscription WatchCertifyVulnAdded {
+  certifyVulnAdded {
+    id
+    package {
+      type
+      namespaces {
+        namespace
+        names {
+          name
+          versions {
+            purl
+          }
+        }
+      }
+    }
+    vulnerability {
+      type
+      vulnerabilityIDs {
+        vulnerabilityID
+      }
+    }
+  }
+}`
+
+// Config configures the watch daemon.
+type Config struct {
+	// GraphQLEndpoint is the address of the GUAC GraphQL server, the
+	// subscription is made over a websocket to the same address
+	GraphQLEndpoint string
+	// WebhookURL receives a POST for each new vulnerability certification
+	WebhookURL string
+	// WebhookSecret, if set, is used to sign the webhook requests
+	WebhookSecret string
+	// RetryDelay is the wait between attempts of a failed webhook POST
+	RetryDelay time.Duration
+	// HTTPClient is used for the webhook requests, defaults to
+	// http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// Event is the JSON payload posted to the webhook.
+type Event struct {
+	CertifyVulnID   string `json:"certifyVulnId"`
+	Purl            string `json:"purl"`
+	VulnerabilityID string `json:"vulnerabilityId"`
+}
+
+// Watch subscribes to the CertifyVuln nodes added to the GUAC server and posts
+// an Event to the webhook for each of them. Certifications that no
+// vulnerability was found are skipped. Watch runs until ctx is canceled or the
+// subscription fails.
+func Watch(ctx context.Context, cfg Config) error {
+	logger := logging.FromContext(ctx)
+	if cfg.GraphQLEndpoint == "" || cfg.WebhookURL == "" {
+		return errors.New("both the GraphQL endpoint and the webhook URL must be specified")
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+
+	client := graphql.NewSubscriptionClient(cfg.GraphQLEndpoint).
+		WithSyncMode(true).
+		WithLog(logger.Debug).
+		OnError(func(_ *graphql.SubscriptionClient, err error) error {
+			return err
+		})
+	_, err := client.SubscribeRaw(certifyVulnAddedSubscription, nil, func(message []byte, err error) error {
+		if err != nil {
+			logger.Errorf("certifyVulnAdded subscription returned an error: %v", err)
+			return nil
+		}
+		var data struct {
+			CertifyVulnAdded *model.CertifyVuln `json:"certifyVulnAdded"`
+		}
+		if err := json.Unmarshal(message, &data); err != nil {
+			logger.Errorf("unable to decode certifyVulnAdded event: %v", err)
+			return nil
+		}
+		event, ok := eventFromCertifyVuln(data.CertifyVulnAdded)
+		if !ok {
+			return nil
+		}
+		if err := Post(ctx, cfg, event); err != nil {
+			logger.Errorf("unable to deliver event for certifyVuln %s: %v", event.CertifyVulnID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to certifyVulnAdded: %w", err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = client.Close()
+	}()
+	if err := client.Run(); err != nil {
+		return fmt.Errorf("certifyVulnAdded subscription failed: %w", err)
+	}
+	return ctx.Err()
+}
+
+func eventFromCertifyVuln(cv *model.CertifyVuln) (Event, bool) {
+	if cv == nil || cv.Vulnerability == nil || cv.Vulnerability.Type == noVulnType || len(cv.Vulnerability.VulnerabilityIDs) == 0 {
+		return Event{}, false
+	}
+	event := Event{
+		CertifyVulnID:   cv.ID,
+		VulnerabilityID: cv.Vulnerability.VulnerabilityIDs[0].VulnerabilityID,
+	}
+	if p := cv.Package; p != nil && len(p.Namespaces) > 0 && len(p.Namespaces[0].Names) > 0 && len(p.Namespaces[0].Names[0].Versions) > 0 {
+		event.Purl = p.Namespaces[0].Names[0].Versions[0].Purl
+	}
+	return event, true
+}
+
+// Post sends event to the webhook, retrying up to 3 times if the request fails
+// or the webhook does not answer with a 2xx status.
+func Post(ctx context.Context, cfg Config, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("unable to encode event: %w", err)
+	}
+	client := cfg.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	for attempt := 0; ; attempt++ {
+		err = post(ctx, client, cfg.WebhookURL, cfg.WebhookSecret, body)
+		if err == nil || attempt == maxRetries {
+			return err
+		}
+		logging.FromContext(ctx).Warnf("webhook POST failed, retrying: %v", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(cfg.RetryDelay):
+		}
+	}
+}
+
+func post(ctx context.Context, client *http.Client, url, secret string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if secret != "" {
+		req.Header.Set(SignatureHeader, Sign(secret, body))
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned status %s", resp.Status)
+	}
+	return nil
+}
+
+// Sign returns the value of the SignatureHeader for body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,167 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+)
+
+func TestPost(t *testing.T) {
+	event := Event{CertifyVulnID: "1", Purl: "pkg:npm/app@1.0.0", VulnerabilityID: "cve-2023-44487"}
+	tests := []struct {
+		name         string
+		failures     int32
+		secret       string
+		wantErr      bool
+		wantRequests int32
+	}{{
+		name:         "delivered",
+		wantRequests: 1,
+	}, {
+		name:         "signed",
+		secret:       "s3cr3t",
+		wantRequests: 1,
+	}, {
+		name:         "retried",
+		failures:     3,
+		wantRequests: 4,
+	}, {
+		name:         "retries exhausted",
+		failures:     4,
+		wantErr:      true,
+		wantRequests: 4,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := requests.Add(1)
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("failed to read request body: %v", err)
+				}
+				if sig := r.Header.Get(SignatureHeader); tt.secret == "" && sig != "" {
+					t.Errorf("got signature %q without a secret", sig)
+				} else if tt.secret != "" && sig != Sign(tt.secret, body) {
+					t.Errorf("got signature %q, want %q", sig, Sign(tt.secret, body))
+				}
+				var got Event
+				if err := json.Unmarshal(body, &got); err != nil {
+					t.Errorf("failed to decode event: %v", err)
+				}
+				if diff := cmp.Diff(event, got); diff != "" {
+					t.Errorf("Unexpected event (-want +got):\n%s", diff)
+				}
+				if n <= tt.failures {
+					w.WriteHeader(http.StatusServiceUnavailable)
+				}
+			}))
+			defer srv.Close()
+
+			err := Post(context.Background(), Config{WebhookURL: srv.URL, WebhookSecret: tt.secret}, event)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Post() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := requests.Load(); got != tt.wantRequests {
+				t.Errorf("got %d requests, want %d", got, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestWatch(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	b, err := backends.Get("keyvalue", nil, nil)
+	if err != nil {
+		t.Fatalf("error creating keyvalue backend: %v", err)
+	}
+	gql := httptest.NewServer(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &resolvers.Resolver{Backend: b}})))
+	defer gql.Close()
+
+	events := make(chan Event, 10)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event Event
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			t.Errorf("failed to decode event: %v", err)
+		}
+		events <- event
+	}))
+	defer webhook.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- Watch(ctx, Config{GraphQLEndpoint: gql.URL, WebhookURL: webhook.URL})
+	}()
+
+	pkg := &model.PkgInputSpec{Type: "npm", Name: "app", Version: ptrfrom.String("1.0.0")}
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: pkg}); err != nil {
+		t.Fatalf("IngestPackage() error = %v", err)
+	}
+	for _, v := range []*model.VulnerabilityInputSpec{{Type: "cve", VulnerabilityID: "cve-2023-44487"}, {Type: "novuln"}} {
+		if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: v}); err != nil {
+			t.Fatalf("IngestVulnerability() error = %v", err)
+		}
+	}
+
+	// the subscription is not acknowledged, so keep adding certifications
+	// until the first one is delivered
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	var got Event
+	for i := 0; got == (Event{}); i++ {
+		select {
+		case got = <-events:
+		case err := <-done:
+			t.Fatalf("Watch() returned early: %v", err)
+		case <-ctx.Done():
+			t.Fatalf("no event received")
+		case <-ticker.C:
+			scan := model.ScanMetadataInput{TimeScanned: time.Unix(int64(i), 0).UTC()}
+			if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: pkg}, model.IDorVulnerabilityInput{VulnerabilityInput: &model.VulnerabilityInputSpec{Type: "novuln"}}, scan); err != nil {
+				t.Fatalf("IngestCertifyVuln() error = %v", err)
+			}
+			if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: pkg}, model.IDorVulnerabilityInput{VulnerabilityInput: &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2023-44487"}}, scan); err != nil {
+				t.Fatalf("IngestCertifyVuln() error = %v", err)
+			}
+		}
+	}
+	if got.Purl != "pkg:npm/app@1.0.0" || got.VulnerabilityID != "cve-2023-44487" || got.CertifyVulnID == "" {
+		t.Errorf("got event %+v", got)
+	}
+
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Watch() error = %v, want context.Canceled", err)
+	}
+}
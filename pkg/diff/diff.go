@@ -0,0 +1,236 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diff compares two snapshots of the GUAC graph and reports the nodes
+// that were added, removed or updated between them.
+//
+// Nodes are matched by their GUAC ID, so both snapshots must come from
+// backends that derive IDs from the node contents (such as ent), for example
+// two databases populated by different pipeline runs.
+package diff
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// NodeType is the type of the nodes compared by Diff.
+type NodeType string
+
+const (
+	NodeTypePackage      NodeType = "package"
+	NodeTypeCertifyVuln  NodeType = "certifyVuln"
+	NodeTypeHasSBOM      NodeType = "hasSBOM"
+	NodeTypeIsDependency NodeType = "isDependency"
+)
+
+// AllNodeTypes lists the node types Diff can compare.
+var AllNodeTypes = []NodeType{NodeTypePackage, NodeTypeCertifyVuln, NodeTypeHasSBOM, NodeTypeIsDependency}
+
+// Backend is the subset of the GUAC backend used to enumerate a snapshot. It is
+// implemented by backends.Backend and, for remote servers, by the client
+// returned from NewGraphQLBackend.
+type Backend interface {
+	Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error)
+	CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error)
+	HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error)
+	IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error)
+}
+
+// NodeFilter selects the node types to compare. An empty filter compares all
+// types.
+type NodeFilter struct {
+	Types []NodeType
+}
+
+func (f NodeFilter) types() []NodeType {
+	if len(f.Types) == 0 {
+		return AllNodeTypes
+	}
+	return f.Types
+}
+
+// Node is a node of a snapshot. Packages are reported one node per package
+// version, as a package trie holding only that version.
+type Node struct {
+	Type  NodeType   `json:"type"`
+	ID    string     `json:"id"`
+	Value model.Node `json:"value"`
+}
+
+// NodePair is a node present in both snapshots with different contents.
+type NodePair struct {
+	Before Node `json:"before"`
+	After  Node `json:"after"`
+}
+
+// DiffResult is the delta between two snapshots, sorted by type and ID.
+type DiffResult struct {
+	Added   []Node     `json:"added"`
+	Removed []Node     `json:"removed"`
+	Updated []NodePair `json:"updated"`
+}
+
+// Diff enumerates the nodes selected by filter in both snapshots and returns
+// the nodes only present in after (added), only present in before (removed)
+// and present in both with different contents (updated).
+func Diff(ctx context.Context, before, after Backend, filter NodeFilter) (*DiffResult, error) {
+	result := &DiffResult{Added: []Node{}, Removed: []Node{}, Updated: []NodePair{}}
+	for _, nodeType := range filter.types() {
+		beforeNodes, err := listNodes(ctx, before, nodeType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s nodes of the before snapshot: %w", nodeType, err)
+		}
+		afterNodes, err := listNodes(ctx, after, nodeType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s nodes of the after snapshot: %w", nodeType, err)
+		}
+		if err := compare(result, beforeNodes, afterNodes); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func compare(result *DiffResult, before, after map[string]Node) error {
+	for _, id := range sortedIDs(after) {
+		b, ok := before[id]
+		if !ok {
+			result.Added = append(result.Added, after[id])
+			continue
+		}
+		equal, err := sameContents(b, after[id])
+		if err != nil {
+			return err
+		}
+		if !equal {
+			result.Updated = append(result.Updated, NodePair{Before: b, After: after[id]})
+		}
+	}
+	for _, id := range sortedIDs(before) {
+		if _, ok := after[id]; !ok {
+			result.Removed = append(result.Removed, before[id])
+		}
+	}
+	return nil
+}
+
+func sameContents(a, b Node) (bool, error) {
+	aJSON, err := json.Marshal(a.Value)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal node %s: %w", a.ID, err)
+	}
+	bJSON, err := json.Marshal(b.Value)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal node %s: %w", b.ID, err)
+	}
+	return bytes.Equal(aJSON, bJSON), nil
+}
+
+func sortedIDs(nodes map[string]Node) []string {
+	ids := make([]string, 0, len(nodes))
+	for id := range nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// listNodes returns the nodes of nodeType in the snapshot, keyed by ID.
+func listNodes(ctx context.Context, b Backend, nodeType NodeType) (map[string]Node, error) {
+	nodes := map[string]Node{}
+	add := func(id string, value model.Node) {
+		nodes[id] = Node{Type: nodeType, ID: id, Value: value}
+	}
+	switch nodeType {
+	case NodeTypePackage:
+		pkgs, err := b.Packages(ctx, &model.PkgSpec{})
+		if err != nil {
+			return nil, err
+		}
+		for _, pkg := range pkgs {
+			for _, version := range packageVersions(pkg) {
+				add(version.Namespaces[0].Names[0].Versions[0].ID, version)
+			}
+		}
+	case NodeTypeCertifyVuln:
+		certs, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{})
+		if err != nil {
+			return nil, err
+		}
+		for _, cert := range certs {
+			add(cert.ID, cert)
+		}
+	case NodeTypeHasSBOM:
+		sboms, err := b.HasSBOM(ctx, &model.HasSBOMSpec{})
+		if err != nil {
+			return nil, err
+		}
+		for _, sbom := range sboms {
+			add(sbom.ID, sbom)
+		}
+	case NodeTypeIsDependency:
+		deps, err := b.IsDependency(ctx, &model.IsDependencySpec{})
+		if err != nil {
+			return nil, err
+		}
+		for _, dep := range deps {
+			add(dep.ID, dep)
+		}
+	default:
+		return nil, fmt.Errorf("unknown node type %q", nodeType)
+	}
+	return nodes, nil
+}
+
+// packageVersions splits a package trie into one trie per package version.
+func packageVersions(pkg *model.Package) []*model.Package {
+	var rv []*model.Package
+	for _, namespace := range pkg.Namespaces {
+		for _, name := range namespace.Names {
+			for _, version := range name.Versions {
+				rv = append(rv, &model.Package{
+					ID:   pkg.ID,
+					Type: pkg.Type,
+					Namespaces: []*model.PackageNamespace{{
+						ID:        namespace.ID,
+						Namespace: namespace.Namespace,
+						Names: []*model.PackageName{{
+							ID:       name.ID,
+							Name:     name.Name,
+							Versions: []*model.PackageVersion{version},
+						}},
+					}},
+				})
+			}
+		}
+	}
+	return rv
+}
+
+// ParseNodeType returns the NodeType named s.
+func ParseNodeType(s string) (NodeType, error) {
+	for _, t := range AllNodeTypes {
+		if string(t) == s {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("unknown node type %q, expected one of %v", s, AllNodeTypes)
+}
@@ -0,0 +1,167 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+type fakeBackend struct {
+	pkgs  []*model.Package
+	vulns []*model.CertifyVuln
+	sboms []*model.HasSbom
+	deps  []*model.IsDependency
+	err   error
+}
+
+func (f *fakeBackend) Packages(context.Context, *model.PkgSpec) ([]*model.Package, error) {
+	return f.pkgs, f.err
+}
+
+func (f *fakeBackend) CertifyVuln(context.Context, *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	return f.vulns, f.err
+}
+
+func (f *fakeBackend) HasSBOM(context.Context, *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	return f.sboms, f.err
+}
+
+func (f *fakeBackend) IsDependency(context.Context, *model.IsDependencySpec) ([]*model.IsDependency, error) {
+	return f.deps, f.err
+}
+
+// pypi returns the trie of the pypi package name with the given versions,
+// where each version has the ID "pv-<version>".
+func pypi(name string, versions ...string) *model.Package {
+	pkg := &model.Package{
+		ID:   "pt-pypi",
+		Type: "pypi",
+		Namespaces: []*model.PackageNamespace{{
+			ID: "pns-pypi",
+			Names: []*model.PackageName{{
+				ID:   "pn-" + name,
+				Name: name,
+			}},
+		}},
+	}
+	for _, v := range versions {
+		pkg.Namespaces[0].Names[0].Versions = append(pkg.Namespaces[0].Names[0].Versions,
+			&model.PackageVersion{ID: "pv-" + v, Version: v, Qualifiers: []*model.PackageQualifier{}})
+	}
+	return pkg
+}
+
+func certifyVuln(dbVersion string) *model.CertifyVuln {
+	return &model.CertifyVuln{
+		ID:            "cv-1",
+		Package:       pypi("django", "4.2"),
+		Vulnerability: &model.Vulnerability{ID: "v-1", Type: "osv"},
+		Metadata:      &model.ScanMetadata{DbVersion: dbVersion},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	sbom := &model.HasSbom{ID: "sbom-1", Subject: &model.Artifact{ID: "a-1", Algorithm: "sha256", Digest: "abc"}}
+	dep := &model.IsDependency{ID: "dep-1", Package: pypi("django", "4.2"), DependencyPackage: pypi("asgiref", "3.7")}
+	before := &fakeBackend{
+		pkgs:  []*model.Package{pypi("django", "4.1", "4.2")},
+		vulns: []*model.CertifyVuln{certifyVuln("1")},
+		sboms: []*model.HasSbom{sbom},
+	}
+	after := &fakeBackend{
+		pkgs:  []*model.Package{pypi("django", "4.2", "5.0")},
+		vulns: []*model.CertifyVuln{certifyVuln("2")},
+		deps:  []*model.IsDependency{dep},
+	}
+
+	tests := []struct {
+		name   string
+		before *fakeBackend
+		after  *fakeBackend
+		filter NodeFilter
+		want   *DiffResult
+	}{{
+		name:   "all types",
+		before: before,
+		after:  after,
+		want: &DiffResult{
+			Added: []Node{
+				{Type: NodeTypePackage, ID: "pv-5.0", Value: pypi("django", "5.0")},
+				{Type: NodeTypeIsDependency, ID: "dep-1", Value: dep},
+			},
+			Removed: []Node{
+				{Type: NodeTypePackage, ID: "pv-4.1", Value: pypi("django", "4.1")},
+				{Type: NodeTypeHasSBOM, ID: "sbom-1", Value: sbom},
+			},
+			Updated: []NodePair{{
+				Before: Node{Type: NodeTypeCertifyVuln, ID: "cv-1", Value: certifyVuln("1")},
+				After:  Node{Type: NodeTypeCertifyVuln, ID: "cv-1", Value: certifyVuln("2")},
+			}},
+		},
+	}, {
+		name:   "packages only",
+		before: before,
+		after:  after,
+		filter: NodeFilter{Types: []NodeType{NodeTypePackage}},
+		want: &DiffResult{
+			Added:   []Node{{Type: NodeTypePackage, ID: "pv-5.0", Value: pypi("django", "5.0")}},
+			Removed: []Node{{Type: NodeTypePackage, ID: "pv-4.1", Value: pypi("django", "4.1")}},
+			Updated: []NodePair{},
+		},
+	}, {
+		name:   "identical snapshots",
+		before: before,
+		after:  before,
+		want:   &DiffResult{Added: []Node{}, Removed: []Node{}, Updated: []NodePair{}},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Diff(context.Background(), tt.before, tt.after, tt.filter)
+			if err != nil {
+				t.Fatalf("Diff() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffErrors(t *testing.T) {
+	ctx := context.Background()
+	if _, err := Diff(ctx, &fakeBackend{}, &fakeBackend{}, NodeFilter{Types: []NodeType{"unknown"}}); err == nil {
+		t.Error("expected error for unknown node type")
+	}
+	if _, err := Diff(ctx, &fakeBackend{err: errors.New("boom")}, &fakeBackend{}, NodeFilter{}); err == nil {
+		t.Error("expected error when a snapshot cannot be listed")
+	}
+}
+
+func TestParseNodeType(t *testing.T) {
+	for _, nodeType := range AllNodeTypes {
+		if got, err := ParseNodeType(string(nodeType)); err != nil || got != nodeType {
+			t.Errorf("ParseNodeType(%q) = %q, %v", nodeType, got, err)
+		}
+	}
+	if _, err := ParseNodeType("artifact"); err == nil {
+		t.Error("expected error for unsupported node type")
+	}
+}
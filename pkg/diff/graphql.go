@@ -0,0 +1,253 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+const packageFragment = `
+fragment diffPackage on Package {
+  id
+  type
+  namespaces {
+    id
+    namespace
+    names {
+      id
+      name
+      versions {
+        id
+        purl
+        version
+        qualifiers {
+          key
+          value
+        }
+        subpath
+      }
+    }
+  }
+}`
+
+const packagesQuery = `
+query DiffPackages($spec: PkgSpec!) {
+  packages(pkgSpec: $spec) {
+    ...diffPackage
+  }
+}` + packageFragment
+
+const certifyVulnQuery = `
+query DiffCertifyVuln($spec: CertifyVulnSpec!) {
+  CertifyVuln(certifyVulnSpec: $spec) {
+    id
+    package {
+      ...diffPackage
+    }
+    vulnerability {
+      id
+      type
+      vulnerabilityIDs {
+        id
+        vulnerabilityID
+      }
+    }
+    metadata {
+      timeScanned
+      dbUri
+      dbVersion
+      scannerUri
+      scannerVersion
+      origin
+      collector
+      documentRef
+      cvssScore
+    }
+    remediationStatus
+  }
+}` + packageFragment
+
+const hasSBOMQuery = `
+query DiffHasSBOM($spec: HasSBOMSpec!) {
+  HasSBOM(hasSBOMSpec: $spec) {
+    id
+    subject {
+      __typename
+      ... on Package {
+        ...diffPackage
+      }
+      ... on Artifact {
+        id
+        algorithm
+        digest
+      }
+    }
+    uri
+    algorithm
+    digest
+    downloadLocation
+    knownSince
+    origin
+    collector
+    documentRef
+  }
+}` + packageFragment
+
+const isDependencyQuery = `
+query DiffIsDependency($spec: IsDependencySpec!) {
+  IsDependency(isDependencySpec: $spec) {
+    id
+    package {
+      ...diffPackage
+    }
+    dependencyPackage {
+      ...diffPackage
+    }
+    versionRange
+    dependencyType
+    justification
+    origin
+    collector
+    documentRef
+  }
+}` + packageFragment
+
+type graphQLBackend struct {
+	client graphql.Client
+}
+
+// NewGraphQLBackend returns a Backend that enumerates the snapshot served by a
+// GUAC GraphQL server. SBOMs are returned without their included software,
+// dependencies and occurrences.
+func NewGraphQLBackend(client graphql.Client) Backend {
+	return &graphQLBackend{client: client}
+}
+
+func (g *graphQLBackend) query(ctx context.Context, opName, query string, spec interface{}, data interface{}) error {
+	req := &graphql.Request{
+		OpName:    opName,
+		Query:     query,
+		Variables: map[string]interface{}{"spec": spec},
+	}
+	if err := g.client.MakeRequest(ctx, req, &graphql.Response{Data: data}); err != nil {
+		return fmt.Errorf("%s query failed: %w", opName, err)
+	}
+	return nil
+}
+
+func (g *graphQLBackend) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+	var data struct {
+		Packages []*model.Package `json:"packages"`
+	}
+	if err := g.query(ctx, "DiffPackages", packagesQuery, pkgSpec, &data); err != nil {
+		return nil, err
+	}
+	return data.Packages, nil
+}
+
+func (g *graphQLBackend) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	var data struct {
+		CertifyVuln []*model.CertifyVuln `json:"CertifyVuln"`
+	}
+	if err := g.query(ctx, "DiffCertifyVuln", certifyVulnQuery, certifyVulnSpec, &data); err != nil {
+		return nil, err
+	}
+	return data.CertifyVuln, nil
+}
+
+// hasSBOM mirrors model.HasSbom with the subject union left undecoded.
+type hasSBOM struct {
+	ID               string          `json:"id"`
+	Subject          json.RawMessage `json:"subject"`
+	URI              string          `json:"uri"`
+	Algorithm        string          `json:"algorithm"`
+	Digest           string          `json:"digest"`
+	DownloadLocation string          `json:"downloadLocation"`
+	KnownSince       time.Time       `json:"knownSince"`
+	Origin           string          `json:"origin"`
+	Collector        string          `json:"collector"`
+	DocumentRef      string          `json:"documentRef"`
+}
+
+func (g *graphQLBackend) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	var data struct {
+		HasSBOM []*hasSBOM `json:"HasSBOM"`
+	}
+	if err := g.query(ctx, "DiffHasSBOM", hasSBOMQuery, hasSBOMSpec, &data); err != nil {
+		return nil, err
+	}
+	rv := make([]*model.HasSbom, 0, len(data.HasSBOM))
+	for _, sbom := range data.HasSBOM {
+		subject, err := decodePackageOrArtifact(sbom.Subject)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode subject of HasSBOM %s: %w", sbom.ID, err)
+		}
+		rv = append(rv, &model.HasSbom{
+			ID:               sbom.ID,
+			Subject:          subject,
+			URI:              sbom.URI,
+			Algorithm:        sbom.Algorithm,
+			Digest:           sbom.Digest,
+			DownloadLocation: sbom.DownloadLocation,
+			KnownSince:       sbom.KnownSince,
+			Origin:           sbom.Origin,
+			Collector:        sbom.Collector,
+			DocumentRef:      sbom.DocumentRef,
+		})
+	}
+	return rv, nil
+}
+
+func decodePackageOrArtifact(raw json.RawMessage) (model.PackageOrArtifact, error) {
+	var typename struct {
+		Typename string `json:"__typename"`
+	}
+	if err := json.Unmarshal(raw, &typename); err != nil {
+		return nil, err
+	}
+	switch typename.Typename {
+	case "Package":
+		var pkg model.Package
+		if err := json.Unmarshal(raw, &pkg); err != nil {
+			return nil, err
+		}
+		return &pkg, nil
+	case "Artifact":
+		var art model.Artifact
+		if err := json.Unmarshal(raw, &art); err != nil {
+			return nil, err
+		}
+		return &art, nil
+	default:
+		return nil, fmt.Errorf("unexpected subject type %q", typename.Typename)
+	}
+}
+
+func (g *graphQLBackend) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
+	var data struct {
+		IsDependency []*model.IsDependency `json:"IsDependency"`
+	}
+	if err := g.query(ctx, "DiffIsDependency", isDependencyQuery, isDependencySpec, &data); err != nil {
+		return nil, err
+	}
+	return data.IsDependency, nil
+}
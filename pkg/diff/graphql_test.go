@@ -0,0 +1,112 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+)
+
+func TestGraphQLBackend(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	srv := httptest.NewServer(handler.NewDefaultServer(generated.NewExecutableSchema(
+		generated.Config{Resolvers: &resolvers.Resolver{Backend: b}})))
+	defer srv.Close()
+	g := NewGraphQLBackend(graphql.NewClient(srv.URL, srv.Client()))
+
+	django := pypi("django", "4.2")
+	django.Namespaces[0].Names[0].Versions[0].Qualifiers = []*model.PackageQualifier{{Key: "arch", Value: "x86"}}
+	cvss := 9.8
+	vuln := &model.CertifyVuln{
+		ID:      "cv-1",
+		Package: django,
+		Vulnerability: &model.Vulnerability{
+			ID:               "v-1",
+			Type:             "osv",
+			VulnerabilityIDs: []*model.VulnerabilityID{{ID: "vid-1", VulnerabilityID: "ghsa-1"}},
+		},
+		Metadata: &model.ScanMetadata{
+			TimeScanned: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			DbVersion:   "1",
+			CVSSScore:   &cvss,
+		},
+		RemediationStatus: model.RemediationStatusOpen,
+	}
+	sboms := []*model.HasSbom{{
+		ID:         "sbom-1",
+		Subject:    django,
+		URI:        "https://example.com/sbom.json",
+		KnownSince: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	}, {
+		ID:         "sbom-2",
+		Subject:    &model.Artifact{ID: "a-1", Algorithm: "sha256", Digest: "abc"},
+		KnownSince: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+	}}
+	dep := &model.IsDependency{
+		ID:                "dep-1",
+		Package:           django,
+		DependencyPackage: pypi("asgiref", "3.7"),
+		VersionRange:      ">=3.6",
+		DependencyType:    model.DependencyTypeDirect,
+	}
+
+	b.EXPECT().Packages(gomock.Any(), gomock.Any()).Return([]*model.Package{django}, nil)
+	b.EXPECT().CertifyVuln(gomock.Any(), gomock.Any()).Return([]*model.CertifyVuln{vuln}, nil)
+	b.EXPECT().HasSBOM(gomock.Any(), gomock.Any()).Return(sboms, nil)
+	b.EXPECT().IsDependency(gomock.Any(), gomock.Any()).Return([]*model.IsDependency{dep}, nil)
+
+	pkgs, err := g.Packages(ctx, &model.PkgSpec{})
+	if err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	if diff := cmp.Diff([]*model.Package{django}, pkgs); diff != "" {
+		t.Errorf("Unexpected packages. (-want +got):\n%s", diff)
+	}
+	vulns, err := g.CertifyVuln(ctx, &model.CertifyVulnSpec{})
+	if err != nil {
+		t.Fatalf("CertifyVuln() error = %v", err)
+	}
+	if diff := cmp.Diff([]*model.CertifyVuln{vuln}, vulns); diff != "" {
+		t.Errorf("Unexpected certifyVulns. (-want +got):\n%s", diff)
+	}
+	gotSBOMs, err := g.HasSBOM(ctx, &model.HasSBOMSpec{})
+	if err != nil {
+		t.Fatalf("HasSBOM() error = %v", err)
+	}
+	if diff := cmp.Diff(sboms, gotSBOMs); diff != "" {
+		t.Errorf("Unexpected SBOMs. (-want +got):\n%s", diff)
+	}
+	deps, err := g.IsDependency(ctx, &model.IsDependencySpec{})
+	if err != nil {
+		t.Fatalf("IsDependency() error = %v", err)
+	}
+	if diff := cmp.Diff([]*model.IsDependency{dep}, deps); diff != "" {
+		t.Errorf("Unexpected dependencies. (-want +got):\n%s", diff)
+	}
+}
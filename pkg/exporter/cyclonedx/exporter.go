@@ -0,0 +1,327 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cyclonedx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+)
+
+const guacTool = "guac"
+
+// exporter accumulates the CycloneDX elements found while walking the graph
+type exporter struct {
+	backend         backends.Backend
+	bom             *cdx.BOM
+	components      map[string]*cdx.Component
+	order           []string
+	dependsOn       map[string][]string
+	vulnerabilities map[string]*cdx.Vulnerability
+	vulnOrder       []string
+}
+
+// ExportCycloneDX walks the graph starting from the package version pkgID and returns a
+// CycloneDX 1.5 BOM describing it. The BOM holds the package as its metadata component, its
+// transitive dependencies as components along with the dependency graph, the vulnerabilities
+// certified against any of them and the source repositories they were built from as vcs
+// external references.
+func ExportCycloneDX(ctx context.Context, backend backends.Backend, pkgID string) (*cdx.BOM, error) {
+	roots, err := backend.Packages(ctx, &model.PkgSpec{ID: &pkgID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query package %s: %w", pkgID, err)
+	}
+	versions := helper.SplitPackageVersions(roots)
+	if len(versions) != 1 {
+		return nil, fmt.Errorf("package version %s not found", pkgID)
+	}
+	root := versions[0]
+
+	bom := cdx.NewBOM()
+	bom.SerialNumber = "urn:uuid:" + uuid.NewString()
+	e := &exporter{
+		backend:         backend,
+		bom:             bom,
+		components:      map[string]*cdx.Component{},
+		dependsOn:       map[string][]string{},
+		vulnerabilities: map[string]*cdx.Vulnerability{},
+	}
+
+	e.addComponent(root)
+	if err := e.addDependencies(ctx, root); err != nil {
+		return nil, err
+	}
+	for _, id := range e.order {
+		if err := e.addSources(ctx, id); err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(id, "name:") {
+			if err := e.addVulnerabilities(ctx, id); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	bom.Metadata = &cdx.Metadata{
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Tools: &cdx.ToolsChoice{
+			Components: &[]cdx.Component{{Type: cdx.ComponentTypeApplication, Name: guacTool}},
+		},
+		Component: e.components[packageID(root)],
+	}
+	var components []cdx.Component
+	var dependencies []cdx.Dependency
+	for _, id := range e.order {
+		c := e.components[id]
+		if id != packageID(root) {
+			components = append(components, *c)
+		}
+		dependency := cdx.Dependency{Ref: c.BOMRef}
+		if deps := e.dependsOn[id]; len(deps) > 0 {
+			dependency.Dependencies = &deps
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	if len(components) > 0 {
+		bom.Components = &components
+	}
+	bom.Dependencies = &dependencies
+	if len(e.vulnOrder) > 0 {
+		var vulnerabilities []cdx.Vulnerability
+		for _, id := range e.vulnOrder {
+			vulnerabilities = append(vulnerabilities, *e.vulnerabilities[id])
+		}
+		bom.Vulnerabilities = &vulnerabilities
+	}
+	return bom, nil
+}
+
+// addDependencies walks the IsDependency edges from the root breadth first. Dependencies on all
+// versions of a package are recorded but not walked further.
+func (e *exporter) addDependencies(ctx context.Context, root *model.Package) error {
+	visited := map[string]bool{}
+	queue := []*model.Package{root}
+	for len(queue) > 0 {
+		pkg := queue[0]
+		queue = queue[1:]
+		id := packageID(pkg)
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+
+		deps, err := e.backend.IsDependency(ctx, &model.IsDependencySpec{Package: &model.PkgSpec{ID: &id}})
+		if err != nil {
+			return fmt.Errorf("failed to query dependencies of package %s: %w", id, err)
+		}
+		for _, dep := range deps {
+			if dep.DependencyPackage == nil {
+				continue
+			}
+			depPkg := dependencyPackage(dep.DependencyPackage)
+			if depPkg == nil {
+				continue
+			}
+			e.addDependsOn(id, e.addComponent(depPkg))
+			if hasVersion(depPkg) {
+				queue = append(queue, depPkg)
+			}
+		}
+	}
+	return nil
+}
+
+// addSources adds the source repositories a package was built from as vcs external references
+func (e *exporter) addSources(ctx context.Context, id string) error {
+	spec := &model.PkgSpec{ID: ptr(strings.TrimPrefix(id, "name:"))}
+	hasSourceAts, err := e.backend.HasSourceAt(ctx, &model.HasSourceAtSpec{Package: spec})
+	if err != nil {
+		return fmt.Errorf("failed to query sources of package %s: %w", id, err)
+	}
+	c := e.components[id]
+	seen := map[string]bool{}
+	for _, hsa := range hasSourceAts {
+		if hsa.Source == nil {
+			continue
+		}
+		for _, src := range helper.SplitSourceNames([]*model.Source{hsa.Source}) {
+			url := sourceURL(src)
+			if seen[url] {
+				continue
+			}
+			seen[url] = true
+			if c.ExternalReferences == nil {
+				c.ExternalReferences = &[]cdx.ExternalReference{}
+			}
+			*c.ExternalReferences = append(*c.ExternalReferences, cdx.ExternalReference{
+				URL:     url,
+				Comment: hsa.Justification,
+				Type:    cdx.ERTypeVCS,
+			})
+		}
+	}
+	return nil
+}
+
+// addVulnerabilities records the vulnerabilities certified against a package version, merging
+// the affected components of findings for the same vulnerability
+func (e *exporter) addVulnerabilities(ctx context.Context, id string) error {
+	certifyVulns, err := e.backend.CertifyVuln(ctx, &model.CertifyVulnSpec{Package: &model.PkgSpec{ID: &id}})
+	if err != nil {
+		return fmt.Errorf("failed to query vulnerabilities of package %s: %w", id, err)
+	}
+	ref := e.components[id].BOMRef
+	for _, cv := range certifyVulns {
+		if cv.Vulnerability == nil || strings.EqualFold(cv.Vulnerability.Type, "novuln") {
+			continue
+		}
+		for _, vulnID := range cv.Vulnerability.VulnerabilityIDs {
+			vuln, ok := e.vulnerabilities[vulnID.VulnerabilityID]
+			if !ok {
+				vuln = &cdx.Vulnerability{
+					BOMRef:  "vuln:" + vulnID.VulnerabilityID,
+					ID:      vulnID.VulnerabilityID,
+					Source:  &cdx.Source{Name: cv.Vulnerability.Type},
+					Affects: &[]cdx.Affects{},
+				}
+				if cv.Metadata != nil {
+					vuln.Tools = &cdx.ToolsChoice{Components: &[]cdx.Component{{
+						Type:    cdx.ComponentTypeApplication,
+						Name:    cv.Metadata.ScannerURI,
+						Version: cv.Metadata.ScannerVersion,
+					}}}
+					vuln.Created = cv.Metadata.TimeScanned.UTC().Format(time.RFC3339)
+				}
+				e.vulnerabilities[vulnID.VulnerabilityID] = vuln
+				e.vulnOrder = append(e.vulnOrder, vulnID.VulnerabilityID)
+			}
+			if !affects(vuln, ref) {
+				*vuln.Affects = append(*vuln.Affects, cdx.Affects{Ref: ref})
+			}
+		}
+	}
+	return nil
+}
+
+// addComponent adds a package holding a single version or name to the BOM once and returns its ID
+func (e *exporter) addComponent(p *model.Package) string {
+	id := packageID(p)
+	if _, ok := e.components[id]; ok {
+		return id
+	}
+	name := p.Namespaces[0].Names[0]
+	purl := packagePurl(p)
+	c := &cdx.Component{
+		BOMRef:     purl,
+		Type:       cdx.ComponentTypeLibrary,
+		Group:      p.Namespaces[0].Namespace,
+		Name:       name.Name,
+		PackageURL: purl,
+	}
+	if hasVersion(p) {
+		c.Version = name.Versions[0].Version
+	}
+	e.components[id] = c
+	e.order = append(e.order, id)
+	return id
+}
+
+func (e *exporter) addDependsOn(id, depID string) {
+	ref := e.components[depID].BOMRef
+	for _, existing := range e.dependsOn[id] {
+		if existing == ref {
+			return
+		}
+	}
+	e.dependsOn[id] = append(e.dependsOn[id], ref)
+}
+
+func affects(vuln *cdx.Vulnerability, ref string) bool {
+	for _, a := range *vuln.Affects {
+		if a.Ref == ref {
+			return true
+		}
+	}
+	return false
+}
+
+// dependencyPackage returns the dependency of an IsDependency as a package holding a single
+// version, or a single name if the dependency is on all versions
+func dependencyPackage(p *model.Package) *model.Package {
+	if versions := helper.SplitPackageVersions([]*model.Package{p}); len(versions) > 0 {
+		return versions[0]
+	}
+	if len(p.Namespaces) == 0 || len(p.Namespaces[0].Names) == 0 {
+		return nil
+	}
+	return p
+}
+
+func hasVersion(p *model.Package) bool {
+	return len(p.Namespaces[0].Names[0].Versions) > 0
+}
+
+// packageID returns the ID of the package version, or of the package name prefixed with "name:"
+// so that it is never confused with a version
+func packageID(p *model.Package) string {
+	name := p.Namespaces[0].Names[0]
+	if len(name.Versions) > 0 {
+		return name.Versions[0].ID
+	}
+	return "name:" + name.ID
+}
+
+func packagePurl(p *model.Package) string {
+	name := p.Namespaces[0].Names[0]
+	if len(name.Versions) > 0 && name.Versions[0].Purl != "" {
+		return name.Versions[0].Purl
+	}
+	var version, subpath string
+	var qualifiers []string
+	if len(name.Versions) > 0 {
+		version = name.Versions[0].Version
+		subpath = name.Versions[0].Subpath
+		for _, q := range name.Versions[0].Qualifiers {
+			qualifiers = append(qualifiers, q.Key, q.Value)
+		}
+	}
+	return helpers.PkgToPurl(p.Type, p.Namespaces[0].Namespace, name.Name, version, subpath, qualifiers)
+}
+
+// sourceURL formats a source holding a single name as a VCS URL in the form accepted by
+// helpers.VcsToSrc, e.g. git+https://github.com/guacsec/guac@v0.1.0
+func sourceURL(s *model.Source) string {
+	name := s.Namespaces[0].Names[0]
+	url := fmt.Sprintf("%s+https://%s/%s", s.Type, s.Namespaces[0].Namespace, name.Name)
+	if name.Commit != nil && *name.Commit != "" {
+		url += "@" + *name.Commit
+	} else if name.Tag != nil && *name.Tag != "" {
+		url += "@" + *name.Tag
+	}
+	return url
+}
+
+func ptr[T any](s T) *T {
+	return &s
+}
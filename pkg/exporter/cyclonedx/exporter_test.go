@@ -0,0 +1,177 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cyclonedx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+var (
+	app = &model.PkgInputSpec{Type: "npm", Name: "app", Version: ptrfrom.String("1.0.0")}
+	lib = &model.PkgInputSpec{Type: "npm", Name: "lib", Version: ptrfrom.String("2.0.0")}
+	sub = &model.PkgInputSpec{Type: "npm", Namespace: ptrfrom.String("@scope"), Name: "sub", Version: ptrfrom.String("3.0.0")}
+	src = &model.SourceInputSpec{Type: "git", Namespace: "github.com/example", Name: "app", Tag: ptrfrom.String("v1.0.0")}
+)
+
+// schemas maps the IDs of the CycloneDX 1.5 JSON schema and the schemas it references to local copies
+var schemas = map[string]string{
+	"http://cyclonedx.org/schema/bom-1.5.schema.json":  "testdata/bom-1.5.schema.json",
+	"http://cyclonedx.org/schema/spdx.schema.json":     "testdata/spdx.schema.json",
+	"http://cyclonedx.org/schema/jsf-0.82.schema.json": "testdata/jsf-0.82.schema.json",
+}
+
+func validateBOM(t *testing.T, bom *cdx.BOM) {
+	t.Helper()
+	compiler := jsonschema.NewCompiler()
+	for url, path := range schemas {
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatalf("failed to open schema %s: %v", path, err)
+		}
+		defer f.Close()
+		if err := compiler.AddResource(url, f); err != nil {
+			t.Fatalf("failed to add schema %s: %v", path, err)
+		}
+	}
+	schema, err := compiler.Compile("http://cyclonedx.org/schema/bom-1.5.schema.json")
+	if err != nil {
+		t.Fatalf("failed to compile schema: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := cdx.NewBOMEncoder(&buf, cdx.BOMFileFormatJSON).Encode(bom); err != nil {
+		t.Fatalf("failed to encode BOM: %v", err)
+	}
+	var doc interface{}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("failed to decode BOM: %v", err)
+	}
+	if err := schema.Validate(doc); err != nil {
+		t.Errorf("BOM does not match the CycloneDX 1.5 schema: %v", err)
+	}
+}
+
+func TestExportCycloneDX(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", nil, nil)
+	if err != nil {
+		t.Fatalf("error creating keyvalue backend: %v", err)
+	}
+
+	var appID string
+	for _, p := range []*model.PkgInputSpec{app, lib, sub} {
+		ids, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p})
+		if err != nil {
+			t.Fatalf("IngestPackage() error = %v", err)
+		}
+		if p == app {
+			appID = ids.PackageVersionID
+		}
+	}
+	dependency := model.IsDependencyInputSpec{DependencyType: model.DependencyTypeDirect, Justification: "test"}
+	match := model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	if _, err := b.IngestDependency(ctx, model.IDorPkgInput{PackageInput: app}, model.IDorPkgInput{PackageInput: lib}, match, dependency); err != nil {
+		t.Fatalf("IngestDependency() error = %v", err)
+	}
+	if _, err := b.IngestDependency(ctx, model.IDorPkgInput{PackageInput: lib}, model.IDorPkgInput{PackageInput: sub}, match, dependency); err != nil {
+		t.Fatalf("IngestDependency() error = %v", err)
+	}
+
+	if _, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: src}); err != nil {
+		t.Fatalf("IngestSource() error = %v", err)
+	}
+	if _, err := b.IngestHasSourceAt(ctx, model.IDorPkgInput{PackageInput: app}, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+		model.IDorSourceInput{SourceInput: src}, model.HasSourceAtInputSpec{KnownSince: time.Unix(1e9, 0), Justification: "built from"}); err != nil {
+		t.Fatalf("IngestHasSourceAt() error = %v", err)
+	}
+
+	vuln := &model.VulnerabilityInputSpec{Type: "ghsa", VulnerabilityID: "ghsa-h45f-rjvw-2rv2"}
+	if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: vuln}); err != nil {
+		t.Fatalf("IngestVulnerability() error = %v", err)
+	}
+	for _, p := range []*model.PkgInputSpec{lib, sub} {
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: p}, model.IDorVulnerabilityInput{VulnerabilityInput: vuln},
+			model.ScanMetadataInput{ScannerURI: "osv.dev", ScannerVersion: "0.0.14", TimeScanned: time.Unix(1e9, 0)}); err != nil {
+			t.Fatalf("IngestCertifyVuln() error = %v", err)
+		}
+	}
+
+	bom, err := ExportCycloneDX(ctx, b, appID)
+	if err != nil {
+		t.Fatalf("ExportCycloneDX() error = %v", err)
+	}
+	validateBOM(t, bom)
+
+	if bom.SpecVersion != cdx.SpecVersion1_5 {
+		t.Errorf("unexpected spec version %v", bom.SpecVersion)
+	}
+	root := bom.Metadata.Component
+	if root == nil || root.PackageURL != "pkg:npm/app@1.0.0" {
+		t.Fatalf("unexpected metadata component %+v", root)
+	}
+	if diff := cmp.Diff(&[]cdx.ExternalReference{{
+		URL:     "git+https://github.com/example/app@v1.0.0",
+		Comment: "built from",
+		Type:    cdx.ERTypeVCS,
+	}}, root.ExternalReferences); diff != "" {
+		t.Errorf("Unexpected external references (-want +got):\n%s", diff)
+	}
+
+	var purls []string
+	for _, c := range *bom.Components {
+		purls = append(purls, c.PackageURL)
+	}
+	if diff := cmp.Diff([]string{"pkg:npm/lib@2.0.0", "pkg:npm/%40scope/sub@3.0.0"}, purls); diff != "" {
+		t.Errorf("Unexpected components (-want +got):\n%s", diff)
+	}
+
+	wantDependencies := &[]cdx.Dependency{
+		{Ref: "pkg:npm/app@1.0.0", Dependencies: &[]string{"pkg:npm/lib@2.0.0"}},
+		{Ref: "pkg:npm/lib@2.0.0", Dependencies: &[]string{"pkg:npm/%40scope/sub@3.0.0"}},
+		{Ref: "pkg:npm/%40scope/sub@3.0.0"},
+	}
+	if diff := cmp.Diff(wantDependencies, bom.Dependencies); diff != "" {
+		t.Errorf("Unexpected dependencies (-want +got):\n%s", diff)
+	}
+
+	if bom.Vulnerabilities == nil || len(*bom.Vulnerabilities) != 1 {
+		t.Fatalf("expected a single vulnerability, got %+v", bom.Vulnerabilities)
+	}
+	v := (*bom.Vulnerabilities)[0]
+	if v.ID != "ghsa-h45f-rjvw-2rv2" {
+		t.Errorf("unexpected vulnerability ID %q", v.ID)
+	}
+	if diff := cmp.Diff(&[]cdx.Affects{{Ref: "pkg:npm/lib@2.0.0"}, {Ref: "pkg:npm/%40scope/sub@3.0.0"}}, v.Affects); diff != "" {
+		t.Errorf("Unexpected affected components (-want +got):\n%s", diff)
+	}
+
+	if _, err := ExportCycloneDX(ctx, b, "999999"); err == nil {
+		t.Errorf("expected error exporting an unknown package")
+	}
+}
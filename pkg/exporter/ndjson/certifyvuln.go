@@ -0,0 +1,205 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ndjson streams GUAC nodes as newline-delimited JSON, one node per
+// line, so that clients can export result sets too large to buffer.
+package ndjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// CertifyVulnExportPath is the path the CertifyVuln export is served on.
+const CertifyVulnExportPath = "/api/v1/certifyVuln/export"
+
+// ContentType is the media type of the exported streams.
+const ContentType = "application/x-ndjson"
+
+// batchSize is the number of nodes fetched from the backend per page. The
+// response is flushed after each page.
+const batchSize = 100
+
+// NewCertifyVulnHandler returns a handler for GET requests that writes every
+// CertifyVuln matching the filter given in the query parameters as one JSON
+// object per line. The parameters are named after the CertifyVulnSpec fields,
+// with the fields of the nested package and vulnerability specs prefixed by
+// "package." and "vulnerability.", e.g.
+// `?vulnerability.vulnerabilityID=cve-2021-44228&minCVSS=7`. Times are in RFC
+// 3339 format.
+//
+// The nodes are read from the backend in pages of 100 with cursor pagination,
+// so only one page is held in memory. If reading a page fails after the stream
+// has started, the response is cut short as the status can not be changed.
+func NewCertifyVulnHandler(backend backends.Backend) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		filter, err := certifyVulnSpecFromQuery(r.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		ctx := r.Context()
+		logger := logging.FromContext(ctx)
+		flusher, _ := w.(http.Flusher)
+		enc := json.NewEncoder(w)
+		first := batchSize
+		var after *string
+		started := false
+		for {
+			conn, err := backend.CertifyVulnList(ctx, *filter, &model.PaginationSpec{First: &first, After: after})
+			if err != nil {
+				if !started {
+					http.Error(w, fmt.Sprintf("failed to query vulnerability certifications: %v", err), http.StatusInternalServerError)
+				} else {
+					logger.Errorf("CertifyVuln export aborted: %v", err)
+				}
+				return
+			}
+			if !started {
+				w.Header().Set("Content-Type", ContentType)
+				w.WriteHeader(http.StatusOK)
+				started = true
+			}
+			if conn == nil {
+				return
+			}
+			for _, edge := range conn.Edges {
+				if err := enc.Encode(edge.Node); err != nil {
+					// the client has gone away
+					return
+				}
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+			if conn.PageInfo == nil || !conn.PageInfo.HasNextPage || conn.PageInfo.EndCursor == nil {
+				return
+			}
+			after = conn.PageInfo.EndCursor
+		}
+	})
+}
+
+// certifyVulnSpecFromQuery builds the CertifyVulnSpec given by the query
+// parameters, failing on unknown parameters so that a typo does not silently
+// export everything.
+func certifyVulnSpecFromQuery(query url.Values) (*model.CertifyVulnSpec, error) {
+	spec := &model.CertifyVulnSpec{}
+	pkg := func() *model.PkgSpec {
+		if spec.Package == nil {
+			spec.Package = &model.PkgSpec{}
+		}
+		return spec.Package
+	}
+	vuln := func() *model.VulnerabilitySpec {
+		if spec.Vulnerability == nil {
+			spec.Vulnerability = &model.VulnerabilitySpec{}
+		}
+		return spec.Vulnerability
+	}
+	for key, values := range query {
+		if len(values) != 1 {
+			return nil, fmt.Errorf("query parameter %q must be given once", key)
+		}
+		value := values[0]
+		var err error
+		switch key {
+		case "id":
+			spec.ID = &value
+		case "timeScanned":
+			spec.TimeScanned, err = parseTime(key, value)
+		case "timeScannedAfter":
+			spec.TimeScannedAfter, err = parseTime(key, value)
+		case "timeScannedBefore":
+			spec.TimeScannedBefore, err = parseTime(key, value)
+		case "dbUri":
+			spec.DbURI = &value
+		case "dbVersion":
+			spec.DbVersion = &value
+		case "scannerUri":
+			spec.ScannerURI = &value
+		case "scannerVersion":
+			spec.ScannerVersion = &value
+		case "origin":
+			spec.Origin = &value
+		case "collector":
+			spec.Collector = &value
+		case "documentRef":
+			spec.DocumentRef = &value
+		case "minCVSS":
+			spec.MinCVSS, err = parseFloat(key, value)
+		case "maxCVSS":
+			spec.MaxCVSS, err = parseFloat(key, value)
+		case "package.id":
+			pkg().ID = &value
+		case "package.type":
+			pkg().Type = &value
+		case "package.namespace":
+			pkg().Namespace = &value
+		case "package.name":
+			pkg().Name = &value
+		case "package.version":
+			pkg().Version = &value
+		case "package.subpath":
+			pkg().Subpath = &value
+		case "vulnerability.id":
+			vuln().ID = &value
+		case "vulnerability.type":
+			vuln().Type = &value
+		case "vulnerability.vulnerabilityID":
+			vuln().VulnerabilityID = &value
+		case "vulnerability.noVuln":
+			var noVuln bool
+			noVuln, err = strconv.ParseBool(value)
+			vuln().NoVuln = &noVuln
+		default:
+			return nil, fmt.Errorf("unknown query parameter %q", key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return spec, nil
+}
+
+func parseTime(key, value string) (*time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("query parameter %q must be an RFC 3339 time: %w", key, err)
+	}
+	return &t, nil
+}
+
+func parseFloat(key, value string) (*float64, error) {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, fmt.Errorf("query parameter %q must be a number: %w", key, err)
+	}
+	return &f, nil
+}
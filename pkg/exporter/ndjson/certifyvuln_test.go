@@ -0,0 +1,173 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ndjson
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// flushRecorder counts the flushes of the response
+type flushRecorder struct {
+	*httptest.ResponseRecorder
+	flushes int
+}
+
+func (f *flushRecorder) Flush() {
+	f.flushes++
+	f.ResponseRecorder.Flush()
+}
+
+func ingestCertifyVulns(ctx context.Context, t *testing.T, b backends.Backend, n int) {
+	t.Helper()
+	pkg := model.IDorPkgInput{PackageInput: &model.PkgInputSpec{Type: "npm", Name: "app", Version: ptrfrom.String("1.0.0")}}
+	if _, err := b.IngestPackage(ctx, pkg); err != nil {
+		t.Fatalf("failed to ingest package: %v", err)
+	}
+	for i := 0; i < n; i++ {
+		vuln := model.IDorVulnerabilityInput{VulnerabilityInput: &model.VulnerabilityInputSpec{
+			Type:            "cve",
+			VulnerabilityID: fmt.Sprintf("cve-2024-%04d", i),
+		}}
+		if _, err := b.IngestVulnerability(ctx, vuln); err != nil {
+			t.Fatalf("failed to ingest vulnerability: %v", err)
+		}
+		scanner := "osv"
+		if i%2 == 1 {
+			scanner = "grype"
+		}
+		if _, err := b.IngestCertifyVuln(ctx, pkg, vuln, model.ScanMetadataInput{
+			TimeScanned: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			ScannerURI:  scanner,
+		}); err != nil {
+			t.Fatalf("failed to ingest CertifyVuln: %v", err)
+		}
+	}
+}
+
+func TestCertifyVulnHandler(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", ctx, nil)
+	if err != nil {
+		t.Fatalf("failed to get backend: %v", err)
+	}
+	ingestCertifyVulns(ctx, t, b, 250)
+	h := NewCertifyVulnHandler(b)
+
+	tests := []struct {
+		name        string
+		method      string
+		query       string
+		wantStatus  int
+		wantLines   int
+		wantFlushes int
+		wantScanner string
+	}{{
+		name:        "all",
+		wantStatus:  http.StatusOK,
+		wantLines:   250,
+		wantFlushes: 3,
+	}, {
+		name:        "by scanner",
+		query:       "scannerUri=grype",
+		wantStatus:  http.StatusOK,
+		wantLines:   125,
+		wantFlushes: 2,
+		wantScanner: "grype",
+	}, {
+		name:        "by vulnerability",
+		query:       "vulnerability.vulnerabilityID=cve-2024-0042&package.name=app",
+		wantStatus:  http.StatusOK,
+		wantLines:   1,
+		wantFlushes: 1,
+	}, {
+		name:        "no match",
+		query:       "package.name=other",
+		wantStatus:  http.StatusOK,
+		wantFlushes: 1,
+	}, {
+		name:       "unknown parameter",
+		query:      "scanner=grype",
+		wantStatus: http.StatusBadRequest,
+	}, {
+		name:       "invalid time",
+		query:      "timeScannedAfter=yesterday",
+		wantStatus: http.StatusBadRequest,
+	}, {
+		name:       "invalid number",
+		query:      "minCVSS=high",
+		wantStatus: http.StatusBadRequest,
+	}, {
+		name:       "post",
+		method:     http.MethodPost,
+		wantStatus: http.StatusMethodNotAllowed,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			req := httptest.NewRequest(method, CertifyVulnExportPath+"?"+tt.query, nil)
+			w := &flushRecorder{ResponseRecorder: httptest.NewRecorder()}
+			h.ServeHTTP(w, req)
+			if w.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if got := w.Header().Get("Content-Type"); got != ContentType {
+				t.Errorf("got content type %q, want %q", got, ContentType)
+			}
+			if w.flushes != tt.wantFlushes {
+				t.Errorf("got %d flushes, want %d", w.flushes, tt.wantFlushes)
+			}
+			seen := map[string]bool{}
+			scanner := bufio.NewScanner(w.Body)
+			for scanner.Scan() {
+				var cv model.CertifyVuln
+				if err := json.Unmarshal(scanner.Bytes(), &cv); err != nil {
+					t.Fatalf("line %q is not a CertifyVuln: %v", scanner.Text(), err)
+				}
+				if seen[cv.ID] {
+					t.Errorf("CertifyVuln %s exported twice", cv.ID)
+				}
+				seen[cv.ID] = true
+				if tt.wantScanner != "" {
+					if diff := cmp.Diff(tt.wantScanner, cv.Metadata.ScannerURI); diff != "" {
+						t.Errorf("unexpected scanner (-want +got):\n%s", diff)
+					}
+				}
+			}
+			if len(seen) != tt.wantLines {
+				t.Errorf("got %d CertifyVulns, want %d", len(seen), tt.wantLines)
+			}
+		})
+	}
+}
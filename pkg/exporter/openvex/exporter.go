@@ -0,0 +1,197 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openvex
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/openvex/go-vex/pkg/vex"
+)
+
+const (
+	guacAuthor = "GUAC"
+	guacTool   = "https://guac.sh"
+	noVulnType = "novuln"
+)
+
+var (
+	vexStatusMap = map[model.VexStatus]vex.Status{
+		model.VexStatusNotAffected:        vex.StatusNotAffected,
+		model.VexStatusAffected:           vex.StatusAffected,
+		model.VexStatusFixed:              vex.StatusFixed,
+		model.VexStatusUnderInvestigation: vex.StatusUnderInvestigation,
+	}
+
+	justificationsMap = map[model.VexJustification]vex.Justification{
+		model.VexJustificationComponentNotPresent:                         vex.ComponentNotPresent,
+		model.VexJustificationVulnerableCodeNotPresent:                    vex.VulnerableCodeNotPresent,
+		model.VexJustificationVulnerableCodeNotInExecutePath:              vex.VulnerableCodeNotInExecutePath,
+		model.VexJustificationVulnerableCodeCannotBeControlledByAdversary: vex.VulnerableCodeCannotBeControlledByAdversary,
+		model.VexJustificationInlineMitigationsAlreadyExist:               vex.InlineMitigationsAlreadyExist,
+	}
+)
+
+// ExportOpenVEX returns an OpenVEX document with a statement for each
+// vulnerability of each CertifyVuln matching filter, whose product is the purl
+// of the package. The status of a statement is the one of the latest
+// CertifyVEXStatement about the same package and vulnerability. Scanner
+// findings that were not triaged with a VEX statement are under investigation.
+// Certifications that no vulnerability was found are skipped.
+func ExportOpenVEX(ctx context.Context, backend backends.Backend, filter model.CertifyVulnSpec) ([]byte, error) {
+	certs, err := backend.CertifyVuln(ctx, &filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vulnerability certifications: %w", err)
+	}
+
+	doc := vex.New()
+	doc.Author = guacAuthor
+	doc.Tooling = guacTool
+	for _, cert := range certs {
+		if cert.Vulnerability == nil || cert.Vulnerability.Type == noVulnType || cert.Package == nil {
+			continue
+		}
+		for _, pkg := range helper.SplitPackageVersions([]*model.Package{cert.Package}) {
+			purl := packagePurl(pkg)
+			for _, vulnID := range cert.Vulnerability.VulnerabilityIDs {
+				stmt, err := statement(ctx, backend, cert, pkg, vulnID)
+				if err != nil {
+					return nil, err
+				}
+				stmt.Products = []vex.Product{{
+					Component: vex.Component{
+						ID:          purl,
+						Identifiers: map[vex.IdentifierType]string{vex.PURL: purl},
+					},
+				}}
+				if err := stmt.Validate(); err != nil {
+					return nil, fmt.Errorf("invalid OpenVEX statement for %s on %s: %w", vulnID.VulnerabilityID, purl, err)
+				}
+				doc.Statements = append(doc.Statements, stmt)
+			}
+		}
+	}
+	sort.SliceStable(doc.Statements, func(i, j int) bool {
+		a, b := doc.Statements[i], doc.Statements[j]
+		if a.Vulnerability.Name != b.Vulnerability.Name {
+			return a.Vulnerability.Name < b.Vulnerability.Name
+		}
+		return a.Products[0].ID < b.Products[0].ID
+	})
+	if _, err := doc.GenerateCanonicalID(); err != nil {
+		return nil, fmt.Errorf("failed to generate OpenVEX document ID: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := doc.ToJSON(&buf); err != nil {
+		return nil, fmt.Errorf("failed to write OpenVEX document: %w", err)
+	}
+	if _, err := vex.Parse(buf.Bytes()); err != nil {
+		return nil, fmt.Errorf("failed to parse exported OpenVEX document: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// statement returns the statement, without products, of the latest VEX
+// statement about the package version and vulnerability, or an under
+// investigation statement naming the scanner when there is none
+func statement(ctx context.Context, backend backends.Backend, cert *model.CertifyVuln, pkg *model.Package, vulnID *model.VulnerabilityID) (vex.Statement, error) {
+	stmt := vex.Statement{
+		Vulnerability: vex.Vulnerability{Name: vex.VulnerabilityID(vulnID.VulnerabilityID)},
+	}
+	if cert.Metadata != nil {
+		stmt.Timestamp = timePtr(cert.Metadata.TimeScanned)
+	}
+
+	pkgVersionID := pkg.Namespaces[0].Names[0].Versions[0].ID
+	statements, err := backend.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{
+		Subject:       &model.PackageOrArtifactSpec{Package: &model.PkgSpec{ID: &pkgVersionID}},
+		Vulnerability: &model.VulnerabilitySpec{ID: &vulnID.ID},
+	})
+	if err != nil {
+		return vex.Statement{}, fmt.Errorf("failed to query VEX statements for %s: %w", vulnID.VulnerabilityID, err)
+	}
+	var latest *model.CertifyVEXStatement
+	for _, s := range statements {
+		if latest == nil || s.KnownSince.After(latest.KnownSince) {
+			latest = s
+		}
+	}
+	if latest == nil {
+		stmt.Status = vex.StatusUnderInvestigation
+		stmt.StatusNotes = fmt.Sprintf("reported by %s", scannerText(cert.Metadata))
+		return stmt, nil
+	}
+
+	status, ok := vexStatusMap[latest.Status]
+	if !ok {
+		return vex.Statement{}, fmt.Errorf("unknown VEX status %q for %s", latest.Status, vulnID.VulnerabilityID)
+	}
+	stmt.Status = status
+	stmt.StatusNotes = latest.StatusNotes
+	stmt.Timestamp = timePtr(latest.KnownSince)
+	switch status {
+	case vex.StatusNotAffected:
+		stmt.Justification = justificationsMap[latest.VexJustification]
+		stmt.ImpactStatement = latest.Statement
+	case vex.StatusAffected:
+		// OpenVEX requires an action statement for affected products
+		stmt.ActionStatement = latest.Statement
+		if stmt.ActionStatement == "" {
+			stmt.ActionStatement = "No action statement was provided"
+		}
+	}
+	return stmt, nil
+}
+
+// scannerText returns the scanner URI and version of the scan
+func scannerText(metadata *model.ScanMetadata) string {
+	if metadata == nil {
+		return "unknown scanner"
+	}
+	return fmt.Sprintf("%s %s", metadata.ScannerURI, metadata.ScannerVersion)
+}
+
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func packagePurl(p *model.Package) string {
+	name := p.Namespaces[0].Names[0]
+	if len(name.Versions) > 0 && name.Versions[0].Purl != "" {
+		return name.Versions[0].Purl
+	}
+	var version, subpath string
+	var qualifiers []string
+	if len(name.Versions) > 0 {
+		version = name.Versions[0].Version
+		subpath = name.Versions[0].Subpath
+		for _, q := range name.Versions[0].Qualifiers {
+			qualifiers = append(qualifiers, q.Key, q.Value)
+		}
+	}
+	return helpers.PkgToPurl(p.Type, p.Namespaces[0].Namespace, name.Name, version, subpath, qualifiers)
+}
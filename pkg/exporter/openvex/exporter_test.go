@@ -0,0 +1,135 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openvex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/openvex/go-vex/pkg/vex"
+)
+
+var (
+	app = &model.PkgInputSpec{Type: "npm", Name: "app", Version: ptrfrom.String("1.0.0")}
+	lib = &model.PkgInputSpec{Type: "npm", Namespace: ptrfrom.String("@scope"), Name: "lib", Version: ptrfrom.String("2.0.0")}
+
+	cve    = &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2023-44487"}
+	ghsa   = &model.VulnerabilityInputSpec{Type: "ghsa", VulnerabilityID: "ghsa-h45f-rjvw-2rv2"}
+	noVuln = &model.VulnerabilityInputSpec{Type: "novuln", VulnerabilityID: ""}
+)
+
+func TestExportOpenVEX(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", nil, nil)
+	if err != nil {
+		t.Fatalf("error creating keyvalue backend: %v", err)
+	}
+	for _, p := range []*model.PkgInputSpec{app, lib} {
+		if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p}); err != nil {
+			t.Fatalf("IngestPackage() error = %v", err)
+		}
+	}
+	for _, v := range []*model.VulnerabilityInputSpec{cve, ghsa, noVuln} {
+		if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: v}); err != nil {
+			t.Fatalf("IngestVulnerability() error = %v", err)
+		}
+	}
+	scanned := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	scan := model.ScanMetadataInput{ScannerURI: "osv.dev", ScannerVersion: "0.0.14", TimeScanned: scanned}
+	for _, c := range []struct {
+		pkg  *model.PkgInputSpec
+		vuln *model.VulnerabilityInputSpec
+	}{
+		{app, cve},
+		{lib, cve},
+		{lib, ghsa},
+		{app, noVuln},
+	} {
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: c.pkg}, model.IDorVulnerabilityInput{VulnerabilityInput: c.vuln}, scan); err != nil {
+			t.Fatalf("IngestCertifyVuln() error = %v", err)
+		}
+	}
+	triaged := scanned.Add(24 * time.Hour)
+	for _, v := range []struct {
+		pkg  *model.PkgInputSpec
+		vuln *model.VulnerabilityInputSpec
+		spec model.VexStatementInputSpec
+	}{
+		{app, cve, model.VexStatementInputSpec{Status: model.VexStatusAffected, VexJustification: model.VexJustificationNotProvided, Statement: "upgrade to 1.0.1", KnownSince: triaged}},
+		{lib, ghsa, model.VexStatementInputSpec{Status: model.VexStatusUnderInvestigation, VexJustification: model.VexJustificationNotProvided, KnownSince: scanned}},
+		// the latest statement wins
+		{lib, ghsa, model.VexStatementInputSpec{Status: model.VexStatusNotAffected, VexJustification: model.VexJustificationVulnerableCodeNotInExecutePath, Statement: "lib does not use http2", KnownSince: triaged}},
+	} {
+		if _, err := b.IngestVEXStatement(ctx, model.PackageOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: v.pkg}}, model.IDorVulnerabilityInput{VulnerabilityInput: v.vuln}, v.spec); err != nil {
+			t.Fatalf("IngestVEXStatement() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		filter model.CertifyVulnSpec
+		want   []string
+	}{{
+		name:   "all certifications",
+		filter: model.CertifyVulnSpec{},
+		want: []string{
+			"cve-2023-44487 pkg:npm/%40scope/lib@2.0.0 under_investigation  reported by osv.dev 0.0.14 2024-01-02T03:04:05Z",
+			"cve-2023-44487 pkg:npm/app@1.0.0 affected  upgrade to 1.0.1 2024-01-03T03:04:05Z",
+			"ghsa-h45f-rjvw-2rv2 pkg:npm/%40scope/lib@2.0.0 not_affected vulnerable_code_not_in_execute_path lib does not use http2 2024-01-03T03:04:05Z",
+		},
+	}, {
+		name:   "filter by package",
+		filter: model.CertifyVulnSpec{Package: &model.PkgSpec{Name: ptrfrom.String("app")}},
+		want:   []string{"cve-2023-44487 pkg:npm/app@1.0.0 affected  upgrade to 1.0.1 2024-01-03T03:04:05Z"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := ExportOpenVEX(ctx, b, tt.filter)
+			if err != nil {
+				t.Fatalf("ExportOpenVEX() error = %v", err)
+			}
+			doc, err := vex.Parse(out)
+			if err != nil {
+				t.Fatalf("failed to parse OpenVEX output: %v", err)
+			}
+			if doc.Context != vex.ContextLocator() || doc.ID == "" || doc.Author != "GUAC" {
+				t.Errorf("unexpected document metadata %+v", doc.Metadata)
+			}
+			var got []string
+			for _, s := range doc.Statements {
+				if err := s.Validate(); err != nil {
+					t.Errorf("invalid statement %+v: %v", s, err)
+				}
+				if len(s.Products) != 1 || s.Products[0].Identifiers[vex.PURL] != s.Products[0].ID {
+					t.Errorf("unexpected products %+v", s.Products)
+					continue
+				}
+				got = append(got, fmt.Sprintf("%s %s %s %s %s%s%s %s", s.Vulnerability.Name, s.Products[0].ID, s.Status,
+					s.Justification, s.ImpactStatement, s.ActionStatement, s.StatusNotes, s.Timestamp.UTC().Format(time.RFC3339)))
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Unexpected statements (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
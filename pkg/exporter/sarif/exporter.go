@@ -0,0 +1,117 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarif
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/owenrumney/go-sarif/v2/sarif"
+)
+
+const (
+	guacTool           = "guac"
+	guacInformationURI = "https://guac.sh"
+	noVulnType         = "novuln"
+)
+
+// ExportSARIF returns a SARIF 2.1.0 log, as consumed by GitHub Code Scanning,
+// with a result for each CertifyVuln matching filter. The rule of a result is
+// the vulnerability ID, its message names the scanner that reported it and its
+// location is the purl of the affected package. Certifications that no
+// vulnerability was found are skipped.
+func ExportSARIF(ctx context.Context, backend backends.Backend, filter model.CertifyVulnSpec) ([]byte, error) {
+	certs, err := backend.CertifyVuln(ctx, &filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vulnerability certifications: %w", err)
+	}
+
+	report, err := sarif.New(sarif.Version210)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SARIF report: %w", err)
+	}
+	run := sarif.NewRunWithInformationURI(guacTool, guacInformationURI)
+	for _, cert := range certs {
+		if cert.Vulnerability == nil || cert.Vulnerability.Type == noVulnType || cert.Package == nil {
+			continue
+		}
+		for _, pkg := range helper.SplitPackageVersions([]*model.Package{cert.Package}) {
+			purl := packagePurl(pkg)
+			for _, vulnID := range cert.Vulnerability.VulnerabilityIDs {
+				run.AddRule(vulnID.VulnerabilityID).
+					WithShortDescription(sarif.NewMultiformatMessageString(fmt.Sprintf("%s vulnerability %s", cert.Vulnerability.Type, vulnID.VulnerabilityID)))
+				run.CreateResultForRule(vulnID.VulnerabilityID).
+					WithLevel(level(cert.Metadata)).
+					WithMessage(sarif.NewTextMessage(scannerText(cert.Metadata))).
+					AddLocation(sarif.NewLocationWithPhysicalLocation(
+						sarif.NewPhysicalLocation().WithArtifactLocation(sarif.NewSimpleArtifactLocation(purl))))
+			}
+		}
+	}
+	report.AddRun(run)
+
+	var buf bytes.Buffer
+	if err := report.PrettyWrite(&buf); err != nil {
+		return nil, fmt.Errorf("failed to write SARIF report: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// scannerText returns the scanner URI and version of the scan
+func scannerText(metadata *model.ScanMetadata) string {
+	if metadata == nil {
+		return "unknown scanner"
+	}
+	return fmt.Sprintf("%s %s", metadata.ScannerURI, metadata.ScannerVersion)
+}
+
+// level maps the CVSS score of the scan to a SARIF level, following the CVSS
+// v3 qualitative severity ratings. Unscored vulnerabilities are warnings.
+func level(metadata *model.ScanMetadata) string {
+	if metadata == nil || metadata.CVSSScore == nil {
+		return "warning"
+	}
+	switch score := *metadata.CVSSScore; {
+	case score >= 7.0:
+		return "error"
+	case score >= 4.0:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+func packagePurl(p *model.Package) string {
+	name := p.Namespaces[0].Names[0]
+	if len(name.Versions) > 0 && name.Versions[0].Purl != "" {
+		return name.Versions[0].Purl
+	}
+	var version, subpath string
+	var qualifiers []string
+	if len(name.Versions) > 0 {
+		version = name.Versions[0].Version
+		subpath = name.Versions[0].Subpath
+		for _, q := range name.Versions[0].Qualifiers {
+			qualifiers = append(qualifiers, q.Key, q.Value)
+		}
+	}
+	return helpers.PkgToPurl(p.Type, p.Namespaces[0].Namespace, name.Name, version, subpath, qualifiers)
+}
@@ -0,0 +1,127 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarif
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/owenrumney/go-sarif/v2/sarif"
+)
+
+var (
+	app = &model.PkgInputSpec{Type: "npm", Name: "app", Version: ptrfrom.String("1.0.0")}
+	lib = &model.PkgInputSpec{Type: "npm", Namespace: ptrfrom.String("@scope"), Name: "lib", Version: ptrfrom.String("2.0.0")}
+
+	cve    = &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2023-44487"}
+	ghsa   = &model.VulnerabilityInputSpec{Type: "ghsa", VulnerabilityID: "ghsa-h45f-rjvw-2rv2"}
+	noVuln = &model.VulnerabilityInputSpec{Type: "novuln", VulnerabilityID: ""}
+)
+
+func TestExportSARIF(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", nil, nil)
+	if err != nil {
+		t.Fatalf("error creating keyvalue backend: %v", err)
+	}
+	for _, p := range []*model.PkgInputSpec{app, lib} {
+		if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p}); err != nil {
+			t.Fatalf("IngestPackage() error = %v", err)
+		}
+	}
+	for _, v := range []*model.VulnerabilityInputSpec{cve, ghsa, noVuln} {
+		if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: v}); err != nil {
+			t.Fatalf("IngestVulnerability() error = %v", err)
+		}
+	}
+	scan := model.ScanMetadataInput{ScannerURI: "osv.dev", ScannerVersion: "0.0.14", TimeScanned: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}
+	for _, c := range []struct {
+		pkg  *model.PkgInputSpec
+		vuln *model.VulnerabilityInputSpec
+		cvss *float64
+	}{
+		{app, cve, ptrfrom.Float64(7.5)},
+		{lib, cve, ptrfrom.Float64(7.5)},
+		{lib, ghsa, nil},
+		{app, noVuln, nil},
+	} {
+		scan.CVSSScore = c.cvss
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: c.pkg}, model.IDorVulnerabilityInput{VulnerabilityInput: c.vuln}, scan); err != nil {
+			t.Fatalf("IngestCertifyVuln() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		filter     model.CertifyVulnSpec
+		wantRules  int
+		wantResult []string
+	}{{
+		name:      "all certifications",
+		filter:    model.CertifyVulnSpec{},
+		wantRules: 2,
+		wantResult: []string{
+			"cve-2023-44487 error pkg:npm/%40scope/lib@2.0.0",
+			"cve-2023-44487 error pkg:npm/app@1.0.0",
+			"ghsa-h45f-rjvw-2rv2 warning pkg:npm/%40scope/lib@2.0.0",
+		},
+	}, {
+		name:       "filter by package",
+		filter:     model.CertifyVulnSpec{Package: &model.PkgSpec{Name: ptrfrom.String("app")}},
+		wantRules:  1,
+		wantResult: []string{"cve-2023-44487 error pkg:npm/app@1.0.0"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := ExportSARIF(ctx, b, tt.filter)
+			if err != nil {
+				t.Fatalf("ExportSARIF() error = %v", err)
+			}
+			report, err := sarif.FromBytes(out)
+			if err != nil {
+				t.Fatalf("failed to parse SARIF output: %v", err)
+			}
+			if len(report.Runs) != 1 {
+				t.Fatalf("got %d runs, want 1", len(report.Runs))
+			}
+			run := report.Runs[0]
+			if run.Tool.Driver.Name != "guac" {
+				t.Errorf("got tool %q, want guac", run.Tool.Driver.Name)
+			}
+			if len(run.Tool.Driver.Rules) != tt.wantRules {
+				t.Errorf("got %d rules, want %d", len(run.Tool.Driver.Rules), tt.wantRules)
+			}
+			var results []string
+			for _, r := range run.Results {
+				if *r.Message.Text != "osv.dev 0.0.14" {
+					t.Errorf("unexpected message %q", *r.Message.Text)
+				}
+				results = append(results, *r.RuleID+" "+*r.Level+" "+*r.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+			}
+			sort.Strings(results)
+			if diff := cmp.Diff(tt.wantResult, results); diff != "" {
+				t.Errorf("Unexpected results (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
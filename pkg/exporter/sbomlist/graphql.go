@@ -0,0 +1,171 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbomlist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+const hasSBOMListQuery = `
+query SBOMList($spec: HasSBOMSpec!, $pagination: PaginationSpec) {
+  HasSBOMList(hasSBOMSpec: $spec, pagination: $pagination) {
+    totalCount
+    pageInfo {
+      hasNextPage
+      startCursor
+      endCursor
+    }
+    edges {
+      cursor
+      node {
+        id
+        subject {
+          __typename
+          ... on Package {
+            id
+            type
+            namespaces {
+              id
+              namespace
+              names {
+                id
+                name
+                versions {
+                  id
+                  purl
+                  version
+                  qualifiers {
+                    key
+                    value
+                  }
+                  subpath
+                }
+              }
+            }
+          }
+          ... on Artifact {
+            id
+            algorithm
+            digest
+          }
+        }
+        uri
+        algorithm
+        digest
+        knownSince
+      }
+    }
+  }
+}`
+
+type graphQLBackend struct {
+	client graphql.Client
+}
+
+// NewGraphQLBackend returns a Backend that lists the SBOMs known to a GUAC
+// GraphQL server. Only the fields used by ExportSBOMList are fetched.
+func NewGraphQLBackend(client graphql.Client) Backend {
+	return &graphQLBackend{client: client}
+}
+
+// hasSBOMConnection mirrors model.HasSBOMConnection with the subject union of
+// the nodes left undecoded.
+type hasSBOMConnection struct {
+	TotalCount int             `json:"totalCount"`
+	PageInfo   *model.PageInfo `json:"pageInfo"`
+	Edges      []struct {
+		Cursor string `json:"cursor"`
+		Node   struct {
+			ID         string          `json:"id"`
+			Subject    json.RawMessage `json:"subject"`
+			URI        string          `json:"uri"`
+			Algorithm  string          `json:"algorithm"`
+			Digest     string          `json:"digest"`
+			KnownSince time.Time       `json:"knownSince"`
+		} `json:"node"`
+	} `json:"edges"`
+}
+
+func (g *graphQLBackend) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, pagination *model.PaginationSpec) (*model.HasSBOMConnection, error) {
+	req := &graphql.Request{
+		OpName: "SBOMList",
+		Query:  hasSBOMListQuery,
+		Variables: map[string]interface{}{
+			"spec":       hasSBOMSpec,
+			"pagination": pagination,
+		},
+	}
+	var data struct {
+		HasSBOMList hasSBOMConnection `json:"HasSBOMList"`
+	}
+	if err := g.client.MakeRequest(ctx, req, &graphql.Response{Data: &data}); err != nil {
+		return nil, fmt.Errorf("SBOMList query failed: %w", err)
+	}
+	conn := &model.HasSBOMConnection{
+		TotalCount: data.HasSBOMList.TotalCount,
+		PageInfo:   data.HasSBOMList.PageInfo,
+		Edges:      make([]*model.HasSBOMEdge, 0, len(data.HasSBOMList.Edges)),
+	}
+	for _, edge := range data.HasSBOMList.Edges {
+		subject, err := decodePackageOrArtifact(edge.Node.Subject)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode subject of HasSBOM %s: %w", edge.Node.ID, err)
+		}
+		conn.Edges = append(conn.Edges, &model.HasSBOMEdge{
+			Cursor: edge.Cursor,
+			Node: &model.HasSbom{
+				ID:         edge.Node.ID,
+				Subject:    subject,
+				URI:        edge.Node.URI,
+				Algorithm:  edge.Node.Algorithm,
+				Digest:     edge.Node.Digest,
+				KnownSince: edge.Node.KnownSince,
+			},
+		})
+	}
+	return conn, nil
+}
+
+func decodePackageOrArtifact(raw json.RawMessage) (model.PackageOrArtifact, error) {
+	var typename struct {
+		Typename string `json:"__typename"`
+	}
+	if err := json.Unmarshal(raw, &typename); err != nil {
+		return nil, err
+	}
+	switch typename.Typename {
+	case "Package":
+		var pkg model.Package
+		if err := json.Unmarshal(raw, &pkg); err != nil {
+			return nil, err
+		}
+		return &pkg, nil
+	case "Artifact":
+		var art model.Artifact
+		if err := json.Unmarshal(raw, &art); err != nil {
+			return nil, err
+		}
+		return &art, nil
+	default:
+		return nil, fmt.Errorf("unexpected subject type %q", typename.Typename)
+	}
+}
@@ -0,0 +1,124 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sbomlist lists the packages that have an SBOM attested by a HasSBOM
+// node, along with where the SBOM can be found.
+package sbomlist
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+)
+
+// MaxPageSize is the number of HasSBOM nodes requested per page.
+const MaxPageSize = 1000
+
+// Backend is the subset of the GUAC backend used to list the SBOMs. It is
+// implemented by backends.Backend and, for remote servers, by the client
+// returned from NewGraphQLBackend.
+type Backend interface {
+	HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, pagination *model.PaginationSpec) (*model.HasSBOMConnection, error)
+}
+
+// SBOMEntry is a package with an SBOM.
+type SBOMEntry struct {
+	PURL       string    `json:"purl"`
+	URI        string    `json:"uri"`
+	Algorithm  string    `json:"algorithm"`
+	Digest     string    `json:"digest"`
+	KnownSince time.Time `json:"knownSince"`
+}
+
+// ExportSBOMList returns an entry for each HasSBOM matching filter whose
+// subject is a package. SBOMs of artifacts are skipped. The HasSBOM nodes are
+// fetched MaxPageSize at a time.
+func ExportSBOMList(ctx context.Context, backend Backend, filter model.HasSBOMSpec) ([]*SBOMEntry, error) {
+	var entries []*SBOMEntry
+	pagination := &model.PaginationSpec{First: ptrfrom.Int(MaxPageSize)}
+	for {
+		conn, err := backend.HasSBOMList(ctx, filter, pagination)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query HasSBOM: %w", err)
+		}
+		for _, edge := range conn.Edges {
+			pkg, ok := edge.Node.Subject.(*model.Package)
+			if !ok {
+				continue
+			}
+			entries = append(entries, &SBOMEntry{
+				PURL:       packagePurl(pkg),
+				URI:        edge.Node.URI,
+				Algorithm:  edge.Node.Algorithm,
+				Digest:     edge.Node.Digest,
+				KnownSince: edge.Node.KnownSince,
+			})
+		}
+		if conn.PageInfo == nil || !conn.PageInfo.HasNextPage || conn.PageInfo.EndCursor == nil {
+			return entries, nil
+		}
+		pagination.After = conn.PageInfo.EndCursor
+	}
+}
+
+// WriteJSON writes the entries to w as a JSON array.
+func WriteJSON(w io.Writer, entries []*SBOMEntry) error {
+	if entries == nil {
+		entries = []*SBOMEntry{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(entries)
+}
+
+// WriteCSV writes the entries to w as CSV, with a header row.
+func WriteCSV(w io.Writer, entries []*SBOMEntry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"purl", "uri", "algorithm", "digest", "knownSince"}); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if err := cw.Write([]string{e.PURL, e.URI, e.Algorithm, e.Digest, e.KnownSince.UTC().Format(time.RFC3339)}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// packagePurl returns the purl of a package trie holding a single version.
+func packagePurl(p *model.Package) string {
+	name := p.Namespaces[0].Names[0]
+	if len(name.Versions) > 0 && name.Versions[0].Purl != "" {
+		return name.Versions[0].Purl
+	}
+	var version, subpath string
+	var qualifiers []string
+	if len(name.Versions) > 0 {
+		version = name.Versions[0].Version
+		subpath = name.Versions[0].Subpath
+		for _, q := range name.Versions[0].Qualifiers {
+			qualifiers = append(qualifiers, q.Key, q.Value)
+		}
+	}
+	return helpers.PkgToPurl(p.Type, p.Namespaces[0].Namespace, name.Name, version, subpath, qualifiers)
+}
@@ -0,0 +1,234 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbomlist
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+)
+
+var knownSince = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+func ingestSBOMs(ctx context.Context, t *testing.T, b backends.Backend) {
+	t.Helper()
+	app := &model.PkgInputSpec{Type: "npm", Name: "app", Version: ptrfrom.String("1.0.0")}
+	lib := &model.PkgInputSpec{Type: "npm", Namespace: ptrfrom.String("@scope"), Name: "lib", Version: ptrfrom.String("2.0.0")}
+	art := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "abc"}
+	for _, p := range []*model.PkgInputSpec{app, lib} {
+		if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p}); err != nil {
+			t.Fatalf("failed to ingest package: %v", err)
+		}
+	}
+	if _, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: art}); err != nil {
+		t.Fatalf("failed to ingest artifact: %v", err)
+	}
+	sboms := []struct {
+		subject model.PackageOrArtifactInput
+		uri     string
+	}{
+		{model.PackageOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: app}}, "https://example.com/app.cdx.json"},
+		{model.PackageOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: lib}}, "https://example.com/lib.spdx.json"},
+		{model.PackageOrArtifactInput{Artifact: &model.IDorArtifactInput{ArtifactInput: art}}, "https://example.com/image.spdx.json"},
+	}
+	for _, s := range sboms {
+		if _, err := b.IngestHasSbom(ctx, s.subject, model.HasSBOMInputSpec{
+			URI:        s.uri,
+			Algorithm:  "sha256",
+			Digest:     "digest-of-" + s.uri,
+			KnownSince: knownSince,
+		}, model.HasSBOMIncludesInputSpec{}); err != nil {
+			t.Fatalf("failed to ingest HasSBOM: %v", err)
+		}
+	}
+}
+
+func TestExportSBOMList(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", ctx, nil)
+	if err != nil {
+		t.Fatalf("failed to get backend: %v", err)
+	}
+	ingestSBOMs(ctx, t, b)
+	srv := httptest.NewServer(handler.NewDefaultServer(generated.NewExecutableSchema(
+		generated.Config{Resolvers: &resolvers.Resolver{Backend: b}})))
+	defer srv.Close()
+
+	appEntry := &SBOMEntry{
+		PURL:       "pkg:npm/app@1.0.0",
+		URI:        "https://example.com/app.cdx.json",
+		Algorithm:  "sha256",
+		Digest:     "digest-of-https://example.com/app.cdx.json",
+		KnownSince: knownSince,
+	}
+	libEntry := &SBOMEntry{
+		PURL:       "pkg:npm/%40scope/lib@2.0.0",
+		URI:        "https://example.com/lib.spdx.json",
+		Algorithm:  "sha256",
+		Digest:     "digest-of-https://example.com/lib.spdx.json",
+		KnownSince: knownSince,
+	}
+	tests := []struct {
+		name   string
+		filter model.HasSBOMSpec
+		want   []*SBOMEntry
+	}{{
+		name: "all",
+		want: []*SBOMEntry{appEntry, libEntry},
+	}, {
+		name:   "by uri",
+		filter: model.HasSBOMSpec{URI: ptrfrom.String("https://example.com/lib.spdx.json")},
+		want:   []*SBOMEntry{libEntry},
+	}, {
+		name:   "artifact only",
+		filter: model.HasSBOMSpec{URI: ptrfrom.String("https://example.com/image.spdx.json")},
+	}}
+	for name, backend := range map[string]Backend{
+		"backend": b,
+		"graphql": NewGraphQLBackend(graphql.NewClient(srv.URL, srv.Client())),
+	} {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				got, err := ExportSBOMList(ctx, backend, tt.filter)
+				if err != nil {
+					t.Fatalf("ExportSBOMList() error = %v", err)
+				}
+				less := func(a, b *SBOMEntry) bool { return a.PURL < b.PURL }
+				if diff := cmp.Diff(tt.want, got, cmpopts.SortSlices(less), cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("Unexpected entries. (-want +got):\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+// pagedBackend serves sboms a page at a time and records the requested pages.
+type pagedBackend struct {
+	sboms []*model.HasSbom
+	pages []*model.PaginationSpec
+}
+
+func (p *pagedBackend) HasSBOMList(_ context.Context, _ model.HasSBOMSpec, pagination *model.PaginationSpec) (*model.HasSBOMConnection, error) {
+	spec := *pagination
+	p.pages = append(p.pages, &spec)
+	page, pageInfo, err := helper.PaginateSlice(p.sboms, func(n *model.HasSbom) string { return n.ID }, pagination)
+	if err != nil {
+		return nil, err
+	}
+	conn := &model.HasSBOMConnection{TotalCount: len(p.sboms), PageInfo: pageInfo}
+	for _, n := range page {
+		conn.Edges = append(conn.Edges, &model.HasSBOMEdge{Cursor: n.ID, Node: n})
+	}
+	return conn, nil
+}
+
+func TestExportSBOMListPagination(t *testing.T) {
+	const numSBOMs = 2*MaxPageSize + 1
+	b := &pagedBackend{}
+	for i := 0; i < numSBOMs; i++ {
+		b.sboms = append(b.sboms, &model.HasSbom{
+			ID: fmt.Sprint(i),
+			Subject: &model.Package{
+				Type: "pypi",
+				Namespaces: []*model.PackageNamespace{{
+					Names: []*model.PackageName{{
+						Name:     fmt.Sprintf("package-%d", i),
+						Versions: []*model.PackageVersion{{Version: "1.0"}},
+					}},
+				}},
+			},
+			URI: fmt.Sprintf("https://example.com/%d.json", i),
+		})
+	}
+
+	got, err := ExportSBOMList(context.Background(), b, model.HasSBOMSpec{})
+	if err != nil {
+		t.Fatalf("ExportSBOMList() error = %v", err)
+	}
+	if len(got) != numSBOMs {
+		t.Errorf("got %d entries, want %d", len(got), numSBOMs)
+	}
+	seen := map[string]bool{}
+	for _, e := range got {
+		if seen[e.URI] {
+			t.Errorf("duplicate entry for %s", e.URI)
+		}
+		seen[e.URI] = true
+	}
+	if len(b.pages) != 3 {
+		t.Fatalf("got %d page requests, want 3", len(b.pages))
+	}
+	for i, page := range b.pages {
+		if page.First == nil || *page.First != MaxPageSize {
+			t.Errorf("page %d: got first %v, want %d", i, page.First, MaxPageSize)
+		}
+		if (i == 0) != (page.After == nil) {
+			t.Errorf("page %d: unexpected after cursor %v", i, page.After)
+		}
+	}
+}
+
+func TestWrite(t *testing.T) {
+	entries := []*SBOMEntry{{
+		PURL:       "pkg:npm/app@1.0.0",
+		URI:        "https://example.com/app.cdx.json",
+		Algorithm:  "sha256",
+		Digest:     "abc",
+		KnownSince: knownSince,
+	}}
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, entries); err != nil {
+		t.Fatalf("WriteCSV() error = %v", err)
+	}
+	wantCSV := "purl,uri,algorithm,digest,knownSince\n" +
+		"pkg:npm/app@1.0.0,https://example.com/app.cdx.json,sha256,abc,2024-03-01T00:00:00Z\n"
+	if diff := cmp.Diff(wantCSV, buf.String()); diff != "" {
+		t.Errorf("Unexpected CSV. (-want +got):\n%s", diff)
+	}
+
+	buf.Reset()
+	if err := WriteJSON(&buf, entries); err != nil {
+		t.Fatalf("WriteJSON() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), `"purl": "pkg:npm/app@1.0.0"`) {
+		t.Errorf("Unexpected JSON: %s", buf.String())
+	}
+
+	buf.Reset()
+	if err := WriteJSON(&buf, nil); err != nil {
+		t.Fatalf("WriteJSON() error = %v", err)
+	}
+	if got := strings.TrimSpace(buf.String()); got != "[]" {
+		t.Errorf("got %q for no entries, want []", got)
+	}
+}
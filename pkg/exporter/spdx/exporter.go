@@ -0,0 +1,329 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	spdx "github.com/spdx/tools-golang/spdx"
+	spdx_common "github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+const (
+	guacTool          = "guac"
+	documentNamespace = "https://guac.sh/spdx/"
+	noAssertion       = "NOASSERTION"
+)
+
+var invalidSPDXIDChars = regexp.MustCompile(`[^a-zA-Z0-9.-]`)
+
+var checksumAlgorithms = map[string]spdx_common.ChecksumAlgorithm{
+	"md5":      spdx_common.MD5,
+	"sha1":     spdx_common.SHA1,
+	"sha224":   spdx_common.SHA224,
+	"sha256":   spdx_common.SHA256,
+	"sha384":   spdx_common.SHA384,
+	"sha512":   spdx_common.SHA512,
+	"sha3-256": spdx_common.SHA3_256,
+	"sha3-384": spdx_common.SHA3_384,
+	"sha3-512": spdx_common.SHA3_512,
+}
+
+// exporter accumulates the SPDX elements found while walking the graph
+type exporter struct {
+	backend       backends.Backend
+	doc           *spdx.Document
+	packages      map[string]*spdx.Package
+	versions      []string
+	relationships map[string]bool
+	created       string
+}
+
+// ExportSPDX walks the graph starting from the package version pkgID and returns an SPDX 2.3
+// document describing it. The document holds the package, its transitive dependencies and the
+// software included by its SBOMs. Artifacts the packages occur as become package checksums and
+// known vulnerabilities become annotations on the affected packages.
+func ExportSPDX(ctx context.Context, backend backends.Backend, pkgID string) (*spdx.Document, error) {
+	roots, err := backend.Packages(ctx, &model.PkgSpec{ID: &pkgID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query package %s: %w", pkgID, err)
+	}
+	versions := helper.SplitPackageVersions(roots)
+	if len(versions) != 1 {
+		return nil, fmt.Errorf("package version %s not found", pkgID)
+	}
+	root := versions[0]
+
+	created := time.Now().UTC().Format(time.RFC3339)
+	e := &exporter{
+		backend: backend,
+		doc: &spdx.Document{
+			SPDXVersion:       spdx.Version,
+			DataLicense:       spdx.DataLicense,
+			SPDXIdentifier:    spdx_common.ElementID("DOCUMENT"),
+			DocumentName:      packagePurl(root),
+			DocumentNamespace: documentNamespace + sanitizeSPDXID(pkgID) + "-" + created,
+			CreationInfo: &spdx.CreationInfo{
+				Creators: []spdx_common.Creator{{CreatorType: "Tool", Creator: guacTool}},
+				Created:  created,
+			},
+		},
+		packages:      map[string]*spdx.Package{},
+		relationships: map[string]bool{},
+		created:       created,
+	}
+
+	rootRef := e.addPackage(root)
+	e.addRelationship(spdx_common.ElementID("DOCUMENT"), rootRef, spdx_common.TypeRelationshipDescribe)
+
+	if err := e.addSBOMs(ctx, root, rootRef); err != nil {
+		return nil, err
+	}
+	if err := e.addDependencies(ctx, root); err != nil {
+		return nil, err
+	}
+	// artifacts and vulnerabilities are only attached to package versions
+	for _, id := range e.versions {
+		if err := e.addOccurrences(ctx, id, e.packages[id]); err != nil {
+			return nil, err
+		}
+		if err := e.addVulnerabilities(ctx, id, e.packages[id]); err != nil {
+			return nil, err
+		}
+	}
+	return e.doc, nil
+}
+
+// addSBOMs records the SBOMs of the root as external document references and adds the software
+// and dependencies they include
+func (e *exporter) addSBOMs(ctx context.Context, root *model.Package, rootRef spdx_common.ElementID) error {
+	rootID := packageID(root)
+	sboms, err := e.backend.HasSBOM(ctx, &model.HasSBOMSpec{
+		Subject: &model.PackageOrArtifactSpec{Package: &model.PkgSpec{ID: &rootID}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to query SBOMs of package %s: %w", rootID, err)
+	}
+	for i, sbom := range sboms {
+		if algorithm, ok := checksumAlgorithms[strings.ToLower(sbom.Algorithm)]; ok {
+			e.doc.ExternalDocumentReferences = append(e.doc.ExternalDocumentReferences, spdx.ExternalDocumentRef{
+				DocumentRefID: fmt.Sprintf("DocumentRef-sbom-%d", i),
+				URI:           sbom.URI,
+				Checksum:      spdx_common.Checksum{Algorithm: algorithm, Value: sbom.Digest},
+			})
+		}
+		for _, software := range sbom.IncludedSoftware {
+			if p, ok := software.(*model.Package); ok {
+				for _, v := range helper.SplitPackageVersions([]*model.Package{p}) {
+					if packageID(v) != rootID {
+						e.addRelationship(rootRef, e.addPackage(v), spdx_common.TypeRelationshipContains)
+					}
+				}
+			}
+		}
+		for _, dep := range sbom.IncludedDependencies {
+			e.addDependency(dep)
+		}
+	}
+	return nil
+}
+
+// addDependencies walks the IsDependency edges from the root breadth first. Dependencies on all
+// versions of a package are recorded but not walked further.
+func (e *exporter) addDependencies(ctx context.Context, root *model.Package) error {
+	visited := map[string]bool{}
+	queue := []string{packageID(root)}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+
+		deps, err := e.backend.IsDependency(ctx, &model.IsDependencySpec{Package: &model.PkgSpec{ID: &id}})
+		if err != nil {
+			return fmt.Errorf("failed to query dependencies of package %s: %w", id, err)
+		}
+		for _, dep := range deps {
+			if depPkg := e.addDependency(dep); depPkg != nil && hasVersion(depPkg) {
+				queue = append(queue, packageID(depPkg))
+			}
+		}
+	}
+	return nil
+}
+
+func (e *exporter) addDependency(dep *model.IsDependency) *model.Package {
+	if dep.Package == nil || dep.DependencyPackage == nil {
+		return nil
+	}
+	pkgs := helper.SplitPackageVersions([]*model.Package{dep.Package})
+	if len(pkgs) == 0 {
+		return nil
+	}
+	depPkg := dependencyPackage(dep.DependencyPackage)
+	if depPkg == nil {
+		return nil
+	}
+	e.addRelationship(e.addPackage(pkgs[0]), e.addPackage(depPkg), spdx_common.TypeRelationshipDependsOn)
+	return depPkg
+}
+
+// addOccurrences adds the digests of the artifacts a package occurs as to its checksums
+func (e *exporter) addOccurrences(ctx context.Context, id string, p *spdx.Package) error {
+	occurrences, err := e.backend.IsOccurrence(ctx, &model.IsOccurrenceSpec{
+		Subject: &model.PackageOrSourceSpec{Package: &model.PkgSpec{ID: &id}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to query occurrences of package %s: %w", id, err)
+	}
+	for _, occurrence := range occurrences {
+		if occurrence.Artifact == nil {
+			continue
+		}
+		algorithm, ok := checksumAlgorithms[strings.ToLower(occurrence.Artifact.Algorithm)]
+		if !ok {
+			continue
+		}
+		p.PackageChecksums = append(p.PackageChecksums, spdx_common.Checksum{
+			Algorithm: algorithm,
+			Value:     occurrence.Artifact.Digest,
+		})
+	}
+	return nil
+}
+
+// addVulnerabilities annotates a package with the vulnerabilities certified against it
+func (e *exporter) addVulnerabilities(ctx context.Context, id string, p *spdx.Package) error {
+	certifyVulns, err := e.backend.CertifyVuln(ctx, &model.CertifyVulnSpec{Package: &model.PkgSpec{ID: &id}})
+	if err != nil {
+		return fmt.Errorf("failed to query vulnerabilities of package %s: %w", id, err)
+	}
+	for _, cv := range certifyVulns {
+		if cv.Vulnerability == nil || strings.EqualFold(cv.Vulnerability.Type, "novuln") {
+			continue
+		}
+		for _, vulnID := range cv.Vulnerability.VulnerabilityIDs {
+			comment := fmt.Sprintf("vulnerability %s:%s", cv.Vulnerability.Type, vulnID.VulnerabilityID)
+			if cv.Metadata != nil {
+				comment += fmt.Sprintf(" reported by %s %s at %s", cv.Metadata.ScannerURI, cv.Metadata.ScannerVersion,
+					cv.Metadata.TimeScanned.UTC().Format(time.RFC3339))
+			}
+			p.Annotations = append(p.Annotations, spdx.Annotation{
+				Annotator:                spdx_common.Annotator{AnnotatorType: "Tool", Annotator: guacTool},
+				AnnotationDate:           e.created,
+				AnnotationType:           "OTHER",
+				AnnotationSPDXIdentifier: spdx_common.DocElementID{ElementRefID: p.PackageSPDXIdentifier},
+				AnnotationComment:        comment,
+			})
+		}
+	}
+	return nil
+}
+
+// addPackage adds a package holding a single version or name to the document once and returns its SPDX ID
+func (e *exporter) addPackage(p *model.Package) spdx_common.ElementID {
+	id := packageID(p)
+	if existing, ok := e.packages[id]; ok {
+		return existing.PackageSPDXIdentifier
+	}
+	name := p.Namespaces[0].Names[0]
+	spdxPkg := &spdx.Package{
+		PackageName:             name.Name,
+		PackageSPDXIdentifier:   spdx_common.ElementID("Package-" + sanitizeSPDXID(id)),
+		PackageDownloadLocation: noAssertion,
+		FilesAnalyzed:           false,
+		PackageExternalReferences: []*spdx.PackageExternalReference{{
+			Category: spdx_common.CategoryPackageManager,
+			RefType:  spdx_common.TypePackageManagerPURL,
+			Locator:  packagePurl(p),
+		}},
+	}
+	if hasVersion(p) {
+		spdxPkg.PackageVersion = name.Versions[0].Version
+		e.versions = append(e.versions, id)
+	}
+	e.packages[id] = spdxPkg
+	e.doc.Packages = append(e.doc.Packages, spdxPkg)
+	return spdxPkg.PackageSPDXIdentifier
+}
+
+func (e *exporter) addRelationship(a, b spdx_common.ElementID, relationship string) {
+	key := string(a) + " " + relationship + " " + string(b)
+	if e.relationships[key] {
+		return
+	}
+	e.relationships[key] = true
+	e.doc.Relationships = append(e.doc.Relationships, &spdx.Relationship{
+		RefA:         spdx_common.DocElementID{ElementRefID: a},
+		RefB:         spdx_common.DocElementID{ElementRefID: b},
+		Relationship: relationship,
+	})
+}
+
+// dependencyPackage returns the dependency of an IsDependency as a package holding a single
+// version, or a single name if the dependency is on all versions
+func dependencyPackage(p *model.Package) *model.Package {
+	if versions := helper.SplitPackageVersions([]*model.Package{p}); len(versions) > 0 {
+		return versions[0]
+	}
+	if len(p.Namespaces) == 0 || len(p.Namespaces[0].Names) == 0 {
+		return nil
+	}
+	return p
+}
+
+func hasVersion(p *model.Package) bool {
+	return len(p.Namespaces[0].Names[0].Versions) > 0
+}
+
+func packageID(p *model.Package) string {
+	name := p.Namespaces[0].Names[0]
+	if len(name.Versions) > 0 {
+		return name.Versions[0].ID
+	}
+	return name.ID
+}
+
+func packagePurl(p *model.Package) string {
+	name := p.Namespaces[0].Names[0]
+	if len(name.Versions) > 0 && name.Versions[0].Purl != "" {
+		return name.Versions[0].Purl
+	}
+	var version, subpath string
+	var qualifiers []string
+	if len(name.Versions) > 0 {
+		version = name.Versions[0].Version
+		subpath = name.Versions[0].Subpath
+		for _, q := range name.Versions[0].Qualifiers {
+			qualifiers = append(qualifiers, q.Key, q.Value)
+		}
+	}
+	return helpers.PkgToPurl(p.Type, p.Namespaces[0].Namespace, name.Name, version, subpath, qualifiers)
+}
+
+func sanitizeSPDXID(id string) string {
+	return invalidSPDXIDChars.ReplaceAllString(id, "-")
+}
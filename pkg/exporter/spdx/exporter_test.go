@@ -0,0 +1,160 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	spdx_json "github.com/spdx/tools-golang/json"
+	spdx_common "github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+var (
+	app = &model.PkgInputSpec{Type: "npm", Name: "app", Version: ptrfrom.String("1.0.0")}
+	lib = &model.PkgInputSpec{Type: "npm", Name: "lib", Version: ptrfrom.String("2.0.0")}
+	sub = &model.PkgInputSpec{Type: "npm", Namespace: ptrfrom.String("@scope"), Name: "sub", Version: ptrfrom.String("3.0.0")}
+)
+
+func TestExportSPDX(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", nil, nil)
+	if err != nil {
+		t.Fatalf("error creating keyvalue backend: %v", err)
+	}
+
+	var appID string
+	for _, p := range []*model.PkgInputSpec{app, lib, sub} {
+		ids, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p})
+		if err != nil {
+			t.Fatalf("IngestPackage() error = %v", err)
+		}
+		if p == app {
+			appID = ids.PackageVersionID
+		}
+	}
+	dependency := model.IsDependencyInputSpec{DependencyType: model.DependencyTypeDirect, Justification: "test"}
+	match := model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	if _, err := b.IngestDependency(ctx, model.IDorPkgInput{PackageInput: app}, model.IDorPkgInput{PackageInput: lib}, match, dependency); err != nil {
+		t.Fatalf("IngestDependency() error = %v", err)
+	}
+	if _, err := b.IngestDependency(ctx, model.IDorPkgInput{PackageInput: lib}, model.IDorPkgInput{PackageInput: sub}, match, dependency); err != nil {
+		t.Fatalf("IngestDependency() error = %v", err)
+	}
+
+	artifact := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf"}
+	if _, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: artifact}); err != nil {
+		t.Fatalf("IngestArtifact() error = %v", err)
+	}
+	if _, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: &model.IDorPkgInput{PackageInput: lib}},
+		model.IDorArtifactInput{ArtifactInput: artifact}, model.IsOccurrenceInputSpec{Justification: "test"}); err != nil {
+		t.Fatalf("IngestOccurrence() error = %v", err)
+	}
+
+	vuln := &model.VulnerabilityInputSpec{Type: "ghsa", VulnerabilityID: "ghsa-h45f-rjvw-2rv2"}
+	if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: vuln}); err != nil {
+		t.Fatalf("IngestVulnerability() error = %v", err)
+	}
+	scanned := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: sub}, model.IDorVulnerabilityInput{VulnerabilityInput: vuln},
+		model.ScanMetadataInput{ScannerURI: "osv.dev", ScannerVersion: "0.0.14", TimeScanned: scanned}); err != nil {
+		t.Fatalf("IngestCertifyVuln() error = %v", err)
+	}
+
+	if _, err := b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: app}},
+		model.HasSBOMInputSpec{URI: "https://example.com/app.spdx.json", Algorithm: "sha256", Digest: "abc"},
+		model.HasSBOMIncludesInputSpec{}); err != nil {
+		t.Fatalf("IngestHasSbom() error = %v", err)
+	}
+
+	doc, err := ExportSPDX(ctx, b, appID)
+	if err != nil {
+		t.Fatalf("ExportSPDX() error = %v", err)
+	}
+
+	if doc.SPDXVersion != "SPDX-2.3" || doc.DocumentName != "pkg:npm/app@1.0.0" {
+		t.Errorf("unexpected document header: version %q, name %q", doc.SPDXVersion, doc.DocumentName)
+	}
+
+	refs := map[string]spdx_common.ElementID{}
+	for _, p := range doc.Packages {
+		refs[p.PackageExternalReferences[0].Locator] = p.PackageSPDXIdentifier
+	}
+	if diff := cmp.Diff([]string{"pkg:npm/%40scope/sub@3.0.0", "pkg:npm/app@1.0.0", "pkg:npm/lib@2.0.0"}, sortedKeys(refs)); diff != "" {
+		t.Errorf("Unexpected packages (-want +got):\n%s", diff)
+	}
+
+	var relationships []string
+	for _, r := range doc.Relationships {
+		relationships = append(relationships, string(r.RefA.ElementRefID)+" "+r.Relationship+" "+string(r.RefB.ElementRefID))
+	}
+	wantRelationships := []string{
+		"DOCUMENT DESCRIBES " + string(refs["pkg:npm/app@1.0.0"]),
+		string(refs["pkg:npm/app@1.0.0"]) + " DEPENDS_ON " + string(refs["pkg:npm/lib@2.0.0"]),
+		string(refs["pkg:npm/lib@2.0.0"]) + " DEPENDS_ON " + string(refs["pkg:npm/%40scope/sub@3.0.0"]),
+	}
+	if diff := cmp.Diff(wantRelationships, relationships); diff != "" {
+		t.Errorf("Unexpected relationships (-want +got):\n%s", diff)
+	}
+
+	for _, p := range doc.Packages {
+		switch p.PackageName {
+		case "lib":
+			if len(p.PackageChecksums) != 1 || p.PackageChecksums[0].Value != artifact.Digest {
+				t.Errorf("unexpected checksums for lib: %+v", p.PackageChecksums)
+			}
+		case "sub":
+			if len(p.Annotations) != 1 || !strings.Contains(p.Annotations[0].AnnotationComment, "ghsa-h45f-rjvw-2rv2") {
+				t.Errorf("unexpected annotations for sub: %+v", p.Annotations)
+			}
+		default:
+			if len(p.Annotations) != 0 || len(p.PackageChecksums) != 0 {
+				t.Errorf("unexpected annotations or checksums for %s", p.PackageName)
+			}
+		}
+	}
+
+	if len(doc.ExternalDocumentReferences) != 1 || doc.ExternalDocumentReferences[0].URI != "https://example.com/app.spdx.json" {
+		t.Errorf("unexpected external document references: %+v", doc.ExternalDocumentReferences)
+	}
+
+	var buf bytes.Buffer
+	if err := spdx_json.Write(doc, &buf); err != nil {
+		t.Errorf("failed to serialize document: %v", err)
+	}
+
+	if _, err := ExportSPDX(ctx, b, "999999"); err == nil {
+		t.Errorf("expected error exporting an unknown package")
+	}
+}
+
+func sortedKeys(m map[string]spdx_common.ElementID) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
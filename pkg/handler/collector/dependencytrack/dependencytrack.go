@@ -0,0 +1,347 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencytrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/version"
+)
+
+const (
+	DependencyTrackCollector = "DependencyTrack"
+	// DefaultPageSize is the number of projects or findings requested per page
+	DefaultPageSize = 100
+)
+
+// analysisStates maps the analysis states of DependencyTrack to the CycloneDX
+// impact analysis states known to the CycloneDX parser. Findings that were not
+// analyzed yet are reported as in triage.
+var analysisStates = map[string]cdx.ImpactAnalysisState{
+	"NOT_SET":        cdx.IASInTriage,
+	"IN_TRIAGE":      cdx.IASInTriage,
+	"EXPLOITABLE":    cdx.IASExploitable,
+	"RESOLVED":       cdx.IASResolved,
+	"FALSE_POSITIVE": cdx.IASNotAffected,
+	"NOT_AFFECTED":   cdx.IASNotAffected,
+}
+
+type dependencyTrackCollector struct {
+	httpClient *http.Client
+	url        string
+	apiKey     string
+	projects   map[string]bool
+	pageSize   int
+	poll       bool
+	interval   time.Duration
+}
+
+type Opt func(*dependencyTrackCollector)
+
+// NewDependencyTrackCollector returns a collector of the findings of the
+// projects of the DependencyTrack server at url, read with the given API key.
+// Every finding is emitted as a CycloneDX document holding the vulnerability
+// and the component of the project it affects.
+func NewDependencyTrackCollector(url, apiKey string, opts ...Opt) (*dependencyTrackCollector, error) {
+	d := &dependencyTrackCollector{
+		httpClient: &http.Client{Transport: version.UATransport},
+		url:        strings.TrimSuffix(url, "/"),
+		apiKey:     apiKey,
+		pageSize:   DefaultPageSize,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	if d.url == "" {
+		return nil, fmt.Errorf("a url must be provided for the dependencytrack collector")
+	}
+	if d.apiKey == "" {
+		return nil, fmt.Errorf("an api key must be provided for the dependencytrack collector")
+	}
+	if d.pageSize <= 0 {
+		return nil, fmt.Errorf("invalid dependencytrack page size %d, must be positive", d.pageSize)
+	}
+	return d, nil
+}
+
+// WithHTTPClient sets the client used for the requests to DependencyTrack
+func WithHTTPClient(c *http.Client) Opt {
+	return func(d *dependencyTrackCollector) {
+		d.httpClient = c
+	}
+}
+
+// WithProjects only collects the findings of the projects with the given
+// names or UUIDs, instead of every project
+func WithProjects(projects ...string) Opt {
+	return func(d *dependencyTrackCollector) {
+		d.projects = map[string]bool{}
+		for _, p := range projects {
+			d.projects[p] = true
+		}
+	}
+}
+
+// WithPageSize sets the number of projects or findings requested per page,
+// DefaultPageSize by default
+func WithPageSize(pageSize int) Opt {
+	return func(d *dependencyTrackCollector) {
+		d.pageSize = pageSize
+	}
+}
+
+func WithPolling(interval time.Duration) Opt {
+	return func(d *dependencyTrackCollector) {
+		d.poll = true
+		d.interval = interval
+	}
+}
+
+// RetrieveArtifacts collects the findings of the projects once, or on every
+// interval when polling
+func (d *dependencyTrackCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	if !d.poll {
+		return d.collect(ctx, docChannel)
+	}
+	logger := logging.FromContext(ctx)
+	for {
+		if err := d.collect(ctx, docChannel); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logger.Errorf("failed to collect from dependencytrack: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(d.interval):
+		}
+	}
+}
+
+// Type returns the collector type
+func (d *dependencyTrackCollector) Type() string {
+	return DependencyTrackCollector
+}
+
+type project struct {
+	UUID    string `json:"uuid"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Purl    string `json:"purl"`
+}
+
+type finding struct {
+	Component struct {
+		UUID    string `json:"uuid"`
+		Name    string `json:"name"`
+		Group   string `json:"group"`
+		Version string `json:"version"`
+		Purl    string `json:"purl"`
+	} `json:"component"`
+	Vulnerability struct {
+		UUID            string   `json:"uuid"`
+		Source          string   `json:"source"`
+		VulnID          string   `json:"vulnId"`
+		Description     string   `json:"description"`
+		CvssV2BaseScore *float64 `json:"cvssV2BaseScore"`
+		CvssV3BaseScore *float64 `json:"cvssV3BaseScore"`
+		// Published is in milliseconds since the epoch
+		Published int64 `json:"published"`
+	} `json:"vulnerability"`
+	Analysis struct {
+		State string `json:"state"`
+	} `json:"analysis"`
+}
+
+func (d *dependencyTrackCollector) collect(ctx context.Context, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	projects, err := fetchAll[project](ctx, d, "/api/v1/project")
+	if err != nil {
+		return err
+	}
+	for _, p := range projects {
+		if len(d.projects) > 0 && !d.projects[p.Name] && !d.projects[p.UUID] {
+			continue
+		}
+		findings, err := fetchAll[finding](ctx, d, "/api/v1/finding/project/"+url.PathEscape(p.UUID))
+		if err != nil {
+			return err
+		}
+		for _, f := range findings {
+			doc, err := d.document(p, f)
+			if err != nil {
+				logger.Debugf("skipping dependencytrack finding %s of project %s: %v", f.Vulnerability.VulnID, p.UUID, err)
+				continue
+			}
+			select {
+			case docChannel <- doc:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+// fetchAll returns every page of the items at path. The pages are read until
+// one is not full, or the total count reported by DependencyTrack is reached.
+func fetchAll[T any](ctx context.Context, d *dependencyTrackCollector, path string) ([]T, error) {
+	var items []T
+	for pageNumber := 1; ; pageNumber++ {
+		var page []T
+		total, err := d.fetchPage(ctx, path, pageNumber, &page)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+		if len(page) < d.pageSize || (total >= 0 && len(items) >= total) {
+			return items, nil
+		}
+	}
+}
+
+// fetchPage unmarshals a page of the items at path into page and returns the
+// total count of items, or -1 if DependencyTrack did not report it
+func (d *dependencyTrackCollector) fetchPage(ctx context.Context, path string, pageNumber int, page any) (int, error) {
+	params := url.Values{}
+	params.Set("pageNumber", strconv.Itoa(pageNumber))
+	params.Set("pageSize", strconv.Itoa(d.pageSize))
+	reqURL := d.url + path + "?" + params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create dependencytrack request: %w", err)
+	}
+	req.Header.Set("X-Api-Key", d.apiKey)
+	req.Header.Set("Accept", "application/json")
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query dependencytrack: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("dependencytrack returned status %s for %s page %d", resp.Status, path, pageNumber)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read dependencytrack response: %w", err)
+	}
+	if err := json.Unmarshal(body, page); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal dependencytrack response for %s: %w", path, err)
+	}
+	total, err := strconv.Atoi(resp.Header.Get("X-Total-Count"))
+	if err != nil {
+		return -1, nil
+	}
+	return total, nil
+}
+
+// document returns a CycloneDX BOM of the project holding the finding, so that
+// it is ingested as a vulnerability of the affected component. The component is
+// referenced by its purl, or by its name and version if it has none.
+func (d *dependencyTrackCollector) document(p project, f finding) (*processor.Document, error) {
+	analysisState := f.Analysis.State
+	if analysisState == "" {
+		analysisState = "NOT_SET"
+	}
+	state, ok := analysisStates[analysisState]
+	if !ok {
+		return nil, fmt.Errorf("unknown analysis state %q", analysisState)
+	}
+	if f.Vulnerability.VulnID == "" {
+		return nil, fmt.Errorf("finding has no vulnerability id")
+	}
+
+	component := cdx.Component{
+		BOMRef:     f.Component.Purl,
+		Type:       cdx.ComponentTypeLibrary,
+		Group:      f.Component.Group,
+		Name:       f.Component.Name,
+		Version:    f.Component.Version,
+		PackageURL: f.Component.Purl,
+	}
+	affects := cdx.Affects{}
+	if f.Component.Purl == "" {
+		if f.Component.Name == "" || f.Component.Version == "" {
+			return nil, fmt.Errorf("component %s has neither a purl nor a name and version", f.Component.UUID)
+		}
+		component.BOMRef = f.Component.Name
+		affects.Range = &[]cdx.AffectedVersions{{Version: f.Component.Version}}
+	}
+	serial := "urn:uuid:" + p.UUID
+	affects.Ref = fmt.Sprintf("urn:cdx:%s/1#%s", p.UUID, component.BOMRef)
+
+	ratings := []cdx.VulnerabilityRating{}
+	if f.Vulnerability.CvssV3BaseScore != nil {
+		ratings = append(ratings, cdx.VulnerabilityRating{Score: f.Vulnerability.CvssV3BaseScore, Method: cdx.ScoringMethodCVSSv3})
+	}
+	if f.Vulnerability.CvssV2BaseScore != nil {
+		ratings = append(ratings, cdx.VulnerabilityRating{Score: f.Vulnerability.CvssV2BaseScore, Method: cdx.ScoringMethodCVSSv2})
+	}
+	vuln := cdx.Vulnerability{
+		BOMRef:      f.Vulnerability.UUID,
+		ID:          f.Vulnerability.VulnID,
+		Source:      &cdx.Source{Name: f.Vulnerability.Source},
+		Description: f.Vulnerability.Description,
+		Ratings:     &ratings,
+		Analysis:    &cdx.VulnerabilityAnalysis{State: state},
+		Affects:     &[]cdx.Affects{affects},
+	}
+	if f.Vulnerability.Published > 0 {
+		vuln.Published = time.UnixMilli(f.Vulnerability.Published).UTC().Format(time.RFC3339)
+	}
+
+	bom := cdx.NewBOM()
+	bom.SerialNumber = serial
+	bom.Metadata = &cdx.Metadata{
+		Component: &cdx.Component{
+			BOMRef:     serial,
+			Type:       cdx.ComponentTypeApplication,
+			Name:       p.Name,
+			Version:    p.Version,
+			PackageURL: p.Purl,
+		},
+	}
+	bom.Components = &[]cdx.Component{component}
+	bom.Vulnerabilities = &[]cdx.Vulnerability{vuln}
+
+	blob, err := json.Marshal(bom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cyclonedx document: %w", err)
+	}
+	return &processor.Document{
+		Blob:   blob,
+		Type:   processor.DocumentCycloneDX,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector: DependencyTrackCollector,
+			Source:    fmt.Sprintf("%s/api/v1/finding/project/%s", d.url, p.UUID),
+		},
+	}, nil
+}
@@ -0,0 +1,274 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencytrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/cyclonedx"
+)
+
+// fakeDependencyTrack serves the project and finding APIs, and records the
+// requested paths and pages
+type fakeDependencyTrack struct {
+	mu       sync.Mutex
+	projects []project
+	findings map[string][]json.RawMessage
+	requests []string
+	apiKeys  []string
+}
+
+func (f *fakeDependencyTrack) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	query := r.URL.Query()
+	f.requests = append(f.requests, fmt.Sprintf("%s?%s", r.URL.Path, query.Get("pageNumber")))
+	f.apiKeys = append(f.apiKeys, r.Header.Get("X-Api-Key"))
+
+	var items []any
+	if r.URL.Path == "/api/v1/project" {
+		for _, p := range f.projects {
+			items = append(items, p)
+		}
+	} else if uuid, ok := strings.CutPrefix(r.URL.Path, "/api/v1/finding/project/"); ok {
+		for _, finding := range f.findings[uuid] {
+			items = append(items, finding)
+		}
+	} else {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	pageNumber, _ := strconv.Atoi(query.Get("pageNumber"))
+	pageSize, _ := strconv.Atoi(query.Get("pageSize"))
+	start := (pageNumber - 1) * pageSize
+	end := start + pageSize
+	if start > len(items) {
+		start = len(items)
+	}
+	if end > len(items) {
+		end = len(items)
+	}
+	w.Header().Set("X-Total-Count", strconv.Itoa(len(items)))
+	_ = json.NewEncoder(w).Encode(append([]any{}, items[start:end]...))
+}
+
+func findingJSON(vulnID, purl, state string) json.RawMessage {
+	return json.RawMessage(fmt.Sprintf(`{
+		"component": {"uuid": "c-%[1]s", "name": "log4j-core", "group": "org.apache.logging.log4j", "version": "2.14.1", "purl": %[2]q},
+		"vulnerability": {"uuid": "v-%[1]s", "source": "NVD", "vulnId": %[1]q, "cvssV3BaseScore": 10.0, "published": 1639094400000},
+		"analysis": {"state": %[3]q, "isSuppressed": false}
+	}`, vulnID, purl, state))
+}
+
+func collectDocs(t *testing.T, c *dependencyTrackCollector) []*processor.Document {
+	t.Helper()
+	docChan := make(chan *processor.Document, 100)
+	if err := c.RetrieveArtifacts(context.Background(), docChan); err != nil {
+		t.Fatalf("RetrieveArtifacts() error = %v", err)
+	}
+	close(docChan)
+	var docs []*processor.Document
+	for doc := range docChan {
+		if doc.Type != processor.DocumentCycloneDX || doc.Format != processor.FormatJSON || doc.SourceInformation.Collector != DependencyTrackCollector {
+			t.Errorf("unexpected document %+v", doc.SourceInformation)
+		}
+		docs = append(docs, doc)
+	}
+	return docs
+}
+
+func TestNewDependencyTrackCollector(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		apiKey  string
+		opts    []Opt
+		wantErr bool
+	}{{
+		name:   "defaults",
+		url:    "https://dtrack.example.com/",
+		apiKey: "key",
+	}, {
+		name:    "no url",
+		apiKey:  "key",
+		wantErr: true,
+	}, {
+		name:    "no api key",
+		url:     "https://dtrack.example.com",
+		wantErr: true,
+	}, {
+		name:    "no page size",
+		url:     "https://dtrack.example.com",
+		apiKey:  "key",
+		opts:    []Opt{WithPageSize(0)},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewDependencyTrackCollector(tt.url, tt.apiKey, tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewDependencyTrackCollector() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && c.url != "https://dtrack.example.com" {
+				t.Errorf("url = %s, want the trailing slash trimmed", c.url)
+			}
+		})
+	}
+}
+
+func Test_dependencyTrackCollector_RetrieveArtifacts(t *testing.T) {
+	fake := &fakeDependencyTrack{
+		projects: []project{
+			{UUID: "p1", Name: "webapp", Version: "1.0.0", Purl: "pkg:maven/com.example/webapp@1.0.0"},
+			{UUID: "p2", Name: "backend", Version: "2.0.0"},
+			{UUID: "p3", Name: "ignored"},
+		},
+		findings: map[string][]json.RawMessage{
+			"p1": {
+				findingJSON("CVE-2021-44228", "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1", "EXPLOITABLE"),
+				findingJSON("CVE-2021-45046", "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1", "NOT_SET"),
+				findingJSON("CVE-2021-45105", "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1", "FALSE_POSITIVE"),
+			},
+			"p2": {
+				findingJSON("CVE-2021-44832", "", "IN_TRIAGE"),
+			},
+			"p3": {
+				findingJSON("CVE-2022-0001", "pkg:npm/foo@1.0.0", "NOT_SET"),
+			},
+		},
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	c, err := NewDependencyTrackCollector(server.URL, "secret", WithPageSize(2), WithProjects("webapp", "p2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	docs := collectDocs(t, c)
+
+	wantRequests := []string{
+		"/api/v1/project?1",
+		"/api/v1/project?2",
+		"/api/v1/finding/project/p1?1",
+		"/api/v1/finding/project/p1?2",
+		"/api/v1/finding/project/p2?1",
+	}
+	if diff := cmp.Diff(wantRequests, fake.requests); diff != "" {
+		t.Errorf("requests (-want +got):\n%s", diff)
+	}
+	for i, key := range fake.apiKeys {
+		if key != "secret" {
+			t.Errorf("request %d X-Api-Key = %q", i, key)
+		}
+	}
+
+	var got []string
+	for _, doc := range docs {
+		bom, err := cyclonedx.ParseCycloneDXBOM(doc)
+		if err != nil {
+			t.Fatalf("failed to parse document: %v", err)
+		}
+		v := (*bom.Vulnerabilities)[0]
+		got = append(got, fmt.Sprintf("%s %s %s", bom.Metadata.Component.Name, v.ID, v.Analysis.State))
+	}
+	want := []string{
+		"webapp CVE-2021-44228 exploitable",
+		"webapp CVE-2021-45046 in_triage",
+		"webapp CVE-2021-45105 not_affected",
+		"backend CVE-2021-44832 in_triage",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("findings (-want +got):\n%s", diff)
+	}
+}
+
+func Test_dependencyTrackCollector_documentIngestion(t *testing.T) {
+	tests := []struct {
+		name     string
+		finding  json.RawMessage
+		wantType string
+	}{{
+		name:     "component with purl",
+		finding:  findingJSON("CVE-2021-44228", "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1", "EXPLOITABLE"),
+		wantType: "maven",
+	}, {
+		name:     "component without purl",
+		finding:  findingJSON("CVE-2021-44228", "", "EXPLOITABLE"),
+		wantType: "guac",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeDependencyTrack{
+				projects: []project{{UUID: "p1", Name: "webapp", Version: "1.0.0"}},
+				findings: map[string][]json.RawMessage{"p1": {tt.finding}},
+			}
+			server := httptest.NewServer(fake)
+			defer server.Close()
+
+			c, err := NewDependencyTrackCollector(server.URL, "secret")
+			if err != nil {
+				t.Fatal(err)
+			}
+			docs := collectDocs(t, c)
+			if len(docs) != 1 {
+				t.Fatalf("got %d documents, want 1", len(docs))
+			}
+
+			ctx := context.Background()
+			parser := cyclonedx.NewCycloneDXParser()
+			if err := parser.Parse(ctx, docs[0]); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			preds := parser.GetPredicates(ctx)
+			if len(preds.CertifyVuln) != 1 {
+				t.Fatalf("got %d certifyVuln, want 1", len(preds.CertifyVuln))
+			}
+			cv := preds.CertifyVuln[0]
+			if cv.Vulnerability.VulnerabilityID != "cve-2021-44228" || cv.Pkg.Type != tt.wantType || cv.Pkg.Name != "log4j-core" {
+				t.Errorf("unexpected certifyVuln of %s for package %s/%s", cv.Vulnerability.VulnerabilityID, cv.Pkg.Type, cv.Pkg.Name)
+			}
+			if len(preds.VulnMetadata) != 1 || preds.VulnMetadata[0].VulnMetadata.ScoreValue != 10.0 {
+				t.Errorf("unexpected vulnerability metadata %+v", preds.VulnMetadata)
+			}
+		})
+	}
+}
+
+func Test_dependencyTrackCollector_error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	c, err := NewDependencyTrackCollector(server.URL, "wrong")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.RetrieveArtifacts(context.Background(), make(chan *processor.Document, 1)); err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -16,19 +16,28 @@
 package file
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/guacsec/guac/pkg/events"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
 	FileCollector = "FileCollector"
+	// watchSettle is how long a watched file must go without events before
+	// it is emitted, so that a file written in several chunks is emitted once
+	watchSettle = 500 * time.Millisecond
 )
 
 type fileCollector struct {
@@ -37,15 +46,60 @@ type fileCollector struct {
 	poll        bool
 	interval    time.Duration
 	useBlobURL  bool
+	compress    bool
+	concurrency int
+	watch       bool
 }
 
-func NewFileCollector(ctx context.Context, path string, poll bool, interval time.Duration, useBlobURL bool) *fileCollector {
-	return &fileCollector{
-		path:       path,
-		poll:       poll,
-		interval:   interval,
-		useBlobURL: useBlobURL,
+// NewFileCollector creates a collector for the documents under path. When
+// polling with watch set, the directory tree is watched for file changes
+// instead of being re-walked on every interval. The watcher is set up when the
+// artifacts are retrieved, and if it cannot be the collector falls back to
+// polling. With compress set, the documents are
+// emitted gzip compressed, to reduce the memory held by large documents waiting
+// to be processed. With a concurrency above 1, up to that many files are read
+// at once while walking the tree, which helps on high latency storage such as
+// NFS, but they are still emitted in the order they were found.
+func NewFileCollector(ctx context.Context, path string, poll bool, interval time.Duration, useBlobURL bool, watch bool, compress bool, concurrency int) *fileCollector {
+	f := &fileCollector{
+		path:        path,
+		poll:        poll,
+		interval:    interval,
+		useBlobURL:  useBlobURL,
+		compress:    compress,
+		concurrency: concurrency,
+		watch:       poll && watch,
 	}
+	return f
+}
+
+// newWatcher creates a watcher for the directory at root and all its
+// subdirectories, as fsnotify does not watch recursively.
+func newWatcher(root string) (*fsnotify.Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create watcher: %w", err)
+	}
+	if err := addWatchDirs(watcher, root); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+	return watcher, nil
+}
+
+func addWatchDirs(watcher *fsnotify.Watcher, root string) error {
+	return filepath.WalkDir(root, func(path string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("path: %s is invalid", path)
+		}
+		if !dirEntry.IsDir() {
+			return nil
+		}
+		if err := watcher.Add(path); err != nil {
+			return fmt.Errorf("unable to watch path: %s, err: %w", path, err)
+		}
+		return nil
+	})
 }
 
 // RetrieveArtifacts collects the documents from the collector. It emits each collected
@@ -62,59 +116,51 @@ func (f *fileCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 		return fmt.Errorf("unknown error on os.Stat for FileCollector path: %w", err)
 	}
 
-	readFunc := func(path string, dirEntry fs.DirEntry, err error) error {
-		// If the context has been canceled it contains an err which we can throw.
-		// When it gets thrown a second time will cancel the walk.
-		// See filepath.WalkDir for more info.
-		if ctx.Err() != nil {
-			return ctx.Err() // nolint:wrapcheck
-		}
-		// NOTE: Explicitly rethrowing new errors if a particular directory has an error.
-		// If we rethrow the error it kills the whole walk. Still useful to make it explicit that we ran into an error.
-		if err != nil {
-			return fmt.Errorf("path: %s is invalid", path)
-		}
-		if dirEntry.IsDir() {
-			return nil
-		}
-		info, err := dirEntry.Info()
-		if err != nil {
-			return fmt.Errorf("unknown error on dirEntry.Info while walking path: %w", err)
-		}
-		if !info.ModTime().After(f.lastChecked) {
-			return nil
-		}
+	walk := func(emit func(path string) error) error {
+		return filepath.WalkDir(f.path, func(path string, dirEntry fs.DirEntry, err error) error {
+			// If the context has been canceled it contains an err which we can throw.
+			// When it gets thrown a second time will cancel the walk.
+			// See filepath.WalkDir for more info.
+			if ctx.Err() != nil {
+				return ctx.Err() // nolint:wrapcheck
+			}
+			// NOTE: Explicitly rethrowing new errors if a particular directory has an error.
+			// If we rethrow the error it kills the whole walk. Still useful to make it explicit that we ran into an error.
+			if err != nil {
+				return fmt.Errorf("path: %s is invalid", path)
+			}
+			if dirEntry.IsDir() {
+				return nil
+			}
+			info, err := dirEntry.Info()
+			if err != nil {
+				return fmt.Errorf("unknown error on dirEntry.Info while walking path: %w", err)
+			}
+			if !info.ModTime().After(f.lastChecked) {
+				return nil
+			}
 
-		blob, err := os.ReadFile(path)
-		if err != nil {
-			return fmt.Errorf("error reading file: %s, err: %w", path, err)
-		}
+			return emit(path)
+		})
+	}
 
-		var docRef string
-		if f.useBlobURL {
-			docRef = events.GetKey(blob) // this is the blob store path
+	if f.watch {
+		watcher, err := newWatcher(f.path)
+		if err != nil {
+			logger := logging.FromContext(ctx)
+			logger.Warnf("unable to watch path: %s, falling back to polling: %v", f.path, err)
 		} else {
-			docRef = ""
-		}
-
-		doc := &processor.Document{
-			Blob:   blob,
-			Type:   processor.DocumentUnknown,
-			Format: processor.FormatUnknown,
-			SourceInformation: processor.SourceInformation{
-				Collector:   string(FileCollector),
-				Source:      fmt.Sprintf("file:///%s", path),
-				DocumentRef: docRef,
-			},
+			defer watcher.Close()
+			if err := f.emitWalk(ctx, docChannel, walk); err != nil {
+				return fmt.Errorf("error walking path: %s, err: %w", f.path, err)
+			}
+			f.lastChecked = time.Now()
+			return f.watchFiles(ctx, docChannel, watcher)
 		}
-
-		docChannel <- doc
-
-		return nil
 	}
 
 	for {
-		if err := filepath.WalkDir(f.path, readFunc); err != nil {
+		if err := f.emitWalk(ctx, docChannel, walk); err != nil {
 			return fmt.Errorf("error walking path: %s, err: %w", f.path, err)
 		}
 		f.lastChecked = time.Now()
@@ -132,6 +178,218 @@ func (f *fileCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 	return nil
 }
 
+// watchFiles emits the files that are created or written to until the context
+// is canceled. A file is emitted once it went watchSettle without events, so
+// that it is not emitted on every write while it is being written. Newly
+// created directories are added to the watcher and their files are emitted.
+func (f *fileCollector) watchFiles(ctx context.Context, docChannel chan<- *processor.Document, watcher *fsnotify.Watcher) error {
+	logger := logging.FromContext(ctx)
+	// the time of the last event of the files waiting to settle
+	pending := map[string]time.Time{}
+	var settle <-chan time.Time
+	for {
+		select {
+		// If the context has been canceled it contains an err which we can throw.
+		case <-ctx.Done():
+			return ctx.Err() // nolint:wrapcheck
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			logger.Errorf("error watching path: %s, err: %v", f.path, err)
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) {
+				continue
+			}
+			info, err := os.Stat(event.Name)
+			if err != nil {
+				// the file may already have been removed again
+				logger.Debugf("unable to stat path: %s, skipping: %v", event.Name, err)
+				continue
+			}
+			now := time.Now()
+			if info.IsDir() {
+				if err := addWatchDirs(watcher, event.Name); err != nil {
+					logger.Errorf("unable to watch new directory: %s, err: %v", event.Name, err)
+				}
+				// files may have been written before the directory was watched
+				err = filepath.WalkDir(event.Name, func(path string, dirEntry fs.DirEntry, err error) error {
+					if err != nil || dirEntry.IsDir() {
+						return nil
+					}
+					pending[path] = now
+					return nil
+				})
+				if err != nil {
+					logger.Errorf("error walking path: %s, err: %v", event.Name, err)
+				}
+			} else {
+				pending[event.Name] = now
+			}
+			if settle == nil && len(pending) > 0 {
+				settle = time.After(watchSettle)
+			}
+		case now := <-settle:
+			settle = nil
+			var settled []string
+			next := watchSettle
+			for path, last := range pending {
+				if wait := watchSettle - now.Sub(last); wait > 0 {
+					next = min(next, wait)
+					continue
+				}
+				settled = append(settled, path)
+				delete(pending, path)
+			}
+			if len(pending) > 0 {
+				settle = time.After(next)
+			}
+			sort.Strings(settled)
+			for _, path := range settled {
+				info, err := os.Stat(path)
+				if err != nil {
+					logger.Debugf("unable to stat path: %s, skipping: %v", path, err)
+					continue
+				}
+				if info.Size() == 0 {
+					// an empty file is emitted once it is written to
+					continue
+				}
+				if err := f.emitFile(path, docChannel); err != nil {
+					logger.Errorf("%v", err)
+				}
+			}
+		}
+	}
+}
+
+// emitWalk emits the files passed to emit by walk, reading them on up to
+// f.concurrency goroutines
+func (f *fileCollector) emitWalk(ctx context.Context, docChannel chan<- *processor.Document, walk func(emit func(path string) error) error) error {
+	if f.concurrency <= 1 {
+		return walk(func(path string) error {
+			return f.emitFile(path, docChannel)
+		})
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	// the documents being read, in the order the files were found; its
+	// capacity bounds the documents waiting to be emitted
+	pending := make(chan chan *processor.Document, f.concurrency)
+	readers := make(chan struct{}, f.concurrency)
+	g.Go(func() error {
+		defer close(pending)
+		seen := map[string]bool{}
+		return walk(func(path string) error {
+			if seen[path] {
+				return nil
+			}
+			seen[path] = true
+			read := make(chan *processor.Document, 1)
+			select {
+			case pending <- read:
+			case <-gctx.Done():
+				return gctx.Err() // nolint:wrapcheck
+			}
+			select {
+			case readers <- struct{}{}:
+			case <-gctx.Done():
+				return gctx.Err() // nolint:wrapcheck
+			}
+			g.Go(func() error {
+				defer func() { <-readers }()
+				defer close(read)
+				doc, err := f.readFile(path)
+				if err != nil {
+					return err
+				}
+				read <- doc
+				return nil
+			})
+			return nil
+		})
+	})
+
+emit:
+	for read := range pending {
+		select {
+		case doc, ok := <-read:
+			if !ok {
+				// the read failed, which cancels gctx
+				break emit
+			}
+			select {
+			case docChannel <- doc:
+			case <-gctx.Done():
+				break emit
+			}
+		case <-gctx.Done():
+			break emit
+		}
+	}
+	return g.Wait() // nolint:wrapcheck
+}
+
+// emitFile reads the file at path and emits it as a document.
+func (f *fileCollector) emitFile(path string, docChannel chan<- *processor.Document) error {
+	doc, err := f.readFile(path)
+	if err != nil {
+		return err
+	}
+	docChannel <- doc
+	return nil
+}
+
+// readFile reads the file at path into a document.
+func (f *fileCollector) readFile(path string) (*processor.Document, error) {
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file: %s, err: %w", path, err)
+	}
+	var encoding processor.EncodingType
+	if f.compress {
+		blob, err = gzipBlob(blob)
+		if err != nil {
+			return nil, fmt.Errorf("error compressing file: %s, err: %w", path, err)
+		}
+		encoding = processor.EncodingGzip
+	}
+
+	var docRef string
+	if f.useBlobURL {
+		docRef = events.GetKey(blob) // this is the blob store path
+	} else {
+		docRef = ""
+	}
+
+	return &processor.Document{
+		Blob:     blob,
+		Type:     processor.DocumentUnknown,
+		Format:   processor.FormatUnknown,
+		Encoding: encoding,
+		SourceInformation: processor.SourceInformation{
+			Collector:   string(FileCollector),
+			Source:      fmt.Sprintf("file:///%s", path),
+			DocumentRef: docRef,
+		},
+	}, nil
+}
+
+func gzipBlob(blob []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(blob); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
 // Type returns the collector type
 func (f *fileCollector) Type() string {
 	return FileCollector
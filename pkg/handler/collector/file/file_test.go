@@ -16,12 +16,20 @@
 package file
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/guacsec/guac/pkg/events"
 	"github.com/guacsec/guac/pkg/handler/collector"
 	"github.com/guacsec/guac/pkg/handler/processor"
 )
@@ -158,6 +166,212 @@ func Test_fileCollector_RetrieveArtifacts(t *testing.T) {
 	}
 }
 
+func Test_fileCollector_Watch(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "existing"), []byte("existing\n"), 0o600); err != nil {
+		t.Fatalf("unable to write file: %v", err)
+	}
+
+	f := NewFileCollector(context.Background(), dir, true, time.Hour, false, true, false, 1)
+	if !f.watch {
+		t.Fatalf("expected the collector to watch %s", dir)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	docChan := make(chan *processor.Document, 10)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- f.RetrieveArtifacts(ctx, docChan)
+	}()
+
+	waitForDoc := func(want string) {
+		t.Helper()
+		select {
+		case d := <-docChan:
+			if string(d.Blob) != want {
+				t.Errorf("got document %q, want %q", d.Blob, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for document %q", want)
+		}
+	}
+
+	// existing files are collected before watching
+	waitForDoc("existing\n")
+
+	// only the changed file is collected afterwards, it is moved into place
+	// so that the create event sees the whole content
+	tmp := filepath.Join(t.TempDir(), "new")
+	if err := os.WriteFile(tmp, []byte("new\n"), 0o600); err != nil {
+		t.Fatalf("unable to write file: %v", err)
+	}
+	if err := os.Rename(tmp, filepath.Join(dir, "new")); err != nil {
+		t.Fatalf("unable to move file: %v", err)
+	}
+	waitForDoc("new\n")
+
+	cancel()
+	if err := <-errChan; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context canceled error, got: %v", err)
+	}
+}
+
+func Test_fileCollector_WatchSettle(t *testing.T) {
+	dir := t.TempDir()
+	f := NewFileCollector(context.Background(), dir, true, time.Hour, false, true, false, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	docChan := make(chan *processor.Document, 10)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- f.RetrieveArtifacts(ctx, docChan)
+	}()
+	// let the collector walk the empty directory and start watching
+	time.Sleep(100 * time.Millisecond)
+
+	// a file written in several chunks is only emitted once, when complete
+	file, err := os.Create(filepath.Join(dir, "chunked"))
+	if err != nil {
+		t.Fatalf("unable to create file: %v", err)
+	}
+	for i := 0; i < 5; i++ {
+		if _, err := fmt.Fprintf(file, "chunk %d\n", i); err != nil {
+			t.Fatalf("unable to write file: %v", err)
+		}
+		time.Sleep(watchSettle / 10)
+	}
+	if err := file.Close(); err != nil {
+		t.Fatalf("unable to close file: %v", err)
+	}
+
+	want := "chunk 0\nchunk 1\nchunk 2\nchunk 3\nchunk 4\n"
+	select {
+	case d := <-docChan:
+		if string(d.Blob) != want {
+			t.Errorf("got document %q, want %q", d.Blob, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for document")
+	}
+	select {
+	case d := <-docChan:
+		t.Errorf("got unexpected document %q", d.Blob)
+	case <-time.After(2 * watchSettle):
+	}
+
+	cancel()
+	if err := <-errChan; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context canceled error, got: %v", err)
+	}
+}
+
+func Test_fileCollector_Compress(t *testing.T) {
+	// a synthetic CycloneDX SBOM of about 5 MB
+	var sbom bytes.Buffer
+	sbom.WriteString(`{"bomFormat":"CycloneDX","specVersion":"1.5","version":1,"components":[`)
+	for i := 0; sbom.Len() < 5<<20; i++ {
+		if i > 0 {
+			sbom.WriteString(",")
+		}
+		fmt.Fprintf(&sbom, `{"type":"library","bom-ref":"pkg:npm/package-%d@1.0.%d","name":"package-%d","version":"1.0.%d","purl":"pkg:npm/package-%d@1.0.%d"}`, i, i%100, i, i%100, i, i%100)
+	}
+	sbom.WriteString(`]}`)
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "sbom.cdx.json"), sbom.Bytes(), 0o600); err != nil {
+		t.Fatalf("unable to write file: %v", err)
+	}
+
+	f := NewFileCollector(context.Background(), dir, false, time.Second, true, false, true, 1)
+	docChan := make(chan *processor.Document, 1)
+	if err := f.RetrieveArtifacts(context.Background(), docChan); err != nil {
+		t.Fatalf("fileCollector.RetrieveArtifacts() error = %v", err)
+	}
+	d := <-docChan
+
+	if d.Encoding != processor.EncodingGzip {
+		t.Errorf("got encoding %q, want %q", d.Encoding, processor.EncodingGzip)
+	}
+	if len(d.Blob) >= sbom.Len() {
+		t.Errorf("emitted blob of %d bytes is not smaller than the %d bytes file", len(d.Blob), sbom.Len())
+	}
+	if d.SourceInformation.DocumentRef != events.GetKey(d.Blob) {
+		t.Errorf("got document ref %s, want the key of the emitted blob", d.SourceInformation.DocumentRef)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(d.Blob))
+	if err != nil {
+		t.Fatalf("unable to read gzip blob: %v", err)
+	}
+	blob, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatalf("unable to decompress blob: %v", err)
+	}
+	if !bytes.Equal(blob, sbom.Bytes()) {
+		t.Errorf("decompressed blob differs from the file")
+	}
+}
+
+func Test_fileCollector_Concurrency(t *testing.T) {
+	dir := t.TempDir()
+	var want []string
+	for i := 0; i < 50; i++ {
+		sub := filepath.Join(dir, fmt.Sprintf("dir-%d", i%3))
+		if err := os.MkdirAll(sub, 0o700); err != nil {
+			t.Fatalf("unable to create directory: %v", err)
+		}
+		path := filepath.Join(sub, fmt.Sprintf("file-%02d.json", i))
+		if err := os.WriteFile(path, []byte(fmt.Sprintf(`{"file":%d}`, i)), 0o600); err != nil {
+			t.Fatalf("unable to write file: %v", err)
+		}
+	}
+	// the order filepath.WalkDir finds the files in
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			want = append(want, fmt.Sprintf("file:///%s", path))
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to walk directory: %v", err)
+	}
+
+	for _, concurrency := range []int{1, 4, 100} {
+		t.Run(fmt.Sprintf("concurrency %d", concurrency), func(t *testing.T) {
+			f := NewFileCollector(context.Background(), dir, false, time.Second, false, false, false, concurrency)
+			// unbuffered, so that the readers get ahead of the emission
+			docChan := make(chan *processor.Document)
+			errChan := make(chan error, 1)
+			go func() {
+				errChan <- f.RetrieveArtifacts(context.Background(), docChan)
+				close(docChan)
+			}()
+			var got []string
+			for d := range docChan {
+				got = append(got, d.SourceInformation.Source)
+			}
+			if err := <-errChan; err != nil {
+				t.Fatalf("fileCollector.RetrieveArtifacts() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("emitted files out of discovery order or more than once:\ngot  %v\nwant %v", got, want)
+			}
+		})
+	}
+
+	t.Run("unreadable file", func(t *testing.T) {
+		if err := os.Symlink(filepath.Join(dir, "missing.json"), filepath.Join(dir, "dir-0", "broken.json")); err != nil {
+			t.Skipf("unable to create symlink: %v", err)
+		}
+		defer os.Remove(filepath.Join(dir, "dir-0", "broken.json"))
+		f := NewFileCollector(context.Background(), dir, false, time.Second, false, false, false, 4)
+		docChan := make(chan *processor.Document, len(want)+1)
+		if err := f.RetrieveArtifacts(context.Background(), docChan); err == nil {
+			t.Error("fileCollector.RetrieveArtifacts() succeeded with an unreadable file")
+		}
+	})
+}
+
 // checkWhileIgnoringLogger works like a regular reflect.DeepEqual(), but ignores the loggers.
 func checkWhileIgnoringLogger(collectedDoc, want []*processor.Document) bool {
 	if len(collectedDoc) != len(want) {
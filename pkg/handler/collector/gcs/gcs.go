@@ -23,7 +23,9 @@ import (
 	"time"
 
 	"cloud.google.com/go/storage"
+	"golang.org/x/oauth2/google"
 	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
 
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
@@ -31,6 +33,8 @@ import (
 
 type gcs struct {
 	bucket       string
+	prefix       string
+	projectID    string
 	reader       gcsReader
 	client       *storage.Client
 	lastDownload time.Time
@@ -48,8 +52,8 @@ func NewGCSCollector(opts ...Opt) (*gcs, error) {
 		opt(gstore)
 	}
 
-	// Set reader using both the client and bucket
-	gstore.reader = &reader{client: gstore.client, bucket: gstore.bucket}
+	// Set reader using the client, bucket and listing options
+	gstore.reader = &reader{client: gstore.client, bucket: gstore.bucket, prefix: gstore.prefix, projectID: gstore.projectID}
 
 	if gstore.bucket == "" {
 		return nil, errors.New("gcs bucket not specified")
@@ -83,6 +87,37 @@ func WithBucket(bucket string) Opt {
 	}
 }
 
+// WithPrefix restricts the collection to the objects whose name starts with prefix
+func WithPrefix(prefix string) Opt {
+	return func(g *gcs) {
+		g.prefix = prefix
+	}
+}
+
+// WithProjectID bills the requests to the project, required for buckets with
+// requester pays enabled
+func WithProjectID(projectID string) Opt {
+	return func(g *gcs) {
+		g.projectID = projectID
+	}
+}
+
+// NewClient creates a storage client reading with the service account
+// credentials file at credentialsPath. Without it, the application default
+// credentials are used, which also cover Workload Identity Federation.
+func NewClient(ctx context.Context, credentialsPath string, opts ...option.ClientOption) (*storage.Client, error) {
+	if credentialsPath != "" {
+		opts = append(opts, option.WithCredentialsFile(credentialsPath))
+	} else {
+		tokenSource, err := google.DefaultTokenSource(ctx, storage.ScopeReadOnly)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find default credentials: %w", err)
+		}
+		opts = append(opts, option.WithTokenSource(tokenSource))
+	}
+	return storage.NewClient(ctx, opts...)
+}
+
 // Type is the collector type of the collector
 func (g *gcs) Type() string {
 	return CollectorGCS
@@ -94,12 +129,26 @@ type gcsReader interface {
 }
 
 type reader struct {
-	client *storage.Client
-	bucket string
+	client    *storage.Client
+	bucket    string
+	prefix    string
+	projectID string
+}
+
+func (r *reader) bucketHandle() *storage.BucketHandle {
+	b := r.client.Bucket(r.bucket)
+	if r.projectID != "" {
+		b = b.UserProject(r.projectID)
+	}
+	return b
 }
 
+// getIterator lists all the objects under the prefix. The query cannot select
+// objects by their update time, StartOffset compares object names, so the
+// objects are filtered by their Updated attribute while iterating.
 func (r *reader) getIterator(ctx context.Context) (*storage.ObjectIterator, error) {
 	q := &storage.Query{
+		Prefix:     r.prefix,
 		Projection: storage.ProjectionNoACL,
 	}
 	// set query to return only the Name and Updated attributes
@@ -107,11 +156,11 @@ func (r *reader) getIterator(ctx context.Context) (*storage.ObjectIterator, erro
 	if err != nil {
 		return nil, err
 	}
-	return r.client.Bucket(r.bucket).Objects(ctx, q), nil
+	return r.bucketHandle().Objects(ctx, q), nil
 }
 
 func (r *reader) getReader(ctx context.Context, object string) (io.ReadCloser, error) {
-	return r.client.Bucket(r.bucket).Object(object).NewReader(ctx)
+	return r.bucketHandle().Object(object).NewReader(ctx)
 }
 
 // RetrieveArtifacts get the artifacts from the collector source based on polling or one time
@@ -122,11 +171,13 @@ func (g *gcs) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processo
 	}
 
 	gcsGetArtifacts := func() error {
+		// objects updated while listing are collected again by the next poll
+		listStart := time.Now()
 		err := g.getArtifacts(ctx, docChannel)
 		if err != nil {
 			return fmt.Errorf("failed to get artifacts from gcs: %w", err)
 		}
-		g.lastDownload = time.Now()
+		g.lastDownload = listStart
 		return nil
 	}
 
@@ -182,11 +233,16 @@ func (g *gcs) getArtifacts(ctx context.Context, docChannel chan<- *processor.Doc
 				Type:   processor.DocumentUnknown,
 				Format: processor.FormatUnknown,
 				SourceInformation: processor.SourceInformation{
-					Collector: string(CollectorGCS),
-					Source:    g.bucket + "/" + attrs.Name,
+					Collector:   string(CollectorGCS),
+					Source:      g.bucket + "/" + attrs.Name,
+					DocumentRef: fmt.Sprintf("gs://%s/%s", g.bucket, attrs.Name),
 				},
 			}
-			docChannel <- doc
+			select {
+			case docChannel <- doc:
+			case <-ctx.Done():
+				return ctx.Err() // nolint:wrapcheck
+			}
 		}
 	}
 	return nil
@@ -49,8 +49,9 @@ func TestGCS_RetrieveArtifacts(t *testing.T) {
 		Type:   processor.DocumentUnknown,
 		Format: processor.FormatUnknown,
 		SourceInformation: processor.SourceInformation{
-			Collector: string(CollectorGCS),
-			Source:    bucketName + "/some/object/file.txt",
+			Collector:   string(CollectorGCS),
+			Source:      bucketName + "/some/object/file.txt",
+			DocumentRef: "gs://" + bucketName + "/some/object/file.txt",
 		},
 	}
 
@@ -99,6 +100,24 @@ func TestGCS_RetrieveArtifacts(t *testing.T) {
 		want:     []*processor.Document{doc},
 		wantErr:  false,
 		wantDone: true,
+	}, {
+		name: "object under prefix",
+		fields: fields{
+			bucket: bucketName,
+			reader: &reader{client: client, bucket: bucketName, prefix: "some/object/"},
+		},
+		want:     []*processor.Document{doc},
+		wantErr:  false,
+		wantDone: true,
+	}, {
+		name: "object outside prefix",
+		fields: fields{
+			bucket: bucketName,
+			reader: &reader{client: client, bucket: bucketName, prefix: "other/"},
+		},
+		want:     nil,
+		wantErr:  false,
+		wantDone: true,
 	}}
 
 	for _, tt := range tests {
@@ -167,6 +186,8 @@ func TestNewGCSCollector(t *testing.T) {
 
 	type args struct {
 		bucket       string
+		prefix       string
+		projectID    string
 		pollInterval time.Duration
 		client       *storage.Client
 	}
@@ -215,6 +236,22 @@ func TestNewGCSCollector(t *testing.T) {
 				interval: 2 * time.Minute,
 			},
 			wantErr: false,
+		}, {
+			name: "prefix and project",
+			args: args{
+				bucket:    "some-bucket",
+				client:    client,
+				prefix:    "sboms/",
+				projectID: "some-project",
+			},
+			want: &gcs{
+				bucket:    "some-bucket",
+				prefix:    "sboms/",
+				projectID: "some-project",
+				client:    client,
+				reader:    &reader{bucket: "some-bucket", client: client, prefix: "sboms/", projectID: "some-project"},
+			},
+			wantErr: false,
 		}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -232,6 +269,14 @@ func TestNewGCSCollector(t *testing.T) {
 				opts = append(opts, WithClient(tt.args.client))
 			}
 
+			if tt.args.prefix != "" {
+				opts = append(opts, WithPrefix(tt.args.prefix))
+			}
+
+			if tt.args.projectID != "" {
+				opts = append(opts, WithProjectID(tt.args.projectID))
+			}
+
 			g, err := NewGCSCollector(opts...)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NewGCSCollector() error = %v, wantErr %v", err, tt.wantErr)
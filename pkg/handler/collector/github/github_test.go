@@ -157,6 +157,14 @@ func (m *MockGithubClient) GetWorkflowRunArtifacts(ctx context.Context, owner, r
 	return nil, nil
 }
 
+func (m *MockGithubClient) ListCommits(ctx context.Context, owner, repo string, limit int) ([]*client.Commit, error) {
+	return nil, nil
+}
+
+func (m *MockGithubClient) ListCommitsUntil(ctx context.Context, owner, repo string, until time.Time, limit int) ([]*client.Commit, error) {
+	return nil, nil
+}
+
 func TestNewGithubCollector(t *testing.T) {
 	mockClient := &MockGithubClient{}
 	mockData := mockDataSource()
@@ -0,0 +1,230 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubactions
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/version"
+)
+
+const (
+	GithubActionsCollector = "GithubActionsCollector"
+
+	defaultAPIURL = "https://api.github.com"
+)
+
+// attestationsResponse is the subset of the GitHub attestations API response
+// (GET /repos/{owner}/{repo}/attestations/{subject_digest}) used by the collector
+type attestationsResponse struct {
+	Attestations []struct {
+		Bundle struct {
+			DSSEEnvelope struct {
+				Payload     string `json:"payload"`
+				PayloadType string `json:"payloadType"`
+			} `json:"dsseEnvelope"`
+		} `json:"bundle"`
+	} `json:"attestations"`
+}
+
+// provenancePredicate holds the fields of SLSA v0.2 and v1 provenance that
+// name the GitHub Actions workflow which produced the artifact
+type provenancePredicate struct {
+	Predicate struct {
+		BuildDefinition struct {
+			ExternalParameters struct {
+				Workflow struct {
+					Path string `json:"path"`
+				} `json:"workflow"`
+			} `json:"externalParameters"`
+		} `json:"buildDefinition"`
+		Invocation struct {
+			ConfigSource struct {
+				EntryPoint string `json:"entryPoint"`
+			} `json:"configSource"`
+		} `json:"invocation"`
+	} `json:"predicate"`
+}
+
+type githubActionsCollector struct {
+	httpClient *http.Client
+	apiURL     string
+	token      string
+	owner      string
+	repo       string
+	workflow   string
+	digests    []string
+	poll       bool
+	interval   time.Duration
+	seen       map[[sha256.Size]byte]bool
+}
+
+// NewGithubActionsCollector initializes a collector that fetches the provenance attestations GitHub
+// Actions produced in owner/repo for the given artifact digests (e.g. "sha256:abc..."). If workflow is
+// set, only attestations produced by that workflow file (e.g. ".github/workflows/release.yml") are emitted.
+func NewGithubActionsCollector(ctx context.Context, token, owner, repo, workflow string, digests []string, poll bool, interval time.Duration) (*githubActionsCollector, error) {
+	if owner == "" || repo == "" {
+		return nil, fmt.Errorf("owner and repo must be specified for the github actions collector")
+	}
+	if len(digests) == 0 {
+		return nil, fmt.Errorf("no artifact digests provided for the github actions collector")
+	}
+	for _, d := range digests {
+		if !strings.Contains(d, ":") {
+			return nil, fmt.Errorf("artifact digest %q must be of the form <algorithm>:<hex>", d)
+		}
+	}
+	if poll && interval <= 0 {
+		return nil, fmt.Errorf("polling interval must be greater than zero")
+	}
+	return &githubActionsCollector{
+		httpClient: &http.Client{Transport: version.UATransport},
+		apiURL:     defaultAPIURL,
+		token:      token,
+		owner:      owner,
+		repo:       repo,
+		workflow:   workflow,
+		digests:    digests,
+		poll:       poll,
+		interval:   interval,
+		seen:       map[[sha256.Size]byte]bool{},
+	}, nil
+}
+
+// RetrieveArtifacts fetches the attestations for each digest once, or repeatedly on the
+// polling interval until the context is canceled. Attestations already emitted are skipped.
+func (g *githubActionsCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	for {
+		for _, digest := range g.digests {
+			if err := g.fetchAttestations(ctx, digest, docChannel); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if !g.poll {
+					return err
+				}
+				logging.FromContext(ctx).Warnf("failed to fetch attestations for %s: %v", digest, err)
+			}
+		}
+		if !g.poll {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(g.interval):
+		}
+	}
+}
+
+func (g *githubActionsCollector) Type() string {
+	return GithubActionsCollector
+}
+
+func (g *githubActionsCollector) fetchAttestations(ctx context.Context, digest string, docChannel chan<- *processor.Document) error {
+	source := fmt.Sprintf("%s/repos/%s/%s/attestations/%s", g.apiURL, url.PathEscape(g.owner), url.PathEscape(g.repo), url.PathEscape(digest))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source+"?per_page=100", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if g.token != "" {
+		req.Header.Set("Authorization", "Bearer "+g.token)
+	}
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to query attestations: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		// no attestations exist (yet) for this digest
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("attestations request returned status %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read attestations response: %w", err)
+	}
+	var attestations attestationsResponse
+	if err := json.Unmarshal(body, &attestations); err != nil {
+		return fmt.Errorf("failed to unmarshal attestations response: %w", err)
+	}
+
+	for _, attestation := range attestations.Attestations {
+		envelope := attestation.Bundle.DSSEEnvelope
+		if envelope.PayloadType != "application/vnd.in-toto+json" {
+			continue
+		}
+		statement, err := base64.StdEncoding.DecodeString(envelope.Payload)
+		if err != nil {
+			return fmt.Errorf("failed to decode attestation payload: %w", err)
+		}
+		if !g.matchesWorkflow(statement) {
+			continue
+		}
+		key := sha256.Sum256(statement)
+		if g.seen[key] {
+			continue
+		}
+		g.seen[key] = true
+
+		doc := &processor.Document{
+			Blob:   statement,
+			Type:   processor.DocumentITE6Generic,
+			Format: processor.FormatJSON,
+			SourceInformation: processor.SourceInformation{
+				Collector: GithubActionsCollector,
+				Source:    source,
+			},
+		}
+		select {
+		case docChannel <- doc:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// matchesWorkflow reports whether the statement was produced by the configured workflow.
+// Statements without provenance naming a workflow only match when no filter is set.
+func (g *githubActionsCollector) matchesWorkflow(statement []byte) bool {
+	if g.workflow == "" {
+		return true
+	}
+	var p provenancePredicate
+	if err := json.Unmarshal(statement, &p); err != nil {
+		return false
+	}
+	workflow := p.Predicate.BuildDefinition.ExternalParameters.Workflow.Path
+	if workflow == "" {
+		workflow = p.Predicate.Invocation.ConfigSource.EntryPoint
+	}
+	return workflow == g.workflow || strings.TrimPrefix(workflow, ".github/workflows/") == g.workflow
+}
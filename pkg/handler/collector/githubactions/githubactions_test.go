@@ -0,0 +1,156 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubactions
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+const (
+	releaseStatement = `{"_type":"https://in-toto.io/Statement/v1","predicateType":"https://slsa.dev/provenance/v1","predicate":{"buildDefinition":{"externalParameters":{"workflow":{"path":".github/workflows/release.yml"}}}}}`
+	ciStatement      = `{"_type":"https://in-toto.io/Statement/v0.1","predicateType":"https://slsa.dev/provenance/v0.2","predicate":{"invocation":{"configSource":{"entryPoint":".github/workflows/ci.yml"}}}}`
+)
+
+func attestationServer(t *testing.T, statements ...string) *httptest.Server {
+	body := `{"attestations":[`
+	for i, s := range statements {
+		if i > 0 {
+			body += ","
+		}
+		body += fmt.Sprintf(`{"bundle":{"dsseEnvelope":{"payloadType":"application/vnd.in-toto+json","payload":%q}}}`,
+			base64.StdEncoding.EncodeToString([]byte(s)))
+	}
+	body += `]}`
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/mock/repo/attestations/sha256:abc" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("unexpected authorization header %q", got)
+		}
+		fmt.Fprint(w, body)
+	}))
+}
+
+func Test_githubActionsCollector_RetrieveArtifacts(t *testing.T) {
+	tests := []struct {
+		name     string
+		workflow string
+		digests  []string
+		want     []string
+	}{{
+		name:    "all attestations",
+		digests: []string{"sha256:abc"},
+		want:    []string{releaseStatement, ciStatement},
+	}, {
+		name:     "workflow file name filter",
+		workflow: "release.yml",
+		digests:  []string{"sha256:abc"},
+		want:     []string{releaseStatement},
+	}, {
+		name:     "workflow path filter on v0.2 provenance",
+		workflow: ".github/workflows/ci.yml",
+		digests:  []string{"sha256:abc"},
+		want:     []string{ciStatement},
+	}, {
+		name:    "digest without attestations",
+		digests: []string{"sha256:def"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := attestationServer(t, releaseStatement, ciStatement)
+			defer server.Close()
+
+			g, err := NewGithubActionsCollector(context.Background(), "token", "mock", "repo", tt.workflow, tt.digests, false, 0)
+			if err != nil {
+				t.Fatalf("NewGithubActionsCollector() error = %v", err)
+			}
+			g.apiURL = server.URL
+
+			docChan := make(chan *processor.Document, 10)
+			if err := g.RetrieveArtifacts(context.Background(), docChan); err != nil {
+				t.Fatalf("RetrieveArtifacts() error = %v", err)
+			}
+			close(docChan)
+
+			var got []string
+			for doc := range docChan {
+				if doc.Type != processor.DocumentITE6Generic || doc.Format != processor.FormatJSON {
+					t.Errorf("unexpected document type %v and format %v", doc.Type, doc.Format)
+				}
+				if doc.SourceInformation.Collector != GithubActionsCollector {
+					t.Errorf("unexpected collector %v", doc.SourceInformation.Collector)
+				}
+				got = append(got, string(doc.Blob))
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d documents, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("document %d = %s, want %s", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func Test_githubActionsCollector_Polling(t *testing.T) {
+	server := attestationServer(t, releaseStatement)
+	defer server.Close()
+
+	g, err := NewGithubActionsCollector(context.Background(), "token", "mock", "repo", "", []string{"sha256:abc"}, true, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("NewGithubActionsCollector() error = %v", err)
+	}
+	g.apiURL = server.URL
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	docChan := make(chan *processor.Document, 10)
+	if err := g.RetrieveArtifacts(ctx, docChan); err != context.DeadlineExceeded {
+		t.Errorf("RetrieveArtifacts() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	// the same attestation is only emitted once across polls
+	if len(docChan) != 1 {
+		t.Errorf("got %d documents, want 1", len(docChan))
+	}
+}
+
+func TestNewGithubActionsCollector(t *testing.T) {
+	ctx := context.Background()
+	if _, err := NewGithubActionsCollector(ctx, "token", "", "repo", "", []string{"sha256:abc"}, false, 0); err == nil {
+		t.Errorf("expected error for missing owner")
+	}
+	if _, err := NewGithubActionsCollector(ctx, "token", "mock", "repo", "", nil, false, 0); err == nil {
+		t.Errorf("expected error for missing digests")
+	}
+	if _, err := NewGithubActionsCollector(ctx, "token", "mock", "repo", "", []string{"abc"}, false, 0); err == nil {
+		t.Errorf("expected error for digest without algorithm")
+	}
+	if _, err := NewGithubActionsCollector(ctx, "token", "mock", "repo", "", []string{"sha256:abc"}, true, 0); err == nil {
+		t.Errorf("expected error for polling without interval")
+	}
+}
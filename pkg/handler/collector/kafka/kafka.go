@@ -0,0 +1,174 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/segmentio/kafka-go"
+)
+
+const CollectorKafka = "kafka"
+
+// messageReader is the part of kafka.Reader used by the collector
+type messageReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+type kafkaCollector struct {
+	brokers []string
+	topic   string
+	groupID string
+	tls     *tls.Config
+	reader  messageReader
+}
+
+// NewKafkaCollector initializes a collector that consumes documents from a Kafka
+// topic as a member of a consumer group. Each message holds a processor.Document
+// encoded as JSON, as stored in the blob store by collector.Publish.
+func NewKafkaCollector(opts ...Opt) (*kafkaCollector, error) {
+	k := &kafkaCollector{}
+	for _, opt := range opts {
+		opt(k)
+	}
+
+	if len(k.brokers) == 0 {
+		return nil, errors.New("kafka brokers not specified")
+	}
+	if k.topic == "" {
+		return nil, errors.New("kafka topic not specified")
+	}
+	if k.groupID == "" {
+		return nil, errors.New("kafka consumer group not specified")
+	}
+
+	if k.reader == nil {
+		dialer := &kafka.Dialer{
+			Timeout:   10 * time.Second,
+			DualStack: true,
+			TLS:       k.tls,
+		}
+		k.reader = kafka.NewReader(kafka.ReaderConfig{
+			Brokers: k.brokers,
+			Topic:   k.topic,
+			GroupID: k.groupID,
+			Dialer:  dialer,
+		})
+	}
+	return k, nil
+}
+
+type Opt func(*kafkaCollector)
+
+func WithBrokers(brokers []string) Opt {
+	return func(k *kafkaCollector) {
+		k.brokers = brokers
+	}
+}
+
+func WithTopic(topic string) Opt {
+	return func(k *kafkaCollector) {
+		k.topic = topic
+	}
+}
+
+func WithGroupID(groupID string) Opt {
+	return func(k *kafkaCollector) {
+		k.groupID = groupID
+	}
+}
+
+// WithTLS connects to the brokers over TLS with the given configuration
+func WithTLS(config *tls.Config) Opt {
+	return func(k *kafkaCollector) {
+		k.tls = config
+	}
+}
+
+func withReader(reader messageReader) Opt {
+	return func(k *kafkaCollector) {
+		k.reader = reader
+	}
+}
+
+// Type is the collector type of the collector
+func (k *kafkaCollector) Type() string {
+	return CollectorKafka
+}
+
+// RetrieveArtifacts consumes documents until the context is canceled. The offset of
+// a message is only committed once its document has been sent on docChannel, so
+// that documents are not lost if the collector stops. Messages that are not valid
+// documents are logged and committed so that they are not retried forever. The
+// reader is closed once the context is canceled, and kept open on errors so that
+// the documents can be retrieved again.
+func (k *kafkaCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	err := k.consume(ctx, docChannel)
+	if ctx.Err() != nil {
+		if err := k.reader.Close(); err != nil {
+			logger.Errorf("failed to close kafka reader: %v", err)
+		}
+		return nil
+	}
+	return err
+}
+
+func (k *kafkaCollector) consume(ctx context.Context, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	for {
+		msg, err := k.reader.FetchMessage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to fetch message from kafka topic %s: %w", k.topic, err)
+		}
+
+		doc, err := decodeDocument(msg.Value)
+		if err != nil {
+			logger.Errorf("skipping message at offset %d of partition %d: %v", msg.Offset, msg.Partition, err)
+		} else {
+			select {
+			case docChannel <- doc:
+			case <-ctx.Done():
+				return ctx.Err() // nolint:wrapcheck
+			}
+		}
+
+		if err := k.reader.CommitMessages(ctx, msg); err != nil {
+			return fmt.Errorf("failed to commit offset %d of partition %d: %w", msg.Offset, msg.Partition, err)
+		}
+	}
+}
+
+func decodeDocument(value []byte) (*processor.Document, error) {
+	var doc processor.Document
+	if err := json.Unmarshal(value, &doc); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal document: %w", err)
+	}
+	if len(doc.Blob) == 0 {
+		return nil, errors.New("document has no blob")
+	}
+	doc.ChildLogger = nil
+	return &doc, nil
+}
@@ -0,0 +1,225 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/segmentio/kafka-go"
+)
+
+// fakeReader serves messages in order and blocks once they are exhausted
+type fakeReader struct {
+	mu        sync.Mutex
+	messages  []kafka.Message
+	committed []int64
+	closed    bool
+	fetchErr  error
+}
+
+func (f *fakeReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	f.mu.Lock()
+	if f.fetchErr != nil {
+		f.mu.Unlock()
+		return kafka.Message{}, f.fetchErr
+	}
+	if len(f.messages) > 0 {
+		m := f.messages[0]
+		f.messages = f.messages[1:]
+		f.mu.Unlock()
+		return m, nil
+	}
+	f.mu.Unlock()
+	<-ctx.Done()
+	return kafka.Message{}, ctx.Err()
+}
+
+func (f *fakeReader) CommitMessages(_ context.Context, msgs ...kafka.Message) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, m := range msgs {
+		f.committed = append(f.committed, m.Offset)
+	}
+	return nil
+}
+
+func (f *fakeReader) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	return nil
+}
+
+func (f *fakeReader) committedOffsets() []int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]int64{}, f.committed...)
+}
+
+func message(t *testing.T, offset int64, doc *processor.Document) kafka.Message {
+	t.Helper()
+	b, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("failed to marshal document: %v", err)
+	}
+	return kafka.Message{Offset: offset, Value: b}
+}
+
+func TestNewKafkaCollector(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Opt
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			opts: []Opt{WithBrokers([]string{"localhost:9092"}), WithTopic("guac"), WithGroupID("guac-collector")},
+		},
+		{
+			name:    "no brokers",
+			opts:    []Opt{WithTopic("guac"), WithGroupID("guac-collector")},
+			wantErr: true,
+		},
+		{
+			name:    "no topic",
+			opts:    []Opt{WithBrokers([]string{"localhost:9092"}), WithGroupID("guac-collector")},
+			wantErr: true,
+		},
+		{
+			name:    "no group",
+			opts:    []Opt{WithBrokers([]string{"localhost:9092"}), WithTopic("guac")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := NewKafkaCollector(tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewKafkaCollector() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				if k.Type() != CollectorKafka {
+					t.Errorf("Type() = %s, want %s", k.Type(), CollectorKafka)
+				}
+				_ = k.reader.Close()
+			}
+		})
+	}
+}
+
+func TestRetrieveArtifacts(t *testing.T) {
+	doc1 := &processor.Document{
+		Blob:              []byte(`{"spdxVersion": "SPDX-2.3"}`),
+		Type:              processor.DocumentSPDX,
+		Format:            processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{Collector: "ci", Source: "sbom-1.json"},
+	}
+	doc2 := &processor.Document{
+		Blob:              []byte(`{"bomFormat": "CycloneDX"}`),
+		Type:              processor.DocumentUnknown,
+		SourceInformation: processor.SourceInformation{Collector: "ci", Source: "sbom-2.json"},
+	}
+	reader := &fakeReader{messages: []kafka.Message{
+		message(t, 1, doc1),
+		{Offset: 2, Value: []byte("not a document")},
+		message(t, 3, doc2),
+	}}
+	k, err := NewKafkaCollector(WithBrokers([]string{"localhost:9092"}), WithTopic("guac"), WithGroupID("guac-collector"), withReader(reader))
+	if err != nil {
+		t.Fatalf("NewKafkaCollector() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// unbuffered so that commits can be checked against receives
+	docChan := make(chan *processor.Document)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- k.RetrieveArtifacts(ctx, docChan)
+	}()
+
+	got1 := <-docChan
+	// the collector is blocked sending the second document
+	for _, offset := range reader.committedOffsets() {
+		if offset == 3 {
+			t.Errorf("offset 3 committed before its document was consumed")
+		}
+	}
+	got2 := <-docChan
+	if diff := cmp.Diff([]*processor.Document{doc1, doc2}, []*processor.Document{got1, got2}, cmpopts.IgnoreFields(processor.Document{}, "ChildLogger")); diff != "" {
+		t.Errorf("Unexpected documents (-want +got):\n%s", diff)
+	}
+
+	cancel()
+	if err := <-errChan; err != nil {
+		t.Errorf("RetrieveArtifacts() error = %v", err)
+	}
+	if diff := cmp.Diff([]int64{1, 2, 3}, reader.committedOffsets()); diff != "" {
+		t.Errorf("Unexpected committed offsets (-want +got):\n%s", diff)
+	}
+	if !reader.closed {
+		t.Errorf("reader not closed on cancellation")
+	}
+}
+
+func TestRetrieveArtifactsFetchError(t *testing.T) {
+	reader := &fakeReader{fetchErr: errors.New("broker unavailable")}
+	k, err := NewKafkaCollector(WithBrokers([]string{"localhost:9092"}), WithTopic("guac"), WithGroupID("guac-collector"), withReader(reader))
+	if err != nil {
+		t.Fatalf("NewKafkaCollector() error = %v", err)
+	}
+	if err := k.RetrieveArtifacts(context.Background(), make(chan *processor.Document)); err == nil {
+		t.Errorf("RetrieveArtifacts() expected error")
+	}
+	if reader.closed {
+		t.Fatalf("reader closed on error")
+	}
+
+	// the documents can be retrieved again once the broker is back
+	doc := &processor.Document{
+		Blob:              []byte(`{"spdxVersion": "SPDX-2.3"}`),
+		SourceInformation: processor.SourceInformation{Collector: "ci", Source: "sbom-1.json"},
+	}
+	reader.mu.Lock()
+	reader.fetchErr = nil
+	reader.messages = []kafka.Message{message(t, 1, doc)}
+	reader.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	docChan := make(chan *processor.Document, 1)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- k.RetrieveArtifacts(ctx, docChan)
+	}()
+	if got := <-docChan; got.SourceInformation.Source != "sbom-1.json" {
+		t.Errorf("unexpected document from %s", got.SourceInformation.Source)
+	}
+	cancel()
+	if err := <-errChan; err != nil {
+		t.Errorf("RetrieveArtifacts() error = %v", err)
+	}
+	if !reader.closed {
+		t.Errorf("reader not closed on cancellation")
+	}
+}
@@ -0,0 +1,126 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// CollectorManager runs collectors concurrently and fans the documents they
+// collect into a single buffered channel. When the buffer is full, collectors
+// block until the consumer catches up instead of documents being dropped.
+type CollectorManager struct {
+	collectors []Collector
+	docChan    chan *processor.Document
+
+	mu      sync.Mutex
+	started bool
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	errs    []error
+}
+
+// NewCollectorManager returns a manager for the collectors whose document
+// channel buffers up to bufferSize documents.
+func NewCollectorManager(collectors []Collector, bufferSize int) (*CollectorManager, error) {
+	if bufferSize < 0 {
+		return nil, fmt.Errorf("invalid buffer size: %d", bufferSize)
+	}
+	return &CollectorManager{
+		collectors: collectors,
+		docChan:    make(chan *processor.Document, bufferSize),
+	}, nil
+}
+
+// Documents returns the channel the collected documents are sent to. It is
+// closed once every collector has returned.
+func (m *CollectorManager) Documents() <-chan *processor.Document {
+	return m.docChan
+}
+
+// Start starts each collector in its own goroutine. The collectors run until
+// they return on their own, ctx is done or Drain is called.
+func (m *CollectorManager) Start(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.started {
+		return errors.New("collector manager already started")
+	}
+	m.started = true
+
+	ctx, m.cancel = context.WithCancel(ctx)
+	for _, c := range m.collectors {
+		m.wg.Add(1)
+		go m.run(ctx, c)
+	}
+	go func() {
+		m.wg.Wait()
+		close(m.docChan)
+	}()
+	return nil
+}
+
+// run runs the collector, forwarding its documents to the shared channel.
+// Each collector sends to its own channel so that it never blocks forever on
+// a full shared channel after the manager is drained: documents collected
+// after that are discarded until the collector returns.
+func (m *CollectorManager) run(ctx context.Context, c Collector) {
+	defer m.wg.Done()
+
+	collected := make(chan *processor.Document)
+	forwarded := make(chan struct{})
+	go func() {
+		defer close(forwarded)
+		for d := range collected {
+			select {
+			case m.docChan <- d:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	err := c.RetrieveArtifacts(ctx, collected)
+	close(collected)
+	<-forwarded
+
+	if err != nil && !errors.Is(err, context.Canceled) {
+		m.mu.Lock()
+		m.errs = append(m.errs, fmt.Errorf("collector %s: %w", c.Type(), err))
+		m.mu.Unlock()
+	}
+}
+
+// Drain signals all collectors to stop by canceling their context and waits
+// for them to return. Documents already in the channel can still be read. It
+// returns the errors of the collectors that failed.
+func (m *CollectorManager) Drain() error {
+	m.mu.Lock()
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.mu.Unlock()
+
+	m.wg.Wait()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return errors.Join(m.errs...)
+}
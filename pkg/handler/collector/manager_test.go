@@ -0,0 +1,180 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// fakeCollector sends count documents, or documents until its context is done
+// if count is negative, without checking the context while sending.
+type fakeCollector struct {
+	name  string
+	count int
+	err   error
+	sent  atomic.Int32
+}
+
+func (f *fakeCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	for i := 0; f.count < 0 || i < f.count; i++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		docChannel <- &processor.Document{Blob: []byte(fmt.Sprintf("%s-%d", f.name, i))}
+		f.sent.Add(1)
+	}
+	return f.err
+}
+
+func (f *fakeCollector) Type() string {
+	return f.name
+}
+
+func waitClosed(t *testing.T, docs <-chan *processor.Document) int {
+	t.Helper()
+	n := 0
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case _, ok := <-docs:
+			if !ok {
+				return n
+			}
+			n++
+		case <-timeout:
+			t.Fatalf("document channel not closed")
+		}
+	}
+}
+
+func TestCollectorManager(t *testing.T) {
+	ctx := context.Background()
+	m, err := NewCollectorManager([]Collector{
+		&fakeCollector{name: "a", count: 10},
+		&fakeCollector{name: "b", count: 20},
+	}, 5)
+	if err != nil {
+		t.Fatalf("NewCollectorManager() error = %v", err)
+	}
+	if err := m.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if err := m.Start(ctx); err == nil {
+		t.Errorf("expected error starting the manager twice")
+	}
+
+	got := map[string]bool{}
+	for d := range m.Documents() {
+		got[string(d.Blob)] = true
+	}
+	if len(got) != 30 {
+		t.Errorf("got %d distinct documents, want 30", len(got))
+	}
+	if err := m.Drain(); err != nil {
+		t.Errorf("Drain() error = %v", err)
+	}
+}
+
+func TestCollectorManagerBackpressure(t *testing.T) {
+	ctx := context.Background()
+	c := &fakeCollector{name: "a", count: 10}
+	m, err := NewCollectorManager([]Collector{c}, 2)
+	if err != nil {
+		t.Fatalf("NewCollectorManager() error = %v", err)
+	}
+	if err := m.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	// two documents in the buffer and one held by the forwarder
+	if sent := c.sent.Load(); sent > 3 {
+		t.Errorf("collector sent %d documents to a full buffer", sent)
+	}
+	if n := waitClosed(t, m.Documents()); n != 10 {
+		t.Errorf("got %d documents, want 10", n)
+	}
+	if err := m.Drain(); err != nil {
+		t.Errorf("Drain() error = %v", err)
+	}
+}
+
+func TestCollectorManagerDrain(t *testing.T) {
+	ctx := context.Background()
+	m, err := NewCollectorManager([]Collector{
+		&fakeCollector{name: "endless", count: -1},
+		&fakeCollector{name: "other endless", count: -1},
+	}, 1)
+	if err != nil {
+		t.Fatalf("NewCollectorManager() error = %v", err)
+	}
+	if err := m.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	// nothing reads the documents, so the collectors are blocked sending
+	time.Sleep(50 * time.Millisecond)
+
+	drained := make(chan error)
+	go func() {
+		drained <- m.Drain()
+	}()
+	select {
+	case err := <-drained:
+		if err != nil {
+			t.Errorf("Drain() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Drain() did not return")
+	}
+	// the buffered document can still be read
+	if n := waitClosed(t, m.Documents()); n != 1 {
+		t.Errorf("got %d documents after drain, want 1", n)
+	}
+}
+
+func TestCollectorManagerErrors(t *testing.T) {
+	ctx := context.Background()
+	errCollect := errors.New("collect failed")
+	m, err := NewCollectorManager([]Collector{
+		&fakeCollector{name: "ok", count: 1},
+		&fakeCollector{name: "failing", count: 1, err: errCollect},
+	}, 10)
+	if err != nil {
+		t.Fatalf("NewCollectorManager() error = %v", err)
+	}
+	if err := m.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if n := waitClosed(t, m.Documents()); n != 2 {
+		t.Errorf("got %d documents, want 2", n)
+	}
+	if err := m.Drain(); !errors.Is(err, errCollect) {
+		t.Errorf("Drain() error = %v, want %v", err, errCollect)
+	}
+}
+
+func TestNewCollectorManagerInvalidBufferSize(t *testing.T) {
+	if _, err := NewCollectorManager(nil, -1); err == nil {
+		t.Errorf("expected error for negative buffer size")
+	}
+}
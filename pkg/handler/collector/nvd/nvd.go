@@ -0,0 +1,330 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nvd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	nvdprocessor "github.com/guacsec/guac/pkg/handler/processor/nvd"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/version"
+)
+
+const (
+	NVDCollector = "NVDCollector"
+	// DefaultNVDURL is the NVD CVE API 2.0 endpoint
+	DefaultNVDURL = "https://services.nvd.nist.gov/rest/json/cves/2.0"
+	// DefaultResultsPerPage is the largest page the NVD API returns
+	DefaultResultsPerPage = 2000
+
+	// The NVD allows 5 requests in a rolling 30 second window without an API
+	// key and 50 with one
+	publicRequestInterval = 6 * time.Second
+	apiKeyRequestInterval = 600 * time.Millisecond
+
+	// maxModifiedRange is the longest lastModStartDate to lastModEndDate
+	// range accepted by the NVD API
+	maxModifiedRange = 120 * 24 * time.Hour
+	nvdDateLayout    = "2006-01-02T15:04:05.000-07:00"
+)
+
+type nvdCollector struct {
+	httpClient      *http.Client
+	url             string
+	apiKey          string
+	resultsPerPage  int
+	requestInterval time.Duration
+	poll            bool
+	interval        time.Duration
+
+	// lastModStart is the time of the last successful collection, the CVEs
+	// modified since then are collected next. It is zero until the first
+	// collection, which collects all the CVEs.
+	lastModStart time.Time
+	now          func() time.Time
+}
+
+type Opt func(*nvdCollector)
+
+// NewNVDCollector returns a collector of the CVEs published by the NVD CVE API
+// 2.0. The first collection retrieves every CVE, unless WithLastModStartDate is
+// given, and the following ones only the CVEs modified since the previous one.
+func NewNVDCollector(opts ...Opt) (*nvdCollector, error) {
+	n := &nvdCollector{
+		httpClient:     &http.Client{Transport: version.UATransport},
+		url:            DefaultNVDURL,
+		resultsPerPage: DefaultResultsPerPage,
+		now:            time.Now,
+	}
+	for _, opt := range opts {
+		opt(n)
+	}
+
+	if n.resultsPerPage <= 0 || n.resultsPerPage > DefaultResultsPerPage {
+		return nil, fmt.Errorf("invalid nvd results per page %d, must be between 1 and %d", n.resultsPerPage, DefaultResultsPerPage)
+	}
+	if n.requestInterval == 0 {
+		n.requestInterval = publicRequestInterval
+		if n.apiKey != "" {
+			n.requestInterval = apiKeyRequestInterval
+		}
+	}
+	return n, nil
+}
+
+// WithURL sets the URL of the CVE API, DefaultNVDURL by default
+func WithURL(url string) Opt {
+	return func(n *nvdCollector) {
+		n.url = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithHTTPClient sets the client used for the requests to the CVE API
+func WithHTTPClient(c *http.Client) Opt {
+	return func(n *nvdCollector) {
+		n.httpClient = c
+	}
+}
+
+// WithAPIKey sets the NVD API key sent with the requests, which raises the rate
+// limit of the NVD API
+func WithAPIKey(apiKey string) Opt {
+	return func(n *nvdCollector) {
+		n.apiKey = apiKey
+	}
+}
+
+// WithResultsPerPage sets the number of CVEs requested per page, at most
+// DefaultResultsPerPage
+func WithResultsPerPage(resultsPerPage int) Opt {
+	return func(n *nvdCollector) {
+		n.resultsPerPage = resultsPerPage
+	}
+}
+
+// WithRequestInterval sets the minimum time between two requests, by default
+// the one allowed by the NVD rate limit with or without an API key
+func WithRequestInterval(interval time.Duration) Opt {
+	return func(n *nvdCollector) {
+		n.requestInterval = interval
+	}
+}
+
+// WithLastModStartDate only collects the CVEs modified since start
+func WithLastModStartDate(start time.Time) Opt {
+	return func(n *nvdCollector) {
+		n.lastModStart = start
+	}
+}
+
+func WithPolling(interval time.Duration) Opt {
+	return func(n *nvdCollector) {
+		n.poll = true
+		n.interval = interval
+	}
+}
+
+// RetrieveArtifacts collects the new and modified CVEs once, or on every
+// interval when polling
+func (n *nvdCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	if !n.poll {
+		return n.collect(ctx, docChannel)
+	}
+	logger := logging.FromContext(ctx)
+	for {
+		if err := n.collect(ctx, docChannel); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logger.Errorf("failed to collect from nvd: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(n.interval):
+		}
+	}
+}
+
+// Type returns the collector type
+func (n *nvdCollector) Type() string {
+	return NVDCollector
+}
+
+// cveResponse is a page of the CVE API response. The vulnerabilities are kept
+// as they are to be emitted without losing the fields unknown to GUAC.
+type cveResponse struct {
+	ResultsPerPage  int               `json:"resultsPerPage"`
+	StartIndex      int               `json:"startIndex"`
+	TotalResults    int               `json:"totalResults"`
+	Format          string            `json:"format"`
+	Version         string            `json:"version"`
+	Timestamp       string            `json:"timestamp"`
+	Vulnerabilities []json.RawMessage `json:"vulnerabilities"`
+}
+
+// collect collects the CVEs modified since the last collection, in windows no
+// longer than the NVD API allows, and only moves lastModStart forward once
+// every page of a window has been emitted
+func (n *nvdCollector) collect(ctx context.Context, docChannel chan<- *processor.Document) error {
+	ticker := time.NewTicker(n.requestInterval)
+	defer ticker.Stop()
+	first := true
+	wait := func() error {
+		if first {
+			first = false
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			return nil
+		}
+	}
+
+	end := n.now()
+	if n.lastModStart.IsZero() {
+		if err := n.collectRange(ctx, docChannel, wait, nil); err != nil {
+			return err
+		}
+		n.lastModStart = end
+		return nil
+	}
+	for n.lastModStart.Before(end) {
+		windowEnd := n.lastModStart.Add(maxModifiedRange)
+		if windowEnd.After(end) {
+			windowEnd = end
+		}
+		query := url.Values{}
+		query.Set("lastModStartDate", n.lastModStart.UTC().Format(nvdDateLayout))
+		query.Set("lastModEndDate", windowEnd.UTC().Format(nvdDateLayout))
+		if err := n.collectRange(ctx, docChannel, wait, query); err != nil {
+			return err
+		}
+		n.lastModStart = windowEnd
+	}
+	return nil
+}
+
+// collectRange collects every page of the CVEs matching query
+func (n *nvdCollector) collectRange(ctx context.Context, docChannel chan<- *processor.Document, wait func() error, query url.Values) error {
+	for startIndex := 0; ; {
+		if err := wait(); err != nil {
+			return err
+		}
+		page, err := n.fetchPage(ctx, query, startIndex)
+		if err != nil {
+			return err
+		}
+		for _, vuln := range page.Vulnerabilities {
+			doc, err := n.document(page, vuln)
+			if err != nil {
+				return err
+			}
+			select {
+			case docChannel <- doc:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		startIndex += len(page.Vulnerabilities)
+		if len(page.Vulnerabilities) == 0 || startIndex >= page.TotalResults {
+			return nil
+		}
+	}
+}
+
+func (n *nvdCollector) fetchPage(ctx context.Context, query url.Values, startIndex int) (*cveResponse, error) {
+	params := url.Values{}
+	for k, v := range query {
+		params[k] = v
+	}
+	params.Set("resultsPerPage", strconv.Itoa(n.resultsPerPage))
+	params.Set("startIndex", strconv.Itoa(startIndex))
+	reqURL := n.url + "?" + params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create nvd request: %w", err)
+	}
+	if n.apiKey != "" {
+		req.Header.Set("apiKey", n.apiKey)
+	}
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query nvd: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("nvd returned status %s for start index %d", resp.Status, startIndex)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read nvd response: %w", err)
+	}
+	var page cveResponse
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal nvd response: %w", err)
+	}
+	return &page, nil
+}
+
+// document returns a feed holding only vuln, so that every CVE is processed
+// and ingested on its own
+func (n *nvdCollector) document(page *cveResponse, vuln json.RawMessage) (*processor.Document, error) {
+	var item struct {
+		CVE struct {
+			ID string `json:"id"`
+		} `json:"cve"`
+	}
+	if err := json.Unmarshal(vuln, &item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal nvd vulnerability: %w", err)
+	}
+	format := page.Format
+	if format == "" {
+		format = nvdprocessor.FeedFormat
+	}
+	blob, err := json.Marshal(cveResponse{
+		ResultsPerPage:  1,
+		TotalResults:    1,
+		Format:          format,
+		Version:         page.Version,
+		Timestamp:       page.Timestamp,
+		Vulnerabilities: []json.RawMessage{vuln},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal nvd document for %s: %w", item.CVE.ID, err)
+	}
+	return &processor.Document{
+		Blob:   blob,
+		Type:   processor.DocumentNVD,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector: NVDCollector,
+			Source:    n.url + "?cveId=" + url.QueryEscape(item.CVE.ID),
+		},
+	}, nil
+}
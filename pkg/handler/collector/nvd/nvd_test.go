@@ -0,0 +1,230 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nvd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	nvdprocessor "github.com/guacsec/guac/pkg/handler/processor/nvd"
+)
+
+// fakeNVD serves the CVE API for a list of CVE IDs and records the queries
+type fakeNVD struct {
+	mu      sync.Mutex
+	cves    []string
+	queries []url.Values
+	apiKeys []string
+}
+
+func (f *fakeNVD) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	query := r.URL.Query()
+	f.queries = append(f.queries, query)
+	f.apiKeys = append(f.apiKeys, r.Header.Get("apiKey"))
+
+	start, _ := strconv.Atoi(query.Get("startIndex"))
+	perPage, _ := strconv.Atoi(query.Get("resultsPerPage"))
+	end := start + perPage
+	if end > len(f.cves) {
+		end = len(f.cves)
+	}
+	var vulns []json.RawMessage
+	for _, id := range f.cves[start:end] {
+		vulns = append(vulns, json.RawMessage(fmt.Sprintf(`{"cve":{"id":%q,"sourceIdentifier":"cve@mitre.org"}}`, id)))
+	}
+	_ = json.NewEncoder(w).Encode(cveResponse{
+		ResultsPerPage:  len(vulns),
+		StartIndex:      start,
+		TotalResults:    len(f.cves),
+		Format:          nvdprocessor.FeedFormat,
+		Version:         "2.0",
+		Timestamp:       "2024-05-01T00:00:00.000",
+		Vulnerabilities: vulns,
+	})
+}
+
+func collectIDs(t *testing.T, c *nvdCollector) []string {
+	t.Helper()
+	docChan := make(chan *processor.Document, 100)
+	if err := c.RetrieveArtifacts(context.Background(), docChan); err != nil {
+		t.Fatalf("RetrieveArtifacts() error = %v", err)
+	}
+	close(docChan)
+	var ids []string
+	for doc := range docChan {
+		if doc.Type != processor.DocumentNVD || doc.Format != processor.FormatJSON || doc.SourceInformation.Collector != NVDCollector {
+			t.Errorf("unexpected document %+v", doc)
+		}
+		var feed nvdprocessor.Feed
+		if err := json.Unmarshal(doc.Blob, &feed); err != nil {
+			t.Fatalf("failed to unmarshal document: %v", err)
+		}
+		if feed.Format != nvdprocessor.FeedFormat || len(feed.Vulnerabilities) != 1 {
+			t.Fatalf("unexpected feed %+v", feed)
+		}
+		ids = append(ids, feed.Vulnerabilities[0].CVE.ID)
+	}
+	return ids
+}
+
+func TestNewNVDCollector(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         []Opt
+		wantInterval time.Duration
+		wantErr      bool
+	}{{
+		name:         "defaults",
+		wantInterval: publicRequestInterval,
+	}, {
+		name:         "api key",
+		opts:         []Opt{WithAPIKey("key")},
+		wantInterval: apiKeyRequestInterval,
+	}, {
+		name:         "request interval",
+		opts:         []Opt{WithAPIKey("key"), WithRequestInterval(time.Second)},
+		wantInterval: time.Second,
+	}, {
+		name:    "too many results per page",
+		opts:    []Opt{WithResultsPerPage(DefaultResultsPerPage + 1)},
+		wantErr: true,
+	}, {
+		name:    "no results per page",
+		opts:    []Opt{WithResultsPerPage(0)},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewNVDCollector(tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewNVDCollector() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && c.requestInterval != tt.wantInterval {
+				t.Errorf("requestInterval = %v, want %v", c.requestInterval, tt.wantInterval)
+			}
+		})
+	}
+}
+
+func Test_nvdCollector_RetrieveArtifacts(t *testing.T) {
+	fake := &fakeNVD{cves: []string{"CVE-2024-0001", "CVE-2024-0002", "CVE-2024-0003", "CVE-2024-0004", "CVE-2024-0005"}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	c, err := NewNVDCollector(WithURL(server.URL), WithAPIKey("secret"), WithResultsPerPage(2), WithRequestInterval(time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.now = func() time.Time { return now }
+
+	if diff := cmp.Diff(fake.cves, collectIDs(t, c)); diff != "" {
+		t.Errorf("first collection (-want +got):\n%s", diff)
+	}
+	if len(fake.queries) != 3 {
+		t.Fatalf("got %d requests, want 3 pages", len(fake.queries))
+	}
+	for i, q := range fake.queries {
+		if got, want := q.Get("startIndex"), strconv.Itoa(2*i); got != want {
+			t.Errorf("request %d startIndex = %s, want %s", i, got, want)
+		}
+		if q.Get("lastModStartDate") != "" {
+			t.Errorf("request %d of the first collection has lastModStartDate %s", i, q.Get("lastModStartDate"))
+		}
+		if fake.apiKeys[i] != "secret" {
+			t.Errorf("request %d apiKey = %q", i, fake.apiKeys[i])
+		}
+	}
+
+	// the next collection only asks for the CVEs modified since the first one
+	fake.queries = nil
+	fake.cves = []string{"CVE-2024-0006"}
+	now = now.Add(time.Hour)
+	if diff := cmp.Diff([]string{"CVE-2024-0006"}, collectIDs(t, c)); diff != "" {
+		t.Errorf("second collection (-want +got):\n%s", diff)
+	}
+	if len(fake.queries) != 1 {
+		t.Fatalf("got %d requests, want 1", len(fake.queries))
+	}
+	if got, want := fake.queries[0].Get("lastModStartDate"), "2024-05-01T12:00:00.000+00:00"; got != want {
+		t.Errorf("lastModStartDate = %s, want %s", got, want)
+	}
+	if got, want := fake.queries[0].Get("lastModEndDate"), "2024-05-01T13:00:00.000+00:00"; got != want {
+		t.Errorf("lastModEndDate = %s, want %s", got, want)
+	}
+}
+
+func Test_nvdCollector_modifiedRangeWindows(t *testing.T) {
+	fake := &fakeNVD{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c, err := NewNVDCollector(WithURL(server.URL), WithLastModStartDate(start), WithRequestInterval(time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.now = func() time.Time { return start.Add(200 * 24 * time.Hour) }
+
+	if ids := collectIDs(t, c); len(ids) != 0 {
+		t.Errorf("got CVEs %v, want none", ids)
+	}
+	want := [][2]string{
+		{"2024-01-01T00:00:00.000+00:00", "2024-04-30T00:00:00.000+00:00"},
+		{"2024-04-30T00:00:00.000+00:00", "2024-07-19T00:00:00.000+00:00"},
+	}
+	var got [][2]string
+	for _, q := range fake.queries {
+		got = append(got, [2]string{q.Get("lastModStartDate"), q.Get("lastModEndDate")})
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("modified ranges (-want +got):\n%s", diff)
+	}
+	if !c.lastModStart.Equal(start.Add(200 * 24 * time.Hour)) {
+		t.Errorf("lastModStart = %v", c.lastModStart)
+	}
+}
+
+func Test_nvdCollector_errorKeepsLastModStart(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c, err := NewNVDCollector(WithURL(server.URL), WithLastModStartDate(start), WithRequestInterval(time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.RetrieveArtifacts(context.Background(), make(chan *processor.Document, 1)); err == nil {
+		t.Fatal("expected an error")
+	}
+	if !c.lastModStart.Equal(start) {
+		t.Errorf("lastModStart = %v, want %v", c.lastModStart, start)
+	}
+}
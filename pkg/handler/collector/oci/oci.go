@@ -41,8 +41,9 @@ const (
 
 // OCI artifact types
 const (
-	SpdxJson   = "application/spdx+json"
-	InTotoJson = "application/vnd.in-toto+json"
+	SpdxJson      = "application/spdx+json"
+	CycloneDxJson = "application/vnd.cyclonedx+json"
+	InTotoJson    = "application/vnd.in-toto+json"
 )
 
 // wellKnownOCIArtifactTypes is a map of OCI media types to document type and format
@@ -55,6 +56,10 @@ var wellKnownOCIArtifactTypes = map[string]struct {
 		documentType: processor.DocumentSPDX,
 		formatType:   processor.FormatJSON,
 	},
+	CycloneDxJson: {
+		documentType: processor.DocumentCycloneDX,
+		formatType:   processor.FormatJSON,
+	},
 	InTotoJson: {
 		documentType: processor.DocumentITE6SLSA,
 		formatType:   processor.FormatJSON,
@@ -69,6 +74,7 @@ type ociCollector struct {
 	checkedDigest     sync.Map
 	poll              bool
 	interval          time.Duration
+	referrerTypes     map[string]bool
 }
 
 // NewOCICollector initializes the oci collector by passing in the repo and tag being collected.
@@ -76,12 +82,23 @@ type ociCollector struct {
 // repos in a given registry. For further details see issue #298
 //
 // Interval should be set to about 5 mins or more for production so that it doesn't clobber registries.
-func NewOCICollector(ctx context.Context, collectDataSource datasource.CollectSource, poll bool, interval time.Duration) *ociCollector {
+//
+// referrerTypes restricts the referrer artifacts collected to the given artifact types. When empty, all
+// well known artifact types (SPDX, CycloneDX and in-toto) are collected.
+func NewOCICollector(ctx context.Context, collectDataSource datasource.CollectSource, poll bool, interval time.Duration, referrerTypes []string) *ociCollector {
+	var types map[string]bool
+	if len(referrerTypes) > 0 {
+		types = map[string]bool{}
+		for _, t := range referrerTypes {
+			types[t] = true
+		}
+	}
 	return &ociCollector{
 		collectDataSource: collectDataSource,
 		checkedDigest:     sync.Map{},
 		poll:              poll,
 		interval:          interval,
+		referrerTypes:     types,
 	}
 }
 
@@ -320,7 +337,7 @@ func (o *ociCollector) fetchFallbackArtifacts(ctx context.Context, repo string,
 		// check to see if the digest + suffix has already been collected
 		if !o.isDigestCollected(repo, digestTag) {
 			imageTag := fmt.Sprintf("%v:%v", repo, digestTag)
-			err := fetchOCIArtifactBlobs(ctx, rc, imageTag, "unknown", docChannel)
+			err := fetchOCIArtifactBlobs(ctx, rc, imageTag, "unknown", "", docChannel)
 			if err != nil {
 				return fmt.Errorf("failed retrieving artifact blobs from registry fallback artifacts: %w", err)
 			}
@@ -357,15 +374,15 @@ func (o *ociCollector) fetchReferrerArtifacts(ctx context.Context, repo string,
 		wg.Add(1)
 		go func(referrerDesc descriptor.Descriptor) {
 			defer wg.Done() // Decrement the WaitGroup counter when done
-			if _, ok := wellKnownOCIArtifactTypes[referrerDesc.ArtifactType]; ok {
+			if o.collectReferrerType(referrerDesc.ArtifactType) {
 				referrerDescDigest := referrerDesc.Digest.String()
 
 				if !o.isDigestCollected(repo, referrerDescDigest) {
 					logger.Infof("Fetching referrer %s with artifact type %s", referrerDescDigest, referrerDesc.ArtifactType)
 					referrerDigest := fmt.Sprintf("%v@%v", repo, referrerDescDigest)
-					e := fetchOCIArtifactBlobs(ctx, rc, referrerDigest, referrerDesc.ArtifactType, docChannel)
+					e := fetchOCIArtifactBlobs(ctx, rc, referrerDigest, referrerDesc.ArtifactType, referrerDescDigest, docChannel)
 					if e != nil {
-						errorChan <- fmt.Errorf("failed retrieving artifact blobs from registry: %w", err)
+						errorChan <- fmt.Errorf("failed retrieving artifact blobs from registry: %w", e)
 						cancel()
 						return
 					}
@@ -394,11 +411,21 @@ func (o *ociCollector) fetchReferrerArtifacts(ctx context.Context, repo string,
 	return nil
 }
 
+// collectReferrerType reports whether referrers with the given artifact type should be collected.
+func (o *ociCollector) collectReferrerType(artifactType string) bool {
+	if o.referrerTypes != nil {
+		return o.referrerTypes[artifactType]
+	}
+	_, ok := wellKnownOCIArtifactTypes[artifactType]
+	return ok
+}
+
 // fetchOCIArtifactBlobs fetches the blobs of an OCI artifact and sends them to the provided docChannel.
-// It takes a context.Context, a *regclient.RegClient, an artifact string, an artifactType string, and a docChannel chan<- *processor.Document as input.
+// It takes a context.Context, a *regclient.RegClient, an artifact string, an artifactType string, the artifact digest
+// used as the document reference (empty if unknown), and a docChannel chan<- *processor.Document as input.
 // Note that we are not concurrently fetching the layers since we will usually have 1 layer per artifact.
 // It returns an error if there was an issue fetching the artifact blobs.
-func fetchOCIArtifactBlobs(ctx context.Context, rc *regclient.RegClient, artifact string, artifactType string, docChannel chan<- *processor.Document) error {
+func fetchOCIArtifactBlobs(ctx context.Context, rc *regclient.RegClient, artifact string, artifactType string, artifactDigest string, docChannel chan<- *processor.Document) error {
 	logger := logging.FromContext(ctx)
 	r, err := ref.New(artifact)
 	if err != nil {
@@ -455,8 +482,9 @@ func fetchOCIArtifactBlobs(ctx context.Context, rc *regclient.RegClient, artifac
 			Type:   docType,
 			Format: docFormat,
 			SourceInformation: processor.SourceInformation{
-				Collector: string(OCICollector),
-				Source:    artifact,
+				Collector:   string(OCICollector),
+				Source:      artifact,
+				DocumentRef: artifactDigest,
 			},
 		}
 		docChannel <- doc
@@ -128,8 +128,9 @@ func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
 				Type:   processor.DocumentITE6SLSA,
 				Format: processor.FormatJSON,
 				SourceInformation: processor.SourceInformation{
-					Collector: string(OCICollector),
-					Source:    "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:395fbca091afd8cd7406a3b9815c2c4054bd06331f7a2a6b2e48798a5365d4f6",
+					Collector:   string(OCICollector),
+					Source:      "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:395fbca091afd8cd7406a3b9815c2c4054bd06331f7a2a6b2e48798a5365d4f6",
+					DocumentRef: "sha256:395fbca091afd8cd7406a3b9815c2c4054bd06331f7a2a6b2e48798a5365d4f6",
 				},
 			},
 			{
@@ -137,8 +138,9 @@ func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
 				Type:   processor.DocumentSPDX,
 				Format: processor.FormatJSON,
 				SourceInformation: processor.SourceInformation{
-					Collector: string(OCICollector),
-					Source:    "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:dc365ff2a58436089f1dfcc2dcbf4699a3b9bbb596f5485b70cc75cbd309f020",
+					Collector:   string(OCICollector),
+					Source:      "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:dc365ff2a58436089f1dfcc2dcbf4699a3b9bbb596f5485b70cc75cbd309f020",
+					DocumentRef: "sha256:dc365ff2a58436089f1dfcc2dcbf4699a3b9bbb596f5485b70cc75cbd309f020",
 				},
 			},
 			{
@@ -146,8 +148,9 @@ func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
 				Type:   processor.DocumentSPDX,
 				Format: processor.FormatJSON,
 				SourceInformation: processor.SourceInformation{
-					Collector: string(OCICollector),
-					Source:    "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:8e20922c8c2c4172879ff18b85e9de011e7bc5d8a93aab8244b8834e6e471e29",
+					Collector:   string(OCICollector),
+					Source:      "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:8e20922c8c2c4172879ff18b85e9de011e7bc5d8a93aab8244b8834e6e471e29",
+					DocumentRef: "sha256:8e20922c8c2c4172879ff18b85e9de011e7bc5d8a93aab8244b8834e6e471e29",
 				},
 			},
 			{
@@ -155,8 +158,9 @@ func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
 				Type:   processor.DocumentITE6SLSA,
 				Format: processor.FormatJSON,
 				SourceInformation: processor.SourceInformation{
-					Collector: string(OCICollector),
-					Source:    "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:988bec89fa63b1eed4c804e4d8acb7073a97ede89c86768193bca408faad3ed6",
+					Collector:   string(OCICollector),
+					Source:      "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:988bec89fa63b1eed4c804e4d8acb7073a97ede89c86768193bca408faad3ed6",
+					DocumentRef: "sha256:988bec89fa63b1eed4c804e4d8acb7073a97ede89c86768193bca408faad3ed6",
 				},
 			},
 			{
@@ -164,8 +168,9 @@ func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
 				Type:   processor.DocumentSPDX,
 				Format: processor.FormatJSON,
 				SourceInformation: processor.SourceInformation{
-					Collector: string(OCICollector),
-					Source:    "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:264ad11a504bc570eadd7e75c3eeb6432638ecb65ab5203a5b464218dba5b63e",
+					Collector:   string(OCICollector),
+					Source:      "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:264ad11a504bc570eadd7e75c3eeb6432638ecb65ab5203a5b464218dba5b63e",
+					DocumentRef: "sha256:264ad11a504bc570eadd7e75c3eeb6432638ecb65ab5203a5b464218dba5b63e",
 				},
 			},
 			{
@@ -173,8 +178,9 @@ func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
 				Type:   processor.DocumentITE6SLSA,
 				Format: processor.FormatJSON,
 				SourceInformation: processor.SourceInformation{
-					Collector: string(OCICollector),
-					Source:    "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:884e5fd916210a1f2fe371fe7e35812e7770787e292134aba104ed1b10d721e9",
+					Collector:   string(OCICollector),
+					Source:      "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:884e5fd916210a1f2fe371fe7e35812e7770787e292134aba104ed1b10d721e9",
+					DocumentRef: "sha256:884e5fd916210a1f2fe371fe7e35812e7770787e292134aba104ed1b10d721e9",
 				},
 			},
 			{
@@ -182,8 +188,9 @@ func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
 				Type:   processor.DocumentSPDX,
 				Format: processor.FormatJSON,
 				SourceInformation: processor.SourceInformation{
-					Collector: string(OCICollector),
-					Source:    "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:3c48cc52d341cc6a57fcf25225b1d669fbdd32d8942fe669495e6f88de948597",
+					Collector:   string(OCICollector),
+					Source:      "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:3c48cc52d341cc6a57fcf25225b1d669fbdd32d8942fe669495e6f88de948597",
+					DocumentRef: "sha256:3c48cc52d341cc6a57fcf25225b1d669fbdd32d8942fe669495e6f88de948597",
 				},
 			},
 			{
@@ -191,8 +198,9 @@ func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
 				Type:   processor.DocumentSPDX,
 				Format: processor.FormatJSON,
 				SourceInformation: processor.SourceInformation{
-					Collector: string(OCICollector),
-					Source:    "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:ff231891d6af8af7c5e90b3647a262bd4a8013c74b99dae4a171ccc5480e60c2",
+					Collector:   string(OCICollector),
+					Source:      "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:ff231891d6af8af7c5e90b3647a262bd4a8013c74b99dae4a171ccc5480e60c2",
+					DocumentRef: "sha256:ff231891d6af8af7c5e90b3647a262bd4a8013c74b99dae4a171ccc5480e60c2",
 				},
 			},
 			{
@@ -200,8 +208,9 @@ func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
 				Type:   processor.DocumentITE6SLSA,
 				Format: processor.FormatJSON,
 				SourceInformation: processor.SourceInformation{
-					Collector: string(OCICollector),
-					Source:    "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:61715e8b3d8577fc8fcc54335d4f726803f806475239899498a96170d062b6ea",
+					Collector:   string(OCICollector),
+					Source:      "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:61715e8b3d8577fc8fcc54335d4f726803f806475239899498a96170d062b6ea",
+					DocumentRef: "sha256:61715e8b3d8577fc8fcc54335d4f726803f806475239899498a96170d062b6ea",
 				},
 			},
 			{
@@ -209,8 +218,9 @@ func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
 				Type:   processor.DocumentITE6SLSA,
 				Format: processor.FormatJSON,
 				SourceInformation: processor.SourceInformation{
-					Collector: string(OCICollector),
-					Source:    "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:1b7421f29020b2ff35190e26da222e379c14b457fe23a83be7c2ed44a57c490c",
+					Collector:   string(OCICollector),
+					Source:      "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:1b7421f29020b2ff35190e26da222e379c14b457fe23a83be7c2ed44a57c490c",
+					DocumentRef: "sha256:1b7421f29020b2ff35190e26da222e379c14b457fe23a83be7c2ed44a57c490c",
 				},
 			},
 			{
@@ -218,8 +228,9 @@ func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
 				Type:   processor.DocumentITE6SLSA,
 				Format: processor.FormatJSON,
 				SourceInformation: processor.SourceInformation{
-					Collector: string(OCICollector),
-					Source:    "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:30019e253ab74eb3e38abae7b8997e8e60c420169044ca9bfaf9665f54ad18bc",
+					Collector:   string(OCICollector),
+					Source:      "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:30019e253ab74eb3e38abae7b8997e8e60c420169044ca9bfaf9665f54ad18bc",
+					DocumentRef: "sha256:30019e253ab74eb3e38abae7b8997e8e60c420169044ca9bfaf9665f54ad18bc",
 				},
 			},
 			{
@@ -227,8 +238,9 @@ func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
 				Type:   processor.DocumentSPDX,
 				Format: processor.FormatJSON,
 				SourceInformation: processor.SourceInformation{
-					Collector: string(OCICollector),
-					Source:    "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:8035089a59a6f8577255f494c1ced250e1206667d8462869fc0deeca98d79427",
+					Collector:   string(OCICollector),
+					Source:      "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:8035089a59a6f8577255f494c1ced250e1206667d8462869fc0deeca98d79427",
+					DocumentRef: "sha256:8035089a59a6f8577255f494c1ced250e1206667d8462869fc0deeca98d79427",
 				},
 			},
 			{
@@ -236,8 +248,9 @@ func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
 				Type:   processor.DocumentSPDX,
 				Format: processor.FormatJSON,
 				SourceInformation: processor.SourceInformation{
-					Collector: string(OCICollector),
-					Source:    "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:78efdf2e0abe78a6964b8b5cbcdbfe4496f4564b227992806edd1cac57b68db2",
+					Collector:   string(OCICollector),
+					Source:      "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:78efdf2e0abe78a6964b8b5cbcdbfe4496f4564b227992806edd1cac57b68db2",
+					DocumentRef: "sha256:78efdf2e0abe78a6964b8b5cbcdbfe4496f4564b227992806edd1cac57b68db2",
 				},
 			},
 			{
@@ -245,8 +258,9 @@ func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
 				Type:   processor.DocumentSPDX,
 				Format: processor.FormatJSON,
 				SourceInformation: processor.SourceInformation{
-					Collector: string(OCICollector),
-					Source:    "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:64f5e3b86c83acef2fb79e359a942bd2290e30773269f532856421db4e75cc30",
+					Collector:   string(OCICollector),
+					Source:      "mcr.microsoft.com/oss/kubernetes/kubectl@sha256:64f5e3b86c83acef2fb79e359a942bd2290e30773269f532856421db4e75cc30",
+					DocumentRef: "sha256:64f5e3b86c83acef2fb79e359a942bd2290e30773269f532856421db4e75cc30",
 				},
 			},
 		}}, {
@@ -353,7 +367,7 @@ func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			g := NewOCICollector(ctx, toDataSource(tt.fields.ociValues), tt.fields.poll, tt.fields.interval)
+			g := NewOCICollector(ctx, toDataSource(tt.fields.ociValues), tt.fields.poll, tt.fields.interval, nil)
 
 			var cancel context.CancelFunc
 			if tt.fields.poll {
@@ -433,3 +447,38 @@ func toDataSource(ociValues []string) datasource.CollectSource {
 	}
 	return ds
 }
+
+func Test_ociCollector_collectReferrerType(t *testing.T) {
+	tests := []struct {
+		name          string
+		referrerTypes []string
+		artifactType  string
+		want          bool
+	}{{
+		name:         "well known type by default",
+		artifactType: CycloneDxJson,
+		want:         true,
+	}, {
+		name:         "unknown type skipped by default",
+		artifactType: "application/vnd.example+json",
+		want:         false,
+	}, {
+		name:          "configured type",
+		referrerTypes: []string{"application/vnd.example+json"},
+		artifactType:  "application/vnd.example+json",
+		want:          true,
+	}, {
+		name:          "well known type not configured",
+		referrerTypes: []string{SpdxJson},
+		artifactType:  InTotoJson,
+		want:          false,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewOCICollector(context.Background(), toDataSource(nil), false, 0, tt.referrerTypes)
+			if got := o.collectReferrerType(tt.artifactType); got != tt.want {
+				t.Errorf("collectReferrerType(%q) = %v, want %v", tt.artifactType, got, tt.want)
+			}
+		})
+	}
+}
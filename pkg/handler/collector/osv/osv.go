@@ -0,0 +1,281 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/version"
+)
+
+const (
+	OSVCollector = "OSVCollector"
+	// DefaultOSVURL is the public HTTP endpoint of the gs://osv-vulnerabilities
+	// data dump
+	DefaultOSVURL = "https://osv-vulnerabilities.storage.googleapis.com"
+	// modifiedIndex lists the "<modified>,<id>" of the entries of an
+	// ecosystem, or "<modified>,<ecosystem>/<id>" at the root of the dump
+	modifiedIndex = "modified_id.csv"
+)
+
+type osvCollector struct {
+	httpClient *http.Client
+	url        string
+	ecosystems []string
+	poll       bool
+	interval   time.Duration
+
+	// lastModified is the modified time of the newest entry collected, per
+	// ecosystem, or for all of them under ""
+	lastModified map[string]time.Time
+}
+
+type Opt func(*osvCollector)
+
+// NewOSVCollector returns a collector of the OSV entries of the ecosystems given
+// with WithEcosystems, or of every ecosystem. The first collection retrieves
+// every entry, unless WithModifiedSince is given, and the following ones only
+// the entries modified since.
+func NewOSVCollector(opts ...Opt) (*osvCollector, error) {
+	o := &osvCollector{
+		httpClient:   &http.Client{Transport: version.UATransport},
+		url:          DefaultOSVURL,
+		lastModified: map[string]time.Time{},
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	for _, ecosystem := range o.ecosystems {
+		if ecosystem == "" || strings.Contains(ecosystem, "/") {
+			return nil, fmt.Errorf("invalid OSV ecosystem %q", ecosystem)
+		}
+	}
+	if len(o.ecosystems) == 0 {
+		o.ecosystems = []string{""}
+	}
+	return o, nil
+}
+
+// WithURL sets the URL of the OSV data dump, DefaultOSVURL by default
+func WithURL(url string) Opt {
+	return func(o *osvCollector) {
+		o.url = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithHTTPClient sets the client used for the requests to the data dump
+func WithHTTPClient(c *http.Client) Opt {
+	return func(o *osvCollector) {
+		o.httpClient = c
+	}
+}
+
+// WithEcosystems only collects the entries of the ecosystems, named as in the
+// data dump such as "PyPI", "npm" or "Go"
+func WithEcosystems(ecosystems ...string) Opt {
+	return func(o *osvCollector) {
+		o.ecosystems = ecosystems
+	}
+}
+
+// WithModifiedSince only collects the entries modified after since
+func WithModifiedSince(since time.Time) Opt {
+	return func(o *osvCollector) {
+		o.lastModified[""] = since
+	}
+}
+
+func WithPolling(interval time.Duration) Opt {
+	return func(o *osvCollector) {
+		o.poll = true
+		o.interval = interval
+	}
+}
+
+// RetrieveArtifacts collects the new and modified entries once, or on every
+// interval when polling
+func (o *osvCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	if !o.poll {
+		return o.collect(ctx, docChannel)
+	}
+	logger := logging.FromContext(ctx)
+	for {
+		if err := o.collect(ctx, docChannel); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logger.Errorf("failed to collect from osv: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(o.interval):
+		}
+	}
+}
+
+// Type returns the collector type
+func (o *osvCollector) Type() string {
+	return OSVCollector
+}
+
+// modifiedEntry is an entry of the modified index, path being relative to the
+// root of the data dump
+type modifiedEntry struct {
+	modified time.Time
+	path     string
+}
+
+func (o *osvCollector) collect(ctx context.Context, docChannel chan<- *processor.Document) error {
+	var errs []error
+	for _, ecosystem := range o.ecosystems {
+		if err := o.collectEcosystem(ctx, docChannel, ecosystem); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// collectEcosystem emits the entries modified since the last collection, oldest
+// first, so that an interrupted collection resumes after the last entry emitted
+func (o *osvCollector) collectEcosystem(ctx context.Context, docChannel chan<- *processor.Document, ecosystem string) error {
+	since, ok := o.lastModified[ecosystem]
+	if !ok {
+		since = o.lastModified[""]
+	}
+	entries, err := o.modifiedSince(ctx, ecosystem, since)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		doc, err := o.fetchEntry(ctx, entry.path)
+		if err != nil {
+			return err
+		}
+		select {
+		case docChannel <- doc:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		o.lastModified[ecosystem] = entry.modified
+	}
+	return nil
+}
+
+// modifiedSince returns the entries of the modified index of the ecosystem, or
+// of the root for "", modified after since, sorted by modified time
+func (o *osvCollector) modifiedSince(ctx context.Context, ecosystem string, since time.Time) ([]modifiedEntry, error) {
+	indexPath := modifiedIndex
+	if ecosystem != "" {
+		indexPath = ecosystem + "/" + modifiedIndex
+	}
+	body, err := o.get(ctx, indexPath)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var entries []modifiedEntry
+	reader := csv.NewReader(body)
+	reader.FieldsPerRecord = 2
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", indexPath, err)
+		}
+		modified, err := time.Parse(time.RFC3339, record[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse modified time in %s: %w", indexPath, err)
+		}
+		if !modified.After(since) {
+			continue
+		}
+		path := record[1] + ".json"
+		if ecosystem != "" {
+			path = ecosystem + "/" + path
+		}
+		entries = append(entries, modifiedEntry{modified: modified, path: path})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].modified.Before(entries[j].modified)
+	})
+	return entries, nil
+}
+
+func (o *osvCollector) fetchEntry(ctx context.Context, path string) (*processor.Document, error) {
+	body, err := o.get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	blob, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read osv entry %s: %w", path, err)
+	}
+	return &processor.Document{
+		Blob:   blob,
+		Type:   processor.DocumentOSV,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector: OSVCollector,
+			Source:    o.entryURL(path),
+		},
+	}, nil
+}
+
+func (o *osvCollector) get(ctx context.Context, path string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.entryURL(path), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create osv request: %w", err)
+	}
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s from osv: %w", path, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("osv returned status %s for %s", resp.Status, path)
+	}
+	return resp.Body, nil
+}
+
+// entryURL escapes the path, since ecosystem names such as "GitHub Actions"
+// contain spaces
+func (o *osvCollector) entryURL(path string) string {
+	segments := strings.Split(path, "/")
+	for i := range segments {
+		segments[i] = url.PathEscape(segments[i])
+	}
+	return o.url + "/" + strings.Join(segments, "/")
+}
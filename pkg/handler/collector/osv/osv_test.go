@@ -0,0 +1,184 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// fakeDump serves an OSV data dump from the files, keyed by their unescaped
+// path, and records the paths requested
+type fakeDump struct {
+	mu        sync.Mutex
+	files     map[string]string
+	requested []string
+}
+
+func (f *fakeDump) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requested = append(f.requested, r.URL.Path)
+	content, ok := f.files[r.URL.Path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	_, _ = w.Write([]byte(content))
+}
+
+func entry(id, modified string) string {
+	return fmt.Sprintf(`{"id":%q,"modified":%q}`, id, modified)
+}
+
+func collectSources(t *testing.T, o *osvCollector, wantErr bool) []string {
+	t.Helper()
+	docChan := make(chan *processor.Document, 100)
+	err := o.RetrieveArtifacts(context.Background(), docChan)
+	if (err != nil) != wantErr {
+		t.Fatalf("RetrieveArtifacts() error = %v, wantErr %v", err, wantErr)
+	}
+	close(docChan)
+	var sources []string
+	for doc := range docChan {
+		if doc.Type != processor.DocumentOSV || doc.Format != processor.FormatJSON || doc.SourceInformation.Collector != OSVCollector {
+			t.Errorf("unexpected document %+v", doc)
+		}
+		sources = append(sources, doc.SourceInformation.Source)
+	}
+	return sources
+}
+
+func TestNewOSVCollector(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Opt
+		want    []string
+		wantErr bool
+	}{{
+		name: "all ecosystems",
+		want: []string{""},
+	}, {
+		name: "ecosystems",
+		opts: []Opt{WithEcosystems("PyPI", "npm", "Go")},
+		want: []string{"PyPI", "npm", "Go"},
+	}, {
+		name:    "empty ecosystem",
+		opts:    []Opt{WithEcosystems("")},
+		wantErr: true,
+	}, {
+		name:    "ecosystem path",
+		opts:    []Opt{WithEcosystems("PyPI/GHSA-1")},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o, err := NewOSVCollector(tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewOSVCollector() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, o.ecosystems); diff != "" {
+				t.Errorf("ecosystems (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_osvCollector_RetrieveArtifacts(t *testing.T) {
+	dump := &fakeDump{files: map[string]string{
+		"/modified_id.csv": "2024-03-02T00:00:00Z,PyPI/PYSEC-2024-2\n" +
+			"2024-03-01T00:00:00Z,GitHub Actions/GHSA-aaaa-bbbb-cccc\n" +
+			"2024-01-01T00:00:00Z,PyPI/PYSEC-2024-1\n",
+		"/PyPI/modified_id.csv":                    "2024-03-02T00:00:00Z,PYSEC-2024-2\n2024-01-01T00:00:00Z,PYSEC-2024-1\n",
+		"/PyPI/PYSEC-2024-1.json":                  entry("PYSEC-2024-1", "2024-01-01T00:00:00Z"),
+		"/PyPI/PYSEC-2024-2.json":                  entry("PYSEC-2024-2", "2024-03-02T00:00:00Z"),
+		"/GitHub Actions/GHSA-aaaa-bbbb-cccc.json": entry("GHSA-aaaa-bbbb-cccc", "2024-03-01T00:00:00Z"),
+	}}
+	server := httptest.NewServer(dump)
+	defer server.Close()
+
+	t.Run("all ecosystems", func(t *testing.T) {
+		o, err := NewOSVCollector(WithURL(server.URL))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []string{
+			server.URL + "/PyPI/PYSEC-2024-1.json",
+			server.URL + "/GitHub%20Actions/GHSA-aaaa-bbbb-cccc.json",
+			server.URL + "/PyPI/PYSEC-2024-2.json",
+		}
+		if diff := cmp.Diff(want, collectSources(t, o, false)); diff != "" {
+			t.Errorf("collected entries (-want +got):\n%s", diff)
+		}
+		// nothing was modified since
+		if got := collectSources(t, o, false); len(got) != 0 {
+			t.Errorf("second collection got %v, want nothing", got)
+		}
+	})
+
+	t.Run("ecosystem", func(t *testing.T) {
+		dump.requested = nil
+		o, err := NewOSVCollector(WithURL(server.URL), WithEcosystems("PyPI"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []string{server.URL + "/PyPI/PYSEC-2024-1.json", server.URL + "/PyPI/PYSEC-2024-2.json"}
+		if diff := cmp.Diff(want, collectSources(t, o, false)); diff != "" {
+			t.Errorf("collected entries (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{"/PyPI/modified_id.csv", "/PyPI/PYSEC-2024-1.json", "/PyPI/PYSEC-2024-2.json"}, dump.requested); diff != "" {
+			t.Errorf("requested paths (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("modified since", func(t *testing.T) {
+		o, err := NewOSVCollector(WithURL(server.URL), WithEcosystems("PyPI"), WithModifiedSince(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]string{server.URL + "/PyPI/PYSEC-2024-2.json"}, collectSources(t, o, false)); diff != "" {
+			t.Errorf("collected entries (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("missing entry", func(t *testing.T) {
+		dump.files["/npm/modified_id.csv"] = "2024-03-01T00:00:00Z,GHSA-missing\n2024-01-01T00:00:00Z,GHSA-present\n"
+		dump.files["/npm/GHSA-present.json"] = entry("GHSA-present", "2024-01-01T00:00:00Z")
+		o, err := NewOSVCollector(WithURL(server.URL), WithEcosystems("npm"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]string{server.URL + "/npm/GHSA-present.json"}, collectSources(t, o, true)); diff != "" {
+			t.Errorf("collected entries (-want +got):\n%s", diff)
+		}
+		// the entries emitted before the error are not collected again
+		dump.files["/npm/GHSA-missing.json"] = entry("GHSA-missing", "2024-03-01T00:00:00Z")
+		if diff := cmp.Diff([]string{server.URL + "/npm/GHSA-missing.json"}, collectSources(t, o, false)); diff != "" {
+			t.Errorf("collected entries (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,434 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rekor
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/in-toto/in-toto-golang/in_toto"
+	rekorclient "github.com/sigstore/rekor/pkg/client"
+	"github.com/sigstore/rekor/pkg/generated/client"
+	"github.com/sigstore/rekor/pkg/generated/client/entries"
+	"github.com/sigstore/rekor/pkg/generated/client/index"
+	"github.com/sigstore/rekor/pkg/generated/client/tlog"
+	"github.com/sigstore/rekor/pkg/generated/models"
+)
+
+const (
+	RekorCollector = "RekorCollector"
+	// DefaultRekorURL is the public Sigstore Rekor instance
+	DefaultRekorURL = "https://rekor.sigstore.dev"
+	// defaultPageSize is the number of log entries read between checkpoints
+	// when scanning the log for a repository
+	defaultPageSize = 100
+)
+
+type rekorCollector struct {
+	client         *client.Rekor
+	url            string
+	digest         string
+	repository     string
+	checkpointFile string
+	pageSize       int64
+	poll           bool
+	interval       time.Duration
+
+	// nextIndex is the log index of the first entry not collected yet, or -1
+	// if there is no checkpoint
+	nextIndex int64
+}
+
+type Opt func(*rekorCollector)
+
+// NewRekorCollector returns a collector of the in-toto attestations stored in a
+// Rekor transparency log, such as SLSA provenance. Exactly one of WithDigest,
+// to collect the attestations about an artifact, or WithRepository, to collect
+// the new attestations mentioning a repository, must be given.
+func NewRekorCollector(opts ...Opt) (*rekorCollector, error) {
+	r := &rekorCollector{
+		url:       DefaultRekorURL,
+		pageSize:  defaultPageSize,
+		nextIndex: -1,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if (r.digest == "") == (r.repository == "") {
+		return nil, fmt.Errorf("exactly one of an artifact digest or a repository must be provided for the rekor collector")
+	}
+	if r.pageSize <= 0 {
+		return nil, fmt.Errorf("invalid rekor page size %d, must be positive", r.pageSize)
+	}
+	if r.client == nil {
+		c, err := rekorclient.GetRekorClient(r.url)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create rekor client for %s: %w", r.url, err)
+		}
+		r.client = c
+	}
+	if r.checkpointFile != "" {
+		if err := r.loadCheckpoint(); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// WithURL sets the URL of the Rekor server, DefaultRekorURL by default
+func WithURL(url string) Opt {
+	return func(r *rekorCollector) {
+		r.url = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithClient sets the Rekor client, used instead of one created for the URL
+func WithClient(c *client.Rekor) Opt {
+	return func(r *rekorCollector) {
+		r.client = c
+	}
+}
+
+// WithDigest collects the attestations indexed under the artifact digest, in
+// the "sha256:<hex>" form
+func WithDigest(digest string) Opt {
+	return func(r *rekorCollector) {
+		r.digest = digest
+	}
+}
+
+// WithRepository collects the attestations added to the log whose subjects or
+// predicate mention the repository, such as "github.com/guacsec/guac"
+func WithRepository(repository string) Opt {
+	return func(r *rekorCollector) {
+		r.repository = repository
+	}
+}
+
+// WithCheckpointFile stores the index of the next log entry to collect in
+// file, so that a restarted collector resumes where it stopped
+func WithCheckpointFile(file string) Opt {
+	return func(r *rekorCollector) {
+		r.checkpointFile = file
+	}
+}
+
+// WithPageSize sets the number of log entries read between checkpoints when
+// scanning the log for a repository
+func WithPageSize(pageSize int64) Opt {
+	return func(r *rekorCollector) {
+		r.pageSize = pageSize
+	}
+}
+
+func WithPolling(interval time.Duration) Opt {
+	return func(r *rekorCollector) {
+		r.poll = true
+		r.interval = interval
+	}
+}
+
+// RetrieveArtifacts collects the new matching attestations once, or on every
+// interval when polling
+func (r *rekorCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	if !r.poll {
+		return r.collect(ctx, docChannel)
+	}
+	logger := logging.FromContext(ctx)
+	for {
+		if err := r.collect(ctx, docChannel); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logger.Errorf("failed to collect from rekor: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.interval):
+		}
+	}
+}
+
+// Type returns the collector type
+func (r *rekorCollector) Type() string {
+	return RekorCollector
+}
+
+func (r *rekorCollector) collect(ctx context.Context, docChannel chan<- *processor.Document) error {
+	if r.digest != "" {
+		return r.collectDigest(ctx, docChannel)
+	}
+	return r.collectRepository(ctx, docChannel)
+}
+
+// collectDigest collects the entries indexed under the digest that were added
+// to the log after the checkpoint, in log order
+func (r *rekorCollector) collectDigest(ctx context.Context, docChannel chan<- *processor.Document) error {
+	params := index.NewSearchIndexParamsWithContext(ctx).WithQuery(&models.SearchIndex{Hash: r.digest})
+	resp, err := r.client.Index.SearchIndex(params)
+	if err != nil {
+		return fmt.Errorf("failed to search rekor index for %s: %w", r.digest, err)
+	}
+
+	var found []models.LogEntry
+	for _, uuid := range resp.Payload {
+		entry, err := r.client.Entries.GetLogEntryByUUID(entries.NewGetLogEntryByUUIDParamsWithContext(ctx).WithEntryUUID(uuid))
+		if err != nil {
+			return fmt.Errorf("failed to get rekor entry %s: %w", uuid, err)
+		}
+		found = append(found, entry.Payload)
+	}
+	sort.Slice(found, func(i, j int) bool {
+		return logIndex(found[i]) < logIndex(found[j])
+	})
+
+	for _, entry := range found {
+		if logIndex(entry) < r.nextIndex {
+			continue
+		}
+		if err := r.emit(ctx, entry, docChannel); err != nil {
+			return err
+		}
+		if err := r.saveCheckpoint(logIndex(entry) + 1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectRepository reads the log entries added after the checkpoint, a page
+// at a time, collecting those that mention the repository. Without a
+// checkpoint, only the entries added from now on are collected.
+func (r *rekorCollector) collectRepository(ctx context.Context, docChannel chan<- *processor.Document) error {
+	size, err := r.logSize(ctx)
+	if err != nil {
+		return err
+	}
+	if r.nextIndex < 0 {
+		return r.saveCheckpoint(size)
+	}
+
+	for r.nextIndex < size {
+		end := r.nextIndex + r.pageSize
+		if end > size {
+			end = size
+		}
+		for i := r.nextIndex; i < end; i++ {
+			entry, err := r.client.Entries.GetLogEntryByIndex(entries.NewGetLogEntryByIndexParamsWithContext(ctx).WithLogIndex(i))
+			if err != nil {
+				return fmt.Errorf("failed to get rekor entry at index %d: %w", i, err)
+			}
+			if err := r.emit(ctx, entry.Payload, docChannel); err != nil {
+				return err
+			}
+		}
+		if err := r.saveCheckpoint(end); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// logSize returns the number of entries in the log, across its shards
+func (r *rekorCollector) logSize(ctx context.Context) (int64, error) {
+	resp, err := r.client.Tlog.GetLogInfo(tlog.NewGetLogInfoParamsWithContext(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rekor log info: %w", err)
+	}
+	if resp.Payload.TreeSize == nil {
+		return 0, fmt.Errorf("rekor log info is missing the tree size")
+	}
+	size := *resp.Payload.TreeSize
+	for _, shard := range resp.Payload.InactiveShards {
+		if shard.TreeSize != nil {
+			size += *shard.TreeSize
+		}
+	}
+	return size, nil
+}
+
+// emit sends the in-toto statements of the entry that match the collector.
+// Entries of other kinds, or without a readable statement, are skipped.
+func (r *rekorCollector) emit(ctx context.Context, entry models.LogEntry, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	for uuid, e := range entry {
+		statement, err := statementFromEntry(e)
+		if err != nil {
+			logger.Debugf("skipping rekor entry %s: %v", uuid, err)
+			continue
+		}
+		if statement == nil || !r.matches(statement) {
+			continue
+		}
+		doc := &processor.Document{
+			Blob:   statement,
+			Type:   documentType(statement),
+			Format: processor.FormatJSON,
+			SourceInformation: processor.SourceInformation{
+				Collector: RekorCollector,
+				Source:    fmt.Sprintf("%s/api/v1/log/entries/%s", r.url, uuid),
+			},
+		}
+		select {
+		case docChannel <- doc:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// matches checks the statement against the repository. Entries found by
+// digest already match, as Rekor indexes them under the digests of their
+// subjects.
+func (r *rekorCollector) matches(statement []byte) bool {
+	if r.repository == "" {
+		return true
+	}
+	var s in_toto.Statement
+	if err := json.Unmarshal(statement, &s); err != nil {
+		return false
+	}
+	for _, subject := range s.Subject {
+		if strings.Contains(subject.Name, r.repository) {
+			return true
+		}
+	}
+	predicate, err := json.Marshal(s.Predicate)
+	return err == nil && bytes.Contains(predicate, []byte(r.repository))
+}
+
+// entryBody is the part of the body of an intoto entry holding the DSSE
+// envelope, which is only present in version 0.0.1 entries. Later versions
+// store the statement as the attestation of the entry instead.
+type entryBody struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		Content struct {
+			Envelope string `json:"envelope"`
+		} `json:"content"`
+	} `json:"spec"`
+}
+
+// statementFromEntry returns the ITE-6 statement attested by an intoto or
+// dsse entry, or nil for the other kinds of entries
+func statementFromEntry(e models.LogEntryAnon) ([]byte, error) {
+	encoded, ok := e.Body.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected body type %T", e.Body)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode body: %w", err)
+	}
+	var body entryBody
+	if err := json.Unmarshal(decoded, &body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal body: %w", err)
+	}
+	if body.Kind != "intoto" && body.Kind != "dsse" {
+		return nil, nil
+	}
+
+	if e.Attestation != nil && len(e.Attestation.Data) > 0 {
+		return e.Attestation.Data, nil
+	}
+	if body.Spec.Content.Envelope == "" {
+		return nil, errors.New("no attestation stored for the entry")
+	}
+	var envelope struct {
+		Payload string `json:"payload"`
+	}
+	if err := json.Unmarshal([]byte(body.Spec.Content.Envelope), &envelope); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal envelope: %w", err)
+	}
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode envelope payload: %w", err)
+	}
+	return payload, nil
+}
+
+func documentType(statement []byte) processor.DocumentType {
+	var s in_toto.StatementHeader
+	if err := json.Unmarshal(statement, &s); err == nil && strings.HasPrefix(s.PredicateType, "https://slsa.dev/provenance") {
+		return processor.DocumentITE6SLSA
+	}
+	return processor.DocumentITE6Generic
+}
+
+func logIndex(entry models.LogEntry) int64 {
+	for _, e := range entry {
+		if e.LogIndex != nil {
+			return *e.LogIndex
+		}
+	}
+	return -1
+}
+
+func (r *rekorCollector) loadCheckpoint() error {
+	b, err := os.ReadFile(r.checkpointFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read rekor checkpoint %s: %w", r.checkpointFile, err)
+	}
+	next, err := strconv.ParseInt(strings.TrimSpace(string(b)), 10, 64)
+	if err != nil || next < 0 {
+		return fmt.Errorf("invalid rekor checkpoint in %s: %q", r.checkpointFile, string(b))
+	}
+	r.nextIndex = next
+	return nil
+}
+
+// saveCheckpoint records next as the index of the first entry not collected
+// yet, replacing the checkpoint file atomically
+func (r *rekorCollector) saveCheckpoint(next int64) error {
+	r.nextIndex = next
+	if r.checkpointFile == "" {
+		return nil
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(r.checkpointFile), filepath.Base(r.checkpointFile)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to write rekor checkpoint: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.WriteString(strconv.FormatInt(next, 10)); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write rekor checkpoint: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write rekor checkpoint: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), r.checkpointFile); err != nil {
+		return fmt.Errorf("failed to write rekor checkpoint: %w", err)
+	}
+	return nil
+}
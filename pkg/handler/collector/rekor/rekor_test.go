@@ -0,0 +1,257 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rekor
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/sigstore/rekor/pkg/generated/client"
+	"github.com/sigstore/rekor/pkg/generated/client/entries"
+	"github.com/sigstore/rekor/pkg/generated/client/index"
+	"github.com/sigstore/rekor/pkg/generated/client/tlog"
+	"github.com/sigstore/rekor/pkg/generated/models"
+)
+
+const (
+	testDigest     = "sha256:9a0ed21e0ad1e5f8a8e9a1b5e2f8c5e0a5c2b8d6c3f1e7a6b4d2c0e8f6a4b2c0"
+	testRepository = "github.com/guacsec/guac"
+
+	slsaStatement = `{"_type":"https://in-toto.io/Statement/v0.1","predicateType":"https://slsa.dev/provenance/v0.2",` +
+		`"subject":[{"name":"guac","digest":{"sha256":"9a0ed21e"}}],` +
+		`"predicate":{"invocation":{"configSource":{"uri":"git+https://github.com/guacsec/guac@refs/heads/main"}}}}`
+	otherStatement = `{"_type":"https://in-toto.io/Statement/v0.1","predicateType":"https://slsa.dev/provenance/v0.2",` +
+		`"subject":[{"name":"other","digest":{"sha256":"1234"}}],` +
+		`"predicate":{"invocation":{"configSource":{"uri":"git+https://github.com/example/other@refs/heads/main"}}}}`
+	genericStatement = `{"_type":"https://in-toto.io/Statement/v0.1","predicateType":"https://example.com/predicate",` +
+		`"subject":[{"name":"github.com/guacsec/guac/cmd/guacone","digest":{"sha256":"5678"}}],"predicate":{}}`
+)
+
+// fakeRekor is an in-memory log implementing the Rekor clients used by the
+// collector. The entry at log index i has UUID "uuid-<i>".
+type fakeRekor struct {
+	log     []models.LogEntryAnon
+	indexed []string
+}
+
+func (f *fakeRekor) add(kind string, statement string) {
+	body := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf(`{"kind":%q,"apiVersion":"0.0.2","spec":{}}`, kind)))
+	logIndex := int64(len(f.log))
+	f.log = append(f.log, models.LogEntryAnon{
+		Body:        body,
+		LogIndex:    &logIndex,
+		Attestation: &models.LogEntryAnonAttestation{Data: []byte(statement)},
+	})
+}
+
+func (f *fakeRekor) client() *client.Rekor {
+	return &client.Rekor{Entries: f, Index: f, Tlog: f}
+}
+
+func (f *fakeRekor) entry(i int64) models.LogEntry {
+	return models.LogEntry{fmt.Sprintf("uuid-%d", i): f.log[i]}
+}
+
+func (f *fakeRekor) GetLogEntryByIndex(params *entries.GetLogEntryByIndexParams, _ ...entries.ClientOption) (*entries.GetLogEntryByIndexOK, error) {
+	if params.LogIndex < 0 || params.LogIndex >= int64(len(f.log)) {
+		return nil, entries.NewGetLogEntryByIndexNotFound()
+	}
+	return &entries.GetLogEntryByIndexOK{Payload: f.entry(params.LogIndex)}, nil
+}
+
+func (f *fakeRekor) GetLogEntryByUUID(params *entries.GetLogEntryByUUIDParams, _ ...entries.ClientOption) (*entries.GetLogEntryByUUIDOK, error) {
+	var i int64
+	if _, err := fmt.Sscanf(params.EntryUUID, "uuid-%d", &i); err != nil || i >= int64(len(f.log)) {
+		return nil, entries.NewGetLogEntryByUUIDNotFound()
+	}
+	return &entries.GetLogEntryByUUIDOK{Payload: f.entry(i)}, nil
+}
+
+func (f *fakeRekor) CreateLogEntry(*entries.CreateLogEntryParams, ...entries.ClientOption) (*entries.CreateLogEntryCreated, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *fakeRekor) SearchLogQuery(*entries.SearchLogQueryParams, ...entries.ClientOption) (*entries.SearchLogQueryOK, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *fakeRekor) SearchIndex(params *index.SearchIndexParams, _ ...index.ClientOption) (*index.SearchIndexOK, error) {
+	if params.Query.Hash != testDigest {
+		return &index.SearchIndexOK{}, nil
+	}
+	return &index.SearchIndexOK{Payload: f.indexed}, nil
+}
+
+func (f *fakeRekor) GetLogInfo(*tlog.GetLogInfoParams, ...tlog.ClientOption) (*tlog.GetLogInfoOK, error) {
+	size := int64(len(f.log))
+	return &tlog.GetLogInfoOK{Payload: &models.LogInfo{TreeSize: &size}}, nil
+}
+
+func (f *fakeRekor) GetLogProof(*tlog.GetLogProofParams, ...tlog.ClientOption) (*tlog.GetLogProofOK, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *fakeRekor) SetTransport(runtime.ClientTransport) {}
+
+func collect(t *testing.T, opts ...Opt) []*processor.Document {
+	t.Helper()
+	c, err := NewRekorCollector(opts...)
+	if err != nil {
+		t.Fatalf("NewRekorCollector() error = %v", err)
+	}
+	docChan := make(chan *processor.Document, 100)
+	if err := c.RetrieveArtifacts(context.Background(), docChan); err != nil {
+		t.Fatalf("RetrieveArtifacts() error = %v", err)
+	}
+	close(docChan)
+	var docs []*processor.Document
+	for d := range docChan {
+		docs = append(docs, d)
+	}
+	return docs
+}
+
+func readCheckpoint(t *testing.T, file string) string {
+	t.Helper()
+	b, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("failed to read checkpoint: %v", err)
+	}
+	return string(b)
+}
+
+func TestRekorCollectorDigest(t *testing.T) {
+	f := &fakeRekor{}
+	f.add("intoto", otherStatement)
+	f.add("intoto", slsaStatement)
+	f.add("dsse", genericStatement)
+	f.indexed = []string{"uuid-2", "uuid-1"}
+	checkpoint := filepath.Join(t.TempDir(), "checkpoint")
+	opts := []Opt{WithClient(f.client()), WithDigest(testDigest), WithCheckpointFile(checkpoint)}
+
+	docs := collect(t, opts...)
+	if len(docs) != 2 {
+		t.Fatalf("got %d documents, want 2", len(docs))
+	}
+	if string(docs[0].Blob) != slsaStatement || docs[0].Type != processor.DocumentITE6SLSA {
+		t.Errorf("unexpected first document %s of type %s", docs[0].Blob, docs[0].Type)
+	}
+	if string(docs[1].Blob) != genericStatement || docs[1].Type != processor.DocumentITE6Generic {
+		t.Errorf("unexpected second document %s of type %s", docs[1].Blob, docs[1].Type)
+	}
+	if want := DefaultRekorURL + "/api/v1/log/entries/uuid-1"; docs[0].SourceInformation.Source != want {
+		t.Errorf("got source %q, want %q", docs[0].SourceInformation.Source, want)
+	}
+	if got := readCheckpoint(t, checkpoint); got != "3" {
+		t.Errorf("got checkpoint %q, want %q", got, "3")
+	}
+
+	// a restarted collector only collects the entries added since
+	if docs := collect(t, opts...); len(docs) != 0 {
+		t.Errorf("got %d documents after restart, want 0", len(docs))
+	}
+	f.add("intoto", slsaStatement)
+	f.indexed = append(f.indexed, "uuid-3")
+	if docs := collect(t, opts...); len(docs) != 1 {
+		t.Errorf("got %d new documents, want 1", len(docs))
+	}
+}
+
+func TestRekorCollectorRepository(t *testing.T) {
+	f := &fakeRekor{}
+	f.add("intoto", slsaStatement)
+	checkpoint := filepath.Join(t.TempDir(), "checkpoint")
+	opts := []Opt{WithClient(f.client()), WithRepository(testRepository), WithCheckpointFile(checkpoint), WithPageSize(2)}
+
+	// without a checkpoint, only entries added from now on are collected
+	if docs := collect(t, opts...); len(docs) != 0 {
+		t.Fatalf("got %d documents from the existing log, want 0", len(docs))
+	}
+	if got := readCheckpoint(t, checkpoint); got != "1" {
+		t.Errorf("got checkpoint %q, want %q", got, "1")
+	}
+
+	f.add("intoto", otherStatement)
+	f.add("hashedrekord", slsaStatement)
+	f.add("intoto", slsaStatement)
+	f.add("dsse", genericStatement)
+	f.add("intoto", otherStatement)
+	docs := collect(t, opts...)
+	if len(docs) != 2 {
+		t.Fatalf("got %d documents, want 2", len(docs))
+	}
+	if string(docs[0].Blob) != slsaStatement {
+		t.Errorf("unexpected first document %s", docs[0].Blob)
+	}
+	if string(docs[1].Blob) != genericStatement {
+		t.Errorf("unexpected second document %s", docs[1].Blob)
+	}
+	if got := readCheckpoint(t, checkpoint); got != "6" {
+		t.Errorf("got checkpoint %q, want %q", got, "6")
+	}
+}
+
+func TestStatementFromEntryEnvelope(t *testing.T) {
+	envelope := fmt.Sprintf(`{"payloadType":"application/vnd.in-toto+json","payload":%q,"signatures":[]}`,
+		base64.StdEncoding.EncodeToString([]byte(slsaStatement)))
+	body := fmt.Sprintf(`{"kind":"intoto","apiVersion":"0.0.1","spec":{"content":{"envelope":%q}}}`, envelope)
+	got, err := statementFromEntry(models.LogEntryAnon{Body: base64.StdEncoding.EncodeToString([]byte(body))})
+	if err != nil {
+		t.Fatalf("statementFromEntry() error = %v", err)
+	}
+	if string(got) != slsaStatement {
+		t.Errorf("got statement %s, want %s", got, slsaStatement)
+	}
+}
+
+func TestNewRekorCollectorErrors(t *testing.T) {
+	c := (&fakeRekor{}).client()
+	tests := []struct {
+		name string
+		opts []Opt
+	}{{
+		name: "no digest or repository",
+		opts: []Opt{WithClient(c)},
+	}, {
+		name: "digest and repository",
+		opts: []Opt{WithClient(c), WithDigest(testDigest), WithRepository(testRepository)},
+	}, {
+		name: "invalid page size",
+		opts: []Opt{WithClient(c), WithRepository(testRepository), WithPageSize(0)},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewRekorCollector(tt.opts...); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+
+	checkpoint := filepath.Join(t.TempDir(), "checkpoint")
+	if err := os.WriteFile(checkpoint, []byte("not a number"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewRekorCollector(WithClient(c), WithDigest(testDigest), WithCheckpointFile(checkpoint)); err == nil {
+		t.Errorf("expected error for invalid checkpoint")
+	}
+}
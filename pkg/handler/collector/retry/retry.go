@@ -0,0 +1,92 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package retry provides a collector wrapper that retries collectors failing
+// with transient errors.
+package retry
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// maxDelay caps the delay between retries.
+const maxDelay = 5 * time.Minute
+
+type retryableCollector struct {
+	collector  collector.Collector
+	maxRetries int
+	baseDelay  time.Duration
+}
+
+// NewRetryableCollector wraps c so that RetrieveArtifacts is called again when
+// it fails with an error other than a context error, up to maxRetries times.
+// Retries are delayed by a jittered exponential backoff starting at baseDelay.
+// Documents collected before a failure may be collected again by the retry.
+func NewRetryableCollector(c collector.Collector, maxRetries int, baseDelay time.Duration) collector.Collector {
+	return &retryableCollector{
+		collector:  c,
+		maxRetries: maxRetries,
+		baseDelay:  baseDelay,
+	}
+}
+
+// RetrieveArtifacts calls the wrapped collector, retrying on failure.
+func (r *retryableCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	for attempt := 0; ; attempt++ {
+		err := r.collector.RetrieveArtifacts(ctx, docChannel)
+		if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) ||
+			attempt >= r.maxRetries {
+			return err
+		}
+
+		delay := backoff(r.baseDelay, attempt)
+		logger.Warnf("collector %s failed, retrying in %s (attempt %d of %d): %v",
+			r.collector.Type(), delay, attempt+1, r.maxRetries, err)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Type returns the type of the wrapped collector.
+func (r *retryableCollector) Type() string {
+	return r.collector.Type()
+}
+
+// backoff returns the delay before the retry following the given attempt: the
+// base delay doubled for each previous attempt, capped at maxDelay, with up to
+// half of it removed at random so that collectors failing together do not
+// retry in lockstep.
+func backoff(base time.Duration, attempt int) time.Duration {
+	delay := maxDelay
+	if attempt < 32 && base < maxDelay>>attempt {
+		delay = base << attempt
+	}
+	if delay <= 1 {
+		return delay
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)))
+}
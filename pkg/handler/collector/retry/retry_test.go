@@ -0,0 +1,143 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+var errTransient = errors.New("transient error")
+
+// flakyCollector fails with its errors in order, then succeeds.
+type flakyCollector struct {
+	errs  []error
+	calls int
+}
+
+func (f *flakyCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	f.calls++
+	if f.calls <= len(f.errs) {
+		return f.errs[f.calls-1]
+	}
+	docChannel <- &processor.Document{Blob: []byte("doc")}
+	return nil
+}
+
+func (f *flakyCollector) Type() string {
+	return "flaky"
+}
+
+func TestRetrieveArtifacts(t *testing.T) {
+	tests := []struct {
+		name       string
+		errs       []error
+		maxRetries int
+		wantErr    error
+		wantCalls  int
+	}{{
+		name:      "success",
+		wantCalls: 1,
+	}, {
+		name:       "retried until success",
+		errs:       []error{errTransient, errTransient},
+		maxRetries: 2,
+		wantCalls:  3,
+	}, {
+		name:       "retries exhausted",
+		errs:       []error{errTransient, errTransient, errTransient},
+		maxRetries: 2,
+		wantErr:    errTransient,
+		wantCalls:  3,
+	}, {
+		name:       "no retries",
+		errs:       []error{errTransient},
+		maxRetries: 0,
+		wantErr:    errTransient,
+		wantCalls:  1,
+	}, {
+		name:       "context error not retried",
+		errs:       []error{context.DeadlineExceeded},
+		maxRetries: 2,
+		wantErr:    context.DeadlineExceeded,
+		wantCalls:  1,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &flakyCollector{errs: tt.errs}
+			c := NewRetryableCollector(f, tt.maxRetries, time.Millisecond)
+			docs := make(chan *processor.Document, 1)
+			err := c.RetrieveArtifacts(context.Background(), docs)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RetrieveArtifacts() error = %v, want %v", err, tt.wantErr)
+			}
+			if f.calls != tt.wantCalls {
+				t.Errorf("collector called %d times, want %d", f.calls, tt.wantCalls)
+			}
+			if tt.wantErr == nil && len(docs) != 1 {
+				t.Errorf("expected a collected document")
+			}
+		})
+	}
+}
+
+func TestRetrieveArtifactsCanceledBetweenRetries(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	f := &flakyCollector{errs: []error{errTransient}}
+	c := NewRetryableCollector(f, 1, time.Hour)
+
+	done := make(chan error)
+	go func() {
+		done <- c.RetrieveArtifacts(ctx, make(chan *processor.Document, 1))
+	}()
+	cancel()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("RetrieveArtifacts() error = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("RetrieveArtifacts() did not return after cancel")
+	}
+	if f.calls != 1 {
+		t.Errorf("collector called %d times, want 1", f.calls)
+	}
+}
+
+func TestType(t *testing.T) {
+	if got := NewRetryableCollector(&flakyCollector{}, 1, time.Second).Type(); got != "flaky" {
+		t.Errorf("Type() = %q, want %q", got, "flaky")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second} {
+		got := backoff(time.Second, attempt)
+		if got < want/2 || got > want {
+			t.Errorf("backoff(1s, %d) = %s, want between %s and %s", attempt, got, want/2, want)
+		}
+	}
+	if got := backoff(time.Second, 100); got < maxDelay/2 || got > maxDelay {
+		t.Errorf("backoff(1s, 100) = %s, want at most %s", got, maxDelay)
+	}
+	if got := backoff(0, 3); got != 0 {
+		t.Errorf("backoff(0, 3) = %s, want 0", got)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -41,8 +42,15 @@ func (bd *BucketBuilder) GetBucket(url string, region string) Bucket {
 	}
 }
 
+// Object describes an item listed from a bucket
+type Object struct {
+	Key          string
+	LastModified time.Time
+}
+
 type Bucket interface {
 	ListFiles(ctx context.Context, bucket string, token *string, max int32) ([]string, *string, error)
+	ListObjects(ctx context.Context, bucket string, prefix string, token *string, max int32) ([]Object, *string, error)
 	DownloadFile(ctx context.Context, bucket string, item string) ([]byte, error)
 	GetEncoding(ctx context.Context, bucket string, item string) (string, error)
 }
@@ -90,6 +98,47 @@ func (d *s3Bucket) ListFiles(ctx context.Context, bucket string, token *string,
 	return files, resp.NextContinuationToken, nil
 }
 
+func (d *s3Bucket) ListObjects(ctx context.Context, bucket string, prefix string, token *string, max int32) ([]Object, *string, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error loading AWS SDK config: %w", err)
+	}
+
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.UsePathStyle = true
+		if d.url != "" {
+			o.BaseEndpoint = aws.String(d.url)
+		}
+
+		if d.region != "" {
+			o.Region = d.region
+		}
+	})
+
+	input := &s3.ListObjectsV2Input{
+		Bucket:            &bucket,
+		ContinuationToken: token,
+		MaxKeys:           aws.Int32(max),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	resp, err := client.ListObjectsV2(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing objects: %w", err)
+	}
+
+	var objects []Object
+	for _, item := range resp.Contents {
+		obj := Object{Key: *item.Key}
+		if item.LastModified != nil {
+			obj.LastModified = *item.LastModified
+		}
+		objects = append(objects, obj)
+	}
+	return objects, resp.NextContinuationToken, nil
+}
+
 func (d *s3Bucket) DownloadFile(ctx context.Context, bucket string, item string) ([]byte, error) {
 	cfg, err := config.LoadDefaultConfig(ctx)
 	if err != nil {
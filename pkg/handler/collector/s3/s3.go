@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/guacsec/guac/pkg/handler/collector/s3/bucket"
 	"github.com/guacsec/guac/pkg/handler/collector/s3/messaging"
@@ -41,11 +42,13 @@ type S3CollectorConfig struct {
 	S3Url                   string                           // optional (uses aws sdk defaults)
 	S3Bucket                string                           // bucket name to collect from
 	S3Item                  string                           // optional (only for non-polling behaviour)
+	S3Prefix                string                           // optional (only for polling by listing the bucket)
 	S3Region                string                           // optional (defaults to us-east-1, assumes same region for s3 and sqs)
 	Queues                  string                           // optional (comma-separated list of queues/topics)
 	MpBuilder               messaging.MessageProviderBuilder // optional
 	BucketBuilder           bucket.BuildBucket               // optional
 	Poll                    bool
+	PollInterval            time.Duration // optional (when set, polling lists the bucket on this interval instead of reading queues)
 }
 
 func NewS3Collector(cfg S3CollectorConfig) *S3Collector {
@@ -56,7 +59,9 @@ func NewS3Collector(cfg S3CollectorConfig) *S3Collector {
 }
 
 func (s *S3Collector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
-	if s.config.Poll {
+	if s.config.Poll && s.config.PollInterval > 0 {
+		return retrieveWithListPoll(*s, ctx, docChannel)
+	} else if s.config.Poll {
 		retrieveWithPoll(*s, ctx, docChannel)
 	} else {
 		return retrieve(*s, ctx, docChannel)
@@ -233,6 +238,88 @@ func retrieveWithPoll(s S3Collector, ctx context.Context, docChannel chan<- *pro
 	wg.Wait()
 }
 
+// retrieveWithListPoll lists the bucket (optionally restricted to a key prefix) on
+// every poll interval and collects the objects modified since the previous listing.
+func retrieveWithListPoll(s S3Collector, ctx context.Context, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	downloader := getDownloader(s)
+
+	var lastChecked time.Time
+	for {
+		listStart := time.Now()
+		var token *string
+		const MaxKeys = 100
+		listed := true
+		for {
+			objects, t, err := downloader.ListObjects(ctx, s.config.S3Bucket, s.config.S3Prefix, token, MaxKeys)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err() // nolint:wrapcheck
+				}
+				// a failed listing is retried from the start on the next interval,
+				// without moving lastChecked so that no object is missed
+				logger.Errorf("could not list objects in bucket %v, retrying on the next interval: %v", s.config.S3Bucket, err)
+				listed = false
+				break
+			}
+			token = t
+
+			for _, obj := range objects {
+				// stop between downloads if the collector is shut down
+				if ctx.Err() != nil {
+					return ctx.Err() // nolint:wrapcheck
+				}
+				if !obj.LastModified.After(lastChecked) {
+					continue
+				}
+
+				blob, err := downloader.DownloadFile(ctx, s.config.S3Bucket, obj.Key)
+				if err != nil {
+					logger.Errorf("could not download item %v, skipping: %v", obj.Key, err)
+					continue
+				}
+
+				enc, err := downloader.GetEncoding(ctx, s.config.S3Bucket, obj.Key)
+				if err != nil {
+					logger.Errorf("could not get encoding for item %v, skipping: %v", obj.Key, err)
+					continue
+				}
+
+				doc := &processor.Document{
+					Blob:     blob,
+					Type:     processor.DocumentUnknown,
+					Format:   processor.FormatUnknown,
+					Encoding: bucket.ExtractEncoding(enc, obj.Key),
+					SourceInformation: processor.SourceInformation{
+						Collector:   S3CollectorType,
+						Source:      "S3",
+						DocumentRef: fmt.Sprintf("s3://%s/%s", s.config.S3Bucket, obj.Key),
+					},
+				}
+				select {
+				case docChannel <- doc:
+				case <-ctx.Done():
+					return ctx.Err() // nolint:wrapcheck
+				}
+			}
+
+			if token == nil {
+				break
+			}
+		}
+		if listed {
+			lastChecked = listStart
+		}
+
+		select {
+		// If the context has been canceled it contains an err which we can throw.
+		case <-ctx.Done():
+			return ctx.Err() // nolint:wrapcheck
+		case <-time.After(s.config.PollInterval):
+		}
+	}
+}
+
 func getMessageProvider(s S3Collector, queue string) (messaging.MessageProvider, error) {
 	var err error
 	var mpBuilder messaging.MessageProviderBuilder
@@ -19,6 +19,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -88,6 +89,17 @@ func (td *TestBucket) ListFiles(ctx context.Context, bucket string, token *strin
 	return []string{"no-poll-item"}, nil, nil
 }
 
+func (td *TestBucket) ListObjects(ctx context.Context, bucketName string, prefix string, token *string, max int32) ([]bucket.Object, *string, error) {
+	modified := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	var objects []bucket.Object
+	for _, key := range []string{"sboms/poll-item", "vex/poll-item"} {
+		if strings.HasPrefix(key, prefix) {
+			objects = append(objects, bucket.Object{Key: key, LastModified: modified})
+		}
+	}
+	return objects, nil, nil
+}
+
 func (td *TestBucket) DownloadFile(ctx context.Context, bucket string, item string) ([]byte, error) {
 	return []byte("{\"key\": \"value\"}"), nil
 }
@@ -96,6 +108,28 @@ func (td *TestBucket) GetEncoding(ctx context.Context, bucket string, item strin
 	return "application/json", nil
 }
 
+// FailingBucket fails the first listings of the bucket
+type FailingBucket struct {
+	TestBucket
+	failures int
+}
+
+func (fb *FailingBucket) ListObjects(ctx context.Context, bucketName string, prefix string, token *string, max int32) ([]bucket.Object, *string, error) {
+	if fb.failures > 0 {
+		fb.failures--
+		return nil, nil, errors.New("transient listing error")
+	}
+	return fb.TestBucket.ListObjects(ctx, bucketName, prefix, token, max)
+}
+
+type FailingBucketBuilder struct {
+	failures int
+}
+
+func (fb *FailingBucketBuilder) GetDownloader(url string, region string) bucket.Bucket {
+	return &FailingBucket{failures: fb.failures}
+}
+
 type TestBucketBuilder struct {
 }
 
@@ -107,6 +141,88 @@ func TestS3Collector(t *testing.T) {
 	ctx := context.Background()
 	testNoPolling(t, ctx)
 	testQueuesSplitPolling(t, ctx)
+	testListPolling(t, ctx)
+	testListPollingError(t, ctx)
+}
+
+func testListPollingError(t *testing.T, ctx context.Context) {
+	s3Collector := NewS3Collector(S3CollectorConfig{
+		BucketBuilder: &FailingBucketBuilder{failures: 2},
+		S3Bucket:      "poll-bucket",
+		S3Prefix:      "sboms/",
+		Poll:          true,
+		PollInterval:  100 * time.Millisecond,
+	})
+
+	docChan := make(chan *processor.Document, 10)
+	errChan := make(chan error, 1)
+	cancelCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		errChan <- s3Collector.RetrieveArtifacts(cancelCtx, docChan)
+	}()
+
+	// the first listings fail, the collector must keep polling until one succeeds
+	time.Sleep(500 * time.Millisecond)
+	cancel()
+
+	if err := <-errChan; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context canceled error, got: %v", err)
+	}
+	close(docChan)
+
+	var s []*processor.Document
+	for d := range docChan {
+		s = append(s, d)
+	}
+
+	if len(s) != 1 {
+		t.Fatalf("expected 1 document, got %d", len(s))
+	}
+	if s[0].SourceInformation.DocumentRef != "s3://poll-bucket/sboms/poll-item" {
+		t.Errorf("wrong document ref returned: %s", s[0].SourceInformation.DocumentRef)
+	}
+}
+
+func testListPolling(t *testing.T, ctx context.Context) {
+	s3Collector := NewS3Collector(S3CollectorConfig{
+		BucketBuilder: &TestBucketBuilder{},
+		S3Bucket:      "poll-bucket",
+		S3Prefix:      "sboms/",
+		Poll:          true,
+		PollInterval:  100 * time.Millisecond,
+	})
+
+	docChan := make(chan *processor.Document, 10)
+	errChan := make(chan error, 1)
+	cancelCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		errChan <- s3Collector.RetrieveArtifacts(cancelCtx, docChan)
+	}()
+
+	// let the collector list the bucket a few times, unchanged objects must
+	// only be collected once
+	time.Sleep(500 * time.Millisecond)
+	cancel()
+
+	if err := <-errChan; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context canceled error, got: %v", err)
+	}
+	close(docChan)
+
+	var s []*processor.Document
+	for d := range docChan {
+		s = append(s, d)
+	}
+
+	if len(s) != 1 {
+		t.Fatalf("expected 1 document, got %d", len(s))
+	}
+	if s[0].SourceInformation.DocumentRef != "s3://poll-bucket/sboms/poll-item" {
+		t.Errorf("wrong document ref returned: %s", s[0].SourceInformation.DocumentRef)
+	}
+	if !bytes.Equal(s[0].Blob, []byte("{\"key\": \"value\"}")) {
+		t.Errorf("wrong item returned")
+	}
 }
 
 func testQueuesSplitPolling(t *testing.T, ctx context.Context) {
@@ -28,6 +28,7 @@ import (
 const (
 	bzipMimeType = "application/x-bzip2"
 	zstdMimeType = "application/zstd"
+	gzipMimeType = "application/x-gzip"
 	blankType    = ""
 )
 
@@ -47,6 +48,8 @@ func GuessEncoding(ctx context.Context, d *processor.Document) error {
 		d.Encoding = processor.EncodingBzip2
 	case zstdMimeType:
 		d.Encoding = processor.EncodingZstd
+	case gzipMimeType:
+		d.Encoding = processor.EncodingGzip
 	default:
 	}
 	if d.Encoding != "" {
@@ -64,6 +67,8 @@ func GuessEncoding(ctx context.Context, d *processor.Document) error {
 //
 // and bzip2 encoding is identified by the initial three-octet string 0x42,
 // 0x5A, 0x68.  as detailed by: https://www.ietf.org/rfc/rfc5655.txt
+//
+// gzip encoding is detected by http.DetectContentType.
 func detectFileEncoding(i *processor.Document) (string, error) {
 
 	// create a bufio.Reader so we can 'peek' at the first few bytes without
@@ -16,6 +16,8 @@
 package guesser
 
 import (
+	"bytes"
+	"compress/gzip"
 	"testing"
 
 	"github.com/guacsec/guac/internal/testing/testdata"
@@ -57,6 +59,20 @@ func Test_guessEncoding(t *testing.T) {
 			wantErr:      false,
 			wantMimeType: "application/zstd",
 		},
+		{
+			name: "valid .gz format document",
+			doc: processor.Document{
+				Blob:   gzipBlob(t, testdata.CycloneDXBusyboxExample),
+				Type:   processor.DocumentUnknown,
+				Format: processor.FormatUnknown,
+				SourceInformation: processor.SourceInformation{
+					Collector: "a-collector",
+					Source:    "a-source",
+				},
+			},
+			wantErr:      false,
+			wantMimeType: "application/x-gzip",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -70,3 +86,16 @@ func Test_guessEncoding(t *testing.T) {
 		})
 	}
 }
+
+func gzipBlob(t *testing.T, blob []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(blob); err != nil {
+		t.Fatalf("failed to compress blob: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("failed to compress blob: %v", err)
+	}
+	return buf.Bytes()
+}
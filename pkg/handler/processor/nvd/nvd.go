@@ -0,0 +1,113 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nvd
+
+import (
+	"fmt"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// FeedFormat is the value of the format field of an NVD CVE API 2.0 response
+const FeedFormat = "NVD_CVE"
+
+// Feed is the subset of the NVD CVE API 2.0 JSON response used by GUAC. See
+// https://csrc.nist.gov/schema/nvd/api/2.0/cve_api_json_2.0.schema
+type Feed struct {
+	Format          string          `json:"format"`
+	Version         string          `json:"version"`
+	Timestamp       string          `json:"timestamp"`
+	Vulnerabilities []Vulnerability `json:"vulnerabilities"`
+}
+
+type Vulnerability struct {
+	CVE CVE `json:"cve"`
+}
+
+type CVE struct {
+	ID           string     `json:"id"`
+	Published    string     `json:"published"`
+	LastModified string     `json:"lastModified"`
+	Metrics      Metrics    `json:"metrics"`
+	Weaknesses   []Weakness `json:"weaknesses,omitempty"`
+}
+
+type Metrics struct {
+	CVSSMetricV40 []CVSSMetric `json:"cvssMetricV40,omitempty"`
+	CVSSMetricV31 []CVSSMetric `json:"cvssMetricV31,omitempty"`
+	CVSSMetricV30 []CVSSMetric `json:"cvssMetricV30,omitempty"`
+	CVSSMetricV2  []CVSSMetric `json:"cvssMetricV2,omitempty"`
+}
+
+// CVSSMetric is a CVSS score given by a source. The type is either "Primary"
+// for the score of the NVD itself or "Secondary" for other sources, like the
+// CNA.
+type CVSSMetric struct {
+	Source   string   `json:"source"`
+	Type     string   `json:"type"`
+	CVSSData CVSSData `json:"cvssData"`
+}
+
+type CVSSData struct {
+	Version      string  `json:"version"`
+	VectorString string  `json:"vectorString"`
+	BaseScore    float64 `json:"baseScore"`
+}
+
+type Weakness struct {
+	Source      string        `json:"source"`
+	Type        string        `json:"type"`
+	Description []Description `json:"description"`
+}
+
+type Description struct {
+	Lang  string `json:"lang"`
+	Value string `json:"value"`
+}
+
+type NVDProcessor struct{}
+
+func (p *NVDProcessor) ValidateSchema(d *processor.Document) error {
+	if d.Type != processor.DocumentNVD {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentNVD, d.Type)
+	}
+
+	switch d.Format {
+	case processor.FormatJSON:
+		var feed Feed
+		if err := json.Unmarshal(d.Blob, &feed); err != nil {
+			return err
+		}
+		if feed.Format != FeedFormat {
+			return fmt.Errorf("unexpected NVD feed format: %q", feed.Format)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unable to support parsing of NVD document format: %v", d.Format)
+}
+
+func (p *NVDProcessor) Unpack(d *processor.Document) ([]*processor.Document, error) {
+	if d.Type != processor.DocumentNVD {
+		return nil, fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentNVD, d.Type)
+	}
+
+	return []*processor.Document{}, nil
+}
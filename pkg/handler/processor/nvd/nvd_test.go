@@ -0,0 +1,124 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nvd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+func TestNVDProcessor_ValidateSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     *processor.Document
+		wantErr bool
+	}{
+		{
+			name: "default NVD document",
+			doc: &processor.Document{
+				Blob:   testdata.NVDFeedExample,
+				Type:   processor.DocumentNVD,
+				Format: processor.FormatJSON,
+			},
+			wantErr: false,
+		},
+		{
+			name: "incorrect type",
+			doc: &processor.Document{
+				Blob:   testdata.NVDFeedExample,
+				Type:   processor.DocumentUnknown,
+				Format: processor.FormatJSON,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid NVD document",
+			doc: &processor.Document{
+				Blob:   []byte("invalid"),
+				Type:   processor.DocumentNVD,
+				Format: processor.FormatJSON,
+			},
+			wantErr: true,
+		},
+		{
+			name: "not an NVD CVE feed",
+			doc: &processor.Document{
+				Blob:   []byte(`{"format": "NVD_CPE", "version": "2.0"}`),
+				Type:   processor.DocumentNVD,
+				Format: processor.FormatJSON,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid NVD document format",
+			doc: &processor.Document{
+				Blob:   testdata.NVDFeedExample,
+				Type:   processor.DocumentNVD,
+				Format: processor.FormatUnknown,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &NVDProcessor{}
+			if err := p.ValidateSchema(tt.doc); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSchema() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNVDProcessor_Unpack(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     *processor.Document
+		want    []*processor.Document
+		wantErr bool
+	}{
+		{
+			name: "NVD document",
+			doc: &processor.Document{
+				Type: processor.DocumentNVD,
+			},
+			want: []*processor.Document{},
+		},
+		{
+			name: "Incorrect type",
+			doc: &processor.Document{
+				Type: processor.DocumentUnknown,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &NVDProcessor{}
+			got, err := p.Unpack(tt.doc)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unpack() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Unpack() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
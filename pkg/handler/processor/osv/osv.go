@@ -0,0 +1,60 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"fmt"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/google/osv-scanner/pkg/models"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+type OSVProcessor struct{}
+
+func (p *OSVProcessor) ValidateSchema(d *processor.Document) error {
+	if d.Type != processor.DocumentOSV {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentOSV, d.Type)
+	}
+
+	switch d.Format {
+	case processor.FormatJSON:
+		var vuln models.Vulnerability
+		if err := json.Unmarshal(d.Blob, &vuln); err != nil {
+			return err
+		}
+		if vuln.ID == "" {
+			return fmt.Errorf("OSV entry has no id")
+		}
+		if vuln.Modified.IsZero() {
+			return fmt.Errorf("OSV entry %s has no modified time", vuln.ID)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unable to support parsing of OSV document format: %v", d.Format)
+}
+
+func (p *OSVProcessor) Unpack(d *processor.Document) ([]*processor.Document, error) {
+	if d.Type != processor.DocumentOSV {
+		return nil, fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentOSV, d.Type)
+	}
+
+	return []*processor.Document{}, nil
+}
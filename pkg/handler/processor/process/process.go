@@ -18,6 +18,7 @@ package process
 import (
 	"bytes"
 	"compress/bzip2"
+	"compress/gzip"
 	"context"
 	"encoding/xml"
 	"fmt"
@@ -38,7 +39,9 @@ import (
 	"github.com/guacsec/guac/pkg/handler/processor/dsse"
 	"github.com/guacsec/guac/pkg/handler/processor/guesser"
 	"github.com/guacsec/guac/pkg/handler/processor/ite6"
+	"github.com/guacsec/guac/pkg/handler/processor/nvd"
 	"github.com/guacsec/guac/pkg/handler/processor/open_vex"
+	"github.com/guacsec/guac/pkg/handler/processor/osv"
 	"github.com/guacsec/guac/pkg/handler/processor/scorecard"
 	"github.com/guacsec/guac/pkg/handler/processor/spdx"
 	"github.com/guacsec/guac/pkg/logging"
@@ -63,6 +66,8 @@ func init() {
 	_ = RegisterDocumentProcessor(&scorecard.ScorecardProcessor{}, processor.DocumentScorecard)
 	_ = RegisterDocumentProcessor(&cyclonedx.CycloneDXProcessor{}, processor.DocumentCycloneDX)
 	_ = RegisterDocumentProcessor(&deps_dev.DepsDev{}, processor.DocumentDepsDev)
+	_ = RegisterDocumentProcessor(&nvd.NVDProcessor{}, processor.DocumentNVD)
+	_ = RegisterDocumentProcessor(&osv.OSVProcessor{}, processor.DocumentOSV)
 }
 
 func RegisterDocumentProcessor(p processor.DocumentProcessor, d processor.DocumentType) error {
@@ -270,6 +275,11 @@ func decodeDocument(ctx context.Context, i *processor.Document) error {
 		if err != nil {
 			return fmt.Errorf("unable to create zstd reader: %w", err)
 		}
+	case processor.EncodingGzip:
+		reader, err = gzip.NewReader(bytes.NewReader(i.Blob))
+		if err != nil {
+			return fmt.Errorf("unable to create gzip reader: %w", err)
+		}
 	}
 	if reader != nil {
 		if err := decompressDocument(i, reader); err != nil {
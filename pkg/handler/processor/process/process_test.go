@@ -17,6 +17,7 @@ package process
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"go.uber.org/zap"
@@ -506,6 +507,26 @@ func Test_SimpleDocProcessTest(t *testing.T) {
 				Format:            processor.FormatJSON,
 				SourceInformation: processor.SourceInformation{},
 			}),
+		}, {
+			name: "JsonGzipIngestion",
+			doc: processor.Document{
+				Blob:     gzipBlob(t, testdata.CycloneDXBusyboxExample),
+				Type:     processor.DocumentCycloneDX,
+				Format:   processor.FormatJSON,
+				Encoding: processor.EncodingGzip,
+				SourceInformation: processor.SourceInformation{
+					Source: "file:///exampledata/busybox-cyclonedx.json",
+				},
+			},
+			expected: dochelper.DocNode(&processor.Document{
+				Blob:     testdata.CycloneDXBusyboxExample,
+				Type:     processor.DocumentCycloneDX,
+				Format:   processor.FormatJSON,
+				Encoding: processor.EncodingGzip,
+				SourceInformation: processor.SourceInformation{
+					Source: "file:///exampledata/busybox-cyclonedx.json",
+				},
+			}),
 		}, {
 			name: "JsonBz2Ingestion",
 			doc: processor.Document{
@@ -854,3 +875,16 @@ func testPublish(ctx context.Context, d *processor.Document, blobStore *blob.Blo
 	logger.Debugf("doc published: %+v", d.SourceInformation.Source)
 	return nil
 }
+
+func gzipBlob(t *testing.T, blob []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(blob); err != nil {
+		t.Fatalf("failed to compress blob: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("failed to compress blob: %v", err)
+	}
+	return buf.Bytes()
+}
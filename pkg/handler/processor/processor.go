@@ -67,6 +67,8 @@ const (
 	DocumentDepsDev          DocumentType = "DEPS_DEV"
 	DocumentCsaf             DocumentType = "CSAF"
 	DocumentOpenVEX          DocumentType = "OPEN_VEX"
+	DocumentNVD              DocumentType = "NVD"
+	DocumentOSV              DocumentType = "OSV"
 	DocumentIngestPredicates DocumentType = "INGEST_PREDICATES"
 	DocumentUnknown          DocumentType = "UNKNOWN"
 )
@@ -87,12 +89,14 @@ type EncodingType string
 const (
 	EncodingBzip2   EncodingType = "BZIP2"
 	EncodingZstd    EncodingType = "ZSTD"
+	EncodingGzip    EncodingType = "GZIP"
 	EncodingUnknown EncodingType = "UNKNOWN"
 )
 
 var EncodingExts = map[string]EncodingType{
 	".bz2": EncodingBzip2,
 	".zst": EncodingZstd,
+	".gz":  EncodingGzip,
 }
 
 // SourceInformation provides additional information about where the document comes from
@@ -0,0 +1,110 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package health reports whether a GUAC service can reach its database, in a
+// form suited to Kubernetes liveness and readiness probes.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// Path is the path the health check is served on.
+const Path = "/healthz"
+
+// checkTimeout bounds the database queries of a check, so that a probe gets an
+// answer before it times out itself.
+const checkTimeout = 5 * time.Second
+
+// Database is implemented by the backends that store the graph in a database.
+type Database interface {
+	// Ping checks that the database can be queried.
+	Ping(ctx context.Context) error
+	// SchemaVersion returns the version of the last schema migration applied
+	// to the database, or an empty string if it is not tracked.
+	SchemaVersion(ctx context.Context) (string, error)
+}
+
+// HealthStatus is the result of a health check.
+type HealthStatus struct {
+	DBReachable   bool          `json:"dbReachable"`
+	SchemaVersion string        `json:"schemaVersion,omitempty"`
+	Uptime        time.Duration `json:"-"`
+	// Error is why the check failed
+	Error string `json:"error,omitempty"`
+}
+
+// MarshalJSON writes the uptime as a duration string, e.g. "1h2m3s".
+func (s HealthStatus) MarshalJSON() ([]byte, error) {
+	type status HealthStatus
+	return json.Marshal(struct {
+		status
+		Uptime string `json:"uptime"`
+	}{status(s), s.Uptime.String()})
+}
+
+// HealthChecker checks the database of a service. It is an http.Handler
+// answering 200 with the HealthStatus as JSON when the database is reachable
+// and 503 otherwise.
+type HealthChecker struct {
+	db    Database
+	start time.Time
+	now   func() time.Time
+}
+
+// NewHealthChecker returns a checker for db, measuring the uptime from now. A
+// nil db is for the backends keeping the graph in memory, which are always
+// reachable.
+func NewHealthChecker(db Database) *HealthChecker {
+	return &HealthChecker{db: db, start: time.Now(), now: time.Now}
+}
+
+// Check pings the database and reads its schema version.
+func (h *HealthChecker) Check(ctx context.Context) HealthStatus {
+	status := HealthStatus{Uptime: h.now().Sub(h.start).Round(time.Second)}
+	if h.db == nil {
+		status.DBReachable = true
+		return status
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	if err := h.db.Ping(ctx); err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	status.DBReachable = true
+	version, err := h.db.SchemaVersion(ctx)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	status.SchemaVersion = version
+	return status
+}
+
+func (h *HealthChecker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	status := h.Check(r.Context())
+	w.Header().Set("Content-Type", "application/json")
+	if status.DBReachable {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(status)
+}
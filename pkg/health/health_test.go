@@ -0,0 +1,102 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type fakeDatabase struct {
+	pingErr    error
+	version    string
+	versionErr error
+}
+
+func (f *fakeDatabase) Ping(ctx context.Context) error {
+	return f.pingErr
+}
+
+func (f *fakeDatabase) SchemaVersion(ctx context.Context) (string, error) {
+	return f.version, f.versionErr
+}
+
+func TestHealthChecker(t *testing.T) {
+	tests := []struct {
+		name       string
+		db         Database
+		wantStatus int
+		want       map[string]interface{}
+	}{{
+		name:       "reachable",
+		db:         &fakeDatabase{version: "20240301000000"},
+		wantStatus: http.StatusOK,
+		want:       map[string]interface{}{"dbReachable": true, "schemaVersion": "20240301000000", "uptime": "1m30s"},
+	}, {
+		name:       "untracked schema version",
+		db:         &fakeDatabase{},
+		wantStatus: http.StatusOK,
+		want:       map[string]interface{}{"dbReachable": true, "uptime": "1m30s"},
+	}, {
+		name:       "ping fails",
+		db:         &fakeDatabase{pingErr: errors.New("connection refused")},
+		wantStatus: http.StatusServiceUnavailable,
+		want:       map[string]interface{}{"dbReachable": false, "uptime": "1m30s", "error": "connection refused"},
+	}, {
+		name:       "schema version fails",
+		db:         &fakeDatabase{versionErr: errors.New("permission denied")},
+		wantStatus: http.StatusOK,
+		want:       map[string]interface{}{"dbReachable": true, "uptime": "1m30s", "error": "permission denied"},
+	}, {
+		name:       "in memory backend",
+		wantStatus: http.StatusOK,
+		want:       map[string]interface{}{"dbReachable": true, "uptime": "1m30s"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHealthChecker(tt.db)
+			h.now = func() time.Time { return h.start.Add(90*time.Second + 200*time.Millisecond) }
+			server := httptest.NewServer(h)
+			defer server.Close()
+
+			resp, err := http.Get(server.URL + Path)
+			if err != nil {
+				t.Fatalf("health check failed: %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("got status %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if ct := resp.Header.Get("Content-Type"); ct != "application/json" {
+				t.Errorf("got content type %q, want application/json", ct)
+			}
+			var got map[string]interface{}
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected status (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
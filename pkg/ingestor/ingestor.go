@@ -39,7 +39,8 @@ func Ingest(ctx context.Context, d *processor.Document, graphqlEndpoint string,
 	logger := d.ChildLogger
 	// Get pipeline of components
 	processorFunc := GetProcessor(ctx)
-	ingestorFunc := GetIngestor(ctx)
+	// parsers such as the OSV one look up the packages already in the graph
+	ingestorFunc := GetIngestor(parser_common.WithGraphQLClient(ctx, graphql.NewClient(graphqlEndpoint, &http.Client{})))
 	collectSubEmitFunc := GetCollectSubEmit(ctx, csubClient)
 	assemblerFunc := GetAssembler(ctx, d.ChildLogger, graphqlEndpoint)
 
@@ -73,7 +74,7 @@ func MergedIngest(ctx context.Context, docs []*processor.Document, graphqlEndpoi
 	logger := logging.FromContext(ctx)
 	// Get pipeline of components
 	processorFunc := GetProcessor(ctx)
-	ingestorFunc := GetIngestor(ctx)
+	ingestorFunc := GetIngestor(parser_common.WithGraphQLClient(ctx, graphql.NewClient(graphqlEndpoint, &http.Client{})))
 	collectSubEmitFunc := GetCollectSubEmit(ctx, csubClient)
 	assemblerFunc := GetAssembler(ctx, logger, graphqlEndpoint)
 
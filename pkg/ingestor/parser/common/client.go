@@ -0,0 +1,37 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+type graphQLClientKey struct{}
+
+// WithGraphQLClient returns a copy of ctx carrying the client used by the
+// parsers that need to look up the nodes already in the graph.
+func WithGraphQLClient(ctx context.Context, client graphql.Client) context.Context {
+	return context.WithValue(ctx, graphQLClientKey{}, client)
+}
+
+// GraphQLClientFromContext returns the client set by WithGraphQLClient, or nil
+// when there is none.
+func GraphQLClientFromContext(ctx context.Context) graphql.Client {
+	client, _ := ctx.Value(graphQLClientKey{}).(graphql.Client)
+	return client
+}
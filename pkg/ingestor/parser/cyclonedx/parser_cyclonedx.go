@@ -191,6 +191,10 @@ func (c *cyclonedxParser) getPackages() error {
 				if err != nil {
 					return err
 				}
+				if comp.Type != "" {
+					componentType := strings.ToLower(string(comp.Type))
+					pkg.ComponentType = &componentType
+				}
 				c.packagePackages[comp.BOMRef] = append(c.packagePackages[comp.BOMRef], pkg)
 				c.identifierStrings.PurlStrings = append(c.identifierStrings.PurlStrings, comp.PackageURL)
 
@@ -318,9 +318,10 @@ func Test_cyclonedxParser_addRootPackage(t *testing.T) {
 
 func Test_cyclonedxParser_getComponentPackages(t *testing.T) {
 	tests := []struct {
-		name     string
-		cdxBom   *cdx.BOM
-		wantPurl string
+		name              string
+		cdxBom            *cdx.BOM
+		wantPurl          string
+		wantComponentType string
 	}{{
 		name: "purl provided",
 		cdxBom: &cdx.BOM{
@@ -331,7 +332,8 @@ func Test_cyclonedxParser_getComponentPackages(t *testing.T) {
 				PackageURL: "pkg:oci/static@sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388?repository_url=gcr.io/distroless/static&tag=nonroot",
 			}},
 		},
-		wantPurl: "pkg:oci/static@sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388?repository_url=gcr.io/distroless/static&tag=nonroot",
+		wantPurl:          "pkg:oci/static@sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388?repository_url=gcr.io/distroless/static&tag=nonroot",
+		wantComponentType: "container",
 	}, {
 		name: "gcr.io/distroless/static:nonroot - purl not provided",
 		cdxBom: &cdx.BOM{
@@ -341,7 +343,8 @@ func Test_cyclonedxParser_getComponentPackages(t *testing.T) {
 				Version: "sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388",
 			}},
 		},
-		wantPurl: "pkg:guac/pkg/gcr.io/distroless/static@sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388?tag=nonroot",
+		wantPurl:          "pkg:guac/pkg/gcr.io/distroless/static@sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388?tag=nonroot",
+		wantComponentType: "container",
 	}, {
 		name: "gcr.io/distroless/static - purl not provided, tag not specified",
 
@@ -352,7 +355,8 @@ func Test_cyclonedxParser_getComponentPackages(t *testing.T) {
 				Version: "sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388",
 			}},
 		},
-		wantPurl: "pkg:guac/pkg/gcr.io/distroless/static@sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388?tag=",
+		wantPurl:          "pkg:guac/pkg/gcr.io/distroless/static@sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388?tag=",
+		wantComponentType: "container",
 	}, {
 		name: "gcr.io/distroless/static - purl not provided, tag not specified, version not specified",
 
@@ -362,7 +366,8 @@ func Test_cyclonedxParser_getComponentPackages(t *testing.T) {
 				Type: cdx.ComponentTypeContainer,
 			}},
 		},
-		wantPurl: "pkg:guac/pkg/gcr.io/distroless/static@?tag=",
+		wantPurl:          "pkg:guac/pkg/gcr.io/distroless/static@?tag=",
+		wantComponentType: "container",
 	}, {
 		name: "library/debian:latest - purl not provided, assume docker.io",
 
@@ -373,7 +378,8 @@ func Test_cyclonedxParser_getComponentPackages(t *testing.T) {
 				Version: "sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870",
 			}},
 		},
-		wantPurl: "pkg:guac/pkg/library/debian@sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870?tag=latest",
+		wantPurl:          "pkg:guac/pkg/library/debian@sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870?tag=latest",
+		wantComponentType: "container",
 	}, {
 		name: "library/debian - purl not provided, tag not specified",
 		cdxBom: &cdx.BOM{
@@ -383,7 +389,8 @@ func Test_cyclonedxParser_getComponentPackages(t *testing.T) {
 				Version: "sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870",
 			}},
 		},
-		wantPurl: "pkg:guac/pkg/library/debian@sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870?tag=",
+		wantPurl:          "pkg:guac/pkg/library/debian@sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870?tag=",
+		wantComponentType: "container",
 	}, {
 		name: "library - purl not provided, tag not specified",
 		cdxBom: &cdx.BOM{
@@ -393,7 +400,8 @@ func Test_cyclonedxParser_getComponentPackages(t *testing.T) {
 				Version: "sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870",
 			}},
 		},
-		wantPurl: "pkg:guac/pkg/library@sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870?tag=",
+		wantPurl:          "pkg:guac/pkg/library@sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870?tag=",
+		wantComponentType: "container",
 	}, {
 		name: "name split length too long, tag not specified",
 		cdxBom: &cdx.BOM{
@@ -403,7 +411,8 @@ func Test_cyclonedxParser_getComponentPackages(t *testing.T) {
 				Version: "sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870",
 			}},
 		},
-		wantPurl: "pkg:guac/pkg/ghcr.io/guacsec/guac/guacsec@sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870",
+		wantPurl:          "pkg:guac/pkg/ghcr.io/guacsec/guac/guacsec@sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870",
+		wantComponentType: "container",
 	}, {
 		name: "name contains local registry, tag specified",
 		cdxBom: &cdx.BOM{
@@ -413,7 +422,8 @@ func Test_cyclonedxParser_getComponentPackages(t *testing.T) {
 				Version: "sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870",
 			}},
 		},
-		wantPurl: "pkg:guac/pkg/foo.registry.com:4443/myapp/debian@sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870?tag=latest",
+		wantPurl:          "pkg:guac/pkg/foo.registry.com:4443/myapp/debian@sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870?tag=latest",
+		wantComponentType: "container",
 	}, {
 		name: "ComponentTypeLibrary",
 
@@ -424,7 +434,8 @@ func Test_cyclonedxParser_getComponentPackages(t *testing.T) {
 				Version: "sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870",
 			}},
 		},
-		wantPurl: "pkg:guac/pkg/ghcr.io/guacsec/guac/guacsec@sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870",
+		wantPurl:          "pkg:guac/pkg/ghcr.io/guacsec/guac/guacsec@sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870",
+		wantComponentType: "library",
 	}, {
 		name: "file type - purl nor provided, version provided",
 		cdxBom: &cdx.BOM{
@@ -434,7 +445,8 @@ func Test_cyclonedxParser_getComponentPackages(t *testing.T) {
 				Version: "sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870",
 			}},
 		},
-		wantPurl: "pkg:guac/files/sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870?filename=/home/work/test/build/webserver",
+		wantPurl:          "pkg:guac/files/sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870?filename=/home/work/test/build/webserver",
+		wantComponentType: "file",
 	}, {
 		name: "file type - purl nor provided, version not provided",
 		cdxBom: &cdx.BOM{
@@ -443,7 +455,8 @@ func Test_cyclonedxParser_getComponentPackages(t *testing.T) {
 				Type: cdx.ComponentTypeFile,
 			}},
 		},
-		wantPurl: "pkg:guac/files/home/work/test/build/webserver",
+		wantPurl:          "pkg:guac/files/home/work/test/build/webserver",
+		wantComponentType: "file",
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -465,6 +478,7 @@ func Test_cyclonedxParser_getComponentPackages(t *testing.T) {
 			if err != nil {
 				t.Errorf("Failed to parse purl %v %v", tt.wantPurl, err)
 			}
+			wantPackage.ComponentType = &tt.wantComponentType
 			for _, comp := range *tt.cdxBom.Components {
 				if d := cmp.Diff(*wantPackage, *c.packagePackages[comp.BOMRef][0]); len(d) != 0 {
 					t.Errorf("addRootPackage failed to produce expected package for %v", tt.name)
@@ -0,0 +1,122 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nvd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/nvd"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// nvdTimeLayout is the layout of the timestamps in the NVD feed, which are in
+// UTC but carry no zone offset
+const nvdTimeLayout = "2006-01-02T15:04:05"
+
+type nvdParser struct {
+	vulnMetadata []assembler.VulnMetadataIngest
+}
+
+// NewNVDParser initializes the parser for the NVD CVE API 2.0 feed
+func NewNVDParser() common.DocumentParser {
+	return &nvdParser{}
+}
+
+// Parse breaks out the document into the graph components. Only the CVSS base
+// scores are ingested: VulnerabilityMetadata holds a score type and value, so
+// the vector strings and the CWE IDs of the weaknesses are dropped.
+func (c *nvdParser) Parse(ctx context.Context, doc *processor.Document) error {
+	var feed nvd.Feed
+	if err := json.Unmarshal(doc.Blob, &feed); err != nil {
+		return fmt.Errorf("failed to unmarshal NVD document: %w", err)
+	}
+
+	for _, v := range feed.Vulnerabilities {
+		vuln, err := helpers.CreateVulnInput(v.CVE.ID)
+		if err != nil {
+			return fmt.Errorf("failed to create vulnerability input: %w", err)
+		}
+		timestamp, err := time.Parse(nvdTimeLayout, v.CVE.LastModified)
+		if err != nil {
+			return fmt.Errorf("failed to parse lastModified of %s: %w", v.CVE.ID, err)
+		}
+
+		scores := []struct {
+			scoreType generated.VulnerabilityScoreType
+			metrics   []nvd.CVSSMetric
+		}{
+			{generated.VulnerabilityScoreTypeCvssv2, v.CVE.Metrics.CVSSMetricV2},
+			{generated.VulnerabilityScoreTypeCvssv3, v.CVE.Metrics.CVSSMetricV30},
+			{generated.VulnerabilityScoreTypeCvssv31, v.CVE.Metrics.CVSSMetricV31},
+			{generated.VulnerabilityScoreTypeCvssv4, v.CVE.Metrics.CVSSMetricV40},
+		}
+		for _, s := range scores {
+			metric := primaryMetric(s.metrics)
+			if metric == nil {
+				continue
+			}
+			c.vulnMetadata = append(c.vulnMetadata, assembler.VulnMetadataIngest{
+				Vulnerability: vuln,
+				VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
+					ScoreType:  s.scoreType,
+					ScoreValue: metric.CVSSData.BaseScore,
+					Timestamp:  timestamp,
+				},
+			})
+		}
+	}
+
+	return nil
+}
+
+// primaryMetric returns the score given by the NVD itself, falling back to the
+// first score listed when the NVD has not analyzed the CVE yet
+func primaryMetric(metrics []nvd.CVSSMetric) *nvd.CVSSMetric {
+	for i := range metrics {
+		if metrics[i].Type == "Primary" {
+			return &metrics[i]
+		}
+	}
+	if len(metrics) > 0 {
+		return &metrics[0]
+	}
+	return nil
+}
+
+// GetIdentities gets the identity node from the document if they exist
+func (c *nvdParser) GetIdentities(ctx context.Context) []common.TrustInformation {
+	return nil
+}
+
+func (c *nvdParser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
+	return &common.IdentifierStrings{}, nil
+}
+
+func (c *nvdParser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	return &assembler.IngestPredicates{
+		VulnMetadata: c.vulnMetadata,
+	}
+}
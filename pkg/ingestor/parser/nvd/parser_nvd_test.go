@@ -0,0 +1,81 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nvd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+func Test_nvdParser(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		doc     *processor.Document
+		want    *assembler.IngestPredicates
+		wantErr bool
+	}{
+		{
+			name: "NVD feed",
+			doc: &processor.Document{
+				Blob:   testdata.NVDFeedExample,
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentNVD,
+			},
+			want: &assembler.IngestPredicates{
+				VulnMetadata: testdata.NVDVulnMetadataIngest,
+			},
+		},
+		{
+			name: "malformed CVE ID",
+			doc: &processor.Document{
+				Blob:   []byte(`{"format": "NVD_CVE", "vulnerabilities": [{"cve": {"id": "log4shell", "lastModified": "2023-11-07T03:39:36.747"}}]}`),
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentNVD,
+			},
+			wantErr: true,
+		},
+		{
+			name: "malformed timestamp",
+			doc: &processor.Document{
+				Blob:   []byte(`{"format": "NVD_CVE", "vulnerabilities": [{"cve": {"id": "CVE-2021-44228", "lastModified": "yesterday"}}]}`),
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentNVD,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewNVDParser()
+			err := p.Parse(ctx, tt.doc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if d := cmp.Diff(tt.want, p.GetPredicates(ctx), testdata.IngestPredicatesCmpOpts...); len(d) != 0 {
+				t.Errorf("nvd.GetPredicates mismatch values (+got, -expected): %s", d)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/google/osv-scanner/pkg/models"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/misc/depversion"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// osvDB is recorded as the database of the CertifyVuln nodes created from the
+// entries
+const osvDB = "osv.dev"
+
+// ecosystemPurlTypes maps the OSV ecosystems to the purl type of their
+// packages. It is only used when the entry does not give the purl itself.
+var ecosystemPurlTypes = map[models.Ecosystem]string{
+	models.EcosystemCratesIO:  "cargo",
+	models.EcosystemGo:        "golang",
+	models.EcosystemHex:       "hex",
+	models.EcosystemMaven:     "maven",
+	models.EcosystemNPM:       "npm",
+	models.EcosystemNuGet:     "nuget",
+	models.EcosystemPackagist: "composer",
+	models.EcosystemPub:       "pub",
+	models.EcosystemPyPI:      "pypi",
+	models.EcosystemRubyGems:  "gem",
+}
+
+type osvParser struct {
+	vulnEquals   []assembler.VulnEqualIngest
+	certifyVulns []assembler.CertifyVulnIngest
+}
+
+// NewOSVParser initializes the parser for OSV entries
+func NewOSVParser() common.DocumentParser {
+	return &osvParser{}
+}
+
+// Parse breaks out the document into the graph components. The OSV node of
+// the entry, as created by the vulnerability certifier, is made equal to the
+// vulnerability it describes and to each of its aliases.
+//
+// When the context carries a GraphQL client, the OSV node is also certified
+// against each version already in the graph of the affected packages that
+// falls in one of the SEMVER or ECOSYSTEM ranges, or is listed, of the entry.
+func (c *osvParser) Parse(ctx context.Context, doc *processor.Document) error {
+	var entry models.Vulnerability
+	if err := json.Unmarshal(doc.Blob, &entry); err != nil {
+		return fmt.Errorf("failed to unmarshal OSV document: %w", err)
+	}
+
+	osvVuln := &generated.VulnerabilityInputSpec{
+		Type:            "osv",
+		VulnerabilityID: strings.ToLower(entry.ID),
+	}
+	vuln, err := helpers.CreateVulnInput(entry.ID)
+	if err != nil {
+		return fmt.Errorf("createVulnInput failed with error: %w", err)
+	}
+	c.vulnEquals = append(c.vulnEquals, assembler.VulnEqualIngest{
+		Vulnerability:      osvVuln,
+		EqualVulnerability: vuln,
+		VulnEqual: &generated.VulnEqualInputSpec{
+			Justification: "Decoded OSV data",
+		},
+	})
+	for _, alias := range entry.Aliases {
+		aliasVuln, err := helpers.CreateVulnInput(alias)
+		if err != nil {
+			return fmt.Errorf("createVulnInput failed for alias of %s with error: %w", entry.ID, err)
+		}
+		c.vulnEquals = append(c.vulnEquals, assembler.VulnEqualIngest{
+			Vulnerability:      osvVuln,
+			EqualVulnerability: aliasVuln,
+			VulnEqual: &generated.VulnEqualInputSpec{
+				Justification: "OSV alias",
+			},
+		})
+	}
+
+	client := common.GraphQLClientFromContext(ctx)
+	if client == nil {
+		return nil
+	}
+	vulnData := &generated.ScanMetadataInput{
+		TimeScanned: entry.Modified,
+		DbUri:       osvDB,
+	}
+	for _, affected := range entry.Affected {
+		pkgs, err := affectedPackages(ctx, client, affected)
+		if err != nil {
+			return fmt.Errorf("failed to find the packages affected by %s: %w", entry.ID, err)
+		}
+		for _, pkg := range pkgs {
+			c.certifyVulns = append(c.certifyVulns, assembler.CertifyVulnIngest{
+				Pkg:           pkg,
+				Vulnerability: osvVuln,
+				VulnData:      vulnData,
+			})
+		}
+	}
+
+	return nil
+}
+
+// affectedPackages returns the package versions in the graph that are
+// affected according to the entry
+func affectedPackages(ctx context.Context, client graphql.Client, affected models.Affected) ([]*generated.PkgInputSpec, error) {
+	purl := affected.Package.Purl
+	if purl == "" {
+		purlType, ok := ecosystemPurlTypes[affected.Package.Ecosystem]
+		if !ok {
+			return nil, nil
+		}
+		name := affected.Package.Name
+		if purlType == "maven" {
+			name = strings.Replace(name, ":", "/", 1)
+		}
+		purl = "pkg:" + purlType + "/" + name
+	}
+	pkgInput, err := helpers.PurlToPkg(purl)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := generated.Packages(ctx, client, generated.PkgSpec{
+		Type:      &pkgInput.Type,
+		Namespace: pkgInput.Namespace,
+		Name:      &pkgInput.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query packages: %w", err)
+	}
+
+	var versions []string
+	for _, p := range resp.Packages {
+		for _, ns := range p.Namespaces {
+			for _, n := range ns.Names {
+				for _, v := range n.Versions {
+					versions = append(versions, v.Version)
+				}
+			}
+		}
+	}
+	matched, err := affectedVersions(affected, versions)
+	if err != nil {
+		return nil, err
+	}
+
+	var pkgs []*generated.PkgInputSpec
+	for _, p := range resp.Packages {
+		for _, ns := range p.Namespaces {
+			for _, n := range ns.Names {
+				for _, v := range n.Versions {
+					if !matched[v.Version] {
+						continue
+					}
+					var qualifiers []generated.PackageQualifierInputSpec
+					for _, q := range v.Qualifiers {
+						qualifiers = append(qualifiers, generated.PackageQualifierInputSpec{Key: q.Key, Value: q.Value})
+					}
+					namespace, version, subpath := ns.Namespace, v.Version, v.Subpath
+					pkgs = append(pkgs, &generated.PkgInputSpec{
+						Type:       p.Type,
+						Namespace:  &namespace,
+						Name:       n.Name,
+						Version:    &version,
+						Qualifiers: qualifiers,
+						Subpath:    &subpath,
+					})
+				}
+			}
+		}
+	}
+	return pkgs, nil
+}
+
+// affectedVersions returns which of the versions are listed by the entry or
+// fall in one of its SEMVER or ECOSYSTEM ranges. The ECOSYSTEM ranges are
+// compared as semantic versions, which most ecosystems follow closely enough;
+// the others are covered by the versions listed.
+func affectedVersions(affected models.Affected, versions []string) (map[string]bool, error) {
+	matched := map[string]bool{}
+	for _, v := range affected.Versions {
+		matched[v] = true
+	}
+	for _, r := range affected.Ranges {
+		if r.Type != models.RangeSemVer && r.Type != models.RangeEcosystem {
+			continue
+		}
+		constraint := rangeConstraint(r.Events)
+		if constraint == "" {
+			continue
+		}
+		inRange, err := depversion.WhichVersionMatches(versions, constraint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to match range %q: %w", constraint, err)
+		}
+		maps.Copy(matched, inRange)
+	}
+	return matched, nil
+}
+
+// rangeConstraint turns the events of an OSV range into a version constraint,
+// with one interval per introduced event
+func rangeConstraint(events []models.Event) string {
+	var intervals []string
+	var lower string
+	open := false
+	for _, e := range events {
+		switch {
+		case e.Introduced != "":
+			lower = ""
+			if e.Introduced != "0" {
+				lower = ">=" + e.Introduced
+			}
+			open = true
+		case e.Fixed != "" && open:
+			intervals = append(intervals, boundedInterval(lower, "<"+e.Fixed))
+			open = false
+		case e.LastAffected != "" && open:
+			intervals = append(intervals, boundedInterval(lower, "<="+e.LastAffected))
+			open = false
+		}
+	}
+	if open {
+		if lower == "" {
+			lower = ">=0.0.0"
+		}
+		intervals = append(intervals, lower)
+	}
+	return strings.Join(intervals, " || ")
+}
+
+func boundedInterval(lower, upper string) string {
+	if lower == "" {
+		return upper
+	}
+	return lower + "," + upper
+}
+
+// GetIdentities gets the identity node from the document if they exist
+func (c *osvParser) GetIdentities(ctx context.Context) []common.TrustInformation {
+	return nil
+}
+
+func (c *osvParser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
+	return &common.IdentifierStrings{}, nil
+}
+
+func (c *osvParser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	return &assembler.IngestPredicates{
+		VulnEqual:   c.vulnEquals,
+		CertifyVuln: c.certifyVulns,
+	}
+}
@@ -0,0 +1,212 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scanner/pkg/models"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	gqlgenerated "github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+)
+
+func Test_osvParser(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		doc     *processor.Document
+		want    *assembler.IngestPredicates
+		wantErr bool
+	}{
+		{
+			name: "OSV entry",
+			doc: &processor.Document{
+				Blob:   testdata.OSVExample,
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentOSV,
+			},
+			want: &assembler.IngestPredicates{
+				VulnEqual: testdata.OSVVulnEqualIngest,
+			},
+		},
+		{
+			name: "malformed OSV ID",
+			doc: &processor.Document{
+				Blob:   []byte(`{"id": "log4shell", "modified": "2024-03-15T16:08:12Z"}`),
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentOSV,
+			},
+			wantErr: true,
+		},
+		{
+			name: "malformed alias",
+			doc: &processor.Document{
+				Blob:   []byte(`{"id": "GHSA-jfh8-c2jp-5v3q", "modified": "2024-03-15T16:08:12Z", "aliases": ["log4shell"]}`),
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentOSV,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewOSVParser()
+			err := p.Parse(ctx, tt.doc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if d := cmp.Diff(tt.want, p.GetPredicates(ctx), testdata.IngestPredicatesCmpOpts...); len(d) != 0 {
+				t.Errorf("osv.GetPredicates mismatch values (+got, -expected): %s", d)
+			}
+		})
+	}
+}
+
+func Test_osvParser_certifyVuln(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", ctx, nil)
+	if err != nil {
+		t.Fatalf("failed to get backend: %v", err)
+	}
+	for _, v := range []string{"2.12.0", "2.13.0", "2.14.1", "2.15.0"} {
+		if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: &model.PkgInputSpec{
+			Type:      "maven",
+			Namespace: ptrfrom.String("org.apache.logging.log4j"),
+			Name:      "log4j-core",
+			Version:   ptrfrom.String(v),
+		}}); err != nil {
+			t.Fatalf("failed to ingest package: %v", err)
+		}
+	}
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: &model.PkgInputSpec{
+		Type:      "maven",
+		Namespace: ptrfrom.String("org.apache.logging.log4j"),
+		Name:      "log4j-api",
+		Version:   ptrfrom.String("2.14.1"),
+	}}); err != nil {
+		t.Fatalf("failed to ingest package: %v", err)
+	}
+	srv := httptest.NewServer(handler.NewDefaultServer(gqlgenerated.NewExecutableSchema(
+		gqlgenerated.Config{Resolvers: &resolvers.Resolver{Backend: b}})))
+	defer srv.Close()
+	ctx = common.WithGraphQLClient(ctx, graphql.NewClient(srv.URL, srv.Client()))
+
+	p := NewOSVParser()
+	if err := p.Parse(ctx, &processor.Document{
+		Blob:   testdata.OSVExample,
+		Format: processor.FormatJSON,
+		Type:   processor.DocumentOSV,
+	}); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	vuln := &generated.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "ghsa-jfh8-c2jp-5v3q"}
+	vulnData := &generated.ScanMetadataInput{
+		TimeScanned: time.Date(2024, 3, 15, 16, 8, 12, 0, time.UTC),
+		DbUri:       "osv.dev",
+	}
+	var want []assembler.CertifyVulnIngest
+	for _, v := range []string{"2.13.0", "2.14.1"} {
+		want = append(want, assembler.CertifyVulnIngest{
+			Pkg: &generated.PkgInputSpec{
+				Type:      "maven",
+				Namespace: ptrfrom.String("org.apache.logging.log4j"),
+				Name:      "log4j-core",
+				Version:   ptrfrom.String(v),
+				Subpath:   ptrfrom.String(""),
+			},
+			Vulnerability: vuln,
+			VulnData:      vulnData,
+		})
+	}
+	if d := cmp.Diff(want, p.GetPredicates(ctx).CertifyVuln, testdata.IngestPredicatesCmpOpts...); len(d) != 0 {
+		t.Errorf("osv.GetPredicates CertifyVuln mismatch values (+got, -expected): %s", d)
+	}
+}
+
+func Test_affectedVersions(t *testing.T) {
+	versions := []string{"0.9.0", "1.0.0", "1.2.0", "1.4.0", "2.0.0", "2.1.0", "3.0.0"}
+	tests := []struct {
+		name     string
+		affected models.Affected
+		want     []string
+	}{{
+		name: "semver fixed",
+		affected: models.Affected{Ranges: []models.Range{{
+			Type:   models.RangeSemVer,
+			Events: []models.Event{{Introduced: "1.0.0"}, {Fixed: "1.4.0"}},
+		}}},
+		want: []string{"1.0.0", "1.2.0"},
+	}, {
+		name: "ecosystem last affected and reintroduced",
+		affected: models.Affected{Ranges: []models.Range{{
+			Type:   models.RangeEcosystem,
+			Events: []models.Event{{Introduced: "0"}, {LastAffected: "1.0.0"}, {Introduced: "2.1.0"}},
+		}}},
+		want: []string{"0.9.0", "1.0.0", "2.1.0", "3.0.0"},
+	}, {
+		name: "listed versions",
+		affected: models.Affected{
+			Versions: []string{"2.0.0"},
+			Ranges: []models.Range{{
+				Type:   models.RangeSemVer,
+				Events: []models.Event{{Introduced: "3.0.0"}},
+			}},
+		},
+		want: []string{"2.0.0", "3.0.0"},
+	}, {
+		name: "git range ignored",
+		affected: models.Affected{Ranges: []models.Range{{
+			Type:   models.RangeGit,
+			Events: []models.Event{{Introduced: "0"}},
+		}}},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, err := affectedVersions(tt.affected, versions)
+			if err != nil {
+				t.Fatalf("affectedVersions() error = %v", err)
+			}
+			var got []string
+			for _, v := range versions {
+				if matched[v] {
+					got = append(got, v)
+				}
+			}
+			if d := cmp.Diff(tt.want, got); len(d) != 0 {
+				t.Errorf("affectedVersions() mismatch (-want +got): %s", d)
+			}
+		})
+	}
+}
@@ -139,6 +139,10 @@ func (s *spdxParser) getPackages() error {
 		if err != nil {
 			return err
 		}
+		if pac.PrimaryPackagePurpose != "" {
+			componentType := strings.ToLower(pac.PrimaryPackagePurpose)
+			pkg.ComponentType = &componentType
+		}
 
 		if slices.Contains(topLevelSpdxIds, string(pac.PackageSPDXIdentifier)) {
 			s.topLevelPackages[string(s.spdxDoc.SPDXIdentifier)] = append(s.topLevelPackages[string(s.spdxDoc.SPDXIdentifier)], pkg)